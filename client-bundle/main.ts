@@ -3,12 +3,44 @@ import "./main.css";
 import Alpine from "alpinejs";
 import htmx from "htmx.org";
 
+interface TimelineMarker {
+  id?: string;
+  date: string;
+  kind: string;
+  message: string;
+}
+
+interface TimelineRun {
+  id: string;
+  reason?: string;
+  start: string;
+  end: string;
+  ended: boolean;
+  crashed: boolean;
+  errors: number;
+  markers: TimelineMarker[];
+}
+
+interface ResourceSample {
+  date: string;
+  rss: number;
+  cpu: number;
+  goroutines?: number;
+  heapAlloc?: number;
+}
+
+interface ResourceRun {
+  id: string;
+  samples: ResourceSample[];
+}
+
 interface SSEEvent {
   id: string;
   dt: string;
   target: string;
   swap: string;
   markup: string;
+  live?: boolean;
 }
 
 export type SwapType =
@@ -31,17 +63,69 @@ declare global {
 window.Alpine = Alpine;
 window.htmx = htmx;
 
+// the ui.auth token is added to the page by the server and sent with every request the UI makes
+const authToken =
+  document.querySelector<HTMLMetaElement>('meta[name="gomon-token"]')?.content ||
+  "";
+
+const authHeaders = (): Record<string, string> =>
+  authToken ? { Authorization: "Bearer " + authToken } : {};
+
+// the browser can't set headers on an EventSource so the token is sent as a parameter instead
+const withToken = (url: string): string =>
+  authToken
+    ? url + (url.includes("?") ? "&" : "?") + "token=" + encodeURIComponent(authToken)
+    : url;
+
+document.addEventListener("htmx:configRequest", (ev) => {
+  Object.assign((ev as CustomEvent).detail.headers, authHeaders());
+});
+
+// the timeline is drawn once its component has been loaded
+document.addEventListener("htmx:afterSwap", (ev) => {
+  const el = (ev.target as HTMLElement).querySelector<HTMLElement>(
+    "[data-timeline]"
+  );
+  if (el) {
+    renderTimeline(el);
+  }
+  const resourcesEl = (ev.target as HTMLElement).querySelector<HTMLElement>(
+    "[data-resources]"
+  );
+  if (resourcesEl) {
+    renderResources(resourcesEl);
+  }
+});
+
+// the split view shows stdout and stderr entries from the output list in their own panes
+const paneStreams: Record<string, string> = { "11": "stdout", "12": "stderr" };
+// entries which arrive while a pane is paused are added when it is resumed
+const paneQueues: Record<string, HTMLElement[]> = { stdout: [], stderr: [] };
+let paneSeq = 0;
+
+// events held back while streaming is paused are dropped beyond this and the output is reloaded on resume instead
+const maxPausedEvents = 10000;
+
 Alpine.data("search", () => ({
   searchText: "",
   runId: "all",
+  level: "",
   isShowingSearchResults: false,
   isShowingConnectionError: false,
-  eventSource: new EventSource("/sse?stream=events", {
-    withCredentials: false
-  }),
   eventQueue: [] as MessageEvent[],
   toastTimeout: null as number | null,
   zoomContent: "",
+  cursorId: "",
+  selectionStart: "",
+  selectionEnd: "",
+  selectionMessage: "",
+  isSplit: false,
+  pausedPanes: { stdout: false, stderr: false } as Record<string, boolean>,
+  isPaused: false,
+  isPausedByScroll: false,
+  isPauseOverflowed: false,
+  isProfiling: false,
+  profileMessage: "",
   init: function () {
     console.log("init");
     this.$watch("searchText", (val) => {
@@ -50,19 +134,57 @@ Alpine.data("search", () => ({
     this.$watch("runId", (val) => {
       this.onRunIdChanged(val);
     });
+    this.$watch("level", (val) => {
+      this.onLevelChanged(val);
+    });
     this.$watch("isShowingSearchResults", (val) => {
       this.onIsShowingSearchResults(val);
     });
+    document.addEventListener("htmx:afterSwap", (ev) => {
+      if (this.isSplit && (ev.target as HTMLElement).id === "log-output-inner") {
+        this.rebuildPanes();
+      }
+    });
 
-    this.eventSource.onmessage = (ev) => {
+    this.connect();
+  },
+  connect: function () {
+    // prefer a websocket, some proxies buffer SSE, but fall back to SSE if the socket can't be opened
+    const url = new URL("/ws", window.location.href);
+    url.protocol = url.protocol.replace("http", "ws");
+    if (authToken) {
+      url.searchParams.set("token", authToken);
+    }
+    let isOpen = false;
+    const socket = new WebSocket(url);
+    socket.onopen = () => {
+      isOpen = true;
+    };
+    socket.onmessage = (ev) => {
       this.handleEventSourceMessage(ev);
     };
-    this.eventSource.onerror = () => {
+    socket.onclose = () => {
+      if (!isOpen) {
+        this.connectEventSource();
+        return;
+      }
+      this.handleEventSourceError();
+      setTimeout(() => this.connect(), 1000);
+    };
+  },
+  connectEventSource: function () {
+    const eventSource = new EventSource(withToken("/sse?stream=events"), {
+      withCredentials: false
+    });
+    eventSource.onmessage = (ev) => {
+      this.handleEventSourceMessage(ev);
+    };
+    eventSource.onerror = () => {
       this.handleEventSourceError();
     };
   },
   onSearchTextChanged: function (value: string) {
-    if (value.length === 0) {
+    if (value.length === 0 && this.level === "") {
       this.isShowingSearchResults = false;
       return;
     }
@@ -70,23 +192,43 @@ Alpine.data("search", () => ({
     this.onClickSearch();
   },
   onRunIdChanged: function (value: string) {
-    if (value === "all") {
+    document.body.dispatchEvent(new Event("runSelected"));
+    if (value === "all" && this.level === "") {
       this.isShowingSearchResults = false;
       this.searchText = "";
       return;
     }
     this.isShowingSearchResults = true;
-    if (this.searchText.length > 0) {
+    if (this.searchText.length > 0 || this.level !== "") {
       this.onClickSearch();
     }
   },
+  onLevelChanged: function (value: string) {
+    // live output isn't filtered so the matching events are shown as search results instead
+    this.isShowingSearchResults =
+      value !== "" || this.runId !== "all" || this.searchText.length > 0;
+    this.onClickSearch();
+  },
   onIsShowingSearchResults: function (value: boolean) {
     console.log("isShowingSearchResults changed", value);
     if (!value) {
-      while (this.eventQueue.length > 0) {
-        const ev = this.eventQueue.shift()!;
-        this.processEvent(ev);
-      }
+      this.flushEventQueue();
+    }
+  },
+  flushEventQueue: function () {
+    if (this.isPaused || this.isShowingSearchResults) {
+      return;
+    }
+    if (this.isPauseOverflowed) {
+      // some of the output was dropped so it is reloaded, the queued events are only the ones which came after
+      this.isPauseOverflowed = false;
+      this.eventQueue = [];
+      this.onClickSearch();
+      return;
+    }
+    while (this.eventQueue.length > 0) {
+      const ev = this.eventQueue.shift()!;
+      this.processEvent(ev);
     }
   },
   onSearchTextKeyDown: function (ev: KeyboardEvent) {
@@ -109,6 +251,20 @@ Alpine.data("search", () => ({
       this.eventQueue.push(ev);
       return;
     }
+    if (this.isPaused) {
+      // only output is held back, task progress and the run list are kept up to date while paused
+      const msg = JSON.parse(ev.data) as SSEEvent;
+      if (!msg.live) {
+        this.processEvent(ev);
+        return;
+      }
+      if (this.eventQueue.length >= maxPausedEvents) {
+        this.isPauseOverflowed = true;
+        this.eventQueue = [];
+      }
+      this.eventQueue.push(ev);
+      return;
+    }
     this.processEvent(ev);
   },
   handleEventSourceError: function () {
@@ -124,6 +280,138 @@ Alpine.data("search", () => ({
   processEvent: function (ev: MessageEvent) {
     const msg = JSON.parse(ev.data) as SSEEvent;
     swap(msg);
+    if (msg.live) {
+      // the cursor below the output is brought into view too so that following the output isn't seen as scrolling
+      const outputEl = document.getElementById("log-output") as HTMLElement;
+      scrollPane(outputEl, outputEl.scrollHeight);
+    }
+    if (this.isSplit && msg.swap.startsWith("beforeend")) {
+      const el = document.querySelector(msg.target)?.lastElementChild;
+      if (el) {
+        this.addToPanes([el as HTMLElement]);
+      }
+    }
+  },
+  jump: async function (marker: string, direction: string) {
+    // jump to the nearest lifecycle marker relative to the one currently shown
+    const params = new URLSearchParams({
+      marker: marker,
+      dir: direction,
+      from: this.cursorId
+    });
+    const res = await fetch("/actions/jump?" + params.toString(), {
+      headers: authHeaders()
+    });
+    if (res.status !== 200) {
+      return;
+    }
+    const eventId = res.headers.get("X-Gomon-Event-Id") || "";
+    const targetEl = document.querySelector("#log-output-inner") as HTMLElement;
+    this.isShowingSearchResults = true;
+    targetEl.innerHTML = await res.text();
+    this.cursorId = eventId;
+    const eventEl = targetEl.querySelector(
+      `[data-event-id="${eventId}"]`
+    ) as HTMLElement;
+    eventEl?.classList.add("marker-highlight");
+    eventEl?.scrollIntoView({ block: "center", behavior: "instant" });
+    if (this.isSplit) {
+      this.rebuildPanes();
+      document
+        .querySelector(`#split-output [data-event-id="${eventId}"]`)
+        ?.scrollIntoView({ block: "center", behavior: "instant" });
+    }
+  },
+  pause: function (byScroll: boolean) {
+    this.isPaused = true;
+    this.isPausedByScroll = byScroll;
+  },
+  resume: function () {
+    this.isPaused = false;
+    this.isPausedByScroll = false;
+    this.flushEventQueue();
+    const outputEl = document.getElementById("log-output") as HTMLElement;
+    scrollPane(outputEl, outputEl.scrollHeight);
+  },
+  togglePaused: function () {
+    if (this.isPaused) {
+      this.resume();
+    } else {
+      this.pause(false);
+    }
+  },
+  onOutputScroll: function () {
+    // scrolling up to read older output pauses streaming so that new events don't move the view, scrolling back
+    // to the end resumes it unless it was paused explicitly
+    const outputEl = document.getElementById("log-output") as HTMLElement;
+    if (
+      this.isShowingSearchResults ||
+      Math.abs(outputEl.scrollTop - Number(outputEl.dataset.autoScrollTop)) < 1
+    ) {
+      return;
+    }
+    const isAtEnd = isScrolledToEnd(outputEl);
+    if (!isAtEnd && !this.isPaused) {
+      this.pause(true);
+    } else if (isAtEnd && this.isPausedByScroll) {
+      this.resume();
+    }
+  },
+  pausedLabel: function () {
+    if (this.isPauseOverflowed) {
+      return "Paused, more than " + maxPausedEvents + " new events";
+    }
+    const count = this.eventQueue.length;
+    return "Paused, " + count + (count === 1 ? " new event" : " new events");
+  },
+  goLive: function () {
+    this.clearSelection();
+    this.cursorId = "";
+    this.searchText = "";
+    this.runId = "all";
+    this.level = "";
+    // the output is reloaded so anything held back while paused is already included
+    this.isPaused = false;
+    this.isPausedByScroll = false;
+    this.isPauseOverflowed = false;
+    this.eventQueue = [];
+    this.isShowingSearchResults = false;
+    this.onClickSearch();
+  },
+  onGlobalKeyDown: function (ev: KeyboardEvent) {
+    const target = ev.target as HTMLElement;
+    if (
+      ["INPUT", "SELECT", "TEXTAREA"].includes(target.tagName) ||
+      ev.ctrlKey ||
+      ev.metaKey ||
+      ev.altKey
+    ) {
+      return;
+    }
+    switch (ev.key) {
+      case "c":
+        this.jump("crash", "prev");
+        break;
+      case "C":
+        this.jump("crash", "next");
+        break;
+      case "s":
+        this.jump("startup", "prev");
+        break;
+      case "S":
+        this.jump("startup", "next");
+        break;
+      case "e":
+        this.jump("error", "prev");
+        break;
+      case "p":
+        this.togglePaused();
+        break;
+      case "l":
+      case "Escape":
+        this.goLive();
+        break;
+    }
   },
   onZoomEntry: function (ev: MouseEvent) {
     const targetEl = ev.target as HTMLElement;
@@ -138,12 +426,374 @@ Alpine.data("search", () => ({
     }
     const dialog = document.getElementById("zoom-dialog") as HTMLDialogElement;
     dialog.showModal();
+  },
+  onLogClick: function (ev: MouseEvent) {
+    // shift-click starts a selection and then extends it to the clicked entry
+    if (!ev.shiftKey) {
+      return;
+    }
+    const entryEl = (ev.target as HTMLElement).closest(
+      "[data-event-id]"
+    ) as HTMLElement;
+    if (!entryEl) {
+      return;
+    }
+    ev.preventDefault();
+    window.getSelection()?.removeAllRanges();
+    const eventId = entryEl.dataset.eventId || "";
+    if (!this.selectionStart) {
+      this.selectionStart = eventId;
+    }
+    this.selectionEnd = eventId;
+    this.selectionMessage = "";
+    this.highlightSelection();
+  },
+  highlightSelection: function () {
+    const entries = Array.from(
+      document.querySelectorAll("#log-output-inner [data-event-id]")
+    ) as HTMLElement[];
+    const ids = entries.map((el) => el.dataset.eventId);
+    let from = ids.indexOf(this.selectionStart);
+    let to = ids.indexOf(this.selectionEnd);
+    if (from > to) {
+      [from, to] = [to, from];
+    }
+    entries.forEach((el, ix) => {
+      el.classList.toggle("selected-entry", from >= 0 && ix >= from && ix <= to);
+    });
+  },
+  selectionParams: function () {
+    return new URLSearchParams({
+      from: this.selectionStart,
+      to: this.selectionEnd
+    });
+  },
+  copySelection: async function (format: string) {
+    const params = this.selectionParams();
+    params.set("format", format);
+    const res = await fetch("/actions/range?" + params.toString(), {
+      headers: authHeaders()
+    });
+    if (res.status !== 200) {
+      this.selectionMessage = "Copy failed";
+      return;
+    }
+    await navigator.clipboard.writeText(await res.text());
+    this.selectionMessage = "Copied to clipboard";
+  },
+  openSelectionInEditor: async function () {
+    const res = await fetch(
+      "/actions/scratch?" + this.selectionParams().toString(),
+      { method: "POST", headers: authHeaders() }
+    );
+    if (res.status !== 200) {
+      this.selectionMessage = "Could not write scratch file";
+      return;
+    }
+    const data = await res.json();
+    this.selectionMessage = "Saved to " + data.path;
+    window.location.href = data.url;
+  },
+  captureProfile: async function () {
+    // the request is held open while the child is profiled
+    this.isProfiling = true;
+    this.profileMessage = "";
+    try {
+      const res = await fetch("/actions/profile", {
+        method: "POST",
+        headers: authHeaders()
+      });
+      const data = await res.json();
+      this.profileMessage =
+        res.status === 200 ? "Saved to " + data.path : data.error;
+    } catch (err) {
+      this.profileMessage = "Profile failed";
+    } finally {
+      this.isProfiling = false;
+    }
+  },
+  clearSelection: function () {
+    this.selectionStart = "";
+    this.selectionEnd = "";
+    this.selectionMessage = "";
+    this.highlightSelection();
+  },
+  selectRun: function (runId: string) {
+    // clicking a run in the timeline shows its output
+    (document.getElementById("timeline-dialog") as HTMLDialogElement).close();
+    this.runId = runId;
+    this.isShowingSearchResults = true;
+    this.onClickSearch();
+  },
+  toggleSplit: function () {
+    this.isSplit = !this.isSplit;
+    if (this.isSplit) {
+      this.rebuildPanes();
+    }
+  },
+  rebuildPanes: function () {
+    for (const stream of Object.values(paneStreams)) {
+      this.clearPane(stream);
+    }
+    const entries = Array.from(
+      document.querySelectorAll("#log-output-inner [data-event-type]")
+    ) as HTMLElement[];
+    this.addToPanes(entries);
+  },
+  addToPanes: function (entries: HTMLElement[]) {
+    for (const el of entries) {
+      const stream = paneStreams[el.dataset.eventType || ""];
+      if (!stream) {
+        continue;
+      }
+      const entry = el.cloneNode(true) as HTMLElement;
+      // entries are matched across panes by their position in the output list
+      entry.dataset.seq = String(paneSeq++);
+      if (this.pausedPanes[stream]) {
+        paneQueues[stream].push(entry);
+        continue;
+      }
+      appendToPane(stream, [entry]);
+    }
+  },
+  togglePause: function (stream: string) {
+    this.pausedPanes[stream] = !this.pausedPanes[stream];
+    if (!this.pausedPanes[stream]) {
+      appendToPane(stream, paneQueues[stream].splice(0));
+    }
+  },
+  clearPane: function (stream: string) {
+    paneQueues[stream] = [];
+    document.getElementById(stream + "-pane")?.replaceChildren();
+  },
+  onPaneScroll: function (stream: string) {
+    // keep the other pane showing output from the same time, unless the scroll was caused by new output
+    const pane = document.getElementById(stream + "-pane") as HTMLElement;
+    if (Math.abs(pane.scrollTop - Number(pane.dataset.autoScrollTop)) < 1) {
+      return;
+    }
+    const other = document.getElementById(
+      (stream === "stdout" ? "stderr" : "stdout") + "-pane"
+    ) as HTMLElement;
+    syncPane(pane, other);
   }
 }));
 
 Alpine.start();
 
+// renderTimeline draws each run as a bar with its markers, positioned relative to the start of the first run and
+// the end of the last so that gaps between runs are visible
+function renderTimeline(el: HTMLElement) {
+  const runs = JSON.parse(el.dataset.timeline || "[]") as TimelineRun[];
+  if (runs.length === 0) {
+    return;
+  }
+
+  const time = (s: string) => new Date(s).getTime();
+  const clock = (t: number) => new Date(t).toLocaleTimeString();
+  const last = runs[runs.length - 1];
+  // the latest run is still going if it hasn't stopped
+  const end = (run: TimelineRun) =>
+    run === last && !run.ended ? Date.now() : time(run.end);
+  const from = time(runs[0].start);
+  const span = Math.max(end(last) - from, 1);
+  const percent = (t: number) => ((t - from) / span) * 100 + "%";
+
+  const axis = document.createElement("div");
+  axis.className = "timeline-axis";
+  for (const t of [from, from + span]) {
+    const label = document.createElement("span");
+    label.textContent = clock(t);
+    axis.appendChild(label);
+  }
+  el.replaceChildren(axis);
+
+  for (const run of runs) {
+    const start = time(run.start);
+    const row = document.createElement("div");
+    row.className = "timeline-row";
+    row.setAttribute("x-on:click", `selectRun('${run.id}')`);
+
+    const label = document.createElement("div");
+    label.className = "timeline-label";
+    label.textContent =
+      clock(start) + (run.errors > 0 ? ` (${run.errors} errors)` : "");
+    row.appendChild(label);
+
+    const track = document.createElement("div");
+    track.className = "timeline-track";
+    const bar = document.createElement("div");
+    bar.className =
+      "timeline-bar " +
+      (run.crashed
+        ? "timeline-crashed"
+        : run.ended
+          ? "timeline-stopped"
+          : "timeline-running");
+    bar.style.left = percent(start);
+    bar.style.width = ((end(run) - start) / span) * 100 + "%";
+    bar.title = [
+      run.reason || "",
+      Math.round((end(run) - start) / 1000) + "s"
+    ]
+      .filter((s) => s)
+      .join(", ");
+    track.appendChild(bar);
+
+    for (const m of run.markers) {
+      const marker = document.createElement("div");
+      marker.className = "timeline-marker timeline-" + m.kind;
+      marker.style.left = percent(time(m.date));
+      marker.title = clock(time(m.date)) + " " + m.message;
+      track.appendChild(marker);
+    }
+
+    row.appendChild(track);
+    el.appendChild(row);
+  }
+}
+
+const formatBytes = (n: number) => (n / (1024 * 1024)).toFixed(1) + "MB";
+
+// the expvar metrics are only charted if the child's expvar handler is being scraped
+const resourceMetrics = [
+  {
+    name: "Memory (RSS)",
+    value: (s: ResourceSample) => s.rss,
+    format: formatBytes
+  },
+  {
+    name: "CPU",
+    value: (s: ResourceSample) => s.cpu,
+    format: (n: number) => n.toFixed(1) + "%"
+  },
+  {
+    name: "Heap",
+    value: (s: ResourceSample) => s.heapAlloc,
+    format: formatBytes
+  },
+  {
+    name: "Goroutines",
+    value: (s: ResourceSample) => s.goroutines,
+    format: (n: number) => String(Math.round(n))
+  }
+];
+
+// renderResources draws a chart of each metric for every run, the runs share a scale so that growth across restarts
+// e.g. a leak which survives hot reloads stands out
+function renderResources(el: HTMLElement) {
+  const runs = JSON.parse(el.dataset.resources || "[]") as ResourceRun[];
+  el.replaceChildren();
+
+  for (const metric of resourceMetrics) {
+    let max = 0;
+    let isSampled = false;
+    for (const run of runs) {
+      for (const s of run.samples) {
+        const v = metric.value(s);
+        if (v !== undefined && v !== null) {
+          isSampled = true;
+          max = Math.max(max, v);
+        }
+      }
+    }
+    if (!isSampled) {
+      continue;
+    }
+
+    const section = document.createElement("section");
+    section.className = "resources-metric";
+    const title = document.createElement("h3");
+    title.textContent = `${metric.name}, peak ${metric.format(max)}`;
+    section.appendChild(title);
+
+    for (const run of runs) {
+      const values = run.samples.map((s) => metric.value(s) ?? 0);
+      const row = document.createElement("div");
+      row.className = "resources-row";
+
+      const label = document.createElement("div");
+      label.className = "resources-label";
+      label.textContent = new Date(run.samples[0].date).toLocaleTimeString();
+      row.appendChild(label);
+
+      const chart = document.createElementNS("http://www.w3.org/2000/svg", "svg");
+      chart.setAttribute("class", "resources-chart");
+      chart.setAttribute("viewBox", "0 0 100 20");
+      chart.setAttribute("preserveAspectRatio", "none");
+      const line = document.createElementNS(
+        "http://www.w3.org/2000/svg",
+        "polyline"
+      );
+      const step = values.length > 1 ? 100 / (values.length - 1) : 0;
+      line.setAttribute(
+        "points",
+        values
+          .map((v, ix) => `${ix * step},${20 - (max > 0 ? (v / max) * 20 : 0)}`)
+          .join(" ")
+      );
+      line.setAttribute("vector-effect", "non-scaling-stroke");
+      chart.appendChild(line);
+      row.appendChild(chart);
+
+      const last = document.createElement("div");
+      last.className = "resources-value";
+      last.textContent = metric.format(values[values.length - 1]);
+      last.title = "peak " + metric.format(Math.max(...values));
+      row.appendChild(last);
+
+      section.appendChild(row);
+    }
+    el.appendChild(section);
+  }
+}
+
+function isScrolledToEnd(el: HTMLElement) {
+  return el.scrollHeight - el.scrollTop - el.clientHeight < 4;
+}
+
+// scrollPane moves a pane without it being treated as the user scrolling
+function scrollPane(pane: HTMLElement, top: number) {
+  pane.scrollTop = top;
+  pane.dataset.autoScrollTop = String(pane.scrollTop);
+}
+
+// appendToPane adds entries to a pane, following the output if the pane was already showing the latest
+function appendToPane(stream: string, entries: HTMLElement[]) {
+  const pane = document.getElementById(stream + "-pane") as HTMLElement;
+  const isFollowing = isScrolledToEnd(pane);
+  pane.append(...entries);
+  if (isFollowing) {
+    scrollPane(pane, pane.scrollHeight);
+  }
+}
+
+// syncPane scrolls to to the first entry at or after the first one visible in from
+function syncPane(from: HTMLElement, to: HTMLElement) {
+  if (isScrolledToEnd(from)) {
+    scrollPane(to, to.scrollHeight);
+    return;
+  }
+  const top = from.getBoundingClientRect().top;
+  const entries = Array.from(from.children) as HTMLElement[];
+  const first = entries.find((el) => el.getBoundingClientRect().bottom > top);
+  if (!first) {
+    return;
+  }
+  const seq = Number(first.dataset.seq);
+  const match = (Array.from(to.children) as HTMLElement[]).find(
+    (el) => Number(el.dataset.seq) >= seq
+  );
+  scrollPane(to, match ? match.offsetTop : to.scrollHeight);
+}
+
 function swap(msg: SSEEvent) {
+  // the pushed run selector lists every run so it would drop the label filter
+  const labelEl = document.querySelector("#label-select") as HTMLSelectElement;
+  if (msg.target === "#search-select" && labelEl && labelEl.value !== "") {
+    return;
+  }
+
   const targetEl = document.querySelector(msg.target) as HTMLElement;
   if (!targetEl) {
     throw new Error(`Target element not found: ${msg.target}/${msg.id}`);
@@ -156,6 +806,8 @@ function swap(msg: SSEEvent) {
   const range = document.createRange();
   range.selectNode(targetEl);
   const documentFragment = range.createContextualFragment(msg.markup);
+  // the target is detached by an outerHTML swap so its parent is kept for htmx
+  const parentEl = targetEl.parentElement;
 
   switch (swapType) {
     case "innerHTML":
@@ -183,6 +835,19 @@ function swap(msg: SSEEvent) {
       break;
   }
 
+  // markup containing htmx attributes e.g. the cancel button of a task has to be processed by htmx
+  if (msg.markup.includes("hx-")) {
+    const swapped =
+      swapType === "innerHTML" ||
+      swapType === "afterbegin" ||
+      swapType === "beforeend"
+        ? targetEl
+        : parentEl;
+    if (swapped) {
+      htmx.process(swapped);
+    }
+  }
+
   if (scrollExpr) {
     const f = scrollExpr.split(":");
     const scrollType = f[0];
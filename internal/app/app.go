@@ -4,36 +4,92 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"io"
+	"net/http"
 	"os"
 	"os/signal"
+	"slices"
+	"strings"
+	"sync"
+	"sync/atomic"
 	"syscall"
 	"time"
 
 	"github.com/jdudmesh/gomon/internal/config"
 	"github.com/jdudmesh/gomon/internal/console"
+	"github.com/jdudmesh/gomon/internal/logfwd"
 	"github.com/jdudmesh/gomon/internal/notification"
+	"github.com/jdudmesh/gomon/internal/plugins"
 	"github.com/jdudmesh/gomon/internal/process"
 	"github.com/jdudmesh/gomon/internal/proxy"
+	"github.com/jdudmesh/gomon/internal/sinks"
+	"github.com/jdudmesh/gomon/internal/tracing"
+	"github.com/jdudmesh/gomon/internal/tui"
 	"github.com/jdudmesh/gomon/internal/utils"
 	"github.com/jdudmesh/gomon/internal/watcher"
 	"github.com/jdudmesh/gomon/internal/webui"
-	log "github.com/sirupsen/logrus"
+	"github.com/sirupsen/logrus"
 	"gopkg.in/cenkalti/backoff.v1"
 )
 
+var log = logrus.WithField("component", "app")
+
+// softRestartDebounce is how long changes which follow a soft restart are collected for, so they are sent to the
+// child process together
+const softRestartDebounce = 100 * time.Millisecond
+
 type App struct {
+	cfg           atomic.Pointer[config.Config]
 	proxyOnly     bool
 	sigint        chan os.Signal
 	hardRestart   chan string
+	unhealthy     chan string
 	softRestart   chan string
 	oobTask       chan string
 	childProcess  process.AtomicChildProcess
+	builder       *process.Builder
+	secrets       *process.SecretResolver
+	socket        *process.Socket
 	db            Database
 	watcher       Watcher
 	proxy         WebProxy
 	notifier      Notifier
 	consoleWriter Console
-	webui         UI
+	webui         WebUI
+	tui           UI
+	sinks         NotificationSinks
+	logs          LogForwarder
+	tracer        Tracer
+	plugins       Plugins
+	// restartTimer measures how long each hard restart takes until the new run is ready
+	restartTimer notification.EventConsumer
+	// bus delivers notifications to the components, subscriptions are theirs so they can be removed on Close when
+	// the bus is shared with an embedding program
+	bus           *notification.Bus
+	subscriptions []*notification.Subscription
+	// restartRequested is signalled on each hard restart so a crashed process can wait for a change
+	restartRequested chan struct{}
+	// restartHint is what caused the next start of the child process, it is shown in the startup banner
+	restartHint atomic.Value
+	// pipelineLock stops pipelines from running concurrently
+	pipelineLock sync.Mutex
+	// isWatching is false when gomon is embedded without the file watcher
+	isWatching atomic.Bool
+	// testRunner replaces the child process in test mode
+	testRunner *process.TestRunner
+	// changeTester tests the packages containing changed test files in serve mode
+	changeTester *process.TestRunner
+	// checksLock guards the state of the checks run before a hard restart, a restart requested while they are
+	// running is checked again once they finish
+	checksLock    sync.Mutex
+	checksRunning bool
+	checksPending bool
+	checksHint    string
+	// stateLock guards the state reported by Dump
+	stateLock    sync.Mutex
+	recent       []*notification.Notification
+	currentRun   string
+	restartState utils.RestartState
 }
 
 type Closeable interface {
@@ -45,14 +101,17 @@ type Startable interface {
 }
 
 type Database interface {
-	Closeable
-	notification.EventConsumer
+	utils.Store
 	webui.Database
+	process.ResourceRecorder
 }
 
 type Watcher interface {
 	Closeable
+	notification.EventConsumer
 	Watch(notification.NotificationCallback) error
+	Health() error
+	Directories() (int, int)
 }
 
 type WebProxy interface {
@@ -60,20 +119,24 @@ type WebProxy interface {
 	Startable
 	notification.EventConsumer
 	Enabled() bool
+	Mount(prefix string, handler http.Handler)
+	Health() error
 }
 
 type Notifier interface {
 	Closeable
 	Startable
 	notification.EventConsumer
-	SendSoftRestart(hint string) error
+	SendSoftRestart(paths []string) error
 }
 
 type Console interface {
 	Closeable
 	Startable
 	notification.EventConsumer
+	utils.QueueStatsReporter
 	process.ConsoleOutput
+	Terminal() io.Writer
 }
 
 type UI interface {
@@ -83,34 +146,163 @@ type UI interface {
 	Enabled() bool
 }
 
-func New(cfg config.Config) (*App, error) {
+// NotificationSinks forwards selected events outside of gomon e.g. to Slack
+type NotificationSinks interface {
+	Closeable
+	Startable
+	notification.EventConsumer
+}
+
+// LogForwarder tees the child process's output to log collectors e.g. Loki
+type LogForwarder interface {
+	Closeable
+	Startable
+	notification.EventConsumer
+	utils.QueueStatsReporter
+}
+
+// Tracer traces each restart through the steps of starting the child process and exports the traces e.g. to an
+// OpenTelemetry collector
+type Tracer interface {
+	Closeable
+	Startable
+	notification.EventConsumer
+	process.PhaseRecorder
+	utils.QueueStatsReporter
+}
+
+// Plugins runs external programs which are sent gomon's events and can make requests e.g. to restart
+type Plugins interface {
+	Closeable
+	Startable
+	notification.EventConsumer
+	utils.QueueStatsReporter
+}
+
+type WebUI interface {
+	UI
+	Mount(basePath string) http.Handler
+	Clients() map[string]int
+}
+
+// Option configures an App
+type Option func(*App)
+
+// WithBus publishes notifications on bus instead of a bus of the app's own, so that subscribers outlive the app
+func WithBus(bus *notification.Bus) Option {
+	return func(a *App) {
+		a.bus = bus
+	}
+}
+
+func New(cfg config.Config, opts ...Option) (*App, error) {
 	var err error
 
 	app := &App{
-		proxyOnly:    cfg.ProxyOnly,
-		sigint:       make(chan os.Signal, 1),
-		hardRestart:  make(chan string),
-		softRestart:  make(chan string),
-		oobTask:      make(chan string),
-		childProcess: process.AtomicChildProcess{},
+		bus:              notification.NewBus(),
+		proxyOnly:        cfg.ProxyOnly,
+		sigint:           make(chan os.Signal, 1),
+		hardRestart:      make(chan string),
+		unhealthy:        make(chan string),
+		restartRequested: make(chan struct{}, 1),
+		softRestart:      make(chan string),
+		oobTask:          make(chan string),
+		childProcess:     process.AtomicChildProcess{},
+		secrets:          process.NewSecretResolver(),
+	}
+	app.cfg.Store(&cfg)
+
+	for _, opt := range opts {
+		opt(app)
+	}
+
+	switch cfg.Restart {
+	case "", config.RestartBackoff, config.RestartImmediate, config.RestartNever:
+	default:
+		return nil, fmt.Errorf("unsupported restart policy: %s", cfg.Restart)
+	}
+
+	switch cfg.Runtime {
+	case "", config.RuntimeLocal:
+	case config.RuntimeDocker:
+		// the binary would be built for the host and the socket can't be passed into a container
+		if cfg.Build.Enabled {
+			return nil, errors.New("build can't be used with runtime docker")
+		}
+		if cfg.Process.Socket.Addr != "" {
+			return nil, errors.New("process.socket can't be used with runtime docker")
+		}
+		// only the docker client would be sampled, not the container
+		if cfg.Resources.Enabled {
+			return nil, errors.New("resources can't be used with runtime docker")
+		}
+	default:
+		return nil, fmt.Errorf("unsupported runtime: %s", cfg.Runtime)
 	}
 
-	app.db, err = utils.NewDatabase(cfg)
+	switch cfg.Mode {
+	case "", config.ModeServe:
+	case config.ModeTest:
+		app.testRunner, err = process.NewTestRunner(cfg)
+		if err != nil {
+			return nil, fmt.Errorf("creating test runner: %w", err)
+		}
+	default:
+		return nil, fmt.Errorf("unsupported mode: %s", cfg.Mode)
+	}
+
+	if !cfg.ProxyOnly && app.testRunner == nil {
+		app.changeTester, err = process.NewChangeTester(cfg, app.currentRunID)
+		if err != nil {
+			return nil, fmt.Errorf("creating test runner: %w", err)
+		}
+	}
+
+	if cfg.Build.Enabled && app.testRunner == nil {
+		app.builder, err = process.NewBuilder(cfg)
+		if err != nil {
+			return nil, fmt.Errorf("creating builder: %w", err)
+		}
+	}
+
+	if !cfg.ProxyOnly && app.testRunner == nil {
+		app.socket, err = process.NewSocket(cfg)
+		if err != nil {
+			return nil, fmt.Errorf("opening socket: %w", err)
+		}
+	}
+
+	err = app.checkPorts(&cfg)
 	if err != nil {
-		log.Fatalf("creating database: %v", err)
+		return nil, err
 	}
 
+	// the token is shared with every run of the child process through its environment
+	if cfg.IPCTransport() != config.IPCTransportUDP && cfg.IPC.Token == "" {
+		cfg.IPC.Token, err = notification.NewToken()
+		if err != nil {
+			return nil, err
+		}
+	}
+	app.cfg.Store(&cfg)
+
+	app.db, err = utils.NewStore(cfg)
+	if err != nil {
+		return nil, fmt.Errorf("creating database: %w", err)
+	}
+	app.restartTimer = utils.NewRestartTimer(app.db, app.Notify)
+
 	app.proxy, err = proxy.New(cfg)
 	if err != nil {
 		return nil, fmt.Errorf("creating proxy: %v", err)
 	}
 
-	app.watcher, err = watcher.New(cfg)
+	app.watcher, err = watcher.New(cfg, watcher.WithConfigChangeHandler(app.configChanged))
 	if err != nil {
 		return nil, fmt.Errorf("creating monitor: %w", err)
 	}
 
-	app.notifier, err = notification.NewNotifier(app.Notify)
+	app.notifier, err = notification.NewNotifier(cfg, app.Notify)
 	if err != nil {
 		return nil, fmt.Errorf("creating notifier: %v", err)
 	}
@@ -120,30 +312,107 @@ func New(cfg config.Config) (*App, error) {
 		return nil, fmt.Errorf("creating console: %v", err)
 	}
 
-	app.webui, err = webui.New(cfg, app.db, func(n notification.Notification) error {
-		switch n.Type {
-		case notification.NotificationTypeHardRestartRequested:
-			app.hardRestart <- "webui"
-		case notification.NotificationTypeSoftRestartRequested:
-			app.softRestart <- "webui"
-		case notification.NotificationTypeShutdownRequested:
-			app.sigint <- syscall.SIGTERM
-		}
-		return app.Notify(n)
-	})
+	app.webui, err = webui.New(cfg, app.db, app, app.handleRequest)
 	if err != nil {
 		return nil, fmt.Errorf("creating console: %v", err)
 	}
 
+	app.tui, err = tui.New(cfg, app.db, app.handleRequest)
+	if err != nil {
+		return nil, fmt.Errorf("creating terminal UI: %w", err)
+	}
+
+	app.sinks, err = sinks.New(cfg)
+	if err != nil {
+		return nil, fmt.Errorf("creating notification sinks: %w", err)
+	}
+
+	app.logs, err = logfwd.New(cfg)
+	if err != nil {
+		return nil, fmt.Errorf("creating log forwarding: %w", err)
+	}
+
+	app.tracer, err = tracing.New(cfg)
+	if err != nil {
+		return nil, fmt.Errorf("creating tracing: %w", err)
+	}
+
+	app.plugins, err = plugins.New(cfg, app.handleRequest)
+	if err != nil {
+		return nil, fmt.Errorf("creating plugins: %w", err)
+	}
+
+	// the components are notified in this order on the publisher's goroutine
+	consumers := []struct {
+		name     string
+		consumer notification.EventConsumer
+	}{
+		{"database", app.db},
+		{"restart timer", app.restartTimer},
+		{"console", app.consoleWriter},
+		{"proxy", app.proxy},
+		{"web UI", app.webui},
+		{"IPC notifier", app.notifier},
+		{"terminal UI", app.tui},
+		{"notification sinks", app.sinks},
+		{"log forwarding", app.logs},
+		{"tracing", app.tracer},
+		{"plugins", app.plugins},
+		{"watcher", app.watcher},
+	}
+	for _, c := range consumers {
+		app.subscriptions = append(app.subscriptions, app.bus.Subscribe(c.consumer, notification.WithName(c.name)))
+	}
+
+	if warning := app.db.RecoveryWarning(); warning != "" {
+		log.Warn(warning)
+		app.Notify(notification.Notification{
+			ID:      notification.NextID(),
+			Date:    time.Now(),
+			Type:    notification.NotificationTypeSystemError,
+			Message: warning,
+		})
+	}
+
+	// the pruner reports through Notify so it can only start once every consumer exists
+	app.db.StartPruner(app.Notify)
+
+	if cfg.UI.MountOnProxy && app.webui.Enabled() {
+		if app.proxy.Enabled() {
+			app.proxy.Mount(webui.MountPath, app.webui.Mount(webui.MountPath))
+		} else {
+			log.Warn("ui.mountOnProxy requires the proxy to be enabled, UI will use its own port")
+		}
+	}
+
 	return app, nil
 }
 
+// Config returns the current config, which may have been reloaded since gomon started
+func (a *App) Config() config.Config {
+	return *a.cfg.Load()
+}
+
+func (a *App) configChanged(cfg config.Config) {
+	prev := a.cfg.Swap(&cfg)
+	if config.ChildProcessChanged(*prev, cfg) && !a.proxyOnly {
+		a.hardRestart <- "config changed"
+	}
+}
+
 func (a *App) Close() {
 	proc := a.childProcess.Load()
 	if proc != nil {
 		proc.Stop()
 	}
 
+	for _, sub := range a.subscriptions {
+		sub.Close()
+	}
+
+	if a.socket != nil {
+		a.socket.Close()
+	}
 	if a.db != nil {
 		a.db.Close()
 	}
@@ -162,6 +431,21 @@ func (a *App) Close() {
 	if a.webui != nil {
 		a.webui.Close()
 	}
+	if a.tui != nil {
+		a.tui.Close()
+	}
+	if a.sinks != nil {
+		a.sinks.Close()
+	}
+	if a.logs != nil {
+		a.logs.Close()
+	}
+	if a.tracer != nil {
+		a.tracer.Close()
+	}
+	if a.plugins != nil {
+		a.plugins.Close()
+	}
 }
 
 func (a *App) MonitorFileChanges(ctx context.Context) error {
@@ -173,6 +457,10 @@ func (a *App) MonitorFileChanges(ctx context.Context) error {
 			a.softRestart <- n.Message
 		case notification.NotificationTypeOOBTaskRequested:
 			a.oobTask <- n.Message
+		case notification.NotificationTypePipelineRequested:
+			go a.runPipeline(n.Message)
+		case notification.NotificationTypeTestRequested:
+			a.requestTests(n.Message)
 		}
 		return a.Notify(n)
 	})
@@ -206,22 +494,96 @@ func (a *App) RunWebUI() error {
 	return nil
 }
 
+func (a *App) RunTUI() error {
+	if a.tui.Enabled() {
+		return a.tui.Start()
+	}
+	return nil
+}
+
+// RunCommands reads commands typed into gomon's terminal, stdin belongs to the terminal UI when it is enabled
+func (a *App) RunCommands() error {
+	if a.tui.Enabled() || !console.IsTerminal(os.Stdin) {
+		return nil
+	}
+	return console.ReadCommands(os.Stdin, a.consoleWriter.Terminal(), a.handleConsoleRequest)
+}
+
+func (a *App) RunSinks() error {
+	return a.sinks.Start()
+}
+
+func (a *App) RunLogForwarding() error {
+	return a.logs.Start()
+}
+
+func (a *App) RunTracing() error {
+	return a.tracer.Start()
+}
+
+func (a *App) RunPlugins() error {
+	return a.plugins.Start()
+}
+
 func (a *App) RunChildProcess(cfg config.Config) error {
-	proc, err := process.NewChildProcess(cfg)
+	opts := []process.ChildProcessOption{
+		process.WithSecretResolver(a.secrets),
+		process.WithResourceRecorder(a.db),
+		process.WithPhaseRecorder(a.tracer),
+		process.WithUnhealthyHandler(func(string) {
+			a.unhealthy <- "health check failed"
+		}),
+		process.WithTrigger(func() string {
+			hint, _ := a.restartHint.Swap("").(string)
+			return hint
+		}),
+	}
+	if a.builder != nil {
+		opts = append(opts, process.WithBuilder(a.builder))
+	}
+	if a.socket != nil {
+		opts = append(opts, process.WithSocket(a.socket))
+	}
+
+	// an invalid config e.g. a bad env file after a reload stops gomon, or the embedding program's Runner, with an
+	// error rather than exiting the process
+	proc, err := process.NewChildProcess(cfg, opts...)
 	if err != nil {
-		log.Fatalf("creating child process: %v", err)
+		return fmt.Errorf("creating child process: %w", err)
 	}
 
 	a.childProcess.Store(proc)
+	policy := cfg.Restart
+	if policy == "" {
+		policy = config.RestartBackoff
+	}
+	a.updateRestartState(func(s *utils.RestartState) {
+		*s = utils.RestartState{Policy: policy}
+	})
+
+	switch cfg.Restart {
+	case config.RestartImmediate, config.RestartNever:
+		return a.runChildProcessImmediate(func() error {
+			return proc.Start(a.consoleWriter, a.Notify)
+		}, cfg.Restart == config.RestartNever)
+	}
 
 	backoffPolicy := backoff.NewExponentialBackOff()
 	backoffPolicy.InitialInterval = 500 * time.Millisecond
 	backoffPolicy.MaxInterval = 5000 * time.Millisecond
 	backoffPolicy.MaxElapsedTime = 60 * time.Second
 
-	err = backoff.Retry(func() error {
+	err = backoff.RetryNotify(func() error {
 		return proc.Start(a.consoleWriter, a.Notify)
-	}, backoffPolicy)
+	}, backoffPolicy, func(err error, next time.Duration) {
+		a.restartHint.Store("retrying after a failure")
+		a.updateRestartState(func(s *utils.RestartState) {
+			s.Attempts++
+			s.LastError = err.Error()
+			nextRetry := time.Now().Add(next)
+			s.NextRetry = &nextRetry
+		})
+	})
 
 	if err != nil {
 		log.Errorf("failed retrying child process: %v", err)
@@ -231,25 +593,81 @@ func (a *App) RunChildProcess(cfg config.Config) error {
 	return nil
 }
 
+// runChildProcessImmediate runs the child process once without the backoff used for crash recovery. If the process
+// fails without being asked to stop then it isn't restarted until a hard restart is requested e.g. a file changes,
+// with waitOnExit the same applies when it exits successfully.
+func (a *App) runChildProcessImmediate(start func() error, waitOnExit bool) error {
+	// discard any request which has already been handled by stopping the previous process
+	select {
+	case <-a.restartRequested:
+	default:
+	}
+
+	err := start()
+	if err == nil && !waitOnExit {
+		return nil
+	}
+
+	select {
+	case <-a.restartRequested:
+		// the process exited because a restart was requested
+		return nil
+	default:
+	}
+
+	if err == nil {
+		log.Info("child process exited, waiting for a change before restarting")
+		a.updateRestartState(func(s *utils.RestartState) {
+			s.Waiting = true
+		})
+		<-a.restartRequested
+		return nil
+	}
+
+	log.Warnf("child process failed, waiting for a change before restarting: %v", err)
+	a.updateRestartState(func(s *utils.RestartState) {
+		s.Attempts++
+		s.LastError = err.Error()
+		s.Waiting = true
+	})
+	<-a.restartRequested
+	return nil
+}
+
 func (a *App) ProcessRestartEvents(ctx context.Context) error {
 	for {
 		select {
 		case hint := <-a.hardRestart:
-			if !a.proxyOnly {
-				log.Info("hard restart: " + hint)
-				proc := a.childProcess.Load()
-				if proc != nil {
-					proc.Stop()
+			if a.testRunner != nil {
+				log.Info("testing: " + hint)
+				a.testRunner.Request(hint)
+			} else if !a.proxyOnly {
+				if len(a.Config().Checks) > 0 {
+					a.requestChecks(hint)
+					continue
 				}
+				a.restartChildProcess(hint)
+			}
+		case hint := <-a.unhealthy:
+			// the checks are skipped so that a hung process is replaced even if they fail on the code being edited
+			if !a.proxyOnly {
+				a.restartChildProcess(hint)
 			}
 		case hint := <-a.softRestart:
-			log.Info("soft restart: " + hint)
-			err := a.notifier.SendSoftRestart(hint)
-			if err != nil {
-				log.Warnf("notifying child process: %v", err)
+			if a.testRunner != nil {
+				// there is no process to reload so templates etc. are tested like any other change
+				log.Info("testing: " + hint)
+				a.testRunner.Request(hint)
+				continue
 			}
+			a.softReload(a.collectSoftRestarts(ctx, hint))
 		case task := <-a.oobTask:
 			if !a.proxyOnly {
+				// named tasks from the config file are run by name, anything else is a command from the terminal or a
+				// generated rule
+				if command, ok := a.Config().Tasks[task]; ok {
+					task = command
+				}
 				log.Info("out of band task: " + task)
 				proc := a.childProcess.Load()
 				if proc != nil {
@@ -262,29 +680,227 @@ func (a *App) ProcessRestartEvents(ctx context.Context) error {
 	}
 }
 
+// cancelTasks kills the running task with the given ID, or every running task if it is empty
+func (a *App) cancelTasks(taskID string) {
+	if taskID == "" {
+		log.Infof("cancelled %d running tasks", process.CancelAllTasks())
+		return
+	}
+	if !process.CancelTask(taskID) {
+		log.Warnf("task %s isn't running", taskID)
+	}
+}
+
+// requestTests tests the package containing a changed test file without restarting the child process
+func (a *App) requestTests(hint string) {
+	switch {
+	case a.testRunner != nil:
+		a.testRunner.Request(hint)
+	case a.changeTester != nil:
+		log.Info("testing: " + hint)
+		a.changeTester.Request(hint)
+	}
+}
+
+// collectSoftRestarts waits for the soft restarts which follow hint within the debounce window e.g. a save all in
+// the editor, so that the child process reloads once with every changed file
+func (a *App) collectSoftRestarts(ctx context.Context, hint string) []string {
+	hints := []string{hint}
+	timer := time.NewTimer(softRestartDebounce)
+	defer timer.Stop()
+
+	for {
+		select {
+		case next := <-a.softRestart:
+			if !slices.Contains(hints, next) {
+				hints = append(hints, next)
+			}
+		case <-timer.C:
+			return hints
+		case <-ctx.Done():
+			return hints
+		}
+	}
+}
+
+// softReload asks the child process to reload the changed files over IPC, and with templates.signal if it is set
+func (a *App) softReload(hints []string) {
+	log.Info("soft restart: " + strings.Join(hints, ", "))
+	signalled := false
+	if proc := a.childProcess.Load(); proc != nil {
+		var err error
+		signalled, err = proc.ReloadTemplates()
+		if err != nil {
+			log.Warnf("signalling child process: %v", err)
+		}
+	}
+	err := a.notifier.SendSoftRestart(hints)
+	if err != nil && signalled {
+		// programs which reload on a signal don't have to use IPC
+		log.Debugf("notifying child process: %v", err)
+	} else if err != nil {
+		log.Warnf("notifying child process: %v", err)
+	}
+}
+
+// restartChildProcess stops the child process so that it is started again by RunChildProcess
+func (a *App) restartChildProcess(hint string) {
+	log.Info("hard restart: " + hint)
+	a.restartHint.Store(hint)
+	if a.builder != nil {
+		a.builder.Invalidate()
+	}
+	select {
+	case a.restartRequested <- struct{}{}:
+	default:
+	}
+	proc := a.childProcess.Load()
+	if proc != nil {
+		proc.Stop()
+	}
+}
+
+// requestChecks runs the checks in the background and restarts the child process if they pass
+func (a *App) requestChecks(hint string) {
+	a.checksLock.Lock()
+	defer a.checksLock.Unlock()
+
+	if a.checksRunning {
+		a.checksPending = true
+		a.checksHint = hint
+		return
+	}
+	a.checksRunning = true
+	go a.runChecks(hint)
+}
+
+func (a *App) runChecks(hint string) {
+	for {
+		log.Info("running checks: " + hint)
+		proc := a.childProcess.Load()
+		var err error
+		if proc != nil {
+			err = proc.RunChecks(a.Config().Checks, a.Notify)
+		}
+		if err == nil {
+			a.restartChildProcess(hint)
+		}
+
+		a.checksLock.Lock()
+		if !a.checksPending {
+			a.checksRunning = false
+			a.checksLock.Unlock()
+			return
+		}
+		hint = a.checksHint
+		a.checksPending = false
+		a.checksLock.Unlock()
+	}
+}
+
 func (a *App) ProcessSignals() error {
-	signal.Notify(a.sigint, syscall.SIGINT, syscall.SIGTERM, syscall.SIGHUP, syscall.SIGUSR1)
+	signal.Notify(a.sigint, notifySignals...)
 	for s := range a.sigint {
-		switch s {
-		case syscall.SIGHUP:
+		switch {
+		case s == syscall.SIGHUP:
 			log.Info("received signal, restarting")
 			a.softRestart <- "sighup"
-		case syscall.SIGUSR1:
+		case isHardRestartSignal(s):
 			log.Info("received signal, hard restarting")
 			a.hardRestart <- "sigusr1"
-		case syscall.SIGINT, syscall.SIGTERM:
+		case isDumpSignal(s):
+			a.dumpState()
+		case s == syscall.SIGINT, s == syscall.SIGTERM:
 			log.Info("received term signal, exiting")
-			return errors.New("shutdown requested")
+			return errShutdownRequested
 		}
 	}
 	return nil
 }
 
+func (a *App) Metrics() utils.Metrics {
+	m := utils.CollectMetrics(a.db, a.consoleWriter, a.logs, a.tracer, a.plugins)
+	if proc := a.childProcess.Load(); proc != nil {
+		m.Environment = proc.Environment()
+	}
+	m.Retention = a.db.RetentionStatus()
+	return m
+}
+
+// Health reports the state of each of gomon's subsystems and the child process
+func (a *App) Health() utils.Health {
+	components := map[string]utils.ComponentHealth{
+		"watcher":  {Status: utils.HealthDisabled},
+		"database": utils.ComponentStatus(a.db.Health()),
+		"proxy":    {Status: utils.HealthDisabled},
+		"child":    {Status: utils.HealthDisabled},
+	}
+
+	if a.isWatching.Load() {
+		components["watcher"] = utils.ComponentStatus(a.watcher.Health())
+	}
+
+	if a.proxy.Enabled() {
+		components["proxy"] = utils.ComponentStatus(a.proxy.Health())
+	}
+
+	if !a.proxyOnly {
+		components["child"] = utils.ComponentHealth{Status: utils.HealthStarting}
+		if proc := a.childProcess.Load(); proc != nil {
+			state := proc.State()
+			if state == process.ProcessStateStarted {
+				components["child"] = utils.ComponentHealth{Status: utils.HealthOK}
+			} else {
+				components["child"] = utils.ComponentHealth{Status: utils.HealthStarting, Error: state.String()}
+			}
+		}
+	}
+
+	return utils.NewHealth(components)
+}
+
 func (a *App) Notify(n notification.Notification) error {
-	a.db.Notify(n)
-	a.consoleWriter.Notify(n)
-	a.proxy.Notify(n)
-	a.webui.Notify(n)
-	a.notifier.Notify(n)
+	log.WithFields(logrus.Fields{
+		"childProcessId":   n.ChildProccessID,
+		"notificationType": n.Type.String(),
+	}).Debug(n.Message)
+
+	a.recordNotification(n)
+	a.bus.Publish(n)
 	return nil
 }
+
+// handleConsoleRequest acts on commands typed into gomon's terminal, which can run any command as a task
+func (a *App) handleConsoleRequest(n notification.Notification) error {
+	if n.Type == notification.NotificationTypeOOBTaskRequested {
+		a.oobTask <- n.Message
+		return a.Notify(n)
+	}
+	return a.handleRequest(n)
+}
+
+// handleRequest acts on requests made by the user from one of the interactive UIs, the API or a plugin. Only the
+// named tasks from the config file can be run, other commands could come from anyone who can reach the API.
+func (a *App) handleRequest(n notification.Notification) error {
+	switch n.Type {
+	case notification.NotificationTypeHardRestartRequested:
+		a.hardRestart <- n.Message
+	case notification.NotificationTypeSoftRestartRequested:
+		a.softRestart <- n.Message
+	case notification.NotificationTypeOOBTaskRequested:
+		if _, ok := a.Config().Tasks[n.Message]; !ok {
+			log.Warnf("ignoring request for unknown task: %s", n.Message)
+			return nil
+		}
+		a.oobTask <- n.Message
+	case notification.NotificationTypePipelineRequested:
+		go a.runPipeline(n.Message)
+	case notification.NotificationTypeTestRequested:
+		a.requestTests(n.Message)
+	case notification.NotificationTypeOOBTaskCancelRequested:
+		a.cancelTasks(n.Message)
+	case notification.NotificationTypeShutdownRequested:
+		a.sigint <- syscall.SIGTERM
+	}
+	return a.Notify(n)
+}
@@ -0,0 +1,26 @@
+package app
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/jdudmesh/gomon/internal/process"
+)
+
+// startDependencies brings up the compose services in dependsOn and waits for them to be healthy, it is run once
+// before the child process (or the first test run) starts so that prestart tasks can use them e.g. migrations
+func (a *App) startDependencies() error {
+	cfg := a.Config()
+	command := process.DependenciesCommand(cfg)
+	if command == "" {
+		return nil
+	}
+
+	log.Infof("starting dependencies: %v", cfg.DependsOn)
+	err := process.NewOutOfBandTask(cfg.RootDirectory, command, os.Environ()).Run("", a.Notify)
+	if err != nil {
+		return fmt.Errorf("starting dependencies: %w", err)
+	}
+
+	return nil
+}
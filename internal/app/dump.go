@@ -0,0 +1,92 @@
+package app
+
+// gomon is a simple command line tool that watches your files and automatically restarts the application when it detects any changes in the working directory.
+// Copyright (C) 2023 John Dudmesh
+
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+
+// You should have received a copy of the GNU General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>.
+
+import (
+	"bytes"
+	"time"
+
+	"github.com/jdudmesh/gomon/internal/notification"
+	"github.com/jdudmesh/gomon/internal/utils"
+	"github.com/sirupsen/logrus"
+)
+
+// recordNotification keeps the most recent notifications and the current run for the state dump
+func (a *App) recordNotification(n notification.Notification) {
+	a.stateLock.Lock()
+	defer a.stateLock.Unlock()
+
+	if n.Type == notification.NotificationTypeStartup {
+		a.currentRun = n.ChildProccessID
+	}
+	a.recent = append(a.recent, &n)
+	if len(a.recent) > utils.MaxDumpNotifications {
+		a.recent = a.recent[len(a.recent)-utils.MaxDumpNotifications:]
+	}
+}
+
+// currentRunID is the ID of the child process's most recent run
+func (a *App) currentRunID() string {
+	a.stateLock.Lock()
+	defer a.stateLock.Unlock()
+
+	return a.currentRun
+}
+
+func (a *App) updateRestartState(fn func(s *utils.RestartState)) {
+	a.stateLock.Lock()
+	defer a.stateLock.Unlock()
+
+	fn(&a.restartState)
+}
+
+// Dump returns a snapshot of gomon's internal state, it is shown by `gomon status` and on SIGUSR2
+func (a *App) Dump() utils.StateDump {
+	d := utils.NewStateDump()
+
+	a.stateLock.Lock()
+	d.Child.RunID = a.currentRun
+	d.Restart = a.restartState
+	d.Notifications = append(d.Notifications, a.recent...)
+	a.stateLock.Unlock()
+
+	d.Child.State = "not started"
+	if a.proxyOnly {
+		d.Child.State = "proxy only"
+	} else if proc := a.childProcess.Load(); proc != nil {
+		d.Child.State = proc.State().String()
+		if pid, startedAt := proc.PID(); pid != 0 {
+			d.Child.PID = pid
+			d.Child.Uptime = time.Since(startedAt).Round(time.Second).String()
+		}
+	}
+
+	d.Watcher.Watching = a.isWatching.Load() && a.watcher.Health() == nil
+	d.Watcher.Directories, d.Watcher.Unwatched = a.watcher.Directories()
+
+	d.Clients = a.webui.Clients()
+
+	return d
+}
+
+// dumpState writes the state dump to gomon's log output so that it appears in the terminal UI when it is in use
+func (a *App) dumpState() {
+	buf := bytes.Buffer{}
+	buf.WriteString("gomon state dump\n")
+	a.Dump().Format(&buf)
+	logrus.StandardLogger().Out.Write(buf.Bytes())
+}
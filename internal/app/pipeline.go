@@ -0,0 +1,50 @@
+package app
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/jdudmesh/gomon/internal/notification"
+	"github.com/jdudmesh/gomon/internal/process"
+)
+
+// runPipeline runs the stages configured for a file pattern in order, each stage must succeed before the next
+// one is run. Stages are tasks or one of the restart markers used by generated files.
+func (a *App) runPipeline(pattern string) {
+	if a.proxyOnly {
+		return
+	}
+
+	a.pipelineLock.Lock()
+	defer a.pipelineLock.Unlock()
+
+	stages := a.Config().Pipelines[pattern]
+	hint := "pipeline " + pattern
+
+	for i, stage := range stages {
+		switch stage {
+		case process.ForceHardRestart:
+			a.hardRestart <- hint
+		case process.ForceSoftRestart:
+			a.softRestart <- hint
+		default:
+			proc := a.childProcess.Load()
+			if proc == nil {
+				return
+			}
+			log.Infof("pipeline %s stage %d: %s", pattern, i+1, stage)
+			err := proc.ExecuteOOBTask(stage, a.Notify)
+			if err != nil {
+				message := fmt.Sprintf("pipeline %s stopped at stage %d (%s): %v", pattern, i+1, stage, err)
+				log.Warn(message)
+				a.Notify(notification.Notification{
+					ID:      notification.NextID(),
+					Date:    time.Now(),
+					Type:    notification.NotificationTypeSystemError,
+					Message: message,
+				})
+				return
+			}
+		}
+	}
+}
@@ -0,0 +1,104 @@
+package app
+
+import (
+	"fmt"
+	"net"
+	"net/url"
+	"strconv"
+	"strings"
+
+	"github.com/jdudmesh/gomon/internal/config"
+	"github.com/jdudmesh/gomon/internal/utils"
+)
+
+// checkPorts makes sure the proxy and the UI will be able to listen. If a port is in use the process which owns it
+// is reported and, with autoPorts, the next free port is used instead so the URLs passed to the child are correct.
+func (a *App) checkPorts(cfg *config.Config) error {
+	type listener struct {
+		setting string
+		port    *int
+		initial int
+	}
+
+	listeners := []listener{}
+	if cfg.Proxy.Enabled {
+		listeners = append(listeners, listener{"proxy.port", &cfg.Proxy.Port, config.DefaultProxyPort})
+	}
+	if cfg.UI.Enabled && !(cfg.UI.MountOnProxy && cfg.Proxy.Enabled) {
+		listeners = append(listeners, listener{"ui.port", &cfg.UI.Port, config.DefaultUIPort})
+	}
+
+	// a port which is moved mustn't take one of the others
+	taken := []int{}
+	for _, l := range listeners {
+		if *l.port == 0 {
+			taken = append(taken, l.initial)
+		} else {
+			taken = append(taken, *l.port)
+		}
+	}
+
+	isMoved := false
+	for i, l := range listeners {
+		port := taken[i]
+		if utils.PortAvailable(port) {
+			continue
+		}
+
+		inUse := utils.PortInUseError(l.setting, port)
+		if !cfg.AutoPorts {
+			return fmt.Errorf("%w, stop it, change %s or set autoPorts to use the next free port", inUse, l.setting)
+		}
+
+		next, err := utils.NextFreePort(port, taken)
+		if err != nil {
+			return fmt.Errorf("%v: %w", inUse, err)
+		}
+		log.Warnf("%v, using %d instead", inUse, next)
+		*l.port = next
+		isMoved = true
+		taken = append(taken, next)
+	}
+
+	if proxyURL := cfg.ProxyURL(); isMoved && proxyURL != "" {
+		log.Infof("proxy URL: %s", proxyURL)
+	}
+	if uiURL := cfg.UIURL(); isMoved && uiURL != "" {
+		log.Infof("UI URL: %s", uiURL)
+	}
+
+	// gomon can't move the child's port because the app chooses it, but a conflict is reported before it crash loops
+	if port := downstreamPort(cfg.Proxy.Downstream.Host); cfg.Proxy.Enabled && !cfg.ProxyOnly && a.testRunner == nil && a.socket == nil && port != 0 {
+		if !utils.PortAvailable(port) {
+			log.Warnf("%v, the child process won't be able to listen on it", utils.PortInUseError("the downstream port", port))
+		}
+	}
+
+	return nil
+}
+
+// downstreamPort returns the port of the downstream host if it's on this machine, otherwise 0
+func downstreamPort(host string) int {
+	if !strings.Contains(host, "://") {
+		host = "http://" + host
+	}
+
+	u, err := url.Parse(host)
+	if err != nil || u.Port() == "" {
+		return 0
+	}
+
+	switch u.Hostname() {
+	case "", "localhost", "0.0.0.0", "::":
+	default:
+		if ip := net.ParseIP(u.Hostname()); ip == nil || !ip.IsLoopback() {
+			return 0
+		}
+	}
+
+	port, err := strconv.Atoi(u.Port())
+	if err != nil {
+		return 0
+	}
+	return port
+}
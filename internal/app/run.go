@@ -0,0 +1,149 @@
+package app
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"syscall"
+)
+
+// errShutdownRequested is returned by ProcessSignals when gomon is asked to exit
+var errShutdownRequested = errors.New("shutdown requested")
+
+// RunOptions selects which of the optional parts of gomon are run
+type RunOptions struct {
+	// Watch restarts the child process when files change, without it restarts must be requested
+	Watch bool
+	// HandleSignals installs handlers for SIGINT/SIGTERM (exit) and SIGHUP/SIGUSR1 (soft/hard restart)
+	HandleSignals bool
+	// ReadCommands accepts commands (rs, ss, task <name>, cancel, quit) typed into the terminal when stdin is a terminal and
+	// the terminal UI isn't in use
+	ReadCommands bool
+}
+
+// Run starts every component and keeps the child process running until the context is cancelled, a shutdown is
+// requested or a component fails. The App should be closed once Run returns.
+func (a *App) Run(ctx context.Context, opts RunOptions) error {
+	ctx, cancel := context.WithCancelCause(ctx)
+	defer cancel(nil)
+
+	a.isWatching.Store(opts.Watch)
+
+	start := func(name string, fn func() error) {
+		go func() {
+			err := fn()
+			if err != nil {
+				log.Errorf("starting %s: %v", name, err)
+				cancel(fmt.Errorf("starting %s: %w", name, err))
+			}
+		}()
+	}
+
+	start("proxy", a.RunProxy)
+	start("web UI", a.RunWebUI)
+	start("terminal UI", a.RunTUI)
+	start("console", a.RunConsole)
+	start("IPC server", a.RunNotifer)
+	start("notification sinks", a.RunSinks)
+	start("log forwarding", a.RunLogForwarding)
+	start("tracing", a.RunTracing)
+	start("plugins", a.RunPlugins)
+	if opts.ReadCommands {
+		start("command reader", a.RunCommands)
+	}
+
+	if opts.Watch {
+		go func() {
+			err := a.MonitorFileChanges(ctx)
+			if err != nil {
+				cancel(err)
+			}
+		}()
+	}
+
+	if opts.HandleSignals {
+		go func() {
+			err := a.ProcessSignals()
+			if err != nil {
+				cancel(err)
+			}
+		}()
+	} else {
+		// shutdown requests from the UI are delivered as a signal even when signals aren't handled
+		go func() {
+			select {
+			case s := <-a.sigint:
+				if s == syscall.SIGINT || s == syscall.SIGTERM {
+					cancel(errShutdownRequested)
+				}
+			case <-ctx.Done():
+			}
+		}()
+	}
+
+	go a.ProcessRestartEvents(ctx)
+
+	// all components should be up and running by now
+	log.Infof("gomon started with pid %d", os.Getpid())
+
+	// keep restarting the child process until the context is cancelled or an error occurs
+	if a.testRunner != nil {
+		go func() {
+			err := a.startDependencies()
+			if err != nil {
+				cancel(err)
+				return
+			}
+			err = a.testRunner.Run(ctx, a.consoleWriter, a.Notify)
+			if err != nil {
+				cancel(err)
+			}
+		}()
+	} else if !a.proxyOnly {
+		go func() {
+			err := a.changeTester.Run(ctx, a.consoleWriter, a.Notify)
+			if err != nil {
+				log.Errorf("testing changes: %v", err)
+			}
+		}()
+		go func() {
+			err := a.startDependencies()
+			if err != nil {
+				cancel(err)
+				return
+			}
+			for ctx.Err() == nil {
+				err := a.RunChildProcess(a.Config())
+				if err != nil {
+					cancel(err)
+				}
+			}
+		}()
+	}
+
+	<-ctx.Done()
+
+	err := context.Cause(ctx)
+	if errors.Is(err, context.Canceled) || errors.Is(err, errShutdownRequested) {
+		return nil
+	}
+	return err
+}
+
+// Restart requests a hard restart of the child process, or a soft restart which is passed on to the child process
+// over IPC. The hint is shown in the logs and passed to the child process on a soft restart. An error is returned
+// if ctx is cancelled before the request is accepted e.g. because Run has returned.
+func (a *App) Restart(ctx context.Context, hard bool, hint string) error {
+	restart := a.softRestart
+	if hard {
+		restart = a.hardRestart
+	}
+
+	select {
+	case restart <- hint:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
@@ -0,0 +1,36 @@
+//go:build windows
+// +build windows
+
+package app
+
+// gomon is a simple command line tool that watches your files and automatically restarts the application when it detects any changes in the working directory.
+// Copyright (C) 2023 John Dudmesh
+
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+
+// You should have received a copy of the GNU General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>.
+
+import (
+	"os"
+	"syscall"
+)
+
+// Windows has no SIGUSR1 or SIGUSR2, hard restarts can be requested from the web UI and state dumps with `gomon status`
+var notifySignals = []os.Signal{syscall.SIGINT, syscall.SIGTERM, syscall.SIGHUP}
+
+func isHardRestartSignal(s os.Signal) bool {
+	return false
+}
+
+func isDumpSignal(s os.Signal) bool {
+	return false
+}
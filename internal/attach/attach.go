@@ -0,0 +1,110 @@
+package attach
+
+// gomon is a simple command line tool that watches your files and automatically restarts the application when it detects any changes in the working directory.
+// Copyright (C) 2023 John Dudmesh
+
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+
+// You should have received a copy of the GNU General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>.
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/jdudmesh/gomon/internal/config"
+	"github.com/jdudmesh/gomon/internal/notification"
+	"github.com/jdudmesh/gomon/internal/tui"
+	"github.com/sirupsen/logrus"
+)
+
+var log = logrus.WithField("component", "attach")
+
+// Options configures `gomon attach`
+type Options struct {
+	URL   string
+	Token string
+	// TUI shows the remote output in the terminal UI rather than writing it to the console
+	TUI bool
+}
+
+// Attach shows the output of a remote gomon instance until ctx is cancelled or the terminal UI is closed
+func Attach(ctx context.Context, opts Options) error {
+	client, err := NewClient(opts.URL, opts.Token)
+	if err != nil {
+		return err
+	}
+
+	if !opts.TUI {
+		out := &console{stdout: os.Stdout, stderr: os.Stderr}
+		return client.Stream(ctx, out.Notify)
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	cfg := config.Config{TUI: true}
+	t, err := tui.New(cfg, client, func(n notification.Notification) error {
+		if n.Type == notification.NotificationTypeShutdownRequested {
+			// quitting detaches rather than stopping the remote instance
+			cancel()
+			return nil
+		}
+		return client.Request(n)
+	})
+	if err != nil {
+		return fmt.Errorf("creating tui: %w", err)
+	}
+
+	streamErr := make(chan error, 1)
+	go func() {
+		streamErr <- client.Stream(ctx, t.Notify)
+		cancel()
+	}()
+	go func() {
+		<-ctx.Done()
+		t.Close()
+	}()
+
+	err = t.Start()
+	cancel()
+	if err != nil {
+		return err
+	}
+	return <-streamErr
+}
+
+// console writes the remote output to the terminal in the same way as gomon does without a UI, the child's output
+// is passed straight through and everything else is logged
+type console struct {
+	stdout io.Writer
+	stderr io.Writer
+}
+
+func (c *console) Notify(n notification.Notification) error {
+	switch n.Type {
+	case notification.NotificationTypeStdOut:
+		fmt.Fprintln(c.stdout, n.Message)
+	case notification.NotificationTypeStdErr, notification.NotificationTypeBuildError:
+		fmt.Fprintln(c.stderr, n.Message)
+	case notification.NotificationTypeOOBTaskStdOut:
+		fmt.Fprintln(c.stdout, "[task] "+n.Message)
+	case notification.NotificationTypeOOBTaskStdErr:
+		fmt.Fprintln(c.stderr, "[task] "+n.Message)
+	case notification.NotificationTypeCrash, notification.NotificationTypeUnhealthy, notification.NotificationTypeSystemError, notification.NotificationTypeTestFail:
+		log.Warnf("%s: %s", n.Type.String(), n.Message)
+	default:
+		log.Infof("%s: %s", n.Type.String(), n.Message)
+	}
+	return nil
+}
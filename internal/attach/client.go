@@ -0,0 +1,243 @@
+package attach
+
+// gomon is a simple command line tool that watches your files and automatically restarts the application when it detects any changes in the working directory.
+// Copyright (C) 2023 John Dudmesh
+
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+
+// You should have received a copy of the GNU General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>.
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/jdudmesh/gomon/internal/notification"
+	"github.com/jdudmesh/gomon/internal/utils"
+	"github.com/r3labs/sse/v2"
+	"gopkg.in/cenkalti/backoff.v1"
+)
+
+const (
+	notificationsStream = "notifications"
+	// maxEventSize is the largest notification which can be received, build errors can be long
+	maxEventSize = 1 << 20
+)
+
+// ErrUnauthorized is returned if the remote instance rejects the credentials
+var ErrUnauthorized = errors.New("not authorized, check the token")
+
+// Client follows a gomon instance running elsewhere e.g. in a devcontainer, using the same event stream and API
+// as the web UI. Credentials are sent as a bearer token or, if the URL contains them, with basic auth.
+type Client struct {
+	baseURL string
+	// displayURL is baseURL without the password, for messages
+	displayURL string
+	token      string
+	http       *http.Client
+}
+
+func NewClient(baseURL, token string) (*Client, error) {
+	u, err := url.Parse(baseURL)
+	if err != nil {
+		return nil, fmt.Errorf("parsing url: %w", err)
+	}
+	if u.Scheme != "http" && u.Scheme != "https" {
+		return nil, fmt.Errorf("unsupported url %s, expected http(s)://host:port", baseURL)
+	}
+
+	return &Client{
+		baseURL:    strings.TrimSuffix(baseURL, "/"),
+		displayURL: strings.TrimSuffix(u.Redacted(), "/"),
+		token:      token,
+		http:       &http.Client{Timeout: 10 * time.Second},
+	}, nil
+}
+
+// Stream calls fn with each notification published by the remote instance until ctx is cancelled. If the
+// connection is lost it reconnects with an exponential backoff, events published while disconnected are missed.
+func (c *Client) Stream(ctx context.Context, fn notification.NotificationCallback) error {
+	policy := backoff.NewExponentialBackOff()
+	// keep trying for as long as we're attached
+	policy.MaxElapsedTime = 0
+
+	client := sse.NewClient(c.baseURL+"/sse", sse.ClientMaxBufferSize(maxEventSize))
+	// stop retrying once detached, otherwise the subscription outlives ctx while the instance is unreachable
+	client.ReconnectStrategy = backoff.WithContext(policy, ctx)
+	if c.token != "" {
+		client.Headers["Authorization"] = "Bearer " + c.token
+	}
+	client.ResponseValidator = func(_ *sse.Client, res *http.Response) error {
+		if res.StatusCode == http.StatusOK {
+			return nil
+		}
+		res.Body.Close()
+		if res.StatusCode == http.StatusUnauthorized || res.StatusCode == http.StatusForbidden {
+			return backoff.Permanent(ErrUnauthorized)
+		}
+		return fmt.Errorf("unexpected status: %s", res.Status)
+	}
+	client.OnConnect(func(_ *sse.Client) {
+		log.Infof("attached to %s", c.displayURL)
+		policy.Reset()
+	})
+	client.ReconnectNotify = func(err error, next time.Duration) {
+		log.Warnf("not connected to %s, retrying in %s: %v", c.displayURL, next.Round(time.Second), err)
+	}
+
+	err := client.SubscribeWithContext(ctx, notificationsStream, func(msg *sse.Event) {
+		if len(msg.Data) == 0 {
+			return
+		}
+		n := notification.Notification{}
+		err := json.Unmarshal(msg.Data, &n)
+		if err != nil {
+			log.Warnf("decoding notification: %v", err)
+			return
+		}
+		fn(n)
+	})
+	if ctx.Err() != nil {
+		return nil
+	}
+	return err
+}
+
+// Request passes a request made in the terminal UI on to the remote instance
+func (c *Client) Request(n notification.Notification) error {
+	var path string
+	switch n.Type {
+	case notification.NotificationTypeHardRestartRequested:
+		path = "/api/restart?type=hard"
+	case notification.NotificationTypeSoftRestartRequested:
+		path = "/api/restart?type=soft"
+	case notification.NotificationTypeOOBTaskRequested:
+		path = "/api/tasks/" + url.PathEscape(n.Message)
+	default:
+		return nil
+	}
+
+	res, err := c.do(http.MethodPost, path)
+	if err != nil {
+		return err
+	}
+	res.Body.Close()
+
+	if res.StatusCode != http.StatusAccepted {
+		return fmt.Errorf("requesting %s: %s", n.Type.String(), res.Status)
+	}
+	return nil
+}
+
+// FindRuns returns the most recent runs of the remote child process for the terminal UI's history view, optionally
+// only those with a label
+func (c *Client) FindRuns(label string) ([]*notification.Notification, error) {
+	path := "/api/runs"
+	if label != "" {
+		path += "?label=" + url.QueryEscape(label)
+	}
+	res, err := c.do(http.MethodGet, path)
+	if err != nil {
+		return nil, err
+	}
+	defer res.Body.Close()
+
+	if res.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("getting runs: %s", res.Status)
+	}
+
+	runs := []*notification.Notification{}
+	err = json.NewDecoder(res.Body).Decode(&runs)
+	if err != nil {
+		return nil, fmt.Errorf("decoding runs: %w", err)
+	}
+	return runs, nil
+}
+
+// FindNotifications returns the events of a run, only whole runs can be exported so stm, level and filter are ignored
+func (c *Client) FindNotifications(runID, stm, level, filter string) ([][]*notification.Notification, error) {
+	res, err := c.do(http.MethodGet, "/api/runs/"+url.PathEscape(runID)+"/export?format=ndjson")
+	if err != nil {
+		return nil, err
+	}
+	defer res.Body.Close()
+
+	if res.StatusCode == http.StatusNotFound {
+		return nil, nil
+	}
+	if res.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("exporting run: %s", res.Status)
+	}
+
+	events := []*notification.Notification{}
+	dec := json.NewDecoder(res.Body)
+	for dec.More() {
+		n := new(notification.Notification)
+		err = dec.Decode(n)
+		if err != nil {
+			return nil, fmt.Errorf("decoding run: %w", err)
+		}
+		events = append(events, n)
+	}
+	return [][]*notification.Notification{events}, nil
+}
+
+// State returns a dump of the remote instance's internal state, as printed by `gomon status`
+func (c *Client) State() (utils.StateDump, error) {
+	state := utils.StateDump{}
+
+	res, err := c.do(http.MethodGet, "/api/state")
+	if err != nil {
+		return state, err
+	}
+	defer res.Body.Close()
+
+	if res.StatusCode != http.StatusOK {
+		return state, fmt.Errorf("getting state: %s", res.Status)
+	}
+
+	err = json.NewDecoder(res.Body).Decode(&state)
+	if err != nil {
+		return state, fmt.Errorf("decoding state: %w", err)
+	}
+	return state, nil
+}
+
+// StorageWarning is always empty, the remote instance reports storage problems in its event stream
+func (c *Client) StorageWarning() string {
+	return ""
+}
+
+func (c *Client) do(method, path string) (*http.Response, error) {
+	req, err := http.NewRequest(method, c.baseURL+path, nil)
+	if err != nil {
+		return nil, fmt.Errorf("creating request: %w", err)
+	}
+	if c.token != "" {
+		req.Header.Set("Authorization", "Bearer "+c.token)
+	}
+
+	res, err := c.http.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("connecting to %s: %w", c.displayURL, err)
+	}
+	if res.StatusCode == http.StatusUnauthorized || res.StatusCode == http.StatusForbidden {
+		res.Body.Close()
+		return nil, ErrUnauthorized
+	}
+	return res, nil
+}
@@ -0,0 +1,158 @@
+package attach
+
+// gomon is a simple command line tool that watches your files and automatically restarts the application when it detects any changes in the working directory.
+// Copyright (C) 2023 John Dudmesh
+
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+
+// You should have received a copy of the GNU General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>.
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/jdudmesh/gomon/internal/notification"
+	"github.com/jdudmesh/gomon/internal/utils"
+	"github.com/r3labs/sse/v2"
+)
+
+func TestStream(t *testing.T) {
+	sseServer := sse.New()
+	sseServer.AutoReplay = false
+	sseServer.CreateStream(notificationsStream)
+	defer sseServer.Close()
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Authorization") != "Bearer secret" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		sseServer.ServeHTTP(w, r)
+	}))
+	defer srv.Close()
+
+	client, err := NewClient(srv.URL, "secret")
+	if err != nil {
+		t.Fatalf("creating client: %v", err)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	// the subscription may not be ready when the first event is published
+	go func() {
+		data, _ := json.Marshal(notification.Notification{ID: "1", Type: notification.NotificationTypeStdErr, Message: "boom"})
+		for ctx.Err() == nil {
+			sseServer.Publish(notificationsStream, &sse.Event{Data: data})
+			time.Sleep(50 * time.Millisecond)
+		}
+	}()
+
+	var received notification.Notification
+	err = client.Stream(ctx, func(n notification.Notification) error {
+		received = n
+		cancel()
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("streaming: %v", err)
+	}
+	if received.Type != notification.NotificationTypeStdErr || received.Message != "boom" {
+		t.Errorf("unexpected notification: %+v", received)
+	}
+
+	client, _ = NewClient(srv.URL, "wrong")
+	err = client.Stream(context.Background(), func(n notification.Notification) error { return nil })
+	if !errors.Is(err, ErrUnauthorized) {
+		t.Errorf("expected ErrUnauthorized, got %v", err)
+	}
+}
+
+func TestFindNotifications(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/api/runs/123/export" || r.URL.Query().Get("format") != "ndjson" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		enc := json.NewEncoder(w)
+		enc.Encode(notification.Notification{ID: "1", ChildProccessID: "123", Type: notification.NotificationTypeStartup})
+		enc.Encode(notification.Notification{ID: "2", ChildProccessID: "123", Type: notification.NotificationTypeStdOut, Message: "hello"})
+	}))
+	defer srv.Close()
+
+	client, err := NewClient(srv.URL+"/", "")
+	if err != nil {
+		t.Fatalf("creating client: %v", err)
+	}
+
+	runs, err := client.FindNotifications("123", "all", "", "")
+	if err != nil {
+		t.Fatalf("finding notifications: %v", err)
+	}
+	if len(runs) != 1 || len(runs[0]) != 2 || runs[0][1].Message != "hello" {
+		t.Errorf("unexpected events: %+v", runs)
+	}
+
+	runs, err = client.FindNotifications("missing", "all", "", "")
+	if err != nil || len(runs) != 0 {
+		t.Errorf("expected no events for a missing run, got %+v, %v", runs, err)
+	}
+}
+
+func TestState(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Authorization") != "Bearer secret" {
+			w.WriteHeader(http.StatusForbidden)
+			return
+		}
+		json.NewEncoder(w).Encode(utils.StateDump{PID: 42, Child: utils.ChildState{RunID: "123", State: "running"}})
+	}))
+	defer srv.Close()
+
+	client, _ := NewClient(srv.URL, "secret")
+	state, err := client.State()
+	if err != nil {
+		t.Fatalf("getting state: %v", err)
+	}
+	if state.PID != 42 || state.Child.State != "running" || state.Child.RunID != "123" {
+		t.Errorf("unexpected state: %+v", state)
+	}
+
+	client, _ = NewClient(srv.URL, "wrong")
+	_, err = client.State()
+	if !errors.Is(err, ErrUnauthorized) {
+		t.Errorf("expected ErrUnauthorized, got %v", err)
+	}
+}
+
+func TestConsole(t *testing.T) {
+	stdout := bytes.Buffer{}
+	stderr := bytes.Buffer{}
+	c := &console{stdout: &stdout, stderr: &stderr}
+
+	c.Notify(notification.Notification{Type: notification.NotificationTypeStdOut, Message: "listening"})
+	c.Notify(notification.Notification{Type: notification.NotificationTypeStdErr, Message: "boom"})
+	c.Notify(notification.Notification{Type: notification.NotificationTypeOOBTaskStdOut, Message: "generated"})
+
+	if stdout.String() != "listening\n[task] generated\n" {
+		t.Errorf("unexpected stdout: %q", stdout.String())
+	}
+	if stderr.String() != "boom\n" {
+		t.Errorf("unexpected stderr: %q", stderr.String())
+	}
+}
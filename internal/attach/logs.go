@@ -0,0 +1,120 @@
+package attach
+
+// gomon is a simple command line tool that watches your files and automatically restarts the application when it detects any changes in the working directory.
+// Copyright (C) 2023 John Dudmesh
+
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+
+// You should have received a copy of the GNU General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>.
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"slices"
+	"strings"
+
+	"github.com/jdudmesh/gomon/internal/notification"
+	"github.com/jdudmesh/gomon/internal/utils"
+)
+
+// LogsOptions configures `gomon logs`
+type LogsOptions struct {
+	URL   string
+	Token string
+	// RunID is the run whose output is shown first, the latest run if empty
+	RunID string
+	// Filter only shows events containing the text, ignoring case
+	Filter string
+	// Level only shows lines of output logged at this level or above, as in the web UI
+	Level string
+	// Follow keeps streaming new events after the run's output has been shown, like tail -f
+	Follow bool
+}
+
+// Logs writes the output of a run of a gomon instance to the terminal and, when following, the events published
+// after it until ctx is cancelled. If a run is given only its events are followed.
+func Logs(ctx context.Context, opts LogsOptions) error {
+	f, err := newLogFilter(opts.Filter, opts.Level)
+	if err != nil {
+		return err
+	}
+
+	client, err := NewClient(opts.URL, opts.Token)
+	if err != nil {
+		return err
+	}
+
+	runID := opts.RunID
+	if runID == "" {
+		runID = utils.LatestRun
+	}
+	runs, err := client.FindNotifications(runID, "", "", "")
+	if err != nil {
+		return err
+	}
+	if len(runs) == 0 && opts.RunID != "" {
+		return fmt.Errorf("run %s not found", opts.RunID)
+	}
+
+	out := &console{stdout: os.Stdout, stderr: os.Stderr}
+	for _, run := range runs {
+		for _, n := range run {
+			if f.match(*n) {
+				out.Notify(*n)
+			}
+		}
+	}
+
+	if !opts.Follow {
+		return nil
+	}
+
+	return client.Stream(ctx, func(n notification.Notification) error {
+		if opts.RunID != "" && n.ChildProccessID != opts.RunID {
+			return nil
+		}
+		if !f.match(n) {
+			return nil
+		}
+		return out.Notify(n)
+	})
+}
+
+// logFilter applies the web UI's search options to events on the client
+type logFilter struct {
+	text  string
+	level int
+}
+
+func newLogFilter(text, level string) (logFilter, error) {
+	f := logFilter{text: strings.ToLower(text), level: -1}
+	if level != "" {
+		f.level = slices.Index(notification.Levels, level)
+		if f.level < 0 {
+			return f, fmt.Errorf("unknown level %s, expected one of %s", level, strings.Join(notification.Levels, ", "))
+		}
+	}
+	return f, nil
+}
+
+func (f logFilter) match(n notification.Notification) bool {
+	if f.text != "" && !strings.Contains(strings.ToLower(utils.StripANSI(n.Message)), f.text) {
+		return false
+	}
+	// lifecycle events are kept so that it is still clear when each run started and how it ended
+	isOutput := n.Type == notification.NotificationTypeStdOut || n.Type == notification.NotificationTypeStdErr
+	if f.level >= 0 && isOutput && slices.Index(notification.Levels, n.Level) < f.level {
+		return false
+	}
+	return true
+}
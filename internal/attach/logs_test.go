@@ -0,0 +1,51 @@
+package attach
+
+// gomon is a simple command line tool that watches your files and automatically restarts the application when it detects any changes in the working directory.
+// Copyright (C) 2023 John Dudmesh
+
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+
+// You should have received a copy of the GNU General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>.
+
+import (
+	"testing"
+
+	"github.com/jdudmesh/gomon/internal/notification"
+)
+
+func TestLogFilter(t *testing.T) {
+	f, err := newLogFilter("Listening", notification.LevelWarn)
+	if err != nil {
+		t.Fatalf("creating filter: %v", err)
+	}
+
+	tests := []struct {
+		n    notification.Notification
+		want bool
+	}{
+		{notification.Notification{Type: notification.NotificationTypeStdErr, Level: notification.LevelError, Message: "\x1b[31mlistening\x1b[0m failed"}, true},
+		{notification.Notification{Type: notification.NotificationTypeStdOut, Level: notification.LevelInfo, Message: "listening on :8080"}, false},
+		{notification.Notification{Type: notification.NotificationTypeStdOut, Message: "listening on :8080"}, false},
+		{notification.Notification{Type: notification.NotificationTypeStdErr, Level: notification.LevelError, Message: "boom"}, false},
+		{notification.Notification{Type: notification.NotificationTypeStartup, Message: "started listening"}, true},
+	}
+	for _, tt := range tests {
+		if got := f.match(tt.n); got != tt.want {
+			t.Errorf("match(%q) = %v, want %v", tt.n.Message, got, tt.want)
+		}
+	}
+
+	_, err = newLogFilter("", "fatal")
+	if err == nil {
+		t.Error("expected an error for an unknown level")
+	}
+}
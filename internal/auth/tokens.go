@@ -0,0 +1,291 @@
+package auth
+
+// gomon is a simple command line tool that watches your files and automatically restarts the application when it detects any changes in the working directory.
+// Copyright (C) 2023 John Dudmesh
+
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+
+// You should have received a copy of the GNU General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>.
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/sirupsen/logrus"
+)
+
+var log = logrus.WithField("component", "auth")
+
+// Scope is a permission granted to an API token
+type Scope string
+
+const (
+	// ScopeReadEvents allows reading the event history and subscribing to the live event stream
+	ScopeReadEvents Scope = "read:events"
+	// ScopeControlRestart allows restarting the child process and stopping gomon
+	ScopeControlRestart Scope = "control:restart"
+	// ScopeControlTasks allows running tasks
+	ScopeControlTasks Scope = "control:tasks"
+	// ScopeWriteLabels allows labelling runs
+	ScopeWriteLabels Scope = "write:labels"
+)
+
+// AllScopes is every scope, the API token from the config file (or .gomon/api_token) is granted all of them
+var AllScopes = []Scope{ScopeReadEvents, ScopeControlRestart, ScopeControlTasks, ScopeWriteLabels}
+
+const (
+	tokensFileName = "tokens.json"
+	tokenPrefix    = "gomon_"
+)
+
+var ErrTokenNotFound = errors.New("token not found")
+
+// Token is a scoped API token, only a hash of the secret is stored
+type Token struct {
+	ID        string    `json:"id"`
+	Name      string    `json:"name"`
+	Scopes    []Scope   `json:"scopes"`
+	Hash      string    `json:"hash"`
+	CreatedAt time.Time `json:"createdAt"`
+}
+
+// Store manages the tokens in .gomon/tokens.json. Tokens are created and revoked with `gomon token` while gomon is
+// running so the file is re-read whenever it changes.
+type Store struct {
+	path    string
+	lock    sync.Mutex
+	modTime time.Time
+	size    int64
+	tokens  []Token
+}
+
+func NewStore(rootDirectory string) *Store {
+	return &Store{path: filepath.Join(rootDirectory, ".gomon", tokensFileName)}
+}
+
+// ParseScopes parses a comma separated list of scopes e.g. "read:events,control:tasks"
+func ParseScopes(value string) ([]Scope, error) {
+	scopes := []Scope{}
+	for _, name := range strings.Split(value, ",") {
+		name = strings.TrimSpace(name)
+		if name == "" {
+			continue
+		}
+		scope := Scope(name)
+		if !HasScope(AllScopes, scope) {
+			return nil, fmt.Errorf("unknown scope: %s", name)
+		}
+		if !HasScope(scopes, scope) {
+			scopes = append(scopes, scope)
+		}
+	}
+	if len(scopes) == 0 {
+		return nil, errors.New("at least one scope is required")
+	}
+	return scopes, nil
+}
+
+func HasScope(scopes []Scope, scope Scope) bool {
+	for _, s := range scopes {
+		if s == scope {
+			return true
+		}
+	}
+	return false
+}
+
+// List returns the tokens in the order they were created
+func (s *Store) List() ([]Token, error) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	err := s.load()
+	if err != nil {
+		return nil, err
+	}
+	return append([]Token{}, s.tokens...), nil
+}
+
+// Create adds a token and returns its secret, which can't be retrieved again
+func (s *Store) Create(name string, scopes []Scope) (string, Token, error) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	err := s.load()
+	if err != nil {
+		return "", Token{}, err
+	}
+
+	for _, t := range s.tokens {
+		if name != "" && t.Name == name {
+			return "", Token{}, fmt.Errorf("a token named %s already exists", name)
+		}
+	}
+
+	id, err := randomHex(4)
+	if err != nil {
+		return "", Token{}, err
+	}
+	secret, err := randomHex(16)
+	if err != nil {
+		return "", Token{}, err
+	}
+
+	token := Token{
+		ID:        id,
+		Name:      name,
+		Scopes:    scopes,
+		Hash:      hashSecret(secret),
+		CreatedAt: time.Now(),
+	}
+	tokens := append(append([]Token{}, s.tokens...), token)
+
+	err = s.save(tokens)
+	if err != nil {
+		return "", Token{}, err
+	}
+
+	return tokenPrefix + id + "_" + secret, token, nil
+}
+
+// Revoke deletes the token with the given ID or name
+func (s *Store) Revoke(idOrName string) error {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	err := s.load()
+	if err != nil {
+		return err
+	}
+
+	tokens := []Token{}
+	for _, t := range s.tokens {
+		if t.ID != idOrName && (t.Name == "" || t.Name != idOrName) {
+			tokens = append(tokens, t)
+		}
+	}
+	if len(tokens) == len(s.tokens) {
+		return ErrTokenNotFound
+	}
+
+	return s.save(tokens)
+}
+
+// Authorize returns the scopes granted to a token, false if the token isn't valid
+func (s *Store) Authorize(value string) ([]Scope, bool) {
+	id, secret, ok := strings.Cut(strings.TrimPrefix(value, tokenPrefix), "_")
+	if !ok || !strings.HasPrefix(value, tokenPrefix) {
+		return nil, false
+	}
+
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	err := s.load()
+	if err != nil {
+		log.Errorf("loading tokens: %v", err)
+		return nil, false
+	}
+
+	hash := hashSecret(secret)
+	for _, t := range s.tokens {
+		if t.ID == id && subtle.ConstantTimeCompare([]byte(hash), []byte(t.Hash)) == 1 {
+			return t.Scopes, true
+		}
+	}
+	return nil, false
+}
+
+// load re-reads the tokens file if it has changed since it was last read
+func (s *Store) load() error {
+	info, err := os.Stat(s.path)
+	if os.IsNotExist(err) {
+		s.tokens = []Token{}
+		s.modTime = time.Time{}
+		return nil
+	} else if err != nil {
+		return fmt.Errorf("reading tokens: %w", err)
+	}
+
+	if info.ModTime().Equal(s.modTime) && info.Size() == s.size && s.tokens != nil {
+		return nil
+	}
+
+	buf, err := os.ReadFile(s.path)
+	if err != nil {
+		return fmt.Errorf("reading tokens: %w", err)
+	}
+
+	tokens := []Token{}
+	err = json.Unmarshal(buf, &tokens)
+	if err != nil {
+		return fmt.Errorf("parsing tokens: %w", err)
+	}
+
+	s.tokens = tokens
+	s.modTime = info.ModTime()
+	s.size = info.Size()
+	return nil
+}
+
+// save replaces the tokens file, a temporary file is renamed into place so that a running gomon never reads a
+// partially written file
+func (s *Store) save(tokens []Token) error {
+	err := os.MkdirAll(filepath.Dir(s.path), 0755)
+	if err != nil {
+		return fmt.Errorf("creating .gomon directory: %w", err)
+	}
+
+	buf, err := json.MarshalIndent(tokens, "", "  ")
+	if err != nil {
+		return fmt.Errorf("encoding tokens: %w", err)
+	}
+
+	tmpPath := s.path + ".tmp"
+	err = os.WriteFile(tmpPath, buf, 0600)
+	if err != nil {
+		return fmt.Errorf("writing tokens: %w", err)
+	}
+
+	err = os.Rename(tmpPath, s.path)
+	if err != nil {
+		return fmt.Errorf("writing tokens: %w", err)
+	}
+
+	s.tokens = tokens
+	s.modTime = time.Time{}
+	return nil
+}
+
+func randomHex(n int) (string, error) {
+	buf := make([]byte, n)
+	_, err := rand.Read(buf)
+	if err != nil {
+		return "", fmt.Errorf("generating token: %w", err)
+	}
+	return hex.EncodeToString(buf), nil
+}
+
+func hashSecret(secret string) string {
+	sum := sha256.Sum256([]byte(secret))
+	return hex.EncodeToString(sum[:])
+}
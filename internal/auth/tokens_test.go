@@ -0,0 +1,79 @@
+package auth
+
+// gomon is a simple command line tool that watches your files and automatically restarts the application when it detects any changes in the working directory.
+// Copyright (C) 2023 John Dudmesh
+
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+
+// You should have received a copy of the GNU General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>.
+
+import (
+	"errors"
+	"strings"
+	"testing"
+)
+
+func TestStore(t *testing.T) {
+	rootDirectory := t.TempDir()
+	cli := NewStore(rootDirectory)
+	server := NewStore(rootDirectory)
+
+	if _, ok := server.Authorize("gomon_missing_secret"); ok {
+		t.Error("expected an unknown token to be rejected")
+	}
+
+	secret, token, err := cli.Create("ci", []Scope{ScopeReadEvents, ScopeControlRestart})
+	if err != nil {
+		t.Fatalf("creating token: %v", err)
+	}
+	if !strings.HasPrefix(secret, "gomon_"+token.ID+"_") || strings.Contains(token.Hash, strings.TrimPrefix(secret, "gomon_"+token.ID+"_")) {
+		t.Errorf("unexpected token: %s %+v", secret, token)
+	}
+
+	// tokens created by another process are picked up
+	scopes, ok := server.Authorize(secret)
+	if !ok || !HasScope(scopes, ScopeControlRestart) || HasScope(scopes, ScopeControlTasks) {
+		t.Errorf("unexpected scopes: %v, %v", scopes, ok)
+	}
+	if _, ok := server.Authorize(secret + "x"); ok {
+		t.Error("expected a modified token to be rejected")
+	}
+
+	if _, _, err := cli.Create("ci", []Scope{ScopeReadEvents}); err == nil {
+		t.Error("expected duplicate names to be rejected")
+	}
+
+	err = cli.Revoke("ci")
+	if err != nil {
+		t.Fatalf("revoking token: %v", err)
+	}
+	if _, ok := server.Authorize(secret); ok {
+		t.Error("expected a revoked token to be rejected")
+	}
+	if err := cli.Revoke(token.ID); !errors.Is(err, ErrTokenNotFound) {
+		t.Errorf("expected ErrTokenNotFound, got %v", err)
+	}
+}
+
+func TestParseScopes(t *testing.T) {
+	scopes, err := ParseScopes("read:events, control:tasks,read:events")
+	if err != nil || len(scopes) != 2 || scopes[1] != ScopeControlTasks {
+		t.Errorf("unexpected scopes: %v, %v", scopes, err)
+	}
+
+	if _, err := ParseScopes("write:everything"); err == nil {
+		t.Error("expected an unknown scope to be rejected")
+	}
+	if _, err := ParseScopes(""); err == nil {
+		t.Error("expected at least one scope to be required")
+	}
+}
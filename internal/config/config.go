@@ -20,37 +20,402 @@ import (
 	"fmt"
 	"io"
 	"os"
+	"path/filepath"
+	"reflect"
+	"time"
 
-	log "github.com/sirupsen/logrus"
+	"github.com/sirupsen/logrus"
 	"gopkg.in/yaml.v3"
 )
 
+var log = logrus.WithField("component", "config")
+
 const DefaultConfigFileName = "gomon.config.yml"
 
+const (
+	// RestartBackoff retries the child process with an exponential backoff when it fails, this is the default
+	RestartBackoff = "backoff"
+	// RestartImmediate restarts the child process without delay and waits for a change after a crash
+	RestartImmediate = "immediate"
+	// RestartNever waits for a change whenever the child process exits, even successfully e.g. a database migrator
+	RestartNever = "never"
+)
+
+const (
+	// ReadinessTCP waits for the downstream to accept connections before browsers are told to reload
+	ReadinessTCP = "tcp"
+	// ReadinessHTTP waits for the downstream to respond to a GET request before browsers are told to reload
+	ReadinessHTTP = "http"
+)
+
+const (
+	// ModeServe runs the entrypoint and restarts it when files change, this is the default
+	ModeServe = "serve"
+	// ModeTest runs the tests of the packages affected by each change instead of a child process
+	ModeTest = "test"
+)
+
+const (
+	// TestFilesIgnore doesn't act on changes to test files, this is the default
+	TestFilesIgnore = "ignore"
+	// TestFilesTest tests the package containing a changed test file without restarting the child process
+	TestFilesTest = "test"
+	// TestFilesHardReload applies the reload rules to test files like any other file
+	TestFilesHardReload = "hardReload"
+)
+
+const (
+	// RuntimeLocal runs the child process on the host, this is the default
+	RuntimeLocal = "local"
+	// RuntimeDocker runs the child process in a container with `docker run` or as a `docker compose` service
+	RuntimeDocker = "docker"
+)
+
+const (
+	// IPCTransportUDP is the channel on localhost provided by gomon-ipc, this is the default
+	IPCTransportUDP = "udp"
+	// IPCTransportUnix serves the channel on a unix socket which can be mounted into a container
+	IPCTransportUnix = "unix"
+	// IPCTransportTCP serves the channel on a TCP port which can be reached from another network namespace
+	IPCTransportTCP = "tcp"
+)
+
+const (
+	// InjectHead adds the reload script to the start of <head>, this is the default
+	InjectHead = "head"
+	// InjectBody adds the reload script to the end of <body>
+	InjectBody = "body"
+	// InjectOff doesn't modify responses, the page has to include the reload script itself
+	InjectOff = "off"
+)
+
+const (
+	// StorageSQLite keeps the history in .gomon/gomon.db, this is the default unless gomon was built without cgo
+	StorageSQLite = "sqlite"
+	// StorageJSONL appends the history to .gomon/history.jsonl, it doesn't need cgo
+	StorageJSONL = "jsonl"
+	// StorageMemory keeps the history in memory, it is lost when gomon exits
+	StorageMemory = "memory"
+)
+
+// What happens to the child process's output when the console buffer is full, see limits.consoleOverflow
+const (
+	// ConsoleOverflowDropOldest discards the oldest buffered output to make room, this is the default
+	ConsoleOverflowDropOldest = "dropOldest"
+	// ConsoleOverflowDropNewest discards output written while the buffer is full
+	ConsoleOverflowDropNewest = "dropNewest"
+	// ConsoleOverflowBlock makes the child process wait until there is room, nothing is lost but it stalls the child
+	ConsoleOverflowBlock = "block"
+)
+
+const (
+	DefaultConsoleBuffer = 256
+	DefaultSSEBuffer     = 256
+	DefaultDBBuffer      = 1024
+	// events are written to the database in transactions of up to this many rows, or after this many milliseconds
+	DefaultDBBatchSize     = 500
+	DefaultDBBatchInterval = 50
+	// how often old runs are pruned while gomon is running
+	DefaultRetentionIntervalMinutes = 10
+	// the number of events kept in memory once the database has reached its hard limit
+	DefaultMemoryRingEvents = 10000
+	// seconds between samples of the child process's memory and CPU usage
+	DefaultResourceInterval = 5
+	// the length of CPU profiles captured from the UI in seconds
+	DefaultProfileSeconds = 30
+	// the time between health checks of the child process
+	DefaultHealthInterval = 5 * time.Second
+	// the number of failed health checks in a row before the child process is restarted
+	DefaultHealthFailureThreshold = 3
+)
+
 type Config struct {
-	RootDirectory  string              `yaml:"rootDirectory"`
-	Command        []string            `yaml:"command"`
-	Entrypoint     string              `yaml:"entrypoint"`
-	EntrypointArgs []string            `yaml:"entrypointArgs"`
-	EnvFiles       []string            `yaml:"envFiles"`
-	ExcludePaths   []string            `yaml:"excludePaths"`
-	HardReload     []string            `yaml:"hardReload"`
-	SoftReload     []string            `yaml:"softReload"`
-	Generated      map[string][]string `yaml:"generated"`
-	Prestart       []string            `yaml:"prestart"`
-	ProxyOnly      bool                `yaml:"proxyOnly"`
-	Proxy          struct {
+	ConfigPath string `yaml:"-"`
+	// CommandLine is the settings given on the command line, which are applied again each time the config file is
+	// reloaded
+	CommandLine    CommandLine              `yaml:"-"`
+	RootDirectory  string                   `yaml:"rootDirectory"`
+	Command        []string                 `yaml:"command"`
+	Entrypoint     string                   `yaml:"entrypoint"`
+	EntrypointArgs []string                 `yaml:"entrypointArgs"`
+	EnvFiles       []string                 `yaml:"envFiles"`
+	ExcludePaths   []string                 `yaml:"excludePaths"`
+	Roots          map[string]string        `yaml:"roots"`
+	Workspace      bool                     `yaml:"workspace"`
+	HardReload     []string                 `yaml:"hardReload"`
+	SoftReload     []string                 `yaml:"softReload"`
+	Generated      map[string]GeneratedRule `yaml:"generated"`
+	Pipelines      map[string][]string      `yaml:"pipelines"`
+	Prestart       []Task                   `yaml:"prestart"`
+	Checks         []string                 `yaml:"checks"`
+	DependsOn      []string                 `yaml:"dependsOn"`
+	Services       map[string]Service       `yaml:"services"`
+	Tasks          map[string]string        `yaml:"tasks"`
+	TaskTimeout    Duration                 `yaml:"taskTimeout"`
+	ProxyOnly      bool                     `yaml:"proxyOnly"`
+	LogFormat      string                   `yaml:"logFormat"`
+	TUI            bool                     `yaml:"tui"`
+	StatusLine     bool                     `yaml:"statusLine"`
+	Restart        string                   `yaml:"restart"`
+	Profile        string                   `yaml:"profile"`
+	Mode           string                   `yaml:"mode"`
+	Runtime        string                   `yaml:"runtime"`
+	AutoPorts      bool                     `yaml:"autoPorts"`
+	Hooks          struct {
+		PreStop   []string `yaml:"preStop"`
+		PostStop  []string `yaml:"postStop"`
+		PostStart []string `yaml:"postStart"`
+	} `yaml:"hooks"`
+	Watcher struct {
+		UseGitignore bool           `yaml:"useGitignore"`
+		Agents       []WatcherAgent `yaml:"agents"`
+		// PollOverflow polls the directories which can't be watched once the inotify watch limit is reached
+		PollOverflow bool `yaml:"pollOverflow"`
+		// PollInterval is in milliseconds
+		PollInterval int `yaml:"pollInterval"`
+		// GoModuleAware only watches the package directories the entrypoint depends on for hard reloads
+		GoModuleAware bool `yaml:"goModuleAware"`
+		// TestFiles is the action taken when a *_test.go file changes, they don't affect the running program so by
+		// default they are ignored
+		TestFiles string `yaml:"testFiles"`
+	} `yaml:"watcher"`
+	// Templates are reloaded by the child process when they change instead of restarting it, see pkg/templates
+	Templates struct {
+		// Paths are patterns of template files, they are matched in the same way as softReload
+		Paths []string `yaml:"paths"`
+		// Signal is sent to the child process on each soft reload as well as the IPC message e.g. SIGUSR1, for
+		// programs which reload their templates on a signal
+		Signal string `yaml:"signal"`
+	} `yaml:"templates"`
+	Test struct {
+		// Packages are the patterns passed to `go test`, defaults to ./...
+		Packages []string `yaml:"packages"`
+		Flags    []string `yaml:"flags"`
+	} `yaml:"test"`
+	Build struct {
+		Enabled bool     `yaml:"enabled"`
+		Output  string   `yaml:"output"`
+		Flags   []string `yaml:"flags"`
+	} `yaml:"build"`
+	Process struct {
+		KillTimeout int    `yaml:"killTimeout"`
+		StopSignal  string `yaml:"stopSignal"`
+		// Socket is a listening socket opened by gomon and passed to each run of the child process
+		Socket struct {
+			Addr string `yaml:"addr"`
+			Env  string `yaml:"env"`
+		} `yaml:"socket"`
+	} `yaml:"process"`
+	// Health restarts the child process when it stops responding even though it is still running e.g. a deadlock
+	Health struct {
+		// URL is requested on each check, any response other than a 4xx or 5xx status is healthy
+		URL      string   `yaml:"url"`
+		Interval Duration `yaml:"interval"`
+		// FailureThreshold is the number of checks in a row which must fail before the child process is restarted
+		FailureThreshold int `yaml:"failureThreshold"`
+	} `yaml:"health"`
+	// IPC is how the child process connects to gomon, the default UDP channel can't be reached from a container
+	IPC struct {
+		// Transport is udp, unix or tcp
+		Transport string `yaml:"transport"`
+		// Address is the socket path for unix, relative to the root directory, or the host:port to listen on for tcp
+		Address string `yaml:"address"`
+		// Advertise is the address given to the child process if it differs e.g. host.docker.internal:33333
+		Advertise string `yaml:"advertise"`
+		// Token is sent by the child process when it connects, a random one is generated if it isn't set
+		Token string `yaml:"token"`
+	} `yaml:"ipc"`
+	// Resources samples the memory and CPU used by each run so they can be charted in the UI
+	Resources struct {
+		Enabled bool `yaml:"enabled"`
+		// Interval is in seconds
+		Interval int `yaml:"interval"`
+		// Expvar is the URL of the child's expvar handler e.g. http://localhost:8081/debug/vars, the goroutine count
+		// and heap size are read from it if it is set
+		Expvar string `yaml:"expvar"`
+	} `yaml:"resources"`
+	Docker struct {
+		// Image is run with the root directory mounted at Workdir, Args are extra `docker run` flags e.g. ports
+		Image   string   `yaml:"image"`
+		Workdir string   `yaml:"workdir"`
+		Args    []string `yaml:"args"`
+		// Compose and Service run a service from a compose file instead of an image
+		Compose string `yaml:"compose"`
+		Service string `yaml:"service"`
+	} `yaml:"docker"`
+	Proxy struct {
 		Enabled    bool `yaml:"enabled"`
 		Port       int  `yaml:"port"`
 		Downstream struct {
-			Host    string `yaml:"host"`
-			Timeout int    `yaml:"timeout"`
+			Host        string `yaml:"host"`
+			Timeout     int    `yaml:"timeout"`
+			GracePeriod int    `yaml:"gracePeriod"`
 		} `yaml:"downstream"`
+		TLS struct {
+			Cert               string `yaml:"cert"`
+			Key                string `yaml:"key"`
+			InsecureSkipVerify bool   `yaml:"insecureSkipVerify"`
+		} `yaml:"tls"`
+		Readiness struct {
+			Check    string `yaml:"check"`
+			Path     string `yaml:"path"`
+			Interval int    `yaml:"interval"`
+			Retries  int    `yaml:"retries"`
+		} `yaml:"readiness"`
+		Rewrite []RewriteRule `yaml:"rewrite"`
+		// Inject is where the reload script is added to HTML pages
+		Inject string `yaml:"inject"`
 	} `yaml:"proxy"`
 	UI struct {
-		Enabled bool `yaml:"enabled"`
-		Port    int  `yaml:"port"`
+		Enabled      bool   `yaml:"enabled"`
+		Port         int    `yaml:"port"`
+		MountOnProxy bool   `yaml:"mountOnProxy"`
+		APIToken     string `yaml:"apiToken"`
+		EditorURL    string `yaml:"editorURL"`
+		// RequireToken protects the UI and the live event stream with the same scoped tokens as the API
+		RequireToken bool `yaml:"requireToken"`
+		// Auth protects the UI, its actions and the live event stream with a static token or basic auth, values can
+		// be secret references
+		Auth struct {
+			Token    string `yaml:"token"`
+			Username string `yaml:"username"`
+			Password string `yaml:"password"`
+		} `yaml:"auth"`
+		// StripANSI removes colour codes from the child's output instead of rendering them
+		StripANSI bool `yaml:"stripANSI"`
+		// Pprof is the child's server which serves net/http/pprof e.g. http://localhost:8081, the UI proxies its
+		// /debug/pprof pages and captures CPU profiles from it
+		Pprof struct {
+			URL string `yaml:"url"`
+			// Seconds is the length of the CPU profiles captured from the UI
+			Seconds int `yaml:"seconds"`
+		} `yaml:"pprof"`
+		Retention struct {
+			MaxRuns    int `yaml:"maxRuns"`
+			MaxAgeDays int `yaml:"maxAgeDays"`
+			MaxSizeMB  int `yaml:"maxSizeMB"`
+			// HardLimitMB stops the database growing any further, events are kept in memory once it is reached
+			HardLimitMB int `yaml:"hardLimitMB"`
+			// IntervalMinutes is how often pruning runs, -1 only prunes at startup
+			IntervalMinutes int `yaml:"intervalMinutes"`
+		} `yaml:"retention"`
 	} `yaml:"ui"`
+	// Storage is where the history of events is kept
+	Storage struct {
+		// Type is one of the Storage* backends
+		Type string `yaml:"type"`
+	} `yaml:"storage"`
+	Limits struct {
+		ConsoleBuffer int `yaml:"consoleBuffer"`
+		// ConsoleOverflow is one of the ConsoleOverflow* policies
+		ConsoleOverflow string `yaml:"consoleOverflow"`
+		SSEBuffer       int    `yaml:"sseBuffer"`
+		DBBuffer        int    `yaml:"dbBuffer"`
+		// DBBatchSize is the most events written in one transaction, DBBatchInterval is the longest an event waits
+		// for the rest of its batch in milliseconds
+		DBBatchSize     int `yaml:"dbBatchSize"`
+		DBBatchInterval int `yaml:"dbBatchInterval"`
+	} `yaml:"limits"`
+	Notifications struct {
+		Sinks []NotificationSink `yaml:"sinks"`
+	} `yaml:"notifications"`
+	// LogForwarding sends every line of the child process's output to log collectors outside gomon
+	LogForwarding []LogSink `yaml:"logForwarding"`
+	// Tracing exports a trace of each hard restart to an OpenTelemetry collector
+	Tracing struct {
+		Enabled bool `yaml:"enabled"`
+		// URL is the OTLP/HTTP traces endpoint, it defaults to $OTEL_EXPORTER_OTLP_ENDPOINT/v1/traces or
+		// http://localhost:4318/v1/traces and can be a secret reference
+		URL string `yaml:"url"`
+		// Headers are added to each export e.g. for authentication, the values can be secret references
+		Headers map[string]string `yaml:"headers"`
+		// ServiceName defaults to gomon
+		ServiceName string `yaml:"serviceName"`
+		// Attributes are added to the resource of every trace e.g. the team or developer
+		Attributes map[string]string `yaml:"attributes"`
+	} `yaml:"tracing"`
+	// Plugins are programs which gomon starts alongside the child process and sends its events to
+	Plugins []Plugin `yaml:"plugins"`
+}
+
+// Plugin is a program which is sent gomon's events as lines of JSON on its stdin, and can ask for restarts and
+// tasks by writing lines of JSON to its stdout. It is restarted if it exits while gomon is running.
+type Plugin struct {
+	// Name is used in logs, it defaults to the name of the program
+	Name string `yaml:"name"`
+	// Command is run in the root directory, it is split on spaces like a task
+	Command string `yaml:"command"`
+	// Events are the notification types which are sent e.g. crash or buildError, every event is sent if it is empty
+	Events []string `yaml:"events"`
+	// Env is added to the plugin's environment, the values can be secret references
+	Env map[string]string `yaml:"env"`
+}
+
+// WatcherAgent is a `gomon agent` which streams file changes from another machine e.g. a VM or container where
+// the code lives
+type WatcherAgent struct {
+	URL   string `yaml:"url"`
+	Token string `yaml:"token"`
+	// Root is the local directory the agent's paths are relative to, defaults to the root directory
+	Root string `yaml:"root"`
+}
+
+const (
+	SinkTypeWebhook = "webhook"
+	SinkTypeSlack   = "slack"
+	SinkTypeDesktop = "desktop"
+)
+
+// NotificationSink forwards selected events to somewhere outside gomon so the user hears about them while they are
+// away from the terminal
+type NotificationSink struct {
+	// Type is one of webhook, slack or desktop
+	Type string `yaml:"type"`
+	// URL is the webhook URL for webhook and slack sinks, it can be a secret reference e.g. env://SLACK_WEBHOOK
+	URL string `yaml:"url"`
+	// Events are the notification types which are sent e.g. crash or buildError, crashLoop is sent when the child
+	// process keeps crashing
+	Events []string `yaml:"events"`
+	// ThrottleSeconds is the minimum time between notifications of the same type
+	ThrottleSeconds int `yaml:"throttleSeconds"`
+}
+
+const (
+	LogSinkTypeFile   = "file"
+	LogSinkTypeSyslog = "syslog"
+	LogSinkTypeLoki   = "loki"
+	LogSinkTypeOTLP   = "otlp"
+)
+
+// The size a forwarded log file is rotated at and the number of rotated files kept
+const (
+	DefaultLogFileMaxSizeMB = 10
+	DefaultLogFileMaxFiles  = 5
+)
+
+// The OTLP/HTTP traces endpoint and service name used when tracing doesn't set them
+const (
+	DefaultTracingURL         = "http://localhost:4318/v1/traces"
+	DefaultTracingServiceName = "gomon"
+)
+
+// LogSink forwards the child process's output to a rotating file, syslog, Grafana Loki or an OTLP collector
+type LogSink struct {
+	// Type is one of file, syslog, loki or otlp
+	Type string `yaml:"type"`
+	// Path is the directory a file sink writes to, relative to the root directory, defaults to .gomon/logs
+	Path string `yaml:"path"`
+	// MaxSizeMB is the size a file is rotated at and MaxFiles is the number of rotated files kept
+	MaxSizeMB int `yaml:"maxSizeMB"`
+	MaxFiles  int `yaml:"maxFiles"`
+	// Address is the syslog server e.g. udp://localhost:514, the local syslog daemon is used if it is empty
+	Address string `yaml:"address"`
+	// URL is the Loki push endpoint or the OTLP/HTTP logs endpoint, it can be a secret reference
+	URL string `yaml:"url"`
+	// Headers are added to Loki and OTLP requests e.g. for authentication, the values can be secret references
+	Headers map[string]string `yaml:"headers"`
+	// Labels are added to Loki streams and to the OTLP resource
+	Labels map[string]string `yaml:"labels"`
 }
 
 var defaultConfig = Config{
@@ -93,9 +458,197 @@ func New(configPath string) (Config, error) {
 		cfg.ExcludePaths = append(cfg.ExcludePaths, ".gomon")
 	}
 
+	cfg.ConfigPath = configPath
+
 	return cfg, nil
 }
 
+// Load reads the config file, looking for one in the root directory if a path isn't specified. The root directory
+// defaults to the current directory.
+func Load(configPath, rootDirectory string) (Config, error) {
+	if rootDirectory == "" {
+		curDir, err := os.Getwd()
+		if err != nil {
+			return Config{}, fmt.Errorf("getting current directory: %w", err)
+		}
+		rootDirectory = curDir
+	}
+
+	if configPath == "" {
+		nextConfigPath := filepath.Join(rootDirectory, DefaultConfigFileName)
+		if _, err := os.Stat(nextConfigPath); err == nil {
+			configPath = nextConfigPath
+		} else if !os.IsNotExist(err) {
+			return Config{}, fmt.Errorf("checking for default config file: %w", err)
+		}
+	}
+
+	cfg, err := New(configPath)
+	if err != nil {
+		return Config{}, err
+	}
+
+	if cfg.RootDirectory == "" {
+		cfg.RootDirectory = rootDirectory
+	}
+
+	return cfg, nil
+}
+
+// Reload re-reads the config file. Settings which can only be applied when gomon starts are kept from
+// the current config and the names of any which have changed are returned so the user can be warned.
+func Reload(current Config) (Config, []string, error) {
+	if current.ConfigPath == "" {
+		return current, nil, nil
+	}
+
+	next, err := New(current.ConfigPath)
+	if err != nil {
+		return current, nil, err
+	}
+
+	// the ports may have been moved to free ones when gomon started
+	if current.AutoPorts {
+		next.Proxy.Port = current.Proxy.Port
+		next.UI.Port = current.UI.Port
+	}
+
+	ignored := []string{}
+	if !reflect.DeepEqual(next.Proxy, current.Proxy) {
+		ignored = append(ignored, "proxy")
+	}
+	if !reflect.DeepEqual(next.UI, current.UI) {
+		ignored = append(ignored, "ui")
+	}
+	if next.Storage != current.Storage {
+		ignored = append(ignored, "storage")
+	}
+	if !reflect.DeepEqual(next.Limits, current.Limits) {
+		ignored = append(ignored, "limits")
+	}
+	if !reflect.DeepEqual(next.Build, current.Build) {
+		ignored = append(ignored, "build")
+	}
+	if !reflect.DeepEqual(next.Watcher.Agents, current.Watcher.Agents) {
+		ignored = append(ignored, "watcher.agents")
+	}
+	if next.Watcher.PollOverflow != current.Watcher.PollOverflow || next.Watcher.PollInterval != current.Watcher.PollInterval {
+		ignored = append(ignored, "watcher.pollOverflow")
+	}
+	if !reflect.DeepEqual(next.Notifications, current.Notifications) {
+		ignored = append(ignored, "notifications")
+	}
+	if !reflect.DeepEqual(next.LogForwarding, current.LogForwarding) {
+		ignored = append(ignored, "logForwarding")
+	}
+	if !reflect.DeepEqual(next.Tracing, current.Tracing) {
+		ignored = append(ignored, "tracing")
+	}
+	if !reflect.DeepEqual(next.Plugins, current.Plugins) {
+		ignored = append(ignored, "plugins")
+	}
+	// the mode and test packages can also be set on the command line with `gomon test`
+	if next.Mode != "" && next.Mode != current.Mode {
+		ignored = append(ignored, "mode")
+	}
+	if !reflect.DeepEqual(next.Test.Flags, current.Test.Flags) || (len(next.Test.Packages) > 0 && !reflect.DeepEqual(next.Test.Packages, current.Test.Packages)) {
+		ignored = append(ignored, "test")
+	}
+	if next.TUI != current.TUI {
+		ignored = append(ignored, "tui")
+	}
+	if next.StatusLine != current.StatusLine {
+		ignored = append(ignored, "statusLine")
+	}
+	if next.Process.Socket != current.Process.Socket {
+		ignored = append(ignored, "process.socket")
+	}
+	if !reflect.DeepEqual(next.DependsOn, current.DependsOn) {
+		ignored = append(ignored, "dependsOn")
+	}
+	if next.AutoPorts != current.AutoPorts {
+		ignored = append(ignored, "autoPorts")
+	}
+	// the token may have been generated when gomon started
+	if next.IPC.Transport != current.IPC.Transport || next.IPC.Address != current.IPC.Address || next.IPC.Advertise != current.IPC.Advertise ||
+		(next.IPC.Token != "" && next.IPC.Token != current.IPC.Token) {
+		ignored = append(ignored, "ipc")
+	}
+
+	next.Proxy = current.Proxy
+	next.UI = current.UI
+	next.Storage = current.Storage
+	next.Limits = current.Limits
+	next.Build = current.Build
+	next.Watcher.Agents = current.Watcher.Agents
+	next.Watcher.PollOverflow = current.Watcher.PollOverflow
+	next.Watcher.PollInterval = current.Watcher.PollInterval
+	next.Notifications = current.Notifications
+	next.LogForwarding = current.LogForwarding
+	next.Tracing = current.Tracing
+	next.Plugins = current.Plugins
+	next.Mode = current.Mode
+	next.Test = current.Test
+	next.TUI = current.TUI
+	next.StatusLine = current.StatusLine
+	next.Process.Socket = current.Process.Socket
+	next.DependsOn = current.DependsOn
+	next.AutoPorts = current.AutoPorts
+	next.IPC = current.IPC
+	next.LogFormat = current.LogFormat
+	next.ProxyOnly = current.ProxyOnly
+
+	// the root directory can't be moved once gomon has started
+	next.RootDirectory = current.RootDirectory
+	// settings given on the command line take priority over the file, the others can be changed or cleared in it
+	next.CommandLine = current.CommandLine
+	next.ApplyCommandLine()
+
+	return next, ignored, nil
+}
+
+// CommandLine is the settings which can be given on the command line as well as in the config file, empty ones
+// weren't given
+type CommandLine struct {
+	Entrypoint     string
+	EntrypointArgs []string
+	EnvFiles       []string
+	Profile        string
+}
+
+// ApplyCommandLine overrides the settings from the config file with the ones given on the command line
+func (c *Config) ApplyCommandLine() {
+	if c.CommandLine.Entrypoint != "" {
+		c.Entrypoint = c.CommandLine.Entrypoint
+	}
+	if len(c.CommandLine.EntrypointArgs) > 0 {
+		c.EntrypointArgs = c.CommandLine.EntrypointArgs
+	}
+	if len(c.CommandLine.EnvFiles) > 0 {
+		c.EnvFiles = c.CommandLine.EnvFiles
+	}
+	if c.CommandLine.Profile != "" {
+		c.Profile = c.CommandLine.Profile
+	}
+}
+
+// ChildProcessChanged returns true if the settings used to start the child process differ
+func ChildProcessChanged(a, b Config) bool {
+	return !reflect.DeepEqual(a.Command, b.Command) ||
+		a.Entrypoint != b.Entrypoint ||
+		a.Profile != b.Profile ||
+		a.Workspace != b.Workspace ||
+		!reflect.DeepEqual(a.EntrypointArgs, b.EntrypointArgs) ||
+		!reflect.DeepEqual(a.EnvFiles, b.EnvFiles) ||
+		!reflect.DeepEqual(a.Prestart, b.Prestart) ||
+		!reflect.DeepEqual(a.Hooks, b.Hooks) ||
+		!reflect.DeepEqual(a.Process, b.Process) ||
+		a.Runtime != b.Runtime ||
+		!reflect.DeepEqual(a.Docker, b.Docker) ||
+		!reflect.DeepEqual(a.Templates, b.Templates) ||
+		a.Health != b.Health
+}
+
 func findIndex(array []string, target string) int {
 	for i, value := range array {
 		if value == target {
@@ -0,0 +1,55 @@
+package config
+
+// gomon is a simple command line tool that watches your files and automatically restarts the application when it detects any changes in the working directory.
+// Copyright (C) 2023 John Dudmesh
+
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+
+// You should have received a copy of the GNU General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>.
+
+import (
+	"os"
+	"path/filepath"
+	"slices"
+	"testing"
+)
+
+func TestReloadKeepsCommandLine(t *testing.T) {
+	dir := t.TempDir()
+	configPath := filepath.Join(dir, DefaultConfigFileName)
+	os.WriteFile(configPath, []byte("entrypoint: ./cmd/web\nenvFiles: [.env]\n"), 0644)
+
+	current, err := Load(configPath, dir)
+	if err != nil {
+		t.Fatalf("loading config: %v", err)
+	}
+	current.CommandLine.Entrypoint = "./cmd/other"
+	current.ApplyCommandLine()
+
+	os.WriteFile(configPath, []byte("entrypoint: ./cmd/web\nentrypointArgs: [--debug]\n"), 0644)
+	next, _, err := Reload(current)
+	if err != nil {
+		t.Fatalf("reloading config: %v", err)
+	}
+	if next.Entrypoint != "./cmd/other" {
+		t.Errorf("expected the entrypoint from the command line, got %s", next.Entrypoint)
+	}
+	if !slices.Equal(next.EntrypointArgs, []string{"--debug"}) {
+		t.Errorf("expected the entrypoint args from the file, got %v", next.EntrypointArgs)
+	}
+	if len(next.EnvFiles) != 0 {
+		t.Errorf("expected the env files to be cleared, got %v", next.EnvFiles)
+	}
+	if next.RootDirectory != dir {
+		t.Errorf("expected the root directory to be kept, got %s", next.RootDirectory)
+	}
+}
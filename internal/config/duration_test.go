@@ -0,0 +1,48 @@
+package config
+
+// gomon is a simple command line tool that watches your files and automatically restarts the application when it detects any changes in the working directory.
+// Copyright (C) 2023 John Dudmesh
+
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+
+// You should have received a copy of the GNU General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>.
+
+import (
+	"testing"
+	"time"
+
+	"gopkg.in/yaml.v3"
+)
+
+func TestDurationUnmarshal(t *testing.T) {
+	tests := map[string]time.Duration{
+		"5s":    5 * time.Second,
+		"500ms": 500 * time.Millisecond,
+		"10":    10 * time.Second,
+	}
+	for value, expected := range tests {
+		cfg := Config{}
+		err := yaml.Unmarshal([]byte("health:\n  interval: "+value), &cfg)
+		if err != nil {
+			t.Errorf("parsing %s: %v", value, err)
+			continue
+		}
+		if time.Duration(cfg.Health.Interval) != expected {
+			t.Errorf("expected %s to be %v, got %v", value, expected, time.Duration(cfg.Health.Interval))
+		}
+	}
+
+	cfg := Config{}
+	if err := yaml.Unmarshal([]byte("health:\n  interval: soon"), &cfg); err == nil {
+		t.Error("expected an error for an invalid duration")
+	}
+}
@@ -0,0 +1,38 @@
+package config
+
+// gomon is a simple command line tool that watches your files and automatically restarts the application when it detects any changes in the working directory.
+// Copyright (C) 2023 John Dudmesh
+
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+
+// You should have received a copy of the GNU General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>.
+
+const (
+	// CookieSecureKeep leaves the Secure attribute as set by the downstream, this is the default
+	CookieSecureKeep = "keep"
+	// CookieSecureAuto removes the Secure attribute when the proxy is served over plain http
+	CookieSecureAuto = "auto"
+	// CookieSecureStrip always removes the Secure attribute
+	CookieSecureStrip = "strip"
+	// CookieSecureForce always sets the Secure attribute
+	CookieSecureForce = "force"
+)
+
+// RewriteRule adjusts the redirects and cookies returned by the downstream for requests under Path so that
+// they refer to the proxy rather than the downstream server. When several rules match the longest Path wins.
+type RewriteRule struct {
+	Path         string `yaml:"path"`
+	Location     bool   `yaml:"location"`
+	Cookies      bool   `yaml:"cookies"`
+	CookieDomain string `yaml:"cookieDomain"`
+	CookieSecure string `yaml:"cookieSecure"`
+}
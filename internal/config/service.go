@@ -0,0 +1,102 @@
+package config
+
+// gomon is a simple command line tool that watches your files and automatically restarts the application when it detects any changes in the working directory.
+// Copyright (C) 2023 John Dudmesh
+
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+
+// You should have received a copy of the GNU General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>.
+
+import (
+	"fmt"
+	"slices"
+	"sort"
+	"strings"
+)
+
+const (
+	// ServiceReadyRunning makes a service ready once its child process is running and its health.url, if it has one,
+	// responds. This is the default.
+	ServiceReadyRunning = "running"
+	// ServiceReadyExit makes a service ready once its child process has exited successfully e.g. a database migrator
+	ServiceReadyExit = "exit"
+)
+
+// Service is one of the projects of a monorepo run by `gomon services`. Each service is run as if gomon had been
+// started in its directory, using the gomon.config.yml there.
+type Service struct {
+	// Directory is the service's root directory relative to the root directory, it defaults to the service's name
+	Directory string `yaml:"directory"`
+	// DependsOn is the services which must be ready before this one is started, it is restarted when they are
+	DependsOn []string `yaml:"dependsOn"`
+	// Ready is running or exit, it decides when the services which depend on this one can start
+	Ready string `yaml:"ready"`
+}
+
+// ServiceOrder returns the names of the services in the order they are started, every service comes after the
+// services it depends on. An error is returned if a service depends on one which doesn't exist, the dependencies
+// form a cycle or a service's ready setting isn't supported.
+func ServiceOrder(services map[string]Service) ([]string, error) {
+	names := make([]string, 0, len(services))
+	for name, s := range services {
+		switch s.Ready {
+		case "", ServiceReadyRunning, ServiceReadyExit:
+		default:
+			return nil, fmt.Errorf("unsupported ready setting for service %s: %s", name, s.Ready)
+		}
+		for _, dep := range s.DependsOn {
+			if _, ok := services[dep]; !ok {
+				return nil, fmt.Errorf("service %s depends on %s which isn't a service", name, dep)
+			}
+		}
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	order := []string{}
+	visited := map[string]bool{}
+	// path is the chain of dependencies being followed, a service which is already on it is a cycle
+	path := []string{}
+	var visit func(name string) error
+	visit = func(name string) error {
+		if ix := slices.Index(path, name); ix >= 0 {
+			return fmt.Errorf("services depend on each other: %s", strings.Join(append(path[ix:], name), " -> "))
+		}
+		if visited[name] {
+			return nil
+		}
+
+		path = append(path, name)
+		deps := slices.Clone(services[name].DependsOn)
+		sort.Strings(deps)
+		for _, dep := range deps {
+			err := visit(dep)
+			if err != nil {
+				return err
+			}
+		}
+		path = path[:len(path)-1]
+
+		visited[name] = true
+		order = append(order, name)
+		return nil
+	}
+
+	for _, name := range names {
+		err := visit(name)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return order, nil
+}
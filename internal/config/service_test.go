@@ -0,0 +1,72 @@
+package config
+
+// gomon is a simple command line tool that watches your files and automatically restarts the application when it detects any changes in the working directory.
+// Copyright (C) 2023 John Dudmesh
+
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+
+// You should have received a copy of the GNU General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>.
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+
+	"gopkg.in/yaml.v3"
+)
+
+func TestServiceOrder(t *testing.T) {
+	cfg := Config{}
+	err := yaml.Unmarshal([]byte(`
+services:
+  worker:
+    dependsOn: [api, migrator]
+  api:
+    directory: services/api
+    dependsOn: [migrator]
+  migrator:
+    ready: exit
+  docs: {}
+`), &cfg)
+	if err != nil {
+		t.Fatalf("parsing config: %v", err)
+	}
+
+	order, err := ServiceOrder(cfg.Services)
+	if err != nil {
+		t.Fatalf("ordering services: %v", err)
+	}
+	expected := []string{"migrator", "api", "docs", "worker"}
+	if !reflect.DeepEqual(order, expected) {
+		t.Errorf("expected %v, got %v", expected, order)
+	}
+
+	tests := []struct {
+		services map[string]Service
+		expected string
+	}{
+		{map[string]Service{"api": {DependsOn: []string{"db"}}}, "api depends on db which isn't a service"},
+		{map[string]Service{"api": {Ready: "healthy"}}, "unsupported ready setting for service api: healthy"},
+		{map[string]Service{
+			"api":    {DependsOn: []string{"worker"}},
+			"worker": {DependsOn: []string{"queue"}},
+			"queue":  {DependsOn: []string{"worker"}},
+		}, "services depend on each other: worker -> queue -> worker"},
+		{map[string]Service{"api": {DependsOn: []string{"api"}}}, "services depend on each other: api -> api"},
+	}
+	for _, tt := range tests {
+		_, err := ServiceOrder(tt.services)
+		if err == nil || !strings.Contains(err.Error(), tt.expected) {
+			t.Errorf("expected %q, got %v", tt.expected, err)
+		}
+	}
+}
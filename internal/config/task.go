@@ -0,0 +1,108 @@
+package config
+
+// gomon is a simple command line tool that watches your files and automatically restarts the application when it detects any changes in the working directory.
+// Copyright (C) 2023 John Dudmesh
+
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+
+// You should have received a copy of the GNU General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>.
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+
+	"gopkg.in/yaml.v3"
+)
+
+const (
+	// OnFailureAbort stops the restart when the task fails, this is the default
+	OnFailureAbort = "abort"
+	// OnFailureContinue logs the failure and carries on with the restart
+	OnFailureContinue = "continue"
+	// OnFailureRetry runs the task again, up to the number of times given e.g. retry(3)
+	OnFailureRetry = "retry"
+)
+
+var retryPolicyPattern = regexp.MustCompile(`^retry\((\d+)\)$`)
+
+// Task is a command run by gomon. In the config file it can be written as a plain string or as a mapping
+// with a failure policy e.g. {command: "docker pull postgres", onFailure: "retry(3)"}. A prestart task can also
+// be a list of tasks which are run in parallel e.g. ["sqlc generate", "templ generate"].
+type Task struct {
+	Command   string `yaml:"command"`
+	OnFailure string `yaml:"onFailure"`
+	// Group is the tasks run in parallel if the task was written as a list, Command is empty
+	Group []Task `yaml:"-"`
+}
+
+func (t *Task) UnmarshalYAML(value *yaml.Node) error {
+	switch value.Kind {
+	case yaml.ScalarNode:
+		t.Command = value.Value
+		return nil
+	case yaml.SequenceNode:
+		return value.Decode(&t.Group)
+	}
+
+	type plain Task
+	return value.Decode((*plain)(t))
+}
+
+// GeneratedRule is the tasks run when the source of a generated file changes. In the config file it can be written as
+// a list of tasks or as a mapping which also lists the files the tasks write, changes to those files are ignored so
+// that they don't trigger the rule again e.g. {tasks: ["templ generate", "__hard_reload"], outputs: ["*_templ.go"]}.
+// If Chain is set the outputs are passed on to the rules which match them once the tasks have run instead, e.g. the
+// Go files written by `buf generate` trigger the hardReload rule for *.go.
+type GeneratedRule struct {
+	// Name is shown in place of the pattern in logs and triggers
+	Name    string   `yaml:"name"`
+	Tasks   []string `yaml:"tasks"`
+	Outputs []string `yaml:"outputs"`
+	Chain   bool     `yaml:"chain"`
+}
+
+func (g *GeneratedRule) UnmarshalYAML(value *yaml.Node) error {
+	if value.Kind == yaml.SequenceNode {
+		return value.Decode(&g.Tasks)
+	}
+
+	type plain GeneratedRule
+	return value.Decode((*plain)(g))
+}
+
+// FailurePolicy is the parsed form of a task's onFailure setting
+type FailurePolicy struct {
+	Action  string
+	Retries int
+}
+
+func (t Task) FailurePolicy() (FailurePolicy, error) {
+	switch t.OnFailure {
+	case "", OnFailureAbort:
+		return FailurePolicy{Action: OnFailureAbort}, nil
+	case OnFailureContinue:
+		return FailurePolicy{Action: OnFailureContinue}, nil
+	}
+
+	match := retryPolicyPattern.FindStringSubmatch(t.OnFailure)
+	if match == nil {
+		return FailurePolicy{}, fmt.Errorf("unsupported onFailure policy for %s: %s", t.Command, t.OnFailure)
+	}
+
+	retries, err := strconv.Atoi(match[1])
+	if err != nil || retries < 1 {
+		return FailurePolicy{}, fmt.Errorf("retry count must be at least 1 for %s: %s", t.Command, t.OnFailure)
+	}
+
+	return FailurePolicy{Action: OnFailureRetry, Retries: retries}, nil
+}
@@ -0,0 +1,101 @@
+package config
+
+// gomon is a simple command line tool that watches your files and automatically restarts the application when it detects any changes in the working directory.
+// Copyright (C) 2023 John Dudmesh
+
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+
+// You should have received a copy of the GNU General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>.
+
+import (
+	"testing"
+
+	"gopkg.in/yaml.v3"
+)
+
+func TestTaskUnmarshal(t *testing.T) {
+	cfg := Config{}
+	err := yaml.Unmarshal([]byte(`
+prestart:
+  - go generate ./...
+  - command: docker pull postgres
+    onFailure: retry(3)
+`), &cfg)
+	if err != nil {
+		t.Fatalf("parsing config: %v", err)
+	}
+
+	if len(cfg.Prestart) != 2 {
+		t.Fatalf("expected 2 prestart tasks, got %d", len(cfg.Prestart))
+	}
+
+	if cfg.Prestart[0].Command != "go generate ./..." || cfg.Prestart[0].OnFailure != "" {
+		t.Errorf("unexpected task: %+v", cfg.Prestart[0])
+	}
+
+	policy, err := cfg.Prestart[1].FailurePolicy()
+	if err != nil {
+		t.Fatalf("parsing failure policy: %v", err)
+	}
+	if policy.Action != OnFailureRetry || policy.Retries != 3 {
+		t.Errorf("unexpected policy: %+v", policy)
+	}
+
+	_, err = Task{Command: "make", OnFailure: "ignore"}.FailurePolicy()
+	if err == nil {
+		t.Error("expected an error for an unsupported policy")
+	}
+}
+
+func TestTaskGroupUnmarshal(t *testing.T) {
+	cfg := Config{}
+	err := yaml.Unmarshal([]byte(`
+prestart: [["sqlc generate", {command: templ generate, onFailure: continue}], ["go vet ./..."]]
+`), &cfg)
+	if err != nil {
+		t.Fatalf("parsing config: %v", err)
+	}
+
+	if len(cfg.Prestart) != 2 || len(cfg.Prestart[0].Group) != 2 || len(cfg.Prestart[1].Group) != 1 {
+		t.Fatalf("expected groups of 2 and 1 tasks, got %+v", cfg.Prestart)
+	}
+
+	group := cfg.Prestart[0].Group
+	if group[0].Command != "sqlc generate" || group[1].Command != "templ generate" || group[1].OnFailure != OnFailureContinue {
+		t.Errorf("unexpected group: %+v", group)
+	}
+}
+
+func TestGeneratedRuleUnmarshal(t *testing.T) {
+	cfg := Config{}
+	err := yaml.Unmarshal([]byte(`
+generated:
+  "*.sql":
+    - sqlc generate
+  "*.templ":
+    tasks: [templ generate, __hard_reload]
+    outputs: ["*_templ.go"]
+`), &cfg)
+	if err != nil {
+		t.Fatalf("parsing config: %v", err)
+	}
+
+	sql := cfg.Generated["*.sql"]
+	if len(sql.Tasks) != 1 || sql.Tasks[0] != "sqlc generate" || len(sql.Outputs) != 0 {
+		t.Errorf("unexpected rule: %+v", sql)
+	}
+
+	templ := cfg.Generated["*.templ"]
+	if len(templ.Tasks) != 2 || templ.Tasks[1] != "__hard_reload" || len(templ.Outputs) != 1 || templ.Outputs[0] != "*_templ.go" {
+		t.Errorf("unexpected rule: %+v", templ)
+	}
+}
@@ -0,0 +1,116 @@
+package config
+
+// gomon is a simple command line tool that watches your files and automatically restarts the application when it detects any changes in the working directory.
+// Copyright (C) 2023 John Dudmesh
+
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+
+// You should have received a copy of the GNU General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>.
+
+import (
+	"fmt"
+	"net"
+	"path/filepath"
+	"strconv"
+)
+
+const (
+	DefaultProxyPort = 4000
+	DefaultUIPort    = 4001
+	// UIMountPath is the path under which the UI is served when it shares the proxy's port
+	UIMountPath = "/__gomon__/ui"
+	// DefaultIPCPort is used by the udp and tcp transports
+	DefaultIPCPort = 33333
+	// DefaultIPCSocket is the unix socket in the root directory, .gomon is excluded from watching
+	DefaultIPCSocket = ".gomon/ipc.sock"
+)
+
+// ProxyURL returns the local URL of the proxy, or an empty string if it isn't enabled
+func (c Config) ProxyURL() string {
+	if !c.Proxy.Enabled {
+		return ""
+	}
+
+	port := c.Proxy.Port
+	if port == 0 {
+		port = DefaultProxyPort
+	}
+
+	scheme := "http"
+	if c.Proxy.TLS.Cert != "" {
+		scheme = "https"
+	}
+
+	return fmt.Sprintf("%s://localhost:%d", scheme, port)
+}
+
+// UIURL returns the local URL of the web UI, or an empty string if it isn't enabled
+func (c Config) UIURL() string {
+	if !c.UI.Enabled {
+		return ""
+	}
+
+	if proxyURL := c.ProxyURL(); c.UI.MountOnProxy && proxyURL != "" {
+		return proxyURL + UIMountPath
+	}
+
+	port := c.UI.Port
+	if port == 0 {
+		port = DefaultUIPort
+	}
+
+	return fmt.Sprintf("http://localhost:%d", port)
+}
+
+// IPCTransport returns the transport the IPC channel is served on
+func (c Config) IPCTransport() string {
+	if c.IPC.Transport == "" {
+		return IPCTransportUDP
+	}
+	return c.IPC.Transport
+}
+
+// IPCAddress returns the address gomon serves the IPC channel on, unix sockets are in the root directory unless
+// the path is absolute
+func (c Config) IPCAddress() string {
+	switch c.IPCTransport() {
+	case IPCTransportUnix:
+		path := c.IPC.Address
+		if path == "" {
+			path = DefaultIPCSocket
+		}
+		if !filepath.IsAbs(path) {
+			path = filepath.Join(c.RootDirectory, path)
+		}
+		return path
+	case IPCTransportTCP:
+		if c.IPC.Address != "" {
+			return c.IPC.Address
+		}
+	}
+	return net.JoinHostPort("127.0.0.1", strconv.Itoa(DefaultIPCPort))
+}
+
+// IPCAdvertisedAddress returns the address the child process connects to. Relative socket paths are passed as they
+// are because the child runs in the root directory, which is also the working directory of a container.
+func (c Config) IPCAdvertisedAddress() string {
+	if c.IPC.Advertise != "" {
+		return c.IPC.Advertise
+	}
+	if c.IPCTransport() == IPCTransportUnix && !filepath.IsAbs(c.IPC.Address) {
+		if c.IPC.Address == "" {
+			return DefaultIPCSocket
+		}
+		return c.IPC.Address
+	}
+	return c.IPCAddress()
+}
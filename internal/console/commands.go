@@ -0,0 +1,109 @@
+package console
+
+// gomon is a simple command line tool that watches your files and automatically restarts the application when it detects any changes in the working directory.
+// Copyright (C) 2023 John Dudmesh
+
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+
+// You should have received a copy of the GNU General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>.
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/jdudmesh/gomon/internal/notification"
+)
+
+// CommandHelp lists the commands which can be typed into gomon's terminal
+const CommandHelp = `commands: rs - hard restart, ss - soft restart, task <name> - run a task, cancel - cancel running tasks, quit - exit, help - show this message`
+
+// IsTerminal returns true if f is a terminal rather than a file or pipe
+func IsTerminal(f *os.File) bool {
+	info, err := f.Stat()
+	return err == nil && info.Mode()&os.ModeCharDevice != 0 && isTerminal(int(f.Fd()))
+}
+
+// ReadCommands reads commands a line at a time from in and passes them to callbackFn as requests, help and errors
+// are written to out. The child process never reads from gomon's stdin so nothing is lost by consuming it here.
+func ReadCommands(in io.Reader, out io.Writer, callbackFn notification.NotificationCallback) error {
+	fmt.Fprintln(out, CommandHelp)
+
+	scanner := bufio.NewScanner(in)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" {
+			continue
+		}
+		if line == "help" {
+			fmt.Fprintln(out, CommandHelp)
+			continue
+		}
+
+		n, err := parseCommand(line)
+		if err != nil {
+			fmt.Fprintf(out, "%v\n%s\n", err, CommandHelp)
+			continue
+		}
+
+		err = callbackFn(n)
+		if err != nil {
+			log.Errorf("sending request: %v", err)
+		}
+	}
+
+	return scanner.Err()
+}
+
+// parseCommand converts a line typed by the user into a request
+func parseCommand(line string) (notification.Notification, error) {
+	n := notification.Notification{
+		ID:      notification.NextID(),
+		Date:    time.Now(),
+		Message: "console",
+	}
+
+	command, arg, _ := strings.Cut(line, " ")
+	arg = strings.TrimSpace(arg)
+
+	switch command {
+	case "rs":
+		n.Type = notification.NotificationTypeHardRestartRequested
+	case "ss":
+		n.Type = notification.NotificationTypeSoftRestartRequested
+	case "quit":
+		n.Type = notification.NotificationTypeShutdownRequested
+	case "cancel":
+		// the console doesn't show task IDs so every running task is cancelled
+		n.Type = notification.NotificationTypeOOBTaskCancelRequested
+		n.Message = ""
+	case "task":
+		if arg == "" {
+			return n, errors.New("task requires a name")
+		}
+		n.Type = notification.NotificationTypeOOBTaskRequested
+		n.Message = arg
+		return n, nil
+	default:
+		return n, fmt.Errorf("unknown command: %s", line)
+	}
+
+	if arg != "" {
+		return n, fmt.Errorf("%s doesn't take any arguments", command)
+	}
+
+	return n, nil
+}
@@ -0,0 +1,67 @@
+package console
+
+// gomon is a simple command line tool that watches your files and automatically restarts the application when it detects any changes in the working directory.
+// Copyright (C) 2023 John Dudmesh
+
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+
+// You should have received a copy of the GNU General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>.
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/jdudmesh/gomon/internal/notification"
+)
+
+func TestReadCommands(t *testing.T) {
+	var requests []notification.Notification
+	out := &bytes.Buffer{}
+
+	in := strings.NewReader("rs\n\n  ss  \ntask migrate\ntask\nrestart\nrs now\ncancel\nquit\n")
+	err := ReadCommands(in, out, func(n notification.Notification) error {
+		requests = append(requests, n)
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("reading commands: %v", err)
+	}
+
+	expected := []struct {
+		notifType notification.NotificationType
+		message   string
+	}{
+		{notification.NotificationTypeHardRestartRequested, "console"},
+		{notification.NotificationTypeSoftRestartRequested, "console"},
+		{notification.NotificationTypeOOBTaskRequested, "migrate"},
+		{notification.NotificationTypeOOBTaskCancelRequested, ""},
+		{notification.NotificationTypeShutdownRequested, "console"},
+	}
+	if len(requests) != len(expected) {
+		t.Fatalf("expected %d requests, got %d", len(expected), len(requests))
+	}
+	for i, e := range expected {
+		if requests[i].Type != e.notifType || requests[i].Message != e.message {
+			t.Errorf("request %d: expected %v %q, got %v %q", i, e.notifType, e.message, requests[i].Type, requests[i].Message)
+		}
+	}
+
+	for _, msg := range []string{"task requires a name", "unknown command: restart", "rs doesn't take any arguments"} {
+		if !strings.Contains(out.String(), msg) {
+			t.Errorf("expected %q in output: %s", msg, out.String())
+		}
+	}
+	if !strings.HasPrefix(out.String(), CommandHelp) {
+		t.Errorf("expected the help to be printed first: %s", out.String())
+	}
+}
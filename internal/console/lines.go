@@ -0,0 +1,82 @@
+package console
+
+// gomon is a simple command line tool that watches your files and automatically restarts the application when it detects any changes in the working directory.
+// Copyright (C) 2023 John Dudmesh
+
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+
+// You should have received a copy of the GNU General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>.
+
+import (
+	"strings"
+	"time"
+
+	"github.com/jdudmesh/gomon/internal/utils"
+)
+
+// partialLineTimeout is how long an incomplete line is held waiting for the rest of it, e.g. a prompt which
+// isn't followed by a newline
+const partialLineTimeout = 250 * time.Millisecond
+
+// lineBuffer splits the output of the child process into lines. Output arrives in arbitrary chunks so a line
+// can be split across writes, including between the \r and \n of a Windows line ending.
+type lineBuffer struct {
+	partial      string
+	partialSince time.Time
+	// keepColours leaves colour codes in the lines so that the web UI can render them
+	keepColours bool
+}
+
+// lines returns the complete lines in the chunk, a trailing partial line is kept until the next chunk
+func (b *lineBuffer) lines(chunk string) []string {
+	data := b.partial + chunk
+	ix := strings.LastIndexByte(data, '\n')
+	if ix < 0 {
+		if b.partial == "" {
+			b.partialSince = time.Now()
+		}
+		b.partial = data
+		return nil
+	}
+
+	b.partial = data[ix+1:]
+	b.partialSince = time.Now()
+
+	lines := strings.Split(data[:ix], "\n")
+	for i, line := range lines {
+		lines[i] = normalizeLine(line, b.keepColours)
+	}
+	return lines
+}
+
+// flush returns the partial line if it has been waiting for longer than the timeout
+func (b *lineBuffer) flush(now time.Time) (string, bool) {
+	if b.partial == "" || now.Sub(b.partialSince) < partialLineTimeout {
+		return "", false
+	}
+	line := normalizeLine(b.partial, b.keepColours)
+	b.partial = ""
+	return line, true
+}
+
+// normalizeLine removes the parts of a line which only make sense on a terminal. A carriage return in the
+// middle of a line (e.g. a progress bar) overwrites what came before it, as it would on screen.
+func normalizeLine(line string, keepColours bool) string {
+	line = strings.TrimSuffix(line, "\r")
+	if ix := strings.LastIndexByte(line, '\r'); ix >= 0 {
+		line = line[ix+1:]
+	}
+	if keepColours {
+		return utils.StripANSIControls(line)
+	}
+	return utils.StripANSI(line)
+}
@@ -0,0 +1,74 @@
+package console
+
+// gomon is a simple command line tool that watches your files and automatically restarts the application when it detects any changes in the working directory.
+// Copyright (C) 2023 John Dudmesh
+
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+
+// You should have received a copy of the GNU General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>.
+
+import (
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestLineBuffer(t *testing.T) {
+	b := lineBuffer{}
+
+	lines := b.lines("first line\r\nsecond ")
+	if !reflect.DeepEqual(lines, []string{"first line"}) {
+		t.Errorf("unexpected lines: %q", lines)
+	}
+
+	// a Windows line ending split across writes
+	lines = b.lines("line\r")
+	if len(lines) != 0 {
+		t.Errorf("expected the partial line to be held, got %q", lines)
+	}
+	lines = b.lines("\nthird\n")
+	if !reflect.DeepEqual(lines, []string{"second line", "third"}) {
+		t.Errorf("unexpected lines: %q", lines)
+	}
+
+	b.lines("Password: ")
+	if _, ok := b.flush(time.Now()); ok {
+		t.Error("expected the partial line to be held until the timeout")
+	}
+	line, ok := b.flush(time.Now().Add(partialLineTimeout))
+	if !ok || line != "Password: " {
+		t.Errorf("expected the partial line to be flushed, got %q", line)
+	}
+}
+
+func TestNormalizeLine(t *testing.T) {
+	cases := map[string]string{
+		"plain":  "plain",
+		"crlf\r": "crlf",
+		"\x1b[31mred\x1b[0m and \x1b[1;32mbold green\x1b[m": "red and bold green",
+		"10%\r50%\r100%":                "100%",
+		"\x1b]0;window title\x07output": "output",
+		"\x1b[2K\x1b[1Gcleared":         "cleared",
+	}
+
+	for input, expected := range cases {
+		if actual := normalizeLine(input, false); actual != expected {
+			t.Errorf("normalizeLine(%q): expected %q, got %q", input, expected, actual)
+		}
+	}
+
+	// colours are kept for the web UI but cursor movement is still removed
+	input := "\x1b[2K\x1b[31mred\x1b[0m\x1b]0;title\x07"
+	if actual := normalizeLine(input, true); actual != "\x1b[31mred\x1b[0m" {
+		t.Errorf("normalizeLine(%q): unexpected %q", input, actual)
+	}
+}
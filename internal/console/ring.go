@@ -0,0 +1,133 @@
+package console
+
+// gomon is a simple command line tool that watches your files and automatically restarts the application when it detects any changes in the working directory.
+// Copyright (C) 2023 John Dudmesh
+
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+
+// You should have received a copy of the GNU General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>.
+
+import (
+	"strings"
+	"sync"
+	"sync/atomic"
+
+	"github.com/jdudmesh/gomon/internal/config"
+)
+
+// ringBuffer is a bounded queue of chunks of the child process's output. Writers never block unless the policy is
+// block, in which case they wait for room. Pushing to a closed buffer discards the chunk rather than panicking, so
+// the child's output can still be copied while gomon shuts down.
+type ringBuffer struct {
+	lock    sync.Mutex
+	notFull *sync.Cond
+	chunks  []string
+	head    int
+	size    int
+	policy  string
+	closed  bool
+	// ready is signalled when a chunk is pushed to an empty buffer
+	ready chan struct{}
+	// dropped counts the lines discarded because the buffer was full, overflowing is set from the first one until
+	// the buffer has been emptied so that each burst is only logged once
+	dropped     atomic.Int64
+	overflowing bool
+	name        string
+}
+
+func newRingBuffer(name string, capacity int, policy string) *ringBuffer {
+	r := &ringBuffer{
+		chunks: make([]string, capacity),
+		policy: policy,
+		ready:  make(chan struct{}, 1),
+		name:   name,
+	}
+	r.notFull = sync.NewCond(&r.lock)
+	return r
+}
+
+func (r *ringBuffer) push(chunk string) {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	for r.policy == config.ConsoleOverflowBlock && r.size == len(r.chunks) && !r.closed {
+		r.notFull.Wait()
+	}
+	if r.closed {
+		return
+	}
+
+	if r.size == len(r.chunks) {
+		if r.policy == config.ConsoleOverflowDropNewest {
+			r.drop(chunk)
+			return
+		}
+		r.drop(r.chunks[r.head])
+		r.head = (r.head + 1) % len(r.chunks)
+		r.size--
+	}
+
+	r.chunks[(r.head+r.size)%len(r.chunks)] = chunk
+	r.size++
+
+	select {
+	case r.ready <- struct{}{}:
+	default:
+	}
+}
+
+// drop is called with the lock held
+func (r *ringBuffer) drop(chunk string) {
+	r.dropped.Add(int64(max(strings.Count(chunk, "\n"), 1)))
+	if !r.overflowing {
+		r.overflowing = true
+		log.Warnf("console %s can't keep up with the child process, output is being dropped", r.name)
+	}
+}
+
+// drain removes and returns the buffered chunks in the order they were written
+func (r *ringBuffer) drain() []string {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	chunks := make([]string, 0, r.size)
+	for ; r.size > 0; r.size-- {
+		chunks = append(chunks, r.chunks[r.head])
+		r.chunks[r.head] = ""
+		r.head = (r.head + 1) % len(r.chunks)
+	}
+	r.overflowing = false
+	r.notFull.Broadcast()
+	return chunks
+}
+
+// close discards any buffered output and releases blocked writers
+func (r *ringBuffer) close() {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	r.closed = true
+	r.size = 0
+	r.notFull.Broadcast()
+}
+
+func (r *ringBuffer) depth() int {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	return r.size
+}
+
+// Write queues a chunk of output, it always succeeds so that the child process isn't sent SIGPIPE
+func (r *ringBuffer) Write(p []byte) (int, error) {
+	r.push(string(p))
+	return len(p), nil
+}
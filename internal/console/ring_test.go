@@ -0,0 +1,82 @@
+package console
+
+// gomon is a simple command line tool that watches your files and automatically restarts the application when it detects any changes in the working directory.
+// Copyright (C) 2023 John Dudmesh
+
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+
+// You should have received a copy of the GNU General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>.
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/jdudmesh/gomon/internal/config"
+)
+
+func TestRingBufferOverflow(t *testing.T) {
+	tests := []struct {
+		policy string
+		want   []string
+	}{
+		{config.ConsoleOverflowDropOldest, []string{"c\n", "d\n"}},
+		{config.ConsoleOverflowDropNewest, []string{"a\n", "b\n"}},
+	}
+
+	for _, tt := range tests {
+		r := newRingBuffer("stdout", 2, tt.policy)
+		for _, chunk := range []string{"a\n", "b\n", "c\n", "d\n"} {
+			r.Write([]byte(chunk))
+		}
+		if got := r.drain(); !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("%s: expected %q, got %q", tt.policy, tt.want, got)
+		}
+		if r.dropped.Load() != 2 {
+			t.Errorf("%s: expected 2 dropped lines, got %d", tt.policy, r.dropped.Load())
+		}
+	}
+}
+
+func TestRingBufferBlock(t *testing.T) {
+	r := newRingBuffer("stdout", 1, config.ConsoleOverflowBlock)
+	r.Write([]byte("a\n"))
+
+	written := make(chan struct{})
+	go func() {
+		r.Write([]byte("b\n"))
+		close(written)
+	}()
+
+	select {
+	case <-written:
+		t.Fatal("expected the write to wait for room")
+	case <-time.After(50 * time.Millisecond):
+	}
+
+	if got := r.drain(); !reflect.DeepEqual(got, []string{"a\n"}) {
+		t.Errorf("unexpected chunks: %q", got)
+	}
+	<-written
+	if got := r.drain(); !reflect.DeepEqual(got, []string{"b\n"}) {
+		t.Errorf("unexpected chunks: %q", got)
+	}
+
+	// closing releases blocked writers and later writes are discarded
+	r.Write([]byte("c\n"))
+	go r.close()
+	r.Write([]byte("d\n"))
+	r.Write([]byte("e\n"))
+	if r.depth() != 0 || r.dropped.Load() != 0 {
+		t.Errorf("expected writes after close to be discarded, depth %d dropped %d", r.depth(), r.dropped.Load())
+	}
+}
@@ -0,0 +1,218 @@
+package console
+
+// gomon is a simple command line tool that watches your files and automatically restarts the application when it detects any changes in the working directory.
+// Copyright (C) 2023 John Dudmesh
+
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+
+// You should have received a copy of the GNU General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>.
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/jdudmesh/gomon/internal/notification"
+)
+
+const statusLineInterval = time.Second
+
+// statusLine keeps a summary of the child process on the bottom row of the terminal. Scrolling is confined
+// to the rows above it so that output never overwrites it, all writes to the terminal must hold the lock.
+type statusLine struct {
+	out            *os.File
+	lock           sync.Mutex
+	width          int
+	height         int
+	startedAt      time.Time
+	state          string
+	starts         int
+	buildStartedAt time.Time
+	lastBuild      time.Duration
+	done           chan struct{}
+}
+
+type guardedWriter struct {
+	status *statusLine
+	next   io.Writer
+}
+
+func newStatusLine(out *os.File) (*statusLine, error) {
+	width, height, err := terminalSize(int(out.Fd()))
+	if err != nil {
+		return nil, fmt.Errorf("getting terminal size: %w", err)
+	}
+
+	if height < 2 {
+		return nil, errors.New("terminal is too small")
+	}
+
+	return &statusLine{
+		out:       out,
+		width:     width,
+		height:    height,
+		startedAt: time.Now(),
+		state:     "waiting",
+		done:      make(chan struct{}),
+	}, nil
+}
+
+func (s *statusLine) Start() {
+	s.lock.Lock()
+	s.setScrollRegion()
+	s.draw()
+	s.lock.Unlock()
+
+	// redraw regularly to keep the uptime current and to pick up changes to the terminal size
+	go func() {
+		ticker := time.NewTicker(statusLineInterval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ticker.C:
+				s.refresh()
+			case <-s.done:
+				return
+			}
+		}
+	}()
+}
+
+func (s *statusLine) Close() {
+	close(s.done)
+
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	// reset the scroll region and clear the status line
+	fmt.Fprintf(s.out, "\x1b[r\x1b[%d;1H\x1b[2K", s.height)
+}
+
+// guard wraps w so that writes don't interleave with drawing the status line
+func (s *statusLine) guard(w io.Writer) io.Writer {
+	return &guardedWriter{status: s, next: w}
+}
+
+func (s *statusLine) Notify(n notification.Notification) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	switch n.Type {
+	case notification.NotificationTypeStartup:
+		s.starts++
+		s.state = "starting"
+		if strings.HasPrefix(n.Message, "testing") {
+			// test mode, each run is a go test rather than a process
+			s.state = "testing"
+		}
+	case notification.NotificationTypeStdOut, notification.NotificationTypeStdErr:
+		// the first output from the child process is the best sign that it is up
+		if s.state == "starting" {
+			s.state = "ready"
+		}
+	case notification.NotificationTypeHardRestartRequested:
+		s.state = "restarting"
+	case notification.NotificationTypeBuildError:
+		s.state = "build failed"
+	case notification.NotificationTypeOOBTaskStartup:
+		if strings.HasPrefix(n.Message, "building") {
+			s.state = "building"
+			s.buildStartedAt = n.Date
+		}
+	case notification.NotificationTypeOOBTaskComplete:
+		if s.state != "building" {
+			return
+		}
+		s.lastBuild = n.Date.Sub(s.buildStartedAt)
+		s.state = "starting"
+		if strings.HasPrefix(n.Message, "build failed") {
+			s.state = "build failed"
+		}
+	case notification.NotificationTypeShutdown:
+		if s.state != "crashed" {
+			s.state = "stopped"
+		}
+	case notification.NotificationTypeCrash:
+		s.state = "crashed"
+	case notification.NotificationTypeUnhealthy:
+		s.state = "unhealthy"
+	case notification.NotificationTypeTestPass:
+		s.state = "tests passed"
+	case notification.NotificationTypeTestFail:
+		s.state = "tests failed"
+	default:
+		return
+	}
+
+	s.draw()
+}
+
+func (s *statusLine) refresh() {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	width, height, err := terminalSize(int(s.out.Fd()))
+	if err == nil && height >= 2 && (width != s.width || height != s.height) {
+		s.width = width
+		s.height = height
+		s.setScrollRegion()
+	}
+
+	s.draw()
+}
+
+func (s *statusLine) setScrollRegion() {
+	// make room for the status line then confine scrolling to the rows above it
+	fmt.Fprintf(s.out, "\n\x1b[1;%dr\x1b[%d;1H", s.height-1, s.height-1)
+}
+
+func (s *statusLine) draw() {
+	text := s.text()
+	if len(text) > s.width {
+		text = text[:s.width]
+	} else {
+		text += strings.Repeat(" ", s.width-len(text))
+	}
+
+	colour := "\x1b[7m"
+	if s.state == "crashed" || s.state == "build failed" {
+		colour = "\x1b[41;97m"
+	}
+
+	// save the cursor, draw on the bottom row then restore it
+	fmt.Fprintf(s.out, "\x1b7\x1b[%d;1H\x1b[2K%s%s\x1b[0m\x1b8", s.height, colour, text)
+}
+
+func (s *statusLine) text() string {
+	restarts := 0
+	if s.starts > 1 {
+		restarts = s.starts - 1
+	}
+
+	lastBuild := "-"
+	if s.lastBuild > 0 {
+		lastBuild = s.lastBuild.Round(time.Millisecond).String()
+	}
+
+	return fmt.Sprintf(" gomon | %s | uptime %s | restarts %d | last build %s",
+		s.state, time.Since(s.startedAt).Round(time.Second), restarts, lastBuild)
+}
+
+func (w *guardedWriter) Write(p []byte) (int, error) {
+	w.status.lock.Lock()
+	defer w.status.lock.Unlock()
+	return w.next.Write(p)
+}
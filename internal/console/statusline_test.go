@@ -0,0 +1,61 @@
+package console
+
+// gomon is a simple command line tool that watches your files and automatically restarts the application when it detects any changes in the working directory.
+// Copyright (C) 2023 John Dudmesh
+
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+
+// You should have received a copy of the GNU General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>.
+
+import (
+	"os"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/jdudmesh/gomon/internal/notification"
+)
+
+func TestStatusLine(t *testing.T) {
+	out, err := os.CreateTemp(t.TempDir(), "term")
+	if err != nil {
+		t.Fatalf("creating output: %v", err)
+	}
+	defer out.Close()
+
+	s := &statusLine{out: out, width: 120, height: 24, startedAt: time.Now(), state: "waiting"}
+
+	now := time.Now()
+	events := []notification.Notification{
+		{Type: notification.NotificationTypeStartup, Date: now},
+		{Type: notification.NotificationTypeOOBTaskStartup, Date: now, Message: "building: ./cmd/app"},
+		{Type: notification.NotificationTypeOOBTaskComplete, Date: now.Add(1500 * time.Millisecond), Message: "build completed"},
+		{Type: notification.NotificationTypeStdOut, Date: now, Message: "listening"},
+		{Type: notification.NotificationTypeHardRestartRequested, Date: now},
+		{Type: notification.NotificationTypeStartup, Date: now},
+		{Type: notification.NotificationTypeShutdown, Date: now},
+		{Type: notification.NotificationTypeCrash, Date: now},
+	}
+
+	expected := []string{"starting", "building", "starting", "ready", "restarting", "starting", "stopped", "crashed"}
+	for i, n := range events {
+		s.Notify(n)
+		if s.state != expected[i] {
+			t.Errorf("event %d: expected %s, got %s", i, expected[i], s.state)
+		}
+	}
+
+	text := s.text()
+	if !strings.Contains(text, "restarts 1") || !strings.Contains(text, "last build 1.5s") {
+		t.Errorf("unexpected status line: %s", text)
+	}
+}
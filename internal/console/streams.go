@@ -17,63 +17,138 @@ package console
 // along with this program.  If not, see <https://www.gnu.org/licenses/>.
 
 import (
-	"bufio"
+	"fmt"
 	"io"
 	"os"
+	"slices"
 	"strings"
+	"sync"
 	"sync/atomic"
 	"time"
 
 	"github.com/jdudmesh/gomon/internal/config"
 	"github.com/jdudmesh/gomon/internal/notification"
-	_ "github.com/mattn/go-sqlite3"
-	log "github.com/sirupsen/logrus"
+	"github.com/jdudmesh/gomon/internal/utils"
+	"github.com/sirupsen/logrus"
 )
 
+var log = logrus.WithField("component", "console")
+
 type streams struct {
 	enabled               bool
-	stdoutWriter          chan string
-	stderrWriter          chan string
+	stdoutWriter          *ringBuffer
+	stderrWriter          *ringBuffer
+	done                  chan struct{}
+	closeOnce             sync.Once
 	currentRunID          atomic.Int64
 	currentChildProcessID string
 	callbackFn            notification.NotificationCallback
-}
-
-type streamWriter struct {
-	streamConsumer chan string
+	stdout                io.Writer
+	stderr                io.Writer
+	status                *statusLine
+	stdoutLines           lineBuffer
+	stderrLines           lineBuffer
+	// printBanner writes the startup banner to the terminal, unless the terminal UI owns it
+	printBanner bool
+	// taskNames holds the command of each running task so that the output of tasks run in parallel can be told apart
+	taskNames     map[string]string
+	taskNamesLock sync.Mutex
 }
 
 func New(cfg config.Config, callbackFn notification.NotificationCallback) (*streams, error) {
+	bufferSize := cfg.Limits.ConsoleBuffer
+	if bufferSize <= 0 {
+		bufferSize = config.DefaultConsoleBuffer
+	}
+
+	overflow := cfg.Limits.ConsoleOverflow
+	switch overflow {
+	case "":
+		overflow = config.ConsoleOverflowDropOldest
+	case config.ConsoleOverflowDropOldest, config.ConsoleOverflowDropNewest, config.ConsoleOverflowBlock:
+	default:
+		return nil, fmt.Errorf("unsupported limits.consoleOverflow: %s", overflow)
+	}
+
 	stm := &streams{
-		enabled:      cfg.UI.Enabled,
-		stdoutWriter: make(chan string),
-		stderrWriter: make(chan string),
+		enabled:      cfg.UI.Enabled || cfg.TUI,
+		printBanner:  !cfg.TUI,
+		stdoutWriter: newRingBuffer("stdout", bufferSize, overflow),
+		stderrWriter: newRingBuffer("stderr", bufferSize, overflow),
+		done:         make(chan struct{}),
 		callbackFn:   callbackFn,
+		stdout:       os.Stdout,
+		stderr:       os.Stderr,
+		stdoutLines:  lineBuffer{keepColours: !cfg.UI.StripANSI},
+		stderrLines:  lineBuffer{keepColours: !cfg.UI.StripANSI},
+		taskNames:    map[string]string{},
+	}
+
+	if !stm.enabled {
+		// output is passed straight through so the terminal must understand the child's colour codes
+		for _, f := range []*os.File{os.Stdout, os.Stderr} {
+			err := enableVirtualTerminal(f)
+			if err != nil {
+				log.Warnf("enabling terminal colours: %v", err)
+			}
+		}
+	}
+
+	if cfg.StatusLine && !stm.enabled {
+		status, err := newStatusLine(os.Stdout)
+		if err != nil {
+			log.Warnf("status line disabled: %v", err)
+			return stm, nil
+		}
+		stm.status = status
+		stm.stdout = status.guard(os.Stdout)
+		stm.stderr = status.guard(os.Stderr)
+		logrus.SetOutput(status.guard(logrus.StandardLogger().Out))
 	}
 
 	return stm, nil
 }
 
 func (s *streams) Start() error {
+	if s.status != nil {
+		s.status.Start()
+	}
+
+	flushTicker := time.NewTicker(partialLineTimeout / 2)
+	defer flushTicker.Stop()
+
 	for {
 		select {
-		case line := <-s.stdoutWriter:
-			if !s.enabled {
-				os.Stdout.WriteString(line)
-				continue
+		case <-s.done:
+			return nil
+		case <-s.stdoutWriter.ready:
+			for _, chunk := range s.stdoutWriter.drain() {
+				if !s.enabled {
+					io.WriteString(s.stdout, chunk)
+					continue
+				}
+				err := s.write(notification.NotificationTypeStdOut, s.stdoutLines.lines(chunk), s.callbackFn)
+				if err != nil {
+					log.Errorf("writing stdout: %v", err)
+				}
 			}
-			err := s.write(notification.NotificationTypeStdOut, line, s.callbackFn)
-			if err != nil {
-				log.Errorf("writing stdout: %v", err)
+		case <-s.stderrWriter.ready:
+			for _, chunk := range s.stderrWriter.drain() {
+				if !s.enabled {
+					io.WriteString(s.stderr, chunk)
+					continue
+				}
+				err := s.write(notification.NotificationTypeStdErr, s.stderrLines.lines(chunk), s.callbackFn)
+				if err != nil {
+					log.Errorf("writing stderr: %v", err)
+				}
 			}
-		case line := <-s.stderrWriter:
-			if !s.enabled {
-				os.Stderr.WriteString(line)
-				continue
+		case now := <-flushTicker.C:
+			if line, ok := s.stdoutLines.flush(now); ok {
+				s.write(notification.NotificationTypeStdOut, []string{line}, s.callbackFn)
 			}
-			err := s.write(notification.NotificationTypeStdErr, line, s.callbackFn)
-			if err != nil {
-				log.Errorf("writing stderr: %v", err)
+			if line, ok := s.stderrLines.flush(now); ok {
+				s.write(notification.NotificationTypeStdErr, []string{line}, s.callbackFn)
 			}
 		}
 	}
@@ -81,33 +156,82 @@ func (s *streams) Start() error {
 
 func (s *streams) Close() error {
 	log.Info("closing console streams")
-	close(s.stdoutWriter)
-	close(s.stderrWriter)
+	if s.status != nil {
+		s.status.Close()
+	}
+	// writers may still be copying the child's output, the buffers discard it rather than panicking
+	s.closeOnce.Do(func() {
+		s.stdoutWriter.close()
+		s.stderrWriter.close()
+		close(s.done)
+	})
 	return nil
 }
 
 func (s *streams) Stdout() io.Writer {
-	return &streamWriter{streamConsumer: s.stdoutWriter}
+	return s.stdoutWriter
 }
 
 func (s *streams) Stderr() io.Writer {
-	return &streamWriter{streamConsumer: s.stderrWriter}
+	return s.stderrWriter
 }
 
-func (s *streams) write(logType notification.NotificationType, logData string, callbackFn notification.NotificationCallback) error {
-	eventDate := time.Now()
+// Terminal returns a writer for gomon's own messages to the terminal which doesn't disturb the status line
+func (s *streams) Terminal() io.Writer {
+	return s.stdout
+}
 
-	scanner := bufio.NewScanner(strings.NewReader(logData))
-	for scanner.Scan() {
+func (s *streams) QueueStats() map[string]utils.QueueStats {
+	return map[string]utils.QueueStats{
+		"console.stdout": {Depth: s.stdoutWriter.depth(), Capacity: len(s.stdoutWriter.chunks), Dropped: s.stdoutWriter.dropped.Load()},
+		"console.stderr": {Depth: s.stderrWriter.depth(), Capacity: len(s.stderrWriter.chunks), Dropped: s.stderrWriter.dropped.Load()},
+	}
+}
+
+func (s *streams) write(logType notification.NotificationType, lines []string, callbackFn notification.NotificationCallback) error {
+	if len(lines) == 0 {
+		return nil
+	}
+
+	eventDate := time.Now()
+	emit := func(notifType notification.NotificationType, message string) {
+		level := notification.LevelError
+		if notifType != notification.NotificationTypeBuildError {
+			level = utils.DetectLevel(message)
+		}
 		callbackFn(notification.Notification{
 			ID:              notification.NextID(),
 			Date:            eventDate,
 			ChildProccessID: s.currentChildProcessID,
-			Type:            logType,
-			Message:         scanner.Text(),
+			Type:            notifType,
+			Message:         message,
+			Level:           level,
 		})
 	}
 
+	// compiler output is kept together so that it can be shown as a single build error
+	buildOutput := []string{}
+	flush := func() {
+		if slices.ContainsFunc(buildOutput, utils.IsCompilerError) {
+			emit(notification.NotificationTypeBuildError, strings.Join(buildOutput, "\n"))
+		} else {
+			for _, line := range buildOutput {
+				emit(logType, line)
+			}
+		}
+		buildOutput = buildOutput[:0]
+	}
+
+	for _, line := range lines {
+		if logType == notification.NotificationTypeStdErr && utils.IsCompilerOutput(line) {
+			buildOutput = append(buildOutput, line)
+			continue
+		}
+		flush()
+		emit(logType, line)
+	}
+	flush()
+
 	return nil
 }
 
@@ -115,10 +239,44 @@ func (s *streams) Notify(n notification.Notification) error {
 	if n.Type == notification.NotificationTypeStartup {
 		s.currentChildProcessID = n.ChildProccessID
 	}
+
+	if n.Type == notification.NotificationTypeRunning && s.printBanner {
+		io.WriteString(s.stdout, n.Message+"\n")
+	}
+
+	// when there is no UI task output is written straight to the console as it arrives
+	if !s.enabled && n.TaskID != "" {
+		switch n.Type {
+		case notification.NotificationTypeOOBTaskStartup:
+			s.taskNamesLock.Lock()
+			s.taskNames[n.TaskID] = strings.TrimPrefix(n.Message, "running task: ")
+			s.taskNamesLock.Unlock()
+		case notification.NotificationTypeOOBTaskStdOut:
+			io.WriteString(s.stdout, s.taskPrefix(n.TaskID)+n.Message+"\n")
+		case notification.NotificationTypeOOBTaskStdErr:
+			io.WriteString(s.stderr, s.taskPrefix(n.TaskID)+n.Message+"\n")
+		case notification.NotificationTypeOOBTaskComplete:
+			s.taskNamesLock.Lock()
+			delete(s.taskNames, n.TaskID)
+			s.taskNamesLock.Unlock()
+		}
+	}
+
+	if s.status != nil {
+		s.status.Notify(n)
+	}
+
 	return nil
 }
 
-func (w *streamWriter) Write(p []byte) (int, error) {
-	w.streamConsumer <- string(p)
-	return len(p), nil
+// taskPrefix labels a line of task output with the task's command e.g. "[templ generate] "
+func (s *streams) taskPrefix(taskID string) string {
+	s.taskNamesLock.Lock()
+	defer s.taskNamesLock.Unlock()
+
+	name, ok := s.taskNames[taskID]
+	if !ok || name == "" {
+		name = "task"
+	}
+	return "[" + name + "] "
 }
@@ -0,0 +1,33 @@
+//go:build !linux && !darwin
+// +build !linux,!darwin
+
+package console
+
+// gomon is a simple command line tool that watches your files and automatically restarts the application when it detects any changes in the working directory.
+// Copyright (C) 2023 John Dudmesh
+
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+
+// You should have received a copy of the GNU General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>.
+
+import (
+	"errors"
+)
+
+func terminalSize(fd int) (int, int, error) {
+	return 0, 0, errors.New("the status line is not supported on this platform")
+}
+
+// isTerminal can't tell terminals from other character devices on this platform
+func isTerminal(fd int) bool {
+	return true
+}
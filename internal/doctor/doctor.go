@@ -0,0 +1,309 @@
+package doctor
+
+// gomon is a simple command line tool that watches your files and automatically restarts the application when it detects any changes in the working directory.
+// Copyright (C) 2023 John Dudmesh
+
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+
+// You should have received a copy of the GNU General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>.
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/jdudmesh/gomon/internal/config"
+	"github.com/jdudmesh/gomon/internal/utils"
+)
+
+// Status is the outcome of a check
+type Status int
+
+const (
+	StatusOK Status = iota
+	StatusWarning
+	StatusProblem
+)
+
+var statusLabels = []string{"ok", "warn", "fail"}
+
+func (s Status) String() string {
+	return statusLabels[s]
+}
+
+// Result is the outcome of a single check, Fix describes how to resolve a warning or a problem
+type Result struct {
+	Check   string
+	Status  Status
+	Message string
+	Fix     string
+}
+
+const dialTimeout = 2 * time.Second
+
+type check func(cfg config.Config, isRunning bool) []Result
+
+var checks = []check{
+	checkEntrypoint,
+	checkInotify,
+	checkPorts,
+	checkDownstream,
+	checkDatabase,
+}
+
+// Run checks the environment for common problems
+func Run(cfg config.Config) []Result {
+	isRunning := isGomonRunning(cfg)
+
+	results := []Result{}
+	for _, c := range checks {
+		results = append(results, c(cfg, isRunning)...)
+	}
+	return results
+}
+
+// Print writes the results, it returns true if any problems were found
+func Print(w io.Writer, results []Result) bool {
+	hasProblems := false
+	for _, r := range results {
+		fmt.Fprintf(w, "[%s] %s: %s\n", r.Status, r.Check, r.Message)
+		if r.Fix != "" && r.Status != StatusOK {
+			fmt.Fprintf(w, "       fix: %s\n", r.Fix)
+		}
+		hasProblems = hasProblems || r.Status == StatusProblem
+	}
+	return hasProblems
+}
+
+// isGomonRunning reports whether a gomon instance for the project is answering on the UI port, its ports and
+// database being in use aren't problems
+func isGomonRunning(cfg config.Config) bool {
+	uiURL := cfg.UIURL()
+	if uiURL == "" {
+		return false
+	}
+
+	client := http.Client{Timeout: dialTimeout}
+	res, err := client.Get(uiURL + "/healthz")
+	if err != nil {
+		return false
+	}
+	res.Body.Close()
+	return res.StatusCode == http.StatusOK || res.StatusCode == http.StatusServiceUnavailable
+}
+
+func checkEntrypoint(cfg config.Config, isRunning bool) []Result {
+	if cfg.ProxyOnly {
+		return nil
+	}
+
+	if cfg.Entrypoint == "" {
+		return []Result{{
+			Check:   "entrypoint",
+			Status:  StatusProblem,
+			Message: "no entrypoint is configured",
+			Fix:     "pass it on the command line e.g. `gomon ./cmd/server` or set entrypoint in the config file, `gomon init` creates one",
+		}}
+	}
+
+	entrypointPath := cfg.Entrypoint
+	if !filepath.IsAbs(entrypointPath) {
+		entrypointPath = filepath.Join(cfg.RootDirectory, entrypointPath)
+	}
+	if _, err := os.Stat(entrypointPath); err == nil {
+		return []Result{{Check: "entrypoint", Status: StatusOK, Message: cfg.Entrypoint}}
+	}
+
+	// go run also accepts package paths e.g. example.com/app/cmd/server
+	firstSegment := strings.Split(cfg.Entrypoint, "/")[0]
+	if !strings.HasPrefix(cfg.Entrypoint, ".") && strings.Contains(firstSegment, ".") && !strings.HasSuffix(cfg.Entrypoint, ".go") {
+		return []Result{{
+			Check:   "entrypoint",
+			Status:  StatusWarning,
+			Message: fmt.Sprintf("%s isn't in the root directory, assuming it is a package path", cfg.Entrypoint),
+			Fix:     fmt.Sprintf("check that `go run %s` works", cfg.Entrypoint),
+		}}
+	}
+
+	return []Result{{
+		Check:   "entrypoint",
+		Status:  StatusProblem,
+		Message: fmt.Sprintf("%s doesn't exist in %s", cfg.Entrypoint, cfg.RootDirectory),
+		Fix:     "entrypoint is relative to the root directory, check it and rootDirectory (or --dir)",
+	}}
+}
+
+type portUse struct {
+	setting string
+	port    int
+}
+
+func checkPorts(cfg config.Config, isRunning bool) []Result {
+	listeners := []portUse{}
+	if cfg.Proxy.Enabled {
+		listeners = append(listeners, portUse{"proxy.port", portOrDefault(cfg.Proxy.Port, config.DefaultProxyPort)})
+	}
+	if cfg.UI.Enabled && !(cfg.UI.MountOnProxy && cfg.Proxy.Enabled) {
+		listeners = append(listeners, portUse{"ui.port", portOrDefault(cfg.UI.Port, config.DefaultUIPort)})
+	}
+	if len(listeners) == 0 {
+		return nil
+	}
+
+	results := []Result{}
+
+	used := append([]portUse{}, listeners...)
+	if cfg.Proxy.Enabled {
+		if _, portStr, err := net.SplitHostPort(cfg.Proxy.Downstream.Host); err == nil {
+			if port, err := strconv.Atoi(portStr); err == nil {
+				used = append(used, portUse{"proxy.downstream.host", port})
+			}
+		}
+	}
+	for i, a := range used {
+		for _, b := range used[:i] {
+			if a.port == b.port {
+				results = append(results, Result{
+					Check:   "ports",
+					Status:  StatusProblem,
+					Message: fmt.Sprintf("%s and %s are both port %d", b.setting, a.setting, a.port),
+					Fix:     "give each of them a different port",
+				})
+			}
+		}
+	}
+
+	for _, l := range listeners {
+		if isRunning {
+			results = append(results, Result{Check: "ports", Status: StatusOK, Message: fmt.Sprintf("%d (%s) is in use by the running gomon", l.port, l.setting)})
+			continue
+		}
+
+		listener, err := net.Listen("tcp", fmt.Sprintf(":%d", l.port))
+		if err != nil {
+			results = append(results, Result{
+				Check:   "ports",
+				Status:  StatusProblem,
+				Message: portInUse(l),
+				Fix:     fmt.Sprintf("stop the process which is using it, change %s or set autoPorts to use the next free port", l.setting),
+			})
+			continue
+		}
+		listener.Close()
+		results = append(results, Result{Check: "ports", Status: StatusOK, Message: fmt.Sprintf("%d (%s) is available", l.port, l.setting)})
+	}
+
+	return results
+}
+
+func portInUse(l portUse) string {
+	if owner := utils.PortOwner(l.port); owner != "" {
+		return fmt.Sprintf("%d (%s) is already in use by %s", l.port, l.setting, owner)
+	}
+	return fmt.Sprintf("%d (%s) is already in use", l.port, l.setting)
+}
+
+func portOrDefault(port, defaultPort int) int {
+	if port == 0 {
+		return defaultPort
+	}
+	return port
+}
+
+func checkDownstream(cfg config.Config, isRunning bool) []Result {
+	if !cfg.Proxy.Enabled {
+		return nil
+	}
+
+	host := cfg.Proxy.Downstream.Host
+	if host == "" {
+		return []Result{{
+			Check:   "proxy downstream",
+			Status:  StatusProblem,
+			Message: "proxy.downstream.host isn't set",
+			Fix:     "set it to the host:port your app listens on e.g. localhost:8081",
+		}}
+	}
+
+	conn, err := net.DialTimeout("tcp", host, dialTimeout)
+	if err != nil {
+		return []Result{{
+			Check:   "proxy downstream",
+			Status:  StatusWarning,
+			Message: fmt.Sprintf("can't connect to %s: %v", host, err),
+			Fix:     "this is expected if your app isn't running, otherwise check that proxy.downstream.host matches the address it listens on",
+		}}
+	}
+	conn.Close()
+
+	return []Result{{Check: "proxy downstream", Status: StatusOK, Message: fmt.Sprintf("%s is accepting connections", host)}}
+}
+
+func checkDatabase(cfg config.Config, isRunning bool) []Result {
+	switch utils.StorageType(cfg) {
+	case config.StorageMemory:
+		return []Result{{Check: "database", Status: StatusOK, Message: "history is kept in memory"}}
+	case config.StorageJSONL:
+		return []Result{{Check: "database", Status: StatusOK, Message: "history is kept in .gomon/history.jsonl"}}
+	}
+
+	results := []Result{}
+
+	err := utils.CheckDatabase(cfg.RootDirectory)
+	switch {
+	case err == nil:
+		results = append(results, Result{Check: "database", Status: StatusOK, Message: "no problems found"})
+	case errors.Is(err, utils.ErrDatabaseLocked) && isRunning:
+		results = append(results, Result{Check: "database", Status: StatusOK, Message: "in use by the running gomon"})
+	case errors.Is(err, utils.ErrDatabaseLocked):
+		results = append(results, Result{
+			Check:   "database",
+			Status:  StatusProblem,
+			Message: "locked by another process, e.g. a gomon which hasn't finished exiting or one with the UI disabled",
+			Fix:     "stop the other process, `lsof .gomon/gomon.db` shows which one",
+		})
+	case errors.Is(err, utils.ErrDatabaseCorrupt):
+		results = append(results, Result{
+			Check:   "database",
+			Status:  StatusProblem,
+			Message: err.Error(),
+			Fix:     "gomon moves a corrupt database aside and starts a new one when it starts, or delete .gomon/gomon.db (the history will be lost)",
+		})
+	default:
+		results = append(results, Result{
+			Check:   "database",
+			Status:  StatusProblem,
+			Message: err.Error(),
+			Fix:     "check the permissions of the .gomon directory",
+		})
+	}
+
+	// corrupt databases are kept in case they need to be recovered, but they are never used again
+	corrupt, _ := filepath.Glob(filepath.Join(cfg.RootDirectory, ".gomon", "gomon.db.corrupt-*"))
+	if len(corrupt) > 0 {
+		results = append(results, Result{
+			Check:   "database",
+			Status:  StatusWarning,
+			Message: fmt.Sprintf("%d files from corrupt databases are in .gomon", len(corrupt)),
+			Fix:     "delete .gomon/gomon.db.corrupt-* if you don't need to recover them",
+		})
+	}
+
+	return results
+}
@@ -0,0 +1,99 @@
+package doctor
+
+// gomon is a simple command line tool that watches your files and automatically restarts the application when it detects any changes in the working directory.
+// Copyright (C) 2023 John Dudmesh
+
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+
+// You should have received a copy of the GNU General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>.
+
+import (
+	"net"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/jdudmesh/gomon/internal/config"
+)
+
+func TestCheckEntrypoint(t *testing.T) {
+	rootDirectory := t.TempDir()
+	os.WriteFile(filepath.Join(rootDirectory, "main.go"), []byte("package main"), 0644)
+
+	tests := []struct {
+		entrypoint string
+		expected   Status
+	}{
+		{"", StatusProblem},
+		{"main.go", StatusOK},
+		{"./cmd/server", StatusProblem},
+		{"example.com/app/cmd/server", StatusWarning},
+	}
+
+	for _, tt := range tests {
+		results := checkEntrypoint(config.Config{RootDirectory: rootDirectory, Entrypoint: tt.entrypoint}, false)
+		if len(results) != 1 || results[0].Status != tt.expected {
+			t.Errorf("%q: expected %s, got %+v", tt.entrypoint, tt.expected, results)
+		}
+	}
+}
+
+func TestCheckPorts(t *testing.T) {
+	listener, err := net.Listen("tcp", ":0")
+	if err != nil {
+		t.Fatalf("listening: %v", err)
+	}
+	defer listener.Close()
+	port := listener.Addr().(*net.TCPAddr).Port
+
+	cfg := config.Config{}
+	cfg.Proxy.Enabled = true
+	cfg.Proxy.Port = port
+	cfg.Proxy.Downstream.Host = "localhost:4001"
+	cfg.UI.Enabled = true
+
+	messages := []string{}
+	for _, r := range checkPorts(cfg, false) {
+		if r.Status == StatusProblem {
+			messages = append(messages, r.Message)
+		}
+	}
+	all := strings.Join(messages, "\n")
+
+	if !strings.Contains(all, "ui.port and proxy.downstream.host are both port 4001") {
+		t.Errorf("expected the port conflict to be reported, got:\n%s", all)
+	}
+	if !strings.Contains(all, "(proxy.port) is already in use") {
+		t.Errorf("expected the port in use to be reported, got:\n%s", all)
+	}
+
+	for _, r := range checkPorts(cfg, true) {
+		if strings.Contains(r.Message, "already in use") {
+			t.Errorf("ports used by a running gomon shouldn't be reported: %s", r.Message)
+		}
+	}
+}
+
+func TestCheckDatabase(t *testing.T) {
+	rootDirectory := t.TempDir()
+	os.MkdirAll(filepath.Join(rootDirectory, ".gomon"), 0755)
+	os.WriteFile(filepath.Join(rootDirectory, ".gomon", "gomon.db"), []byte(strings.Repeat("not a database", 1000)), 0644)
+	os.WriteFile(filepath.Join(rootDirectory, ".gomon", "gomon.db.corrupt-20240101-000000"), nil, 0644)
+
+	cfg := config.Config{RootDirectory: rootDirectory}
+	cfg.Storage.Type = config.StorageSQLite
+	results := checkDatabase(cfg, false)
+	if len(results) != 2 || results[0].Status != StatusProblem || results[1].Status != StatusWarning {
+		t.Errorf("expected the corrupt database to be reported, got %+v", results)
+	}
+}
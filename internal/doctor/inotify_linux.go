@@ -0,0 +1,65 @@
+//go:build linux
+
+package doctor
+
+// gomon is a simple command line tool that watches your files and automatically restarts the application when it detects any changes in the working directory.
+// Copyright (C) 2023 John Dudmesh
+
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+
+// You should have received a copy of the GNU General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>.
+
+import (
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+
+	"github.com/jdudmesh/gomon/internal/config"
+	"github.com/jdudmesh/gomon/internal/watcher"
+)
+
+const maxUserWatchesPath = "/proc/sys/fs/inotify/max_user_watches"
+
+// checkInotify compares the number of directories gomon watches with the inotify limit, the limit is shared with
+// every other program the user runs (e.g. IDEs) so a warning is given well before it is reached
+func checkInotify(cfg config.Config, isRunning bool) []Result {
+	buf, err := os.ReadFile(maxUserWatchesPath)
+	if err != nil {
+		return []Result{{Check: "inotify watches", Status: StatusWarning, Message: fmt.Sprintf("reading limit: %v", err)}}
+	}
+	limit, err := strconv.Atoi(strings.TrimSpace(string(buf)))
+	if err != nil {
+		return []Result{{Check: "inotify watches", Status: StatusWarning, Message: fmt.Sprintf("parsing limit: %v", err)}}
+	}
+
+	count, err := watcher.CountWatchedDirectories(cfg)
+	if err != nil {
+		return []Result{{Check: "inotify watches", Status: StatusWarning, Message: fmt.Sprintf("counting directories: %v", err)}}
+	}
+
+	return []Result{inotifyResult(count, limit)}
+}
+
+func inotifyResult(count, limit int) Result {
+	message := fmt.Sprintf("%d directories are watched, the limit is %d", count, limit)
+	fix := "raise the limit with `sudo sysctl -w fs.inotify.max_user_watches=524288` (add fs.inotify.max_user_watches=524288 to /etc/sysctl.d/99-gomon.conf to keep it after a reboot) or add large directories such as node_modules to excludePaths"
+
+	switch {
+	case count >= limit:
+		return Result{Check: "inotify watches", Status: StatusProblem, Message: message, Fix: fix}
+	case count > limit*8/10:
+		return Result{Check: "inotify watches", Status: StatusWarning, Message: message + ", other programs share the limit", Fix: fix}
+	default:
+		return Result{Check: "inotify watches", Status: StatusOK, Message: message}
+	}
+}
@@ -0,0 +1,38 @@
+//go:build linux
+
+package doctor
+
+// gomon is a simple command line tool that watches your files and automatically restarts the application when it detects any changes in the working directory.
+// Copyright (C) 2023 John Dudmesh
+
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+
+// You should have received a copy of the GNU General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>.
+
+import "testing"
+
+func TestInotifyResult(t *testing.T) {
+	tests := []struct {
+		count    int
+		expected Status
+	}{
+		{100, StatusOK},
+		{900, StatusWarning},
+		{1000, StatusProblem},
+	}
+
+	for _, tt := range tests {
+		if r := inotifyResult(tt.count, 1000); r.Status != tt.expected {
+			t.Errorf("%d: expected %s, got %s", tt.count, tt.expected, r.Status)
+		}
+	}
+}
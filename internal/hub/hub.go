@@ -0,0 +1,432 @@
+package hub
+
+// gomon is a simple command line tool that watches your files and automatically restarts the application when it detects any changes in the working directory.
+// Copyright (C) 2023 John Dudmesh
+
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+
+// You should have received a copy of the GNU General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>.
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/jdudmesh/gomon/internal/attach"
+	"github.com/jdudmesh/gomon/internal/notification"
+	"github.com/jdudmesh/gomon/internal/utils"
+	"github.com/r3labs/sse/v2"
+	"github.com/sirupsen/logrus"
+)
+
+var log = logrus.WithField("component", "hub")
+
+const (
+	// DefaultListen only accepts connections from this machine, the hub can restart every project it shows
+	DefaultListen = "127.0.0.1:4100"
+	// eventsStream carries the events and status changes of every project
+	eventsStream = "events"
+	// maxProjectEvents is how many of each project's most recent events are shown when the dashboard is opened
+	maxProjectEvents = 1000
+	statusInterval   = 5 * time.Second
+	retryInterval    = 5 * time.Second
+)
+
+// ProjectStatus is the state of a project's child process as last reported by its gomon instance
+type ProjectStatus struct {
+	Name string `json:"name"`
+	// URL is the project's UI, without any credentials
+	URL       string `json:"url,omitempty"`
+	Connected bool   `json:"connected"`
+	State     string `json:"state,omitempty"`
+	RunID     string `json:"runId,omitempty"`
+	PID       int    `json:"pid,omitempty"`
+	Uptime    string `json:"uptime,omitempty"`
+	Error     string `json:"error,omitempty"`
+}
+
+// Event is published to the dashboard when a project's gomon instance sends a notification or its status changes
+type Event struct {
+	Project string `json:"project"`
+	// Type is the name of the notification's type e.g. "stderr"
+	Type         string                     `json:"type,omitempty"`
+	Notification *notification.Notification `json:"notification,omitempty"`
+	Status       *ProjectStatus             `json:"status,omitempty"`
+}
+
+// projectView is a project's status and recent events, returned when the dashboard is opened
+type projectView struct {
+	Status ProjectStatus `json:"status"`
+	Events []Event       `json:"events"`
+}
+
+// Hub follows several gomon instances e.g. one for each repo being worked on, and shows them in a single dashboard
+type Hub struct {
+	projects  []*project
+	sseServer *sse.Server
+	mux       *http.ServeMux
+}
+
+func New(projects []Project) (*Hub, error) {
+	if len(projects) == 0 {
+		return nil, errors.New("at least one project is required")
+	}
+
+	h := &Hub{
+		sseServer: sse.New(),
+		mux:       http.NewServeMux(),
+	}
+	h.sseServer.AutoReplay = false
+	h.sseServer.CreateStream(eventsStream)
+
+	for _, p := range projects {
+		if p.Name == "" {
+			return nil, errors.New("project name is required")
+		}
+		if h.find(p.Name) != nil {
+			return nil, fmt.Errorf("duplicate project name %s, name them e.g. api=../api", p.Name)
+		}
+		h.projects = append(h.projects, &project{
+			Project: p,
+			publish: h.publish,
+			refresh: make(chan struct{}, 1),
+			status:  ProjectStatus{Name: p.Name},
+		})
+	}
+
+	h.mux.HandleFunc("/", h.pageHandler)
+	h.mux.HandleFunc("/sse", h.sseServer.ServeHTTP)
+	h.mux.HandleFunc("/api/projects", h.projectsHandler)
+	h.mux.HandleFunc("/api/projects/", h.projectHandler)
+
+	return h, nil
+}
+
+// ListenAndServe follows the projects and serves the dashboard until ctx is cancelled
+func (h *Hub) ListenAndServe(ctx context.Context, addr string) error {
+	server := &http.Server{
+		Addr:    addr,
+		Handler: h,
+	}
+	serverErrors := make(chan error, 1)
+	go func() {
+		serverErrors <- server.ListenAndServe()
+	}()
+	// streams never finish so the server is closed rather than shut down gracefully
+	defer server.Close()
+	defer h.sseServer.Close()
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	wg := sync.WaitGroup{}
+	for _, p := range h.projects {
+		wg.Add(2)
+		go func(p *project) {
+			defer wg.Done()
+			p.follow(ctx)
+		}(p)
+		go func(p *project) {
+			defer wg.Done()
+			p.poll(ctx)
+		}(p)
+	}
+	defer wg.Wait()
+
+	log.Infof("hub listening on http://%s", addr)
+
+	select {
+	case <-ctx.Done():
+		return nil
+	case err := <-serverErrors:
+		return fmt.Errorf("serving hub: %w", err)
+	}
+}
+
+func (h *Hub) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	h.mux.ServeHTTP(w, r)
+}
+
+func (h *Hub) find(name string) *project {
+	for _, p := range h.projects {
+		if p.Name == name {
+			return p
+		}
+	}
+	return nil
+}
+
+func (h *Hub) publish(e Event) {
+	data, err := json.Marshal(e)
+	if err != nil {
+		log.Errorf("marshalling event: %v", err)
+		return
+	}
+	h.sseServer.Publish(eventsStream, &sse.Event{Data: data})
+}
+
+func (h *Hub) pageHandler(w http.ResponseWriter, r *http.Request) {
+	if r.URL.Path != "/" {
+		w.WriteHeader(http.StatusNotFound)
+		return
+	}
+	w.Header().Set("Content-Type", "text/html; charset=utf-8")
+	w.Write([]byte(dashboardPage))
+}
+
+// projectsHandler returns the status and recent events of every project
+func (h *Hub) projectsHandler(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		w.WriteHeader(http.StatusMethodNotAllowed)
+		return
+	}
+
+	views := make([]projectView, 0, len(h.projects))
+	for _, p := range h.projects {
+		views = append(views, p.view())
+	}
+	writeJSON(w, http.StatusOK, views)
+}
+
+// projectHandler restarts a project's child process e.g. POST /api/projects/api/restart?type=soft
+func (h *Hub) projectHandler(w http.ResponseWriter, r *http.Request) {
+	name, action, _ := strings.Cut(strings.TrimPrefix(r.URL.Path, "/api/projects/"), "/")
+	name, err := url.PathUnescape(name)
+	if err != nil {
+		writeJSONError(w, "invalid project name", http.StatusBadRequest)
+		return
+	}
+	p := h.find(name)
+	if p == nil || action != "restart" {
+		writeJSONError(w, "not found", http.StatusNotFound)
+		return
+	}
+	if r.Method != http.MethodPost {
+		w.WriteHeader(http.StatusMethodNotAllowed)
+		return
+	}
+
+	var notifType notification.NotificationType
+	switch restartType := r.URL.Query().Get("type"); restartType {
+	case "", "hard":
+		notifType = notification.NotificationTypeHardRestartRequested
+	case "soft":
+		notifType = notification.NotificationTypeSoftRestartRequested
+	default:
+		writeJSONError(w, fmt.Sprintf("unknown restart type: %s", restartType), http.StatusBadRequest)
+		return
+	}
+
+	client := p.currentClient()
+	if client == nil {
+		writeJSONError(w, fmt.Sprintf("%s is not connected", p.Name), http.StatusServiceUnavailable)
+		return
+	}
+	err = client.Request(notification.Notification{Type: notifType, Message: "hub"})
+	if err != nil {
+		writeJSONError(w, err.Error(), http.StatusBadGateway)
+		return
+	}
+	writeJSON(w, http.StatusAccepted, map[string]string{"project": p.Name, "type": notifType.String()})
+}
+
+func writeJSON(w http.ResponseWriter, status int, v any) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	err := json.NewEncoder(w).Encode(v)
+	if err != nil {
+		log.Errorf("writing response: %v", err)
+	}
+}
+
+func writeJSONError(w http.ResponseWriter, message string, status int) {
+	writeJSON(w, status, map[string]string{"error": message})
+}
+
+// project follows one gomon instance, keeping its status and most recent events for the dashboard
+type project struct {
+	Project
+	publish func(e Event)
+	// refresh is signalled when the child process changes state so that the status isn't out of date until the next
+	// poll
+	refresh chan struct{}
+	lock    sync.Mutex
+	client  *attach.Client
+	status  ProjectStatus
+	events  []Event
+}
+
+// follow streams the project's events until ctx is cancelled. The endpoint is looked up again each time the stream
+// ends, e.g. because gomon was restarted with a new token, and while gomon isn't running.
+func (p *project) follow(ctx context.Context) {
+	for {
+		err := p.connect()
+		if err == nil {
+			err = p.currentClient().Stream(ctx, p.notify)
+		}
+		if ctx.Err() != nil {
+			return
+		}
+		if err != nil {
+			log.WithField("project", p.Name).Debugf("following: %v", err)
+			p.setStatus(func(s *ProjectStatus) {
+				s.Connected = false
+				s.Error = err.Error()
+			})
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(retryInterval):
+		}
+	}
+}
+
+func (p *project) connect() error {
+	baseURL, token, err := p.Endpoint()
+	if err != nil {
+		return err
+	}
+	client, err := attach.NewClient(baseURL, token)
+	if err != nil {
+		return err
+	}
+
+	displayURL := ""
+	if u, err := url.Parse(baseURL); err == nil {
+		u.User = nil
+		displayURL = u.String()
+	}
+
+	p.lock.Lock()
+	p.client = client
+	p.lock.Unlock()
+	p.setStatus(func(s *ProjectStatus) {
+		s.URL = displayURL
+	})
+	p.signalRefresh()
+	return nil
+}
+
+func (p *project) currentClient() *attach.Client {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+	return p.client
+}
+
+// poll fetches the state of the project's child process every few seconds, and whenever it has changed
+func (p *project) poll(ctx context.Context) {
+	ticker := time.NewTicker(statusInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		case <-p.refresh:
+		}
+
+		client := p.currentClient()
+		if client == nil {
+			continue
+		}
+		state, err := client.State()
+		if errors.Is(err, attach.ErrUnauthorized) {
+			// gomon has been restarted with a new token, the event stream may not need one so it is still connected
+			if p.connect() == nil {
+				state, err = p.currentClient().State()
+			}
+		}
+		p.setStatus(func(s *ProjectStatus) {
+			applyState(s, state, err)
+		})
+	}
+}
+
+func applyState(s *ProjectStatus, state utils.StateDump, err error) {
+	if err != nil {
+		s.Connected = false
+		s.State = ""
+		s.RunID = ""
+		s.PID = 0
+		s.Uptime = ""
+		s.Error = err.Error()
+		return
+	}
+	s.Connected = true
+	s.State = state.Child.State
+	s.RunID = state.Child.RunID
+	s.PID = state.Child.PID
+	s.Uptime = state.Child.Uptime
+	s.Error = state.Restart.LastError
+}
+
+func (p *project) signalRefresh() {
+	select {
+	case p.refresh <- struct{}{}:
+	default:
+	}
+}
+
+// setStatus applies a change to the status and publishes it if anything changed
+func (p *project) setStatus(fn func(s *ProjectStatus)) {
+	p.lock.Lock()
+	prev := p.status
+	fn(&p.status)
+	next := p.status
+	p.lock.Unlock()
+
+	if prev != next {
+		p.publish(Event{Project: p.Name, Status: &next})
+	}
+}
+
+func (p *project) notify(n notification.Notification) error {
+	e := Event{Project: p.Name, Type: n.Type.String(), Notification: &n}
+
+	p.lock.Lock()
+	p.events = append(p.events, e)
+	if len(p.events) > maxProjectEvents {
+		p.events = slices.Clone(p.events[len(p.events)-maxProjectEvents:])
+	}
+	p.lock.Unlock()
+
+	p.publish(e)
+
+	switch n.Type {
+	case notification.NotificationTypeStartup, notification.NotificationTypeHardRestart, notification.NotificationTypeShutdown,
+		notification.NotificationTypeCrash, notification.NotificationTypeBuildError, notification.NotificationTypeRunning,
+		notification.NotificationTypeUnhealthy:
+		p.signalRefresh()
+	}
+	return nil
+}
+
+func (p *project) view() projectView {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+	events := make([]Event, len(p.events))
+	copy(events, p.events)
+	return projectView{
+		Status: p.status,
+		Events: events,
+	}
+}
@@ -0,0 +1,152 @@
+package hub
+
+// gomon is a simple command line tool that watches your files and automatically restarts the application when it detects any changes in the working directory.
+// Copyright (C) 2023 John Dudmesh
+
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+
+// You should have received a copy of the GNU General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>.
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/jdudmesh/gomon/internal/config"
+	"github.com/jdudmesh/gomon/internal/notification"
+	"github.com/jdudmesh/gomon/internal/utils"
+	"github.com/r3labs/sse/v2"
+)
+
+func TestParseProject(t *testing.T) {
+	p, err := ParseProject("api=http://localhost:4001", "secret")
+	if err != nil {
+		t.Fatalf("parsing url: %v", err)
+	}
+	baseURL, token, _ := p.Endpoint()
+	if p.Name != "api" || baseURL != "http://localhost:4001" || token != "secret" {
+		t.Errorf("unexpected project: %s %s %s", p.Name, baseURL, token)
+	}
+
+	p, err = ParseProject("http://localhost:4002", "")
+	if err != nil || p.Name != "localhost:4002" {
+		t.Errorf("expected the host as the name, got %q, %v", p.Name, err)
+	}
+
+	_, err = ParseProject("ftp://localhost", "")
+	if err == nil {
+		t.Error("expected an error for an unsupported url")
+	}
+
+	dir := filepath.Join(t.TempDir(), "web")
+	os.MkdirAll(filepath.Join(dir, ".gomon"), 0755)
+	os.WriteFile(filepath.Join(dir, config.DefaultConfigFileName), []byte("entrypoint: main.go\nui:\n  enabled: true\n  port: 4005\n"), 0644)
+	os.WriteFile(filepath.Join(dir, ".gomon", "api_token"), []byte("abc\n"), 0600)
+
+	t.Setenv("GOMON_TOKEN", "")
+	p, err = ParseProject(dir, "ignored")
+	if err != nil {
+		t.Fatalf("parsing directory: %v", err)
+	}
+	baseURL, token, err = p.Endpoint()
+	if p.Name != "web" || baseURL != "http://localhost:4005" || token != "abc" || err != nil {
+		t.Errorf("unexpected project: %s %s %s %v", p.Name, baseURL, token, err)
+	}
+
+	os.WriteFile(filepath.Join(dir, config.DefaultConfigFileName), []byte("entrypoint: main.go\n"), 0644)
+	_, err = ParseProject(dir, "")
+	if err == nil {
+		t.Error("expected an error for a project without the ui")
+	}
+}
+
+func TestHub(t *testing.T) {
+	sseServer := sse.New()
+	sseServer.AutoReplay = false
+	sseServer.CreateStream("notifications")
+	defer sseServer.Close()
+
+	restarts := make(chan string, 1)
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/sse":
+			sseServer.ServeHTTP(w, r)
+		case "/api/state":
+			json.NewEncoder(w).Encode(utils.StateDump{Child: utils.ChildState{RunID: "123", PID: 42, State: "started"}})
+		case "/api/restart":
+			restarts <- r.URL.Query().Get("type")
+			w.WriteHeader(http.StatusAccepted)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer srv.Close()
+
+	h, err := New([]Project{{
+		Name:     "api",
+		Endpoint: func() (string, string, error) { return srv.URL, "", nil },
+	}})
+	if err != nil {
+		t.Fatalf("creating hub: %v", err)
+	}
+
+	_, err = New([]Project{h.projects[0].Project, h.projects[0].Project})
+	if err == nil {
+		t.Error("expected an error for duplicate project names")
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	p := h.find("api")
+	go p.follow(ctx)
+	go p.poll(ctx)
+
+	// the subscription may not be ready when the first event is published
+	data, _ := json.Marshal(notification.Notification{ID: "1", Type: notification.NotificationTypeStdErr, Message: "boom"})
+	for {
+		sseServer.Publish("notifications", &sse.Event{Data: data})
+		v := p.view()
+		if v.Status.Connected && len(v.Events) > 0 {
+			if v.Status.State != "started" || v.Status.PID != 42 || v.Status.URL != srv.URL {
+				t.Errorf("unexpected status: %+v", v.Status)
+			}
+			if v.Events[0].Type != "stderr" || v.Events[0].Notification.Message != "boom" {
+				t.Errorf("unexpected event: %+v", v.Events[0])
+			}
+			break
+		}
+		if ctx.Err() != nil {
+			t.Fatalf("timed out, status: %+v", v.Status)
+		}
+		time.Sleep(50 * time.Millisecond)
+	}
+
+	res := httptest.NewRecorder()
+	h.ServeHTTP(res, httptest.NewRequest(http.MethodPost, "/api/projects/api/restart?type=soft", nil))
+	if res.Code != http.StatusAccepted {
+		t.Errorf("unexpected status: %d %s", res.Code, res.Body.String())
+	}
+	if restartType := <-restarts; restartType != "soft" {
+		t.Errorf("unexpected restart type: %s", restartType)
+	}
+
+	res = httptest.NewRecorder()
+	h.ServeHTTP(res, httptest.NewRequest(http.MethodPost, "/api/projects/web/restart", nil))
+	if res.Code != http.StatusNotFound {
+		t.Errorf("expected 404 for an unknown project, got %d", res.Code)
+	}
+}
@@ -0,0 +1,244 @@
+package hub
+
+// gomon is a simple command line tool that watches your files and automatically restarts the application when it detects any changes in the working directory.
+// Copyright (C) 2023 John Dudmesh
+
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+
+// You should have received a copy of the GNU General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>.
+
+// dashboardPage shows a tab for each project with its status, restart buttons and output. Events are loaded from
+// /api/projects and then followed on the SSE stream, events which arrive in between are de-duplicated by ID.
+const dashboardPage = `<!doctype html>
+<html>
+<head>
+	<title>gomon hub</title>
+	<meta charset="utf-8">
+	<style>
+		body { font-family: sans-serif; background: #0f172a; color: #f8fafc; margin: 0; display: flex; flex-direction: column; height: 100vh; }
+		nav { display: flex; align-items: center; gap: 0.5rem; padding: 0.75rem 1rem; background: #3b82f6; }
+		nav h1 { font-size: 1.25rem; margin: 0 1rem 0 0; }
+		.tab { display: flex; align-items: center; gap: 0.4rem; padding: 0.3rem 0.8rem; border-radius: 0.4rem; cursor: pointer; background: #1e3a8a; border: none; color: inherit; font-size: 0.9rem; }
+		.tab.selected { background: #0f172a; }
+		.dot { width: 0.6rem; height: 0.6rem; border-radius: 50%; background: #64748b; }
+		.dot.running { background: #22c55e; }
+		.dot.busy { background: #eab308; }
+		.dot.failed { background: #ef4444; }
+		.count { background: #ef4444; border-radius: 0.6rem; padding: 0 0.4rem; font-size: 0.75rem; }
+		header { display: flex; align-items: center; gap: 1rem; padding: 0.75rem 1rem; border-bottom: 1px solid #334155; }
+		header .state { flex-grow: 1; }
+		header .error { color: #f87171; }
+		button.action { background: #334155; color: inherit; border: none; border-radius: 0.4rem; padding: 0.3rem 0.8rem; cursor: pointer; }
+		a { color: #93c5fd; }
+		main { flex-grow: 1; overflow-y: auto; padding: 0.5rem 1rem; font-family: monospace; font-size: 0.85rem; }
+		main div { white-space: pre-wrap; }
+		.stderr, .buildError, .crash, .unhealthy, .systemError, .testFail { color: #f87171; }
+		.lifecycle { color: #60a5fa; }
+		.task { color: #a3a3a3; }
+	</style>
+</head>
+<body>
+	<nav><h1>gomon hub</h1><span id="tabs"></span></nav>
+	<header>
+		<span class="state" id="state"></span>
+		<button class="action" onclick="restart('hard')">Hard restart</button>
+		<button class="action" onclick="restart('soft')">Soft restart</button>
+		<a id="open" target="_blank">Open UI</a>
+	</header>
+	<main id="output"></main>
+	<script>
+		const maxEvents = 1000;
+		const projects = new Map();
+		let selected = "";
+
+		const lifecycle = ["startup", "hardRestart", "softRestart", "shutdown", "running", "hardRestartRequested", "softRestartRequested"];
+		const failures = ["stderr", "buildError", "crash", "unhealthy", "systemError", "testFail"];
+
+		function project(name) {
+			if (!projects.has(name)) {
+				projects.set(name, { status: { name: name }, events: [], ids: new Set(), unread: 0 });
+			}
+			return projects.get(name);
+		}
+
+		function addEvent(e) {
+			const p = project(e.project);
+			const n = e.notification;
+			if (p.ids.has(n.id)) {
+				return false;
+			}
+			p.ids.add(n.id);
+			p.events.push(e);
+			if (p.events.length > maxEvents) {
+				p.ids.delete(p.events.shift().notification.id);
+			}
+			if (e.project !== selected && failures.includes(e.type)) {
+				p.unread++;
+			}
+			return true;
+		}
+
+		function line(e) {
+			const div = document.createElement("div");
+			const n = e.notification;
+			const time = new Date(n.createdAt).toLocaleTimeString();
+			if (lifecycle.includes(e.type)) {
+				div.className = "lifecycle";
+				div.textContent = time + " " + e.type + (n.message ? ": " + n.message : "");
+			} else if (e.type.startsWith("oobTask")) {
+				div.className = "task " + e.type;
+				div.textContent = "[task] " + n.message;
+			} else {
+				div.className = e.type;
+				div.textContent = n.message;
+			}
+			return div;
+		}
+
+		// health is the colour of a project's tab: grey if gomon isn't reachable, red if the child process stopped
+		// because of an error
+		function health(s) {
+			if (!s.connected) {
+				return "";
+			}
+			switch (s.state) {
+				case "started":
+				case "proxy only":
+					return "running";
+				case "starting":
+				case "stopping":
+					return "busy";
+			}
+			return s.error ? "failed" : "";
+		}
+
+		function renderTabs() {
+			const tabs = document.getElementById("tabs");
+			tabs.replaceChildren();
+			for (const [name, p] of projects) {
+				const tab = document.createElement("button");
+				tab.className = "tab" + (name === selected ? " selected" : "");
+				tab.onclick = () => { location.hash = encodeURIComponent(name); };
+				const dot = document.createElement("span");
+				dot.className = "dot " + health(p.status);
+				tab.append(dot, name);
+				if (p.unread > 0) {
+					const count = document.createElement("span");
+					count.className = "count";
+					count.textContent = p.unread;
+					tab.append(count);
+				}
+				tabs.append(tab);
+			}
+		}
+
+		function renderStatus() {
+			const p = projects.get(selected);
+			if (!p) {
+				return;
+			}
+			const s = p.status;
+			const state = document.getElementById("state");
+			state.replaceChildren();
+			if (!s.connected) {
+				state.append("not connected");
+			} else {
+				state.append(s.state + (s.pid ? ", pid " + s.pid : "") + (s.uptime ? ", up " + s.uptime : "") + (s.runId ? ", run " + s.runId : ""));
+			}
+			if (s.error) {
+				const err = document.createElement("span");
+				err.className = "error";
+				err.textContent = " " + s.error;
+				state.append(err);
+			}
+			const open = document.getElementById("open");
+			open.href = s.url || "#";
+			open.style.visibility = s.url ? "visible" : "hidden";
+		}
+
+		function renderOutput() {
+			const p = projects.get(selected);
+			const output = document.getElementById("output");
+			output.replaceChildren(...(p ? p.events.map(line) : []));
+			output.scrollTop = output.scrollHeight;
+		}
+
+		// select shows a project, the selected project is kept in the URL's fragment e.g. /#api
+		function select(name) {
+			if (!projects.has(name)) {
+				name = projects.keys().next().value;
+			}
+			selected = name;
+			project(name).unread = 0;
+			renderTabs();
+			renderStatus();
+			renderOutput();
+		}
+
+		async function restart(type) {
+			const res = await fetch("/api/projects/" + encodeURIComponent(selected) + "/restart?type=" + type, { method: "POST" });
+			if (!res.ok) {
+				const body = await res.json();
+				alert(body.error || res.statusText);
+			}
+		}
+
+		function onEvent(e) {
+			if (e.status) {
+				project(e.project).status = e.status;
+				renderTabs();
+				if (e.project === selected) {
+					renderStatus();
+				}
+				return;
+			}
+			if (!addEvent(e)) {
+				return;
+			}
+			if (e.project !== selected) {
+				renderTabs();
+				return;
+			}
+			const output = document.getElementById("output");
+			const atEnd = output.scrollTop + output.clientHeight >= output.scrollHeight - 10;
+			output.append(line(e));
+			while (output.childElementCount > maxEvents) {
+				output.firstElementChild.remove();
+			}
+			if (atEnd) {
+				output.scrollTop = output.scrollHeight;
+			}
+		}
+
+		const pending = [];
+		let loaded = false;
+		const source = new EventSource("/sse?stream=events");
+		source.onmessage = (msg) => {
+			const e = JSON.parse(msg.data);
+			loaded ? onEvent(e) : pending.push(e);
+		};
+
+		fetch("/api/projects").then((res) => res.json()).then((views) => {
+			for (const v of views) {
+				const p = project(v.status.name);
+				p.status = v.status;
+				v.events.forEach(addEvent);
+				p.unread = 0;
+			}
+			loaded = true;
+			pending.forEach(onEvent);
+			select(decodeURIComponent(location.hash.slice(1)));
+		});
+		window.onhashchange = () => select(decodeURIComponent(location.hash.slice(1)));
+	</script>
+</body>
+</html>`
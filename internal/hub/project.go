@@ -0,0 +1,88 @@
+package hub
+
+// gomon is a simple command line tool that watches your files and automatically restarts the application when it detects any changes in the working directory.
+// Copyright (C) 2023 John Dudmesh
+
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+
+// You should have received a copy of the GNU General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>.
+
+import (
+	"fmt"
+	"net/url"
+	"path/filepath"
+	"strings"
+
+	"github.com/jdudmesh/gomon/internal/config"
+	"github.com/jdudmesh/gomon/internal/webui"
+)
+
+// Project is a gomon instance shown on the dashboard. Endpoint returns the URL of its UI and the token to use with
+// it, it is called each time the hub connects because gomon generates a new API token every time it starts.
+type Project struct {
+	Name     string
+	Endpoint func() (string, string, error)
+}
+
+// ParseProject reads a project from the command line: the root directory of a project whose config enables the UI,
+// or the URL of a gomon UI, either optionally prefixed with a name e.g. api=../api or api=http://localhost:4001.
+// The token is used for URLs, the token for a directory is found in the same way as `gomon status` does.
+func ParseProject(arg, token string) (Project, error) {
+	name, target, ok := strings.Cut(arg, "=")
+	if !ok || strings.Contains(name, "://") {
+		name, target = "", arg
+	}
+	if target == "" {
+		return Project{}, fmt.Errorf("project %s: a directory or url is required", arg)
+	}
+
+	if strings.Contains(target, "://") {
+		u, err := url.Parse(target)
+		if err != nil {
+			return Project{}, fmt.Errorf("project %s: parsing url: %w", arg, err)
+		}
+		if u.Scheme != "http" && u.Scheme != "https" {
+			return Project{}, fmt.Errorf("project %s: unsupported url, expected http(s)://host:port", arg)
+		}
+		if name == "" {
+			name = u.Host
+		}
+		return Project{
+			Name: name,
+			Endpoint: func() (string, string, error) {
+				return target, token, nil
+			},
+		}, nil
+	}
+
+	cfg, err := config.Load("", target)
+	if err != nil {
+		return Project{}, fmt.Errorf("project %s: loading config: %w", arg, err)
+	}
+	if cfg.UIURL() == "" {
+		return Project{}, fmt.Errorf("project %s: the ui is not enabled", arg)
+	}
+	cfg.RootDirectory, err = filepath.Abs(cfg.RootDirectory)
+	if err != nil {
+		return Project{}, fmt.Errorf("project %s: resolving root directory: %w", arg, err)
+	}
+	if name == "" {
+		name = filepath.Base(cfg.RootDirectory)
+	}
+
+	return Project{
+		Name: name,
+		Endpoint: func() (string, string, error) {
+			return webui.Endpoint(cfg)
+		},
+	}, nil
+}
@@ -0,0 +1,146 @@
+package logfwd
+
+// gomon is a simple command line tool that watches your files and automatically restarts the application when it detects any changes in the working directory.
+// Copyright (C) 2023 John Dudmesh
+
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+
+// You should have received a copy of the GNU General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>.
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/jdudmesh/gomon/internal/config"
+)
+
+const (
+	logFileName    = "gomon.log"
+	rotatedPattern = "gomon-*.log"
+)
+
+// fileWriter appends the records to gomon.log in dir, once the file reaches maxSize it is renamed with the time it
+// was rotated and a new one is started. Only the most recent maxFiles rotated files are kept.
+type fileWriter struct {
+	dir      string
+	maxSize  int64
+	maxFiles int
+	file     *os.File
+	size     int64
+}
+
+func NewFileWriter(dir string, maxSizeMB, maxFiles int) (*fileWriter, error) {
+	if maxSizeMB <= 0 {
+		maxSizeMB = config.DefaultLogFileMaxSizeMB
+	}
+	if maxFiles <= 0 {
+		maxFiles = config.DefaultLogFileMaxFiles
+	}
+
+	err := os.MkdirAll(dir, 0755)
+	if err != nil {
+		return nil, fmt.Errorf("creating log directory: %w", err)
+	}
+
+	w := &fileWriter{
+		dir:      dir,
+		maxSize:  int64(maxSizeMB) * 1024 * 1024,
+		maxFiles: maxFiles,
+	}
+	err = w.open()
+	if err != nil {
+		return nil, err
+	}
+	return w, nil
+}
+
+func (w *fileWriter) open() error {
+	f, err := os.OpenFile(filepath.Join(w.dir, logFileName), os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0644)
+	if err != nil {
+		return fmt.Errorf("opening log file: %w", err)
+	}
+	info, err := f.Stat()
+	if err != nil {
+		f.Close()
+		return fmt.Errorf("checking log file: %w", err)
+	}
+	w.file = f
+	w.size = info.Size()
+	return nil
+}
+
+// Write appends a line for each record e.g. `2024-01-02T15:04:05.000Z b3lk2ef3kx stderr connection refused`
+func (w *fileWriter) Write(ctx context.Context, records []Record) error {
+	buf := bufio.NewWriter(w.file)
+	for _, r := range records {
+		if w.size >= w.maxSize {
+			err := buf.Flush()
+			if err == nil {
+				err = w.rotate()
+			}
+			if err != nil {
+				return err
+			}
+			buf.Reset(w.file)
+		}
+
+		line := fmt.Sprintf("%s %s %s %s\n", r.Date.UTC().Format("2006-01-02T15:04:05.000Z07:00"), r.RunID, r.Stream, r.Message)
+		n, err := buf.WriteString(line)
+		w.size += int64(n)
+		if err != nil {
+			return fmt.Errorf("writing log file: %w", err)
+		}
+	}
+
+	err := buf.Flush()
+	if err != nil {
+		return fmt.Errorf("writing log file: %w", err)
+	}
+	return nil
+}
+
+// rotate renames the current file and starts a new one, deleting the oldest rotated files
+func (w *fileWriter) rotate() error {
+	err := w.file.Close()
+	if err != nil {
+		return fmt.Errorf("closing log file: %w", err)
+	}
+
+	rotated := strings.Replace(rotatedPattern, "*", time.Now().Format("20060102-150405.000"), 1)
+	err = os.Rename(filepath.Join(w.dir, logFileName), filepath.Join(w.dir, rotated))
+	if err != nil {
+		return fmt.Errorf("rotating log file: %w", err)
+	}
+
+	// the timestamps sort in the order the files were rotated
+	files, _ := filepath.Glob(filepath.Join(w.dir, rotatedPattern))
+	slices.Sort(files)
+	for len(files) > w.maxFiles {
+		err = os.Remove(files[0])
+		if err != nil {
+			log.Warnf("removing old log file: %v", err)
+		}
+		files = files[1:]
+	}
+
+	return w.open()
+}
+
+func (w *fileWriter) Close() error {
+	return w.file.Close()
+}
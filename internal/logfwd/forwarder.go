@@ -0,0 +1,285 @@
+package logfwd
+
+// gomon is a simple command line tool that watches your files and automatically restarts the application when it detects any changes in the working directory.
+// Copyright (C) 2023 John Dudmesh
+
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+
+// You should have received a copy of the GNU General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>.
+
+import (
+	"context"
+	"fmt"
+	"path/filepath"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/jdudmesh/gomon/internal/config"
+	"github.com/jdudmesh/gomon/internal/notification"
+	"github.com/jdudmesh/gomon/internal/process"
+	"github.com/jdudmesh/gomon/internal/utils"
+	"github.com/sirupsen/logrus"
+)
+
+var log = logrus.WithField("component", "logfwd")
+
+const (
+	queueSize     = 4096
+	batchSize     = 500
+	flushInterval = 200 * time.Millisecond
+	writeTimeout  = 10 * time.Second
+)
+
+// Record is a line of the child process's output, Stream is stdout or stderr and Level is empty if the line
+// doesn't have one. Colour codes are removed from the message.
+type Record struct {
+	Date    time.Time
+	RunID   string
+	Stream  string
+	Level   string
+	Message string
+}
+
+// Writer sends records to a log collector, the records are in the order they were written
+type Writer interface {
+	Write(ctx context.Context, records []Record) error
+	Close() error
+}
+
+type output struct {
+	name    string
+	writer  Writer
+	queue   chan Record
+	dropped atomic.Int64
+	// failing is set while writes are failing so that an outage is only logged once
+	failing bool
+}
+
+// Forwarder tees the child process's output to the configured writers. Each writer has a queue of its own and is
+// written to in batches in the background, so a slow collector can't hold up gomon or the other writers. Lines are
+// dropped when a queue is full.
+type Forwarder struct {
+	outputs   []*output
+	done      chan struct{}
+	wait      sync.WaitGroup
+	closeOnce sync.Once
+}
+
+func New(cfg config.Config) (*Forwarder, error) {
+	f := &Forwarder{done: make(chan struct{})}
+
+	secrets := process.NewSecretResolver()
+	for ix, sinkCfg := range cfg.LogForwarding {
+		writer, err := newWriter(cfg, sinkCfg, secrets)
+		if err != nil {
+			f.closeWriters()
+			return nil, fmt.Errorf("log forwarding %d: %w", ix, err)
+		}
+		f.outputs = append(f.outputs, &output{
+			name:   fmt.Sprintf("%s[%d]", sinkCfg.Type, ix),
+			writer: writer,
+			queue:  make(chan Record, queueSize),
+		})
+	}
+
+	// the outputs are written to from the start so that Close can always wait for them to finish
+	for _, o := range f.outputs {
+		f.wait.Add(1)
+		go func(o *output) {
+			defer f.wait.Done()
+			f.run(o)
+		}(o)
+	}
+
+	return f, nil
+}
+
+func newWriter(cfg config.Config, sinkCfg config.LogSink, secrets *process.SecretResolver) (Writer, error) {
+	switch sinkCfg.Type {
+	case config.LogSinkTypeFile:
+		dir := sinkCfg.Path
+		if dir == "" {
+			dir = filepath.Join(".gomon", "logs")
+		}
+		if !filepath.IsAbs(dir) {
+			dir = filepath.Join(cfg.RootDirectory, dir)
+		}
+		return NewFileWriter(dir, sinkCfg.MaxSizeMB, sinkCfg.MaxFiles)
+	case config.LogSinkTypeSyslog:
+		return NewSyslogWriter(sinkCfg.Address)
+	case config.LogSinkTypeLoki, config.LogSinkTypeOTLP:
+		if sinkCfg.URL == "" {
+			return nil, fmt.Errorf("%s sink requires a url", sinkCfg.Type)
+		}
+		url, err := secrets.Resolve(sinkCfg.URL)
+		if err != nil {
+			return nil, fmt.Errorf("resolving url: %w", err)
+		}
+		headers := map[string]string{}
+		for k, v := range sinkCfg.Headers {
+			headers[k], err = secrets.Resolve(v)
+			if err != nil {
+				return nil, fmt.Errorf("resolving header %s: %w", k, err)
+			}
+		}
+		labels := map[string]string{"project": filepath.Base(cfg.RootDirectory)}
+		for k, v := range sinkCfg.Labels {
+			labels[k] = v
+		}
+		if sinkCfg.Type == config.LogSinkTypeLoki {
+			return NewLokiWriter(url, headers, labels), nil
+		}
+		return NewOTLPWriter(url, headers, labels), nil
+	default:
+		return nil, fmt.Errorf("unsupported log sink type: %s", sinkCfg.Type)
+	}
+}
+
+// Start blocks until the forwarder is closed, the records are written in the background from when it is created
+func (f *Forwarder) Start() error {
+	<-f.done
+	return nil
+}
+
+// Close stops forwarding once the records which have already been queued have been written
+func (f *Forwarder) Close() error {
+	f.closeOnce.Do(func() {
+		close(f.done)
+		f.wait.Wait()
+		f.closeWriters()
+	})
+	return nil
+}
+
+func (f *Forwarder) closeWriters() {
+	for _, o := range f.outputs {
+		err := o.writer.Close()
+		if err != nil {
+			log.Warnf("closing %s: %v", o.name, err)
+		}
+	}
+}
+
+func (f *Forwarder) Notify(n notification.Notification) error {
+	if len(f.outputs) == 0 {
+		return nil
+	}
+
+	stream := ""
+	switch n.Type {
+	case notification.NotificationTypeStdOut:
+		stream = "stdout"
+	case notification.NotificationTypeStdErr:
+		stream = "stderr"
+	default:
+		return nil
+	}
+
+	select {
+	case <-f.done:
+		return nil
+	default:
+	}
+
+	r := Record{
+		Date:    n.Date,
+		RunID:   n.ChildProccessID,
+		Stream:  stream,
+		Level:   n.Level,
+		Message: utils.StripANSI(n.Message),
+	}
+	for _, o := range f.outputs {
+		select {
+		case o.queue <- r:
+		default:
+			o.dropped.Add(1)
+		}
+	}
+
+	return nil
+}
+
+func (f *Forwarder) QueueStats() map[string]utils.QueueStats {
+	stats := map[string]utils.QueueStats{}
+	for _, o := range f.outputs {
+		stats["logs."+o.name] = utils.QueueStats{Depth: len(o.queue), Capacity: cap(o.queue), Dropped: o.dropped.Load()}
+	}
+	return stats
+}
+
+// run writes the records queued for an output in batches, a batch is written once it has batchSize records or
+// flushInterval after its first record arrived
+func (f *Forwarder) run(o *output) {
+	batch := make([]Record, 0, batchSize)
+	timer := time.NewTimer(flushInterval)
+	timer.Stop()
+
+	for {
+		select {
+		case r := <-o.queue:
+			if len(batch) == 0 {
+				timer.Reset(flushInterval)
+			}
+			batch = append(batch, r)
+			if len(batch) < batchSize {
+				continue
+			}
+			if !timer.Stop() {
+				<-timer.C
+			}
+		case <-timer.C:
+		case <-f.done:
+			timer.Stop()
+			// write anything left in the queue before exiting
+			for {
+				select {
+				case r := <-o.queue:
+					batch = append(batch, r)
+					if len(batch) == batchSize {
+						f.write(o, batch)
+						batch = batch[:0]
+					}
+				default:
+					f.write(o, batch)
+					return
+				}
+			}
+		}
+
+		f.write(o, batch)
+		batch = batch[:0]
+	}
+}
+
+func (f *Forwarder) write(o *output, batch []Record) {
+	if len(batch) == 0 {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), writeTimeout)
+	defer cancel()
+
+	err := o.writer.Write(ctx, batch)
+	if err != nil {
+		o.dropped.Add(int64(len(batch)))
+		if !o.failing {
+			log.Warnf("forwarding logs to %s: %v", o.name, err)
+			o.failing = true
+		}
+		return
+	}
+	if o.failing {
+		log.Infof("forwarding logs to %s has recovered", o.name)
+		o.failing = false
+	}
+}
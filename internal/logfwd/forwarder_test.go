@@ -0,0 +1,142 @@
+package logfwd
+
+// gomon is a simple command line tool that watches your files and automatically restarts the application when it detects any changes in the working directory.
+// Copyright (C) 2023 John Dudmesh
+
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+
+// You should have received a copy of the GNU General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>.
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/jdudmesh/gomon/internal/config"
+	"github.com/jdudmesh/gomon/internal/notification"
+)
+
+func TestForwarderWritesChildOutput(t *testing.T) {
+	lock := sync.Mutex{}
+	bodies := []map[string]any{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body := map[string]any{}
+		json.NewDecoder(r.Body).Decode(&body)
+		lock.Lock()
+		bodies = append(bodies, body)
+		lock.Unlock()
+		if r.Header.Get("X-Scope-OrgID") != "dev" {
+			w.WriteHeader(http.StatusUnauthorized)
+		}
+	}))
+	defer server.Close()
+
+	cfg := config.Config{RootDirectory: t.TempDir()}
+	cfg.LogForwarding = []config.LogSink{
+		{Type: config.LogSinkTypeFile},
+		{Type: config.LogSinkTypeLoki, URL: server.URL, Headers: map[string]string{"X-Scope-OrgID": "dev"}, Labels: map[string]string{"env": "dev"}},
+	}
+	f, err := New(cfg)
+	if err != nil {
+		t.Fatalf("creating forwarder: %v", err)
+	}
+	go f.Start()
+
+	now := time.Now()
+	f.Notify(notification.Notification{Date: now, ChildProccessID: "run1", Type: notification.NotificationTypeStartup, Message: "started"})
+	f.Notify(notification.Notification{Date: now, ChildProccessID: "run1", Type: notification.NotificationTypeStdOut, Message: "\x1b[32mlistening\x1b[0m", Level: notification.LevelInfo})
+	f.Notify(notification.Notification{Date: now, ChildProccessID: "run1", Type: notification.NotificationTypeStdErr, Message: "connection refused", Level: notification.LevelError})
+	f.Close()
+
+	data, err := os.ReadFile(filepath.Join(cfg.RootDirectory, ".gomon", "logs", "gomon.log"))
+	if err != nil {
+		t.Fatalf("reading log file: %v", err)
+	}
+	lines := strings.Split(strings.TrimSpace(string(data)), "\n")
+	if len(lines) != 2 || !strings.HasSuffix(lines[0], " run1 stdout listening") || !strings.HasSuffix(lines[1], " run1 stderr connection refused") {
+		t.Errorf("unexpected log file: %q", data)
+	}
+
+	if len(bodies) != 1 {
+		t.Fatalf("expected one push to loki, got %d", len(bodies))
+	}
+	streams := bodies[0]["streams"].([]any)
+	if len(streams) != 2 {
+		t.Fatalf("expected a stream per level, got %v", streams)
+	}
+	labels := streams[0].(map[string]any)["stream"].(map[string]any)
+	if labels["env"] != "dev" || labels["stream"] != "stderr" || labels["level"] != "error" || labels["project"] != filepath.Base(cfg.RootDirectory) {
+		t.Errorf("unexpected labels: %v", labels)
+	}
+	if line := streams[0].(map[string]any)["values"].([]any)[0].([]any)[1]; line != "run=run1 connection refused" {
+		t.Errorf("unexpected line: %v", line)
+	}
+}
+
+func TestFileWriterRotates(t *testing.T) {
+	dir := t.TempDir()
+	w, err := NewFileWriter(dir, 1, 2)
+	if err != nil {
+		t.Fatalf("creating writer: %v", err)
+	}
+	defer w.Close()
+	w.maxSize = 100
+
+	for i := 0; i < 10; i++ {
+		err = w.Write(context.Background(), []Record{{Date: time.Now(), RunID: "run", Stream: "stdout", Message: strings.Repeat("x", 60)}})
+		if err != nil {
+			t.Fatalf("writing: %v", err)
+		}
+		// rotated files are named by the time so they need to differ
+		time.Sleep(2 * time.Millisecond)
+	}
+
+	rotated, _ := filepath.Glob(filepath.Join(dir, rotatedPattern))
+	if len(rotated) != 2 {
+		t.Errorf("expected 2 rotated files to be kept, got %v", rotated)
+	}
+	info, err := os.Stat(filepath.Join(dir, logFileName))
+	if err != nil || info.Size() > 200 {
+		t.Errorf("expected the current file to have been started again, got %v %v", info, err)
+	}
+}
+
+func TestOTLPWriter(t *testing.T) {
+	var body map[string]any
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		json.NewDecoder(r.Body).Decode(&body)
+	}))
+	defer server.Close()
+
+	w := NewOTLPWriter(server.URL, nil, map[string]string{"project": "api"})
+	err := w.Write(context.Background(), []Record{{Date: time.Unix(1, 0), RunID: "run1", Stream: "stderr", Level: notification.LevelWarn, Message: "slow query"}})
+	if err != nil {
+		t.Fatalf("writing: %v", err)
+	}
+
+	resourceLogs := body["resourceLogs"].([]any)[0].(map[string]any)
+	attrs, _ := json.Marshal(resourceLogs["resource"])
+	if !strings.Contains(string(attrs), `{"key":"service.name","value":{"stringValue":"api"}}`) {
+		t.Errorf("expected the project to be the service name, got %s", attrs)
+	}
+	record := resourceLogs["scopeLogs"].([]any)[0].(map[string]any)["logRecords"].([]any)[0].(map[string]any)
+	if record["timeUnixNano"] != "1000000000" || record["severityNumber"] != float64(13) || record["body"].(map[string]any)["stringValue"] != "slow query" {
+		t.Errorf("unexpected log record: %v", record)
+	}
+}
@@ -0,0 +1,94 @@
+package logfwd
+
+// gomon is a simple command line tool that watches your files and automatically restarts the application when it detects any changes in the working directory.
+// Copyright (C) 2023 John Dudmesh
+
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+
+// You should have received a copy of the GNU General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>.
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"sort"
+	"strconv"
+
+	"github.com/jdudmesh/gomon/internal/utils"
+)
+
+type lokiWriter struct {
+	url     string
+	headers map[string]string
+	labels  map[string]string
+	client  *http.Client
+}
+
+// NewLokiWriter pushes the records to the Loki push API at url e.g. http://localhost:3100/loki/api/v1/push. Each
+// stream is the labels along with the output stream and level of the line, the run ID is part of the line so that
+// it doesn't create a stream per run.
+func NewLokiWriter(url string, headers, labels map[string]string) *lokiWriter {
+	return &lokiWriter{
+		url:     url,
+		headers: headers,
+		labels:  labels,
+		client:  http.DefaultClient,
+	}
+}
+
+type lokiStream struct {
+	Stream map[string]string `json:"stream"`
+	Values [][2]string       `json:"values"`
+}
+
+func (w *lokiWriter) Write(ctx context.Context, records []Record) error {
+	streams := map[string]*lokiStream{}
+	for _, r := range records {
+		key := r.Stream + "/" + r.Level
+		stream, ok := streams[key]
+		if !ok {
+			labels := map[string]string{"stream": r.Stream}
+			for k, v := range w.labels {
+				labels[k] = v
+			}
+			if r.Level != "" {
+				labels["level"] = r.Level
+			}
+			stream = &lokiStream{Stream: labels}
+			streams[key] = stream
+		}
+		line := fmt.Sprintf("run=%s %s", r.RunID, r.Message)
+		stream.Values = append(stream.Values, [2]string{strconv.FormatInt(r.Date.UnixNano(), 10), line})
+	}
+
+	keys := make([]string, 0, len(streams))
+	for k := range streams {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	body := struct {
+		Streams []*lokiStream `json:"streams"`
+	}{}
+	for _, k := range keys {
+		body.Streams = append(body.Streams, streams[k])
+	}
+
+	err := utils.PostJSON(ctx, w.client, w.url, w.headers, body)
+	if err != nil {
+		return fmt.Errorf("pushing to loki: %w", err)
+	}
+	return nil
+}
+
+func (w *lokiWriter) Close() error {
+	return nil
+}
@@ -0,0 +1,130 @@
+package logfwd
+
+// gomon is a simple command line tool that watches your files and automatically restarts the application when it detects any changes in the working directory.
+// Copyright (C) 2023 John Dudmesh
+
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+
+// You should have received a copy of the GNU General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>.
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"sort"
+	"strconv"
+
+	"github.com/jdudmesh/gomon/internal/notification"
+	"github.com/jdudmesh/gomon/internal/utils"
+)
+
+// otlpSeverities are the OTLP severity numbers of the log levels
+var otlpSeverities = map[string]int{
+	notification.LevelDebug: 5,
+	notification.LevelInfo:  9,
+	notification.LevelWarn:  13,
+	notification.LevelError: 17,
+}
+
+type otlpWriter struct {
+	url      string
+	headers  map[string]string
+	resource []otlpAttribute
+	client   *http.Client
+}
+
+// NewOTLPWriter exports the records as OTLP logs encoded as JSON to url e.g. http://localhost:4318/v1/logs. The
+// labels are the resource attributes, project is used as the service name unless service.name is set.
+func NewOTLPWriter(url string, headers, labels map[string]string) *otlpWriter {
+	attrs := map[string]string{"service.name": labels["project"]}
+	for k, v := range labels {
+		attrs[k] = v
+	}
+
+	keys := make([]string, 0, len(attrs))
+	for k := range attrs {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	resource := []otlpAttribute{}
+	for _, k := range keys {
+		resource = append(resource, newOTLPAttribute(k, attrs[k]))
+	}
+
+	return &otlpWriter{
+		url:      url,
+		headers:  headers,
+		resource: resource,
+		client:   http.DefaultClient,
+	}
+}
+
+type otlpAttribute struct {
+	Key   string `json:"key"`
+	Value struct {
+		StringValue string `json:"stringValue"`
+	} `json:"value"`
+}
+
+func newOTLPAttribute(key, value string) otlpAttribute {
+	attr := otlpAttribute{Key: key}
+	attr.Value.StringValue = value
+	return attr
+}
+
+type otlpLogRecord struct {
+	TimeUnixNano   string          `json:"timeUnixNano"`
+	SeverityNumber int             `json:"severityNumber,omitempty"`
+	SeverityText   string          `json:"severityText,omitempty"`
+	Body           otlpBody        `json:"body"`
+	Attributes     []otlpAttribute `json:"attributes"`
+}
+
+type otlpBody struct {
+	StringValue string `json:"stringValue"`
+}
+
+func (w *otlpWriter) Write(ctx context.Context, records []Record) error {
+	logRecords := make([]otlpLogRecord, 0, len(records))
+	for _, r := range records {
+		logRecords = append(logRecords, otlpLogRecord{
+			TimeUnixNano:   strconv.FormatInt(r.Date.UnixNano(), 10),
+			SeverityNumber: otlpSeverities[r.Level],
+			SeverityText:   r.Level,
+			Body:           otlpBody{StringValue: r.Message},
+			Attributes: []otlpAttribute{
+				newOTLPAttribute("gomon.run_id", r.RunID),
+				newOTLPAttribute("log.iostream", r.Stream),
+			},
+		})
+	}
+
+	body := map[string]any{
+		"resourceLogs": []map[string]any{{
+			"resource": map[string]any{"attributes": w.resource},
+			"scopeLogs": []map[string]any{{
+				"scope":      map[string]string{"name": "gomon"},
+				"logRecords": logRecords,
+			}},
+		}},
+	}
+
+	err := utils.PostJSON(ctx, w.client, w.url, w.headers, body)
+	if err != nil {
+		return fmt.Errorf("exporting to otlp: %w", err)
+	}
+	return nil
+}
+
+func (w *otlpWriter) Close() error {
+	return nil
+}
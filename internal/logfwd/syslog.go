@@ -0,0 +1,80 @@
+//go:build !windows && !plan9
+
+package logfwd
+
+// gomon is a simple command line tool that watches your files and automatically restarts the application when it detects any changes in the working directory.
+// Copyright (C) 2023 John Dudmesh
+
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+
+// You should have received a copy of the GNU General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>.
+
+import (
+	"context"
+	"fmt"
+	"log/syslog"
+	"net/url"
+
+	"github.com/jdudmesh/gomon/internal/notification"
+)
+
+const syslogTag = "gomon"
+
+type syslogWriter struct {
+	writer *syslog.Writer
+}
+
+// NewSyslogWriter sends the records to the syslog server at address e.g. udp://localhost:514, or to the local
+// syslog daemon if address is empty
+func NewSyslogWriter(address string) (*syslogWriter, error) {
+	network, raddr := "", ""
+	if address != "" {
+		u, err := url.Parse(address)
+		if err != nil || u.Scheme == "" || u.Host == "" {
+			return nil, fmt.Errorf("syslog address must be of the form udp://host:port or tcp://host:port: %s", address)
+		}
+		network, raddr = u.Scheme, u.Host
+	}
+
+	writer, err := syslog.Dial(network, raddr, syslog.LOG_INFO|syslog.LOG_USER, syslogTag)
+	if err != nil {
+		return nil, fmt.Errorf("connecting to syslog: %w", err)
+	}
+	return &syslogWriter{writer: writer}, nil
+}
+
+// Write sends each record at the severity of its level, lines without a level are sent as info
+func (w *syslogWriter) Write(ctx context.Context, records []Record) error {
+	for _, r := range records {
+		message := fmt.Sprintf("%s %s %s", r.RunID, r.Stream, r.Message)
+
+		var err error
+		switch r.Level {
+		case notification.LevelError:
+			err = w.writer.Err(message)
+		case notification.LevelWarn:
+			err = w.writer.Warning(message)
+		case notification.LevelDebug:
+			err = w.writer.Debug(message)
+		default:
+			err = w.writer.Info(message)
+		}
+		if err != nil {
+			return fmt.Errorf("writing to syslog: %w", err)
+		}
+	}
+	return nil
+}
+
+func (w *syslogWriter) Close() error {
+	return w.writer.Close()
+}
@@ -0,0 +1,235 @@
+package notification
+
+// gomon is a simple command line tool that watches your files and automatically restarts the application when it detects any changes in the working directory.
+// Copyright (C) 2023 John Dudmesh
+
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+
+// You should have received a copy of the GNU General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>.
+
+import (
+	"slices"
+	"sync"
+	"sync/atomic"
+)
+
+// OverflowPolicy is what happens when a buffered subscriber falls behind and its buffer is full
+type OverflowPolicy int
+
+const (
+	// DropNewest discards notifications published while the buffer is full
+	DropNewest OverflowPolicy = iota
+	// DropOldest discards the oldest queued notification to make room for the new one
+	DropOldest
+	// Disconnect unsubscribes the subscriber, it receives the notifications which are already queued
+	Disconnect
+)
+
+// ConsumerFunc adapts a function to an EventConsumer
+type ConsumerFunc func(n Notification) error
+
+func (f ConsumerFunc) Notify(n Notification) error {
+	return f(n)
+}
+
+// Bus delivers each published notification to its subscribers. Subscribers without a buffer are called on the
+// publisher's goroutine in the order they subscribed, so they see notifications in the order they are published
+// and hold up the publisher while they handle them. Buffered subscribers each have their own goroutine and queue so
+// a slow one can't hold up gomon or the other subscribers.
+type Bus struct {
+	lock        sync.RWMutex
+	subscribers []*Subscription
+}
+
+func NewBus() *Bus {
+	return &Bus{}
+}
+
+// SubscribeOption configures a subscription
+type SubscribeOption func(*Subscription)
+
+// WithTypes only delivers notifications of the given types, by default every notification is delivered
+func WithTypes(types ...NotificationType) SubscribeOption {
+	return func(s *Subscription) {
+		s.types = map[NotificationType]bool{}
+		for _, t := range types {
+			s.types[t] = true
+		}
+	}
+}
+
+// WithBuffer delivers notifications on a separate goroutine, queueing up to size of them while the subscriber is
+// busy. A size of 0 delivers them on the publisher's goroutine.
+func WithBuffer(size int) SubscribeOption {
+	return func(s *Subscription) {
+		s.buffer = max(size, 0)
+	}
+}
+
+// WithOverflow sets what happens when a buffered subscriber's queue is full, the default is DropNewest
+func WithOverflow(policy OverflowPolicy) SubscribeOption {
+	return func(s *Subscription) {
+		s.overflow = policy
+	}
+}
+
+// WithName names the subscriber in log messages
+func WithName(name string) SubscribeOption {
+	return func(s *Subscription) {
+		s.name = name
+	}
+}
+
+// Subscription is a subscriber's registration with a Bus, Close unsubscribes it
+type Subscription struct {
+	bus      *Bus
+	consumer EventConsumer
+	name     string
+	types    map[NotificationType]bool
+	buffer   int
+	overflow OverflowPolicy
+	// lock stops the queue from being closed while a notification is being queued
+	lock   sync.RWMutex
+	closed bool
+	queue  chan Notification
+	done   chan struct{}
+	// dropped counts the notifications lost because the queue was full, overflowing is set from the first one until
+	// the queue has been emptied so that each backlog is only logged once
+	dropped     atomic.Int64
+	overflowing atomic.Bool
+	closeOnce   sync.Once
+}
+
+// Subscribe registers consumer for the notifications published from now on
+func (b *Bus) Subscribe(consumer EventConsumer, opts ...SubscribeOption) *Subscription {
+	s := &Subscription{
+		bus:      b,
+		consumer: consumer,
+		name:     "subscriber",
+		done:     make(chan struct{}),
+	}
+	for _, opt := range opts {
+		opt(s)
+	}
+
+	if s.buffer > 0 {
+		s.queue = make(chan Notification, s.buffer)
+		go s.deliver()
+	} else {
+		close(s.done)
+	}
+
+	b.lock.Lock()
+	b.subscribers = append(b.subscribers, s)
+	b.lock.Unlock()
+
+	return s
+}
+
+// Publish delivers n to every subscriber which accepts its type. The subscribers are copied first so that a
+// subscriber can publish notifications of its own or unsubscribe while it is being notified.
+func (b *Bus) Publish(n Notification) {
+	b.lock.RLock()
+	subscribers := slices.Clone(b.subscribers)
+	b.lock.RUnlock()
+
+	for _, s := range subscribers {
+		if s.types != nil && !s.types[n.Type] {
+			continue
+		}
+		if s.queue == nil {
+			s.notify(n)
+			continue
+		}
+		s.enqueue(n)
+	}
+}
+
+func (s *Subscription) notify(n Notification) {
+	err := s.consumer.Notify(n)
+	if err != nil {
+		log.Debugf("%s: handling %s notification: %v", s.name, n.Type, err)
+	}
+}
+
+func (s *Subscription) enqueue(n Notification) {
+	s.lock.RLock()
+	defer s.lock.RUnlock()
+
+	if s.closed {
+		return
+	}
+
+	select {
+	case s.queue <- n:
+		return
+	default:
+	}
+
+	s.dropped.Add(1)
+	switch s.overflow {
+	case DropOldest:
+		select {
+		case <-s.queue:
+		default:
+		}
+		select {
+		case s.queue <- n:
+		default:
+		}
+	case Disconnect:
+		if !s.overflowing.Swap(true) {
+			log.Warnf("%s can't keep up with notifications, unsubscribing it", s.name)
+			go s.Close()
+		}
+		return
+	}
+
+	if !s.overflowing.Swap(true) {
+		log.Warnf("%s can't keep up with notifications, dropping them", s.name)
+	}
+}
+
+func (s *Subscription) deliver() {
+	defer close(s.done)
+	for n := range s.queue {
+		s.notify(n)
+		if len(s.queue) == 0 && s.overflow != Disconnect {
+			s.overflowing.Store(false)
+		}
+	}
+}
+
+// Dropped returns the number of notifications which weren't delivered because the subscriber fell behind
+func (s *Subscription) Dropped() int64 {
+	return s.dropped.Load()
+}
+
+// Close unsubscribes and waits for the notifications which are already queued to be delivered. It must not be
+// called from the subscriber's own Notify if it is buffered.
+func (s *Subscription) Close() {
+	s.closeOnce.Do(func() {
+		s.bus.lock.Lock()
+		s.bus.subscribers = slices.DeleteFunc(s.bus.subscribers, func(other *Subscription) bool {
+			return other == s
+		})
+		s.bus.lock.Unlock()
+
+		s.lock.Lock()
+		s.closed = true
+		if s.queue != nil {
+			close(s.queue)
+		}
+		s.lock.Unlock()
+	})
+	<-s.done
+}
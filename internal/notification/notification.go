@@ -29,14 +29,97 @@ const (
 	NotificationTypeOOBTaskStdOut
 	NotificationTypeOOBTaskStdErr
 	NotificationTypeIPC
+	NotificationTypeCrash
+	NotificationTypeOOBTaskComplete
+	NotificationTypePipelineRequested
+	NotificationTypeBuildError
+	NotificationTypeTestPass
+	NotificationTypeTestFail
+	NotificationTypeTestRequested
+	NotificationTypeUnhealthy
+	NotificationTypeRunning
+	NotificationTypeOOBTaskCancelRequested
 )
 
+var notificationTypeNames = []string{
+	"systemError",
+	"softRestartRequested",
+	"hardRestartRequested",
+	"oobTaskRequested",
+	"shutdownRequested",
+	"systemShutdown",
+	"startup",
+	"hardRestart",
+	"softRestart",
+	"shutdown",
+	"logEvent",
+	"stdout",
+	"stderr",
+	"oobTaskStartup",
+	"oobTaskStdout",
+	"oobTaskStderr",
+	"ipc",
+	"crash",
+	"oobTaskComplete",
+	"pipelineRequested",
+	"buildError",
+	"testPass",
+	"testFail",
+	"testRequested",
+	"unhealthy",
+	"running",
+	"oobTaskCancelRequested",
+}
+
+func (t NotificationType) String() string {
+	if int(t) < 0 || int(t) >= len(notificationTypeNames) {
+		return "unknown"
+	}
+	return notificationTypeNames[t]
+}
+
+// ParseType returns the notification type with the given name e.g. "crash"
+func ParseType(name string) (NotificationType, bool) {
+	for ix, typeName := range notificationTypeNames {
+		if typeName == name {
+			return NotificationType(ix), true
+		}
+	}
+	return 0, false
+}
+
 type Notification struct {
 	ID              string           `json:"id" db:"id"` // snowflake
 	Date            time.Time        `json:"createdAt" db:"created_at"`
 	ChildProccessID string           `json:"childProcessId" db:"child_process_id"` // snowflake
 	Type            NotificationType `json:"type" db:"event_type"`
 	Message         string           `json:"message" db:"event_data"`
+	// Level is the log level found in a line of the child's output, it is empty if the line doesn't have one
+	Level string `json:"level,omitempty" db:"level"`
+	// TaskID links the output of an out of band task to its startup notification, it isn't persisted
+	TaskID string `json:"taskId,omitempty" db:"-"`
+	// Trigger is set on restart requests caused by a file change
+	Trigger *Trigger `json:"trigger,omitempty" db:"-"`
+}
+
+// Trigger describes the file change which caused a restart and the reload rule it matched
+// The log levels which are recognised in the child's output, other names are mapped onto these e.g. fatal is an error
+const (
+	LevelDebug = "debug"
+	LevelInfo  = "info"
+	LevelWarn  = "warn"
+	LevelError = "error"
+)
+
+// Levels are in order of severity
+var Levels = []string{LevelDebug, LevelInfo, LevelWarn, LevelError}
+
+type Trigger struct {
+	Path string `json:"path" db:"path"`
+	// Rule is the config key and the pattern which matched e.g. "hardReload *.go"
+	Rule string `json:"rule" db:"rule"`
+	// Event is the file system operation e.g. "WRITE"
+	Event string `json:"event" db:"event"`
 }
 
 type EventConsumer interface {
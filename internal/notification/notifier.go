@@ -20,30 +20,54 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"sync/atomic"
 	"time"
 
 	ipc "github.com/jdudmesh/gomon-ipc"
-	log "github.com/sirupsen/logrus"
+	"github.com/jdudmesh/gomon/internal/config"
+	"github.com/jdudmesh/gomon/pkg/protocol"
+	"github.com/sirupsen/logrus"
 )
 
-const SoftRestartMessage = "__soft_reload"
-const HardRestartMessage = "__hard_restart"
+var log = logrus.WithField("component", "notification")
+
+const SoftRestartMessage = protocol.LegacySoftReloaded
+const HardRestartMessage = protocol.LegacyStarted
 
 type Notifier struct {
 	ipcServer      ipc.Connection
 	callbackFn     NotificationCallback
 	childProcessID string
+	// version is the protocol version negotiated with the connected client, 0 until it says hello
+	version atomic.Int32
 }
 
-func NewNotifier(callbackFn NotificationCallback) (*Notifier, error) {
+// NewNotifier serves the IPC channel on the transport set in the ipc section, the unix and tcp transports need a
+// token which the caller must generate if it isn't configured so it can also be passed to the child process
+func NewNotifier(cfg config.Config, callbackFn NotificationCallback) (*Notifier, error) {
 	n := &Notifier{
 		callbackFn: callbackFn,
 	}
-	ipcServer, err := ipc.NewConnection(ipc.ServerConnection, ipc.WithReadHandler(n.handleInboundMessage))
-	if err != nil {
-		return nil, fmt.Errorf("creating IPC server: %w", err)
+
+	switch transport := cfg.IPCTransport(); transport {
+	case config.IPCTransportUDP:
+		if cfg.IPC.Address != "" {
+			return nil, errors.New("ipc.address requires the unix or tcp transport")
+		}
+		ipcServer, err := ipc.NewConnection(ipc.ServerConnection, ipc.WithReadHandler(n.handleInboundMessage))
+		if err != nil {
+			return nil, fmt.Errorf("creating IPC server: %w", err)
+		}
+		n.ipcServer = ipcServer
+	case config.IPCTransportUnix, config.IPCTransportTCP:
+		if cfg.IPC.Token == "" {
+			return nil, errors.New("ipc.token is required")
+		}
+		n.ipcServer = newStreamConnection(transport, cfg.IPCAddress(), cfg.IPC.Token, n.handleInboundMessage)
+	default:
+		return nil, fmt.Errorf("unsupported IPC transport: %s", transport)
 	}
-	n.ipcServer = ipcServer
+
 	return n, nil
 }
 
@@ -52,6 +76,8 @@ func (n *Notifier) Start() error {
 	defer cancelFn()
 
 	err := n.ipcServer.ListenAndServe(ctx, func(state ipc.ConnectionState) error {
+		// each connection negotiates the version again, it may be a different client
+		n.version.Store(0)
 		switch state {
 		case ipc.Connected:
 			n.callbackFn(Notification{
@@ -94,15 +120,34 @@ func (n *Notifier) Notify(notif Notification) error {
 	return nil
 }
 
-func (n *Notifier) SendSoftRestart(hint string) error {
+// SendSoftRestart asks the child process to reload the changed paths, clients which haven't negotiated a version
+// only receive the first path
+func (n *Notifier) SendSoftRestart(paths []string) error {
 	if !n.ipcServer.IsConnected() {
 		return errors.New("IPC server is not connected")
 	}
 
+	if len(paths) == 0 {
+		return nil
+	}
+
+	data := []byte(paths[0])
+	if version := int(n.version.Load()); version > 0 {
+		var err error
+		data, err = protocol.Encode(protocol.Message{Version: version, Type: protocol.TypeSoftReload, Paths: paths})
+		if err != nil {
+			return err
+		}
+	}
+
+	return n.write(data)
+}
+
+func (n *Notifier) write(data []byte) error {
 	ctx, cancelFn := context.WithTimeout(context.Background(), time.Second)
 	defer cancelFn()
 
-	err := n.ipcServer.Write(ctx, []byte(hint))
+	err := n.ipcServer.Write(ctx, data)
 	if err != nil {
 		return fmt.Errorf("writing to IPC server: %w", err)
 	}
@@ -110,28 +155,60 @@ func (n *Notifier) SendSoftRestart(hint string) error {
 }
 
 func (n *Notifier) handleInboundMessage(data []byte) error {
+	if m, ok := protocol.Decode(data); ok {
+		n.handleMessage(m)
+		return nil
+	}
+
 	msg := string(data)
 	if len(msg) == 0 {
 		return nil
 	}
 	switch msg {
 	case HardRestartMessage:
-		n.callbackFn(Notification{
-			ID:              NextID(),
-			Date:            time.Now(),
-			ChildProccessID: n.childProcessID,
-			Type:            NotificationTypeHardRestart,
-			Message:         "hard restart completed",
-		})
+		n.notify(NotificationTypeHardRestart, "hard restart completed")
 	case SoftRestartMessage:
-		n.callbackFn(Notification{
-			ID:              NextID(),
-			Date:            time.Now(),
-			ChildProccessID: n.childProcessID,
-			Type:            NotificationTypeSoftRestart,
-			Message:         "soft restart completed",
-		})
+		n.notify(NotificationTypeSoftRestart, "soft restart completed")
 	}
 
 	return nil
 }
+
+// handleMessage acts on a message from a client which uses the versioned protocol
+func (n *Notifier) handleMessage(m protocol.Message) {
+	switch m.Type {
+	case protocol.TypeHello:
+		version := protocol.Negotiate(m.Version)
+		n.version.Store(int32(version))
+		welcome, err := protocol.Encode(protocol.Message{Version: version, Type: protocol.TypeWelcome})
+		if err != nil {
+			log.Warn(err)
+			return
+		}
+		// the reply is written from another goroutine so the connection keeps reading while it is sent
+		go func() {
+			err := n.write(welcome)
+			if err != nil {
+				log.Warnf("welcoming child process: %v", err)
+			}
+		}()
+	case protocol.TypeStarted:
+		n.notify(NotificationTypeHardRestart, "hard restart completed")
+	case protocol.TypeReloaded:
+		n.notify(NotificationTypeSoftRestart, "soft restart completed")
+	case protocol.TypeReloadFailed:
+		n.notify(NotificationTypeSystemError, "soft restart failed: "+m.Error)
+	default:
+		log.Debugf("ignoring %s message from child process", m.Type)
+	}
+}
+
+func (n *Notifier) notify(notifType NotificationType, message string) {
+	n.callbackFn(Notification{
+		ID:              NextID(),
+		Date:            time.Now(),
+		ChildProccessID: n.childProcessID,
+		Type:            notifType,
+		Message:         message,
+	})
+}
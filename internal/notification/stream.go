@@ -0,0 +1,201 @@
+package notification
+
+// gomon is a simple command line tool that watches your files and automatically restarts the application when it detects any changes in the working directory.
+// Copyright (C) 2023 John Dudmesh
+
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+
+// You should have received a copy of the GNU General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>.
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/subtle"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"net"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+
+	ipc "github.com/jdudmesh/gomon-ipc"
+	"github.com/jdudmesh/gomon/pkg/protocol"
+)
+
+// tokenTimeout is how long a client has to send the token after it connects
+const tokenTimeout = 5 * time.Second
+
+// NewToken returns a random token for the unix and tcp transports, it is generated when one isn't configured
+func NewToken() (string, error) {
+	buf := make([]byte, 16)
+	_, err := rand.Read(buf)
+	if err != nil {
+		return "", fmt.Errorf("generating IPC token: %w", err)
+	}
+	return hex.EncodeToString(buf), nil
+}
+
+// streamConnection serves the IPC channel on a unix socket or TCP port so that it can be reached by a child in a
+// container. Only one client is connected at a time, a new one replaces the previous one like a restarted child.
+type streamConnection struct {
+	network     string
+	address     string
+	token       string
+	readHandler ipc.MessageHandler
+	lock        sync.Mutex
+	listener    net.Listener
+	conn        net.Conn
+}
+
+var _ ipc.Connection = (*streamConnection)(nil)
+
+func newStreamConnection(network, address, token string, readHandler ipc.MessageHandler) *streamConnection {
+	return &streamConnection{
+		network:     network,
+		address:     address,
+		token:       token,
+		readHandler: readHandler,
+	}
+}
+
+func (s *streamConnection) ListenAndServe(ctx context.Context, callbackFn ipc.StateHandler) error {
+	if s.network == protocol.TransportUnix {
+		err := os.MkdirAll(filepath.Dir(s.address), 0o755)
+		if err != nil {
+			return fmt.Errorf("creating socket directory: %w", err)
+		}
+		// the socket is left behind if gomon is killed
+		err = os.Remove(s.address)
+		if err != nil && !os.IsNotExist(err) {
+			return fmt.Errorf("removing stale socket: %w", err)
+		}
+	}
+
+	listener, err := net.Listen(s.network, s.address)
+	if err != nil {
+		return fmt.Errorf("listening on %s: %w", s.address, err)
+	}
+	s.lock.Lock()
+	s.listener = listener
+	s.lock.Unlock()
+
+	go func() {
+		<-ctx.Done()
+		listener.Close()
+	}()
+
+	for {
+		conn, err := listener.Accept()
+		if errors.Is(err, net.ErrClosed) {
+			return nil
+		} else if err != nil {
+			return fmt.Errorf("accepting connection: %w", err)
+		}
+		go s.serve(conn, callbackFn)
+	}
+}
+
+// serve checks the client's token then passes its messages to the read handler until it disconnects
+func (s *streamConnection) serve(conn net.Conn, callbackFn ipc.StateHandler) {
+	defer conn.Close()
+
+	if !s.authenticate(conn) {
+		log.Warnf("rejected IPC connection from %s: invalid token", conn.RemoteAddr())
+		return
+	}
+
+	s.lock.Lock()
+	if s.conn != nil {
+		s.conn.Close()
+	}
+	s.conn = conn
+	s.lock.Unlock()
+	callbackFn(ipc.Connected)
+
+	for {
+		data, err := protocol.ReadFrame(conn)
+		if err != nil {
+			break
+		}
+		err = s.readHandler(data)
+		if err != nil {
+			log.Warnf("handling IPC message: %v", err)
+		}
+	}
+
+	s.lock.Lock()
+	current := s.conn == conn
+	if current {
+		s.conn = nil
+	}
+	s.lock.Unlock()
+
+	// a client which has been replaced has already been superseded by a new connection
+	if current {
+		callbackFn(ipc.Disconnected)
+	}
+}
+
+func (s *streamConnection) authenticate(conn net.Conn) bool {
+	conn.SetReadDeadline(time.Now().Add(tokenTimeout))
+	defer conn.SetReadDeadline(time.Time{})
+
+	token, err := protocol.ReadFrame(conn)
+	if err != nil {
+		return false
+	}
+	return subtle.ConstantTimeCompare(token, []byte(s.token)) == 1
+}
+
+func (s *streamConnection) Read(ctx context.Context) ([]byte, error) {
+	return nil, errors.New("messages are passed to the read handler")
+}
+
+func (s *streamConnection) Write(ctx context.Context, data []byte) error {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	if s.conn == nil {
+		return errors.New("not connected")
+	}
+
+	deadline, ok := ctx.Deadline()
+	if ok {
+		s.conn.SetWriteDeadline(deadline)
+		defer s.conn.SetWriteDeadline(time.Time{})
+	}
+	return protocol.WriteFrame(s.conn, data)
+}
+
+func (s *streamConnection) Close() error {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	if s.conn != nil {
+		s.conn.Close()
+		s.conn = nil
+	}
+	if s.listener != nil {
+		// closing a unix listener also removes the socket
+		return s.listener.Close()
+	}
+	return nil
+}
+
+func (s *streamConnection) IsConnected() bool {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	return s.conn != nil
+}
@@ -0,0 +1,240 @@
+package plugins
+
+// gomon is a simple command line tool that watches your files and automatically restarts the application when it detects any changes in the working directory.
+// Copyright (C) 2023 John Dudmesh
+
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+
+// You should have received a copy of the GNU General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>.
+
+import (
+	"fmt"
+	"path/filepath"
+	"slices"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/jdudmesh/gomon/internal/config"
+	"github.com/jdudmesh/gomon/internal/notification"
+	"github.com/jdudmesh/gomon/internal/process"
+	"github.com/jdudmesh/gomon/internal/utils"
+	"github.com/sirupsen/logrus"
+)
+
+var log = logrus.WithField("component", "plugins")
+
+const (
+	queueSize = 1024
+	// stopTimeout is how long a plugin has to exit once its stdin has been closed before it is killed
+	stopTimeout = 5 * time.Second
+	// a plugin which exits is restarted after a delay which doubles each time, up to maxRestartDelay. The delay
+	// starts again once a plugin has run for longer than maxRestartDelay.
+	minRestartDelay = time.Second
+	maxRestartDelay = time.Minute
+	// maxLineSize is the longest line a plugin can write
+	maxLineSize = 1 << 20
+)
+
+// EventInit is the event of the first message sent to a plugin each time it starts, the other messages are
+// named after the type of the notification they carry e.g. "crash"
+const EventInit = "init"
+
+// The requests a plugin can make
+const (
+	RequestHardRestart = "hardRestart"
+	RequestSoftRestart = "softRestart"
+	RequestTask        = "task"
+	RequestLog         = "log"
+)
+
+// Project describes the project gomon is running, it is sent to plugins when they start
+type Project struct {
+	Name          string `json:"name"`
+	RootDirectory string `json:"rootDirectory"`
+	UIURL         string `json:"uiURL,omitempty"`
+	ProxyURL      string `json:"proxyURL,omitempty"`
+}
+
+// Message is a line of JSON written to a plugin's stdin, Project is only set on the init message
+type Message struct {
+	Event        string                     `json:"event"`
+	Project      *Project                   `json:"project,omitempty"`
+	Notification *notification.Notification `json:"notification,omitempty"`
+}
+
+// Request is a line of JSON written by a plugin to its stdout, other lines are logged. Task is the named task or
+// command run by a task request, Message is logged by a log request at Level (debug, info, warn or error).
+type Request struct {
+	Type    string `json:"type"`
+	Task    string `json:"task,omitempty"`
+	Message string `json:"message,omitempty"`
+	Level   string `json:"level,omitempty"`
+}
+
+// Manager runs the plugins in the config and sends them gomon's events. Each plugin has a queue of its own which
+// is written to its stdin in the background, so a plugin which is slow to read can't hold up gomon or the other
+// plugins. Events are dropped when a queue is full.
+type Manager struct {
+	plugins   []*plugin
+	done      chan struct{}
+	wait      sync.WaitGroup
+	closeOnce sync.Once
+}
+
+// New creates the plugins, they aren't started until Start is called. Requests made by the plugins are passed to
+// callbackFn.
+func New(cfg config.Config, callbackFn notification.NotificationCallback) (*Manager, error) {
+	m := &Manager{done: make(chan struct{})}
+
+	project := &Project{
+		Name:          filepath.Base(cfg.RootDirectory),
+		RootDirectory: cfg.RootDirectory,
+		UIURL:         cfg.UIURL(),
+		ProxyURL:      cfg.ProxyURL(),
+	}
+
+	secrets := process.NewSecretResolver()
+	names := []string{}
+	for ix, pluginCfg := range cfg.Plugins {
+		p, err := newPlugin(pluginCfg, cfg.RootDirectory, secrets)
+		if err != nil {
+			return nil, fmt.Errorf("plugin %d: %w", ix, err)
+		}
+		if slices.Contains(names, p.name) {
+			return nil, fmt.Errorf("plugin %d: duplicate name %s", ix, p.name)
+		}
+		names = append(names, p.name)
+
+		p.project = project
+		p.callbackFn = callbackFn
+		p.done = m.done
+		m.plugins = append(m.plugins, p)
+	}
+
+	return m, nil
+}
+
+func newPlugin(cfg config.Plugin, rootDirectory string, secrets *process.SecretResolver) (*plugin, error) {
+	command := strings.TrimSpace(cfg.Command)
+	if command == "" {
+		return nil, fmt.Errorf("command is required")
+	}
+	args := strings.Split(command, " ")
+
+	name := cfg.Name
+	if name == "" {
+		name = filepath.Base(args[0])
+	}
+
+	p := &plugin{
+		name:          name,
+		args:          args,
+		rootDirectory: rootDirectory,
+		env:           []string{"GOMON_PLUGIN=" + name},
+		queue:         make(chan notification.Notification, queueSize),
+		log:           log.WithField("plugin", name),
+	}
+
+	if len(cfg.Events) > 0 {
+		p.events = map[notification.NotificationType]bool{}
+		for _, event := range cfg.Events {
+			notifType, ok := notification.ParseType(event)
+			if !ok {
+				return nil, fmt.Errorf("%s: unknown event: %s", name, event)
+			}
+			p.events[notifType] = true
+		}
+	}
+
+	keys := make([]string, 0, len(cfg.Env))
+	for k := range cfg.Env {
+		keys = append(keys, k)
+	}
+	slices.Sort(keys)
+	for _, k := range keys {
+		v, err := secrets.Resolve(cfg.Env[k])
+		if err != nil {
+			return nil, fmt.Errorf("%s: resolving env %s: %w", name, k, err)
+		}
+		p.env = append(p.env, k+"="+v)
+	}
+
+	return p, nil
+}
+
+// Start runs the plugins until the manager is closed, restarting any which exit
+func (m *Manager) Start() error {
+	for _, p := range m.plugins {
+		m.wait.Add(1)
+		go func(p *plugin) {
+			defer m.wait.Done()
+			p.run()
+		}(p)
+	}
+	<-m.done
+	return nil
+}
+
+// Close asks the plugins to exit by closing their stdin, any which haven't exited after a few seconds are killed
+func (m *Manager) Close() error {
+	m.closeOnce.Do(func() {
+		close(m.done)
+		m.wait.Wait()
+	})
+	return nil
+}
+
+func (m *Manager) Notify(n notification.Notification) error {
+	select {
+	case <-m.done:
+		return nil
+	default:
+	}
+
+	for _, p := range m.plugins {
+		if p.events != nil && !p.events[n.Type] {
+			continue
+		}
+		select {
+		case p.queue <- n:
+		default:
+			p.dropped.Add(1)
+		}
+	}
+	return nil
+}
+
+func (m *Manager) QueueStats() map[string]utils.QueueStats {
+	stats := map[string]utils.QueueStats{}
+	for _, p := range m.plugins {
+		stats["plugins."+p.name] = utils.QueueStats{Depth: len(p.queue), Capacity: cap(p.queue), Dropped: p.dropped.Load()}
+	}
+	return stats
+}
+
+// plugin is a plugin's process, which is started again whenever it exits
+type plugin struct {
+	name          string
+	args          []string
+	rootDirectory string
+	env           []string
+	// events are the notification types which are sent, every type is sent if it is nil
+	events     map[notification.NotificationType]bool
+	project    *Project
+	callbackFn notification.NotificationCallback
+	queue      chan notification.Notification
+	dropped    atomic.Int64
+	done       chan struct{}
+	log        *logrus.Entry
+}
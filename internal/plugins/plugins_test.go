@@ -0,0 +1,147 @@
+package plugins
+
+// gomon is a simple command line tool that watches your files and automatically restarts the application when it detects any changes in the working directory.
+// Copyright (C) 2023 John Dudmesh
+
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+
+// You should have received a copy of the GNU General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>.
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/jdudmesh/gomon/internal/config"
+	"github.com/jdudmesh/gomon/internal/notification"
+)
+
+func writeScript(t *testing.T, script string) string {
+	path := filepath.Join(t.TempDir(), "plugin.sh")
+	err := os.WriteFile(path, []byte("#!/bin/sh\n"+script), 0755)
+	if err != nil {
+		t.Fatalf("writing plugin: %v", err)
+	}
+	return path
+}
+
+func TestPlugin(t *testing.T) {
+	out := filepath.Join(t.TempDir(), "events")
+	script := writeScript(t, `echo '{"type":"hardRestart"}'
+echo '{"type":"task","task":"go generate"}'
+echo "not a request"
+while read -r line; do echo "$line" >> "$OUT"; done
+`)
+
+	cfg := config.Config{RootDirectory: t.TempDir()}
+	cfg.Plugins = []config.Plugin{{Command: script, Events: []string{"crash"}, Env: map[string]string{"OUT": out}}}
+
+	requests := make(chan notification.Notification, 10)
+	m, err := New(cfg, func(n notification.Notification) error {
+		requests <- n
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("creating plugins: %v", err)
+	}
+	go m.Start()
+
+	for _, expected := range []notification.NotificationType{notification.NotificationTypeHardRestartRequested, notification.NotificationTypeOOBTaskRequested} {
+		select {
+		case n := <-requests:
+			if n.Type != expected {
+				t.Errorf("expected %s, got %s %s", expected.String(), n.Type.String(), n.Message)
+			}
+			if n.Type == notification.NotificationTypeOOBTaskRequested && n.Message != "go generate" {
+				t.Errorf("unexpected task: %s", n.Message)
+			}
+		case <-time.After(5 * time.Second):
+			t.Fatal("timed out waiting for a request")
+		}
+	}
+
+	m.Notify(notification.Notification{ID: "1", Type: notification.NotificationTypeStdOut, Message: "ignored"})
+	m.Notify(notification.Notification{ID: "2", ChildProccessID: "123", Type: notification.NotificationTypeCrash, Message: "exit status 2"})
+	// closing stdin stops the plugin once the queued events have been written
+	m.Close()
+
+	buf, err := os.ReadFile(out)
+	if err != nil {
+		t.Fatalf("reading events: %v", err)
+	}
+	lines := strings.Split(strings.TrimSpace(string(buf)), "\n")
+	if len(lines) != 2 {
+		t.Fatalf("expected the init message and the crash, got %q", lines)
+	}
+
+	init := Message{}
+	json.Unmarshal([]byte(lines[0]), &init)
+	if init.Event != EventInit || init.Project == nil || init.Project.RootDirectory != cfg.RootDirectory {
+		t.Errorf("unexpected init message: %s", lines[0])
+	}
+	crash := Message{}
+	json.Unmarshal([]byte(lines[1]), &crash)
+	if crash.Event != "crash" || crash.Notification == nil || crash.Notification.Message != "exit status 2" {
+		t.Errorf("unexpected event: %s", lines[1])
+	}
+}
+
+func TestPluginRestart(t *testing.T) {
+	out := filepath.Join(t.TempDir(), "starts")
+	script := writeScript(t, `echo "$GOMON_PLUGIN" >> "$OUT"
+`)
+
+	cfg := config.Config{RootDirectory: t.TempDir()}
+	cfg.Plugins = []config.Plugin{{Name: "short-lived", Command: script, Env: map[string]string{"OUT": out}}}
+
+	m, err := New(cfg, func(n notification.Notification) error { return nil })
+	if err != nil {
+		t.Fatalf("creating plugins: %v", err)
+	}
+	go m.Start()
+	defer m.Close()
+
+	deadline := time.Now().Add(5 * time.Second)
+	for {
+		buf, _ := os.ReadFile(out)
+		if string(buf) == "short-lived\nshort-lived\n" {
+			break
+		}
+		if time.Now().After(deadline) {
+			t.Fatalf("expected the plugin to be restarted, got %q", buf)
+		}
+		time.Sleep(50 * time.Millisecond)
+	}
+}
+
+func TestNewPlugins(t *testing.T) {
+	tests := []struct {
+		name    string
+		plugins []config.Plugin
+	}{
+		{"no command", []config.Plugin{{Name: "empty"}}},
+		{"unknown event", []config.Plugin{{Command: "notify", Events: []string{"explosion"}}}},
+		{"duplicate name", []config.Plugin{{Command: "bin/notify --a"}, {Command: "notify --b"}}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg := config.Config{Plugins: tt.plugins}
+			_, err := New(cfg, nil)
+			if err == nil {
+				t.Error("expected an error")
+			}
+		})
+	}
+}
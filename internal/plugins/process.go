@@ -0,0 +1,230 @@
+package plugins
+
+// gomon is a simple command line tool that watches your files and automatically restarts the application when it detects any changes in the working directory.
+// Copyright (C) 2023 John Dudmesh
+
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+
+// You should have received a copy of the GNU General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>.
+
+import (
+	"bufio"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"os/exec"
+	"sync"
+	"time"
+
+	"github.com/jdudmesh/gomon/internal/notification"
+	"github.com/sirupsen/logrus"
+)
+
+// run starts the plugin and starts it again each time it exits, until gomon is shutting down
+func (p *plugin) run() {
+	delay := minRestartDelay
+	for {
+		startedAt := time.Now()
+		err := p.runOnce()
+
+		select {
+		case <-p.done:
+			return
+		default:
+		}
+
+		if time.Since(startedAt) > maxRestartDelay {
+			delay = minRestartDelay
+		}
+		if err == nil {
+			err = errors.New("exited")
+		}
+		p.log.Warnf("plugin stopped: %v, restarting in %s", err, delay)
+
+		select {
+		case <-p.done:
+			return
+		case <-time.After(delay):
+		}
+		delay = min(delay*2, maxRestartDelay)
+	}
+}
+
+// runOnce runs the plugin until it exits or gomon is shutting down. Events are written to its stdin on a goroutine
+// of their own so that a plugin which doesn't read them can still be stopped.
+func (p *plugin) runOnce() error {
+	cmd := exec.Command(p.args[0], p.args[1:]...)
+	cmd.Dir = p.rootDirectory
+	cmd.Env = append(os.Environ(), p.env...)
+	// output from any processes the plugin started, which may outlive it, isn't waited for
+	cmd.WaitDelay = time.Second
+
+	stdin, err := cmd.StdinPipe()
+	if err != nil {
+		return fmt.Errorf("creating stdin: %w", err)
+	}
+	stdoutReader, stdout := io.Pipe()
+	stderrReader, stderr := io.Pipe()
+	cmd.Stdout = stdout
+	cmd.Stderr = stderr
+
+	err = cmd.Start()
+	if err != nil {
+		return fmt.Errorf("starting: %w", err)
+	}
+	p.log.Infof("plugin started: pid %d", cmd.Process.Pid)
+
+	readers := sync.WaitGroup{}
+	readers.Add(2)
+	go func() {
+		defer readers.Done()
+		p.readLines(stdoutReader, p.handleLine)
+	}()
+	go func() {
+		defer readers.Done()
+		p.readLines(stderrReader, func(line string) {
+			p.log.Warn(line)
+		})
+	}()
+
+	exited := make(chan error, 1)
+	go func() {
+		err := cmd.Wait()
+		stdout.Close()
+		stderr.Close()
+		readers.Wait()
+		exited <- err
+	}()
+
+	// stdin is closed by Wait once the plugin has exited, which stops a write which is blocked
+	stop := make(chan struct{})
+	writerDone := make(chan struct{})
+	go func() {
+		defer close(writerDone)
+		p.write(stdin, stop)
+	}()
+
+	select {
+	case err := <-exited:
+		close(stop)
+		<-writerDone
+		return err
+	case <-p.done:
+	}
+
+	// closing stdin asks the plugin to exit, once the events which are already queued have been written
+	close(stop)
+	select {
+	case <-exited:
+	case <-time.After(stopTimeout):
+		p.log.Warnf("plugin didn't exit within %s, killing it", stopTimeout)
+		cmd.Process.Kill()
+		<-exited
+	}
+	<-writerDone
+	return nil
+}
+
+// write sends the init message and then the queued events to the plugin until stop is closed, when the events which
+// are still queued are written and stdin is closed
+func (p *plugin) write(stdin io.WriteCloser, stop <-chan struct{}) {
+	defer stdin.Close()
+
+	enc := json.NewEncoder(stdin)
+	err := enc.Encode(Message{Event: EventInit, Project: p.project})
+	for err == nil {
+		select {
+		case n := <-p.queue:
+			err = enc.Encode(newMessage(n))
+		case <-stop:
+			for {
+				select {
+				case n := <-p.queue:
+					err = enc.Encode(newMessage(n))
+					if err != nil {
+						return
+					}
+				default:
+					return
+				}
+			}
+		}
+	}
+	// the plugin has exited or closed its stdin, the events are queued until it is restarted
+	p.log.Debugf("writing events: %v", err)
+}
+
+func newMessage(n notification.Notification) Message {
+	return Message{Event: n.Type.String(), Notification: &n}
+}
+
+// readLines calls fn with each line written by the plugin, output which isn't in lines is discarded so that the
+// plugin isn't blocked writing it
+func (p *plugin) readLines(r io.Reader, fn func(line string)) {
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(make([]byte, 0, 64*1024), maxLineSize)
+	for scanner.Scan() {
+		fn(scanner.Text())
+	}
+	if err := scanner.Err(); err != nil {
+		p.log.Warnf("reading output: %v", err)
+		io.Copy(io.Discard, r)
+	}
+}
+
+// handleLine acts on a request written by the plugin, lines which aren't requests are logged
+func (p *plugin) handleLine(line string) {
+	req := Request{}
+	err := json.Unmarshal([]byte(line), &req)
+	if err != nil || req.Type == "" {
+		p.log.Info(line)
+		return
+	}
+
+	var notifType notification.NotificationType
+	message := "plugin " + p.name
+	switch req.Type {
+	case RequestHardRestart:
+		notifType = notification.NotificationTypeHardRestartRequested
+	case RequestSoftRestart:
+		notifType = notification.NotificationTypeSoftRestartRequested
+	case RequestTask:
+		if req.Task == "" {
+			p.log.Warn("task request without a task")
+			return
+		}
+		notifType = notification.NotificationTypeOOBTaskRequested
+		message = req.Task
+	case RequestLog:
+		level, err := logrus.ParseLevel(req.Level)
+		if err != nil {
+			level = logrus.InfoLevel
+		}
+		p.log.Log(level, req.Message)
+		return
+	default:
+		p.log.Warnf("unknown request: %s", req.Type)
+		return
+	}
+
+	err = p.callbackFn(notification.Notification{
+		ID:      notification.NextID(),
+		Date:    time.Now(),
+		Type:    notifType,
+		Message: message,
+	})
+	if err != nil {
+		p.log.Warnf("%s request: %v", req.Type, err)
+	}
+}
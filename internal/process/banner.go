@@ -0,0 +1,70 @@
+package process
+
+// gomon is a simple command line tool that watches your files and automatically restarts the application when it detects any changes in the working directory.
+// Copyright (C) 2023 John Dudmesh
+
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+
+// You should have received a copy of the GNU General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>.
+
+import (
+	"fmt"
+	"strings"
+	"time"
+)
+
+// WithTrigger is called on each start to describe what caused it e.g. the file which changed, it returns an empty
+// string if gomon has just started
+func WithTrigger(fn func() string) ChildProcessOption {
+	return func(c *childProcess) error {
+		c.trigger = fn
+		return nil
+	}
+}
+
+// startupBanner is the summary of a run shown once the child process has started. Elapsed is the time from the
+// start being requested to the process being spawned, including prestart tasks and the build, but with `go run`
+// the program is compiled after it has been spawned.
+type startupBanner struct {
+	pid      int
+	runID    string
+	elapsed  time.Duration
+	build    time.Duration
+	trigger  string
+	proxyURL string
+	uiURL    string
+}
+
+func (b startupBanner) String() string {
+	lines := []string{fmt.Sprintf("child process running: pid %d, run %s", b.pid, b.runID)}
+
+	started := "started in " + b.elapsed.Round(time.Millisecond).String()
+	if b.build > 0 {
+		started += fmt.Sprintf(" (build %s)", b.build.Round(time.Millisecond))
+	}
+	lines = append(lines, started)
+
+	trigger := b.trigger
+	if trigger == "" {
+		trigger = "gomon started"
+	}
+	lines = append(lines, "trigger: "+trigger)
+
+	if b.proxyURL != "" {
+		lines = append(lines, "proxy: "+b.proxyURL)
+	}
+	if b.uiURL != "" {
+		lines = append(lines, "ui: "+b.uiURL)
+	}
+
+	return strings.Join(lines, "\n  ")
+}
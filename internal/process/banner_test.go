@@ -0,0 +1,49 @@
+package process
+
+// gomon is a simple command line tool that watches your files and automatically restarts the application when it detects any changes in the working directory.
+// Copyright (C) 2023 John Dudmesh
+
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+
+// You should have received a copy of the GNU General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>.
+
+import (
+	"testing"
+	"time"
+)
+
+func TestStartupBanner(t *testing.T) {
+	b := startupBanner{
+		pid:      1234,
+		runID:    "b417s5pqyyryy",
+		elapsed:  2345678 * time.Microsecond,
+		build:    1900 * time.Millisecond,
+		trigger:  "main.go",
+		proxyURL: "http://localhost:4000",
+		uiURL:    "http://localhost:4001",
+	}
+	expected := "child process running: pid 1234, run b417s5pqyyryy\n" +
+		"  started in 2.346s (build 1.9s)\n" +
+		"  trigger: main.go\n" +
+		"  proxy: http://localhost:4000\n" +
+		"  ui: http://localhost:4001"
+	if b.String() != expected {
+		t.Errorf("unexpected banner:\n%s", b.String())
+	}
+
+	// go run builds after the process has started and the URLs are omitted when the services are disabled
+	b = startupBanner{pid: 1, runID: "r", elapsed: 50 * time.Millisecond}
+	expected = "child process running: pid 1, run r\n  started in 50ms\n  trigger: gomon started"
+	if b.String() != expected {
+		t.Errorf("unexpected banner:\n%s", b.String())
+	}
+}
@@ -0,0 +1,173 @@
+package process
+
+// gomon is a simple command line tool that watches your files and automatically restarts the application when it detects any changes in the working directory.
+// Copyright (C) 2023 John Dudmesh
+
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+
+// You should have received a copy of the GNU General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>.
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"runtime"
+	"slices"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/jdudmesh/gomon/internal/config"
+	"github.com/jdudmesh/gomon/internal/notification"
+	"github.com/jdudmesh/gomon/internal/utils"
+)
+
+const defaultBuildOutput = ".gomon/bin/app"
+
+// Builder compiles the entrypoint into a binary which is reused across restarts until invalidated
+type Builder struct {
+	rootDirectory string
+	entrypoint    string
+	output        string
+	flags         []string
+	dirty         atomic.Bool
+	buildLock     sync.Mutex
+}
+
+func NewBuilder(cfg config.Config) (*Builder, error) {
+	if cfg.Entrypoint == "" {
+		return nil, errors.New("an entrypoint is required for build mode")
+	}
+
+	b := &Builder{
+		rootDirectory: cfg.RootDirectory,
+		entrypoint:    cfg.Entrypoint,
+		output:        cfg.Build.Output,
+		flags:         cfg.Build.Flags,
+	}
+
+	if b.output == "" {
+		b.output = defaultBuildOutput
+	}
+	if runtime.GOOS == "windows" && filepath.Ext(b.output) == "" {
+		b.output += ".exe"
+	}
+	if !filepath.IsAbs(b.output) {
+		b.output = filepath.Join(b.rootDirectory, b.output)
+	}
+
+	// always build on first run
+	b.dirty.Store(true)
+
+	return b, nil
+}
+
+// Binary returns the path of the compiled binary
+func (b *Builder) Binary() string {
+	return b.output
+}
+
+// Invalidate forces a rebuild the next time Build is called
+func (b *Builder) Invalidate() {
+	b.dirty.Store(true)
+}
+
+// Build compiles the entrypoint if the cached binary is missing or has been invalidated
+func (b *Builder) Build(envVars []string, childProcessID string, callbackFn notification.NotificationCallback) error {
+	b.buildLock.Lock()
+	defer b.buildLock.Unlock()
+
+	if !b.dirty.Load() {
+		if _, err := os.Stat(b.output); err == nil {
+			log.Info("using cached binary")
+			return nil
+		}
+	}
+
+	err := os.MkdirAll(filepath.Dir(b.output), 0755)
+	if err != nil {
+		return fmt.Errorf("creating build output directory: %w", err)
+	}
+
+	args := []string{"build"}
+	args = append(args, b.flags...)
+	args = append(args, "-o", b.output, b.entrypoint)
+
+	log.Infof("building: go %v", args)
+	callbackFn(notification.Notification{
+		ID:              notification.NextID(),
+		ChildProccessID: childProcessID,
+		Date:            time.Now(),
+		Type:            notification.NotificationTypeOOBTaskStartup,
+		Message:         fmt.Sprintf("building: %s", b.entrypoint),
+	})
+
+	stdoutBuf := &bytes.Buffer{}
+	stderrBuf := &bytes.Buffer{}
+
+	cmd := exec.Command("go", args...)
+	cmd.Dir = b.rootDirectory
+	cmd.Stdout = stdoutBuf
+	cmd.Stderr = stderrBuf
+	cmd.Env = envVars
+
+	startedAt := time.Now()
+	err = cmd.Run()
+
+	if stdoutBuf.Len() > 0 {
+		callbackFn(notification.Notification{
+			ID:              notification.NextID(),
+			ChildProccessID: childProcessID,
+			Date:            time.Now(),
+			Type:            notification.NotificationTypeOOBTaskStdOut,
+			Message:         stdoutBuf.String(),
+		})
+	}
+
+	if stderrBuf.Len() > 0 {
+		notifType := notification.NotificationTypeOOBTaskStdErr
+		if slices.ContainsFunc(strings.Split(stderrBuf.String(), "\n"), utils.IsCompilerError) {
+			notifType = notification.NotificationTypeBuildError
+		}
+		callbackFn(notification.Notification{
+			ID:              notification.NextID(),
+			ChildProccessID: childProcessID,
+			Date:            time.Now(),
+			Type:            notifType,
+			Message:         stderrBuf.String(),
+		})
+	}
+
+	status := fmt.Sprintf("build completed in %s: %s", time.Since(startedAt).Round(time.Millisecond), b.entrypoint)
+	if err != nil {
+		status = fmt.Sprintf("build failed: %s: %v", b.entrypoint, err)
+	}
+	callbackFn(notification.Notification{
+		ID:              notification.NextID(),
+		ChildProccessID: childProcessID,
+		Date:            time.Now(),
+		Type:            notification.NotificationTypeOOBTaskComplete,
+		Message:         status,
+	})
+
+	if err != nil {
+		return fmt.Errorf("building entrypoint: %w", err)
+	}
+
+	b.dirty.Store(false)
+
+	return nil
+}
@@ -0,0 +1,130 @@
+package process
+
+// gomon is a simple command line tool that watches your files and automatically restarts the application when it detects any changes in the working directory.
+// Copyright (C) 2023 John Dudmesh
+
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+
+// You should have received a copy of the GNU General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>.
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"regexp"
+	"strings"
+	"sync"
+)
+
+type CrashCategory string
+
+const (
+	CrashCategoryUnknown     CrashCategory = "unknown"
+	CrashCategoryPanic       CrashCategory = "panic"
+	CrashCategoryPortInUse   CrashCategory = "port in use"
+	CrashCategoryMigration   CrashCategory = "migration failure"
+	CrashCategoryOutOfMemory CrashCategory = "out of memory"
+)
+
+const crashTailLines = 50
+
+var (
+	panicPattern       = regexp.MustCompile(`^(panic|fatal error): (.*)`)
+	portInUsePattern   = regexp.MustCompile(`listen tcp .*:(\d+): bind: (address already in use|Only one usage of each socket address)`)
+	migrationPattern   = regexp.MustCompile(`(?i)migrat\w*.*(fail|error|dirty)`)
+	outOfMemoryPattern = regexp.MustCompile(`(?i)(signal: killed|out of memory|cannot allocate memory)`)
+)
+
+// Crash describes why a child process exited unexpectedly
+type Crash struct {
+	Category    CrashCategory
+	Description string
+}
+
+// ClassifyCrash inspects the last lines written to stderr before the process exited to work out why it crashed
+func ClassifyCrash(lines []string, exitCode int) Crash {
+	for _, line := range lines {
+		line = strings.TrimSpace(line)
+		if m := portInUsePattern.FindStringSubmatch(line); m != nil {
+			return Crash{Category: CrashCategoryPortInUse, Description: fmt.Sprintf("port %s already in use", m[1])}
+		}
+	}
+
+	for _, line := range lines {
+		line = strings.TrimSpace(line)
+		if m := panicPattern.FindStringSubmatch(line); m != nil {
+			return Crash{Category: CrashCategoryPanic, Description: line}
+		}
+	}
+
+	for _, line := range lines {
+		if migrationPattern.MatchString(line) {
+			return Crash{Category: CrashCategoryMigration, Description: strings.TrimSpace(line)}
+		}
+	}
+
+	for _, line := range lines {
+		if outOfMemoryPattern.MatchString(line) {
+			return Crash{Category: CrashCategoryOutOfMemory, Description: "killed, possibly out of memory"}
+		}
+	}
+
+	return Crash{Category: CrashCategoryUnknown, Description: fmt.Sprintf("exit code %d", exitCode)}
+}
+
+// tailWriter keeps the last few lines written to it so that they can be inspected if the process crashes
+type tailWriter struct {
+	lines    []string
+	partial  []byte
+	maxLines int
+	lock     sync.Mutex
+}
+
+func newTailWriter(maxLines int) *tailWriter {
+	return &tailWriter{
+		maxLines: maxLines,
+	}
+}
+
+func (t *tailWriter) Write(p []byte) (int, error) {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+
+	t.partial = append(t.partial, p...)
+	for {
+		ix := bytes.IndexByte(t.partial, '\n')
+		if ix < 0 {
+			break
+		}
+		t.lines = append(t.lines, string(t.partial[:ix]))
+		t.partial = t.partial[ix+1:]
+	}
+
+	if len(t.lines) > t.maxLines {
+		t.lines = t.lines[len(t.lines)-t.maxLines:]
+	}
+
+	return len(p), nil
+}
+
+func (t *tailWriter) Lines() []string {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+
+	lines := append([]string{}, t.lines...)
+	if len(t.partial) > 0 {
+		lines = append(lines, string(t.partial))
+	}
+	return lines
+}
+
+var _ io.Writer = (*tailWriter)(nil)
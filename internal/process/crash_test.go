@@ -0,0 +1,90 @@
+package process
+
+// gomon is a simple command line tool that watches your files and automatically restarts the application when it detects any changes in the working directory.
+// Copyright (C) 2023 John Dudmesh
+
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+
+// You should have received a copy of the GNU General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>.
+
+import (
+	"testing"
+)
+
+func TestClassifyCrash(t *testing.T) {
+	tests := []struct {
+		name     string
+		lines    []string
+		category CrashCategory
+		desc     string
+	}{
+		{
+			name:     "port in use",
+			lines:    []string{"starting server", "listen tcp :8080: bind: address already in use", "exit status 1"},
+			category: CrashCategoryPortInUse,
+			desc:     "port 8080 already in use",
+		},
+		{
+			name:     "panic",
+			lines:    []string{"panic: runtime error: invalid memory address or nil pointer dereference", "goroutine 1 [running]:"},
+			category: CrashCategoryPanic,
+			desc:     "panic: runtime error: invalid memory address or nil pointer dereference",
+		},
+		{
+			name:     "migration",
+			lines:    []string{"running migrations", "migration 0003_users failed: column already exists"},
+			category: CrashCategoryMigration,
+			desc:     "migration 0003_users failed: column already exists",
+		},
+		{
+			name:     "out of memory",
+			lines:    []string{"signal: killed"},
+			category: CrashCategoryOutOfMemory,
+			desc:     "killed, possibly out of memory",
+		},
+		{
+			name:     "unknown",
+			lines:    []string{"something went wrong"},
+			category: CrashCategoryUnknown,
+			desc:     "exit code 2",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			crash := ClassifyCrash(tt.lines, 2)
+			if crash.Category != tt.category {
+				t.Errorf("expected category %s, got %s", tt.category, crash.Category)
+			}
+			if crash.Description != tt.desc {
+				t.Errorf("expected description %q, got %q", tt.desc, crash.Description)
+			}
+		})
+	}
+}
+
+func TestTailWriterKeepsLastLines(t *testing.T) {
+	w := newTailWriter(2)
+	w.Write([]byte("one\ntwo\nthr"))
+	w.Write([]byte("ee\nfour"))
+
+	lines := w.Lines()
+	expected := []string{"two", "three", "four"}
+	if len(lines) != len(expected) {
+		t.Fatalf("expected %v, got %v", expected, lines)
+	}
+	for i := range expected {
+		if lines[i] != expected[i] {
+			t.Errorf("expected %s, got %s", expected[i], lines[i])
+		}
+	}
+}
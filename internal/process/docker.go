@@ -0,0 +1,161 @@
+package process
+
+// gomon is a simple command line tool that watches your files and automatically restarts the application when it detects any changes in the working directory.
+// Copyright (C) 2023 John Dudmesh
+
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+
+// You should have received a copy of the GNU General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>.
+
+import (
+	"errors"
+	"fmt"
+	"os/exec"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"syscall"
+	"time"
+
+	"github.com/jdudmesh/gomon/internal/config"
+)
+
+// defaultDockerWorkdir is where the root directory is mounted in the container
+const defaultDockerWorkdir = "/app"
+
+// dockerRuntime runs the child process in a container. The docker CLI stays attached to the container's output so
+// that it is captured in the same way as a local process, a restart stops the container and starts a new one.
+type dockerRuntime struct {
+	rootDirectory string
+	image         string
+	workdir       string
+	args          []string
+	compose       string
+	service       string
+}
+
+func newDockerRuntime(cfg config.Config) (*dockerRuntime, error) {
+	d := &dockerRuntime{
+		rootDirectory: cfg.RootDirectory,
+		image:         cfg.Docker.Image,
+		workdir:       cfg.Docker.Workdir,
+		args:          cfg.Docker.Args,
+		compose:       cfg.Docker.Compose,
+		service:       cfg.Docker.Service,
+	}
+
+	if d.compose == "" && d.image == "" {
+		return nil, errors.New("docker.image or docker.compose is required with runtime docker")
+	}
+	if d.compose != "" && d.service == "" {
+		return nil, errors.New("docker.service is required with docker.compose")
+	}
+
+	if d.workdir == "" {
+		d.workdir = defaultDockerWorkdir
+	}
+
+	if d.rootDirectory != "" && !filepath.IsAbs(d.rootDirectory) {
+		rootDirectory, err := filepath.Abs(d.rootDirectory)
+		if err != nil {
+			return nil, fmt.Errorf("resolving root directory: %w", err)
+		}
+		d.rootDirectory = rootDirectory
+	}
+
+	return d, nil
+}
+
+// prepare starts a compose service in the background before its logs are followed, since is the time from which
+// logs are shown so that output from previous runs isn't repeated
+func (d *dockerRuntime) prepare(envVars []string) (time.Time, error) {
+	since := time.Now()
+	if d.compose == "" {
+		return since, nil
+	}
+
+	cmd := exec.Command("docker", d.composeArgs("up", "--detach", d.service)...)
+	cmd.Dir = d.rootDirectory
+	cmd.Env = envVars
+	out, err := cmd.CombinedOutput()
+	if err != nil {
+		return since, fmt.Errorf("starting compose service %s: %w: %s", d.service, err, string(out))
+	}
+
+	return since, nil
+}
+
+// command wraps the child's command so that it is run in a container called name. The variables in env are passed
+// through from the docker CLI's environment. Compose services run their own command so their logs are followed.
+func (d *dockerRuntime) command(name string, command []string, env []string, since time.Time) []string {
+	if d.compose != "" {
+		return append([]string{"docker"}, d.composeArgs("logs", "--follow", "--no-log-prefix", "--since", since.Format(time.RFC3339Nano), d.service)...)
+	}
+
+	// the init process passes signals on to the whole process tree e.g. go run and the program it builds
+	args := []string{"docker", "run", "--rm", "--init", "--name", name,
+		"--volume", d.rootDirectory + ":" + d.workdir, "--workdir", d.workdir,
+		"--env", "TINI_KILL_PROCESS_GROUP=1"}
+	for _, key := range env {
+		args = append(args, "--env", key)
+	}
+	args = append(args, d.args...)
+	args = append(args, d.image)
+	return append(args, command...)
+}
+
+// signal sends the stop signal to the container, the attached CLI exits when the container stops
+func (d *dockerRuntime) signal(name string, sig syscall.Signal) error {
+	args := []string{"kill", "--signal", strconv.Itoa(int(sig)), name}
+	if d.compose != "" {
+		args = d.composeArgs("kill", "--signal", strconv.Itoa(int(sig)), d.service)
+	}
+	return d.run(args)
+}
+
+// kill stops the container immediately
+func (d *dockerRuntime) kill(name string) error {
+	args := []string{"rm", "--force", name}
+	if d.compose != "" {
+		args = d.composeArgs("kill", d.service)
+	}
+	return d.run(args)
+}
+
+// DependenciesCommand returns the command which starts the compose services in dependsOn and waits for them to be
+// running, or healthy if they have a health check. It is empty if there are no dependencies.
+func DependenciesCommand(cfg config.Config) string {
+	if len(cfg.DependsOn) == 0 {
+		return ""
+	}
+
+	args := []string{"docker", "compose"}
+	if cfg.Docker.Compose != "" {
+		args = append(args, "--file", cfg.Docker.Compose)
+	}
+	args = append(args, "up", "--detach", "--wait")
+	return strings.Join(append(args, cfg.DependsOn...), " ")
+}
+
+func (d *dockerRuntime) composeArgs(args ...string) []string {
+	return append([]string{"compose", "--file", d.compose}, args...)
+}
+
+func (d *dockerRuntime) run(args []string) error {
+	cmd := exec.Command("docker", args...)
+	cmd.Dir = d.rootDirectory
+	out, err := cmd.CombinedOutput()
+	if err != nil {
+		return fmt.Errorf("docker %s: %w: %s", args[0], err, string(out))
+	}
+	return nil
+}
@@ -0,0 +1,139 @@
+package process
+
+// gomon is a simple command line tool that watches your files and automatically restarts the application when it detects any changes in the working directory.
+// Copyright (C) 2023 John Dudmesh
+
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+
+// You should have received a copy of the GNU General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>.
+
+import (
+	"os"
+	"path/filepath"
+	"runtime"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/jdudmesh/gomon/internal/config"
+	"github.com/jdudmesh/gomon/internal/notification"
+)
+
+func TestDockerCommand(t *testing.T) {
+	cfg := config.Config{RootDirectory: "/src", Runtime: config.RuntimeDocker}
+	_, err := newDockerRuntime(cfg)
+	if err == nil {
+		t.Error("expected an error without an image or compose file")
+	}
+
+	cfg.Docker.Image = "golang:1.21"
+	cfg.Docker.Args = []string{"--publish", "8080:8080"}
+	d, err := newDockerRuntime(cfg)
+	if err != nil {
+		t.Fatalf("creating runtime: %v", err)
+	}
+
+	args := strings.Join(d.command("gomon-1", []string{"go", "run", "./cmd/server"}, []string{"GOMON_RUN_ID"}, time.Now()), " ")
+	expected := "docker run --rm --init --name gomon-1 --volume /src:/app --workdir /app --env TINI_KILL_PROCESS_GROUP=1 --env GOMON_RUN_ID --publish 8080:8080 golang:1.21 go run ./cmd/server"
+	if args != expected {
+		t.Errorf("unexpected command:\n%s\nexpected:\n%s", args, expected)
+	}
+
+	cfg.Docker.Compose = "compose.yml"
+	_, err = newDockerRuntime(cfg)
+	if err == nil {
+		t.Error("expected an error without a compose service")
+	}
+
+	cfg.Docker.Service = "api"
+	d, err = newDockerRuntime(cfg)
+	if err != nil {
+		t.Fatalf("creating runtime: %v", err)
+	}
+
+	since := time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC)
+	args = strings.Join(d.command("gomon-1", []string{"go", "run"}, nil, since), " ")
+	expected = "docker compose --file compose.yml logs --follow --no-log-prefix --since 2024-01-02T03:04:05Z api"
+	if args != expected {
+		t.Errorf("unexpected command:\n%s\nexpected:\n%s", args, expected)
+	}
+}
+
+func TestDependenciesCommand(t *testing.T) {
+	cfg := config.Config{}
+	if command := DependenciesCommand(cfg); command != "" {
+		t.Errorf("expected no command without dependencies, got %q", command)
+	}
+
+	cfg.DependsOn = []string{"postgres", "redis"}
+	expected := "docker compose up --detach --wait postgres redis"
+	if command := DependenciesCommand(cfg); command != expected {
+		t.Errorf("unexpected command: %q, expected: %q", command, expected)
+	}
+
+	cfg.Docker.Compose = "compose.yml"
+	expected = "docker compose --file compose.yml up --detach --wait postgres redis"
+	if command := DependenciesCommand(cfg); command != expected {
+		t.Errorf("unexpected command: %q, expected: %q", command, expected)
+	}
+}
+
+func TestDockerRuntime(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("the fake docker CLI is a shell script")
+	}
+
+	// a fake docker CLI which records its arguments
+	binDir := t.TempDir()
+	argsFile := filepath.Join(binDir, "args")
+	script := "#!/bin/sh\necho \"$@\" >> " + argsFile + "\necho container output\n"
+	err := os.WriteFile(filepath.Join(binDir, "docker"), []byte(script), 0755)
+	if err != nil {
+		t.Fatalf("writing fake docker: %v", err)
+	}
+	t.Setenv("PATH", binDir+string(os.PathListSeparator)+os.Getenv("PATH"))
+
+	rootDirectory := t.TempDir()
+	err = os.WriteFile(filepath.Join(rootDirectory, ".env"), []byte("DATABASE_URL=postgres://db\n"), 0644)
+	if err != nil {
+		t.Fatalf("writing env file: %v", err)
+	}
+
+	cfg := config.Config{
+		RootDirectory: rootDirectory,
+		Entrypoint:    "./cmd/server",
+		EnvFiles:      []string{".env"},
+		Runtime:       config.RuntimeDocker,
+	}
+	cfg.Docker.Image = "golang:1.21"
+
+	proc, err := NewChildProcess(cfg)
+	if err != nil {
+		t.Fatalf("creating child process: %v", err)
+	}
+
+	err = proc.Start(&testConsole{}, func(n notification.Notification) error { return nil })
+	if err != nil {
+		t.Fatalf("starting child process: %v", err)
+	}
+
+	buf, err := os.ReadFile(argsFile)
+	if err != nil {
+		t.Fatalf("reading arguments: %v", err)
+	}
+	args := string(buf)
+	for _, expected := range []string{"run --rm --init --name gomon-", "--volume " + rootDirectory + ":/app", "--env DATABASE_URL", "--env GOMON_RUN_ID", "golang:1.21 go run ./cmd/server"} {
+		if !strings.Contains(args, expected) {
+			t.Errorf("expected %q in: %s", expected, args)
+		}
+	}
+}
@@ -0,0 +1,93 @@
+package process
+
+// gomon is a simple command line tool that watches your files and automatically restarts the application when it detects any changes in the working directory.
+// Copyright (C) 2023 John Dudmesh
+
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+
+// You should have received a copy of the GNU General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>.
+
+import (
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/jdudmesh/gomon/internal/config"
+)
+
+// The environment contract, these variables are injected into every child process
+const (
+	EnvIPCChannel = "GOMON_IPC_CHANNEL"
+	// EnvIPCTransport and EnvIPCToken are only set when the channel is a unix socket or TCP port
+	EnvIPCTransport = "GOMON_IPC_TRANSPORT"
+	EnvIPCToken     = "GOMON_IPC_TOKEN"
+	EnvRunID        = "GOMON_RUN_ID"
+	EnvProxyURL     = "GOMON_PROXY_URL"
+	EnvUIURL        = "GOMON_UI_URL"
+	EnvProfile      = "GOMON_PROFILE"
+	// EnvTemplates is the list of templates.paths patterns, separated in the same way as PATH
+	EnvTemplates = "GOMON_TEMPLATES"
+)
+
+const defaultProfile = "default"
+
+// newEnvContract builds the GOMON_* variables which don't change between runs.
+// GOMON_RUN_ID is added on each start, URLs are omitted when the service is disabled.
+func newEnvContract(cfg config.Config) map[string]string {
+	env := map[string]string{
+		EnvIPCChannel: cfg.IPCAdvertisedAddress(),
+		EnvProfile:    cfg.Profile,
+	}
+
+	if transport := cfg.IPCTransport(); transport != config.IPCTransportUDP {
+		env[EnvIPCTransport] = transport
+		env[EnvIPCToken] = cfg.IPC.Token
+	}
+
+	if env[EnvProfile] == "" {
+		env[EnvProfile] = defaultProfile
+	}
+
+	if proxyURL := cfg.ProxyURL(); proxyURL != "" {
+		env[EnvProxyURL] = proxyURL
+	}
+
+	if uiURL := cfg.UIURL(); uiURL != "" {
+		env[EnvUIURL] = uiURL
+	}
+
+	if len(cfg.Templates.Paths) > 0 {
+		env[EnvTemplates] = strings.Join(cfg.Templates.Paths, string(filepath.ListSeparator))
+	}
+
+	return env
+}
+
+// environ renders the contract as KEY=value pairs, sorted so that the child's environment is stable
+func environ(env map[string]string) []string {
+	vars := make([]string, 0, len(env))
+	for k, v := range env {
+		vars = append(vars, k+"="+v)
+	}
+	sort.Strings(vars)
+	return vars
+}
+
+// envKeys returns the names of the variables, sorted in the same way as environ
+func envKeys(env map[string]string) []string {
+	keys := make([]string, 0, len(env))
+	for k := range env {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
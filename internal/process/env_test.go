@@ -0,0 +1,94 @@
+package process
+
+// gomon is a simple command line tool that watches your files and automatically restarts the application when it detects any changes in the working directory.
+// Copyright (C) 2023 John Dudmesh
+
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+
+// You should have received a copy of the GNU General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>.
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/jdudmesh/gomon/internal/config"
+)
+
+func TestEnvContract(t *testing.T) {
+	cfg := config.Config{}
+	env := newEnvContract(cfg)
+
+	if env[EnvIPCChannel] != "127.0.0.1:33333" {
+		t.Errorf("unexpected IPC channel: %s", env[EnvIPCChannel])
+	}
+	if env[EnvProfile] != "default" {
+		t.Errorf("unexpected profile: %s", env[EnvProfile])
+	}
+	if _, ok := env[EnvProxyURL]; ok {
+		t.Error("proxy URL should not be set when the proxy is disabled")
+	}
+	if _, ok := env[EnvUIURL]; ok {
+		t.Error("UI URL should not be set when the UI is disabled")
+	}
+	if _, ok := env[EnvTemplates]; ok {
+		t.Error("templates should not be set when there are no template paths")
+	}
+
+	cfg.Profile = "integration"
+	cfg.Proxy.Enabled = true
+	cfg.Proxy.Port = 8443
+	cfg.Proxy.TLS.Cert = "cert.pem"
+	cfg.UI.Enabled = true
+	cfg.UI.MountOnProxy = true
+	cfg.Templates.Paths = []string{"views/**/*.html", "*.tmpl"}
+	env = newEnvContract(cfg)
+
+	expected := map[string]string{
+		EnvProfile:   "integration",
+		EnvProxyURL:  "https://localhost:8443",
+		EnvUIURL:     "https://localhost:8443/__gomon__/ui",
+		EnvTemplates: "views/**/*.html" + string(filepath.ListSeparator) + "*.tmpl",
+	}
+	for k, v := range expected {
+		if env[k] != v {
+			t.Errorf("expected %s=%s, got %s", k, v, env[k])
+		}
+	}
+
+	cfg.Proxy.Enabled = false
+	env = newEnvContract(cfg)
+	if env[EnvUIURL] != "http://localhost:4001" {
+		t.Errorf("unexpected UI URL: %s", env[EnvUIURL])
+	}
+	if _, ok := env[EnvIPCToken]; ok {
+		t.Error("IPC token should not be set for the udp transport")
+	}
+
+	cfg.RootDirectory = "/src"
+	cfg.IPC.Transport = config.IPCTransportUnix
+	cfg.IPC.Token = "secret"
+	env = newEnvContract(cfg)
+	if env[EnvIPCChannel] != ".gomon/ipc.sock" || env[EnvIPCTransport] != "unix" || env[EnvIPCToken] != "secret" {
+		t.Errorf("unexpected unix IPC channel: %s %s %s", env[EnvIPCChannel], env[EnvIPCTransport], env[EnvIPCToken])
+	}
+	if cfg.IPCAddress() != filepath.Join("/src", ".gomon", "ipc.sock") {
+		t.Errorf("unexpected socket path: %s", cfg.IPCAddress())
+	}
+
+	cfg.IPC.Transport = config.IPCTransportTCP
+	cfg.IPC.Address = "0.0.0.0:33333"
+	cfg.IPC.Advertise = "host.docker.internal:33333"
+	env = newEnvContract(cfg)
+	if env[EnvIPCChannel] != "host.docker.internal:33333" || env[EnvIPCTransport] != "tcp" {
+		t.Errorf("unexpected tcp IPC channel: %s %s", env[EnvIPCChannel], env[EnvIPCTransport])
+	}
+}
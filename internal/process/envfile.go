@@ -0,0 +1,107 @@
+package process
+
+// gomon is a simple command line tool that watches your files and automatically restarts the application when it detects any changes in the working directory.
+// Copyright (C) 2023 John Dudmesh
+
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+
+// You should have received a copy of the GNU General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>.
+
+import (
+	"bufio"
+	"os"
+	"sort"
+	"strings"
+)
+
+// readEnvFile returns the KEY=VALUE lines of an env file, skipping blank lines and comments
+func readEnvFile(filename string) ([]string, error) {
+	file, err := os.Open(filename)
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+
+	lines := []string{}
+	scanner := bufio.NewScanner(file)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if strings.HasPrefix(line, "#") || len(line) == 0 {
+			continue
+		}
+		lines = append(lines, line)
+	}
+
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+
+	return lines, nil
+}
+
+// ParseEnvFile reads an env file into a map of variable names to values, a missing file has no variables
+func ParseEnvFile(filename string) (map[string]string, error) {
+	vars := map[string]string{}
+
+	lines, err := readEnvFile(filename)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return vars, nil
+		}
+		return nil, err
+	}
+
+	for _, line := range lines {
+		key, value, _ := strings.Cut(line, "=")
+		key = strings.TrimSpace(strings.TrimPrefix(key, "export "))
+		vars[key] = value
+	}
+
+	return vars, nil
+}
+
+// DiffEnv describes which variables were added, changed or removed. Only the names are included
+// as env files often contain secrets. An empty string is returned if nothing changed.
+func DiffEnv(prev, next map[string]string) string {
+	added := []string{}
+	changed := []string{}
+	removed := []string{}
+
+	for k, v := range next {
+		prevValue, ok := prev[k]
+		switch {
+		case !ok:
+			added = append(added, k)
+		case prevValue != v:
+			changed = append(changed, k)
+		}
+	}
+	for k := range prev {
+		if _, ok := next[k]; !ok {
+			removed = append(removed, k)
+		}
+	}
+
+	parts := []string{}
+	for _, group := range []struct {
+		label string
+		names []string
+	}{{"added", added}, {"changed", changed}, {"removed", removed}} {
+		if len(group.names) == 0 {
+			continue
+		}
+		sort.Strings(group.names)
+		parts = append(parts, group.label+": "+strings.Join(group.names, ", "))
+	}
+
+	return strings.Join(parts, "; ")
+}
@@ -0,0 +1,69 @@
+package process
+
+// gomon is a simple command line tool that watches your files and automatically restarts the application when it detects any changes in the working directory.
+// Copyright (C) 2023 John Dudmesh
+
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+
+// You should have received a copy of the GNU General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>.
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestParseEnvFile(t *testing.T) {
+	filename := filepath.Join(t.TempDir(), ".env")
+	err := os.WriteFile(filename, []byte("# database\nDB_URL=postgres://localhost/app?sslmode=disable\n\nexport API_KEY=secret\nEMPTY=\n"), 0644)
+	if err != nil {
+		t.Fatalf("writing env file: %v", err)
+	}
+
+	vars, err := ParseEnvFile(filename)
+	if err != nil {
+		t.Fatalf("parsing env file: %v", err)
+	}
+
+	expected := map[string]string{
+		"DB_URL":  "postgres://localhost/app?sslmode=disable",
+		"API_KEY": "secret",
+		"EMPTY":   "",
+	}
+	if len(vars) != len(expected) {
+		t.Fatalf("expected %d variables, got %v", len(expected), vars)
+	}
+	for k, v := range expected {
+		if vars[k] != v {
+			t.Errorf("%s: expected %q, got %q", k, v, vars[k])
+		}
+	}
+
+	vars, err = ParseEnvFile(filepath.Join(t.TempDir(), "missing.env"))
+	if err != nil || len(vars) != 0 {
+		t.Errorf("expected a missing file to have no variables, got %v, %v", vars, err)
+	}
+}
+
+func TestDiffEnv(t *testing.T) {
+	prev := map[string]string{"A": "1", "B": "2", "C": "3"}
+	next := map[string]string{"A": "1", "B": "changed", "D": "4", "E": "5"}
+
+	diff := DiffEnv(prev, next)
+	if diff != "added: D, E; changed: B; removed: C" {
+		t.Errorf("unexpected diff: %s", diff)
+	}
+
+	if diff := DiffEnv(prev, prev); diff != "" {
+		t.Errorf("expected no differences, got %s", diff)
+	}
+}
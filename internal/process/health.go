@@ -0,0 +1,128 @@
+package process
+
+// gomon is a simple command line tool that watches your files and automatically restarts the application when it detects any changes in the working directory.
+// Copyright (C) 2023 John Dudmesh
+
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+
+// You should have received a copy of the GNU General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>.
+
+import (
+	"fmt"
+	"io"
+	"net/http"
+	"time"
+
+	"github.com/jdudmesh/gomon/internal/config"
+	"github.com/jdudmesh/gomon/internal/notification"
+)
+
+// WithUnhealthyHandler is called when the child process fails its health checks, the caller is expected to restart it
+func WithUnhealthyHandler(fn func(message string)) ChildProcessOption {
+	return func(c *childProcess) error {
+		if c.health != nil {
+			c.health.onUnhealthy = fn
+		}
+		return nil
+	}
+}
+
+type healthMonitor struct {
+	url              string
+	interval         time.Duration
+	failureThreshold int
+	client           http.Client
+	onUnhealthy      func(message string)
+}
+
+func newHealthMonitor(cfg config.Config) *healthMonitor {
+	if cfg.Health.URL == "" {
+		return nil
+	}
+
+	interval := time.Duration(cfg.Health.Interval)
+	if interval <= 0 {
+		interval = config.DefaultHealthInterval
+	}
+
+	failureThreshold := cfg.Health.FailureThreshold
+	if failureThreshold <= 0 {
+		failureThreshold = config.DefaultHealthFailureThreshold
+	}
+
+	return &healthMonitor{
+		url:              cfg.Health.URL,
+		interval:         interval,
+		failureThreshold: failureThreshold,
+		// a check which hasn't finished by the next one is a failure
+		client: http.Client{Timeout: interval},
+	}
+}
+
+// run checks the child process until stop is closed or it is found to be unhealthy. Failures aren't counted until
+// the first check succeeds, so a program which is still compiling or starting up isn't restarted.
+func (m *healthMonitor) run(runID string, stop <-chan struct{}, callbackFn notification.NotificationCallback) {
+	ticker := time.NewTicker(m.interval)
+	defer ticker.Stop()
+
+	healthy := false
+	failures := 0
+	for {
+		select {
+		case <-stop:
+			return
+		case <-ticker.C:
+			err := m.check()
+			if err == nil {
+				healthy = true
+				failures = 0
+				continue
+			}
+			if !healthy {
+				continue
+			}
+
+			failures++
+			log.WithField("childProcessId", runID).Debugf("health check failed: %v", err)
+			if failures < m.failureThreshold {
+				continue
+			}
+
+			message := fmt.Sprintf("health check failed %d times: %v", failures, err)
+			callbackFn(notification.Notification{
+				ID:              notification.NextID(),
+				ChildProccessID: runID,
+				Date:            time.Now(),
+				Type:            notification.NotificationTypeUnhealthy,
+				Message:         message,
+			})
+			if m.onUnhealthy != nil {
+				m.onUnhealthy(message)
+			}
+			return
+		}
+	}
+}
+
+func (m *healthMonitor) check() error {
+	res, err := m.client.Get(m.url)
+	if err != nil {
+		return err
+	}
+	defer res.Body.Close()
+	io.Copy(io.Discard, res.Body)
+
+	if res.StatusCode >= http.StatusBadRequest {
+		return fmt.Errorf("unexpected status: %s", res.Status)
+	}
+	return nil
+}
@@ -0,0 +1,96 @@
+package process
+
+// gomon is a simple command line tool that watches your files and automatically restarts the application when it detects any changes in the working directory.
+// Copyright (C) 2023 John Dudmesh
+
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+
+// You should have received a copy of the GNU General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>.
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/jdudmesh/gomon/internal/config"
+	"github.com/jdudmesh/gomon/internal/notification"
+)
+
+func TestHealthMonitor(t *testing.T) {
+	// the child starts up, responds, then deadlocks
+	status := atomic.Int32{}
+	status.Store(http.StatusServiceUnavailable)
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(int(status.Load()))
+	}))
+	defer srv.Close()
+
+	cfg := config.Config{}
+	cfg.Health.URL = srv.URL
+	cfg.Health.Interval = config.Duration(10 * time.Millisecond)
+	cfg.Health.FailureThreshold = 2
+	m := newHealthMonitor(cfg)
+
+	unhealthy := make(chan string, 1)
+	m.onUnhealthy = func(message string) {
+		unhealthy <- message
+	}
+
+	notifs := make(chan notification.Notification, 10)
+	stop := make(chan struct{})
+	defer close(stop)
+	go m.run("run-1", stop, func(n notification.Notification) error {
+		notifs <- n
+		return nil
+	})
+
+	// failures while the child is starting aren't counted
+	select {
+	case <-unhealthy:
+		t.Fatal("the child shouldn't be restarted before it has responded")
+	case <-time.After(100 * time.Millisecond):
+	}
+
+	status.Store(http.StatusOK)
+	time.Sleep(50 * time.Millisecond)
+	status.Store(http.StatusInternalServerError)
+
+	select {
+	case message := <-unhealthy:
+		if !strings.HasPrefix(message, "health check failed 2 times") {
+			t.Errorf("unexpected message: %s", message)
+		}
+	case <-time.After(time.Second):
+		t.Fatal("expected the child to be reported as unhealthy")
+	}
+
+	n := <-notifs
+	if n.Type != notification.NotificationTypeUnhealthy || n.ChildProccessID != "run-1" {
+		t.Errorf("unexpected notification: %+v", n)
+	}
+}
+
+func TestHealthMonitorDefaults(t *testing.T) {
+	if newHealthMonitor(config.Config{}) != nil {
+		t.Error("health checks should be disabled without a URL")
+	}
+
+	cfg := config.Config{}
+	cfg.Health.URL = "http://localhost:8080/healthz"
+	m := newHealthMonitor(cfg)
+	if m.interval != config.DefaultHealthInterval || m.failureThreshold != config.DefaultHealthFailureThreshold {
+		t.Errorf("unexpected defaults: %v %d", m.interval, m.failureThreshold)
+	}
+}
@@ -18,20 +18,48 @@ package process
 
 import (
 	"bytes"
+	"context"
+	"errors"
 	"fmt"
 	"os/exec"
 	"strings"
-	"syscall"
+	"sync"
 	"time"
 
 	"github.com/jdudmesh/gomon/internal/notification"
-	log "github.com/sirupsen/logrus"
 )
 
+// runningTasks holds the cancel function of each running task by its ID so that a task which hangs can be stopped
+// from the UI without restarting gomon
+var runningTasks sync.Map
+
+// CancelTask kills the running task with the given ID, it returns false if there is no such task e.g. it has finished
+func CancelTask(taskID string) bool {
+	cancel, ok := runningTasks.Load(taskID)
+	if !ok {
+		return false
+	}
+	cancel.(context.CancelFunc)()
+	return true
+}
+
+// CancelAllTasks kills every running task and returns the number cancelled
+func CancelAllTasks() int {
+	count := 0
+	runningTasks.Range(func(_, cancel any) bool {
+		cancel.(context.CancelFunc)()
+		count++
+		return true
+	})
+	return count
+}
+
 type outOfBandTask struct {
 	rootDirectory string
 	task          string
 	envVars       []string
+	// timeout kills the task if it hasn't finished in time, there is no limit if it is zero
+	timeout time.Duration
 }
 
 func NewOutOfBandTask(rootDirectory string, task string, envVars []string) *outOfBandTask {
@@ -43,53 +71,84 @@ func NewOutOfBandTask(rootDirectory string, task string, envVars []string) *outO
 }
 
 func (o *outOfBandTask) Run(childProcessID string, callbackFn notification.NotificationCallback) error {
+	return o.RunContext(context.Background(), childProcessID, callbackFn)
+}
+
+// RunContext runs the task, killing it and any processes it started if ctx is cancelled before it finishes
+func (o *outOfBandTask) RunContext(ctx context.Context, childProcessID string, callbackFn notification.NotificationCallback) error {
 	log.Infof("running task: %s", o.task)
 
-	stdoutBuf := &bytes.Buffer{}
-	stderrBuf := &bytes.Buffer{}
+	taskID := notification.NextID()
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	if o.timeout > 0 {
+		ctx, cancel = context.WithTimeout(ctx, o.timeout)
+		defer cancel()
+	}
+	runningTasks.Store(taskID, cancel)
+	defer runningTasks.Delete(taskID)
 
 	callbackFn(notification.Notification{
-		ID:              notification.NextID(),
+		ID:              taskID,
 		ChildProccessID: childProcessID,
 		Date:            time.Now(),
 		Type:            notification.NotificationTypeOOBTaskStartup,
 		Message:         "running task: " + o.task,
+		TaskID:          taskID,
 	})
 
+	// output is streamed a line at a time so that progress of long running tasks is visible
+	emit := func(notifType notification.NotificationType) func(string) {
+		return func(line string) {
+			callbackFn(notification.Notification{
+				ID:              notification.NextID(),
+				ChildProccessID: childProcessID,
+				Date:            time.Now(),
+				Type:            notifType,
+				Message:         line,
+				TaskID:          taskID,
+			})
+		}
+	}
+	stdout := newLineWriter(emit(notification.NotificationTypeOOBTaskStdOut))
+	stderr := newLineWriter(emit(notification.NotificationTypeOOBTaskStdErr))
+
 	args := strings.Split(o.task, " ")
-	cmd := exec.Command(args[0], args[1:]...)
+	cmd := exec.CommandContext(ctx, args[0], args[1:]...)
 	cmd.Dir = o.rootDirectory
-	cmd.Stdout = stdoutBuf
-	cmd.Stderr = stderrBuf
-	cmd.SysProcAttr = &syscall.SysProcAttr{Setpgid: true}
+	cmd.Stdout = stdout
+	cmd.Stderr = stderr
+	cmd.SysProcAttr = newSysProcAttr()
 	cmd.Env = o.envVars
+	cmd.Cancel = func() error {
+		return killProcessGroup(cmd.Process.Pid)
+	}
 
+	startedAt := time.Now()
 	err := cmd.Start()
-	if err != nil {
-		return fmt.Errorf("starting task: %w", err)
+	if err == nil {
+		err = cmd.Wait()
 	}
 
-	err = cmd.Wait()
-
-	if stdoutBuf.Len() > 0 {
-		callbackFn(notification.Notification{
-			ID:              notification.NextID(),
-			ChildProccessID: childProcessID,
-			Date:            time.Now(),
-			Type:            notification.NotificationTypeOOBTaskStdOut,
-			Message:         string(stdoutBuf.Bytes()),
-		})
-	}
+	stdout.Flush()
+	stderr.Flush()
 
-	if stderrBuf.Len() > 0 {
-		callbackFn(notification.Notification{
-			ID:              notification.NextID(),
-			ChildProccessID: childProcessID,
-			Date:            time.Now(),
-			Type:            notification.NotificationTypeOOBTaskStdErr,
-			Message:         string(stderrBuf.Bytes()),
-		})
+	status := fmt.Sprintf("task completed in %s: %s", time.Since(startedAt).Round(time.Millisecond), o.task)
+	if err != nil && errors.Is(ctx.Err(), context.DeadlineExceeded) {
+		status = fmt.Sprintf("task timed out after %s: %s", o.timeout, o.task)
+	} else if err != nil && ctx.Err() != nil {
+		status = "task cancelled: " + o.task
+	} else if err != nil {
+		status = fmt.Sprintf("task failed: %s: %v", o.task, err)
 	}
+	callbackFn(notification.Notification{
+		ID:              notification.NextID(),
+		ChildProccessID: childProcessID,
+		Date:            time.Now(),
+		Type:            notification.NotificationTypeOOBTaskComplete,
+		Message:         status,
+		TaskID:          taskID,
+	})
 
 	if err != nil {
 		return fmt.Errorf("running oob task: %w", err)
@@ -97,3 +156,42 @@ func (o *outOfBandTask) Run(childProcessID string, callbackFn notification.Notif
 
 	return nil
 }
+
+// lineWriter calls fn for each complete line written to it
+type lineWriter struct {
+	fn      func(string)
+	partial []byte
+	lock    sync.Mutex
+}
+
+func newLineWriter(fn func(string)) *lineWriter {
+	return &lineWriter{fn: fn}
+}
+
+func (l *lineWriter) Write(p []byte) (int, error) {
+	l.lock.Lock()
+	defer l.lock.Unlock()
+
+	l.partial = append(l.partial, p...)
+	for {
+		ix := bytes.IndexByte(l.partial, '\n')
+		if ix < 0 {
+			break
+		}
+		l.fn(strings.TrimSuffix(string(l.partial[:ix]), "\r"))
+		l.partial = l.partial[ix+1:]
+	}
+
+	return len(p), nil
+}
+
+// Flush emits any trailing output which wasn't terminated by a newline
+func (l *lineWriter) Flush() {
+	l.lock.Lock()
+	defer l.lock.Unlock()
+
+	if len(l.partial) > 0 {
+		l.fn(string(l.partial))
+		l.partial = nil
+	}
+}
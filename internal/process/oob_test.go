@@ -0,0 +1,130 @@
+package process
+
+// gomon is a simple command line tool that watches your files and automatically restarts the application when it detects any changes in the working directory.
+// Copyright (C) 2023 John Dudmesh
+
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+
+// You should have received a copy of the GNU General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>.
+
+import (
+	"os"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/jdudmesh/gomon/internal/notification"
+)
+
+func TestLineWriter(t *testing.T) {
+	lines := []string{}
+	w := newLineWriter(func(line string) {
+		lines = append(lines, line)
+	})
+
+	w.Write([]byte("first\nsec"))
+	w.Write([]byte("ond\r\nthi"))
+	if len(lines) != 2 || lines[0] != "first" || lines[1] != "second" {
+		t.Fatalf("unexpected lines before flush: %q", lines)
+	}
+
+	w.Flush()
+	if len(lines) != 3 || lines[2] != "thi" {
+		t.Errorf("expected partial line to be flushed: %q", lines)
+	}
+}
+
+func TestOutOfBandTaskStreamsOutput(t *testing.T) {
+	task := NewOutOfBandTask(t.TempDir(), "echo hello", os.Environ())
+
+	lock := sync.Mutex{}
+	received := []notification.Notification{}
+	err := task.Run("run1", func(n notification.Notification) error {
+		lock.Lock()
+		defer lock.Unlock()
+		received = append(received, n)
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("running task: %v", err)
+	}
+
+	expected := []notification.NotificationType{
+		notification.NotificationTypeOOBTaskStartup,
+		notification.NotificationTypeOOBTaskStdOut,
+		notification.NotificationTypeOOBTaskComplete,
+	}
+	if len(received) != len(expected) {
+		t.Fatalf("expected %d notifications, got %d: %+v", len(expected), len(received), received)
+	}
+	for i, n := range received {
+		if n.Type != expected[i] {
+			t.Errorf("notification %d: expected %s, got %s", i, expected[i], n.Type)
+		}
+		if n.TaskID != received[0].ID {
+			t.Errorf("notification %d: expected task ID %s, got %s", i, received[0].ID, n.TaskID)
+		}
+	}
+	if received[1].Message != "hello" {
+		t.Errorf("expected task output, got %q", received[1].Message)
+	}
+}
+
+func TestOutOfBandTaskCancel(t *testing.T) {
+	task := NewOutOfBandTask(t.TempDir(), "sleep 10", os.Environ())
+
+	status := ""
+	startedAt := time.Now()
+	err := task.Run("run1", func(n notification.Notification) error {
+		switch n.Type {
+		case notification.NotificationTypeOOBTaskStartup:
+			// the task is registered before it is announced
+			if !CancelTask(n.TaskID) {
+				t.Error("expected the task to be running")
+			}
+		case notification.NotificationTypeOOBTaskComplete:
+			status = n.Message
+		}
+		return nil
+	})
+	if err == nil {
+		t.Fatal("expected the cancelled task to fail")
+	}
+	if time.Since(startedAt) > 5*time.Second {
+		t.Errorf("expected the task to be killed, took %s", time.Since(startedAt))
+	}
+	if status != "task cancelled: sleep 10" {
+		t.Errorf("unexpected status: %q", status)
+	}
+	if CancelAllTasks() != 0 {
+		t.Error("expected the finished task to be removed")
+	}
+}
+
+func TestOutOfBandTaskTimeout(t *testing.T) {
+	task := NewOutOfBandTask(t.TempDir(), "sleep 10", os.Environ())
+	task.timeout = 100 * time.Millisecond
+
+	status := ""
+	err := task.Run("run1", func(n notification.Notification) error {
+		if n.Type == notification.NotificationTypeOOBTaskComplete {
+			status = n.Message
+		}
+		return nil
+	})
+	if err == nil {
+		t.Fatal("expected the task to time out")
+	}
+	if status != "task timed out after 100ms: sleep 10" {
+		t.Errorf("unexpected status: %q", status)
+	}
+}
@@ -0,0 +1,64 @@
+package process
+
+// gomon is a simple command line tool that watches your files and automatically restarts the application when it detects any changes in the working directory.
+// Copyright (C) 2023 John Dudmesh
+
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+
+// You should have received a copy of the GNU General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>.
+
+import "time"
+
+// The steps of starting the child process which are reported to a PhaseRecorder
+const (
+	PhasePrestart = "prestart"
+	PhaseBuild    = "build"
+	PhaseSpawn    = "process start"
+)
+
+// StartPhase is how long a step of starting a run took, Err is set if the step failed and the run didn't start
+type StartPhase struct {
+	RunID string
+	Name  string
+	Start time.Time
+	End   time.Time
+	Err   error
+}
+
+// PhaseRecorder is told about each step of starting a run e.g. to trace restarts. It is called on the goroutine which
+// starts the child process so it mustn't block.
+type PhaseRecorder interface {
+	RecordPhase(p StartPhase)
+}
+
+// WithPhaseRecorder reports the prestart tasks, the build and spawning the process of each run to r
+func WithPhaseRecorder(r PhaseRecorder) ChildProcessOption {
+	return func(c *childProcess) error {
+		c.phases = r
+		return nil
+	}
+}
+
+// recordPhase reports a step which began at start and has just finished, it returns err so that failures can be
+// reported and returned in one go
+func (c *childProcess) recordPhase(name string, start time.Time, err error) error {
+	if c.phases != nil {
+		c.phases.RecordPhase(StartPhase{
+			RunID: c.childProcessID,
+			Name:  name,
+			Start: start,
+			End:   time.Now(),
+			Err:   err,
+		})
+	}
+	return err
+}
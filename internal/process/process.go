@@ -17,13 +17,13 @@ package process
 // along with this program.  If not, see <https://www.gnu.org/licenses/>.
 
 import (
-	"bufio"
 	"context"
 	"errors"
 	"fmt"
 	"io"
 	"os"
 	"os/exec"
+	"path/filepath"
 	"strings"
 	"sync"
 	"sync/atomic"
@@ -33,9 +33,11 @@ import (
 	"github.com/jdudmesh/gomon/internal/config"
 	"github.com/jdudmesh/gomon/internal/notification"
 	"github.com/jdudmesh/gomon/internal/utils"
-	log "github.com/sirupsen/logrus"
+	"github.com/sirupsen/logrus"
 )
 
+var log = logrus.WithField("component", "process")
+
 // type ChildProcess interface {
 // 	HardRestart(string) error
 // 	SoftRestart(string) error
@@ -66,16 +68,22 @@ const (
 	processStateClosed
 )
 
+// ErrCrashed is returned by Start when the child process exits unexpectedly
+var ErrCrashed = errors.New("child process crashed")
+
 const ipcStatusDisconnected = "Disconnected"
 const initialBackoff = 50 * time.Millisecond
 const maxBackoff = 5 * time.Second
+const defaultKillTimeout = 5 * time.Second
+const prestartRetryDelay = time.Second
 
 type AtomicChildProcess struct {
 	value atomic.Value
 }
 
 func (a *AtomicChildProcess) Load() *childProcess {
-	return a.value.Load().(*childProcess)
+	p, _ := a.value.Load().(*childProcess)
+	return p
 }
 
 func (a *AtomicChildProcess) Store(p *childProcess) {
@@ -91,52 +99,189 @@ const (
 	ProcessStateStopping
 )
 
+func (s ProcessState) String() string {
+	switch s {
+	case ProcessStateStopped:
+		return "stopped"
+	case ProcessStateStarting:
+		return "starting"
+	case ProcessStateStarted:
+		return "started"
+	case ProcessStateStopping:
+		return "stopping"
+	}
+	return "unknown"
+}
+
+type ChildProcessOption func(*childProcess) error
+
+// WithBuilder runs the child from a compiled binary rather than using `go run`
+func WithBuilder(b *Builder) ChildProcessOption {
+	return func(c *childProcess) error {
+		c.builder = b
+		return nil
+	}
+}
+
+// WithSocket passes a listening socket to the child process, it is shared between runs
+func WithSocket(s *Socket) ChildProcessOption {
+	return func(c *childProcess) error {
+		c.socket = s
+		return nil
+	}
+}
+
+// WithSecretResolver shares a resolver, and its cache, between child process runs
+func WithSecretResolver(r *SecretResolver) ChildProcessOption {
+	return func(c *childProcess) error {
+		c.secrets = r
+		return nil
+	}
+}
+
+type prestartTask struct {
+	command   string
+	onFailure config.FailurePolicy
+}
+
+// prestartGroup is the prestart tasks which are run in parallel, a task which isn't in a group is a group of one
+type prestartGroup []prestartTask
+
 type childProcess struct {
 	rootDirectory  string
 	command        []string
 	entrypoint     string
 	envVars        []string
 	entrypointArgs []string
-	prestart       []string
+	prestart       []prestartGroup
+	taskTimeout    time.Duration
+	preStop        []string
+	postStop       []string
+	postStart      []string
+	callbackFn     notification.NotificationCallback
 	state          *utils.State[ProcessState]
 	childLock      sync.Mutex
 	closeLock      sync.Mutex
 	termChild      chan struct{}
 	killChild      chan struct{}
-	killTimeout    time.Duration
+	// loopDone is closed when the current run stops listening on termChild and killChild e.g. because the child
+	// process exited while a pre stop hook was running
+	loopDone    chan struct{}
+	killTimeout time.Duration
+	stopSignal  syscall.Signal
+	// reloadSignal is sent on each soft reload, it is 0 if templates.signal isn't set
+	reloadSignal syscall.Signal
+	builder      *Builder
+	secrets      *SecretResolver
+	socket       *Socket
+	docker       *dockerRuntime
+	resources    *resourceMonitor
+	health       *healthMonitor
+	// trigger describes what caused each start for the startup banner, it may be nil
+	trigger func() string
+	// phases is told how long each step of starting a run took, it may be nil
+	phases PhaseRecorder
+	// envFileKeys are the names of the variables from env files, they are passed through to containers
+	envFileKeys    []string
 	childProcessID string
+	contract       map[string]string
+	runEnv         atomic.Value
+	// pid and startedAt (unix nanoseconds) describe the running process, pid is 0 when it isn't running
+	pid       atomic.Int64
+	startedAt atomic.Int64
 }
 
-func NewChildProcess(cfg config.Config) (*childProcess, error) {
+func NewChildProcess(cfg config.Config, opts ...ChildProcessOption) (*childProcess, error) {
 	proc := &childProcess{
 		rootDirectory:  cfg.RootDirectory,
 		command:        cfg.Command,
 		entrypoint:     cfg.Entrypoint,
 		envVars:        os.Environ(),
 		entrypointArgs: cfg.EntrypointArgs,
-		prestart:       cfg.Prestart,
+		preStop:        cfg.Hooks.PreStop,
+		postStop:       cfg.Hooks.PostStop,
+		postStart:      cfg.Hooks.PostStart,
 		state:          utils.NewState[ProcessState](ProcessStateStopped),
 		childLock:      sync.Mutex{},
 		closeLock:      sync.Mutex{},
 		termChild:      make(chan struct{}),
 		killChild:      make(chan struct{}),
-		killTimeout:    5 * time.Second,
+		killTimeout:    defaultKillTimeout,
+		secrets:        NewSecretResolver(),
+		contract:       newEnvContract(cfg),
+		resources:      newResourceMonitor(cfg),
+		health:         newHealthMonitor(cfg),
+		taskTimeout:    time.Duration(cfg.TaskTimeout),
+	}
+
+	if cfg.Process.KillTimeout > 0 {
+		proc.killTimeout = time.Duration(cfg.Process.KillTimeout) * time.Second
+	}
+
+	stopSignal, err := parseStopSignal(cfg.Process.StopSignal)
+	if err != nil {
+		return nil, fmt.Errorf("parsing stop signal: %w", err)
+	}
+	proc.stopSignal = stopSignal
+
+	if cfg.Templates.Signal != "" {
+		// go run exits on signals it doesn't handle, so the program has to be run directly
+		if len(cfg.Command) == 0 && !cfg.Build.Enabled {
+			return nil, errors.New("templates.signal requires build.enabled or a command, go run exits when it is signalled")
+		}
+		proc.reloadSignal, err = parseReloadSignal(cfg.Templates.Signal)
+		if err != nil {
+			return nil, fmt.Errorf("parsing templates signal: %w", err)
+		}
+	}
+
+	for _, task := range cfg.Prestart {
+		group, err := newPrestartGroup(task)
+		if err != nil {
+			return nil, fmt.Errorf("parsing prestart task: %w", err)
+		}
+		proc.prestart = append(proc.prestart, group)
+	}
+
+	if cfg.Runtime == config.RuntimeDocker {
+		proc.docker, err = newDockerRuntime(cfg)
+		if err != nil {
+			return nil, err
+		}
 	}
 
 	if len(proc.command) == 0 {
 		proc.command = []string{"go", "run"}
-		if proc.entrypoint == "" {
+		// compose services run the command from the compose file
+		if proc.entrypoint == "" && (proc.docker == nil || proc.docker.compose == "") {
 			return nil, errors.New("an entrypoint is required")
 		}
 	}
 
+	workspace, err := workspaceEnv(cfg)
+	if err != nil {
+		return nil, err
+	}
+	proc.envVars = append(proc.envVars, workspace...)
+
 	for _, file := range cfg.EnvFiles {
+		// env files are relative to the root directory, which isn't always the working directory when embedded
+		if !filepath.IsAbs(file) {
+			file = filepath.Join(cfg.RootDirectory, file)
+		}
 		err := proc.loadEnvFile(file)
 		if err != nil {
 			return nil, fmt.Errorf("loading env file: %w", err)
 		}
 	}
 
+	for _, opt := range opts {
+		err := opt(proc)
+		if err != nil {
+			return nil, err
+		}
+	}
+
 	return proc, nil
 }
 
@@ -148,7 +293,9 @@ func (c *childProcess) Start(console ConsoleOutput, callbackFn notification.Noti
 		return errors.New("process is already running")
 	}
 
+	startRequested := time.Now()
 	c.childProcessID = notification.NextID()
+	c.callbackFn = callbackFn
 
 	callbackFn(notification.Notification{
 		ID:              notification.NextID(),
@@ -159,41 +306,153 @@ func (c *childProcess) Start(console ConsoleOutput, callbackFn notification.Noti
 	})
 
 	// run prestart tasks
-	for _, task := range c.prestart {
-		err := c.ExecuteOOBTask(task, callbackFn)
+	prestartStarted := time.Now()
+	for _, group := range c.prestart {
+		err := c.runPrestartGroup(group, callbackFn)
 		if err != nil {
-			return fmt.Errorf("running prestart task: %w", err)
+			return c.recordPhase(PhasePrestart, prestartStarted, fmt.Errorf("running prestart task: %w", err))
 		}
 	}
+	if len(c.prestart) > 0 {
+		c.recordPhase(PhasePrestart, prestartStarted, nil)
+	}
 
 	c.state.Set(ProcessStateStarting)
 
 	childCtx, cancelChildCtx := context.WithCancel(context.Background())
 	defer cancelChildCtx()
 
+	// secret references are resolved on every start so that rotated secrets are picked up
+	entrypointArgs := []string{}
+	envVars, err := c.secrets.ResolveEnv(c.envVars)
+	if err == nil {
+		entrypointArgs, err = c.secrets.ResolveAll(c.entrypointArgs)
+	}
+	if err != nil {
+		c.state.Set(ProcessStateStopped)
+		callbackFn(notification.Notification{
+			ID:              notification.NextID(),
+			ChildProccessID: c.childProcessID,
+			Date:            time.Now(),
+			Type:            notification.NotificationTypeSystemError,
+			Message:         fmt.Sprintf("resolving secrets: %v", err),
+		})
+		return fmt.Errorf("resolving secrets: %w", err)
+	}
+
+	command := c.command[0]
 	args := c.command[1:]
-	if len(c.entrypoint) > 0 {
+	var buildDuration time.Duration
+	if c.builder != nil {
+		buildStarted := time.Now()
+		err := c.recordPhase(PhaseBuild, buildStarted, c.builder.Build(envVars, c.childProcessID, callbackFn))
+		if err != nil {
+			c.state.Set(ProcessStateStopped)
+			return err
+		}
+		buildDuration = time.Since(buildStarted)
+		command = c.builder.Binary()
+		args = entrypointArgs
+	} else if len(c.entrypoint) > 0 {
 		args = append(args, c.entrypoint)
-		if len(c.entrypointArgs) > 0 {
-			args = append(args, c.entrypointArgs...)
+		if len(entrypointArgs) > 0 {
+			args = append(args, entrypointArgs...)
+		}
+	}
+
+	spawnStarted := time.Now()
+	runEnv := map[string]string{EnvRunID: c.childProcessID}
+	for k, v := range c.contract {
+		runEnv[k] = v
+	}
+	if c.socket != nil {
+		for k, v := range c.socket.environ() {
+			runEnv[k] = v
+		}
+	}
+	c.runEnv.Store(runEnv)
+	envVars = append(envVars, environ(runEnv)...)
+
+	containerName := c.containerName()
+	if c.docker != nil {
+		since, err := c.docker.prepare(envVars)
+		if err != nil {
+			c.state.Set(ProcessStateStopped)
+			return c.recordPhase(PhaseSpawn, spawnStarted, err)
 		}
+		passEnv := append(append([]string{}, c.envFileKeys...), envKeys(runEnv)...)
+		wrapped := c.docker.command(containerName, append([]string{command}, args...), passEnv, since)
+		command, args = wrapped[0], wrapped[1:]
 	}
 
 	// create and start the child process
-	cmd := exec.CommandContext(childCtx, c.command[0], args...)
+	cmd := exec.CommandContext(childCtx, command, args...)
 	cmd.Dir = c.rootDirectory
 	cmd.Stdout = console.Stdout()
-	cmd.Stderr = console.Stderr()
-	cmd.SysProcAttr = &syscall.SysProcAttr{Setpgid: true}
-	cmd.Env = c.envVars
+	stderrTail := newTailWriter(crashTailLines)
+	cmd.Stderr = io.MultiWriter(console.Stderr(), stderrTail)
+	cmd.SysProcAttr = newSysProcAttr()
+	cmd.Env = envVars
+	if c.socket != nil {
+		cmd.ExtraFiles = []*os.File{c.socket.file}
+	}
+
+	procLog := log.WithField("childProcessId", c.childProcessID)
 
-	err := cmd.Start()
+	err = c.recordPhase(PhaseSpawn, spawnStarted, cmd.Start())
 	if err != nil {
-		log.Errorf("spawning child process: %+v", err)
+		procLog.Errorf("spawning child process: %+v", err)
 		return err
 	}
 
+	loopDone := make(chan struct{})
+	c.loopDone = loopDone
 	c.state.Set(ProcessStateStarted)
+	c.startedAt.Store(time.Now().UnixNano())
+	c.pid.Store(int64(cmd.Process.Pid))
+
+	err = attachProcessGroup(cmd.Process.Pid)
+	if err != nil {
+		procLog.Warnf("attaching child process group: %v", err)
+	}
+	defer releaseProcessGroup(cmd.Process.Pid)
+	defer c.pid.Store(0)
+
+	banner := startupBanner{
+		pid:      cmd.Process.Pid,
+		runID:    c.childProcessID,
+		elapsed:  time.Since(startRequested),
+		build:    buildDuration,
+		proxyURL: c.contract[EnvProxyURL],
+		uiURL:    c.contract[EnvUIURL],
+	}
+	if c.trigger != nil {
+		banner.trigger = c.trigger()
+	}
+	callbackFn(notification.Notification{
+		ID:              notification.NextID(),
+		ChildProccessID: c.childProcessID,
+		Date:            time.Now(),
+		Type:            notification.NotificationTypeRunning,
+		Message:         banner.String(),
+	})
+
+	if c.resources != nil {
+		stopSampling := make(chan struct{})
+		defer close(stopSampling)
+		go c.resources.run(cmd.Process.Pid, c.childProcessID, stopSampling)
+	}
+
+	if c.health != nil {
+		stopChecking := make(chan struct{})
+		defer close(stopChecking)
+		go c.health.run(c.childProcessID, stopChecking, callbackFn)
+	}
+
+	// run post start hooks in the background so that they can't block a stop request
+	if len(c.postStart) > 0 {
+		go c.runHooks("post start", c.postStart)
+	}
 
 	// wait for the child process to exit, putting the exit code into the exitWait channel
 	// allows us to wait for multiple triggers (signals or process exit)
@@ -201,12 +460,12 @@ func (c *childProcess) Start(console ConsoleOutput, callbackFn notification.Noti
 	go func() {
 		err = cmd.Wait()
 		if err != nil && !(err.Error() != "signal: terminated" || err.Error() != "signal: killed") {
-			log.Warnf("child process exited abnormally: %+v", err)
+			procLog.Warnf("child process exited abnormally: %+v", err)
 		}
 
 		s := cmd.ProcessState.ExitCode()
 		if s > 0 {
-			log.Warnf("child process exited with non-zero status: %d", cmd.ProcessState.ExitCode())
+			procLog.Warnf("child process exited with non-zero status: %d", cmd.ProcessState.ExitCode())
 		}
 		exitWait <- s
 	}()
@@ -217,22 +476,45 @@ event_loop:
 	for {
 		select {
 		case <-c.termChild:
-			// graceful shutdown (Windows (non-Posix) clients will not receive this signal)
-			log.Info("stopping child process: terminate requested")
-			// confusingly, the syscall.Kill function sends a TERMINATE signal
-			err := syscall.Kill(-cmd.Process.Pid, syscall.SIGTERM)
+			// graceful shutdown, the whole process group/tree is asked to terminate
+			procLog.Info("stopping child process: terminate requested")
+			if c.docker != nil {
+				err := c.docker.signal(containerName, c.stopSignal)
+				if err != nil {
+					procLog.Warnf("signalling container: %v", err)
+				}
+				continue
+			}
+			// a failed request isn't fatal, e.g. taskkill can't close console programs such as go run without /F,
+			// Stop sends killChild once the kill timeout has passed
+			err := terminateProcessGroup(cmd.Process.Pid, c.stopSignal)
 			if err != nil {
-				return err
+				procLog.Warnf("terminating child process group: %v", err)
 			}
 		case <-c.killChild:
 			// hard shutdown
-			log.Info("stopping child process: close requested")
+			procLog.Info("stopping child process: close requested")
+			if c.docker != nil {
+				err := c.docker.kill(containerName)
+				if err != nil {
+					procLog.Warnf("killing container: %v", err)
+				}
+			}
+			err := killProcessGroup(cmd.Process.Pid)
+			if err != nil {
+				procLog.Warnf("killing child process group: %v", err)
+			}
 			cancelChildCtx()
 		case exitCode = <-exitWait:
-			log.Infof("child process exited with status: %d", exitCode)
+			procLog.Infof("child process exited with status: %d", exitCode)
 			break event_loop
 		}
 	}
+	close(loopDone)
+
+	isStopRequested := c.state.Get() == ProcessStateStopping
+
+	c.runHooks("post stop", c.postStop)
 
 	c.state.Set(ProcessStateStopped)
 
@@ -245,7 +527,98 @@ event_loop:
 	})
 
 	if exitCode > 0 {
-		return fmt.Errorf("child process exited with status: %d", exitCode)
+		if isStopRequested {
+			return fmt.Errorf("child process exited with status: %d", exitCode)
+		}
+
+		crash := ClassifyCrash(stderrTail.Lines(), exitCode)
+		procLog.WithField("crashCategory", crash.Category).Warnf("child process crashed: %s", crash.Description)
+		callbackFn(notification.Notification{
+			ID:              notification.NextID(),
+			ChildProccessID: c.childProcessID,
+			Date:            time.Now(),
+			Type:            notification.NotificationTypeCrash,
+			Message:         fmt.Sprintf("%s: %s", crash.Category, crash.Description),
+		})
+		return fmt.Errorf("exited with status %d: %s: %w", exitCode, crash.Description, ErrCrashed)
+	}
+
+	return nil
+}
+
+func (c *childProcess) State() ProcessState {
+	return c.state.Get()
+}
+
+// PID returns the process ID of the running child process and when it started, the ID is 0 if it isn't running
+func (c *childProcess) PID() (int, time.Time) {
+	return int(c.pid.Load()), time.Unix(0, c.startedAt.Load())
+}
+
+// Environment returns the GOMON_* variables injected into the current (or most recent) run
+func (c *childProcess) Environment() map[string]string {
+	env, _ := c.runEnv.Load().(map[string]string)
+	return env
+}
+
+// ReloadTemplates sends templates.signal to the running child process, it returns false if the signal isn't set
+func (c *childProcess) ReloadTemplates() (bool, error) {
+	if c.reloadSignal == 0 {
+		return false, nil
+	}
+
+	pid := int(c.pid.Load())
+	if pid == 0 {
+		return true, errors.New("process is not running")
+	}
+
+	if c.docker != nil {
+		return true, c.docker.signal(c.containerName(), c.reloadSignal)
+	}
+	return true, signalProcessGroup(pid, c.reloadSignal)
+}
+
+func (c *childProcess) containerName() string {
+	return "gomon-" + c.childProcessID
+}
+
+func (c *childProcess) Stop() error {
+	c.closeLock.Lock()
+	defer c.closeLock.Unlock()
+
+	if c.state.Get() != ProcessStateStarted {
+		return errors.New("process is not running")
+	}
+
+	c.state.Set(ProcessStateStopping)
+	loopDone := c.loopDone
+
+	c.runHooks("pre stop", c.preStop)
+
+	isChildClosed := make(chan struct{})
+	go func() {
+		// wait for the child process to close by trying capture lock, will have been locked in the Start method
+		c.childLock.Lock()
+		defer c.childLock.Unlock()
+		// signal that the child process has closed
+		isChildClosed <- struct{}{}
+	}()
+
+	// send the signal to the child process to close, unless it has already exited
+	select {
+	case c.termChild <- struct{}{}:
+	case <-loopDone:
+	}
+
+	// wait for the child process to close or timeout
+	select {
+	case <-isChildClosed:
+		log.Info("child process closed")
+	case <-time.After(c.killTimeout):
+		select {
+		case c.killChild <- struct{}{}:
+		case <-loopDone:
+		}
 	}
 
 	return nil
@@ -257,30 +630,155 @@ func (c *childProcess) loadEnvFile(filename string) error {
 		return nil
 	}
 
-	file, err := os.Open(filename)
+	lines, err := readEnvFile(filename)
 	if err != nil {
 		return err
 	}
-	defer file.Close()
+	c.envVars = append(c.envVars, lines...)
+	for _, line := range lines {
+		key, _, _ := strings.Cut(line, "=")
+		c.envFileKeys = append(c.envFileKeys, strings.TrimSpace(strings.TrimPrefix(key, "export ")))
+	}
+
+	return nil
+}
 
-	scanner := bufio.NewScanner(file)
-	for scanner.Scan() {
-		line := strings.TrimSpace(scanner.Text())
-		if strings.HasPrefix(line, "#") || len(line) == 0 {
-			continue
+// runHooks executes lifecycle hooks in order, failures are logged but do not prevent the lifecycle from continuing
+func (c *childProcess) runHooks(stage string, hooks []string) {
+	for _, task := range hooks {
+		err := c.ExecuteOOBTask(task, c.callbackFn)
+		if err != nil {
+			log.Warnf("running %s hook: %v", stage, err)
 		}
-		c.envVars = append(c.envVars, line)
 	}
+}
 
-	if err := scanner.Err(); err != nil {
-		return err
+func newPrestartGroup(task config.Task) (prestartGroup, error) {
+	tasks := task.Group
+	if len(tasks) == 0 {
+		tasks = []config.Task{task}
 	}
 
-	return nil
+	group := prestartGroup{}
+	for _, t := range tasks {
+		if len(t.Group) > 0 {
+			return nil, errors.New("prestart groups can't be nested")
+		}
+		policy, err := t.FailurePolicy()
+		if err != nil {
+			return nil, err
+		}
+		group = append(group, prestartTask{command: t.Command, onFailure: policy})
+	}
+	return group, nil
+}
+
+// runPrestartGroup runs the tasks in the group in parallel. As soon as one of them aborts the restart the others
+// are cancelled rather than left to finish.
+func (c *childProcess) runPrestartGroup(group prestartGroup, callbackFn notification.NotificationCallback) error {
+	if len(group) == 1 {
+		return c.runPrestartTask(context.Background(), group[0], callbackFn)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	errs := make([]error, len(group))
+	wg := sync.WaitGroup{}
+	for ix, task := range group {
+		wg.Add(1)
+		go func(ix int, task prestartTask) {
+			defer wg.Done()
+			errs[ix] = c.runPrestartTask(ctx, task, callbackFn)
+			if errs[ix] != nil {
+				cancel()
+			}
+		}(ix, task)
+	}
+	wg.Wait()
+
+	// the tasks which were cancelled didn't fail themselves
+	failed := []error{}
+	for _, err := range errs {
+		if err != nil && !errors.Is(err, context.Canceled) {
+			failed = append(failed, err)
+		}
+	}
+	return errors.Join(failed...)
+}
+
+// runPrestartTask runs the task applying its failure policy, an error is only returned if the restart should be aborted
+func (c *childProcess) runPrestartTask(ctx context.Context, task prestartTask, callbackFn notification.NotificationCallback) error {
+	err := c.executeTask(ctx, task.command, callbackFn)
+	for attempt := 1; err != nil && ctx.Err() == nil && attempt <= task.onFailure.Retries; attempt++ {
+		c.notifyTaskFailure(fmt.Sprintf("prestart task failed, retrying (%d/%d): %s", attempt, task.onFailure.Retries, task.command))
+		select {
+		case <-ctx.Done():
+		case <-time.After(prestartRetryDelay):
+		}
+		err = c.executeTask(ctx, task.command, callbackFn)
+	}
+
+	if err == nil {
+		return nil
+	}
+
+	if ctx.Err() != nil {
+		return ctx.Err()
+	}
+
+	if task.onFailure.Action == config.OnFailureContinue {
+		c.notifyTaskFailure(fmt.Sprintf("prestart task failed, continuing: %s", task.command))
+		return nil
+	}
+
+	c.notifyTaskFailure(fmt.Sprintf("prestart task failed, aborting restart: %s", task.command))
+	return err
+}
+
+func (c *childProcess) notifyTaskFailure(message string) {
+	log.Warn(message)
+	c.callbackFn(notification.Notification{
+		ID:              notification.NextID(),
+		ChildProccessID: c.childProcessID,
+		Date:            time.Now(),
+		Type:            notification.NotificationTypeSystemError,
+		Message:         message,
+	})
 }
 
 func (c *childProcess) ExecuteOOBTask(task string, callbackFn notification.NotificationCallback) error {
+	return c.executeTask(context.Background(), task, callbackFn)
+}
+
+// executeTask runs the task in the current run, it is killed if ctx is cancelled or it takes longer than the task timeout
+func (c *childProcess) executeTask(ctx context.Context, task string, callbackFn notification.NotificationCallback) error {
 	oobTask := NewOutOfBandTask(c.rootDirectory, task, c.envVars)
-	err := oobTask.Run(c.childProcessID, callbackFn)
-	return err
+	oobTask.timeout = c.taskTimeout
+	return oobTask.RunContext(ctx, c.childProcessID, callbackFn)
+}
+
+// RunChecks runs each check as a task in the current run, every check is run so that all of the failures are shown
+func (c *childProcess) RunChecks(checks []string, callbackFn notification.NotificationCallback) error {
+	failed := []string{}
+	for _, check := range checks {
+		err := c.ExecuteOOBTask(check, callbackFn)
+		if err != nil {
+			failed = append(failed, check)
+		}
+	}
+	if len(failed) == 0 {
+		return nil
+	}
+
+	message := fmt.Sprintf("checks failed, keeping the current process: %s", strings.Join(failed, ", "))
+	log.Warn(message)
+	callbackFn(notification.Notification{
+		ID:              notification.NextID(),
+		ChildProccessID: c.childProcessID,
+		Date:            time.Now(),
+		Type:            notification.NotificationTypeSystemError,
+		Message:         message,
+	})
+	return fmt.Errorf("checks failed: %s", strings.Join(failed, ", "))
 }
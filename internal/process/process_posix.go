@@ -1,5 +1,5 @@
-//go:build linux || darwin
-// +build linux darwin
+//go:build !windows
+// +build !windows
 
 package process
 
@@ -20,41 +20,66 @@ package process
 // along with this program.  If not, see <https://www.gnu.org/licenses/>.
 
 import (
-	"errors"
-	"time"
-
-	log "github.com/sirupsen/logrus"
+	"fmt"
+	"strings"
+	"syscall"
 )
 
-func (c *childProcess) Stop() error {
-	c.closeLock.Lock()
-	defer c.closeLock.Unlock()
+var stopSignals = map[string]syscall.Signal{
+	"SIGTERM": syscall.SIGTERM,
+	"SIGINT":  syscall.SIGINT,
+	"SIGQUIT": syscall.SIGQUIT,
+	"SIGHUP":  syscall.SIGHUP,
+	"SIGUSR1": syscall.SIGUSR1,
+	"SIGUSR2": syscall.SIGUSR2,
+}
 
-	if c.state.Get() != ProcessStateStarted {
-		return errors.New("process is not running")
+// newSysProcAttr puts the child into its own process group so that the whole tree can be signalled
+func newSysProcAttr() *syscall.SysProcAttr {
+	return &syscall.SysProcAttr{Setpgid: true}
+}
+
+// parseStopSignal converts a signal name e.g. SIGINT or INT into a signal, defaulting to SIGTERM
+func parseStopSignal(name string) (syscall.Signal, error) {
+	if name == "" {
+		return syscall.SIGTERM, nil
 	}
 
-	c.state.Set(ProcessStateStopping)
-
-	isChildClosed := make(chan struct{})
-	go func() {
-		// wait for the child process to close by trying capture lock, will have been locked in the Start method
-		c.childLock.Lock()
-		defer c.childLock.Unlock()
-		// signal that the child process has closed
-		isChildClosed <- struct{}{}
-	}()
-
-	// send the signal to the child process to close
-	c.termChild <- struct{}{}
-
-	// wait for the child process to close or timeout
-	select {
-	case <-isChildClosed:
-		log.Info("child process closed")
-	case <-time.After(c.killTimeout):
-		c.killChild <- struct{}{}
+	name = strings.ToUpper(name)
+	if !strings.HasPrefix(name, "SIG") {
+		name = "SIG" + name
 	}
 
+	sig, ok := stopSignals[name]
+	if !ok {
+		return 0, fmt.Errorf("unsupported signal: %s", name)
+	}
+
+	return sig, nil
+}
+
+// parseReloadSignal converts the name of the signal which asks the child to reload its templates into a signal
+func parseReloadSignal(name string) (syscall.Signal, error) {
+	return parseStopSignal(name)
+}
+
+func signalProcessGroup(pid int, sig syscall.Signal) error {
+	return syscall.Kill(-pid, sig)
+}
+
+func terminateProcessGroup(pid int, sig syscall.Signal) error {
+	// confusingly, the syscall.Kill function sends a signal, not necessarily a KILL
+	return syscall.Kill(-pid, sig)
+}
+
+func killProcessGroup(pid int) error {
+	return syscall.Kill(-pid, syscall.SIGKILL)
+}
+
+// attachProcessGroup does nothing, the child's process group is created when it is started
+func attachProcessGroup(pid int) error {
 	return nil
 }
+
+// releaseProcessGroup does nothing, process groups don't need to be released
+func releaseProcessGroup(pid int) {}
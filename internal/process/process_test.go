@@ -19,6 +19,8 @@ package process
 import (
 	"io"
 	"os"
+	"slices"
+	"sync"
 	"testing"
 	"time"
 
@@ -67,3 +69,189 @@ func TestChildProcess(t *testing.T) {
 	}
 
 }
+
+func TestStopAfterChildExitsDuringPreStop(t *testing.T) {
+	cfg := config.Config{
+		RootDirectory: "/bin",
+		Command:       []string{"sleep", "1"},
+	}
+	// the hook outlives the child e.g. a hook which tells the app to shut down
+	cfg.Hooks.PreStop = []string{"sleep 2"}
+
+	proc, err := NewChildProcess(cfg)
+	if err != nil {
+		t.Fatalf("error creating child process: %v", err)
+	}
+
+	running := make(chan struct{})
+	exited := make(chan struct{})
+	go func() {
+		defer close(exited)
+		proc.Start(&testConsole{}, func(n notification.Notification) error {
+			if n.Type == notification.NotificationTypeRunning {
+				close(running)
+			}
+			return nil
+		})
+	}()
+	<-running
+
+	stopped := make(chan error)
+	go func() {
+		stopped <- proc.Stop()
+	}()
+
+	select {
+	case <-stopped:
+	case <-time.After(10 * time.Second):
+		t.Fatal("Stop blocked after the child process exited")
+	}
+	<-exited
+}
+
+func TestPrestartFailurePolicy(t *testing.T) {
+	cfg := config.Config{
+		RootDirectory: "/bin",
+		Command:       []string{"true"},
+		Prestart: []config.Task{
+			{Command: "false", OnFailure: "continue"},
+			{Command: "false", OnFailure: "retry(1)"},
+		},
+	}
+
+	proc, err := NewChildProcess(cfg)
+	if err != nil {
+		t.Fatalf("error creating child process: %v", err)
+	}
+
+	runs := 0
+	failures := []string{}
+	err = proc.Start(&testConsole{}, func(n notification.Notification) error {
+		switch n.Type {
+		case notification.NotificationTypeOOBTaskStartup:
+			runs++
+		case notification.NotificationTypeSystemError:
+			failures = append(failures, n.Message)
+		}
+		return nil
+	})
+
+	if err == nil {
+		t.Fatal("expected the retried task to abort the restart")
+	}
+
+	if runs != 3 {
+		t.Errorf("expected 3 task runs, got %d", runs)
+	}
+
+	expected := []string{
+		"prestart task failed, continuing: false",
+		"prestart task failed, retrying (1/1): false",
+		"prestart task failed, aborting restart: false",
+	}
+	if len(failures) != len(expected) {
+		t.Fatalf("expected %d failure notifications, got %v", len(expected), failures)
+	}
+	for i := range expected {
+		if failures[i] != expected[i] {
+			t.Errorf("expected %q, got %q", expected[i], failures[i])
+		}
+	}
+
+	cfg.Prestart = []config.Task{{Command: "false", OnFailure: "retry(0)"}}
+	_, err = NewChildProcess(cfg)
+	if err == nil {
+		t.Error("expected an error for a retry count of 0")
+	}
+}
+
+func TestPrestartGroupFailFast(t *testing.T) {
+	cfg := config.Config{
+		RootDirectory: "/bin",
+		Command:       []string{"true"},
+		Prestart: []config.Task{
+			{Group: []config.Task{{Command: "sleep 10"}, {Command: "false"}}},
+			{Command: "true"},
+		},
+	}
+
+	proc, err := NewChildProcess(cfg)
+	if err != nil {
+		t.Fatalf("error creating child process: %v", err)
+	}
+
+	lock := sync.Mutex{}
+	failures := []string{}
+	completed := []string{}
+	startedAt := time.Now()
+	err = proc.Start(&testConsole{}, func(n notification.Notification) error {
+		lock.Lock()
+		defer lock.Unlock()
+		switch n.Type {
+		case notification.NotificationTypeSystemError:
+			failures = append(failures, n.Message)
+		case notification.NotificationTypeOOBTaskComplete:
+			completed = append(completed, n.Message)
+		}
+		return nil
+	})
+
+	if err == nil {
+		t.Fatal("expected the failed task to abort the restart")
+	}
+	if time.Since(startedAt) > 5*time.Second {
+		t.Errorf("expected the rest of the group to be cancelled, took %s", time.Since(startedAt))
+	}
+
+	if len(failures) != 1 || failures[0] != "prestart task failed, aborting restart: false" {
+		t.Errorf("expected only the failed task to be reported, got %v", failures)
+	}
+	if !slices.Contains(completed, "task cancelled: sleep 10") || len(completed) != 2 {
+		t.Errorf("expected the sleep to be cancelled and the next group not to run, got %v", completed)
+	}
+
+	cfg.Prestart = []config.Task{{Group: []config.Task{{Group: []config.Task{{Command: "true"}}}}}}
+	_, err = NewChildProcess(cfg)
+	if err == nil {
+		t.Error("expected an error for a nested group")
+	}
+}
+
+func TestRunChecks(t *testing.T) {
+	proc, err := NewChildProcess(config.Config{
+		RootDirectory: "/bin",
+		Command:       []string{"true"},
+	})
+	if err != nil {
+		t.Fatalf("error creating child process: %v", err)
+	}
+
+	runs := 0
+	failures := []string{}
+	callbackFn := func(n notification.Notification) error {
+		switch n.Type {
+		case notification.NotificationTypeOOBTaskStartup:
+			runs++
+		case notification.NotificationTypeSystemError:
+			failures = append(failures, n.Message)
+		}
+		return nil
+	}
+
+	err = proc.RunChecks([]string{"true", "true"}, callbackFn)
+	if err != nil || runs != 2 || len(failures) != 0 {
+		t.Fatalf("expected passing checks, got %v after %d runs: %v", err, runs, failures)
+	}
+
+	runs = 0
+	err = proc.RunChecks([]string{"false", "true", "false"}, callbackFn)
+	if err == nil {
+		t.Fatal("expected the checks to fail")
+	}
+	if runs != 3 {
+		t.Errorf("expected every check to run, got %d", runs)
+	}
+	if len(failures) != 1 || failures[0] != "checks failed, keeping the current process: false, false" {
+		t.Errorf("unexpected failures: %v", failures)
+	}
+}
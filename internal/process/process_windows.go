@@ -21,21 +21,109 @@ package process
 
 import (
 	"errors"
+	"fmt"
+	"os/exec"
+	"strconv"
+	"strings"
+	"sync"
+	"syscall"
+
+	"golang.org/x/sys/windows"
 )
 
-func (c *childProcess2) Stop() error {
-	c.closeLock.Lock()
-	defer c.closeLock.Unlock()
+// jobs holds the job object of each child process, keyed by pid. Windows has no process groups which can be
+// killed together, processes started by the child join its job so they are killed with it even after the
+// process which started them has exited, which taskkill /T can't find.
+var jobs sync.Map
+
+// newSysProcAttr creates the child in a new process group so that it does not receive gomon's console signals
+func newSysProcAttr() *syscall.SysProcAttr {
+	return &syscall.SysProcAttr{CreationFlags: syscall.CREATE_NEW_PROCESS_GROUP}
+}
+
+// parseStopSignal always returns SIGTERM, Windows processes cannot be sent arbitrary signals
+func parseStopSignal(name string) (syscall.Signal, error) {
+	if name != "" && !strings.EqualFold(name, "SIGTERM") && !strings.EqualFold(name, "TERM") {
+		log.Warnf("stop signal %s is not supported on Windows, ignoring", name)
+	}
+	return syscall.SIGTERM, nil
+}
+
+// parseReloadSignal returns an error, Windows processes cannot be sent arbitrary signals
+func parseReloadSignal(name string) (syscall.Signal, error) {
+	return 0, fmt.Errorf("signal %s is not supported on Windows", name)
+}
+
+func signalProcessGroup(pid int, sig syscall.Signal) error {
+	return errors.New("signals are not supported on Windows")
+}
+
+// terminateProcessGroup asks the process tree rooted at pid to close, Windows has no equivalent of
+// SIGTERM so taskkill is used to post a close request to every process in the tree. Console programs
+// which have no window, e.g. go run, can't be closed this way so it fails and the tree is killed once
+// the kill timeout has passed.
+func terminateProcessGroup(pid int, _ syscall.Signal) error {
+	return taskkill(pid, false)
+}
+
+// attachProcessGroup puts the child process into a job object of its own, processes which it starts before
+// it has been added aren't in the job but are still found by taskkill
+func attachProcessGroup(pid int) error {
+	job, err := windows.CreateJobObject(nil, nil)
+	if err != nil {
+		return fmt.Errorf("creating job object: %w", err)
+	}
+
+	process, err := windows.OpenProcess(windows.PROCESS_SET_QUOTA|windows.PROCESS_TERMINATE, false, uint32(pid))
+	if err != nil {
+		windows.CloseHandle(job)
+		return fmt.Errorf("opening process: %w", err)
+	}
+	defer windows.CloseHandle(process)
+
+	err = windows.AssignProcessToJobObject(job, process)
+	if err != nil {
+		windows.CloseHandle(job)
+		return fmt.Errorf("assigning process to job object: %w", err)
+	}
+
+	jobs.Store(pid, job)
+	return nil
+}
 
-	if c.state.Get() != ProcessStateStarted {
-		return errors.New("process is not running")
+// releaseProcessGroup closes the child process's job object once it has exited, processes left in the job
+// keep running
+func releaseProcessGroup(pid int) {
+	if job, ok := jobs.LoadAndDelete(pid); ok {
+		windows.CloseHandle(job.(windows.Handle))
 	}
+}
 
-	c.state.Set(ProcessStateStopping)
-	c.killChild <- struct{}{}
+// killProcessGroup kills the process tree rooted at pid and then everything left in the child's job object
+func killProcessGroup(pid int) error {
+	err := taskkill(pid, true)
 
-	c.childLock.Lock()
-	defer c.childLock.Unlock()
+	job, ok := jobs.Load(pid)
+	if !ok {
+		return err
+	}
+	err = windows.TerminateJobObject(job.(windows.Handle), 1)
+	if err != nil {
+		return fmt.Errorf("terminating job object: %w", err)
+	}
+	return nil
+}
+
+func taskkill(pid int, force bool) error {
+	args := []string{"/T", "/PID", strconv.Itoa(pid)}
+	if force {
+		args = append([]string{"/F"}, args...)
+	}
+
+	out, err := exec.Command("taskkill", args...).CombinedOutput()
+	if err != nil {
+		return fmt.Errorf("taskkill: %w: %s", err, string(out))
+	}
 
 	return nil
 }
@@ -0,0 +1,169 @@
+package process
+
+// gomon is a simple command line tool that watches your files and automatically restarts the application when it detects any changes in the working directory.
+// Copyright (C) 2023 John Dudmesh
+
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+
+// You should have received a copy of the GNU General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>.
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/jdudmesh/gomon/internal/config"
+	"github.com/jdudmesh/gomon/internal/utils"
+)
+
+// expvarTimeout stops a child which is busy or not yet listening from delaying the next sample
+const expvarTimeout = 2 * time.Second
+
+// errResourcesUnsupported is returned on platforms where the process group can't be sampled
+var errResourcesUnsupported = errors.New("resource monitoring is not supported on this platform")
+
+// ResourceRecorder stores the samples of the child process's memory and CPU usage
+type ResourceRecorder interface {
+	RecordSample(s utils.ResourceSample) error
+}
+
+// WithResourceRecorder passes samples of each run's memory and CPU usage to r when resources are enabled
+func WithResourceRecorder(r ResourceRecorder) ChildProcessOption {
+	return func(c *childProcess) error {
+		if c.resources != nil {
+			c.resources.recorder = r
+		}
+		return nil
+	}
+}
+
+// resourceUsage is the total of every process in the child's process group, because `go run` starts the program
+// as a child of its own
+type resourceUsage struct {
+	rss int64
+	cpu time.Duration
+}
+
+type resourceMonitor struct {
+	recorder  ResourceRecorder
+	interval  time.Duration
+	expvarURL string
+	client    http.Client
+}
+
+func newResourceMonitor(cfg config.Config) *resourceMonitor {
+	if !cfg.Resources.Enabled {
+		return nil
+	}
+
+	interval := cfg.Resources.Interval
+	if interval <= 0 {
+		interval = config.DefaultResourceInterval
+	}
+
+	return &resourceMonitor{
+		interval:  time.Duration(interval) * time.Second,
+		expvarURL: cfg.Resources.Expvar,
+		client:    http.Client{Timeout: expvarTimeout},
+	}
+}
+
+// run samples the process group led by pid until stop is closed
+func (m *resourceMonitor) run(pid int, runID string, stop <-chan struct{}) {
+	if m.recorder == nil {
+		return
+	}
+
+	ticker := time.NewTicker(m.interval)
+	defer ticker.Stop()
+
+	prev := resourceUsage{}
+	prevDate := time.Now()
+	for {
+		select {
+		case <-stop:
+			return
+		case now := <-ticker.C:
+			usage, err := sampleProcessGroup(pid)
+			if errors.Is(err, errResourcesUnsupported) {
+				log.Warn(err)
+				return
+			} else if err != nil {
+				log.Debugf("sampling child process: %v", err)
+				continue
+			}
+
+			sample := utils.ResourceSample{
+				ChildProccessID: runID,
+				Date:            now,
+				RSS:             usage.rss,
+				CPU:             cpuPercent(prev.cpu, usage.cpu, now.Sub(prevDate)),
+			}
+			prev, prevDate = usage, now
+
+			if m.expvarURL != "" {
+				sample.Goroutines, sample.HeapAlloc, err = m.readExpvar()
+				if err != nil {
+					log.Debugf("reading expvar: %v", err)
+				}
+			}
+
+			err = m.recorder.RecordSample(sample)
+			if err != nil {
+				log.Warn(err)
+			}
+		}
+	}
+}
+
+// cpuPercent is the share of a core used between two samples, processes which exit between samples take their
+// CPU time with them so the total can go down
+func cpuPercent(prev, next, elapsed time.Duration) float64 {
+	if elapsed <= 0 || next < prev {
+		return 0
+	}
+	return float64(next-prev) / float64(elapsed) * 100
+}
+
+// readExpvar reads the goroutine count and heap size from the child's expvar handler. The heap size is part of the
+// memstats published by every program which imports expvar but the goroutine count must be published by the child
+// e.g. expvar.Publish("goroutines", expvar.Func(func() any { return runtime.NumGoroutine() })).
+func (m *resourceMonitor) readExpvar() (*int64, *int64, error) {
+	res, err := m.client.Get(m.expvarURL)
+	if err != nil {
+		return nil, nil, err
+	}
+	defer res.Body.Close()
+
+	if res.StatusCode != http.StatusOK {
+		return nil, nil, fmt.Errorf("unexpected status: %s", res.Status)
+	}
+
+	vars := struct {
+		Goroutines *int64 `json:"goroutines"`
+		MemStats   *struct {
+			HeapAlloc int64 `json:"HeapAlloc"`
+		} `json:"memstats"`
+	}{}
+	err = json.NewDecoder(res.Body).Decode(&vars)
+	if err != nil {
+		return nil, nil, fmt.Errorf("decoding vars: %w", err)
+	}
+
+	var heapAlloc *int64
+	if vars.MemStats != nil {
+		heapAlloc = &vars.MemStats.HeapAlloc
+	}
+	return vars.Goroutines, heapAlloc, nil
+}
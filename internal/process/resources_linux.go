@@ -0,0 +1,102 @@
+//go:build linux
+
+package process
+
+// gomon is a simple command line tool that watches your files and automatically restarts the application when it detects any changes in the working directory.
+// Copyright (C) 2023 John Dudmesh
+
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+
+// You should have received a copy of the GNU General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>.
+
+import (
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// clockTicks is USER_HZ, the unit of the CPU times in /proc, it is 100 on every architecture Go supports
+const clockTicks = 100
+
+// procStat holds the fields of /proc/<pid>/stat which are needed to sample a process group
+type procStat struct {
+	pgrp     int
+	utime    int64
+	stime    int64
+	rssPages int64
+}
+
+// sampleProcessGroup adds up the memory and CPU time of every process in the group
+func sampleProcessGroup(pgid int) (resourceUsage, error) {
+	usage := resourceUsage{}
+	entries, err := os.ReadDir("/proc")
+	if err != nil {
+		return usage, fmt.Errorf("listing processes: %w", err)
+	}
+
+	found := false
+	for _, entry := range entries {
+		if _, err := strconv.Atoi(entry.Name()); err != nil {
+			continue
+		}
+		// the process may have exited since the directory was listed
+		buf, err := os.ReadFile("/proc/" + entry.Name() + "/stat")
+		if err != nil {
+			continue
+		}
+		stat, err := parseProcStat(string(buf))
+		if err != nil || stat.pgrp != pgid {
+			continue
+		}
+		found = true
+		usage.rss += stat.rssPages * int64(os.Getpagesize())
+		usage.cpu += time.Duration(stat.utime+stat.stime) * time.Second / clockTicks
+	}
+
+	if !found {
+		return usage, fmt.Errorf("process group %d not found", pgid)
+	}
+	return usage, nil
+}
+
+// parseProcStat reads the process group, CPU times and resident pages from the contents of /proc/<pid>/stat, the
+// command name is skipped by looking for its closing bracket because it can contain spaces
+func parseProcStat(stat string) (procStat, error) {
+	ix := strings.LastIndexByte(stat, ')')
+	if ix < 0 {
+		return procStat{}, fmt.Errorf("malformed stat: %q", stat)
+	}
+	// fields starts at the state, the third field
+	fields := strings.Fields(stat[ix+1:])
+	if len(fields) < 22 {
+		return procStat{}, fmt.Errorf("malformed stat: %q", stat)
+	}
+
+	s := procStat{}
+	var err error
+	s.pgrp, err = strconv.Atoi(fields[2])
+	if err == nil {
+		s.utime, err = strconv.ParseInt(fields[11], 10, 64)
+	}
+	if err == nil {
+		s.stime, err = strconv.ParseInt(fields[12], 10, 64)
+	}
+	if err == nil {
+		s.rssPages, err = strconv.ParseInt(fields[21], 10, 64)
+	}
+	if err != nil {
+		return procStat{}, fmt.Errorf("parsing stat: %w", err)
+	}
+	return s, nil
+}
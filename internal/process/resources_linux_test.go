@@ -0,0 +1,63 @@
+//go:build linux
+
+package process
+
+// gomon is a simple command line tool that watches your files and automatically restarts the application when it detects any changes in the working directory.
+// Copyright (C) 2023 John Dudmesh
+
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+
+// You should have received a copy of the GNU General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>.
+
+import (
+	"os/exec"
+	"syscall"
+	"testing"
+)
+
+func TestParseProcStat(t *testing.T) {
+	// the command name contains a space and a bracket
+	stat := "1234 (my (app)) S 1 1230 1230 0 -1 4194560 100 0 0 0 250 50 0 0 20 0 8 0 100 1000000 512 18446744073709551615"
+	s, err := parseProcStat(stat)
+	if err != nil {
+		t.Fatalf("parsing stat: %v", err)
+	}
+	if s.pgrp != 1230 || s.utime != 250 || s.stime != 50 || s.rssPages != 512 {
+		t.Errorf("unexpected stat: %+v", s)
+	}
+
+	_, err = parseProcStat("1234 (app) S 1")
+	if err == nil {
+		t.Error("expected an error for a truncated stat")
+	}
+}
+
+func TestSampleProcessGroup(t *testing.T) {
+	cmd := exec.Command("sh", "-c", "sleep 5 & sleep 5")
+	cmd.SysProcAttr = &syscall.SysProcAttr{Setpgid: true}
+	err := cmd.Start()
+	if err != nil {
+		t.Fatalf("starting process: %v", err)
+	}
+	defer func() {
+		killProcessGroup(cmd.Process.Pid)
+		cmd.Wait()
+	}()
+
+	usage, err := sampleProcessGroup(cmd.Process.Pid)
+	if err != nil {
+		t.Fatalf("sampling process group: %v", err)
+	}
+	if usage.rss <= 0 {
+		t.Errorf("expected resident memory, got %+v", usage)
+	}
+}
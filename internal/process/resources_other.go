@@ -0,0 +1,92 @@
+//go:build !linux && !windows
+
+package process
+
+// gomon is a simple command line tool that watches your files and automatically restarts the application when it detects any changes in the working directory.
+// Copyright (C) 2023 John Dudmesh
+
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+
+// You should have received a copy of the GNU General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>.
+
+import (
+	"fmt"
+	"os/exec"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// sampleProcessGroup adds up the memory and CPU time of every process in the group using ps, there is no /proc
+// on macOS and the BSDs
+func sampleProcessGroup(pgid int) (resourceUsage, error) {
+	usage := resourceUsage{}
+	out, err := exec.Command("ps", "-A", "-o", "pgid=", "-o", "rss=", "-o", "time=").Output()
+	if err != nil {
+		return usage, fmt.Errorf("running ps: %w", err)
+	}
+
+	found := false
+	for _, line := range strings.Split(string(out), "\n") {
+		fields := strings.Fields(line)
+		if len(fields) != 3 || fields[0] != strconv.Itoa(pgid) {
+			continue
+		}
+		rss, err := strconv.ParseInt(fields[1], 10, 64)
+		if err != nil {
+			continue
+		}
+		cpu, err := parsePsTime(fields[2])
+		if err != nil {
+			continue
+		}
+		found = true
+		// ps reports the resident size in kilobytes
+		usage.rss += rss * 1024
+		usage.cpu += cpu
+	}
+
+	if !found {
+		return usage, fmt.Errorf("process group %d not found", pgid)
+	}
+	return usage, nil
+}
+
+// parsePsTime parses the CPU time reported by ps which is [[dd-]hh:]mm:ss[.ss]
+func parsePsTime(s string) (time.Duration, error) {
+	days := int64(0)
+	if before, after, ok := strings.Cut(s, "-"); ok {
+		d, err := strconv.ParseInt(before, 10, 64)
+		if err != nil {
+			return 0, fmt.Errorf("parsing cpu time: %w", err)
+		}
+		days, s = d, after
+	}
+
+	parts := strings.Split(s, ":")
+	seconds, err := strconv.ParseFloat(parts[len(parts)-1], 64)
+	if err != nil {
+		return 0, fmt.Errorf("parsing cpu time: %w", err)
+	}
+	total := time.Duration(days) * 24 * time.Hour
+	total += time.Duration(seconds * float64(time.Second))
+	unit := time.Minute
+	for ix := len(parts) - 2; ix >= 0; ix-- {
+		n, err := strconv.ParseInt(parts[ix], 10, 64)
+		if err != nil {
+			return 0, fmt.Errorf("parsing cpu time: %w", err)
+		}
+		total += time.Duration(n) * unit
+		unit *= 60
+	}
+	return total, nil
+}
@@ -0,0 +1,69 @@
+package process
+
+// gomon is a simple command line tool that watches your files and automatically restarts the application when it detects any changes in the working directory.
+// Copyright (C) 2023 John Dudmesh
+
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+
+// You should have received a copy of the GNU General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>.
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/jdudmesh/gomon/internal/config"
+)
+
+func TestReadExpvar(t *testing.T) {
+	body := `{"cmdline": ["app"], "goroutines": 42, "memstats": {"Alloc": 1, "HeapAlloc": 2048}}`
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(body))
+	}))
+	defer srv.Close()
+
+	cfg := config.Config{}
+	cfg.Resources.Enabled = true
+	cfg.Resources.Expvar = srv.URL
+	m := newResourceMonitor(cfg)
+
+	goroutines, heapAlloc, err := m.readExpvar()
+	if err != nil {
+		t.Fatalf("reading expvar: %v", err)
+	}
+	if goroutines == nil || *goroutines != 42 || heapAlloc == nil || *heapAlloc != 2048 {
+		t.Errorf("unexpected values: %v %v", goroutines, heapAlloc)
+	}
+
+	// the goroutine count is only there if the child publishes it
+	body = `{"memstats": {"HeapAlloc": 4096}}`
+	goroutines, heapAlloc, err = m.readExpvar()
+	if err != nil || goroutines != nil || heapAlloc == nil || *heapAlloc != 4096 {
+		t.Errorf("expected only the heap size, got %v %v %v", goroutines, heapAlloc, err)
+	}
+}
+
+func TestCPUPercent(t *testing.T) {
+	if p := cpuPercent(time.Second, 3*time.Second, 4*time.Second); p != 50 {
+		t.Errorf("expected 50%%, got %v", p)
+	}
+	if p := cpuPercent(3*time.Second, time.Second, 4*time.Second); p != 0 {
+		t.Errorf("expected 0%% when a process has exited, got %v", p)
+	}
+}
+
+func TestResourcesDisabled(t *testing.T) {
+	if m := newResourceMonitor(config.Config{}); m != nil {
+		t.Errorf("expected no monitor when resources aren't enabled")
+	}
+}
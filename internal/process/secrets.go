@@ -0,0 +1,117 @@
+package process
+
+// gomon is a simple command line tool that watches your files and automatically restarts the application when it detects any changes in the working directory.
+// Copyright (C) 2023 John Dudmesh
+
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+
+// You should have received a copy of the GNU General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>.
+
+import (
+	"bytes"
+	"fmt"
+	"os"
+	"os/exec"
+	"strings"
+	"sync"
+)
+
+const (
+	envRefPrefix         = "env://"
+	fileRefPrefix        = "file://"
+	onePasswordRefPrefix = "op://"
+)
+
+// SecretResolver replaces references such as env://VAR, file:///path and op://vault/item/field with the
+// values they point to so that secrets don't need to be committed to env files in plain text
+type SecretResolver struct {
+	cache     map[string]string
+	cacheLock sync.Mutex
+}
+
+func NewSecretResolver() *SecretResolver {
+	return &SecretResolver{
+		cache: map[string]string{},
+	}
+}
+
+// IsReference returns true if the value should be resolved
+func IsReference(value string) bool {
+	return strings.HasPrefix(value, envRefPrefix) || strings.HasPrefix(value, fileRefPrefix) || strings.HasPrefix(value, onePasswordRefPrefix)
+}
+
+// Resolve returns the value a reference points to, values which are not references are returned unchanged
+func (r *SecretResolver) Resolve(value string) (string, error) {
+	switch {
+	case strings.HasPrefix(value, envRefPrefix):
+		name := strings.TrimPrefix(value, envRefPrefix)
+		resolved, ok := os.LookupEnv(name)
+		if !ok {
+			return "", fmt.Errorf("environment variable %s is not set", name)
+		}
+		return resolved, nil
+	case strings.HasPrefix(value, fileRefPrefix):
+		data, err := os.ReadFile(strings.TrimPrefix(value, fileRefPrefix))
+		if err != nil {
+			return "", fmt.Errorf("reading secret file: %w", err)
+		}
+		return strings.TrimRight(string(data), "\r\n"), nil
+	case strings.HasPrefix(value, onePasswordRefPrefix):
+		// the 1Password CLI can be slow and may prompt for authentication so results are cached
+		r.cacheLock.Lock()
+		defer r.cacheLock.Unlock()
+		if resolved, ok := r.cache[value]; ok {
+			return resolved, nil
+		}
+		stderr := &bytes.Buffer{}
+		cmd := exec.Command("op", "read", "--no-newline", value)
+		cmd.Stderr = stderr
+		out, err := cmd.Output()
+		if err != nil {
+			return "", fmt.Errorf("reading 1Password secret: %w: %s", err, strings.TrimSpace(stderr.String()))
+		}
+		r.cache[value] = string(out)
+		return string(out), nil
+	}
+	return value, nil
+}
+
+// ResolveEnv resolves the values of KEY=VALUE pairs
+func (r *SecretResolver) ResolveEnv(envVars []string) ([]string, error) {
+	resolved := make([]string, 0, len(envVars))
+	for _, envVar := range envVars {
+		key, value, found := strings.Cut(envVar, "=")
+		if !found || !IsReference(value) {
+			resolved = append(resolved, envVar)
+			continue
+		}
+		value, err := r.Resolve(value)
+		if err != nil {
+			return nil, fmt.Errorf("resolving %s: %w", key, err)
+		}
+		resolved = append(resolved, key+"="+value)
+	}
+	return resolved, nil
+}
+
+// ResolveAll resolves each of the values
+func (r *SecretResolver) ResolveAll(values []string) ([]string, error) {
+	resolved := make([]string, 0, len(values))
+	for _, value := range values {
+		next, err := r.Resolve(value)
+		if err != nil {
+			return nil, err
+		}
+		resolved = append(resolved, next)
+	}
+	return resolved, nil
+}
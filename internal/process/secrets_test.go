@@ -0,0 +1,54 @@
+package process
+
+// gomon is a simple command line tool that watches your files and automatically restarts the application when it detects any changes in the working directory.
+// Copyright (C) 2023 John Dudmesh
+
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+
+// You should have received a copy of the GNU General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>.
+
+import (
+	"os"
+	"path"
+	"testing"
+)
+
+func TestSecretResolverResolveEnv(t *testing.T) {
+	t.Setenv("GOMON_TEST_SECRET", "from-env")
+
+	secretFile := path.Join(t.TempDir(), "secret")
+	err := os.WriteFile(secretFile, []byte("from-file\n"), 0600)
+	if err != nil {
+		t.Fatalf("writing secret file: %v", err)
+	}
+
+	resolved, err := NewSecretResolver().ResolveEnv([]string{
+		"PLAIN=value",
+		"FROM_ENV=env://GOMON_TEST_SECRET",
+		"FROM_FILE=file://" + secretFile,
+	})
+	if err != nil {
+		t.Fatalf("resolving env: %v", err)
+	}
+
+	expected := []string{"PLAIN=value", "FROM_ENV=from-env", "FROM_FILE=from-file"}
+	for i := range expected {
+		if resolved[i] != expected[i] {
+			t.Errorf("expected %s, got %s", expected[i], resolved[i])
+		}
+	}
+
+	_, err = NewSecretResolver().ResolveEnv([]string{"MISSING=env://GOMON_TEST_MISSING_SECRET"})
+	if err == nil {
+		t.Error("expected an error for a missing environment variable")
+	}
+}
@@ -0,0 +1,100 @@
+package process
+
+// gomon is a simple command line tool that watches your files and automatically restarts the application when it detects any changes in the working directory.
+// Copyright (C) 2023 John Dudmesh
+
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+
+// You should have received a copy of the GNU General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>.
+
+import (
+	"errors"
+	"fmt"
+	"net"
+	"os"
+	"runtime"
+	"strconv"
+
+	"github.com/jdudmesh/gomon/internal/config"
+)
+
+// DefaultSocketEnv is the variable which tells the child process the number of the inherited socket's descriptor
+const DefaultSocketEnv = "LISTEN_FD"
+
+// socketFD is the descriptor number of the socket in the child, os/exec numbers extra files from 3
+const socketFD = 3
+
+// Socket is a listening socket opened by gomon and inherited by each run of the child process, connections made
+// while the child restarts wait in the socket's backlog rather than being refused
+type Socket struct {
+	addr     string
+	env      string
+	listener *net.TCPListener
+	file     *os.File
+}
+
+// NewSocket opens the socket from process.socket, it returns nil if socket passing isn't configured
+func NewSocket(cfg config.Config) (*Socket, error) {
+	if cfg.Process.Socket.Addr == "" {
+		return nil, nil
+	}
+
+	if runtime.GOOS == "windows" {
+		return nil, errors.New("process.socket is not supported on Windows")
+	}
+
+	addr, err := net.ResolveTCPAddr("tcp", cfg.Process.Socket.Addr)
+	if err != nil {
+		return nil, fmt.Errorf("resolving socket address: %w", err)
+	}
+
+	listener, err := net.ListenTCP("tcp", addr)
+	if err != nil {
+		return nil, fmt.Errorf("listening on %s: %w", cfg.Process.Socket.Addr, err)
+	}
+
+	// the file is a duplicate of the listener's descriptor, it is the one inherited by the child
+	file, err := listener.File()
+	if err != nil {
+		listener.Close()
+		return nil, fmt.Errorf("getting socket descriptor: %w", err)
+	}
+
+	env := cfg.Process.Socket.Env
+	if env == "" {
+		env = DefaultSocketEnv
+	}
+
+	log.Infof("listening on %s for the child process", listener.Addr())
+
+	return &Socket{
+		addr:     listener.Addr().String(),
+		env:      env,
+		listener: listener,
+		file:     file,
+	}, nil
+}
+
+// Addr is the address the socket is listening on
+func (s *Socket) Addr() string {
+	return s.addr
+}
+
+// environ tells the child process which descriptor to listen on
+func (s *Socket) environ() map[string]string {
+	return map[string]string{s.env: strconv.Itoa(socketFD)}
+}
+
+func (s *Socket) Close() error {
+	s.file.Close()
+	return s.listener.Close()
+}
@@ -0,0 +1,72 @@
+package process
+
+// gomon is a simple command line tool that watches your files and automatically restarts the application when it detects any changes in the working directory.
+// Copyright (C) 2023 John Dudmesh
+
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+
+// You should have received a copy of the GNU General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>.
+
+import (
+	"net"
+	"runtime"
+	"testing"
+
+	"github.com/jdudmesh/gomon/internal/config"
+	"github.com/jdudmesh/gomon/internal/notification"
+)
+
+func TestSocket(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("socket passing is not supported on Windows")
+	}
+
+	cfg := config.Config{
+		RootDirectory: t.TempDir(),
+		// the child fails unless the variable is set and descriptor 3 is open
+		Command: []string{"sh", "-c", `[ "$TEST_FD" = 3 ] && exec 4<&3`},
+	}
+	cfg.Process.Socket.Addr = "127.0.0.1:0"
+	cfg.Process.Socket.Env = "TEST_FD"
+
+	socket, err := NewSocket(cfg)
+	if err != nil {
+		t.Fatalf("opening socket: %v", err)
+	}
+	defer socket.Close()
+
+	proc, err := NewChildProcess(cfg, WithSocket(socket))
+	if err != nil {
+		t.Fatalf("creating child process: %v", err)
+	}
+
+	// the socket is shared between runs
+	for run := 0; run < 2; run++ {
+		err = proc.Start(&testConsole{}, func(n notification.Notification) error { return nil })
+		if err != nil {
+			t.Fatalf("run %d: expected the child to inherit the socket: %v", run, err)
+		}
+	}
+
+	// connections are accepted into the backlog while no child is running
+	conn, err := net.Dial("tcp", socket.Addr())
+	if err != nil {
+		t.Fatalf("connecting to the socket: %v", err)
+	}
+	conn.Close()
+
+	cfg.Process.Socket.Addr = ""
+	socket, err = NewSocket(cfg)
+	if socket != nil || err != nil {
+		t.Errorf("expected no socket when it isn't configured, got %v %v", socket, err)
+	}
+}
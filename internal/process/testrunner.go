@@ -0,0 +1,464 @@
+package process
+
+// gomon is a simple command line tool that watches your files and automatically restarts the application when it detects any changes in the working directory.
+// Copyright (C) 2023 John Dudmesh
+
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+
+// You should have received a copy of the GNU General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>.
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/jdudmesh/gomon/internal/config"
+	"github.com/jdudmesh/gomon/internal/notification"
+	"github.com/jdudmesh/gomon/internal/utils"
+)
+
+// changes which arrive close together e.g. a save all in the editor are tested together
+const testDebounce = 200 * time.Millisecond
+
+// TestRunner runs `go test` for the packages affected by each change instead of running a child process. A change
+// affects the packages which contain it, depend on it or import it in their tests.
+type TestRunner struct {
+	rootDirectory string
+	packages      []string
+	flags         []string
+	envVars       []string
+	resolver      *utils.PathResolver
+	pendingLock   sync.Mutex
+	pending       map[string]struct{}
+	wake          chan struct{}
+	// currentRun is set when tests are run alongside the child process, the results are shown in its current run
+	currentRun func() string
+}
+
+func NewTestRunner(cfg config.Config) (*TestRunner, error) {
+	resolver, err := utils.NewPathResolver(cfg.RootDirectory, cfg.Roots)
+	if err != nil {
+		return nil, fmt.Errorf("resolving roots: %w", err)
+	}
+
+	runner := &TestRunner{
+		rootDirectory: cfg.RootDirectory,
+		packages:      cfg.Test.Packages,
+		flags:         cfg.Test.Flags,
+		envVars:       os.Environ(),
+		resolver:      resolver,
+		pending:       map[string]struct{}{},
+		wake:          make(chan struct{}, 1),
+	}
+	if len(runner.packages) == 0 {
+		runner.packages = []string{"./..."}
+	}
+
+	workspace, err := workspaceEnv(cfg)
+	if err != nil {
+		return nil, err
+	}
+	runner.envVars = append(runner.envVars, workspace...)
+
+	for _, file := range cfg.EnvFiles {
+		if !filepath.IsAbs(file) {
+			file = filepath.Join(cfg.RootDirectory, file)
+		}
+		lines, err := readEnvFile(file)
+		if err != nil && !os.IsNotExist(err) {
+			return nil, fmt.Errorf("loading env file: %w", err)
+		}
+		runner.envVars = append(runner.envVars, lines...)
+	}
+
+	return runner, nil
+}
+
+// NewChangeTester creates a TestRunner which tests the packages containing changed test files while the child
+// process is running, currentRun returns the ID of the run the results are shown in
+func NewChangeTester(cfg config.Config, currentRun func() string) (*TestRunner, error) {
+	runner, err := NewTestRunner(cfg)
+	if err != nil {
+		return nil, err
+	}
+	runner.currentRun = currentRun
+	return runner, nil
+}
+
+// Request queues a test run for a changed file, hints which aren't files e.g. a restart requested from the UI
+// run every package
+func (t *TestRunner) Request(hint string) {
+	t.pendingLock.Lock()
+	t.pending[hint] = struct{}{}
+	t.pendingLock.Unlock()
+
+	select {
+	case t.wake <- struct{}{}:
+	default:
+	}
+}
+
+func (t *TestRunner) takePending() []string {
+	t.pendingLock.Lock()
+	defer t.pendingLock.Unlock()
+
+	hints := make([]string, 0, len(t.pending))
+	for hint := range t.pending {
+		hints = append(hints, hint)
+	}
+	t.pending = map[string]struct{}{}
+	sort.Strings(hints)
+	return hints
+}
+
+// Run tests every package then waits for changes until the context is cancelled. Changes made while the tests are
+// running are tested once they finish. Runners created by NewChangeTester only wait for changes.
+func (t *TestRunner) Run(ctx context.Context, console ConsoleOutput, callbackFn notification.NotificationCallback) error {
+	if t.currentRun == nil {
+		t.runTests(ctx, t.packages, console, callbackFn)
+	}
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-t.wake:
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-time.After(testDebounce):
+		}
+
+		hints := t.takePending()
+		packages, err := t.affectedPackages(hints)
+		if err != nil {
+			log.Warnf("finding affected packages, testing every package: %v", err)
+			packages = t.packages
+		}
+		if len(packages) == 0 {
+			log.Infof("no tests affected by: %s", strings.Join(hints, ", "))
+			continue
+		}
+
+		t.runTests(ctx, packages, console, callbackFn)
+	}
+}
+
+// listedPackage is the subset of `go list -json` output used to find the packages affected by a change
+type listedPackage struct {
+	ImportPath   string
+	Dir          string
+	DepOnly      bool
+	Deps         []string
+	TestImports  []string
+	XTestImports []string
+}
+
+// affectedPackages returns the import paths of the packages under test which are affected by the changed files
+func (t *TestRunner) affectedPackages(hints []string) ([]string, error) {
+	stdout := &bytes.Buffer{}
+	stderr := &bytes.Buffer{}
+	args := append([]string{"list", "-e", "-deps", "-json=ImportPath,Dir,DepOnly,Deps,TestImports,XTestImports"}, t.packages...)
+	cmd := exec.Command("go", args...)
+	cmd.Dir = t.rootDirectory
+	cmd.Env = t.envVars
+	cmd.Stdout = stdout
+	cmd.Stderr = stderr
+	err := cmd.Run()
+	if err != nil {
+		return nil, fmt.Errorf("go list: %w: %s", err, strings.TrimSpace(stderr.String()))
+	}
+
+	listed, err := parseListedPackages(stdout)
+	if err != nil {
+		return nil, err
+	}
+
+	changed := map[string]struct{}{}
+	for _, hint := range hints {
+		importPath, ok := t.changedPackage(hint, listed)
+		if !ok {
+			// e.g. go.mod, testdata outside a package or a restart requested from the UI
+			return t.packages, nil
+		}
+		changed[importPath] = struct{}{}
+	}
+
+	if onlyTestFiles(hints) {
+		// test files aren't compiled into other packages
+		return changedPackages(changed, listed), nil
+	}
+	return affectedBy(changed, listed), nil
+}
+
+func onlyTestFiles(hints []string) bool {
+	for _, hint := range hints {
+		if !strings.HasSuffix(hint, "_test.go") {
+			return false
+		}
+	}
+	return len(hints) > 0
+}
+
+// changedPackages returns the changed packages in the order they were listed
+func changedPackages(changed map[string]struct{}, listed []listedPackage) []string {
+	packages := []string{}
+	for _, pkg := range listed {
+		if _, ok := changed[pkg.ImportPath]; ok && !pkg.DepOnly {
+			packages = append(packages, pkg.ImportPath)
+		}
+	}
+	return packages
+}
+
+func parseListedPackages(r io.Reader) ([]listedPackage, error) {
+	listed := []listedPackage{}
+	dec := json.NewDecoder(r)
+	for {
+		pkg := listedPackage{}
+		err := dec.Decode(&pkg)
+		if errors.Is(err, io.EOF) {
+			return listed, nil
+		}
+		if err != nil {
+			return nil, fmt.Errorf("decoding go list output: %w", err)
+		}
+		listed = append(listed, pkg)
+	}
+}
+
+// changedPackage returns the import path of the package containing a changed file, files in subdirectories which
+// aren't packages e.g. testdata belong to the closest package above them
+func (t *TestRunner) changedPackage(hint string, listed []listedPackage) (string, bool) {
+	filePath := t.resolver.Resolve(hint)
+	if _, err := os.Stat(filePath); err != nil && filepath.Ext(filePath) != ".go" {
+		return "", false
+	}
+
+	byDir := map[string]string{}
+	for _, pkg := range listed {
+		byDir[pkg.Dir] = pkg.ImportPath
+	}
+
+	dir := filepath.Dir(filePath)
+	for {
+		if importPath, ok := byDir[dir]; ok {
+			return importPath, true
+		}
+		parent := filepath.Dir(dir)
+		if dir == t.rootDirectory || parent == dir {
+			return "", false
+		}
+		dir = parent
+	}
+}
+
+// affectedBy returns the packages under test which are, depend on or import in their tests any changed package
+func affectedBy(changed map[string]struct{}, listed []listedPackage) []string {
+	isChanged := func(importPaths []string) bool {
+		for _, importPath := range importPaths {
+			if _, ok := changed[importPath]; ok {
+				return true
+			}
+		}
+		return false
+	}
+
+	affected := []string{}
+	for _, pkg := range listed {
+		if pkg.DepOnly {
+			continue
+		}
+		if isChanged([]string{pkg.ImportPath}) || isChanged(pkg.Deps) || isChanged(pkg.TestImports) || isChanged(pkg.XTestImports) {
+			affected = append(affected, pkg.ImportPath)
+		}
+	}
+	return affected
+}
+
+// testEvent is a line of `go test -json` output, see `go doc test2json`
+type testEvent struct {
+	Action  string
+	Package string
+	Test    string
+	Elapsed float64
+	Output  string
+	// ImportPath is set instead of Package on build events
+	ImportPath string
+}
+
+// testResults collects the outcome of a run, the output of passing tests is dropped so only failures are shown
+type testResults struct {
+	stdout  io.Writer
+	stderr  io.Writer
+	output  map[string][]string
+	passed  []string
+	failed  []string
+	skipped []string
+	// failedTests are the tests which failed in each package
+	failedTests map[string][]string
+}
+
+func newTestResults(stdout, stderr io.Writer) *testResults {
+	return &testResults{
+		stdout:      stdout,
+		stderr:      stderr,
+		output:      map[string][]string{},
+		failedTests: map[string][]string{},
+	}
+}
+
+func (r *testResults) handle(ev testEvent) {
+	key := ev.Package + "/" + ev.Test
+	switch ev.Action {
+	case "output":
+		if ev.Test == "" && isPackageResult(ev.Output) {
+			fmt.Fprint(r.stdout, ev.Output)
+			return
+		}
+		r.output[key] = append(r.output[key], ev.Output)
+	case "build-output":
+		// compiler errors are written to stderr so they're shown as build errors
+		fmt.Fprint(r.stderr, ev.Output)
+	case "fail":
+		for _, line := range r.output[key] {
+			fmt.Fprint(r.stdout, line)
+		}
+		delete(r.output, key)
+		if ev.Test != "" {
+			// subtests are covered by their parent
+			if !strings.Contains(ev.Test, "/") {
+				r.failedTests[ev.Package] = append(r.failedTests[ev.Package], ev.Test)
+			}
+		} else if ev.Package != "" {
+			r.failed = append(r.failed, ev.Package)
+		}
+	case "pass", "skip":
+		delete(r.output, key)
+		if ev.Test != "" {
+			return
+		}
+		if ev.Action == "pass" {
+			r.passed = append(r.passed, ev.Package)
+		} else {
+			r.skipped = append(r.skipped, ev.Package)
+		}
+	}
+}
+
+func isPackageResult(line string) bool {
+	return strings.HasPrefix(line, "ok ") || strings.HasPrefix(line, "FAIL\t") || strings.HasPrefix(line, "?")
+}
+
+// summary describes the run for its pass or fail badge
+func (r *testResults) summary(elapsed time.Duration) (notification.NotificationType, string) {
+	if len(r.failed) == 0 {
+		return notification.NotificationTypeTestPass, fmt.Sprintf("tests passed: %d packages in %s", len(r.passed), elapsed.Round(time.Millisecond))
+	}
+
+	failures := []string{}
+	for _, pkg := range r.failed {
+		if tests := r.failedTests[pkg]; len(tests) > 0 {
+			failures = append(failures, fmt.Sprintf("%s (%s)", pkg, strings.Join(tests, ", ")))
+		} else {
+			failures = append(failures, pkg)
+		}
+	}
+	return notification.NotificationTypeTestFail, fmt.Sprintf("tests failed: %d of %d packages, %s", len(r.failed), len(r.failed)+len(r.passed), strings.Join(failures, ", "))
+}
+
+// runTests runs `go test -json` as a new run so that each one is listed separately in the UI, or in the child
+// process's current run if there is one
+func (t *TestRunner) runTests(ctx context.Context, packages []string, console ConsoleOutput, callbackFn notification.NotificationCallback) {
+	runID := notification.NextID()
+	startType := notification.NotificationTypeStartup
+	if t.currentRun != nil {
+		runID = t.currentRun()
+		startType = notification.NotificationTypeLogEvent
+	}
+	callbackFn(notification.Notification{
+		ID:              notification.NextID(),
+		ChildProccessID: runID,
+		Date:            time.Now(),
+		Type:            startType,
+		Message:         "testing " + strings.Join(packages, " "),
+	})
+
+	args := append([]string{"test", "-json"}, t.flags...)
+	args = append(args, packages...)
+	log.Infof("running: go %s", strings.Join(args, " "))
+
+	cmd := exec.CommandContext(ctx, "go", args...)
+	cmd.Dir = t.rootDirectory
+	cmd.Env = append(append([]string{}, t.envVars...), EnvRunID+"="+runID)
+	cmd.Stderr = console.Stderr()
+	stdout, err := cmd.StdoutPipe()
+	if err == nil {
+		err = cmd.Start()
+	}
+	if err != nil {
+		callbackFn(notification.Notification{
+			ID:              notification.NextID(),
+			ChildProccessID: runID,
+			Date:            time.Now(),
+			Type:            notification.NotificationTypeSystemError,
+			Message:         fmt.Sprintf("running go test: %v", err),
+		})
+		return
+	}
+
+	startedAt := time.Now()
+	results := newTestResults(console.Stdout(), console.Stderr())
+	scanner := bufio.NewScanner(stdout)
+	scanner.Buffer(make([]byte, 64*1024), 4*1024*1024)
+	for scanner.Scan() {
+		line := scanner.Bytes()
+		ev := testEvent{}
+		if len(line) == 0 || line[0] != '{' || json.Unmarshal(line, &ev) != nil {
+			// e.g. output from the go command itself
+			fmt.Fprintf(console.Stdout(), "%s\n", line)
+			continue
+		}
+		results.handle(ev)
+	}
+
+	err = cmd.Wait()
+	if ctx.Err() != nil {
+		return
+	}
+
+	notifType, message := results.summary(time.Since(startedAt))
+	if err != nil && notifType == notification.NotificationTypeTestPass {
+		// e.g. the packages couldn't be listed or built
+		notifType, message = notification.NotificationTypeTestFail, fmt.Sprintf("tests failed: %v", err)
+	}
+	callbackFn(notification.Notification{
+		ID:              notification.NextID(),
+		ChildProccessID: runID,
+		Date:            time.Now(),
+		Type:            notifType,
+		Message:         message,
+	})
+}
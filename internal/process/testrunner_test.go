@@ -0,0 +1,185 @@
+package process
+
+// gomon is a simple command line tool that watches your files and automatically restarts the application when it detects any changes in the working directory.
+// Copyright (C) 2023 John Dudmesh
+
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+
+// You should have received a copy of the GNU General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>.
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/jdudmesh/gomon/internal/config"
+	"github.com/jdudmesh/gomon/internal/notification"
+)
+
+func TestTestResults(t *testing.T) {
+	stdout := &bytes.Buffer{}
+	stderr := &bytes.Buffer{}
+	results := newTestResults(stdout, stderr)
+
+	events := []testEvent{
+		{Action: "run", Package: "example.com/app/a", Test: "TestA"},
+		{Action: "output", Package: "example.com/app/a", Test: "TestA", Output: "=== RUN   TestA\n"},
+		{Action: "output", Package: "example.com/app/a", Test: "TestA", Output: "--- PASS: TestA (0.00s)\n"},
+		{Action: "pass", Package: "example.com/app/a", Test: "TestA"},
+		{Action: "output", Package: "example.com/app/a", Output: "PASS\n"},
+		{Action: "output", Package: "example.com/app/a", Output: "ok  \texample.com/app/a\t0.01s\n"},
+		{Action: "pass", Package: "example.com/app/a"},
+		{Action: "output", Package: "example.com/app/b", Test: "TestB", Output: "    b_test.go:10: expected 1, got 2\n"},
+		{Action: "output", Package: "example.com/app/b", Test: "TestB/sub", Output: "--- FAIL: TestB/sub (0.00s)\n"},
+		{Action: "fail", Package: "example.com/app/b", Test: "TestB/sub"},
+		{Action: "fail", Package: "example.com/app/b", Test: "TestB"},
+		{Action: "output", Package: "example.com/app/b", Output: "FAIL\texample.com/app/b\t0.02s\n"},
+		{Action: "fail", Package: "example.com/app/b"},
+		{Action: "build-output", ImportPath: "example.com/app/c", Output: "c/c.go:3:1: syntax error\n"},
+	}
+	for _, ev := range events {
+		results.handle(ev)
+	}
+
+	if strings.Contains(stdout.String(), "=== RUN   TestA") {
+		t.Errorf("expected the output of passing tests to be dropped: %s", stdout)
+	}
+	for _, line := range []string{"ok  \texample.com/app/a", "b_test.go:10: expected 1, got 2", "--- FAIL: TestB/sub", "FAIL\texample.com/app/b"} {
+		if !strings.Contains(stdout.String(), line) {
+			t.Errorf("expected %q in the output: %s", line, stdout)
+		}
+	}
+	if stderr.String() != "c/c.go:3:1: syntax error\n" {
+		t.Errorf("expected build output on stderr, got %q", stderr)
+	}
+
+	notifType, message := results.summary(time.Second)
+	if notifType != notification.NotificationTypeTestFail || message != "tests failed: 1 of 2 packages, example.com/app/b (TestB)" {
+		t.Errorf("unexpected summary: %s %s", notifType, message)
+	}
+}
+
+type bufferConsole struct {
+	lock   sync.Mutex
+	stdout bytes.Buffer
+	stderr bytes.Buffer
+}
+
+func (c *bufferConsole) Write(p []byte) (int, error) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	return c.stdout.Write(p)
+}
+
+func (c *bufferConsole) Stdout() io.Writer {
+	return c
+}
+
+func (c *bufferConsole) Stderr() io.Writer {
+	return &c.stderr
+}
+
+func TestTestRunner(t *testing.T) {
+	if _, err := exec.LookPath("go"); err != nil {
+		t.Skip("go isn't installed")
+	}
+
+	root := t.TempDir()
+	files := map[string]string{
+		"go.mod":               "module example.com/app\n\ngo 1.21\n",
+		"a/a.go":               "package a\n\nfunc A() int { return 1 }\n",
+		"a/a_test.go":          "package a\n\nimport \"testing\"\n\nfunc TestA(t *testing.T) {}\n",
+		"b/b.go":               "package b\n\nimport \"example.com/app/a\"\n\nfunc B() int { return a.A() }\n",
+		"b/b_test.go":          "package b\n\nimport \"testing\"\n\nfunc TestB(t *testing.T) { t.Fatal(\"broken\") }\n",
+		"c/c.go":               "package c\n",
+		"d/d_test.go":          "package d\n\nimport (\n\t\"testing\"\n\n\t\"example.com/app/a\"\n)\n\nfunc TestD(t *testing.T) { a.A() }\n",
+		"a/testdata/input.txt": "",
+	}
+	for name, contents := range files {
+		name = filepath.Join(root, name)
+		if err := os.MkdirAll(filepath.Dir(name), 0755); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.WriteFile(name, []byte(contents), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	cfg := config.Config{RootDirectory: root}
+	runner, err := NewTestRunner(cfg)
+	if err != nil {
+		t.Fatalf("creating test runner: %v", err)
+	}
+
+	tests := []struct {
+		hints []string
+		want  string
+	}{
+		{[]string{"a/a.go"}, "example.com/app/a example.com/app/b example.com/app/d"},
+		{[]string{"a/testdata/input.txt"}, "example.com/app/a example.com/app/b example.com/app/d"},
+		{[]string{"c/c.go"}, "example.com/app/c"},
+		{[]string{"a/a_test.go"}, "example.com/app/a"},
+		{[]string{"a/a_test.go", "d/d_test.go"}, "example.com/app/a example.com/app/d"},
+		{[]string{"a/a_test.go", "a/a.go"}, "example.com/app/a example.com/app/b example.com/app/d"},
+		{[]string{"c/c.go", "go.mod"}, "./..."},
+		{[]string{"api"}, "./..."},
+	}
+	for _, tt := range tests {
+		packages, err := runner.affectedPackages(tt.hints)
+		if err != nil {
+			t.Fatalf("finding affected packages: %v", err)
+		}
+		if got := strings.Join(packages, " "); got != tt.want {
+			t.Errorf("%v: expected %s, got %s", tt.hints, tt.want, got)
+		}
+	}
+
+	console := &bufferConsole{}
+	received := []notification.Notification{}
+	runner.runTests(context.Background(), []string{"./b"}, console, func(n notification.Notification) error {
+		received = append(received, n)
+		return nil
+	})
+	if len(received) != 2 || received[0].Type != notification.NotificationTypeStartup || received[0].ChildProccessID != received[1].ChildProccessID {
+		t.Fatalf("expected a startup and a result for the run, got %+v", received)
+	}
+	if received[1].Type != notification.NotificationTypeTestFail || !strings.Contains(received[1].Message, "example.com/app/b (TestB)") {
+		t.Errorf("unexpected result: %s", received[1].Message)
+	}
+	if !strings.Contains(console.stdout.String(), "broken") {
+		t.Errorf("expected the failure to be written to the console: %s", console.stdout.String())
+	}
+
+	// alongside the child process the results are shown in its current run
+	changeTester, err := NewChangeTester(cfg, func() string { return "run-1" })
+	if err != nil {
+		t.Fatalf("creating change tester: %v", err)
+	}
+	received = nil
+	changeTester.runTests(context.Background(), []string{"./a"}, &bufferConsole{}, func(n notification.Notification) error {
+		received = append(received, n)
+		return nil
+	})
+	if len(received) != 2 || received[0].Type != notification.NotificationTypeLogEvent {
+		t.Fatalf("expected a log event and a result, got %+v", received)
+	}
+	if received[0].ChildProccessID != "run-1" || received[1].ChildProccessID != "run-1" || received[1].Type != notification.NotificationTypeTestPass {
+		t.Errorf("expected the tests to pass in the current run, got %+v", received)
+	}
+}
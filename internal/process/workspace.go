@@ -0,0 +1,35 @@
+package process
+
+// gomon is a simple command line tool that watches your files and automatically restarts the application when it detects any changes in the working directory.
+// Copyright (C) 2023 John Dudmesh
+
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+
+// You should have received a copy of the GNU General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>.
+
+import (
+	"github.com/jdudmesh/gomon/internal/config"
+	"github.com/jdudmesh/gomon/internal/utils"
+)
+
+// workspaceEnv returns the variables which make the go command use the workspace in workspace mode. GOWORK is set
+// explicitly so that it applies even if it is turned off in the environment or a command runs in a member's directory.
+func workspaceEnv(cfg config.Config) ([]string, error) {
+	if !cfg.Workspace {
+		return nil, nil
+	}
+	goWork, err := utils.GoWorkFile(cfg.RootDirectory)
+	if err != nil {
+		return nil, err
+	}
+	return []string{"GOWORK=" + goWork}, nil
+}
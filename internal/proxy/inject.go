@@ -0,0 +1,246 @@
+package proxy
+
+// gomon is a simple command line tool that watches your files and automatically restarts the application when it detects any changes in the working directory.
+// Copyright (C) 2023 John Dudmesh
+
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+
+// You should have received a copy of the GNU General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>.
+
+import (
+	"bytes"
+	"compress/gzip"
+	"compress/zlib"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+
+	"github.com/jdudmesh/gomon/internal/config"
+	"golang.org/x/net/html"
+)
+
+// supportedEncodings are the content encodings which can be decoded to inject the reload script, browsers are
+// asked not to use any others for pages
+var supportedEncodings = []string{"gzip", "deflate", "identity"}
+
+// injector adds the reload script to HTML pages. The page is tokenized so that tags with attributes, minified
+// pages and tags in comments or scripts are handled. Fragments without <html>, <head> or <body> e.g. responses
+// to htmx requests aren't modified so the script isn't added to the page more than once.
+type injector struct {
+	position string
+	code     []byte
+}
+
+func newInjector(position, code string) (*injector, error) {
+	switch position {
+	case "":
+		position = config.InjectHead
+	case config.InjectHead, config.InjectBody:
+	case config.InjectOff:
+		return nil, nil
+	default:
+		return nil, fmt.Errorf("unknown inject position: %s", position)
+	}
+	return &injector{position: position, code: []byte(code)}, nil
+}
+
+// inject returns the page with the script added, ok is false if there is nowhere to put it
+func (i *injector) inject(page []byte) ([]byte, bool) {
+	cutPos := i.findPosition(page)
+	if cutPos < 0 {
+		return page, false
+	}
+
+	out := make([]byte, 0, len(page)+len(i.code))
+	out = append(out, page[:cutPos]...)
+	out = append(out, i.code...)
+	out = append(out, page[cutPos:]...)
+	return out, true
+}
+
+// findPosition returns the offset the script should be inserted at or -1. In the head it goes after the opening
+// tag, falling back to after <html> or before <body> when <head> is omitted. In the body it goes before the last
+// closing tag, falling back to before </html> or the end of the page when the closing tags are omitted.
+func (i *injector) findPosition(page []byte) int {
+	afterHTML, beforeBody, beforeBodyEnd, beforeHTMLEnd := -1, -1, -1, -1
+
+	z := html.NewTokenizer(bytes.NewReader(page))
+	offset := 0
+	for {
+		tt := z.Next()
+		if tt == html.ErrorToken {
+			break
+		}
+		start := offset
+		offset += len(z.Raw())
+
+		name, _ := z.TagName()
+		switch tt {
+		case html.StartTagToken, html.SelfClosingTagToken:
+			switch string(name) {
+			case "head":
+				if i.position == config.InjectHead {
+					return offset
+				}
+			case "html":
+				if afterHTML < 0 {
+					afterHTML = offset
+				}
+			case "body":
+				if beforeBody < 0 {
+					beforeBody = start
+				}
+			}
+		case html.EndTagToken:
+			switch string(name) {
+			case "body":
+				beforeBodyEnd = start
+			case "html":
+				beforeHTMLEnd = start
+			}
+		}
+	}
+
+	if i.position == config.InjectHead {
+		if afterHTML >= 0 {
+			return afterHTML
+		}
+		return beforeBody
+	}
+
+	switch {
+	case beforeBodyEnd >= 0:
+		return beforeBodyEnd
+	case beforeHTMLEnd >= 0:
+		return beforeHTMLEnd
+	case beforeBody >= 0 || afterHTML >= 0:
+		return len(page)
+	}
+	return -1
+}
+
+// injectResponse adds the reload script to an HTML response, compressed responses are decoded and encoded again
+func (i *injector) injectResponse(res *http.Response) error {
+	if res.StatusCode == http.StatusNoContent || res.StatusCode == http.StatusNotModified {
+		return nil
+	}
+
+	encoding := strings.ToLower(strings.TrimSpace(res.Header.Get("Content-Encoding")))
+	if !isSupportedEncoding(encoding) {
+		log.Debugf("not injecting reload script, unsupported content encoding: %s", encoding)
+		return nil
+	}
+
+	raw, err := io.ReadAll(res.Body)
+	res.Body.Close()
+	if err != nil {
+		return fmt.Errorf("reading response body: %w", err)
+	}
+	// the original response is sent if anything goes wrong
+	res.Body = io.NopCloser(bytes.NewReader(raw))
+
+	page, err := decodeBody(raw, encoding)
+	if err != nil {
+		log.Warnf("not injecting reload script, decoding %s response: %v", encoding, err)
+		return nil
+	}
+
+	page, ok := i.inject(page)
+	if !ok {
+		return nil
+	}
+
+	out, err := encodeBody(page, encoding)
+	if err != nil {
+		return fmt.Errorf("encoding %s response: %w", encoding, err)
+	}
+
+	res.Body = io.NopCloser(bytes.NewReader(out))
+	res.ContentLength = int64(len(out))
+	res.Header.Set("Content-Length", fmt.Sprint(len(out)))
+	return nil
+}
+
+func isSupportedEncoding(encoding string) bool {
+	if encoding == "" {
+		return true
+	}
+	for _, supported := range supportedEncodings {
+		if encoding == supported {
+			return true
+		}
+	}
+	return false
+}
+
+func decodeBody(raw []byte, encoding string) ([]byte, error) {
+	var r io.ReadCloser
+	var err error
+	switch encoding {
+	case "gzip":
+		r, err = gzip.NewReader(bytes.NewReader(raw))
+	case "deflate":
+		r, err = zlib.NewReader(bytes.NewReader(raw))
+	default:
+		return raw, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	defer r.Close()
+	return io.ReadAll(r)
+}
+
+func encodeBody(page []byte, encoding string) ([]byte, error) {
+	var w io.WriteCloser
+	buf := &bytes.Buffer{}
+	switch encoding {
+	case "gzip":
+		w = gzip.NewWriter(buf)
+	case "deflate":
+		w = zlib.NewWriter(buf)
+	default:
+		return page, nil
+	}
+	_, err := w.Write(page)
+	if err != nil {
+		return nil, err
+	}
+	err = w.Close()
+	if err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// acceptSupportedEncodings removes encodings which can't be decoded from a page request e.g. br and zstd, other
+// requests are left alone
+func acceptSupportedEncodings(req *http.Request) {
+	accept := req.Header.Get("Accept-Encoding")
+	if accept == "" || !strings.Contains(req.Header.Get("Accept"), "text/html") {
+		return
+	}
+
+	kept := []string{}
+	for _, part := range strings.Split(accept, ",") {
+		name, _, _ := strings.Cut(strings.TrimSpace(part), ";")
+		if isSupportedEncoding(strings.ToLower(strings.TrimSpace(name))) {
+			kept = append(kept, strings.TrimSpace(part))
+		}
+	}
+	if len(kept) == 0 {
+		req.Header.Del("Accept-Encoding")
+		return
+	}
+	req.Header.Set("Accept-Encoding", strings.Join(kept, ", "))
+}
@@ -0,0 +1,129 @@
+package proxy
+
+// gomon is a simple command line tool that watches your files and automatically restarts the application when it detects any changes in the working directory.
+// Copyright (C) 2023 John Dudmesh
+
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+
+// You should have received a copy of the GNU General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>.
+
+import (
+	"bytes"
+	"compress/gzip"
+	"io"
+	"net/http"
+	"testing"
+
+	"github.com/jdudmesh/gomon/internal/config"
+)
+
+func TestInject(t *testing.T) {
+	tests := []struct {
+		name     string
+		position string
+		page     string
+		want     string
+	}{
+		{"head", config.InjectHead, "<html><head><title>x</title></head></html>", "<html><head>[S]<title>x</title></head></html>"},
+		{"head with attributes", config.InjectHead, "<!doctype html><html lang=en><HEAD data-x=\"1\"><title>x</title>", "<!doctype html><html lang=en><HEAD data-x=\"1\">[S]<title>x</title>"},
+		{"head in a comment", config.InjectHead, "<html><!-- <head> --><head></head></html>", "<html><!-- <head> --><head>[S]</head></html>"},
+		{"head in a script", config.InjectHead, "<script>const s = '<head>'</script><head></head>", "<script>const s = '<head>'</script><head>[S]</head>"},
+		{"no head", config.InjectHead, "<html><body>x</body></html>", "<html>[S]<body>x</body></html>"},
+		{"body only", config.InjectHead, "<body class=\"app\">x</body>", "[S]<body class=\"app\">x</body>"},
+		{"fragment", config.InjectHead, "<div id=\"list\"><li>x</li></div>", "<div id=\"list\"><li>x</li></div>"},
+		{"body", config.InjectBody, "<html><head></head><body><p>x</p></body></html>", "<html><head></head><body><p>x</p>[S]</body></html>"},
+		{"body in a script", config.InjectBody, "<body><script>document.write('</body>')</script></body>", "<body><script>document.write('</body>')</script>[S]</body>"},
+		{"body without closing tag", config.InjectBody, "<html><body><p>x", "<html><body><p>x[S]"},
+		{"body fragment", config.InjectBody, "<p>x</p>", "<p>x</p>"},
+	}
+
+	for _, tt := range tests {
+		inj, err := newInjector(tt.position, "[S]")
+		if err != nil {
+			t.Fatalf("%s: %v", tt.name, err)
+		}
+		got, _ := inj.inject([]byte(tt.page))
+		if string(got) != tt.want {
+			t.Errorf("%s: expected %s, got %s", tt.name, tt.want, got)
+		}
+	}
+
+	if inj, err := newInjector(config.InjectOff, "[S]"); inj != nil || err != nil {
+		t.Errorf("expected no injector when injection is off, got %v %v", inj, err)
+	}
+	if _, err := newInjector("footer", "[S]"); err == nil {
+		t.Error("expected an error for an unknown position")
+	}
+}
+
+func TestInjectResponse(t *testing.T) {
+	inj, _ := newInjector(config.InjectHead, "[S]")
+
+	buf := &bytes.Buffer{}
+	zw := gzip.NewWriter(buf)
+	zw.Write([]byte("<html><head></head></html>"))
+	zw.Close()
+
+	res := &http.Response{
+		StatusCode: http.StatusOK,
+		Header:     http.Header{"Content-Encoding": {"gzip"}},
+		Body:       io.NopCloser(buf),
+	}
+	err := inj.injectResponse(res)
+	if err != nil {
+		t.Fatalf("injecting: %v", err)
+	}
+
+	zr, err := gzip.NewReader(res.Body)
+	if err != nil {
+		t.Fatalf("expected a gzip response: %v", err)
+	}
+	page, _ := io.ReadAll(zr)
+	if string(page) != "<html><head>[S]</head></html>" {
+		t.Errorf("unexpected page: %s", page)
+	}
+	if res.Header.Get("Content-Encoding") != "gzip" || res.Header.Get("Content-Length") == "" {
+		t.Errorf("unexpected headers: %v", res.Header)
+	}
+
+	// brotli can't be decoded so the response is sent unchanged
+	res = &http.Response{
+		StatusCode: http.StatusOK,
+		Header:     http.Header{"Content-Encoding": {"br"}},
+		Body:       io.NopCloser(bytes.NewReader([]byte{0x1b, 0x02})),
+	}
+	err = inj.injectResponse(res)
+	if err != nil {
+		t.Fatalf("injecting: %v", err)
+	}
+	body, _ := io.ReadAll(res.Body)
+	if !bytes.Equal(body, []byte{0x1b, 0x02}) {
+		t.Errorf("expected the brotli response to be unchanged, got %v", body)
+	}
+}
+
+func TestAcceptSupportedEncodings(t *testing.T) {
+	req, _ := http.NewRequest(http.MethodGet, "http://localhost/", nil)
+	req.Header.Set("Accept", "text/html,application/xhtml+xml")
+	req.Header.Set("Accept-Encoding", "gzip, deflate, br, zstd;q=0.5")
+	acceptSupportedEncodings(req)
+	if got := req.Header.Get("Accept-Encoding"); got != "gzip, deflate" {
+		t.Errorf("expected gzip, deflate, got %s", got)
+	}
+
+	req.Header.Set("Accept", "application/json")
+	req.Header.Set("Accept-Encoding", "br")
+	acceptSupportedEncodings(req)
+	if got := req.Header.Get("Accept-Encoding"); got != "br" {
+		t.Errorf("expected API requests to be unchanged, got %s", got)
+	}
+}
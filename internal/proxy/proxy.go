@@ -17,24 +17,29 @@ package proxy
 // along with this program.  If not, see <https://www.gnu.org/licenses/>.
 
 import (
-	"bytes"
 	"context"
+	"crypto/tls"
 	"errors"
 	"fmt"
-	"io"
+	"html/template"
+	"net"
 	"net/http"
 	"net/http/httputil"
 	"net/url"
 	"strings"
 	"sync"
+	"sync/atomic"
 	"time"
 
 	"github.com/jdudmesh/gomon/internal/config"
 	"github.com/jdudmesh/gomon/internal/notification"
+	"github.com/jdudmesh/gomon/internal/utils"
 	"github.com/r3labs/sse/v2"
-	log "github.com/sirupsen/logrus"
+	"github.com/sirupsen/logrus"
 )
 
+var log = logrus.WithField("component", "proxy")
+
 const gomonInjectCode = `
 <script>
 	const source = new EventSource('/__gomon__/events?stream=hmr');
@@ -45,17 +50,73 @@ const gomonInjectCode = `
 	};
 </script>`
 
-const headTag = `<head>`
+const maxErrorExcerptLines = 20
+
+var statusPageTemplate = template.Must(template.New("status").Parse(`<!doctype html>
+<html>
+<head>
+	<title>gomon - {{ .State }}</title>
+	<style>
+		body { font-family: sans-serif; background: #0f172a; color: #f8fafc; margin: 2rem; }
+		h1 { color: #3b82f6; }
+		pre { background: #1e293b; color: #f87171; padding: 1rem; overflow-x: auto; }
+	</style>
+</head>
+<body>
+	<h1>gomon</h1>
+	<p>The downstream server at <code>{{ .Downstream }}</code> is not available.</p>
+	<p>Current state: <strong>{{ .State }}</strong> (since {{ .Since.Format "15:04:05" }})</p>
+	{{ if .Message }}<p>{{ .Message }}</p>{{ end }}
+	{{ if .Excerpt }}<pre>{{ range .Excerpt }}{{ . }}
+{{ end }}</pre>{{ end }}
+	<p><small>{{ .Error }}</small></p>
+	<script>
+		const source = new EventSource('/__gomon__/events?stream=status');
+		source.onmessage = function () {
+			source.close();
+			window.location.reload();
+		};
+		{{ if .Retry }}setTimeout(function () { window.location.reload(); }, 2000);{{ end }}
+	</script>
+</body>
+</html>`))
+
+type downstreamStatus struct {
+	State      string
+	Since      time.Time
+	Message    string
+	Excerpt    []string
+	Downstream string
+	Error      string
+	Retry      bool
+}
 
 type webProxy struct {
 	isEnabled         bool
 	port              int
 	downstreamHost    string
+	downstreamURL     *url.URL
 	downstreamTimeout time.Duration
+	gracePeriod       time.Duration
+	tlsCert           string
+	tlsKey            string
+	insecureTLS       bool
 	httpServer        *http.Server
+	mux               *http.ServeMux
 	sseServer         *sse.Server
 	sseServerLock     sync.Mutex
-	injectCode        string
+	injectPosition    string
+	injector          *injector
+	sseBufferSize     int
+	status            downstreamStatus
+	statusLock        sync.Mutex
+	readiness         *readinessProbe
+	rewriteRules      []config.RewriteRule
+	rewriter          *responseRewriter
+	cancelReadiness   context.CancelFunc
+	// cancelRunning stops waiting for the readiness check of the current run, it is guarded by statusLock
+	cancelRunning context.CancelFunc
+	isListening   atomic.Bool
 }
 
 func New(cfg config.Config) (*webProxy, error) {
@@ -64,7 +125,18 @@ func New(cfg config.Config) (*webProxy, error) {
 		port:              cfg.Proxy.Port,
 		downstreamHost:    cfg.Proxy.Downstream.Host,
 		downstreamTimeout: time.Duration(cfg.Proxy.Downstream.Timeout) * time.Second,
+		gracePeriod:       time.Duration(cfg.Proxy.Downstream.GracePeriod) * time.Second,
+		tlsCert:           cfg.Proxy.TLS.Cert,
+		tlsKey:            cfg.Proxy.TLS.Key,
+		insecureTLS:       cfg.Proxy.TLS.InsecureSkipVerify,
+		rewriteRules:      cfg.Proxy.Rewrite,
+		injectPosition:    cfg.Proxy.Inject,
 		sseServerLock:     sync.Mutex{},
+		sseBufferSize:     cfg.Limits.SSEBuffer,
+		status: downstreamStatus{
+			State: "waiting",
+			Since: time.Now(),
+		},
 	}
 
 	err := proxy.initProxy()
@@ -72,6 +144,13 @@ func New(cfg config.Config) (*webProxy, error) {
 		return nil, err
 	}
 
+	if proxy.isEnabled {
+		proxy.readiness, err = newReadinessProbe(cfg, proxy.downstreamURL, proxy.insecureTLS)
+		if err != nil {
+			return nil, err
+		}
+	}
+
 	return proxy, nil
 }
 func (p *webProxy) Enabled() bool {
@@ -84,7 +163,7 @@ func (p *webProxy) initProxy() error {
 	}
 
 	if p.port == 0 {
-		p.port = 4000
+		p.port = config.DefaultProxyPort
 		p.isEnabled = true
 	}
 
@@ -97,50 +176,134 @@ func (p *webProxy) initProxy() error {
 	}
 
 	if p.downstreamTimeout == 0 {
-		p.downstreamTimeout = 5
+		p.downstreamTimeout = 5 * time.Second
+	}
+
+	if p.gracePeriod == 0 {
+		p.gracePeriod = defaultGracePeriod
 	}
 
-	p.injectCode = gomonInjectCode
+	var err error
+	p.injector, err = newInjector(p.injectPosition, gomonInjectCode)
+	if err != nil {
+		return err
+	}
+
+	if p.sseBufferSize <= 0 {
+		p.sseBufferSize = config.DefaultSSEBuffer
+	}
 
 	p.sseServer = sse.New()
 	p.sseServer.AutoReplay = false
+	p.sseServer.BufferSize = p.sseBufferSize
 	p.sseServer.CreateStream("hmr")
+	p.sseServer.CreateStream("status")
 
-	mux := http.NewServeMux()
-	mux.HandleFunc("/__gomon__/reload", p.handleReload)
-	mux.HandleFunc("/__gomon__/events", p.sseServer.ServeHTTP)
+	p.mux = http.NewServeMux()
+	p.mux.HandleFunc("/__gomon__/reload", p.handleReload)
+	p.mux.HandleFunc("/__gomon__/events", p.sseServer.ServeHTTP)
 
 	downstreamURL, err := url.Parse(p.downstreamHost)
 	if err != nil {
 		return fmt.Errorf("downstream host: %v", err)
 	}
 
+	if (p.tlsCert == "") != (p.tlsKey == "") {
+		return errors.New("proxy tls requires both a cert and a key")
+	}
+
+	p.downstreamURL = downstreamURL
+
+	p.rewriter, err = newResponseRewriter(p.rewriteRules, downstreamURL, p.tlsCert != "")
+	if err != nil {
+		return err
+	}
+
 	proxy := httputil.NewSingleHostReverseProxy(downstreamURL)
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	if p.insecureTLS {
+		// allow downstream dev servers which use self-signed certificates
+		transport.TLSClientConfig = &tls.Config{InsecureSkipVerify: true}
+	}
+	proxy.Transport = transport
+	if p.gracePeriod > 0 {
+		proxy.Transport = &retryTransport{
+			next:         transport,
+			gracePeriod:  p.gracePeriod,
+			isRestarting: p.isRestarting,
+		}
+	}
 	proxy.ModifyResponse = p.proxyRequest
+	proxy.ErrorHandler = p.handleProxyError
 
-	mux.HandleFunc("/", proxy.ServeHTTP)
+	p.mux.HandleFunc("/", func(res http.ResponseWriter, req *http.Request) {
+		if isWebsocketUpgrade(req) {
+			p.tunnelWebsocket(res, req)
+			return
+		}
+		if p.injector != nil {
+			acceptSupportedEncodings(req)
+		}
+		proxy.ServeHTTP(res, req)
+	})
 
 	p.httpServer = &http.Server{
 		Addr:    fmt.Sprintf(":%d", p.port),
-		Handler: mux,
+		Handler: p.mux,
 	}
 
 	return nil
 }
 
+// Mount serves the handler under the path prefix instead of forwarding those requests downstream
+func (p *webProxy) Mount(prefix string, handler http.Handler) {
+	p.mux.Handle(prefix+"/", handler)
+}
+
 func (p *webProxy) Start() error {
-	log.Infof("proxy server running on http://localhost:%d", p.port)
-	err := p.httpServer.ListenAndServe()
+	listener, err := net.Listen("tcp", p.httpServer.Addr)
+	if utils.IsAddrInUse(err) {
+		return utils.PortInUseError("proxy.port", p.port)
+	} else if err != nil {
+		return fmt.Errorf("proxy server failed to listen: %w", err)
+	}
+
+	p.isListening.Store(true)
+	defer p.isListening.Store(false)
+
+	if p.tlsCert != "" {
+		log.Infof("proxy server running on https://localhost:%d", p.port)
+		err = p.httpServer.ServeTLS(listener, p.tlsCert, p.tlsKey)
+	} else {
+		log.Infof("proxy server running on http://localhost:%d", p.port)
+		err = p.httpServer.Serve(listener)
+	}
 	if err != nil && !errors.Is(err, http.ErrServerClosed) {
 		panic(fmt.Sprintf("proxy server shut down unexpectedly: %v", err))
 	}
 	return nil
 }
 
+// Health returns an error if the proxy is enabled but isn't accepting connections
+func (p *webProxy) Health() error {
+	if p.isEnabled && !p.isListening.Load() {
+		return errors.New("not listening")
+	}
+	return nil
+}
+
 func (p *webProxy) Close() error {
 	log.Info("closing web proxy")
 	if p.sseServer != nil {
+		p.sseServerLock.Lock()
+		if p.cancelReadiness != nil {
+			p.cancelReadiness()
+		}
+		p.statusLock.Lock()
+		p.stopWaitingUntilRunning()
+		p.statusLock.Unlock()
 		p.sseServer.Close()
+		p.sseServerLock.Unlock()
 	}
 
 	if p.httpServer != nil {
@@ -160,84 +323,209 @@ func (p *webProxy) Notify(n notification.Notification) error {
 
 	switch n.Type {
 	case notification.NotificationTypeHardRestart, notification.NotificationTypeSoftRestart, notification.NotificationTypeIPC:
-		log.Infof("notifying browser: %s", n.Message)
-		p.sseServer.Publish("hmr", &sse.Event{
-			Data: []byte(n.Message),
-		})
+		p.publishReload(n.Message)
 	}
-	return nil
-}
 
-func (p *webProxy) handleReload(res http.ResponseWriter, req *http.Request) {
-	log.Infof("reloading proxy")
-	res.WriteHeader(http.StatusOK)
+	p.updateStatus(n)
+
+	return nil
 }
 
-func (p *webProxy) proxyRequest(res *http.Response) error {
-	isHtml := strings.HasPrefix(res.Header.Get("Content-Type"), "text/html")
-	if !isHtml {
-		return nil
+// publishReload tells browsers to reload, if a readiness check is configured this waits (in the background)
+// for the downstream to respond first. Only the latest reload is published if several arrive while waiting.
+func (p *webProxy) publishReload(message string) {
+	if p.cancelReadiness != nil {
+		p.cancelReadiness()
+		p.cancelReadiness = nil
 	}
 
-	outBuf := bytes.Buffer{}
-	inBuf, err := io.ReadAll(res.Body)
-	if err != nil {
-		log.Errorf("reading request body: %v", err)
-		return err
+	if p.readiness == nil {
+		log.Infof("notifying browser: %s", message)
+		p.sseServer.Publish("hmr", &sse.Event{
+			Data: []byte(message),
+		})
+		return
 	}
 
-	ix := 0
-	match := false
-	for {
-		if ix >= len(inBuf) {
-			break
-		}
+	ctx, cancel := context.WithCancel(context.Background())
+	p.cancelReadiness = cancel
 
-		if inBuf[ix] == '<' {
-			// check if we have a match
-			match = true
-			for jx := 0; jx < len(headTag); jx++ {
-				if ix+jx >= len(inBuf) || inBuf[ix+jx] != headTag[jx] {
-					match = false
-					break
-				}
+	go func() {
+		err := p.readiness.Wait(ctx)
+		if err != nil {
+			if !errors.Is(err, context.Canceled) {
+				log.Warnf("not notifying browser: %v", err)
 			}
+			return
+		}
 
-			if match {
-				cutPos := ix + len(headTag)
-				// we have a match, inject the code
-				_, err = outBuf.Write(inBuf[:cutPos])
-				if err != nil {
-					log.Errorf("writing response: %v", err)
-					return err
-				}
-				_, err = outBuf.Write([]byte(p.injectCode))
-				if err != nil {
-					log.Errorf("writing response: %v", err)
-					return err
-				}
-				_, err = outBuf.Write(inBuf[cutPos:])
-				if err != nil {
-					log.Errorf("writing response: %v", err)
-					return err
-				}
-				break
-			}
+		p.sseServerLock.Lock()
+		defer p.sseServerLock.Unlock()
+
+		if ctx.Err() != nil {
+			return
+		}
+
+		log.Infof("notifying browser: %s", message)
+		p.sseServer.Publish("hmr", &sse.Event{
+			Data: []byte(message),
+		})
+	}()
+}
+
+// updateStatus tracks the lifecycle of the child process so that it can be reported when the downstream is unavailable
+func (p *webProxy) updateStatus(n notification.Notification) {
+	p.statusLock.Lock()
+	defer p.statusLock.Unlock()
+
+	nextState := ""
+	switch n.Type {
+	case notification.NotificationTypeHardRestartRequested:
+		nextState = "restarting"
+		p.stopWaitingUntilRunning()
+	case notification.NotificationTypeOOBTaskStartup:
+		if strings.HasPrefix(n.Message, "building") {
+			nextState = "building"
+		} else {
+			nextState = "running tasks"
 		}
+	case notification.NotificationTypeStartup:
+		nextState = "starting"
+		p.status.Excerpt = nil
+		p.stopWaitingUntilRunning()
+	case notification.NotificationTypeRunning:
+		// the process has been spawned but it isn't running until the downstream responds
+		p.waitUntilRunning()
+	case notification.NotificationTypeShutdown:
+		p.stopWaitingUntilRunning()
+		if strings.HasSuffix(n.Message, "exit code 0") {
+			nextState = "stopped"
+		} else {
+			nextState = "crashed"
+		}
+	case notification.NotificationTypeCrash:
+		// the crash is reported after the shutdown so just add the detail
+		p.status.Message = n.Message
+	case notification.NotificationTypeStdErr, notification.NotificationTypeOOBTaskStdErr, notification.NotificationTypeBuildError:
+		p.status.Excerpt = append(p.status.Excerpt, strings.Split(strings.TrimSpace(utils.StripANSI(n.Message)), "\n")...)
+		if len(p.status.Excerpt) > maxErrorExcerptLines {
+			p.status.Excerpt = p.status.Excerpt[len(p.status.Excerpt)-maxErrorExcerptLines:]
+		}
+	}
 
-		ix++
+	if nextState == "" || nextState == p.status.State {
+		return
 	}
 
-	if !match {
-		_, err = outBuf.Write(inBuf)
+	p.status.State = nextState
+	p.status.Since = n.Date
+	p.status.Message = n.Message
+
+	p.sseServer.Publish("status", &sse.Event{
+		Data: []byte(nextState),
+	})
+}
+
+// waitUntilRunning marks the child process as running once the readiness check passes, if there is one. Otherwise
+// the first response proxied from the downstream does. Must be called with statusLock held.
+func (p *webProxy) waitUntilRunning() {
+	p.stopWaitingUntilRunning()
+	if p.readiness == nil {
+		return
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	p.cancelRunning = cancel
+	go func() {
+		err := p.readiness.Wait(ctx)
 		if err != nil {
-			log.Errorf("writing response: %v", err)
-			return err
+			return
 		}
+		p.markRunning(ctx)
+	}()
+}
+
+// stopWaitingUntilRunning must be called with statusLock held
+func (p *webProxy) stopWaitingUntilRunning() {
+	if p.cancelRunning != nil {
+		p.cancelRunning()
+		p.cancelRunning = nil
+	}
+}
+
+// markRunning moves a child process which is starting to running, ctx is cancelled if the run has ended since
+func (p *webProxy) markRunning(ctx context.Context) {
+	p.statusLock.Lock()
+	defer p.statusLock.Unlock()
+
+	if ctx.Err() != nil || p.status.State != "starting" {
+		return
+	}
+
+	p.status.State = "running"
+	p.status.Since = time.Now()
+	p.status.Message = ""
+
+	p.sseServer.Publish("status", &sse.Event{
+		Data: []byte(p.status.State),
+	})
+}
+
+// isRestarting is true while the child process is on its way (back) up
+func (p *webProxy) isRestarting() bool {
+	p.statusLock.Lock()
+	defer p.statusLock.Unlock()
+
+	switch p.status.State {
+	case "waiting", "restarting", "building", "running tasks", "starting":
+		return true
 	}
+	return false
+}
+
+func (p *webProxy) handleProxyError(res http.ResponseWriter, req *http.Request, proxyErr error) {
+	log.Warnf("proxying request: %v", proxyErr)
+
+	p.statusLock.Lock()
+	status := p.status
+	status.Excerpt = append([]string{}, p.status.Excerpt...)
+	p.statusLock.Unlock()
 
-	res.Body = io.NopCloser(&outBuf)
-	res.Header["Content-Length"] = []string{fmt.Sprint(outBuf.Len())}
+	status.Downstream = p.downstreamHost
+	status.Error = proxyErr.Error()
+	// keep retrying while the process is on its way up, otherwise wait for the status to change
+	status.Retry = status.State == "starting" || status.State == "waiting"
 
+	res.Header().Set("Content-Type", "text/html; charset=utf-8")
+	res.Header().Set("Cache-Control", "no-store")
+	res.WriteHeader(http.StatusBadGateway)
+	err := statusPageTemplate.Execute(res, status)
+	if err != nil {
+		log.Errorf("rendering status page: %v", err)
+	}
+}
+
+func (p *webProxy) handleReload(res http.ResponseWriter, req *http.Request) {
+	log.Infof("reloading proxy")
+	res.WriteHeader(http.StatusOK)
+}
+
+func (p *webProxy) proxyRequest(res *http.Response) error {
+	if res.StatusCode < http.StatusInternalServerError {
+		p.markRunning(context.Background())
+	}
+
+	p.rewriter.Rewrite(res)
+
+	isHtml := strings.HasPrefix(res.Header.Get("Content-Type"), "text/html")
+	if !isHtml || p.injector == nil {
+		return nil
+	}
+
+	err := p.injector.injectResponse(res)
+	if err != nil {
+		log.Errorf("injecting reload script: %v", err)
+		return err
+	}
 	return nil
 }
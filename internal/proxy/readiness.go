@@ -0,0 +1,144 @@
+package proxy
+
+// gomon is a simple command line tool that watches your files and automatically restarts the application when it detects any changes in the working directory.
+// Copyright (C) 2023 John Dudmesh
+
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+
+// You should have received a copy of the GNU General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>.
+
+import (
+	"context"
+	"crypto/tls"
+	"fmt"
+	"net"
+	"net/http"
+	"net/url"
+	"time"
+
+	"github.com/jdudmesh/gomon/internal/config"
+)
+
+const defaultReadinessInterval = 250 * time.Millisecond
+const defaultReadinessRetries = 120
+
+// readinessProbe checks that the downstream is actually serving requests so that browsers aren't reloaded
+// into a connection error while the child process is still starting up
+type readinessProbe struct {
+	check    string
+	target   *url.URL
+	interval time.Duration
+	retries  int
+	client   *http.Client
+}
+
+func newReadinessProbe(cfg config.Config, downstreamURL *url.URL, insecureTLS bool) (*readinessProbe, error) {
+	readiness := cfg.Proxy.Readiness
+	if readiness.Check == "" {
+		return nil, nil
+	}
+
+	probe := &readinessProbe{
+		check:    readiness.Check,
+		target:   downstreamURL,
+		interval: time.Duration(readiness.Interval) * time.Millisecond,
+		retries:  readiness.Retries,
+	}
+
+	if probe.interval <= 0 {
+		probe.interval = defaultReadinessInterval
+	}
+
+	if probe.retries <= 0 {
+		probe.retries = defaultReadinessRetries
+	}
+
+	switch probe.check {
+	case config.ReadinessTCP:
+	case config.ReadinessHTTP:
+		probe.target = downstreamURL.JoinPath(readiness.Path)
+		transport := http.DefaultTransport.(*http.Transport).Clone()
+		if insecureTLS {
+			transport.TLSClientConfig = &tls.Config{InsecureSkipVerify: true}
+		}
+		probe.client = &http.Client{
+			Transport: transport,
+			Timeout:   probe.interval * 4,
+			// a redirect is a response, there's no need to follow it
+			CheckRedirect: func(req *http.Request, via []*http.Request) error {
+				return http.ErrUseLastResponse
+			},
+		}
+	default:
+		return nil, fmt.Errorf("unsupported readiness check: %s", probe.check)
+	}
+
+	return probe, nil
+}
+
+// Wait blocks until the downstream is ready, the retries are exhausted or the context is cancelled
+func (r *readinessProbe) Wait(ctx context.Context) error {
+	var err error
+	for i := 0; i < r.retries; i++ {
+		err = r.probe(ctx)
+		if err == nil {
+			return nil
+		}
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(r.interval):
+		}
+	}
+
+	return fmt.Errorf("downstream not ready after %d attempts: %w", r.retries, err)
+}
+
+func (r *readinessProbe) probe(ctx context.Context) error {
+	if r.check == config.ReadinessTCP {
+		dialer := net.Dialer{Timeout: r.interval * 4}
+		conn, err := dialer.DialContext(ctx, "tcp", hostPort(r.target))
+		if err != nil {
+			return err
+		}
+		return conn.Close()
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, r.target.String(), nil)
+	if err != nil {
+		return err
+	}
+
+	res, err := r.client.Do(req)
+	if err != nil {
+		return err
+	}
+	res.Body.Close()
+
+	// the server is up but may still be initialising e.g. waiting for a database connection
+	if res.StatusCode >= http.StatusInternalServerError {
+		return fmt.Errorf("downstream returned status %d", res.StatusCode)
+	}
+
+	return nil
+}
+
+func hostPort(u *url.URL) string {
+	if u.Port() != "" {
+		return u.Host
+	}
+	if u.Scheme == "https" {
+		return net.JoinHostPort(u.Hostname(), "443")
+	}
+	return net.JoinHostPort(u.Hostname(), "80")
+}
@@ -0,0 +1,100 @@
+package proxy
+
+// gomon is a simple command line tool that watches your files and automatically restarts the application when it detects any changes in the working directory.
+// Copyright (C) 2023 John Dudmesh
+
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+
+// You should have received a copy of the GNU General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>.
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"sync/atomic"
+	"testing"
+
+	"github.com/jdudmesh/gomon/internal/config"
+)
+
+func TestReadinessProbeHTTP(t *testing.T) {
+	var requests atomic.Int32
+	downstream := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/healthz" {
+			t.Errorf("unexpected path: %s", r.URL.Path)
+		}
+		// fail the first couple of checks as if the server were still initialising
+		if requests.Add(1) < 3 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer downstream.Close()
+
+	cfg := config.Config{}
+	cfg.Proxy.Readiness.Check = config.ReadinessHTTP
+	cfg.Proxy.Readiness.Path = "/healthz"
+	cfg.Proxy.Readiness.Interval = 10
+
+	downstreamURL, _ := url.Parse(downstream.URL)
+	probe, err := newReadinessProbe(cfg, downstreamURL, false)
+	if err != nil {
+		t.Fatalf("creating probe: %v", err)
+	}
+
+	err = probe.Wait(context.Background())
+	if err != nil {
+		t.Fatalf("waiting for downstream: %v", err)
+	}
+
+	if requests.Load() != 3 {
+		t.Errorf("expected 3 requests, got %d", requests.Load())
+	}
+}
+
+func TestReadinessProbeTCP(t *testing.T) {
+	downstream := httptest.NewServer(http.NotFoundHandler())
+	downstreamURL, _ := url.Parse(downstream.URL)
+
+	cfg := config.Config{}
+	cfg.Proxy.Readiness.Check = config.ReadinessTCP
+	cfg.Proxy.Readiness.Interval = 10
+	cfg.Proxy.Readiness.Retries = 3
+
+	probe, err := newReadinessProbe(cfg, downstreamURL, false)
+	if err != nil {
+		t.Fatalf("creating probe: %v", err)
+	}
+
+	err = probe.Wait(context.Background())
+	if err != nil {
+		t.Fatalf("waiting for downstream: %v", err)
+	}
+
+	downstream.Close()
+	err = probe.Wait(context.Background())
+	if err == nil {
+		t.Error("expected an error once the downstream has closed")
+	}
+}
+
+func TestReadinessProbeUnsupported(t *testing.T) {
+	cfg := config.Config{}
+	cfg.Proxy.Readiness.Check = "udp"
+
+	_, err := newReadinessProbe(cfg, &url.URL{Scheme: "http", Host: "localhost:8080"}, false)
+	if err == nil {
+		t.Error("expected an error for an unsupported check")
+	}
+}
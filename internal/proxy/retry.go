@@ -0,0 +1,104 @@
+package proxy
+
+// gomon is a simple command line tool that watches your files and automatically restarts the application when it detects any changes in the working directory.
+// Copyright (C) 2023 John Dudmesh
+
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+
+// You should have received a copy of the GNU General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>.
+
+import (
+	"bytes"
+	"errors"
+	"io"
+	"net"
+	"net/http"
+	"syscall"
+	"time"
+)
+
+const defaultGracePeriod = 10 * time.Second
+const retryInterval = 100 * time.Millisecond
+
+// maxReplayBodySize is the largest request body which is buffered so that the request can be retried
+const maxReplayBodySize = 1 << 20
+
+// retryTransport holds requests while the downstream is unavailable during a restart, retrying them until the
+// grace period expires so that a browser refresh during a rebuild waits rather than failing
+type retryTransport struct {
+	next         http.RoundTripper
+	gracePeriod  time.Duration
+	isRestarting func() bool
+}
+
+func (t *retryTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	replayable, err := bufferBody(req)
+	if err != nil {
+		return nil, err
+	}
+
+	deadline := time.Now().Add(t.gracePeriod)
+	for {
+		res, err := t.next.RoundTrip(req)
+		if err == nil || !replayable || !isConnectionRefused(err) || !t.isRestarting() || time.Now().After(deadline) {
+			return res, err
+		}
+
+		select {
+		case <-req.Context().Done():
+			return nil, req.Context().Err()
+		case <-time.After(retryInterval):
+		}
+
+		if req.GetBody != nil {
+			req.Body, err = req.GetBody()
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+}
+
+// bufferBody reads small request bodies into memory so that they can be sent again, it returns false if the
+// body is too large to replay
+func bufferBody(req *http.Request) (bool, error) {
+	if req.Body == nil || req.Body == http.NoBody {
+		return true, nil
+	}
+
+	if req.ContentLength < 0 || req.ContentLength > maxReplayBodySize {
+		return false, nil
+	}
+
+	body, err := io.ReadAll(req.Body)
+	req.Body.Close()
+	if err != nil {
+		return false, err
+	}
+
+	req.GetBody = func() (io.ReadCloser, error) {
+		return io.NopCloser(bytes.NewReader(body)), nil
+	}
+	req.Body, _ = req.GetBody()
+
+	return true, nil
+}
+
+// isConnectionRefused is true if the request failed before anything was sent so it is always safe to retry
+func isConnectionRefused(err error) bool {
+	if errors.Is(err, syscall.ECONNREFUSED) {
+		return true
+	}
+
+	opErr := &net.OpError{}
+	return errors.As(err, &opErr) && opErr.Op == "dial"
+}
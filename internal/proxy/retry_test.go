@@ -0,0 +1,91 @@
+package proxy
+
+// gomon is a simple command line tool that watches your files and automatically restarts the application when it detects any changes in the working directory.
+// Copyright (C) 2023 John Dudmesh
+
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+
+// You should have received a copy of the GNU General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>.
+
+import (
+	"io"
+	"net"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestRetryTransportWaitsForDownstream(t *testing.T) {
+	// reserve a port then release it so that the first attempts are refused
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("listening: %v", err)
+	}
+	addr := listener.Addr().String()
+	listener.Close()
+
+	go func() {
+		time.Sleep(300 * time.Millisecond)
+		listener, err := net.Listen("tcp", addr)
+		if err != nil {
+			t.Errorf("listening: %v", err)
+			return
+		}
+		http.Serve(listener, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			io.Copy(w, r.Body)
+		}))
+	}()
+
+	transport := &retryTransport{
+		next:         http.DefaultTransport,
+		gracePeriod:  5 * time.Second,
+		isRestarting: func() bool { return true },
+	}
+
+	req, _ := http.NewRequest(http.MethodPost, "http://"+addr+"/", strings.NewReader("hello"))
+	res, err := transport.RoundTrip(req)
+	if err != nil {
+		t.Fatalf("expected the request to be retried: %v", err)
+	}
+	defer res.Body.Close()
+
+	body, _ := io.ReadAll(res.Body)
+	if string(body) != "hello" {
+		t.Errorf("expected the body to be replayed, got %q", body)
+	}
+}
+
+func TestRetryTransportFailsFastWhenNotRestarting(t *testing.T) {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("listening: %v", err)
+	}
+	addr := listener.Addr().String()
+	listener.Close()
+
+	transport := &retryTransport{
+		next:         http.DefaultTransport,
+		gracePeriod:  5 * time.Second,
+		isRestarting: func() bool { return false },
+	}
+
+	startedAt := time.Now()
+	req, _ := http.NewRequest(http.MethodGet, "http://"+addr+"/", nil)
+	_, err = transport.RoundTrip(req)
+	if err == nil {
+		t.Fatal("expected an error")
+	}
+	if time.Since(startedAt) > time.Second {
+		t.Error("expected the request to fail without waiting")
+	}
+}
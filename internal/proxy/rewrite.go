@@ -0,0 +1,202 @@
+package proxy
+
+// gomon is a simple command line tool that watches your files and automatically restarts the application when it detects any changes in the working directory.
+// Copyright (C) 2023 John Dudmesh
+
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+
+// You should have received a copy of the GNU General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>.
+
+import (
+	"fmt"
+	"net"
+	"net/http"
+	"net/url"
+	"sort"
+	"strings"
+
+	"github.com/jdudmesh/gomon/internal/config"
+)
+
+// responseRewriter makes redirects and cookies issued by the downstream refer to the proxy so that sessions
+// survive being proxied e.g. an absolute redirect to http://localhost:8080/login is sent to the proxy instead
+type responseRewriter struct {
+	rules      []config.RewriteRule
+	downstream *url.URL
+	isTLS      bool
+}
+
+func newResponseRewriter(rules []config.RewriteRule, downstream *url.URL, isTLS bool) (*responseRewriter, error) {
+	sorted := make([]config.RewriteRule, len(rules))
+	copy(sorted, rules)
+
+	for i, rule := range sorted {
+		switch rule.CookieSecure {
+		case "":
+			sorted[i].CookieSecure = config.CookieSecureKeep
+		case config.CookieSecureKeep, config.CookieSecureAuto, config.CookieSecureStrip, config.CookieSecureForce:
+		default:
+			return nil, fmt.Errorf("rewrite rule %s: unknown cookieSecure setting: %s", rule.Path, rule.CookieSecure)
+		}
+		if sorted[i].Path == "" {
+			sorted[i].Path = "/"
+		}
+	}
+
+	// the most specific rule is checked first
+	sort.SliceStable(sorted, func(i, j int) bool {
+		return len(sorted[i].Path) > len(sorted[j].Path)
+	})
+
+	return &responseRewriter{
+		rules:      sorted,
+		downstream: downstream,
+		isTLS:      isTLS,
+	}, nil
+}
+
+func (r *responseRewriter) rule(path string) (config.RewriteRule, bool) {
+	for _, rule := range r.rules {
+		if strings.HasPrefix(path, rule.Path) {
+			return rule, true
+		}
+	}
+	return config.RewriteRule{}, false
+}
+
+func (r *responseRewriter) Rewrite(res *http.Response) {
+	if r == nil || res.Request == nil {
+		return
+	}
+
+	rule, ok := r.rule(res.Request.URL.Path)
+	if !ok {
+		return
+	}
+
+	if rule.Location {
+		r.rewriteLocation(res)
+	}
+	r.rewriteCookies(res, rule)
+}
+
+func (r *responseRewriter) rewriteLocation(res *http.Response) {
+	location, err := url.Parse(res.Header.Get("Location"))
+	if err != nil || !location.IsAbs() || !r.isDownstream(location) {
+		return
+	}
+
+	// the reverse proxy leaves the Host header as sent by the browser
+	if res.Request.Host == "" {
+		return
+	}
+
+	location.Scheme = "http"
+	if r.isTLS {
+		location.Scheme = "https"
+	}
+	location.Host = res.Request.Host
+	res.Header.Set("Location", location.String())
+}
+
+func (r *responseRewriter) rewriteCookies(res *http.Response, rule config.RewriteRule) {
+	headers := res.Header.Values("Set-Cookie")
+	if len(headers) == 0 {
+		return
+	}
+
+	rewritten := make([]string, 0, len(headers))
+	for _, header := range headers {
+		cookie := parseSetCookie(header)
+		if cookie == nil {
+			rewritten = append(rewritten, header)
+			continue
+		}
+
+		changed := false
+		if rule.Cookies && cookie.Domain != "" && r.isDownstreamHostname(strings.TrimPrefix(cookie.Domain, ".")) {
+			cookie.Domain = rule.CookieDomain
+			changed = true
+		}
+
+		secure := cookie.Secure
+		switch rule.CookieSecure {
+		case config.CookieSecureAuto:
+			secure = cookie.Secure && r.isTLS
+		case config.CookieSecureStrip:
+			secure = false
+		case config.CookieSecureForce:
+			secure = true
+		}
+		if secure != cookie.Secure {
+			cookie.Secure = secure
+			// browsers reject SameSite=None cookies which aren't secure
+			if !secure && cookie.SameSite == http.SameSiteNoneMode {
+				cookie.SameSite = http.SameSiteLaxMode
+			}
+			changed = true
+		}
+
+		if !changed {
+			rewritten = append(rewritten, header)
+			continue
+		}
+		rewritten = append(rewritten, cookie.String())
+	}
+
+	res.Header.Del("Set-Cookie")
+	for _, header := range rewritten {
+		res.Header.Add("Set-Cookie", header)
+	}
+}
+
+// isDownstream returns true if the URL refers to the downstream server, loopback addresses are treated
+// as equivalent so that a redirect to 127.0.0.1 is matched when the downstream is localhost
+func (r *responseRewriter) isDownstream(u *url.URL) bool {
+	return r.isDownstreamHostname(u.Hostname()) && portOrDefault(u) == portOrDefault(r.downstream)
+}
+
+func (r *responseRewriter) isDownstreamHostname(name string) bool {
+	downstream := r.downstream.Hostname()
+	if strings.EqualFold(name, downstream) {
+		return true
+	}
+	return isLoopback(name) && isLoopback(downstream)
+}
+
+func portOrDefault(u *url.URL) string {
+	if port := u.Port(); port != "" {
+		return port
+	}
+	if u.Scheme == "https" {
+		return "443"
+	}
+	return "80"
+}
+
+// parseSetCookie parses a single Set-Cookie header value, returning nil if it is invalid
+func parseSetCookie(header string) *http.Cookie {
+	res := http.Response{Header: http.Header{"Set-Cookie": {header}}}
+	cookies := res.Cookies()
+	if len(cookies) == 0 {
+		return nil
+	}
+	return cookies[0]
+}
+
+func isLoopback(name string) bool {
+	if strings.EqualFold(name, "localhost") {
+		return true
+	}
+	ip := net.ParseIP(name)
+	return ip != nil && ip.IsLoopback()
+}
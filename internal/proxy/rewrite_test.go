@@ -0,0 +1,88 @@
+package proxy
+
+// gomon is a simple command line tool that watches your files and automatically restarts the application when it detects any changes in the working directory.
+// Copyright (C) 2023 John Dudmesh
+
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+
+// You should have received a copy of the GNU General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>.
+
+import (
+	"net/http"
+	"net/url"
+	"testing"
+
+	"github.com/jdudmesh/gomon/internal/config"
+)
+
+func newRewriteResponse(path string, header http.Header) *http.Response {
+	req, _ := http.NewRequest(http.MethodGet, "http://localhost:8080"+path, nil)
+	req.Host = "localhost:4000"
+	return &http.Response{Request: req, Header: header}
+}
+
+func TestRewriteLocation(t *testing.T) {
+	downstream, _ := url.Parse("http://localhost:8080")
+	rewriter, err := newResponseRewriter([]config.RewriteRule{{Path: "/", Location: true}}, downstream, false)
+	if err != nil {
+		t.Fatalf("creating rewriter: %v", err)
+	}
+
+	cases := map[string]string{
+		"http://localhost:8080/login?next=%2F": "http://localhost:4000/login?next=%2F",
+		"http://127.0.0.1:8080/login":          "http://localhost:4000/login",
+		"https://example.com/login":            "https://example.com/login",
+		"http://localhost:9090/login":          "http://localhost:9090/login",
+		"/login":                               "/login",
+	}
+
+	for location, expected := range cases {
+		res := newRewriteResponse("/", http.Header{"Location": {location}})
+		rewriter.Rewrite(res)
+		if actual := res.Header.Get("Location"); actual != expected {
+			t.Errorf("rewriting %s: expected %s, got %s", location, expected, actual)
+		}
+	}
+}
+
+func TestRewriteCookies(t *testing.T) {
+	downstream, _ := url.Parse("http://localhost:8080")
+	rules := []config.RewriteRule{
+		{Path: "/", Cookies: true},
+		{Path: "/auth", Cookies: true, CookieSecure: config.CookieSecureAuto},
+	}
+	rewriter, err := newResponseRewriter(rules, downstream, false)
+	if err != nil {
+		t.Fatalf("creating rewriter: %v", err)
+	}
+
+	res := newRewriteResponse("/", http.Header{"Set-Cookie": {"a=1; Domain=localhost; Path=/", "b=2; Domain=example.com"}})
+	rewriter.Rewrite(res)
+	cookies := res.Header.Values("Set-Cookie")
+	if len(cookies) != 2 || cookies[0] != "a=1; Path=/" || cookies[1] != "b=2; Domain=example.com" {
+		t.Errorf("unexpected cookies: %v", cookies)
+	}
+
+	res = newRewriteResponse("/auth/callback", http.Header{"Set-Cookie": {"session=x; Path=/; Secure; SameSite=None"}})
+	rewriter.Rewrite(res)
+	if actual := res.Header.Get("Set-Cookie"); actual != "session=x; Path=/; SameSite=Lax" {
+		t.Errorf("expected the secure attribute to be removed, got %s", actual)
+	}
+}
+
+func TestRewriteRuleValidation(t *testing.T) {
+	downstream, _ := url.Parse("http://localhost:8080")
+	_, err := newResponseRewriter([]config.RewriteRule{{CookieSecure: "sometimes"}}, downstream, false)
+	if err == nil {
+		t.Error("expected an error for an unknown cookieSecure setting")
+	}
+}
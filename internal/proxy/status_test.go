@@ -0,0 +1,97 @@
+package proxy
+
+// gomon is a simple command line tool that watches your files and automatically restarts the application when it detects any changes in the working directory.
+// Copyright (C) 2023 John Dudmesh
+
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+
+// You should have received a copy of the GNU General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>.
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/jdudmesh/gomon/internal/config"
+	"github.com/jdudmesh/gomon/internal/notification"
+)
+
+func proxyState(p *webProxy) string {
+	p.statusLock.Lock()
+	defer p.statusLock.Unlock()
+	return p.status.State
+}
+
+func TestRunningAfterFirstResponse(t *testing.T) {
+	downstream := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+	defer downstream.Close()
+
+	cfg := config.Config{}
+	cfg.Proxy.Enabled = true
+	cfg.Proxy.Downstream.Host = downstream.URL
+
+	p, err := New(cfg)
+	if err != nil {
+		t.Fatalf("creating proxy: %v", err)
+	}
+	defer p.sseServer.Close()
+
+	p.Notify(notification.Notification{Type: notification.NotificationTypeStartup, Date: time.Now()})
+	p.Notify(notification.Notification{Type: notification.NotificationTypeRunning, Date: time.Now()})
+	if !p.isRestarting() {
+		t.Fatalf("expected the child process to be starting, got %s", proxyState(p))
+	}
+
+	srv := httptest.NewServer(p.mux)
+	defer srv.Close()
+	res, err := http.Get(srv.URL)
+	if err != nil {
+		t.Fatalf("requesting page: %v", err)
+	}
+	res.Body.Close()
+
+	if state := proxyState(p); state != "running" || p.isRestarting() {
+		t.Errorf("expected the child process to be running after a response, got %s", state)
+	}
+}
+
+func TestRunningAfterReadinessCheck(t *testing.T) {
+	downstream := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+	defer downstream.Close()
+
+	cfg := config.Config{}
+	cfg.Proxy.Enabled = true
+	cfg.Proxy.Downstream.Host = downstream.URL
+	cfg.Proxy.Readiness.Check = config.ReadinessHTTP
+	cfg.Proxy.Readiness.Interval = 10
+
+	p, err := New(cfg)
+	if err != nil {
+		t.Fatalf("creating proxy: %v", err)
+	}
+	defer p.sseServer.Close()
+
+	p.Notify(notification.Notification{Type: notification.NotificationTypeStartup, Date: time.Now()})
+	p.Notify(notification.Notification{Type: notification.NotificationTypeRunning, Date: time.Now()})
+
+	deadline := time.Now().Add(5 * time.Second)
+	for proxyState(p) != "running" {
+		if time.Now().After(deadline) {
+			t.Fatalf("expected the child process to be running after the readiness check, got %s", proxyState(p))
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+	if p.isRestarting() {
+		t.Error("expected a running child process not to be restarting")
+	}
+}
@@ -0,0 +1,128 @@
+package proxy
+
+// gomon is a simple command line tool that watches your files and automatically restarts the application when it detects any changes in the working directory.
+// Copyright (C) 2023 John Dudmesh
+
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+
+// You should have received a copy of the GNU General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>.
+
+import (
+	"crypto/tls"
+	"io"
+	"net"
+	"net/http"
+	"strings"
+	"sync"
+)
+
+func isWebsocketUpgrade(req *http.Request) bool {
+	if !strings.EqualFold(req.Header.Get("Upgrade"), "websocket") {
+		return false
+	}
+	for _, value := range req.Header.Values("Connection") {
+		for _, token := range strings.Split(value, ",") {
+			if strings.EqualFold(strings.TrimSpace(token), "upgrade") {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+func (p *webProxy) dialDownstream() (net.Conn, error) {
+	host := p.downstreamURL.Host
+	dialer := &net.Dialer{Timeout: p.downstreamTimeout}
+
+	if p.downstreamURL.Scheme == "https" {
+		if p.downstreamURL.Port() == "" {
+			host = net.JoinHostPort(host, "443")
+		}
+		return tls.DialWithDialer(dialer, "tcp", host, &tls.Config{InsecureSkipVerify: p.insecureTLS})
+	}
+
+	if p.downstreamURL.Port() == "" {
+		host = net.JoinHostPort(host, "80")
+	}
+	return dialer.Dial("tcp", host)
+}
+
+// tunnelWebsocket forwards the upgrade request downstream and then copies data in both directions
+// until either side closes the connection
+func (p *webProxy) tunnelWebsocket(res http.ResponseWriter, req *http.Request) {
+	downstreamConn, err := p.dialDownstream()
+	if err != nil {
+		p.handleProxyError(res, req, err)
+		return
+	}
+	defer downstreamConn.Close()
+
+	hijacker, ok := res.(http.Hijacker)
+	if !ok {
+		log.Error("proxying websocket: connection does not support hijacking")
+		res.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+
+	clientConn, clientBuf, err := hijacker.Hijack()
+	if err != nil {
+		log.Errorf("proxying websocket: hijacking connection: %v", err)
+		return
+	}
+	defer clientConn.Close()
+
+	outReq := req.Clone(req.Context())
+	outReq.URL.Scheme = p.downstreamURL.Scheme
+	outReq.URL.Host = p.downstreamURL.Host
+	outReq.RequestURI = ""
+
+	err = outReq.Write(downstreamConn)
+	if err != nil {
+		log.Errorf("proxying websocket: writing upgrade request: %v", err)
+		return
+	}
+
+	// the client may already have sent frames which were buffered while reading the request
+	if buffered := clientBuf.Reader.Buffered(); buffered > 0 {
+		_, err = io.CopyN(downstreamConn, clientBuf, int64(buffered))
+		if err != nil {
+			log.Errorf("proxying websocket: flushing buffered data: %v", err)
+			return
+		}
+	}
+
+	wg := sync.WaitGroup{}
+	wg.Add(2)
+	go func() {
+		defer wg.Done()
+		io.Copy(downstreamConn, clientConn)
+		closeWrite(downstreamConn)
+	}()
+	go func() {
+		defer wg.Done()
+		io.Copy(clientConn, downstreamConn)
+		closeWrite(clientConn)
+	}()
+	wg.Wait()
+}
+
+// closeWrite half closes the connection so that the other side sees EOF
+func closeWrite(conn net.Conn) {
+	switch c := conn.(type) {
+	case *net.TCPConn:
+		c.CloseWrite()
+	case *tls.Conn:
+		c.CloseWrite()
+	default:
+		c.Close()
+	}
+}
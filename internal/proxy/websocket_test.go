@@ -0,0 +1,71 @@
+package proxy
+
+// gomon is a simple command line tool that watches your files and automatically restarts the application when it detects any changes in the working directory.
+// Copyright (C) 2023 John Dudmesh
+
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+
+// You should have received a copy of the GNU General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>.
+
+import (
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/jdudmesh/gomon/internal/config"
+	"golang.org/x/net/websocket"
+)
+
+func TestWebsocketPassThrough(t *testing.T) {
+	downstream := httptest.NewServer(websocket.Handler(func(conn *websocket.Conn) {
+		var msg string
+		for websocket.Message.Receive(conn, &msg) == nil {
+			websocket.Message.Send(conn, "echo: "+msg)
+		}
+	}))
+	defer downstream.Close()
+
+	cfg := config.Config{}
+	cfg.Proxy.Enabled = true
+	cfg.Proxy.Downstream.Host = downstream.URL
+
+	p, err := New(cfg)
+	if err != nil {
+		t.Fatalf("creating proxy: %v", err)
+	}
+	defer p.sseServer.Close()
+
+	srv := httptest.NewServer(p.mux)
+	defer srv.Close()
+
+	wsURL := "ws" + strings.TrimPrefix(srv.URL, "http") + "/chat"
+	conn, err := websocket.Dial(wsURL, "", srv.URL)
+	if err != nil {
+		t.Fatalf("dialing proxy: %v", err)
+	}
+	defer conn.Close()
+
+	err = websocket.Message.Send(conn, "hello")
+	if err != nil {
+		t.Fatalf("sending message: %v", err)
+	}
+
+	var reply string
+	err = websocket.Message.Receive(conn, &reply)
+	if err != nil {
+		t.Fatalf("receiving message: %v", err)
+	}
+
+	if reply != "echo: hello" {
+		t.Errorf("unexpected reply: %s", reply)
+	}
+}
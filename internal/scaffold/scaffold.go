@@ -0,0 +1,263 @@
+package scaffold
+
+// gomon is a simple command line tool that watches your files and automatically restarts the application when it detects any changes in the working directory.
+// Copyright (C) 2023 John Dudmesh
+
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+
+// You should have received a copy of the GNU General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>.
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"go/parser"
+	"go/token"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"text/template"
+
+	"github.com/jdudmesh/gomon/internal/config"
+	"github.com/sirupsen/logrus"
+)
+
+var log = logrus.WithField("component", "scaffold")
+
+var skipDirs = map[string]bool{
+	".git":         true,
+	".gomon":       true,
+	".idea":        true,
+	".vscode":      true,
+	"vendor":       true,
+	"node_modules": true,
+}
+
+var envFileCandidates = []string{".env", ".env.local", ".env.development", ".env.development.local"}
+
+var sqlcConfigFiles = []string{"sqlc.yaml", "sqlc.yml", "sqlc.json"}
+
+// Project holds what was discovered about the module in the root directory
+type Project struct {
+	Entrypoint   string
+	MainPackages []string
+	EnvFiles     []string
+	ExcludePaths []string
+	SoftReload   []string
+	Generated    map[string][]string
+	ProxyEnabled bool
+	// Downstream is the address the child process listens on, requests to the proxy are forwarded to it
+	Downstream string
+	UIEnabled  bool
+}
+
+var configTemplate = template.Must(template.New("config").Parse(`# generated by gomon, see https://github.com/jdudmesh/gomon for all options
+entrypoint: {{ .Entrypoint }}
+{{- if gt (len .MainPackages) 1 }}
+# other main packages found:
+{{- range .MainPackages }}
+#   {{ . }}
+{{- end }}
+{{- end }}
+entrypointArgs: []
+
+excludePaths: [{{ range $i, $p := .ExcludePaths }}{{ if $i }}, {{ end }}"{{ $p }}"{{ end }}]
+
+hardReload:
+  - "*.go"
+  - "go.mod"
+  - "go.sum"
+{{ if .SoftReload }}
+softReload:
+{{- range .SoftReload }}
+  - "{{ . }}"
+{{- end }}
+{{ end }}
+{{- if .Generated }}
+generated:
+{{- range $patt, $tasks := .Generated }}
+  "{{ $patt }}":
+{{- range $tasks }}
+    - "{{ . }}"
+{{- end }}
+{{- end }}
+{{ end }}
+{{- if .EnvFiles }}
+envFiles:
+{{- range .EnvFiles }}
+  - "{{ . }}"
+{{- end }}
+{{ end }}
+proxy:
+  enabled: {{ .ProxyEnabled }}
+  port: 4000
+  downstream:
+    host: {{ .Downstream }}
+    timeout: 5
+
+ui:
+  enabled: {{ .UIEnabled }}
+  port: 4001
+`))
+
+// Inspect walks the root directory looking for main packages, generators and env files
+func Inspect(rootDirectory string) (*Project, error) {
+	proj := &Project{
+		ExcludePaths: []string{"vendor"},
+		Generated:    map[string][]string{},
+		Downstream:   "localhost:8080",
+		UIEnabled:    true,
+	}
+
+	softReload := map[string]bool{}
+	mainPackages := map[string]bool{}
+	fset := token.NewFileSet()
+
+	err := filepath.WalkDir(rootDirectory, func(srcPath string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+
+		relPath, _ := filepath.Rel(rootDirectory, srcPath)
+		if d.IsDir() {
+			if skipDirs[d.Name()] && relPath != "." {
+				if d.Name() == "node_modules" {
+					proj.ExcludePaths = append(proj.ExcludePaths, relPath)
+				}
+				return filepath.SkipDir
+			}
+			return nil
+		}
+
+		switch ext := filepath.Ext(d.Name()); ext {
+		case ".go":
+			if strings.HasSuffix(d.Name(), "_test.go") {
+				return nil
+			}
+			f, err := parser.ParseFile(fset, srcPath, nil, parser.PackageClauseOnly)
+			if err != nil {
+				log.Warnf("parsing %s: %v", relPath, err)
+				return nil
+			}
+			if f.Name.Name == "main" {
+				mainPackages["./"+filepath.ToSlash(filepath.Dir(relPath))] = true
+			}
+		case ".templ":
+			proj.Generated["*.templ"] = []string{"templ generate"}
+		case ".html", ".tmpl", ".css", ".js":
+			softReload["*"+ext] = true
+		}
+
+		for _, name := range sqlcConfigFiles {
+			if d.Name() == name {
+				proj.Generated["*.sql"] = []string{"sqlc generate -f " + filepath.ToSlash(relPath)}
+			}
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("inspecting project: %w", err)
+	}
+
+	for pkg := range mainPackages {
+		proj.MainPackages = append(proj.MainPackages, strings.TrimSuffix(pkg, "/."))
+	}
+	sort.Slice(proj.MainPackages, func(i, j int) bool {
+		// prefer the root package, then packages under cmd, then the shallowest
+		a, b := proj.MainPackages[i], proj.MainPackages[j]
+		if rankPackage(a) != rankPackage(b) {
+			return rankPackage(a) < rankPackage(b)
+		}
+		return a < b
+	})
+	if len(proj.MainPackages) > 0 {
+		proj.Entrypoint = proj.MainPackages[0]
+	}
+
+	for ext := range softReload {
+		proj.SoftReload = append(proj.SoftReload, ext)
+	}
+	sort.Strings(proj.SoftReload)
+
+	for _, envFile := range envFileCandidates {
+		if _, err := os.Stat(filepath.Join(rootDirectory, envFile)); err == nil {
+			proj.EnvFiles = append(proj.EnvFiles, envFile)
+		}
+	}
+
+	return proj, nil
+}
+
+func rankPackage(pkg string) int {
+	switch {
+	case pkg == ".":
+		return 0
+	case strings.HasPrefix(pkg, "./cmd/"):
+		return 1
+	default:
+		return 2 + strings.Count(pkg, "/")
+	}
+}
+
+// Render produces the YAML for a starter config file
+func (p *Project) Render() ([]byte, error) {
+	buf := bytes.Buffer{}
+	err := configTemplate.Execute(&buf, p)
+	if err != nil {
+		return nil, fmt.Errorf("rendering config: %w", err)
+	}
+	return buf.Bytes(), nil
+}
+
+// Run inspects the project in rootDirectory and writes a starter gomon.config.yml
+func Run(rootDirectory string, force bool) error {
+	configPath := filepath.Join(rootDirectory, config.DefaultConfigFileName)
+	if _, err := os.Stat(configPath); err == nil && !force {
+		return fmt.Errorf("%s already exists, use --force to overwrite it", configPath)
+	} else if err != nil && !errors.Is(err, os.ErrNotExist) {
+		return fmt.Errorf("checking for config file: %w", err)
+	}
+
+	proj, err := Inspect(rootDirectory)
+	if err != nil {
+		return err
+	}
+
+	if proj.Entrypoint == "" {
+		log.Warn("no main package found, set the entrypoint in the config file")
+	}
+
+	err = proj.write(configPath)
+	if err != nil {
+		return err
+	}
+
+	log.Infof("wrote %s", configPath)
+	return nil
+}
+
+func (p *Project) write(configPath string) error {
+	data, err := p.Render()
+	if err != nil {
+		return err
+	}
+
+	err = os.WriteFile(configPath, data, 0644)
+	if err != nil {
+		return fmt.Errorf("writing config file: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,120 @@
+package scaffold
+
+// gomon is a simple command line tool that watches your files and automatically restarts the application when it detects any changes in the working directory.
+// Copyright (C) 2023 John Dudmesh
+
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+
+// You should have received a copy of the GNU General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>.
+
+import (
+	"bytes"
+	"errors"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/jdudmesh/gomon/internal/config"
+)
+
+func writeFile(t *testing.T, root, name, content string) {
+	t.Helper()
+	p := filepath.Join(root, name)
+	err := os.MkdirAll(filepath.Dir(p), 0755)
+	if err != nil {
+		t.Fatalf("creating directory: %v", err)
+	}
+	err = os.WriteFile(p, []byte(content), 0644)
+	if err != nil {
+		t.Fatalf("writing file: %v", err)
+	}
+}
+
+func TestInspect(t *testing.T) {
+	root := t.TempDir()
+	writeFile(t, root, "cmd/server/main.go", "package main\n\nfunc main() {}\n")
+	writeFile(t, root, "tools/gen/main.go", "package main\n\nfunc main() {}\n")
+	writeFile(t, root, "internal/db/db.go", "package db\n")
+	writeFile(t, root, "views/index.templ", "")
+	writeFile(t, root, "static/site.css", "")
+	writeFile(t, root, "sqlc.yaml", "")
+	writeFile(t, root, ".env", "")
+
+	proj, err := Inspect(root)
+	if err != nil {
+		t.Fatalf("inspecting project: %v", err)
+	}
+
+	if proj.Entrypoint != "./cmd/server" {
+		t.Errorf("unexpected entrypoint: %s", proj.Entrypoint)
+	}
+	if len(proj.MainPackages) != 2 {
+		t.Errorf("expected 2 main packages, got %v", proj.MainPackages)
+	}
+	if _, ok := proj.Generated["*.templ"]; !ok {
+		t.Error("expected templ generator")
+	}
+	if _, ok := proj.Generated["*.sql"]; !ok {
+		t.Error("expected sqlc generator")
+	}
+	if len(proj.SoftReload) != 1 || proj.SoftReload[0] != "*.css" {
+		t.Errorf("unexpected soft reload patterns: %v", proj.SoftReload)
+	}
+	if len(proj.EnvFiles) != 1 || proj.EnvFiles[0] != ".env" {
+		t.Errorf("unexpected env files: %v", proj.EnvFiles)
+	}
+
+	_, err = proj.Render()
+	if err != nil {
+		t.Fatalf("rendering config: %v", err)
+	}
+}
+
+func TestWizard(t *testing.T) {
+	root := t.TempDir()
+	writeFile(t, root, "cmd/server/main.go", "package main\n\nfunc main() {}\n")
+	writeFile(t, root, "tools/gen/main.go", "package main\n\nfunc main() {}\n")
+
+	// pick the second package after an invalid choice, enable the proxy on port 3000 and accept the UI default
+	in := strings.NewReader("7\n2\ny\nabc\n3000\n\n")
+	out := &bytes.Buffer{}
+
+	configPath, err := Wizard(root, in, out)
+	if err != nil {
+		t.Fatalf("running wizard: %v\n%s", err, out.String())
+	}
+
+	cfg, err := config.New(configPath)
+	if err != nil {
+		t.Fatalf("loading config: %v", err)
+	}
+
+	if cfg.Entrypoint != "./tools/gen" {
+		t.Errorf("unexpected entrypoint: %s", cfg.Entrypoint)
+	}
+	if !cfg.Proxy.Enabled || cfg.Proxy.Downstream.Host != "localhost:3000" {
+		t.Errorf("unexpected proxy settings: %+v", cfg.Proxy)
+	}
+	if !cfg.UI.Enabled {
+		t.Error("expected the UI to be enabled")
+	}
+	if !strings.Contains(out.String(), "Please enter a number between 1 and 2") {
+		t.Errorf("expected the invalid choice to be rejected:\n%s", out.String())
+	}
+
+	_, err = Wizard(t.TempDir(), strings.NewReader(""), out)
+	if !errors.Is(err, io.ErrUnexpectedEOF) {
+		t.Errorf("expected an error when there is no input, got %v", err)
+	}
+}
@@ -0,0 +1,175 @@
+package scaffold
+
+// gomon is a simple command line tool that watches your files and automatically restarts the application when it detects any changes in the working directory.
+// Copyright (C) 2023 John Dudmesh
+
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+
+// You should have received a copy of the GNU General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>.
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"github.com/jdudmesh/gomon/internal/config"
+)
+
+// Wizard asks which package to run and whether to enable the proxy and the UI, then writes gomon.config.yml to the
+// root directory and returns its path. It is used when gomon is started without a config file or entrypoint.
+func Wizard(rootDirectory string, in io.Reader, out io.Writer) (string, error) {
+	proj, err := Inspect(rootDirectory)
+	if err != nil {
+		return "", err
+	}
+
+	p := &prompter{
+		in:  bufio.NewScanner(in),
+		out: out,
+	}
+
+	fmt.Fprintf(out, "No config file or entrypoint found, answer a few questions to create %s (press enter to accept the default)\n\n", config.DefaultConfigFileName)
+
+	proj.Entrypoint, err = p.chooseEntrypoint(proj.MainPackages)
+	if err != nil {
+		return "", err
+	}
+
+	proj.ProxyEnabled, err = p.confirm("Enable the proxy so that browsers reload when files change?", false)
+	if err != nil {
+		return "", err
+	}
+
+	if proj.ProxyEnabled {
+		port, err := p.port("Which port does your app listen on?", 8080)
+		if err != nil {
+			return "", err
+		}
+		proj.Downstream = fmt.Sprintf("localhost:%d", port)
+	}
+
+	proj.UIEnabled, err = p.confirm("Enable the web UI?", true)
+	if err != nil {
+		return "", err
+	}
+
+	configPath := filepath.Join(rootDirectory, config.DefaultConfigFileName)
+	err = proj.write(configPath)
+	if err != nil {
+		return "", err
+	}
+
+	fmt.Fprintf(out, "\nwrote %s, edit it to change these settings\n\n", configPath)
+	return configPath, nil
+}
+
+type prompter struct {
+	in  *bufio.Scanner
+	out io.Writer
+}
+
+// ask prints the question and returns the answer, or the default if the answer is blank
+func (p *prompter) ask(question, defaultAnswer string) (string, error) {
+	if defaultAnswer != "" {
+		fmt.Fprintf(p.out, "%s [%s]: ", question, defaultAnswer)
+	} else {
+		fmt.Fprintf(p.out, "%s: ", question)
+	}
+
+	if !p.in.Scan() {
+		if err := p.in.Err(); err != nil {
+			return "", fmt.Errorf("reading answer: %w", err)
+		}
+		return "", io.ErrUnexpectedEOF
+	}
+
+	answer := strings.TrimSpace(p.in.Text())
+	if answer == "" {
+		return defaultAnswer, nil
+	}
+	return answer, nil
+}
+
+// chooseEntrypoint lists the main packages found in the project, the answer can be the number of one of them or a path
+func (p *prompter) chooseEntrypoint(mainPackages []string) (string, error) {
+	if len(mainPackages) == 0 {
+		for {
+			answer, err := p.ask("No main package found, which package should gomon run?", "")
+			if err != nil || answer != "" {
+				return answer, err
+			}
+		}
+	}
+
+	fmt.Fprintln(p.out, "Main packages:")
+	for i, pkg := range mainPackages {
+		fmt.Fprintf(p.out, "  %d) %s\n", i+1, pkg)
+	}
+
+	for {
+		answer, err := p.ask("Which package should gomon run? (number or path)", "1")
+		if err != nil {
+			return "", err
+		}
+
+		n, err := strconv.Atoi(answer)
+		if err != nil {
+			return answer, nil
+		}
+		if n >= 1 && n <= len(mainPackages) {
+			return mainPackages[n-1], nil
+		}
+		fmt.Fprintf(p.out, "Please enter a number between 1 and %d or a path\n", len(mainPackages))
+	}
+}
+
+func (p *prompter) confirm(question string, defaultAnswer bool) (bool, error) {
+	options := "y/N"
+	if defaultAnswer {
+		options = "Y/n"
+	}
+
+	for {
+		answer, err := p.ask(question+" ("+options+")", "")
+		if err != nil {
+			return false, err
+		}
+
+		switch strings.ToLower(answer) {
+		case "":
+			return defaultAnswer, nil
+		case "y", "yes":
+			return true, nil
+		case "n", "no":
+			return false, nil
+		}
+		fmt.Fprintln(p.out, "Please answer y or n")
+	}
+}
+
+func (p *prompter) port(question string, defaultPort int) (int, error) {
+	for {
+		answer, err := p.ask(question, strconv.Itoa(defaultPort))
+		if err != nil {
+			return 0, err
+		}
+
+		port, err := strconv.Atoi(answer)
+		if err == nil && port > 0 && port <= 65535 {
+			return port, nil
+		}
+		fmt.Fprintln(p.out, "Please enter a port number between 1 and 65535")
+	}
+}
@@ -0,0 +1,157 @@
+package services
+
+// gomon is a simple command line tool that watches your files and automatically restarts the application when it detects any changes in the working directory.
+// Copyright (C) 2023 John Dudmesh
+
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+
+// You should have received a copy of the GNU General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>.
+
+// graphPage shows the services as a dependency graph, each column holds the services which depend on ones in the
+// columns before it. Statuses are loaded from /api/services and then followed on the SSE stream.
+const graphPage = `<!doctype html>
+<html>
+<head>
+	<title>gomon services</title>
+	<meta charset="utf-8">
+	<style>
+		body { font-family: sans-serif; background: #0f172a; color: #f8fafc; margin: 0; }
+		nav { padding: 0.75rem 1rem; background: #3b82f6; }
+		nav h1 { font-size: 1.25rem; margin: 0; }
+		#graph { position: relative; display: flex; gap: 5rem; padding: 2rem; align-items: flex-start; }
+		#edges { position: absolute; top: 0; left: 0; width: 100%; height: 100%; pointer-events: none; }
+		#edges path { stroke: #64748b; stroke-width: 1.5; fill: none; marker-end: url(#arrow); }
+		.column { display: flex; flex-direction: column; gap: 1.5rem; }
+		.service { position: relative; min-width: 12rem; background: #1e293b; border: 1px solid #334155; border-left: 0.4rem solid #64748b; border-radius: 0.4rem; padding: 0.6rem 0.8rem; }
+		.service.starting { border-left-color: #eab308; }
+		.service.ready { border-left-color: #22c55e; }
+		.service.failed { border-left-color: #ef4444; }
+		.service h2 { font-size: 1rem; margin: 0 0 0.3rem 0; }
+		.service div { font-size: 0.8rem; color: #cbd5e1; }
+		.service .error { color: #f87171; white-space: pre-wrap; }
+		a { color: #93c5fd; }
+	</style>
+</head>
+<body>
+	<nav><h1>gomon services</h1></nav>
+	<div id="graph">
+		<svg id="edges">
+			<defs>
+				<marker id="arrow" viewBox="0 0 10 10" refX="10" refY="5" markerWidth="6" markerHeight="6" orient="auto-start-reverse">
+					<path d="M 0 0 L 10 5 L 0 10 z" fill="#64748b"></path>
+				</marker>
+			</defs>
+		</svg>
+	</div>
+	<script>
+		// services are in dependency order, each comes after the services it depends on
+		const services = new Map();
+
+		// depth is the column of a service, one to the right of the deepest service it depends on
+		function depth(name) {
+			const s = services.get(name);
+			return s.dependsOn.reduce((d, dep) => Math.max(d, depth(dep) + 1), 0);
+		}
+
+		function card(s) {
+			const div = document.createElement("div");
+			div.className = "service " + s.state;
+			div.id = "service-" + s.name;
+			const title = document.createElement("h2");
+			title.textContent = s.name;
+			const state = document.createElement("div");
+			state.textContent = s.state + (s.ready === "exit" ? " (ready on exit)" : "") + (s.runId ? ", run " + s.runId : "");
+			div.append(title, state);
+			if (s.restarts > 0) {
+				const restarts = document.createElement("div");
+				restarts.textContent = "restarted " + s.restarts + " times after a dependency restarted";
+				div.append(restarts);
+			}
+			if (s.url) {
+				const link = document.createElement("a");
+				link.href = s.url;
+				link.target = "_blank";
+				link.textContent = "Open UI";
+				const open = document.createElement("div");
+				open.append(link);
+				div.append(open);
+			}
+			if (s.error) {
+				const err = document.createElement("div");
+				err.className = "error";
+				err.textContent = s.error;
+				div.append(err);
+			}
+			return div;
+		}
+
+		// drawEdges draws an arrow from each service to each of the services it depends on
+		function drawEdges() {
+			const graph = document.getElementById("graph").getBoundingClientRect();
+			const edges = document.getElementById("edges");
+			edges.querySelectorAll("path.edge").forEach((p) => p.remove());
+			for (const s of services.values()) {
+				const from = document.getElementById("service-" + s.name).getBoundingClientRect();
+				for (const dep of s.dependsOn) {
+					const to = document.getElementById("service-" + dep).getBoundingClientRect();
+					const x1 = from.left - graph.left, y1 = from.top + from.height / 2 - graph.top;
+					const x2 = to.right - graph.left, y2 = to.top + to.height / 2 - graph.top;
+					const mid = (x1 + x2) / 2;
+					const path = document.createElementNS("http://www.w3.org/2000/svg", "path");
+					path.setAttribute("class", "edge");
+					path.setAttribute("d", "M " + x1 + " " + y1 + " C " + mid + " " + y1 + ", " + mid + " " + y2 + ", " + x2 + " " + y2);
+					edges.append(path);
+				}
+			}
+		}
+
+		function render() {
+			const columns = [];
+			for (const s of services.values()) {
+				const d = depth(s.name);
+				columns[d] = columns[d] || [];
+				columns[d].push(s);
+			}
+			const graph = document.getElementById("graph");
+			graph.querySelectorAll(".column").forEach((c) => c.remove());
+			for (const column of columns) {
+				const div = document.createElement("div");
+				div.className = "column";
+				div.append(...column.map(card));
+				graph.append(div);
+			}
+			drawEdges();
+		}
+
+		const pending = [];
+		let loaded = false;
+		const source = new EventSource("/sse?stream=services");
+		source.onmessage = (msg) => {
+			const s = JSON.parse(msg.data);
+			if (!loaded) {
+				pending.push(s);
+				return;
+			}
+			services.set(s.name, s);
+			render();
+		};
+
+		fetch("/api/services").then((res) => res.json()).then((statuses) => {
+			statuses.forEach((s) => services.set(s.name, s));
+			pending.forEach((s) => services.set(s.name, s));
+			loaded = true;
+			render();
+		});
+		window.onresize = drawEdges;
+	</script>
+</body>
+</html>`
@@ -0,0 +1,542 @@
+package services
+
+// gomon is a simple command line tool that watches your files and automatically restarts the application when it detects any changes in the working directory.
+// Copyright (C) 2023 John Dudmesh
+
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+
+// You should have received a copy of the GNU General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>.
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"path/filepath"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/jdudmesh/gomon/internal/app"
+	"github.com/jdudmesh/gomon/internal/config"
+	"github.com/jdudmesh/gomon/internal/notification"
+	"github.com/r3labs/sse/v2"
+	"github.com/sirupsen/logrus"
+)
+
+var log = logrus.WithField("component", "services")
+
+const (
+	// DefaultListen only accepts connections from this machine
+	DefaultListen = "127.0.0.1:4200"
+	// statusStream carries the status changes of every service
+	statusStream = "services"
+	// healthInterval is how often the health check of a service which has just started is tried until it responds
+	healthInterval = 500 * time.Millisecond
+	healthTimeout  = 2 * time.Second
+)
+
+// The states of a service shown in the dependency graph
+const (
+	// StateWaiting is a service which is waiting for the services it depends on to be ready before it starts or restarts
+	StateWaiting  = "waiting"
+	StateStarting = "starting"
+	StateReady    = "ready"
+	StateStopped  = "stopped"
+	StateFailed   = "failed"
+)
+
+// ServiceStatus is a service's place in the dependency graph and the state of its child process
+type ServiceStatus struct {
+	Name      string   `json:"name"`
+	DependsOn []string `json:"dependsOn"`
+	// Ready is when the service becomes ready, running or exit
+	Ready string `json:"ready"`
+	State string `json:"state"`
+	RunID string `json:"runId,omitempty"`
+	// URL is the service's UI, if it has one
+	URL string `json:"url,omitempty"`
+	// Restarts counts the times the service was restarted because a service it depends on became ready again
+	Restarts int    `json:"restarts"`
+	Error    string `json:"error,omitempty"`
+}
+
+// instance is the gomon which runs a service, it is an *app.App outside of the tests
+type instance interface {
+	Run(ctx context.Context, opts app.RunOptions) error
+	Restart(ctx context.Context, hard bool, hint string) error
+	Config() config.Config
+	Close()
+}
+
+func newApp(cfg config.Config, bus *notification.Bus) (instance, error) {
+	a, err := app.New(cfg, app.WithBus(bus))
+	if err != nil {
+		return nil, err
+	}
+	return a, nil
+}
+
+// Supervisor runs the services of a monorepo, each in its own gomon. A service is started once the services it
+// depends on are ready and is restarted whenever one of them becomes ready again e.g. after it was rebuilt.
+type Supervisor struct {
+	// order is the names of the services, each comes after the services it depends on
+	order       []string
+	services    map[string]*service
+	newInstance func(cfg config.Config, bus *notification.Bus) (instance, error)
+	sseServer   *sse.Server
+	mux         *http.ServeMux
+	lock        sync.Mutex
+	// ctx is the context the services are run in, it is set by Run
+	ctx context.Context
+	wg  sync.WaitGroup
+}
+
+// service is the state of one service, guarded by the Supervisor's lock
+type service struct {
+	config.Service
+	name string
+	cfg  config.Config
+	bus  *notification.Bus
+	inst instance
+	// started is set once the service has been started, it isn't cleared when the service stops
+	started bool
+	ready   bool
+	// stale is set when a service it depends on has become ready again since it was started
+	stale bool
+	// cancelProbe stops polling the health check of the current run
+	cancelProbe context.CancelFunc
+	status      ServiceStatus
+}
+
+// New loads the config of each of the services in cfg, a service's config is the gomon.config.yml in its directory
+func New(cfg config.Config) (*Supervisor, error) {
+	if len(cfg.Services) == 0 {
+		return nil, errors.New("no services are configured")
+	}
+
+	order, err := config.ServiceOrder(cfg.Services)
+	if err != nil {
+		return nil, err
+	}
+
+	rootDirectory, err := filepath.Abs(cfg.RootDirectory)
+	if err != nil {
+		return nil, fmt.Errorf("resolving root directory: %w", err)
+	}
+
+	s := &Supervisor{
+		order:       order,
+		services:    map[string]*service{},
+		newInstance: newApp,
+		sseServer:   sse.New(),
+		mux:         http.NewServeMux(),
+	}
+	s.sseServer.AutoReplay = false
+	s.sseServer.CreateStream(statusStream)
+
+	for _, name := range order {
+		def := cfg.Services[name]
+		if def.Ready == "" {
+			def.Ready = config.ServiceReadyRunning
+		}
+		if def.DependsOn == nil {
+			def.DependsOn = []string{}
+		}
+
+		dir := def.Directory
+		if dir == "" {
+			dir = name
+		}
+		if !filepath.IsAbs(dir) {
+			dir = filepath.Join(rootDirectory, dir)
+		}
+
+		svcCfg, err := config.Load("", dir)
+		if err != nil {
+			return nil, fmt.Errorf("loading config of service %s: %w", name, err)
+		}
+		if svcCfg.Entrypoint == "" && len(svcCfg.Command) == 0 {
+			return nil, fmt.Errorf("service %s has no entrypoint", name)
+		}
+		// the services share the terminal and each needs its own IPC channel rather than the default UDP port
+		svcCfg.TUI = false
+		if svcCfg.IPC.Transport == "" {
+			svcCfg.IPC.Transport = config.IPCTransportUnix
+		}
+		// a service which is ready once it has exited is only run again when it changes or is restarted
+		if def.Ready == config.ServiceReadyExit {
+			svcCfg.Restart = config.RestartNever
+		}
+
+		s.services[name] = &service{
+			Service: def,
+			name:    name,
+			cfg:     svcCfg,
+			bus:     notification.NewBus(),
+			status: ServiceStatus{
+				Name:      name,
+				DependsOn: def.DependsOn,
+				Ready:     def.Ready,
+				State:     StateWaiting,
+			},
+		}
+	}
+
+	s.mux.HandleFunc("/", s.pageHandler)
+	s.mux.HandleFunc("/sse", s.sseServer.ServeHTTP)
+	s.mux.HandleFunc("/api/services", s.servicesHandler)
+
+	return s, nil
+}
+
+// ListenAndServe runs the services and serves the dependency graph until ctx is cancelled
+func (s *Supervisor) ListenAndServe(ctx context.Context, addr string) error {
+	server := &http.Server{
+		Addr:    addr,
+		Handler: s,
+	}
+	serverErrors := make(chan error, 1)
+	go func() {
+		serverErrors <- server.ListenAndServe()
+	}()
+	// streams never finish so the server is closed rather than shut down gracefully
+	defer server.Close()
+	defer s.sseServer.Close()
+
+	ctx, cancel := context.WithCancel(ctx)
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		s.Run(ctx)
+	}()
+	defer func() {
+		cancel()
+		<-done
+	}()
+
+	log.Infof("services listening on http://%s", addr)
+
+	select {
+	case <-ctx.Done():
+		return nil
+	case err := <-serverErrors:
+		return fmt.Errorf("serving services: %w", err)
+	}
+}
+
+// Run starts the services in dependency order and returns once ctx is cancelled and they have all stopped
+func (s *Supervisor) Run(ctx context.Context) {
+	s.lock.Lock()
+	s.ctx = ctx
+	s.lock.Unlock()
+
+	s.reconcile()
+	<-ctx.Done()
+	s.wg.Wait()
+}
+
+func (s *Supervisor) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	s.mux.ServeHTTP(w, r)
+}
+
+// Statuses returns the status of every service, each comes after the services it depends on
+func (s *Supervisor) Statuses() []ServiceStatus {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	statuses := make([]ServiceStatus, 0, len(s.order))
+	for _, name := range s.order {
+		statuses = append(statuses, s.services[name].status)
+	}
+	return statuses
+}
+
+// reconcile starts each service whose dependencies are ready, and restarts the ones which are stale
+func (s *Supervisor) reconcile() {
+	s.lock.Lock()
+	changed := []ServiceStatus{}
+	for _, name := range s.order {
+		svc := s.services[name]
+		if s.ctx.Err() != nil || !s.dependenciesReady(svc) {
+			continue
+		}
+
+		switch {
+		case !svc.started:
+			svc.started = true
+			svc.status.State = StateStarting
+			changed = append(changed, svc.status)
+			s.wg.Add(1)
+			go s.run(svc)
+		case svc.stale && svc.inst != nil:
+			svc.stale = false
+			svc.stopProbe()
+			// events from the run being replaced mustn't make the service ready
+			svc.status.RunID = ""
+			svc.status.State = StateStarting
+			svc.status.Restarts++
+			changed = append(changed, svc.status)
+			go func(name string, inst instance) {
+				err := inst.Restart(s.ctx, true, "a service it depends on restarted")
+				if err != nil && s.ctx.Err() == nil {
+					log.WithField("service", name).Errorf("restarting: %v", err)
+				}
+			}(svc.name, svc.inst)
+		}
+	}
+	s.lock.Unlock()
+
+	s.publish(changed...)
+}
+
+func (s *Supervisor) dependenciesReady(svc *service) bool {
+	for _, dep := range svc.DependsOn {
+		if !s.services[dep].ready {
+			return false
+		}
+	}
+	return true
+}
+
+// dependents returns the services which depend on name, directly or through other services
+func (s *Supervisor) dependents(name string) []*service {
+	found := []*service{}
+	names := []string{name}
+	// the services are in dependency order so a dependent always comes after the services it depends on
+	for _, other := range s.order {
+		svc := s.services[other]
+		for _, dep := range svc.DependsOn {
+			if slices.Contains(names, dep) {
+				names = append(names, other)
+				found = append(found, svc)
+				break
+			}
+		}
+	}
+	return found
+}
+
+// run runs the service's gomon until the Supervisor's context is cancelled
+func (s *Supervisor) run(svc *service) {
+	defer s.wg.Done()
+	logger := log.WithField("service", svc.name)
+
+	inst, err := s.newInstance(svc.cfg, svc.bus)
+	if err != nil {
+		logger.Errorf("starting: %v", err)
+		s.update(svc, func(st *ServiceStatus) {
+			st.State = StateFailed
+			st.Error = err.Error()
+		})
+		return
+	}
+	defer inst.Close()
+
+	sub := svc.bus.Subscribe(notification.ConsumerFunc(func(n notification.Notification) error {
+		s.notify(svc, n)
+		return nil
+	}), notification.WithTypes(
+		notification.NotificationTypeStartup,
+		notification.NotificationTypeRunning,
+		notification.NotificationTypeShutdown,
+		notification.NotificationTypeCrash,
+		notification.NotificationTypeBuildError,
+	), notification.WithName("service "+svc.name))
+	defer sub.Close()
+
+	s.lock.Lock()
+	svc.inst = inst
+	s.lock.Unlock()
+	s.update(svc, func(st *ServiceStatus) {
+		st.URL = inst.Config().UIURL()
+	})
+
+	err = inst.Run(s.ctx, app.RunOptions{Watch: true})
+
+	s.lock.Lock()
+	svc.inst = nil
+	svc.ready = false
+	svc.stopProbe()
+	s.lock.Unlock()
+	s.update(svc, func(st *ServiceStatus) {
+		st.State = StateStopped
+		if err != nil && s.ctx.Err() == nil {
+			logger.Errorf("running: %v", err)
+			st.State = StateFailed
+			st.Error = err.Error()
+		}
+	})
+}
+
+// notify follows the lifecycle of the service's child process, it is called on the service's gomon goroutines
+func (s *Supervisor) notify(svc *service, n notification.Notification) {
+	switch n.Type {
+	case notification.NotificationTypeStartup:
+		s.lock.Lock()
+		svc.ready = false
+		svc.stopProbe()
+		s.lock.Unlock()
+		s.update(svc, func(st *ServiceStatus) {
+			st.State = StateStarting
+			st.RunID = n.ChildProccessID
+			st.Error = ""
+		})
+	case notification.NotificationTypeRunning:
+		if svc.Ready == config.ServiceReadyExit {
+			return
+		}
+		if svc.cfg.Health.URL == "" {
+			s.markReady(svc, n.ChildProccessID)
+			return
+		}
+		ctx, cancel := context.WithCancel(s.ctx)
+		s.lock.Lock()
+		svc.stopProbe()
+		svc.cancelProbe = cancel
+		s.lock.Unlock()
+		go s.probe(ctx, svc, n.ChildProccessID)
+	case notification.NotificationTypeShutdown:
+		if svc.Ready == config.ServiceReadyExit && strings.HasSuffix(n.Message, "exit code 0") {
+			s.markReady(svc, n.ChildProccessID)
+			return
+		}
+		s.lock.Lock()
+		svc.ready = false
+		svc.stopProbe()
+		s.lock.Unlock()
+		s.update(svc, func(st *ServiceStatus) {
+			st.State = StateStopped
+		})
+	case notification.NotificationTypeCrash, notification.NotificationTypeBuildError:
+		s.lock.Lock()
+		svc.ready = false
+		svc.stopProbe()
+		s.lock.Unlock()
+		s.update(svc, func(st *ServiceStatus) {
+			st.State = StateFailed
+			st.Error = n.Message
+		})
+	}
+}
+
+// markReady makes the service ready if runID is still its current run. The services which depend on it and are
+// already running are restarted once the rest of their dependencies are ready too.
+func (s *Supervisor) markReady(svc *service, runID string) {
+	s.lock.Lock()
+	if svc.status.RunID != runID || svc.stale {
+		s.lock.Unlock()
+		return
+	}
+	svc.ready = true
+	svc.status.State = StateReady
+	svc.status.Error = ""
+	changed := []ServiceStatus{svc.status}
+	for _, dependent := range s.dependents(svc.name) {
+		if !dependent.started || dependent.inst == nil {
+			continue
+		}
+		dependent.stale = true
+		dependent.ready = false
+		dependent.status.State = StateWaiting
+		changed = append(changed, dependent.status)
+	}
+	s.lock.Unlock()
+
+	s.publish(changed...)
+	s.reconcile()
+}
+
+// probe polls the service's health check until it responds, the run is ready from then on
+func (s *Supervisor) probe(ctx context.Context, svc *service, runID string) {
+	client := http.Client{Timeout: healthTimeout}
+	ticker := time.NewTicker(healthInterval)
+	defer ticker.Stop()
+
+	for {
+		req, err := http.NewRequestWithContext(ctx, http.MethodGet, svc.cfg.Health.URL, nil)
+		if err != nil {
+			s.update(svc, func(st *ServiceStatus) {
+				st.State = StateFailed
+				st.Error = fmt.Sprintf("invalid health.url: %v", err)
+			})
+			return
+		}
+		res, err := client.Do(req)
+		if err == nil {
+			res.Body.Close()
+			if res.StatusCode < http.StatusBadRequest {
+				s.markReady(svc, runID)
+				return
+			}
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+func (svc *service) stopProbe() {
+	if svc.cancelProbe != nil {
+		svc.cancelProbe()
+		svc.cancelProbe = nil
+	}
+}
+
+// update applies a change to the service's status and publishes it
+func (s *Supervisor) update(svc *service, fn func(st *ServiceStatus)) {
+	s.lock.Lock()
+	fn(&svc.status)
+	status := svc.status
+	s.lock.Unlock()
+
+	s.publish(status)
+}
+
+func (s *Supervisor) publish(statuses ...ServiceStatus) {
+	for _, st := range statuses {
+		data, err := json.Marshal(st)
+		if err != nil {
+			log.Errorf("marshalling status: %v", err)
+			continue
+		}
+		s.sseServer.Publish(statusStream, &sse.Event{Data: data})
+	}
+}
+
+func (s *Supervisor) pageHandler(w http.ResponseWriter, r *http.Request) {
+	if r.URL.Path != "/" {
+		w.WriteHeader(http.StatusNotFound)
+		return
+	}
+	w.Header().Set("Content-Type", "text/html; charset=utf-8")
+	w.Write([]byte(graphPage))
+}
+
+// servicesHandler returns the status of every service, each comes after the services it depends on
+func (s *Supervisor) servicesHandler(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		w.WriteHeader(http.StatusMethodNotAllowed)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	err := json.NewEncoder(w).Encode(s.Statuses())
+	if err != nil {
+		log.Errorf("writing response: %v", err)
+	}
+}
@@ -0,0 +1,292 @@
+package services
+
+// gomon is a simple command line tool that watches your files and automatically restarts the application when it detects any changes in the working directory.
+// Copyright (C) 2023 John Dudmesh
+
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+
+// You should have received a copy of the GNU General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>.
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"slices"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/jdudmesh/gomon/internal/app"
+	"github.com/jdudmesh/gomon/internal/config"
+	"github.com/jdudmesh/gomon/internal/notification"
+)
+
+// fakeService publishes the notifications of a child process which starts straight away, or which exits
+// successfully when exits is set
+type fakeService struct {
+	name  string
+	cfg   config.Config
+	bus   *notification.Bus
+	exits bool
+	log   *eventLog
+}
+
+type eventLog struct {
+	lock   sync.Mutex
+	events []string
+}
+
+func (l *eventLog) add(event string) {
+	l.lock.Lock()
+	defer l.lock.Unlock()
+	l.events = append(l.events, event)
+}
+
+func (l *eventLog) get() []string {
+	l.lock.Lock()
+	defer l.lock.Unlock()
+	return slices.Clone(l.events)
+}
+
+func (f *fakeService) start() {
+	runID := notification.NextID()
+	f.bus.Publish(notification.Notification{Type: notification.NotificationTypeStartup, ChildProccessID: runID})
+	f.bus.Publish(notification.Notification{Type: notification.NotificationTypeRunning, ChildProccessID: runID})
+	if f.exits {
+		f.bus.Publish(notification.Notification{
+			Type:            notification.NotificationTypeShutdown,
+			ChildProccessID: runID,
+			Message:         "process stopped: exit code 0",
+		})
+	}
+}
+
+func (f *fakeService) Run(ctx context.Context, opts app.RunOptions) error {
+	f.log.add("start " + f.name)
+	f.start()
+	<-ctx.Done()
+	return nil
+}
+
+func (f *fakeService) Restart(ctx context.Context, hard bool, hint string) error {
+	f.log.add("restart " + f.name)
+	f.start()
+	return nil
+}
+
+func (f *fakeService) Config() config.Config {
+	return f.cfg
+}
+
+func (f *fakeService) Close() {}
+
+func writeService(t *testing.T, dir, config string) {
+	t.Helper()
+	os.MkdirAll(dir, 0755)
+	err := os.WriteFile(filepath.Join(dir, "gomon.config.yml"), []byte(config), 0644)
+	if err != nil {
+		t.Fatal(err)
+	}
+}
+
+func waitFor(t *testing.T, what string, fn func() bool) {
+	t.Helper()
+	deadline := time.Now().Add(5 * time.Second)
+	for !fn() {
+		if time.Now().After(deadline) {
+			t.Fatalf("timed out waiting for %s", what)
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+}
+
+func TestSupervisor(t *testing.T) {
+	root := t.TempDir()
+	for _, name := range []string{"db", "migrator", "api", "worker"} {
+		writeService(t, filepath.Join(root, name), "command: [\"true\"]\n")
+	}
+
+	cfg := config.Config{
+		RootDirectory: root,
+		Services: map[string]config.Service{
+			"worker":   {DependsOn: []string{"api"}},
+			"api":      {DependsOn: []string{"db", "migrator"}},
+			"migrator": {DependsOn: []string{"db"}, Ready: config.ServiceReadyExit},
+			"db":       {},
+		},
+	}
+	s, err := New(cfg)
+	if err != nil {
+		t.Fatalf("creating supervisor: %v", err)
+	}
+	if s.services["migrator"].cfg.Restart != config.RestartNever {
+		t.Errorf("expected a service which is ready on exit not to be restarted, got %q", s.services["migrator"].cfg.Restart)
+	}
+	if s.services["api"].cfg.IPC.Transport != config.IPCTransportUnix {
+		t.Errorf("expected each service to have its own IPC socket, got %q", s.services["api"].cfg.IPC.Transport)
+	}
+
+	events := &eventLog{}
+	fakes := map[string]*fakeService{}
+	s.newInstance = func(cfg config.Config, bus *notification.Bus) (instance, error) {
+		name := filepath.Base(cfg.RootDirectory)
+		f := &fakeService{name: name, cfg: cfg, bus: bus, exits: name == "migrator", log: events}
+		events.lock.Lock()
+		fakes[name] = f
+		events.lock.Unlock()
+		return f, nil
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		s.Run(ctx)
+	}()
+	defer func() {
+		cancel()
+		<-done
+	}()
+
+	allReady := func() bool {
+		for _, st := range s.Statuses() {
+			if st.State != StateReady {
+				return false
+			}
+		}
+		return true
+	}
+	waitFor(t, "the services to be ready", allReady)
+
+	expected := []string{"start db", "start migrator", "start api", "start worker"}
+	if got := events.get(); !slices.Equal(got, expected) {
+		t.Fatalf("expected the services to start in dependency order %v, got %v", expected, got)
+	}
+
+	// db restarting e.g. because it was rebuilt restarts every service which depends on it, once, in dependency order
+	events.lock.Lock()
+	db := fakes["db"]
+	events.lock.Unlock()
+	db.start()
+	waitFor(t, "the dependents to restart", func() bool {
+		return len(events.get()) == 7 && allReady()
+	})
+
+	expected = append(expected, "restart migrator", "restart api", "restart worker")
+	if got := events.get(); !slices.Equal(got, expected) {
+		t.Errorf("expected the dependents to restart in dependency order %v, got %v", expected, got)
+	}
+	for _, st := range s.Statuses() {
+		restarts := 1
+		if st.Name == "db" {
+			restarts = 0
+		}
+		if st.Restarts != restarts {
+			t.Errorf("expected %s to have been restarted %d times, got %d", st.Name, restarts, st.Restarts)
+		}
+	}
+
+	server := httptest.NewServer(s)
+	defer server.Close()
+	res, err := http.Get(server.URL + "/api/services")
+	if err != nil {
+		t.Fatalf("getting services: %v", err)
+	}
+	defer res.Body.Close()
+	statuses := []ServiceStatus{}
+	err = json.NewDecoder(res.Body).Decode(&statuses)
+	if err != nil {
+		t.Fatalf("decoding services: %v", err)
+	}
+	if len(statuses) != 4 || statuses[2].Name != "api" || !slices.Equal(statuses[2].DependsOn, []string{"db", "migrator"}) {
+		t.Errorf("unexpected services: %+v", statuses)
+	}
+}
+
+func TestSupervisorWaitsForHealthCheck(t *testing.T) {
+	healthy := make(chan struct{})
+	health := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		select {
+		case <-healthy:
+		default:
+			w.WriteHeader(http.StatusServiceUnavailable)
+		}
+	}))
+	defer health.Close()
+
+	root := t.TempDir()
+	writeService(t, filepath.Join(root, "db"), "command: [\"true\"]\nhealth:\n  url: "+health.URL+"\n")
+	writeService(t, filepath.Join(root, "api"), "command: [\"true\"]\n")
+
+	s, err := New(config.Config{
+		RootDirectory: root,
+		Services: map[string]config.Service{
+			"api": {DependsOn: []string{"db"}},
+			"db":  {},
+		},
+	})
+	if err != nil {
+		t.Fatalf("creating supervisor: %v", err)
+	}
+
+	events := &eventLog{}
+	s.newInstance = func(cfg config.Config, bus *notification.Bus) (instance, error) {
+		return &fakeService{name: filepath.Base(cfg.RootDirectory), cfg: cfg, bus: bus, log: events}, nil
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		s.Run(ctx)
+	}()
+	defer func() {
+		cancel()
+		<-done
+	}()
+
+	waitFor(t, "db to start", func() bool {
+		return len(events.get()) == 1
+	})
+	time.Sleep(2 * healthInterval)
+	if got := events.get(); len(got) != 1 {
+		t.Fatalf("expected api to wait for db's health check, got %v", got)
+	}
+
+	close(healthy)
+	waitFor(t, "api to start", func() bool {
+		return len(events.get()) == 2
+	})
+}
+
+func TestNewErrors(t *testing.T) {
+	root := t.TempDir()
+	writeService(t, filepath.Join(root, "api"), "proxy:\n  enabled: false\n")
+
+	_, err := New(config.Config{RootDirectory: root})
+	if err == nil {
+		t.Error("expected an error without any services")
+	}
+
+	_, err = New(config.Config{RootDirectory: root, Services: map[string]config.Service{"api": {}}})
+	if err == nil {
+		t.Error("expected an error for a service without an entrypoint")
+	}
+
+	_, err = New(config.Config{RootDirectory: root, Services: map[string]config.Service{"api": {DependsOn: []string{"db"}}}})
+	if err == nil {
+		t.Error("expected an error for a dependency which isn't a service")
+	}
+}
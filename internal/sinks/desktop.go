@@ -0,0 +1,38 @@
+package sinks
+
+// gomon is a simple command line tool that watches your files and automatically restarts the application when it detects any changes in the working directory.
+// Copyright (C) 2023 John Dudmesh
+
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+
+// You should have received a copy of the GNU General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>.
+
+import (
+	"context"
+	"fmt"
+)
+
+type desktopSink struct{}
+
+// NewDesktopSink shows each alert as an OS desktop notification, using notify-send on Linux, osascript on macOS
+// and a PowerShell toast on Windows
+func NewDesktopSink() *desktopSink {
+	return &desktopSink{}
+}
+
+func (s *desktopSink) Send(ctx context.Context, alert Alert) error {
+	output, err := desktopCommand(ctx, alert.Title, alert.Message).CombinedOutput()
+	if err != nil {
+		return fmt.Errorf("showing notification: %w: %s", err, output)
+	}
+	return nil
+}
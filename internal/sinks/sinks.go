@@ -0,0 +1,272 @@
+package sinks
+
+// gomon is a simple command line tool that watches your files and automatically restarts the application when it detects any changes in the working directory.
+// Copyright (C) 2023 John Dudmesh
+
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+
+// You should have received a copy of the GNU General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>.
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/jdudmesh/gomon/internal/config"
+	"github.com/jdudmesh/gomon/internal/notification"
+	"github.com/jdudmesh/gomon/internal/process"
+	"github.com/jdudmesh/gomon/internal/utils"
+	"github.com/sirupsen/logrus"
+)
+
+var log = logrus.WithField("component", "sinks")
+
+const (
+	// EventCrashLoop is sent when the child process crashes crashLoopCount times within crashLoopWindow
+	EventCrashLoop = "crashLoop"
+
+	DefaultThrottle = 30 * time.Second
+
+	crashLoopCount  = 3
+	crashLoopWindow = time.Minute
+	sendTimeout     = 10 * time.Second
+	queueSize       = 64
+	maxMessageLen   = 2000
+)
+
+// DefaultEvents are sent by sinks which don't list any events
+var DefaultEvents = []string{EventCrashLoop, "systemError", "buildError"}
+
+var alertTitles = map[string]string{
+	EventCrashLoop: "Child process is crash looping",
+	"crash":        "Child process crashed",
+	"unhealthy":    "Child process stopped responding",
+	"systemError":  "gomon error",
+	"buildError":   "Build failed",
+}
+
+// Alert is an event which is being sent to a sink
+type Alert struct {
+	Event          string    `json:"event"`
+	Title          string    `json:"title"`
+	Message        string    `json:"message"`
+	ChildProcessID string    `json:"childProcessId,omitempty"`
+	Date           time.Time `json:"createdAt"`
+}
+
+// Sink delivers alerts somewhere outside of gomon
+type Sink interface {
+	Send(ctx context.Context, alert Alert) error
+}
+
+type route struct {
+	name     string
+	sink     Sink
+	events   map[string]bool
+	throttle time.Duration
+	lastSent map[string]time.Time
+}
+
+type delivery struct {
+	route *route
+	alert Alert
+}
+
+// Dispatcher forwards notifications to the configured sinks. Alerts are sent in the background so that a slow
+// webhook can't hold up the rest of gomon.
+type Dispatcher struct {
+	routes    []*route
+	queue     chan delivery
+	crashes   []time.Time
+	lock      sync.Mutex
+	done      chan struct{}
+	closeOnce sync.Once
+	now       func() time.Time
+}
+
+func New(cfg config.Config) (*Dispatcher, error) {
+	d := &Dispatcher{
+		queue: make(chan delivery, queueSize),
+		done:  make(chan struct{}),
+		now:   time.Now,
+	}
+
+	secrets := process.NewSecretResolver()
+	for ix, sinkCfg := range cfg.Notifications.Sinks {
+		sink, err := newSink(sinkCfg, secrets)
+		if err != nil {
+			return nil, fmt.Errorf("notification sink %d: %w", ix, err)
+		}
+
+		throttle := time.Duration(sinkCfg.ThrottleSeconds) * time.Second
+		switch {
+		case sinkCfg.ThrottleSeconds == 0:
+			throttle = DefaultThrottle
+		case sinkCfg.ThrottleSeconds < 0:
+			throttle = 0
+		}
+
+		err = d.addSink(fmt.Sprintf("%s[%d]", sinkCfg.Type, ix), sink, sinkCfg.Events, throttle)
+		if err != nil {
+			return nil, fmt.Errorf("notification sink %d: %w", ix, err)
+		}
+	}
+
+	return d, nil
+}
+
+func newSink(cfg config.NotificationSink, secrets *process.SecretResolver) (Sink, error) {
+	switch cfg.Type {
+	case config.SinkTypeWebhook, config.SinkTypeSlack:
+		if cfg.URL == "" {
+			return nil, fmt.Errorf("%s sink requires a url", cfg.Type)
+		}
+		url, err := secrets.Resolve(cfg.URL)
+		if err != nil {
+			return nil, fmt.Errorf("resolving url: %w", err)
+		}
+		if cfg.Type == config.SinkTypeSlack {
+			return NewSlackSink(url), nil
+		}
+		return NewWebhookSink(url), nil
+	case config.SinkTypeDesktop:
+		return NewDesktopSink(), nil
+	default:
+		return nil, fmt.Errorf("unsupported sink type: %s", cfg.Type)
+	}
+}
+
+func (d *Dispatcher) addSink(name string, sink Sink, events []string, throttle time.Duration) error {
+	if len(events) == 0 {
+		events = DefaultEvents
+	}
+
+	r := &route{
+		name:     name,
+		sink:     sink,
+		events:   map[string]bool{},
+		throttle: throttle,
+		lastSent: map[string]time.Time{},
+	}
+	for _, event := range events {
+		if _, ok := notification.ParseType(event); !ok && event != EventCrashLoop {
+			return fmt.Errorf("unknown event: %s", event)
+		}
+		r.events[event] = true
+	}
+
+	d.routes = append(d.routes, r)
+	return nil
+}
+
+// Start sends queued alerts until the dispatcher is closed
+func (d *Dispatcher) Start() error {
+	for {
+		select {
+		case next := <-d.queue:
+			ctx, cancel := context.WithTimeout(context.Background(), sendTimeout)
+			err := next.route.sink.Send(ctx, next.alert)
+			cancel()
+			if err != nil {
+				log.Warnf("sending %s alert to %s: %v", next.alert.Event, next.route.name, err)
+			}
+		case <-d.done:
+			return nil
+		}
+	}
+}
+
+func (d *Dispatcher) Close() error {
+	d.closeOnce.Do(func() {
+		close(d.done)
+	})
+	return nil
+}
+
+func (d *Dispatcher) Notify(n notification.Notification) error {
+	if len(d.routes) == 0 {
+		return nil
+	}
+
+	d.lock.Lock()
+	defer d.lock.Unlock()
+
+	alerts := []Alert{newAlert(n.Type.String(), n)}
+	if n.Type == notification.NotificationTypeCrash && d.isCrashLooping() {
+		alerts = append(alerts, newAlert(EventCrashLoop, notification.Notification{
+			Date:            n.Date,
+			ChildProccessID: n.ChildProccessID,
+			Message:         fmt.Sprintf("the child process crashed %d times in %s, most recently: %s", crashLoopCount, crashLoopWindow, n.Message),
+		}))
+	}
+
+	now := d.now()
+	for _, alert := range alerts {
+		for _, r := range d.routes {
+			if !r.events[alert.Event] {
+				continue
+			}
+			if last, ok := r.lastSent[alert.Event]; ok && now.Sub(last) < r.throttle {
+				continue
+			}
+			r.lastSent[alert.Event] = now
+
+			select {
+			case d.queue <- delivery{route: r, alert: alert}:
+			default:
+				log.Warnf("alert queue is full, dropping %s alert for %s", alert.Event, r.name)
+			}
+		}
+	}
+
+	return nil
+}
+
+// isCrashLooping records a crash and reports whether there have been enough recent crashes to count as a crash
+// loop, the count starts again once a crash loop has been reported
+func (d *Dispatcher) isCrashLooping() bool {
+	now := d.now()
+	recent := []time.Time{}
+	for _, crash := range d.crashes {
+		if now.Sub(crash) < crashLoopWindow {
+			recent = append(recent, crash)
+		}
+	}
+	d.crashes = append(recent, now)
+
+	if len(d.crashes) < crashLoopCount {
+		return false
+	}
+	d.crashes = nil
+	return true
+}
+
+func newAlert(event string, n notification.Notification) Alert {
+	title, ok := alertTitles[event]
+	if !ok {
+		title = "gomon: " + event
+	}
+
+	message := utils.StripANSI(n.Message)
+	if len(message) > maxMessageLen {
+		message = message[:maxMessageLen] + "..."
+	}
+
+	return Alert{
+		Event:          event,
+		Title:          title,
+		Message:        message,
+		ChildProcessID: n.ChildProccessID,
+		Date:           n.Date,
+	}
+}
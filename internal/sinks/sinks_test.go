@@ -0,0 +1,154 @@
+package sinks
+
+// gomon is a simple command line tool that watches your files and automatically restarts the application when it detects any changes in the working directory.
+// Copyright (C) 2023 John Dudmesh
+
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+
+// You should have received a copy of the GNU General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>.
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/jdudmesh/gomon/internal/config"
+	"github.com/jdudmesh/gomon/internal/notification"
+)
+
+type recordingSink struct {
+	alerts []Alert
+}
+
+func (s *recordingSink) Send(ctx context.Context, alert Alert) error {
+	s.alerts = append(s.alerts, alert)
+	return nil
+}
+
+// drain sends the queued alerts without starting the dispatcher
+func (d *Dispatcher) drain() {
+	for {
+		select {
+		case next := <-d.queue:
+			next.route.sink.Send(context.Background(), next.alert)
+		default:
+			return
+		}
+	}
+}
+
+func TestDispatcherRoutesEvents(t *testing.T) {
+	now := time.Now()
+	d := &Dispatcher{queue: make(chan delivery, queueSize), now: func() time.Time { return now }}
+
+	crashes := &recordingSink{}
+	defaults := &recordingSink{}
+	if err := d.addSink("crashes", crashes, []string{"crash", EventCrashLoop}, 0); err != nil {
+		t.Fatalf("adding sink: %v", err)
+	}
+	if err := d.addSink("defaults", defaults, nil, DefaultThrottle); err != nil {
+		t.Fatalf("adding sink: %v", err)
+	}
+
+	notify := func(notifType notification.NotificationType, message string) {
+		d.Notify(notification.Notification{Date: now, Type: notifType, Message: message})
+		d.drain()
+	}
+
+	notify(notification.NotificationTypeStdOut, "ignored")
+	notify(notification.NotificationTypeCrash, "panic: one")
+	notify(notification.NotificationTypeCrash, "panic: two")
+	notify(notification.NotificationTypeCrash, "panic: three")
+
+	events := []string{}
+	for _, alert := range crashes.alerts {
+		events = append(events, alert.Event)
+	}
+	if strings.Join(events, ",") != "crash,crash,crash,crashLoop" {
+		t.Errorf("unexpected alerts: %v", events)
+	}
+	if len(defaults.alerts) != 1 || defaults.alerts[0].Event != EventCrashLoop || !strings.Contains(defaults.alerts[0].Message, "panic: three") {
+		t.Errorf("expected a single crash loop alert, got %+v", defaults.alerts)
+	}
+
+	// repeated errors are throttled
+	notify(notification.NotificationTypeSystemError, "prestart task failed, aborting restart: false")
+	notify(notification.NotificationTypeSystemError, "prestart task failed, aborting restart: false")
+	if len(defaults.alerts) != 2 {
+		t.Errorf("expected the second error to be throttled, got %d alerts", len(defaults.alerts))
+	}
+
+	now = now.Add(DefaultThrottle)
+	notify(notification.NotificationTypeSystemError, "prestart task failed, aborting restart: false")
+	if len(defaults.alerts) != 3 {
+		t.Errorf("expected an alert once the throttle has expired, got %d alerts", len(defaults.alerts))
+	}
+}
+
+func TestNewValidatesSinks(t *testing.T) {
+	tests := []struct {
+		sink     config.NotificationSink
+		expected string
+	}{
+		{config.NotificationSink{Type: "pager"}, "unsupported sink type"},
+		{config.NotificationSink{Type: config.SinkTypeSlack}, "requires a url"},
+		{config.NotificationSink{Type: config.SinkTypeWebhook, URL: "http://localhost", Events: []string{"explosion"}}, "unknown event"},
+	}
+
+	for _, tt := range tests {
+		cfg := config.Config{}
+		cfg.Notifications.Sinks = []config.NotificationSink{tt.sink}
+		_, err := New(cfg)
+		if err == nil || !strings.Contains(err.Error(), tt.expected) {
+			t.Errorf("%+v: expected error containing %q, got %v", tt.sink, tt.expected, err)
+		}
+	}
+}
+
+func TestWebhookSinks(t *testing.T) {
+	bodies := []map[string]any{}
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body := map[string]any{}
+		json.NewDecoder(r.Body).Decode(&body)
+		bodies = append(bodies, body)
+		if r.URL.Path == "/fail" {
+			w.WriteHeader(http.StatusInternalServerError)
+		}
+	}))
+	defer srv.Close()
+
+	alert := Alert{Event: "crash", Title: "Child process crashed", Message: "panic: boom"}
+
+	err := NewWebhookSink(srv.URL+"/hook").Send(context.Background(), alert)
+	if err != nil {
+		t.Fatalf("sending webhook: %v", err)
+	}
+	err = NewSlackSink(srv.URL+"/slack").Send(context.Background(), alert)
+	if err != nil {
+		t.Fatalf("sending to slack: %v", err)
+	}
+	err = NewWebhookSink(srv.URL+"/fail").Send(context.Background(), alert)
+	if err == nil {
+		t.Error("expected an error for a failed request")
+	}
+
+	if bodies[0]["event"] != "crash" || bodies[0]["message"] != "panic: boom" {
+		t.Errorf("unexpected webhook body: %v", bodies[0])
+	}
+	if bodies[1]["text"] != "*Child process crashed*\npanic: boom" {
+		t.Errorf("unexpected slack body: %v", bodies[1])
+	}
+}
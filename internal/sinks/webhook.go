@@ -0,0 +1,80 @@
+package sinks
+
+// gomon is a simple command line tool that watches your files and automatically restarts the application when it detects any changes in the working directory.
+// Copyright (C) 2023 John Dudmesh
+
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+
+// You should have received a copy of the GNU General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>.
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+)
+
+type webhookSink struct {
+	url    string
+	client *http.Client
+	// payload converts an alert into the JSON body expected by the receiver
+	payload func(alert Alert) any
+}
+
+// NewWebhookSink posts each alert as JSON to url
+func NewWebhookSink(url string) *webhookSink {
+	return &webhookSink{
+		url:    url,
+		client: http.DefaultClient,
+		payload: func(alert Alert) any {
+			return alert
+		},
+	}
+}
+
+// NewSlackSink posts each alert to a Slack incoming webhook
+func NewSlackSink(url string) *webhookSink {
+	return &webhookSink{
+		url:    url,
+		client: http.DefaultClient,
+		payload: func(alert Alert) any {
+			return map[string]string{
+				"text": fmt.Sprintf("*%s*\n%s", alert.Title, alert.Message),
+			}
+		},
+	}
+}
+
+func (s *webhookSink) Send(ctx context.Context, alert Alert) error {
+	body, err := json.Marshal(s.payload(alert))
+	if err != nil {
+		return fmt.Errorf("encoding alert: %w", err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, s.url, bytes.NewReader(body))
+	if err != nil {
+		return fmt.Errorf("creating request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	res, err := s.client.Do(req)
+	if err != nil {
+		return fmt.Errorf("posting alert: %w", err)
+	}
+	defer res.Body.Close()
+
+	if res.StatusCode >= http.StatusMultipleChoices {
+		return fmt.Errorf("posting alert: unexpected status: %s", res.Status)
+	}
+	return nil
+}
@@ -0,0 +1,165 @@
+package tracing
+
+// gomon is a simple command line tool that watches your files and automatically restarts the application when it detects any changes in the working directory.
+// Copyright (C) 2023 John Dudmesh
+
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+
+// You should have received a copy of the GNU General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>.
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"fmt"
+	"net/http"
+	"sort"
+	"strconv"
+	"time"
+
+	"github.com/jdudmesh/gomon/internal/utils"
+)
+
+// The OTLP span kind and status codes
+const (
+	otlpSpanKindInternal = 1
+	otlpStatusOK         = 1
+	otlpStatusError      = 2
+)
+
+// Span is a step of a restart, the root span of a trace doesn't have a parent. Error is set if the step failed.
+type Span struct {
+	TraceID    string
+	SpanID     string
+	ParentID   string
+	Name       string
+	Start      time.Time
+	End        time.Time
+	Attributes map[string]string
+	Error      string
+}
+
+// Exporter sends finished traces to a collector
+type Exporter interface {
+	Export(ctx context.Context, spans []Span) error
+}
+
+func newTraceID() string {
+	return randomID(16)
+}
+
+func newSpanID() string {
+	return randomID(8)
+}
+
+func randomID(size int) string {
+	id := make([]byte, size)
+	// reading random bytes doesn't fail on the supported platforms
+	rand.Read(id)
+	return hex.EncodeToString(id)
+}
+
+type otlpExporter struct {
+	url      string
+	headers  map[string]string
+	resource []otlpAttribute
+	client   *http.Client
+}
+
+// NewOTLPExporter exports the spans as OTLP traces encoded as JSON to url e.g. http://localhost:4318/v1/traces, the
+// resource attributes identify the service and project
+func NewOTLPExporter(url string, headers, resource map[string]string) *otlpExporter {
+	return &otlpExporter{
+		url:      url,
+		headers:  headers,
+		resource: otlpAttributes(resource),
+		client:   http.DefaultClient,
+	}
+}
+
+type otlpAttribute struct {
+	Key   string `json:"key"`
+	Value struct {
+		StringValue string `json:"stringValue"`
+	} `json:"value"`
+}
+
+// otlpAttributes are sorted by key so that the requests are the same each time
+func otlpAttributes(attrs map[string]string) []otlpAttribute {
+	keys := make([]string, 0, len(attrs))
+	for k := range attrs {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	list := make([]otlpAttribute, 0, len(keys))
+	for _, k := range keys {
+		attr := otlpAttribute{Key: k}
+		attr.Value.StringValue = attrs[k]
+		list = append(list, attr)
+	}
+	return list
+}
+
+type otlpStatus struct {
+	Code    int    `json:"code"`
+	Message string `json:"message,omitempty"`
+}
+
+type otlpSpan struct {
+	TraceID           string          `json:"traceId"`
+	SpanID            string          `json:"spanId"`
+	ParentSpanID      string          `json:"parentSpanId,omitempty"`
+	Name              string          `json:"name"`
+	Kind              int             `json:"kind"`
+	StartTimeUnixNano string          `json:"startTimeUnixNano"`
+	EndTimeUnixNano   string          `json:"endTimeUnixNano"`
+	Attributes        []otlpAttribute `json:"attributes"`
+	Status            otlpStatus      `json:"status"`
+}
+
+func (e *otlpExporter) Export(ctx context.Context, spans []Span) error {
+	otlpSpans := make([]otlpSpan, 0, len(spans))
+	for _, s := range spans {
+		status := otlpStatus{Code: otlpStatusOK}
+		if s.Error != "" {
+			status = otlpStatus{Code: otlpStatusError, Message: s.Error}
+		}
+		otlpSpans = append(otlpSpans, otlpSpan{
+			TraceID:           s.TraceID,
+			SpanID:            s.SpanID,
+			ParentSpanID:      s.ParentID,
+			Name:              s.Name,
+			Kind:              otlpSpanKindInternal,
+			StartTimeUnixNano: strconv.FormatInt(s.Start.UnixNano(), 10),
+			EndTimeUnixNano:   strconv.FormatInt(s.End.UnixNano(), 10),
+			Attributes:        otlpAttributes(s.Attributes),
+			Status:            status,
+		})
+	}
+
+	body := map[string]any{
+		"resourceSpans": []map[string]any{{
+			"resource": map[string]any{"attributes": e.resource},
+			"scopeSpans": []map[string]any{{
+				"scope": map[string]string{"name": "gomon"},
+				"spans": otlpSpans,
+			}},
+		}},
+	}
+
+	err := utils.PostJSON(ctx, e.client, e.url, e.headers, body)
+	if err != nil {
+		return fmt.Errorf("exporting to otlp: %w", err)
+	}
+	return nil
+}
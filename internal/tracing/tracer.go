@@ -0,0 +1,346 @@
+package tracing
+
+// gomon is a simple command line tool that watches your files and automatically restarts the application when it detects any changes in the working directory.
+// Copyright (C) 2023 John Dudmesh
+
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+
+// You should have received a copy of the GNU General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>.
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/jdudmesh/gomon/internal/config"
+	"github.com/jdudmesh/gomon/internal/notification"
+	"github.com/jdudmesh/gomon/internal/process"
+	"github.com/jdudmesh/gomon/internal/utils"
+	"github.com/sirupsen/logrus"
+)
+
+var log = logrus.WithField("component", "tracing")
+
+const (
+	queueSize    = 64
+	writeTimeout = 10 * time.Second
+)
+
+// The names of the spans in a restart's trace, the steps of starting the process are named by the process package
+const (
+	spanRestart   = "restart"
+	spanStart     = "start"
+	spanFileEvent = "file event"
+	spanReady     = "first ready"
+)
+
+// cycle is a restart which is being traced, from the first request to the new run being ready
+type cycle struct {
+	root  Span
+	runID string
+	spans []Span
+	// requests is the number of restart requests since the first one e.g. a burst of saved files
+	requests  int
+	runningAt time.Time
+}
+
+// Tracer traces each hard restart, and the first start of the child process, through the file event which caused
+// it, the prestart tasks, the build, spawning the process and the new run becoming ready. The run is ready when it
+// connects to the IPC channel or writes its first line of output, like the restart durations shown in the UI. The
+// traces are exported to an OTLP collector in the background and dropped if the queue is full.
+type Tracer struct {
+	exporter  Exporter
+	lock      sync.Mutex
+	current   *cycle
+	queue     chan []Span
+	dropped   atomic.Int64
+	failing   bool
+	done      chan struct{}
+	wait      sync.WaitGroup
+	closeOnce sync.Once
+}
+
+// New creates a tracer which exports to the collector in the config, it does nothing if tracing isn't enabled
+func New(cfg config.Config) (*Tracer, error) {
+	if !cfg.Tracing.Enabled {
+		return NewTracer(nil), nil
+	}
+
+	secrets := process.NewSecretResolver()
+	url, err := secrets.Resolve(tracesURL(cfg))
+	if err != nil {
+		return nil, fmt.Errorf("tracing: resolving url: %w", err)
+	}
+	headers := map[string]string{}
+	for k, v := range cfg.Tracing.Headers {
+		headers[k], err = secrets.Resolve(v)
+		if err != nil {
+			return nil, fmt.Errorf("tracing: resolving header %s: %w", k, err)
+		}
+	}
+
+	serviceName := cfg.Tracing.ServiceName
+	if serviceName == "" {
+		serviceName = config.DefaultTracingServiceName
+	}
+	resource := map[string]string{
+		"service.name": serviceName,
+		"project":      filepath.Base(cfg.RootDirectory),
+	}
+	if host, err := os.Hostname(); err == nil {
+		resource["host.name"] = host
+	}
+	for k, v := range cfg.Tracing.Attributes {
+		resource[k] = v
+	}
+
+	return NewTracer(NewOTLPExporter(url, headers, resource)), nil
+}
+
+// tracesURL is the configured endpoint, or the one in the standard OpenTelemetry environment variables
+func tracesURL(cfg config.Config) string {
+	if cfg.Tracing.URL != "" {
+		return cfg.Tracing.URL
+	}
+	if url := os.Getenv("OTEL_EXPORTER_OTLP_TRACES_ENDPOINT"); url != "" {
+		return url
+	}
+	if url := os.Getenv("OTEL_EXPORTER_OTLP_ENDPOINT"); url != "" {
+		return url + "/v1/traces"
+	}
+	return config.DefaultTracingURL
+}
+
+// NewTracer creates a tracer which exports the traces with e, or does nothing if e is nil. The exporter runs from
+// the start so that Close can always wait for it to finish.
+func NewTracer(e Exporter) *Tracer {
+	t := &Tracer{
+		exporter: e,
+		queue:    make(chan []Span, queueSize),
+		done:     make(chan struct{}),
+	}
+
+	t.wait.Add(1)
+	go func() {
+		defer t.wait.Done()
+		t.run()
+	}()
+
+	return t
+}
+
+// Start blocks until the tracer is closed
+func (t *Tracer) Start() error {
+	<-t.done
+	return nil
+}
+
+// Close stops tracing once the traces which have already finished have been exported, a restart which is still in
+// progress isn't exported
+func (t *Tracer) Close() error {
+	t.closeOnce.Do(func() {
+		close(t.done)
+		t.wait.Wait()
+	})
+	return nil
+}
+
+func (t *Tracer) QueueStats() map[string]utils.QueueStats {
+	if t.exporter == nil {
+		return map[string]utils.QueueStats{}
+	}
+	return map[string]utils.QueueStats{
+		"tracing": {Depth: len(t.queue), Capacity: cap(t.queue), Dropped: t.dropped.Load()},
+	}
+}
+
+func (t *Tracer) Notify(n notification.Notification) error {
+	if t.exporter == nil {
+		return nil
+	}
+
+	t.lock.Lock()
+	defer t.lock.Unlock()
+
+	switch n.Type {
+	case notification.NotificationTypeHardRestartRequested:
+		if t.current == nil {
+			t.current = newCycle(n.Date, n.Trigger)
+			return nil
+		}
+		if t.current.runID != "" {
+			// the run which is starting is replaced before it was ready
+			t.finish(n.Date, "superseded by another restart")
+			t.current = newCycle(n.Date, n.Trigger)
+			return nil
+		}
+		t.current.requests++
+	case notification.NotificationTypeStartup:
+		if t.current != nil && t.current.runID != "" {
+			t.finish(n.Date, "superseded by another start")
+		}
+		if t.current == nil {
+			// gomon has just started or the child process is being restarted after a crash
+			t.current = newCycle(n.Date, nil)
+			t.current.root.Name = spanStart
+		} else {
+			t.current.add(spanFileEvent, t.current.root.Start, n.Date, "")
+			t.current.root.Attributes["gomon.restart.requests"] = fmt.Sprint(t.current.requests)
+		}
+		t.current.runID = n.ChildProccessID
+		t.current.root.Attributes["gomon.run_id"] = n.ChildProccessID
+	case notification.NotificationTypeRunning:
+		if t.current != nil && t.current.runID == n.ChildProccessID {
+			t.current.runningAt = n.Date
+		}
+	case notification.NotificationTypeHardRestart, notification.NotificationTypeStdOut, notification.NotificationTypeStdErr:
+		// output from prestart tasks and the build comes before the process is running
+		if t.current == nil || t.current.runID != n.ChildProccessID || t.current.runningAt.IsZero() {
+			return nil
+		}
+		t.current.add(spanReady, t.current.runningAt, n.Date, "")
+		t.finish(n.Date, "")
+	case notification.NotificationTypeShutdown, notification.NotificationTypeCrash, notification.NotificationTypeBuildError:
+		if t.current != nil && t.current.runID == n.ChildProccessID {
+			t.finish(n.Date, n.Message)
+		}
+	}
+
+	return nil
+}
+
+// RecordPhase adds a step of starting the run which is being traced, a step which failed ends the trace
+func (t *Tracer) RecordPhase(p process.StartPhase) {
+	if t.exporter == nil {
+		return
+	}
+
+	t.lock.Lock()
+	defer t.lock.Unlock()
+
+	if t.current == nil || t.current.runID != p.RunID {
+		return
+	}
+
+	if p.Err != nil {
+		t.current.add(p.Name, p.Start, p.End, p.Err.Error())
+		t.finish(p.End, p.Err.Error())
+		return
+	}
+	t.current.add(p.Name, p.Start, p.End, "")
+}
+
+func newCycle(requestedAt time.Time, trigger *notification.Trigger) *cycle {
+	c := &cycle{
+		root: Span{
+			TraceID:    newTraceID(),
+			SpanID:     newSpanID(),
+			Name:       spanRestart,
+			Start:      requestedAt,
+			Attributes: map[string]string{},
+		},
+		requests: 1,
+	}
+	if trigger != nil {
+		c.root.Attributes["gomon.trigger.path"] = trigger.Path
+		c.root.Attributes["gomon.trigger.rule"] = trigger.Rule
+		c.root.Attributes["gomon.trigger.event"] = trigger.Event
+	}
+	return c
+}
+
+func (c *cycle) add(name string, start, end time.Time, errMessage string) {
+	c.spans = append(c.spans, Span{
+		TraceID:  c.root.TraceID,
+		SpanID:   newSpanID(),
+		ParentID: c.root.SpanID,
+		Name:     name,
+		Start:    start,
+		End:      end,
+		Error:    errMessage,
+	})
+}
+
+// finish ends the current trace at end and queues it to be exported, errMessage is set if the run didn't become
+// ready. The caller must hold the lock.
+func (t *Tracer) finish(end time.Time, errMessage string) {
+	c := t.current
+	t.current = nil
+
+	c.root.End = end
+	c.root.Error = errMessage
+	spans := append([]Span{c.root}, c.spans...)
+
+	select {
+	case <-t.done:
+		return
+	default:
+	}
+
+	select {
+	case t.queue <- spans:
+	default:
+		t.dropped.Add(1)
+	}
+}
+
+// run exports the traces as they are finished, the ones which are already queued are exported together
+func (t *Tracer) run() {
+	for {
+		select {
+		case spans := <-t.queue:
+			t.export(t.drain(spans))
+		case <-t.done:
+			// export anything left in the queue before exiting
+			select {
+			case spans := <-t.queue:
+				t.export(t.drain(spans))
+			default:
+			}
+			return
+		}
+	}
+}
+
+func (t *Tracer) drain(spans []Span) []Span {
+	for {
+		select {
+		case more := <-t.queue:
+			spans = append(spans, more...)
+		default:
+			return spans
+		}
+	}
+}
+
+func (t *Tracer) export(spans []Span) {
+	ctx, cancel := context.WithTimeout(context.Background(), writeTimeout)
+	defer cancel()
+
+	err := t.exporter.Export(ctx, spans)
+	if err != nil {
+		t.dropped.Add(1)
+		if !t.failing {
+			log.Warnf("exporting traces: %v", err)
+			t.failing = true
+		}
+		return
+	}
+	if t.failing {
+		log.Info("exporting traces has recovered")
+		t.failing = false
+	}
+}
@@ -0,0 +1,144 @@
+package tracing
+
+// gomon is a simple command line tool that watches your files and automatically restarts the application when it detects any changes in the working directory.
+// Copyright (C) 2023 John Dudmesh
+
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+
+// You should have received a copy of the GNU General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>.
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/jdudmesh/gomon/internal/notification"
+	"github.com/jdudmesh/gomon/internal/process"
+)
+
+type testExporter struct {
+	lock  sync.Mutex
+	spans []Span
+}
+
+func (e *testExporter) Export(ctx context.Context, spans []Span) error {
+	e.lock.Lock()
+	defer e.lock.Unlock()
+	e.spans = append(e.spans, spans...)
+	return nil
+}
+
+func TestTracerTracesRestart(t *testing.T) {
+	exporter := &testExporter{}
+	tracer := NewTracer(exporter)
+
+	start := time.Now()
+	at := func(ms int) time.Time {
+		return start.Add(time.Duration(ms) * time.Millisecond)
+	}
+	trigger := &notification.Trigger{Path: "main.go", Rule: "hardReload *.go", Event: "WRITE"}
+	tracer.Notify(notification.Notification{Date: at(0), Type: notification.NotificationTypeHardRestartRequested, Trigger: trigger})
+	tracer.Notify(notification.Notification{Date: at(10), Type: notification.NotificationTypeHardRestartRequested, Trigger: trigger})
+	tracer.Notify(notification.Notification{Date: at(100), ChildProccessID: "run1", Type: notification.NotificationTypeStartup})
+	tracer.RecordPhase(process.StartPhase{RunID: "run1", Name: process.PhasePrestart, Start: at(100), End: at(200)})
+	tracer.Notify(notification.Notification{Date: at(150), ChildProccessID: "run1", Type: notification.NotificationTypeStdOut, Message: "generating"})
+	tracer.RecordPhase(process.StartPhase{RunID: "run1", Name: process.PhaseBuild, Start: at(200), End: at(900)})
+	tracer.RecordPhase(process.StartPhase{RunID: "run1", Name: process.PhaseSpawn, Start: at(900), End: at(910)})
+	tracer.Notify(notification.Notification{Date: at(910), ChildProccessID: "run1", Type: notification.NotificationTypeRunning})
+	tracer.Notify(notification.Notification{Date: at(1000), ChildProccessID: "run1", Type: notification.NotificationTypeHardRestart})
+	tracer.Close()
+
+	names := []string{}
+	for _, s := range exporter.spans {
+		names = append(names, s.Name)
+	}
+	if strings.Join(names, ",") != "restart,file event,prestart,build,process start,first ready" {
+		t.Fatalf("unexpected spans: %v", names)
+	}
+
+	root := exporter.spans[0]
+	if root.ParentID != "" || !root.Start.Equal(at(0)) || !root.End.Equal(at(1000)) || root.Error != "" {
+		t.Errorf("unexpected root span: %+v", root)
+	}
+	if root.Attributes["gomon.trigger.path"] != "main.go" || root.Attributes["gomon.restart.requests"] != "2" || root.Attributes["gomon.run_id"] != "run1" {
+		t.Errorf("unexpected attributes: %v", root.Attributes)
+	}
+	for _, s := range exporter.spans[1:] {
+		if s.TraceID != root.TraceID || s.ParentID != root.SpanID {
+			t.Errorf("expected %s to be a child of the restart", s.Name)
+		}
+	}
+	if ready := exporter.spans[5]; !ready.Start.Equal(at(910)) || !ready.End.Equal(at(1000)) {
+		t.Errorf("unexpected ready span: %+v", ready)
+	}
+}
+
+func TestTracerEndsTraceWhenBuildFails(t *testing.T) {
+	exporter := &testExporter{}
+	tracer := NewTracer(exporter)
+
+	now := time.Now()
+	tracer.Notify(notification.Notification{Date: now, Type: notification.NotificationTypeHardRestartRequested})
+	tracer.Notify(notification.Notification{Date: now, ChildProccessID: "run1", Type: notification.NotificationTypeStartup})
+	tracer.RecordPhase(process.StartPhase{RunID: "run1", Name: process.PhaseBuild, Start: now, End: now, Err: errors.New("build failed")})
+	tracer.Notify(notification.Notification{Date: now, ChildProccessID: "run1", Type: notification.NotificationTypeBuildError, Message: "build failed"})
+	// the next start is traced on its own
+	tracer.Notify(notification.Notification{Date: now, ChildProccessID: "run2", Type: notification.NotificationTypeStartup})
+	tracer.Close()
+
+	if len(exporter.spans) != 3 {
+		t.Fatalf("expected the failed restart to be exported once, got %+v", exporter.spans)
+	}
+	if exporter.spans[0].Error != "build failed" || exporter.spans[2].Error != "build failed" {
+		t.Errorf("expected the restart and build to have failed: %+v", exporter.spans)
+	}
+}
+
+func TestOTLPExporter(t *testing.T) {
+	var body map[string]any
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		json.NewDecoder(r.Body).Decode(&body)
+	}))
+	defer server.Close()
+
+	e := NewOTLPExporter(server.URL, nil, map[string]string{"service.name": "gomon"})
+	err := e.Export(context.Background(), []Span{{
+		TraceID: newTraceID(),
+		SpanID:  newSpanID(),
+		Name:    spanRestart,
+		Start:   time.Unix(1, 0),
+		End:     time.Unix(2, 0),
+		Error:   "crashed",
+	}})
+	if err != nil {
+		t.Fatalf("exporting: %v", err)
+	}
+
+	resourceSpans := body["resourceSpans"].([]any)[0].(map[string]any)
+	attrs, _ := json.Marshal(resourceSpans["resource"])
+	if !strings.Contains(string(attrs), `{"key":"service.name","value":{"stringValue":"gomon"}}`) {
+		t.Errorf("unexpected resource: %s", attrs)
+	}
+	span := resourceSpans["scopeSpans"].([]any)[0].(map[string]any)["spans"].([]any)[0].(map[string]any)
+	if len(span["traceId"].(string)) != 32 || span["startTimeUnixNano"] != "1000000000" || span["endTimeUnixNano"] != "2000000000" {
+		t.Errorf("unexpected span: %v", span)
+	}
+	if status := span["status"].(map[string]any); status["code"] != float64(otlpStatusError) || status["message"] != "crashed" {
+		t.Errorf("unexpected status: %v", status)
+	}
+}
@@ -0,0 +1,510 @@
+package tui
+
+// gomon is a simple command line tool that watches your files and automatically restarts the application when it detects any changes in the working directory.
+// Copyright (C) 2023 John Dudmesh
+
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+
+// You should have received a copy of the GNU General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>.
+
+import (
+	"bufio"
+	"fmt"
+	"os"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/jdudmesh/gomon/internal/config"
+	"github.com/jdudmesh/gomon/internal/notification"
+	"github.com/sirupsen/logrus"
+)
+
+var log = logrus.WithField("component", "tui")
+
+const (
+	maxLines       = 1000
+	redrawInterval = 100 * time.Millisecond
+)
+
+const (
+	colourReset  = "\x1b[0m"
+	colourRed    = "\x1b[31m"
+	colourGreen  = "\x1b[32m"
+	colourYellow = "\x1b[33m"
+	colourBlue   = "\x1b[36m"
+	colourGray   = "\x1b[37m"
+	reverseVideo = "\x1b[7m"
+)
+
+var colourMap = map[notification.NotificationType]string{
+	notification.NotificationTypeStartup:         colourBlue,
+	notification.NotificationTypeShutdown:        colourBlue,
+	notification.NotificationTypeHardRestart:     colourBlue,
+	notification.NotificationTypeSoftRestart:     colourBlue,
+	notification.NotificationTypeIPC:             colourBlue,
+	notification.NotificationTypeStdOut:          colourGreen,
+	notification.NotificationTypeStdErr:          colourRed,
+	notification.NotificationTypeOOBTaskStartup:  colourYellow,
+	notification.NotificationTypeOOBTaskStdOut:   colourYellow,
+	notification.NotificationTypeOOBTaskStdErr:   colourYellow,
+	notification.NotificationTypeCrash:           colourRed,
+	notification.NotificationTypeUnhealthy:       colourRed,
+	notification.NotificationTypeRunning:         colourBlue,
+	notification.NotificationTypeSystemError:     colourRed,
+	notification.NotificationTypeOOBTaskComplete: colourYellow,
+	notification.NotificationTypeBuildError:      colourRed,
+	notification.NotificationTypeLogEvent:        colourBlue,
+	notification.NotificationTypeTestPass:        colourGreen,
+	notification.NotificationTypeTestFail:        colourRed,
+}
+
+type Database interface {
+	FindNotifications(runID, stm, level, filter string) ([][]*notification.Notification, error)
+	FindRuns(label string) ([]*notification.Notification, error)
+	StorageWarning() string
+}
+
+type line struct {
+	date    time.Time
+	colour  string
+	message string
+}
+
+// taskProgress is the state of an out of band task shown in the task panel
+type taskProgress struct {
+	title    string
+	lastLine string
+	isDone   bool
+}
+
+type tui struct {
+	isEnabled   bool
+	db          Database
+	callbackFn  notification.NotificationCallback
+	lines       []line
+	tasks       []*taskProgress
+	taskIndex   map[string]*taskProgress
+	viewLines   []line
+	runs        []*notification.Notification
+	selectedRun int
+	showHistory bool
+	currentRun  string
+	state       string
+	isDirty     bool
+	lock        sync.Mutex
+	done        chan struct{}
+	closeOnce   sync.Once
+	restoreFn   func() error
+}
+
+func New(cfg config.Config, db Database, callbackFn notification.NotificationCallback) (*tui, error) {
+	t := &tui{
+		isEnabled:  cfg.TUI,
+		db:         db,
+		callbackFn: callbackFn,
+		state:      "starting",
+		taskIndex:  map[string]*taskProgress{},
+		done:       make(chan struct{}),
+	}
+
+	return t, nil
+}
+
+func (t *tui) Enabled() bool {
+	return t.isEnabled
+}
+
+func (t *tui) Start() error {
+	if !t.isEnabled {
+		return nil
+	}
+
+	fd := int(os.Stdin.Fd())
+	restoreFn, err := enterCbreakMode(fd)
+	if err != nil {
+		return fmt.Errorf("initialising terminal: %w", err)
+	}
+	t.restoreFn = restoreFn
+
+	// switch to the alternate screen and hide the cursor
+	os.Stdout.WriteString("\x1b[?1049h\x1b[?25l")
+
+	// gomon's own log output would corrupt the display so it is shown inline instead
+	logrus.SetOutput(t)
+
+	go t.readKeys()
+
+	ticker := time.NewTicker(redrawInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ticker.C:
+			t.draw()
+		case <-t.done:
+			return nil
+		}
+	}
+}
+
+func (t *tui) Close() error {
+	if !t.isEnabled {
+		return nil
+	}
+
+	t.closeOnce.Do(func() {
+		close(t.done)
+		logrus.SetOutput(os.Stderr)
+		os.Stdout.WriteString("\x1b[?25h\x1b[?1049l")
+		if t.restoreFn != nil {
+			err := t.restoreFn()
+			if err != nil {
+				log.Errorf("restoring terminal: %v", err)
+			}
+		}
+	})
+
+	return nil
+}
+
+func (t *tui) Notify(n notification.Notification) error {
+	if !t.isEnabled {
+		return nil
+	}
+
+	t.lock.Lock()
+	defer t.lock.Unlock()
+
+	switch n.Type {
+	case notification.NotificationTypeStartup:
+		t.currentRun = n.ChildProccessID
+		t.state = "running"
+	case notification.NotificationTypeShutdown:
+		if t.state != "crashed" {
+			t.state = "stopped"
+		}
+	case notification.NotificationTypeCrash:
+		t.state = "crashed"
+	case notification.NotificationTypeUnhealthy:
+		t.state = "unhealthy"
+	case notification.NotificationTypeHardRestartRequested:
+		t.state = "restarting"
+	case notification.NotificationTypeOOBTaskRequested, notification.NotificationTypeOOBTaskCancelRequested, notification.NotificationTypeSoftRestartRequested:
+		// these are requests rather than output
+		return nil
+	}
+
+	if n.TaskID != "" {
+		t.updateTask(n)
+	}
+
+	colour, ok := colourMap[n.Type]
+	if !ok {
+		colour = colourGray
+	}
+
+	scanner := bufio.NewScanner(strings.NewReader(n.Message))
+	for scanner.Scan() {
+		t.lines = append(t.lines, line{date: n.Date, colour: colour, message: scanner.Text()})
+	}
+	if len(t.lines) > maxLines {
+		t.lines = t.lines[len(t.lines)-maxLines:]
+	}
+	t.isDirty = true
+
+	return nil
+}
+
+// updateTask tracks the progress of out of band tasks, must be called with the lock held
+func (t *tui) updateTask(n notification.Notification) {
+	switch n.Type {
+	case notification.NotificationTypeOOBTaskStartup:
+		isIdle := true
+		for _, task := range t.tasks {
+			isIdle = isIdle && task.isDone
+		}
+		if isIdle {
+			// clear out any finished tasks
+			t.tasks = nil
+			t.taskIndex = map[string]*taskProgress{}
+		}
+		task := &taskProgress{title: n.Message}
+		t.tasks = append(t.tasks, task)
+		t.taskIndex[n.TaskID] = task
+	case notification.NotificationTypeOOBTaskStdOut, notification.NotificationTypeOOBTaskStdErr:
+		if task, ok := t.taskIndex[n.TaskID]; ok {
+			task.lastLine = n.Message
+		}
+	case notification.NotificationTypeOOBTaskComplete:
+		if task, ok := t.taskIndex[n.TaskID]; ok {
+			task.lastLine = n.Message
+			task.isDone = true
+		}
+	}
+}
+
+// Write receives gomon's own log output
+func (t *tui) Write(p []byte) (int, error) {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+
+	for _, msg := range strings.Split(strings.TrimRight(string(p), "\n"), "\n") {
+		t.lines = append(t.lines, line{date: time.Now(), colour: colourGray, message: "gomon: " + stripANSI(msg)})
+	}
+	t.isDirty = true
+
+	return len(p), nil
+}
+
+func (t *tui) readKeys() {
+	buf := make([]byte, 3)
+	for {
+		n, err := os.Stdin.Read(buf)
+		if err != nil {
+			return
+		}
+		select {
+		case <-t.done:
+			return
+		default:
+		}
+		t.handleKey(string(buf[:n]))
+	}
+}
+
+func (t *tui) handleKey(key string) {
+	switch key {
+	case "r":
+		t.request(notification.NotificationTypeHardRestartRequested)
+	case "s":
+		t.request(notification.NotificationTypeSoftRestartRequested)
+	case "q":
+		t.request(notification.NotificationTypeShutdownRequested)
+	case "h":
+		t.toggleHistory()
+	case "l":
+		t.lock.Lock()
+		t.viewLines = nil
+		t.showHistory = false
+		t.isDirty = true
+		t.lock.Unlock()
+	case "k", "\x1b[A":
+		t.moveSelection(-1)
+	case "j", "\x1b[B":
+		t.moveSelection(1)
+	case "\n", "\r":
+		t.viewSelectedRun()
+	}
+}
+
+func (t *tui) request(notifType notification.NotificationType) {
+	t.lock.Lock()
+	currentRun := t.currentRun
+	t.lock.Unlock()
+
+	err := t.callbackFn(notification.Notification{
+		ID:              notification.NextID(),
+		Date:            time.Now(),
+		ChildProccessID: currentRun,
+		Type:            notifType,
+		Message:         "tui",
+	})
+	if err != nil {
+		log.Errorf("sending request: %v", err)
+	}
+}
+
+func (t *tui) toggleHistory() {
+	runs, err := t.db.FindRuns("")
+	if err != nil {
+		log.Errorf("finding runs: %v", err)
+		return
+	}
+
+	t.lock.Lock()
+	defer t.lock.Unlock()
+
+	t.showHistory = !t.showHistory
+	t.runs = runs
+	t.selectedRun = 0
+	t.isDirty = true
+}
+
+func (t *tui) moveSelection(delta int) {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+
+	if !t.showHistory || len(t.runs) == 0 {
+		return
+	}
+
+	t.selectedRun += delta
+	if t.selectedRun < 0 {
+		t.selectedRun = 0
+	}
+	if t.selectedRun >= len(t.runs) {
+		t.selectedRun = len(t.runs) - 1
+	}
+	t.isDirty = true
+}
+
+func (t *tui) viewSelectedRun() {
+	t.lock.Lock()
+	if !t.showHistory || len(t.runs) == 0 {
+		t.lock.Unlock()
+		return
+	}
+	runID := t.runs[t.selectedRun].ChildProccessID
+	t.lock.Unlock()
+
+	events, err := t.db.FindNotifications(runID, "all", "", "")
+	if err != nil {
+		log.Errorf("finding notifications: %v", err)
+		return
+	}
+
+	viewLines := []line{}
+	for _, run := range events {
+		for _, n := range run {
+			colour, ok := colourMap[n.Type]
+			if !ok {
+				colour = colourGray
+			}
+			viewLines = append(viewLines, line{date: n.Date, colour: colour, message: n.Message})
+		}
+	}
+
+	t.lock.Lock()
+	defer t.lock.Unlock()
+	t.viewLines = viewLines
+	t.showHistory = false
+	t.isDirty = true
+}
+
+func (t *tui) draw() {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+
+	if !t.isDirty {
+		return
+	}
+	t.isDirty = false
+
+	width, height, err := terminalSize(int(os.Stdout.Fd()))
+	if err != nil || width == 0 || height < 3 {
+		width, height = 80, 24
+	}
+
+	b := strings.Builder{}
+	b.WriteString("\x1b[H")
+
+	header := fmt.Sprintf(" gomon | %s | run %s", t.state, t.currentRun)
+	if t.viewLines != nil {
+		header += " | viewing history, press l for live output"
+	}
+	b.WriteString(reverseVideo + pad(header, width) + colourReset + "\x1b[K\n")
+
+	// events are being lost so this stays on screen rather than scrolling away with the output
+	warningLines := 0
+	if warning := t.db.StorageWarning(); warning != "" {
+		b.WriteString(colourRed + truncate(" "+warning, width) + colourReset + "\x1b[K\n")
+		warningLines = 1
+	}
+
+	for _, task := range t.tasks {
+		status := "[running] "
+		if task.isDone {
+			status = "[done]    "
+		}
+		b.WriteString(colourYellow + truncate(status+task.title+" | "+stripANSI(task.lastLine), width) + colourReset + "\x1b[K\n")
+	}
+
+	bodyHeight := height - 2 - len(t.tasks) - warningLines
+	body := []string{}
+	switch {
+	case t.showHistory:
+		body = append(body, "runs (most recent first), enter to view:")
+		for i, r := range t.runs {
+			marker := "  "
+			if i == t.selectedRun {
+				marker = "> "
+			}
+			body = append(body, truncate(marker+r.Date.Format("2006-01-02 15:04:05")+"  "+r.ChildProccessID, width))
+		}
+		if len(body) > bodyHeight {
+			start := t.selectedRun + 1 - bodyHeight + 1
+			if start < 0 {
+				start = 0
+			}
+			body = body[start:]
+		}
+	default:
+		lines := t.lines
+		if t.viewLines != nil {
+			lines = t.viewLines
+		}
+		if len(lines) > bodyHeight {
+			lines = lines[len(lines)-bodyHeight:]
+		}
+		for _, l := range lines {
+			body = append(body, l.colour+truncate(l.date.Format("15:04:05")+" "+stripANSI(l.message), width)+colourReset)
+		}
+	}
+
+	for i := 0; i < bodyHeight; i++ {
+		if i < len(body) {
+			b.WriteString(body[i])
+		}
+		b.WriteString("\x1b[K\n")
+	}
+
+	footer := " r hard restart | s soft restart | h history | q quit"
+	b.WriteString(reverseVideo + pad(footer, width) + colourReset + "\x1b[K")
+
+	os.Stdout.WriteString(b.String())
+}
+
+func pad(s string, width int) string {
+	s = truncate(s, width)
+	if n := width - len([]rune(s)); n > 0 {
+		s += strings.Repeat(" ", n)
+	}
+	return s
+}
+
+func truncate(s string, width int) string {
+	r := []rune(s)
+	if len(r) > width {
+		return string(r[:width])
+	}
+	return s
+}
+
+// stripANSI removes escape sequences which would otherwise break the layout
+func stripANSI(s string) string {
+	b := strings.Builder{}
+	inEscape := false
+	for _, r := range s {
+		switch {
+		case r == '\x1b':
+			inEscape = true
+		case inEscape:
+			if (r >= 'a' && r <= 'z') || (r >= 'A' && r <= 'Z') {
+				inEscape = false
+			}
+		default:
+			b.WriteRune(r)
+		}
+	}
+	return b.String()
+}
@@ -0,0 +1,42 @@
+package utils
+
+// gomon is a simple command line tool that watches your files and automatically restarts the application when it detects any changes in the working directory.
+// Copyright (C) 2023 John Dudmesh
+
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+
+// You should have received a copy of the GNU General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>.
+
+import (
+	"regexp"
+)
+
+// ansiEscapePattern matches terminal escape sequences (colours, cursor movement and window titles)
+var ansiEscapePattern = regexp.MustCompile(`\x1b\[[0-9;?]*[ -/]*[@-~]|\x1b\][^\x07\x1b]*(?:\x07|\x1b\\)|\x1b[@-Z\\-_]`)
+
+// ansiColourPattern matches the SGR sequences which set colours and text styles
+var ansiColourPattern = regexp.MustCompile(`^\x1b\[[0-9;]*m$`)
+
+// StripANSI removes every terminal escape sequence
+func StripANSI(s string) string {
+	return ansiEscapePattern.ReplaceAllString(s, "")
+}
+
+// StripANSIControls removes escape sequences which only make sense on a terminal but keeps colours and styles
+func StripANSIControls(s string) string {
+	return ansiEscapePattern.ReplaceAllStringFunc(s, func(seq string) string {
+		if ansiColourPattern.MatchString(seq) {
+			return seq
+		}
+		return ""
+	})
+}
@@ -0,0 +1,76 @@
+package utils
+
+// gomon is a simple command line tool that watches your files and automatically restarts the application when it detects any changes in the working directory.
+// Copyright (C) 2023 John Dudmesh
+
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+
+// You should have received a copy of the GNU General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>.
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// compilerErrorPattern matches the file:line:col: message lines written by the Go toolchain
+var compilerErrorPattern = regexp.MustCompile(`^\s*(\S+\.go):(\d+):(?:(\d+):)? (.*)$`)
+
+// BuildErrorLine is a line of compiler output, File is empty for lines which don't refer to a source file
+// e.g. the "# package" headers
+type BuildErrorLine struct {
+	Text   string
+	File   string
+	Line   int
+	Column int
+}
+
+func (l BuildErrorLine) Location() string {
+	if l.Column > 0 {
+		return fmt.Sprintf("%s:%d:%d", l.File, l.Line, l.Column)
+	}
+	return fmt.Sprintf("%s:%d", l.File, l.Line)
+}
+
+// IsCompilerError returns true if the line is a compiler error referring to a source file
+func IsCompilerError(line string) bool {
+	return compilerErrorPattern.MatchString(line)
+}
+
+// IsCompilerOutput returns true if the line is part of the toolchain's error output
+func IsCompilerOutput(line string) bool {
+	return strings.HasPrefix(line, "# ") || line == "too many errors" || IsCompilerError(line)
+}
+
+// ParseBuildErrors splits compiler output into lines, extracting the source location where present
+func ParseBuildErrors(output string) []BuildErrorLine {
+	lines := []BuildErrorLine{}
+	for _, text := range strings.Split(strings.TrimRight(output, "\r\n"), "\n") {
+		text = strings.TrimSuffix(text, "\r")
+		match := compilerErrorPattern.FindStringSubmatch(text)
+		if match == nil {
+			lines = append(lines, BuildErrorLine{Text: text})
+			continue
+		}
+
+		line, _ := strconv.Atoi(match[2])
+		col, _ := strconv.Atoi(match[3])
+		lines = append(lines, BuildErrorLine{
+			Text:   match[4],
+			File:   match[1],
+			Line:   line,
+			Column: col,
+		})
+	}
+	return lines
+}
@@ -0,0 +1,61 @@
+package utils
+
+// gomon is a simple command line tool that watches your files and automatically restarts the application when it detects any changes in the working directory.
+// Copyright (C) 2023 John Dudmesh
+
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+
+// You should have received a copy of the GNU General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>.
+
+import (
+	"testing"
+)
+
+func TestParseBuildErrors(t *testing.T) {
+	output := "# github.com/example/app\n./main.go:12:2: undefined: foo\ninternal/db.go:7: syntax error: unexpected }\n"
+
+	lines := ParseBuildErrors(output)
+	if len(lines) != 3 {
+		t.Fatalf("expected 3 lines, got %d", len(lines))
+	}
+
+	if lines[0].File != "" || lines[0].Text != "# github.com/example/app" {
+		t.Errorf("unexpected header line: %+v", lines[0])
+	}
+
+	if lines[1].File != "./main.go" || lines[1].Line != 12 || lines[1].Column != 2 || lines[1].Text != "undefined: foo" {
+		t.Errorf("unexpected error line: %+v", lines[1])
+	}
+	if lines[1].Location() != "./main.go:12:2" {
+		t.Errorf("unexpected location: %s", lines[1].Location())
+	}
+
+	if lines[2].Column != 0 || lines[2].Location() != "internal/db.go:7" {
+		t.Errorf("unexpected error line without column: %+v", lines[2])
+	}
+}
+
+func TestIsCompilerOutput(t *testing.T) {
+	cases := map[string]bool{
+		"# github.com/example/app":             true,
+		"./main.go:12:2: undefined: foo":       true,
+		"too many errors":                      true,
+		"listening on :8080":                   false,
+		"2024/01/02 15:04:05 main.go:12: boom": false,
+	}
+
+	for line, expected := range cases {
+		if IsCompilerOutput(line) != expected {
+			t.Errorf("IsCompilerOutput(%q) expected %v", line, expected)
+		}
+	}
+}
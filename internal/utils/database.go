@@ -17,44 +17,360 @@ package utils
 // along with this program.  If not, see <https://www.gnu.org/licenses/>.
 
 import (
+	"database/sql"
+	"errors"
 	"fmt"
 	"os"
 	"path"
+	"slices"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
 
 	"github.com/jdudmesh/gomon/internal/config"
 	"github.com/jdudmesh/gomon/internal/notification"
 	"github.com/jmoiron/sqlx"
+	"github.com/sirupsen/logrus"
 )
 
+var log = logrus.WithField("component", "utils")
+
+// ErrRunNotFound is returned when a run has no events in the database
+var ErrRunNotFound = errors.New("run not found")
+
+// ErrEventNotFound is returned when an event referred to by its ID doesn't exist
+var ErrEventNotFound = errors.New("event not found")
+
+var (
+	ErrDatabaseCorrupt = errors.New("database is corrupt")
+	ErrDatabaseLocked  = errors.New("database is locked by another process")
+)
+
+// ErrSQLiteUnavailable is returned when opening a SQLite database if gomon was built without cgo
+var ErrSQLiteUnavailable = fmt.Errorf("gomon was built without cgo so SQLite isn't available, use storage type %s or %s", config.StorageJSONL, config.StorageMemory)
+
+// MaxRangeEvents is the most events returned for a range of the log
+const MaxRangeEvents = 10000
+
+// LatestRun can be used in place of a run ID to refer to the most recent run
+const LatestRun = "latest"
+
+// MaxSummaryLines is the most lines of stderr output included in a run summary
+const MaxSummaryLines = 2000
+
 type Database struct {
-	db *sqlx.DB
+	db         *sqlx.DB
+	writeQueue chan notification.Notification
+	// batchSize and batchInterval bound the transactions events are written in
+	batchSize     int
+	batchInterval time.Duration
+	done          chan struct{}
+	writerWait    sync.WaitGroup
+	dropped       atomic.Int64
+	// lastWriteErr holds the error from the most recent insert, nil if it succeeded
+	lastWriteErr atomic.Pointer[error]
+	// recoveryWarning is set if the database had to be recreated on startup
+	recoveryWarning string
+	retention
+	dbPath string
+	// hasSearch is true if the full text search index is available
+	hasSearch bool
+	// ring replaces the database file once it reaches the hard limit, it only holds the most recent events
+	ring atomic.Pointer[sqlx.DB]
+	// triggers is only used by the writer
+	triggers triggerLinker
+}
+
+// maxPendingTriggers is the most file changes kept while waiting for a hard restart, only the most recent are kept
+const maxPendingTriggers = 100
+
+// triggerLinker links the file changes which caused a restart to the run they affected, a hard restart affects the
+// next run to start and a soft restart affects the current one
+type triggerLinker struct {
+	currentRun      string
+	pendingTriggers []notification.Notification
+}
+
+// link is called with each event in the order they happened, insert is called with each file change once its run
+// is known
+func (l *triggerLinker) link(n notification.Notification, insert func(runID string, t notification.Notification)) {
+	switch {
+	case n.Type == notification.NotificationTypeStartup:
+		l.currentRun = n.ChildProccessID
+		for _, t := range l.pendingTriggers {
+			insert(n.ChildProccessID, t)
+		}
+		l.pendingTriggers = nil
+	case n.Trigger == nil:
+		return
+	case n.Type == notification.NotificationTypeHardRestartRequested:
+		l.pendingTriggers = append(l.pendingTriggers, n)
+		if len(l.pendingTriggers) > maxPendingTriggers {
+			l.pendingTriggers = l.pendingTriggers[1:]
+		}
+	case l.currentRun != "":
+		insert(l.currentRun, n)
+	}
 }
 
-func NewDatabase(config config.Config) (*Database, error) {
-	dataPath := path.Join(config.RootDirectory, "./.gomon")
-	_, err := os.Stat(dataPath)
+// RunTrigger is a file change which restarted a run, Type is the restart request i.e. hard or soft
+type RunTrigger struct {
+	notification.Trigger
+	ChildProccessID string                        `json:"childProcessId" db:"child_process_id"`
+	Date            time.Time                     `json:"createdAt" db:"created_at"`
+	Type            notification.NotificationType `json:"type" db:"request_type"`
+}
+
+func NewDatabase(cfg config.Config) (*Database, error) {
+	dataPath, err := dataDirectory(cfg.RootDirectory)
 	if err != nil {
-		if os.IsNotExist(err) {
-			err = os.Mkdir(dataPath, 0755)
-			if err != nil {
-				return nil, fmt.Errorf("creating .gomon directory: %w", err)
-			}
-		} else {
-			return nil, fmt.Errorf("checking for .gomon directory: %w", err)
+		return nil, err
+	}
+
+	dbPath := path.Join(dataPath, "./gomon.db")
+	recoveredPath := ""
+
+	db, err := openDatabase(dbPath)
+	if err != nil && isCorrupt(err) {
+		log.Warnf("database is corrupt, recreating: %v", err)
+		recoveredPath, err = moveAside(dbPath)
+		if err != nil {
+			return nil, fmt.Errorf("moving corrupt database: %w", err)
 		}
+		db, err = openDatabase(dbPath)
+	}
+	if err != nil {
+		return nil, err
 	}
 
-	db, err := sqlx.Connect("sqlite3", path.Join(dataPath, "./gomon.db"))
+	hasSearch, err := createSearchIndex(db)
+	if err != nil {
+		db.Close()
+		return nil, err
+	}
+	if !hasSearch {
+		log.Warn("SQLite was built without FTS5 (build gomon with -tags sqlite_fts5), search will be slower")
+	}
+
+	d := newDatabase(cfg, db, dbPath, hasSearch)
+	d.hardLimit = int64(cfg.UI.Retention.HardLimitMB) * 1024 * 1024
+	if recoveredPath != "" {
+		d.recoveryWarning = fmt.Sprintf("the event database was corrupt and has been recreated, the old database was moved to %s", recoveredPath)
+	}
+
+	d.writerWait.Add(1)
+	go d.runWriter()
+
+	return d, nil
+}
+
+// NewMemoryDatabase keeps the events in an in memory database which is discarded when gomon exits, e.g. for
+// ephemeral CI runs. Nothing is written to the .gomon directory and the hard limit doesn't apply.
+func NewMemoryDatabase(cfg config.Config) (*Database, error) {
+	db, hasSearch, err := openMemoryDatabase()
+	if err != nil {
+		return nil, err
+	}
+
+	d := newDatabase(cfg, db, "", hasSearch)
+	d.writerWait.Add(1)
+	go d.runWriter()
+
+	return d, nil
+}
+
+func newDatabase(cfg config.Config, db *sqlx.DB, dbPath string, hasSearch bool) *Database {
+	bufferSize := cfg.Limits.DBBuffer
+	if bufferSize <= 0 {
+		bufferSize = config.DefaultDBBuffer
+	}
+
+	d := &Database{
+		db:            db,
+		writeQueue:    make(chan notification.Notification, bufferSize),
+		batchSize:     cfg.Limits.DBBatchSize,
+		batchInterval: time.Duration(cfg.Limits.DBBatchInterval) * time.Millisecond,
+		done:          make(chan struct{}),
+		dbPath:        dbPath,
+		hasSearch:     hasSearch,
+	}
+	d.retention.configure(cfg)
+
+	if d.batchSize <= 0 {
+		d.batchSize = config.DefaultDBBatchSize
+	}
+	if d.batchInterval <= 0 {
+		d.batchInterval = config.DefaultDBBatchInterval * time.Millisecond
+	}
+
+	return d
+}
+
+// openMemoryDatabase creates an empty in memory database with the full schema
+func openMemoryDatabase() (*sqlx.DB, bool, error) {
+	if !sqliteSupported {
+		return nil, false, ErrSQLiteUnavailable
+	}
+
+	db, err := sqlx.Connect(sqliteDriver, ":memory:")
+	if err != nil {
+		return nil, false, fmt.Errorf("creating in memory database: %w", err)
+	}
+	// every connection to :memory: is a separate database
+	db.SetMaxOpenConns(1)
+
+	_, err = db.Exec(schema)
+	if err == nil {
+		err = addLevelColumn(db)
+	}
+	if err != nil {
+		db.Close()
+		return nil, false, fmt.Errorf("creating db schema: %w", err)
+	}
+
+	hasSearch, err := createSearchIndex(db)
+	if err != nil {
+		db.Close()
+		return nil, false, err
+	}
+
+	return db, hasSearch, nil
+}
+
+func openDatabase(dbPath string) (*sqlx.DB, error) {
+	if !sqliteSupported {
+		return nil, ErrSQLiteUnavailable
+	}
+
+	db, err := sqlx.Connect(sqliteDriver, dbPath)
 	if err != nil {
 		return nil, fmt.Errorf("connecting to sqlite: %w", err)
 	}
 
+	result := ""
+	err = db.Get(&result, "PRAGMA quick_check;")
+	if err == nil && result != "ok" {
+		err = fmt.Errorf("integrity check failed: %s: %w", result, errSQLiteCorrupt)
+	}
+	if err != nil {
+		db.Close()
+		return nil, fmt.Errorf("checking database: %w", err)
+	}
+
+	// readers don't block the writer in WAL mode, and synchronous=NORMAL only syncs at checkpoints which is safe
+	// with WAL, at worst the most recent events are lost in a power cut
+	_, err = db.Exec("PRAGMA journal_mode=WAL; PRAGMA synchronous=NORMAL;")
+	if err != nil {
+		db.Close()
+		return nil, fmt.Errorf("enabling WAL mode: %w", err)
+	}
+
 	_, err = db.Exec(schema)
+	if err == nil {
+		err = addLevelColumn(db)
+	}
 	if err != nil {
+		db.Close()
 		return nil, fmt.Errorf("creating db schema: %w", err)
 	}
 
-	return &Database{db: db}, nil
+	return db, nil
+}
+
+// addLevelColumn adds the log level to a database created by an older version of gomon, the levels of the
+// existing output are detected so that they can be filtered too
+func addLevelColumn(db *sqlx.DB) error {
+	hasLevel := false
+	err := db.Get(&hasLevel, "SELECT COUNT(*) > 0 FROM pragma_table_info('notifs') WHERE name = 'level';")
+	if err != nil {
+		return fmt.Errorf("checking for level column: %w", err)
+	}
+
+	if !hasLevel {
+		_, err = db.Exec(`
+			ALTER TABLE notifs ADD COLUMN level TEXT NOT NULL DEFAULT '';
+			UPDATE notifs SET level = detect_level(event_data) WHERE event_type IN (?, ?);
+			UPDATE notifs SET level = ? WHERE event_type = ?;`,
+			notification.NotificationTypeStdOut, notification.NotificationTypeStdErr,
+			notification.LevelError, notification.NotificationTypeBuildError)
+		if err != nil {
+			return fmt.Errorf("adding level column: %w", err)
+		}
+	}
+
+	_, err = db.Exec("CREATE INDEX IF NOT EXISTS notifications_child_process_id_level ON notifs(child_process_id, level);")
+	if err != nil {
+		return fmt.Errorf("creating level index: %w", err)
+	}
+	return nil
+}
+
+// CheckDatabase checks that the event database isn't corrupt or locked by another process without modifying it,
+// unlike NewDatabase it doesn't recreate a corrupt database
+func CheckDatabase(rootDirectory string) error {
+	dbPath := path.Join(rootDirectory, ".gomon", "gomon.db")
+	if _, err := os.Stat(dbPath); os.IsNotExist(err) {
+		return nil
+	}
+	if !sqliteSupported {
+		return ErrSQLiteUnavailable
+	}
+
+	// fail straight away rather than waiting for a lock to be released
+	db, err := sqlx.Connect(sqliteDriver, dbPath+"?_busy_timeout=0")
+	if err != nil {
+		return fmt.Errorf("connecting to sqlite: %w", err)
+	}
+	defer db.Close()
+	db.SetMaxOpenConns(1)
+
+	result := ""
+	err = db.Get(&result, "PRAGMA quick_check;")
+	if err == nil && result != "ok" {
+		return fmt.Errorf("%w: integrity check failed: %s", ErrDatabaseCorrupt, result)
+	}
+	if err != nil {
+		if isCorrupt(err) {
+			return fmt.Errorf("%w: %v", ErrDatabaseCorrupt, err)
+		}
+		return fmt.Errorf("checking database: %w", err)
+	}
+
+	_, err = db.Exec("BEGIN IMMEDIATE;")
+	if err != nil {
+		if isLocked(err) {
+			return fmt.Errorf("%w: %v", ErrDatabaseLocked, err)
+		}
+		return fmt.Errorf("checking database lock: %w", err)
+	}
+	_, err = db.Exec("ROLLBACK;")
+	if err != nil {
+		return fmt.Errorf("checking database lock: %w", err)
+	}
+
+	return nil
+}
+
+// moveAside renames the database (and any journal files) so that a fresh one can be created
+func moveAside(dbPath string) (string, error) {
+	corruptPath := fmt.Sprintf("%s.corrupt-%s", dbPath, time.Now().Format("20060102-150405"))
+	err := os.Rename(dbPath, corruptPath)
+	if err != nil {
+		return "", err
+	}
+	for _, suffix := range []string{"-wal", "-shm", "-journal"} {
+		err = os.Rename(dbPath+suffix, corruptPath+suffix)
+		if err != nil && !os.IsNotExist(err) {
+			return "", err
+		}
+	}
+	return corruptPath, nil
+}
+
+// RecoveryWarning returns a message if the database was recreated because it was corrupt
+func (d *Database) RecoveryWarning() string {
+	return d.recoveryWarning
 }
 
 var schema = `
@@ -63,27 +379,609 @@ CREATE TABLE IF NOT EXISTS notifs (
 	created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
 	child_process_id TEXT NOT NULL,
 	event_type TEXT NOT NULL,
-	event_data TEXT NOT NULL
+	event_data TEXT NOT NULL,
+	level TEXT NOT NULL DEFAULT ''
 );
 CREATE INDEX IF NOT EXISTS notifications_child_process_id ON notifs(child_process_id);
 CREATE INDEX IF NOT EXISTS notifications_event_type ON notifs(event_type);
+CREATE INDEX IF NOT EXISTS notifications_event_type_created_at ON notifs(event_type, created_at);
+CREATE INDEX IF NOT EXISTS notifications_child_process_id_event_type ON notifs(child_process_id, event_type, created_at);
+CREATE TABLE IF NOT EXISTS triggers (
+	id TEXT PRIMARY KEY,
+	created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+	child_process_id TEXT NOT NULL,
+	request_type TEXT NOT NULL,
+	path TEXT NOT NULL,
+	rule TEXT NOT NULL,
+	event TEXT NOT NULL
+);
+CREATE INDEX IF NOT EXISTS triggers_child_process_id ON triggers(child_process_id);
+CREATE TABLE IF NOT EXISTS resources (
+	created_at TIMESTAMP NOT NULL,
+	child_process_id TEXT NOT NULL,
+	rss INTEGER NOT NULL,
+	cpu REAL NOT NULL,
+	goroutines INTEGER,
+	heap_alloc INTEGER
+);
+CREATE INDEX IF NOT EXISTS resources_child_process_id ON resources(child_process_id, created_at);
+CREATE TABLE IF NOT EXISTS restarts (
+	child_process_id TEXT NOT NULL,
+	requested_at TIMESTAMP NOT NULL,
+	ready_at TIMESTAMP NOT NULL,
+	duration_ms INTEGER NOT NULL
+);
+CREATE INDEX IF NOT EXISTS restarts_ready_at ON restarts(ready_at);
+CREATE TABLE IF NOT EXISTS run_labels (
+	child_process_id TEXT NOT NULL,
+	label TEXT NOT NULL,
+	created_at TIMESTAMP NOT NULL,
+	PRIMARY KEY (child_process_id, label)
+);
+CREATE INDEX IF NOT EXISTS run_labels_label ON run_labels(label);
 `
 
 func (d *Database) Close() error {
+	close(d.done)
+	d.writerWait.Wait()
+	if ring := d.ring.Load(); ring != nil {
+		ring.Close()
+	}
 	return d.db.Close()
 }
 
+// conn returns the database events are written to and read from, this is an in memory database once the
+// hard limit has been reached
+func (d *Database) conn() *sqlx.DB {
+	if ring := d.ring.Load(); ring != nil {
+		return ring
+	}
+	return d.db
+}
+
+// Notify queues the notification for writing, if the queue is full then lines of output are dropped rather than
+// allowing memory usage to grow without bound, other events wait for space so that no run goes missing from history
 func (d *Database) Notify(n notification.Notification) error {
-	_, err := d.db.NamedExec(`
-		INSERT INTO notifs (id, created_at, child_process_id, event_type, event_data)
-		VALUES (:id, :created_at, :child_process_id, :event_type, :event_data)
-	`, n)
+	select {
+	case <-d.done:
+		return nil
+	default:
+	}
+
+	select {
+	case d.writeQueue <- n:
+		return nil
+	default:
+	}
+
+	if droppable(n.Type) {
+		d.dropped.Add(1)
+		return nil
+	}
+	select {
+	case d.writeQueue <- n:
+	case <-d.done:
+	}
+	return nil
+}
+
+func (d *Database) QueueStats() map[string]QueueStats {
+	return map[string]QueueStats{
+		"db.writes": {Depth: len(d.writeQueue), Capacity: cap(d.writeQueue), Dropped: d.dropped.Load()},
+	}
+}
+
+// runWriter writes events in batches, a batch is written once it has batchSize events or batchInterval after its
+// first event arrived, whichever is sooner. Events are written in the order they were queued.
+func (d *Database) runWriter() {
+	defer d.writerWait.Done()
+
+	batch := make([]notification.Notification, 0, d.batchSize)
+	timer := time.NewTimer(d.batchInterval)
+	timer.Stop()
+
+	for {
+		select {
+		case n := <-d.writeQueue:
+			if len(batch) == 0 {
+				timer.Reset(d.batchInterval)
+			}
+			batch = append(batch, n)
+			if len(batch) < d.batchSize {
+				continue
+			}
+			if !timer.Stop() {
+				// the timer fired while the batch was filling up
+				<-timer.C
+			}
+		case <-timer.C:
+		case <-d.done:
+			timer.Stop()
+			// flush anything left in the queue before exiting
+			for {
+				select {
+				case n := <-d.writeQueue:
+					batch = append(batch, n)
+					if len(batch) == d.batchSize {
+						d.writeBatch(batch)
+						batch = batch[:0]
+					}
+				default:
+					d.writeBatch(batch)
+					return
+				}
+			}
+		}
+
+		d.writeBatch(batch)
+		batch = batch[:0]
+	}
+}
+
+// StartPruner prunes the database now and then on the configured schedule until the database is closed. A summary
+// is sent to callbackFn whenever events are deleted.
+func (d *Database) StartPruner(callbackFn notification.NotificationCallback) {
+	d.writerWait.Add(1)
+	go func() {
+		defer d.writerWait.Done()
+		d.runPruner(d.done, d.Prune, d.checkHardLimit, callbackFn)
+	}()
+}
+
+// checkHardLimit switches to the in memory ring once the database file reaches the hard limit, after that it keeps
+// the ring to its maximum size
+func (d *Database) checkHardLimit(callbackFn notification.NotificationCallback) {
+	if d.hardLimit <= 0 {
+		return
+	}
+
+	if d.ring.Load() != nil {
+		err := d.trimRing()
+		if err != nil {
+			log.Errorf("trimming in memory events: %v", err)
+		}
+		return
+	}
+
+	size, err := d.diskSize()
+	if err != nil {
+		log.Errorf("checking database size: %v", err)
+		return
+	}
+	if size < d.hardLimit {
+		return
+	}
+
+	err = d.switchToRing()
+	if err != nil {
+		log.Errorf("switching to in memory events: %v", err)
+		return
+	}
+
+	warning := d.StorageWarning()
+	log.Warn(warning)
+	callbackFn(notification.Notification{
+		ID:      notification.NextID(),
+		Date:    time.Now(),
+		Type:    notification.NotificationTypeSystemError,
+		Message: warning,
+	})
+}
+
+// switchToRing stops writing to the database file, events are written to an in memory database instead. The events
+// of the current run are copied across so that it can still be viewed.
+func (d *Database) switchToRing() error {
+	ring, _, err := openMemoryDatabase()
+	if err != nil {
+		return err
+	}
+
+	_, err = ring.Exec("ATTACH DATABASE ? AS disk;", d.dbPath)
+	if err == nil {
+		_, err = ring.Exec(`
+			INSERT INTO notifs SELECT * FROM disk.notifs WHERE child_process_id = (
+				SELECT child_process_id FROM disk.notifs WHERE event_type = ? ORDER BY created_at DESC LIMIT 1
+			);`, notification.NotificationTypeStartup)
+	}
+	if err == nil {
+		_, err = ring.Exec(`
+			INSERT INTO triggers SELECT * FROM disk.triggers WHERE child_process_id = (
+				SELECT child_process_id FROM disk.notifs WHERE event_type = ? ORDER BY created_at DESC LIMIT 1
+			);`, notification.NotificationTypeStartup)
+	}
+	if err == nil {
+		_, err = ring.Exec(`
+			INSERT INTO resources SELECT * FROM disk.resources WHERE child_process_id = (
+				SELECT child_process_id FROM disk.notifs WHERE event_type = ? ORDER BY created_at DESC LIMIT 1
+			);`, notification.NotificationTypeStartup)
+	}
+	if err == nil {
+		// the restart history is small and is needed to spot slow restarts
+		_, err = ring.Exec("INSERT INTO restarts SELECT * FROM disk.restarts ORDER BY ready_at DESC LIMIT ?;", MaxRestartHistory)
+	}
+	if err == nil {
+		// the labels are kept so that the labelled runs can still be found once they are written to disk again
+		_, err = ring.Exec("INSERT INTO run_labels SELECT * FROM disk.run_labels;")
+	}
+	if err == nil {
+		_, err = ring.Exec("DETACH DATABASE disk;")
+	}
+	if err != nil {
+		ring.Close()
+		return fmt.Errorf("copying current run: %w", err)
+	}
+
+	d.ring.Store(ring)
+	return nil
+}
+
+// trimRing deletes the oldest in memory events, startup events are kept so that runs can still be listed
+func (d *Database) trimRing() error {
+	_, err := d.conn().Exec(`
+		DELETE FROM notifs WHERE event_type != ? AND id NOT IN (
+			SELECT id FROM notifs ORDER BY created_at DESC LIMIT ?
+		);`, notification.NotificationTypeStartup, config.DefaultMemoryRingEvents)
 	return err
 }
 
-func (d *Database) FindRuns() ([]*notification.Notification, error) {
+// StorageWarning returns a message if events are no longer being written to disk because the database reached
+// its hard limit
+func (d *Database) StorageWarning() string {
+	if d.ring.Load() == nil {
+		return ""
+	}
+	return fmt.Sprintf("history database reached its %s limit, only the most recent %d events are being kept in memory", formatBytes(d.hardLimit), config.DefaultMemoryRingEvents)
+}
+
+// diskSize returns the size of the database file
+func (d *Database) diskSize() (int64, error) {
+	info, err := os.Stat(d.dbPath)
+	if err != nil {
+		return 0, err
+	}
+	return info.Size(), nil
+}
+
+// RetentionStatus reports when the pruner last ran and when it will next run along with the size of the database
+func (d *Database) RetentionStatus() *RetentionStatus {
+	status := d.status()
+	status.MemoryRing = d.ring.Load() != nil
+	size, err := d.diskSize()
+	if d.dbPath == "" {
+		size, err = d.fileSize()
+	}
+	if err == nil {
+		status.SizeBytes = size
+	}
+	return status
+}
+
+// Prune deletes runs which fall outside of the retention policy and reclaims the space they used
+func (d *Database) Prune() (PruneStats, error) {
+	stats := PruneStats{Date: time.Now()}
+	defer func() {
+		stats.Duration = time.Since(stats.Date).Round(time.Millisecond).String()
+	}()
+
+	runsBefore, err := d.countRuns()
+	if err != nil {
+		return stats, err
+	}
+
+	current, err := d.latestRun()
+	if err != nil {
+		return stats, err
+	}
+
+	deleted := int64(0)
+
+	if d.maxAge > 0 {
+		// a run which has been going for longer than maxAge is kept whole so it doesn't lose its startup event
+		res, err := d.conn().Exec("DELETE FROM notifs WHERE created_at < ? AND (child_process_id != ? OR ? = '');", time.Now().Add(-d.maxAge), current, current)
+		if err != nil {
+			return stats, fmt.Errorf("deleting expired events: %w", err)
+		}
+		n, _ := res.RowsAffected()
+		deleted += n
+	}
+
+	if d.maxRuns > 0 {
+		res, err := d.conn().Exec(`
+			DELETE FROM notifs WHERE child_process_id IN (
+				SELECT child_process_id FROM notifs WHERE event_type = ? ORDER BY created_at DESC LIMIT -1 OFFSET ?
+			);`, notification.NotificationTypeStartup, d.maxRuns)
+		if err != nil {
+			return stats, fmt.Errorf("deleting old runs: %w", err)
+		}
+		n, _ := res.RowsAffected()
+		deleted += n
+	}
+
+	if d.maxSize > 0 {
+		for {
+			size, err := d.usedSize()
+			if err != nil {
+				return stats, err
+			}
+			if size <= d.maxSize {
+				break
+			}
+
+			// delete the oldest run, but always keep the current one
+			res, err := d.conn().Exec(`
+				DELETE FROM notifs WHERE child_process_id = (
+					SELECT child_process_id FROM notifs WHERE event_type = ? ORDER BY created_at ASC LIMIT 1
+				) AND (SELECT COUNT(*) FROM notifs WHERE event_type = ?) > 1;`, notification.NotificationTypeStartup, notification.NotificationTypeStartup)
+			if err != nil {
+				return stats, fmt.Errorf("deleting oldest run: %w", err)
+			}
+			n, _ := res.RowsAffected()
+			if n == 0 {
+				break
+			}
+			deleted += n
+		}
+	}
+
+	if deleted == 0 {
+		return stats, nil
+	}
+
+	stats.EventsDeleted = deleted
+	_, err = d.conn().Exec("DELETE FROM triggers WHERE child_process_id NOT IN (SELECT child_process_id FROM notifs WHERE event_type = ?);", notification.NotificationTypeStartup)
+	if err != nil {
+		return stats, fmt.Errorf("deleting triggers: %w", err)
+	}
+	_, err = d.conn().Exec("DELETE FROM resources WHERE child_process_id NOT IN (SELECT child_process_id FROM notifs WHERE event_type = ?);", notification.NotificationTypeStartup)
+	if err != nil {
+		return stats, fmt.Errorf("deleting resource samples: %w", err)
+	}
+	_, err = d.conn().Exec("DELETE FROM restarts WHERE child_process_id NOT IN (SELECT child_process_id FROM notifs WHERE event_type = ?);", notification.NotificationTypeStartup)
+	if err != nil {
+		return stats, fmt.Errorf("deleting restart durations: %w", err)
+	}
+	_, err = d.conn().Exec("DELETE FROM run_labels WHERE child_process_id NOT IN (SELECT child_process_id FROM notifs WHERE event_type = ?);", notification.NotificationTypeStartup)
+	if err != nil {
+		return stats, fmt.Errorf("deleting labels: %w", err)
+	}
+
+	runsAfter, err := d.countRuns()
+	if err != nil {
+		return stats, err
+	}
+	stats.RunsDeleted = runsBefore - runsAfter
+
+	sizeBefore, err := d.fileSize()
+	if err != nil {
+		return stats, err
+	}
+
+	log.Infof("pruned %d events from database", deleted)
+	_, err = d.conn().Exec("VACUUM;")
+	if err != nil {
+		return stats, fmt.Errorf("vacuuming database: %w", err)
+	}
+
+	if d.hasSearch {
+		err = rebuildSearchIndex(d.conn())
+		if err != nil {
+			return stats, err
+		}
+	}
+
+	sizeAfter, err := d.fileSize()
+	if err != nil {
+		return stats, err
+	}
+	stats.BytesReclaimed = sizeBefore - sizeAfter
+
+	return stats, nil
+}
+
+// latestRun returns the ID of the most recent run, which is the current run while gomon is running, or an empty
+// string if there are no runs
+func (d *Database) latestRun() (string, error) {
+	var runID string
+	err := d.conn().Get(&runID, "SELECT child_process_id FROM notifs WHERE event_type = ? ORDER BY created_at DESC LIMIT 1;", notification.NotificationTypeStartup)
+	if errors.Is(err, sql.ErrNoRows) {
+		return "", nil
+	}
+	if err != nil {
+		return "", fmt.Errorf("getting last run id: %w", err)
+	}
+	return runID, nil
+}
+
+func (d *Database) countRuns() (int64, error) {
+	var count int64
+	err := d.conn().Get(&count, "SELECT COUNT(*) FROM notifs WHERE event_type = ?;", notification.NotificationTypeStartup)
+	if err != nil {
+		return 0, fmt.Errorf("counting runs: %w", err)
+	}
+	return count, nil
+}
+
+// fileSize returns the number of bytes allocated to the database including free pages
+func (d *Database) fileSize() (int64, error) {
+	var pageCount, pageSize int64
+	err := d.conn().Get(&pageCount, "PRAGMA page_count;")
+	if err == nil {
+		err = d.conn().Get(&pageSize, "PRAGMA page_size;")
+	}
+	if err != nil {
+		return 0, fmt.Errorf("getting database size: %w", err)
+	}
+	return pageCount * pageSize, nil
+}
+
+// usedSize returns the number of bytes used by the database excluding free pages
+func (d *Database) usedSize() (int64, error) {
+	var pageCount, freePages, pageSize int64
+	err := d.conn().Get(&pageCount, "PRAGMA page_count;")
+	if err == nil {
+		err = d.conn().Get(&freePages, "PRAGMA freelist_count;")
+	}
+	if err == nil {
+		err = d.conn().Get(&pageSize, "PRAGMA page_size;")
+	}
+	if err != nil {
+		return 0, fmt.Errorf("getting database size: %w", err)
+	}
+	return (pageCount - freePages) * pageSize, nil
+}
+
+func (d *Database) insert(n notification.Notification) {
+	d.writeBatch([]notification.Notification{n})
+}
+
+// writeBatch writes events in a single transaction, which is much faster than a transaction per event. An event
+// which can't be written doesn't stop the others from being written.
+func (d *Database) writeBatch(batch []notification.Notification) {
+	if len(batch) == 0 {
+		return
+	}
+
+	tx, err := d.conn().Beginx()
+	if err != nil {
+		log.Errorf("writing notifications: %v", err)
+		d.lastWriteErr.Store(&err)
+		return
+	}
+
+	var lastErr error
+	for _, n := range batch {
+		_, err := tx.NamedExec(`
+			INSERT INTO notifs (id, created_at, child_process_id, event_type, event_data, level)
+			VALUES (:id, :created_at, :child_process_id, :event_type, :event_data, :level)
+		`, n)
+		if err != nil {
+			log.Errorf("writing notification: %v", err)
+			lastErr = err
+			continue
+		}
+		d.linkTriggers(tx, n)
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		log.Errorf("writing notifications: %v", err)
+		lastErr = err
+	}
+
+	if lastErr != nil {
+		d.lastWriteErr.Store(&lastErr)
+		return
+	}
+	d.lastWriteErr.Store(nil)
+}
+
+// linkTriggers records the file changes which caused a restart against the run they affected
+func (d *Database) linkTriggers(tx sqlx.Execer, n notification.Notification) {
+	d.triggers.link(n, func(runID string, t notification.Notification) {
+		d.insertTrigger(tx, runID, t)
+	})
+}
+
+func (d *Database) insertTrigger(tx sqlx.Execer, runID string, n notification.Notification) {
+	_, err := tx.Exec(`
+		INSERT INTO triggers (id, created_at, child_process_id, request_type, path, rule, event)
+		VALUES (?, ?, ?, ?, ?, ?, ?)
+	`, n.ID, n.Date, runID, n.Type, n.Trigger.Path, n.Trigger.Rule, n.Trigger.Event)
+	if err != nil {
+		log.Errorf("writing trigger: %v", err)
+	}
+}
+
+// Health returns an error if the database can't be reached or the last write failed
+func (d *Database) Health() error {
+	err := d.conn().Ping()
+	if err != nil {
+		return fmt.Errorf("pinging database: %w", err)
+	}
+
+	if lastErr := d.lastWriteErr.Load(); lastErr != nil {
+		return fmt.Errorf("writing notification: %w", *lastErr)
+	}
+
+	return nil
+}
+
+const (
+	MarkerCrash   = "crash"
+	MarkerStartup = "startup"
+	MarkerError   = "error"
+
+	DirectionPrev = "prev"
+	DirectionNext = "next"
+)
+
+// FindMarker returns the nearest lifecycle marker before or after the event fromID, if fromID is empty then the
+// search starts from the most recent event. The error marker is the first stderr output of the run containing fromID.
+// Returns nil if there is no matching marker.
+func (d *Database) FindMarker(marker, direction, fromID string) (*notification.Notification, error) {
+	var err error
+	n := new(notification.Notification)
+
+	switch marker {
+	case MarkerCrash, MarkerStartup:
+		eventType := notification.NotificationTypeCrash
+		if marker == MarkerStartup {
+			eventType = notification.NotificationTypeStartup
+		}
+
+		switch {
+		case fromID == "" && direction == DirectionNext:
+			return nil, nil
+		case fromID == "":
+			err = d.conn().Get(n, "SELECT * FROM notifs WHERE event_type = ? ORDER BY created_at DESC, id DESC LIMIT 1;", eventType)
+		case direction == DirectionNext:
+			err = d.conn().Get(n, `
+				SELECT n.* FROM notifs n, (SELECT created_at, id FROM notifs WHERE id = ?) f
+				WHERE n.event_type = ? AND (n.created_at > f.created_at OR (n.created_at = f.created_at AND n.id > f.id))
+				ORDER BY n.created_at ASC, n.id ASC LIMIT 1;`, fromID, eventType)
+		default:
+			err = d.conn().Get(n, `
+				SELECT n.* FROM notifs n, (SELECT created_at, id FROM notifs WHERE id = ?) f
+				WHERE n.event_type = ? AND (n.created_at < f.created_at OR (n.created_at = f.created_at AND n.id < f.id))
+				ORDER BY n.created_at DESC, n.id DESC LIMIT 1;`, fromID, eventType)
+		}
+	case MarkerError:
+		runID := ""
+		if fromID == "" {
+			err = d.conn().Get(&runID, "SELECT child_process_id FROM notifs WHERE event_type = ? ORDER BY created_at DESC LIMIT 1;", notification.NotificationTypeStartup)
+		} else {
+			err = d.conn().Get(&runID, "SELECT child_process_id FROM notifs WHERE id = ?;", fromID)
+		}
+		if err == nil {
+			err = d.conn().Get(n, "SELECT * FROM notifs WHERE child_process_id = ? AND event_type IN (?, ?) ORDER BY created_at ASC, id ASC LIMIT 1;", runID, notification.NotificationTypeStdErr, notification.NotificationTypeBuildError)
+		}
+	default:
+		return nil, fmt.Errorf("unknown marker: %s", marker)
+	}
+
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("finding %s marker: %w", marker, err)
+	}
+
+	return n, nil
+}
+
+// FindRuns returns the startup events of the most recent runs, the latest first. If label isn't empty only the runs
+// with the label are returned.
+func (d *Database) FindRuns(label string) ([]*notification.Notification, error) {
 	runs := []*notification.Notification{}
-	err := d.db.Select(&runs, "SELECT * FROM notifs WHERE event_type = ? ORDER BY created_at DESC LIMIT 100;", notification.NotificationTypeStartup)
+	var err error
+	if label == "" {
+		err = d.conn().Select(&runs, "SELECT * FROM notifs WHERE event_type = ? ORDER BY created_at DESC LIMIT 100;", notification.NotificationTypeStartup)
+	} else {
+		err = d.conn().Select(&runs, `
+			SELECT * FROM notifs WHERE event_type = ? AND child_process_id IN (
+				SELECT child_process_id FROM run_labels WHERE label = ?
+			) ORDER BY created_at DESC LIMIT 100;`, notification.NotificationTypeStartup, label)
+	}
 	if err != nil {
 		return nil, fmt.Errorf("getting runs: %w", err)
 	}
@@ -91,12 +989,203 @@ func (d *Database) FindRuns() ([]*notification.Notification, error) {
 	return runs, nil
 }
 
-func (d *Database) FindNotifications(runID, stm, filter string) ([][]*notification.Notification, error) {
+// FindRunTriggers returns the file changes which restarted each of the runs in the order they happened
+func (d *Database) FindRunTriggers(runIDs []string) (map[string][]*RunTrigger, error) {
+	triggers := map[string][]*RunTrigger{}
+	if len(runIDs) == 0 {
+		return triggers, nil
+	}
+
+	query, args, err := sqlx.In("SELECT child_process_id, created_at, request_type, path, rule, event FROM triggers WHERE child_process_id IN (?) ORDER BY created_at ASC, id ASC;", runIDs)
+	if err != nil {
+		return nil, fmt.Errorf("building triggers query: %w", err)
+	}
+
+	rows := []*RunTrigger{}
+	err = d.conn().Select(&rows, d.conn().Rebind(query), args...)
+	if err != nil {
+		return nil, fmt.Errorf("getting triggers: %w", err)
+	}
+	for _, t := range rows {
+		triggers[t.ChildProccessID] = append(triggers[t.ChildProccessID], t)
+	}
+
+	return triggers, nil
+}
+
+// FindRunErrorCounts returns the number of lines of error output written by each of the runs, runs without any
+// aren't included
+func (d *Database) FindRunErrorCounts(runIDs []string) (map[string]int, error) {
+	counts := map[string]int{}
+	if len(runIDs) == 0 {
+		return counts, nil
+	}
+
+	query, args, err := sqlx.In("SELECT child_process_id, COUNT(*) AS errors FROM notifs WHERE child_process_id IN (?) AND level = ? GROUP BY child_process_id;", runIDs, notification.LevelError)
+	if err != nil {
+		return nil, fmt.Errorf("building error count query: %w", err)
+	}
+
+	rows := []struct {
+		ID     string `db:"child_process_id"`
+		Errors int    `db:"errors"`
+	}{}
+	err = d.conn().Select(&rows, d.conn().Rebind(query), args...)
+	if err != nil {
+		return nil, fmt.Errorf("counting errors: %w", err)
+	}
+	for _, r := range rows {
+		counts[r.ID] = r.Errors
+	}
+
+	return counts, nil
+}
+
+// comparisonRun is a run which can be compared, Failed is true if it crashed or failed to build
+type comparisonRun struct {
+	ID     string `db:"child_process_id"`
+	Failed bool   `db:"failed"`
+}
+
+// FindComparisonRuns picks a pair of runs to compare. If runID is empty the pair is the most recent run that
+// failed and the working run before it, otherwise runID is compared with the last working run before it. In
+// either case the previous run is used if there is no working run. Empty IDs are returned if there aren't enough runs.
+func (d *Database) FindComparisonRuns(runID string) (string, string, error) {
+	runs := []comparisonRun{}
+	err := d.conn().Select(&runs, `
+		SELECT s.child_process_id, EXISTS (
+			SELECT 1 FROM notifs f WHERE f.child_process_id = s.child_process_id AND f.event_type IN (?, ?)
+		) AS failed
+		FROM notifs s WHERE s.event_type = ? ORDER BY s.created_at DESC LIMIT 100;`,
+		notification.NotificationTypeCrash, notification.NotificationTypeBuildError, notification.NotificationTypeStartup)
+	if err != nil {
+		return "", "", fmt.Errorf("getting runs: %w", err)
+	}
+
+	previous, next := pickComparisonRuns(runs, runID)
+	return previous, next, nil
+}
+
+// pickComparisonRuns picks the pair of runs to compare from the most recent runs, the newest first
+func pickComparisonRuns(runs []comparisonRun, runID string) (string, string) {
+	after := -1
+	for ix, r := range runs {
+		if runID == "" && r.Failed && ix+1 < len(runs) && !runs[ix+1].Failed {
+			return runs[ix+1].ID, r.ID
+		}
+		if r.ID == runID {
+			after = ix
+			break
+		}
+	}
+	if runID == "" {
+		after = 0
+	}
+	if after < 0 || after+1 >= len(runs) {
+		return "", ""
+	}
+
+	for _, r := range runs[after+1:] {
+		if !r.Failed {
+			return r.ID, runs[after].ID
+		}
+	}
+	return runs[after+1].ID, runs[after].ID
+}
+
+// ExportRun calls fn with each event of the run in order. Events are read one at a time so that large runs
+// aren't loaded into memory, ErrRunNotFound is returned if the run has no events.
+func (d *Database) ExportRun(runID string, fn func(*notification.Notification) error) error {
+	if runID == LatestRun {
+		var err error
+		runID, err = d.latestRun()
+		if err != nil {
+			return err
+		}
+		if runID == "" {
+			return ErrRunNotFound
+		}
+	}
+
+	rows, err := d.conn().Queryx("SELECT * FROM notifs WHERE child_process_id = ? ORDER BY created_at ASC, id ASC;", runID)
+	if err != nil {
+		return fmt.Errorf("querying run: %w", err)
+	}
+
+	found, err := streamRows(rows, fn)
+	if err != nil {
+		return err
+	}
+	if !found {
+		return ErrRunNotFound
+	}
+
+	return nil
+}
+
+// ExportRange calls fn with each event of the child process between fromID and toID inclusive, in order. The IDs
+// can be given in either order. At most MaxRangeEvents are returned, ErrEventNotFound is returned if either of the
+// events doesn't exist.
+func (d *Database) ExportRange(fromID, toID string, fn func(*notification.Notification) error) error {
+	bounds := []*notification.Notification{}
+	err := d.conn().Select(&bounds, "SELECT * FROM notifs WHERE id IN (?, ?) ORDER BY created_at ASC, id ASC;", fromID, toID)
+	if err != nil {
+		return fmt.Errorf("finding range: %w", err)
+	}
+	if len(bounds) == 0 || (fromID != toID && len(bounds) < 2) {
+		return ErrEventNotFound
+	}
+	first, last := bounds[0], bounds[len(bounds)-1]
+
+	// gomon's own events aren't shown in the UI so they aren't included
+	rows, err := d.conn().Queryx(`
+		SELECT * FROM notifs WHERE child_process_id != ''
+		AND (created_at > ? OR (created_at = ? AND id >= ?))
+		AND (created_at < ? OR (created_at = ? AND id <= ?))
+		ORDER BY created_at ASC, id ASC LIMIT ?;`,
+		first.Date, first.Date, first.ID, last.Date, last.Date, last.ID, MaxRangeEvents)
+	if err != nil {
+		return fmt.Errorf("querying range: %w", err)
+	}
+
+	_, err = streamRows(rows, fn)
+	return err
+}
+
+// streamRows calls fn with each row, it returns false if there weren't any
+func streamRows(rows *sqlx.Rows, fn func(*notification.Notification) error) (bool, error) {
+	defer rows.Close()
+
+	found := false
+	for rows.Next() {
+		ev := new(notification.Notification)
+		err := rows.StructScan(ev)
+		if err != nil {
+			return found, fmt.Errorf("scanning notification: %w", err)
+		}
+		found = true
+
+		err = fn(ev)
+		if err != nil {
+			return found, err
+		}
+	}
+
+	if err := rows.Err(); err != nil {
+		return found, fmt.Errorf("reading events: %w", err)
+	}
+
+	return found, nil
+}
+
+// FindNotifications returns the events of a run (or "all" runs) grouped by run. stm limits them to an event type,
+// level hides output below the level and filter searches the event text.
+func (d *Database) FindNotifications(runID, stm, level, filter string) ([][]*notification.Notification, error) {
 	var err error
 	notifs := [][]*notification.Notification{}
 
 	if runID == "" {
-		err = d.db.Get(&runID, "SELECT child_process_id FROM notifs WHERE event_type = ? ORDER BY created_at DESC LIMIT 1;", notification.NotificationTypeStartup)
+		err = d.conn().Get(&runID, "SELECT child_process_id FROM notifs WHERE event_type = ? ORDER BY created_at DESC LIMIT 1;", notification.NotificationTypeStartup)
 		if err != nil {
 			return nil, fmt.Errorf("getting last run id: %w", err)
 		}
@@ -104,7 +1193,7 @@ func (d *Database) FindNotifications(runID, stm, filter string) ([][]*notificati
 
 	if runID != "" {
 		params := map[string]interface{}{}
-		sql := "SELECT * FROM notifs WHERE "
+		sql := "SELECT notifs.* FROM notifs WHERE "
 		if runID == "all" {
 			sql += "1 = 1 " // dummy clause
 		} else {
@@ -115,13 +1204,37 @@ func (d *Database) FindNotifications(runID, stm, filter string) ([][]*notificati
 			sql += " AND event_type = :event_type "
 			params["event_type"] = stm
 		}
-		if filter != "" {
-			sql += " AND event_data LIKE :event_data "
-			params["event_data"] = "%" + filter + "%"
+		if ix := slices.Index(notification.Levels, level); ix >= 0 {
+			// lifecycle events are kept so that it is still clear when each run started and how it ended
+			levels := []string{}
+			for i, l := range notification.Levels[ix:] {
+				name := fmt.Sprintf("level_%d", i)
+				levels = append(levels, ":"+name)
+				params[name] = l
+			}
+			sql += " AND (event_type NOT IN (:stdout, :stderr) OR level IN (" + strings.Join(levels, ", ") + ")) "
+			params["stdout"] = notification.NotificationTypeStdOut
+			params["stderr"] = notification.NotificationTypeStdErr
+		}
+
+		query := ""
+		if d.hasSearch {
+			query = searchQuery(filter)
+		}
+		if query != "" {
+			// the best matches are found first and then shown in the order they happened
+			sql = strings.Replace(sql, "FROM notifs WHERE", "FROM notifs_fts JOIN notifs ON notifs.rowid = notifs_fts.rowid WHERE notifs_fts MATCH :query AND", 1)
+			sql = "SELECT * FROM (" + sql + " ORDER BY notifs_fts.rank LIMIT 1000) ORDER BY child_process_id ASC, created_at ASC;"
+			params["query"] = query
+		} else {
+			if filter != "" {
+				sql += " AND event_data LIKE :event_data "
+				params["event_data"] = "%" + filter + "%"
+			}
+			sql += " ORDER BY child_process_id ASC, created_at ASC limit 1000;"
 		}
-		sql += " ORDER BY child_process_id ASC, created_at ASC limit 1000;"
 
-		res, err := d.db.NamedQuery(sql, params)
+		res, err := d.conn().NamedQuery(sql, params)
 		if err != nil {
 			return nil, fmt.Errorf("querying notifications: %w", err)
 		}
@@ -0,0 +1,666 @@
+//go:build cgo
+
+package utils
+
+// gomon is a simple command line tool that watches your files and automatically restarts the application when it detects any changes in the working directory.
+// Copyright (C) 2023 John Dudmesh
+
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+
+// You should have received a copy of the GNU General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>.
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/jdudmesh/gomon/internal/config"
+	"github.com/jdudmesh/gomon/internal/notification"
+	"github.com/jmoiron/sqlx"
+)
+
+func TestDatabaseRecoversFromCorruption(t *testing.T) {
+	rootDirectory := t.TempDir()
+	dataPath := path.Join(rootDirectory, ".gomon")
+	err := os.Mkdir(dataPath, 0755)
+	if err != nil {
+		t.Fatalf("creating data directory: %v", err)
+	}
+
+	err = os.WriteFile(path.Join(dataPath, "gomon.db"), []byte("this is not a sqlite database, it is just some text which is long enough to look like a header"), 0644)
+	if err != nil {
+		t.Fatalf("writing corrupt database: %v", err)
+	}
+
+	db, err := NewDatabase(config.Config{RootDirectory: rootDirectory})
+	if err != nil {
+		t.Fatalf("expected database to be recreated: %v", err)
+	}
+	defer db.Close()
+
+	if db.RecoveryWarning() == "" {
+		t.Error("expected a recovery warning")
+	}
+
+	matches, _ := filepath.Glob(path.Join(dataPath, "gomon.db.corrupt-*"))
+	if len(matches) != 1 {
+		t.Errorf("expected corrupt database to be moved aside, found %v", matches)
+	}
+}
+
+func TestDatabasePruneKeepsMostRecentRuns(t *testing.T) {
+	cfg := config.Config{RootDirectory: t.TempDir()}
+	cfg.UI.Retention.MaxRuns = 2
+
+	db, err := NewDatabase(cfg)
+	if err != nil {
+		t.Fatalf("creating database: %v", err)
+	}
+	defer db.Close()
+
+	start := time.Now().Add(-time.Hour)
+	for i := 0; i < 3; i++ {
+		runID := notification.NextID()
+		db.insert(notification.Notification{
+			ID:              notification.NextID(),
+			Date:            start.Add(time.Duration(i) * time.Minute),
+			ChildProccessID: runID,
+			Type:            notification.NotificationTypeStartup,
+			Message:         "process started",
+		})
+		db.insert(notification.Notification{
+			ID:              notification.NextID(),
+			Date:            start.Add(time.Duration(i) * time.Minute),
+			ChildProccessID: runID,
+			Type:            notification.NotificationTypeStdOut,
+			Message:         "hello",
+		})
+	}
+
+	stats, err := db.Prune()
+	if err != nil {
+		t.Fatalf("pruning database: %v", err)
+	}
+	if stats.RunsDeleted != 1 || stats.EventsDeleted != 2 {
+		t.Errorf("expected 1 run and 2 events to be deleted, got %+v", stats)
+	}
+
+	runs, err := db.FindRuns("")
+	if err != nil {
+		t.Fatalf("finding runs: %v", err)
+	}
+	if len(runs) != 2 {
+		t.Errorf("expected 2 runs, got %d", len(runs))
+	}
+
+	count := 0
+	err = db.db.Get(&count, "SELECT COUNT(*) FROM notifs;")
+	if err != nil {
+		t.Fatalf("counting events: %v", err)
+	}
+	if count != 4 {
+		t.Errorf("expected 4 events, got %d", count)
+	}
+}
+
+func TestFindMarker(t *testing.T) {
+	db, err := NewDatabase(config.Config{RootDirectory: t.TempDir()})
+	if err != nil {
+		t.Fatalf("creating database: %v", err)
+	}
+	defer db.Close()
+
+	start := time.Now().Add(-time.Hour)
+	events := map[string]notification.Notification{}
+	add := func(key, runID string, offset int, notifType notification.NotificationType) {
+		n := notification.Notification{
+			ID:              notification.NextID(),
+			Date:            start.Add(time.Duration(offset) * time.Second),
+			ChildProccessID: runID,
+			Type:            notifType,
+			Message:         key,
+		}
+		events[key] = n
+		db.insert(n)
+	}
+
+	add("start1", "run1", 0, notification.NotificationTypeStartup)
+	add("err1", "run1", 1, notification.NotificationTypeStdErr)
+	add("crash1", "run1", 2, notification.NotificationTypeCrash)
+	add("start2", "run2", 3, notification.NotificationTypeStartup)
+	add("out2", "run2", 4, notification.NotificationTypeStdOut)
+	add("err2", "run2", 5, notification.NotificationTypeStdErr)
+	add("err2b", "run2", 6, notification.NotificationTypeStdErr)
+
+	tests := []struct {
+		marker    string
+		direction string
+		from      string
+		expected  string
+	}{
+		{MarkerStartup, DirectionPrev, "", "start2"},
+		{MarkerStartup, DirectionPrev, "start2", "start1"},
+		{MarkerStartup, DirectionPrev, "start1", ""},
+		{MarkerStartup, DirectionNext, "err1", "start2"},
+		{MarkerStartup, DirectionNext, "", ""},
+		{MarkerCrash, DirectionPrev, "out2", "crash1"},
+		{MarkerCrash, DirectionNext, "crash1", ""},
+		{MarkerError, DirectionPrev, "", "err2"},
+		{MarkerError, DirectionPrev, "crash1", "err1"},
+	}
+
+	for _, tt := range tests {
+		fromID := ""
+		if tt.from != "" {
+			fromID = events[tt.from].ID
+		}
+		n, err := db.FindMarker(tt.marker, tt.direction, fromID)
+		if err != nil {
+			t.Fatalf("finding %s %s from %s: %v", tt.direction, tt.marker, tt.from, err)
+		}
+		actual := ""
+		if n != nil {
+			actual = n.Message
+		}
+		if actual != tt.expected {
+			t.Errorf("%s %s from %s: expected %q, got %q", tt.direction, tt.marker, tt.from, tt.expected, actual)
+		}
+	}
+
+	anchor := events["err2"]
+	around, err := FindEventsAround(db, &anchor, 3)
+	if err != nil {
+		t.Fatalf("finding events around: %v", err)
+	}
+	if len(around) != 3 || around[0].Message != "out2" || around[1].Message != "err2" || around[2].Message != "err2b" {
+		t.Errorf("unexpected events around marker: %+v", around)
+	}
+}
+
+func TestPrunerReportsSummary(t *testing.T) {
+	cfg := config.Config{RootDirectory: t.TempDir()}
+	cfg.UI.Retention.MaxRuns = 1
+
+	db, err := NewDatabase(cfg)
+	if err != nil {
+		t.Fatalf("creating database: %v", err)
+	}
+	defer db.Close()
+
+	for i := 0; i < 2; i++ {
+		db.insert(notification.Notification{
+			ID:              notification.NextID(),
+			Date:            time.Now().Add(time.Duration(i-2) * time.Minute),
+			ChildProccessID: notification.NextID(),
+			Type:            notification.NotificationTypeStartup,
+			Message:         "process started",
+		})
+	}
+
+	summaries := make(chan notification.Notification, 1)
+	db.StartPruner(func(n notification.Notification) error {
+		summaries <- n
+		return nil
+	})
+
+	select {
+	case n := <-summaries:
+		if n.Type != notification.NotificationTypeLogEvent || !strings.Contains(n.Message, "1 runs") {
+			t.Errorf("unexpected summary: %v", n.Message)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("expected a summary notification")
+	}
+
+	status := db.RetentionStatus()
+	if status.LastRun == nil || status.LastRun.RunsDeleted != 1 {
+		t.Errorf("expected the last run to be reported, got %+v", status.LastRun)
+	}
+	if status.Interval != "10m0s" {
+		t.Errorf("expected the default interval, got %s", status.Interval)
+	}
+}
+
+func TestExportRun(t *testing.T) {
+	db, err := NewDatabase(config.Config{RootDirectory: t.TempDir()})
+	if err != nil {
+		t.Fatalf("creating database: %v", err)
+	}
+	defer db.Close()
+
+	start := time.Now().Add(-time.Hour)
+	runIDs := []string{notification.NextID(), notification.NextID()}
+	for i, runID := range runIDs {
+		for j, notifType := range []notification.NotificationType{notification.NotificationTypeStartup, notification.NotificationTypeStdOut, notification.NotificationTypeShutdown} {
+			db.insert(notification.Notification{
+				ID:              notification.NextID(),
+				Date:            start.Add(time.Duration(i*10+j) * time.Second),
+				ChildProccessID: runID,
+				Type:            notifType,
+				Message:         notifType.String(),
+			})
+		}
+	}
+
+	exported := []string{}
+	err = db.ExportRun(runIDs[0], func(n *notification.Notification) error {
+		exported = append(exported, n.Message)
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("exporting run: %v", err)
+	}
+	if strings.Join(exported, ",") != "startup,stdout,shutdown" {
+		t.Errorf("unexpected events: %v", exported)
+	}
+
+	latest := ""
+	err = db.ExportRun(LatestRun, func(n *notification.Notification) error {
+		latest = n.ChildProccessID
+		return nil
+	})
+	if err != nil || latest != runIDs[1] {
+		t.Errorf("expected the latest run to be exported, got %s, %v", latest, err)
+	}
+
+	err = db.ExportRun("missing", func(n *notification.Notification) error { return nil })
+	if !errors.Is(err, ErrRunNotFound) {
+		t.Errorf("expected ErrRunNotFound, got %v", err)
+	}
+}
+
+func TestExportRange(t *testing.T) {
+	db, err := NewDatabase(config.Config{RootDirectory: t.TempDir()})
+	if err != nil {
+		t.Fatalf("creating database: %v", err)
+	}
+	defer db.Close()
+
+	start := time.Now().Add(-time.Hour)
+	runID := notification.NextID()
+	ids := []string{}
+	for i := 0; i < 5; i++ {
+		id := notification.NextID()
+		ids = append(ids, id)
+		db.insert(notification.Notification{
+			ID:              id,
+			Date:            start.Add(time.Duration(i) * time.Second),
+			ChildProccessID: runID,
+			Type:            notification.NotificationTypeStdOut,
+			Message:         fmt.Sprintf("line %d", i),
+		})
+	}
+
+	exportRange := func(fromID, toID string) ([]string, error) {
+		exported := []string{}
+		err := db.ExportRange(fromID, toID, func(n *notification.Notification) error {
+			exported = append(exported, n.Message)
+			return nil
+		})
+		return exported, err
+	}
+
+	exported, err := exportRange(ids[1], ids[3])
+	if err != nil {
+		t.Fatalf("exporting range: %v", err)
+	}
+	if strings.Join(exported, ",") != "line 1,line 2,line 3" {
+		t.Errorf("unexpected events: %v", exported)
+	}
+
+	// a selection made from the bottom up is still exported in order
+	exported, err = exportRange(ids[3], ids[1])
+	if err != nil || strings.Join(exported, ",") != "line 1,line 2,line 3" {
+		t.Errorf("unexpected events for reversed range: %v, %v", exported, err)
+	}
+
+	exported, err = exportRange(ids[4], ids[4])
+	if err != nil || strings.Join(exported, ",") != "line 4" {
+		t.Errorf("unexpected events for single event: %v, %v", exported, err)
+	}
+
+	_, err = exportRange(ids[0], "missing")
+	if !errors.Is(err, ErrEventNotFound) {
+		t.Errorf("expected ErrEventNotFound, got %v", err)
+	}
+}
+
+func TestFindComparisonRuns(t *testing.T) {
+	db, err := NewDatabase(config.Config{RootDirectory: t.TempDir()})
+	if err != nil {
+		t.Fatalf("creating database: %v", err)
+	}
+	defer db.Close()
+
+	// two working runs followed by two crashes
+	start := time.Now().Add(-time.Hour)
+	runIDs := []string{}
+	for i := 0; i < 4; i++ {
+		runID := notification.NextID()
+		runIDs = append(runIDs, runID)
+		events := []notification.NotificationType{notification.NotificationTypeStartup, notification.NotificationTypeStdErr, notification.NotificationTypeShutdown}
+		if i >= 2 {
+			events = append(events, notification.NotificationTypeCrash)
+		}
+		for j, notifType := range events {
+			db.insert(notification.Notification{
+				ID:              notification.NextID(),
+				Date:            start.Add(time.Duration(i*10+j) * time.Second),
+				ChildProccessID: runID,
+				Type:            notifType,
+				Message:         fmt.Sprintf("%s %d", notifType, i),
+			})
+		}
+	}
+
+	before, after, err := db.FindComparisonRuns("")
+	if err != nil {
+		t.Fatalf("finding runs: %v", err)
+	}
+	if before != runIDs[1] || after != runIDs[2] {
+		t.Errorf("expected the last working run and the first failing run, got %s, %s", before, after)
+	}
+
+	before, after, err = db.FindComparisonRuns(runIDs[3])
+	if err != nil || before != runIDs[1] || after != runIDs[3] {
+		t.Errorf("expected the last working run before the selected run, got %s, %s, %v", before, after, err)
+	}
+
+	before, after, err = db.FindComparisonRuns(runIDs[0])
+	if err != nil || before != "" || after != "" {
+		t.Errorf("expected no runs to compare with the first run, got %s, %s, %v", before, after, err)
+	}
+
+	summary, err := FindRunSummary(db, runIDs[2])
+	if err != nil {
+		t.Fatalf("finding summary: %v", err)
+	}
+	if !summary.Failed() || summary.Exit == nil || len(summary.Stderr) != 1 || summary.Stderr[0].Message != "stderr 2" {
+		t.Errorf("unexpected summary: %+v", summary)
+	}
+
+	_, err = FindRunSummary(db, "missing")
+	if !errors.Is(err, ErrRunNotFound) {
+		t.Errorf("expected ErrRunNotFound, got %v", err)
+	}
+}
+
+func TestFindRunTriggers(t *testing.T) {
+	db, err := NewDatabase(config.Config{RootDirectory: t.TempDir()})
+	if err != nil {
+		t.Fatalf("creating database: %v", err)
+	}
+	defer db.Close()
+
+	start := time.Now().Add(-time.Hour)
+	runIDs := []string{notification.NextID(), notification.NextID()}
+	change := func(notifType notification.NotificationType, path, rule string, offset int) notification.Notification {
+		return notification.Notification{
+			ID:      notification.NextID(),
+			Date:    start.Add(time.Duration(offset) * time.Second),
+			Type:    notifType,
+			Message: path,
+			Trigger: &notification.Trigger{Path: path, Rule: rule, Event: "WRITE"},
+		}
+	}
+
+	events := []notification.Notification{
+		{ID: notification.NextID(), Date: start, ChildProccessID: runIDs[0], Type: notification.NotificationTypeStartup},
+		change(notification.NotificationTypeSoftRestartRequested, "views/index.html", "softReload *.html", 1),
+		change(notification.NotificationTypeHardRestartRequested, "internal/foo/bar.go", "hardReload *.go", 2),
+		{ID: notification.NextID(), Date: start.Add(3 * time.Second), ChildProccessID: runIDs[1], Type: notification.NotificationTypeStartup},
+	}
+	for _, n := range events {
+		db.insert(n)
+	}
+
+	triggers, err := db.FindRunTriggers(runIDs)
+	if err != nil {
+		t.Fatalf("finding triggers: %v", err)
+	}
+	if len(triggers[runIDs[0]]) != 1 || triggers[runIDs[0]][0].Path != "views/index.html" || triggers[runIDs[0]][0].Type != notification.NotificationTypeSoftRestartRequested {
+		t.Errorf("expected the soft restart to be linked to the current run, got %+v", triggers[runIDs[0]])
+	}
+	if len(triggers[runIDs[1]]) != 1 || triggers[runIDs[1]][0].Rule != "hardReload *.go" || triggers[runIDs[1]][0].Event != "WRITE" {
+		t.Errorf("expected the hard restart to be linked to the next run, got %+v", triggers[runIDs[1]])
+	}
+}
+
+func TestFindNotificationsByLevel(t *testing.T) {
+	db, err := NewDatabase(config.Config{RootDirectory: t.TempDir()})
+	if err != nil {
+		t.Fatalf("creating database: %v", err)
+	}
+	defer db.Close()
+
+	start := time.Now().Add(-time.Hour)
+	runIDs := []string{notification.NextID(), notification.NextID()}
+	events := []notification.Notification{
+		{ChildProccessID: runIDs[0], Type: notification.NotificationTypeStartup, Message: "startup"},
+		{ChildProccessID: runIDs[0], Type: notification.NotificationTypeStdOut, Message: "debug", Level: notification.LevelDebug},
+		{ChildProccessID: runIDs[0], Type: notification.NotificationTypeStdOut, Message: "plain"},
+		{ChildProccessID: runIDs[0], Type: notification.NotificationTypeStdErr, Message: "warn", Level: notification.LevelWarn},
+		{ChildProccessID: runIDs[0], Type: notification.NotificationTypeStdErr, Message: "error", Level: notification.LevelError},
+		{ChildProccessID: runIDs[0], Type: notification.NotificationTypeCrash, Message: "crash"},
+		{ChildProccessID: runIDs[1], Type: notification.NotificationTypeStartup, Message: "startup"},
+	}
+	for i, n := range events {
+		n.ID = notification.NextID()
+		n.Date = start.Add(time.Duration(i) * time.Second)
+		db.insert(n)
+	}
+
+	notifs, err := db.FindNotifications(runIDs[0], "", notification.LevelWarn, "")
+	if err != nil {
+		t.Fatalf("finding notifications: %v", err)
+	}
+	messages := []string{}
+	for _, n := range notifs[0] {
+		messages = append(messages, n.Message)
+	}
+	if strings.Join(messages, ",") != "startup,warn,error,crash" {
+		t.Errorf("unexpected events: %v", messages)
+	}
+
+	counts, err := db.FindRunErrorCounts(runIDs)
+	if err != nil {
+		t.Fatalf("counting errors: %v", err)
+	}
+	if counts[runIDs[0]] != 1 || counts[runIDs[1]] != 0 {
+		t.Errorf("unexpected error counts: %v", counts)
+	}
+}
+
+func TestLevelColumnIsAddedToOldDatabases(t *testing.T) {
+	rootDirectory := t.TempDir()
+	err := os.Mkdir(path.Join(rootDirectory, ".gomon"), 0755)
+	if err != nil {
+		t.Fatalf("creating data directory: %v", err)
+	}
+
+	old, err := sqlx.Connect(sqliteDriver, path.Join(rootDirectory, ".gomon", "gomon.db"))
+	if err != nil {
+		t.Fatalf("creating old database: %v", err)
+	}
+	_, err = old.Exec(`
+		CREATE TABLE notifs (id TEXT PRIMARY KEY, created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP, child_process_id TEXT NOT NULL, event_type TEXT NOT NULL, event_data TEXT NOT NULL);
+		INSERT INTO notifs (id, child_process_id, event_type, event_data) VALUES ('1', 'run', ?, 'startup'), ('2', 'run', ?, 'ERROR[0000] failed'), ('3', 'run', ?, 'INFO started');`,
+		notification.NotificationTypeStartup, notification.NotificationTypeStdErr, notification.NotificationTypeStdOut)
+	old.Close()
+	if err != nil {
+		t.Fatalf("populating old database: %v", err)
+	}
+
+	db, err := NewDatabase(config.Config{RootDirectory: rootDirectory})
+	if err != nil {
+		t.Fatalf("opening database: %v", err)
+	}
+	defer db.Close()
+
+	notifs, err := db.FindNotifications("run", "", notification.LevelError, "")
+	if err != nil {
+		t.Fatalf("finding notifications: %v", err)
+	}
+	if len(notifs) != 1 || len(notifs[0]) != 2 || notifs[0][1].Level != notification.LevelError {
+		t.Errorf("expected the existing error to be detected, got %v", notifs)
+	}
+}
+
+func TestDatabaseBatchesWrites(t *testing.T) {
+	rootDirectory := t.TempDir()
+	cfg := config.Config{RootDirectory: rootDirectory}
+	cfg.Limits.DBBatchSize = 10
+	cfg.Limits.DBBatchInterval = 10000
+	db, err := NewDatabase(cfg)
+	if err != nil {
+		t.Fatalf("creating database: %v", err)
+	}
+
+	runID := notification.NextID()
+	ids := []string{}
+	for ix := 0; ix < 25; ix++ {
+		n := notification.Notification{
+			ID:              notification.NextID(),
+			Date:            time.Now(),
+			ChildProccessID: runID,
+			Type:            notification.NotificationTypeStdOut,
+			Message:         fmt.Sprintf("line %d", ix),
+		}
+		ids = append(ids, n.ID)
+		db.Notify(n)
+	}
+
+	// two full batches are written straight away, the rest wait for the interval
+	deadline := time.Now().Add(5 * time.Second)
+	count := 0
+	for count < 20 && time.Now().Before(deadline) {
+		time.Sleep(10 * time.Millisecond)
+		db.conn().Get(&count, "SELECT COUNT(*) FROM notifs;")
+	}
+	if count != 20 {
+		t.Errorf("expected 2 batches to be written, got %d events", count)
+	}
+
+	// the partial batch is written on close
+	db.Close()
+
+	conn, err := sqlx.Connect(sqliteDriver, filepath.Join(rootDirectory, ".gomon", "gomon.db"))
+	if err != nil {
+		t.Fatalf("connecting to database: %v", err)
+	}
+	defer conn.Close()
+
+	written := []string{}
+	err = conn.Select(&written, "SELECT id FROM notifs ORDER BY rowid;")
+	if err != nil {
+		t.Fatalf("reading events: %v", err)
+	}
+	if strings.Join(written, ",") != strings.Join(ids, ",") {
+		t.Errorf("expected the events to be written in order, got %v", written)
+	}
+
+	mode := ""
+	conn.Get(&mode, "PRAGMA journal_mode;")
+	if mode != "wal" {
+		t.Errorf("expected WAL mode, got %s", mode)
+	}
+}
+
+func TestCheckDatabase(t *testing.T) {
+	rootDirectory := t.TempDir()
+	db, err := NewDatabase(config.Config{RootDirectory: rootDirectory})
+	if err != nil {
+		t.Fatalf("creating database: %v", err)
+	}
+	db.Close()
+
+	err = CheckDatabase(rootDirectory)
+	if err != nil {
+		t.Fatalf("checking database: %v", err)
+	}
+
+	// hold a write lock as another process would
+	other, err := sqlx.Connect(sqliteDriver, filepath.Join(rootDirectory, ".gomon", "gomon.db"))
+	if err != nil {
+		t.Fatalf("connecting to database: %v", err)
+	}
+	defer other.Close()
+	other.SetMaxOpenConns(1)
+	other.MustExec("BEGIN IMMEDIATE;")
+
+	err = CheckDatabase(rootDirectory)
+	if !errors.Is(err, ErrDatabaseLocked) {
+		t.Errorf("expected ErrDatabaseLocked, got %v", err)
+	}
+}
+
+func TestHardLimitSwitchesToMemoryRing(t *testing.T) {
+	db, err := NewDatabase(config.Config{RootDirectory: t.TempDir()})
+	if err != nil {
+		t.Fatalf("creating database: %v", err)
+	}
+	defer db.Close()
+	db.hardLimit = 1
+
+	runID := notification.NextID()
+	db.insert(notification.Notification{
+		ID:              notification.NextID(),
+		Date:            time.Now(),
+		ChildProccessID: runID,
+		Type:            notification.NotificationTypeStartup,
+		Message:         "process started",
+	})
+
+	warnings := []notification.Notification{}
+	db.checkHardLimit(func(n notification.Notification) error {
+		warnings = append(warnings, n)
+		return nil
+	})
+
+	if len(warnings) != 1 || warnings[0].Type != notification.NotificationTypeSystemError {
+		t.Fatalf("expected a warning, got %+v", warnings)
+	}
+	if db.StorageWarning() == "" || !db.RetentionStatus().MemoryRing {
+		t.Error("expected the memory ring to be reported")
+	}
+
+	db.insert(notification.Notification{
+		ID:              notification.NextID(),
+		Date:            time.Now(),
+		ChildProccessID: runID,
+		Type:            notification.NotificationTypeStdOut,
+		Message:         "in memory",
+	})
+
+	// the current run is carried over and new events are only written to memory
+	notifs, err := db.FindNotifications("", "", "", "")
+	if err != nil {
+		t.Fatalf("finding notifications: %v", err)
+	}
+	if len(notifs) != 1 || len(notifs[0]) != 2 {
+		t.Errorf("expected the current run to have 2 events, got %+v", notifs)
+	}
+
+	var onDisk int
+	err = db.db.Get(&onDisk, "SELECT COUNT(*) FROM notifs;")
+	if err != nil {
+		t.Fatalf("counting events: %v", err)
+	}
+	if onDisk != 1 {
+		t.Errorf("expected nothing to be written to disk, got %d events", onDisk)
+	}
+}
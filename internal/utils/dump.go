@@ -0,0 +1,133 @@
+package utils
+
+// gomon is a simple command line tool that watches your files and automatically restarts the application when it detects any changes in the working directory.
+// Copyright (C) 2023 John Dudmesh
+
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+
+// You should have received a copy of the GNU General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>.
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"runtime"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/jdudmesh/gomon/internal/notification"
+)
+
+// MaxDumpNotifications is the number of recent notifications included in a state dump
+const MaxDumpNotifications = 10
+
+// ChildState describes the current child process
+type ChildState struct {
+	RunID  string `json:"runId,omitempty"`
+	PID    int    `json:"pid,omitempty"`
+	State  string `json:"state"`
+	Uptime string `json:"uptime,omitempty"`
+}
+
+// RestartState describes how gomon restarts the child process after it fails
+type RestartState struct {
+	Policy string `json:"policy"`
+	// Attempts is the number of times the child process has failed to start since it last ran successfully
+	Attempts  int        `json:"attempts"`
+	LastError string     `json:"lastError,omitempty"`
+	NextRetry *time.Time `json:"nextRetry,omitempty"`
+	// Waiting is true if the process failed with the immediate policy and gomon is waiting for a change
+	Waiting bool `json:"waiting"`
+}
+
+// WatcherState describes the file watcher
+type WatcherState struct {
+	Watching    bool `json:"watching"`
+	Directories int  `json:"directories"`
+	Unwatched   int  `json:"unwatched"`
+}
+
+// StateDump is a snapshot of gomon's internal state for diagnosing an instance which appears to be hung
+type StateDump struct {
+	Date       time.Time    `json:"date"`
+	PID        int          `json:"pid"`
+	Uptime     string       `json:"uptime"`
+	Goroutines int          `json:"goroutines"`
+	Child      ChildState   `json:"child"`
+	Restart    RestartState `json:"restart"`
+	Watcher    WatcherState `json:"watcher"`
+	// Clients is the number of UI clients subscribed to each SSE stream and to the websocket
+	Clients       map[string]int               `json:"clients"`
+	Notifications []*notification.Notification `json:"notifications"`
+}
+
+// NewStateDump fills in the details of gomon's own process, the caller adds the state of each component
+func NewStateDump() StateDump {
+	return StateDump{
+		Date:       time.Now(),
+		PID:        os.Getpid(),
+		Uptime:     time.Since(startedAt).Round(time.Second).String(),
+		Goroutines: runtime.NumGoroutine(),
+		Clients:    map[string]int{},
+	}
+}
+
+// Format writes the dump in a human readable form
+func (d StateDump) Format(w io.Writer) {
+	fmt.Fprintf(w, "gomon: pid %d, up %s, %d goroutines\n", d.PID, d.Uptime, d.Goroutines)
+
+	child := d.Child.State
+	if d.Child.PID != 0 {
+		child += fmt.Sprintf(", pid %d, up %s", d.Child.PID, d.Child.Uptime)
+	}
+	if d.Child.RunID != "" {
+		child += ", run " + d.Child.RunID
+	}
+	fmt.Fprintf(w, "child process: %s\n", child)
+
+	restart := fmt.Sprintf("%s policy, %d failed attempts", d.Restart.Policy, d.Restart.Attempts)
+	if d.Restart.Waiting {
+		restart += ", waiting for a change"
+	}
+	if d.Restart.NextRetry != nil {
+		restart += fmt.Sprintf(", next retry at %s", d.Restart.NextRetry.Format(time.TimeOnly))
+	}
+	if d.Restart.LastError != "" {
+		restart += ", last error: " + d.Restart.LastError
+	}
+	fmt.Fprintf(w, "restarts: %s\n", restart)
+
+	watcher := "not watching"
+	if d.Watcher.Watching {
+		watcher = fmt.Sprintf("watching %d directories", d.Watcher.Directories)
+	}
+	if d.Watcher.Unwatched > 0 {
+		watcher += fmt.Sprintf(", %d over the watch limit", d.Watcher.Unwatched)
+	}
+	fmt.Fprintf(w, "watcher: %s\n", watcher)
+
+	clients := []string{}
+	for name, count := range d.Clients {
+		clients = append(clients, fmt.Sprintf("%s %d", name, count))
+	}
+	sort.Strings(clients)
+	if len(clients) == 0 {
+		clients = append(clients, "none")
+	}
+	fmt.Fprintf(w, "ui clients: %s\n", strings.Join(clients, ", "))
+
+	fmt.Fprintf(w, "last %d notifications:\n", len(d.Notifications))
+	for _, n := range d.Notifications {
+		fmt.Fprintf(w, "  %s %-20s %s\n", n.Date.Format(time.TimeOnly), n.Type, strings.TrimRight(n.Message, "\n"))
+	}
+}
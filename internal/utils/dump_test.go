@@ -0,0 +1,55 @@
+package utils
+
+// gomon is a simple command line tool that watches your files and automatically restarts the application when it detects any changes in the working directory.
+// Copyright (C) 2023 John Dudmesh
+
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+
+// You should have received a copy of the GNU General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>.
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/jdudmesh/gomon/internal/notification"
+)
+
+func TestStateDumpFormat(t *testing.T) {
+	d := NewStateDump()
+	d.Child = ChildState{RunID: "123", PID: 4321, State: "started", Uptime: "5s"}
+	d.Restart = RestartState{Policy: "backoff", Attempts: 2, LastError: "exited with status 1"}
+	d.Watcher = WatcherState{Watching: true, Directories: 12, Unwatched: 3}
+	d.Clients["sse events"] = 2
+	d.Notifications = []*notification.Notification{
+		{Date: time.Now(), Type: notification.NotificationTypeStdOut, Message: "listening on :8080\n"},
+	}
+
+	buf := bytes.Buffer{}
+	d.Format(&buf)
+	out := buf.String()
+
+	for _, expected := range []string{
+		"child process: started, pid 4321, up 5s, run 123\n",
+		"restarts: backoff policy, 2 failed attempts, last error: exited with status 1\n",
+		"watcher: watching 12 directories, 3 over the watch limit\n",
+		"ui clients: sse events 2\n",
+		"last 1 notifications:\n",
+		"stdout",
+		"listening on :8080\n",
+	} {
+		if !strings.Contains(out, expected) {
+			t.Errorf("expected %q in:\n%s", expected, out)
+		}
+	}
+}
@@ -0,0 +1,66 @@
+package utils
+
+// gomon is a simple command line tool that watches your files and automatically restarts the application when it detects any changes in the working directory.
+// Copyright (C) 2023 John Dudmesh
+
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+
+// You should have received a copy of the GNU General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>.
+
+const (
+	HealthOK       = "ok"
+	HealthDown     = "down"
+	HealthStarting = "starting"
+	HealthDisabled = "disabled"
+)
+
+// ComponentHealth is the state of one of gomon's subsystems
+type ComponentHealth struct {
+	Status string `json:"status"`
+	Error  string `json:"error,omitempty"`
+}
+
+// Health reports whether gomon's subsystems are running (Healthy) and whether the child process is up as well (Ready)
+type Health struct {
+	Healthy    bool                       `json:"healthy"`
+	Ready      bool                       `json:"ready"`
+	Components map[string]ComponentHealth `json:"components"`
+}
+
+// NewHealth summarises the component states, a component which is starting is healthy but not ready
+func NewHealth(components map[string]ComponentHealth) Health {
+	h := Health{
+		Healthy:    true,
+		Ready:      true,
+		Components: components,
+	}
+
+	for _, c := range components {
+		switch c.Status {
+		case HealthDown:
+			h.Healthy = false
+			h.Ready = false
+		case HealthStarting:
+			h.Ready = false
+		}
+	}
+
+	return h
+}
+
+// ComponentStatus converts the result of a health check to a component state
+func ComponentStatus(err error) ComponentHealth {
+	if err != nil {
+		return ComponentHealth{Status: HealthDown, Error: err.Error()}
+	}
+	return ComponentHealth{Status: HealthOK}
+}
@@ -0,0 +1,44 @@
+package utils
+
+// gomon is a simple command line tool that watches your files and automatically restarts the application when it detects any changes in the working directory.
+// Copyright (C) 2023 John Dudmesh
+
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+
+// You should have received a copy of the GNU General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>.
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestNewHealth(t *testing.T) {
+	h := NewHealth(map[string]ComponentHealth{
+		"watcher": ComponentStatus(nil),
+		"proxy":   {Status: HealthDisabled},
+		"child":   {Status: HealthStarting},
+	})
+	if !h.Healthy || h.Ready {
+		t.Errorf("expected healthy but not ready, got %+v", h)
+	}
+
+	h = NewHealth(map[string]ComponentHealth{
+		"watcher":  ComponentStatus(nil),
+		"database": ComponentStatus(errors.New("disk full")),
+	})
+	if h.Healthy || h.Ready {
+		t.Errorf("expected unhealthy, got %+v", h)
+	}
+	if h.Components["database"].Error != "disk full" {
+		t.Errorf("unexpected error: %s", h.Components["database"].Error)
+	}
+}
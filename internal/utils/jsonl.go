@@ -0,0 +1,1209 @@
+package utils
+
+// gomon is a simple command line tool that watches your files and automatically restarts the application when it detects any changes in the working directory.
+// Copyright (C) 2023 John Dudmesh
+
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+
+// You should have received a copy of the GNU General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>.
+
+import (
+	"bufio"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path"
+	"slices"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/jdudmesh/gomon/internal/config"
+	"github.com/jdudmesh/gomon/internal/notification"
+)
+
+// jsonlRecord is a line of the history file, exactly one of Event, Trigger, Sample, Restart, Label and Unlabel is
+// set. RunID is the run a sample was taken during because it isn't part of the sample's JSON. Unlabel removes a label
+// which was added earlier in the file, they are dropped when the file is rewritten.
+type jsonlRecord struct {
+	Event   *notification.Notification `json:"event,omitempty"`
+	Trigger *RunTrigger                `json:"trigger,omitempty"`
+	Sample  *ResourceSample            `json:"sample,omitempty"`
+	RunID   string                     `json:"runId,omitempty"`
+	Restart *RestartDuration           `json:"restart,omitempty"`
+	Label   *RunLabel                  `json:"label,omitempty"`
+	Unlabel *RunLabel                  `json:"unlabel,omitempty"`
+}
+
+// JSONLStore keeps the history in memory and appends it to .gomon/history.jsonl so that it survives a restart.
+// Unlike the SQLite database it doesn't need cgo, but every event is held in memory and searched by scanning, so it
+// suits the smaller histories of containers and ARM boards. The file is rewritten without the deleted runs when the
+// history is pruned. If path is empty nothing is written to disk.
+type JSONLStore struct {
+	lock   sync.RWMutex
+	path   string
+	file   *os.File
+	writer *bufio.Writer
+	size   int64
+	// events are in the order they happened, they are returned to callers so they mustn't be modified
+	events   []*notification.Notification
+	byID     map[string]*notification.Notification
+	triggers []*RunTrigger
+	samples  []*ResourceSample
+	restarts []*RestartDuration
+	labels   []*RunLabel
+	// memoryOnly is set once the file reaches the hard limit, after that new events are only kept in memory
+	memoryOnly atomic.Bool
+
+	writeQueue chan notification.Notification
+	batchSize  int
+	done       chan struct{}
+	writerWait sync.WaitGroup
+	dropped    atomic.Int64
+	// lastWriteErr holds the error from the most recent write, nil if it succeeded
+	lastWriteErr    atomic.Pointer[error]
+	recoveryWarning string
+	retention
+	// linker is only used by the writer
+	linker triggerLinker
+}
+
+// NewJSONLStore loads the history from .gomon/history.jsonl, lines which can't be read e.g. because gomon was
+// killed while writing them are skipped
+func NewJSONLStore(cfg config.Config) (*JSONLStore, error) {
+	dataPath, err := dataDirectory(cfg.RootDirectory)
+	if err != nil {
+		return nil, err
+	}
+
+	s := newJSONLStore(cfg, path.Join(dataPath, "history.jsonl"))
+	s.hardLimit = int64(cfg.UI.Retention.HardLimitMB) * 1024 * 1024
+
+	skipped, err := s.load()
+	if err != nil {
+		return nil, err
+	}
+	if skipped > 0 {
+		s.recoveryWarning = fmt.Sprintf("%d lines of the history file %s couldn't be read and were skipped", skipped, s.path)
+		log.Warn(s.recoveryWarning)
+	}
+
+	err = s.openFile()
+	if err != nil {
+		return nil, err
+	}
+
+	s.writerWait.Add(1)
+	go s.runWriter()
+
+	return s, nil
+}
+
+// NewMemoryJSONLStore keeps the history in memory only, it is used for the memory storage type when gomon was
+// built without cgo
+func NewMemoryJSONLStore(cfg config.Config) *JSONLStore {
+	s := newJSONLStore(cfg, "")
+	s.writerWait.Add(1)
+	go s.runWriter()
+	return s
+}
+
+func newJSONLStore(cfg config.Config, path string) *JSONLStore {
+	bufferSize := cfg.Limits.DBBuffer
+	if bufferSize <= 0 {
+		bufferSize = config.DefaultDBBuffer
+	}
+
+	s := &JSONLStore{
+		path:       path,
+		byID:       map[string]*notification.Notification{},
+		writeQueue: make(chan notification.Notification, bufferSize),
+		batchSize:  cfg.Limits.DBBatchSize,
+		done:       make(chan struct{}),
+	}
+	if s.batchSize <= 0 {
+		s.batchSize = config.DefaultDBBatchSize
+	}
+	s.retention.configure(cfg)
+
+	return s
+}
+
+// load reads the history file into memory and returns the number of lines which couldn't be read
+func (s *JSONLStore) load() (int, error) {
+	f, err := os.Open(s.path)
+	if os.IsNotExist(err) {
+		return 0, nil
+	}
+	if err != nil {
+		return 0, fmt.Errorf("opening history file: %w", err)
+	}
+	defer f.Close()
+
+	skipped := 0
+	reader := bufio.NewReader(f)
+	for {
+		line, err := reader.ReadBytes('\n')
+		if len(line) > 0 {
+			record := jsonlRecord{}
+			if json.Unmarshal(line, &record) == nil {
+				s.addRecord(record)
+			} else {
+				skipped++
+			}
+		}
+		if errors.Is(err, io.EOF) {
+			return skipped, nil
+		}
+		if err != nil {
+			return skipped, fmt.Errorf("reading history file: %w", err)
+		}
+	}
+}
+
+// openFile opens the history file for appending, a partial line left by a crash is ended so that it doesn't run
+// into the next record
+func (s *JSONLStore) openFile() error {
+	f, err := os.OpenFile(s.path, os.O_APPEND|os.O_CREATE|os.O_RDWR, 0644)
+	if err != nil {
+		return fmt.Errorf("opening history file: %w", err)
+	}
+
+	info, err := f.Stat()
+	if err != nil {
+		f.Close()
+		return fmt.Errorf("checking history file: %w", err)
+	}
+	s.size = info.Size()
+
+	if s.size > 0 {
+		last := make([]byte, 1)
+		_, err = f.ReadAt(last, s.size-1)
+		if err == nil && last[0] != '\n' {
+			_, err = f.Write([]byte{'\n'})
+			s.size++
+		}
+		if err != nil {
+			f.Close()
+			return fmt.Errorf("repairing history file: %w", err)
+		}
+	}
+
+	s.file = f
+	s.writer = bufio.NewWriter(f)
+	return nil
+}
+
+// addRecord adds a record read from the file to the history in memory
+func (s *JSONLStore) addRecord(record jsonlRecord) {
+	switch {
+	case record.Event != nil:
+		s.addEvent(record.Event)
+	case record.Trigger != nil:
+		s.triggers = append(s.triggers, record.Trigger)
+	case record.Sample != nil:
+		record.Sample.ChildProccessID = record.RunID
+		s.samples = append(s.samples, record.Sample)
+	case record.Restart != nil:
+		s.restarts = append(s.restarts, record.Restart)
+	case record.Label != nil:
+		s.addLabel(record.Label)
+	case record.Unlabel != nil:
+		s.removeLabel(record.Unlabel.ChildProccessID, record.Unlabel.Label)
+	}
+}
+
+// addLabel adds a label to a run unless the run already has it, it returns false if it did
+func (s *JSONLStore) addLabel(l *RunLabel) bool {
+	for _, existing := range s.labels {
+		if existing.ChildProccessID == l.ChildProccessID && existing.Label == l.Label {
+			return false
+		}
+	}
+	s.labels = append(s.labels, l)
+	return true
+}
+
+// removeLabel removes a label from a run, it returns false if the run didn't have it
+func (s *JSONLStore) removeLabel(runID, label string) bool {
+	before := len(s.labels)
+	s.labels = slices.DeleteFunc(s.labels, func(l *RunLabel) bool {
+		return l.ChildProccessID == runID && l.Label == label
+	})
+	return len(s.labels) < before
+}
+
+// eventBefore is the order events are kept in, the same as they are sorted by the database
+func eventBefore(a, b *notification.Notification) bool {
+	return a.Date.Before(b.Date) || (a.Date.Equal(b.Date) && a.ID < b.ID)
+}
+
+// addEvent inserts an event in order, events usually arrive in order so the search starts at the end
+func (s *JSONLStore) addEvent(n *notification.Notification) {
+	ix := len(s.events)
+	for ix > 0 && eventBefore(n, s.events[ix-1]) {
+		ix--
+	}
+	s.events = slices.Insert(s.events, ix, n)
+	s.byID[n.ID] = n
+}
+
+// append writes a record to the end of the file, it is counted towards the size even if it is only kept in memory
+func (s *JSONLStore) append(record jsonlRecord) error {
+	data, err := json.Marshal(record)
+	if err != nil {
+		return fmt.Errorf("encoding record: %w", err)
+	}
+	data = append(data, '\n')
+	s.size += int64(len(data))
+
+	if s.writer == nil {
+		return nil
+	}
+	_, err = s.writer.Write(data)
+	if err != nil {
+		return fmt.Errorf("writing history file: %w", err)
+	}
+	return nil
+}
+
+func (s *JSONLStore) flush() error {
+	if s.writer == nil {
+		return nil
+	}
+	err := s.writer.Flush()
+	if err != nil {
+		return fmt.Errorf("writing history file: %w", err)
+	}
+	return nil
+}
+
+func (s *JSONLStore) Close() error {
+	close(s.done)
+	s.writerWait.Wait()
+
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	if s.file == nil {
+		return nil
+	}
+	err := s.flush()
+	return errors.Join(err, s.file.Close())
+}
+
+// Notify queues the notification for writing, if the queue is full then lines of output are dropped rather than
+// allowing memory usage to grow without bound, other events wait for space so that no run goes missing from history
+func (s *JSONLStore) Notify(n notification.Notification) error {
+	select {
+	case <-s.done:
+		return nil
+	default:
+	}
+
+	select {
+	case s.writeQueue <- n:
+		return nil
+	default:
+	}
+
+	if droppable(n.Type) {
+		s.dropped.Add(1)
+		return nil
+	}
+	select {
+	case s.writeQueue <- n:
+	case <-s.done:
+	}
+	return nil
+}
+
+func (s *JSONLStore) QueueStats() map[string]QueueStats {
+	return map[string]QueueStats{
+		"db.writes": {Depth: len(s.writeQueue), Capacity: cap(s.writeQueue), Dropped: s.dropped.Load()},
+	}
+}
+
+// runWriter writes whatever is queued, up to batchSize events, before flushing the file
+func (s *JSONLStore) runWriter() {
+	defer s.writerWait.Done()
+
+	batch := make([]notification.Notification, 0, s.batchSize)
+	for {
+		select {
+		case n := <-s.writeQueue:
+			batch = append(batch, n)
+		drain:
+			for len(batch) < s.batchSize {
+				select {
+				case n := <-s.writeQueue:
+					batch = append(batch, n)
+				default:
+					break drain
+				}
+			}
+		case <-s.done:
+			// flush anything left in the queue before exiting
+			for {
+				select {
+				case n := <-s.writeQueue:
+					batch = append(batch, n)
+				default:
+					s.writeBatch(batch)
+					return
+				}
+			}
+		}
+
+		s.writeBatch(batch)
+		batch = batch[:0]
+	}
+}
+
+func (s *JSONLStore) insert(n notification.Notification) {
+	s.writeBatch([]notification.Notification{n})
+}
+
+// writeBatch adds the events to the history and appends them to the file, an event which can't be written doesn't
+// stop the others from being written
+func (s *JSONLStore) writeBatch(batch []notification.Notification) {
+	if len(batch) == 0 {
+		return
+	}
+
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	var lastErr error
+	for _, n := range batch {
+		if _, ok := s.byID[n.ID]; ok {
+			lastErr = fmt.Errorf("event %s already exists", n.ID)
+			log.Errorf("writing notification: %v", lastErr)
+			continue
+		}
+
+		// the task and trigger aren't persisted by the database either
+		ev := n
+		ev.TaskID = ""
+		ev.Trigger = nil
+		s.addEvent(&ev)
+		err := s.append(jsonlRecord{Event: &ev})
+		if err != nil {
+			log.Errorf("writing notification: %v", err)
+			lastErr = err
+			continue
+		}
+
+		s.linker.link(n, func(runID string, t notification.Notification) {
+			trigger := &RunTrigger{Trigger: *t.Trigger, ChildProccessID: runID, Date: t.Date, Type: t.Type}
+			s.triggers = append(s.triggers, trigger)
+			err := s.append(jsonlRecord{Trigger: trigger})
+			if err != nil {
+				log.Errorf("writing trigger: %v", err)
+			}
+		})
+	}
+
+	err := s.flush()
+	if err != nil {
+		log.Errorf("writing notifications: %v", err)
+		lastErr = err
+	}
+
+	if lastErr != nil {
+		s.lastWriteErr.Store(&lastErr)
+		return
+	}
+	s.lastWriteErr.Store(nil)
+}
+
+// RecordSample adds a resource sample to the history
+func (s *JSONLStore) RecordSample(sample ResourceSample) error {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	s.samples = append(s.samples, &sample)
+	err := s.append(jsonlRecord{Sample: &sample, RunID: sample.ChildProccessID})
+	if err == nil {
+		err = s.flush()
+	}
+	if err != nil {
+		return fmt.Errorf("writing resource sample: %w", err)
+	}
+	return nil
+}
+
+// RecordRestart adds a restart duration to the history
+func (s *JSONLStore) RecordRestart(r RestartDuration) error {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	s.restarts = append(s.restarts, &r)
+	err := s.append(jsonlRecord{Restart: &r})
+	if err == nil {
+		err = s.flush()
+	}
+	if err != nil {
+		return fmt.Errorf("writing restart duration: %w", err)
+	}
+	return nil
+}
+
+// AddRunLabel labels a run, ErrRunNotFound is returned if the run has no startup event. Adding a label which the
+// run already has does nothing.
+func (s *JSONLStore) AddRunLabel(runID, label string) error {
+	label, err := CleanLabel(label)
+	if err != nil {
+		return err
+	}
+
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	if !slices.ContainsFunc(s.runs(), func(n *notification.Notification) bool { return n.ChildProccessID == runID }) {
+		return ErrRunNotFound
+	}
+
+	l := &RunLabel{ChildProccessID: runID, Label: label, Date: time.Now()}
+	if !s.addLabel(l) {
+		return nil
+	}
+	err = s.append(jsonlRecord{Label: l})
+	if err == nil {
+		err = s.flush()
+	}
+	if err != nil {
+		return fmt.Errorf("writing label: %w", err)
+	}
+	return nil
+}
+
+// RemoveRunLabel removes a label from a run, removing a label which the run doesn't have does nothing
+func (s *JSONLStore) RemoveRunLabel(runID, label string) error {
+	label, err := CleanLabel(label)
+	if err != nil {
+		return err
+	}
+
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	if !s.removeLabel(runID, label) {
+		return nil
+	}
+	err = s.append(jsonlRecord{Unlabel: &RunLabel{ChildProccessID: runID, Label: label, Date: time.Now()}})
+	if err == nil {
+		err = s.flush()
+	}
+	if err != nil {
+		return fmt.Errorf("writing label: %w", err)
+	}
+	return nil
+}
+
+// FindRunLabels returns the labels of each of the runs in the order they were added, runs without any aren't
+// included
+func (s *JSONLStore) FindRunLabels(runIDs []string) (map[string][]string, error) {
+	s.lock.RLock()
+	defer s.lock.RUnlock()
+
+	labels := map[string][]string{}
+	for _, l := range s.labels {
+		if slices.Contains(runIDs, l.ChildProccessID) {
+			labels[l.ChildProccessID] = append(labels[l.ChildProccessID], l.Label)
+		}
+	}
+	return labels, nil
+}
+
+// FindLabels returns every label which is in use, the most recently added first
+func (s *JSONLStore) FindLabels() ([]string, error) {
+	s.lock.RLock()
+	defer s.lock.RUnlock()
+
+	labels := []string{}
+	for ix := len(s.labels) - 1; ix >= 0; ix-- {
+		if !slices.Contains(labels, s.labels[ix].Label) {
+			labels = append(labels, s.labels[ix].Label)
+		}
+	}
+	return labels, nil
+}
+
+// Health returns an error if the last write failed
+func (s *JSONLStore) Health() error {
+	if lastErr := s.lastWriteErr.Load(); lastErr != nil {
+		return fmt.Errorf("writing notification: %w", *lastErr)
+	}
+	return nil
+}
+
+// RecoveryWarning returns a message if part of the history file couldn't be read
+func (s *JSONLStore) RecoveryWarning() string {
+	return s.recoveryWarning
+}
+
+// StorageWarning returns a message if events are no longer being written to disk because the file reached its
+// hard limit
+func (s *JSONLStore) StorageWarning() string {
+	if !s.memoryOnly.Load() {
+		return ""
+	}
+	return fmt.Sprintf("history file reached its %s limit, only the most recent %d events are being kept in memory", formatBytes(s.hardLimit), config.DefaultMemoryRingEvents)
+}
+
+// StartPruner prunes the history now and then on the configured schedule until the store is closed
+func (s *JSONLStore) StartPruner(callbackFn notification.NotificationCallback) {
+	s.writerWait.Add(1)
+	go func() {
+		defer s.writerWait.Done()
+		s.runPruner(s.done, s.Prune, s.checkHardLimit, callbackFn)
+	}()
+}
+
+// checkHardLimit stops writing to the file once it reaches the hard limit, after that the events in memory are
+// trimmed to the most recent ones
+func (s *JSONLStore) checkHardLimit(callbackFn notification.NotificationCallback) {
+	if s.hardLimit <= 0 {
+		return
+	}
+
+	s.lock.Lock()
+	if s.memoryOnly.Load() {
+		s.trimEvents()
+		s.lock.Unlock()
+		return
+	}
+	if s.size < s.hardLimit {
+		s.lock.Unlock()
+		return
+	}
+	err := s.flush()
+	if err == nil {
+		err = s.file.Close()
+	}
+	if err != nil {
+		log.Errorf("closing history file: %v", err)
+	}
+	s.file = nil
+	s.writer = nil
+	s.memoryOnly.Store(true)
+	s.lock.Unlock()
+
+	warning := s.StorageWarning()
+	log.Warn(warning)
+	callbackFn(notification.Notification{
+		ID:      notification.NextID(),
+		Date:    time.Now(),
+		Type:    notification.NotificationTypeSystemError,
+		Message: warning,
+	})
+}
+
+// trimEvents deletes the oldest events beyond the size of the memory ring, startup events are kept so that runs
+// can still be listed
+func (s *JSONLStore) trimEvents() {
+	excess := len(s.events) - config.DefaultMemoryRingEvents
+	if excess <= 0 {
+		return
+	}
+	s.deleteEvents(func(ix int, n *notification.Notification) bool {
+		return ix < excess && n.Type != notification.NotificationTypeStartup
+	})
+}
+
+// deleteEvents deletes the events matching fn and returns the number deleted
+func (s *JSONLStore) deleteEvents(fn func(ix int, n *notification.Notification) bool) int64 {
+	kept := s.events[:0]
+	deleted := int64(0)
+	for ix, n := range s.events {
+		if fn(ix, n) {
+			delete(s.byID, n.ID)
+			deleted++
+			continue
+		}
+		kept = append(kept, n)
+	}
+	clear(s.events[len(kept):])
+	s.events = kept
+	return deleted
+}
+
+// RetentionStatus reports when the pruner last ran and when it will next run along with the size of the history
+func (s *JSONLStore) RetentionStatus() *RetentionStatus {
+	status := s.status()
+	status.MemoryRing = s.memoryOnly.Load()
+
+	s.lock.RLock()
+	status.SizeBytes = s.size
+	s.lock.RUnlock()
+
+	return status
+}
+
+// Prune deletes runs which fall outside of the retention policy and rewrites the file without them
+func (s *JSONLStore) Prune() (PruneStats, error) {
+	stats := PruneStats{Date: time.Now()}
+	defer func() {
+		stats.Duration = time.Since(stats.Date).Round(time.Millisecond).String()
+	}()
+
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	runsBefore := len(s.runs())
+	deleted := int64(0)
+
+	if s.maxAge > 0 {
+		// a run which has been going for longer than maxAge is kept whole so it doesn't lose its startup event
+		expiry := time.Now().Add(-s.maxAge)
+		current := s.latestRun()
+		deleted += s.deleteEvents(func(_ int, n *notification.Notification) bool {
+			return n.Date.Before(expiry) && (current == "" || n.ChildProccessID != current)
+		})
+	}
+
+	if s.maxRuns > 0 {
+		if runs := s.runs(); len(runs) > s.maxRuns {
+			deleted += s.deleteRuns(runs[s.maxRuns:])
+		}
+	}
+
+	if s.maxSize > 0 {
+		sizes, total := s.runSizes()
+		runs := s.runs()
+		// delete the oldest runs, but always keep the current one
+		for len(runs) > 1 && total > s.maxSize {
+			oldest := runs[len(runs)-1]
+			deleted += s.deleteRuns(runs[len(runs)-1:])
+			total -= sizes[oldest.ChildProccessID]
+			runs = runs[:len(runs)-1]
+		}
+	}
+
+	if deleted == 0 {
+		return stats, nil
+	}
+	stats.EventsDeleted = deleted
+
+	started := map[string]bool{}
+	for _, run := range s.runs() {
+		started[run.ChildProccessID] = true
+	}
+	s.triggers = slices.DeleteFunc(s.triggers, func(t *RunTrigger) bool { return !started[t.ChildProccessID] })
+	s.samples = slices.DeleteFunc(s.samples, func(r *ResourceSample) bool { return !started[r.ChildProccessID] })
+	s.restarts = slices.DeleteFunc(s.restarts, func(r *RestartDuration) bool { return !started[r.ChildProccessID] })
+	s.labels = slices.DeleteFunc(s.labels, func(l *RunLabel) bool { return !started[l.ChildProccessID] })
+	stats.RunsDeleted = int64(runsBefore - len(started))
+
+	log.Infof("pruned %d events from history", deleted)
+	sizeBefore := s.size
+	err := s.rewrite()
+	if err != nil {
+		return stats, err
+	}
+	stats.BytesReclaimed = sizeBefore - s.size
+
+	return stats, nil
+}
+
+// deleteRuns deletes every event of the runs, given by their startup events
+func (s *JSONLStore) deleteRuns(runs []*notification.Notification) int64 {
+	ids := map[string]bool{}
+	for _, run := range runs {
+		ids[run.ChildProccessID] = true
+	}
+	return s.deleteEvents(func(_ int, n *notification.Notification) bool {
+		return ids[n.ChildProccessID]
+	})
+}
+
+// runSizes returns the number of bytes used by the events of each run and by the whole history
+func (s *JSONLStore) runSizes() (map[string]int64, int64) {
+	sizes := map[string]int64{}
+	total := int64(0)
+	s.eachRecord(func(record jsonlRecord) {
+		data, _ := json.Marshal(record)
+		total += int64(len(data)) + 1
+		if record.Event != nil {
+			sizes[record.Event.ChildProccessID] += int64(len(data)) + 1
+		}
+	})
+	return sizes, total
+}
+
+// eachRecord calls fn with the record of everything in the history
+func (s *JSONLStore) eachRecord(fn func(record jsonlRecord)) {
+	for _, n := range s.events {
+		fn(jsonlRecord{Event: n})
+	}
+	for _, t := range s.triggers {
+		fn(jsonlRecord{Trigger: t})
+	}
+	for _, r := range s.samples {
+		fn(jsonlRecord{Sample: r, RunID: r.ChildProccessID})
+	}
+	for _, r := range s.restarts {
+		fn(jsonlRecord{Restart: r})
+	}
+	for _, l := range s.labels {
+		fn(jsonlRecord{Label: l})
+	}
+}
+
+// rewrite replaces the file with one containing only what is left of the history, the new file is written
+// alongside the old one and renamed over it so that a crash can't lose the history
+func (s *JSONLStore) rewrite() error {
+	if s.file == nil {
+		_, s.size = s.runSizes()
+		return nil
+	}
+
+	tmpPath := s.path + ".tmp"
+	f, err := os.Create(tmpPath)
+	if err != nil {
+		return fmt.Errorf("rewriting history file: %w", err)
+	}
+
+	writer := bufio.NewWriter(f)
+	encoder := json.NewEncoder(writer)
+	s.eachRecord(func(record jsonlRecord) {
+		if err == nil {
+			err = encoder.Encode(record)
+		}
+	})
+	if err == nil {
+		err = writer.Flush()
+	}
+	if err == nil {
+		err = f.Sync()
+	}
+	err = errors.Join(err, f.Close())
+	if err == nil {
+		err = errors.Join(s.flush(), s.file.Close())
+		s.file, s.writer = nil, nil
+		if err == nil {
+			err = os.Rename(tmpPath, s.path)
+		}
+		err = errors.Join(err, s.openFile())
+	}
+	if err != nil {
+		os.Remove(tmpPath)
+		return fmt.Errorf("rewriting history file: %w", err)
+	}
+
+	return nil
+}
+
+// runs returns the startup events of the runs, the most recent first
+func (s *JSONLStore) runs() []*notification.Notification {
+	runs := []*notification.Notification{}
+	for ix := len(s.events) - 1; ix >= 0; ix-- {
+		if s.events[ix].Type == notification.NotificationTypeStartup {
+			runs = append(runs, s.events[ix])
+		}
+	}
+	return runs
+}
+
+// latestRun returns the ID of the most recent run, or an empty string if there aren't any
+func (s *JSONLStore) latestRun() string {
+	for ix := len(s.events) - 1; ix >= 0; ix-- {
+		if s.events[ix].Type == notification.NotificationTypeStartup {
+			return s.events[ix].ChildProccessID
+		}
+	}
+	return ""
+}
+
+// runEvents returns the events of a run in order
+func (s *JSONLStore) runEvents(runID string) []*notification.Notification {
+	events := []*notification.Notification{}
+	for _, n := range s.events {
+		if n.ChildProccessID == runID {
+			events = append(events, n)
+		}
+	}
+	return events
+}
+
+// FindNotifications returns the events of a run (or "all" runs) grouped by run. stm limits them to an event type,
+// level hides output below the level and filter matches the event text ignoring case.
+func (s *JSONLStore) FindNotifications(runID, stm, level, filter string) ([][]*notification.Notification, error) {
+	s.lock.RLock()
+	defer s.lock.RUnlock()
+
+	notifs := [][]*notification.Notification{}
+	if runID == "" {
+		runID = s.latestRun()
+	}
+	if runID == "" {
+		return notifs, nil
+	}
+
+	levels := []string{}
+	if ix := slices.Index(notification.Levels, level); ix >= 0 {
+		levels = notification.Levels[ix:]
+	}
+	filter = strings.ToLower(filter)
+
+	matches := []*notification.Notification{}
+	for _, n := range s.events {
+		switch {
+		case n.ChildProccessID == "":
+		case runID != "all" && n.ChildProccessID != runID:
+		case !(stm == "" || stm == "all") && strconv.Itoa(int(n.Type)) != stm:
+		// lifecycle events are kept so that it is still clear when each run started and how it ended
+		case len(levels) > 0 && (n.Type == notification.NotificationTypeStdOut || n.Type == notification.NotificationTypeStdErr) && !slices.Contains(levels, n.Level):
+		case filter != "" && !strings.Contains(strings.ToLower(n.Message), filter):
+		default:
+			matches = append(matches, n)
+		}
+	}
+
+	// the runs are ordered by their IDs like the database
+	sort.SliceStable(matches, func(i, j int) bool { return matches[i].ChildProccessID < matches[j].ChildProccessID })
+	if len(matches) > 1000 {
+		matches = matches[:1000]
+	}
+
+	lastRunID := ""
+	for _, n := range matches {
+		if lastRunID != n.ChildProccessID {
+			notifs = append(notifs, []*notification.Notification{})
+			lastRunID = n.ChildProccessID
+		}
+		notifs[len(notifs)-1] = append(notifs[len(notifs)-1], n)
+	}
+
+	return notifs, nil
+}
+
+// FindRuns returns the startup events of the most recent runs, the latest first. If label isn't empty only the runs
+// with the label are returned.
+func (s *JSONLStore) FindRuns(label string) ([]*notification.Notification, error) {
+	s.lock.RLock()
+	defer s.lock.RUnlock()
+
+	runs := s.runs()
+	if label != "" {
+		labelled := map[string]bool{}
+		for _, l := range s.labels {
+			if l.Label == label {
+				labelled[l.ChildProccessID] = true
+			}
+		}
+		runs = slices.DeleteFunc(runs, func(n *notification.Notification) bool { return !labelled[n.ChildProccessID] })
+	}
+	if len(runs) > 100 {
+		runs = runs[:100]
+	}
+	return runs, nil
+}
+
+// FindMarker returns the nearest lifecycle marker before or after the event fromID, if fromID is empty then the
+// search starts from the most recent event. The error marker is the first stderr output of the run containing fromID.
+// Returns nil if there is no matching marker.
+func (s *JSONLStore) FindMarker(marker, direction, fromID string) (*notification.Notification, error) {
+	s.lock.RLock()
+	defer s.lock.RUnlock()
+
+	switch marker {
+	case MarkerCrash, MarkerStartup:
+		eventType := notification.NotificationTypeCrash
+		if marker == MarkerStartup {
+			eventType = notification.NotificationTypeStartup
+		}
+
+		if fromID == "" && direction == DirectionNext {
+			return nil, nil
+		}
+		from := len(s.events)
+		if fromID != "" {
+			from = slices.Index(s.events, s.byID[fromID])
+			if from < 0 {
+				return nil, nil
+			}
+		}
+
+		if direction == DirectionNext {
+			for _, n := range s.events[from+1:] {
+				if n.Type == eventType {
+					return n, nil
+				}
+			}
+			return nil, nil
+		}
+		for ix := from - 1; ix >= 0; ix-- {
+			if s.events[ix].Type == eventType {
+				return s.events[ix], nil
+			}
+		}
+		return nil, nil
+	case MarkerError:
+		runID := s.latestRun()
+		if fromID != "" {
+			n, ok := s.byID[fromID]
+			if !ok {
+				return nil, nil
+			}
+			runID = n.ChildProccessID
+		}
+		if runID == "" {
+			return nil, nil
+		}
+		for _, n := range s.events {
+			if n.ChildProccessID == runID && (n.Type == notification.NotificationTypeStdErr || n.Type == notification.NotificationTypeBuildError) {
+				return n, nil
+			}
+		}
+		return nil, nil
+	default:
+		return nil, fmt.Errorf("unknown marker: %s", marker)
+	}
+}
+
+// FindRunTriggers returns the file changes which restarted each of the runs in the order they happened
+func (s *JSONLStore) FindRunTriggers(runIDs []string) (map[string][]*RunTrigger, error) {
+	s.lock.RLock()
+	defer s.lock.RUnlock()
+
+	triggers := map[string][]*RunTrigger{}
+	for _, t := range s.triggers {
+		if slices.Contains(runIDs, t.ChildProccessID) {
+			triggers[t.ChildProccessID] = append(triggers[t.ChildProccessID], t)
+		}
+	}
+	for _, ts := range triggers {
+		sort.SliceStable(ts, func(i, j int) bool { return ts[i].Date.Before(ts[j].Date) })
+	}
+
+	return triggers, nil
+}
+
+// FindRunErrorCounts returns the number of lines of error output written by each of the runs, runs without any
+// aren't included
+func (s *JSONLStore) FindRunErrorCounts(runIDs []string) (map[string]int, error) {
+	s.lock.RLock()
+	defer s.lock.RUnlock()
+
+	counts := map[string]int{}
+	for _, n := range s.events {
+		if n.Level == notification.LevelError && slices.Contains(runIDs, n.ChildProccessID) {
+			counts[n.ChildProccessID]++
+		}
+	}
+
+	return counts, nil
+}
+
+// FindComparisonRuns picks a pair of runs to compare in the same way as the database
+func (s *JSONLStore) FindComparisonRuns(runID string) (string, string, error) {
+	s.lock.RLock()
+	defer s.lock.RUnlock()
+
+	failed := map[string]bool{}
+	for _, n := range s.events {
+		if n.Type == notification.NotificationTypeCrash || n.Type == notification.NotificationTypeBuildError {
+			failed[n.ChildProccessID] = true
+		}
+	}
+
+	runs := []comparisonRun{}
+	for _, n := range s.runs() {
+		runs = append(runs, comparisonRun{ID: n.ChildProccessID, Failed: failed[n.ChildProccessID]})
+		if len(runs) == 100 {
+			break
+		}
+	}
+
+	previous, next := pickComparisonRuns(runs, runID)
+	return previous, next, nil
+}
+
+// ExportRun calls fn with each event of the run in order, ErrRunNotFound is returned if the run has no events
+func (s *JSONLStore) ExportRun(runID string, fn func(*notification.Notification) error) error {
+	s.lock.RLock()
+	if runID == LatestRun {
+		runID = s.latestRun()
+	}
+	events := s.runEvents(runID)
+	s.lock.RUnlock()
+
+	if len(events) == 0 {
+		return ErrRunNotFound
+	}
+	return exportEvents(events, fn)
+}
+
+// ExportRange calls fn with each event of the child process between fromID and toID inclusive, in order. The IDs
+// can be given in either order. At most MaxRangeEvents are returned, ErrEventNotFound is returned if either of the
+// events doesn't exist.
+func (s *JSONLStore) ExportRange(fromID, toID string, fn func(*notification.Notification) error) error {
+	s.lock.RLock()
+	from := slices.Index(s.events, s.byID[fromID])
+	to := slices.Index(s.events, s.byID[toID])
+	if from > to {
+		from, to = to, from
+	}
+	if from < 0 {
+		s.lock.RUnlock()
+		return ErrEventNotFound
+	}
+
+	// gomon's own events aren't shown in the UI so they aren't included
+	events := []*notification.Notification{}
+	for _, n := range s.events[from : to+1] {
+		if n.ChildProccessID != "" && len(events) < MaxRangeEvents {
+			events = append(events, n)
+		}
+	}
+	s.lock.RUnlock()
+
+	return exportEvents(events, fn)
+}
+
+func exportEvents(events []*notification.Notification, fn func(*notification.Notification) error) error {
+	for _, n := range events {
+		err := fn(n)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// FindTimeline returns the most recent runs in the order they started with the restarts, build errors, tasks, IPC
+// events and crashes which happened during each of them
+func (s *JSONLStore) FindTimeline() ([]*TimelineRun, error) {
+	s.lock.RLock()
+	defer s.lock.RUnlock()
+
+	starts := s.runs()
+	if len(starts) > MaxTimelineRuns {
+		starts = starts[:MaxTimelineRuns]
+	}
+
+	runs := make([]*TimelineRun, len(starts))
+	byID := map[string]*TimelineRun{}
+	for ix, n := range starts {
+		run := &TimelineRun{ID: n.ChildProccessID, Start: n.Date, End: n.Date, Markers: []*TimelineMarker{}}
+		runs[len(starts)-ix-1] = run
+		byID[run.ID] = run
+	}
+
+	markers := 0
+	for _, n := range s.events {
+		run, ok := byID[n.ChildProccessID]
+		if !ok {
+			continue
+		}
+		if n.Date.After(run.End) {
+			run.End = n.Date
+		}
+		switch {
+		case n.Type == notification.NotificationTypeShutdown:
+			run.Ended = true
+		case n.Type == notification.NotificationTypeCrash:
+			run.Ended = true
+			run.Crashed = true
+		}
+		if n.Level == notification.LevelError {
+			run.Errors++
+		}
+		if kind, ok := markerKinds[n.Type]; ok && markers < maxTimelineMarkers {
+			run.Markers = append(run.Markers, &TimelineMarker{ID: n.ID, Date: n.Date, Kind: kind, Message: StripANSI(n.Message)})
+			markers++
+		}
+	}
+
+	// soft restarts requested by gomon aren't run events, they are found from the file changes which caused them
+	for _, t := range s.triggers {
+		run, ok := byID[t.ChildProccessID]
+		if !ok || t.Type != notification.NotificationTypeSoftRestartRequested {
+			continue
+		}
+		run.Markers = append(run.Markers, &TimelineMarker{Date: t.Date, Kind: TimelineRestart, Message: fmt.Sprintf("soft restart because %s changed (%s)", t.Path, t.Rule)})
+	}
+	for _, run := range runs {
+		sort.SliceStable(run.Markers, func(i, j int) bool { return run.Markers[i].Date.Before(run.Markers[j].Date) })
+	}
+
+	return runs, nil
+}
+
+// FindResources returns the samples taken during a run, or during the most recent runs if runID is empty or "all",
+// the runs are in the order they started
+func (s *JSONLStore) FindResources(runID string) ([]*ResourceRun, error) {
+	s.lock.RLock()
+	defer s.lock.RUnlock()
+
+	runIDs := []string{}
+	switch runID {
+	case "", "all":
+		// the runs sampled most recently
+		for ix := len(s.samples) - 1; ix >= 0 && len(runIDs) < MaxResourceRuns; ix-- {
+			if id := s.samples[ix].ChildProccessID; !slices.Contains(runIDs, id) {
+				runIDs = append(runIDs, id)
+			}
+		}
+	case LatestRun:
+		if len(s.samples) > 0 {
+			runIDs = append(runIDs, s.samples[len(s.samples)-1].ChildProccessID)
+		}
+	default:
+		runIDs = append(runIDs, runID)
+	}
+
+	samples := []*ResourceSample{}
+	for _, sample := range s.samples {
+		if slices.Contains(runIDs, sample.ChildProccessID) {
+			samples = append(samples, sample)
+		}
+	}
+	sort.SliceStable(samples, func(i, j int) bool { return samples[i].Date.Before(samples[j].Date) })
+	if len(samples) > maxResourceSamples {
+		samples = samples[len(samples)-maxResourceSamples:]
+	}
+
+	runs := []*ResourceRun{}
+	byID := map[string]*ResourceRun{}
+	for _, sample := range samples {
+		run, ok := byID[sample.ChildProccessID]
+		if !ok {
+			run = &ResourceRun{ID: sample.ChildProccessID}
+			byID[sample.ChildProccessID] = run
+			runs = append(runs, run)
+		}
+		run.Samples = append(run.Samples, sample)
+	}
+
+	return runs, nil
+}
+
+// FindRestarts returns the most recent restart durations in the order they happened
+func (s *JSONLStore) FindRestarts() ([]*RestartDuration, error) {
+	s.lock.RLock()
+	defer s.lock.RUnlock()
+
+	restarts := slices.Clone(s.restarts)
+	sort.SliceStable(restarts, func(i, j int) bool { return restarts[i].ReadyAt.Before(restarts[j].ReadyAt) })
+	if len(restarts) > MaxRestartHistory {
+		restarts = restarts[len(restarts)-MaxRestartHistory:]
+	}
+	return restarts, nil
+}
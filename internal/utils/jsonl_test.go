@@ -0,0 +1,156 @@
+package utils
+
+// gomon is a simple command line tool that watches your files and automatically restarts the application when it detects any changes in the working directory.
+// Copyright (C) 2023 John Dudmesh
+
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+
+// You should have received a copy of the GNU General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>.
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/jdudmesh/gomon/internal/config"
+	"github.com/jdudmesh/gomon/internal/notification"
+)
+
+func TestJSONLStoreReloadsHistory(t *testing.T) {
+	cfg := config.Config{RootDirectory: t.TempDir()}
+	cfg.Storage.Type = config.StorageJSONL
+	store, err := NewStore(cfg)
+	if err != nil {
+		t.Fatalf("creating store: %v", err)
+	}
+
+	start := time.Now().Add(-time.Hour)
+	runIDs := []string{notification.NextID(), notification.NextID()}
+	events := []notification.Notification{
+		{ChildProccessID: runIDs[0], Type: notification.NotificationTypeStartup, Message: "startup"},
+		{ChildProccessID: runIDs[0], Type: notification.NotificationTypeStdOut, Message: "debug", Level: notification.LevelDebug},
+		{ChildProccessID: runIDs[0], Type: notification.NotificationTypeStdErr, Message: "Connection refused", Level: notification.LevelError},
+		{Type: notification.NotificationTypeHardRestartRequested, Message: "main.go", Trigger: &notification.Trigger{Path: "main.go", Rule: "hardReload *.go", Event: "WRITE"}},
+		{ChildProccessID: runIDs[1], Type: notification.NotificationTypeStartup, Message: "startup"},
+	}
+	for i, n := range events {
+		n.ID = notification.NextID()
+		n.Date = start.Add(time.Duration(i) * time.Second)
+		store.Notify(n)
+	}
+	err = store.RecordSample(ResourceSample{ChildProccessID: runIDs[1], Date: start, RSS: 1024})
+	if err != nil {
+		t.Fatalf("recording sample: %v", err)
+	}
+	store.Close()
+
+	// a line cut short by a crash
+	historyPath := filepath.Join(cfg.RootDirectory, ".gomon", "history.jsonl")
+	f, err := os.OpenFile(historyPath, os.O_APPEND|os.O_WRONLY, 0644)
+	if err != nil {
+		t.Fatalf("opening history: %v", err)
+	}
+	f.WriteString(`{"event":{"id":"`)
+	f.Close()
+
+	store, err = NewStore(cfg)
+	if err != nil {
+		t.Fatalf("reopening store: %v", err)
+	}
+	defer store.Close()
+
+	if store.RecoveryWarning() == "" {
+		t.Error("expected the partial line to be reported")
+	}
+
+	runs, err := store.FindRuns("")
+	if err != nil {
+		t.Fatalf("finding runs: %v", err)
+	}
+	if len(runs) != 2 || runs[0].ChildProccessID != runIDs[1] {
+		t.Errorf("expected 2 runs, the latest first, got %v", runs)
+	}
+
+	notifs, err := store.FindNotifications(runIDs[0], "", notification.LevelWarn, "refused")
+	if err != nil {
+		t.Fatalf("finding notifications: %v", err)
+	}
+	if len(notifs) != 1 || len(notifs[0]) != 1 || notifs[0][0].Message != "Connection refused" {
+		t.Errorf("expected the error to be found, got %v", notifs)
+	}
+
+	triggers, err := store.FindRunTriggers(runIDs)
+	if err != nil {
+		t.Fatalf("finding triggers: %v", err)
+	}
+	if len(triggers[runIDs[1]]) != 1 || triggers[runIDs[1]][0].Path != "main.go" {
+		t.Errorf("expected the hard restart to be linked to the next run, got %+v", triggers)
+	}
+
+	resources, err := store.FindResources("")
+	if err != nil {
+		t.Fatalf("finding resources: %v", err)
+	}
+	if len(resources) != 1 || resources[0].ID != runIDs[1] {
+		t.Errorf("expected the sample to be kept, got %+v", resources)
+	}
+
+	// new events are appended after the partial line
+	store.(*JSONLStore).insert(notification.Notification{ID: notification.NextID(), Date: time.Now(), ChildProccessID: runIDs[1], Type: notification.NotificationTypeStdOut, Message: "hello"})
+	data, err := os.ReadFile(historyPath)
+	if err != nil {
+		t.Fatalf("reading history: %v", err)
+	}
+	if !strings.HasSuffix(string(data), "\"message\":\"hello\"}}\n") || !strings.Contains(string(data), "{\"event\":{\"id\":\"\n") {
+		t.Errorf("expected the new event on a line of its own, got %s", data)
+	}
+}
+
+func TestJSONLStorePruneRewritesFile(t *testing.T) {
+	cfg := config.Config{RootDirectory: t.TempDir()}
+	cfg.UI.Retention.MaxRuns = 2
+	store, err := NewJSONLStore(cfg)
+	if err != nil {
+		t.Fatalf("creating store: %v", err)
+	}
+
+	start := time.Now().Add(-time.Hour)
+	for i := 0; i < 3; i++ {
+		runID := notification.NextID()
+		store.insert(notification.Notification{ID: notification.NextID(), Date: start.Add(time.Duration(i) * time.Minute), ChildProccessID: runID, Type: notification.NotificationTypeStartup, Message: "process started"})
+		store.insert(notification.Notification{ID: notification.NextID(), Date: start.Add(time.Duration(i) * time.Minute), ChildProccessID: runID, Type: notification.NotificationTypeStdOut, Message: "hello"})
+	}
+
+	stats, err := store.Prune()
+	if err != nil {
+		t.Fatalf("pruning: %v", err)
+	}
+	if stats.RunsDeleted != 1 || stats.EventsDeleted != 2 || stats.BytesReclaimed <= 0 {
+		t.Errorf("expected 1 run and 2 events to be deleted, got %+v", stats)
+	}
+	store.Close()
+
+	store, err = NewJSONLStore(cfg)
+	if err != nil {
+		t.Fatalf("reopening store: %v", err)
+	}
+	defer store.Close()
+
+	if len(store.events) != 4 {
+		t.Errorf("expected 4 events to be left in the file, got %d", len(store.events))
+	}
+	if _, err := os.Stat(store.path + ".tmp"); !os.IsNotExist(err) {
+		t.Errorf("expected the temporary file to be removed, got %v", err)
+	}
+}
@@ -0,0 +1,130 @@
+package utils
+
+// gomon is a simple command line tool that watches your files and automatically restarts the application when it detects any changes in the working directory.
+// Copyright (C) 2023 John Dudmesh
+
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+
+// You should have received a copy of the GNU General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>.
+
+import (
+	"fmt"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/jdudmesh/gomon/internal/notification"
+	"github.com/jmoiron/sqlx"
+)
+
+// MaxLabelLength is the number of characters a run label can have
+const MaxLabelLength = 100
+
+// ErrInvalidLabel is returned when a label is empty or longer than MaxLabelLength
+var ErrInvalidLabel = fmt.Errorf("a label must have between 1 and %d characters", MaxLabelLength)
+
+// RunLabel tags a run so that it can be found again among the others e.g. "before refactor" or "repro of bug #421"
+type RunLabel struct {
+	ChildProccessID string    `json:"childProcessId" db:"child_process_id"`
+	Label           string    `json:"label" db:"label"`
+	Date            time.Time `json:"createdAt" db:"created_at"`
+}
+
+// RunLabeller adds and removes the labels of runs
+type RunLabeller interface {
+	AddRunLabel(runID, label string) error
+	RemoveRunLabel(runID, label string) error
+	FindRunLabels(runIDs []string) (map[string][]string, error)
+	FindLabels() ([]string, error)
+}
+
+// CleanLabel removes the whitespace around a label and checks its length
+func CleanLabel(label string) (string, error) {
+	label = strings.Join(strings.Fields(label), " ")
+	if label == "" || utf8.RuneCountInString(label) > MaxLabelLength {
+		return "", ErrInvalidLabel
+	}
+	return label, nil
+}
+
+// AddRunLabel labels a run, ErrRunNotFound is returned if the run has no startup event. Adding a label which the
+// run already has does nothing.
+func (d *Database) AddRunLabel(runID, label string) error {
+	label, err := CleanLabel(label)
+	if err != nil {
+		return err
+	}
+
+	exists := false
+	err = d.conn().Get(&exists, "SELECT COUNT(*) > 0 FROM notifs WHERE child_process_id = ? AND event_type = ?;", runID, notification.NotificationTypeStartup)
+	if err != nil {
+		return fmt.Errorf("finding run: %w", err)
+	}
+	if !exists {
+		return ErrRunNotFound
+	}
+
+	_, err = d.conn().Exec("INSERT OR IGNORE INTO run_labels (child_process_id, label, created_at) VALUES (?, ?, ?);", runID, label, time.Now())
+	if err != nil {
+		return fmt.Errorf("adding label: %w", err)
+	}
+	return nil
+}
+
+// RemoveRunLabel removes a label from a run, removing a label which the run doesn't have does nothing
+func (d *Database) RemoveRunLabel(runID, label string) error {
+	label, err := CleanLabel(label)
+	if err != nil {
+		return err
+	}
+
+	_, err = d.conn().Exec("DELETE FROM run_labels WHERE child_process_id = ? AND label = ?;", runID, label)
+	if err != nil {
+		return fmt.Errorf("removing label: %w", err)
+	}
+	return nil
+}
+
+// FindRunLabels returns the labels of each of the runs in the order they were added, runs without any aren't
+// included
+func (d *Database) FindRunLabels(runIDs []string) (map[string][]string, error) {
+	labels := map[string][]string{}
+	if len(runIDs) == 0 {
+		return labels, nil
+	}
+
+	query, args, err := sqlx.In("SELECT * FROM run_labels WHERE child_process_id IN (?) ORDER BY created_at ASC, label ASC;", runIDs)
+	if err != nil {
+		return nil, fmt.Errorf("building labels query: %w", err)
+	}
+
+	rows := []*RunLabel{}
+	err = d.conn().Select(&rows, d.conn().Rebind(query), args...)
+	if err != nil {
+		return nil, fmt.Errorf("getting labels: %w", err)
+	}
+	for _, l := range rows {
+		labels[l.ChildProccessID] = append(labels[l.ChildProccessID], l.Label)
+	}
+
+	return labels, nil
+}
+
+// FindLabels returns every label which is in use, the most recently added first
+func (d *Database) FindLabels() ([]string, error) {
+	labels := []string{}
+	err := d.conn().Select(&labels, "SELECT label FROM run_labels GROUP BY label ORDER BY MAX(created_at) DESC, label ASC;")
+	if err != nil {
+		return nil, fmt.Errorf("getting labels: %w", err)
+	}
+	return labels, nil
+}
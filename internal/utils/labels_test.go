@@ -0,0 +1,113 @@
+package utils
+
+// gomon is a simple command line tool that watches your files and automatically restarts the application when it detects any changes in the working directory.
+// Copyright (C) 2023 John Dudmesh
+
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+
+// You should have received a copy of the GNU General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>.
+
+import (
+	"errors"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/jdudmesh/gomon/internal/config"
+	"github.com/jdudmesh/gomon/internal/notification"
+)
+
+func TestRunLabels(t *testing.T) {
+	for _, storageType := range storageTypes() {
+		t.Run(storageType, func(t *testing.T) {
+			cfg := config.Config{RootDirectory: t.TempDir()}
+			cfg.Storage.Type = storageType
+			store, err := NewStore(cfg)
+			if err != nil {
+				t.Fatalf("creating store: %v", err)
+			}
+
+			start := time.Now().Add(-time.Hour)
+			runIDs := []string{notification.NextID(), notification.NextID(), notification.NextID()}
+			for ix, runID := range runIDs {
+				store.Notify(notification.Notification{ID: notification.NextID(), Date: start.Add(time.Duration(ix) * time.Second), ChildProccessID: runID, Type: notification.NotificationTypeStartup})
+			}
+			// the events are written in the background
+			store.Close()
+			store, err = NewStore(cfg)
+			if err != nil {
+				t.Fatalf("reopening store: %v", err)
+			}
+
+			for _, l := range []struct{ runID, label string }{
+				{runIDs[0], "before refactor"},
+				{runIDs[2], "  repro of   bug #421 "},
+				{runIDs[0], "repro of bug #421"},
+				{runIDs[0], "before refactor"},
+				{runIDs[1], "flaky"},
+			} {
+				err = store.AddRunLabel(l.runID, l.label)
+				if err != nil {
+					t.Fatalf("adding label: %v", err)
+				}
+			}
+			err = store.RemoveRunLabel(runIDs[1], "flaky")
+			if err != nil {
+				t.Fatalf("removing label: %v", err)
+			}
+
+			// the labels are kept when gomon restarts
+			store.Close()
+			store, err = NewStore(cfg)
+			if err != nil {
+				t.Fatalf("reopening store: %v", err)
+			}
+			defer store.Close()
+
+			if err = store.AddRunLabel("missing", "label"); !errors.Is(err, ErrRunNotFound) {
+				t.Errorf("expected a missing run to be reported, got %v", err)
+			}
+			if err = store.AddRunLabel(runIDs[0], strings.Repeat("x", MaxLabelLength+1)); !errors.Is(err, ErrInvalidLabel) {
+				t.Errorf("expected a long label to be rejected, got %v", err)
+			}
+
+			labels, err := store.FindRunLabels(runIDs)
+			if err != nil {
+				t.Fatalf("finding labels: %v", err)
+			}
+			expected := map[string][]string{
+				runIDs[0]: {"before refactor", "repro of bug #421"},
+				runIDs[2]: {"repro of bug #421"},
+			}
+			if !reflect.DeepEqual(labels, expected) {
+				t.Errorf("unexpected labels: %v", labels)
+			}
+
+			runs, err := store.FindRuns("repro of bug #421")
+			if err != nil {
+				t.Fatalf("finding runs: %v", err)
+			}
+			if len(runs) != 2 || runs[0].ChildProccessID != runIDs[2] || runs[1].ChildProccessID != runIDs[0] {
+				t.Errorf("expected the labelled runs, the latest first, got %v", runs)
+			}
+
+			inUse, err := store.FindLabels()
+			if err != nil {
+				t.Fatalf("finding labels in use: %v", err)
+			}
+			if len(inUse) != 2 || inUse[0] != "repro of bug #421" {
+				t.Errorf("expected the labels in use, the latest first, got %v", inUse)
+			}
+		})
+	}
+}
@@ -0,0 +1,110 @@
+package utils
+
+// gomon is a simple command line tool that watches your files and automatically restarts the application when it detects any changes in the working directory.
+// Copyright (C) 2023 John Dudmesh
+
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+
+// You should have received a copy of the GNU General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>.
+
+import (
+	"regexp"
+	"strings"
+	"unicode"
+
+	"github.com/jdudmesh/gomon/internal/notification"
+)
+
+// levelNames maps the level names and abbreviations used by common loggers (log/slog, logrus, zap, zerolog) onto
+// gomon's levels, logrus truncates names to four characters and zerolog's console writer to three
+var levelNames = map[string]string{
+	"trace":    notification.LevelDebug,
+	"trac":     notification.LevelDebug,
+	"trc":      notification.LevelDebug,
+	"debug":    notification.LevelDebug,
+	"debu":     notification.LevelDebug,
+	"dbg":      notification.LevelDebug,
+	"info":     notification.LevelInfo,
+	"inf":      notification.LevelInfo,
+	"notice":   notification.LevelInfo,
+	"warn":     notification.LevelWarn,
+	"warning":  notification.LevelWarn,
+	"wrn":      notification.LevelWarn,
+	"error":    notification.LevelError,
+	"erro":     notification.LevelError,
+	"err":      notification.LevelError,
+	"fatal":    notification.LevelError,
+	"fata":     notification.LevelError,
+	"ftl":      notification.LevelError,
+	"panic":    notification.LevelError,
+	"pani":     notification.LevelError,
+	"dpanic":   notification.LevelError,
+	"critical": notification.LevelError,
+	"crit":     notification.LevelError,
+}
+
+// levelFieldPattern matches a level field in JSON (zap, slog, logrus, zerolog) or key=value (slog, logrus) output
+var levelFieldPattern = regexp.MustCompile(`(?i)(?:"(?:level|lvl|severity)"\s*:\s*"|(?:^|\s)(?:level|lvl)=)"?([a-z]+)`)
+
+// maxLevelPrefixWords is how many words at the start of a line are searched for a level, timestamps come first
+const maxLevelPrefixWords = 4
+
+// DetectLevel returns the log level of a line of output or an empty string if it doesn't have one. The level is
+// taken from a level field (JSON or key=value) or from a level name at the start of the line, possibly after a
+// timestamp e.g. "2024/01/02 15:04:05 ERROR msg", "INFO[0000] msg" or "[WARN] msg". Only the first word may be
+// lower case so that words in the message aren't mistaken for levels.
+func DetectLevel(line string) string {
+	line = strings.TrimSpace(StripANSI(line))
+	if line == "" {
+		return ""
+	}
+
+	if level := levelField(line); level != "" {
+		return level
+	}
+
+	for ix, word := range strings.Fields(line) {
+		if ix >= maxLevelPrefixWords {
+			break
+		}
+
+		name := levelWord(word)
+		if level, ok := levelNames[strings.ToLower(name)]; ok && (ix == 0 || strings.ToUpper(name) == name) {
+			return level
+		}
+
+		// anything other than a timestamp ends the prefix
+		if !strings.ContainsFunc(word, unicode.IsDigit) {
+			break
+		}
+	}
+
+	return ""
+}
+
+func levelField(line string) string {
+	match := levelFieldPattern.FindStringSubmatch(line)
+	if match == nil {
+		return ""
+	}
+	return levelNames[strings.ToLower(match[1])]
+}
+
+// levelWord returns the letters at the start of a word, ignoring an opening bracket e.g. "[WARN]" and "INFO[0000]"
+func levelWord(word string) string {
+	word = strings.TrimLeft(word, "[(<")
+	end := strings.IndexFunc(word, func(r rune) bool { return !unicode.IsLetter(r) })
+	if end < 0 {
+		return word
+	}
+	return word[:end]
+}
@@ -0,0 +1,51 @@
+package utils
+
+// gomon is a simple command line tool that watches your files and automatically restarts the application when it detects any changes in the working directory.
+// Copyright (C) 2023 John Dudmesh
+
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+
+// You should have received a copy of the GNU General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>.
+
+import "testing"
+
+func TestDetectLevel(t *testing.T) {
+	tests := []struct {
+		line     string
+		expected string
+	}{
+		{"listening on :8080", ""},
+		{"2024/01/02 15:04:05 listening on :8080", ""},
+		{"2024/01/02 15:04:05 ERROR connection refused", "error"},
+		{"2024/01/02 15:04:05 INFO an error occurred", "info"},
+		{`time=2024-01-02T15:04:05.000Z level=WARN msg="slow query"`, "warn"},
+		{`{"time":"2024-01-02T15:04:05Z","level":"INFO","msg":"started"}`, "info"},
+		{`time="2024-01-02T15:04:05Z" level=debug msg="cache miss"`, "debug"},
+		{"\x1b[31mERRO\x1b[0m[0000] failed to connect", "error"},
+		{"WARN[0002] retrying", "warn"},
+		{"2024-01-02T15:04:05.000Z\tDPANIC\tmain.go:12\tunexpected", "error"},
+		{`{"level":"warn","ts":1704164645,"caller":"main.go:12","msg":"slow"}`, "warn"},
+		{"3:04PM INF request handled", "info"},
+		{"3:04PM DBG request received", "debug"},
+		{"[ERROR] disk full", "error"},
+		{"error: open config.yml: no such file or directory", "error"},
+		{"panic: runtime error: index out of range", "error"},
+		{"the error was handled", ""},
+		{"2024/01/02 15:04:05 the Error was handled", ""},
+	}
+
+	for _, tt := range tests {
+		if level := DetectLevel(tt.line); level != tt.expected {
+			t.Errorf("%q: expected %q, got %q", tt.line, tt.expected, level)
+		}
+	}
+}
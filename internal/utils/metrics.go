@@ -0,0 +1,77 @@
+package utils
+
+// gomon is a simple command line tool that watches your files and automatically restarts the application when it detects any changes in the working directory.
+// Copyright (C) 2023 John Dudmesh
+
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+
+// You should have received a copy of the GNU General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>.
+
+import (
+	"runtime"
+	"time"
+)
+
+// QueueStats describes the fill level of one of gomon's internal buffers
+type QueueStats struct {
+	Depth    int   `json:"depth"`
+	Capacity int   `json:"capacity"`
+	Dropped  int64 `json:"dropped"`
+}
+
+// QueueStatsReporter is implemented by components which own bounded buffers
+type QueueStatsReporter interface {
+	QueueStats() map[string]QueueStats
+}
+
+// Metrics is a snapshot of gomon's own resource usage
+type Metrics struct {
+	Date       time.Time             `json:"date"`
+	Uptime     string                `json:"uptime"`
+	Goroutines int                   `json:"goroutines"`
+	HeapAlloc  uint64                `json:"heapAlloc"`
+	HeapSys    uint64                `json:"heapSys"`
+	NumGC      uint32                `json:"numGC"`
+	Queues     map[string]QueueStats `json:"queues"`
+	// Environment is the GOMON_* contract injected into the current child process
+	Environment map[string]string `json:"environment,omitempty"`
+	// Retention describes the background pruning of old runs
+	Retention *RetentionStatus `json:"retention,omitempty"`
+}
+
+var startedAt = time.Now()
+
+func CollectMetrics(reporters ...QueueStatsReporter) Metrics {
+	memStats := runtime.MemStats{}
+	runtime.ReadMemStats(&memStats)
+
+	m := Metrics{
+		Date:       time.Now(),
+		Uptime:     time.Since(startedAt).Round(time.Second).String(),
+		Goroutines: runtime.NumGoroutine(),
+		HeapAlloc:  memStats.HeapAlloc,
+		HeapSys:    memStats.HeapSys,
+		NumGC:      memStats.NumGC,
+		Queues:     map[string]QueueStats{},
+	}
+
+	for _, r := range reporters {
+		if r == nil {
+			continue
+		}
+		for k, v := range r.QueueStats() {
+			m.Queues[k] = v
+		}
+	}
+
+	return m
+}
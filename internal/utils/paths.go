@@ -0,0 +1,183 @@
+package utils
+
+// gomon is a simple command line tool that watches your files and automatically restarts the application when it detects any changes in the working directory.
+// Copyright (C) 2023 John Dudmesh
+
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+
+// You should have received a copy of the GNU General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>.
+
+import (
+	"bufio"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+)
+
+const goWorkFileName = "go.work"
+
+// Root is a named directory which is watched for changes, the main root directory has an empty name
+type Root struct {
+	Name string
+	Path string
+}
+
+// PathResolver converts between absolute paths and the form shown to the user. Paths in the main root directory
+// are shown relative to it, paths in other named roots are prefixed with the name of the root e.g. api:internal/foo.go
+type PathResolver struct {
+	main  Root
+	roots []Root
+}
+
+// NewPathResolver creates a resolver for the main root directory, any additional named roots and the members of
+// a go.work file in the main root directory
+func NewPathResolver(rootDirectory string, roots map[string]string) (*PathResolver, error) {
+	rootDirectory, err := filepath.Abs(rootDirectory)
+	if err != nil {
+		return nil, fmt.Errorf("resolving root directory: %w", err)
+	}
+
+	named, err := loadGoWork(rootDirectory)
+	if err != nil {
+		return nil, err
+	}
+	for name, p := range roots {
+		named[name] = p
+	}
+
+	r := &PathResolver{
+		main: Root{Name: "", Path: rootDirectory},
+	}
+	r.roots = append(r.roots, r.main)
+	for name, p := range named {
+		if !filepath.IsAbs(p) {
+			p = filepath.Join(rootDirectory, p)
+		}
+		r.roots = append(r.roots, Root{Name: name, Path: filepath.Clean(p)})
+	}
+
+	// the deepest root containing a path is used to display it
+	sort.SliceStable(r.roots, func(i, j int) bool {
+		return len(r.roots[i].Path) > len(r.roots[j].Path)
+	})
+
+	return r, nil
+}
+
+// Roots returns all of the roots, deepest first
+func (r *PathResolver) Roots() []Root {
+	return r.roots
+}
+
+// Relative returns the root containing absPath and the path relative to it. If the path is outside all of the
+// roots it is returned relative to the main root directory.
+func (r *PathResolver) Relative(absPath string) (Root, string) {
+	for _, root := range r.roots {
+		if rel, ok := within(root.Path, absPath); ok {
+			return root, rel
+		}
+	}
+	rel, err := filepath.Rel(r.main.Path, absPath)
+	if err != nil {
+		rel = absPath
+	}
+	return r.main, filepath.ToSlash(rel)
+}
+
+// Display returns the path as it should be shown in notifications, the UI and logs
+func (r *PathResolver) Display(absPath string) string {
+	root, rel := r.Relative(absPath)
+	if root.Name == "" {
+		return rel
+	}
+	return root.Name + ":" + rel
+}
+
+// Resolve converts a displayed path back to an absolute path
+func (r *PathResolver) Resolve(displayPath string) string {
+	if name, rel, ok := strings.Cut(displayPath, ":"); ok {
+		for _, root := range r.roots {
+			if root.Name != "" && root.Name == name {
+				return filepath.Join(root.Path, filepath.FromSlash(rel))
+			}
+		}
+	}
+	return filepath.Join(r.main.Path, filepath.FromSlash(displayPath))
+}
+
+func within(base, absPath string) (string, bool) {
+	rel, err := filepath.Rel(base, absPath)
+	if err != nil || rel == ".." || strings.HasPrefix(rel, ".."+string(filepath.Separator)) {
+		return "", false
+	}
+	return filepath.ToSlash(rel), true
+}
+
+// GoWorkFile returns the absolute path of the go.work file in the root directory, it is an error if there isn't one
+func GoWorkFile(rootDirectory string) (string, error) {
+	goWork, err := filepath.Abs(filepath.Join(rootDirectory, goWorkFileName))
+	if err != nil {
+		return "", fmt.Errorf("resolving go.work: %w", err)
+	}
+	if _, err := os.Stat(goWork); err != nil {
+		return "", fmt.Errorf("workspace mode requires a go.work file in the root directory: %w", err)
+	}
+	return goWork, nil
+}
+
+// loadGoWork returns the members of the workspace named after their directories, the main module is skipped
+func loadGoWork(rootDirectory string) (map[string]string, error) {
+	members := map[string]string{}
+
+	f, err := os.Open(filepath.Join(rootDirectory, goWorkFileName))
+	if os.IsNotExist(err) {
+		return members, nil
+	} else if err != nil {
+		return nil, fmt.Errorf("opening go.work: %w", err)
+	}
+	defer f.Close()
+
+	isUseBlock := false
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if ix := strings.Index(line, "//"); ix >= 0 {
+			line = strings.TrimSpace(line[:ix])
+		}
+
+		dir := ""
+		switch {
+		case isUseBlock && line == ")":
+			isUseBlock = false
+		case isUseBlock:
+			dir = line
+		case line == "use (":
+			isUseBlock = true
+		case strings.HasPrefix(line, "use "):
+			dir = strings.TrimSpace(strings.TrimPrefix(line, "use "))
+		}
+
+		dir = strings.Trim(dir, "\"")
+		if dir == "" || filepath.Clean(dir) == "." {
+			continue
+		}
+		members[filepath.Base(filepath.Clean(dir))] = dir
+	}
+
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("reading go.work: %w", err)
+	}
+
+	return members, nil
+}
@@ -0,0 +1,68 @@
+package utils
+
+// gomon is a simple command line tool that watches your files and automatically restarts the application when it detects any changes in the working directory.
+// Copyright (C) 2023 John Dudmesh
+
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+
+// You should have received a copy of the GNU General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>.
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestPathResolver(t *testing.T) {
+	workspace := t.TempDir()
+	rootDirectory := filepath.Join(workspace, "app")
+	err := os.MkdirAll(rootDirectory, 0755)
+	if err != nil {
+		t.Fatalf("creating root directory: %v", err)
+	}
+
+	goWork := "go 1.21\n\nuse (\n\t.\n\t./api // the api module\n\t../shared\n)\n"
+	err = os.WriteFile(filepath.Join(rootDirectory, "go.work"), []byte(goWork), 0644)
+	if err != nil {
+		t.Fatalf("writing go.work: %v", err)
+	}
+
+	r, err := NewPathResolver(rootDirectory, map[string]string{"assets": filepath.Join(workspace, "assets")})
+	if err != nil {
+		t.Fatalf("creating resolver: %v", err)
+	}
+
+	if len(r.Roots()) != 4 {
+		t.Fatalf("expected 4 roots, got %+v", r.Roots())
+	}
+
+	tests := []struct {
+		absPath  string
+		expected string
+	}{
+		{filepath.Join(rootDirectory, "main.go"), "main.go"},
+		{filepath.Join(rootDirectory, "api", "internal", "foo.go"), "api:internal/foo.go"},
+		{filepath.Join(workspace, "shared", "lib.go"), "shared:lib.go"},
+		{filepath.Join(workspace, "assets", "app.css"), "assets:app.css"},
+		{filepath.Join(workspace, "other", "x.go"), "../other/x.go"},
+	}
+
+	for _, tt := range tests {
+		actual := r.Display(tt.absPath)
+		if actual != tt.expected {
+			t.Errorf("Display(%s): expected %s, got %s", tt.absPath, tt.expected, actual)
+		}
+		if resolved := r.Resolve(actual); resolved != tt.absPath {
+			t.Errorf("Resolve(%s): expected %s, got %s", actual, tt.absPath, resolved)
+		}
+	}
+}
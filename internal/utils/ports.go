@@ -0,0 +1,182 @@
+package utils
+
+// gomon is a simple command line tool that watches your files and automatically restarts the application when it detects any changes in the working directory.
+// Copyright (C) 2023 John Dudmesh
+
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+
+// You should have received a copy of the GNU General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>.
+
+import (
+	"bufio"
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"os/exec"
+	"regexp"
+	"runtime"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// MaxPortSearch is how many ports above the configured one are tried when looking for a free port
+const MaxPortSearch = 100
+
+const portOwnerTimeout = 2 * time.Second
+
+// PortAvailable reports whether a TCP listener can be opened on the port
+func PortAvailable(port int) bool {
+	listener, err := net.Listen("tcp", fmt.Sprintf(":%d", port))
+	if err != nil {
+		return false
+	}
+	listener.Close()
+	return true
+}
+
+// NextFreePort returns the first available port above port which isn't in taken
+func NextFreePort(port int, taken []int) (int, error) {
+	for next := port + 1; next <= port+MaxPortSearch && next <= 65535; next++ {
+		if !slices.Contains(taken, next) && PortAvailable(next) {
+			return next, nil
+		}
+	}
+	return 0, fmt.Errorf("no free port between %d and %d", port+1, port+MaxPortSearch)
+}
+
+// PortOwner describes the process listening on the TCP port e.g. "node (pid 1234)" using lsof, ss or netstat,
+// it is empty if the process can't be found e.g. none of the tools are installed or it belongs to another user
+func PortOwner(port int) string {
+	ctx, cancel := context.WithTimeout(context.Background(), portOwnerTimeout)
+	defer cancel()
+
+	if runtime.GOOS == "windows" {
+		out, err := exec.CommandContext(ctx, "netstat", "-ano", "-p", "tcp").Output()
+		if err != nil {
+			return ""
+		}
+		pid := parseNetstat(string(out), port)
+		if pid == 0 {
+			return ""
+		}
+		out, err = exec.CommandContext(ctx, "tasklist", "/FI", fmt.Sprintf("PID eq %d", pid), "/FO", "CSV", "/NH").Output()
+		if err != nil {
+			return formatOwner("", pid)
+		}
+		return formatOwner(parseTasklist(string(out)), pid)
+	}
+
+	out, err := exec.CommandContext(ctx, "lsof", "-nP", fmt.Sprintf("-iTCP:%d", port), "-sTCP:LISTEN", "-Fpc").Output()
+	if err == nil {
+		if name, pid := parseLsof(string(out)); pid != 0 {
+			return formatOwner(name, pid)
+		}
+	}
+
+	out, err = exec.CommandContext(ctx, "ss", "-Hltnp", fmt.Sprintf("sport = :%d", port)).Output()
+	if err == nil {
+		if name, pid := parseSS(string(out)); pid != 0 {
+			return formatOwner(name, pid)
+		}
+	}
+
+	return ""
+}
+
+// PortInUseError reports the owner of a port which is needed by the setting
+func PortInUseError(setting string, port int) error {
+	if owner := PortOwner(port); owner != "" {
+		return fmt.Errorf("%s %d is already in use by %s", setting, port, owner)
+	}
+	return fmt.Errorf("%s %d is already in use", setting, port)
+}
+
+// IsAddrInUse reports whether a listen error is because the address is already in use
+func IsAddrInUse(err error) bool {
+	var opErr *net.OpError
+	if !errors.As(err, &opErr) {
+		return false
+	}
+	// the errno differs between platforms so the message is checked instead
+	msg := strings.ToLower(opErr.Err.Error())
+	return strings.Contains(msg, "address already in use") || strings.Contains(msg, "only one usage of each socket address")
+}
+
+func formatOwner(name string, pid int) string {
+	if name == "" {
+		return fmt.Sprintf("pid %d", pid)
+	}
+	return fmt.Sprintf("%s (pid %d)", name, pid)
+}
+
+// parseLsof reads the first process from `lsof -F pc` output, which has a line per field prefixed with its name
+func parseLsof(out string) (string, int) {
+	name, pid := "", 0
+	scanner := bufio.NewScanner(strings.NewReader(out))
+	for scanner.Scan() {
+		line := scanner.Text()
+		if len(line) < 2 {
+			continue
+		}
+		switch line[0] {
+		case 'p':
+			if pid != 0 {
+				return name, pid
+			}
+			pid, _ = strconv.Atoi(line[1:])
+		case 'c':
+			name = line[1:]
+		}
+	}
+	return name, pid
+}
+
+var ssUserPattern = regexp.MustCompile(`users:\(\("([^"]*)",pid=(\d+)`)
+
+// parseSS reads the owner from `ss -p` output e.g. users:(("node",pid=1234,fd=20))
+func parseSS(out string) (string, int) {
+	match := ssUserPattern.FindStringSubmatch(out)
+	if match == nil {
+		return "", 0
+	}
+	pid, _ := strconv.Atoi(match[2])
+	return match[1], pid
+}
+
+// parseNetstat finds the pid listening on the port in `netstat -ano` output
+func parseNetstat(out string, port int) int {
+	suffix := fmt.Sprintf(":%d", port)
+	scanner := bufio.NewScanner(strings.NewReader(out))
+	for scanner.Scan() {
+		fields := strings.Fields(scanner.Text())
+		if len(fields) < 5 || fields[3] != "LISTENING" || !strings.HasSuffix(fields[1], suffix) {
+			continue
+		}
+		pid, err := strconv.Atoi(fields[4])
+		if err == nil {
+			return pid
+		}
+	}
+	return 0
+}
+
+// parseTasklist reads the image name from `tasklist /FO CSV /NH` output e.g. "node.exe","1234",...
+func parseTasklist(out string) string {
+	fields := strings.Split(strings.TrimSpace(out), ",")
+	if len(fields) < 2 {
+		return ""
+	}
+	return strings.Trim(fields[0], "\"")
+}
@@ -0,0 +1,91 @@
+package utils
+
+// gomon is a simple command line tool that watches your files and automatically restarts the application when it detects any changes in the working directory.
+// Copyright (C) 2023 John Dudmesh
+
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+
+// You should have received a copy of the GNU General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>.
+
+import (
+	"fmt"
+	"net"
+	"os"
+	"strings"
+	"testing"
+)
+
+func TestNextFreePort(t *testing.T) {
+	listener, err := net.Listen("tcp", ":0")
+	if err != nil {
+		t.Fatalf("listening: %v", err)
+	}
+	defer listener.Close()
+	port := listener.Addr().(*net.TCPAddr).Port
+
+	if PortAvailable(port) {
+		t.Errorf("expected port %d to be in use", port)
+	}
+
+	next, err := NextFreePort(port, []int{port + 1})
+	if err != nil {
+		t.Fatalf("finding a free port: %v", err)
+	}
+	if next <= port+1 {
+		t.Errorf("expected a port above %d, got %d", port+1, next)
+	}
+
+	_, err = net.Listen("tcp", fmt.Sprintf(":%d", port))
+	if !IsAddrInUse(err) {
+		t.Errorf("expected an address in use error, got %v", err)
+	}
+}
+
+func TestPortOwner(t *testing.T) {
+	listener, err := net.Listen("tcp", ":0")
+	if err != nil {
+		t.Fatalf("listening: %v", err)
+	}
+	defer listener.Close()
+	port := listener.Addr().(*net.TCPAddr).Port
+
+	owner := PortOwner(port)
+	if owner == "" {
+		t.Skip("lsof, ss and netstat aren't available")
+	}
+	if expected := fmt.Sprintf("(pid %d)", os.Getpid()); !strings.HasSuffix(owner, expected) {
+		t.Errorf("unexpected owner: %s", owner)
+	}
+}
+
+func TestParsePortOwner(t *testing.T) {
+	name, pid := parseLsof("p1234\ncnode\nf20\np5678\ncnode\n")
+	if name != "node" || pid != 1234 {
+		t.Errorf("unexpected lsof owner: %s %d", name, pid)
+	}
+
+	name, pid = parseSS("LISTEN 0 511 *:4000 *:* users:((\"node\",pid=1234,fd=20))\n")
+	if name != "node" || pid != 1234 {
+		t.Errorf("unexpected ss owner: %s %d", name, pid)
+	}
+
+	out := "  Proto  Local Address          Foreign Address        State           PID\n" +
+		"  TCP    0.0.0.0:40000          0.0.0.0:0              LISTENING       99\n" +
+		"  TCP    0.0.0.0:4000           0.0.0.0:0              LISTENING       1234\n"
+	if pid := parseNetstat(out, 4000); pid != 1234 {
+		t.Errorf("unexpected netstat pid: %d", pid)
+	}
+
+	if name := parseTasklist("\"node.exe\",\"1234\",\"Console\",\"1\",\"50,000 K\"\n"); name != "node.exe" {
+		t.Errorf("unexpected tasklist name: %s", name)
+	}
+}
@@ -0,0 +1,114 @@
+package utils
+
+// gomon is a simple command line tool that watches your files and automatically restarts the application when it detects any changes in the working directory.
+// Copyright (C) 2023 John Dudmesh
+
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+
+// You should have received a copy of the GNU General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>.
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/jmoiron/sqlx"
+)
+
+// MaxResourceRuns is the number of most recent runs charted when a run isn't selected
+const MaxResourceRuns = 10
+
+// maxResourceSamples stops a long run from making the charts unusable, the earliest samples are dropped
+const maxResourceSamples = 20000
+
+// ResourceSample is the memory and CPU used by the child process, and any processes it started, at a point in time
+type ResourceSample struct {
+	ChildProccessID string    `json:"-" db:"child_process_id"`
+	Date            time.Time `json:"date" db:"created_at"`
+	// RSS is the resident memory in bytes
+	RSS int64 `json:"rss" db:"rss"`
+	// CPU is the percentage of a core used since the previous sample
+	CPU float64 `json:"cpu" db:"cpu"`
+	// Goroutines and HeapAlloc are read from the child's expvar endpoint, they are nil if it isn't being scraped
+	Goroutines *int64 `json:"goroutines,omitempty" db:"goroutines"`
+	HeapAlloc  *int64 `json:"heapAlloc,omitempty" db:"heap_alloc"`
+}
+
+// ResourceRun is the samples taken during a run in the order they were taken
+type ResourceRun struct {
+	ID      string            `json:"id"`
+	Samples []*ResourceSample `json:"samples"`
+}
+
+// RecordSample writes a sample straight to the database, samples are only taken every few seconds so they don't
+// need to be queued like the child's output
+func (d *Database) RecordSample(s ResourceSample) error {
+	_, err := d.conn().NamedExec(`
+		INSERT INTO resources (created_at, child_process_id, rss, cpu, goroutines, heap_alloc)
+		VALUES (:created_at, :child_process_id, :rss, :cpu, :goroutines, :heap_alloc)
+	`, s)
+	if err != nil {
+		return fmt.Errorf("writing resource sample: %w", err)
+	}
+	return nil
+}
+
+// FindResources returns the samples taken during a run, or during the most recent runs if runID is empty or "all",
+// the runs are in the order they started
+func (d *Database) FindResources(runID string) ([]*ResourceRun, error) {
+	runIDs := []string{}
+	switch runID {
+	case "", "all":
+		err := d.conn().Select(&runIDs, "SELECT child_process_id FROM resources GROUP BY child_process_id ORDER BY MAX(created_at) DESC LIMIT ?;", MaxResourceRuns)
+		if err != nil {
+			return nil, fmt.Errorf("finding runs: %w", err)
+		}
+	case LatestRun:
+		err := d.conn().Select(&runIDs, "SELECT child_process_id FROM resources ORDER BY created_at DESC LIMIT 1;")
+		if err != nil {
+			return nil, fmt.Errorf("finding latest run: %w", err)
+		}
+	default:
+		runIDs = append(runIDs, runID)
+	}
+
+	runs := []*ResourceRun{}
+	if len(runIDs) == 0 {
+		return runs, nil
+	}
+
+	query, args, err := sqlx.In(`
+		SELECT * FROM (
+			SELECT * FROM resources WHERE child_process_id IN (?) ORDER BY created_at DESC LIMIT ?
+		) ORDER BY created_at ASC;`, runIDs, maxResourceSamples)
+	if err != nil {
+		return nil, fmt.Errorf("building samples query: %w", err)
+	}
+
+	samples := []*ResourceSample{}
+	err = d.conn().Select(&samples, d.conn().Rebind(query), args...)
+	if err != nil {
+		return nil, fmt.Errorf("getting samples: %w", err)
+	}
+
+	byID := map[string]*ResourceRun{}
+	for _, s := range samples {
+		run, ok := byID[s.ChildProccessID]
+		if !ok {
+			run = &ResourceRun{ID: s.ChildProccessID}
+			byID[s.ChildProccessID] = run
+			runs = append(runs, run)
+		}
+		run.Samples = append(run.Samples, s)
+	}
+
+	return runs, nil
+}
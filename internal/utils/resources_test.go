@@ -0,0 +1,79 @@
+package utils
+
+// gomon is a simple command line tool that watches your files and automatically restarts the application when it detects any changes in the working directory.
+// Copyright (C) 2023 John Dudmesh
+
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+
+// You should have received a copy of the GNU General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>.
+
+import (
+	"testing"
+	"time"
+
+	"github.com/jdudmesh/gomon/internal/config"
+	"github.com/jdudmesh/gomon/internal/notification"
+)
+
+func TestFindResources(t *testing.T) {
+	db, err := NewStore(config.Config{RootDirectory: t.TempDir()})
+	if err != nil {
+		t.Fatalf("creating store: %v", err)
+	}
+	defer db.Close()
+
+	start := time.Now().Add(-time.Hour).UTC()
+	runIDs := []string{notification.NextID(), notification.NextID()}
+	goroutines := int64(12)
+	for i := 0; i < 6; i++ {
+		s := ResourceSample{
+			ChildProccessID: runIDs[i/3],
+			Date:            start.Add(time.Duration(i) * time.Second),
+			RSS:             int64(i+1) * 1024,
+			CPU:             1.5,
+		}
+		if i/3 == 1 {
+			s.Goroutines = &goroutines
+		}
+		err = db.RecordSample(s)
+		if err != nil {
+			t.Fatalf("recording sample: %v", err)
+		}
+	}
+
+	runs, err := db.FindResources("all")
+	if err != nil {
+		t.Fatalf("finding samples: %v", err)
+	}
+	if len(runs) != 2 || runs[0].ID != runIDs[0] || runs[1].ID != runIDs[1] {
+		t.Fatalf("expected both runs in the order they started, got %+v", runs)
+	}
+	if len(runs[0].Samples) != 3 || runs[0].Samples[0].RSS != 1024 || runs[0].Samples[2].RSS != 3*1024 {
+		t.Errorf("expected the samples in order, got %+v", runs[0].Samples)
+	}
+	if runs[0].Samples[0].Goroutines != nil || runs[1].Samples[0].Goroutines == nil || *runs[1].Samples[0].Goroutines != 12 {
+		t.Errorf("expected goroutines only in the second run")
+	}
+
+	runs, err = db.FindResources(LatestRun)
+	if err != nil {
+		t.Fatalf("finding samples: %v", err)
+	}
+	if len(runs) != 1 || runs[0].ID != runIDs[1] {
+		t.Errorf("expected the latest run, got %+v", runs)
+	}
+
+	runs, err = db.FindResources(notification.NextID())
+	if err != nil || len(runs) != 0 {
+		t.Errorf("expected no samples for an unknown run, got %+v %v", runs, err)
+	}
+}
@@ -0,0 +1,201 @@
+package utils
+
+// gomon is a simple command line tool that watches your files and automatically restarts the application when it detects any changes in the working directory.
+// Copyright (C) 2023 John Dudmesh
+
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+
+// You should have received a copy of the GNU General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>.
+
+import (
+	"fmt"
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/jdudmesh/gomon/internal/notification"
+)
+
+// MaxRestartHistory is the number of most recent restarts charted in the UI and compared with the latest one
+const MaxRestartHistory = 30
+
+// minRestartHistory is the number of earlier restarts needed before a slow one is reported
+const minRestartHistory = 5
+
+// A restart has regressed if it takes restartRegressionFactor times the median of the earlier ones and at least
+// restartRegressionMin longer, so that a fast build isn't reported for being a few milliseconds slower
+const (
+	restartRegressionFactor = 2
+	restartRegressionMin    = time.Second
+)
+
+// RestartDuration is how long a hard restart took from it being requested e.g. by a file change to the new run being
+// ready
+type RestartDuration struct {
+	ChildProccessID string    `json:"childProcessId" db:"child_process_id"`
+	RequestedAt     time.Time `json:"requestedAt" db:"requested_at"`
+	ReadyAt         time.Time `json:"readyAt" db:"ready_at"`
+	Milliseconds    int64     `json:"durationMs" db:"duration_ms"`
+}
+
+func (r *RestartDuration) Duration() time.Duration {
+	return time.Duration(r.Milliseconds) * time.Millisecond
+}
+
+// RestartRecorder stores the restart durations and returns the most recent ones
+type RestartRecorder interface {
+	RecordRestart(r RestartDuration) error
+	FindRestarts() ([]*RestartDuration, error)
+}
+
+// RecordRestart writes a restart duration straight to the database, there is at most one per run
+func (d *Database) RecordRestart(r RestartDuration) error {
+	_, err := d.conn().NamedExec(`
+		INSERT INTO restarts (child_process_id, requested_at, ready_at, duration_ms)
+		VALUES (:child_process_id, :requested_at, :ready_at, :duration_ms)
+	`, r)
+	if err != nil {
+		return fmt.Errorf("writing restart duration: %w", err)
+	}
+	return nil
+}
+
+// FindRestarts returns the most recent restart durations in the order they happened
+func (d *Database) FindRestarts() ([]*RestartDuration, error) {
+	restarts := []*RestartDuration{}
+	err := d.conn().Select(&restarts, `
+		SELECT * FROM (
+			SELECT * FROM restarts ORDER BY ready_at DESC LIMIT ?
+		) ORDER BY ready_at ASC;`, MaxRestartHistory)
+	if err != nil {
+		return nil, fmt.Errorf("finding restart durations: %w", err)
+	}
+	return restarts, nil
+}
+
+// RestartTimer measures each hard restart end to end. The clock starts at the first request since the last restart
+// completed, so a burst of file changes is timed from the first one, and stops when the new run connects to the IPC
+// channel or writes its first line of output. A run which exits before then isn't timed.
+type RestartTimer struct {
+	recorder    RestartRecorder
+	callbackFn  notification.NotificationCallback
+	lock        sync.Mutex
+	requestedAt time.Time
+	runID       string
+	history     []time.Duration
+}
+
+func NewRestartTimer(recorder RestartRecorder, callbackFn notification.NotificationCallback) *RestartTimer {
+	t := &RestartTimer{
+		recorder:   recorder,
+		callbackFn: callbackFn,
+	}
+
+	restarts, err := recorder.FindRestarts()
+	if err != nil {
+		log.Warn(err)
+	}
+	for _, r := range restarts {
+		t.history = append(t.history, r.Duration())
+	}
+
+	return t
+}
+
+func (t *RestartTimer) Notify(n notification.Notification) error {
+	t.lock.Lock()
+	restart, ok := t.observe(n)
+	var previous []time.Duration
+	if ok {
+		previous = slices.Clone(t.history)
+		t.history = append(t.history, restart.Duration())
+		if len(t.history) > MaxRestartHistory {
+			t.history = t.history[1:]
+		}
+	}
+	t.lock.Unlock()
+
+	if !ok {
+		return nil
+	}
+
+	err := t.recorder.RecordRestart(restart)
+	if err != nil {
+		log.Warn(err)
+	}
+
+	median, regressed := RestartRegressed(previous, restart.Duration())
+	if regressed {
+		message := fmt.Sprintf("restart took %s, the median of the last %d restarts is %s", restart.Duration(), len(previous), median)
+		log.Warn(message)
+		t.callbackFn(notification.Notification{
+			ID:              notification.NextID(),
+			Date:            time.Now(),
+			ChildProccessID: restart.ChildProccessID,
+			Type:            notification.NotificationTypeLogEvent,
+			Message:         message,
+			Level:           notification.LevelWarn,
+		})
+	}
+
+	return nil
+}
+
+// observe returns the duration of the restart which n completes, if any
+func (t *RestartTimer) observe(n notification.Notification) (RestartDuration, bool) {
+	switch n.Type {
+	case notification.NotificationTypeHardRestartRequested:
+		if t.requestedAt.IsZero() {
+			t.requestedAt = n.Date
+		}
+	case notification.NotificationTypeStartup:
+		// a run which starts without being requested e.g. after a crash isn't timed
+		if !t.requestedAt.IsZero() {
+			t.runID = n.ChildProccessID
+		}
+	case notification.NotificationTypeHardRestart, notification.NotificationTypeStdOut, notification.NotificationTypeStdErr:
+		if t.runID == "" || n.ChildProccessID != t.runID {
+			return RestartDuration{}, false
+		}
+		restart := RestartDuration{
+			ChildProccessID: t.runID,
+			RequestedAt:     t.requestedAt,
+			ReadyAt:         n.Date,
+			Milliseconds:    n.Date.Sub(t.requestedAt).Milliseconds(),
+		}
+		t.requestedAt = time.Time{}
+		t.runID = ""
+		return restart, true
+	case notification.NotificationTypeShutdown, notification.NotificationTypeCrash, notification.NotificationTypeBuildError:
+		if t.runID != "" && n.ChildProccessID == t.runID {
+			t.requestedAt = time.Time{}
+			t.runID = ""
+		}
+	}
+	return RestartDuration{}, false
+}
+
+// RestartRegressed returns the median of the previous restart durations and whether latest is significantly slower
+func RestartRegressed(previous []time.Duration, latest time.Duration) (time.Duration, bool) {
+	if len(previous) < minRestartHistory {
+		return 0, false
+	}
+
+	sorted := slices.Clone(previous)
+	slices.Sort(sorted)
+	median := sorted[len(sorted)/2]
+	if len(sorted)%2 == 0 {
+		median = (sorted[len(sorted)/2-1] + median) / 2
+	}
+
+	return median, latest >= median*restartRegressionFactor && latest-median >= restartRegressionMin
+}
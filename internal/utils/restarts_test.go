@@ -0,0 +1,150 @@
+package utils
+
+// gomon is a simple command line tool that watches your files and automatically restarts the application when it detects any changes in the working directory.
+// Copyright (C) 2023 John Dudmesh
+
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+
+// You should have received a copy of the GNU General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>.
+
+import (
+	"testing"
+	"time"
+
+	"github.com/jdudmesh/gomon/internal/config"
+	"github.com/jdudmesh/gomon/internal/notification"
+)
+
+func TestRestartTimer(t *testing.T) {
+	db, err := NewStore(config.Config{RootDirectory: t.TempDir()})
+	if err != nil {
+		t.Fatalf("creating store: %v", err)
+	}
+	defer db.Close()
+
+	warnings := []notification.Notification{}
+	timer := NewRestartTimer(db, func(n notification.Notification) error {
+		warnings = append(warnings, n)
+		return nil
+	})
+
+	start := time.Now().Add(-time.Hour).UTC()
+	oldRun, newRun := notification.NextID(), notification.NextID()
+	events := []notification.Notification{
+		{Type: notification.NotificationTypeHardRestartRequested, Date: start},
+		// later changes in the same burst don't reset the clock
+		{Type: notification.NotificationTypeHardRestartRequested, Date: start.Add(100 * time.Millisecond)},
+		// output from the run being stopped doesn't count
+		{Type: notification.NotificationTypeStdOut, ChildProccessID: oldRun, Date: start.Add(200 * time.Millisecond)},
+		{Type: notification.NotificationTypeStartup, ChildProccessID: newRun, Date: start.Add(time.Second)},
+		{Type: notification.NotificationTypeStdErr, ChildProccessID: newRun, Date: start.Add(2500 * time.Millisecond)},
+		{Type: notification.NotificationTypeStdOut, ChildProccessID: newRun, Date: start.Add(3 * time.Second)},
+	}
+	for _, n := range events {
+		timer.Notify(n)
+	}
+
+	restarts, err := db.FindRestarts()
+	if err != nil {
+		t.Fatalf("finding restarts: %v", err)
+	}
+	if len(restarts) != 1 || restarts[0].ChildProccessID != newRun || restarts[0].Duration() != 2500*time.Millisecond {
+		t.Fatalf("expected one restart of 2.5s, got %+v", restarts)
+	}
+	if len(warnings) != 0 {
+		t.Fatalf("expected no warnings, got %+v", warnings)
+	}
+
+	// a run which crashes before it is ready isn't timed
+	crashed := notification.NextID()
+	for _, n := range []notification.Notification{
+		{Type: notification.NotificationTypeHardRestartRequested, Date: start.Add(time.Minute)},
+		{Type: notification.NotificationTypeStartup, ChildProccessID: crashed, Date: start.Add(time.Minute + time.Second)},
+		{Type: notification.NotificationTypeCrash, ChildProccessID: crashed, Date: start.Add(time.Minute + 2*time.Second)},
+		{Type: notification.NotificationTypeStdErr, ChildProccessID: crashed, Date: start.Add(time.Minute + 3*time.Second)},
+	} {
+		timer.Notify(n)
+	}
+	restarts, _ = db.FindRestarts()
+	if len(restarts) != 1 {
+		t.Fatalf("expected the crashed run not to be timed, got %+v", restarts)
+	}
+}
+
+func TestRestartTimerRegression(t *testing.T) {
+	db, err := NewStore(config.Config{RootDirectory: t.TempDir()})
+	if err != nil {
+		t.Fatalf("creating store: %v", err)
+	}
+	defer db.Close()
+
+	start := time.Now().Add(-time.Hour).UTC()
+	for i := 0; i < 5; i++ {
+		requested := start.Add(time.Duration(i) * time.Minute)
+		err = db.RecordRestart(RestartDuration{
+			ChildProccessID: notification.NextID(),
+			RequestedAt:     requested,
+			ReadyAt:         requested.Add(time.Second),
+			Milliseconds:    1000,
+		})
+		if err != nil {
+			t.Fatalf("recording restart: %v", err)
+		}
+	}
+
+	// the history is loaded from the database so the first restart after gomon starts is compared too
+	warnings := []notification.Notification{}
+	timer := NewRestartTimer(db, func(n notification.Notification) error {
+		warnings = append(warnings, n)
+		return nil
+	})
+
+	runID := notification.NextID()
+	requested := start.Add(time.Hour)
+	timer.Notify(notification.Notification{Type: notification.NotificationTypeHardRestartRequested, Date: requested})
+	timer.Notify(notification.Notification{Type: notification.NotificationTypeStartup, ChildProccessID: runID, Date: requested})
+	timer.Notify(notification.Notification{Type: notification.NotificationTypeHardRestart, ChildProccessID: runID, Date: requested.Add(3 * time.Second)})
+
+	if len(warnings) != 1 || warnings[0].Level != notification.LevelWarn || warnings[0].ChildProccessID != runID {
+		t.Fatalf("expected a warning about the slow restart, got %+v", warnings)
+	}
+}
+
+func TestRestartRegressed(t *testing.T) {
+	second := time.Second
+	previous := []time.Duration{second, 2 * second, 2 * second, 3 * second, 10 * second}
+
+	median, regressed := RestartRegressed(previous, 3*second)
+	if median != 2*second || regressed {
+		t.Errorf("expected a median of 2s and no regression, got %s %v", median, regressed)
+	}
+
+	_, regressed = RestartRegressed(previous, 4*second)
+	if !regressed {
+		t.Error("expected twice the median to be a regression")
+	}
+
+	// fast restarts must also be at least a second slower
+	fast := []time.Duration{100, 100, 100, 100, 100}
+	for i := range fast {
+		fast[i] *= time.Millisecond
+	}
+	_, regressed = RestartRegressed(fast, 500*time.Millisecond)
+	if regressed {
+		t.Error("expected a small absolute increase not to be a regression")
+	}
+
+	_, regressed = RestartRegressed(previous[:4], time.Minute)
+	if regressed {
+		t.Error("expected too few restarts not to be compared")
+	}
+}
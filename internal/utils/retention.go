@@ -0,0 +1,176 @@
+package utils
+
+// gomon is a simple command line tool that watches your files and automatically restarts the application when it detects any changes in the working directory.
+// Copyright (C) 2023 John Dudmesh
+
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+
+// You should have received a copy of the GNU General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>.
+
+import (
+	"fmt"
+	"os"
+	"path"
+	"sync/atomic"
+	"time"
+
+	"github.com/jdudmesh/gomon/internal/config"
+	"github.com/jdudmesh/gomon/internal/notification"
+)
+
+// hardLimitCheckInterval is how often the size of the history is compared with the hard limit
+const hardLimitCheckInterval = 5 * time.Second
+
+// PruneStats describes a run of the retention pruner
+type PruneStats struct {
+	Date           time.Time `json:"date"`
+	Duration       string    `json:"duration"`
+	RunsDeleted    int64     `json:"runsDeleted"`
+	EventsDeleted  int64     `json:"eventsDeleted"`
+	BytesReclaimed int64     `json:"bytesReclaimed"`
+	Error          string    `json:"error,omitempty"`
+}
+
+// RetentionStatus is the state of the background pruner, NextRun is nil if pruning isn't scheduled. MemoryRing is
+// true once the database has reached its hard limit and events are only kept in memory.
+type RetentionStatus struct {
+	Interval       string      `json:"interval"`
+	NextRun        *time.Time  `json:"nextRun,omitempty"`
+	LastRun        *PruneStats `json:"lastRun,omitempty"`
+	SizeBytes      int64       `json:"sizeBytes"`
+	HardLimitBytes int64       `json:"hardLimitBytes,omitempty"`
+	MemoryRing     bool        `json:"memoryRing"`
+}
+
+// retention is the retention policy shared by the stores along with the state of their background pruner. Nothing
+// is pruned unless a limit is configured, and the events of the current run (the latest startup) are always kept.
+type retention struct {
+	maxRuns       int
+	maxAge        time.Duration
+	maxSize       int64
+	pruneInterval time.Duration
+	hardLimit     int64
+	lastPrune     atomic.Pointer[PruneStats]
+	nextPrune     atomic.Pointer[time.Time]
+}
+
+func (r *retention) configure(cfg config.Config) {
+	r.maxRuns = cfg.UI.Retention.MaxRuns
+	r.maxAge = time.Duration(cfg.UI.Retention.MaxAgeDays) * 24 * time.Hour
+	r.maxSize = int64(cfg.UI.Retention.MaxSizeMB) * 1024 * 1024
+
+	switch {
+	case cfg.UI.Retention.IntervalMinutes == 0:
+		r.pruneInterval = config.DefaultRetentionIntervalMinutes * time.Minute
+	case cfg.UI.Retention.IntervalMinutes > 0:
+		r.pruneInterval = time.Duration(cfg.UI.Retention.IntervalMinutes) * time.Minute
+	}
+}
+
+// runPruner prunes now and then on the configured schedule until done is closed, checkHardLimit is called after
+// each prune and more often in between if there is a hard limit
+func (r *retention) runPruner(done <-chan struct{}, prune func() (PruneStats, error), checkHardLimit func(notification.NotificationCallback), callbackFn notification.NotificationCallback) {
+	var tick <-chan time.Time
+	if r.pruneInterval > 0 {
+		ticker := time.NewTicker(r.pruneInterval)
+		defer ticker.Stop()
+		tick = ticker.C
+	}
+
+	// the hard limit is checked much more often than pruning runs so that the disk can't fill up in between
+	var limitTick <-chan time.Time
+	if r.hardLimit > 0 {
+		ticker := time.NewTicker(hardLimitCheckInterval)
+		defer ticker.Stop()
+		limitTick = ticker.C
+	}
+
+	shouldPrune := true
+	for {
+		if shouldPrune {
+			r.runPrune(prune, callbackFn)
+		}
+		checkHardLimit(callbackFn)
+
+		select {
+		case <-tick:
+			shouldPrune = true
+		case <-limitTick:
+			shouldPrune = false
+		case <-done:
+			return
+		}
+	}
+}
+
+func (r *retention) runPrune(prune func() (PruneStats, error), callbackFn notification.NotificationCallback) {
+	stats, err := prune()
+	if err != nil {
+		log.Errorf("pruning database: %v", err)
+		stats.Error = err.Error()
+	}
+	r.lastPrune.Store(&stats)
+
+	if stats.EventsDeleted > 0 {
+		callbackFn(notification.Notification{
+			ID:      notification.NextID(),
+			Date:    time.Now(),
+			Type:    notification.NotificationTypeLogEvent,
+			Message: fmt.Sprintf("pruned history: %d runs (%d events) deleted, %s reclaimed", stats.RunsDeleted, stats.EventsDeleted, formatBytes(stats.BytesReclaimed)),
+		})
+	}
+
+	if r.pruneInterval > 0 {
+		next := time.Now().Add(r.pruneInterval)
+		r.nextPrune.Store(&next)
+	}
+}
+
+// status returns the schedule of the pruner, the store fills in its size
+func (r *retention) status() *RetentionStatus {
+	status := &RetentionStatus{
+		Interval:       "startup only",
+		NextRun:        r.nextPrune.Load(),
+		LastRun:        r.lastPrune.Load(),
+		HardLimitBytes: r.hardLimit,
+	}
+	if r.pruneInterval > 0 {
+		status.Interval = r.pruneInterval.String()
+	}
+	return status
+}
+
+// dataDirectory returns the path of the .gomon directory the history is kept in, creating it if needed
+func dataDirectory(rootDirectory string) (string, error) {
+	dataPath := path.Join(rootDirectory, "./.gomon")
+	_, err := os.Stat(dataPath)
+	if err != nil {
+		if !os.IsNotExist(err) {
+			return "", fmt.Errorf("checking for .gomon directory: %w", err)
+		}
+		err = os.Mkdir(dataPath, 0755)
+		if err != nil {
+			return "", fmt.Errorf("creating .gomon directory: %w", err)
+		}
+	}
+	return dataPath, nil
+}
+
+func formatBytes(n int64) string {
+	switch {
+	case n >= 1024*1024:
+		return fmt.Sprintf("%.1fMB", float64(n)/(1024*1024))
+	case n >= 1024:
+		return fmt.Sprintf("%.1fKB", float64(n)/1024)
+	}
+	return fmt.Sprintf("%dB", n)
+}
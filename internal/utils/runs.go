@@ -0,0 +1,110 @@
+package utils
+
+// gomon is a simple command line tool that watches your files and automatically restarts the application when it detects any changes in the working directory.
+// Copyright (C) 2023 John Dudmesh
+
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+
+// You should have received a copy of the GNU General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>.
+
+import (
+	"errors"
+
+	"github.com/jdudmesh/gomon/internal/notification"
+)
+
+// RunExporter is the part of a store that the run summaries and the events around a marker are read from
+type RunExporter interface {
+	ExportRun(runID string, fn func(*notification.Notification) error) error
+}
+
+// errStopExport ends an export once enough events have been read
+var errStopExport = errors.New("stop export")
+
+// RunSummary is what a run wrote to stderr and how it ended, it is used to compare two runs. Exit and Crash are
+// nil if the run didn't stop or didn't crash.
+type RunSummary struct {
+	Start  *notification.Notification
+	Exit   *notification.Notification
+	Crash  *notification.Notification
+	Stderr []*notification.Notification
+}
+
+// Failed is true if the run crashed or failed to build
+func (s *RunSummary) Failed() bool {
+	if s.Crash != nil {
+		return true
+	}
+	for _, n := range s.Stderr {
+		if n.Type == notification.NotificationTypeBuildError {
+			return true
+		}
+	}
+	return false
+}
+
+// FindRunSummary returns the lifecycle events and the first MaxSummaryLines lines of stderr output of a run,
+// ErrRunNotFound is returned if the run has no startup event
+func FindRunSummary(store RunExporter, runID string) (*RunSummary, error) {
+	summary := &RunSummary{Stderr: []*notification.Notification{}}
+	err := store.ExportRun(runID, func(n *notification.Notification) error {
+		switch n.Type {
+		case notification.NotificationTypeStartup:
+			if summary.Start == nil {
+				summary.Start = n
+			}
+		case notification.NotificationTypeShutdown:
+			summary.Exit = n
+		case notification.NotificationTypeCrash:
+			summary.Crash = n
+		case notification.NotificationTypeStdErr, notification.NotificationTypeBuildError:
+			if len(summary.Stderr) < MaxSummaryLines {
+				summary.Stderr = append(summary.Stderr, n)
+			}
+		}
+		return nil
+	})
+	if err != nil && !errors.Is(err, ErrRunNotFound) {
+		return nil, err
+	}
+	if summary.Start == nil {
+		return nil, ErrRunNotFound
+	}
+
+	return summary, nil
+}
+
+// FindEventsAround returns up to limit events from the same run as n, centred on n
+func FindEventsAround(store RunExporter, n *notification.Notification, limit int) ([]*notification.Notification, error) {
+	before := []*notification.Notification{}
+	after := []*notification.Notification{}
+	err := store.ExportRun(n.ChildProccessID, func(e *notification.Notification) error {
+		if eventBefore(e, n) {
+			// only the last limit/2 events before n are kept
+			before = append(before, e)
+			if len(before) > limit/2 {
+				before = before[1:]
+			}
+			return nil
+		}
+		if len(before)+len(after) >= limit {
+			return errStopExport
+		}
+		after = append(after, e)
+		return nil
+	})
+	if err != nil && !errors.Is(err, errStopExport) && !errors.Is(err, ErrRunNotFound) {
+		return nil, err
+	}
+
+	return append(before, after...), nil
+}
@@ -0,0 +1,86 @@
+package utils
+
+// gomon is a simple command line tool that watches your files and automatically restarts the application when it detects any changes in the working directory.
+// Copyright (C) 2023 John Dudmesh
+
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+
+// You should have received a copy of the GNU General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>.
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/jdudmesh/gomon/internal/config"
+	"github.com/jdudmesh/gomon/internal/notification"
+)
+
+func TestRunEvents(t *testing.T) {
+	for _, storageType := range storageTypes() {
+		t.Run(storageType, func(t *testing.T) {
+			cfg := config.Config{RootDirectory: t.TempDir()}
+			cfg.Storage.Type = storageType
+			store, err := NewStore(cfg)
+			if err != nil {
+				t.Fatalf("creating store: %v", err)
+			}
+
+			start := time.Now().Add(-time.Hour)
+			runID := notification.NextID()
+			events := []notification.Notification{}
+			for ix, e := range []struct {
+				eventType notification.NotificationType
+				message   string
+			}{
+				{notification.NotificationTypeStartup, "startup"},
+				{notification.NotificationTypeStdOut, "out1"},
+				{notification.NotificationTypeStdErr, "err1"},
+				{notification.NotificationTypeStdOut, "out2"},
+				{notification.NotificationTypeStdErr, "err2"},
+				{notification.NotificationTypeCrash, "crash"},
+			} {
+				n := notification.Notification{ID: notification.NextID(), Date: start.Add(time.Duration(ix) * time.Second), ChildProccessID: runID, Type: e.eventType, Message: e.message}
+				events = append(events, n)
+				store.Notify(n)
+			}
+			// the events are written in the background
+			store.Close()
+			store, err = NewStore(cfg)
+			if err != nil {
+				t.Fatalf("reopening store: %v", err)
+			}
+			defer store.Close()
+
+			around, err := FindEventsAround(store, &events[3], 3)
+			if err != nil {
+				t.Fatalf("finding events around: %v", err)
+			}
+			if len(around) != 3 || around[0].Message != "err1" || around[1].Message != "out2" || around[2].Message != "err2" {
+				t.Errorf("unexpected events around out2: %+v", around)
+			}
+
+			summary, err := FindRunSummary(store, runID)
+			if err != nil {
+				t.Fatalf("finding summary: %v", err)
+			}
+			if !summary.Failed() || summary.Start.Message != "startup" || len(summary.Stderr) != 2 || summary.Stderr[1].Message != "err2" {
+				t.Errorf("unexpected summary: %+v", summary)
+			}
+
+			_, err = FindRunSummary(store, "missing")
+			if !errors.Is(err, ErrRunNotFound) {
+				t.Errorf("expected ErrRunNotFound, got %v", err)
+			}
+		})
+	}
+}
@@ -0,0 +1,146 @@
+package utils
+
+// gomon is a simple command line tool that watches your files and automatically restarts the application when it detects any changes in the working directory.
+// Copyright (C) 2023 John Dudmesh
+
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+
+// You should have received a copy of the GNU General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>.
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/jmoiron/sqlx"
+)
+
+// sqliteDriver is go-sqlite3 with the functions used by the schema and migrations registered on every connection,
+// it is only registered when gomon is built with cgo
+const sqliteDriver = "sqlite3_gomon"
+
+// searchSchema is an FTS5 index over the event text, triggers keep it in step with the notifs table. Colour codes
+// are removed so that they don't become part of the indexed words. It needs SQLite to be built with FTS5 which
+// go-sqlite3 only does with the sqlite_fts5 build tag.
+var searchSchema = `
+CREATE VIRTUAL TABLE IF NOT EXISTS notifs_fts USING fts5(event_data, content='notifs', content_rowid='rowid');
+CREATE TRIGGER IF NOT EXISTS notifs_fts_insert AFTER INSERT ON notifs BEGIN
+	INSERT INTO notifs_fts(rowid, event_data) VALUES (new.rowid, strip_ansi(new.event_data));
+END;
+CREATE TRIGGER IF NOT EXISTS notifs_fts_delete AFTER DELETE ON notifs BEGIN
+	INSERT INTO notifs_fts(notifs_fts, rowid, event_data) VALUES ('delete', old.rowid, strip_ansi(old.event_data));
+END;
+`
+
+// createSearchIndex adds the full text index to the database, indexing any existing events if it is new or was
+// created by an older version of gomon. It returns false if SQLite doesn't support FTS5, in which case searches
+// fall back to LIKE.
+func createSearchIndex(db *sqlx.DB) (bool, error) {
+	var triggerSQL string
+	err := db.Get(&triggerSQL, "SELECT COALESCE((SELECT sql FROM sqlite_master WHERE type = 'trigger' AND name = 'notifs_fts_insert'), '');")
+	if err != nil {
+		return false, fmt.Errorf("checking for search index: %w", err)
+	}
+
+	isCurrent := strings.Contains(triggerSQL, "strip_ansi")
+	if !isCurrent {
+		err = dropSearchTriggers(db)
+		if err != nil {
+			return false, err
+		}
+	}
+
+	_, err = db.Exec(searchSchema)
+	if err != nil {
+		if strings.Contains(err.Error(), "no such module: fts5") {
+			// the index may have been created by a build with FTS5, its triggers would stop events being written.
+			// It is rebuilt the next time FTS5 is available.
+			return false, dropSearchTriggers(db)
+		}
+		return false, fmt.Errorf("creating search index: %w", err)
+	}
+
+	if !isCurrent {
+		err = rebuildSearchIndex(db)
+		if err != nil {
+			return false, err
+		}
+	}
+
+	return true, nil
+}
+
+func dropSearchTriggers(db *sqlx.DB) error {
+	_, err := db.Exec("DROP TRIGGER IF EXISTS notifs_fts_insert; DROP TRIGGER IF EXISTS notifs_fts_delete;")
+	if err != nil {
+		return fmt.Errorf("removing search triggers: %w", err)
+	}
+	return nil
+}
+
+// rebuildSearchIndex re-indexes every event, this is needed after a VACUUM because it can renumber the rows
+func rebuildSearchIndex(db *sqlx.DB) error {
+	_, err := db.Exec(`
+		INSERT INTO notifs_fts(notifs_fts) VALUES ('delete-all');
+		INSERT INTO notifs_fts(rowid, event_data) SELECT rowid, strip_ansi(event_data) FROM notifs;`)
+	if err != nil {
+		return fmt.Errorf("rebuilding search index: %w", err)
+	}
+	return nil
+}
+
+// searchQuery converts the text typed in the search box into an FTS5 query. Every term has to match, "quoted text"
+// matches a phrase and a trailing * matches a prefix e.g. `"connection refused" data*`. Terms are quoted so that
+// punctuation is never treated as FTS5 syntax.
+func searchQuery(text string) string {
+	terms := []string{}
+	for len(text) > 0 {
+		text = strings.TrimLeft(text, " \t")
+		if text == "" {
+			break
+		}
+
+		var term string
+		if text[0] == '"' {
+			end := strings.IndexByte(text[1:], '"')
+			if end < 0 {
+				term, text = text[1:], ""
+			} else {
+				term, text = text[1:end+1], text[end+2:]
+			}
+		} else {
+			end := strings.IndexAny(text, " \t")
+			if end < 0 {
+				end = len(text)
+			}
+			term, text = text[:end], text[end:]
+		}
+
+		isPrefix := strings.HasSuffix(term, "*")
+		if len(text) > 0 && text[0] == '*' {
+			// a prefix after a phrase e.g. "main.g"*
+			isPrefix = true
+			text = text[1:]
+		}
+		term = strings.TrimSpace(strings.TrimRight(term, "*"))
+		if term == "" {
+			continue
+		}
+
+		term = `"` + strings.ReplaceAll(term, `"`, `""`) + `"`
+		if isPrefix {
+			term += "*"
+		}
+		terms = append(terms, term)
+	}
+
+	return strings.Join(terms, " ")
+}
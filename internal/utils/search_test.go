@@ -0,0 +1,127 @@
+package utils
+
+// gomon is a simple command line tool that watches your files and automatically restarts the application when it detects any changes in the working directory.
+// Copyright (C) 2023 John Dudmesh
+
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+
+// You should have received a copy of the GNU General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>.
+
+import (
+	"testing"
+	"time"
+
+	"github.com/jdudmesh/gomon/internal/config"
+	"github.com/jdudmesh/gomon/internal/notification"
+)
+
+func TestSearchQuery(t *testing.T) {
+	tests := []struct {
+		text     string
+		expected string
+	}{
+		{"", ""},
+		{"error", `"error"`},
+		{"connection refused", `"connection" "refused"`},
+		{`"connection refused" data*`, `"connection refused" "data"*`},
+		{`"main.g"*`, `"main.g"*`},
+		{"main.go:12", `"main.go:12"`},
+		{`say"hi`, `"say""hi"`},
+		{`"unterminated phrase`, `"unterminated phrase"`},
+		{"  *  ", ""},
+	}
+
+	for _, tt := range tests {
+		if actual := searchQuery(tt.text); actual != tt.expected {
+			t.Errorf("searchQuery(%q): expected %s, got %s", tt.text, tt.expected, actual)
+		}
+	}
+}
+
+func TestFullTextSearch(t *testing.T) {
+	if !sqliteSupported {
+		t.Skip("SQLite isn't available without cgo")
+	}
+	db, err := NewDatabase(config.Config{RootDirectory: t.TempDir()})
+	if err != nil {
+		t.Fatalf("creating database: %v", err)
+	}
+	defer db.Close()
+
+	if !db.hasSearch {
+		t.Skip("SQLite was built without FTS5, run the tests with -tags sqlite_fts5")
+	}
+
+	// an older run which is pruned below
+	db.insert(notification.Notification{
+		ID:              notification.NextID(),
+		Date:            time.Now().Add(-time.Hour),
+		ChildProccessID: notification.NextID(),
+		Type:            notification.NotificationTypeStartup,
+		Message:         "database connected",
+	})
+
+	runID := notification.NextID()
+	db.insert(notification.Notification{
+		ID:              notification.NextID(),
+		Date:            time.Now(),
+		ChildProccessID: runID,
+		Type:            notification.NotificationTypeStartup,
+		Message:         "process started",
+	})
+	for i, msg := range []string{"starting", "dial tcp: connection refused", "refused to start connection", "database connected", "\x1b[31mERR\x1b[0m retrying"} {
+		db.insert(notification.Notification{
+			ID:              notification.NextID(),
+			Date:            time.Now().Add(time.Duration(i+1) * time.Second),
+			ChildProccessID: runID,
+			Type:            notification.NotificationTypeStdOut,
+			Message:         msg,
+		})
+	}
+
+	tests := []struct {
+		filter   string
+		expected int
+	}{
+		{"connection refused", 2},
+		{`"connection refused"`, 1},
+		{"connect*", 3},
+		{"missing", 0},
+		// colour codes aren't indexed as part of the words they surround
+		{"ERR", 1},
+	}
+
+	for _, tt := range tests {
+		notifs, err := db.FindNotifications(runID, "", "", tt.filter)
+		if err != nil {
+			t.Fatalf("searching for %s: %v", tt.filter, err)
+		}
+		found := 0
+		for _, run := range notifs {
+			found += len(run)
+		}
+		if found != tt.expected {
+			t.Errorf("searching for %s: expected %d events, got %d", tt.filter, tt.expected, found)
+		}
+	}
+
+	// pruning vacuums the database which can renumber rows so the index must still match afterwards
+	db.maxRuns = 1
+	stats, err := db.Prune()
+	if err != nil || stats.RunsDeleted != 1 {
+		t.Fatalf("pruning: %+v, %v", stats, err)
+	}
+	notifs, err := db.FindNotifications("all", "", "", "database")
+	if err != nil || len(notifs) != 1 || notifs[0][0].Message != "database connected" {
+		t.Errorf("unexpected search results after pruning: %+v, %v", notifs, err)
+	}
+}
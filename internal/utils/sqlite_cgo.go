@@ -0,0 +1,61 @@
+//go:build cgo
+
+package utils
+
+// gomon is a simple command line tool that watches your files and automatically restarts the application when it detects any changes in the working directory.
+// Copyright (C) 2023 John Dudmesh
+
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+
+// You should have received a copy of the GNU General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>.
+
+import (
+	"database/sql"
+	"errors"
+
+	"github.com/mattn/go-sqlite3"
+)
+
+// sqliteSupported is false if gomon was built without cgo, which go-sqlite3 needs
+const sqliteSupported = true
+
+// errSQLiteCorrupt is wrapped by the error returned when the integrity check fails so that the database is recreated
+var errSQLiteCorrupt error = sqlite3.ErrCorrupt
+
+func init() {
+	sql.Register(sqliteDriver, &sqlite3.SQLiteDriver{
+		ConnectHook: func(conn *sqlite3.SQLiteConn) error {
+			err := conn.RegisterFunc("strip_ansi", StripANSI, true)
+			if err != nil {
+				return err
+			}
+			return conn.RegisterFunc("detect_level", DetectLevel, true)
+		},
+	})
+}
+
+func isCorrupt(err error) bool {
+	if errors.Is(err, sqlite3.ErrCorrupt) || errors.Is(err, sqlite3.ErrNotADB) {
+		return true
+	}
+	sqliteErr := sqlite3.Error{}
+	if errors.As(err, &sqliteErr) {
+		return sqliteErr.Code == sqlite3.ErrCorrupt || sqliteErr.Code == sqlite3.ErrNotADB
+	}
+	return false
+}
+
+// isLocked is true if the database is being written to by another connection
+func isLocked(err error) bool {
+	sqliteErr := sqlite3.Error{}
+	return errors.As(err, &sqliteErr) && (sqliteErr.Code == sqlite3.ErrBusy || sqliteErr.Code == sqlite3.ErrLocked)
+}
@@ -0,0 +1,35 @@
+//go:build !cgo
+
+package utils
+
+// gomon is a simple command line tool that watches your files and automatically restarts the application when it detects any changes in the working directory.
+// Copyright (C) 2023 John Dudmesh
+
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+
+// You should have received a copy of the GNU General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>.
+
+import "errors"
+
+// sqliteSupported is false if gomon was built without cgo, which go-sqlite3 needs. The SQLite driver isn't
+// registered so the history has to be kept in the JSONL store instead.
+const sqliteSupported = false
+
+var errSQLiteCorrupt = errors.New("database disk image is malformed")
+
+func isCorrupt(err error) bool {
+	return errors.Is(err, errSQLiteCorrupt)
+}
+
+func isLocked(err error) bool {
+	return false
+}
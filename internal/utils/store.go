@@ -0,0 +1,98 @@
+package utils
+
+// gomon is a simple command line tool that watches your files and automatically restarts the application when it detects any changes in the working directory.
+// Copyright (C) 2023 John Dudmesh
+
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+
+// You should have received a copy of the GNU General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>.
+
+import (
+	"fmt"
+
+	"github.com/jdudmesh/gomon/internal/config"
+	"github.com/jdudmesh/gomon/internal/notification"
+)
+
+// Store keeps the history of events along with the runs, restarts and resource samples derived from them. Events
+// are queued by Notify and written in the background.
+type Store interface {
+	notification.EventConsumer
+	QueueStatsReporter
+	RestartRecorder
+	RunLabeller
+	Close() error
+	Health() error
+	RecoveryWarning() string
+	StorageWarning() string
+	StartPruner(callbackFn notification.NotificationCallback)
+	RetentionStatus() *RetentionStatus
+	RecordSample(s ResourceSample) error
+	FindResources(runID string) ([]*ResourceRun, error)
+	FindNotifications(runID, stm, level, filter string) ([][]*notification.Notification, error)
+	FindRuns(label string) ([]*notification.Notification, error)
+	FindMarker(marker, direction, fromID string) (*notification.Notification, error)
+	ExportRun(runID string, fn func(*notification.Notification) error) error
+	ExportRange(fromID, toID string, fn func(*notification.Notification) error) error
+	FindComparisonRuns(runID string) (string, string, error)
+	FindRunTriggers(runIDs []string) (map[string][]*RunTrigger, error)
+	FindRunErrorCounts(runIDs []string) (map[string]int, error)
+	FindTimeline() ([]*TimelineRun, error)
+}
+
+var (
+	_ Store = (*Database)(nil)
+	_ Store = (*JSONLStore)(nil)
+)
+
+// droppable reports whether an event may be dropped when a store's write queue is full, only lines of output are
+// dropped so the lifecycle events that runs are found from are always written
+func droppable(t notification.NotificationType) bool {
+	switch t {
+	case notification.NotificationTypeStdOut,
+		notification.NotificationTypeStdErr,
+		notification.NotificationTypeOOBTaskStdOut,
+		notification.NotificationTypeOOBTaskStdErr:
+		return true
+	default:
+		return false
+	}
+}
+
+// StorageType returns the storage backend selected by the config, the default is SQLite unless gomon was built
+// without cgo in which case it is the JSONL store
+func StorageType(cfg config.Config) string {
+	if cfg.Storage.Type != "" {
+		return cfg.Storage.Type
+	}
+	if sqliteSupported {
+		return config.StorageSQLite
+	}
+	return config.StorageJSONL
+}
+
+// NewStore opens the store selected by the storage section of the config
+func NewStore(cfg config.Config) (Store, error) {
+	switch StorageType(cfg) {
+	case config.StorageSQLite:
+		return NewDatabase(cfg)
+	case config.StorageJSONL:
+		return NewJSONLStore(cfg)
+	case config.StorageMemory:
+		if !sqliteSupported {
+			return NewMemoryJSONLStore(cfg), nil
+		}
+		return NewMemoryDatabase(cfg)
+	default:
+		return nil, fmt.Errorf("unsupported storage type: %s", cfg.Storage.Type)
+	}
+}
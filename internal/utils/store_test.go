@@ -0,0 +1,184 @@
+package utils
+
+// gomon is a simple command line tool that watches your files and automatically restarts the application when it detects any changes in the working directory.
+// Copyright (C) 2023 John Dudmesh
+
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+
+// You should have received a copy of the GNU General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>.
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/jdudmesh/gomon/internal/config"
+	"github.com/jdudmesh/gomon/internal/notification"
+)
+
+// storageTypes returns the backends that the tests of the Store interface run against, SQLite needs cgo
+func storageTypes() []string {
+	if !sqliteSupported {
+		return []string{config.StorageJSONL}
+	}
+	return []string{config.StorageSQLite, config.StorageJSONL}
+}
+
+func TestNotifyKeepsLifecycleEvents(t *testing.T) {
+	for _, storageType := range storageTypes() {
+		t.Run(storageType, func(t *testing.T) {
+			cfg := config.Config{RootDirectory: t.TempDir()}
+			cfg.Storage.Type = storageType
+			cfg.Limits.DBBuffer = 1
+			store, err := NewStore(cfg)
+			if err != nil {
+				t.Fatalf("creating store: %v", err)
+			}
+
+			// each run logs far more than the queue holds so lines of output are dropped, but not the startups
+			const runs = 50
+			start := time.Now().Add(-time.Hour)
+			for ix := 0; ix < runs; ix++ {
+				runID := notification.NextID()
+				store.Notify(notification.Notification{ID: notification.NextID(), Date: start.Add(time.Duration(ix) * time.Second), ChildProccessID: runID, Type: notification.NotificationTypeStartup})
+				for line := 0; line < 100; line++ {
+					store.Notify(notification.Notification{ID: notification.NextID(), Date: start.Add(time.Duration(ix) * time.Second), ChildProccessID: runID, Type: notification.NotificationTypeStdOut, Message: "output"})
+				}
+			}
+			store.Close()
+			store, err = NewStore(cfg)
+			if err != nil {
+				t.Fatalf("reopening store: %v", err)
+			}
+			defer store.Close()
+
+			found, err := store.FindRuns("")
+			if err != nil {
+				t.Fatalf("finding runs: %v", err)
+			}
+			if len(found) != runs {
+				t.Errorf("expected %d runs, got %d", runs, len(found))
+			}
+		})
+	}
+}
+
+func TestMemoryStore(t *testing.T) {
+	rootDirectory := t.TempDir()
+	cfg := config.Config{RootDirectory: rootDirectory}
+	cfg.Storage.Type = config.StorageMemory
+	store, err := NewStore(cfg)
+	if err != nil {
+		t.Fatalf("creating store: %v", err)
+	}
+	defer store.Close()
+
+	runID := notification.NextID()
+	store.Notify(notification.Notification{ID: notification.NextID(), Date: time.Now(), ChildProccessID: runID, Type: notification.NotificationTypeStartup})
+	store.Notify(notification.Notification{ID: notification.NextID(), Date: time.Now(), ChildProccessID: runID, Type: notification.NotificationTypeStdOut, Message: "hello"})
+
+	// the events are written in the background
+	var runs []*notification.Notification
+	for deadline := time.Now().Add(5 * time.Second); time.Now().Before(deadline); time.Sleep(10 * time.Millisecond) {
+		runs, err = store.FindRuns("")
+		if err != nil {
+			t.Fatalf("finding runs: %v", err)
+		}
+		if len(runs) > 0 {
+			break
+		}
+	}
+	if len(runs) != 1 || runs[0].ChildProccessID != runID {
+		t.Errorf("expected the run to be listed, got %v", runs)
+	}
+	if _, err := os.Stat(filepath.Join(rootDirectory, ".gomon")); !os.IsNotExist(err) {
+		t.Errorf("expected nothing to be written to disk, got %v", err)
+	}
+	if status := store.RetentionStatus(); status.SizeBytes == 0 {
+		t.Error("expected the size of the in memory store to be reported")
+	}
+
+	cfg.Storage.Type = "mysql"
+	if _, err := NewStore(cfg); err == nil {
+		t.Error("expected an error for an unknown storage type")
+	}
+}
+
+func TestPruneKeepsCurrentRun(t *testing.T) {
+	for _, storageType := range storageTypes() {
+		t.Run(storageType, func(t *testing.T) {
+			cfg := config.Config{RootDirectory: t.TempDir()}
+			cfg.Storage.Type = storageType
+			store, err := NewStore(cfg)
+			if err != nil {
+				t.Fatalf("creating store: %v", err)
+			}
+
+			// an old run and a long running current run, both started longer ago than the maximum age
+			start := time.Now().Add(-10 * 24 * time.Hour)
+			oldRun, currentRun := notification.NextID(), notification.NextID()
+			for _, n := range []notification.Notification{
+				{ChildProccessID: oldRun, Type: notification.NotificationTypeStartup, Date: start},
+				{ChildProccessID: oldRun, Type: notification.NotificationTypeStdOut, Date: start.Add(time.Second)},
+				{ChildProccessID: currentRun, Type: notification.NotificationTypeStartup, Date: start.Add(time.Minute)},
+				{ChildProccessID: currentRun, Type: notification.NotificationTypeStdOut, Date: start.Add(2 * time.Minute)},
+				{ChildProccessID: currentRun, Type: notification.NotificationTypeStdOut, Date: time.Now()},
+			} {
+				n.ID = notification.NextID()
+				store.Notify(n)
+			}
+			store.Close()
+
+			// nothing is pruned unless retention is configured
+			store, err = NewStore(cfg)
+			if err != nil {
+				t.Fatalf("reopening store: %v", err)
+			}
+			stats, err := store.(interface{ Prune() (PruneStats, error) }).Prune()
+			store.Close()
+			if err != nil || stats.EventsDeleted != 0 {
+				t.Fatalf("expected nothing to be pruned by default, got %+v, %v", stats, err)
+			}
+
+			cfg.UI.Retention.MaxAgeDays = 7
+			store, err = NewStore(cfg)
+			if err != nil {
+				t.Fatalf("reopening store: %v", err)
+			}
+			defer store.Close()
+			stats, err = store.(interface{ Prune() (PruneStats, error) }).Prune()
+			if err != nil {
+				t.Fatalf("pruning: %v", err)
+			}
+			if stats.EventsDeleted != 2 || stats.RunsDeleted != 1 {
+				t.Errorf("expected only the old run to be pruned, got %+v", stats)
+			}
+
+			runs, err := store.FindRuns("")
+			if err != nil {
+				t.Fatalf("finding runs: %v", err)
+			}
+			if len(runs) != 1 || runs[0].ChildProccessID != currentRun {
+				t.Fatalf("expected the current run to be kept, got %+v", runs)
+			}
+			count := 0
+			err = store.ExportRun(currentRun, func(*notification.Notification) error {
+				count++
+				return nil
+			})
+			if err != nil || count != 3 {
+				t.Errorf("expected every event of the current run to be kept, got %d, %v", count, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,182 @@
+package utils
+
+// gomon is a simple command line tool that watches your files and automatically restarts the application when it detects any changes in the working directory.
+// Copyright (C) 2023 John Dudmesh
+
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+
+// You should have received a copy of the GNU General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>.
+
+import (
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/jdudmesh/gomon/internal/notification"
+	"github.com/jmoiron/sqlx"
+)
+
+// MaxTimelineRuns is the number of most recent runs shown in the timeline
+const MaxTimelineRuns = 50
+
+// maxTimelineMarkers stops a run which restarted in a tight loop from making the timeline unusable
+const maxTimelineMarkers = 5000
+
+// The kinds of marker shown on the timeline
+const (
+	TimelineRestart = "restart"
+	TimelineBuild   = "build"
+	TimelineTask    = "task"
+	TimelineIPC     = "ipc"
+	TimelineCrash   = "crash"
+)
+
+var markerKinds = map[notification.NotificationType]string{
+	notification.NotificationTypeHardRestart:     TimelineRestart,
+	notification.NotificationTypeSoftRestart:     TimelineRestart,
+	notification.NotificationTypeBuildError:      TimelineBuild,
+	notification.NotificationTypeOOBTaskStartup:  TimelineTask,
+	notification.NotificationTypeOOBTaskComplete: TimelineTask,
+	notification.NotificationTypeIPC:             TimelineIPC,
+	notification.NotificationTypeCrash:           TimelineCrash,
+	notification.NotificationTypeUnhealthy:       TimelineCrash,
+}
+
+// TimelineMarker is an event shown on a run's bar
+type TimelineMarker struct {
+	ID      string    `json:"id,omitempty"`
+	Date    time.Time `json:"date"`
+	Kind    string    `json:"kind"`
+	Message string    `json:"message"`
+}
+
+// TimelineRun is a run's bar in the timeline, End is the time of its last event and Ended is false if it didn't
+// stop (it is still running, or gomon exited without stopping it). Reason is why it started, it is set by the UI.
+type TimelineRun struct {
+	ID      string            `json:"id"`
+	Reason  string            `json:"reason,omitempty"`
+	Start   time.Time         `json:"start"`
+	End     time.Time         `json:"end"`
+	Ended   bool              `json:"ended"`
+	Crashed bool              `json:"crashed"`
+	Errors  int               `json:"errors"`
+	Markers []*TimelineMarker `json:"markers"`
+}
+
+// FindTimeline returns the most recent runs in the order they started with the restarts, build errors, tasks, IPC
+// events and crashes which happened during each of them
+func (d *Database) FindTimeline() ([]*TimelineRun, error) {
+	rows := []struct {
+		ID      string    `db:"child_process_id"`
+		Start   time.Time `db:"created_at"`
+		End     string    `db:"last_event"`
+		Ended   bool      `db:"ended"`
+		Crashed bool      `db:"crashed"`
+		Errors  int       `db:"errors"`
+	}{}
+	err := d.conn().Select(&rows, `
+		SELECT s.child_process_id, s.created_at,
+			(SELECT MAX(e.created_at) FROM notifs e WHERE e.child_process_id = s.child_process_id) AS last_event,
+			EXISTS (SELECT 1 FROM notifs e WHERE e.child_process_id = s.child_process_id AND e.event_type IN (?, ?)) AS ended,
+			EXISTS (SELECT 1 FROM notifs e WHERE e.child_process_id = s.child_process_id AND e.event_type = ?) AS crashed,
+			(SELECT COUNT(*) FROM notifs e WHERE e.child_process_id = s.child_process_id AND e.level = ?) AS errors
+		FROM notifs s WHERE s.event_type = ? ORDER BY s.created_at DESC LIMIT ?;`,
+		notification.NotificationTypeShutdown, notification.NotificationTypeCrash, notification.NotificationTypeCrash,
+		notification.LevelError, notification.NotificationTypeStartup, MaxTimelineRuns)
+	if err != nil {
+		return nil, fmt.Errorf("getting runs: %w", err)
+	}
+
+	runs := make([]*TimelineRun, len(rows))
+	byID := map[string]*TimelineRun{}
+	for ix, r := range rows {
+		// the aggregate loses the column type so the timestamp isn't converted by the driver
+		end, err := parseTimestamp(r.End)
+		if err != nil {
+			end = r.Start
+		}
+		run := &TimelineRun{ID: r.ID, Start: r.Start, End: end, Ended: r.Ended, Crashed: r.Crashed, Errors: r.Errors, Markers: []*TimelineMarker{}}
+		runs[len(rows)-ix-1] = run
+		byID[r.ID] = run
+	}
+	if len(runs) == 0 {
+		return runs, nil
+	}
+
+	types := []notification.NotificationType{}
+	for t := range markerKinds {
+		types = append(types, t)
+	}
+	query, args, err := sqlx.In("SELECT * FROM notifs WHERE child_process_id IN (?) AND event_type IN (?) ORDER BY created_at ASC, id ASC LIMIT ?;", keys(byID), types, maxTimelineMarkers)
+	if err != nil {
+		return nil, fmt.Errorf("building markers query: %w", err)
+	}
+
+	markers := []*notification.Notification{}
+	err = d.conn().Select(&markers, d.conn().Rebind(query), args...)
+	if err != nil {
+		return nil, fmt.Errorf("getting markers: %w", err)
+	}
+	for _, n := range markers {
+		run := byID[n.ChildProccessID]
+		run.Markers = append(run.Markers, &TimelineMarker{ID: n.ID, Date: n.Date, Kind: markerKinds[n.Type], Message: StripANSI(n.Message)})
+	}
+
+	// soft restarts requested by gomon aren't run events, they are found from the file changes which caused them
+	triggers, err := d.FindRunTriggers(keys(byID))
+	if err != nil {
+		return nil, err
+	}
+	for runID, ts := range triggers {
+		run := byID[runID]
+		for _, t := range ts {
+			if t.Type != notification.NotificationTypeSoftRestartRequested {
+				continue
+			}
+			run.Markers = append(run.Markers, &TimelineMarker{Date: t.Date, Kind: TimelineRestart, Message: fmt.Sprintf("soft restart because %s changed (%s)", t.Path, t.Rule)})
+		}
+		sort.SliceStable(run.Markers, func(i, j int) bool { return run.Markers[i].Date.Before(run.Markers[j].Date) })
+	}
+
+	return runs, nil
+}
+
+// timestampFormats are the formats go-sqlite3 writes timestamps in
+var timestampFormats = []string{
+	"2006-01-02 15:04:05.999999999-07:00",
+	"2006-01-02T15:04:05.999999999-07:00",
+	"2006-01-02 15:04:05.999999999",
+	"2006-01-02T15:04:05.999999999",
+	"2006-01-02 15:04:05",
+	"2006-01-02T15:04:05",
+	"2006-01-02 15:04",
+	"2006-01-02T15:04",
+	"2006-01-02",
+}
+
+func parseTimestamp(s string) (time.Time, error) {
+	for _, format := range timestampFormats {
+		t, err := time.Parse(format, s)
+		if err == nil {
+			return t, nil
+		}
+	}
+	return time.Time{}, fmt.Errorf("unrecognised timestamp: %s", s)
+}
+
+func keys[V any](m map[string]V) []string {
+	ks := make([]string, 0, len(m))
+	for k := range m {
+		ks = append(ks, k)
+	}
+	return ks
+}
@@ -0,0 +1,76 @@
+//go:build cgo
+
+package utils
+
+// gomon is a simple command line tool that watches your files and automatically restarts the application when it detects any changes in the working directory.
+// Copyright (C) 2023 John Dudmesh
+
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+
+// You should have received a copy of the GNU General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>.
+
+import (
+	"testing"
+	"time"
+
+	"github.com/jdudmesh/gomon/internal/config"
+	"github.com/jdudmesh/gomon/internal/notification"
+)
+
+func TestFindTimeline(t *testing.T) {
+	db, err := NewDatabase(config.Config{RootDirectory: t.TempDir()})
+	if err != nil {
+		t.Fatalf("creating database: %v", err)
+	}
+	defer db.Close()
+
+	start := time.Now().Add(-time.Hour).UTC()
+	runIDs := []string{notification.NextID(), notification.NextID()}
+	events := []notification.Notification{
+		{ChildProccessID: runIDs[0], Type: notification.NotificationTypeStartup},
+		{ChildProccessID: runIDs[0], Type: notification.NotificationTypeIPC, Message: "child process connected"},
+		{ChildProccessID: runIDs[0], Type: notification.NotificationTypeStdErr, Message: "ERROR failed", Level: notification.LevelError},
+		{ChildProccessID: runIDs[0], Type: notification.NotificationTypeShutdown},
+		{ChildProccessID: runIDs[1], Type: notification.NotificationTypeStartup},
+		{Type: notification.NotificationTypeSoftRestartRequested, Trigger: &notification.Trigger{Path: "index.html", Rule: "softReload *.html", Event: "WRITE"}},
+		{ChildProccessID: runIDs[1], Type: notification.NotificationTypeCrash, Message: "panic"},
+	}
+	for i, n := range events {
+		n.ID = notification.NextID()
+		n.Date = start.Add(time.Duration(i) * time.Second)
+		db.insert(n)
+	}
+
+	runs, err := db.FindTimeline()
+	if err != nil {
+		t.Fatalf("finding timeline: %v", err)
+	}
+	if len(runs) != 2 || runs[0].ID != runIDs[0] || runs[1].ID != runIDs[1] {
+		t.Fatalf("expected the runs in the order they started, got %+v", runs)
+	}
+
+	first := runs[0]
+	if !first.Ended || first.Crashed || first.Errors != 1 || !first.End.Equal(start.Add(3*time.Second)) {
+		t.Errorf("unexpected first run: %+v", first)
+	}
+	if len(first.Markers) != 1 || first.Markers[0].Kind != TimelineIPC {
+		t.Errorf("expected an IPC marker, got %+v", first.Markers)
+	}
+
+	second := runs[1]
+	if !second.Ended || !second.Crashed {
+		t.Errorf("expected the second run to have crashed: %+v", second)
+	}
+	if len(second.Markers) != 2 || second.Markers[0].Kind != TimelineRestart || second.Markers[1].Kind != TimelineCrash {
+		t.Errorf("expected a soft restart and a crash, got %+v", second.Markers)
+	}
+}
@@ -0,0 +1,69 @@
+package watcher
+
+// gomon is a simple command line tool that watches your files and automatically restarts the application when it detects any changes in the working directory.
+// Copyright (C) 2023 John Dudmesh
+
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+
+// You should have received a copy of the GNU General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>.
+
+import (
+	"sync"
+	"time"
+
+	"github.com/jdudmesh/gomon/internal/notification"
+)
+
+// taskSettleTime is how long changes are still ignored after the last task finishes, events are read from the
+// driver independently of notifications so those made at the end of a task may arrive after it completes
+const taskSettleTime = 500 * time.Millisecond
+
+// buildTaskID tracks builds, which unlike other tasks don't have an ID
+const buildTaskID = "build"
+
+// taskActivity tracks the tasks and builds which are running, the files they write would otherwise trigger the
+// rules which started them again
+type taskActivity struct {
+	lock     sync.Mutex
+	running  map[string]struct{}
+	lastDone time.Time
+}
+
+func newTaskActivity() *taskActivity {
+	return &taskActivity{running: map[string]struct{}{}}
+}
+
+func (a *taskActivity) notify(n notification.Notification) {
+	taskID := n.TaskID
+	if taskID == "" {
+		taskID = buildTaskID
+	}
+
+	a.lock.Lock()
+	defer a.lock.Unlock()
+
+	switch n.Type {
+	case notification.NotificationTypeOOBTaskStartup:
+		a.running[taskID] = struct{}{}
+	case notification.NotificationTypeOOBTaskComplete:
+		delete(a.running, taskID)
+		a.lastDone = n.Date
+	}
+}
+
+// isBusy returns true if a task is running or one finished too recently for its changes to have been seen
+func (a *taskActivity) isBusy(now time.Time) bool {
+	a.lock.Lock()
+	defer a.lock.Unlock()
+
+	return len(a.running) > 0 || now.Sub(a.lastDone) < taskSettleTime
+}
@@ -0,0 +1,413 @@
+package watcher
+
+// gomon is a simple command line tool that watches your files and automatically restarts the application when it detects any changes in the working directory.
+// Copyright (C) 2023 John Dudmesh
+
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+
+// You should have received a copy of the GNU General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>.
+
+import (
+	"context"
+	"crypto/subtle"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/fsnotify/fsnotify"
+	"github.com/jdudmesh/gomon/internal/config"
+	"gopkg.in/cenkalti/backoff.v1"
+)
+
+const (
+	agentEventsPath = "/events"
+	// agents send a heartbeat when there are no changes so that subscribers notice a connection which has been lost
+	agentHeartbeatInterval = 15 * time.Second
+	agentReadTimeout       = 3 * agentHeartbeatInterval
+	agentEventBuffer       = 256
+)
+
+var errAgentTimeout = errors.New("no heartbeat received")
+
+// AgentEvent is a file system change streamed from an agent as a line of JSON, the path is slash separated and
+// relative to the agent's root directory. Events without a path are heartbeats.
+type AgentEvent struct {
+	Path string   `json:"path,omitempty"`
+	Ops  []string `json:"ops,omitempty"`
+}
+
+var agentOps = []struct {
+	name string
+	op   fsnotify.Op
+}{
+	{"create", fsnotify.Create},
+	{"write", fsnotify.Write},
+	{"remove", fsnotify.Remove},
+	{"rename", fsnotify.Rename},
+	{"chmod", fsnotify.Chmod},
+}
+
+func encodeOps(op fsnotify.Op) []string {
+	names := []string{}
+	for _, o := range agentOps {
+		if op.Has(o.op) {
+			names = append(names, o.name)
+		}
+	}
+	return names
+}
+
+func decodeOps(names []string) fsnotify.Op {
+	var op fsnotify.Op
+	for _, name := range names {
+		for _, o := range agentOps {
+			if o.name == name {
+				op |= o.op
+			}
+		}
+	}
+	return op
+}
+
+// Agent watches the root directory and streams the changes to the gomon instances subscribed to it, the reload
+// rules are applied by the subscribers. Exclusions (excludePaths and .gitignore files) are applied by the agent
+// so that ignored changes aren't sent.
+type Agent struct {
+	watcher     *filesystemWatcher
+	token       string
+	mu          sync.Mutex
+	subscribers map[chan AgentEvent]struct{}
+}
+
+// NewAgent creates an agent for the root directory in the config, subscribers must send the token as a bearer
+// token if it isn't empty
+func NewAgent(cfg config.Config, token string, opts ...HotReloaderOption) (*Agent, error) {
+	w, err := New(cfg, opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Agent{
+		watcher:     w,
+		token:       token,
+		subscribers: map[chan AgentEvent]struct{}{},
+	}, nil
+}
+
+// ListenAndServe watches for changes and serves them on addr until the context is cancelled
+func (a *Agent) ListenAndServe(ctx context.Context, addr string) error {
+	var err error
+	w := a.watcher
+	defer w.Close()
+
+	if w.driver == nil {
+		w.driver, err = newFsnotifyDriver()
+		if err != nil {
+			return fmt.Errorf("watcher: %+v", err)
+		}
+	}
+
+	err = w.init()
+	if err != nil {
+		return fmt.Errorf("adding watcher for root path: %w", err)
+	}
+
+	server := &http.Server{
+		Addr:    addr,
+		Handler: a,
+	}
+	serverErrors := make(chan error, 1)
+	go func() {
+		serverErrors <- server.ListenAndServe()
+	}()
+	// streams never finish so the server is closed rather than shut down gracefully
+	defer server.Close()
+
+	log.Infof("agent watching %s, listening on %s", w.rootDirectory, addr)
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case err := <-serverErrors:
+			return fmt.Errorf("serving agent: %w", err)
+		case event, ok := <-w.driver.Events():
+			if !ok {
+				return nil
+			}
+			a.forward(event)
+		case err, ok := <-w.driver.Errors():
+			if !ok {
+				return nil
+			}
+			log.Errorf("watcher: %+v", err)
+		}
+	}
+}
+
+// forward sends a file system event to every subscriber unless the file is excluded
+func (a *Agent) forward(event fsnotify.Event) {
+	filePath, _ := filepath.Abs(event.Name)
+	relPath, err := filepath.Rel(a.watcher.rootDirectory, filePath)
+	if err != nil {
+		log.Errorf("failed to get relative path for %s: %+v", filePath, err)
+		return
+	}
+	if a.watcher.isIgnored(relPath, relPath) {
+		return
+	}
+
+	a.broadcast(AgentEvent{
+		Path: filepath.ToSlash(relPath),
+		Ops:  encodeOps(event.Op),
+	})
+}
+
+func (a *Agent) broadcast(ev AgentEvent) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	for ch := range a.subscribers {
+		select {
+		case ch <- ev:
+		default:
+			log.Warnf("subscriber is not keeping up, dropping change to %s", ev.Path)
+		}
+	}
+}
+
+func (a *Agent) subscribe() chan AgentEvent {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	ch := make(chan AgentEvent, agentEventBuffer)
+	a.subscribers[ch] = struct{}{}
+	return ch
+}
+
+func (a *Agent) unsubscribe(ch chan AgentEvent) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	delete(a.subscribers, ch)
+}
+
+// ServeHTTP streams changes to a subscriber as newline delimited JSON
+func (a *Agent) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.URL.Path != agentEventsPath {
+		http.NotFound(w, r)
+		return
+	}
+
+	if r.Method != http.MethodGet {
+		w.WriteHeader(http.StatusMethodNotAllowed)
+		return
+	}
+
+	if a.token != "" {
+		token := strings.TrimPrefix(r.Header.Get("Authorization"), "Bearer ")
+		if subtle.ConstantTimeCompare([]byte(token), []byte(a.token)) != 1 {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+	}
+
+	flusher, ok := w.(http.Flusher)
+	if !ok {
+		http.Error(w, "streaming unsupported", http.StatusInternalServerError)
+		return
+	}
+
+	events := a.subscribe()
+	defer a.unsubscribe(events)
+
+	log.Infof("subscriber connected: %s", r.RemoteAddr)
+	defer log.Infof("subscriber disconnected: %s", r.RemoteAddr)
+
+	w.Header().Set("Content-Type", "application/x-ndjson")
+	w.WriteHeader(http.StatusOK)
+	flusher.Flush()
+
+	heartbeat := time.NewTicker(agentHeartbeatInterval)
+	defer heartbeat.Stop()
+
+	enc := json.NewEncoder(w)
+	for {
+		var ev AgentEvent
+		select {
+		case <-r.Context().Done():
+			return
+		case ev = <-events:
+		case <-heartbeat.C:
+		}
+
+		err := enc.Encode(ev)
+		if err != nil {
+			return
+		}
+		flusher.Flush()
+	}
+}
+
+// agentDriver is a Driver which receives file system events from agents, their paths are mapped on to the local
+// root directory (or the agent's root if it has one)
+type agentDriver struct {
+	events    chan fsnotify.Event
+	errors    chan error
+	cancel    context.CancelFunc
+	wg        sync.WaitGroup
+	closeOnce sync.Once
+}
+
+func newAgentDriver(rootDirectory string, agents []config.WatcherAgent) *agentDriver {
+	ctx, cancel := context.WithCancel(context.Background())
+	d := &agentDriver{
+		events: make(chan fsnotify.Event, agentEventBuffer),
+		errors: make(chan error),
+		cancel: cancel,
+	}
+
+	for _, agent := range agents {
+		root := agent.Root
+		if root == "" {
+			root = rootDirectory
+		} else if !filepath.IsAbs(root) {
+			root = filepath.Join(rootDirectory, root)
+		}
+
+		d.wg.Add(1)
+		go func(agent config.WatcherAgent, root string) {
+			defer d.wg.Done()
+			d.subscribe(ctx, agent, root)
+		}(agent, root)
+	}
+
+	return d
+}
+
+// Add does nothing, agents decide which directories they watch
+func (d *agentDriver) Add(path string) error {
+	return nil
+}
+
+func (d *agentDriver) Events() <-chan fsnotify.Event {
+	return d.events
+}
+
+func (d *agentDriver) Errors() <-chan error {
+	return d.errors
+}
+
+func (d *agentDriver) Close() error {
+	d.closeOnce.Do(func() {
+		d.cancel()
+		d.wg.Wait()
+		close(d.events)
+		close(d.errors)
+	})
+	return nil
+}
+
+// subscribe streams events from an agent, reconnecting with an exponential backoff until the driver is closed
+func (d *agentDriver) subscribe(ctx context.Context, agent config.WatcherAgent, root string) {
+	policy := backoff.NewExponentialBackOff()
+	// keep trying for as long as gomon is running
+	policy.MaxElapsedTime = 0
+
+	for {
+		connected, err := d.stream(ctx, agent, root)
+		if ctx.Err() != nil {
+			return
+		}
+		if connected {
+			policy.Reset()
+			err = fmt.Errorf("agent %s disconnected: %w", agent.URL, err)
+		} else {
+			err = fmt.Errorf("connecting to agent %s: %w", agent.URL, err)
+		}
+
+		select {
+		case d.errors <- err:
+		case <-ctx.Done():
+			return
+		}
+
+		select {
+		case <-time.After(policy.NextBackOff()):
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+// stream reads events from an agent until the connection fails, it returns true if the agent was connected
+func (d *agentDriver) stream(ctx context.Context, agent config.WatcherAgent, root string) (bool, error) {
+	ctx, cancel := context.WithCancelCause(ctx)
+	defer cancel(nil)
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, strings.TrimSuffix(agent.URL, "/")+agentEventsPath, nil)
+	if err != nil {
+		return false, err
+	}
+	if agent.Token != "" {
+		req.Header.Set("Authorization", "Bearer "+agent.Token)
+	}
+
+	res, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return false, err
+	}
+	defer res.Body.Close()
+
+	if res.StatusCode != http.StatusOK {
+		return false, fmt.Errorf("unexpected status: %s", res.Status)
+	}
+
+	log.Infof("subscribed to agent %s", agent.URL)
+
+	watchdog := time.AfterFunc(agentReadTimeout, func() {
+		cancel(errAgentTimeout)
+	})
+	defer watchdog.Stop()
+
+	dec := json.NewDecoder(res.Body)
+	for {
+		var ev AgentEvent
+		err := dec.Decode(&ev)
+		if err != nil {
+			if cause := context.Cause(ctx); errors.Is(cause, errAgentTimeout) {
+				return true, cause
+			}
+			return true, err
+		}
+		watchdog.Reset(agentReadTimeout)
+
+		if ev.Path == "" {
+			continue
+		}
+
+		event := fsnotify.Event{
+			Name: filepath.Join(root, filepath.FromSlash(ev.Path)),
+			Op:   decodeOps(ev.Ops),
+		}
+		select {
+		case d.events <- event:
+		case <-ctx.Done():
+			return true, ctx.Err()
+		}
+	}
+}
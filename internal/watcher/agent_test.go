@@ -0,0 +1,96 @@
+package watcher
+
+// gomon is a simple command line tool that watches your files and automatically restarts the application when it detects any changes in the working directory.
+// Copyright (C) 2023 John Dudmesh
+
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+
+// You should have received a copy of the GNU General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>.
+
+import (
+	"net/http/httptest"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/fsnotify/fsnotify"
+	"github.com/jdudmesh/gomon/internal/config"
+)
+
+func TestAgentStreamsChanges(t *testing.T) {
+	cfg := testConfig(t)
+	agent, err := NewAgent(cfg, "secret")
+	if err != nil {
+		t.Fatalf("creating agent: %v", err)
+	}
+
+	server := httptest.NewServer(agent)
+	defer server.Close()
+
+	localRoot := t.TempDir()
+	driver := newAgentDriver(localRoot, []config.WatcherAgent{{URL: server.URL, Token: "secret"}})
+	defer driver.Close()
+
+	deadline := time.Now().Add(5 * time.Second)
+	for {
+		agent.mu.Lock()
+		n := len(agent.subscribers)
+		agent.mu.Unlock()
+		if n > 0 {
+			break
+		}
+		if time.Now().After(deadline) {
+			t.Fatal("timed out waiting for subscriber")
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+
+	agent.forward(fsnotify.Event{Name: filepath.Join(cfg.RootDirectory, "vendor", "lib.go"), Op: fsnotify.Write})
+	agent.forward(fsnotify.Event{Name: filepath.Join(cfg.RootDirectory, "views", "index.html"), Op: fsnotify.Create | fsnotify.Write})
+
+	select {
+	case event := <-driver.Events():
+		if event.Name != filepath.Join(localRoot, "views", "index.html") {
+			t.Errorf("unexpected path: %s", event.Name)
+		}
+		if !event.Has(fsnotify.Write) || !event.Has(fsnotify.Create) {
+			t.Errorf("unexpected ops: %s", event.Op)
+		}
+	case err := <-driver.Errors():
+		t.Fatalf("unexpected error: %v", err)
+	case <-time.After(5 * time.Second):
+		t.Fatal("timed out waiting for event")
+	}
+}
+
+func TestAgentRejectsInvalidToken(t *testing.T) {
+	agent, err := NewAgent(testConfig(t), "secret")
+	if err != nil {
+		t.Fatalf("creating agent: %v", err)
+	}
+
+	server := httptest.NewServer(agent)
+	defer server.Close()
+
+	driver := newAgentDriver(t.TempDir(), []config.WatcherAgent{{URL: server.URL, Token: "wrong"}})
+	defer driver.Close()
+
+	select {
+	case err := <-driver.Errors():
+		if !strings.Contains(err.Error(), "401") {
+			t.Errorf("expected unauthorized error, got %v", err)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("timed out waiting for error")
+	}
+}
@@ -0,0 +1,189 @@
+package watcher
+
+// gomon is a simple command line tool that watches your files and automatically restarts the application when it detects any changes in the working directory.
+// Copyright (C) 2023 John Dudmesh
+
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+
+// You should have received a copy of the GNU General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>.
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/fsnotify/fsnotify"
+	"github.com/jdudmesh/gomon/internal/config"
+	"github.com/jdudmesh/gomon/internal/notification"
+	"github.com/jdudmesh/gomon/internal/process"
+)
+
+// The rules which the outputs of a chained generated rule can be passed on to
+const (
+	chainHardReload = "hardReload"
+	chainTemplates  = "templates"
+	chainSoftReload = "softReload"
+	chainGenerated  = "generated"
+)
+
+// generatedRuleName is how a generated rule is referred to in logs and triggers
+func generatedRuleName(patt string, rule config.GeneratedRule) string {
+	if rule.Name != "" {
+		return rule.Name
+	}
+	return patt
+}
+
+// chainTarget returns the rule which handles the files written by a chained generated rule, in the same order as
+// the rules are tried for a modified file. The output is matched using representative files like the rule checks.
+// Pipelines are skipped because they run alongside the generated rule's tasks rather than after them.
+func chainTarget(cfg config.Config, output string) (string, string, bool) {
+	for _, sample := range samplePaths(output) {
+		for _, patt := range cfg.HardReload {
+			if matchPattern(patt, sample) {
+				return chainHardReload, patt, true
+			}
+		}
+		for _, patt := range cfg.Templates.Paths {
+			if matchPattern(patt, sample) {
+				return chainTemplates, patt, true
+			}
+		}
+		for _, patt := range cfg.SoftReload {
+			if matchPattern(patt, sample) {
+				return chainSoftReload, patt, true
+			}
+		}
+		for _, patt := range sortedKeys(cfg.Generated) {
+			if matchPattern(patt, sample) {
+				return chainGenerated, patt, true
+			}
+		}
+	}
+	return "", "", false
+}
+
+// checkGeneratedChains returns an error if a chained rule doesn't declare its outputs or if the outputs of chained
+// rules lead back to a rule which has already run, which would regenerate the files forever
+func checkGeneratedChains(cfg config.Config) error {
+	for _, patt := range sortedKeys(cfg.Generated) {
+		rule := cfg.Generated[patt]
+		if rule.Chain && len(rule.Outputs) == 0 {
+			return fmt.Errorf("generated %s: chain requires outputs", generatedRuleName(patt, rule))
+		}
+	}
+
+	for _, patt := range sortedKeys(cfg.Generated) {
+		err := visitChain(cfg, patt, nil)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func visitChain(cfg config.Config, patt string, path []string) error {
+	if ix := slices.Index(path, patt); ix >= 0 {
+		names := []string{}
+		for _, p := range append(path[ix:], patt) {
+			names = append(names, generatedRuleName(p, cfg.Generated[p]))
+		}
+		return fmt.Errorf("generated rules form a cycle: %s", strings.Join(names, " -> "))
+	}
+
+	rule := cfg.Generated[patt]
+	if !rule.Chain {
+		return nil
+	}
+
+	path = append(path, patt)
+	for _, output := range rule.Outputs {
+		kind, next, ok := chainTarget(cfg, output)
+		if !ok || kind != chainGenerated {
+			continue
+		}
+		err := visitChain(cfg, next, slices.Clone(path))
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// generatedRequests returns the requests for a generated rule's tasks followed by those of the rules its outputs
+// are passed on to if it is chained. The tasks are run in order so the requests of the next rule in the chain are
+// only acted on once the files have been written. A restart requested by more than one rule is only made once.
+func (w *filesystemWatcher) generatedRequests(patt, displayPath string, event fsnotify.Event) []notification.Notification {
+	requests := []notification.Notification{}
+	restarts := map[notification.NotificationType]bool{}
+	restart := func(notifType notification.NotificationType, rule string) {
+		if restarts[notifType] {
+			return
+		}
+		restarts[notifType] = true
+		requests = append(requests, triggered(notifType, displayPath, event, rule))
+	}
+
+	visited := map[string]bool{}
+	var visit func(patt, via string)
+	visit = func(patt, via string) {
+		// the chains are checked for cycles when the config is loaded, this guards against rules which changed since
+		if visited[patt] {
+			return
+		}
+		visited[patt] = true
+
+		generated := w.generated[patt]
+		rule := "generated " + generatedRuleName(patt, generated) + via
+		for _, task := range generated.Tasks {
+			switch task {
+			case process.ForceHardRestart:
+				restart(notification.NotificationTypeHardRestartRequested, rule)
+			case process.ForceSoftRestart:
+				restart(notification.NotificationTypeSoftRestartRequested, rule)
+			default:
+				requests = append(requests, request(notification.NotificationTypeOOBTaskRequested, task))
+			}
+		}
+
+		if !generated.Chain {
+			return
+		}
+
+		via = " via " + strings.TrimPrefix(rule, "generated ")
+		for _, output := range generated.Outputs {
+			kind, next, ok := chainTarget(w.cfg, output)
+			if !ok {
+				log.Debugf("generated %s: nothing handles output %s", generatedRuleName(patt, generated), output)
+				continue
+			}
+			switch kind {
+			case chainHardReload:
+				restart(notification.NotificationTypeHardRestartRequested, "hardReload "+next+via)
+			case chainTemplates, chainSoftReload:
+				restart(notification.NotificationTypeSoftRestartRequested, kind+" "+next+via)
+			case chainGenerated:
+				visit(next, via)
+			}
+		}
+	}
+	visit(patt, "")
+
+	// a hard restart reloads everything
+	if restarts[notification.NotificationTypeHardRestartRequested] {
+		requests = slices.DeleteFunc(requests, func(n notification.Notification) bool {
+			return n.Type == notification.NotificationTypeSoftRestartRequested
+		})
+	}
+
+	return requests
+}
@@ -0,0 +1,95 @@
+package watcher
+
+// gomon is a simple command line tool that watches your files and automatically restarts the application when it detects any changes in the working directory.
+// Copyright (C) 2023 John Dudmesh
+
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+
+// You should have received a copy of the GNU General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>.
+
+import (
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/fsnotify/fsnotify"
+	"github.com/jdudmesh/gomon/internal/config"
+	"github.com/jdudmesh/gomon/internal/notification"
+)
+
+func TestGeneratedChain(t *testing.T) {
+	cfg := testConfig(t)
+	cfg.Generated["*.proto"] = config.GeneratedRule{
+		Name:    "proto",
+		Tasks:   []string{"buf generate"},
+		Outputs: []string{"gen/**/*.pb.go", "gen/**/*.openapi.yaml"},
+		Chain:   true,
+	}
+	cfg.Generated["*.openapi.yaml"] = config.GeneratedRule{
+		Tasks:   []string{"oapi-codegen", "__soft_reload"},
+		Outputs: []string{"api/*.gen.go"},
+		Chain:   true,
+	}
+
+	w, err := New(cfg)
+	if err != nil {
+		t.Fatalf("creating watcher: %v", err)
+	}
+
+	requests := w.actions(fsnotify.Event{Name: filepath.Join(cfg.RootDirectory, "api.proto"), Op: fsnotify.Write})
+
+	// the hard restart from the Go files written by buf replaces the soft reload requested by the second rule
+	expected := []struct {
+		notifType notification.NotificationType
+		message   string
+	}{
+		{notification.NotificationTypeOOBTaskRequested, "buf generate"},
+		{notification.NotificationTypeHardRestartRequested, "api.proto"},
+		{notification.NotificationTypeOOBTaskRequested, "oapi-codegen"},
+	}
+	if len(requests) != len(expected) {
+		t.Fatalf("expected %d requests, got %+v", len(expected), requests)
+	}
+	for i, e := range expected {
+		if requests[i].Type != e.notifType || requests[i].Message != e.message {
+			t.Errorf("request %d: expected %s %q, got %s %q", i, e.notifType, e.message, requests[i].Type, requests[i].Message)
+		}
+	}
+	if rule := requests[1].Trigger.Rule; rule != "hardReload *.go via proto" {
+		t.Errorf("unexpected trigger rule: %q", rule)
+	}
+
+	// the outputs are still ignored when they are written
+	requests = w.actions(fsnotify.Event{Name: filepath.Join(cfg.RootDirectory, "gen/api/v1/api.pb.go"), Op: fsnotify.Write})
+	if len(requests) != 0 {
+		t.Errorf("expected chained outputs to be ignored, got %+v", requests)
+	}
+}
+
+func TestGeneratedChainCycle(t *testing.T) {
+	cfg := testConfig(t)
+	cfg.HardReload = nil
+	cfg.Generated["*.proto"] = config.GeneratedRule{Name: "proto", Tasks: []string{"buf generate"}, Outputs: []string{"gen/*.go"}, Chain: true}
+	cfg.Generated["*.go"] = config.GeneratedRule{Name: "mocks", Tasks: []string{"mockery"}, Outputs: []string{"mocks/*.proto"}, Chain: true}
+
+	_, err := New(cfg)
+	if err == nil || !strings.Contains(err.Error(), "mocks -> proto -> mocks") {
+		t.Errorf("expected a cycle to be reported, got %v", err)
+	}
+
+	cfg = testConfig(t)
+	cfg.Generated["*.proto"] = config.GeneratedRule{Tasks: []string{"buf generate"}, Chain: true}
+	_, err = New(cfg)
+	if err == nil {
+		t.Error("expected an error for a chained rule without outputs")
+	}
+}
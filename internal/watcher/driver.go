@@ -0,0 +1,96 @@
+package watcher
+
+// gomon is a simple command line tool that watches your files and automatically restarts the application when it detects any changes in the working directory.
+// Copyright (C) 2023 John Dudmesh
+
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+
+// You should have received a copy of the GNU General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>.
+
+import (
+	"github.com/fsnotify/fsnotify"
+	"github.com/jdudmesh/gomon/internal/config"
+)
+
+// Driver is the source of file system events for the watcher
+type Driver interface {
+	Add(path string) error
+	Events() <-chan fsnotify.Event
+	Errors() <-chan error
+	Close() error
+}
+
+type fsnotifyDriver struct {
+	watcher *fsnotify.Watcher
+}
+
+func newFsnotifyDriver() (*fsnotifyDriver, error) {
+	w, err := fsnotify.NewWatcher()
+	if err != nil {
+		return nil, err
+	}
+	return &fsnotifyDriver{watcher: w}, nil
+}
+
+func (d *fsnotifyDriver) Add(path string) error {
+	return d.watcher.Add(path)
+}
+
+func (d *fsnotifyDriver) Events() <-chan fsnotify.Event {
+	return d.watcher.Events
+}
+
+func (d *fsnotifyDriver) Errors() <-chan error {
+	return d.watcher.Errors
+}
+
+func (d *fsnotifyDriver) Close() error {
+	return d.watcher.Close()
+}
+
+// countingDriver only counts the directories which would be watched
+type countingDriver struct {
+	count int
+}
+
+func (d *countingDriver) Add(path string) error {
+	d.count++
+	return nil
+}
+
+func (d *countingDriver) Events() <-chan fsnotify.Event {
+	return nil
+}
+
+func (d *countingDriver) Errors() <-chan error {
+	return nil
+}
+
+func (d *countingDriver) Close() error {
+	return nil
+}
+
+// CountWatchedDirectories returns the number of directories which would be watched, on Linux each one uses an
+// inotify watch
+func CountWatchedDirectories(cfg config.Config) (int, error) {
+	counter := &countingDriver{}
+	w, err := New(cfg, WithDriver(counter))
+	if err != nil {
+		return 0, err
+	}
+
+	err = w.init()
+	if err != nil {
+		return 0, err
+	}
+	return counter.count, nil
+}
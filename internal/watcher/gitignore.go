@@ -0,0 +1,162 @@
+package watcher
+
+// gomon is a simple command line tool that watches your files and automatically restarts the application when it detects any changes in the working directory.
+// Copyright (C) 2023 John Dudmesh
+
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+
+// You should have received a copy of the GNU General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>.
+
+import (
+	"bufio"
+	"io"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+)
+
+const gitignoreFileName = ".gitignore"
+
+type gitignoreRule struct {
+	// base is the directory containing the .gitignore file relative to the root directory
+	base     string
+	segments []string
+	negate   bool
+	dirOnly  bool
+	anchored bool
+}
+
+// gitignore holds the rules from all of the .gitignore files beneath the root directory. Rules are held in the
+// order they were loaded so rules from nested files, which are loaded after their parents, take priority.
+type gitignore struct {
+	rules []gitignoreRule
+}
+
+func (g *gitignore) parse(base string, r io.Reader) error {
+	scanner := bufio.NewScanner(r)
+	for scanner.Scan() {
+		line := strings.TrimRight(scanner.Text(), " \t")
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+
+		rule := gitignoreRule{base: base}
+		if strings.HasPrefix(line, "!") {
+			rule.negate = true
+			line = line[1:]
+		}
+		line = strings.TrimPrefix(line, "\\")
+		if strings.HasSuffix(line, "/") {
+			rule.dirOnly = true
+			line = strings.TrimSuffix(line, "/")
+		}
+		// a separator anywhere but the end anchors the pattern to the directory containing the .gitignore file
+		if strings.Contains(line, "/") {
+			rule.anchored = true
+			line = strings.TrimPrefix(line, "/")
+		}
+		if line == "" {
+			continue
+		}
+		rule.segments = strings.Split(line, "/")
+
+		g.rules = append(g.rules, rule)
+	}
+	return scanner.Err()
+}
+
+func (r gitignoreRule) match(relPath string, isDir bool) bool {
+	if r.dirOnly && !isDir {
+		return false
+	}
+
+	if r.base != "" {
+		if !strings.HasPrefix(relPath, r.base+"/") {
+			return false
+		}
+		relPath = strings.TrimPrefix(relPath, r.base+"/")
+	}
+
+	if !r.anchored {
+		match, _ := path.Match(r.segments[0], path.Base(relPath))
+		return match
+	}
+
+	return matchSegments(r.segments, strings.Split(relPath, "/"))
+}
+
+// isIgnored reports whether relPath, or any of the directories containing it, is ignored
+func (g *gitignore) isIgnored(relPath string, isDir bool) bool {
+	if g == nil {
+		return false
+	}
+
+	relPath = filepath.ToSlash(relPath)
+	parts := strings.Split(relPath, "/")
+	for i := 1; i < len(parts); i++ {
+		if g.matchPath(strings.Join(parts[:i], "/"), true) {
+			return true
+		}
+	}
+	return g.matchPath(relPath, isDir)
+}
+
+func (g *gitignore) matchPath(relPath string, isDir bool) bool {
+	isIgnored := false
+	for _, rule := range g.rules {
+		if rule.match(relPath, isDir) {
+			isIgnored = !rule.negate
+		}
+	}
+	return isIgnored
+}
+
+// loadGitignore reads the .gitignore files in the root directory and any directories beneath it which are watched
+func (w *filesystemWatcher) loadGitignore() error {
+	g := &gitignore{}
+
+	err := filepath.Walk(w.rootDirectory, func(srcPath string, f os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		if !f.IsDir() {
+			return nil
+		}
+
+		relPath, err := filepath.Rel(w.rootDirectory, srcPath)
+		if err != nil {
+			return err
+		}
+		if relPath == "." {
+			relPath = ""
+		} else if w.isExcluded(filepath.ToSlash(relPath)) || g.isIgnored(relPath, true) {
+			return filepath.SkipDir
+		}
+
+		file, err := os.Open(filepath.Join(srcPath, gitignoreFileName))
+		if os.IsNotExist(err) {
+			return nil
+		} else if err != nil {
+			return err
+		}
+		defer file.Close()
+
+		return g.parse(filepath.ToSlash(relPath), file)
+	})
+	if err != nil {
+		return err
+	}
+
+	w.gitignore = g
+	return nil
+}
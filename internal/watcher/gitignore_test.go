@@ -0,0 +1,89 @@
+package watcher
+
+// gomon is a simple command line tool that watches your files and automatically restarts the application when it detects any changes in the working directory.
+// Copyright (C) 2023 John Dudmesh
+
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+
+// You should have received a copy of the GNU General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>.
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/jdudmesh/gomon/internal/config"
+)
+
+func TestGitignore(t *testing.T) {
+	rootDirectory := t.TempDir()
+	files := map[string]string{
+		".gitignore":        "# build output\nnode_modules/\n*.log\n!keep.log\n/dist\ndocs/*.html\n",
+		"web/.gitignore":    "generated\n",
+		"web/app.js":        "",
+		"web/node_modules/": "",
+		"web/generated/":    "",
+	}
+	for name, content := range files {
+		p := filepath.Join(rootDirectory, name)
+		if strings.HasSuffix(name, "/") {
+			os.MkdirAll(p, 0755)
+			continue
+		}
+		os.MkdirAll(filepath.Dir(p), 0755)
+		err := os.WriteFile(p, []byte(content), 0644)
+		if err != nil {
+			t.Fatalf("writing %s: %v", name, err)
+		}
+	}
+
+	cfg := config.Config{RootDirectory: rootDirectory}
+	cfg.Watcher.UseGitignore = true
+	w, err := New(cfg)
+	if err != nil {
+		t.Fatalf("creating watcher: %v", err)
+	}
+
+	err = w.loadGitignore()
+	if err != nil {
+		t.Fatalf("loading .gitignore: %v", err)
+	}
+
+	tests := []struct {
+		relPath  string
+		isDir    bool
+		expected bool
+	}{
+		{"main.go", false, false},
+		{"server.log", false, true},
+		{"logs/server.log", false, true},
+		{"logs/keep.log", false, false},
+		{"node_modules", true, true},
+		{"web/node_modules/pkg/index.js", false, true},
+		{"node_modules", false, false},
+		{"dist/app.js", false, true},
+		{"web/dist/app.js", false, false},
+		{"docs/index.html", false, true},
+		{"docs/api/index.html", false, false},
+		{"web/generated/types.ts", false, true},
+		{"generated/types.ts", false, false},
+		{"web/app.js", false, false},
+	}
+
+	for _, tt := range tests {
+		actual := w.gitignore.isIgnored(tt.relPath, tt.isDir)
+		if actual != tt.expected {
+			t.Errorf("isIgnored(%q, %v): expected %v, got %v", tt.relPath, tt.isDir, tt.expected, actual)
+		}
+	}
+}
@@ -0,0 +1,312 @@
+package watcher
+
+// gomon is a simple command line tool that watches your files and automatically restarts the application when it detects any changes in the working directory.
+// Copyright (C) 2023 John Dudmesh
+
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+
+// You should have received a copy of the GNU General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>.
+
+import (
+	"fmt"
+	"path"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/jdudmesh/gomon/internal/config"
+)
+
+// defaultExcludePaths are always excluded in addition to the configured excludePaths
+var defaultExcludePaths = []string{".git", ".vscode", ".idea"}
+
+// LintFinding describes a reload rule which doesn't behave the way it looks like it should, e.g. because an earlier
+// rule handles the same files
+type LintFinding struct {
+	Rule    string
+	Message string
+}
+
+func (f LintFinding) String() string {
+	return f.Rule + " " + f.Message
+}
+
+type lintRule struct {
+	name    string
+	pattern string
+	// group is set for rules which come from a map, their order relative to each other isn't defined
+	group string
+}
+
+// lintRules returns the reload rules in the order in which they are tried for a modified file
+func lintRules(cfg config.Config) []lintRule {
+	rules := []lintRule{}
+
+	for _, patt := range sortedKeys(cfg.Pipelines) {
+		rules = append(rules, lintRule{name: fmt.Sprintf("pipelines %q", patt), pattern: patt, group: "pipelines"})
+	}
+	for i, patt := range cfg.HardReload {
+		rules = append(rules, lintRule{name: fmt.Sprintf("hardReload[%d] %q", i, patt), pattern: patt})
+	}
+	for i, patt := range cfg.Templates.Paths {
+		rules = append(rules, lintRule{name: fmt.Sprintf("templates.paths[%d] %q", i, patt), pattern: patt})
+	}
+	for i, patt := range cfg.SoftReload {
+		rules = append(rules, lintRule{name: fmt.Sprintf("softReload[%d] %q", i, patt), pattern: patt})
+	}
+	for _, patt := range sortedKeys(cfg.Generated) {
+		rules = append(rules, lintRule{name: fmt.Sprintf("generated %q", patt), pattern: patt, group: "generated"})
+	}
+	for i, envFile := range cfg.EnvFiles {
+		rules = append(rules, lintRule{name: fmt.Sprintf("envFiles[%d] %q", i, envFile), pattern: filepath.Base(envFile)})
+	}
+
+	return rules
+}
+
+// Lint checks the reload rules for overlapping patterns, rules which can never be reached because an earlier rule
+// always wins and rules whose files are all excluded. Each rule is checked against a few representative files
+// generated from its pattern so the findings are a guide rather than a proof.
+func Lint(cfg config.Config) []LintFinding {
+	findings := []LintFinding{}
+	excludePaths := append(append([]string{}, defaultExcludePaths...), cfg.ExcludePaths...)
+	excluder := &filesystemWatcher{excludePaths: excludePaths}
+
+	rules := lintRules(cfg)
+	// rules which never trigger can't win over later rules
+	reachable := []lintRule{}
+	for _, rule := range rules {
+		samples := samplePaths(rule.pattern)
+		if len(samples) == 0 {
+			continue
+		}
+
+		if exclude := excludedBy(excludePaths, samples); exclude != "" {
+			findings = append(findings, LintFinding{
+				Rule:    rule.name,
+				Message: fmt.Sprintf("never triggers, files such as %s are excluded by excludePaths %q", samples[0], exclude),
+			})
+			continue
+		}
+		samples = excluder.notExcluded(samples)
+		if len(samples) == 0 {
+			findings = append(findings, LintFinding{
+				Rule:    rule.name,
+				Message: "never triggers, every matching file is excluded by excludePaths",
+			})
+			continue
+		}
+
+		isReachable := true
+		for _, earlier := range reachable {
+			claimed := matchingPaths(earlier.pattern, samples)
+			unordered := rule.group != "" && rule.group == earlier.group
+
+			if len(claimed) == len(samples) && !unordered {
+				findings = append(findings, LintFinding{
+					Rule:    rule.name,
+					Message: fmt.Sprintf("is unreachable, %s comes first and handles every matching file e.g. %s", earlier.name, samples[0]),
+				})
+				isReachable = false
+				break
+			}
+
+			example := ""
+			if len(claimed) > 0 {
+				example = claimed[0]
+			} else if shared := excluder.notExcluded(sharedPaths(rule.pattern, earlier.pattern)); len(shared) > 0 {
+				example = shared[0]
+			}
+			if example == "" {
+				continue
+			}
+
+			if unordered {
+				findings = append(findings, LintFinding{
+					Rule:    rule.name,
+					Message: fmt.Sprintf("overlaps with %s, the order of %s isn't defined so either may handle files such as %s", earlier.name, rule.group, example),
+				})
+				continue
+			}
+
+			findings = append(findings, LintFinding{
+				Rule:    rule.name,
+				Message: fmt.Sprintf("overlaps with %s which comes first and wins for files such as %s", earlier.name, example),
+			})
+		}
+
+		if isReachable {
+			reachable = append(reachable, rule)
+		}
+	}
+
+	return findings
+}
+
+// excludedBy returns the exclude path which excludes every one of the files, if there is one
+func excludedBy(excludePaths []string, relPaths []string) string {
+	for _, exclude := range excludePaths {
+		w := &filesystemWatcher{excludePaths: []string{exclude}}
+		excluded := true
+		for _, relPath := range relPaths {
+			if !w.isExcluded(relPath) {
+				excluded = false
+				break
+			}
+		}
+		if excluded {
+			return exclude
+		}
+	}
+	return ""
+}
+
+func (w *filesystemWatcher) notExcluded(relPaths []string) []string {
+	paths := []string{}
+	for _, relPath := range relPaths {
+		if !w.isExcluded(relPath) {
+			paths = append(paths, relPath)
+		}
+	}
+	return paths
+}
+
+func matchingPaths(pattern string, relPaths []string) []string {
+	matches := []string{}
+	for _, relPath := range relPaths {
+		if matchPattern(pattern, relPath) {
+			matches = append(matches, relPath)
+		}
+	}
+	return matches
+}
+
+// sharedPaths returns files matched by both patterns. The samples of one pattern are filled in using the other so
+// that e.g. "*.html" and "web/**" are found to share web/example.html.
+func sharedPaths(a, b string) []string {
+	a = strings.TrimPrefix(filepath.ToSlash(a), "./")
+	b = strings.TrimPrefix(filepath.ToSlash(b), "./")
+
+	candidates := append(samplePaths(a), samplePaths(b)...)
+	for _, pair := range [][2]string{{a, b}, {b, a}} {
+		pattern, other := pair[0], pair[1]
+		if !strings.Contains(pattern, "/") {
+			// a file name pattern matches in the directories of the other pattern
+			candidates = append(candidates, fillPattern(path.Dir(other), "example", nil, "")+"/"+fillSegment(pattern, "example"))
+		} else if strings.HasSuffix(pattern, "/**") {
+			// and the other pattern's files can be anywhere beneath a trailing wildcard
+			candidates = append(candidates, fillPattern(pattern, "example", nil, fillSegment(path.Base(other), "example")))
+		}
+	}
+
+	return matchingPaths(a, matchingPaths(b, validSamples(a, candidates)))
+}
+
+// samplePaths generates representative files for a pattern by filling in its wildcards in a couple of different ways,
+// a file name pattern is also tried in a sub directory because it matches at any depth
+func samplePaths(pattern string) []string {
+	pattern = strings.TrimPrefix(filepath.ToSlash(pattern), "./")
+
+	candidates := []string{
+		fillPattern(pattern, "example", nil, "example"),
+		fillPattern(pattern, "other", []string{"dir", "sub"}, "other"),
+	}
+	if !strings.Contains(pattern, "/") {
+		candidates[1] = "dir/sub/" + candidates[1]
+	}
+
+	return validSamples(pattern, candidates)
+}
+
+func validSamples(pattern string, candidates []string) []string {
+	samples := []string{}
+	for _, candidate := range candidates {
+		// patterns such as negated character classes can't be filled in this simply
+		if candidate != "" && !strings.HasPrefix(candidate, "/") && matchPattern(pattern, candidate) && !contains(samples, candidate) {
+			samples = append(samples, candidate)
+		}
+	}
+	return samples
+}
+
+// fillPattern replaces the wildcards in a pattern, "**" is replaced by dirs and a trailing "**" is followed by leaf
+func fillPattern(pattern, name string, dirs []string, leaf string) string {
+	segments := strings.Split(pattern, "/")
+	parts := []string{}
+	for i, segment := range segments {
+		if segment == "**" {
+			parts = append(parts, dirs...)
+			if i == len(segments)-1 && leaf != "" {
+				parts = append(parts, leaf)
+			}
+			continue
+		}
+		parts = append(parts, fillSegment(segment, name))
+	}
+	return strings.Join(parts, "/")
+}
+
+func fillSegment(segment, name string) string {
+	var sb strings.Builder
+	for i := 0; i < len(segment); i++ {
+		switch c := segment[i]; c {
+		case '*':
+			// repeated stars in a file name pattern behave like a single star
+			for i+1 < len(segment) && segment[i+1] == '*' {
+				i++
+			}
+			sb.WriteString(name)
+		case '?':
+			sb.WriteByte('x')
+		case '[':
+			end := strings.IndexByte(segment[i:], ']')
+			if end < 0 {
+				return ""
+			}
+			class := segment[i+1 : i+end]
+			if class == "" || class[0] == '^' || class[0] == '!' {
+				return ""
+			}
+			if class[0] == '\\' && len(class) > 1 {
+				class = class[1:]
+			}
+			sb.WriteByte(class[0])
+			i += end
+		case '\\':
+			if i+1 < len(segment) {
+				i++
+				sb.WriteByte(segment[i])
+			}
+		default:
+			sb.WriteByte(c)
+		}
+	}
+	return sb.String()
+}
+
+func sortedKeys[V any](m map[string]V) []string {
+	keys := make([]string, 0, len(m))
+	for key := range m {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+func contains(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,84 @@
+package watcher
+
+// gomon is a simple command line tool that watches your files and automatically restarts the application when it detects any changes in the working directory.
+// Copyright (C) 2023 John Dudmesh
+
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+
+// You should have received a copy of the GNU General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>.
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/jdudmesh/gomon/internal/config"
+)
+
+func TestLint(t *testing.T) {
+	cfg := config.Config{
+		HardReload: []string{"*.go", "cmd/**/*.go"},
+		SoftReload: []string{"web/**", "*.html"},
+		Generated: map[string]config.GeneratedRule{
+			"web/*.templ":   {Tasks: []string{"templ generate"}},
+			"views/*.templ": {Tasks: []string{"templ generate"}},
+			"*.templ":       {Tasks: []string{"templ generate"}},
+		},
+		ExcludePaths: []string{"dist"},
+		Pipelines:    map[string][]string{"dist/**": {"build"}},
+	}
+
+	findings := []string{}
+	for _, finding := range Lint(cfg) {
+		findings = append(findings, finding.String())
+	}
+	all := strings.Join(findings, "\n")
+
+	expected := []string{
+		`pipelines "dist/**" never triggers, files such as dist/example are excluded by excludePaths "dist"`,
+		`hardReload[1] "cmd/**/*.go" is unreachable, hardReload[0] "*.go" comes first`,
+		`softReload[1] "*.html" overlaps with softReload[0] "web/**" which comes first and wins for files such as`,
+		`generated "web/*.templ" is unreachable, softReload[0] "web/**" comes first`,
+		`generated "views/*.templ" overlaps with generated "*.templ", the order of generated isn't defined`,
+	}
+	if strings.Contains(all, `overlaps with pipelines "dist/**"`) {
+		t.Errorf("excluded rules shouldn't win over other rules:\n%s", all)
+	}
+	for _, e := range expected {
+		if !strings.Contains(all, e) {
+			t.Errorf("expected finding: %s\ngot:\n%s", e, all)
+		}
+	}
+
+	if findings := Lint(config.Config{HardReload: []string{"*.go"}, SoftReload: []string{"*.html"}}); len(findings) > 0 {
+		t.Errorf("expected no findings, got %v", findings)
+	}
+}
+
+func TestSamplePaths(t *testing.T) {
+	tests := []struct {
+		pattern  string
+		expected string
+	}{
+		{"*.go", "example.go,dir/sub/other.go"},
+		{"cmd/**/*.go", "cmd/example.go,cmd/dir/sub/other.go"},
+		{"web/templates/**", "web/templates/example,web/templates/dir/sub/other"},
+		{"v?/[abc].txt", "vx/a.txt"},
+		{"[!a].txt", ""},
+	}
+
+	for _, tt := range tests {
+		actual := strings.Join(samplePaths(tt.pattern), ",")
+		if actual != tt.expected {
+			t.Errorf("samplePaths(%q): expected %q, got %q", tt.pattern, tt.expected, actual)
+		}
+	}
+}
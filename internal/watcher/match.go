@@ -0,0 +1,97 @@
+package watcher
+
+// gomon is a simple command line tool that watches your files and automatically restarts the application when it detects any changes in the working directory.
+// Copyright (C) 2023 John Dudmesh
+
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+
+// You should have received a copy of the GNU General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>.
+
+import (
+	"path"
+	"path/filepath"
+	"strings"
+)
+
+// matchPattern reports whether relPath matches a watch rule pattern. Patterns without a path separator are matched
+// against the file name only, e.g. "*.go". Otherwise the pattern is matched against the whole path relative to the
+// root directory and "**" matches any number of directories, e.g. "web/templates/**" or "cmd/**/*.go".
+func matchPattern(pattern, relPath string) bool {
+	pattern = strings.TrimPrefix(filepath.ToSlash(pattern), "./")
+	relPath = filepath.ToSlash(relPath)
+
+	if !strings.Contains(pattern, "/") {
+		match, _ := path.Match(pattern, path.Base(relPath))
+		return match
+	}
+
+	return matchSegments(strings.Split(pattern, "/"), strings.Split(relPath, "/"))
+}
+
+func matchSegments(pattern, parts []string) bool {
+	for len(pattern) > 0 {
+		if pattern[0] == "**" {
+			// collapse repeated wildcards then try to match the remainder of the pattern at each depth
+			for len(pattern) > 0 && pattern[0] == "**" {
+				pattern = pattern[1:]
+			}
+			if len(pattern) == 0 {
+				return true
+			}
+			for i := range parts {
+				if matchSegments(pattern, parts[i:]) {
+					return true
+				}
+			}
+			return false
+		}
+
+		if len(parts) == 0 {
+			return false
+		}
+		match, err := path.Match(pattern[0], parts[0])
+		if err != nil || !match {
+			return false
+		}
+		pattern = pattern[1:]
+		parts = parts[1:]
+	}
+
+	return len(parts) == 0
+}
+
+func hasMeta(pattern string) bool {
+	return strings.ContainsAny(pattern, "*?[")
+}
+
+// isExcluded reports whether relPath is excluded. Plain paths exclude anything starting with them, glob patterns
+// exclude matching files and directories along with everything beneath them.
+func (w *filesystemWatcher) isExcluded(relPath string) bool {
+	for _, exclude := range w.excludePaths {
+		if !hasMeta(exclude) && strings.HasPrefix(relPath, exclude) {
+			return true
+		}
+	}
+	return w.isExcludedByPattern(relPath)
+}
+
+func (w *filesystemWatcher) isExcludedByPattern(relPath string) bool {
+	for _, exclude := range w.excludePaths {
+		if !hasMeta(exclude) {
+			continue
+		}
+		if matchPattern(exclude, relPath) || matchPattern(strings.TrimSuffix(exclude, "/")+"/**", relPath) {
+			return true
+		}
+	}
+	return false
+}
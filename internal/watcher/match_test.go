@@ -0,0 +1,71 @@
+package watcher
+
+// gomon is a simple command line tool that watches your files and automatically restarts the application when it detects any changes in the working directory.
+// Copyright (C) 2023 John Dudmesh
+
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+
+// You should have received a copy of the GNU General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>.
+
+import "testing"
+
+func TestMatchPattern(t *testing.T) {
+	tests := []struct {
+		pattern  string
+		relPath  string
+		expected bool
+	}{
+		{"*.go", "main.go", true},
+		{"*.go", "internal/app/app.go", true},
+		{"*.go", "main.templ", false},
+		{"cmd/*.go", "cmd/main.go", true},
+		{"cmd/*.go", "cmd/server/main.go", false},
+		{"cmd/**/*.go", "cmd/main.go", true},
+		{"cmd/**/*.go", "cmd/server/main.go", true},
+		{"cmd/**/*.go", "internal/main.go", false},
+		{"web/templates/**", "web/templates/index.html", true},
+		{"web/templates/**", "web/templates/partials/nav.html", true},
+		{"web/templates/**", "web/static/app.js", false},
+		{"**/testdata/**", "internal/watcher/testdata/a.txt", true},
+		{"./internal/**/*.go", "internal/app/app.go", true},
+	}
+
+	for _, tt := range tests {
+		actual := matchPattern(tt.pattern, tt.relPath)
+		if actual != tt.expected {
+			t.Errorf("matchPattern(%q, %q): expected %v, got %v", tt.pattern, tt.relPath, tt.expected, actual)
+		}
+	}
+}
+
+func TestIsExcluded(t *testing.T) {
+	w := &filesystemWatcher{excludePaths: []string{"vendor", "**/node_modules", "build/*.tmp"}}
+
+	tests := []struct {
+		relPath  string
+		expected bool
+	}{
+		{"vendor/lib/lib.go", true},
+		{"web/node_modules/pkg/index.js", true},
+		{"node_modules/pkg/index.js", true},
+		{"build/out.tmp", true},
+		{"build/out.bin", false},
+		{"internal/app/app.go", false},
+	}
+
+	for _, tt := range tests {
+		actual := w.isExcluded(tt.relPath)
+		if actual != tt.expected {
+			t.Errorf("isExcluded(%q): expected %v, got %v", tt.relPath, tt.expected, actual)
+		}
+	}
+}
@@ -0,0 +1,266 @@
+package watcher
+
+// gomon is a simple command line tool that watches your files and automatically restarts the application when it detects any changes in the working directory.
+// Copyright (C) 2023 John Dudmesh
+
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+
+// You should have received a copy of the GNU General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>.
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"os/exec"
+	"path"
+	"path/filepath"
+	"strings"
+
+	"github.com/jdudmesh/gomon/internal/notification"
+)
+
+// goPackage is the subset of `go list -json` output used to find the directories in the build graph
+type goPackage struct {
+	Dir        string
+	Standard   bool
+	EmbedFiles []string
+}
+
+// packageDirectories is the set of directories containing the packages the entrypoint depends on, along with the
+// directories of the files they embed
+type packageDirectories map[string]struct{}
+
+func (p packageDirectories) contains(dir string) bool {
+	_, ok := p[dir]
+	return ok
+}
+
+func (p packageDirectories) equal(other packageDirectories) bool {
+	if len(p) != len(other) {
+		return false
+	}
+	for dir := range p {
+		if !other.contains(dir) {
+			return false
+		}
+	}
+	return true
+}
+
+type packageUpdate struct {
+	packages packageDirectories
+	err      error
+}
+
+// packageLister returns a function which runs `go list -deps` for the entrypoint, it only uses copies of the
+// watcher's settings so it can run in the background. Packages with errors are still listed so that broken code
+// is watched.
+func (w *filesystemWatcher) packageLister() func() (packageDirectories, error) {
+	target := "./..."
+	if w.cfg.Entrypoint != "" && len(w.cfg.Command) == 0 {
+		target = w.cfg.Entrypoint
+	}
+	rootDirectory := w.rootDirectory
+	goWork := w.goWork
+	roots := []string{}
+	for _, root := range w.resolver.Roots() {
+		roots = append(roots, root.Path)
+	}
+
+	return func() (packageDirectories, error) {
+		stdout := &bytes.Buffer{}
+		stderr := &bytes.Buffer{}
+		cmd := exec.Command("go", "list", "-e", "-deps", "-json=Dir,Standard,EmbedFiles", target)
+		cmd.Dir = rootDirectory
+		if goWork != "" {
+			cmd.Env = append(os.Environ(), "GOWORK="+goWork)
+		}
+		cmd.Stdout = stdout
+		cmd.Stderr = stderr
+		err := cmd.Run()
+		if err != nil {
+			return nil, fmt.Errorf("go list: %w: %s", err, strings.TrimSpace(stderr.String()))
+		}
+		return parsePackages(stdout, roots)
+	}
+}
+
+// parsePackages reads the output of `go list -json`, directories outside the roots e.g. the module cache are
+// left out
+func parsePackages(r io.Reader, roots []string) (packageDirectories, error) {
+	dirs := packageDirectories{}
+	dec := json.NewDecoder(r)
+	for {
+		pkg := goPackage{}
+		err := dec.Decode(&pkg)
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("decoding go list output: %w", err)
+		}
+		if pkg.Standard || pkg.Dir == "" || !isInRoots(pkg.Dir, roots) {
+			continue
+		}
+		dirs[pkg.Dir] = struct{}{}
+		for _, embedded := range pkg.EmbedFiles {
+			dirs[filepath.Dir(filepath.Join(pkg.Dir, embedded))] = struct{}{}
+		}
+	}
+	return dirs, nil
+}
+
+func isInRoots(dir string, roots []string) bool {
+	for _, root := range roots {
+		relPath, err := filepath.Rel(root, dir)
+		if err == nil && !strings.HasPrefix(relPath, "..") {
+			return true
+		}
+	}
+	return false
+}
+
+// usesBuildGraph returns true if the packages the entrypoint depends on are listed, either to limit the directories
+// which are watched or, in a workspace, to limit hard reloads to changes which affect the entrypoint
+func (w *filesystemWatcher) usesBuildGraph() bool {
+	return w.goModuleAware || w.workspace
+}
+
+// isRelevantDirectory returns true if a directory should be watched when only the build graph is watched, that is
+// if it contains a package the entrypoint depends on or a file in it could match a rule other than a hard reload.
+// In a workspace every directory of every member is watched unless goModuleAware is also set.
+func (w *filesystemWatcher) isRelevantDirectory(dir string) bool {
+	if !w.goModuleAware || w.packages == nil || w.packages.contains(dir) {
+		return true
+	}
+
+	relPath, err := filepath.Rel(w.rootDirectory, dir)
+	if err != nil || relPath == "." {
+		// the root directory holds go.mod, env files and usually the config file
+		return true
+	}
+	if strings.HasPrefix(relPath, "..") {
+		_, relPath = w.resolver.Relative(dir)
+		if relPath == "." {
+			return true
+		}
+	}
+
+	for _, envFile := range w.envFiles {
+		if filepath.Dir(envFile) == relPath {
+			return true
+		}
+	}
+
+	patterns := append(append(append([]string{}, w.templates...), w.softReload...), sortedKeys(w.generated)...)
+	patterns = append(patterns, sortedKeys(w.pipelines)...)
+	for _, patt := range patterns {
+		if matchesBeneath(patt, relPath) {
+			return true
+		}
+	}
+	return false
+}
+
+// applyPackages watches the directories in an updated build graph, the previous directories are still watched but
+// hard reload rules no longer apply to them
+func (w *filesystemWatcher) applyPackages(update packageUpdate) {
+	if update.err != nil {
+		log.Warnf("listing packages, keeping the previous build graph: %v", update.err)
+		return
+	}
+	if !w.usesBuildGraph() || w.packages.equal(update.packages) {
+		return
+	}
+
+	if !w.goModuleAware {
+		// every directory in the workspace is already watched
+		log.Infof("build graph changed, hard reloading for %d package directories", len(update.packages))
+		w.packages = update.packages
+		return
+	}
+
+	log.Infof("build graph changed, watching %d package directories", len(update.packages))
+	w.packages = update.packages
+	err := w.init()
+	if err != nil {
+		log.Errorf("updating watched directories: %v", err)
+	}
+}
+
+// Notify tracks running tasks and refreshes the build graph each time the child process starts
+func (w *filesystemWatcher) Notify(n notification.Notification) error {
+	w.activity.notify(n)
+	if n.Type != notification.NotificationTypeStartup {
+		return nil
+	}
+	select {
+	case w.refresh <- struct{}{}:
+	default:
+		// a refresh is already pending
+	}
+	return nil
+}
+
+// isHardReloadSource returns true if hard reload rules apply to a file, when the build graph is listed they only
+// apply to files in package directories and the root directory. In a workspace the go.mod and go.sum files of each
+// member apply too, since they can change the versions of the entrypoint's dependencies.
+func (w *filesystemWatcher) isHardReloadSource(filePath string) bool {
+	if w.packages == nil {
+		return true
+	}
+	dir := filepath.Dir(filePath)
+	if dir == w.rootDirectory || w.packages.contains(dir) {
+		return true
+	}
+	if !w.workspace {
+		return false
+	}
+	base := filepath.Base(filePath)
+	if base != "go.mod" && base != "go.sum" {
+		return false
+	}
+	for _, root := range w.resolver.Roots() {
+		if root.Path == dir {
+			return true
+		}
+	}
+	return false
+}
+
+// matchesBeneath reports whether a pattern could match a file in the directory relDir
+func matchesBeneath(pattern, relDir string) bool {
+	pattern = strings.TrimPrefix(filepath.ToSlash(pattern), "./")
+	if !strings.Contains(pattern, "/") {
+		// file name patterns match in any directory
+		return true
+	}
+	return matchDirectorySegments(strings.Split(pattern, "/"), strings.Split(filepath.ToSlash(relDir), "/"))
+}
+
+func matchDirectorySegments(pattern, parts []string) bool {
+	if len(parts) == 0 {
+		// the remainder of the pattern has to match the file name
+		return len(pattern) > 0
+	}
+	if len(pattern) == 0 {
+		return false
+	}
+	if pattern[0] == "**" {
+		return matchDirectorySegments(pattern[1:], parts) || matchDirectorySegments(pattern, parts[1:])
+	}
+	match, err := path.Match(pattern[0], parts[0])
+	return err == nil && match && matchDirectorySegments(pattern[1:], parts[1:])
+}
@@ -0,0 +1,225 @@
+package watcher
+
+// gomon is a simple command line tool that watches your files and automatically restarts the application when it detects any changes in the working directory.
+// Copyright (C) 2023 John Dudmesh
+
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+
+// You should have received a copy of the GNU General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>.
+
+import (
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/fsnotify/fsnotify"
+	"github.com/jdudmesh/gomon/internal/notification"
+)
+
+func TestMatchesBeneath(t *testing.T) {
+	tests := []struct {
+		pattern string
+		dir     string
+		want    bool
+	}{
+		{"*.html", "assets/img", true},
+		{"web/templates/**", "web", true},
+		{"web/templates/**", "web/templates/partials", true},
+		{"web/templates/**", "api", false},
+		{"cmd/**/*.go", "cmd/server/handlers", true},
+		{"migrations/*.sql", "migrations", true},
+		{"migrations/*.sql", "migrations/old", false},
+		{"./views/*.templ", "views", true},
+	}
+
+	for _, tt := range tests {
+		if got := matchesBeneath(tt.pattern, tt.dir); got != tt.want {
+			t.Errorf("matchesBeneath(%q, %q) = %v, want %v", tt.pattern, tt.dir, got, tt.want)
+		}
+	}
+}
+
+func TestParsePackages(t *testing.T) {
+	output := `{"Dir": "/usr/local/go/src/fmt", "Standard": true}
+{"Dir": "/home/me/go/pkg/mod/github.com/x/y@v1.0.0"}
+{"Dir": "/app/internal/views", "EmbedFiles": ["templates/index.html", "static/app.js"]}
+{"Dir": "/app"}
+`
+	packages, err := parsePackages(strings.NewReader(output), []string{"/app"})
+	if err != nil {
+		t.Fatalf("parsing packages: %v", err)
+	}
+
+	for _, dir := range []string{"/app", "/app/internal/views", "/app/internal/views/templates", "/app/internal/views/static"} {
+		if !packages.contains(dir) {
+			t.Errorf("expected %s to be in the build graph", dir)
+		}
+	}
+	if len(packages) != 4 {
+		t.Errorf("expected 4 directories, got %v", packages)
+	}
+}
+
+func TestGoModuleAware(t *testing.T) {
+	if _, err := exec.LookPath("go"); err != nil {
+		t.Skip("go isn't installed")
+	}
+
+	cfg := testConfig(t)
+	cfg.Entrypoint = "."
+	cfg.Watcher.GoModuleAware = true
+	cfg.SoftReload = []string{"web/**"}
+	// file name patterns match in every directory so they would all be watched
+	cfg.Generated = nil
+	files := map[string]string{
+		"go.mod":             "module example.com/app\n\ngo 1.21\n",
+		"main.go":            "package main\n\nimport _ \"example.com/app/internal/db\"\n\nfunc main() {}\n",
+		"internal/db/db.go":  "package db\n",
+		"tools/gen/main.go":  "package main\n\nfunc main() {}\n",
+		"web/css/site.css":   "",
+		"assets/img/logo.go": "package img\n",
+	}
+	for name, contents := range files {
+		name = filepath.Join(cfg.RootDirectory, name)
+		if err := os.MkdirAll(filepath.Dir(name), 0755); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.WriteFile(name, []byte(contents), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	driver := &recordingDriver{}
+	w, err := New(cfg, WithDriver(driver))
+	if err != nil {
+		t.Fatalf("creating watcher: %v", err)
+	}
+	err = w.init()
+	if err != nil {
+		t.Fatalf("watching: %v", err)
+	}
+
+	watched := []string{}
+	for _, dir := range driver.paths {
+		rel, _ := filepath.Rel(cfg.RootDirectory, dir)
+		watched = append(watched, filepath.ToSlash(rel))
+	}
+	expected := ". internal/db web web/css"
+	if strings.Join(watched, " ") != expected {
+		t.Errorf("expected %s to be watched, got %v", expected, watched)
+	}
+
+	// a Go file outside the build graph doesn't cause a hard reload even if its directory is watched
+	for name, want := range map[string]int{"internal/db/db.go": 1, "tools/gen/main.go": 0, "go.mod": 0} {
+		event := fsnotify.Event{Name: filepath.Join(cfg.RootDirectory, name), Op: fsnotify.Write}
+		if got := len(w.actions(event)); got != want {
+			t.Errorf("expected %d actions for %s, got %d", want, name, got)
+		}
+	}
+
+	w.Notify(notification.Notification{Type: notification.NotificationTypeStartup})
+	w.Notify(notification.Notification{Type: notification.NotificationTypeStartup})
+	if len(w.refresh) != 1 {
+		t.Errorf("expected a single pending refresh, got %d", len(w.refresh))
+	}
+}
+
+func TestWorkspace(t *testing.T) {
+	if _, err := exec.LookPath("go"); err != nil {
+		t.Skip("go isn't installed")
+	}
+
+	// -mod=mod isn't allowed in workspace mode
+	t.Setenv("GOFLAGS", "")
+
+	cfg := testConfig(t)
+	cfg.Entrypoint = "."
+	cfg.Workspace = true
+	cfg.HardReload = []string{"*.go", "go.mod"}
+	cfg.Generated = nil
+	files := map[string]string{
+		"go.work":                   "go 1.21\n\nuse (\n\t.\n\t./lib\n)\n",
+		"go.mod":                    "module example.com/app\n\ngo 1.21\n",
+		"main.go":                   "package main\n\nimport _ \"example.com/lib/db\"\n\nfunc main() {}\n",
+		"lib/go.mod":                "module example.com/lib\n\ngo 1.21\n",
+		"lib/db/db.go":              "package db\n",
+		"lib/metrics/metrics.go":    "package metrics\n",
+		"lib/metrics/README.md":     "",
+		"lib/internal/cache/lru.go": "package cache\n",
+	}
+	for name, contents := range files {
+		name = filepath.Join(cfg.RootDirectory, name)
+		if err := os.MkdirAll(filepath.Dir(name), 0755); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.WriteFile(name, []byte(contents), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	driver := &recordingDriver{}
+	w, err := New(cfg, WithDriver(driver))
+	if err != nil {
+		t.Fatalf("creating watcher: %v", err)
+	}
+	err = w.init()
+	if err != nil {
+		t.Fatalf("watching: %v", err)
+	}
+
+	// every directory in the workspace is watched
+	watched := []string{}
+	for _, dir := range driver.paths {
+		rel, _ := filepath.Rel(cfg.RootDirectory, dir)
+		watched = append(watched, filepath.ToSlash(rel))
+	}
+	expected := ". lib lib/db lib/internal lib/internal/cache lib/metrics"
+	if strings.Join(watched, " ") != expected {
+		t.Errorf("expected %s to be watched, got %v", expected, watched)
+	}
+
+	// only packages the entrypoint depends on and the members' go.mod files cause a hard reload
+	for name, want := range map[string]int{
+		"main.go":                   1,
+		"lib/db/db.go":              1,
+		"lib/go.mod":                1,
+		"lib/metrics/metrics.go":    0,
+		"lib/internal/cache/lru.go": 0,
+	} {
+		event := fsnotify.Event{Name: filepath.Join(cfg.RootDirectory, name), Op: fsnotify.Write}
+		if got := len(w.actions(event)); got != want {
+			t.Errorf("expected %d actions for %s, got %d", want, name, got)
+		}
+	}
+}
+
+func TestWorkspaceRequiresGoWork(t *testing.T) {
+	cfg := testConfig(t)
+	cfg.Workspace = true
+	_, err := New(cfg, WithDriver(&recordingDriver{}))
+	if err == nil {
+		t.Error("expected an error without a go.work file")
+	}
+}
+
+// recordingDriver records the directories which are watched
+type recordingDriver struct {
+	countingDriver
+	paths []string
+}
+
+func (d *recordingDriver) Add(path string) error {
+	d.paths = append(d.paths, path)
+	return nil
+}
@@ -0,0 +1,155 @@
+package watcher
+
+// gomon is a simple command line tool that watches your files and automatically restarts the application when it detects any changes in the working directory.
+// Copyright (C) 2023 John Dudmesh
+
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+
+// You should have received a copy of the GNU General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>.
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"sort"
+	"sync"
+	"syscall"
+	"time"
+
+	"github.com/fsnotify/fsnotify"
+)
+
+const (
+	DefaultPollInterval = 1000
+	pollEventBuffer     = 256
+)
+
+type fileState struct {
+	modTime time.Time
+	size    int64
+}
+
+// poller detects changes to the files in directories which couldn't be watched by comparing their modification
+// times and sizes, directories aren't scanned recursively as each subdirectory is listed separately
+type poller struct {
+	interval time.Duration
+	mu       sync.Mutex
+	dirs     []string
+	files    map[string]fileState
+	events   chan fsnotify.Event
+	done     chan struct{}
+	closer   sync.Once
+}
+
+func newPoller(intervalMs int) *poller {
+	if intervalMs <= 0 {
+		intervalMs = DefaultPollInterval
+	}
+	return &poller{
+		interval: time.Duration(intervalMs) * time.Millisecond,
+		files:    map[string]fileState{},
+		events:   make(chan fsnotify.Event, pollEventBuffer),
+		done:     make(chan struct{}),
+	}
+}
+
+// isWatchLimitError returns true if a directory couldn't be watched because the inotify watch limit was reached
+func isWatchLimitError(err error) bool {
+	return errors.Is(err, syscall.ENOSPC)
+}
+
+// setDirectories replaces the polled directories, the current contents are the baseline for the next poll
+func (p *poller) setDirectories(dirs []string) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.dirs = dirs
+	p.files = p.scan()
+}
+
+func (p *poller) Events() <-chan fsnotify.Event {
+	return p.events
+}
+
+func (p *poller) run() {
+	ticker := time.NewTicker(p.interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-p.done:
+			return
+		case <-ticker.C:
+			for _, event := range p.poll() {
+				select {
+				case p.events <- event:
+				case <-p.done:
+					return
+				}
+			}
+		}
+	}
+}
+
+// poll returns the changes since the previous poll
+func (p *poller) poll() []fsnotify.Event {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	next := p.scan()
+	events := []fsnotify.Event{}
+	for name, state := range next {
+		prev, ok := p.files[name]
+		switch {
+		case !ok:
+			events = append(events, fsnotify.Event{Name: name, Op: fsnotify.Create | fsnotify.Write})
+		case !prev.modTime.Equal(state.modTime) || prev.size != state.size:
+			events = append(events, fsnotify.Event{Name: name, Op: fsnotify.Write})
+		}
+	}
+	for name := range p.files {
+		if _, ok := next[name]; !ok {
+			events = append(events, fsnotify.Event{Name: name, Op: fsnotify.Remove})
+		}
+	}
+	p.files = next
+	sort.Slice(events, func(i, j int) bool {
+		return events[i].Name < events[j].Name
+	})
+	return events
+}
+
+func (p *poller) scan() map[string]fileState {
+	files := map[string]fileState{}
+	for _, dir := range p.dirs {
+		entries, err := os.ReadDir(dir)
+		if err != nil {
+			// the directory may have been removed, its files are reported as removed
+			continue
+		}
+		for _, entry := range entries {
+			if entry.IsDir() {
+				continue
+			}
+			info, err := entry.Info()
+			if err != nil {
+				continue
+			}
+			files[filepath.Join(dir, entry.Name())] = fileState{modTime: info.ModTime(), size: info.Size()}
+		}
+	}
+	return files
+}
+
+func (p *poller) Close() {
+	p.closer.Do(func() {
+		close(p.done)
+	})
+}
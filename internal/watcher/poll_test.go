@@ -0,0 +1,132 @@
+package watcher
+
+// gomon is a simple command line tool that watches your files and automatically restarts the application when it detects any changes in the working directory.
+// Copyright (C) 2023 John Dudmesh
+
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+
+// You should have received a copy of the GNU General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>.
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"syscall"
+	"testing"
+	"time"
+
+	"github.com/fsnotify/fsnotify"
+)
+
+// limitedDriver fails with ENOSPC once limit directories have been added, like inotify
+type limitedDriver struct {
+	countingDriver
+	limit int
+}
+
+func (d *limitedDriver) Add(path string) error {
+	if d.count >= d.limit {
+		return fmt.Errorf("%q: %w", path, syscall.ENOSPC)
+	}
+	return d.countingDriver.Add(path)
+}
+
+func TestPollerDetectsChanges(t *testing.T) {
+	dir := t.TempDir()
+	existing := filepath.Join(dir, "existing.go")
+	removed := filepath.Join(dir, "removed.go")
+	for _, name := range []string{existing, removed} {
+		if err := os.WriteFile(name, []byte("package main"), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	p := newPoller(10)
+	p.setDirectories([]string{dir})
+	if events := p.poll(); len(events) != 0 {
+		t.Fatalf("expected no events before any changes, got %+v", events)
+	}
+
+	created := filepath.Join(dir, "created.go")
+	if err := os.WriteFile(created, []byte("package main"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(existing, []byte("package main\n\nfunc main() {}"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.Remove(removed); err != nil {
+		t.Fatal(err)
+	}
+
+	events := p.poll()
+	expected := []fsnotify.Event{
+		{Name: created, Op: fsnotify.Create | fsnotify.Write},
+		{Name: existing, Op: fsnotify.Write},
+		{Name: removed, Op: fsnotify.Remove},
+	}
+	if len(events) != len(expected) {
+		t.Fatalf("expected %d events, got %+v", len(expected), events)
+	}
+	for i, e := range expected {
+		if events[i] != e {
+			t.Errorf("event %d: expected %v, got %v", i, e, events[i])
+		}
+	}
+}
+
+func TestWatchLimitReached(t *testing.T) {
+	cfg := testConfig(t)
+	for _, dir := range []string{"a", "b", "c", "vendor/lib"} {
+		if err := os.MkdirAll(filepath.Join(cfg.RootDirectory, dir), 0755); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	// the root directory and a are watched, b and c aren't and vendor is excluded
+	w, err := New(cfg, WithDriver(&limitedDriver{limit: 2}))
+	if err != nil {
+		t.Fatalf("creating watcher: %v", err)
+	}
+	w.poller = newPoller(10)
+
+	err = w.init()
+	if err != nil {
+		t.Fatalf("expected the watch limit not to be an error, got %v", err)
+	}
+	if w.watched != 2 {
+		t.Errorf("expected 2 watched directories, got %d", w.watched)
+	}
+	expected := []string{filepath.Join(cfg.RootDirectory, "b"), filepath.Join(cfg.RootDirectory, "c")}
+	if strings.Join(w.unwatched, ",") != strings.Join(expected, ",") {
+		t.Errorf("expected %v to be unwatched, got %v", expected, w.unwatched)
+	}
+	if msg := w.watchLimitMessage(); !strings.Contains(msg, "after watching 2 directories, 2 more (e.g. b)") || !strings.Contains(msg, "polled instead") {
+		t.Errorf("unexpected message: %s", msg)
+	}
+
+	changed := filepath.Join(cfg.RootDirectory, "c", "main.go")
+	if err := os.WriteFile(changed, []byte("package main"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	go w.poller.run()
+	defer w.poller.Close()
+
+	select {
+	case event := <-w.poller.Events():
+		if event.Name != changed || !event.Has(fsnotify.Write) {
+			t.Errorf("unexpected event: %v", event)
+		}
+	case <-time.After(time.Second):
+		t.Fatal("expected the change to be polled")
+	}
+}
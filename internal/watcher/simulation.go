@@ -0,0 +1,214 @@
+package watcher
+
+// gomon is a simple command line tool that watches your files and automatically restarts the application when it detects any changes in the working directory.
+// Copyright (C) 2023 John Dudmesh
+
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+
+// You should have received a copy of the GNU General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>.
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/fsnotify/fsnotify"
+	"github.com/jdudmesh/gomon/internal/config"
+	"github.com/jdudmesh/gomon/internal/notification"
+)
+
+var scriptOps = map[string]fsnotify.Op{
+	"write":  fsnotify.Write,
+	"create": fsnotify.Create,
+	"remove": fsnotify.Remove,
+	"rename": fsnotify.Rename,
+	"chmod":  fsnotify.Chmod,
+}
+
+// SimulatedEvent is a single step in a simulation script, either a file system event or a pause
+type SimulatedEvent struct {
+	Event fsnotify.Event
+	Delay time.Duration
+}
+
+// ParseScript reads a simulation script. Each line is either `<op> <path>` where op is one of
+// write, create, remove, rename or chmod, or `sleep <duration>`. Blank lines and lines starting with # are ignored.
+// Paths are relative to the root directory.
+func ParseScript(rootDirectory string, r io.Reader) ([]SimulatedEvent, error) {
+	events := []SimulatedEvent{}
+
+	lineNum := 0
+	scanner := bufio.NewScanner(r)
+	for scanner.Scan() {
+		lineNum++
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+
+		fields := strings.Fields(line)
+		if len(fields) != 2 {
+			return nil, fmt.Errorf("line %d: expected '<op> <path>' or 'sleep <duration>'", lineNum)
+		}
+
+		if fields[0] == "sleep" {
+			delay, err := time.ParseDuration(fields[1])
+			if err != nil {
+				return nil, fmt.Errorf("line %d: parsing duration: %w", lineNum, err)
+			}
+			events = append(events, SimulatedEvent{Delay: delay})
+			continue
+		}
+
+		op, ok := scriptOps[fields[0]]
+		if !ok {
+			return nil, fmt.Errorf("line %d: unknown op: %s", lineNum, fields[0])
+		}
+
+		events = append(events, SimulatedEvent{
+			Event: fsnotify.Event{Name: filepath.Join(rootDirectory, fields[1]), Op: op},
+		})
+	}
+
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("reading script: %w", err)
+	}
+
+	return events, nil
+}
+
+type simulationDriver struct {
+	script    []SimulatedEvent
+	events    chan fsnotify.Event
+	errors    chan error
+	done      chan struct{}
+	startOnce sync.Once
+	closeOnce sync.Once
+}
+
+// NewSimulationDriver creates a driver which replays a script instead of watching the file system.
+// The event stream is closed once the script has been replayed.
+func NewSimulationDriver(script []SimulatedEvent) *simulationDriver {
+	return &simulationDriver{
+		script: script,
+		events: make(chan fsnotify.Event),
+		errors: make(chan error),
+		done:   make(chan struct{}),
+	}
+}
+
+func (d *simulationDriver) Add(path string) error {
+	return nil
+}
+
+func (d *simulationDriver) Events() <-chan fsnotify.Event {
+	d.startOnce.Do(func() {
+		go d.replay()
+	})
+	return d.events
+}
+
+func (d *simulationDriver) Errors() <-chan error {
+	return d.errors
+}
+
+func (d *simulationDriver) Close() error {
+	d.closeOnce.Do(func() {
+		close(d.done)
+	})
+	return nil
+}
+
+func (d *simulationDriver) replay() {
+	defer close(d.errors)
+	defer close(d.events)
+
+	for _, step := range d.script {
+		if step.Delay > 0 {
+			select {
+			case <-time.After(step.Delay):
+			case <-d.done:
+				return
+			}
+			continue
+		}
+
+		select {
+		case d.events <- step.Event:
+		case <-d.done:
+			return
+		}
+	}
+}
+
+// Simulate runs a script against the reload rules in the config and writes the action taken for each event
+func Simulate(cfg config.Config, r io.Reader, out io.Writer) error {
+	script, err := ParseScript(cfg.RootDirectory, r)
+	if err != nil {
+		return fmt.Errorf("parsing script: %w", err)
+	}
+
+	w, err := New(cfg)
+	if err != nil {
+		return fmt.Errorf("creating watcher: %w", err)
+	}
+
+	if w.useGitignore {
+		err = w.loadGitignore()
+		if err != nil {
+			return fmt.Errorf("loading .gitignore: %w", err)
+		}
+	}
+
+	for _, step := range script {
+		if step.Delay > 0 {
+			time.Sleep(step.Delay)
+			continue
+		}
+
+		relPath, err := filepath.Rel(cfg.RootDirectory, step.Event.Name)
+		if err != nil {
+			relPath = step.Event.Name
+		}
+		fmt.Fprintf(out, "%s %s\n", strings.ToLower(step.Event.Op.String()), relPath)
+
+		actions := w.actions(step.Event)
+		if len(actions) == 0 {
+			fmt.Fprintln(out, "  no action")
+		}
+		for _, n := range actions {
+			fmt.Fprintf(out, "  %s: %s\n", describeAction(n.Type), n.Message)
+		}
+	}
+
+	return nil
+}
+
+func describeAction(notifType notification.NotificationType) string {
+	switch notifType {
+	case notification.NotificationTypeHardRestartRequested:
+		return "hard restart"
+	case notification.NotificationTypeSoftRestartRequested:
+		return "soft restart"
+	case notification.NotificationTypeOOBTaskRequested:
+		return "run task"
+	case notification.NotificationTypePipelineRequested:
+		return "run pipeline"
+	case notification.NotificationTypeTestRequested:
+		return "test package"
+	}
+	return notifType.String()
+}
@@ -17,43 +17,98 @@ package watcher
 // along with this program.  If not, see <https://www.gnu.org/licenses/>.
 
 import (
+	"errors"
 	"fmt"
 	"os"
 	"path"
 	"path/filepath"
 	"strings"
+	"sync/atomic"
 	"time"
 
 	"github.com/fsnotify/fsnotify"
 	"github.com/jdudmesh/gomon/internal/config"
 	"github.com/jdudmesh/gomon/internal/notification"
 	"github.com/jdudmesh/gomon/internal/process"
-	log "github.com/sirupsen/logrus"
+	"github.com/jdudmesh/gomon/internal/utils"
+	"github.com/sirupsen/logrus"
 )
 
+var log = logrus.WithField("component", "watcher")
+
 type HotReloaderOption func(*filesystemWatcher) error
 
+type ConfigChangeHandler func(cfg config.Config)
+
 type filesystemWatcher struct {
+	cfg           config.Config
 	rootDirectory string
 	hardReload    []string
 	softReload    []string
+	templates     []string
 	envFiles      []string
-	generated     map[string][]string
-	excludePaths  []string
-	watcher       *fsnotify.Watcher
+	// envValues is the last seen contents of each env file, used to report which variables changed
+	envValues map[string]map[string]string
+	generated map[string]config.GeneratedRule
+	// outputs are the files written by generated rules' tasks, changes to them are ignored
+	outputs         []string
+	activity        *taskActivity
+	pipelines       map[string][]string
+	testFiles       string
+	excludePaths    []string
+	useGitignore    bool
+	gitignore       *gitignore
+	resolver        *utils.PathResolver
+	driver          Driver
+	agents          Driver
+	onConfigChanged ConfigChangeHandler
+	isWatching      atomic.Bool
+	// watched is the number of directories added to the driver, unwatched are those which couldn't be added
+	// because the inotify watch limit was reached
+	watched   int
+	unwatched []string
+	// watchedCount and unwatchedCount are copies of the above for reporting from other goroutines
+	watchedCount   atomic.Int64
+	unwatchedCount atomic.Int64
+	poller         *poller
+	// packages is the build graph when goModuleAware or workspace is set, refresh requests that it is listed again
+	// after a restart and the result is applied on the watch goroutine. goWork is the workspace's go.work file.
+	goModuleAware  bool
+	workspace      bool
+	goWork         string
+	packages       packageDirectories
+	refresh        chan struct{}
+	packageUpdates chan packageUpdate
+	isListing      bool
+}
+
+func WithDriver(driver Driver) HotReloaderOption {
+	return func(w *filesystemWatcher) error {
+		w.driver = driver
+		return nil
+	}
+}
+
+// WithConfigChangeHandler is called after the config file has been modified and reloaded
+func WithConfigChangeHandler(fn ConfigChangeHandler) HotReloaderOption {
+	return func(w *filesystemWatcher) error {
+		w.onConfigChanged = fn
+		return nil
+	}
 }
 
 func New(cfg config.Config, opts ...HotReloaderOption) (*filesystemWatcher, error) {
 	reloader := &filesystemWatcher{
-		rootDirectory: cfg.RootDirectory,
-		hardReload:    cfg.HardReload,
-		softReload:    cfg.SoftReload,
-		envFiles:      cfg.EnvFiles,
-		generated:     cfg.Generated,
-		excludePaths:  []string{".git", ".vscode", ".idea"},
+		rootDirectory:  cfg.RootDirectory,
+		refresh:        make(chan struct{}, 1),
+		packageUpdates: make(chan packageUpdate, 1),
+		activity:       newTaskActivity(),
 	}
 
-	reloader.excludePaths = append(reloader.excludePaths, cfg.ExcludePaths...)
+	err := reloader.applyConfig(cfg)
+	if err != nil {
+		return nil, err
+	}
 
 	for _, opt := range opts {
 		err := opt(reloader)
@@ -66,9 +121,15 @@ func New(cfg config.Config, opts ...HotReloaderOption) (*filesystemWatcher, erro
 }
 
 func (w *filesystemWatcher) Close() error {
-	if w.watcher != nil {
+	if w.poller != nil {
+		w.poller.Close()
+	}
+	if w.agents != nil {
+		w.agents.Close()
+	}
+	if w.driver != nil {
 		log.Info("closing file watcher")
-		err := w.watcher.Close()
+		err := w.driver.Close()
 		if err != nil {
 			return fmt.Errorf("closing watcher: %w", err)
 		}
@@ -80,132 +141,447 @@ func (w *filesystemWatcher) Watch(callbackFn notification.NotificationCallback)
 	var err error
 	log.Infof("starting gomon with root directory: %s", w.rootDirectory)
 
-	w.watcher, err = fsnotify.NewWatcher()
-	if err != nil {
-		return fmt.Errorf("watcher: %+v", err)
+	if w.driver == nil {
+		w.driver, err = newFsnotifyDriver()
+		if err != nil {
+			return fmt.Errorf("watcher: %+v", err)
+		}
+	}
+
+	if w.cfg.Watcher.PollOverflow && w.poller == nil {
+		w.poller = newPoller(w.cfg.Watcher.PollInterval)
+		go w.poller.run()
 	}
 
 	err = w.init()
 	if err != nil {
 		return fmt.Errorf("adding watcher for root path: %w", err)
 	}
+	if len(w.unwatched) > 0 {
+		callbackFn(request(notification.NotificationTypeSystemError, w.watchLimitMessage()))
+	}
+
+	if w.cfg.ConfigPath != "" && !w.isInRootDirectory(w.cfg.ConfigPath) {
+		err = w.driver.Add(w.cfg.ConfigPath)
+		if err != nil {
+			return fmt.Errorf("adding watcher for config file: %w", err)
+		}
+	}
+
+	var pollEvents <-chan fsnotify.Event
+	if w.poller != nil {
+		pollEvents = w.poller.Events()
+	}
+
+	// changes made on other machines are streamed from agents alongside the local events
+	var agentEvents <-chan fsnotify.Event
+	var agentErrors <-chan error
+	if len(w.cfg.Watcher.Agents) > 0 && w.agents == nil {
+		w.agents = newAgentDriver(w.rootDirectory, w.cfg.Watcher.Agents)
+	}
+	if w.agents != nil {
+		agentEvents = w.agents.Events()
+		agentErrors = w.agents.Errors()
+	}
+
+	w.isWatching.Store(true)
+	defer w.isWatching.Store(false)
 
 	for {
 		select {
-		case event, ok := <-w.watcher.Events:
+		case event, ok := <-w.driver.Events():
 			if !ok {
-				break
-			}
-			if event.Has(fsnotify.Write) {
-				w.processFileChange(event, callbackFn)
+				return nil
 			}
-		case err, ok := <-w.watcher.Errors:
+			w.handleEvent(event, callbackFn)
+		case err, ok := <-w.driver.Errors():
 			if !ok {
-				break
+				return nil
 			}
 			log.Errorf("watcher: %+v", err)
+		case event := <-pollEvents:
+			w.handleEvent(event, callbackFn)
+		case <-w.refresh:
+			if w.usesBuildGraph() && !w.isListing {
+				w.isListing = true
+				list := w.packageLister()
+				go func() {
+					packages, err := list()
+					w.packageUpdates <- packageUpdate{packages: packages, err: err}
+				}()
+			}
+		case update := <-w.packageUpdates:
+			w.isListing = false
+			w.applyPackages(update)
+		case event, ok := <-agentEvents:
+			if !ok {
+				agentEvents = nil
+				continue
+			}
+			w.handleEvent(event, callbackFn)
+		case err, ok := <-agentErrors:
+			if !ok {
+				agentErrors = nil
+				continue
+			}
+			log.Warnf("watcher: %+v", err)
 		}
 	}
 }
 
-func (w *filesystemWatcher) processFileChange(event fsnotify.Event, callbackFn notification.NotificationCallback) {
+func (w *filesystemWatcher) handleEvent(event fsnotify.Event, callbackFn notification.NotificationCallback) {
+	if w.isConfigFile(event) {
+		w.reloadConfig(callbackFn)
+		return
+	}
+	for _, n := range w.actions(event) {
+		callbackFn(n)
+	}
+}
+
+// Health returns an error if the watcher isn't receiving file system events
+func (w *filesystemWatcher) Health() error {
+	if !w.isWatching.Load() {
+		return errors.New("not watching")
+	}
+	return nil
+}
+
+// Directories returns the number of directories being watched and the number which couldn't be watched
+func (w *filesystemWatcher) Directories() (int, int) {
+	return int(w.watchedCount.Load()), int(w.unwatchedCount.Load())
+}
+
+// actions returns the requests triggered by a file system event according to the reload rules
+func (w *filesystemWatcher) actions(event fsnotify.Event) []notification.Notification {
+	if !event.Has(fsnotify.Write) {
+		return nil
+	}
+
 	filePath, _ := filepath.Abs(event.Name)
 	relPath, err := filepath.Rel(w.rootDirectory, filePath)
 	if err != nil {
 		log.Errorf("failed to get relative path for %s: %+v", filePath, err)
 		relPath = filePath
 	}
+	// rules are matched against the path relative to the root it is in, but it is shown with the name of the root
+	root, rootRelPath := w.resolver.Relative(filePath)
+	if root.Name != "" && strings.HasPrefix(relPath, "..") {
+		relPath = rootRelPath
+	}
+	displayPath := w.resolver.Display(filePath)
+
+	if w.isIgnored(relPath, displayPath) {
+		return nil
+	}
+
+	if w.isOutput(relPath) {
+		log.Debugf("generated file: %s", displayPath)
+		return nil
+	}
+
+	// tasks and builds write files which would otherwise trigger the rule that ran them again
+	if w.activity.isBusy(time.Now()) {
+		log.Infof("ignoring change while a task is running: %s", displayPath)
+		return nil
+	}
 
-	for _, exclude := range w.excludePaths {
-		if strings.HasPrefix(relPath, exclude) {
-			log.Debugf("excluded file: %s", relPath)
-			return
+	// pipelines take priority as they usually end by restarting the process
+	for patt := range w.pipelines {
+		if matchPattern(patt, relPath) {
+			log.Infof("running pipeline %s for: %s", patt, displayPath)
+			return []notification.Notification{request(notification.NotificationTypePipelineRequested, patt)}
+		}
+	}
+
+	// in test mode every change is tested so test files are treated like any other file
+	if isTestFile(relPath) && w.cfg.Mode != config.ModeTest {
+		switch w.testFiles {
+		case config.TestFilesIgnore:
+			log.Infof("ignoring test file: %s", displayPath)
+			return nil
+		case config.TestFilesTest:
+			return []notification.Notification{triggered(notification.NotificationTypeTestRequested, displayPath, event, "testFiles "+config.TestFilesTest)}
 		}
 	}
 
 	for _, hard := range w.hardReload {
-		if match, _ := filepath.Match(hard, filepath.Base(filePath)); match {
-			callbackFn(notification.Notification{
-				ID:              notification.NextID(),
-				ChildProccessID: "",
-				Date:            time.Now(),
-				Type:            notification.NotificationTypeHardRestartRequested,
-				Message:         relPath,
-			})
-			return
+		if matchPattern(hard, relPath) && w.isHardReloadSource(filePath) {
+			return []notification.Notification{triggered(notification.NotificationTypeHardRestartRequested, displayPath, event, "hardReload "+hard)}
+		}
+	}
+
+	for _, patt := range w.templates {
+		if matchPattern(patt, relPath) {
+			return []notification.Notification{triggered(notification.NotificationTypeSoftRestartRequested, displayPath, event, "templates "+patt)}
 		}
 	}
 
 	for _, soft := range w.softReload {
-		if match, _ := filepath.Match(soft, filepath.Base(filePath)); match {
-			callbackFn(notification.Notification{
-				ID:              notification.NextID(),
-				ChildProccessID: "",
-				Date:            time.Now(),
-				Type:            notification.NotificationTypeSoftRestartRequested,
-				Message:         relPath,
-			})
-			return
-		}
-	}
-
-	for patt, generated := range w.generated {
-		if match, _ := filepath.Match(patt, filepath.Base(filePath)); match {
-			log.Infof("generated file source: %s", relPath)
-			for _, task := range generated {
-				switch task {
-				case process.ForceHardRestart:
-					callbackFn(notification.Notification{
-						ID:              notification.NextID(),
-						ChildProccessID: "",
-						Date:            time.Now(),
-						Type:            notification.NotificationTypeHardRestartRequested,
-						Message:         relPath,
-					})
-				case process.ForceSoftRestart:
-					callbackFn(notification.Notification{
-						ID:              notification.NextID(),
-						ChildProccessID: "",
-						Date:            time.Now(),
-						Type:            notification.NotificationTypeSoftRestartRequested,
-						Message:         relPath,
-					})
-				default:
-					callbackFn(notification.Notification{
-						ID:              notification.NextID(),
-						ChildProccessID: "",
-						Date:            time.Now(),
-						Type:            notification.NotificationTypeOOBTaskRequested,
-						Message:         task,
-					})
-				}
-			}
+		if matchPattern(soft, relPath) {
+			return []notification.Notification{triggered(notification.NotificationTypeSoftRestartRequested, displayPath, event, "softReload "+soft)}
+		}
+	}
+
+	for patt := range w.generated {
+		if matchPattern(patt, relPath) {
+			log.Infof("generated file source: %s", displayPath)
+			return w.generatedRequests(patt, displayPath, event)
 		}
-		return
 	}
 
 	if w.envFiles != nil {
 		f := filepath.Base(filePath)
 		for _, envFile := range w.envFiles {
 			if f == envFile {
-				log.Infof("modified env file: %s", relPath)
-				callbackFn(notification.Notification{
-					ID:              notification.NextID(),
-					ChildProccessID: "",
-					Date:            time.Now(),
-					Type:            notification.NotificationTypeHardRestartRequested,
-					Message:         relPath,
-				})
-				return
+				log.Infof("modified env file: %s", displayPath)
+				requests := []notification.Notification{}
+				if diff := w.diffEnvFile(envFile, filePath); diff != "" {
+					requests = append(requests, request(notification.NotificationTypeLogEvent, fmt.Sprintf("env file %s changed, %s", displayPath, diff)))
+				}
+				return append(requests, triggered(notification.NotificationTypeHardRestartRequested, displayPath, event, "envFiles "+envFile))
 			}
 		}
 	}
 
-	log.Infof("unhandled modified file: %s", relPath)
+	log.Infof("unhandled modified file: %s", displayPath)
+	return nil
+}
+
+func isTestFile(relPath string) bool {
+	return strings.HasSuffix(relPath, "_test.go")
+}
+
+// isOutput returns true if a file is written by the tasks of a generated rule
+func (w *filesystemWatcher) isOutput(relPath string) bool {
+	for _, patt := range w.outputs {
+		if matchPattern(patt, relPath) {
+			return true
+		}
+	}
+	return false
+}
+
+// isIgnored returns true if changes to a file should not be acted on, the ignore rules are reloaded if the file
+// is a .gitignore
+func (w *filesystemWatcher) isIgnored(relPath, displayPath string) bool {
+	if w.isExcluded(relPath) {
+		log.Debugf("excluded file: %s", displayPath)
+		return true
+	}
+
+	if w.useGitignore {
+		if filepath.Base(relPath) == gitignoreFileName {
+			log.Infof("reloading ignore rules: %s", displayPath)
+			err := w.init()
+			if err != nil {
+				log.Errorf("reloading ignore rules: %v", err)
+			}
+			return true
+		}
+		if w.gitignore.isIgnored(relPath, false) {
+			log.Debugf("ignored file: %s", displayPath)
+			return true
+		}
+	}
+
+	return false
+}
+
+func (w *filesystemWatcher) applyConfig(cfg config.Config) error {
+	testFiles := cfg.Watcher.TestFiles
+	switch testFiles {
+	case "":
+		testFiles = config.TestFilesIgnore
+	case config.TestFilesIgnore, config.TestFilesTest, config.TestFilesHardReload:
+	default:
+		return fmt.Errorf("unsupported watcher.testFiles: %s", testFiles)
+	}
+
+	err := checkGeneratedChains(cfg)
+	if err != nil {
+		return err
+	}
+
+	resolver, err := utils.NewPathResolver(cfg.RootDirectory, cfg.Roots)
+	if err != nil {
+		return fmt.Errorf("resolving roots: %w", err)
+	}
+
+	goWork := ""
+	if cfg.Workspace {
+		goWork, err = utils.GoWorkFile(cfg.RootDirectory)
+		if err != nil {
+			return err
+		}
+	}
+
+	w.resolver = resolver
+	w.cfg = cfg
+	w.hardReload = cfg.HardReload
+	w.softReload = cfg.SoftReload
+	w.templates = cfg.Templates.Paths
+	w.envFiles = cfg.EnvFiles
+	w.envValues = map[string]map[string]string{}
+	for _, envFile := range cfg.EnvFiles {
+		envPath := envFile
+		if !filepath.IsAbs(envPath) {
+			envPath = filepath.Join(cfg.RootDirectory, envPath)
+		}
+		vars, err := process.ParseEnvFile(envPath)
+		if err != nil {
+			log.Warnf("reading env file %s: %v", envFile, err)
+			continue
+		}
+		w.envValues[envFile] = vars
+	}
+	w.generated = cfg.Generated
+	w.outputs = nil
+	for _, patt := range sortedKeys(cfg.Generated) {
+		w.outputs = append(w.outputs, cfg.Generated[patt].Outputs...)
+	}
+	w.pipelines = cfg.Pipelines
+	w.testFiles = testFiles
+	w.excludePaths = append(append([]string{}, defaultExcludePaths...), cfg.ExcludePaths...)
+	w.useGitignore = cfg.Watcher.UseGitignore
+	w.gitignore = nil
+	w.goModuleAware = cfg.Watcher.GoModuleAware
+	w.workspace = cfg.Workspace
+	w.goWork = goWork
+	if !w.usesBuildGraph() {
+		w.packages = nil
+	}
+
+	// misordered rules otherwise fail silently, `gomon check` reports the same findings
+	for _, finding := range Lint(cfg) {
+		log.Warnf("reload rules: %s", finding)
+	}
+
+	return nil
+}
+
+// diffEnvFile re-reads a modified env file and describes which variables changed since it was last read
+func (w *filesystemWatcher) diffEnvFile(envFile, filePath string) string {
+	vars, err := process.ParseEnvFile(filePath)
+	if err != nil {
+		log.Warnf("reading env file %s: %v", envFile, err)
+		return ""
+	}
+
+	prev := w.envValues[envFile]
+	w.envValues[envFile] = vars
+	return process.DiffEnv(prev, vars)
+}
+
+func (w *filesystemWatcher) isConfigFile(event fsnotify.Event) bool {
+	if w.cfg.ConfigPath == "" || !(event.Has(fsnotify.Write) || event.Has(fsnotify.Create)) {
+		return false
+	}
+	eventPath, _ := filepath.Abs(event.Name)
+	configPath, _ := filepath.Abs(w.cfg.ConfigPath)
+	return eventPath == configPath
+}
+
+func (w *filesystemWatcher) isInRootDirectory(filePath string) bool {
+	absPath, _ := filepath.Abs(filePath)
+	relPath, err := filepath.Rel(w.rootDirectory, absPath)
+	return err == nil && !strings.HasPrefix(relPath, "..")
+}
+
+// reloadConfig re-reads the config file and applies the new watch rules, the previous config is kept if it is invalid
+func (w *filesystemWatcher) reloadConfig(callbackFn notification.NotificationCallback) {
+	log.Infof("config file modified, reloading: %s", w.cfg.ConfigPath)
+
+	cfg, ignored, err := config.Reload(w.cfg)
+	if err != nil {
+		log.Errorf("reloading config: %v", err)
+		callbackFn(request(notification.NotificationTypeSystemError, fmt.Sprintf("reloading config, keeping previous settings: %v", err)))
+		return
+	}
+
+	if len(ignored) > 0 {
+		log.Warnf("changes to these settings require gomon to be restarted: %s", strings.Join(ignored, ", "))
+	}
+
+	err = w.applyConfig(cfg)
+	if err != nil {
+		log.Errorf("applying config: %v", err)
+		callbackFn(request(notification.NotificationTypeSystemError, fmt.Sprintf("applying config, keeping previous settings: %v", err)))
+		return
+	}
+
+	// pick up any directories which are no longer excluded
+	err = w.init()
+	if err != nil {
+		log.Errorf("updating watched directories: %v", err)
+	}
+
+	if w.onConfigChanged != nil {
+		w.onConfigChanged(cfg)
+	}
 }
 
 func (w *filesystemWatcher) init() error {
-	return filepath.Walk(w.rootDirectory, func(srcPath string, f os.FileInfo, err error) error {
+	w.watched = 0
+	w.unwatched = nil
+
+	if w.usesBuildGraph() && w.packages == nil {
+		packages, err := w.packageLister()()
+		if err != nil {
+			// everything is watched and hard reloaded until the build graph can be listed
+			log.Warnf("listing packages, watching all directories: %v", err)
+		} else if w.goModuleAware {
+			log.Infof("watching %d package directories in the build graph", len(packages))
+			w.packages = packages
+		} else {
+			log.Infof("hard reloading for %d package directories in the workspace build graph", len(packages))
+			w.packages = packages
+		}
+	}
+
+	if w.useGitignore {
+		err := w.loadGitignore()
+		if err != nil {
+			return fmt.Errorf("loading .gitignore: %w", err)
+		}
+	}
+
+	for _, root := range w.resolver.Roots() {
+		if root.Name != "" && w.isInRootDirectory(root.Path) {
+			// already covered by the walk of the main root directory
+			continue
+		}
+		err := w.watchDirectory(root.Path)
+		if err != nil {
+			return fmt.Errorf("watching %s: %w", root.Path, err)
+		}
+	}
+
+	if len(w.unwatched) > 0 {
+		log.Warn(w.watchLimitMessage())
+	}
+	if w.poller != nil {
+		w.poller.setDirectories(w.unwatched)
+	}
+	w.watchedCount.Store(int64(w.watched))
+	w.unwatchedCount.Store(int64(len(w.unwatched)))
+
+	return nil
+}
+
+// watchLimitMessage describes the directories which aren't being watched and how to fix it
+func (w *filesystemWatcher) watchLimitMessage() string {
+	outcome := "changes to them aren't detected"
+	if w.poller != nil {
+		outcome = "they are polled instead"
+	}
+	return fmt.Sprintf("the inotify watch limit was reached after watching %d directories, %d more (e.g. %s) aren't watched and %s, "+
+		"add directories which don't need to be watched to excludePaths or raise fs.inotify.max_user_watches (see `gomon doctor`)",
+		w.watched, len(w.unwatched), w.resolver.Display(w.unwatched[0]), outcome)
+}
+
+func (w *filesystemWatcher) watchDirectory(dir string) error {
+	return filepath.Walk(dir, func(srcPath string, f os.FileInfo, err error) error {
 		if err != nil {
 			return err
 		}
@@ -218,11 +594,48 @@ func (w *filesystemWatcher) init() error {
 					break
 				}
 			}
+			if relPath, err := filepath.Rel(w.rootDirectory, srcPath); err == nil && relPath != "." {
+				isExcluded = isExcluded || w.isExcludedByPattern(relPath) || w.gitignore.isIgnored(relPath, true)
+			}
 			if isExcluded {
 				return filepath.SkipDir
 			}
-			return w.watcher.Add(srcPath)
+			if !w.isRelevantDirectory(srcPath) {
+				// the directories beneath it may still be relevant
+				return nil
+			}
+			err := w.driver.Add(srcPath)
+			if isWatchLimitError(err) {
+				// carry on so that every directory which isn't watched is known
+				w.unwatched = append(w.unwatched, srcPath)
+				return nil
+			}
+			if err != nil {
+				return err
+			}
+			w.watched++
 		}
 		return nil
 	})
 }
+
+// triggered is a restart request caused by a change to the file at displayPath which matched rule
+func triggered(notifType notification.NotificationType, displayPath string, event fsnotify.Event, rule string) notification.Notification {
+	n := request(notifType, displayPath)
+	n.Trigger = &notification.Trigger{
+		Path:  displayPath,
+		Rule:  rule,
+		Event: event.Op.String(),
+	}
+	return n
+}
+
+func request(notifType notification.NotificationType, message string) notification.Notification {
+	return notification.Notification{
+		ID:              notification.NextID(),
+		ChildProccessID: "",
+		Date:            time.Now(),
+		Type:            notifType,
+		Message:         message,
+	}
+}
@@ -0,0 +1,322 @@
+package watcher
+
+// gomon is a simple command line tool that watches your files and automatically restarts the application when it detects any changes in the working directory.
+// Copyright (C) 2023 John Dudmesh
+
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+
+// You should have received a copy of the GNU General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>.
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/fsnotify/fsnotify"
+	"github.com/jdudmesh/gomon/internal/config"
+	"github.com/jdudmesh/gomon/internal/notification"
+)
+
+func testConfig(t *testing.T) config.Config {
+	return config.Config{
+		RootDirectory: t.TempDir(),
+		HardReload:    []string{"*.go"},
+		SoftReload:    []string{"*.html"},
+		EnvFiles:      []string{".env"},
+		ExcludePaths:  []string{"vendor"},
+		Generated: map[string]config.GeneratedRule{
+			"*.templ": {Tasks: []string{"templ generate", "__hard_reload"}},
+		},
+		Pipelines: map[string][]string{
+			"migrations/*.sql": {"sqlc generate", "__hard_reload"},
+		},
+	}
+}
+
+func TestParseScript(t *testing.T) {
+	script, err := ParseScript("/app", strings.NewReader("# comment\n\nwrite main.go\nsleep 10ms\ncreate views/index.html\n"))
+	if err != nil {
+		t.Fatalf("parsing script: %v", err)
+	}
+
+	if len(script) != 3 {
+		t.Fatalf("expected 3 steps, got %d", len(script))
+	}
+	if script[0].Event.Name != "/app/main.go" || script[0].Event.Op.String() != "WRITE" {
+		t.Errorf("unexpected first step: %+v", script[0])
+	}
+	if script[1].Delay.Milliseconds() != 10 {
+		t.Errorf("expected sleep of 10ms, got %v", script[1].Delay)
+	}
+
+	_, err = ParseScript("/app", strings.NewReader("touch main.go\n"))
+	if err == nil {
+		t.Error("expected error for unknown op")
+	}
+}
+
+func TestWatchWithSimulationDriver(t *testing.T) {
+	cfg := testConfig(t)
+
+	script, err := ParseScript(cfg.RootDirectory, strings.NewReader(`
+write main.go
+write views/index.html
+write vendor/lib.go
+create other.go
+write views/index.templ
+write migrations/001_init.sql
+write .env
+write README.md
+`))
+	if err != nil {
+		t.Fatalf("parsing script: %v", err)
+	}
+
+	w, err := New(cfg, WithDriver(NewSimulationDriver(script)))
+	if err != nil {
+		t.Fatalf("creating watcher: %v", err)
+	}
+	defer w.Close()
+
+	received := []notification.Notification{}
+	err = w.Watch(func(n notification.Notification) error {
+		received = append(received, n)
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("watching: %v", err)
+	}
+
+	expected := []struct {
+		notifType notification.NotificationType
+		message   string
+	}{
+		{notification.NotificationTypeHardRestartRequested, "main.go"},
+		{notification.NotificationTypeSoftRestartRequested, "views/index.html"},
+		{notification.NotificationTypeOOBTaskRequested, "templ generate"},
+		{notification.NotificationTypeHardRestartRequested, "views/index.templ"},
+		{notification.NotificationTypePipelineRequested, "migrations/*.sql"},
+		{notification.NotificationTypeHardRestartRequested, ".env"},
+	}
+
+	if len(received) != len(expected) {
+		t.Fatalf("expected %d notifications, got %d: %+v", len(expected), len(received), received)
+	}
+	for i, e := range expected {
+		if received[i].Type != e.notifType || received[i].Message != e.message {
+			t.Errorf("notification %d: expected %s %s, got %s %s", i, e.notifType, e.message, received[i].Type, received[i].Message)
+		}
+	}
+}
+
+func TestSimulate(t *testing.T) {
+	cfg := testConfig(t)
+
+	out := &bytes.Buffer{}
+	err := Simulate(cfg, strings.NewReader("write views/index.html\nwrite README.md\n"), out)
+	if err != nil {
+		t.Fatalf("simulating: %v", err)
+	}
+
+	expected := "write views/index.html\n  soft restart: views/index.html\nwrite README.md\n  no action\n"
+	if out.String() != expected {
+		t.Errorf("unexpected output:\n%s", out.String())
+	}
+}
+
+func TestConfigReload(t *testing.T) {
+	cfg := testConfig(t)
+	cfg.ConfigPath = filepath.Join(cfg.RootDirectory, config.DefaultConfigFileName)
+
+	err := os.WriteFile(cfg.ConfigPath, []byte("softReload: [\"*.txt\"]\nexcludePaths: [\"tmp\"]\n"), 0644)
+	if err != nil {
+		t.Fatalf("writing config: %v", err)
+	}
+
+	script, err := ParseScript(cfg.RootDirectory, strings.NewReader("write notes.txt\nwrite gomon.config.yml\nwrite notes.txt\nwrite vendor/lib.go\n"))
+	if err != nil {
+		t.Fatalf("parsing script: %v", err)
+	}
+
+	var reloaded *config.Config
+	w, err := New(cfg, WithDriver(NewSimulationDriver(script)), WithConfigChangeHandler(func(next config.Config) {
+		reloaded = &next
+	}))
+	if err != nil {
+		t.Fatalf("creating watcher: %v", err)
+	}
+	defer w.Close()
+
+	received := []notification.Notification{}
+	err = w.Watch(func(n notification.Notification) error {
+		received = append(received, n)
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("watching: %v", err)
+	}
+
+	if reloaded == nil {
+		t.Fatal("expected config change handler to be called")
+	}
+	if reloaded.RootDirectory != cfg.RootDirectory {
+		t.Errorf("expected root directory to be kept, got %s", reloaded.RootDirectory)
+	}
+
+	// vendor is no longer excluded but *.go files no longer trigger a hard restart
+	if len(received) != 1 {
+		t.Fatalf("expected 1 notification, got %d: %+v", len(received), received)
+	}
+	if received[0].Type != notification.NotificationTypeSoftRestartRequested || received[0].Message != "notes.txt" {
+		t.Errorf("unexpected notification: %s %s", received[0].Type, received[0].Message)
+	}
+}
+
+func TestEnvFileChangesAreReported(t *testing.T) {
+	cfg := testConfig(t)
+	envPath := filepath.Join(cfg.RootDirectory, ".env")
+	err := os.WriteFile(envPath, []byte("PORT=8080\nSECRET=one\n"), 0644)
+	if err != nil {
+		t.Fatalf("writing env file: %v", err)
+	}
+
+	w, err := New(cfg)
+	if err != nil {
+		t.Fatalf("creating watcher: %v", err)
+	}
+
+	err = os.WriteFile(envPath, []byte("PORT=8080\nSECRET=two\nDEBUG=true\n"), 0644)
+	if err != nil {
+		t.Fatalf("writing env file: %v", err)
+	}
+
+	requests := w.actions(fsnotify.Event{Name: envPath, Op: fsnotify.Write})
+	if len(requests) != 2 {
+		t.Fatalf("expected 2 requests, got %+v", requests)
+	}
+	if requests[0].Type != notification.NotificationTypeLogEvent || requests[0].Message != "env file .env changed, added: DEBUG; changed: SECRET" {
+		t.Errorf("unexpected change notification: %s %s", requests[0].Type, requests[0].Message)
+	}
+	if strings.Contains(requests[0].Message, "two") {
+		t.Error("expected values to be redacted")
+	}
+	if requests[1].Type != notification.NotificationTypeHardRestartRequested {
+		t.Errorf("expected a hard restart, got %s", requests[1].Type)
+	}
+	if trigger := requests[1].Trigger; trigger == nil || trigger.Path != ".env" || trigger.Rule != "envFiles .env" || trigger.Event != "WRITE" {
+		t.Errorf("unexpected trigger: %+v", trigger)
+	}
+}
+
+func TestTemplateChanges(t *testing.T) {
+	cfg := testConfig(t)
+	cfg.Templates.Paths = []string{"views/**/*.html"}
+
+	w, err := New(cfg)
+	if err != nil {
+		t.Fatalf("creating watcher: %v", err)
+	}
+
+	requests := w.actions(fsnotify.Event{Name: filepath.Join(cfg.RootDirectory, "views", "layouts", "base.html"), Op: fsnotify.Write})
+	if len(requests) != 1 || requests[0].Type != notification.NotificationTypeSoftRestartRequested {
+		t.Fatalf("expected a soft restart, got %+v", requests)
+	}
+	if trigger := requests[0].Trigger; trigger == nil || trigger.Rule != "templates views/**/*.html" {
+		t.Errorf("expected the templates rule to win over softReload, got %+v", trigger)
+	}
+}
+
+func TestTestFileChanges(t *testing.T) {
+	tests := []struct {
+		testFiles string
+		mode      string
+		want      notification.NotificationType
+	}{
+		{"", config.ModeServe, -1},
+		{config.TestFilesTest, config.ModeServe, notification.NotificationTypeTestRequested},
+		{config.TestFilesHardReload, config.ModeServe, notification.NotificationTypeHardRestartRequested},
+		{"", config.ModeTest, notification.NotificationTypeHardRestartRequested},
+	}
+	for _, tt := range tests {
+		cfg := testConfig(t)
+		cfg.Mode = tt.mode
+		cfg.Watcher.TestFiles = tt.testFiles
+
+		w, err := New(cfg)
+		if err != nil {
+			t.Fatalf("creating watcher: %v", err)
+		}
+
+		requests := w.actions(fsnotify.Event{Name: filepath.Join(cfg.RootDirectory, "pkg", "server_test.go"), Op: fsnotify.Write})
+		if tt.want == -1 {
+			if len(requests) != 0 {
+				t.Errorf("%s: expected test files to be ignored, got %+v", tt.testFiles, requests)
+			}
+			continue
+		}
+		if len(requests) != 1 || requests[0].Type != tt.want || requests[0].Message != "pkg/server_test.go" {
+			t.Errorf("%s %s: expected %s, got %+v", tt.mode, tt.testFiles, tt.want, requests)
+		}
+	}
+
+	cfg := testConfig(t)
+	cfg.Watcher.TestFiles = "sometimes"
+	if _, err := New(cfg); err == nil {
+		t.Error("expected an error for an unsupported action")
+	}
+}
+
+func TestChangesWhileTasksRunAreIgnored(t *testing.T) {
+	cfg := testConfig(t)
+	cfg.Generated["*.templ"] = config.GeneratedRule{Tasks: []string{"templ generate"}, Outputs: []string{"*_templ.go"}}
+
+	w, err := New(cfg)
+	if err != nil {
+		t.Fatalf("creating watcher: %v", err)
+	}
+
+	write := func(name string) []notification.Notification {
+		return w.actions(fsnotify.Event{Name: filepath.Join(cfg.RootDirectory, name), Op: fsnotify.Write})
+	}
+
+	if requests := write("index_templ.go"); len(requests) != 0 {
+		t.Errorf("expected generated outputs to be ignored, got %+v", requests)
+	}
+	if requests := write("main.go"); len(requests) != 1 {
+		t.Fatalf("expected a hard restart, got %+v", requests)
+	}
+
+	w.Notify(notification.Notification{Type: notification.NotificationTypeOOBTaskStartup, TaskID: "1", Date: time.Now()})
+	w.Notify(notification.Notification{Type: notification.NotificationTypeOOBTaskStartup, Date: time.Now()})
+	if requests := write("main.go"); len(requests) != 0 {
+		t.Errorf("expected changes to be ignored while tasks run, got %+v", requests)
+	}
+
+	// the build has no task ID
+	w.Notify(notification.Notification{Type: notification.NotificationTypeOOBTaskComplete, Date: time.Now().Add(-taskSettleTime)})
+	if requests := write("main.go"); len(requests) != 0 {
+		t.Errorf("expected changes to be ignored while a task runs, got %+v", requests)
+	}
+
+	w.Notify(notification.Notification{Type: notification.NotificationTypeOOBTaskComplete, TaskID: "1", Date: time.Now()})
+	if requests := write("main.go"); len(requests) != 0 {
+		t.Errorf("expected changes to be ignored just after a task completes, got %+v", requests)
+	}
+
+	w.Notify(notification.Notification{Type: notification.NotificationTypeOOBTaskComplete, TaskID: "1", Date: time.Now().Add(-taskSettleTime)})
+	if requests := write("main.go"); len(requests) != 1 {
+		t.Errorf("expected a hard restart once tasks have finished, got %+v", requests)
+	}
+}
@@ -0,0 +1,200 @@
+package webui
+
+// gomon is a simple command line tool that watches your files and automatically restarts the application when it detects any changes in the working directory.
+// Copyright (C) 2023 John Dudmesh
+
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+
+// You should have received a copy of the GNU General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>.
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/a-h/templ"
+	"github.com/jdudmesh/gomon/internal/utils"
+)
+
+// sgrPattern matches the escape sequences which set colours and text styles
+var sgrPattern = regexp.MustCompile(`\x1b\[([0-9;]*)m`)
+
+// ansiPalette is the standard and bright colours, chosen to be readable on the dark background of the UI
+var ansiPalette = [16]string{
+	"#4b5563", "#f87171", "#4ade80", "#facc15", "#60a5fa", "#e879f9", "#22d3ee", "#e5e7eb",
+	"#9ca3af", "#fca5a5", "#86efac", "#fde047", "#93c5fd", "#f0abfc", "#67e8f9", "#ffffff",
+}
+
+type ansiStyle struct {
+	fg        string
+	bg        string
+	bold      bool
+	dim       bool
+	italic    bool
+	underline bool
+}
+
+func (s ansiStyle) css() string {
+	rules := []string{}
+	if s.fg != "" {
+		rules = append(rules, "color:"+s.fg)
+	}
+	if s.bg != "" {
+		rules = append(rules, "background-color:"+s.bg)
+	}
+	if s.bold {
+		rules = append(rules, "font-weight:bold")
+	}
+	if s.dim {
+		rules = append(rules, "opacity:0.7")
+	}
+	if s.italic {
+		rules = append(rules, "font-style:italic")
+	}
+	if s.underline {
+		rules = append(rules, "text-decoration:underline")
+	}
+	return strings.Join(rules, ";")
+}
+
+// apply updates the style with the parameters of an SGR sequence
+func (s ansiStyle) apply(params string) ansiStyle {
+	codes := []int{}
+	for _, p := range strings.Split(params, ";") {
+		n, err := strconv.Atoi(p)
+		if err != nil {
+			// an empty parameter is the same as 0
+			n = 0
+		}
+		codes = append(codes, n)
+	}
+
+	for i := 0; i < len(codes); i++ {
+		switch c := codes[i]; {
+		case c == 0:
+			s = ansiStyle{}
+		case c == 1:
+			s.bold = true
+		case c == 2:
+			s.dim = true
+		case c == 3:
+			s.italic = true
+		case c == 4:
+			s.underline = true
+		case c == 22:
+			s.bold, s.dim = false, false
+		case c == 23:
+			s.italic = false
+		case c == 24:
+			s.underline = false
+		case c >= 30 && c <= 37:
+			s.fg = ansiPalette[c-30]
+		case c >= 90 && c <= 97:
+			s.fg = ansiPalette[c-90+8]
+		case c == 39:
+			s.fg = ""
+		case c >= 40 && c <= 47:
+			s.bg = ansiPalette[c-40]
+		case c >= 100 && c <= 107:
+			s.bg = ansiPalette[c-100+8]
+		case c == 49:
+			s.bg = ""
+		case c == 38 || c == 48:
+			colour, n := extendedColour(codes[i+1:])
+			i += n
+			if c == 38 {
+				s.fg = colour
+			} else {
+				s.bg = colour
+			}
+		}
+	}
+
+	return s
+}
+
+// extendedColour parses a 256 colour (5;n) or true colour (2;r;g;b) parameter, it returns the colour and the
+// number of parameters used
+func extendedColour(codes []int) (string, int) {
+	switch {
+	case len(codes) >= 2 && codes[0] == 5:
+		return colour256(codes[1]), 2
+	case len(codes) >= 4 && codes[0] == 2:
+		return fmt.Sprintf("#%02x%02x%02x", codes[1]&0xff, codes[2]&0xff, codes[3]&0xff), 4
+	}
+	return "", len(codes)
+}
+
+func colour256(n int) string {
+	switch {
+	case n < 0 || n > 255:
+		return ""
+	case n < 16:
+		return ansiPalette[n]
+	case n < 232:
+		// 6x6x6 colour cube
+		level := func(v int) int {
+			if v == 0 {
+				return 0
+			}
+			return 55 + v*40
+		}
+		n -= 16
+		return fmt.Sprintf("#%02x%02x%02x", level(n/36), level(n/6%6), level(n%6))
+	default:
+		grey := 8 + (n-232)*10
+		return fmt.Sprintf("#%02x%02x%02x", grey, grey, grey)
+	}
+}
+
+// ansiToHTML escapes the message and converts its colour codes into styled spans
+func ansiToHTML(msg string) string {
+	matches := sgrPattern.FindAllStringSubmatchIndex(msg, -1)
+	if len(matches) == 0 {
+		return templ.EscapeString(utils.StripANSI(msg))
+	}
+
+	b := strings.Builder{}
+	style := ansiStyle{}
+	writeText := func(text string) {
+		if text == "" {
+			return
+		}
+		text = templ.EscapeString(utils.StripANSI(text))
+		if css := style.css(); css != "" {
+			b.WriteString(`<span style="` + css + `">` + text + "</span>")
+		} else {
+			b.WriteString(text)
+		}
+	}
+
+	pos := 0
+	for _, m := range matches {
+		writeText(msg[pos:m[0]])
+		style = style.apply(msg[m[2]:m[3]])
+		pos = m[1]
+	}
+	writeText(msg[pos:])
+
+	return b.String()
+}
+
+// ansiMessage renders a line of output, colour codes are shown as colours rather than escape sequences
+func ansiMessage(msg string) templ.Component {
+	return templ.ComponentFunc(func(ctx context.Context, w io.Writer) error {
+		_, err := io.WriteString(w, ansiToHTML(msg))
+		return err
+	})
+}
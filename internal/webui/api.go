@@ -0,0 +1,377 @@
+package webui
+
+// gomon is a simple command line tool that watches your files and automatically restarts the application when it detects any changes in the working directory.
+// Copyright (C) 2023 John Dudmesh
+
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+
+// You should have received a copy of the GNU General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>.
+
+import (
+	"bytes"
+	"context"
+	"crypto/rand"
+	"crypto/subtle"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"html"
+	"net/http"
+	"os"
+	"path"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/jdudmesh/gomon/internal/auth"
+	"github.com/jdudmesh/gomon/internal/config"
+	"github.com/jdudmesh/gomon/internal/notification"
+	"github.com/jdudmesh/gomon/internal/process"
+)
+
+const (
+	apiTokenFileName = "api_token"
+	// tokenCookieName holds the token the UI was opened with when ui.requireToken is set
+	tokenCookieName = "gomon_token"
+)
+
+type scopesKey struct{}
+
+type TriggerRequest struct {
+	Type  string   `json:"type"`
+	Paths []string `json:"paths"`
+	Task  string   `json:"task"`
+}
+
+// loadAPIToken returns the configured token, or generates one and writes it to the .gomon directory so that
+// local tools can read it
+func loadAPIToken(rootDirectory, configured string) (string, error) {
+	if configured != "" {
+		return configured, nil
+	}
+
+	buf := make([]byte, 16)
+	_, err := rand.Read(buf)
+	if err != nil {
+		return "", fmt.Errorf("generating api token: %w", err)
+	}
+	token := hex.EncodeToString(buf)
+
+	dataPath := path.Join(rootDirectory, ".gomon")
+	err = os.MkdirAll(dataPath, 0755)
+	if err != nil {
+		return "", fmt.Errorf("creating .gomon directory: %w", err)
+	}
+
+	err = os.WriteFile(path.Join(dataPath, apiTokenFileName), []byte(token), 0600)
+	if err != nil {
+		return "", fmt.Errorf("writing api token: %w", err)
+	}
+
+	return token, nil
+}
+
+// loadAuth resolves the ui.auth credentials, if any are set the UI and its streams require them in the same way as
+// ui.requireToken
+func (c *server) loadAuth(cfg config.Config) error {
+	if (cfg.UI.Auth.Username == "") != (cfg.UI.Auth.Password == "") {
+		return errors.New("ui.auth requires both a username and a password for basic auth")
+	}
+
+	secrets := process.NewSecretResolver()
+	var err error
+	c.authToken, err = secrets.Resolve(cfg.UI.Auth.Token)
+	if err != nil {
+		return fmt.Errorf("resolving ui.auth.token: %w", err)
+	}
+	c.authUsername = cfg.UI.Auth.Username
+	c.authPassword, err = secrets.Resolve(cfg.UI.Auth.Password)
+	if err != nil {
+		return fmt.Errorf("resolving ui.auth.password: %w", err)
+	}
+
+	if c.authToken != "" || c.authUsername != "" {
+		c.requireToken = true
+	}
+	return nil
+}
+
+// challenge asks the browser for the basic auth credentials when they are configured
+func (c *server) challenge(w http.ResponseWriter) {
+	if c.authUsername != "" {
+		w.Header().Set("WWW-Authenticate", `Basic realm="gomon", charset="UTF-8"`)
+	}
+}
+
+// injectToken adds the ui.auth token to the page so that the client bundle can send it with its own requests,
+// the page is only served to requests which are already authorized
+func injectToken(page []byte, token string) []byte {
+	if token == "" {
+		return page
+	}
+	meta := `<meta name="gomon-token" content="` + html.EscapeString(token) + `" />` + "\n  </head>"
+	return bytes.Replace(page, []byte("</head>"), []byte(meta), 1)
+}
+
+// authorize returns the scopes granted to the token sent with a request. The token is read from the Authorization
+// header, GET requests can also pass it as ?token= (the browser's EventSource and WebSocket can't set headers) and
+// the UI sends the cookie which is set when it is opened with a token.
+func (c *server) authorize(r *http.Request) ([]auth.Scope, bool) {
+	if username, password, ok := r.BasicAuth(); ok && c.authUsername != "" {
+		return auth.AllScopes, subtle.ConstantTimeCompare([]byte(username), []byte(c.authUsername)) == 1 &&
+			subtle.ConstantTimeCompare([]byte(password), []byte(c.authPassword)) == 1
+	}
+
+	token := strings.TrimPrefix(r.Header.Get("Authorization"), "Bearer ")
+	if token == "" && r.Method == http.MethodGet {
+		token = r.URL.Query().Get("token")
+	}
+	if token == "" {
+		if cookie, err := r.Cookie(tokenCookieName); err == nil {
+			token = cookie.Value
+		}
+	}
+	if token == "" {
+		return nil, false
+	}
+
+	// the API token from the config file or .gomon/api_token and the ui.auth token can do anything
+	if subtle.ConstantTimeCompare([]byte(token), []byte(c.apiToken)) == 1 {
+		return auth.AllScopes, true
+	}
+	if c.authToken != "" && subtle.ConstantTimeCompare([]byte(token), []byte(c.authToken)) == 1 {
+		return auth.AllScopes, true
+	}
+	return c.tokens.Authorize(token)
+}
+
+// withScope rejects requests without a token which grants scope, an empty scope only requires a valid token and
+// leaves the handler to check the scopes with requestHasScope
+func (c *server) withScope(scope auth.Scope, next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		scopes, ok := c.authorize(r)
+		if !ok {
+			c.challenge(w)
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		if scope != "" && !auth.HasScope(scopes, scope) {
+			writeJSONError(w, fmt.Sprintf("token does not have the %s scope", scope), http.StatusForbidden)
+			return
+		}
+		next.ServeHTTP(w, r.WithContext(context.WithValue(r.Context(), scopesKey{}, scopes)))
+	})
+}
+
+// withUIScope protects the endpoints used by the UI when ui.requireToken or ui.auth is set, otherwise they are open
+func (c *server) withUIScope(scope auth.Scope, next http.Handler) http.Handler {
+	if !c.requireToken {
+		return withCORS(next)
+	}
+	return withCORS(c.withScope(scope, next))
+}
+
+func requestHasScope(r *http.Request, scope auth.Scope) bool {
+	scopes, _ := r.Context().Value(scopesKey{}).([]auth.Scope)
+	return auth.HasScope(scopes, scope)
+}
+
+// triggerHandler allows external tools (IDE hooks, code generators etc.) to drive the reload pipeline
+func (c *server) triggerHandler(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		w.WriteHeader(http.StatusMethodNotAllowed)
+		return
+	}
+
+	req := TriggerRequest{}
+	err := json.NewDecoder(r.Body).Decode(&req)
+	if err != nil {
+		http.Error(w, fmt.Sprintf("decoding request: %v", err), http.StatusBadRequest)
+		return
+	}
+
+	scope := auth.ScopeControlRestart
+	if req.Type == "task" {
+		scope = auth.ScopeControlTasks
+	}
+	if !requestHasScope(r, scope) {
+		writeJSONError(w, fmt.Sprintf("token does not have the %s scope", scope), http.StatusForbidden)
+		return
+	}
+
+	notifs := []notification.Notification{}
+	switch req.Type {
+	case "hard":
+		notifs = append(notifs, c.triggerNotification(notification.NotificationTypeHardRestartRequested, hint(req.Paths)))
+	case "soft":
+		// the hint is passed on to the child process so send one per path
+		if len(req.Paths) == 0 {
+			req.Paths = []string{"api"}
+		}
+		for _, p := range req.Paths {
+			notifs = append(notifs, c.triggerNotification(notification.NotificationTypeSoftRestartRequested, p))
+		}
+	case "task":
+		if req.Task == "" {
+			http.Error(w, "task is required", http.StatusBadRequest)
+			return
+		}
+		if !slices.Contains(c.tasks, req.Task) {
+			http.Error(w, fmt.Sprintf("unknown task: %s", req.Task), http.StatusNotFound)
+			return
+		}
+		notifs = append(notifs, c.triggerNotification(notification.NotificationTypeOOBTaskRequested, req.Task))
+	default:
+		http.Error(w, fmt.Sprintf("unknown trigger type: %s", req.Type), http.StatusBadRequest)
+		return
+	}
+
+	err = c.dispatch(notifs...)
+	if err != nil {
+		log.Errorf("triggering %s: %v", req.Type, err)
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+
+	w.WriteHeader(http.StatusAccepted)
+}
+
+// restartHandler requests a hard or soft restart e.g. POST /api/restart?type=soft
+func (c *server) restartHandler(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		w.WriteHeader(http.StatusMethodNotAllowed)
+		return
+	}
+
+	var n notification.Notification
+	switch restartType := r.URL.Query().Get("type"); restartType {
+	case "", "hard":
+		n = c.triggerNotification(notification.NotificationTypeHardRestartRequested, "api")
+	case "soft":
+		n = c.triggerNotification(notification.NotificationTypeSoftRestartRequested, "api")
+	default:
+		writeJSONError(w, fmt.Sprintf("unknown restart type: %s", restartType), http.StatusBadRequest)
+		return
+	}
+
+	c.writeDispatched(w, n)
+}
+
+// taskHandler runs one of the named tasks from the config file e.g. POST /api/tasks/migrate, or cancels a running
+// task by its ID
+func (c *server) taskHandler(w http.ResponseWriter, r *http.Request) {
+	task := strings.TrimPrefix(r.URL.Path, "/api/tasks/")
+	if task == "" {
+		writeJSONError(w, "task is required", http.StatusBadRequest)
+		return
+	}
+
+	switch r.Method {
+	case http.MethodPost:
+		if !slices.Contains(c.tasks, task) {
+			writeJSONError(w, fmt.Sprintf("unknown task: %s", task), http.StatusNotFound)
+			return
+		}
+		c.writeDispatched(w, c.triggerNotification(notification.NotificationTypeOOBTaskRequested, task))
+	case http.MethodDelete:
+		// a running task is cancelled by its ID, which is the task ID of its events
+		c.writeDispatched(w, c.triggerNotification(notification.NotificationTypeOOBTaskCancelRequested, task))
+	default:
+		w.WriteHeader(http.StatusMethodNotAllowed)
+	}
+}
+
+// runsHandler lists the most recent runs of the child process along with their labels, ?label= only lists the runs
+// with the label
+func (c *server) runsHandler(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		w.WriteHeader(http.StatusMethodNotAllowed)
+		return
+	}
+
+	runs, err := c.db.FindRuns(r.URL.Query().Get("label"))
+	if err != nil {
+		log.Errorf("finding runs: %v", err)
+		writeJSONError(w, "finding runs", http.StatusInternalServerError)
+		return
+	}
+
+	runIDs := make([]string, 0, len(runs))
+	for _, run := range runs {
+		runIDs = append(runIDs, run.ChildProccessID)
+	}
+	labels, err := c.db.FindRunLabels(runIDs)
+	if err != nil {
+		log.Errorf("finding labels: %v", err)
+		writeJSONError(w, "finding labels", http.StatusInternalServerError)
+		return
+	}
+
+	res := make([]apiRun, 0, len(runs))
+	for _, run := range runs {
+		res = append(res, apiRun{Notification: run, Labels: append([]string{}, labels[run.ChildProccessID]...)})
+	}
+	writeJSON(w, http.StatusOK, res)
+}
+
+// writeDispatched passes the request on to the app and returns it so that callers can correlate it with later events
+func (c *server) writeDispatched(w http.ResponseWriter, n notification.Notification) {
+	err := c.dispatch(n)
+	if err != nil {
+		log.Errorf("dispatching %s: %v", n.Type.String(), err)
+		writeJSONError(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	writeJSON(w, http.StatusAccepted, n)
+}
+
+func (c *server) dispatch(notifs ...notification.Notification) error {
+	for _, n := range notifs {
+		err := c.callbackFn(n)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func writeJSON(w http.ResponseWriter, status int, v any) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	err := json.NewEncoder(w).Encode(v)
+	if err != nil {
+		log.Errorf("encoding response: %v", err)
+	}
+}
+
+func writeJSONError(w http.ResponseWriter, message string, status int) {
+	writeJSON(w, status, map[string]string{"error": message})
+}
+
+func (c *server) triggerNotification(notifType notification.NotificationType, message string) notification.Notification {
+	return notification.Notification{
+		ID:              notification.NextID(),
+		Date:            time.Now(),
+		ChildProccessID: c.currentChildProcessID,
+		Type:            notifType,
+		Message:         message,
+	}
+}
+
+func hint(paths []string) string {
+	if len(paths) == 0 {
+		return "api"
+	}
+	return strings.Join(paths, ",")
+}
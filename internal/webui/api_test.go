@@ -0,0 +1,327 @@
+package webui
+
+// gomon is a simple command line tool that watches your files and automatically restarts the application when it detects any changes in the working directory.
+// Copyright (C) 2023 John Dudmesh
+
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+
+// You should have received a copy of the GNU General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>.
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/jdudmesh/gomon/internal/auth"
+	"github.com/jdudmesh/gomon/internal/config"
+	"github.com/jdudmesh/gomon/internal/notification"
+)
+
+const testAPIToken = "test-api-token"
+
+// newTestServer creates a UI server with a known API token and a "migrate" task, the notifications it dispatches are
+// appended to the returned slice
+func newTestServer(t *testing.T, configure func(cfg *config.Config)) (*server, *[]notification.Notification) {
+	t.Helper()
+
+	cfg := config.Config{
+		RootDirectory: t.TempDir(),
+		Tasks:         map[string]string{"migrate": "go run ./cmd/migrate"},
+	}
+	cfg.UI.Enabled = true
+	cfg.UI.APIToken = testAPIToken
+	if configure != nil {
+		configure(&cfg)
+	}
+
+	dispatched := []notification.Notification{}
+	srv, err := New(cfg, nil, nil, func(n notification.Notification) error {
+		dispatched = append(dispatched, n)
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("creating server: %v", err)
+	}
+	return srv, &dispatched
+}
+
+// createToken creates a token with a single scope in the server's token store
+func createToken(t *testing.T, srv *server, scope auth.Scope) string {
+	t.Helper()
+
+	secret, _, err := auth.NewStore(srv.rootDirectory).Create(string(scope), []auth.Scope{scope})
+	if err != nil {
+		t.Fatalf("creating token: %v", err)
+	}
+	return secret
+}
+
+func serve(handler http.Handler, method, target string, configure func(r *http.Request)) *httptest.ResponseRecorder {
+	req := httptest.NewRequest(method, target, nil)
+	if configure != nil {
+		configure(req)
+	}
+	rec := httptest.NewRecorder()
+	handler.ServeHTTP(rec, req)
+	return rec
+}
+
+func bearer(token string) func(r *http.Request) {
+	return func(r *http.Request) {
+		r.Header.Set("Authorization", "Bearer "+token)
+	}
+}
+
+func cookie(token string) func(r *http.Request) {
+	return func(r *http.Request) {
+		r.AddCookie(&http.Cookie{Name: tokenCookieName, Value: token})
+	}
+}
+
+func basicAuth(username, password string) func(r *http.Request) {
+	return func(r *http.Request) {
+		r.SetBasicAuth(username, password)
+	}
+}
+
+var okHandler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+	if !requestHasScope(r, auth.ScopeControlTasks) {
+		w.WriteHeader(http.StatusTeapot)
+	}
+})
+
+func TestWithScope(t *testing.T) {
+	srv, _ := newTestServer(t, func(cfg *config.Config) {
+		cfg.UI.Auth.Username = "admin"
+		cfg.UI.Auth.Password = "secret"
+	})
+	readOnly := createToken(t, srv, auth.ScopeReadEvents)
+	tasks := createToken(t, srv, auth.ScopeControlTasks)
+	handler := srv.withScope(auth.ScopeControlTasks, okHandler)
+
+	tests := []struct {
+		name      string
+		method    string
+		target    string
+		configure func(r *http.Request)
+		status    int
+	}{
+		{"no credentials", http.MethodPost, "/", nil, http.StatusUnauthorized},
+		{"unknown token", http.MethodPost, "/", bearer("wrong"), http.StatusUnauthorized},
+		{"api token", http.MethodPost, "/", bearer(testAPIToken), http.StatusOK},
+		{"scoped token", http.MethodPost, "/", bearer(tasks), http.StatusOK},
+		{"token without scope", http.MethodPost, "/", bearer(readOnly), http.StatusForbidden},
+		{"query token", http.MethodGet, "/?token=" + tasks, nil, http.StatusOK},
+		// only GET requests can pass the token in the URL
+		{"query token on POST", http.MethodPost, "/?token=" + tasks, nil, http.StatusUnauthorized},
+		{"cookie", http.MethodPost, "/", cookie(tasks), http.StatusOK},
+		{"unknown cookie", http.MethodPost, "/", cookie("wrong"), http.StatusUnauthorized},
+		{"cookie without scope", http.MethodPost, "/", cookie(readOnly), http.StatusForbidden},
+		{"basic auth", http.MethodPost, "/", basicAuth("admin", "secret"), http.StatusOK},
+		{"wrong password", http.MethodPost, "/", basicAuth("admin", "wrong"), http.StatusUnauthorized},
+		{"wrong username", http.MethodPost, "/", basicAuth("root", "secret"), http.StatusUnauthorized},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rec := serve(handler, tt.method, tt.target, tt.configure)
+			if rec.Code != tt.status {
+				t.Errorf("expected %d, got %d: %s", tt.status, rec.Code, rec.Body.String())
+			}
+			if rec.Code == http.StatusUnauthorized && rec.Header().Get("WWW-Authenticate") == "" {
+				t.Error("expected a basic auth challenge")
+			}
+		})
+	}
+}
+
+func TestWithScopeAuthToken(t *testing.T) {
+	srv, _ := newTestServer(t, func(cfg *config.Config) {
+		cfg.UI.Auth.Token = "ui-token"
+	})
+	handler := srv.withScope(auth.ScopeControlTasks, okHandler)
+
+	if rec := serve(handler, http.MethodPost, "/", bearer("ui-token")); rec.Code != http.StatusOK {
+		t.Errorf("expected the ui.auth token to be accepted, got %d", rec.Code)
+	}
+	if rec := serve(handler, http.MethodPost, "/", cookie("ui-token")); rec.Code != http.StatusOK {
+		t.Errorf("expected the ui.auth token cookie to be accepted, got %d", rec.Code)
+	}
+	rec := serve(handler, http.MethodPost, "/", basicAuth("admin", "ui-token"))
+	if rec.Code != http.StatusUnauthorized {
+		t.Errorf("expected basic auth to be rejected when it isn't configured, got %d", rec.Code)
+	}
+	if rec.Header().Get("WWW-Authenticate") != "" {
+		t.Error("expected no basic auth challenge when it isn't configured")
+	}
+}
+
+func TestWithUIScope(t *testing.T) {
+	open, _ := newTestServer(t, nil)
+	handler := open.withUIScope(auth.ScopeControlTasks, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+	rec := serve(handler, http.MethodPost, "/", func(r *http.Request) {
+		r.Header.Set("Origin", "http://localhost:3000")
+	})
+	if rec.Code != http.StatusOK {
+		t.Errorf("expected the UI to be open without ui.requireToken, got %d", rec.Code)
+	}
+	if rec.Header().Get("Access-Control-Allow-Origin") != "http://localhost:3000" {
+		t.Errorf("expected CORS headers, got %v", rec.Header())
+	}
+
+	protected, _ := newTestServer(t, func(cfg *config.Config) {
+		cfg.UI.RequireToken = true
+	})
+	readOnly := createToken(t, protected, auth.ScopeReadEvents)
+	handler = protected.withUIScope(auth.ScopeControlTasks, okHandler)
+	if rec := serve(handler, http.MethodPost, "/", nil); rec.Code != http.StatusUnauthorized {
+		t.Errorf("expected 401 without a token, got %d", rec.Code)
+	}
+	if rec := serve(handler, http.MethodPost, "/", cookie(readOnly)); rec.Code != http.StatusForbidden {
+		t.Errorf("expected 403 without the scope, got %d", rec.Code)
+	}
+	if rec := serve(handler, http.MethodPost, "/", cookie(testAPIToken)); rec.Code != http.StatusOK {
+		t.Errorf("expected 200 with the API token, got %d", rec.Code)
+	}
+
+	// ui.auth protects the UI without ui.requireToken
+	basic, _ := newTestServer(t, func(cfg *config.Config) {
+		cfg.UI.Auth.Username = "admin"
+		cfg.UI.Auth.Password = "secret"
+	})
+	handler = basic.withUIScope(auth.ScopeControlTasks, okHandler)
+	if rec := serve(handler, http.MethodPost, "/", nil); rec.Code != http.StatusUnauthorized {
+		t.Errorf("expected 401 without credentials, got %d", rec.Code)
+	}
+	if rec := serve(handler, http.MethodPost, "/", basicAuth("admin", "secret")); rec.Code != http.StatusOK {
+		t.Errorf("expected 200 with basic auth, got %d", rec.Code)
+	}
+}
+
+func TestLoadAuth(t *testing.T) {
+	cfg := config.Config{RootDirectory: t.TempDir()}
+	cfg.UI.Enabled = true
+	cfg.UI.Auth.Username = "admin"
+	if _, err := New(cfg, nil, nil, nil); err == nil {
+		t.Error("expected a username without a password to be rejected")
+	}
+}
+
+func TestInjectToken(t *testing.T) {
+	page := []byte("<html>\n  <head>\n  </head>\n</html>")
+	if string(injectToken(page, "")) != string(page) {
+		t.Error("expected the page to be unchanged without a token")
+	}
+
+	got := string(injectToken(page, `a"b<c`))
+	if !strings.Contains(got, `<meta name="gomon-token" content="a&#34;b&lt;c" />`) {
+		t.Errorf("expected an escaped token meta tag, got %s", got)
+	}
+	if strings.Index(got, "gomon-token") > strings.Index(got, "</head>") {
+		t.Errorf("expected the meta tag in the head, got %s", got)
+	}
+}
+
+func TestIndexPageSetsTokenCookie(t *testing.T) {
+	srv, _ := newTestServer(t, func(cfg *config.Config) {
+		cfg.UI.RequireToken = true
+	})
+
+	if rec := serve(srv.handler, http.MethodGet, "/", nil); rec.Code != http.StatusUnauthorized {
+		t.Errorf("expected 401 without a token, got %d", rec.Code)
+	}
+	tasks := createToken(t, srv, auth.ScopeControlTasks)
+	if rec := serve(srv.handler, http.MethodGet, "/?token="+tasks, nil); rec.Code != http.StatusUnauthorized {
+		t.Errorf("expected 401 without the read:events scope, got %d", rec.Code)
+	}
+
+	rec := serve(srv.handler, http.MethodGet, "/?token="+testAPIToken, nil)
+	if rec.Code != http.StatusOK {
+		t.Fatalf("expected 200, got %d", rec.Code)
+	}
+	cookies := rec.Result().Cookies()
+	if len(cookies) != 1 || cookies[0].Name != tokenCookieName || cookies[0].Value != testAPIToken || !cookies[0].HttpOnly {
+		t.Errorf("expected the token cookie to be set, got %v", cookies)
+	}
+}
+
+func TestTaskAPI(t *testing.T) {
+	srv, dispatched := newTestServer(t, nil)
+	readOnly := createToken(t, srv, auth.ScopeReadEvents)
+
+	if rec := serve(srv.handler, http.MethodPost, "/api/tasks/migrate", nil); rec.Code != http.StatusUnauthorized {
+		t.Errorf("expected 401 without a token, got %d", rec.Code)
+	}
+	if rec := serve(srv.handler, http.MethodPost, "/api/tasks/migrate", bearer(readOnly)); rec.Code != http.StatusForbidden {
+		t.Errorf("expected 403 without the control:tasks scope, got %d", rec.Code)
+	}
+	if rec := serve(srv.handler, http.MethodPost, "/api/tasks/rm%20-rf%20.", bearer(testAPIToken)); rec.Code != http.StatusNotFound {
+		t.Errorf("expected 404 for an unknown task, got %d", rec.Code)
+	}
+	if len(*dispatched) != 0 {
+		t.Fatalf("expected nothing to be dispatched, got %v", *dispatched)
+	}
+
+	if rec := serve(srv.handler, http.MethodPost, "/api/tasks/migrate", bearer(testAPIToken)); rec.Code != http.StatusAccepted {
+		t.Errorf("expected 202, got %d", rec.Code)
+	}
+	if rec := serve(srv.handler, http.MethodDelete, "/api/tasks/123", bearer(testAPIToken)); rec.Code != http.StatusAccepted {
+		t.Errorf("expected 202, got %d", rec.Code)
+	}
+	if len(*dispatched) != 2 ||
+		(*dispatched)[0].Type != notification.NotificationTypeOOBTaskRequested || (*dispatched)[0].Message != "migrate" ||
+		(*dispatched)[1].Type != notification.NotificationTypeOOBTaskCancelRequested || (*dispatched)[1].Message != "123" {
+		t.Errorf("unexpected notifications: %v", *dispatched)
+	}
+}
+
+func TestTriggerAPI(t *testing.T) {
+	srv, dispatched := newTestServer(t, nil)
+	restart := createToken(t, srv, auth.ScopeControlRestart)
+
+	trigger := func(body, token string) int {
+		req := httptest.NewRequest(http.MethodPost, "/api/trigger", strings.NewReader(body))
+		req.Header.Set("Authorization", "Bearer "+token)
+		rec := httptest.NewRecorder()
+		srv.handler.ServeHTTP(rec, req)
+		return rec.Code
+	}
+
+	if status := trigger(`{"type":"task","task":"migrate"}`, restart); status != http.StatusForbidden {
+		t.Errorf("expected 403 without the control:tasks scope, got %d", status)
+	}
+	if status := trigger(`{"type":"task","task":"go generate ./..."}`, testAPIToken); status != http.StatusNotFound {
+		t.Errorf("expected 404 for an unknown task, got %d", status)
+	}
+	if status := trigger(`{"type":"task"}`, testAPIToken); status != http.StatusBadRequest {
+		t.Errorf("expected 400 without a task, got %d", status)
+	}
+	if status := trigger(`{"type":"reboot"}`, testAPIToken); status != http.StatusBadRequest {
+		t.Errorf("expected 400 for an unknown type, got %d", status)
+	}
+	if len(*dispatched) != 0 {
+		t.Fatalf("expected nothing to be dispatched, got %v", *dispatched)
+	}
+
+	if status := trigger(`{"type":"task","task":"migrate"}`, testAPIToken); status != http.StatusAccepted {
+		t.Errorf("expected 202, got %d", status)
+	}
+	if status := trigger(`{"type":"hard","paths":["main.go"]}`, restart); status != http.StatusAccepted {
+		t.Errorf("expected 202, got %d", status)
+	}
+	if len(*dispatched) != 2 ||
+		(*dispatched)[0].Type != notification.NotificationTypeOOBTaskRequested || (*dispatched)[0].Message != "migrate" ||
+		(*dispatched)[1].Type != notification.NotificationTypeHardRestartRequested || (*dispatched)[1].Message != "main.go" {
+		t.Errorf("unexpected notifications: %v", *dispatched)
+	}
+}
@@ -0,0 +1,139 @@
+package webui
+
+// gomon is a simple command line tool that watches your files and automatically restarts the application when it detects any changes in the working directory.
+// Copyright (C) 2023 John Dudmesh
+
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+
+// You should have received a copy of the GNU General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>.
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"os"
+	"path"
+	"strings"
+	"time"
+
+	"github.com/jdudmesh/gomon/internal/config"
+	"github.com/jdudmesh/gomon/internal/notification"
+	"github.com/jdudmesh/gomon/internal/utils"
+)
+
+// ErrNotRunning is returned by the client if there is no gomon instance listening
+var ErrNotRunning = errors.New("gomon is not running")
+
+// clientToken returns the URL of the running gomon instance's API and the token to use with it
+func clientToken(cfg config.Config) (string, string, error) {
+	baseURL := cfg.UIURL()
+	if baseURL == "" {
+		return "", "", fmt.Errorf("the ui is not enabled: %w", ErrNotRunning)
+	}
+
+	// a scoped token can be used instead of the API token, e.g. on a shared machine
+	token := os.Getenv("GOMON_TOKEN")
+	if token == "" {
+		token = cfg.UI.APIToken
+	}
+	if token == "" {
+		buf, err := os.ReadFile(path.Join(cfg.RootDirectory, ".gomon", apiTokenFileName))
+		if os.IsNotExist(err) {
+			return "", "", ErrNotRunning
+		} else if err != nil {
+			return "", "", fmt.Errorf("reading api token: %w", err)
+		}
+		token = strings.TrimSpace(string(buf))
+	}
+
+	return baseURL, token, nil
+}
+
+// Endpoint returns the URL of the running gomon instance and the token to use with it, for clients which follow
+// its output
+func Endpoint(cfg config.Config) (string, string, error) {
+	return clientToken(cfg)
+}
+
+// RequestState fetches a dump of a running gomon instance's internal state
+func RequestState(cfg config.Config) (utils.StateDump, error) {
+	state := utils.StateDump{}
+
+	baseURL, token, err := clientToken(cfg)
+	if err != nil {
+		return state, err
+	}
+
+	req, err := http.NewRequest(http.MethodGet, baseURL+"/api/state", nil)
+	if err != nil {
+		return state, fmt.Errorf("creating request: %w", err)
+	}
+	req.Header.Set("Authorization", "Bearer "+token)
+
+	client := http.Client{Timeout: 10 * time.Second}
+	res, err := client.Do(req)
+	if err != nil {
+		return state, fmt.Errorf("%w: %v", ErrNotRunning, err)
+	}
+	defer res.Body.Close()
+
+	if res.StatusCode != http.StatusOK {
+		apiErr := map[string]string{}
+		json.NewDecoder(res.Body).Decode(&apiErr)
+		return state, fmt.Errorf("requesting state: %s %s", res.Status, apiErr["error"])
+	}
+
+	err = json.NewDecoder(res.Body).Decode(&state)
+	if err != nil {
+		return state, fmt.Errorf("decoding response: %w", err)
+	}
+
+	return state, nil
+}
+
+// RequestTask asks a running gomon instance to run a task using the control API
+func RequestTask(cfg config.Config, task string) (notification.Notification, error) {
+	n := notification.Notification{}
+
+	baseURL, token, err := clientToken(cfg)
+	if err != nil {
+		return n, err
+	}
+
+	req, err := http.NewRequest(http.MethodPost, baseURL+"/api/tasks/"+url.PathEscape(task), nil)
+	if err != nil {
+		return n, fmt.Errorf("creating request: %w", err)
+	}
+	req.Header.Set("Authorization", "Bearer "+token)
+
+	client := http.Client{Timeout: 10 * time.Second}
+	res, err := client.Do(req)
+	if err != nil {
+		return n, fmt.Errorf("%w: %v", ErrNotRunning, err)
+	}
+	defer res.Body.Close()
+
+	if res.StatusCode != http.StatusAccepted {
+		apiErr := map[string]string{}
+		json.NewDecoder(res.Body).Decode(&apiErr)
+		return n, fmt.Errorf("requesting task: %s %s", res.Status, apiErr["error"])
+	}
+
+	err = json.NewDecoder(res.Body).Decode(&n)
+	if err != nil {
+		return n, fmt.Errorf("decoding response: %w", err)
+	}
+
+	return n, nil
+}
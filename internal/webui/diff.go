@@ -0,0 +1,192 @@
+package webui
+
+// gomon is a simple command line tool that watches your files and automatically restarts the application when it detects any changes in the working directory.
+// Copyright (C) 2023 John Dudmesh
+
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+
+// You should have received a copy of the GNU General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>.
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/jdudmesh/gomon/internal/notification"
+	"github.com/jdudmesh/gomon/internal/utils"
+)
+
+type diffOp string
+
+const (
+	diffSame    diffOp = "same"
+	diffRemoved diffOp = "removed"
+	diffAdded   diffOp = "added"
+)
+
+// diffRow is a line of the side by side comparison, Before or After is empty if the line only appears in one run
+type diffRow struct {
+	Op     diffOp
+	Before string
+	After  string
+}
+
+// runComparison is the stderr output and exit status of two runs, Before and After are nil if there aren't
+// enough runs to compare
+type runComparison struct {
+	Before *utils.RunSummary
+	After  *utils.RunSummary
+	Rows   []diffRow
+	Runs   []*notification.Notification
+	// Labels are the labels of the runs, they are shown in the run selectors
+	Labels map[string][]string
+}
+
+// timestampPattern matches the timestamp at the start of a log line, it is ignored when comparing lines because
+// it is always different between runs
+var timestampPattern = regexp.MustCompile(`^\[?\d{4}[-/]\d{2}[-/]\d{2}[T ]\d{2}:\d{2}:\d{2}(\.\d+)?(Z|[+-]\d{2}:?\d{2})?\]?\s*`)
+
+func comparableLine(line string) string {
+	return timestampPattern.ReplaceAllString(strings.TrimSpace(utils.StripANSI(line)), "")
+}
+
+// diffLines compares two runs' output line by line using the longest common subsequence
+func diffLines(before, after []string) []diffRow {
+	a := make([]string, len(before))
+	for ix, line := range before {
+		a[ix] = comparableLine(line)
+	}
+	b := make([]string, len(after))
+	for ix, line := range after {
+		b[ix] = comparableLine(line)
+	}
+
+	// lcs[i][j] is the length of the longest common subsequence of a[i:] and b[j:]
+	lcs := make([][]int, len(a)+1)
+	for i := range lcs {
+		lcs[i] = make([]int, len(b)+1)
+	}
+	for i := len(a) - 1; i >= 0; i-- {
+		for j := len(b) - 1; j >= 0; j-- {
+			if a[i] == b[j] {
+				lcs[i][j] = lcs[i+1][j+1] + 1
+			} else {
+				lcs[i][j] = max(lcs[i+1][j], lcs[i][j+1])
+			}
+		}
+	}
+
+	rows := []diffRow{}
+	i, j := 0, 0
+	for i < len(a) || j < len(b) {
+		switch {
+		case i < len(a) && j < len(b) && a[i] == b[j]:
+			rows = append(rows, diffRow{Op: diffSame, Before: before[i], After: after[j]})
+			i++
+			j++
+		case i < len(a) && (j == len(b) || lcs[i+1][j] >= lcs[i][j+1]):
+			rows = append(rows, diffRow{Op: diffRemoved, Before: before[i]})
+			i++
+		default:
+			rows = append(rows, diffRow{Op: diffAdded, After: after[j]})
+			j++
+		}
+	}
+
+	return rows
+}
+
+func stderrLines(summary *utils.RunSummary) []string {
+	lines := []string{}
+	for _, n := range summary.Stderr {
+		lines = append(lines, strings.Split(strings.TrimRight(n.Message, "\n"), "\n")...)
+	}
+	return lines
+}
+
+// runStatus describes how a run ended e.g. "exit code 1 after 2.5s"
+func runStatus(summary *utils.RunSummary) string {
+	if summary.Exit == nil {
+		return "running"
+	}
+	status := strings.TrimPrefix(summary.Exit.Message, "process stopped: ")
+	return fmt.Sprintf("%s after %s", status, summary.Exit.Date.Sub(summary.Start.Date).Round(time.Millisecond))
+}
+
+// diffComponentHandler compares the runs a and b, if either is missing the last working run and the first failing
+// one are compared, or the run selected in the toolbar (r) and the last working run before it
+func (c *server) diffComponentHandler(w http.ResponseWriter, r *http.Request) {
+	before := r.URL.Query().Get("a")
+	after := r.URL.Query().Get("b")
+
+	cmp, err := c.compareRuns(before, after, r.URL.Query().Get("r"))
+	if errors.Is(err, utils.ErrRunNotFound) {
+		http.Error(w, err.Error(), http.StatusNotFound)
+		return
+	}
+	if err != nil {
+		log.Errorf("comparing runs: %v", err)
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "text/html; charset=utf-8")
+	err = RunDiff(cmp).Render(r.Context(), w)
+	if err != nil {
+		log.Errorf("rendering: %v", err)
+	}
+}
+
+func (c *server) compareRuns(before, after, selected string) (*runComparison, error) {
+	var err error
+	if before == "" || after == "" {
+		if selected == "all" {
+			selected = ""
+		}
+		before, after, err = c.db.FindComparisonRuns(selected)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	cmp := &runComparison{}
+	cmp.Runs, err = c.db.FindRuns("")
+	if err != nil {
+		return nil, err
+	}
+	runIDs := make([]string, 0, len(cmp.Runs))
+	for _, r := range cmp.Runs {
+		runIDs = append(runIDs, r.ChildProccessID)
+	}
+	cmp.Labels, err = c.db.FindRunLabels(runIDs)
+	if err != nil {
+		return nil, err
+	}
+	if before == "" || after == "" {
+		return cmp, nil
+	}
+
+	cmp.Before, err = utils.FindRunSummary(c.db, before)
+	if err != nil {
+		return nil, err
+	}
+	cmp.After, err = utils.FindRunSummary(c.db, after)
+	if err != nil {
+		return nil, err
+	}
+	cmp.Rows = diffLines(stderrLines(cmp.Before), stderrLines(cmp.After))
+
+	return cmp, nil
+}
@@ -0,0 +1,75 @@
+package webui
+
+// gomon is a simple command line tool that watches your files and automatically restarts the application when it detects any changes in the working directory.
+// Copyright (C) 2023 John Dudmesh
+
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+
+// You should have received a copy of the GNU General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>.
+
+import (
+	"path/filepath"
+	"strconv"
+	"strings"
+	"sync/atomic"
+
+	"github.com/a-h/templ"
+	"github.com/jdudmesh/gomon/internal/utils"
+)
+
+// DefaultEditorURL opens a file in VS Code, {path}, {line} and {col} are substituted
+const DefaultEditorURL = "vscode://file/{path}:{line}:{col}"
+
+type editorConfig struct {
+	urlTemplate   string
+	rootDirectory string
+}
+
+// editor is shared with the templ components which render build errors
+var editor atomic.Pointer[editorConfig]
+
+func setEditor(urlTemplate, rootDirectory string) {
+	if urlTemplate == "" {
+		urlTemplate = DefaultEditorURL
+	}
+	editor.Store(&editorConfig{
+		urlTemplate:   urlTemplate,
+		rootDirectory: rootDirectory,
+	})
+}
+
+// editorLink returns a link which opens the source file referenced by a compiler error
+func editorLink(line utils.BuildErrorLine) templ.SafeURL {
+	return templ.SafeURL(editorURL(line.File, line.Line, line.Column))
+}
+
+// editorURL fills in the editor URL template for a file, relative paths are resolved against the root directory
+func editorURL(path string, line, col int) string {
+	cfg := editor.Load()
+	if cfg == nil {
+		cfg = &editorConfig{urlTemplate: DefaultEditorURL}
+	}
+
+	if !filepath.IsAbs(path) && cfg.rootDirectory != "" {
+		path = filepath.Join(cfg.rootDirectory, path)
+	}
+
+	if col == 0 {
+		col = 1
+	}
+
+	return strings.NewReplacer(
+		"{path}", filepath.ToSlash(path),
+		"{line}", strconv.Itoa(line),
+		"{col}", strconv.Itoa(col),
+	).Replace(cfg.urlTemplate)
+}
@@ -0,0 +1,258 @@
+package webui
+
+// gomon is a simple command line tool that watches your files and automatically restarts the application when it detects any changes in the working directory.
+// Copyright (C) 2023 John Dudmesh
+
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+
+// You should have received a copy of the GNU General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>.
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/jdudmesh/gomon/internal/notification"
+	"github.com/jdudmesh/gomon/internal/utils"
+)
+
+const (
+	ExportFormatText     = "txt"
+	ExportFormatJSON     = "json"
+	ExportFormatNDJSON   = "ndjson"
+	ExportFormatMarkdown = "md"
+)
+
+var exportContentTypes = map[string]string{
+	ExportFormatText:     "text/plain; charset=utf-8",
+	ExportFormatJSON:     "application/json",
+	ExportFormatNDJSON:   "application/x-ndjson",
+	ExportFormatMarkdown: "text/markdown; charset=utf-8",
+}
+
+// runExportHandler serves GET /api/runs/{id}/export?format=txt|json|ndjson
+// exportActionHandler serves the download button in the UI, GET /export/{id}?format=txt|json|ndjson
+func (c *server) exportActionHandler(w http.ResponseWriter, r *http.Request) {
+	runID := strings.TrimPrefix(r.URL.Path, "/export/")
+	if runID == "" || strings.Contains(runID, "/") {
+		http.NotFound(w, r)
+		return
+	}
+	c.exportRun(w, r, runID)
+}
+
+// exportRun streams the events of a run from the database as a file download
+func (c *server) exportRun(w http.ResponseWriter, r *http.Request, runID string) {
+	if r.Method != http.MethodGet {
+		w.WriteHeader(http.StatusMethodNotAllowed)
+		return
+	}
+
+	format, ok := exportFormat(w, r)
+	if !ok {
+		return
+	}
+
+	e := &exporter{
+		w:      w,
+		format: format,
+		filename: func(n *notification.Notification) string {
+			return fmt.Sprintf("gomon-%s.%s", n.ChildProccessID, format)
+		},
+	}
+	e.finish(c.db.ExportRun(runID, e.write))
+}
+
+// rangeHandler serves GET /actions/range and /api/range?from={id}&to={id}&format=txt|md|json|ndjson which return the
+// events between two events, e.g. a selection in the UI
+func (c *server) rangeHandler(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		w.WriteHeader(http.StatusMethodNotAllowed)
+		return
+	}
+
+	fromID, toID, ok := rangeParams(w, r)
+	if !ok {
+		return
+	}
+
+	format, ok := exportFormat(w, r)
+	if !ok {
+		return
+	}
+
+	e := &exporter{w: w, format: format}
+	e.finish(c.db.ExportRange(fromID, toID, e.write))
+}
+
+// scratchActionHandler serves POST /actions/scratch?from={id}&to={id}, it writes the events between two events to a
+// scratch file and returns its path along with a link which opens it in the editor
+func (c *server) scratchActionHandler(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		w.WriteHeader(http.StatusMethodNotAllowed)
+		return
+	}
+
+	fromID, toID, ok := rangeParams(w, r)
+	if !ok {
+		return
+	}
+
+	dir := filepath.Join(c.rootDirectory, ".gomon", "scratch")
+	err := os.MkdirAll(dir, 0755)
+	if err != nil {
+		log.Errorf("creating scratch directory: %v", err)
+		writeJSONError(w, "creating scratch file", http.StatusInternalServerError)
+		return
+	}
+
+	scratchPath := filepath.Join(dir, fmt.Sprintf("selection-%s.log", time.Now().Format("20060102-150405")))
+	f, err := os.Create(scratchPath)
+	if err != nil {
+		log.Errorf("creating scratch file: %v", err)
+		writeJSONError(w, "creating scratch file", http.StatusInternalServerError)
+		return
+	}
+	defer f.Close()
+
+	err = c.db.ExportRange(fromID, toID, func(n *notification.Notification) error {
+		return writeExportedEvent(f, ExportFormatText, n)
+	})
+	if err != nil {
+		f.Close()
+		os.Remove(scratchPath)
+		if errors.Is(err, utils.ErrEventNotFound) {
+			writeJSONError(w, "event not found", http.StatusNotFound)
+			return
+		}
+		log.Errorf("writing scratch file: %v", err)
+		writeJSONError(w, "writing scratch file", http.StatusInternalServerError)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, map[string]string{
+		"path": scratchPath,
+		"url":  editorURL(scratchPath, 1, 1),
+	})
+}
+
+func rangeParams(w http.ResponseWriter, r *http.Request) (string, string, bool) {
+	fromID := r.URL.Query().Get("from")
+	toID := r.URL.Query().Get("to")
+	if fromID == "" {
+		writeJSONError(w, "from is required", http.StatusBadRequest)
+		return "", "", false
+	}
+	if toID == "" {
+		toID = fromID
+	}
+	return fromID, toID, true
+}
+
+// exportFormat returns the format requested by the format parameter, txt is the default
+func exportFormat(w http.ResponseWriter, r *http.Request) (string, bool) {
+	format := r.URL.Query().Get("format")
+	if format == "" {
+		format = ExportFormatText
+	}
+	if _, ok := exportContentTypes[format]; !ok {
+		writeJSONError(w, fmt.Sprintf("unsupported format: %s", format), http.StatusBadRequest)
+		return "", false
+	}
+	return format, true
+}
+
+// exporter writes events in one of the export formats. The headers are only written once the first event has been
+// read so that a missing run can still be reported as an error.
+type exporter struct {
+	w      http.ResponseWriter
+	format string
+	// filename makes the response a download, the name is based on the first event
+	filename func(n *notification.Notification) string
+	started  bool
+}
+
+func (e *exporter) start(n *notification.Notification) {
+	e.started = true
+	e.w.Header().Set("Content-Type", exportContentTypes[e.format])
+	if e.filename != nil && n != nil {
+		e.w.Header().Set("Content-Disposition", fmt.Sprintf("attachment; filename=\"%s\"", e.filename(n)))
+	}
+	e.w.WriteHeader(http.StatusOK)
+
+	switch e.format {
+	case ExportFormatJSON:
+		io.WriteString(e.w, "[")
+	case ExportFormatMarkdown:
+		io.WriteString(e.w, "```text\n")
+	}
+}
+
+func (e *exporter) write(n *notification.Notification) error {
+	if !e.started {
+		e.start(n)
+	} else if e.format == ExportFormatJSON {
+		io.WriteString(e.w, ",")
+	}
+	return writeExportedEvent(e.w, e.format, n)
+}
+
+// finish completes the response, or reports the error if nothing has been written yet
+func (e *exporter) finish(err error) {
+	if !e.started {
+		switch {
+		case errors.Is(err, utils.ErrRunNotFound):
+			writeJSONError(e.w, "run not found", http.StatusNotFound)
+			return
+		case errors.Is(err, utils.ErrEventNotFound):
+			writeJSONError(e.w, "event not found", http.StatusNotFound)
+			return
+		case err != nil:
+			log.Errorf("exporting events: %v", err)
+			writeJSONError(e.w, "exporting events", http.StatusInternalServerError)
+			return
+		}
+		e.start(nil)
+	}
+
+	if err != nil {
+		// the response has already started so all that can be done is to stop writing
+		log.Errorf("exporting events: %v", err)
+		return
+	}
+
+	switch e.format {
+	case ExportFormatJSON:
+		io.WriteString(e.w, "]\n")
+	case ExportFormatMarkdown:
+		io.WriteString(e.w, "```\n")
+	}
+}
+
+func writeExportedEvent(w io.Writer, format string, n *notification.Notification) error {
+	switch format {
+	case ExportFormatJSON, ExportFormatNDJSON:
+		// Encode adds the newline which separates ndjson records
+		return json.NewEncoder(w).Encode(n)
+	default:
+		// markdown is a code block of the text format
+		// colour codes are kept in the JSON formats but would make a text file hard to read
+		_, err := fmt.Fprintf(w, "%s [%s] %s\n", n.Date.Format("2006-01-02 15:04:05.000"), n.Type.String(), utils.StripANSI(n.Message))
+		return err
+	}
+}
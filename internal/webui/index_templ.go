@@ -27,20 +27,26 @@ import "bytes"
 
 import (
 	"github.com/jdudmesh/gomon/internal/notification"
+	"github.com/jdudmesh/gomon/internal/utils"
 	"strconv"
 )
 
 var colourMap = map[notification.NotificationType]string{
-	notification.NotificationTypeStartup:        "text-blue-400",
-	notification.NotificationTypeShutdown:       "text-blue-400",
-	notification.NotificationTypeHardRestart:    "text-blue-400",
-	notification.NotificationTypeSoftRestart:    "text-blue-400",
-	notification.NotificationTypeIPC:            "text-blue-400",
-	notification.NotificationTypeStdOut:         "text-green-400",
-	notification.NotificationTypeStdErr:         "text-red-400",
-	notification.NotificationTypeOOBTaskStartup: "text-yellow-400",
-	notification.NotificationTypeOOBTaskStdOut:  "text-yellow-400",
-	notification.NotificationTypeOOBTaskStdErr:  "text-orange-400",
+	notification.NotificationTypeStartup:         "text-blue-400",
+	notification.NotificationTypeShutdown:        "text-blue-400",
+	notification.NotificationTypeHardRestart:     "text-blue-400",
+	notification.NotificationTypeSoftRestart:     "text-blue-400",
+	notification.NotificationTypeIPC:             "text-blue-400",
+	notification.NotificationTypeStdOut:          "text-green-400",
+	notification.NotificationTypeStdErr:          "text-red-400",
+	notification.NotificationTypeOOBTaskStartup:  "text-yellow-400",
+	notification.NotificationTypeOOBTaskStdOut:   "text-yellow-400",
+	notification.NotificationTypeOOBTaskStdErr:   "text-orange-400",
+	notification.NotificationTypeCrash:           "text-red-500",
+	notification.NotificationTypeUnhealthy:       "text-red-500",
+	notification.NotificationTypeOOBTaskComplete: "text-yellow-400",
+	notification.NotificationTypeBuildError:      "text-red-400",
+	notification.NotificationTypeLogEvent:        "text-blue-400",
 }
 
 func SearchNoResults() templ.Component {
@@ -76,7 +82,7 @@ func SearchNoResults() templ.Component {
 	})
 }
 
-func SearchSelect(runs []*notification.Notification, currentRun string) templ.Component {
+func SearchSelect(runs []*notification.Notification, currentRun string, errorCounts map[string]int, labels map[string][]string) templ.Component {
 	return templ.ComponentFunc(func(ctx context.Context, w io.Writer) (err error) {
 		templBuffer, templIsBuffer := w.(*bytes.Buffer)
 		if !templIsBuffer {
@@ -127,7 +133,7 @@ func SearchSelect(runs []*notification.Notification, currentRun string) templ.Co
 			if err != nil {
 				return err
 			}
-			var var_5 string = r.Date.Format("2006-01-02 15:04:05")
+			var var_5 string = runOptionText(r, labels[r.ChildProccessID]) + errorCountSuffix(errorCounts[r.ChildProccessID])
 			_, err = templBuffer.WriteString(templ.EscapeString(var_5))
 			if err != nil {
 				return err
@@ -148,6 +154,160 @@ func SearchSelect(runs []*notification.Notification, currentRun string) templ.Co
 	})
 }
 
+func LabelSelect(labels []string, selected string) templ.Component {
+	return templ.ComponentFunc(func(ctx context.Context, w io.Writer) (err error) {
+		templBuffer, templIsBuffer := w.(*bytes.Buffer)
+		if !templIsBuffer {
+			templBuffer = templ.GetBuffer()
+			defer templ.ReleaseBuffer(templBuffer)
+		}
+		ctx = templ.InitializeContext(ctx)
+		var_72 := templ.GetChildren(ctx)
+		if var_72 == nil {
+			var_72 = templ.NopComponent
+		}
+		ctx = templ.ClearChildren(ctx)
+		_, err = templBuffer.WriteString("<select id=\"label-select\" name=\"label\" class=\"select select-sm select-bordered w-40\" title=\"Only list the runs with a label\"><option value=\"\">")
+		if err != nil {
+			return err
+		}
+		var_73 := `All labels`
+		_, err = templBuffer.WriteString(var_73)
+		if err != nil {
+			return err
+		}
+		_, err = templBuffer.WriteString("</option>")
+		if err != nil {
+			return err
+		}
+		for _, l := range labels {
+			_, err = templBuffer.WriteString("<option value=\"")
+			if err != nil {
+				return err
+			}
+			_, err = templBuffer.WriteString(templ.EscapeString(l))
+			if err != nil {
+				return err
+			}
+			_, err = templBuffer.WriteString("\"")
+			if err != nil {
+				return err
+			}
+			if l == selected {
+				if true {
+					_, err = templBuffer.WriteString(" selected")
+					if err != nil {
+						return err
+					}
+				}
+			}
+			_, err = templBuffer.WriteString(">")
+			if err != nil {
+				return err
+			}
+			var var_74 string = l
+			_, err = templBuffer.WriteString(templ.EscapeString(var_74))
+			if err != nil {
+				return err
+			}
+			_, err = templBuffer.WriteString("</option>")
+			if err != nil {
+				return err
+			}
+		}
+		_, err = templBuffer.WriteString("</select>")
+		if err != nil {
+			return err
+		}
+		if !templIsBuffer {
+			_, err = templBuffer.WriteTo(w)
+		}
+		return err
+	})
+}
+
+func RunLabels(basePath string, runID string, labels []string) templ.Component {
+	return templ.ComponentFunc(func(ctx context.Context, w io.Writer) (err error) {
+		templBuffer, templIsBuffer := w.(*bytes.Buffer)
+		if !templIsBuffer {
+			templBuffer = templ.GetBuffer()
+			defer templ.ReleaseBuffer(templBuffer)
+		}
+		ctx = templ.InitializeContext(ctx)
+		var_75 := templ.GetChildren(ctx)
+		if var_75 == nil {
+			var_75 = templ.NopComponent
+		}
+		ctx = templ.ClearChildren(ctx)
+		if runID != "" && runID != "all" {
+			for _, l := range labels {
+				_, err = templBuffer.WriteString("<span class=\"badge badge-outline gap-1\">")
+				if err != nil {
+					return err
+				}
+				var var_76 string = l
+				_, err = templBuffer.WriteString(templ.EscapeString(var_76))
+				if err != nil {
+					return err
+				}
+				_, err = templBuffer.WriteString(" <button type=\"button\" class=\"cursor-pointer\" title=\"Remove label\" hx-delete=\"")
+				if err != nil {
+					return err
+				}
+				_, err = templBuffer.WriteString(templ.EscapeString(basePath + "/actions/label"))
+				if err != nil {
+					return err
+				}
+				_, err = templBuffer.WriteString("\" hx-vals=\"")
+				if err != nil {
+					return err
+				}
+				_, err = templBuffer.WriteString(templ.EscapeString(labelValues(runID, l)))
+				if err != nil {
+					return err
+				}
+				_, err = templBuffer.WriteString("\" hx-target=\"#run-labels\">")
+				if err != nil {
+					return err
+				}
+				var_77 := `&times;`
+				_, err = templBuffer.WriteString(var_77)
+				if err != nil {
+					return err
+				}
+				_, err = templBuffer.WriteString("</button></span>")
+				if err != nil {
+					return err
+				}
+			}
+			_, err = templBuffer.WriteString("<form hx-post=\"")
+			if err != nil {
+				return err
+			}
+			_, err = templBuffer.WriteString(templ.EscapeString(basePath + "/actions/label"))
+			if err != nil {
+				return err
+			}
+			_, err = templBuffer.WriteString("\" hx-target=\"#run-labels\"><input type=\"hidden\" name=\"run\" value=\"")
+			if err != nil {
+				return err
+			}
+			_, err = templBuffer.WriteString(templ.EscapeString(runID))
+			if err != nil {
+				return err
+			}
+			_, err = templBuffer.WriteString("\"><input name=\"label\" type=\"text\" class=\"input input-xs input-bordered w-48 text-slate-900\" placeholder=\"Label this run...\" maxlength=\"100\"></form>")
+			if err != nil {
+				return err
+			}
+		}
+		if !templIsBuffer {
+			_, err = templBuffer.WriteTo(w)
+		}
+		return err
+	})
+}
+
 func Event(n *notification.Notification) templ.Component {
 	return templ.ComponentFunc(func(ctx context.Context, w io.Writer) (err error) {
 		templBuffer, templIsBuffer := w.(*bytes.Buffer)
@@ -161,7 +321,22 @@ func Event(n *notification.Notification) templ.Component {
 			var_6 = templ.NopComponent
 		}
 		ctx = templ.ClearChildren(ctx)
-		if col, ok := colourMap[n.Type]; ok {
+		if n.Type == notification.NotificationTypeBuildError {
+			err = BuildErrorPanel(n).Render(ctx, templBuffer)
+			if err != nil {
+				return err
+			}
+		} else if n.Type == notification.NotificationTypeRunning {
+			err = StartupBanner(n).Render(ctx, templBuffer)
+			if err != nil {
+				return err
+			}
+		} else if n.Type == notification.NotificationTypeTestPass || n.Type == notification.NotificationTypeTestFail {
+			err = TestResult(n).Render(ctx, templBuffer)
+			if err != nil {
+				return err
+			}
+		} else if col, ok := colourMap[n.Type]; ok {
 			var var_7 = []any{"log-entry flex flex-row gap-4 items-stretch " + col}
 			err = templ.RenderCSSItems(ctx, templBuffer, var_7...)
 			if err != nil {
@@ -183,6 +358,14 @@ func Event(n *notification.Notification) templ.Component {
 			if err != nil {
 				return err
 			}
+			_, err = templBuffer.WriteString("\" data-event-id=\"")
+			if err != nil {
+				return err
+			}
+			_, err = templBuffer.WriteString(templ.EscapeString(n.ID))
+			if err != nil {
+				return err
+			}
 			_, err = templBuffer.WriteString("\"><div class=\"grow-0 shrink-0\">")
 			if err != nil {
 				return err
@@ -196,8 +379,7 @@ func Event(n *notification.Notification) templ.Component {
 			if err != nil {
 				return err
 			}
-			var var_9 string = n.Message
-			_, err = templBuffer.WriteString(templ.EscapeString(var_9))
+			err = ansiMessage(n.Message).Render(ctx, templBuffer)
 			if err != nil {
 				return err
 			}
@@ -224,6 +406,14 @@ func Event(n *notification.Notification) templ.Component {
 			if err != nil {
 				return err
 			}
+			_, err = templBuffer.WriteString("\" data-event-id=\"")
+			if err != nil {
+				return err
+			}
+			_, err = templBuffer.WriteString(templ.EscapeString(n.ID))
+			if err != nil {
+				return err
+			}
 			_, err = templBuffer.WriteString("\"><div class=\"w-36 grow-0 shrink-0\">")
 			if err != nil {
 				return err
@@ -237,8 +427,7 @@ func Event(n *notification.Notification) templ.Component {
 			if err != nil {
 				return err
 			}
-			var var_11 string = n.Message
-			_, err = templBuffer.WriteString(templ.EscapeString(var_11))
+			err = ansiMessage(n.Message).Render(ctx, templBuffer)
 			if err != nil {
 				return err
 			}
@@ -264,7 +453,7 @@ func Event(n *notification.Notification) templ.Component {
 	})
 }
 
-func EmptyRun(id string) templ.Component {
+func BuildErrorPanel(n *notification.Notification) templ.Component {
 	return templ.ComponentFunc(func(ctx context.Context, w io.Writer) (err error) {
 		templBuffer, templIsBuffer := w.(*bytes.Buffer)
 		if !templIsBuffer {
@@ -272,20 +461,107 @@ func EmptyRun(id string) templ.Component {
 			defer templ.ReleaseBuffer(templBuffer)
 		}
 		ctx = templ.InitializeContext(ctx)
-		var_12 := templ.GetChildren(ctx)
-		if var_12 == nil {
-			var_12 = templ.NopComponent
+		var_22 := templ.GetChildren(ctx)
+		if var_22 == nil {
+			var_22 = templ.NopComponent
 		}
 		ctx = templ.ClearChildren(ctx)
-		_, err = templBuffer.WriteString("<hr class=\"h-px my-8 bg-green-400 border-0 dark:bg-green-700\"><div class=\"my-4\" id=\"")
+		_, err = templBuffer.WriteString("<details open class=\"log-entry build-error text-red-400\" data-event-type=\"")
 		if err != nil {
 			return err
 		}
-		_, err = templBuffer.WriteString(templ.EscapeString(id))
+		_, err = templBuffer.WriteString(templ.EscapeString(strconv.Itoa(int(n.Type))))
 		if err != nil {
 			return err
 		}
-		_, err = templBuffer.WriteString("\"></div>")
+		_, err = templBuffer.WriteString("\" data-event-id=\"")
+		if err != nil {
+			return err
+		}
+		_, err = templBuffer.WriteString(templ.EscapeString(n.ID))
+		if err != nil {
+			return err
+		}
+		_, err = templBuffer.WriteString("\"><summary class=\"cursor-pointer\"><span>")
+		if err != nil {
+			return err
+		}
+		var var_23 string = n.Date.Format("15:04:05.000")
+		_, err = templBuffer.WriteString(templ.EscapeString(var_23))
+		if err != nil {
+			return err
+		}
+		_, err = templBuffer.WriteString("</span> <span class=\"log-text\">")
+		if err != nil {
+			return err
+		}
+		var_24 := `build failed`
+		_, err = templBuffer.WriteString(var_24)
+		if err != nil {
+			return err
+		}
+		_, err = templBuffer.WriteString("</span></summary><div class=\"build-error-lines\">")
+		if err != nil {
+			return err
+		}
+		for _, line := range utils.ParseBuildErrors(n.Message) {
+			_, err = templBuffer.WriteString("<div>")
+			if err != nil {
+				return err
+			}
+			if line.File != "" {
+				_, err = templBuffer.WriteString("<a href=\"")
+				if err != nil {
+					return err
+				}
+				var var_25 templ.SafeURL = editorLink(line)
+				_, err = templBuffer.WriteString(templ.EscapeString(string(var_25)))
+				if err != nil {
+					return err
+				}
+				_, err = templBuffer.WriteString("\" class=\"text-blue-400 underline\">")
+				if err != nil {
+					return err
+				}
+				var var_26 string = line.Location()
+				_, err = templBuffer.WriteString(templ.EscapeString(var_26))
+				if err != nil {
+					return err
+				}
+				_, err = templBuffer.WriteString("</a> <span class=\"text-red-400\">")
+				if err != nil {
+					return err
+				}
+				var var_27 string = line.Text
+				_, err = templBuffer.WriteString(templ.EscapeString(var_27))
+				if err != nil {
+					return err
+				}
+				_, err = templBuffer.WriteString("</span>")
+				if err != nil {
+					return err
+				}
+			} else {
+				_, err = templBuffer.WriteString("<span class=\"build-error-header\">")
+				if err != nil {
+					return err
+				}
+				var var_28 string = line.Text
+				_, err = templBuffer.WriteString(templ.EscapeString(var_28))
+				if err != nil {
+					return err
+				}
+				_, err = templBuffer.WriteString("</span>")
+				if err != nil {
+					return err
+				}
+			}
+			_, err = templBuffer.WriteString("</div>")
+			if err != nil {
+				return err
+			}
+		}
+		_, err = templBuffer.WriteString("</div></details>")
 		if err != nil {
 			return err
 		}
@@ -296,7 +572,7 @@ func EmptyRun(id string) templ.Component {
 	})
 }
 
-func EventList(notifs [][]*notification.Notification) templ.Component {
+func StartupBanner(n *notification.Notification) templ.Component {
 	return templ.ComponentFunc(func(ctx context.Context, w io.Writer) (err error) {
 		templBuffer, templIsBuffer := w.(*bytes.Buffer)
 		if !templIsBuffer {
@@ -304,31 +580,1107 @@ func EventList(notifs [][]*notification.Notification) templ.Component {
 			defer templ.ReleaseBuffer(templBuffer)
 		}
 		ctx = templ.InitializeContext(ctx)
-		var_13 := templ.GetChildren(ctx)
-		if var_13 == nil {
-			var_13 = templ.NopComponent
+		var_62 := templ.GetChildren(ctx)
+		if var_62 == nil {
+			var_62 = templ.NopComponent
 		}
 		ctx = templ.ClearChildren(ctx)
-		for _, run := range notifs {
-			_, err = templBuffer.WriteString("<hr class=\"h-px my-8 bg-green-400 border-0 dark:bg-green-700\"> <div class=\"my-4\" id=\"")
-			if err != nil {
-				return err
-			}
-			_, err = templBuffer.WriteString(templ.EscapeString(run[0].ChildProccessID))
-			if err != nil {
-				return err
-			}
-			_, err = templBuffer.WriteString("\">")
+		_, err = templBuffer.WriteString("<div class=\"log-entry startup-banner flex flex-row gap-4 items-stretch text-blue-400\" data-event-type=\"")
+		if err != nil {
+			return err
+		}
+		_, err = templBuffer.WriteString(templ.EscapeString(strconv.Itoa(int(n.Type))))
+		if err != nil {
+			return err
+		}
+		_, err = templBuffer.WriteString("\" data-event-id=\"")
+		if err != nil {
+			return err
+		}
+		_, err = templBuffer.WriteString(templ.EscapeString(n.ID))
+		if err != nil {
+			return err
+		}
+		_, err = templBuffer.WriteString("\"><div class=\"grow-0 shrink-0\">")
+		if err != nil {
+			return err
+		}
+		var var_63 string = n.Date.Format("15:04:05.000")
+		_, err = templBuffer.WriteString(templ.EscapeString(var_63))
+		if err != nil {
+			return err
+		}
+		_, err = templBuffer.WriteString("</div><div class=\"flex flex-col\">")
+		if err != nil {
+			return err
+		}
+		for _, line := range bannerLines(n.Message) {
+			_, err = templBuffer.WriteString("<div>")
 			if err != nil {
 				return err
 			}
-			for _, n := range run {
-				err = Event(n).Render(ctx, templBuffer)
+			if line.URL != "" {
+				_, err = templBuffer.WriteString("<span>")
 				if err != nil {
 					return err
 				}
-			}
-			_, err = templBuffer.WriteString("</div>")
+				var var_64 string = line.Label
+				_, err = templBuffer.WriteString(templ.EscapeString(var_64))
+				if err != nil {
+					return err
+				}
+				_, err = templBuffer.WriteString("</span> <a href=\"")
+				if err != nil {
+					return err
+				}
+				var var_65 templ.SafeURL = templ.URL(line.URL)
+				_, err = templBuffer.WriteString(templ.EscapeString(string(var_65)))
+				if err != nil {
+					return err
+				}
+				_, err = templBuffer.WriteString("\" target=\"_blank\" class=\"underline\">")
+				if err != nil {
+					return err
+				}
+				var var_66 string = line.URL
+				_, err = templBuffer.WriteString(templ.EscapeString(var_66))
+				if err != nil {
+					return err
+				}
+				_, err = templBuffer.WriteString("</a>")
+				if err != nil {
+					return err
+				}
+			} else {
+				_, err = templBuffer.WriteString("<span class=\"log-text\">")
+				if err != nil {
+					return err
+				}
+				var var_67 string = line.Text
+				_, err = templBuffer.WriteString(templ.EscapeString(var_67))
+				if err != nil {
+					return err
+				}
+				_, err = templBuffer.WriteString("</span>")
+				if err != nil {
+					return err
+				}
+			}
+			_, err = templBuffer.WriteString("</div>")
+			if err != nil {
+				return err
+			}
+		}
+		_, err = templBuffer.WriteString("</div></div>")
+		if err != nil {
+			return err
+		}
+		if !templIsBuffer {
+			_, err = templBuffer.WriteTo(w)
+		}
+		return err
+	})
+}
+
+func TestResult(n *notification.Notification) templ.Component {
+	return templ.ComponentFunc(func(ctx context.Context, w io.Writer) (err error) {
+		templBuffer, templIsBuffer := w.(*bytes.Buffer)
+		if !templIsBuffer {
+			templBuffer = templ.GetBuffer()
+			defer templ.ReleaseBuffer(templBuffer)
+		}
+		ctx = templ.InitializeContext(ctx)
+		var_32 := templ.GetChildren(ctx)
+		if var_32 == nil {
+			var_32 = templ.NopComponent
+		}
+		ctx = templ.ClearChildren(ctx)
+		_, err = templBuffer.WriteString("<div class=\"log-entry flex flex-row gap-4 items-stretch\" data-event-type=\"")
+		if err != nil {
+			return err
+		}
+		_, err = templBuffer.WriteString(templ.EscapeString(strconv.Itoa(int(n.Type))))
+		if err != nil {
+			return err
+		}
+		_, err = templBuffer.WriteString("\" data-event-id=\"")
+		if err != nil {
+			return err
+		}
+		_, err = templBuffer.WriteString(templ.EscapeString(n.ID))
+		if err != nil {
+			return err
+		}
+		_, err = templBuffer.WriteString("\"><div class=\"grow-0 shrink-0\">")
+		if err != nil {
+			return err
+		}
+		var var_33 string = n.Date.Format("15:04:05.000")
+		_, err = templBuffer.WriteString(templ.EscapeString(var_33))
+		if err != nil {
+			return err
+		}
+		_, err = templBuffer.WriteString("</div> ")
+		if err != nil {
+			return err
+		}
+		if n.Type == notification.NotificationTypeTestPass {
+			_, err = templBuffer.WriteString("<span class=\"test-badge test-pass\">")
+			if err != nil {
+				return err
+			}
+			var_34 := `PASS`
+			_, err = templBuffer.WriteString(var_34)
+			if err != nil {
+				return err
+			}
+			_, err = templBuffer.WriteString("</span>")
+			if err != nil {
+				return err
+			}
+		} else {
+			_, err = templBuffer.WriteString("<span class=\"test-badge test-fail\">")
+			if err != nil {
+				return err
+			}
+			var_35 := `FAIL`
+			_, err = templBuffer.WriteString(var_35)
+			if err != nil {
+				return err
+			}
+			_, err = templBuffer.WriteString("</span>")
+			if err != nil {
+				return err
+			}
+		}
+		_, err = templBuffer.WriteString(" <span class=\"log-text break-all grow\">")
+		if err != nil {
+			return err
+		}
+		var var_36 string = n.Message
+		_, err = templBuffer.WriteString(templ.EscapeString(var_36))
+		if err != nil {
+			return err
+		}
+		_, err = templBuffer.WriteString("</span></div>")
+		if err != nil {
+			return err
+		}
+		if !templIsBuffer {
+			_, err = templBuffer.WriteTo(w)
+		}
+		return err
+	})
+}
+
+func RunTrigger(reason string) templ.Component {
+	return templ.ComponentFunc(func(ctx context.Context, w io.Writer) (err error) {
+		templBuffer, templIsBuffer := w.(*bytes.Buffer)
+		if !templIsBuffer {
+			templBuffer = templ.GetBuffer()
+			defer templ.ReleaseBuffer(templBuffer)
+		}
+		ctx = templ.InitializeContext(ctx)
+		var_45 := templ.GetChildren(ctx)
+		if var_45 == nil {
+			var_45 = templ.NopComponent
+		}
+		ctx = templ.ClearChildren(ctx)
+		if reason != "" {
+			_, err = templBuffer.WriteString("<div class=\"run-trigger\">")
+			if err != nil {
+				return err
+			}
+			var var_46 string = reason
+			_, err = templBuffer.WriteString(templ.EscapeString(var_46))
+			if err != nil {
+				return err
+			}
+			_, err = templBuffer.WriteString("</div>")
+			if err != nil {
+				return err
+			}
+		}
+		if !templIsBuffer {
+			_, err = templBuffer.WriteTo(w)
+		}
+		return err
+	})
+}
+
+func RunErrors(count int) templ.Component {
+	return templ.ComponentFunc(func(ctx context.Context, w io.Writer) (err error) {
+		templBuffer, templIsBuffer := w.(*bytes.Buffer)
+		if !templIsBuffer {
+			templBuffer = templ.GetBuffer()
+			defer templ.ReleaseBuffer(templBuffer)
+		}
+		ctx = templ.InitializeContext(ctx)
+		var_47 := templ.GetChildren(ctx)
+		if var_47 == nil {
+			var_47 = templ.NopComponent
+		}
+		ctx = templ.ClearChildren(ctx)
+		if count > 0 {
+			_, err = templBuffer.WriteString("<div class=\"run-errors\"><span class=\"error-badge\">")
+			if err != nil {
+				return err
+			}
+			var var_48 string = errorCountLabel(count)
+			_, err = templBuffer.WriteString(templ.EscapeString(var_48))
+			if err != nil {
+				return err
+			}
+			_, err = templBuffer.WriteString("</span></div>")
+			if err != nil {
+				return err
+			}
+		}
+		if !templIsBuffer {
+			_, err = templBuffer.WriteTo(w)
+		}
+		return err
+	})
+}
+
+func EmptyRun(id string, reason string) templ.Component {
+	return templ.ComponentFunc(func(ctx context.Context, w io.Writer) (err error) {
+		templBuffer, templIsBuffer := w.(*bytes.Buffer)
+		if !templIsBuffer {
+			templBuffer = templ.GetBuffer()
+			defer templ.ReleaseBuffer(templBuffer)
+		}
+		ctx = templ.InitializeContext(ctx)
+		var_12 := templ.GetChildren(ctx)
+		if var_12 == nil {
+			var_12 = templ.NopComponent
+		}
+		ctx = templ.ClearChildren(ctx)
+		_, err = templBuffer.WriteString("<hr class=\"h-px my-8 bg-green-400 border-0 dark:bg-green-700\">")
+		if err != nil {
+			return err
+		}
+		err = RunTrigger(reason).Render(ctx, templBuffer)
+		if err != nil {
+			return err
+		}
+		_, err = templBuffer.WriteString("<div class=\"my-4\" id=\"")
+		if err != nil {
+			return err
+		}
+		_, err = templBuffer.WriteString(templ.EscapeString(id))
+		if err != nil {
+			return err
+		}
+		_, err = templBuffer.WriteString("\"></div>")
+		if err != nil {
+			return err
+		}
+		if !templIsBuffer {
+			_, err = templBuffer.WriteTo(w)
+		}
+		return err
+	})
+}
+
+func EventList(notifs [][]*notification.Notification, triggers map[string][]*utils.RunTrigger, errorCounts map[string]int) templ.Component {
+	return templ.ComponentFunc(func(ctx context.Context, w io.Writer) (err error) {
+		templBuffer, templIsBuffer := w.(*bytes.Buffer)
+		if !templIsBuffer {
+			templBuffer = templ.GetBuffer()
+			defer templ.ReleaseBuffer(templBuffer)
+		}
+		ctx = templ.InitializeContext(ctx)
+		var_13 := templ.GetChildren(ctx)
+		if var_13 == nil {
+			var_13 = templ.NopComponent
+		}
+		ctx = templ.ClearChildren(ctx)
+		for _, run := range notifs {
+			_, err = templBuffer.WriteString("<hr class=\"h-px my-8 bg-green-400 border-0 dark:bg-green-700\">")
+			if err != nil {
+				return err
+			}
+			err = RunTrigger(restartReason(triggers[run[0].ChildProccessID])).Render(ctx, templBuffer)
+			if err != nil {
+				return err
+			}
+			err = RunErrors(errorCounts[run[0].ChildProccessID]).Render(ctx, templBuffer)
+			if err != nil {
+				return err
+			}
+			_, err = templBuffer.WriteString("<div class=\"my-4\" id=\"")
+			if err != nil {
+				return err
+			}
+			_, err = templBuffer.WriteString(templ.EscapeString(run[0].ChildProccessID))
+			if err != nil {
+				return err
+			}
+			_, err = templBuffer.WriteString("\">")
+			if err != nil {
+				return err
+			}
+			for _, n := range run {
+				err = Event(n).Render(ctx, templBuffer)
+				if err != nil {
+					return err
+				}
+			}
+			_, err = templBuffer.WriteString("</div>")
+			if err != nil {
+				return err
+			}
+		}
+		if !templIsBuffer {
+			_, err = templBuffer.WriteTo(w)
+		}
+		return err
+	})
+}
+
+func TaskPanel(basePath string, taskID string, title string) templ.Component {
+	return templ.ComponentFunc(func(ctx context.Context, w io.Writer) (err error) {
+		templBuffer, templIsBuffer := w.(*bytes.Buffer)
+		if !templIsBuffer {
+			templBuffer = templ.GetBuffer()
+			defer templ.ReleaseBuffer(templBuffer)
+		}
+		ctx = templ.InitializeContext(ctx)
+		var_14 := templ.GetChildren(ctx)
+		if var_14 == nil {
+			var_14 = templ.NopComponent
+		}
+		ctx = templ.ClearChildren(ctx)
+		_, err = templBuffer.WriteString("<div id=\"")
+		if err != nil {
+			return err
+		}
+		_, err = templBuffer.WriteString(templ.EscapeString("task-" + taskID))
+		if err != nil {
+			return err
+		}
+		_, err = templBuffer.WriteString("\" class=\"flex flex-col text-yellow-400\"><div class=\"flex flex-row justify-between gap-4\"><span>")
+		if err != nil {
+			return err
+		}
+		var var_15 string = title
+		_, err = templBuffer.WriteString(templ.EscapeString(var_15))
+		if err != nil {
+			return err
+		}
+		_, err = templBuffer.WriteString("</span><span id=\"")
+		if err != nil {
+			return err
+		}
+		_, err = templBuffer.WriteString(templ.EscapeString("task-" + taskID + "-status"))
+		if err != nil {
+			return err
+		}
+		_, err = templBuffer.WriteString("\">")
+		if err != nil {
+			return err
+		}
+		var_16 := `running`
+		_, err = templBuffer.WriteString(var_16)
+		if err != nil {
+			return err
+		}
+		_, err = templBuffer.WriteString(" <button class=\"btn btn-xs btn-ghost\" hx-post=\"")
+		if err != nil {
+			return err
+		}
+		_, err = templBuffer.WriteString(templ.EscapeString(basePath + "/actions/cancel-task?taskId=" + taskID))
+		if err != nil {
+			return err
+		}
+		_, err = templBuffer.WriteString("\" hx-swap=\"none\">")
+		if err != nil {
+			return err
+		}
+		var_71 := `cancel`
+		_, err = templBuffer.WriteString(var_71)
+		if err != nil {
+			return err
+		}
+		_, err = templBuffer.WriteString("</button></span></div><div id=\"")
+		if err != nil {
+			return err
+		}
+		_, err = templBuffer.WriteString(templ.EscapeString("task-" + taskID + "-output"))
+		if err != nil {
+			return err
+		}
+		_, err = templBuffer.WriteString("\" class=\"task-output\"></div></div>")
+		if err != nil {
+			return err
+		}
+		if !templIsBuffer {
+			_, err = templBuffer.WriteTo(w)
+		}
+		return err
+	})
+}
+
+func TaskStatus(taskID string, status string) templ.Component {
+	return templ.ComponentFunc(func(ctx context.Context, w io.Writer) (err error) {
+		templBuffer, templIsBuffer := w.(*bytes.Buffer)
+		if !templIsBuffer {
+			templBuffer = templ.GetBuffer()
+			defer templ.ReleaseBuffer(templBuffer)
+		}
+		ctx = templ.InitializeContext(ctx)
+		var_17 := templ.GetChildren(ctx)
+		if var_17 == nil {
+			var_17 = templ.NopComponent
+		}
+		ctx = templ.ClearChildren(ctx)
+		_, err = templBuffer.WriteString("<span id=\"")
+		if err != nil {
+			return err
+		}
+		_, err = templBuffer.WriteString(templ.EscapeString("task-" + taskID + "-status"))
+		if err != nil {
+			return err
+		}
+		_, err = templBuffer.WriteString("\">")
+		if err != nil {
+			return err
+		}
+		var var_18 string = status
+		_, err = templBuffer.WriteString(templ.EscapeString(var_18))
+		if err != nil {
+			return err
+		}
+		_, err = templBuffer.WriteString("</span>")
+		if err != nil {
+			return err
+		}
+		if !templIsBuffer {
+			_, err = templBuffer.WriteTo(w)
+		}
+		return err
+	})
+}
+
+func RetentionStatus(status *utils.RetentionStatus) templ.Component {
+	return templ.ComponentFunc(func(ctx context.Context, w io.Writer) (err error) {
+		templBuffer, templIsBuffer := w.(*bytes.Buffer)
+		if !templIsBuffer {
+			templBuffer = templ.GetBuffer()
+			defer templ.ReleaseBuffer(templBuffer)
+		}
+		ctx = templ.InitializeContext(ctx)
+		var_29 := templ.GetChildren(ctx)
+		if var_29 == nil {
+			var_29 = templ.NopComponent
+		}
+		ctx = templ.ClearChildren(ctx)
+		if status != nil && status.MemoryRing {
+			_, err = templBuffer.WriteString("<span class=\"history-full\" title=\"")
+			if err != nil {
+				return err
+			}
+			_, err = templBuffer.WriteString(templ.EscapeString(historyFullDetail(status)))
+			if err != nil {
+				return err
+			}
+			_, err = templBuffer.WriteString("\">")
+			if err != nil {
+				return err
+			}
+			var_31 := `history full, in memory only`
+			_, err = templBuffer.WriteString(var_31)
+			if err != nil {
+				return err
+			}
+			_, err = templBuffer.WriteString("</span>")
+			if err != nil {
+				return err
+			}
+		}
+		if status != nil && status.LastRun != nil {
+			_, err = templBuffer.WriteString("<span class=\"retention-status\" title=\"")
+			if err != nil {
+				return err
+			}
+			_, err = templBuffer.WriteString(templ.EscapeString(retentionDetail(status)))
+			if err != nil {
+				return err
+			}
+			_, err = templBuffer.WriteString("\">")
+			if err != nil {
+				return err
+			}
+			var var_30 string = retentionSummary(status)
+			_, err = templBuffer.WriteString(templ.EscapeString(var_30))
+			if err != nil {
+				return err
+			}
+			_, err = templBuffer.WriteString("</span>")
+			if err != nil {
+				return err
+			}
+		}
+		if !templIsBuffer {
+			_, err = templBuffer.WriteTo(w)
+		}
+		return err
+	})
+}
+
+func TaskSelect(tasks []string) templ.Component {
+	return templ.ComponentFunc(func(ctx context.Context, w io.Writer) (err error) {
+		templBuffer, templIsBuffer := w.(*bytes.Buffer)
+		if !templIsBuffer {
+			templBuffer = templ.GetBuffer()
+			defer templ.ReleaseBuffer(templBuffer)
+		}
+		ctx = templ.InitializeContext(ctx)
+		var_19 := templ.GetChildren(ctx)
+		if var_19 == nil {
+			var_19 = templ.NopComponent
+		}
+		ctx = templ.ClearChildren(ctx)
+		if len(tasks) > 0 {
+			_, err = templBuffer.WriteString("<select id=\"task-select\" name=\"task\" class=\"select select-sm select-bordered\"><option value=\"\" selected disabled>")
+			if err != nil {
+				return err
+			}
+			var_20 := `Run task...`
+			_, err = templBuffer.WriteString(var_20)
+			if err != nil {
+				return err
+			}
+			_, err = templBuffer.WriteString("</option>")
+			if err != nil {
+				return err
+			}
+			for _, t := range tasks {
+				_, err = templBuffer.WriteString("<option value=\"")
+				if err != nil {
+					return err
+				}
+				_, err = templBuffer.WriteString(templ.EscapeString(t))
+				if err != nil {
+					return err
+				}
+				_, err = templBuffer.WriteString("\">")
+				if err != nil {
+					return err
+				}
+				var var_21 string = t
+				_, err = templBuffer.WriteString(templ.EscapeString(var_21))
+				if err != nil {
+					return err
+				}
+				_, err = templBuffer.WriteString("</option>")
+				if err != nil {
+					return err
+				}
+			}
+			_, err = templBuffer.WriteString("</select>")
+			if err != nil {
+				return err
+			}
+		}
+		if !templIsBuffer {
+			_, err = templBuffer.WriteTo(w)
+		}
+		return err
+	})
+}
+
+func RunCompareSelect(name string, runs []*notification.Notification, labels map[string][]string, selected string) templ.Component {
+	return templ.ComponentFunc(func(ctx context.Context, w io.Writer) (err error) {
+		templBuffer, templIsBuffer := w.(*bytes.Buffer)
+		if !templIsBuffer {
+			templBuffer = templ.GetBuffer()
+			defer templ.ReleaseBuffer(templBuffer)
+		}
+		ctx = templ.InitializeContext(ctx)
+		var_37 := templ.GetChildren(ctx)
+		if var_37 == nil {
+			var_37 = templ.NopComponent
+		}
+		ctx = templ.ClearChildren(ctx)
+		_, err = templBuffer.WriteString("<select name=\"")
+		if err != nil {
+			return err
+		}
+		_, err = templBuffer.WriteString(templ.EscapeString(name))
+		if err != nil {
+			return err
+		}
+		_, err = templBuffer.WriteString("\" class=\"select select-sm select-bordered w-48 text-slate-900\">")
+		if err != nil {
+			return err
+		}
+		for _, r := range runs {
+			_, err = templBuffer.WriteString("<option value=\"")
+			if err != nil {
+				return err
+			}
+			_, err = templBuffer.WriteString(templ.EscapeString(r.ChildProccessID))
+			if err != nil {
+				return err
+			}
+			_, err = templBuffer.WriteString("\"")
+			if err != nil {
+				return err
+			}
+			if r.ChildProccessID == selected {
+				if true {
+					_, err = templBuffer.WriteString(" selected")
+					if err != nil {
+						return err
+					}
+				}
+			}
+			_, err = templBuffer.WriteString(">")
+			if err != nil {
+				return err
+			}
+			var var_38 string = runOptionText(r, labels[r.ChildProccessID])
+			_, err = templBuffer.WriteString(templ.EscapeString(var_38))
+			if err != nil {
+				return err
+			}
+			_, err = templBuffer.WriteString("</option>")
+			if err != nil {
+				return err
+			}
+		}
+		_, err = templBuffer.WriteString("</select>")
+		if err != nil {
+			return err
+		}
+		if !templIsBuffer {
+			_, err = templBuffer.WriteTo(w)
+		}
+		return err
+	})
+}
+
+func RunSummaryHeader(name string, runs []*notification.Notification, labels map[string][]string, summary *utils.RunSummary) templ.Component {
+	return templ.ComponentFunc(func(ctx context.Context, w io.Writer) (err error) {
+		templBuffer, templIsBuffer := w.(*bytes.Buffer)
+		if !templIsBuffer {
+			templBuffer = templ.GetBuffer()
+			defer templ.ReleaseBuffer(templBuffer)
+		}
+		ctx = templ.InitializeContext(ctx)
+		var_39 := templ.GetChildren(ctx)
+		if var_39 == nil {
+			var_39 = templ.NopComponent
+		}
+		ctx = templ.ClearChildren(ctx)
+		_, err = templBuffer.WriteString("<th>")
+		if err != nil {
+			return err
+		}
+		err = RunCompareSelect(name, runs, labels, summary.Start.ChildProccessID).Render(ctx, templBuffer)
+		if err != nil {
+			return err
+		}
+		_, err = templBuffer.WriteString("<div class=\"run-status\">")
+		if err != nil {
+			return err
+		}
+		var var_40 string = runStatus(summary)
+		_, err = templBuffer.WriteString(templ.EscapeString(var_40))
+		if err != nil {
+			return err
+		}
+		_, err = templBuffer.WriteString("</div> ")
+		if err != nil {
+			return err
+		}
+		if summary.Crash != nil {
+			_, err = templBuffer.WriteString("<div class=\"text-red-500\">")
+			if err != nil {
+				return err
+			}
+			var var_41 string = summary.Crash.Message
+			_, err = templBuffer.WriteString(templ.EscapeString(var_41))
+			if err != nil {
+				return err
+			}
+			_, err = templBuffer.WriteString("</div>")
+			if err != nil {
+				return err
+			}
+		}
+		_, err = templBuffer.WriteString("</th>")
+		if err != nil {
+			return err
+		}
+		if !templIsBuffer {
+			_, err = templBuffer.WriteTo(w)
+		}
+		return err
+	})
+}
+
+func RunDiff(cmp *runComparison) templ.Component {
+	return templ.ComponentFunc(func(ctx context.Context, w io.Writer) (err error) {
+		templBuffer, templIsBuffer := w.(*bytes.Buffer)
+		if !templIsBuffer {
+			templBuffer = templ.GetBuffer()
+			defer templ.ReleaseBuffer(templBuffer)
+		}
+		ctx = templ.InitializeContext(ctx)
+		var_42 := templ.GetChildren(ctx)
+		if var_42 == nil {
+			var_42 = templ.NopComponent
+		}
+		ctx = templ.ClearChildren(ctx)
+		if cmp.Before == nil || cmp.After == nil {
+			_, err = templBuffer.WriteString("<div class=\"text-2xl text-bold\">")
+			if err != nil {
+				return err
+			}
+			var_43 := `not enough runs to compare`
+			_, err = templBuffer.WriteString(var_43)
+			if err != nil {
+				return err
+			}
+			_, err = templBuffer.WriteString("</div>")
+			if err != nil {
+				return err
+			}
+		} else {
+			_, err = templBuffer.WriteString("<table class=\"run-diff\"><thead><tr>")
+			if err != nil {
+				return err
+			}
+			err = RunSummaryHeader("a", cmp.Runs, cmp.Labels, cmp.Before).Render(ctx, templBuffer)
+			if err != nil {
+				return err
+			}
+			err = RunSummaryHeader("b", cmp.Runs, cmp.Labels, cmp.After).Render(ctx, templBuffer)
+			if err != nil {
+				return err
+			}
+			_, err = templBuffer.WriteString("</tr></thead><tbody>")
+			if err != nil {
+				return err
+			}
+			for _, row := range cmp.Rows {
+				_, err = templBuffer.WriteString("<tr data-diff=\"")
+				if err != nil {
+					return err
+				}
+				_, err = templBuffer.WriteString(templ.EscapeString(string(row.Op)))
+				if err != nil {
+					return err
+				}
+				_, err = templBuffer.WriteString("\"><td>")
+				if err != nil {
+					return err
+				}
+				err = ansiMessage(row.Before).Render(ctx, templBuffer)
+				if err != nil {
+					return err
+				}
+				_, err = templBuffer.WriteString("</td><td>")
+				if err != nil {
+					return err
+				}
+				err = ansiMessage(row.After).Render(ctx, templBuffer)
+				if err != nil {
+					return err
+				}
+				_, err = templBuffer.WriteString("</td></tr>")
+				if err != nil {
+					return err
+				}
+			}
+			_, err = templBuffer.WriteString("</tbody></table> ")
+			if err != nil {
+				return err
+			}
+			if len(cmp.Rows) == 0 {
+				_, err = templBuffer.WriteString("<div>")
+				if err != nil {
+					return err
+				}
+				var_44 := `neither run wrote to stderr`
+				_, err = templBuffer.WriteString(var_44)
+				if err != nil {
+					return err
+				}
+				_, err = templBuffer.WriteString("</div>")
+				if err != nil {
+					return err
+				}
+			}
+		}
+		if !templIsBuffer {
+			_, err = templBuffer.WriteTo(w)
+		}
+		return err
+	})
+}
+
+func Timeline(runs []*utils.TimelineRun) templ.Component {
+	return templ.ComponentFunc(func(ctx context.Context, w io.Writer) (err error) {
+		templBuffer, templIsBuffer := w.(*bytes.Buffer)
+		if !templIsBuffer {
+			templBuffer = templ.GetBuffer()
+			defer templ.ReleaseBuffer(templBuffer)
+		}
+		ctx = templ.InitializeContext(ctx)
+		var_49 := templ.GetChildren(ctx)
+		if var_49 == nil {
+			var_49 = templ.NopComponent
+		}
+		ctx = templ.ClearChildren(ctx)
+		if len(runs) == 0 {
+			_, err = templBuffer.WriteString("<div class=\"text-2xl text-bold\">")
+			if err != nil {
+				return err
+			}
+			var_50 := `no runs yet`
+			_, err = templBuffer.WriteString(var_50)
+			if err != nil {
+				return err
+			}
+			_, err = templBuffer.WriteString("</div>")
+			if err != nil {
+				return err
+			}
+		} else {
+			_, err = templBuffer.WriteString("<div class=\"timeline-legend\"><span class=\"timeline-key timeline-restart\">")
+			if err != nil {
+				return err
+			}
+			var_51 := `restart`
+			_, err = templBuffer.WriteString(var_51)
+			if err != nil {
+				return err
+			}
+			_, err = templBuffer.WriteString("</span><span class=\"timeline-key timeline-build\">")
+			if err != nil {
+				return err
+			}
+			var_52 := `build error`
+			_, err = templBuffer.WriteString(var_52)
+			if err != nil {
+				return err
+			}
+			_, err = templBuffer.WriteString("</span><span class=\"timeline-key timeline-task\">")
+			if err != nil {
+				return err
+			}
+			var_53 := `task`
+			_, err = templBuffer.WriteString(var_53)
+			if err != nil {
+				return err
+			}
+			_, err = templBuffer.WriteString("</span><span class=\"timeline-key timeline-ipc\">")
+			if err != nil {
+				return err
+			}
+			var_54 := `IPC`
+			_, err = templBuffer.WriteString(var_54)
+			if err != nil {
+				return err
+			}
+			_, err = templBuffer.WriteString("</span><span class=\"timeline-key timeline-crash\">")
+			if err != nil {
+				return err
+			}
+			var_55 := `crash`
+			_, err = templBuffer.WriteString(var_55)
+			if err != nil {
+				return err
+			}
+			_, err = templBuffer.WriteString("</span></div><div id=\"timeline\" class=\"timeline\" data-timeline=\"")
+			if err != nil {
+				return err
+			}
+			_, err = templBuffer.WriteString(templ.EscapeString(timelineJSON(runs)))
+			if err != nil {
+				return err
+			}
+			_, err = templBuffer.WriteString("\"></div>")
+			if err != nil {
+				return err
+			}
+		}
+		if !templIsBuffer {
+			_, err = templBuffer.WriteTo(w)
+		}
+		return err
+	})
+}
+
+func Resources(runs []*utils.ResourceRun) templ.Component {
+	return templ.ComponentFunc(func(ctx context.Context, w io.Writer) (err error) {
+		templBuffer, templIsBuffer := w.(*bytes.Buffer)
+		if !templIsBuffer {
+			templBuffer = templ.GetBuffer()
+			defer templ.ReleaseBuffer(templBuffer)
+		}
+		ctx = templ.InitializeContext(ctx)
+		var_56 := templ.GetChildren(ctx)
+		if var_56 == nil {
+			var_56 = templ.NopComponent
+		}
+		ctx = templ.ClearChildren(ctx)
+		if len(runs) == 0 {
+			_, err = templBuffer.WriteString("<div class=\"text-2xl text-bold\">")
+			if err != nil {
+				return err
+			}
+			var_57 := `no samples yet, set resources.enabled in the config file`
+			_, err = templBuffer.WriteString(var_57)
+			if err != nil {
+				return err
+			}
+			_, err = templBuffer.WriteString("</div>")
+			if err != nil {
+				return err
+			}
+		} else {
+			_, err = templBuffer.WriteString("<div id=\"resources\" class=\"resources\" data-resources=\"")
+			if err != nil {
+				return err
+			}
+			_, err = templBuffer.WriteString(templ.EscapeString(resourcesJSON(runs)))
+			if err != nil {
+				return err
+			}
+			_, err = templBuffer.WriteString("\"></div>")
+			if err != nil {
+				return err
+			}
+		}
+		if !templIsBuffer {
+			_, err = templBuffer.WriteTo(w)
+		}
+		return err
+	})
+}
+
+func RestartSparkline(s restartSparkline) templ.Component {
+	return templ.ComponentFunc(func(ctx context.Context, w io.Writer) (err error) {
+		templBuffer, templIsBuffer := w.(*bytes.Buffer)
+		if !templIsBuffer {
+			templBuffer = templ.GetBuffer()
+			defer templ.ReleaseBuffer(templBuffer)
+		}
+		ctx = templ.InitializeContext(ctx)
+		var_68 := templ.GetChildren(ctx)
+		if var_68 == nil {
+			var_68 = templ.NopComponent
+		}
+		ctx = templ.ClearChildren(ctx)
+		if s.Latest != "" {
+			_, err = templBuffer.WriteString("<div class=\"tooltip tooltip-bottom\" data-tip=\"")
+			if err != nil {
+				return err
+			}
+			_, err = templBuffer.WriteString(templ.EscapeString(s.Tip))
+			if err != nil {
+				return err
+			}
+			_, err = templBuffer.WriteString("\"><div class=\"flex items-center gap-1 px-2 text-sm\"><svg width=\"80\" height=\"20\" viewBox=\"0 0 80 20\"><polyline points=\"")
+			if err != nil {
+				return err
+			}
+			_, err = templBuffer.WriteString(templ.EscapeString(s.Points))
+			if err != nil {
+				return err
+			}
+			_, err = templBuffer.WriteString("\" fill=\"none\" stroke=\"currentColor\" stroke-width=\"1.5\"></polyline></svg>")
+			if err != nil {
+				return err
+			}
+			if s.Regressed {
+				_, err = templBuffer.WriteString("<span class=\"text-red-500\">")
+				if err != nil {
+					return err
+				}
+				var var_69 string = s.Latest
+				_, err = templBuffer.WriteString(templ.EscapeString(var_69))
+				if err != nil {
+					return err
+				}
+				_, err = templBuffer.WriteString("</span>")
+				if err != nil {
+					return err
+				}
+			} else {
+				_, err = templBuffer.WriteString("<span>")
+				if err != nil {
+					return err
+				}
+				var var_70 string = s.Latest
+				_, err = templBuffer.WriteString(templ.EscapeString(var_70))
+				if err != nil {
+					return err
+				}
+				_, err = templBuffer.WriteString("</span>")
+				if err != nil {
+					return err
+				}
+			}
+			_, err = templBuffer.WriteString("</div></div>")
+			if err != nil {
+				return err
+			}
+		}
+		if !templIsBuffer {
+			_, err = templBuffer.WriteTo(w)
+		}
+		return err
+	})
+}
+
+func Profiling(enabled bool, pprofPath string) templ.Component {
+	return templ.ComponentFunc(func(ctx context.Context, w io.Writer) (err error) {
+		templBuffer, templIsBuffer := w.(*bytes.Buffer)
+		if !templIsBuffer {
+			templBuffer = templ.GetBuffer()
+			defer templ.ReleaseBuffer(templBuffer)
+		}
+		ctx = templ.InitializeContext(ctx)
+		var_58 := templ.GetChildren(ctx)
+		if var_58 == nil {
+			var_58 = templ.NopComponent
+		}
+		ctx = templ.ClearChildren(ctx)
+		if enabled {
+			_, err = templBuffer.WriteString("<div class=\"flex items-center\"><div class=\"tooltip tooltip-bottom\" data-tip=\"pprof pages of the current run\"><a href=\"")
+			if err != nil {
+				return err
+			}
+			var var_59 templ.SafeURL = templ.URL(pprofPath)
+			_, err = templBuffer.WriteString(templ.EscapeString(string(var_59)))
+			if err != nil {
+				return err
+			}
+			_, err = templBuffer.WriteString("\" target=\"_blank\" class=\"btn btn-sm btn-ghost\">")
+			if err != nil {
+				return err
+			}
+			var_60 := `pprof`
+			_, err = templBuffer.WriteString(var_60)
+			if err != nil {
+				return err
+			}
+			_, err = templBuffer.WriteString("</a></div><div class=\"tooltip tooltip-bottom\" data-tip=\"Capture a CPU profile of the current run\"><button class=\"btn btn-sm btn-ghost\" :disabled=\"isProfiling\" @click=\"captureProfile\">")
+			if err != nil {
+				return err
+			}
+			var_61 := `CPU profile`
+			_, err = templBuffer.WriteString(var_61)
+			if err != nil {
+				return err
+			}
+			_, err = templBuffer.WriteString("</button></div><span class=\"text-sm\" x-text=\"profileMessage\"></span></div>")
 			if err != nil {
 				return err
 			}
@@ -0,0 +1,48 @@
+package webui
+
+// gomon is a simple command line tool that watches your files and automatically restarts the application when it detects any changes in the working directory.
+// Copyright (C) 2023 John Dudmesh
+
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+
+// You should have received a copy of the GNU General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>.
+
+import (
+	"bytes"
+	"context"
+	"strings"
+	"testing"
+)
+
+func TestTaskPanelBasePath(t *testing.T) {
+	buffer := bytes.Buffer{}
+	err := TaskPanel("/__gomon", "123", "go generate").Render(context.Background(), &buffer)
+	if err != nil {
+		t.Fatalf("rendering task panel: %v", err)
+	}
+	if !strings.Contains(buffer.String(), `hx-post="/__gomon/actions/cancel-task?taskId=123"`) {
+		t.Errorf("expected the cancel button to post to the mounted UI, got %s", buffer.String())
+	}
+}
+
+func TestRunLabelsBasePath(t *testing.T) {
+	buffer := bytes.Buffer{}
+	err := RunLabels("/__gomon", "123", []string{"slow"}).Render(context.Background(), &buffer)
+	if err != nil {
+		t.Fatalf("rendering labels: %v", err)
+	}
+	for _, expected := range []string{`hx-delete="/__gomon/actions/label"`, `hx-post="/__gomon/actions/label"`} {
+		if !strings.Contains(buffer.String(), expected) {
+			t.Errorf("expected %s, got %s", expected, buffer.String())
+		}
+	}
+}
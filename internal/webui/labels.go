@@ -0,0 +1,220 @@
+package webui
+
+// gomon is a simple command line tool that watches your files and automatically restarts the application when it detects any changes in the working directory.
+// Copyright (C) 2023 John Dudmesh
+
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+
+// You should have received a copy of the GNU General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>.
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/jdudmesh/gomon/internal/auth"
+	"github.com/jdudmesh/gomon/internal/notification"
+	"github.com/jdudmesh/gomon/internal/utils"
+)
+
+// labelsChangedEvent is triggered in the browser when a label is added or removed so that the run selector and the
+// label filter are reloaded
+const labelsChangedEvent = "labelsChanged"
+
+// apiRun is a run returned by /api/runs, the startup event along with the run's labels
+type apiRun struct {
+	*notification.Notification
+	Labels []string `json:"labels"`
+}
+
+// LabelRequest adds a label to a run or removes one, POST|DELETE /api/runs/{id}/labels
+type LabelRequest struct {
+	Label string `json:"label"`
+}
+
+// runHandler serves the endpoints of a single run, /api/runs/{id}/export and /api/runs/{id}/labels
+func (c *server) runHandler(w http.ResponseWriter, r *http.Request) {
+	runID, resource, _ := strings.Cut(strings.TrimPrefix(r.URL.Path, "/api/runs/"), "/")
+	if runID == "" {
+		writeJSONError(w, "not found", http.StatusNotFound)
+		return
+	}
+
+	scope := auth.ScopeReadEvents
+	if resource == "labels" && r.Method != http.MethodGet {
+		scope = auth.ScopeWriteLabels
+	}
+	if !requestHasScope(r, scope) {
+		writeJSONError(w, fmt.Sprintf("token does not have the %s scope", scope), http.StatusForbidden)
+		return
+	}
+
+	switch resource {
+	case "export":
+		c.exportRun(w, r, runID)
+	case "labels":
+		c.runLabelsHandler(w, r, runID)
+	default:
+		writeJSONError(w, "not found", http.StatusNotFound)
+	}
+}
+
+// runLabelsHandler lists, adds or removes the labels of a run and returns the run's labels
+func (c *server) runLabelsHandler(w http.ResponseWriter, r *http.Request, runID string) {
+	if r.Method != http.MethodGet {
+		req := LabelRequest{}
+		err := json.NewDecoder(r.Body).Decode(&req)
+		if err != nil {
+			writeJSONError(w, fmt.Sprintf("decoding request: %v", err), http.StatusBadRequest)
+			return
+		}
+
+		switch r.Method {
+		case http.MethodPost:
+			err = c.db.AddRunLabel(runID, req.Label)
+		case http.MethodDelete:
+			err = c.db.RemoveRunLabel(runID, req.Label)
+		default:
+			w.WriteHeader(http.StatusMethodNotAllowed)
+			return
+		}
+		if err != nil {
+			writeLabelError(w, err)
+			return
+		}
+	}
+
+	labels, err := c.db.FindRunLabels([]string{runID})
+	if err != nil {
+		log.Errorf("finding labels: %v", err)
+		writeJSONError(w, "finding labels", http.StatusInternalServerError)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, append([]string{}, labels[runID]...))
+}
+
+func writeLabelError(w http.ResponseWriter, err error) {
+	switch {
+	case errors.Is(err, utils.ErrInvalidLabel):
+		writeJSONError(w, err.Error(), http.StatusBadRequest)
+	case errors.Is(err, utils.ErrRunNotFound):
+		writeJSONError(w, err.Error(), http.StatusNotFound)
+	default:
+		log.Errorf("labelling run: %v", err)
+		writeJSONError(w, "labelling run", http.StatusInternalServerError)
+	}
+}
+
+// labelsHandler lists the labels which are in use, the most recently added first
+func (c *server) labelsHandler(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		w.WriteHeader(http.StatusMethodNotAllowed)
+		return
+	}
+
+	labels, err := c.db.FindLabels()
+	if err != nil {
+		log.Errorf("finding labels: %v", err)
+		writeJSONError(w, "finding labels", http.StatusInternalServerError)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, labels)
+}
+
+// runLabelsComponentHandler renders the labels of the run selected in the toolbar (r), nothing is shown when all
+// runs are selected
+func (c *server) runLabelsComponentHandler(w http.ResponseWriter, r *http.Request) {
+	c.renderRunLabels(w, r, r.FormValue("r"))
+}
+
+// labelActionHandler adds a label to a run (POST) or removes one (DELETE) from the UI
+func (c *server) labelActionHandler(w http.ResponseWriter, r *http.Request) {
+	runID := r.FormValue("run")
+	label := r.FormValue("label")
+
+	var err error
+	switch r.Method {
+	case http.MethodPost:
+		err = c.db.AddRunLabel(runID, label)
+	case http.MethodDelete:
+		err = c.db.RemoveRunLabel(runID, label)
+	default:
+		w.WriteHeader(http.StatusMethodNotAllowed)
+		return
+	}
+	// a label which can't be added is ignored like an empty form
+	if err != nil && !errors.Is(err, utils.ErrInvalidLabel) && !errors.Is(err, utils.ErrRunNotFound) {
+		log.Errorf("labelling run: %v", err)
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+
+	if err == nil {
+		w.Header().Set("HX-Trigger", labelsChangedEvent)
+	}
+	c.renderRunLabels(w, r, runID)
+}
+
+func (c *server) renderRunLabels(w http.ResponseWriter, r *http.Request, runID string) {
+	labels := []string{}
+	if runID != "" && runID != "all" {
+		byRun, err := c.db.FindRunLabels([]string{runID})
+		if err != nil {
+			log.Errorf("finding labels: %v", err)
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+		labels = byRun[runID]
+	}
+
+	w.Header().Set("Content-Type", "text/html; charset=utf-8")
+	err := RunLabels(c.basePath, runID, labels).Render(r.Context(), w)
+	if err != nil {
+		log.Errorf("rendering: %v", err)
+	}
+}
+
+// labelSelectComponentHandler renders the label filter of the run selector, the selected label is kept if it is
+// still in use
+func (c *server) labelSelectComponentHandler(w http.ResponseWriter, r *http.Request) {
+	labels, err := c.db.FindLabels()
+	if err != nil {
+		log.Errorf("finding labels: %v", err)
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "text/html; charset=utf-8")
+	err = LabelSelect(labels, r.FormValue("label")).Render(r.Context(), w)
+	if err != nil {
+		log.Errorf("rendering: %v", err)
+	}
+}
+
+// labelValues are the form values which remove a label from a run
+func labelValues(runID, label string) string {
+	data, _ := json.Marshal(map[string]string{"run": runID, "label": label})
+	return string(data)
+}
+
+// runOptionText is how a run is shown in a run selector, its start time followed by its labels
+func runOptionText(r *notification.Notification, labels []string) string {
+	text := r.Date.Format("2006-01-02 15:04:05")
+	if len(labels) > 0 {
+		text += " · " + strings.Join(labels, ", ")
+	}
+	return text
+}
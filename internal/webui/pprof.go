@@ -0,0 +1,152 @@
+package webui
+
+// gomon is a simple command line tool that watches your files and automatically restarts the application when it detects any changes in the working directory.
+// Copyright (C) 2023 John Dudmesh
+
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+
+// You should have received a copy of the GNU General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>.
+
+import (
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httputil"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/jdudmesh/gomon/internal/config"
+	"github.com/jdudmesh/gomon/internal/notification"
+)
+
+// pprofPath is where the child's /debug/pprof pages are served from by the UI
+const pprofPath = "/pprof/"
+
+// profilesDirectory is where captured profiles are written, relative to .gomon
+const profilesDirectory = "profiles"
+
+// newPprofProxy forwards requests for the UI's pprof pages to the child's /debug/pprof handlers, the pages use
+// relative links so they work under the UI's path
+func newPprofProxy(pprofURL string) (http.Handler, error) {
+	target, err := url.Parse(strings.TrimSuffix(pprofURL, "/") + "/debug/pprof")
+	if err != nil {
+		return nil, fmt.Errorf("parsing ui.pprof.url: %w", err)
+	}
+
+	proxy := &httputil.ReverseProxy{
+		Rewrite: func(r *httputil.ProxyRequest) {
+			r.SetURL(target)
+			// the UI's credentials are not passed on to the child
+			r.Out.Header.Del("Authorization")
+			r.Out.Header.Del("Cookie")
+		},
+		ErrorHandler: func(w http.ResponseWriter, r *http.Request, err error) {
+			log.Warnf("proxying pprof request: %v", err)
+			http.Error(w, fmt.Sprintf("the child process isn't serving pprof at %s: %v", target, err), http.StatusBadGateway)
+		},
+	}
+	return http.StripPrefix(strings.TrimSuffix(pprofPath, "/"), proxy), nil
+}
+
+// profileActionHandler serves POST /actions/profile, it captures a CPU profile of the current run and writes it to
+// .gomon/profiles/<run ID>-cpu-<time>.pprof so that it can be opened with `go tool pprof`
+func (c *server) profileActionHandler(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		w.WriteHeader(http.StatusMethodNotAllowed)
+		return
+	}
+
+	if c.pprofURL == "" {
+		writeJSONError(w, "ui.pprof.url is not set", http.StatusNotFound)
+		return
+	}
+
+	runID := c.currentChildProcessID
+	profileURL := fmt.Sprintf("%s/debug/pprof/profile?seconds=%d", strings.TrimSuffix(c.pprofURL, "/"), c.profileSeconds)
+	req, err := http.NewRequestWithContext(r.Context(), http.MethodGet, profileURL, nil)
+	if err != nil {
+		writeJSONError(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	client := http.Client{Timeout: time.Duration(c.profileSeconds)*time.Second + 10*time.Second}
+	res, err := client.Do(req)
+	if err != nil {
+		log.Warnf("capturing profile: %v", err)
+		writeJSONError(w, fmt.Sprintf("capturing profile: %v", err), http.StatusBadGateway)
+		return
+	}
+	defer res.Body.Close()
+
+	if res.StatusCode != http.StatusOK {
+		writeJSONError(w, "capturing profile: "+res.Status, http.StatusBadGateway)
+		return
+	}
+
+	dir := filepath.Join(c.rootDirectory, ".gomon", profilesDirectory)
+	err = os.MkdirAll(dir, 0755)
+	if err != nil {
+		log.Errorf("creating profiles directory: %v", err)
+		writeJSONError(w, "creating profile", http.StatusInternalServerError)
+		return
+	}
+
+	profilePath := filepath.Join(dir, fmt.Sprintf("%s-cpu-%s.pprof", runID, time.Now().Format("20060102-150405")))
+	f, err := os.Create(profilePath)
+	if err != nil {
+		log.Errorf("creating profile: %v", err)
+		writeJSONError(w, "creating profile", http.StatusInternalServerError)
+		return
+	}
+	defer f.Close()
+
+	_, err = io.Copy(f, res.Body)
+	if err != nil {
+		f.Close()
+		os.Remove(profilePath)
+		log.Errorf("writing profile: %v", err)
+		writeJSONError(w, "writing profile", http.StatusInternalServerError)
+		return
+	}
+
+	c.callbackFn(notification.Notification{
+		ID:              notification.NextID(),
+		Date:            time.Now(),
+		ChildProccessID: runID,
+		Type:            notification.NotificationTypeLogEvent,
+		Message:         fmt.Sprintf("%ds CPU profile saved to %s", c.profileSeconds, profilePath),
+	})
+
+	writeJSON(w, http.StatusOK, map[string]string{
+		"path":  profilePath,
+		"runId": runID,
+	})
+}
+
+// profilingComponentHandler renders the pprof link and profile button if ui.pprof.url is set
+func (c *server) profilingComponentHandler(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "text/html; charset=utf-8")
+	err := Profiling(c.pprofURL != "", c.basePath+pprofPath).Render(r.Context(), w)
+	if err != nil {
+		log.Errorf("rendering: %v", err)
+	}
+}
+
+func profileSeconds(cfg config.Config) int {
+	if cfg.UI.Pprof.Seconds > 0 {
+		return cfg.UI.Pprof.Seconds
+	}
+	return config.DefaultProfileSeconds
+}
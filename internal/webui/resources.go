@@ -0,0 +1,50 @@
+package webui
+
+// gomon is a simple command line tool that watches your files and automatically restarts the application when it detects any changes in the working directory.
+// Copyright (C) 2023 John Dudmesh
+
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+
+// You should have received a copy of the GNU General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>.
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/jdudmesh/gomon/internal/utils"
+)
+
+// resourcesComponentHandler renders the memory and CPU usage of the selected run, or of the most recent runs, the
+// charts are drawn by the client from the samples embedded in the component
+func (c *server) resourcesComponentHandler(w http.ResponseWriter, r *http.Request) {
+	runs, err := c.db.FindResources(r.URL.Query().Get("r"))
+	if err != nil {
+		log.Errorf("finding resource samples: %v", err)
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "text/html; charset=utf-8")
+	err = Resources(runs).Render(r.Context(), w)
+	if err != nil {
+		log.Errorf("rendering: %v", err)
+	}
+}
+
+func resourcesJSON(runs []*utils.ResourceRun) string {
+	buf, err := json.Marshal(runs)
+	if err != nil {
+		log.Errorf("encoding resource samples: %v", err)
+		return "[]"
+	}
+	return string(buf)
+}
@@ -0,0 +1,98 @@
+package webui
+
+// gomon is a simple command line tool that watches your files and automatically restarts the application when it detects any changes in the working directory.
+// Copyright (C) 2023 John Dudmesh
+
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+
+// You should have received a copy of the GNU General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>.
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/jdudmesh/gomon/internal/utils"
+)
+
+// the size of the sparkline in pixels
+const (
+	sparklineWidth  = 80
+	sparklineHeight = 20
+)
+
+// restartSparkline is the chart of recent restart durations shown in the header, Latest is empty if no restarts
+// have been timed yet
+type restartSparkline struct {
+	Points    string
+	Latest    string
+	Tip       string
+	Regressed bool
+}
+
+// restartsComponentHandler renders the sparkline of recent restart durations
+func (c *server) restartsComponentHandler(w http.ResponseWriter, r *http.Request) {
+	restarts, err := c.db.FindRestarts()
+	if err != nil {
+		log.Errorf("finding restart durations: %v", err)
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "text/html; charset=utf-8")
+	err = RestartSparkline(newRestartSparkline(restarts)).Render(r.Context(), w)
+	if err != nil {
+		log.Errorf("rendering: %v", err)
+	}
+}
+
+func newRestartSparkline(restarts []*utils.RestartDuration) restartSparkline {
+	s := restartSparkline{}
+	if len(restarts) == 0 {
+		return s
+	}
+
+	durations := make([]time.Duration, len(restarts))
+	longest := time.Duration(1)
+	for ix, r := range restarts {
+		durations[ix] = r.Duration()
+		longest = max(longest, durations[ix])
+	}
+
+	// the points are spread across the width with the longest restart at the top, a single restart is a flat line
+	step := float64(sparklineWidth)
+	if len(durations) > 1 {
+		step = float64(sparklineWidth) / float64(len(durations)-1)
+	}
+	points := make([]string, 0, len(durations)+1)
+	for ix, d := range durations {
+		y := float64(sparklineHeight-1) - float64(d)/float64(longest)*float64(sparklineHeight-2)
+		points = append(points, fmt.Sprintf("%.1f,%.1f", float64(ix)*step, y))
+	}
+	if len(durations) == 1 {
+		points = append(points, fmt.Sprintf("%d,%s", sparklineWidth, strings.Split(points[0], ",")[1]))
+	}
+	s.Points = strings.Join(points, " ")
+
+	latest := durations[len(durations)-1]
+	s.Latest = latest.Round(100 * time.Millisecond).String()
+
+	median, regressed := utils.RestartRegressed(durations[:len(durations)-1], latest)
+	s.Regressed = regressed
+	s.Tip = fmt.Sprintf("Time from a change to the child being ready, last %d restarts", len(durations))
+	if median > 0 {
+		s.Tip += fmt.Sprintf(" (median %s)", median.Round(100*time.Millisecond))
+	}
+
+	return s
+}
@@ -22,41 +22,105 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"html"
 	"io"
+	"net"
 	"net/http"
+	"slices"
+	"sort"
+	"strings"
 	"sync"
 	"time"
 
 	"github.com/a-h/templ"
+	"github.com/jdudmesh/gomon/internal/auth"
 	"github.com/jdudmesh/gomon/internal/config"
 	"github.com/jdudmesh/gomon/internal/notification"
-	_ "github.com/mattn/go-sqlite3"
+	"github.com/jdudmesh/gomon/internal/utils"
 	"github.com/r3labs/sse/v2"
-	log "github.com/sirupsen/logrus"
+	"github.com/sirupsen/logrus"
 )
 
+var log = logrus.WithField("component", "webui")
+
+const eventsStream = "events"
+
+// notificationsStream carries every notification as JSON rather than as markup, it is used by `gomon attach`
+const notificationsStream = "notifications"
+
+// jumpWindowSize is the number of events shown around a lifecycle marker
+const jumpWindowSize = 500
+
+// MountPath is the path under which the UI is served when it shares the proxy's port
+const MountPath = config.UIMountPath
+
 type SSEEvent struct {
 	ID     string `json:"id"`
 	Date   string `json:"dt"`
 	Target string `json:"target"`
 	Markup string `json:"markup"`
 	Swap   string `json:"swap"`
+	// Live marks events which add to the log output, clients hold these back while streaming is paused and apply
+	// everything else e.g. task progress straight away
+	Live bool `json:"live,omitempty"`
 }
 
 type Database interface {
-	FindNotifications(runID, stm, filter string) ([][]*notification.Notification, error)
-	FindRuns() ([]*notification.Notification, error)
+	FindNotifications(runID, stm, level, filter string) ([][]*notification.Notification, error)
+	FindRuns(label string) ([]*notification.Notification, error)
+	FindMarker(marker, direction, fromID string) (*notification.Notification, error)
+	ExportRun(runID string, fn func(*notification.Notification) error) error
+	ExportRange(fromID, toID string, fn func(*notification.Notification) error) error
+	FindComparisonRuns(runID string) (string, string, error)
+	FindRunTriggers(runIDs []string) (map[string][]*utils.RunTrigger, error)
+	FindRunErrorCounts(runIDs []string) (map[string]int, error)
+	FindTimeline() ([]*utils.TimelineRun, error)
+	FindResources(runID string) ([]*utils.ResourceRun, error)
+	FindRestarts() ([]*utils.RestartDuration, error)
+	utils.RunLabeller
+}
+
+// StatusProvider reports on gomon's own state for the status and health endpoints
+type StatusProvider interface {
+	Metrics() utils.Metrics
+	Health() utils.Health
+	Dump() utils.StateDump
 }
 
 type server struct {
 	isEnabled             bool
 	port                  int
+	rootDirectory         string
 	httpServer            *http.Server
+	handler               http.Handler
+	isMounted             bool
+	basePath              string
+	index                 []byte
+	script                []byte
 	sseServer             *sse.Server
+	wsHub                 *websocketHub
 	db                    Database
+	status                StatusProvider
+	apiToken              string
+	tokens                *auth.Store
+	requireToken          bool
 	callbackFn            notification.NotificationCallback
 	currentChildProcessID string
+	runningTasks          int
+	tasks                 []string
 	notificationLock      sync.Mutex
+	// pendingTriggers are the file changes which caused the hard restart in progress
+	pendingTriggers []*utils.RunTrigger
+	// authToken, authUsername and authPassword are the credentials from ui.auth
+	authToken    string
+	authUsername string
+	authPassword string
+	// pprofURL is the child's server which serves net/http/pprof, it is empty if ui.pprof isn't configured
+	pprofURL       string
+	profileSeconds int
+	// subscribers is the number of clients subscribed to each SSE stream
+	subscribers    map[string]int
+	subscriberLock sync.Mutex
 }
 
 func withCORS(next http.Handler) http.Handler {
@@ -67,38 +131,120 @@ func withCORS(next http.Handler) http.Handler {
 	})
 }
 
-func New(cfg config.Config, db Database, callbackFn notification.NotificationCallback) (*server, error) {
+func New(cfg config.Config, db Database, status StatusProvider, callbackFn notification.NotificationCallback) (*server, error) {
 	srv := &server{
 		isEnabled:        cfg.UI.Enabled,
 		port:             cfg.UI.Port,
+		rootDirectory:    cfg.RootDirectory,
+		tokens:           auth.NewStore(cfg.RootDirectory),
+		requireToken:     cfg.UI.RequireToken,
 		db:               db,
+		status:           status,
 		callbackFn:       callbackFn,
 		notificationLock: sync.Mutex{},
+		index:            index,
+		script:           script,
+		subscribers:      map[string]int{},
+		pprofURL:         cfg.UI.Pprof.URL,
+		profileSeconds:   profileSeconds(cfg),
 	}
 
 	if !srv.isEnabled {
 		return srv, nil
 	}
 
+	setEditor(cfg.UI.EditorURL, cfg.RootDirectory)
+
+	for name := range cfg.Tasks {
+		srv.tasks = append(srv.tasks, name)
+	}
+	sort.Strings(srv.tasks)
+
 	if srv.port == 0 {
-		srv.port = 4001
+		srv.port = config.DefaultUIPort
+	}
+
+	var err error
+	srv.apiToken, err = loadAPIToken(cfg.RootDirectory, cfg.UI.APIToken)
+	if err != nil {
+		return nil, err
+	}
+
+	err = srv.loadAuth(cfg)
+	if err != nil {
+		return nil, err
+	}
+
+	sseBufferSize := cfg.Limits.SSEBuffer
+	if sseBufferSize <= 0 {
+		sseBufferSize = config.DefaultSSEBuffer
 	}
 
 	srv.sseServer = sse.New()
 	srv.sseServer.AutoReplay = false
+	// streams are only created by gomon, the per child process streams e.g. /sse?stream=events.<id> are created
+	// when the child process starts so clients can't create streams of their own
+	srv.sseServer.AutoStream = false
+	srv.sseServer.BufferSize = sseBufferSize
 	srv.sseServer.Headers["Access-Control-Allow-Origin"] = "*"
-	srv.sseServer.CreateStream("events")
+	srv.sseServer.OnSubscribe = func(streamID string, sub *sse.Subscriber) {
+		srv.countSubscriber(streamID, 1)
+	}
+	srv.sseServer.OnUnsubscribe = func(streamID string, sub *sse.Subscriber) {
+		srv.countSubscriber(streamID, -1)
+	}
+	srv.sseServer.CreateStream(eventsStream)
+	srv.sseServer.CreateStream(notificationsStream)
+
+	srv.wsHub = newWebsocketHub(sseBufferSize)
 
 	mux := http.NewServeMux()
 	mux.HandleFunc("/", srv.indexPageHandler)
 	mux.HandleFunc("/dist/main.js", srv.clientBundleScriptHandler)
 	mux.HandleFunc("/dist/main.css", srv.clientBundleStylesheetHandler)
-	mux.Handle("/actions/restart", withCORS(http.HandlerFunc(srv.restartActionHandler)))
-	mux.Handle("/actions/exit", withCORS(http.HandlerFunc(srv.exitActionHandler)))
-	mux.Handle("/actions/search", withCORS(http.HandlerFunc(srv.searchActionHandler)))
-	mux.Handle("/components/search-select", withCORS(http.HandlerFunc(srv.searchSelectComponentHandler)))
-	mux.Handle("/sse", srv.sseServer)
-
+	mux.Handle("/actions/restart", srv.withUIScope(auth.ScopeControlRestart, http.HandlerFunc(srv.restartActionHandler)))
+	mux.Handle("/actions/exit", srv.withUIScope(auth.ScopeControlRestart, http.HandlerFunc(srv.exitActionHandler)))
+	mux.Handle("/actions/search", srv.withUIScope(auth.ScopeReadEvents, http.HandlerFunc(srv.searchActionHandler)))
+	mux.Handle("/actions/jump", srv.withUIScope(auth.ScopeReadEvents, http.HandlerFunc(srv.jumpActionHandler)))
+	mux.Handle("/actions/task", srv.withUIScope(auth.ScopeControlTasks, http.HandlerFunc(srv.taskActionHandler)))
+	mux.Handle("/actions/cancel-task", srv.withUIScope(auth.ScopeControlTasks, http.HandlerFunc(srv.cancelTaskActionHandler)))
+	mux.Handle("/actions/range", srv.withUIScope(auth.ScopeReadEvents, http.HandlerFunc(srv.rangeHandler)))
+	mux.Handle("/actions/scratch", srv.withUIScope(auth.ScopeReadEvents, http.HandlerFunc(srv.scratchActionHandler)))
+	mux.Handle("/components/search-select", srv.withUIScope(auth.ScopeReadEvents, http.HandlerFunc(srv.searchSelectComponentHandler)))
+	mux.Handle("/components/label-select", srv.withUIScope(auth.ScopeReadEvents, http.HandlerFunc(srv.labelSelectComponentHandler)))
+	mux.Handle("/components/run-labels", srv.withUIScope(auth.ScopeReadEvents, http.HandlerFunc(srv.runLabelsComponentHandler)))
+	mux.Handle("/actions/label", srv.withUIScope(auth.ScopeWriteLabels, http.HandlerFunc(srv.labelActionHandler)))
+	mux.Handle("/components/task-select", srv.withUIScope(auth.ScopeReadEvents, http.HandlerFunc(srv.taskSelectComponentHandler)))
+	mux.Handle("/export/", srv.withUIScope(auth.ScopeReadEvents, http.HandlerFunc(srv.exportActionHandler)))
+	mux.Handle("/components/retention", srv.withUIScope(auth.ScopeReadEvents, http.HandlerFunc(srv.retentionComponentHandler)))
+	mux.Handle("/components/diff", srv.withUIScope(auth.ScopeReadEvents, http.HandlerFunc(srv.diffComponentHandler)))
+	mux.Handle("/components/timeline", srv.withUIScope(auth.ScopeReadEvents, http.HandlerFunc(srv.timelineComponentHandler)))
+	mux.Handle("/components/resources", srv.withUIScope(auth.ScopeReadEvents, http.HandlerFunc(srv.resourcesComponentHandler)))
+	mux.Handle("/components/restarts", srv.withUIScope(auth.ScopeReadEvents, http.HandlerFunc(srv.restartsComponentHandler)))
+	mux.Handle("/components/profiling", srv.withUIScope(auth.ScopeReadEvents, http.HandlerFunc(srv.profilingComponentHandler)))
+	mux.Handle("/actions/profile", srv.withUIScope(auth.ScopeControlTasks, http.HandlerFunc(srv.profileActionHandler)))
+	if srv.pprofURL != "" {
+		pprofProxy, err := newPprofProxy(srv.pprofURL)
+		if err != nil {
+			return nil, err
+		}
+		mux.Handle(pprofPath, srv.withUIScope(auth.ScopeReadEvents, pprofProxy))
+	}
+	mux.Handle("/healthz", withCORS(http.HandlerFunc(srv.healthHandler)))
+	mux.Handle("/readyz", withCORS(http.HandlerFunc(srv.readyHandler)))
+	mux.Handle("/api/status", srv.withScope(auth.ScopeReadEvents, http.HandlerFunc(srv.statusHandler)))
+	mux.Handle("/api/state", srv.withScope(auth.ScopeReadEvents, http.HandlerFunc(srv.stateHandler)))
+	mux.Handle("/api/trigger", srv.withScope("", http.HandlerFunc(srv.triggerHandler)))
+	mux.Handle("/api/restart", srv.withScope(auth.ScopeControlRestart, http.HandlerFunc(srv.restartHandler)))
+	mux.Handle("/api/tasks/", srv.withScope(auth.ScopeControlTasks, http.HandlerFunc(srv.taskHandler)))
+	mux.Handle("/api/runs", srv.withScope(auth.ScopeReadEvents, http.HandlerFunc(srv.runsHandler)))
+	mux.Handle("/api/runs/", srv.withScope("", http.HandlerFunc(srv.runHandler)))
+	mux.Handle("/api/labels", srv.withScope(auth.ScopeReadEvents, http.HandlerFunc(srv.labelsHandler)))
+	mux.Handle("/api/range", srv.withScope(auth.ScopeReadEvents, http.HandlerFunc(srv.rangeHandler)))
+	mux.Handle("/sse", srv.withUIScope(auth.ScopeReadEvents, srv.sseServer))
+	mux.Handle("/ws", srv.withUIScope(auth.ScopeReadEvents, srv.wsHub.Handler()))
+
+	srv.handler = mux
 	srv.httpServer = &http.Server{
 		Addr:    fmt.Sprintf(":%d", srv.port),
 		Handler: mux,
@@ -107,13 +253,40 @@ func New(cfg config.Config, db Database, callbackFn notification.NotificationCal
 	return srv, nil
 }
 
+// Mount prepares the UI to be served by another server under basePath rather than listening on its own port,
+// the client bundle uses absolute paths so these are rewritten to include the prefix
+func (c *server) Mount(basePath string) http.Handler {
+	log.Infof("UI server mounted on proxy at %s", basePath)
+	c.isMounted = true
+	c.basePath = basePath
+	c.index = prefixPaths(index, basePath, `"/dist/`, `hx-get="/`, `hx-post="/`, `'/export/`)
+	c.script = prefixPaths(script, basePath, `"/sse?`, `"/ws"`, `"/actions/jump?`, `"/actions/range?`, `"/actions/scratch?`, `"/actions/profile"`)
+	return http.StripPrefix(basePath, c.handler)
+}
+
+func prefixPaths(content []byte, basePath string, patterns ...string) []byte {
+	for _, patt := range patterns {
+		ix := bytes.IndexByte([]byte(patt), '/')
+		prefixed := patt[:ix] + basePath + patt[ix:]
+		content = bytes.ReplaceAll(content, []byte(patt), []byte(prefixed))
+	}
+	return content
+}
+
 func (c *server) Start() error {
-	if !c.isEnabled {
+	if !c.isEnabled || c.isMounted {
 		return nil
 	}
 
+	listener, err := net.Listen("tcp", c.httpServer.Addr)
+	if utils.IsAddrInUse(err) {
+		return utils.PortInUseError("ui.port", c.port)
+	} else if err != nil {
+		return fmt.Errorf("ui server failed to listen: %w", err)
+	}
+
 	log.Infof("Starting UI server on http://localhost:%d", c.port)
-	err := c.httpServer.ListenAndServe()
+	err = c.httpServer.Serve(listener)
 	if err != nil && !errors.Is(err, http.ErrServerClosed) {
 		panic(fmt.Sprintf("ui server shut down unexpectedly: %v", err))
 	}
@@ -128,6 +301,10 @@ func (c *server) Close() error {
 		c.sseServer.Close()
 	}
 
+	if c.wsHub != nil {
+		c.wsHub.Close()
+	}
+
 	if c.httpServer != nil {
 		err := c.httpServer.Close()
 		if err != nil {
@@ -142,18 +319,69 @@ func (c *server) Enabled() bool {
 	return c.isEnabled
 }
 
+func (c *server) countSubscriber(streamID string, delta int) {
+	c.subscriberLock.Lock()
+	defer c.subscriberLock.Unlock()
+
+	c.subscribers[streamID] += delta
+	if c.subscribers[streamID] <= 0 {
+		delete(c.subscribers, streamID)
+	}
+}
+
+// Clients returns the number of clients subscribed to each SSE stream and to the websocket
+func (c *server) Clients() map[string]int {
+	clients := map[string]int{}
+	if !c.isEnabled {
+		return clients
+	}
+
+	c.subscriberLock.Lock()
+	for streamID, count := range c.subscribers {
+		clients["sse "+streamID] = count
+	}
+	c.subscriberLock.Unlock()
+
+	if count := c.wsHub.Clients(); count > 0 {
+		clients["websocket"] = count
+	}
+	return clients
+}
+
 func (c *server) Notify(n notification.Notification) error {
-	if n.ChildProccessID == "" {
+	if !c.isEnabled {
 		return nil
 	}
 
 	c.notificationLock.Lock()
 	defer c.notificationLock.Unlock()
 
-	var err error
+	data, err := json.Marshal(n)
+	if err != nil {
+		return fmt.Errorf("marshalling notification: %w", err)
+	}
+	c.sseServer.Publish(notificationsStream, &sse.Event{
+		Data: data,
+	})
+
+	if n.TaskID != "" {
+		err = c.sendTaskEvent(n)
+		if err != nil {
+			return fmt.Errorf("sending task event: %w", err)
+		}
+	}
+
+	if n.Trigger != nil && n.Type == notification.NotificationTypeHardRestartRequested {
+		c.pendingTriggers = append(c.pendingTriggers, &utils.RunTrigger{Trigger: *n.Trigger, Date: n.Date, Type: n.Type})
+	}
+
+	if n.ChildProccessID == "" {
+		return nil
+	}
 
 	switch n.Type {
 	case notification.NotificationTypeStartup:
+		c.startChildProcessStream(n.ChildProccessID)
 		c.currentChildProcessID = n.ChildProccessID
 		err = c.sendRunEvent(n)
 	default:
@@ -178,22 +406,22 @@ func (c *server) sendLogEvent(n notification.Notification) error {
 		Target: "#" + n.ChildProccessID,
 		Swap:   "beforeend scroll:lastchild",
 		Markup: buffer.String(),
+		Live:   true,
 	}
 	msgBytes, err := json.Marshal(msg)
 	if err != nil {
 		return fmt.Errorf("marshalling event: %w", err)
 	}
 
-	c.sseServer.Publish("events", &sse.Event{
-		Data: msgBytes,
-	})
+	c.publish(n.ChildProccessID, msgBytes)
 
 	return nil
 }
 
 func (c *server) sendRunEvent(n notification.Notification) error {
 	buffer := bytes.Buffer{}
-	err := EmptyRun(n.ChildProccessID).Render(context.Background(), &buffer)
+	err := EmptyRun(n.ChildProccessID, restartReason(c.pendingTriggers)).Render(context.Background(), &buffer)
+	c.pendingTriggers = nil
 	if err != nil {
 		return fmt.Errorf("rendering event: %w", err)
 	}
@@ -204,17 +432,18 @@ func (c *server) sendRunEvent(n notification.Notification) error {
 		Target: "#log-output-inner",
 		Swap:   "beforeend scroll:lastchild",
 		Markup: buffer.String(),
+		Live:   true,
 	}
 	msgBytes, err := json.Marshal(msg)
 	if err != nil {
 		return fmt.Errorf("marshalling event: %w", err)
 	}
-	c.sseServer.Publish("events", &sse.Event{
-		Data: msgBytes,
-	})
+	c.publish(n.ChildProccessID, msgBytes)
 
+	// the run selector isn't filtered by label because a new run doesn't have any, clients which are filtering
+	// ignore it
 	buffer = bytes.Buffer{}
-	err = c.searchSelectComponent(&buffer)
+	err = c.searchSelectComponent(&buffer, "")
 	if err != nil {
 		log.Errorf("rendering: %v", err)
 		return fmt.Errorf("rendering event: %w", err)
@@ -229,13 +458,83 @@ func (c *server) sendRunEvent(n notification.Notification) error {
 	if err != nil {
 		return fmt.Errorf("marshalling event: %w", err)
 	}
-	c.sseServer.Publish("events", &sse.Event{
-		Data: msgBytes,
-	})
+	c.publish(n.ChildProccessID, msgBytes)
 
 	return nil
 }
 
+// sendTaskEvent updates the progress panel for an out of band task, only the latest line of output is shown
+func (c *server) sendTaskEvent(n notification.Notification) error {
+	msg := SSEEvent{
+		ID:   n.ID,
+		Date: n.Date.Format(time.RFC3339),
+	}
+
+	buffer := bytes.Buffer{}
+	switch n.Type {
+	case notification.NotificationTypeOOBTaskStartup:
+		msg.Target = "#task-panel"
+		msg.Swap = "beforeend"
+		if c.runningTasks == 0 {
+			// clear out any finished tasks
+			msg.Swap = "innerHTML"
+		}
+		c.runningTasks++
+		err := TaskPanel(c.basePath, n.TaskID, n.Message).Render(context.Background(), &buffer)
+		if err != nil {
+			return fmt.Errorf("rendering task: %w", err)
+		}
+	case notification.NotificationTypeOOBTaskStdOut, notification.NotificationTypeOOBTaskStdErr:
+		msg.Target = "#task-" + n.TaskID + "-output"
+		msg.Swap = "innerHTML"
+		buffer.WriteString(html.EscapeString(n.Message))
+	case notification.NotificationTypeOOBTaskComplete:
+		if c.runningTasks > 0 {
+			c.runningTasks--
+		}
+		msg.Target = "#task-" + n.TaskID + "-status"
+		msg.Swap = "outerHTML"
+		err := TaskStatus(n.TaskID, n.Message).Render(context.Background(), &buffer)
+		if err != nil {
+			return fmt.Errorf("rendering task status: %w", err)
+		}
+	default:
+		return nil
+	}
+	msg.Markup = buffer.String()
+
+	msgBytes, err := json.Marshal(msg)
+	if err != nil {
+		return fmt.Errorf("marshalling event: %w", err)
+	}
+	c.publish("", msgBytes)
+
+	return nil
+}
+
+// startChildProcessStream creates the stream of a child process which has just started, the stream of the previous
+// child process is removed so there is only ever one per process stream
+func (c *server) startChildProcessStream(childProcessID string) {
+	if c.currentChildProcessID != "" && c.currentChildProcessID != childProcessID {
+		c.sseServer.RemoveStream(eventsStream + "." + c.currentChildProcessID)
+	}
+	c.sseServer.CreateStream(eventsStream + "." + childProcessID)
+}
+
+// publish sends the event to the main events stream, any websocket clients and to the stream for the child
+// process which generated it, events of a child process which is no longer running are only sent to the main stream
+func (c *server) publish(childProcessID string, data []byte) {
+	c.sseServer.Publish(eventsStream, &sse.Event{
+		Data: data,
+	})
+	c.wsHub.Publish(data)
+	if childProcessID != "" {
+		c.sseServer.Publish(eventsStream+"."+childProcessID, &sse.Event{
+			Data: data,
+		})
+	}
+}
+
 func (c *server) restartActionHandler(w http.ResponseWriter, r *http.Request) {
 	c.callbackFn(notification.Notification{
 		ID:              notification.NextID(),
@@ -258,13 +557,49 @@ func (c *server) exitActionHandler(w http.ResponseWriter, r *http.Request) {
 	w.WriteHeader(http.StatusOK)
 }
 
+// taskActionHandler runs one of the named tasks from the config file
+func (c *server) taskActionHandler(w http.ResponseWriter, r *http.Request) {
+	task := r.FormValue("task")
+	if !slices.Contains(c.tasks, task) {
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+
+	c.callbackFn(notification.Notification{
+		ID:              notification.NextID(),
+		Date:            time.Now(),
+		ChildProccessID: c.currentChildProcessID,
+		Type:            notification.NotificationTypeOOBTaskRequested,
+		Message:         task,
+	})
+	w.WriteHeader(http.StatusOK)
+}
+
+func (c *server) cancelTaskActionHandler(w http.ResponseWriter, r *http.Request) {
+	taskID := r.FormValue("taskId")
+	if taskID == "" {
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+
+	c.callbackFn(notification.Notification{
+		ID:              notification.NextID(),
+		Date:            time.Now(),
+		ChildProccessID: c.currentChildProcessID,
+		Type:            notification.NotificationTypeOOBTaskCancelRequested,
+		Message:         taskID,
+	})
+	w.WriteHeader(http.StatusOK)
+}
+
 func (c *server) searchActionHandler(w http.ResponseWriter, r *http.Request) {
 	var err error
 	runID := r.URL.Query().Get("r")
 	stm := r.URL.Query().Get("stm")
+	level := r.URL.Query().Get("lvl")
 	filter := r.URL.Query().Get("q")
 
-	events, err := c.db.FindNotifications(runID, stm, filter)
+	events, err := c.db.FindNotifications(runID, stm, level, filter)
 	if err != nil {
 		log.Errorf("finding notifications: %v", err)
 		w.WriteHeader(http.StatusInternalServerError)
@@ -276,7 +611,19 @@ func (c *server) searchActionHandler(w http.ResponseWriter, r *http.Request) {
 	if len(events) == 0 {
 		markup = SearchNoResults()
 	} else {
-		markup = EventList(events)
+		runIDs := make([]string, 0, len(events))
+		for _, run := range events {
+			runIDs = append(runIDs, run[0].ChildProccessID)
+		}
+		triggers, err := c.db.FindRunTriggers(runIDs)
+		if err != nil {
+			log.Errorf("finding triggers: %v", err)
+		}
+		errorCounts, err := c.db.FindRunErrorCounts(runIDs)
+		if err != nil {
+			log.Errorf("counting errors: %v", err)
+		}
+		markup = EventList(events, triggers, errorCounts)
 	}
 
 	err = markup.Render(r.Context(), w)
@@ -287,9 +634,78 @@ func (c *server) searchActionHandler(w http.ResponseWriter, r *http.Request) {
 	}
 }
 
+// jumpActionHandler renders the events around the nearest lifecycle marker (crash, startup or first error) relative
+// to the event the user is currently looking at, the ID of the marker is returned in a header so it can be highlighted
+func (c *server) jumpActionHandler(w http.ResponseWriter, r *http.Request) {
+	marker := r.URL.Query().Get("marker")
+	direction := r.URL.Query().Get("dir")
+	fromID := r.URL.Query().Get("from")
+
+	n, err := c.db.FindMarker(marker, direction, fromID)
+	if err != nil {
+		log.Errorf("finding marker: %v", err)
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+
+	if n == nil {
+		w.WriteHeader(http.StatusNoContent)
+		return
+	}
+
+	events, err := utils.FindEventsAround(c.db, n, jumpWindowSize)
+	if err != nil {
+		log.Errorf("finding events: %v", err)
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "text/html; charset=utf-8")
+	w.Header().Set("X-Gomon-Event-Id", n.ID)
+	err = EventList([][]*notification.Notification{events}, nil, nil).Render(r.Context(), w)
+	if err != nil {
+		log.Errorf("rendering events: %v", err)
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+}
+
+func (c *server) statusHandler(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "application/json")
+	err := json.NewEncoder(w).Encode(c.status.Metrics())
+	if err != nil {
+		log.Errorf("encoding status: %v", err)
+	}
+}
+
+// stateHandler returns a dump of gomon's internal state, used by `gomon status`
+func (c *server) stateHandler(w http.ResponseWriter, r *http.Request) {
+	writeJSON(w, http.StatusOK, c.status.Dump())
+}
+
+// healthHandler returns 503 if any of gomon's subsystems has died
+func (c *server) healthHandler(w http.ResponseWriter, r *http.Request) {
+	health := c.status.Health()
+	status := http.StatusOK
+	if !health.Healthy {
+		status = http.StatusServiceUnavailable
+	}
+	writeJSON(w, status, health)
+}
+
+// readyHandler returns 503 until gomon and the child process are up and running
+func (c *server) readyHandler(w http.ResponseWriter, r *http.Request) {
+	health := c.status.Health()
+	status := http.StatusOK
+	if !health.Ready {
+		status = http.StatusServiceUnavailable
+	}
+	writeJSON(w, status, health)
+}
+
 func (c *server) searchSelectComponentHandler(w http.ResponseWriter, r *http.Request) {
 	buf := bytes.Buffer{}
-	err := c.searchSelectComponent(&buf)
+	err := c.searchSelectComponent(&buf, r.FormValue("label"))
 	if err != nil {
 		log.Errorf("rendering: %v", err)
 		w.WriteHeader(http.StatusInternalServerError)
@@ -299,8 +715,9 @@ func (c *server) searchSelectComponentHandler(w http.ResponseWriter, r *http.Req
 	w.Write(buf.Bytes())
 }
 
-func (c *server) searchSelectComponent(w io.Writer) error {
-	runs, err := c.db.FindRuns()
+// searchSelectComponent renders the run selector, if label isn't empty only the runs with the label are listed
+func (c *server) searchSelectComponent(w io.Writer, label string) error {
+	runs, err := c.db.FindRuns(label)
 	if err != nil {
 		return fmt.Errorf("finding runs: %w", err)
 	}
@@ -310,7 +727,20 @@ func (c *server) searchSelectComponent(w io.Writer) error {
 		currentRun = runs[0].ChildProccessID
 	}
 
-	markup := SearchSelect(runs, currentRun)
+	runIDs := make([]string, 0, len(runs))
+	for _, r := range runs {
+		runIDs = append(runIDs, r.ChildProccessID)
+	}
+	errorCounts, err := c.db.FindRunErrorCounts(runIDs)
+	if err != nil {
+		return fmt.Errorf("counting errors: %w", err)
+	}
+	labels, err := c.db.FindRunLabels(runIDs)
+	if err != nil {
+		return fmt.Errorf("finding labels: %w", err)
+	}
+
+	markup := SearchSelect(runs, currentRun, errorCounts, labels)
 	err = markup.Render(context.Background(), w)
 	if err != nil {
 		return fmt.Errorf("rendering data: %w", err)
@@ -319,14 +749,132 @@ func (c *server) searchSelectComponent(w io.Writer) error {
 	return nil
 }
 
+func (c *server) taskSelectComponentHandler(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "text/html; charset=utf-8")
+	err := TaskSelect(c.tasks).Render(r.Context(), w)
+	if err != nil {
+		log.Errorf("rendering: %v", err)
+	}
+}
+
+func (c *server) retentionComponentHandler(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "text/html; charset=utf-8")
+	err := RetentionStatus(c.status.Metrics().Retention).Render(r.Context(), w)
+	if err != nil {
+		log.Errorf("rendering: %v", err)
+	}
+}
+
+// retentionSummary is the short form of the pruner status shown in the toolbar
+func retentionSummary(status *utils.RetentionStatus) string {
+	summary := "history pruned " + status.LastRun.Date.Format("15:04")
+	if status.NextRun != nil {
+		summary += ", next " + status.NextRun.Format("15:04")
+	}
+	return summary
+}
+
+func retentionDetail(status *utils.RetentionStatus) string {
+	if status.LastRun.Error != "" {
+		return "pruning failed: " + status.LastRun.Error
+	}
+	return fmt.Sprintf("%d runs (%d events) deleted, %d bytes reclaimed in %s", status.LastRun.RunsDeleted, status.LastRun.EventsDeleted, status.LastRun.BytesReclaimed, status.LastRun.Duration)
+}
+
+// errorCountLabel is shown on a run with error output e.g. "3 errors"
+func errorCountLabel(count int) string {
+	if count == 1 {
+		return "1 error"
+	}
+	return fmt.Sprintf("%d errors", count)
+}
+
+// errorCountSuffix is added to a run in the run list if it has error output
+func errorCountSuffix(count int) string {
+	if count == 0 {
+		return ""
+	}
+	return " (" + errorCountLabel(count) + ")"
+}
+
+// restartReason describes the file change which caused a run to start e.g. "restarted because main.go changed
+// (hardReload *.go)", it is empty if the run wasn't started by a file change
+func restartReason(triggers []*utils.RunTrigger) string {
+	reason := ""
+	others := 0
+	for _, t := range triggers {
+		if t.Type != notification.NotificationTypeHardRestartRequested {
+			continue
+		}
+		if reason == "" {
+			reason = fmt.Sprintf("restarted because %s changed (%s)", t.Path, t.Rule)
+		} else {
+			others++
+		}
+	}
+	switch {
+	case others == 1:
+		reason += " and 1 other change"
+	case others > 1:
+		reason += fmt.Sprintf(" and %d other changes", others)
+	}
+	return reason
+}
+
+// bannerLine is a line of the startup banner, URL is set for lines such as "proxy: http://localhost:4000"
+type bannerLine struct {
+	Text  string
+	Label string
+	URL   string
+}
+
+// bannerLines splits the startup banner into lines so that its URLs can be rendered as links
+func bannerLines(message string) []bannerLine {
+	lines := []bannerLine{}
+	for _, text := range strings.Split(message, "\n") {
+		text = strings.TrimSpace(text)
+		label, value, ok := strings.Cut(text, ": ")
+		if ok && (strings.HasPrefix(value, "http://") || strings.HasPrefix(value, "https://")) {
+			lines = append(lines, bannerLine{Text: text, Label: label + ":", URL: value})
+			continue
+		}
+		lines = append(lines, bannerLine{Text: text})
+	}
+	return lines
+}
+
+// historyFullDetail explains why events are no longer being written to the database
+func historyFullDetail(status *utils.RetentionStatus) string {
+	return fmt.Sprintf("the history database is %d bytes, which has reached its %d byte limit, new events are only kept in memory until gomon is restarted", status.SizeBytes, status.HardLimitBytes)
+}
+
 func (c *server) indexPageHandler(w http.ResponseWriter, r *http.Request) {
+	if c.requireToken {
+		scopes, ok := c.authorize(r)
+		if !ok || !auth.HasScope(scopes, auth.ScopeReadEvents) {
+			c.challenge(w)
+			http.Error(w, "a token with the read:events scope is required, open the UI with ?token=<token>", http.StatusUnauthorized)
+			return
+		}
+		// the cookie authorizes the UI's own requests from now on
+		if token := r.URL.Query().Get("token"); token != "" {
+			http.SetCookie(w, &http.Cookie{
+				Name:     tokenCookieName,
+				Value:    token,
+				Path:     "/",
+				HttpOnly: true,
+				SameSite: http.SameSiteStrictMode,
+			})
+		}
+	}
+
 	w.Header().Set("Content-Type", "text/html; charset=utf-8")
-	w.Write(index)
+	w.Write(injectToken(c.index, c.authToken))
 }
 
 func (c *server) clientBundleScriptHandler(w http.ResponseWriter, r *http.Request) {
 	w.Header().Set("Content-Type", "application/javascript; charset=utf-8")
-	w.Write(script)
+	w.Write(c.script)
 }
 
 func (c *server) clientBundleStylesheetHandler(w http.ResponseWriter, r *http.Request) {
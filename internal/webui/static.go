@@ -22,9 +22,240 @@ var index = []byte(`<!doctype html>
         display: inline-block;
         animation: blink 1.5s steps(2) infinite;
       }
+      .marker-highlight {
+        background: rgb(51, 65, 85);
+      }
+      .task-output {
+        white-space: nowrap;
+        overflow: hidden;
+        text-overflow: ellipsis;
+        opacity: 0.7;
+      }
+      .build-error-lines {
+        white-space: pre-wrap;
+        padding-left: 1rem;
+      }
+      .build-error-header {
+        color: rgb(148, 163, 184);
+      }
+      .retention-status {
+        font-size: 0.875rem;
+        opacity: 0.8;
+        margin-left: 1rem;
+      }
+      .history-full {
+        font-size: 0.875rem;
+        font-weight: 600;
+        color: #f87171;
+        margin-left: 1rem;
+      }
+      .selected-entry {
+        background: rgb(30, 58, 138);
+      }
+      .test-badge {
+        font-size: 0.75rem;
+        font-weight: 700;
+        padding: 0 0.5rem;
+        border-radius: 9999px;
+        color: rgb(15, 23, 42);
+      }
+      .test-pass {
+        background: rgb(74, 222, 128);
+      }
+      .test-fail {
+        background: rgb(248, 113, 113);
+      }
+      .run-diff {
+        width: 100%;
+        table-layout: fixed;
+      }
+      .run-diff th {
+        text-align: left;
+        vertical-align: top;
+        padding-bottom: 1rem;
+      }
+      .run-diff td {
+        vertical-align: top;
+        word-break: break-all;
+        padding: 0 0.5rem;
+      }
+      .run-status {
+        font-weight: 400;
+      }
+      .run-errors {
+        margin-top: -1rem;
+      }
+      .error-badge {
+        color: rgb(15, 23, 42);
+        background: rgb(248, 113, 113);
+        border-radius: 0.25rem;
+        padding: 0 0.375rem;
+        font-size: 0.75rem;
+      }
+      .run-trigger {
+        color: rgb(96, 165, 250);
+        margin-top: -1rem;
+      }
+      .timeline-legend {
+        display: flex;
+        gap: 1rem;
+        margin-bottom: 1rem;
+      }
+      .timeline-key::before {
+        content: "";
+        display: inline-block;
+        width: 0.5rem;
+        height: 0.75rem;
+        margin-right: 0.25rem;
+        background: currentColor;
+      }
+      .timeline-axis {
+        display: flex;
+        justify-content: space-between;
+        margin-left: 12rem;
+        color: rgb(148, 163, 184);
+      }
+      .timeline-row {
+        display: flex;
+        align-items: center;
+        gap: 1rem;
+        cursor: pointer;
+      }
+      .timeline-row:hover {
+        background: rgb(51, 65, 85);
+      }
+      .timeline-label {
+        width: 11rem;
+        flex-shrink: 0;
+      }
+      .timeline-track {
+        position: relative;
+        flex-grow: 1;
+        height: 1.25rem;
+      }
+      .timeline-bar {
+        position: absolute;
+        top: 0.25rem;
+        height: 0.75rem;
+        min-width: 2px;
+        border-radius: 2px;
+      }
+      .timeline-running {
+        background: rgb(96, 165, 250);
+      }
+      .timeline-stopped {
+        background: rgb(74, 222, 128);
+      }
+      .timeline-crashed {
+        background: rgb(248, 113, 113);
+      }
+      .timeline-marker {
+        position: absolute;
+        top: 0;
+        width: 3px;
+        height: 1.25rem;
+        background: currentColor;
+      }
+      .timeline-restart {
+        color: rgb(96, 165, 250);
+      }
+      .timeline-build {
+        color: rgb(251, 146, 60);
+      }
+      .timeline-task {
+        color: rgb(250, 204, 21);
+      }
+      .timeline-ipc {
+        color: rgb(192, 132, 252);
+      }
+      .timeline-crash {
+        color: rgb(239, 68, 68);
+      }
+      .paused-banner {
+        position: sticky;
+        bottom: 0;
+        display: block;
+        margin: 0.5rem auto 0;
+        padding: 0.25rem 1rem;
+        border-radius: 9999px;
+        font-size: 0.875rem;
+        color: rgb(15, 23, 42);
+        background: rgb(250, 204, 21);
+      }
+      .resources-metric {
+        margin-bottom: 1.5rem;
+      }
+      .resources-metric h3 {
+        margin-bottom: 0.5rem;
+        color: rgb(148, 163, 184);
+      }
+      .resources-row {
+        display: flex;
+        align-items: center;
+        gap: 1rem;
+      }
+      .resources-label {
+        width: 8rem;
+        flex-shrink: 0;
+      }
+      .resources-chart {
+        flex-grow: 1;
+        height: 1.5rem;
+      }
+      .resources-chart polyline {
+        fill: none;
+        stroke: rgb(96, 165, 250);
+        stroke-width: 1.5;
+      }
+      .resources-value {
+        width: 6rem;
+        flex-shrink: 0;
+        text-align: right;
+      }
+      .split-output {
+        display: flex;
+        flex-grow: 1;
+        gap: 1rem;
+        min-height: 0;
+      }
+      .split-pane {
+        display: flex;
+        flex-direction: column;
+        flex: 1 1 0;
+        min-width: 0;
+      }
+      .split-pane-header {
+        display: flex;
+        align-items: center;
+        gap: 0.5rem;
+        padding-bottom: 0.5rem;
+        border-bottom: 1px solid rgb(51, 65, 85);
+      }
+      .split-pane-output {
+        position: relative;
+        flex-grow: 1;
+        min-height: 0;
+        overflow-y: scroll;
+      }
+      .stderr-pane .split-pane-header {
+        color: rgb(248, 113, 113);
+      }
+      .stderr-pane .split-pane-output {
+        background: rgba(248, 113, 113, 0.08);
+      }
+      [data-diff="removed"] td:first-child {
+        background: rgba(248, 113, 113, 0.2);
+      }
+      [data-diff="added"] td:last-child {
+        background: rgba(74, 222, 128, 0.2);
+      }
     </style>
   </head>
-  <body class="bg-slate-900 text-white flex flex-col h-screen" x-data="search">
+  <body
+    class="bg-slate-900 text-white flex flex-col h-screen"
+    x-data="search"
+    @keydown.window="onGlobalKeyDown"
+  >
     <nav
       class="grow-0 flex flex-row p-4 justify-between items-center bg-blue-500"
     >
@@ -35,6 +266,13 @@ var index = []byte(`<!doctype html>
           class="text-2xl text-bold"
           >gomon</a
         >
+        <div
+          class="flex flex-row items-center"
+          hx-get="/components/retention"
+          hx-target="this"
+          hx-swap="innerHTML"
+          hx-trigger="load, every 60s"
+        ></div>
       </div>
       <div class="flex flex-row gap-2">
         <div class="flex flex-row gap-2 text-slate-900">
@@ -43,15 +281,31 @@ var index = []byte(`<!doctype html>
             name="q"
             type="text"
             class="input input-sm input-bordered w-96"
-            placeholder="Search..."
+            placeholder='Search... "exact phrase" prefix*'
+            title='Every term must match, use "quotes" for a phrase and a trailing * for a prefix'
             x-model="searchText"
             @keydown="onSearchTextKeyDown"
           />
+          <div
+            id="label-filter"
+            hx-get="/components/label-select"
+            hx-include="#label-select"
+            hx-target="this"
+            hx-swap="innerHTML"
+            hx-trigger="load, labelsChanged from:body"
+          >
+            <select
+              id="label-select"
+              name="label"
+              class="select select-sm select-bordered w-40"
+            ></select>
+          </div>
           <div
             hx-get="/components/search-select"
+            hx-include="#label-select"
             hx-target="this"
             hx-swap="innerHTML"
-            hx-trigger="load"
+            hx-trigger="change from:#label-filter, htmx:afterSwap from:#label-filter"
           >
             <select
               id="search-select"
@@ -60,6 +314,19 @@ var index = []byte(`<!doctype html>
               x-model="runId"
             ></select>
           </div>
+          <select
+            id="level-select"
+            name="lvl"
+            class="select select-sm select-bordered w-32"
+            title="Only show output at or above a log level"
+            x-model="level"
+          >
+            <option value="">All levels</option>
+            <option value="debug">Debug</option>
+            <option value="info">Info</option>
+            <option value="warn">Warn</option>
+            <option value="error">Error</option>
+          </select>
           <div class="tooltip tooltip-bottom" data-tip="Search">
             <button
               type="button"
@@ -83,6 +350,140 @@ var index = []byte(`<!doctype html>
             </button>
           </div>
         </div>
+        <div class="flex flex-row">
+          <div class="tooltip tooltip-bottom" data-tip="Previous crash (c)">
+            <button class="btn btn-sm btn-ghost" @click="jump('crash', 'prev')">
+              &laquo; Crash
+            </button>
+          </div>
+          <div class="tooltip tooltip-bottom" data-tip="Previous startup (s)">
+            <button
+              class="btn btn-sm btn-ghost"
+              @click="jump('startup', 'prev')"
+            >
+              &laquo; Start
+            </button>
+          </div>
+          <div class="tooltip tooltip-bottom" data-tip="First error of run (e)">
+            <button class="btn btn-sm btn-ghost" @click="jump('error', 'prev')">
+              Error
+            </button>
+          </div>
+          <div class="tooltip tooltip-bottom" data-tip="Next startup (S)">
+            <button
+              class="btn btn-sm btn-ghost"
+              @click="jump('startup', 'next')"
+            >
+              Start &raquo;
+            </button>
+          </div>
+          <div class="tooltip tooltip-bottom" data-tip="Next crash (C)">
+            <button class="btn btn-sm btn-ghost" @click="jump('crash', 'next')">
+              Crash &raquo;
+            </button>
+          </div>
+          <div class="tooltip tooltip-bottom" data-tip="Live output (l)">
+            <button class="btn btn-sm btn-ghost" @click="goLive">Live</button>
+          </div>
+          <div
+            class="tooltip tooltip-bottom"
+            data-tip="Pause or resume live output (p)"
+          >
+            <button
+              class="btn btn-sm btn-ghost"
+              :class="isPaused && 'btn-active'"
+              @click="togglePaused"
+              x-text="isPaused ? 'Resume' : 'Pause'"
+            ></button>
+          </div>
+          <div class="tooltip tooltip-bottom" data-tip="Download run log">
+            <a
+              class="btn btn-sm btn-ghost"
+              :href="'/export/' + (runId === 'all' ? 'latest' : runId) + '?format=txt'"
+              download
+              >Download</a
+            >
+          </div>
+          <div
+            class="tooltip tooltip-bottom"
+            data-tip="Compare with the last working run"
+          >
+            <button
+              class="btn btn-sm btn-ghost"
+              hx-get="/components/diff"
+              hx-target="#compare-output"
+              hx-swap="innerHTML"
+              hx-include="[name=r]"
+              @click="document.getElementById('compare-dialog').showModal()"
+            >
+              Compare
+            </button>
+          </div>
+          <div
+            class="tooltip tooltip-bottom"
+            data-tip="Show stdout and stderr side by side"
+          >
+            <button
+              class="btn btn-sm btn-ghost"
+              :class="isSplit && 'btn-active'"
+              @click="toggleSplit"
+            >
+              Split
+            </button>
+          </div>
+          <div class="tooltip tooltip-bottom" data-tip="Timeline of recent runs">
+            <button
+              class="btn btn-sm btn-ghost"
+              hx-get="/components/timeline"
+              hx-target="#timeline-output"
+              hx-swap="innerHTML"
+              @click="document.getElementById('timeline-dialog').showModal()"
+            >
+              Timeline
+            </button>
+          </div>
+          <div
+            class="tooltip tooltip-bottom"
+            data-tip="Memory and CPU usage of recent runs"
+          >
+            <button
+              class="btn btn-sm btn-ghost"
+              hx-get="/components/resources"
+              hx-target="#resources-output"
+              hx-swap="innerHTML"
+              hx-include="[name=r]"
+              @click="document.getElementById('resources-dialog').showModal()"
+            >
+              Resources
+            </button>
+          </div>
+          <div
+            hx-get="/components/profiling"
+            hx-target="this"
+            hx-swap="innerHTML"
+            hx-trigger="load"
+          ></div>
+          <div
+            hx-get="/components/restarts"
+            hx-target="this"
+            hx-swap="innerHTML"
+            hx-trigger="load, every 10s"
+          ></div>
+        </div>
+        <div
+          hx-post="/actions/task"
+          hx-trigger="change"
+          hx-include="[name=task]"
+          hx-swap="none"
+          class="text-slate-900"
+        >
+          <div
+            hx-get="/components/task-select"
+            hx-target="this"
+            hx-swap="innerHTML"
+            hx-trigger="load"
+          ></div>
+        </div>
         <div class="tooltip tooltip-bottom" data-tip="Restart">
           <button
             id="restart"
@@ -131,16 +532,99 @@ var index = []byte(`<!doctype html>
         </div>
       </div>
     </nav>
-    <main id="log-output" class="m-4 font-mono overflow-y-scroll">
+    <div
+      id="run-labels"
+      class="grow-0 flex flex-row px-4 py-1 gap-2 items-center"
+      hx-get="/components/run-labels"
+      hx-include="#search-select"
+      hx-target="this"
+      hx-swap="innerHTML"
+      hx-trigger="runSelected from:body"
+    ></div>
+    <div id="task-panel" class="grow-0 font-mono" style="margin: 1rem 1rem 0"></div>
+    <main
+      id="log-output"
+      class="m-4 font-mono overflow-y-scroll"
+      x-show="!isSplit"
+      @scroll="onOutputScroll"
+    >
       <div
         id="log-output-inner"
+        @click="onLogClick"
         hx-get="/actions/search"
         hx-trigger="load,custom:search"
         hx-swap="innerHTML"
-        hx-include="[name=q],[name=r]"
+        hx-include="[name=q],[name=r],[name=lvl]"
       ></div>
+      <button
+        class="paused-banner"
+        x-show="isPaused && !isShowingSearchResults"
+        @click="resume"
+        x-text="pausedLabel() + ', click to resume'"
+      ></button>
       <div class="blinking-cursor" x-show="!isShowingSearchResults" />
     </main>
+    <div id="split-output" class="m-4 font-mono split-output" x-show="isSplit">
+      <section class="split-pane">
+        <div class="split-pane-header">
+          <span class="grow">stdout</span>
+          <button
+            class="btn btn-xs btn-ghost"
+            @click="togglePause('stdout')"
+            x-text="pausedPanes.stdout ? 'Resume' : 'Pause'"
+          ></button>
+          <button class="btn btn-xs btn-ghost" @click="clearPane('stdout')">
+            Clear
+          </button>
+        </div>
+        <div
+          id="stdout-pane"
+          class="split-pane-output"
+          @scroll="onPaneScroll('stdout')"
+        ></div>
+      </section>
+      <section class="split-pane stderr-pane">
+        <div class="split-pane-header">
+          <span class="grow">stderr</span>
+          <button
+            class="btn btn-xs btn-ghost"
+            @click="togglePause('stderr')"
+            x-text="pausedPanes.stderr ? 'Resume' : 'Pause'"
+          ></button>
+          <button class="btn btn-xs btn-ghost" @click="clearPane('stderr')">
+            Clear
+          </button>
+        </div>
+        <div
+          id="stderr-pane"
+          class="split-pane-output"
+          @scroll="onPaneScroll('stderr')"
+        ></div>
+      </section>
+    </div>
+    <div
+      id="selection-toolbar"
+      class="toast toast-center"
+      x-show="selectionStart !== ''"
+    >
+      <div class="alert flex flex-row gap-2">
+        <span
+          x-text="selectionMessage || 'Shift-click to extend the selection'"
+        ></span>
+        <button class="btn btn-sm btn-primary" @click="copySelection('txt')">
+          Copy text
+        </button>
+        <button class="btn btn-sm btn-primary" @click="copySelection('md')">
+          Copy markdown
+        </button>
+        <button class="btn btn-sm btn-primary" @click="openSelectionInEditor">
+          Open in editor
+        </button>
+        <button class="btn btn-sm btn-ghost" @click="clearSelection">
+          Clear
+        </button>
+      </div>
+    </div>
     <div id="connection-error" class="toast" x-show="isShowingConnectionError">
       <div class="alert alert-error">
         <svg
@@ -194,6 +678,94 @@ var index = []byte(`<!doctype html>
         </div>
       </div>
     </dialog>
+    <dialog id="compare-dialog" class="w-5/6 h-5/6">
+      <div class="flex flex-col p-4 items-stretch w-full h-full">
+        <div class="flex flex-row justify-between items-center grow-0">
+          <h2 class="text-xl">Compare runs</h2>
+          <button
+            class="btn btn-sm btn-ghost"
+            @click="document.getElementById('compare-dialog').close()"
+          >
+            <svg
+              xmlns="http://www.w3.org/2000/svg"
+              fill="none"
+              viewBox="0 0 24 24"
+              stroke-width="1.5"
+              stroke="currentColor"
+              class="w-6 h-6"
+            >
+              <path
+                stroke-linecap="round"
+                stroke-linejoin="round"
+                d="M6 18L18 6M6 6l12 12"
+              />
+            </svg>
+          </button>
+        </div>
+        <div
+          id="compare-output"
+          class="p-4 grow font-mono overflow-y-scroll"
+          hx-get="/components/diff"
+          hx-trigger="change"
+          hx-include="[name=a],[name=b]"
+          hx-swap="innerHTML"
+        ></div>
+      </div>
+    </dialog>
+    <dialog id="timeline-dialog" class="w-5/6 h-5/6">
+      <div class="flex flex-col p-4 items-stretch w-full h-full">
+        <div class="flex flex-row justify-between items-center grow-0">
+          <h2 class="text-xl">Timeline</h2>
+          <button
+            class="btn btn-sm btn-ghost"
+            @click="document.getElementById('timeline-dialog').close()"
+          >
+            <svg
+              xmlns="http://www.w3.org/2000/svg"
+              fill="none"
+              viewBox="0 0 24 24"
+              stroke-width="1.5"
+              stroke="currentColor"
+              class="w-6 h-6"
+            >
+              <path
+                stroke-linecap="round"
+                stroke-linejoin="round"
+                d="M6 18L18 6M6 6l12 12"
+              />
+            </svg>
+          </button>
+        </div>
+        <div id="timeline-output" class="p-4 grow overflow-y-scroll"></div>
+      </div>
+    </dialog>
+    <dialog id="resources-dialog" class="w-5/6 h-5/6">
+      <div class="flex flex-col p-4 items-stretch w-full h-full">
+        <div class="flex flex-row justify-between items-center grow-0">
+          <h2 class="text-xl">Resources</h2>
+          <button
+            class="btn btn-sm btn-ghost"
+            @click="document.getElementById('resources-dialog').close()"
+          >
+            <svg
+              xmlns="http://www.w3.org/2000/svg"
+              fill="none"
+              viewBox="0 0 24 24"
+              stroke-width="1.5"
+              stroke="currentColor"
+              class="w-6 h-6"
+            >
+              <path
+                stroke-linecap="round"
+                stroke-linejoin="round"
+                d="M6 18L18 6M6 6l12 12"
+              />
+            </svg>
+          </button>
+        </div>
+        <div id="resources-output" class="p-4 grow overflow-y-scroll"></div>
+      </div>
+    </dialog>
   </body>
 </html>
 `)
@@ -203,7 +775,7 @@ var script = []byte(`var flushPending=!1,flushing=!1,queue=[],lastFlushedIndex=-
 ${Kr?'Expression: "'+Kr+` + "`" + `"
 
 ` + "`" + `:""}` + "`" + `,Qr),setTimeout(()=>{throw ze},0)}var shouldAutoEvaluateFunctions=!0;function dontAutoEvaluateFunctions(ze){let Qr=shouldAutoEvaluateFunctions;shouldAutoEvaluateFunctions=!1;let Kr=ze();return shouldAutoEvaluateFunctions=Qr,Kr}function evaluate(ze,Qr,Kr={}){let Jr;return evaluateLater(ze,Qr)(Wr=>Jr=Wr,Kr),Jr}function evaluateLater(...ze){return theEvaluatorFunction(...ze)}var theEvaluatorFunction=normalEvaluator;function setEvaluator(ze){theEvaluatorFunction=ze}function normalEvaluator(ze,Qr){let Kr={};injectMagics(Kr,ze);let Jr=[Kr,...closestDataStack(ze)],Wr=typeof Qr=="function"?generateEvaluatorFromFunction(Jr,Qr):generateEvaluatorFromString(Jr,Qr,ze);return tryCatch.bind(null,ze,Qr,Wr)}function generateEvaluatorFromFunction(ze,Qr){return(Kr=()=>{},{scope:Jr={},params:Wr=[]}={})=>{let Yr=Qr.apply(mergeProxies([Jr,...ze]),Wr);runIfTypeOfFunction(Kr,Yr)}}var evaluatorMemo={};function generateFunctionFromString(ze,Qr){if(evaluatorMemo[ze])return evaluatorMemo[ze];let Kr=Object.getPrototypeOf(async function(){}).constructor,Jr=/^[\n\s]*if.*\(.*\)/.test(ze.trim())||/^(let|const)\s/.test(ze.trim())?` + "`" + `(async()=>{ ${ze} })()` + "`" + `:ze,Yr=(()=>{try{let Gr=new Kr(["__self","scope"],` + "`" + `with (scope) { __self.result = ${Jr} }; __self.finished = true; return __self.result;` + "`" + `);return Object.defineProperty(Gr,"name",{value:` + "`" + `[Alpine] ${ze}` + "`" + `}),Gr}catch(Gr){return handleError(Gr,Qr,ze),Promise.resolve()}})();return evaluatorMemo[ze]=Yr,Yr}function generateEvaluatorFromString(ze,Qr,Kr){let Jr=generateFunctionFromString(Qr,Kr);return(Wr=()=>{},{scope:Yr={},params:Gr=[]}={})=>{Jr.result=void 0,Jr.finished=!1;let Zr=mergeProxies([Yr,...ze]);if(typeof Jr=="function"){let en=Jr(Jr,Zr).catch(tn=>handleError(tn,Kr,Qr));Jr.finished?(runIfTypeOfFunction(Wr,Jr.result,Zr,Gr,Kr),Jr.result=void 0):en.then(tn=>{runIfTypeOfFunction(Wr,tn,Zr,Gr,Kr)}).catch(tn=>handleError(tn,Kr,Qr)).finally(()=>Jr.result=void 0)}}}function runIfTypeOfFunction(ze,Qr,Kr,Jr,Wr){if(shouldAutoEvaluateFunctions&&typeof Qr=="function"){let Yr=Qr.apply(Kr,Jr);Yr instanceof Promise?Yr.then(Gr=>runIfTypeOfFunction(ze,Gr,Kr,Jr)).catch(Gr=>handleError(Gr,Wr,Qr)):ze(Yr)}else typeof Qr=="object"&&Qr instanceof Promise?Qr.then(Yr=>ze(Yr)):ze(Qr)}var prefixAsString="x-";function prefix(ze=""){return prefixAsString+ze}function setPrefix(ze){prefixAsString=ze}var directiveHandlers={};function directive(ze,Qr){return directiveHandlers[ze]=Qr,{before(Kr){if(!directiveHandlers[Kr]){console.warn(String.raw` + "`" + `Cannot find directive \` + "`" + `${Kr}\` + "`" + `. \` + "`" + `${ze}\` + "`" + ` will use the default order of execution` + "`" + `);return}const Jr=directiveOrder.indexOf(Kr);directiveOrder.splice(Jr>=0?Jr:directiveOrder.indexOf("DEFAULT"),0,ze)}}}function directives(ze,Qr,Kr){if(Qr=Array.from(Qr),ze._x_virtualDirectives){let Yr=Object.entries(ze._x_virtualDirectives).map(([Zr,en])=>({name:Zr,value:en})),Gr=attributesOnly(Yr);Yr=Yr.map(Zr=>Gr.find(en=>en.name===Zr.name)?{name:` + "`" + `x-bind:${Zr.name}` + "`" + `,value:` + "`" + `"${Zr.value}"` + "`" + `}:Zr),Qr=Qr.concat(Yr)}let Jr={};return Qr.map(toTransformedAttributes((Yr,Gr)=>Jr[Yr]=Gr)).filter(outNonAlpineAttributes).map(toParsedDirectives(Jr,Kr)).sort(byPriority).map(Yr=>getDirectiveHandler(ze,Yr))}function attributesOnly(ze){return Array.from(ze).map(toTransformedAttributes()).filter(Qr=>!outNonAlpineAttributes(Qr))}var isDeferringHandlers=!1,directiveHandlerStacks=new Map,currentHandlerStackKey=Symbol();function deferHandlingDirectives(ze){isDeferringHandlers=!0;let Qr=Symbol();currentHandlerStackKey=Qr,directiveHandlerStacks.set(Qr,[]);let Kr=()=>{for(;directiveHandlerStacks.get(Qr).length;)directiveHandlerStacks.get(Qr).shift()();directiveHandlerStacks.delete(Qr)},Jr=()=>{isDeferringHandlers=!1,Kr()};ze(Kr),Jr()}function getElementBoundUtilities(ze){let Qr=[],Kr=Zr=>Qr.push(Zr),[Jr,Wr]=elementBoundEffect(ze);return Qr.push(Wr),[{Alpine:alpine_default,effect:Jr,cleanup:Kr,evaluateLater:evaluateLater.bind(evaluateLater,ze),evaluate:evaluate.bind(evaluate,ze)},()=>Qr.forEach(Zr=>Zr())]}function getDirectiveHandler(ze,Qr){let Kr=()=>{},Jr=directiveHandlers[Qr.type]||Kr,[Wr,Yr]=getElementBoundUtilities(ze);onAttributeRemoved(ze,Qr.original,Yr);let Gr=()=>{ze._x_ignore||ze._x_ignoreSelf||(Jr.inline&&Jr.inline(ze,Qr,Wr),Jr=Jr.bind(Jr,ze,Qr,Wr),isDeferringHandlers?directiveHandlerStacks.get(currentHandlerStackKey).push(Jr):Jr())};return Gr.runCleanups=Yr,Gr}var startingWith=(ze,Qr)=>({name:Kr,value:Jr})=>(Kr.startsWith(ze)&&(Kr=Kr.replace(ze,Qr)),{name:Kr,value:Jr}),into=ze=>ze;function toTransformedAttributes(ze=()=>{}){return({name:Qr,value:Kr})=>{let{name:Jr,value:Wr}=attributeTransformers.reduce((Yr,Gr)=>Gr(Yr),{name:Qr,value:Kr});return Jr!==Qr&&ze(Jr,Qr),{name:Jr,value:Wr}}}var attributeTransformers=[];function mapAttributes(ze){attributeTransformers.push(ze)}function outNonAlpineAttributes({name:ze}){return alpineAttributeRegex().test(ze)}var alpineAttributeRegex=()=>new RegExp(` + "`" + `^${prefixAsString}([^:^.]+)\\b` + "`" + `);function toParsedDirectives(ze,Qr){return({name:Kr,value:Jr})=>{let Wr=Kr.match(alpineAttributeRegex()),Yr=Kr.match(/:([a-zA-Z0-9\-_:]+)/),Gr=Kr.match(/\.[^.\]]+(?=[^\]]*$)/g)||[],Zr=Qr||ze[Kr]||Kr;return{type:Wr?Wr[1]:null,value:Yr?Yr[1]:null,modifiers:Gr.map(en=>en.replace(".","")),expression:Jr,original:Zr}}}var DEFAULT="DEFAULT",directiveOrder=["ignore","ref","data","id","anchor","bind","init","for","model","modelable","transition","show","if",DEFAULT,"teleport"];function byPriority(ze,Qr){let Kr=directiveOrder.indexOf(ze.type)===-1?DEFAULT:ze.type,Jr=directiveOrder.indexOf(Qr.type)===-1?DEFAULT:Qr.type;return directiveOrder.indexOf(Kr)-directiveOrder.indexOf(Jr)}var tickStack=[],isHolding=!1;function nextTick(ze=()=>{}){return queueMicrotask(()=>{isHolding||setTimeout(()=>{releaseNextTicks()})}),new Promise(Qr=>{tickStack.push(()=>{ze(),Qr()})})}function releaseNextTicks(){for(isHolding=!1;tickStack.length;)tickStack.shift()()}function holdNextTicks(){isHolding=!0}function setClasses(ze,Qr){return Array.isArray(Qr)?setClassesFromString(ze,Qr.join(" ")):typeof Qr=="object"&&Qr!==null?setClassesFromObject(ze,Qr):typeof Qr=="function"?setClasses(ze,Qr()):setClassesFromString(ze,Qr)}function setClassesFromString(ze,Qr){let Kr=Wr=>Wr.split(" ").filter(Yr=>!ze.classList.contains(Yr)).filter(Boolean),Jr=Wr=>(ze.classList.add(...Wr),()=>{ze.classList.remove(...Wr)});return Qr=Qr===!0?Qr="":Qr||"",Jr(Kr(Qr))}function setClassesFromObject(ze,Qr){let Kr=Zr=>Zr.split(" ").filter(Boolean),Jr=Object.entries(Qr).flatMap(([Zr,en])=>en?Kr(Zr):!1).filter(Boolean),Wr=Object.entries(Qr).flatMap(([Zr,en])=>en?!1:Kr(Zr)).filter(Boolean),Yr=[],Gr=[];return Wr.forEach(Zr=>{ze.classList.contains(Zr)&&(ze.classList.remove(Zr),Gr.push(Zr))}),Jr.forEach(Zr=>{ze.classList.contains(Zr)||(ze.classList.add(Zr),Yr.push(Zr))}),()=>{Gr.forEach(Zr=>ze.classList.add(Zr)),Yr.forEach(Zr=>ze.classList.remove(Zr))}}function setStyles(ze,Qr){return typeof Qr=="object"&&Qr!==null?setStylesFromObject(ze,Qr):setStylesFromString(ze,Qr)}function setStylesFromObject(ze,Qr){let Kr={};return Object.entries(Qr).forEach(([Jr,Wr])=>{Kr[Jr]=ze.style[Jr],Jr.startsWith("--")||(Jr=kebabCase(Jr)),ze.style.setProperty(Jr,Wr)}),setTimeout(()=>{ze.style.length===0&&ze.removeAttribute("style")}),()=>{setStyles(ze,Kr)}}function setStylesFromString(ze,Qr){let Kr=ze.getAttribute("style",Qr);return ze.setAttribute("style",Qr),()=>{ze.setAttribute("style",Kr||"")}}function kebabCase(ze){return ze.replace(/([a-z])([A-Z])/g,"$1-$2").toLowerCase()}function once(ze,Qr=()=>{}){let Kr=!1;return function(){Kr?Qr.apply(this,arguments):(Kr=!0,ze.apply(this,arguments))}}directive("transition",(ze,{value:Qr,modifiers:Kr,expression:Jr},{evaluate:Wr})=>{typeof Jr=="function"&&(Jr=Wr(Jr)),Jr!==!1&&(!Jr||typeof Jr=="boolean"?registerTransitionsFromHelper(ze,Kr,Qr):registerTransitionsFromClassString(ze,Jr,Qr))});function registerTransitionsFromClassString(ze,Qr,Kr){registerTransitionObject(ze,setClasses,""),{enter:Wr=>{ze._x_transition.enter.during=Wr},"enter-start":Wr=>{ze._x_transition.enter.start=Wr},"enter-end":Wr=>{ze._x_transition.enter.end=Wr},leave:Wr=>{ze._x_transition.leave.during=Wr},"leave-start":Wr=>{ze._x_transition.leave.start=Wr},"leave-end":Wr=>{ze._x_transition.leave.end=Wr}}[Kr](Qr)}function registerTransitionsFromHelper(ze,Qr,Kr){registerTransitionObject(ze,setStyles);let Jr=!Qr.includes("in")&&!Qr.includes("out")&&!Kr,Wr=Jr||Qr.includes("in")||["enter"].includes(Kr),Yr=Jr||Qr.includes("out")||["leave"].includes(Kr);Qr.includes("in")&&!Jr&&(Qr=Qr.filter((un,cn)=>cn<Qr.indexOf("out"))),Qr.includes("out")&&!Jr&&(Qr=Qr.filter((un,cn)=>cn>Qr.indexOf("out")));let Gr=!Qr.includes("opacity")&&!Qr.includes("scale"),Zr=Gr||Qr.includes("opacity"),en=Gr||Qr.includes("scale"),tn=Zr?0:1,rn=en?modifierValue(Qr,"scale",95)/100:1,nn=modifierValue(Qr,"delay",0)/1e3,an=modifierValue(Qr,"origin","center"),ln="opacity, transform",pn=modifierValue(Qr,"duration",150)/1e3,_n=modifierValue(Qr,"duration",75)/1e3,sn="cubic-bezier(0.4, 0.0, 0.2, 1)";Wr&&(ze._x_transition.enter.during={transformOrigin:an,transitionDelay:` + "`" + `${nn}s` + "`" + `,transitionProperty:ln,transitionDuration:` + "`" + `${pn}s` + "`" + `,transitionTimingFunction:sn},ze._x_transition.enter.start={opacity:tn,transform:` + "`" + `scale(${rn})` + "`" + `},ze._x_transition.enter.end={opacity:1,transform:"scale(1)"}),Yr&&(ze._x_transition.leave.during={transformOrigin:an,transitionDelay:` + "`" + `${nn}s` + "`" + `,transitionProperty:ln,transitionDuration:` + "`" + `${_n}s` + "`" + `,transitionTimingFunction:sn},ze._x_transition.leave.start={opacity:1,transform:"scale(1)"},ze._x_transition.leave.end={opacity:tn,transform:` + "`" + `scale(${rn})` + "`" + `})}function registerTransitionObject(ze,Qr,Kr={}){ze._x_transition||(ze._x_transition={enter:{during:Kr,start:Kr,end:Kr},leave:{during:Kr,start:Kr,end:Kr},in(Jr=()=>{},Wr=()=>{}){transition(ze,Qr,{during:this.enter.during,start:this.enter.start,end:this.enter.end},Jr,Wr)},out(Jr=()=>{},Wr=()=>{}){transition(ze,Qr,{during:this.leave.during,start:this.leave.start,end:this.leave.end},Jr,Wr)}})}window.Element.prototype._x_toggleAndCascadeWithTransitions=function(ze,Qr,Kr,Jr){const Wr=document.visibilityState==="visible"?requestAnimationFrame:setTimeout;let Yr=()=>Wr(Kr);if(Qr){ze._x_transition&&(ze._x_transition.enter||ze._x_transition.leave)?ze._x_transition.enter&&(Object.entries(ze._x_transition.enter.during).length||Object.entries(ze._x_transition.enter.start).length||Object.entries(ze._x_transition.enter.end).length)?ze._x_transition.in(Kr):Yr():ze._x_transition?ze._x_transition.in(Kr):Yr();return}ze._x_hidePromise=ze._x_transition?new Promise((Gr,Zr)=>{ze._x_transition.out(()=>{},()=>Gr(Jr)),ze._x_transitioning&&ze._x_transitioning.beforeCancel(()=>Zr({isFromCancelledTransition:!0}))}):Promise.resolve(Jr),queueMicrotask(()=>{let Gr=closestHide(ze);Gr?(Gr._x_hideChildren||(Gr._x_hideChildren=[]),Gr._x_hideChildren.push(ze)):Wr(()=>{let Zr=en=>{let tn=Promise.all([en._x_hidePromise,...(en._x_hideChildren||[]).map(Zr)]).then(([rn])=>rn());return delete en._x_hidePromise,delete en._x_hideChildren,tn};Zr(ze).catch(en=>{if(!en.isFromCancelledTransition)throw en})})})};function closestHide(ze){let Qr=ze.parentNode;if(Qr)return Qr._x_hidePromise?Qr:closestHide(Qr)}function transition(ze,Qr,{during:Kr,start:Jr,end:Wr}={},Yr=()=>{},Gr=()=>{}){if(ze._x_transitioning&&ze._x_transitioning.cancel(),Object.keys(Kr).length===0&&Object.keys(Jr).length===0&&Object.keys(Wr).length===0){Yr(),Gr();return}let Zr,en,tn;performTransition(ze,{start(){Zr=Qr(ze,Jr)},during(){en=Qr(ze,Kr)},before:Yr,end(){Zr(),tn=Qr(ze,Wr)},after:Gr,cleanup(){en(),tn()}})}function performTransition(ze,Qr){let Kr,Jr,Wr,Yr=once(()=>{mutateDom(()=>{Kr=!0,Jr||Qr.before(),Wr||(Qr.end(),releaseNextTicks()),Qr.after(),ze.isConnected&&Qr.cleanup(),delete ze._x_transitioning})});ze._x_transitioning={beforeCancels:[],beforeCancel(Gr){this.beforeCancels.push(Gr)},cancel:once(function(){for(;this.beforeCancels.length;)this.beforeCancels.shift()();Yr()}),finish:Yr},mutateDom(()=>{Qr.start(),Qr.during()}),holdNextTicks(),requestAnimationFrame(()=>{if(Kr)return;let Gr=Number(getComputedStyle(ze).transitionDuration.replace(/,.*/,"").replace("s",""))*1e3,Zr=Number(getComputedStyle(ze).transitionDelay.replace(/,.*/,"").replace("s",""))*1e3;Gr===0&&(Gr=Number(getComputedStyle(ze).animationDuration.replace("s",""))*1e3),mutateDom(()=>{Qr.before()}),Jr=!0,requestAnimationFrame(()=>{Kr||(mutateDom(()=>{Qr.end()}),releaseNextTicks(),setTimeout(ze._x_transitioning.finish,Gr+Zr),Wr=!0)})})}function modifierValue(ze,Qr,Kr){if(ze.indexOf(Qr)===-1)return Kr;const Jr=ze[ze.indexOf(Qr)+1];if(!Jr||Qr==="scale"&&isNaN(Jr))return Kr;if(Qr==="duration"||Qr==="delay"){let Wr=Jr.match(/([0-9]+)ms/);if(Wr)return Wr[1]}return Qr==="origin"&&["top","right","left","center","bottom"].includes(ze[ze.indexOf(Qr)+2])?[Jr,ze[ze.indexOf(Qr)+2]].join(" "):Jr}var isCloning=!1;function skipDuringClone(ze,Qr=()=>{}){return(...Kr)=>isCloning?Qr(...Kr):ze(...Kr)}function onlyDuringClone(ze){return(...Qr)=>isCloning&&ze(...Qr)}var interceptors=[];function interceptClone(ze){interceptors.push(ze)}function cloneNode(ze,Qr){interceptors.forEach(Kr=>Kr(ze,Qr)),isCloning=!0,dontRegisterReactiveSideEffects(()=>{initTree(Qr,(Kr,Jr)=>{Jr(Kr,()=>{})})}),isCloning=!1}var isCloningLegacy=!1;function clone(ze,Qr){Qr._x_dataStack||(Qr._x_dataStack=ze._x_dataStack),isCloning=!0,isCloningLegacy=!0,dontRegisterReactiveSideEffects(()=>{cloneTree(Qr)}),isCloning=!1,isCloningLegacy=!1}function cloneTree(ze){let Qr=!1;initTree(ze,(Jr,Wr)=>{walk(Jr,(Yr,Gr)=>{if(Qr&&isRoot(Yr))return Gr();Qr=!0,Wr(Yr,Gr)})})}function dontRegisterReactiveSideEffects(ze){let Qr=effect;overrideEffect((Kr,Jr)=>{let Wr=Qr(Kr);return release(Wr),()=>{}}),ze(),overrideEffect(Qr)}function bind(ze,Qr,Kr,Jr=[]){switch(ze._x_bindings||(ze._x_bindings=reactive({})),ze._x_bindings[Qr]=Kr,Qr=Jr.includes("camel")?camelCase(Qr):Qr,Qr){case"value":bindInputValue(ze,Kr);break;case"style":bindStyles(ze,Kr);break;case"class":bindClasses(ze,Kr);break;case"selected":case"checked":bindAttributeAndProperty(ze,Qr,Kr);break;default:bindAttribute(ze,Qr,Kr);break}}function bindInputValue(ze,Qr){if(ze.type==="radio")ze.attributes.value===void 0&&(ze.value=Qr),window.fromModel&&(typeof Qr=="boolean"?ze.checked=safeParseBoolean(ze.value)===Qr:ze.checked=checkedAttrLooseCompare(ze.value,Qr));else if(ze.type==="checkbox")Number.isInteger(Qr)?ze.value=Qr:!Array.isArray(Qr)&&typeof Qr!="boolean"&&![null,void 0].includes(Qr)?ze.value=String(Qr):Array.isArray(Qr)?ze.checked=Qr.some(Kr=>checkedAttrLooseCompare(Kr,ze.value)):ze.checked=!!Qr;else if(ze.tagName==="SELECT")updateSelect(ze,Qr);else{if(ze.value===Qr)return;ze.value=Qr===void 0?"":Qr}}function bindClasses(ze,Qr){ze._x_undoAddedClasses&&ze._x_undoAddedClasses(),ze._x_undoAddedClasses=setClasses(ze,Qr)}function bindStyles(ze,Qr){ze._x_undoAddedStyles&&ze._x_undoAddedStyles(),ze._x_undoAddedStyles=setStyles(ze,Qr)}function bindAttributeAndProperty(ze,Qr,Kr){bindAttribute(ze,Qr,Kr),setPropertyIfChanged(ze,Qr,Kr)}function bindAttribute(ze,Qr,Kr){[null,void 0,!1].includes(Kr)&&attributeShouldntBePreservedIfFalsy(Qr)?ze.removeAttribute(Qr):(isBooleanAttr(Qr)&&(Kr=Qr),setIfChanged(ze,Qr,Kr))}function setIfChanged(ze,Qr,Kr){ze.getAttribute(Qr)!=Kr&&ze.setAttribute(Qr,Kr)}function setPropertyIfChanged(ze,Qr,Kr){ze[Qr]!==Kr&&(ze[Qr]=Kr)}function updateSelect(ze,Qr){const Kr=[].concat(Qr).map(Jr=>Jr+"");Array.from(ze.options).forEach(Jr=>{Jr.selected=Kr.includes(Jr.value)})}function camelCase(ze){return ze.toLowerCase().replace(/-(\w)/g,(Qr,Kr)=>Kr.toUpperCase())}function checkedAttrLooseCompare(ze,Qr){return ze==Qr}function safeParseBoolean(ze){return[1,"1","true","on","yes",!0].includes(ze)?!0:[0,"0","false","off","no",!1].includes(ze)?!1:ze?!!ze:null}function isBooleanAttr(ze){return["disabled","checked","required","readonly","hidden","open","selected","autofocus","itemscope","multiple","novalidate","allowfullscreen","allowpaymentrequest","formnovalidate","autoplay","controls","loop","muted","playsinline","default","ismap","reversed","async","defer","nomodule"].includes(ze)}function attributeShouldntBePreservedIfFalsy(ze){return!["aria-pressed","aria-checked","aria-expanded","aria-selected"].includes(ze)}function getBinding(ze,Qr,Kr){return ze._x_bindings&&ze._x_bindings[Qr]!==void 0?ze._x_bindings[Qr]:getAttributeBinding(ze,Qr,Kr)}function extractProp(ze,Qr,Kr,Jr=!0){if(ze._x_bindings&&ze._x_bindings[Qr]!==void 0)return ze._x_bindings[Qr];if(ze._x_inlineBindings&&ze._x_inlineBindings[Qr]!==void 0){let Wr=ze._x_inlineBindings[Qr];return Wr.extract=Jr,dontAutoEvaluateFunctions(()=>evaluate(ze,Wr.expression))}return getAttributeBinding(ze,Qr,Kr)}function getAttributeBinding(ze,Qr,Kr){let Jr=ze.getAttribute(Qr);return Jr===null?typeof Kr=="function"?Kr():Kr:Jr===""?!0:isBooleanAttr(Qr)?!![Qr,"true"].includes(Jr):Jr}function debounce(ze,Qr){var Kr;return function(){var Jr=this,Wr=arguments,Yr=function(){Kr=null,ze.apply(Jr,Wr)};clearTimeout(Kr),Kr=setTimeout(Yr,Qr)}}function throttle(ze,Qr){let Kr;return function(){let Jr=this,Wr=arguments;Kr||(ze.apply(Jr,Wr),Kr=!0,setTimeout(()=>Kr=!1,Qr))}}function entangle({get:ze,set:Qr},{get:Kr,set:Jr}){let Wr=!0,Yr,Gr=effect(()=>{let Zr=ze(),en=Kr();if(Wr)Jr(cloneIfObject(Zr)),Wr=!1;else{let tn=JSON.stringify(Zr),rn=JSON.stringify(en);tn!==Yr?Jr(cloneIfObject(Zr)):tn!==rn&&Qr(cloneIfObject(en))}Yr=JSON.stringify(ze()),JSON.stringify(Kr())});return()=>{release(Gr)}}function cloneIfObject(ze){return typeof ze=="object"?JSON.parse(JSON.stringify(ze)):ze}function plugin(ze){(Array.isArray(ze)?ze:[ze]).forEach(Kr=>Kr(alpine_default))}var stores={},isReactive=!1;function store(ze,Qr){if(isReactive||(stores=reactive(stores),isReactive=!0),Qr===void 0)return stores[ze];stores[ze]=Qr,typeof Qr=="object"&&Qr!==null&&Qr.hasOwnProperty("init")&&typeof Qr.init=="function"&&stores[ze].init(),initInterceptors2(stores[ze])}function getStores(){return stores}var binds={};function bind2(ze,Qr){let Kr=typeof Qr!="function"?()=>Qr:Qr;return ze instanceof Element?applyBindingsObject(ze,Kr()):(binds[ze]=Kr,()=>{})}function injectBindingProviders(ze){return Object.entries(binds).forEach(([Qr,Kr])=>{Object.defineProperty(ze,Qr,{get(){return(...Jr)=>Kr(...Jr)}})}),ze}function applyBindingsObject(ze,Qr,Kr){let Jr=[];for(;Jr.length;)Jr.pop()();let Wr=Object.entries(Qr).map(([Gr,Zr])=>({name:Gr,value:Zr})),Yr=attributesOnly(Wr);return Wr=Wr.map(Gr=>Yr.find(Zr=>Zr.name===Gr.name)?{name:` + "`" + `x-bind:${Gr.name}` + "`" + `,value:` + "`" + `"${Gr.value}"` + "`" + `}:Gr),directives(ze,Wr,Kr).map(Gr=>{Jr.push(Gr.runCleanups),Gr()}),()=>{for(;Jr.length;)Jr.pop()()}}var datas={};function data(ze,Qr){datas[ze]=Qr}function injectDataProviders(ze,Qr){return Object.entries(datas).forEach(([Kr,Jr])=>{Object.defineProperty(ze,Kr,{get(){return(...Wr)=>Jr.bind(Qr)(...Wr)},enumerable:!1})}),ze}var Alpine={get reactive(){return reactive},get release(){return release},get effect(){return effect},get raw(){return raw},version:"3.13.7",flushAndStopDeferringMutations,dontAutoEvaluateFunctions,disableEffectScheduling,startObservingMutations,stopObservingMutations,setReactivityEngine,onAttributeRemoved,onAttributesAdded,closestDataStack,skipDuringClone,onlyDuringClone,addRootSelector,addInitSelector,interceptClone,addScopeToNode,deferMutations,mapAttributes,evaluateLater,interceptInit,setEvaluator,mergeProxies,extractProp,findClosest,onElRemoved,closestRoot,destroyTree,interceptor,transition,setStyles,mutateDom,directive,entangle,throttle,debounce,evaluate,initTree,nextTick,prefixed:prefix,prefix:setPrefix,plugin,magic,store,start,clone,cloneNode,bound:getBinding,$data:scope,watch,walk,data,bind:bind2},alpine_default=Alpine;function makeMap(ze,Qr){const Kr=Object.create(null),Jr=ze.split(",");for(let Wr=0;Wr<Jr.length;Wr++)Kr[Jr[Wr]]=!0;return Qr?Wr=>!!Kr[Wr.toLowerCase()]:Wr=>!!Kr[Wr]}var EMPTY_OBJ=Object.freeze({}),hasOwnProperty=Object.prototype.hasOwnProperty,hasOwn=(ze,Qr)=>hasOwnProperty.call(ze,Qr),isArray=Array.isArray,isMap=ze=>toTypeString(ze)==="[object Map]",isString=ze=>typeof ze=="string",isSymbol=ze=>typeof ze=="symbol",isObject=ze=>ze!==null&&typeof ze=="object",objectToString=Object.prototype.toString,toTypeString=ze=>objectToString.call(ze),toRawType=ze=>toTypeString(ze).slice(8,-1),isIntegerKey=ze=>isString(ze)&&ze!=="NaN"&&ze[0]!=="-"&&""+parseInt(ze,10)===ze,cacheStringFunction=ze=>{const Qr=Object.create(null);return Kr=>Qr[Kr]||(Qr[Kr]=ze(Kr))},capitalize=cacheStringFunction(ze=>ze.charAt(0).toUpperCase()+ze.slice(1)),hasChanged=(ze,Qr)=>ze!==Qr&&(ze===ze||Qr===Qr),targetMap=new WeakMap,effectStack=[],activeEffect,ITERATE_KEY=Symbol("iterate"),MAP_KEY_ITERATE_KEY=Symbol("Map key iterate");function isEffect(ze){return ze&&ze._isEffect===!0}function effect2(ze,Qr=EMPTY_OBJ){isEffect(ze)&&(ze=ze.raw);const Kr=createReactiveEffect(ze,Qr);return Qr.lazy||Kr(),Kr}function stop(ze){ze.active&&(cleanup(ze),ze.options.onStop&&ze.options.onStop(),ze.active=!1)}var uid=0;function createReactiveEffect(ze,Qr){const Kr=function(){if(!Kr.active)return ze();if(!effectStack.includes(Kr)){cleanup(Kr);try{return enableTracking(),effectStack.push(Kr),activeEffect=Kr,ze()}finally{effectStack.pop(),resetTracking(),activeEffect=effectStack[effectStack.length-1]}}};return Kr.id=uid++,Kr.allowRecurse=!!Qr.allowRecurse,Kr._isEffect=!0,Kr.active=!0,Kr.raw=ze,Kr.deps=[],Kr.options=Qr,Kr}function cleanup(ze){const{deps:Qr}=ze;if(Qr.length){for(let Kr=0;Kr<Qr.length;Kr++)Qr[Kr].delete(ze);Qr.length=0}}var shouldTrack=!0,trackStack=[];function pauseTracking(){trackStack.push(shouldTrack),shouldTrack=!1}function enableTracking(){trackStack.push(shouldTrack),shouldTrack=!0}function resetTracking(){const ze=trackStack.pop();shouldTrack=ze===void 0?!0:ze}function track(ze,Qr,Kr){if(!shouldTrack||activeEffect===void 0)return;let Jr=targetMap.get(ze);Jr||targetMap.set(ze,Jr=new Map);let Wr=Jr.get(Kr);Wr||Jr.set(Kr,Wr=new Set),Wr.has(activeEffect)||(Wr.add(activeEffect),activeEffect.deps.push(Wr),activeEffect.options.onTrack&&activeEffect.options.onTrack({effect:activeEffect,target:ze,type:Qr,key:Kr}))}function trigger(ze,Qr,Kr,Jr,Wr,Yr){const Gr=targetMap.get(ze);if(!Gr)return;const Zr=new Set,en=rn=>{rn&&rn.forEach(nn=>{(nn!==activeEffect||nn.allowRecurse)&&Zr.add(nn)})};if(Qr==="clear")Gr.forEach(en);else if(Kr==="length"&&isArray(ze))Gr.forEach((rn,nn)=>{(nn==="length"||nn>=Jr)&&en(rn)});else switch(Kr!==void 0&&en(Gr.get(Kr)),Qr){case"add":isArray(ze)?isIntegerKey(Kr)&&en(Gr.get("length")):(en(Gr.get(ITERATE_KEY)),isMap(ze)&&en(Gr.get(MAP_KEY_ITERATE_KEY)));break;case"delete":isArray(ze)||(en(Gr.get(ITERATE_KEY)),isMap(ze)&&en(Gr.get(MAP_KEY_ITERATE_KEY)));break;case"set":isMap(ze)&&en(Gr.get(ITERATE_KEY));break}const tn=rn=>{rn.options.onTrigger&&rn.options.onTrigger({effect:rn,target:ze,key:Kr,type:Qr,newValue:Jr,oldValue:Wr,oldTarget:Yr}),rn.options.scheduler?rn.options.scheduler(rn):rn()};Zr.forEach(tn)}var isNonTrackableKeys=makeMap("__proto__,__v_isRef,__isVue"),builtInSymbols=new Set(Object.getOwnPropertyNames(Symbol).map(ze=>Symbol[ze]).filter(isSymbol)),get2=createGetter(),readonlyGet=createGetter(!0),arrayInstrumentations=createArrayInstrumentations();function createArrayInstrumentations(){const ze={};return["includes","indexOf","lastIndexOf"].forEach(Qr=>{ze[Qr]=function(...Kr){const Jr=toRaw(this);for(let Yr=0,Gr=this.length;Yr<Gr;Yr++)track(Jr,"get",Yr+"");const Wr=Jr[Qr](...Kr);return Wr===-1||Wr===!1?Jr[Qr](...Kr.map(toRaw)):Wr}}),["push","pop","shift","unshift","splice"].forEach(Qr=>{ze[Qr]=function(...Kr){pauseTracking();const Jr=toRaw(this)[Qr].apply(this,Kr);return resetTracking(),Jr}}),ze}function createGetter(ze=!1,Qr=!1){return function(Jr,Wr,Yr){if(Wr==="__v_isReactive")return!ze;if(Wr==="__v_isReadonly")return ze;if(Wr==="__v_raw"&&Yr===(ze?Qr?shallowReadonlyMap:readonlyMap:Qr?shallowReactiveMap:reactiveMap).get(Jr))return Jr;const Gr=isArray(Jr);if(!ze&&Gr&&hasOwn(arrayInstrumentations,Wr))return Reflect.get(arrayInstrumentations,Wr,Yr);const Zr=Reflect.get(Jr,Wr,Yr);return(isSymbol(Wr)?builtInSymbols.has(Wr):isNonTrackableKeys(Wr))||(ze||track(Jr,"get",Wr),Qr)?Zr:isRef(Zr)?!Gr||!isIntegerKey(Wr)?Zr.value:Zr:isObject(Zr)?ze?readonly(Zr):reactive2(Zr):Zr}}var set2=createSetter();function createSetter(ze=!1){return function(Kr,Jr,Wr,Yr){let Gr=Kr[Jr];if(!ze&&(Wr=toRaw(Wr),Gr=toRaw(Gr),!isArray(Kr)&&isRef(Gr)&&!isRef(Wr)))return Gr.value=Wr,!0;const Zr=isArray(Kr)&&isIntegerKey(Jr)?Number(Jr)<Kr.length:hasOwn(Kr,Jr),en=Reflect.set(Kr,Jr,Wr,Yr);return Kr===toRaw(Yr)&&(Zr?hasChanged(Wr,Gr)&&trigger(Kr,"set",Jr,Wr,Gr):trigger(Kr,"add",Jr,Wr)),en}}function deleteProperty(ze,Qr){const Kr=hasOwn(ze,Qr),Jr=ze[Qr],Wr=Reflect.deleteProperty(ze,Qr);return Wr&&Kr&&trigger(ze,"delete",Qr,void 0,Jr),Wr}function has(ze,Qr){const Kr=Reflect.has(ze,Qr);return(!isSymbol(Qr)||!builtInSymbols.has(Qr))&&track(ze,"has",Qr),Kr}function ownKeys(ze){return track(ze,"iterate",isArray(ze)?"length":ITERATE_KEY),Reflect.ownKeys(ze)}var mutableHandlers={get:get2,set:set2,deleteProperty,has,ownKeys},readonlyHandlers={get:readonlyGet,set(ze,Qr){return console.warn(` + "`" + `Set operation on key "${String(Qr)}" failed: target is readonly.` + "`" + `,ze),!0},deleteProperty(ze,Qr){return console.warn(` + "`" + `Delete operation on key "${String(Qr)}" failed: target is readonly.` + "`" + `,ze),!0}},toReactive=ze=>isObject(ze)?reactive2(ze):ze,toReadonly=ze=>isObject(ze)?readonly(ze):ze,toShallow=ze=>ze,getProto=ze=>Reflect.getPrototypeOf(ze);function get$1(ze,Qr,Kr=!1,Jr=!1){ze=ze.__v_raw;const Wr=toRaw(ze),Yr=toRaw(Qr);Qr!==Yr&&!Kr&&track(Wr,"get",Qr),!Kr&&track(Wr,"get",Yr);const{has:Gr}=getProto(Wr),Zr=Jr?toShallow:Kr?toReadonly:toReactive;if(Gr.call(Wr,Qr))return Zr(ze.get(Qr));if(Gr.call(Wr,Yr))return Zr(ze.get(Yr));ze!==Wr&&ze.get(Qr)}function has$1(ze,Qr=!1){const Kr=this.__v_raw,Jr=toRaw(Kr),Wr=toRaw(ze);return ze!==Wr&&!Qr&&track(Jr,"has",ze),!Qr&&track(Jr,"has",Wr),ze===Wr?Kr.has(ze):Kr.has(ze)||Kr.has(Wr)}function size(ze,Qr=!1){return ze=ze.__v_raw,!Qr&&track(toRaw(ze),"iterate",ITERATE_KEY),Reflect.get(ze,"size",ze)}function add(ze){ze=toRaw(ze);const Qr=toRaw(this);return getProto(Qr).has.call(Qr,ze)||(Qr.add(ze),trigger(Qr,"add",ze,ze)),this}function set$1(ze,Qr){Qr=toRaw(Qr);const Kr=toRaw(this),{has:Jr,get:Wr}=getProto(Kr);let Yr=Jr.call(Kr,ze);Yr?checkIdentityKeys(Kr,Jr,ze):(ze=toRaw(ze),Yr=Jr.call(Kr,ze));const Gr=Wr.call(Kr,ze);return Kr.set(ze,Qr),Yr?hasChanged(Qr,Gr)&&trigger(Kr,"set",ze,Qr,Gr):trigger(Kr,"add",ze,Qr),this}function deleteEntry(ze){const Qr=toRaw(this),{has:Kr,get:Jr}=getProto(Qr);let Wr=Kr.call(Qr,ze);Wr?checkIdentityKeys(Qr,Kr,ze):(ze=toRaw(ze),Wr=Kr.call(Qr,ze));const Yr=Jr?Jr.call(Qr,ze):void 0,Gr=Qr.delete(ze);return Wr&&trigger(Qr,"delete",ze,void 0,Yr),Gr}function clear(){const ze=toRaw(this),Qr=ze.size!==0,Kr=isMap(ze)?new Map(ze):new Set(ze),Jr=ze.clear();return Qr&&trigger(ze,"clear",void 0,void 0,Kr),Jr}function createForEach(ze,Qr){return function(Jr,Wr){const Yr=this,Gr=Yr.__v_raw,Zr=toRaw(Gr),en=Qr?toShallow:ze?toReadonly:toReactive;return!ze&&track(Zr,"iterate",ITERATE_KEY),Gr.forEach((tn,rn)=>Jr.call(Wr,en(tn),en(rn),Yr))}}function createIterableMethod(ze,Qr,Kr){return function(...Jr){const Wr=this.__v_raw,Yr=toRaw(Wr),Gr=isMap(Yr),Zr=ze==="entries"||ze===Symbol.iterator&&Gr,en=ze==="keys"&&Gr,tn=Wr[ze](...Jr),rn=Kr?toShallow:Qr?toReadonly:toReactive;return!Qr&&track(Yr,"iterate",en?MAP_KEY_ITERATE_KEY:ITERATE_KEY),{next(){const{value:nn,done:an}=tn.next();return an?{value:nn,done:an}:{value:Zr?[rn(nn[0]),rn(nn[1])]:rn(nn),done:an}},[Symbol.iterator](){return this}}}}function createReadonlyMethod(ze){return function(...Qr){{const Kr=Qr[0]?` + "`" + `on key "${Qr[0]}" ` + "`" + `:"";console.warn(` + "`" + `${capitalize(ze)} operation ${Kr}failed: target is readonly.` + "`" + `,toRaw(this))}return ze==="delete"?!1:this}}function createInstrumentations(){const ze={get(Yr){return get$1(this,Yr)},get size(){return size(this)},has:has$1,add,set:set$1,delete:deleteEntry,clear,forEach:createForEach(!1,!1)},Qr={get(Yr){return get$1(this,Yr,!1,!0)},get size(){return size(this)},has:has$1,add,set:set$1,delete:deleteEntry,clear,forEach:createForEach(!1,!0)},Kr={get(Yr){return get$1(this,Yr,!0)},get size(){return size(this,!0)},has(Yr){return has$1.call(this,Yr,!0)},add:createReadonlyMethod("add"),set:createReadonlyMethod("set"),delete:createReadonlyMethod("delete"),clear:createReadonlyMethod("clear"),forEach:createForEach(!0,!1)},Jr={get(Yr){return get$1(this,Yr,!0,!0)},get size(){return size(this,!0)},has(Yr){return has$1.call(this,Yr,!0)},add:createReadonlyMethod("add"),set:createReadonlyMethod("set"),delete:createReadonlyMethod("delete"),clear:createReadonlyMethod("clear"),forEach:createForEach(!0,!0)};return["keys","values","entries",Symbol.iterator].forEach(Yr=>{ze[Yr]=createIterableMethod(Yr,!1,!1),Kr[Yr]=createIterableMethod(Yr,!0,!1),Qr[Yr]=createIterableMethod(Yr,!1,!0),Jr[Yr]=createIterableMethod(Yr,!0,!0)}),[ze,Kr,Qr,Jr]}var[mutableInstrumentations,readonlyInstrumentations,shallowInstrumentations,shallowReadonlyInstrumentations]=createInstrumentations();function createInstrumentationGetter(ze,Qr){const Kr=Qr?ze?shallowReadonlyInstrumentations:shallowInstrumentations:ze?readonlyInstrumentations:mutableInstrumentations;return(Jr,Wr,Yr)=>Wr==="__v_isReactive"?!ze:Wr==="__v_isReadonly"?ze:Wr==="__v_raw"?Jr:Reflect.get(hasOwn(Kr,Wr)&&Wr in Jr?Kr:Jr,Wr,Yr)}var mutableCollectionHandlers={get:createInstrumentationGetter(!1,!1)},readonlyCollectionHandlers={get:createInstrumentationGetter(!0,!1)};function checkIdentityKeys(ze,Qr,Kr){const Jr=toRaw(Kr);if(Jr!==Kr&&Qr.call(ze,Jr)){const Wr=toRawType(ze);console.warn(` + "`" + `Reactive ${Wr} contains both the raw and reactive versions of the same object${Wr==="Map"?" as keys":""}, which can lead to inconsistencies. Avoid differentiating between the raw and reactive versions of an object and only use the reactive version if possible.` + "`" + `)}}var reactiveMap=new WeakMap,shallowReactiveMap=new WeakMap,readonlyMap=new WeakMap,shallowReadonlyMap=new WeakMap;function targetTypeMap(ze){switch(ze){case"Object":case"Array":return 1;case"Map":case"Set":case"WeakMap":case"WeakSet":return 2;default:return 0}}function getTargetType(ze){return ze.__v_skip||!Object.isExtensible(ze)?0:targetTypeMap(toRawType(ze))}function reactive2(ze){return ze&&ze.__v_isReadonly?ze:createReactiveObject(ze,!1,mutableHandlers,mutableCollectionHandlers,reactiveMap)}function readonly(ze){return createReactiveObject(ze,!0,readonlyHandlers,readonlyCollectionHandlers,readonlyMap)}function createReactiveObject(ze,Qr,Kr,Jr,Wr){if(!isObject(ze))return console.warn(` + "`" + `value cannot be made reactive: ${String(ze)}` + "`" + `),ze;if(ze.__v_raw&&!(Qr&&ze.__v_isReactive))return ze;const Yr=Wr.get(ze);if(Yr)return Yr;const Gr=getTargetType(ze);if(Gr===0)return ze;const Zr=new Proxy(ze,Gr===2?Jr:Kr);return Wr.set(ze,Zr),Zr}function toRaw(ze){return ze&&toRaw(ze.__v_raw)||ze}function isRef(ze){return!!(ze&&ze.__v_isRef===!0)}magic("nextTick",()=>nextTick);magic("dispatch",ze=>dispatch.bind(dispatch,ze));magic("watch",(ze,{evaluateLater:Qr,cleanup:Kr})=>(Jr,Wr)=>{let Yr=Qr(Jr),Zr=watch(()=>{let en;return Yr(tn=>en=tn),en},Wr);Kr(Zr)});magic("store",getStores);magic("data",ze=>scope(ze));magic("root",ze=>closestRoot(ze));magic("refs",ze=>(ze._x_refs_proxy||(ze._x_refs_proxy=mergeProxies(getArrayOfRefObject(ze))),ze._x_refs_proxy));function getArrayOfRefObject(ze){let Qr=[];return findClosest(ze,Kr=>{Kr._x_refs&&Qr.push(Kr._x_refs)}),Qr}var globalIdMemo={};function findAndIncrementId(ze){return globalIdMemo[ze]||(globalIdMemo[ze]=0),++globalIdMemo[ze]}function closestIdRoot(ze,Qr){return findClosest(ze,Kr=>{if(Kr._x_ids&&Kr._x_ids[Qr])return!0})}function setIdRoot(ze,Qr){ze._x_ids||(ze._x_ids={}),ze._x_ids[Qr]||(ze._x_ids[Qr]=findAndIncrementId(Qr))}magic("id",(ze,{cleanup:Qr})=>(Kr,Jr=null)=>{let Wr=` + "`" + `${Kr}${Jr?` + "`" + `-${Jr}` + "`" + `:""}` + "`" + `;return cacheIdByNameOnElement(ze,Wr,Qr,()=>{let Yr=closestIdRoot(ze,Kr),Gr=Yr?Yr._x_ids[Kr]:findAndIncrementId(Kr);return Jr?` + "`" + `${Kr}-${Gr}-${Jr}` + "`" + `:` + "`" + `${Kr}-${Gr}` + "`" + `})});interceptClone((ze,Qr)=>{ze._x_id&&(Qr._x_id=ze._x_id)});function cacheIdByNameOnElement(ze,Qr,Kr,Jr){if(ze._x_id||(ze._x_id={}),ze._x_id[Qr])return ze._x_id[Qr];let Wr=Jr();return ze._x_id[Qr]=Wr,Kr(()=>{delete ze._x_id[Qr]}),Wr}magic("el",ze=>ze);warnMissingPluginMagic("Focus","focus","focus");warnMissingPluginMagic("Persist","persist","persist");function warnMissingPluginMagic(ze,Qr,Kr){magic(Qr,Jr=>warn(` + "`" + `You can't use [$${Qr}] without first installing the "${ze}" plugin here: https://alpinejs.dev/plugins/${Kr}` + "`" + `,Jr))}directive("modelable",(ze,{expression:Qr},{effect:Kr,evaluateLater:Jr,cleanup:Wr})=>{let Yr=Jr(Qr),Gr=()=>{let rn;return Yr(nn=>rn=nn),rn},Zr=Jr(` + "`" + `${Qr} = __placeholder` + "`" + `),en=rn=>Zr(()=>{},{scope:{__placeholder:rn}}),tn=Gr();en(tn),queueMicrotask(()=>{if(!ze._x_model)return;ze._x_removeModelListeners.default();let rn=ze._x_model.get,nn=ze._x_model.set,an=entangle({get(){return rn()},set(ln){nn(ln)}},{get(){return Gr()},set(ln){en(ln)}});Wr(an)})});directive("teleport",(ze,{modifiers:Qr,expression:Kr},{cleanup:Jr})=>{ze.tagName.toLowerCase()!=="template"&&warn("x-teleport can only be used on a <template> tag",ze);let Wr=getTarget(Kr),Yr=ze.content.cloneNode(!0).firstElementChild;ze._x_teleport=Yr,Yr._x_teleportBack=ze,ze.setAttribute("data-teleport-template",!0),Yr.setAttribute("data-teleport-target",!0),ze._x_forwardEvents&&ze._x_forwardEvents.forEach(Zr=>{Yr.addEventListener(Zr,en=>{en.stopPropagation(),ze.dispatchEvent(new en.constructor(en.type,en))})}),addScopeToNode(Yr,{},ze);let Gr=(Zr,en,tn)=>{tn.includes("prepend")?en.parentNode.insertBefore(Zr,en):tn.includes("append")?en.parentNode.insertBefore(Zr,en.nextSibling):en.appendChild(Zr)};mutateDom(()=>{Gr(Yr,Wr,Qr),initTree(Yr),Yr._x_ignore=!0}),ze._x_teleportPutBack=()=>{let Zr=getTarget(Kr);mutateDom(()=>{Gr(ze._x_teleport,Zr,Qr)})},Jr(()=>Yr.remove())});var teleportContainerDuringClone=document.createElement("div");function getTarget(ze){let Qr=skipDuringClone(()=>document.querySelector(ze),()=>teleportContainerDuringClone)();return Qr||warn(` + "`" + `Cannot find x-teleport element for selector: "${ze}"` + "`" + `),Qr}var handler=()=>{};handler.inline=(ze,{modifiers:Qr},{cleanup:Kr})=>{Qr.includes("self")?ze._x_ignoreSelf=!0:ze._x_ignore=!0,Kr(()=>{Qr.includes("self")?delete ze._x_ignoreSelf:delete ze._x_ignore})};directive("ignore",handler);directive("effect",skipDuringClone((ze,{expression:Qr},{effect:Kr})=>{Kr(evaluateLater(ze,Qr))}));function on(ze,Qr,Kr,Jr){let Wr=ze,Yr=en=>Jr(en),Gr={},Zr=(en,tn)=>rn=>tn(en,rn);if(Kr.includes("dot")&&(Qr=dotSyntax(Qr)),Kr.includes("camel")&&(Qr=camelCase2(Qr)),Kr.includes("passive")&&(Gr.passive=!0),Kr.includes("capture")&&(Gr.capture=!0),Kr.includes("window")&&(Wr=window),Kr.includes("document")&&(Wr=document),Kr.includes("debounce")){let en=Kr[Kr.indexOf("debounce")+1]||"invalid-wait",tn=isNumeric(en.split("ms")[0])?Number(en.split("ms")[0]):250;Yr=debounce(Yr,tn)}if(Kr.includes("throttle")){let en=Kr[Kr.indexOf("throttle")+1]||"invalid-wait",tn=isNumeric(en.split("ms")[0])?Number(en.split("ms")[0]):250;Yr=throttle(Yr,tn)}return Kr.includes("prevent")&&(Yr=Zr(Yr,(en,tn)=>{tn.preventDefault(),en(tn)})),Kr.includes("stop")&&(Yr=Zr(Yr,(en,tn)=>{tn.stopPropagation(),en(tn)})),Kr.includes("self")&&(Yr=Zr(Yr,(en,tn)=>{tn.target===ze&&en(tn)})),(Kr.includes("away")||Kr.includes("outside"))&&(Wr=document,Yr=Zr(Yr,(en,tn)=>{ze.contains(tn.target)||tn.target.isConnected!==!1&&(ze.offsetWidth<1&&ze.offsetHeight<1||ze._x_isShown!==!1&&en(tn))})),Kr.includes("once")&&(Yr=Zr(Yr,(en,tn)=>{en(tn),Wr.removeEventListener(Qr,Yr,Gr)})),Yr=Zr(Yr,(en,tn)=>{isKeyEvent(Qr)&&isListeningForASpecificKeyThatHasntBeenPressed(tn,Kr)||en(tn)}),Wr.addEventListener(Qr,Yr,Gr),()=>{Wr.removeEventListener(Qr,Yr,Gr)}}function dotSyntax(ze){return ze.replace(/-/g,".")}function camelCase2(ze){return ze.toLowerCase().replace(/-(\w)/g,(Qr,Kr)=>Kr.toUpperCase())}function isNumeric(ze){return!Array.isArray(ze)&&!isNaN(ze)}function kebabCase2(ze){return[" ","_"].includes(ze)?ze:ze.replace(/([a-z])([A-Z])/g,"$1-$2").replace(/[_\s]/,"-").toLowerCase()}function isKeyEvent(ze){return["keydown","keyup"].includes(ze)}function isListeningForASpecificKeyThatHasntBeenPressed(ze,Qr){let Kr=Qr.filter(Yr=>!["window","document","prevent","stop","once","capture"].includes(Yr));if(Kr.includes("debounce")){let Yr=Kr.indexOf("debounce");Kr.splice(Yr,isNumeric((Kr[Yr+1]||"invalid-wait").split("ms")[0])?2:1)}if(Kr.includes("throttle")){let Yr=Kr.indexOf("throttle");Kr.splice(Yr,isNumeric((Kr[Yr+1]||"invalid-wait").split("ms")[0])?2:1)}if(Kr.length===0||Kr.length===1&&keyToModifiers(ze.key).includes(Kr[0]))return!1;const Wr=["ctrl","shift","alt","meta","cmd","super"].filter(Yr=>Kr.includes(Yr));return Kr=Kr.filter(Yr=>!Wr.includes(Yr)),!(Wr.length>0&&Wr.filter(Gr=>((Gr==="cmd"||Gr==="super")&&(Gr="meta"),ze[` + "`" + `${Gr}Key` + "`" + `])).length===Wr.length&&keyToModifiers(ze.key).includes(Kr[0]))}function keyToModifiers(ze){if(!ze)return[];ze=kebabCase2(ze);let Qr={ctrl:"control",slash:"/",space:" ",spacebar:" ",cmd:"meta",esc:"escape",up:"arrow-up",down:"arrow-down",left:"arrow-left",right:"arrow-right",period:".",equal:"=",minus:"-",underscore:"_"};return Qr[ze]=ze,Object.keys(Qr).map(Kr=>{if(Qr[Kr]===ze)return Kr}).filter(Kr=>Kr)}directive("model",(ze,{modifiers:Qr,expression:Kr},{effect:Jr,cleanup:Wr})=>{let Yr=ze;Qr.includes("parent")&&(Yr=ze.parentNode);let Gr=evaluateLater(Yr,Kr),Zr;typeof Kr=="string"?Zr=evaluateLater(Yr,` + "`" + `${Kr} = __placeholder` + "`" + `):typeof Kr=="function"&&typeof Kr()=="string"?Zr=evaluateLater(Yr,` + "`" + `${Kr()} = __placeholder` + "`" + `):Zr=()=>{};let en=()=>{let an;return Gr(ln=>an=ln),isGetterSetter(an)?an.get():an},tn=an=>{let ln;Gr(pn=>ln=pn),isGetterSetter(ln)?ln.set(an):Zr(()=>{},{scope:{__placeholder:an}})};typeof Kr=="string"&&ze.type==="radio"&&mutateDom(()=>{ze.hasAttribute("name")||ze.setAttribute("name",Kr)});var rn=ze.tagName.toLowerCase()==="select"||["checkbox","radio"].includes(ze.type)||Qr.includes("lazy")?"change":"input";let nn=isCloning?()=>{}:on(ze,rn,Qr,an=>{tn(getInputValue(ze,Qr,an,en()))});if(Qr.includes("fill")&&([void 0,null,""].includes(en())||ze.type==="checkbox"&&Array.isArray(en()))&&ze.dispatchEvent(new Event(rn,{})),ze._x_removeModelListeners||(ze._x_removeModelListeners={}),ze._x_removeModelListeners.default=nn,Wr(()=>ze._x_removeModelListeners.default()),ze.form){let an=on(ze.form,"reset",[],ln=>{nextTick(()=>ze._x_model&&ze._x_model.set(ze.value))});Wr(()=>an())}ze._x_model={get(){return en()},set(an){tn(an)}},ze._x_forceModelUpdate=an=>{an===void 0&&typeof Kr=="string"&&Kr.match(/\./)&&(an=""),window.fromModel=!0,mutateDom(()=>bind(ze,"value",an)),delete window.fromModel},Jr(()=>{let an=en();Qr.includes("unintrusive")&&document.activeElement.isSameNode(ze)||ze._x_forceModelUpdate(an)})});function getInputValue(ze,Qr,Kr,Jr){return mutateDom(()=>{if(Kr instanceof CustomEvent&&Kr.detail!==void 0)return Kr.detail!==null&&Kr.detail!==void 0?Kr.detail:Kr.target.value;if(ze.type==="checkbox")if(Array.isArray(Jr)){let Wr=null;return Qr.includes("number")?Wr=safeParseNumber(Kr.target.value):Qr.includes("boolean")?Wr=safeParseBoolean(Kr.target.value):Wr=Kr.target.value,Kr.target.checked?Jr.concat([Wr]):Jr.filter(Yr=>!checkedAttrLooseCompare2(Yr,Wr))}else return Kr.target.checked;else return ze.tagName.toLowerCase()==="select"&&ze.multiple?Qr.includes("number")?Array.from(Kr.target.selectedOptions).map(Wr=>{let Yr=Wr.value||Wr.text;return safeParseNumber(Yr)}):Qr.includes("boolean")?Array.from(Kr.target.selectedOptions).map(Wr=>{let Yr=Wr.value||Wr.text;return safeParseBoolean(Yr)}):Array.from(Kr.target.selectedOptions).map(Wr=>Wr.value||Wr.text):Qr.includes("number")?safeParseNumber(Kr.target.value):Qr.includes("boolean")?safeParseBoolean(Kr.target.value):Qr.includes("trim")?Kr.target.value.trim():Kr.target.value})}function safeParseNumber(ze){let Qr=ze?parseFloat(ze):null;return isNumeric2(Qr)?Qr:ze}function checkedAttrLooseCompare2(ze,Qr){return ze==Qr}function isNumeric2(ze){return!Array.isArray(ze)&&!isNaN(ze)}function isGetterSetter(ze){return ze!==null&&typeof ze=="object"&&typeof ze.get=="function"&&typeof ze.set=="function"}directive("cloak",ze=>queueMicrotask(()=>mutateDom(()=>ze.removeAttribute(prefix("cloak")))));addInitSelector(()=>` + "`" + `[${prefix("init")}]` + "`" + `);directive("init",skipDuringClone((ze,{expression:Qr},{evaluate:Kr})=>typeof Qr=="string"?!!Qr.trim()&&Kr(Qr,{},!1):Kr(Qr,{},!1)));directive("text",(ze,{expression:Qr},{effect:Kr,evaluateLater:Jr})=>{let Wr=Jr(Qr);Kr(()=>{Wr(Yr=>{mutateDom(()=>{ze.textContent=Yr})})})});directive("html",(ze,{expression:Qr},{effect:Kr,evaluateLater:Jr})=>{let Wr=Jr(Qr);Kr(()=>{Wr(Yr=>{mutateDom(()=>{ze.innerHTML=Yr,ze._x_ignoreSelf=!0,initTree(ze),delete ze._x_ignoreSelf})})})});mapAttributes(startingWith(":",into(prefix("bind:"))));var handler2=(ze,{value:Qr,modifiers:Kr,expression:Jr,original:Wr},{effect:Yr})=>{if(!Qr){let Zr={};injectBindingProviders(Zr),evaluateLater(ze,Jr)(tn=>{applyBindingsObject(ze,tn,Wr)},{scope:Zr});return}if(Qr==="key")return storeKeyForXFor(ze,Jr);if(ze._x_inlineBindings&&ze._x_inlineBindings[Qr]&&ze._x_inlineBindings[Qr].extract)return;let Gr=evaluateLater(ze,Jr);Yr(()=>Gr(Zr=>{Zr===void 0&&typeof Jr=="string"&&Jr.match(/\./)&&(Zr=""),mutateDom(()=>bind(ze,Qr,Zr,Kr))}))};handler2.inline=(ze,{value:Qr,modifiers:Kr,expression:Jr})=>{Qr&&(ze._x_inlineBindings||(ze._x_inlineBindings={}),ze._x_inlineBindings[Qr]={expression:Jr,extract:!1})};directive("bind",handler2);function storeKeyForXFor(ze,Qr){ze._x_keyExpression=Qr}addRootSelector(()=>` + "`" + `[${prefix("data")}]` + "`" + `);directive("data",(ze,{expression:Qr},{cleanup:Kr})=>{if(shouldSkipRegisteringDataDuringClone(ze))return;Qr=Qr===""?"{}":Qr;let Jr={};injectMagics(Jr,ze);let Wr={};injectDataProviders(Wr,Jr);let Yr=evaluate(ze,Qr,{scope:Wr});(Yr===void 0||Yr===!0)&&(Yr={}),injectMagics(Yr,ze);let Gr=reactive(Yr);initInterceptors2(Gr);let Zr=addScopeToNode(ze,Gr);Gr.init&&evaluate(ze,Gr.init),Kr(()=>{Gr.destroy&&evaluate(ze,Gr.destroy),Zr()})});interceptClone((ze,Qr)=>{ze._x_dataStack&&(Qr._x_dataStack=ze._x_dataStack,Qr.setAttribute("data-has-alpine-state",!0))});function shouldSkipRegisteringDataDuringClone(ze){return isCloning?isCloningLegacy?!0:ze.hasAttribute("data-has-alpine-state"):!1}directive("show",(ze,{modifiers:Qr,expression:Kr},{effect:Jr})=>{let Wr=evaluateLater(ze,Kr);ze._x_doHide||(ze._x_doHide=()=>{mutateDom(()=>{ze.style.setProperty("display","none",Qr.includes("important")?"important":void 0)})}),ze._x_doShow||(ze._x_doShow=()=>{mutateDom(()=>{ze.style.length===1&&ze.style.display==="none"?ze.removeAttribute("style"):ze.style.removeProperty("display")})});let Yr=()=>{ze._x_doHide(),ze._x_isShown=!1},Gr=()=>{ze._x_doShow(),ze._x_isShown=!0},Zr=()=>setTimeout(Gr),en=once(nn=>nn?Gr():Yr(),nn=>{typeof ze._x_toggleAndCascadeWithTransitions=="function"?ze._x_toggleAndCascadeWithTransitions(ze,nn,Gr,Yr):nn?Zr():Yr()}),tn,rn=!0;Jr(()=>Wr(nn=>{!rn&&nn===tn||(Qr.includes("immediate")&&(nn?Zr():Yr()),en(nn),tn=nn,rn=!1)}))});directive("for",(ze,{expression:Qr},{effect:Kr,cleanup:Jr})=>{let Wr=parseForExpression(Qr),Yr=evaluateLater(ze,Wr.items),Gr=evaluateLater(ze,ze._x_keyExpression||"index");ze._x_prevKeys=[],ze._x_lookup={},Kr(()=>loop(ze,Wr,Yr,Gr)),Jr(()=>{Object.values(ze._x_lookup).forEach(Zr=>Zr.remove()),delete ze._x_prevKeys,delete ze._x_lookup})});function loop(ze,Qr,Kr,Jr){let Wr=Gr=>typeof Gr=="object"&&!Array.isArray(Gr),Yr=ze;Kr(Gr=>{isNumeric3(Gr)&&Gr>=0&&(Gr=Array.from(Array(Gr).keys(),sn=>sn+1)),Gr===void 0&&(Gr=[]);let Zr=ze._x_lookup,en=ze._x_prevKeys,tn=[],rn=[];if(Wr(Gr))Gr=Object.entries(Gr).map(([sn,un])=>{let cn=getIterationScopeVariables(Qr,un,sn,Gr);Jr(fn=>{rn.includes(fn)&&warn("Duplicate key on x-for",ze),rn.push(fn)},{scope:{index:sn,...cn}}),tn.push(cn)});else for(let sn=0;sn<Gr.length;sn++){let un=getIterationScopeVariables(Qr,Gr[sn],sn,Gr);Jr(cn=>{rn.includes(cn)&&warn("Duplicate key on x-for",ze),rn.push(cn)},{scope:{index:sn,...un}}),tn.push(un)}let nn=[],an=[],ln=[],pn=[];for(let sn=0;sn<en.length;sn++){let un=en[sn];rn.indexOf(un)===-1&&ln.push(un)}en=en.filter(sn=>!ln.includes(sn));let _n="template";for(let sn=0;sn<rn.length;sn++){let un=rn[sn],cn=en.indexOf(un);if(cn===-1)en.splice(sn,0,un),nn.push([_n,sn]);else if(cn!==sn){let fn=en.splice(sn,1)[0],dn=en.splice(cn-1,1)[0];en.splice(sn,0,dn),en.splice(cn,0,fn),an.push([fn,dn])}else pn.push(un);_n=un}for(let sn=0;sn<ln.length;sn++){let un=ln[sn];Zr[un]._x_effects&&Zr[un]._x_effects.forEach(dequeueJob),Zr[un].remove(),Zr[un]=null,delete Zr[un]}for(let sn=0;sn<an.length;sn++){let[un,cn]=an[sn],fn=Zr[un],dn=Zr[cn],vn=document.createElement("div");mutateDom(()=>{dn||warn('x-for ":key" is undefined or invalid',Yr,cn,Zr),dn.after(vn),fn.after(dn),dn._x_currentIfEl&&dn.after(dn._x_currentIfEl),vn.before(fn),fn._x_currentIfEl&&fn.after(fn._x_currentIfEl),vn.remove()}),dn._x_refreshXForScope(tn[rn.indexOf(cn)])}for(let sn=0;sn<nn.length;sn++){let[un,cn]=nn[sn],fn=un==="template"?Yr:Zr[un];fn._x_currentIfEl&&(fn=fn._x_currentIfEl);let dn=tn[cn],vn=rn[cn],On=document.importNode(Yr.content,!0).firstElementChild,mn=reactive(dn);addScopeToNode(On,mn,Yr),On._x_refreshXForScope=yn=>{Object.entries(yn).forEach(([Rn,bn])=>{mn[Rn]=bn})},mutateDom(()=>{fn.after(On),skipDuringClone(()=>initTree(On))()}),typeof vn=="object"&&warn("x-for key cannot be an object, it must be a string or an integer",Yr),Zr[vn]=On}for(let sn=0;sn<pn.length;sn++)Zr[pn[sn]]._x_refreshXForScope(tn[rn.indexOf(pn[sn])]);Yr._x_prevKeys=rn})}function parseForExpression(ze){let Qr=/,([^,\}\]]*)(?:,([^,\}\]]*))?$/,Kr=/^\s*\(|\)\s*$/g,Jr=/([\s\S]*?)\s+(?:in|of)\s+([\s\S]*)/,Wr=ze.match(Jr);if(!Wr)return;let Yr={};Yr.items=Wr[2].trim();let Gr=Wr[1].replace(Kr,"").trim(),Zr=Gr.match(Qr);return Zr?(Yr.item=Gr.replace(Qr,"").trim(),Yr.index=Zr[1].trim(),Zr[2]&&(Yr.collection=Zr[2].trim())):Yr.item=Gr,Yr}function getIterationScopeVariables(ze,Qr,Kr,Jr){let Wr={};return/^\[.*\]$/.test(ze.item)&&Array.isArray(Qr)?ze.item.replace("[","").replace("]","").split(",").map(Gr=>Gr.trim()).forEach((Gr,Zr)=>{Wr[Gr]=Qr[Zr]}):/^\{.*\}$/.test(ze.item)&&!Array.isArray(Qr)&&typeof Qr=="object"?ze.item.replace("{","").replace("}","").split(",").map(Gr=>Gr.trim()).forEach(Gr=>{Wr[Gr]=Qr[Gr]}):Wr[ze.item]=Qr,ze.index&&(Wr[ze.index]=Kr),ze.collection&&(Wr[ze.collection]=Jr),Wr}function isNumeric3(ze){return!Array.isArray(ze)&&!isNaN(ze)}function handler3(){}handler3.inline=(ze,{expression:Qr},{cleanup:Kr})=>{let Jr=closestRoot(ze);Jr._x_refs||(Jr._x_refs={}),Jr._x_refs[Qr]=ze,Kr(()=>delete Jr._x_refs[Qr])};directive("ref",handler3);directive("if",(ze,{expression:Qr},{effect:Kr,cleanup:Jr})=>{ze.tagName.toLowerCase()!=="template"&&warn("x-if can only be used on a <template> tag",ze);let Wr=evaluateLater(ze,Qr),Yr=()=>{if(ze._x_currentIfEl)return ze._x_currentIfEl;let Zr=ze.content.cloneNode(!0).firstElementChild;return addScopeToNode(Zr,{},ze),mutateDom(()=>{ze.after(Zr),skipDuringClone(()=>initTree(Zr))()}),ze._x_currentIfEl=Zr,ze._x_undoIf=()=>{walk(Zr,en=>{en._x_effects&&en._x_effects.forEach(dequeueJob)}),Zr.remove(),delete ze._x_currentIfEl},Zr},Gr=()=>{ze._x_undoIf&&(ze._x_undoIf(),delete ze._x_undoIf)};Kr(()=>Wr(Zr=>{Zr?Yr():Gr()})),Jr(()=>ze._x_undoIf&&ze._x_undoIf())});directive("id",(ze,{expression:Qr},{evaluate:Kr})=>{Kr(Qr).forEach(Wr=>setIdRoot(ze,Wr))});interceptClone((ze,Qr)=>{ze._x_ids&&(Qr._x_ids=ze._x_ids)});mapAttributes(startingWith("@",into(prefix("on:"))));directive("on",skipDuringClone((ze,{value:Qr,modifiers:Kr,expression:Jr},{cleanup:Wr})=>{let Yr=Jr?evaluateLater(ze,Jr):()=>{};ze.tagName.toLowerCase()==="template"&&(ze._x_forwardEvents||(ze._x_forwardEvents=[]),ze._x_forwardEvents.includes(Qr)||ze._x_forwardEvents.push(Qr));let Gr=on(ze,Qr,Kr,Zr=>{Yr(()=>{},{scope:{$event:Zr},params:[Zr]})});Wr(()=>Gr())}));warnMissingPluginDirective("Collapse","collapse","collapse");warnMissingPluginDirective("Intersect","intersect","intersect");warnMissingPluginDirective("Focus","trap","focus");warnMissingPluginDirective("Mask","mask","mask");function warnMissingPluginDirective(ze,Qr,Kr){directive(Qr,Jr=>warn(` + "`" + `You can't use [x-${Qr}] without first installing the "${ze}" plugin here: https://alpinejs.dev/plugins/${Kr}` + "`" + `,Jr))}alpine_default.setEvaluator(normalEvaluator);alpine_default.setReactivityEngine({reactive:reactive2,effect:effect2,release:stop,raw:toRaw});var src_default=alpine_default,module_default=src_default,commonjsGlobal=typeof globalThis<"u"?globalThis:typeof window<"u"?window:typeof global<"u"?global:typeof self<"u"?self:{};function getDefaultExportFromCjs(ze){return ze&&ze.__esModule&&Object.prototype.hasOwnProperty.call(ze,"default")?ze.default:ze}var htmx_min={exports:{}};(function(module){(function(ze,Qr){module.exports?module.exports=Qr():ze.htmx=ze.htmx||Qr()})(typeof self<"u"?self:commonjsGlobal,function(){return function(){var Q={onLoad:B,process:zt,on:de,off:ge,trigger:ce,ajax:Nr,find:C,findAll:f,closest:v,values:function(ze,Qr){var Kr=dr(ze,Qr||"post");return Kr.values},remove:_,addClass:z,removeClass:n,toggleClass:$,takeClass:W,defineExtension:Ur,removeExtension:Fr,logAll:V,logNone:j,logger:null,config:{historyEnabled:!0,historyCacheSize:10,refreshOnHistoryMiss:!1,defaultSwapStyle:"innerHTML",defaultSwapDelay:0,defaultSettleDelay:20,includeIndicatorStyles:!0,indicatorClass:"htmx-indicator",requestClass:"htmx-request",addedClass:"htmx-added",settlingClass:"htmx-settling",swappingClass:"htmx-swapping",allowEval:!0,allowScriptTags:!0,inlineScriptNonce:"",attributesToSettle:["class","style","width","height"],withCredentials:!1,timeout:0,wsReconnectDelay:"full-jitter",wsBinaryType:"blob",disableSelector:"[hx-disable], [data-hx-disable]",useTemplateFragments:!1,scrollBehavior:"smooth",defaultFocusScroll:!1,getCacheBusterParam:!1,globalViewTransitions:!1,methodsThatUseUrlParams:["get"],selfRequestsOnly:!1,ignoreTitle:!1,scrollIntoViewOnBoost:!0,triggerSpecsCache:null},parseInterval:d,_:t,createEventSource:function(ze){return new EventSource(ze,{withCredentials:!0})},createWebSocket:function(ze){var Qr=new WebSocket(ze,[]);return Qr.binaryType=Q.config.wsBinaryType,Qr},version:"1.9.11"},r={addTriggerHandler:Lt,bodyContains:se,canAccessLocalStorage:U,findThisElement:xe,filterValues:yr,hasAttribute:o,getAttributeValue:te,getClosestAttributeValue:ne,getClosestMatch:c,getExpressionVars:Hr,getHeaders:xr,getInputValues:dr,getInternalData:ae,getSwapSpecification:wr,getTriggerSpecs:it,getTarget:ye,makeFragment:l,mergeObjects:le,makeSettleInfo:T,oobSwap:Ee,querySelectorExt:ue,selectAndSwap:je,settleImmediately:nr,shouldCancel:ut,triggerEvent:ce,triggerErrorEvent:fe,withExtensions:R},w=["get","post","put","delete","patch"],i=w.map(function(ze){return"[hx-"+ze+"], [data-hx-"+ze+"]"}).join(", "),S=e("head"),q=e("title"),H=e("svg",!0);function e(ze,Qr=!1){return new RegExp(` + "`" + `<${ze}(\\s[^>]*>|>)([\\s\\S]*?)<\\/${ze}>` + "`" + `,Qr?"gim":"im")}function d(ze){if(ze==null)return;let Qr=NaN;return ze.slice(-2)=="ms"?Qr=parseFloat(ze.slice(0,-2)):ze.slice(-1)=="s"?Qr=parseFloat(ze.slice(0,-1))*1e3:ze.slice(-1)=="m"?Qr=parseFloat(ze.slice(0,-1))*1e3*60:Qr=parseFloat(ze),isNaN(Qr)?void 0:Qr}function ee(ze,Qr){return ze.getAttribute&&ze.getAttribute(Qr)}function o(ze,Qr){return ze.hasAttribute&&(ze.hasAttribute(Qr)||ze.hasAttribute("data-"+Qr))}function te(ze,Qr){return ee(ze,Qr)||ee(ze,"data-"+Qr)}function u(ze){return ze.parentElement}function re(){return document}function c(ze,Qr){for(;ze&&!Qr(ze);)ze=u(ze);return ze||null}function L(ze,Qr,Kr){var Jr=te(Qr,Kr),Wr=te(Qr,"hx-disinherit");return ze!==Qr&&Wr&&(Wr==="*"||Wr.split(" ").indexOf(Kr)>=0)?"unset":Jr}function ne(ze,Qr){var Kr=null;if(c(ze,function(Jr){return Kr=L(ze,Jr,Qr)}),Kr!=="unset")return Kr}function h(ze,Qr){var Kr=ze.matches||ze.matchesSelector||ze.msMatchesSelector||ze.mozMatchesSelector||ze.webkitMatchesSelector||ze.oMatchesSelector;return Kr&&Kr.call(ze,Qr)}function A(ze){var Qr=/<([a-z][^\/\0>\x20\t\r\n\f]*)/i,Kr=Qr.exec(ze);return Kr?Kr[1].toLowerCase():""}function s(ze,Qr){for(var Kr=new DOMParser,Jr=Kr.parseFromString(ze,"text/html"),Wr=Jr.body;Qr>0;)Qr--,Wr=Wr.firstChild;return Wr==null&&(Wr=re().createDocumentFragment()),Wr}function N(ze){return/<body/.test(ze)}function l(ze){var Qr=!N(ze),Kr=A(ze),Jr=ze;if(Kr==="head"&&(Jr=Jr.replace(S,"")),Q.config.useTemplateFragments&&Qr){var Wr=s("<body><template>"+Jr+"</template></body>",0),Yr=Wr.querySelector("template").content;return Q.config.allowScriptTags?oe(Yr.querySelectorAll("script"),function(Gr){Q.config.inlineScriptNonce&&(Gr.nonce=Q.config.inlineScriptNonce),Gr.htmxExecuted=navigator.userAgent.indexOf("Firefox")===-1}):oe(Yr.querySelectorAll("script"),function(Gr){_(Gr)}),Yr}switch(Kr){case"thead":case"tbody":case"tfoot":case"colgroup":case"caption":return s("<table>"+Jr+"</table>",1);case"col":return s("<table><colgroup>"+Jr+"</colgroup></table>",2);case"tr":return s("<table><tbody>"+Jr+"</tbody></table>",2);case"td":case"th":return s("<table><tbody><tr>"+Jr+"</tr></tbody></table>",3);case"script":case"style":return s("<div>"+Jr+"</div>",1);default:return s(Jr,0)}}function ie(ze){ze&&ze()}function I(ze,Qr){return Object.prototype.toString.call(ze)==="[object "+Qr+"]"}function k(ze){return I(ze,"Function")}function P(ze){return I(ze,"Object")}function ae(ze){var Qr="htmx-internal-data",Kr=ze[Qr];return Kr||(Kr=ze[Qr]={}),Kr}function M(ze){var Qr=[];if(ze)for(var Kr=0;Kr<ze.length;Kr++)Qr.push(ze[Kr]);return Qr}function oe(ze,Qr){if(ze)for(var Kr=0;Kr<ze.length;Kr++)Qr(ze[Kr])}function X(ze){var Qr=ze.getBoundingClientRect(),Kr=Qr.top,Jr=Qr.bottom;return Kr<window.innerHeight&&Jr>=0}function se(ze){return ze.getRootNode&&ze.getRootNode()instanceof window.ShadowRoot?re().body.contains(ze.getRootNode().host):re().body.contains(ze)}function D(ze){return ze.trim().split(/\s+/)}function le(ze,Qr){for(var Kr in Qr)Qr.hasOwnProperty(Kr)&&(ze[Kr]=Qr[Kr]);return ze}function E(ze){try{return JSON.parse(ze)}catch(Qr){return b(Qr),null}}function U(){var ze="htmx:localStorageTest";try{return localStorage.setItem(ze,ze),localStorage.removeItem(ze),!0}catch{return!1}}function F(ze){try{var Qr=new URL(ze);return Qr&&(ze=Qr.pathname+Qr.search),/^\/$/.test(ze)||(ze=ze.replace(/\/+$/,"")),ze}catch{return ze}}function t(e){return Tr(re().body,function(){return eval(e)})}function B(ze){var Qr=Q.on("htmx:load",function(Kr){ze(Kr.detail.elt)});return Qr}function V(){Q.logger=function(ze,Qr,Kr){console&&console.log(Qr,ze,Kr)}}function j(){Q.logger=null}function C(ze,Qr){return Qr?ze.querySelector(Qr):C(re(),ze)}function f(ze,Qr){return Qr?ze.querySelectorAll(Qr):f(re(),ze)}function _(ze,Qr){ze=p(ze),Qr?setTimeout(function(){_(ze),ze=null},Qr):ze.parentElement.removeChild(ze)}function z(ze,Qr,Kr){ze=p(ze),Kr?setTimeout(function(){z(ze,Qr),ze=null},Kr):ze.classList&&ze.classList.add(Qr)}function n(ze,Qr,Kr){ze=p(ze),Kr?setTimeout(function(){n(ze,Qr),ze=null},Kr):ze.classList&&(ze.classList.remove(Qr),ze.classList.length===0&&ze.removeAttribute("class"))}function $(ze,Qr){ze=p(ze),ze.classList.toggle(Qr)}function W(ze,Qr){ze=p(ze),oe(ze.parentElement.children,function(Kr){n(Kr,Qr)}),z(ze,Qr)}function v(ze,Qr){if(ze=p(ze),ze.closest)return ze.closest(Qr);do if(ze==null||h(ze,Qr))return ze;while(ze=ze&&u(ze));return null}function g(ze,Qr){return ze.substring(0,Qr.length)===Qr}function G(ze,Qr){return ze.substring(ze.length-Qr.length)===Qr}function J(ze){var Qr=ze.trim();return g(Qr,"<")&&G(Qr,"/>")?Qr.substring(1,Qr.length-2):Qr}function Z(ze,Qr){return Qr.indexOf("closest ")===0?[v(ze,J(Qr.substr(8)))]:Qr.indexOf("find ")===0?[C(ze,J(Qr.substr(5)))]:Qr==="next"?[ze.nextElementSibling]:Qr.indexOf("next ")===0?[K(ze,J(Qr.substr(5)))]:Qr==="previous"?[ze.previousElementSibling]:Qr.indexOf("previous ")===0?[Y(ze,J(Qr.substr(9)))]:Qr==="document"?[document]:Qr==="window"?[window]:Qr==="body"?[document.body]:re().querySelectorAll(J(Qr))}var K=function(ze,Qr){for(var Kr=re().querySelectorAll(Qr),Jr=0;Jr<Kr.length;Jr++){var Wr=Kr[Jr];if(Wr.compareDocumentPosition(ze)===Node.DOCUMENT_POSITION_PRECEDING)return Wr}},Y=function(ze,Qr){for(var Kr=re().querySelectorAll(Qr),Jr=Kr.length-1;Jr>=0;Jr--){var Wr=Kr[Jr];if(Wr.compareDocumentPosition(ze)===Node.DOCUMENT_POSITION_FOLLOWING)return Wr}};function ue(ze,Qr){return Qr?Z(ze,Qr)[0]:Z(re().body,ze)[0]}function p(ze){return I(ze,"String")?C(ze):ze}function ve(ze,Qr,Kr){return k(Qr)?{target:re().body,event:ze,listener:Qr}:{target:p(ze),event:Qr,listener:Kr}}function de(ze,Qr,Kr){jr(function(){var Wr=ve(ze,Qr,Kr);Wr.target.addEventListener(Wr.event,Wr.listener)});var Jr=k(Qr);return Jr?Qr:Kr}function ge(ze,Qr,Kr){return jr(function(){var Jr=ve(ze,Qr,Kr);Jr.target.removeEventListener(Jr.event,Jr.listener)}),k(Qr)?Qr:Kr}var pe=re().createElement("output");function me(ze,Qr){var Kr=ne(ze,Qr);if(Kr){if(Kr==="this")return[xe(ze,Qr)];var Jr=Z(ze,Kr);return Jr.length===0?(b('The selector "'+Kr+'" on '+Qr+" returned no matches!"),[pe]):Jr}}function xe(ze,Qr){return c(ze,function(Kr){return te(Kr,Qr)!=null})}function ye(ze){var Qr=ne(ze,"hx-target");if(Qr)return Qr==="this"?xe(ze,"hx-target"):ue(ze,Qr);var Kr=ae(ze);return Kr.boosted?re().body:ze}function be(ze){for(var Qr=Q.config.attributesToSettle,Kr=0;Kr<Qr.length;Kr++)if(ze===Qr[Kr])return!0;return!1}function we(ze,Qr){oe(ze.attributes,function(Kr){!Qr.hasAttribute(Kr.name)&&be(Kr.name)&&ze.removeAttribute(Kr.name)}),oe(Qr.attributes,function(Kr){be(Kr.name)&&ze.setAttribute(Kr.name,Kr.value)})}function Se(ze,Qr){for(var Kr=Br(Qr),Jr=0;Jr<Kr.length;Jr++){var Wr=Kr[Jr];try{if(Wr.isInlineSwap(ze))return!0}catch(Yr){b(Yr)}}return ze==="outerHTML"}function Ee(ze,Qr,Kr){var Jr="#"+ee(Qr,"id"),Wr="outerHTML";ze==="true"||(ze.indexOf(":")>0?(Wr=ze.substr(0,ze.indexOf(":")),Jr=ze.substr(ze.indexOf(":")+1,ze.length)):Wr=ze);var Yr=re().querySelectorAll(Jr);return Yr?(oe(Yr,function(Gr){var Zr,en=Qr.cloneNode(!0);Zr=re().createDocumentFragment(),Zr.appendChild(en),Se(Wr,Gr)||(Zr=en);var tn={shouldSwap:!0,target:Gr,fragment:Zr};ce(Gr,"htmx:oobBeforeSwap",tn)&&(Gr=tn.target,tn.shouldSwap&&Be(Wr,Gr,Gr,Zr,Kr),oe(Kr.elts,function(rn){ce(rn,"htmx:oobAfterSwap",tn)}))}),Qr.parentNode.removeChild(Qr)):(Qr.parentNode.removeChild(Qr),fe(re().body,"htmx:oobErrorNoTarget",{content:Qr})),ze}function Ce(ze,Qr,Kr){var Jr=ne(ze,"hx-select-oob");if(Jr)for(var Wr=Jr.split(","),Yr=0;Yr<Wr.length;Yr++){var Gr=Wr[Yr].split(":",2),Zr=Gr[0].trim();Zr.indexOf("#")===0&&(Zr=Zr.substring(1));var en=Gr[1]||"true",tn=Qr.querySelector("#"+Zr);tn&&Ee(en,tn,Kr)}oe(f(Qr,"[hx-swap-oob], [data-hx-swap-oob]"),function(rn){var nn=te(rn,"hx-swap-oob");nn!=null&&Ee(nn,rn,Kr)})}function Re(ze){oe(f(ze,"[hx-preserve], [data-hx-preserve]"),function(Qr){var Kr=te(Qr,"id"),Jr=re().getElementById(Kr);Jr!=null&&Qr.parentNode.replaceChild(Jr,Qr)})}function Te(ze,Qr,Kr){oe(Qr.querySelectorAll("[id]"),function(Jr){var Wr=ee(Jr,"id");if(Wr&&Wr.length>0){var Yr=Wr.replace("'","\\'"),Gr=Jr.tagName.replace(":","\\:"),Zr=ze.querySelector(Gr+"[id='"+Yr+"']");if(Zr&&Zr!==ze){var en=Jr.cloneNode();we(Jr,Zr),Kr.tasks.push(function(){we(Jr,en)})}}})}function Oe(ze){return function(){n(ze,Q.config.addedClass),zt(ze),Nt(ze),qe(ze),ce(ze,"htmx:load")}}function qe(ze){var Qr="[autofocus]",Kr=h(ze,Qr)?ze:ze.querySelector(Qr);Kr!=null&&Kr.focus()}function a(ze,Qr,Kr,Jr){for(Te(ze,Kr,Jr);Kr.childNodes.length>0;){var Wr=Kr.firstChild;z(Wr,Q.config.addedClass),ze.insertBefore(Wr,Qr),Wr.nodeType!==Node.TEXT_NODE&&Wr.nodeType!==Node.COMMENT_NODE&&Jr.tasks.push(Oe(Wr))}}function He(ze,Qr){for(var Kr=0;Kr<ze.length;)Qr=(Qr<<5)-Qr+ze.charCodeAt(Kr++)|0;return Qr}function Le(ze){var Qr=0;if(ze.attributes)for(var Kr=0;Kr<ze.attributes.length;Kr++){var Jr=ze.attributes[Kr];Jr.value&&(Qr=He(Jr.name,Qr),Qr=He(Jr.value,Qr))}return Qr}function Ae(ze){var Qr=ae(ze);if(Qr.onHandlers){for(var Kr=0;Kr<Qr.onHandlers.length;Kr++){const Jr=Qr.onHandlers[Kr];ze.removeEventListener(Jr.event,Jr.listener)}delete Qr.onHandlers}}function Ne(ze){var Qr=ae(ze);Qr.timeout&&clearTimeout(Qr.timeout),Qr.webSocket&&Qr.webSocket.close(),Qr.sseEventSource&&Qr.sseEventSource.close(),Qr.listenerInfos&&oe(Qr.listenerInfos,function(Kr){Kr.on&&Kr.on.removeEventListener(Kr.trigger,Kr.listener)}),Ae(ze),oe(Object.keys(Qr),function(Kr){delete Qr[Kr]})}function m(ze){ce(ze,"htmx:beforeCleanupElement"),Ne(ze),ze.children&&oe(ze.children,function(Qr){m(Qr)})}function Ie(ze,Qr,Kr){if(ze.tagName==="BODY")return Ue(ze,Qr,Kr);var Jr,Wr=ze.previousSibling;for(a(u(ze),ze,Qr,Kr),Wr==null?Jr=u(ze).firstChild:Jr=Wr.nextSibling,Kr.elts=Kr.elts.filter(function(Yr){return Yr!=ze});Jr&&Jr!==ze;)Jr.nodeType===Node.ELEMENT_NODE&&Kr.elts.push(Jr),Jr=Jr.nextElementSibling;m(ze),u(ze).removeChild(ze)}function ke(ze,Qr,Kr){return a(ze,ze.firstChild,Qr,Kr)}function Pe(ze,Qr,Kr){return a(u(ze),ze,Qr,Kr)}function Me(ze,Qr,Kr){return a(ze,null,Qr,Kr)}function Xe(ze,Qr,Kr){return a(u(ze),ze.nextSibling,Qr,Kr)}function De(ze,Qr,Kr){return m(ze),u(ze).removeChild(ze)}function Ue(ze,Qr,Kr){var Jr=ze.firstChild;if(a(ze,Jr,Qr,Kr),Jr){for(;Jr.nextSibling;)m(Jr.nextSibling),ze.removeChild(Jr.nextSibling);m(Jr),ze.removeChild(Jr)}}function Fe(ze,Qr,Kr){var Jr=Kr||ne(ze,"hx-select");if(Jr){var Wr=re().createDocumentFragment();oe(Qr.querySelectorAll(Jr),function(Yr){Wr.appendChild(Yr)}),Qr=Wr}return Qr}function Be(ze,Qr,Kr,Jr,Wr){switch(ze){case"none":return;case"outerHTML":Ie(Kr,Jr,Wr);return;case"afterbegin":ke(Kr,Jr,Wr);return;case"beforebegin":Pe(Kr,Jr,Wr);return;case"beforeend":Me(Kr,Jr,Wr);return;case"afterend":Xe(Kr,Jr,Wr);return;case"delete":De(Kr);return;default:for(var Yr=Br(Qr),Gr=0;Gr<Yr.length;Gr++){var Zr=Yr[Gr];try{var en=Zr.handleSwap(ze,Kr,Jr,Wr);if(en){if(typeof en.length<"u")for(var tn=0;tn<en.length;tn++){var rn=en[tn];rn.nodeType!==Node.TEXT_NODE&&rn.nodeType!==Node.COMMENT_NODE&&Wr.tasks.push(Oe(rn))}return}}catch(nn){b(nn)}}ze==="innerHTML"?Ue(Kr,Jr,Wr):Be(Q.config.defaultSwapStyle,Qr,Kr,Jr,Wr)}}function Ve(ze){if(ze.indexOf("<title")>-1){var Qr=ze.replace(H,""),Kr=Qr.match(q);if(Kr)return Kr[2]}}function je(ze,Qr,Kr,Jr,Wr,Yr){Wr.title=Ve(Jr);var Gr=l(Jr);if(Gr)return Ce(Kr,Gr,Wr),Gr=Fe(Kr,Gr,Yr),Re(Gr),Be(ze,Kr,Qr,Gr,Wr)}function _e(ze,Qr,Kr){var Jr=ze.getResponseHeader(Qr);if(Jr.indexOf("{")===0){var Wr=E(Jr);for(var Yr in Wr)if(Wr.hasOwnProperty(Yr)){var Gr=Wr[Yr];P(Gr)||(Gr={value:Gr}),ce(Kr,Yr,Gr)}}else for(var Zr=Jr.split(","),en=0;en<Zr.length;en++)ce(Kr,Zr[en].trim(),[])}var x=/[\s,]/,$e=/[_$a-zA-Z]/,We=/[_$a-zA-Z0-9]/,Ge=['"',"'","/"],Je=/[^\s]/,Ze=/[{(]/,Ke=/[})]/;function Ye(ze){for(var Qr=[],Kr=0;Kr<ze.length;){if($e.exec(ze.charAt(Kr))){for(var Jr=Kr;We.exec(ze.charAt(Kr+1));)Kr++;Qr.push(ze.substr(Jr,Kr-Jr+1))}else if(Ge.indexOf(ze.charAt(Kr))!==-1){var Wr=ze.charAt(Kr),Jr=Kr;for(Kr++;Kr<ze.length&&ze.charAt(Kr)!==Wr;)ze.charAt(Kr)==="\\"&&Kr++,Kr++;Qr.push(ze.substr(Jr,Kr-Jr+1))}else{var Yr=ze.charAt(Kr);Qr.push(Yr)}Kr++}return Qr}function Qe(ze,Qr,Kr){return $e.exec(ze.charAt(0))&&ze!=="true"&&ze!=="false"&&ze!=="this"&&ze!==Kr&&Qr!=="."}function et(ze,Qr,Kr){if(Qr[0]==="["){Qr.shift();for(var Jr=1,Wr=" return (function("+Kr+"){ return (",Yr=null;Qr.length>0;){var Gr=Qr[0];if(Gr==="]"){if(Jr--,Jr===0){Yr===null&&(Wr=Wr+"true"),Qr.shift(),Wr+=")})";try{var Zr=Tr(ze,function(){return Function(Wr)()},function(){return!0});return Zr.source=Wr,Zr}catch(en){return fe(re().body,"htmx:syntax:error",{error:en,source:Wr}),null}}}else Gr==="["&&Jr++;Qe(Gr,Yr,Kr)?Wr+="(("+Kr+"."+Gr+") ? ("+Kr+"."+Gr+") : (window."+Gr+"))":Wr=Wr+Gr,Yr=Qr.shift()}}}function y(ze,Qr){for(var Kr="";ze.length>0&&!Qr.test(ze[0]);)Kr+=ze.shift();return Kr}function tt(ze){var Qr;return ze.length>0&&Ze.test(ze[0])?(ze.shift(),Qr=y(ze,Ke).trim(),ze.shift()):Qr=y(ze,x),Qr}var rt="input, textarea, select";function nt(ze,Qr,Kr){var Jr=[],Wr=Ye(Qr);do{y(Wr,Je);var Yr=Wr.length,Gr=y(Wr,/[,\[\s]/);if(Gr!=="")if(Gr==="every"){var Zr={trigger:"every"};y(Wr,Je),Zr.pollInterval=d(y(Wr,/[,\[\s]/)),y(Wr,Je);var en=et(ze,Wr,"event");en&&(Zr.eventFilter=en),Jr.push(Zr)}else if(Gr.indexOf("sse:")===0)Jr.push({trigger:"sse",sseEvent:Gr.substr(4)});else{var tn={trigger:Gr},en=et(ze,Wr,"event");for(en&&(tn.eventFilter=en);Wr.length>0&&Wr[0]!==",";){y(Wr,Je);var rn=Wr.shift();if(rn==="changed")tn.changed=!0;else if(rn==="once")tn.once=!0;else if(rn==="consume")tn.consume=!0;else if(rn==="delay"&&Wr[0]===":")Wr.shift(),tn.delay=d(y(Wr,x));else if(rn==="from"&&Wr[0]===":"){if(Wr.shift(),Ze.test(Wr[0]))var nn=tt(Wr);else{var nn=y(Wr,x);if(nn==="closest"||nn==="find"||nn==="next"||nn==="previous"){Wr.shift();var an=tt(Wr);an.length>0&&(nn+=" "+an)}}tn.from=nn}else rn==="target"&&Wr[0]===":"?(Wr.shift(),tn.target=tt(Wr)):rn==="throttle"&&Wr[0]===":"?(Wr.shift(),tn.throttle=d(y(Wr,x))):rn==="queue"&&Wr[0]===":"?(Wr.shift(),tn.queue=y(Wr,x)):rn==="root"&&Wr[0]===":"?(Wr.shift(),tn[rn]=tt(Wr)):rn==="threshold"&&Wr[0]===":"?(Wr.shift(),tn[rn]=y(Wr,x)):fe(ze,"htmx:syntax:error",{token:Wr.shift()})}Jr.push(tn)}Wr.length===Yr&&fe(ze,"htmx:syntax:error",{token:Wr.shift()}),y(Wr,Je)}while(Wr[0]===","&&Wr.shift());return Kr&&(Kr[Qr]=Jr),Jr}function it(ze){var Qr=te(ze,"hx-trigger"),Kr=[];if(Qr){var Jr=Q.config.triggerSpecsCache;Kr=Jr&&Jr[Qr]||nt(ze,Qr,Jr)}return Kr.length>0?Kr:h(ze,"form")?[{trigger:"submit"}]:h(ze,'input[type="button"], input[type="submit"]')?[{trigger:"click"}]:h(ze,rt)?[{trigger:"change"}]:[{trigger:"click"}]}function at(ze){ae(ze).cancelled=!0}function ot(ze,Qr,Kr){var Jr=ae(ze);Jr.timeout=setTimeout(function(){se(ze)&&Jr.cancelled!==!0&&(ct(Kr,ze,Wt("hx:poll:trigger",{triggerSpec:Kr,target:ze}))||Qr(ze),ot(ze,Qr,Kr))},Kr.pollInterval)}function st(ze){return location.hostname===ze.hostname&&ee(ze,"href")&&ee(ze,"href").indexOf("#")!==0}function lt(ze,Qr,Kr){if(ze.tagName==="A"&&st(ze)&&(ze.target===""||ze.target==="_self")||ze.tagName==="FORM"){Qr.boosted=!0;var Jr,Wr;if(ze.tagName==="A")Jr="get",Wr=ee(ze,"href");else{var Yr=ee(ze,"method");Jr=Yr?Yr.toLowerCase():"get",Wr=ee(ze,"action")}Kr.forEach(function(Gr){ht(ze,function(Zr,en){if(v(Zr,Q.config.disableSelector)){m(Zr);return}he(Jr,Wr,Zr,en)},Qr,Gr,!0)})}}function ut(ze,Qr){return!!((ze.type==="submit"||ze.type==="click")&&(Qr.tagName==="FORM"||h(Qr,'input[type="submit"], button')&&v(Qr,"form")!==null||Qr.tagName==="A"&&Qr.href&&(Qr.getAttribute("href")==="#"||Qr.getAttribute("href").indexOf("#")!==0)))}function ft(ze,Qr){return ae(ze).boosted&&ze.tagName==="A"&&Qr.type==="click"&&(Qr.ctrlKey||Qr.metaKey)}function ct(ze,Qr,Kr){var Jr=ze.eventFilter;if(Jr)try{return Jr.call(Qr,Kr)!==!0}catch(Wr){return fe(re().body,"htmx:eventFilter:error",{error:Wr,source:Jr.source}),!0}return!1}function ht(ze,Qr,Kr,Jr,Wr){var Yr=ae(ze),Gr;Jr.from?Gr=Z(ze,Jr.from):Gr=[ze],Jr.changed&&Gr.forEach(function(Zr){var en=ae(Zr);en.lastValue=Zr.value}),oe(Gr,function(Zr){var en=function(tn){if(!se(ze)){Zr.removeEventListener(Jr.trigger,en);return}if(!ft(ze,tn)&&((Wr||ut(tn,ze))&&tn.preventDefault(),!ct(Jr,ze,tn))){var rn=ae(tn);if(rn.triggerSpec=Jr,rn.handledFor==null&&(rn.handledFor=[]),rn.handledFor.indexOf(ze)<0){if(rn.handledFor.push(ze),Jr.consume&&tn.stopPropagation(),Jr.target&&tn.target&&!h(tn.target,Jr.target))return;if(Jr.once){if(Yr.triggeredOnce)return;Yr.triggeredOnce=!0}if(Jr.changed){var nn=ae(Zr);if(nn.lastValue===Zr.value)return;nn.lastValue=Zr.value}if(Yr.delayed&&clearTimeout(Yr.delayed),Yr.throttle)return;Jr.throttle>0?Yr.throttle||(Qr(ze,tn),Yr.throttle=setTimeout(function(){Yr.throttle=null},Jr.throttle)):Jr.delay>0?Yr.delayed=setTimeout(function(){Qr(ze,tn)},Jr.delay):(ce(ze,"htmx:trigger"),Qr(ze,tn))}}};Kr.listenerInfos==null&&(Kr.listenerInfos=[]),Kr.listenerInfos.push({trigger:Jr.trigger,listener:en,on:Zr}),Zr.addEventListener(Jr.trigger,en)})}var vt=!1,dt=null;function gt(){dt||(dt=function(){vt=!0},window.addEventListener("scroll",dt),setInterval(function(){vt&&(vt=!1,oe(re().querySelectorAll("[hx-trigger='revealed'],[data-hx-trigger='revealed']"),function(ze){pt(ze)}))},200))}function pt(ze){if(!o(ze,"data-hx-revealed")&&X(ze)){ze.setAttribute("data-hx-revealed","true");var Qr=ae(ze);Qr.initHash?ce(ze,"revealed"):ze.addEventListener("htmx:afterProcessNode",function(Kr){ce(ze,"revealed")},{once:!0})}}function mt(ze,Qr,Kr){for(var Jr=D(Kr),Wr=0;Wr<Jr.length;Wr++){var Yr=Jr[Wr].split(/:(.+)/);Yr[0]==="connect"&&xt(ze,Yr[1],0),Yr[0]==="send"&&bt(ze)}}function xt(ze,Qr,Kr){if(se(ze)){if(Qr.indexOf("/")==0){var Jr=location.hostname+(location.port?":"+location.port:"");location.protocol=="https:"?Qr="wss://"+Jr+Qr:location.protocol=="http:"&&(Qr="ws://"+Jr+Qr)}var Wr=Q.createWebSocket(Qr);Wr.onerror=function(Yr){fe(ze,"htmx:wsError",{error:Yr,socket:Wr}),yt(ze)},Wr.onclose=function(Yr){if([1006,1012,1013].indexOf(Yr.code)>=0){var Gr=wt(Kr);setTimeout(function(){xt(ze,Qr,Kr+1)},Gr)}},Wr.onopen=function(Yr){Kr=0},ae(ze).webSocket=Wr,Wr.addEventListener("message",function(Yr){if(!yt(ze)){var Gr=Yr.data;R(ze,function(an){Gr=an.transformResponse(Gr,null,ze)});for(var Zr=T(ze),en=l(Gr),tn=M(en.children),rn=0;rn<tn.length;rn++){var nn=tn[rn];Ee(te(nn,"hx-swap-oob")||"true",nn,Zr)}nr(Zr.tasks)}})}}function yt(ze){if(!se(ze))return ae(ze).webSocket.close(),!0}function bt(ze){var Qr=c(ze,function(Kr){return ae(Kr).webSocket!=null});Qr?ze.addEventListener(it(ze)[0].trigger,function(Kr){var Jr=ae(Qr).webSocket,Wr=xr(ze,Qr),Yr=dr(ze,"post"),Gr=Yr.errors,Zr=Yr.values,en=Hr(ze),tn=le(Zr,en),rn=yr(tn,ze);if(rn.HEADERS=Wr,Gr&&Gr.length>0){ce(ze,"htmx:validation:halted",Gr);return}Jr.send(JSON.stringify(rn)),ut(Kr,ze)&&Kr.preventDefault()}):fe(ze,"htmx:noWebSocketSourceError")}function wt(ze){var Qr=Q.config.wsReconnectDelay;if(typeof Qr=="function")return Qr(ze);if(Qr==="full-jitter"){var Kr=Math.min(ze,6),Jr=1e3*Math.pow(2,Kr);return Jr*Math.random()}b('htmx.config.wsReconnectDelay must either be a function or the string "full-jitter"')}function St(ze,Qr,Kr){for(var Jr=D(Kr),Wr=0;Wr<Jr.length;Wr++){var Yr=Jr[Wr].split(/:(.+)/);Yr[0]==="connect"&&Et(ze,Yr[1]),Yr[0]==="swap"&&Ct(ze,Yr[1])}}function Et(ze,Qr){var Kr=Q.createEventSource(Qr);Kr.onerror=function(Jr){fe(ze,"htmx:sseError",{error:Jr,source:Kr}),Tt(ze)},ae(ze).sseEventSource=Kr}function Ct(ze,Qr){var Kr=c(ze,Ot);if(Kr){var Jr=ae(Kr).sseEventSource,Wr=function(Yr){if(!Tt(Kr)){if(!se(ze)){Jr.removeEventListener(Qr,Wr);return}var Gr=Yr.data;R(ze,function(rn){Gr=rn.transformResponse(Gr,null,ze)});var Zr=wr(ze),en=ye(ze),tn=T(ze);je(Zr.swapStyle,en,ze,Gr,tn),nr(tn.tasks),ce(ze,"htmx:sseMessage",Yr)}};ae(ze).sseListener=Wr,Jr.addEventListener(Qr,Wr)}else fe(ze,"htmx:noSSESourceError")}function Rt(ze,Qr,Kr){var Jr=c(ze,Ot);if(Jr){var Wr=ae(Jr).sseEventSource,Yr=function(){Tt(Jr)||(se(ze)?Qr(ze):Wr.removeEventListener(Kr,Yr))};ae(ze).sseListener=Yr,Wr.addEventListener(Kr,Yr)}else fe(ze,"htmx:noSSESourceError")}function Tt(ze){if(!se(ze))return ae(ze).sseEventSource.close(),!0}function Ot(ze){return ae(ze).sseEventSource!=null}function qt(ze,Qr,Kr,Jr){var Wr=function(){Kr.loaded||(Kr.loaded=!0,Qr(ze))};Jr>0?setTimeout(Wr,Jr):Wr()}function Ht(ze,Qr,Kr){var Jr=!1;return oe(w,function(Wr){if(o(ze,"hx-"+Wr)){var Yr=te(ze,"hx-"+Wr);Jr=!0,Qr.path=Yr,Qr.verb=Wr,Kr.forEach(function(Gr){Lt(ze,Gr,Qr,function(Zr,en){if(v(Zr,Q.config.disableSelector)){m(Zr);return}he(Wr,Yr,Zr,en)})})}}),Jr}function Lt(ze,Qr,Kr,Jr){if(Qr.sseEvent)Rt(ze,Jr,Qr.sseEvent);else if(Qr.trigger==="revealed")gt(),ht(ze,Jr,Kr,Qr),pt(ze);else if(Qr.trigger==="intersect"){var Wr={};Qr.root&&(Wr.root=ue(ze,Qr.root)),Qr.threshold&&(Wr.threshold=parseFloat(Qr.threshold));var Yr=new IntersectionObserver(function(Gr){for(var Zr=0;Zr<Gr.length;Zr++){var en=Gr[Zr];if(en.isIntersecting){ce(ze,"intersect");break}}},Wr);Yr.observe(ze),ht(ze,Jr,Kr,Qr)}else Qr.trigger==="load"?ct(Qr,ze,Wt("load",{elt:ze}))||qt(ze,Jr,Kr,Qr.delay):Qr.pollInterval>0?(Kr.polling=!0,ot(ze,Jr,Qr)):ht(ze,Jr,Kr,Qr)}function At(ze){if(!ze.htmxExecuted&&Q.config.allowScriptTags&&(ze.type==="text/javascript"||ze.type==="module"||ze.type==="")){var Qr=re().createElement("script");oe(ze.attributes,function(Jr){Qr.setAttribute(Jr.name,Jr.value)}),Qr.textContent=ze.textContent,Qr.async=!1,Q.config.inlineScriptNonce&&(Qr.nonce=Q.config.inlineScriptNonce);var Kr=ze.parentElement;try{Kr.insertBefore(Qr,ze)}catch(Jr){b(Jr)}finally{ze.parentElement&&ze.parentElement.removeChild(ze)}}}function Nt(ze){h(ze,"script")&&At(ze),oe(f(ze,"script"),function(Qr){At(Qr)})}function It(ze){for(var Qr=ze.attributes,Kr=0;Kr<Qr.length;Kr++){var Jr=Qr[Kr].name;if(g(Jr,"hx-on:")||g(Jr,"data-hx-on:")||g(Jr,"hx-on-")||g(Jr,"data-hx-on-"))return!0}return!1}function kt(ze){var Qr=null,Kr=[];if(It(ze)&&Kr.push(ze),document.evaluate)for(var Jr=document.evaluate('.//*[@*[ starts-with(name(), "hx-on:") or starts-with(name(), "data-hx-on:") or starts-with(name(), "hx-on-") or starts-with(name(), "data-hx-on-") ]]',ze);Qr=Jr.iterateNext();)Kr.push(Qr);else for(var Wr=ze.getElementsByTagName("*"),Yr=0;Yr<Wr.length;Yr++)It(Wr[Yr])&&Kr.push(Wr[Yr]);return Kr}function Pt(ze){if(ze.querySelectorAll){var Qr=", [hx-boost] a, [data-hx-boost] a, a[hx-boost], a[data-hx-boost]",Kr=ze.querySelectorAll(i+Qr+", form, [type='submit'], [hx-sse], [data-hx-sse], [hx-ws], [data-hx-ws], [hx-ext], [data-hx-ext], [hx-trigger], [data-hx-trigger], [hx-on], [data-hx-on]");return Kr}else return[]}function Mt(ze){var Qr=v(ze.target,"button, input[type='submit']"),Kr=Dt(ze);Kr&&(Kr.lastButtonClicked=Qr)}function Xt(ze){var Qr=Dt(ze);Qr&&(Qr.lastButtonClicked=null)}function Dt(ze){var Qr=v(ze.target,"button, input[type='submit']");if(Qr){var Kr=p("#"+ee(Qr,"form"))||v(Qr,"form");if(Kr)return ae(Kr)}}function Ut(ze){ze.addEventListener("click",Mt),ze.addEventListener("focusin",Mt),ze.addEventListener("focusout",Xt)}function Ft(ze){for(var Qr=Ye(ze),Kr=0,Jr=0;Jr<Qr.length;Jr++){const Wr=Qr[Jr];Wr==="{"?Kr++:Wr==="}"&&Kr--}return Kr}function Bt(ze,Qr,Kr){var Jr=ae(ze);Array.isArray(Jr.onHandlers)||(Jr.onHandlers=[]);var Wr,Yr=function(Gr){return Tr(ze,function(){Wr||(Wr=new Function("event",Kr)),Wr.call(ze,Gr)})};ze.addEventListener(Qr,Yr),Jr.onHandlers.push({event:Qr,listener:Yr})}function Vt(ze){var Qr=te(ze,"hx-on");if(Qr){for(var Kr={},Jr=Qr.split(` + "`" + `
-` + "`" + `),Wr=null,Yr=0;Jr.length>0;){var Gr=Jr.shift(),Zr=Gr.match(/^\s*([a-zA-Z:\-\.]+:)(.*)/);Yr===0&&Zr?(Gr.split(":"),Wr=Zr[1].slice(0,-1),Kr[Wr]=Zr[2]):Kr[Wr]+=Gr,Yr+=Ft(Gr)}for(var en in Kr)Bt(ze,en,Kr[en])}}function jt(ze){Ae(ze);for(var Qr=0;Qr<ze.attributes.length;Qr++){var Kr=ze.attributes[Qr].name,Jr=ze.attributes[Qr].value;if(g(Kr,"hx-on")||g(Kr,"data-hx-on")){var Wr=Kr.indexOf("-on")+3,Yr=Kr.slice(Wr,Wr+1);if(Yr==="-"||Yr===":"){var Gr=Kr.slice(Wr+1);g(Gr,":")?Gr="htmx"+Gr:g(Gr,"-")?Gr="htmx:"+Gr.slice(1):g(Gr,"htmx-")&&(Gr="htmx:"+Gr.slice(5)),Bt(ze,Gr,Jr)}}}}function _t(ze){if(v(ze,Q.config.disableSelector)){m(ze);return}var Qr=ae(ze);if(Qr.initHash!==Le(ze)){Ne(ze),Qr.initHash=Le(ze),Vt(ze),ce(ze,"htmx:beforeProcessNode"),ze.value&&(Qr.lastValue=ze.value);var Kr=it(ze),Jr=Ht(ze,Qr,Kr);Jr||(ne(ze,"hx-boost")==="true"?lt(ze,Qr,Kr):o(ze,"hx-trigger")&&Kr.forEach(function(Gr){Lt(ze,Gr,Qr,function(){})})),(ze.tagName==="FORM"||ee(ze,"type")==="submit"&&o(ze,"form"))&&Ut(ze);var Wr=te(ze,"hx-sse");Wr&&St(ze,Qr,Wr);var Yr=te(ze,"hx-ws");Yr&&mt(ze,Qr,Yr),ce(ze,"htmx:afterProcessNode")}}function zt(ze){if(ze=p(ze),v(ze,Q.config.disableSelector)){m(ze);return}_t(ze),oe(Pt(ze),function(Qr){_t(Qr)}),oe(kt(ze),jt)}function $t(ze){return ze.replace(/([a-z0-9])([A-Z])/g,"$1-$2").toLowerCase()}function Wt(ze,Qr){var Kr;return window.CustomEvent&&typeof window.CustomEvent=="function"?Kr=new CustomEvent(ze,{bubbles:!0,cancelable:!0,detail:Qr}):(Kr=re().createEvent("CustomEvent"),Kr.initCustomEvent(ze,!0,!0,Qr)),Kr}function fe(ze,Qr,Kr){ce(ze,Qr,le({error:Qr},Kr))}function Gt(ze){return ze==="htmx:afterProcessNode"}function R(ze,Qr){oe(Br(ze),function(Kr){try{Qr(Kr)}catch(Jr){b(Jr)}})}function b(ze){console.error?console.error(ze):console.log&&console.log("ERROR: ",ze)}function ce(ze,Qr,Kr){ze=p(ze),Kr==null&&(Kr={}),Kr.elt=ze;var Jr=Wt(Qr,Kr);Q.logger&&!Gt(Qr)&&Q.logger(ze,Qr,Kr),Kr.error&&(b(Kr.error),ce(ze,"htmx:error",{errorInfo:Kr}));var Wr=ze.dispatchEvent(Jr),Yr=$t(Qr);if(Wr&&Yr!==Qr){var Gr=Wt(Yr,Jr.detail);Wr=Wr&&ze.dispatchEvent(Gr)}return R(ze,function(Zr){Wr=Wr&&Zr.onEvent(Qr,Jr)!==!1&&!Jr.defaultPrevented}),Wr}var Jt=location.pathname+location.search;function Zt(){var ze=re().querySelector("[hx-history-elt],[data-hx-history-elt]");return ze||re().body}function Kt(ze,Qr,Kr,Jr){if(U()){if(Q.config.historyCacheSize<=0){localStorage.removeItem("htmx-history-cache");return}ze=F(ze);for(var Wr=E(localStorage.getItem("htmx-history-cache"))||[],Yr=0;Yr<Wr.length;Yr++)if(Wr[Yr].url===ze){Wr.splice(Yr,1);break}var Gr={url:ze,content:Qr,title:Kr,scroll:Jr};for(ce(re().body,"htmx:historyItemCreated",{item:Gr,cache:Wr}),Wr.push(Gr);Wr.length>Q.config.historyCacheSize;)Wr.shift();for(;Wr.length>0;)try{localStorage.setItem("htmx-history-cache",JSON.stringify(Wr));break}catch(Zr){fe(re().body,"htmx:historyCacheError",{cause:Zr,cache:Wr}),Wr.shift()}}}function Yt(ze){if(!U())return null;ze=F(ze);for(var Qr=E(localStorage.getItem("htmx-history-cache"))||[],Kr=0;Kr<Qr.length;Kr++)if(Qr[Kr].url===ze)return Qr[Kr];return null}function Qt(ze){var Qr=Q.config.requestClass,Kr=ze.cloneNode(!0);return oe(f(Kr,"."+Qr),function(Jr){n(Jr,Qr)}),Kr.innerHTML}function er(){var ze=Zt(),Qr=Jt||location.pathname+location.search,Kr;try{Kr=re().querySelector('[hx-history="false" i],[data-hx-history="false" i]')}catch{Kr=re().querySelector('[hx-history="false"],[data-hx-history="false"]')}Kr||(ce(re().body,"htmx:beforeHistorySave",{path:Qr,historyElt:ze}),Kt(Qr,Qt(ze),re().title,window.scrollY)),Q.config.historyEnabled&&history.replaceState({htmx:!0},re().title,window.location.href)}function tr(ze){Q.config.getCacheBusterParam&&(ze=ze.replace(/org\.htmx\.cache-buster=[^&]*&?/,""),(G(ze,"&")||G(ze,"?"))&&(ze=ze.slice(0,-1))),Q.config.historyEnabled&&history.pushState({htmx:!0},"",ze),Jt=ze}function rr(ze){Q.config.historyEnabled&&history.replaceState({htmx:!0},"",ze),Jt=ze}function nr(ze){oe(ze,function(Qr){Qr.call()})}function ir(ze){var Qr=new XMLHttpRequest,Kr={path:ze,xhr:Qr};ce(re().body,"htmx:historyCacheMiss",Kr),Qr.open("GET",ze,!0),Qr.setRequestHeader("HX-Request","true"),Qr.setRequestHeader("HX-History-Restore-Request","true"),Qr.setRequestHeader("HX-Current-URL",re().location.href),Qr.onload=function(){if(this.status>=200&&this.status<400){ce(re().body,"htmx:historyCacheMissLoad",Kr);var Jr=l(this.response);Jr=Jr.querySelector("[hx-history-elt],[data-hx-history-elt]")||Jr;var Wr=Zt(),Yr=T(Wr),Gr=Ve(this.response);if(Gr){var Zr=C("title");Zr?Zr.innerHTML=Gr:window.document.title=Gr}Ue(Wr,Jr,Yr),nr(Yr.tasks),Jt=ze,ce(re().body,"htmx:historyRestore",{path:ze,cacheMiss:!0,serverResponse:this.response})}else fe(re().body,"htmx:historyCacheMissLoadError",Kr)},Qr.send()}function ar(ze){er(),ze=ze||location.pathname+location.search;var Qr=Yt(ze);if(Qr){var Kr=l(Qr.content),Jr=Zt(),Wr=T(Jr);Ue(Jr,Kr,Wr),nr(Wr.tasks),document.title=Qr.title,setTimeout(function(){window.scrollTo(0,Qr.scroll)},0),Jt=ze,ce(re().body,"htmx:historyRestore",{path:ze,item:Qr})}else Q.config.refreshOnHistoryMiss?window.location.reload(!0):ir(ze)}function or(ze){var Qr=me(ze,"hx-indicator");return Qr==null&&(Qr=[ze]),oe(Qr,function(Kr){var Jr=ae(Kr);Jr.requestCount=(Jr.requestCount||0)+1,Kr.classList.add.call(Kr.classList,Q.config.requestClass)}),Qr}function sr(ze){var Qr=me(ze,"hx-disabled-elt");return Qr==null&&(Qr=[]),oe(Qr,function(Kr){var Jr=ae(Kr);Jr.requestCount=(Jr.requestCount||0)+1,Kr.setAttribute("disabled","")}),Qr}function lr(ze,Qr){oe(ze,function(Kr){var Jr=ae(Kr);Jr.requestCount=(Jr.requestCount||0)-1,Jr.requestCount===0&&Kr.classList.remove.call(Kr.classList,Q.config.requestClass)}),oe(Qr,function(Kr){var Jr=ae(Kr);Jr.requestCount=(Jr.requestCount||0)-1,Jr.requestCount===0&&Kr.removeAttribute("disabled")})}function ur(ze,Qr){for(var Kr=0;Kr<ze.length;Kr++){var Jr=ze[Kr];if(Jr.isSameNode(Qr))return!0}return!1}function fr(ze){return ze.name===""||ze.name==null||ze.disabled||v(ze,"fieldset[disabled]")||ze.type==="button"||ze.type==="submit"||ze.tagName==="image"||ze.tagName==="reset"||ze.tagName==="file"?!1:ze.type==="checkbox"||ze.type==="radio"?ze.checked:!0}function cr(ze,Qr,Kr){if(ze!=null&&Qr!=null){var Jr=Kr[ze];Jr===void 0?Kr[ze]=Qr:Array.isArray(Jr)?Array.isArray(Qr)?Kr[ze]=Jr.concat(Qr):Jr.push(Qr):Array.isArray(Qr)?Kr[ze]=[Jr].concat(Qr):Kr[ze]=[Jr,Qr]}}function hr(ze,Qr,Kr,Jr,Wr){if(!(Jr==null||ur(ze,Jr))){if(ze.push(Jr),fr(Jr)){var Yr=ee(Jr,"name"),Gr=Jr.value;Jr.multiple&&Jr.tagName==="SELECT"&&(Gr=M(Jr.querySelectorAll("option:checked")).map(function(en){return en.value})),Jr.files&&(Gr=M(Jr.files)),cr(Yr,Gr,Qr),Wr&&vr(Jr,Kr)}if(h(Jr,"form")){var Zr=Jr.elements;oe(Zr,function(en){hr(ze,Qr,Kr,en,Wr)})}}}function vr(ze,Qr){ze.willValidate&&(ce(ze,"htmx:validation:validate"),ze.checkValidity()||(Qr.push({elt:ze,message:ze.validationMessage,validity:ze.validity}),ce(ze,"htmx:validation:failed",{message:ze.validationMessage,validity:ze.validity})))}function dr(ze,Qr){var Kr=[],Jr={},Wr={},Yr=[],Gr=ae(ze);Gr.lastButtonClicked&&!se(Gr.lastButtonClicked)&&(Gr.lastButtonClicked=null);var Zr=h(ze,"form")&&ze.noValidate!==!0||te(ze,"hx-validate")==="true";if(Gr.lastButtonClicked&&(Zr=Zr&&Gr.lastButtonClicked.formNoValidate!==!0),Qr!=="get"&&hr(Kr,Wr,Yr,v(ze,"form"),Zr),hr(Kr,Jr,Yr,ze,Zr),Gr.lastButtonClicked||ze.tagName==="BUTTON"||ze.tagName==="INPUT"&&ee(ze,"type")==="submit"){var en=Gr.lastButtonClicked||ze,tn=ee(en,"name");cr(tn,en.value,Wr)}var rn=me(ze,"hx-include");return oe(rn,function(nn){hr(Kr,Jr,Yr,nn,Zr),h(nn,"form")||oe(nn.querySelectorAll(rt),function(an){hr(Kr,Jr,Yr,an,Zr)})}),Jr=le(Jr,Wr),{errors:Yr,values:Jr}}function gr(ze,Qr,Kr){ze!==""&&(ze+="&"),String(Kr)==="[object Object]"&&(Kr=JSON.stringify(Kr));var Jr=encodeURIComponent(Kr);return ze+=encodeURIComponent(Qr)+"="+Jr,ze}function pr(ze){var Qr="";for(var Kr in ze)if(ze.hasOwnProperty(Kr)){var Jr=ze[Kr];Array.isArray(Jr)?oe(Jr,function(Wr){Qr=gr(Qr,Kr,Wr)}):Qr=gr(Qr,Kr,Jr)}return Qr}function mr(ze){var Qr=new FormData;for(var Kr in ze)if(ze.hasOwnProperty(Kr)){var Jr=ze[Kr];Array.isArray(Jr)?oe(Jr,function(Wr){Qr.append(Kr,Wr)}):Qr.append(Kr,Jr)}return Qr}function xr(ze,Qr,Kr){var Jr={"HX-Request":"true","HX-Trigger":ee(ze,"id"),"HX-Trigger-Name":ee(ze,"name"),"HX-Target":te(Qr,"id"),"HX-Current-URL":re().location.href};return Rr(ze,"hx-headers",!1,Jr),Kr!==void 0&&(Jr["HX-Prompt"]=Kr),ae(ze).boosted&&(Jr["HX-Boosted"]="true"),Jr}function yr(ze,Qr){var Kr=ne(Qr,"hx-params");if(Kr){if(Kr==="none")return{};if(Kr==="*")return ze;if(Kr.indexOf("not ")===0)return oe(Kr.substr(4).split(","),function(Wr){Wr=Wr.trim(),delete ze[Wr]}),ze;var Jr={};return oe(Kr.split(","),function(Wr){Wr=Wr.trim(),Jr[Wr]=ze[Wr]}),Jr}else return ze}function br(ze){return ee(ze,"href")&&ee(ze,"href").indexOf("#")>=0}function wr(ze,Qr){var Kr=Qr||ne(ze,"hx-swap"),Jr={swapStyle:ae(ze).boosted?"innerHTML":Q.config.defaultSwapStyle,swapDelay:Q.config.defaultSwapDelay,settleDelay:Q.config.defaultSettleDelay};if(Q.config.scrollIntoViewOnBoost&&ae(ze).boosted&&!br(ze)&&(Jr.show="top"),Kr){var Wr=D(Kr);if(Wr.length>0)for(var Yr=0;Yr<Wr.length;Yr++){var Gr=Wr[Yr];if(Gr.indexOf("swap:")===0)Jr.swapDelay=d(Gr.substr(5));else if(Gr.indexOf("settle:")===0)Jr.settleDelay=d(Gr.substr(7));else if(Gr.indexOf("transition:")===0)Jr.transition=Gr.substr(11)==="true";else if(Gr.indexOf("ignoreTitle:")===0)Jr.ignoreTitle=Gr.substr(12)==="true";else if(Gr.indexOf("scroll:")===0){var Zr=Gr.substr(7),en=Zr.split(":"),tn=en.pop(),rn=en.length>0?en.join(":"):null;Jr.scroll=tn,Jr.scrollTarget=rn}else if(Gr.indexOf("show:")===0){var nn=Gr.substr(5),en=nn.split(":"),an=en.pop(),rn=en.length>0?en.join(":"):null;Jr.show=an,Jr.showTarget=rn}else if(Gr.indexOf("focus-scroll:")===0){var ln=Gr.substr(13);Jr.focusScroll=ln=="true"}else Yr==0?Jr.swapStyle=Gr:b("Unknown modifier in hx-swap: "+Gr)}}return Jr}function Sr(ze){return ne(ze,"hx-encoding")==="multipart/form-data"||h(ze,"form")&&ee(ze,"enctype")==="multipart/form-data"}function Er(ze,Qr,Kr){var Jr=null;return R(Qr,function(Wr){Jr==null&&(Jr=Wr.encodeParameters(ze,Kr,Qr))}),Jr??(Sr(Qr)?mr(Kr):pr(Kr))}function T(ze){return{tasks:[],elts:[ze]}}function Cr(ze,Qr){var Kr=ze[0],Jr=ze[ze.length-1];if(Qr.scroll){var Wr=null;Qr.scrollTarget&&(Wr=ue(Kr,Qr.scrollTarget)),Qr.scroll==="top"&&(Kr||Wr)&&(Wr=Wr||Kr,Wr.scrollTop=0),Qr.scroll==="bottom"&&(Jr||Wr)&&(Wr=Wr||Jr,Wr.scrollTop=Wr.scrollHeight)}if(Qr.show){var Wr=null;if(Qr.showTarget){var Yr=Qr.showTarget;Qr.showTarget==="window"&&(Yr="body"),Wr=ue(Kr,Yr)}Qr.show==="top"&&(Kr||Wr)&&(Wr=Wr||Kr,Wr.scrollIntoView({block:"start",behavior:Q.config.scrollBehavior})),Qr.show==="bottom"&&(Jr||Wr)&&(Wr=Wr||Jr,Wr.scrollIntoView({block:"end",behavior:Q.config.scrollBehavior}))}}function Rr(ze,Qr,Kr,Jr){if(Jr==null&&(Jr={}),ze==null)return Jr;var Wr=te(ze,Qr);if(Wr){var Yr=Wr.trim(),Gr=Kr;if(Yr==="unset")return null;Yr.indexOf("javascript:")===0?(Yr=Yr.substr(11),Gr=!0):Yr.indexOf("js:")===0&&(Yr=Yr.substr(3),Gr=!0),Yr.indexOf("{")!==0&&(Yr="{"+Yr+"}");var Zr;Gr?Zr=Tr(ze,function(){return Function("return ("+Yr+")")()},{}):Zr=E(Yr);for(var en in Zr)Zr.hasOwnProperty(en)&&Jr[en]==null&&(Jr[en]=Zr[en])}return Rr(u(ze),Qr,Kr,Jr)}function Tr(ze,Qr,Kr){return Q.config.allowEval?Qr():(fe(ze,"htmx:evalDisallowedError"),Kr)}function Or(ze,Qr){return Rr(ze,"hx-vars",!0,Qr)}function qr(ze,Qr){return Rr(ze,"hx-vals",!1,Qr)}function Hr(ze){return le(Or(ze),qr(ze))}function Lr(ze,Qr,Kr){if(Kr!==null)try{ze.setRequestHeader(Qr,Kr)}catch{ze.setRequestHeader(Qr,encodeURIComponent(Kr)),ze.setRequestHeader(Qr+"-URI-AutoEncoded","true")}}function Ar(ze){if(ze.responseURL&&typeof URL<"u")try{var Qr=new URL(ze.responseURL);return Qr.pathname+Qr.search}catch{fe(re().body,"htmx:badResponseUrl",{url:ze.responseURL})}}function O(ze,Qr){return Qr.test(ze.getAllResponseHeaders())}function Nr(ze,Qr,Kr){return ze=ze.toLowerCase(),Kr?Kr instanceof Element||I(Kr,"String")?he(ze,Qr,null,null,{targetOverride:p(Kr),returnPromise:!0}):he(ze,Qr,p(Kr.source),Kr.event,{handler:Kr.handler,headers:Kr.headers,values:Kr.values,targetOverride:p(Kr.target),swapOverride:Kr.swap,select:Kr.select,returnPromise:!0}):he(ze,Qr,null,null,{returnPromise:!0})}function Ir(ze){for(var Qr=[];ze;)Qr.push(ze),ze=ze.parentElement;return Qr}function kr(ze,Qr,Kr){var Jr,Wr;if(typeof URL=="function"){Wr=new URL(Qr,document.location.href);var Yr=document.location.origin;Jr=Yr===Wr.origin}else Wr=Qr,Jr=g(Qr,document.location.origin);return Q.config.selfRequestsOnly&&!Jr?!1:ce(ze,"htmx:validateUrl",le({url:Wr,sameHost:Jr},Kr))}function he(ze,Qr,Kr,Jr,Wr,Yr){var Gr=null,Zr=null;if(Wr=Wr??{},Wr.returnPromise&&typeof Promise<"u")var en=new Promise(function(En,Tn){Gr=En,Zr=Tn});Kr==null&&(Kr=re().body);var tn=Wr.handler||Mr,rn=Wr.select||null;if(!se(Kr))return ie(Gr),en;var nn=Wr.targetOverride||ye(Kr);if(nn==null||nn==pe)return fe(Kr,"htmx:targetError",{target:te(Kr,"hx-target")}),ie(Zr),en;var an=ae(Kr),ln=an.lastButtonClicked;if(ln){var pn=ee(ln,"formaction");pn!=null&&(Qr=pn);var _n=ee(ln,"formmethod");_n!=null&&_n.toLowerCase()!=="dialog"&&(ze=_n)}var sn=ne(Kr,"hx-confirm");if(Yr===void 0){var un=function(En){return he(ze,Qr,Kr,Jr,Wr,!!En)},cn={target:nn,elt:Kr,path:Qr,verb:ze,triggeringEvent:Jr,etc:Wr,issueRequest:un,question:sn};if(ce(Kr,"htmx:confirm",cn)===!1)return ie(Gr),en}var fn=Kr,dn=ne(Kr,"hx-sync"),vn=null,On=!1;if(dn){var mn=dn.split(":"),yn=mn[0].trim();if(yn==="this"?fn=xe(Kr,"hx-sync"):fn=ue(Kr,yn),dn=(mn[1]||"drop").trim(),an=ae(fn),dn==="drop"&&an.xhr&&an.abortable!==!0)return ie(Gr),en;if(dn==="abort"){if(an.xhr)return ie(Gr),en;On=!0}else if(dn==="replace")ce(fn,"htmx:abort");else if(dn.indexOf("queue")===0){var Rn=dn.split(" ");vn=(Rn[1]||"last").trim()}}if(an.xhr)if(an.abortable)ce(fn,"htmx:abort");else{if(vn==null){if(Jr){var bn=ae(Jr);bn&&bn.triggerSpec&&bn.triggerSpec.queue&&(vn=bn.triggerSpec.queue)}vn==null&&(vn="last")}return an.queuedRequests==null&&(an.queuedRequests=[]),vn==="first"&&an.queuedRequests.length===0?an.queuedRequests.push(function(){he(ze,Qr,Kr,Jr,Wr)}):vn==="all"?an.queuedRequests.push(function(){he(ze,Qr,Kr,Jr,Wr)}):vn==="last"&&(an.queuedRequests=[],an.queuedRequests.push(function(){he(ze,Qr,Kr,Jr,Wr)})),ie(Gr),en}var hn=new XMLHttpRequest;an.xhr=hn,an.abortable=On;var Sn=function(){if(an.xhr=null,an.abortable=!1,an.queuedRequests!=null&&an.queuedRequests.length>0){var En=an.queuedRequests.shift();En()}},Pn=ne(Kr,"hx-prompt");if(Pn){var Mn=prompt(Pn);if(Mn===null||!ce(Kr,"htmx:prompt",{prompt:Mn,target:nn}))return ie(Gr),Sn(),en}if(sn&&!Yr&&!confirm(sn))return ie(Gr),Sn(),en;var gn=xr(Kr,nn,Mn);ze!=="get"&&!Sr(Kr)&&(gn["Content-Type"]="application/x-www-form-urlencoded"),Wr.headers&&(gn=le(gn,Wr.headers));var Nn=dr(Kr,ze),In=Nn.errors,Cn=Nn.values;Wr.values&&(Cn=le(Cn,Wr.values));var zn=Hr(Kr),$n=le(Cn,zn),kn=yr($n,Kr);Q.config.getCacheBusterParam&&ze==="get"&&(kn["org.htmx.cache-buster"]=ee(nn,"id")||"true"),(Qr==null||Qr==="")&&(Qr=re().location.href);var Fn=Rr(Kr,"hx-request"),Qn=ae(Kr).boosted,Hn=Q.config.methodsThatUseUrlParams.indexOf(ze)>=0,wn={boosted:Qn,useUrlParams:Hn,parameters:kn,unfilteredParameters:$n,headers:gn,target:nn,verb:ze,errors:In,withCredentials:Wr.credentials||Fn.credentials||Q.config.withCredentials,timeout:Wr.timeout||Fn.timeout||Q.config.timeout,path:Qr,triggeringEvent:Jr};if(!ce(Kr,"htmx:configRequest",wn))return ie(Gr),Sn(),en;if(Qr=wn.path,ze=wn.verb,gn=wn.headers,kn=wn.parameters,In=wn.errors,Hn=wn.useUrlParams,In&&In.length>0)return ce(Kr,"htmx:validation:halted",wn),ie(Gr),Sn(),en;var Kn=Qr.split("#"),Vn=Kn[0],jn=Kn[1],An=Qr;if(Hn){An=Vn;var Xn=Object.keys(kn).length!==0;Xn&&(An.indexOf("?")<0?An+="?":An+="&",An+=pr(kn),jn&&(An+="#"+jn))}if(!kr(Kr,An,wn))return fe(Kr,"htmx:invalidPath",wn),ie(Zr),en;if(hn.open(ze.toUpperCase(),An,!0),hn.overrideMimeType("text/html"),hn.withCredentials=wn.withCredentials,hn.timeout=wn.timeout,!Fn.noHeaders){for(var Bn in gn)if(gn.hasOwnProperty(Bn)){var Jn=gn[Bn];Lr(hn,Bn,Jn)}}var xn={xhr:hn,target:nn,requestConfig:wn,etc:Wr,boosted:Qn,select:rn,pathInfo:{requestPath:Qr,finalRequestPath:An,anchor:jn}};if(hn.onload=function(){try{var En=Ir(Kr);if(xn.pathInfo.responsePath=Ar(hn),tn(Kr,xn),lr(qn,Dn),ce(Kr,"htmx:afterRequest",xn),ce(Kr,"htmx:afterOnLoad",xn),!se(Kr)){for(var Tn=null;En.length>0&&Tn==null;){var Ln=En.shift();se(Ln)&&(Tn=Ln)}Tn&&(ce(Tn,"htmx:afterRequest",xn),ce(Tn,"htmx:afterOnLoad",xn))}ie(Gr),Sn()}catch(Un){throw fe(Kr,"htmx:onLoadError",le({error:Un},xn)),Un}},hn.onerror=function(){lr(qn,Dn),fe(Kr,"htmx:afterRequest",xn),fe(Kr,"htmx:sendError",xn),ie(Zr),Sn()},hn.onabort=function(){lr(qn,Dn),fe(Kr,"htmx:afterRequest",xn),fe(Kr,"htmx:sendAbort",xn),ie(Zr),Sn()},hn.ontimeout=function(){lr(qn,Dn),fe(Kr,"htmx:afterRequest",xn),fe(Kr,"htmx:timeout",xn),ie(Zr),Sn()},!ce(Kr,"htmx:beforeRequest",xn))return ie(Gr),Sn(),en;var qn=or(Kr),Dn=sr(Kr);oe(["loadstart","loadend","progress","abort"],function(En){oe([hn,hn.upload],function(Tn){Tn.addEventListener(En,function(Ln){ce(Kr,"htmx:xhr:"+En,{lengthComputable:Ln.lengthComputable,loaded:Ln.loaded,total:Ln.total})})})}),ce(Kr,"htmx:beforeSend",xn);var Wn=Hn?null:Er(hn,Kr,kn);return hn.send(Wn),en}function Pr(ze,Qr){var Kr=Qr.xhr,Jr=null,Wr=null;if(O(Kr,/HX-Push:/i)?(Jr=Kr.getResponseHeader("HX-Push"),Wr="push"):O(Kr,/HX-Push-Url:/i)?(Jr=Kr.getResponseHeader("HX-Push-Url"),Wr="push"):O(Kr,/HX-Replace-Url:/i)&&(Jr=Kr.getResponseHeader("HX-Replace-Url"),Wr="replace"),Jr)return Jr==="false"?{}:{type:Wr,path:Jr};var Yr=Qr.pathInfo.finalRequestPath,Gr=Qr.pathInfo.responsePath,Zr=ne(ze,"hx-push-url"),en=ne(ze,"hx-replace-url"),tn=ae(ze).boosted,rn=null,nn=null;return Zr?(rn="push",nn=Zr):en?(rn="replace",nn=en):tn&&(rn="push",nn=Gr||Yr),nn?nn==="false"?{}:(nn==="true"&&(nn=Gr||Yr),Qr.pathInfo.anchor&&nn.indexOf("#")===-1&&(nn=nn+"#"+Qr.pathInfo.anchor),{type:rn,path:nn}):{}}function Mr(ze,Qr){var Kr=Qr.xhr,Jr=Qr.target,Wr=Qr.etc;Qr.requestConfig;var Yr=Qr.select;if(ce(ze,"htmx:beforeOnLoad",Qr)){if(O(Kr,/HX-Trigger:/i)&&_e(Kr,"HX-Trigger",ze),O(Kr,/HX-Location:/i)){er();var Gr=Kr.getResponseHeader("HX-Location"),Zr;Gr.indexOf("{")===0&&(Zr=E(Gr),Gr=Zr.path,delete Zr.path),Nr("GET",Gr,Zr).then(function(){tr(Gr)});return}var en=O(Kr,/HX-Refresh:/i)&&Kr.getResponseHeader("HX-Refresh")==="true";if(O(Kr,/HX-Redirect:/i)){location.href=Kr.getResponseHeader("HX-Redirect"),en&&location.reload();return}if(en){location.reload();return}O(Kr,/HX-Retarget:/i)&&(Kr.getResponseHeader("HX-Retarget")==="this"?Qr.target=ze:Qr.target=ue(ze,Kr.getResponseHeader("HX-Retarget")));var tn=Pr(ze,Qr),rn=Kr.status>=200&&Kr.status<400&&Kr.status!==204,nn=Kr.response,an=Kr.status>=400,ln=Q.config.ignoreTitle,pn=le({shouldSwap:rn,serverResponse:nn,isError:an,ignoreTitle:ln},Qr);if(ce(Jr,"htmx:beforeSwap",pn)){if(Jr=pn.target,nn=pn.serverResponse,an=pn.isError,ln=pn.ignoreTitle,Qr.target=Jr,Qr.failed=an,Qr.successful=!an,pn.shouldSwap){Kr.status===286&&at(ze),R(ze,function(mn){nn=mn.transformResponse(nn,Kr,ze)}),tn.type&&er();var _n=Wr.swapOverride;O(Kr,/HX-Reswap:/i)&&(_n=Kr.getResponseHeader("HX-Reswap"));var Zr=wr(ze,_n);Zr.hasOwnProperty("ignoreTitle")&&(ln=Zr.ignoreTitle),Jr.classList.add(Q.config.swappingClass);var sn=null,un=null,cn=function(){try{var mn=document.activeElement,yn={};try{yn={elt:mn,start:mn?mn.selectionStart:null,end:mn?mn.selectionEnd:null}}catch{}var Rn;Yr&&(Rn=Yr),O(Kr,/HX-Reselect:/i)&&(Rn=Kr.getResponseHeader("HX-Reselect")),tn.type&&(ce(re().body,"htmx:beforeHistoryUpdate",le({history:tn},Qr)),tn.type==="push"?(tr(tn.path),ce(re().body,"htmx:pushedIntoHistory",{path:tn.path})):(rr(tn.path),ce(re().body,"htmx:replacedInHistory",{path:tn.path})));var bn=T(Jr);if(je(Zr.swapStyle,Jr,ze,nn,bn,Rn),yn.elt&&!se(yn.elt)&&ee(yn.elt,"id")){var hn=document.getElementById(ee(yn.elt,"id")),Sn={preventScroll:Zr.focusScroll!==void 0?!Zr.focusScroll:!Q.config.defaultFocusScroll};if(hn){if(yn.start&&hn.setSelectionRange)try{hn.setSelectionRange(yn.start,yn.end)}catch{}hn.focus(Sn)}}if(Jr.classList.remove(Q.config.swappingClass),oe(bn.elts,function(gn){gn.classList&&gn.classList.add(Q.config.settlingClass),ce(gn,"htmx:afterSwap",Qr)}),O(Kr,/HX-Trigger-After-Swap:/i)){var Pn=ze;se(ze)||(Pn=re().body),_e(Kr,"HX-Trigger-After-Swap",Pn)}var Mn=function(){if(oe(bn.tasks,function(Cn){Cn.call()}),oe(bn.elts,function(Cn){Cn.classList&&Cn.classList.remove(Q.config.settlingClass),ce(Cn,"htmx:afterSettle",Qr)}),Qr.pathInfo.anchor){var gn=re().getElementById(Qr.pathInfo.anchor);gn&&gn.scrollIntoView({block:"start",behavior:"auto"})}if(bn.title&&!ln){var Nn=C("title");Nn?Nn.innerHTML=bn.title:window.document.title=bn.title}if(Cr(bn.elts,Zr),O(Kr,/HX-Trigger-After-Settle:/i)){var In=ze;se(ze)||(In=re().body),_e(Kr,"HX-Trigger-After-Settle",In)}ie(sn)};Zr.settleDelay>0?setTimeout(Mn,Zr.settleDelay):Mn()}catch(gn){throw fe(ze,"htmx:swapError",Qr),ie(un),gn}},fn=Q.config.globalViewTransitions;if(Zr.hasOwnProperty("transition")&&(fn=Zr.transition),fn&&ce(ze,"htmx:beforeTransition",Qr)&&typeof Promise<"u"&&document.startViewTransition){var dn=new Promise(function(mn,yn){sn=mn,un=yn}),vn=cn;cn=function(){document.startViewTransition(function(){return vn(),dn})}}Zr.swapDelay>0?setTimeout(cn,Zr.swapDelay):cn()}an&&fe(ze,"htmx:responseError",le({error:"Response Status Error Code "+Kr.status+" from "+Qr.pathInfo.requestPath},Qr))}}}var Xr={};function Dr(){return{init:function(ze){return null},onEvent:function(ze,Qr){return!0},transformResponse:function(ze,Qr,Kr){return ze},isInlineSwap:function(ze){return!1},handleSwap:function(ze,Qr,Kr,Jr){return!1},encodeParameters:function(ze,Qr,Kr){return null}}}function Ur(ze,Qr){Qr.init&&Qr.init(r),Xr[ze]=le(Dr(),Qr)}function Fr(ze){delete Xr[ze]}function Br(ze,Qr,Kr){if(ze==null)return Qr;Qr==null&&(Qr=[]),Kr==null&&(Kr=[]);var Jr=te(ze,"hx-ext");return Jr&&oe(Jr.split(","),function(Wr){if(Wr=Wr.replace(/ /g,""),Wr.slice(0,7)=="ignore:"){Kr.push(Wr.slice(7));return}if(Kr.indexOf(Wr)<0){var Yr=Xr[Wr];Yr&&Qr.indexOf(Yr)<0&&Qr.push(Yr)}}),Br(u(ze),Qr,Kr)}var Vr=!1;re().addEventListener("DOMContentLoaded",function(){Vr=!0});function jr(ze){Vr||re().readyState==="complete"?ze():re().addEventListener("DOMContentLoaded",ze)}function _r(){Q.config.includeIndicatorStyles!==!1&&re().head.insertAdjacentHTML("beforeend","<style>                      ."+Q.config.indicatorClass+"{opacity:0}                      ."+Q.config.requestClass+" ."+Q.config.indicatorClass+"{opacity:1; transition: opacity 200ms ease-in;}                      ."+Q.config.requestClass+"."+Q.config.indicatorClass+"{opacity:1; transition: opacity 200ms ease-in;}                    </style>")}function zr(){var ze=re().querySelector('meta[name="htmx-config"]');return ze?E(ze.content):null}function $r(){var ze=zr();ze&&(Q.config=le(Q.config,ze))}return jr(function(){$r(),_r();var ze=re().body;zt(ze);var Qr=re().querySelectorAll("[hx-trigger='restored'],[data-hx-trigger='restored']");ze.addEventListener("htmx:abort",function(Jr){var Wr=Jr.target,Yr=ae(Wr);Yr&&Yr.xhr&&Yr.xhr.abort()});const Kr=window.onpopstate?window.onpopstate.bind(window):null;window.onpopstate=function(Jr){Jr.state&&Jr.state.htmx?(ar(),oe(Qr,function(Wr){ce(Wr,"htmx:restored",{document:re(),triggerEvent:ce})})):Kr&&Kr(Jr)},setTimeout(function(){ce(ze,"htmx:load",{}),ze=null},0)}),Q}()})})(htmx_min);var htmx_minExports=htmx_min.exports;const htmx=getDefaultExportFromCjs(htmx_minExports);window.Alpine=module_default;window.htmx=htmx;module_default.data("search",()=>({searchText:"",runId:"all",isShowingSearchResults:!1,isShowingConnectionError:!1,eventSource:new EventSource("/sse?stream=events",{withCredentials:!1}),eventQueue:[],toastTimeout:null,zoomContent:"",init:function(){console.log("init"),this.$watch("searchText",ze=>{this.onSearchTextChanged(ze)}),this.$watch("runId",ze=>{this.onRunIdChanged(ze)}),this.$watch("isShowingSearchResults",ze=>{this.onIsShowingSearchResults(ze)}),this.eventSource.onmessage=ze=>{this.handleEventSourceMessage(ze)},this.eventSource.onerror=()=>{this.handleEventSourceError()}},onSearchTextChanged:function(ze){if(ze.length===0){this.isShowingSearchResults=!1;return}this.isShowingSearchResults=!0,this.onClickSearch()},onRunIdChanged:function(ze){if(ze==="all"){this.isShowingSearchResults=!1,this.searchText="";return}this.isShowingSearchResults=!0,this.searchText.length>0&&this.onClickSearch()},onIsShowingSearchResults:function(ze){if(console.log("isShowingSearchResults changed",ze),!ze)for(;this.eventQueue.length>0;){const Qr=this.eventQueue.shift();this.processEvent(Qr)}},onSearchTextKeyDown:function(ze){ze.key==="Enter"&&this.onClickSearch()},onClickSearch:function(){const ze=document.querySelector("#log-output-inner");if(!ze)throw new Error("Target element not found");const Qr=new CustomEvent("custom:search",{detail:{key:"value"}});ze.dispatchEvent(Qr)},handleEventSourceMessage:function(ze){if(this.isShowingSearchResults){this.eventQueue.push(ze);return}this.processEvent(ze)},handleEventSourceError:function(){console.log("sse error"),this.isShowingConnectionError=!0,this.toastTimeout&&clearTimeout(this.toastTimeout),this.toastTimeout=setTimeout(()=>{this.isShowingConnectionError=!1},5e3)},processEvent:function(ze){const Qr=JSON.parse(ze.data);swap(Qr)},onZoomEntry:function(ze){var Wr,Yr;const Kr=(Yr=(Wr=ze.target.closest(".log-entry"))==null?void 0:Wr.querySelector(".log-text"))==null?void 0:Yr.textContent;try{const Gr=JSON.parse(Kr||"");this.zoomContent=JSON.stringify(Gr,null,2)}catch{this.zoomContent=Kr||""}document.getElementById("zoom-dialog").showModal()}}));module_default.start();function swap(ze){var Zr,en,tn,rn,nn,an;const Qr=document.querySelector(ze.target);if(!Qr)throw new Error(` + "`" + `Target element not found: ${ze.target}/${ze.id}` + "`" + `);const Kr=ze.swap.split(" "),Jr=Kr[0],Wr=Kr.length>1?Kr[1]:"",Yr=document.createRange();Yr.selectNode(Qr);const Gr=Yr.createContextualFragment(ze.markup);switch(Jr){case"innerHTML":for(;Qr.firstChild;)Qr.removeChild(Qr.firstChild);Qr.appendChild(Gr);break;case"outerHTML":(Zr=Qr.parentNode)==null||Zr.replaceChild(Gr,Qr);break;case"beforebegin":(en=Qr.parentNode)==null||en.insertBefore(Gr,Qr);break;case"afterbegin":Qr.insertBefore(Gr,Qr.firstChild);break;case"beforeend":Qr.appendChild(Gr);break;case"afterend":(tn=Qr.parentNode)==null||tn.insertBefore(Gr,Qr.nextSibling);break}if(Wr){const ln=Wr.split(":"),pn=ln[0],_n=ln[1];switch(pn){case"scroll":switch(_n){case"view":(rn=Gr.firstChild)==null||rn.scrollIntoView();break;case"lastchild":(nn=Qr.lastChild)==null||nn.scrollIntoView({block:"end",behavior:"instant"});break;case"nextsibling":(an=Qr.nextSibling)==null||an.scrollIntoView({block:"end",behavior:"instant"});break}break}}}
+` + "`" + `),Wr=null,Yr=0;Jr.length>0;){var Gr=Jr.shift(),Zr=Gr.match(/^\s*([a-zA-Z:\-\.]+:)(.*)/);Yr===0&&Zr?(Gr.split(":"),Wr=Zr[1].slice(0,-1),Kr[Wr]=Zr[2]):Kr[Wr]+=Gr,Yr+=Ft(Gr)}for(var en in Kr)Bt(ze,en,Kr[en])}}function jt(ze){Ae(ze);for(var Qr=0;Qr<ze.attributes.length;Qr++){var Kr=ze.attributes[Qr].name,Jr=ze.attributes[Qr].value;if(g(Kr,"hx-on")||g(Kr,"data-hx-on")){var Wr=Kr.indexOf("-on")+3,Yr=Kr.slice(Wr,Wr+1);if(Yr==="-"||Yr===":"){var Gr=Kr.slice(Wr+1);g(Gr,":")?Gr="htmx"+Gr:g(Gr,"-")?Gr="htmx:"+Gr.slice(1):g(Gr,"htmx-")&&(Gr="htmx:"+Gr.slice(5)),Bt(ze,Gr,Jr)}}}}function _t(ze){if(v(ze,Q.config.disableSelector)){m(ze);return}var Qr=ae(ze);if(Qr.initHash!==Le(ze)){Ne(ze),Qr.initHash=Le(ze),Vt(ze),ce(ze,"htmx:beforeProcessNode"),ze.value&&(Qr.lastValue=ze.value);var Kr=it(ze),Jr=Ht(ze,Qr,Kr);Jr||(ne(ze,"hx-boost")==="true"?lt(ze,Qr,Kr):o(ze,"hx-trigger")&&Kr.forEach(function(Gr){Lt(ze,Gr,Qr,function(){})})),(ze.tagName==="FORM"||ee(ze,"type")==="submit"&&o(ze,"form"))&&Ut(ze);var Wr=te(ze,"hx-sse");Wr&&St(ze,Qr,Wr);var Yr=te(ze,"hx-ws");Yr&&mt(ze,Qr,Yr),ce(ze,"htmx:afterProcessNode")}}function zt(ze){if(ze=p(ze),v(ze,Q.config.disableSelector)){m(ze);return}_t(ze),oe(Pt(ze),function(Qr){_t(Qr)}),oe(kt(ze),jt)}function $t(ze){return ze.replace(/([a-z0-9])([A-Z])/g,"$1-$2").toLowerCase()}function Wt(ze,Qr){var Kr;return window.CustomEvent&&typeof window.CustomEvent=="function"?Kr=new CustomEvent(ze,{bubbles:!0,cancelable:!0,detail:Qr}):(Kr=re().createEvent("CustomEvent"),Kr.initCustomEvent(ze,!0,!0,Qr)),Kr}function fe(ze,Qr,Kr){ce(ze,Qr,le({error:Qr},Kr))}function Gt(ze){return ze==="htmx:afterProcessNode"}function R(ze,Qr){oe(Br(ze),function(Kr){try{Qr(Kr)}catch(Jr){b(Jr)}})}function b(ze){console.error?console.error(ze):console.log&&console.log("ERROR: ",ze)}function ce(ze,Qr,Kr){ze=p(ze),Kr==null&&(Kr={}),Kr.elt=ze;var Jr=Wt(Qr,Kr);Q.logger&&!Gt(Qr)&&Q.logger(ze,Qr,Kr),Kr.error&&(b(Kr.error),ce(ze,"htmx:error",{errorInfo:Kr}));var Wr=ze.dispatchEvent(Jr),Yr=$t(Qr);if(Wr&&Yr!==Qr){var Gr=Wt(Yr,Jr.detail);Wr=Wr&&ze.dispatchEvent(Gr)}return R(ze,function(Zr){Wr=Wr&&Zr.onEvent(Qr,Jr)!==!1&&!Jr.defaultPrevented}),Wr}var Jt=location.pathname+location.search;function Zt(){var ze=re().querySelector("[hx-history-elt],[data-hx-history-elt]");return ze||re().body}function Kt(ze,Qr,Kr,Jr){if(U()){if(Q.config.historyCacheSize<=0){localStorage.removeItem("htmx-history-cache");return}ze=F(ze);for(var Wr=E(localStorage.getItem("htmx-history-cache"))||[],Yr=0;Yr<Wr.length;Yr++)if(Wr[Yr].url===ze){Wr.splice(Yr,1);break}var Gr={url:ze,content:Qr,title:Kr,scroll:Jr};for(ce(re().body,"htmx:historyItemCreated",{item:Gr,cache:Wr}),Wr.push(Gr);Wr.length>Q.config.historyCacheSize;)Wr.shift();for(;Wr.length>0;)try{localStorage.setItem("htmx-history-cache",JSON.stringify(Wr));break}catch(Zr){fe(re().body,"htmx:historyCacheError",{cause:Zr,cache:Wr}),Wr.shift()}}}function Yt(ze){if(!U())return null;ze=F(ze);for(var Qr=E(localStorage.getItem("htmx-history-cache"))||[],Kr=0;Kr<Qr.length;Kr++)if(Qr[Kr].url===ze)return Qr[Kr];return null}function Qt(ze){var Qr=Q.config.requestClass,Kr=ze.cloneNode(!0);return oe(f(Kr,"."+Qr),function(Jr){n(Jr,Qr)}),Kr.innerHTML}function er(){var ze=Zt(),Qr=Jt||location.pathname+location.search,Kr;try{Kr=re().querySelector('[hx-history="false" i],[data-hx-history="false" i]')}catch{Kr=re().querySelector('[hx-history="false"],[data-hx-history="false"]')}Kr||(ce(re().body,"htmx:beforeHistorySave",{path:Qr,historyElt:ze}),Kt(Qr,Qt(ze),re().title,window.scrollY)),Q.config.historyEnabled&&history.replaceState({htmx:!0},re().title,window.location.href)}function tr(ze){Q.config.getCacheBusterParam&&(ze=ze.replace(/org\.htmx\.cache-buster=[^&]*&?/,""),(G(ze,"&")||G(ze,"?"))&&(ze=ze.slice(0,-1))),Q.config.historyEnabled&&history.pushState({htmx:!0},"",ze),Jt=ze}function rr(ze){Q.config.historyEnabled&&history.replaceState({htmx:!0},"",ze),Jt=ze}function nr(ze){oe(ze,function(Qr){Qr.call()})}function ir(ze){var Qr=new XMLHttpRequest,Kr={path:ze,xhr:Qr};ce(re().body,"htmx:historyCacheMiss",Kr),Qr.open("GET",ze,!0),Qr.setRequestHeader("HX-Request","true"),Qr.setRequestHeader("HX-History-Restore-Request","true"),Qr.setRequestHeader("HX-Current-URL",re().location.href),Qr.onload=function(){if(this.status>=200&&this.status<400){ce(re().body,"htmx:historyCacheMissLoad",Kr);var Jr=l(this.response);Jr=Jr.querySelector("[hx-history-elt],[data-hx-history-elt]")||Jr;var Wr=Zt(),Yr=T(Wr),Gr=Ve(this.response);if(Gr){var Zr=C("title");Zr?Zr.innerHTML=Gr:window.document.title=Gr}Ue(Wr,Jr,Yr),nr(Yr.tasks),Jt=ze,ce(re().body,"htmx:historyRestore",{path:ze,cacheMiss:!0,serverResponse:this.response})}else fe(re().body,"htmx:historyCacheMissLoadError",Kr)},Qr.send()}function ar(ze){er(),ze=ze||location.pathname+location.search;var Qr=Yt(ze);if(Qr){var Kr=l(Qr.content),Jr=Zt(),Wr=T(Jr);Ue(Jr,Kr,Wr),nr(Wr.tasks),document.title=Qr.title,setTimeout(function(){window.scrollTo(0,Qr.scroll)},0),Jt=ze,ce(re().body,"htmx:historyRestore",{path:ze,item:Qr})}else Q.config.refreshOnHistoryMiss?window.location.reload(!0):ir(ze)}function or(ze){var Qr=me(ze,"hx-indicator");return Qr==null&&(Qr=[ze]),oe(Qr,function(Kr){var Jr=ae(Kr);Jr.requestCount=(Jr.requestCount||0)+1,Kr.classList.add.call(Kr.classList,Q.config.requestClass)}),Qr}function sr(ze){var Qr=me(ze,"hx-disabled-elt");return Qr==null&&(Qr=[]),oe(Qr,function(Kr){var Jr=ae(Kr);Jr.requestCount=(Jr.requestCount||0)+1,Kr.setAttribute("disabled","")}),Qr}function lr(ze,Qr){oe(ze,function(Kr){var Jr=ae(Kr);Jr.requestCount=(Jr.requestCount||0)-1,Jr.requestCount===0&&Kr.classList.remove.call(Kr.classList,Q.config.requestClass)}),oe(Qr,function(Kr){var Jr=ae(Kr);Jr.requestCount=(Jr.requestCount||0)-1,Jr.requestCount===0&&Kr.removeAttribute("disabled")})}function ur(ze,Qr){for(var Kr=0;Kr<ze.length;Kr++){var Jr=ze[Kr];if(Jr.isSameNode(Qr))return!0}return!1}function fr(ze){return ze.name===""||ze.name==null||ze.disabled||v(ze,"fieldset[disabled]")||ze.type==="button"||ze.type==="submit"||ze.tagName==="image"||ze.tagName==="reset"||ze.tagName==="file"?!1:ze.type==="checkbox"||ze.type==="radio"?ze.checked:!0}function cr(ze,Qr,Kr){if(ze!=null&&Qr!=null){var Jr=Kr[ze];Jr===void 0?Kr[ze]=Qr:Array.isArray(Jr)?Array.isArray(Qr)?Kr[ze]=Jr.concat(Qr):Jr.push(Qr):Array.isArray(Qr)?Kr[ze]=[Jr].concat(Qr):Kr[ze]=[Jr,Qr]}}function hr(ze,Qr,Kr,Jr,Wr){if(!(Jr==null||ur(ze,Jr))){if(ze.push(Jr),fr(Jr)){var Yr=ee(Jr,"name"),Gr=Jr.value;Jr.multiple&&Jr.tagName==="SELECT"&&(Gr=M(Jr.querySelectorAll("option:checked")).map(function(en){return en.value})),Jr.files&&(Gr=M(Jr.files)),cr(Yr,Gr,Qr),Wr&&vr(Jr,Kr)}if(h(Jr,"form")){var Zr=Jr.elements;oe(Zr,function(en){hr(ze,Qr,Kr,en,Wr)})}}}function vr(ze,Qr){ze.willValidate&&(ce(ze,"htmx:validation:validate"),ze.checkValidity()||(Qr.push({elt:ze,message:ze.validationMessage,validity:ze.validity}),ce(ze,"htmx:validation:failed",{message:ze.validationMessage,validity:ze.validity})))}function dr(ze,Qr){var Kr=[],Jr={},Wr={},Yr=[],Gr=ae(ze);Gr.lastButtonClicked&&!se(Gr.lastButtonClicked)&&(Gr.lastButtonClicked=null);var Zr=h(ze,"form")&&ze.noValidate!==!0||te(ze,"hx-validate")==="true";if(Gr.lastButtonClicked&&(Zr=Zr&&Gr.lastButtonClicked.formNoValidate!==!0),Qr!=="get"&&hr(Kr,Wr,Yr,v(ze,"form"),Zr),hr(Kr,Jr,Yr,ze,Zr),Gr.lastButtonClicked||ze.tagName==="BUTTON"||ze.tagName==="INPUT"&&ee(ze,"type")==="submit"){var en=Gr.lastButtonClicked||ze,tn=ee(en,"name");cr(tn,en.value,Wr)}var rn=me(ze,"hx-include");return oe(rn,function(nn){hr(Kr,Jr,Yr,nn,Zr),h(nn,"form")||oe(nn.querySelectorAll(rt),function(an){hr(Kr,Jr,Yr,an,Zr)})}),Jr=le(Jr,Wr),{errors:Yr,values:Jr}}function gr(ze,Qr,Kr){ze!==""&&(ze+="&"),String(Kr)==="[object Object]"&&(Kr=JSON.stringify(Kr));var Jr=encodeURIComponent(Kr);return ze+=encodeURIComponent(Qr)+"="+Jr,ze}function pr(ze){var Qr="";for(var Kr in ze)if(ze.hasOwnProperty(Kr)){var Jr=ze[Kr];Array.isArray(Jr)?oe(Jr,function(Wr){Qr=gr(Qr,Kr,Wr)}):Qr=gr(Qr,Kr,Jr)}return Qr}function mr(ze){var Qr=new FormData;for(var Kr in ze)if(ze.hasOwnProperty(Kr)){var Jr=ze[Kr];Array.isArray(Jr)?oe(Jr,function(Wr){Qr.append(Kr,Wr)}):Qr.append(Kr,Jr)}return Qr}function xr(ze,Qr,Kr){var Jr={"HX-Request":"true","HX-Trigger":ee(ze,"id"),"HX-Trigger-Name":ee(ze,"name"),"HX-Target":te(Qr,"id"),"HX-Current-URL":re().location.href};return Rr(ze,"hx-headers",!1,Jr),Kr!==void 0&&(Jr["HX-Prompt"]=Kr),ae(ze).boosted&&(Jr["HX-Boosted"]="true"),Jr}function yr(ze,Qr){var Kr=ne(Qr,"hx-params");if(Kr){if(Kr==="none")return{};if(Kr==="*")return ze;if(Kr.indexOf("not ")===0)return oe(Kr.substr(4).split(","),function(Wr){Wr=Wr.trim(),delete ze[Wr]}),ze;var Jr={};return oe(Kr.split(","),function(Wr){Wr=Wr.trim(),Jr[Wr]=ze[Wr]}),Jr}else return ze}function br(ze){return ee(ze,"href")&&ee(ze,"href").indexOf("#")>=0}function wr(ze,Qr){var Kr=Qr||ne(ze,"hx-swap"),Jr={swapStyle:ae(ze).boosted?"innerHTML":Q.config.defaultSwapStyle,swapDelay:Q.config.defaultSwapDelay,settleDelay:Q.config.defaultSettleDelay};if(Q.config.scrollIntoViewOnBoost&&ae(ze).boosted&&!br(ze)&&(Jr.show="top"),Kr){var Wr=D(Kr);if(Wr.length>0)for(var Yr=0;Yr<Wr.length;Yr++){var Gr=Wr[Yr];if(Gr.indexOf("swap:")===0)Jr.swapDelay=d(Gr.substr(5));else if(Gr.indexOf("settle:")===0)Jr.settleDelay=d(Gr.substr(7));else if(Gr.indexOf("transition:")===0)Jr.transition=Gr.substr(11)==="true";else if(Gr.indexOf("ignoreTitle:")===0)Jr.ignoreTitle=Gr.substr(12)==="true";else if(Gr.indexOf("scroll:")===0){var Zr=Gr.substr(7),en=Zr.split(":"),tn=en.pop(),rn=en.length>0?en.join(":"):null;Jr.scroll=tn,Jr.scrollTarget=rn}else if(Gr.indexOf("show:")===0){var nn=Gr.substr(5),en=nn.split(":"),an=en.pop(),rn=en.length>0?en.join(":"):null;Jr.show=an,Jr.showTarget=rn}else if(Gr.indexOf("focus-scroll:")===0){var ln=Gr.substr(13);Jr.focusScroll=ln=="true"}else Yr==0?Jr.swapStyle=Gr:b("Unknown modifier in hx-swap: "+Gr)}}return Jr}function Sr(ze){return ne(ze,"hx-encoding")==="multipart/form-data"||h(ze,"form")&&ee(ze,"enctype")==="multipart/form-data"}function Er(ze,Qr,Kr){var Jr=null;return R(Qr,function(Wr){Jr==null&&(Jr=Wr.encodeParameters(ze,Kr,Qr))}),Jr??(Sr(Qr)?mr(Kr):pr(Kr))}function T(ze){return{tasks:[],elts:[ze]}}function Cr(ze,Qr){var Kr=ze[0],Jr=ze[ze.length-1];if(Qr.scroll){var Wr=null;Qr.scrollTarget&&(Wr=ue(Kr,Qr.scrollTarget)),Qr.scroll==="top"&&(Kr||Wr)&&(Wr=Wr||Kr,Wr.scrollTop=0),Qr.scroll==="bottom"&&(Jr||Wr)&&(Wr=Wr||Jr,Wr.scrollTop=Wr.scrollHeight)}if(Qr.show){var Wr=null;if(Qr.showTarget){var Yr=Qr.showTarget;Qr.showTarget==="window"&&(Yr="body"),Wr=ue(Kr,Yr)}Qr.show==="top"&&(Kr||Wr)&&(Wr=Wr||Kr,Wr.scrollIntoView({block:"start",behavior:Q.config.scrollBehavior})),Qr.show==="bottom"&&(Jr||Wr)&&(Wr=Wr||Jr,Wr.scrollIntoView({block:"end",behavior:Q.config.scrollBehavior}))}}function Rr(ze,Qr,Kr,Jr){if(Jr==null&&(Jr={}),ze==null)return Jr;var Wr=te(ze,Qr);if(Wr){var Yr=Wr.trim(),Gr=Kr;if(Yr==="unset")return null;Yr.indexOf("javascript:")===0?(Yr=Yr.substr(11),Gr=!0):Yr.indexOf("js:")===0&&(Yr=Yr.substr(3),Gr=!0),Yr.indexOf("{")!==0&&(Yr="{"+Yr+"}");var Zr;Gr?Zr=Tr(ze,function(){return Function("return ("+Yr+")")()},{}):Zr=E(Yr);for(var en in Zr)Zr.hasOwnProperty(en)&&Jr[en]==null&&(Jr[en]=Zr[en])}return Rr(u(ze),Qr,Kr,Jr)}function Tr(ze,Qr,Kr){return Q.config.allowEval?Qr():(fe(ze,"htmx:evalDisallowedError"),Kr)}function Or(ze,Qr){return Rr(ze,"hx-vars",!0,Qr)}function qr(ze,Qr){return Rr(ze,"hx-vals",!1,Qr)}function Hr(ze){return le(Or(ze),qr(ze))}function Lr(ze,Qr,Kr){if(Kr!==null)try{ze.setRequestHeader(Qr,Kr)}catch{ze.setRequestHeader(Qr,encodeURIComponent(Kr)),ze.setRequestHeader(Qr+"-URI-AutoEncoded","true")}}function Ar(ze){if(ze.responseURL&&typeof URL<"u")try{var Qr=new URL(ze.responseURL);return Qr.pathname+Qr.search}catch{fe(re().body,"htmx:badResponseUrl",{url:ze.responseURL})}}function O(ze,Qr){return Qr.test(ze.getAllResponseHeaders())}function Nr(ze,Qr,Kr){return ze=ze.toLowerCase(),Kr?Kr instanceof Element||I(Kr,"String")?he(ze,Qr,null,null,{targetOverride:p(Kr),returnPromise:!0}):he(ze,Qr,p(Kr.source),Kr.event,{handler:Kr.handler,headers:Kr.headers,values:Kr.values,targetOverride:p(Kr.target),swapOverride:Kr.swap,select:Kr.select,returnPromise:!0}):he(ze,Qr,null,null,{returnPromise:!0})}function Ir(ze){for(var Qr=[];ze;)Qr.push(ze),ze=ze.parentElement;return Qr}function kr(ze,Qr,Kr){var Jr,Wr;if(typeof URL=="function"){Wr=new URL(Qr,document.location.href);var Yr=document.location.origin;Jr=Yr===Wr.origin}else Wr=Qr,Jr=g(Qr,document.location.origin);return Q.config.selfRequestsOnly&&!Jr?!1:ce(ze,"htmx:validateUrl",le({url:Wr,sameHost:Jr},Kr))}function he(ze,Qr,Kr,Jr,Wr,Yr){var Gr=null,Zr=null;if(Wr=Wr??{},Wr.returnPromise&&typeof Promise<"u")var en=new Promise(function(En,Tn){Gr=En,Zr=Tn});Kr==null&&(Kr=re().body);var tn=Wr.handler||Mr,rn=Wr.select||null;if(!se(Kr))return ie(Gr),en;var nn=Wr.targetOverride||ye(Kr);if(nn==null||nn==pe)return fe(Kr,"htmx:targetError",{target:te(Kr,"hx-target")}),ie(Zr),en;var an=ae(Kr),ln=an.lastButtonClicked;if(ln){var pn=ee(ln,"formaction");pn!=null&&(Qr=pn);var _n=ee(ln,"formmethod");_n!=null&&_n.toLowerCase()!=="dialog"&&(ze=_n)}var sn=ne(Kr,"hx-confirm");if(Yr===void 0){var un=function(En){return he(ze,Qr,Kr,Jr,Wr,!!En)},cn={target:nn,elt:Kr,path:Qr,verb:ze,triggeringEvent:Jr,etc:Wr,issueRequest:un,question:sn};if(ce(Kr,"htmx:confirm",cn)===!1)return ie(Gr),en}var fn=Kr,dn=ne(Kr,"hx-sync"),vn=null,On=!1;if(dn){var mn=dn.split(":"),yn=mn[0].trim();if(yn==="this"?fn=xe(Kr,"hx-sync"):fn=ue(Kr,yn),dn=(mn[1]||"drop").trim(),an=ae(fn),dn==="drop"&&an.xhr&&an.abortable!==!0)return ie(Gr),en;if(dn==="abort"){if(an.xhr)return ie(Gr),en;On=!0}else if(dn==="replace")ce(fn,"htmx:abort");else if(dn.indexOf("queue")===0){var Rn=dn.split(" ");vn=(Rn[1]||"last").trim()}}if(an.xhr)if(an.abortable)ce(fn,"htmx:abort");else{if(vn==null){if(Jr){var bn=ae(Jr);bn&&bn.triggerSpec&&bn.triggerSpec.queue&&(vn=bn.triggerSpec.queue)}vn==null&&(vn="last")}return an.queuedRequests==null&&(an.queuedRequests=[]),vn==="first"&&an.queuedRequests.length===0?an.queuedRequests.push(function(){he(ze,Qr,Kr,Jr,Wr)}):vn==="all"?an.queuedRequests.push(function(){he(ze,Qr,Kr,Jr,Wr)}):vn==="last"&&(an.queuedRequests=[],an.queuedRequests.push(function(){he(ze,Qr,Kr,Jr,Wr)})),ie(Gr),en}var hn=new XMLHttpRequest;an.xhr=hn,an.abortable=On;var Sn=function(){if(an.xhr=null,an.abortable=!1,an.queuedRequests!=null&&an.queuedRequests.length>0){var En=an.queuedRequests.shift();En()}},Pn=ne(Kr,"hx-prompt");if(Pn){var Mn=prompt(Pn);if(Mn===null||!ce(Kr,"htmx:prompt",{prompt:Mn,target:nn}))return ie(Gr),Sn(),en}if(sn&&!Yr&&!confirm(sn))return ie(Gr),Sn(),en;var gn=xr(Kr,nn,Mn);ze!=="get"&&!Sr(Kr)&&(gn["Content-Type"]="application/x-www-form-urlencoded"),Wr.headers&&(gn=le(gn,Wr.headers));var Nn=dr(Kr,ze),In=Nn.errors,Cn=Nn.values;Wr.values&&(Cn=le(Cn,Wr.values));var zn=Hr(Kr),$n=le(Cn,zn),kn=yr($n,Kr);Q.config.getCacheBusterParam&&ze==="get"&&(kn["org.htmx.cache-buster"]=ee(nn,"id")||"true"),(Qr==null||Qr==="")&&(Qr=re().location.href);var Fn=Rr(Kr,"hx-request"),Qn=ae(Kr).boosted,Hn=Q.config.methodsThatUseUrlParams.indexOf(ze)>=0,wn={boosted:Qn,useUrlParams:Hn,parameters:kn,unfilteredParameters:$n,headers:gn,target:nn,verb:ze,errors:In,withCredentials:Wr.credentials||Fn.credentials||Q.config.withCredentials,timeout:Wr.timeout||Fn.timeout||Q.config.timeout,path:Qr,triggeringEvent:Jr};if(!ce(Kr,"htmx:configRequest",wn))return ie(Gr),Sn(),en;if(Qr=wn.path,ze=wn.verb,gn=wn.headers,kn=wn.parameters,In=wn.errors,Hn=wn.useUrlParams,In&&In.length>0)return ce(Kr,"htmx:validation:halted",wn),ie(Gr),Sn(),en;var Kn=Qr.split("#"),Vn=Kn[0],jn=Kn[1],An=Qr;if(Hn){An=Vn;var Xn=Object.keys(kn).length!==0;Xn&&(An.indexOf("?")<0?An+="?":An+="&",An+=pr(kn),jn&&(An+="#"+jn))}if(!kr(Kr,An,wn))return fe(Kr,"htmx:invalidPath",wn),ie(Zr),en;if(hn.open(ze.toUpperCase(),An,!0),hn.overrideMimeType("text/html"),hn.withCredentials=wn.withCredentials,hn.timeout=wn.timeout,!Fn.noHeaders){for(var Bn in gn)if(gn.hasOwnProperty(Bn)){var Jn=gn[Bn];Lr(hn,Bn,Jn)}}var xn={xhr:hn,target:nn,requestConfig:wn,etc:Wr,boosted:Qn,select:rn,pathInfo:{requestPath:Qr,finalRequestPath:An,anchor:jn}};if(hn.onload=function(){try{var En=Ir(Kr);if(xn.pathInfo.responsePath=Ar(hn),tn(Kr,xn),lr(qn,Dn),ce(Kr,"htmx:afterRequest",xn),ce(Kr,"htmx:afterOnLoad",xn),!se(Kr)){for(var Tn=null;En.length>0&&Tn==null;){var Ln=En.shift();se(Ln)&&(Tn=Ln)}Tn&&(ce(Tn,"htmx:afterRequest",xn),ce(Tn,"htmx:afterOnLoad",xn))}ie(Gr),Sn()}catch(Un){throw fe(Kr,"htmx:onLoadError",le({error:Un},xn)),Un}},hn.onerror=function(){lr(qn,Dn),fe(Kr,"htmx:afterRequest",xn),fe(Kr,"htmx:sendError",xn),ie(Zr),Sn()},hn.onabort=function(){lr(qn,Dn),fe(Kr,"htmx:afterRequest",xn),fe(Kr,"htmx:sendAbort",xn),ie(Zr),Sn()},hn.ontimeout=function(){lr(qn,Dn),fe(Kr,"htmx:afterRequest",xn),fe(Kr,"htmx:timeout",xn),ie(Zr),Sn()},!ce(Kr,"htmx:beforeRequest",xn))return ie(Gr),Sn(),en;var qn=or(Kr),Dn=sr(Kr);oe(["loadstart","loadend","progress","abort"],function(En){oe([hn,hn.upload],function(Tn){Tn.addEventListener(En,function(Ln){ce(Kr,"htmx:xhr:"+En,{lengthComputable:Ln.lengthComputable,loaded:Ln.loaded,total:Ln.total})})})}),ce(Kr,"htmx:beforeSend",xn);var Wn=Hn?null:Er(hn,Kr,kn);return hn.send(Wn),en}function Pr(ze,Qr){var Kr=Qr.xhr,Jr=null,Wr=null;if(O(Kr,/HX-Push:/i)?(Jr=Kr.getResponseHeader("HX-Push"),Wr="push"):O(Kr,/HX-Push-Url:/i)?(Jr=Kr.getResponseHeader("HX-Push-Url"),Wr="push"):O(Kr,/HX-Replace-Url:/i)&&(Jr=Kr.getResponseHeader("HX-Replace-Url"),Wr="replace"),Jr)return Jr==="false"?{}:{type:Wr,path:Jr};var Yr=Qr.pathInfo.finalRequestPath,Gr=Qr.pathInfo.responsePath,Zr=ne(ze,"hx-push-url"),en=ne(ze,"hx-replace-url"),tn=ae(ze).boosted,rn=null,nn=null;return Zr?(rn="push",nn=Zr):en?(rn="replace",nn=en):tn&&(rn="push",nn=Gr||Yr),nn?nn==="false"?{}:(nn==="true"&&(nn=Gr||Yr),Qr.pathInfo.anchor&&nn.indexOf("#")===-1&&(nn=nn+"#"+Qr.pathInfo.anchor),{type:rn,path:nn}):{}}function Mr(ze,Qr){var Kr=Qr.xhr,Jr=Qr.target,Wr=Qr.etc;Qr.requestConfig;var Yr=Qr.select;if(ce(ze,"htmx:beforeOnLoad",Qr)){if(O(Kr,/HX-Trigger:/i)&&_e(Kr,"HX-Trigger",ze),O(Kr,/HX-Location:/i)){er();var Gr=Kr.getResponseHeader("HX-Location"),Zr;Gr.indexOf("{")===0&&(Zr=E(Gr),Gr=Zr.path,delete Zr.path),Nr("GET",Gr,Zr).then(function(){tr(Gr)});return}var en=O(Kr,/HX-Refresh:/i)&&Kr.getResponseHeader("HX-Refresh")==="true";if(O(Kr,/HX-Redirect:/i)){location.href=Kr.getResponseHeader("HX-Redirect"),en&&location.reload();return}if(en){location.reload();return}O(Kr,/HX-Retarget:/i)&&(Kr.getResponseHeader("HX-Retarget")==="this"?Qr.target=ze:Qr.target=ue(ze,Kr.getResponseHeader("HX-Retarget")));var tn=Pr(ze,Qr),rn=Kr.status>=200&&Kr.status<400&&Kr.status!==204,nn=Kr.response,an=Kr.status>=400,ln=Q.config.ignoreTitle,pn=le({shouldSwap:rn,serverResponse:nn,isError:an,ignoreTitle:ln},Qr);if(ce(Jr,"htmx:beforeSwap",pn)){if(Jr=pn.target,nn=pn.serverResponse,an=pn.isError,ln=pn.ignoreTitle,Qr.target=Jr,Qr.failed=an,Qr.successful=!an,pn.shouldSwap){Kr.status===286&&at(ze),R(ze,function(mn){nn=mn.transformResponse(nn,Kr,ze)}),tn.type&&er();var _n=Wr.swapOverride;O(Kr,/HX-Reswap:/i)&&(_n=Kr.getResponseHeader("HX-Reswap"));var Zr=wr(ze,_n);Zr.hasOwnProperty("ignoreTitle")&&(ln=Zr.ignoreTitle),Jr.classList.add(Q.config.swappingClass);var sn=null,un=null,cn=function(){try{var mn=document.activeElement,yn={};try{yn={elt:mn,start:mn?mn.selectionStart:null,end:mn?mn.selectionEnd:null}}catch{}var Rn;Yr&&(Rn=Yr),O(Kr,/HX-Reselect:/i)&&(Rn=Kr.getResponseHeader("HX-Reselect")),tn.type&&(ce(re().body,"htmx:beforeHistoryUpdate",le({history:tn},Qr)),tn.type==="push"?(tr(tn.path),ce(re().body,"htmx:pushedIntoHistory",{path:tn.path})):(rr(tn.path),ce(re().body,"htmx:replacedInHistory",{path:tn.path})));var bn=T(Jr);if(je(Zr.swapStyle,Jr,ze,nn,bn,Rn),yn.elt&&!se(yn.elt)&&ee(yn.elt,"id")){var hn=document.getElementById(ee(yn.elt,"id")),Sn={preventScroll:Zr.focusScroll!==void 0?!Zr.focusScroll:!Q.config.defaultFocusScroll};if(hn){if(yn.start&&hn.setSelectionRange)try{hn.setSelectionRange(yn.start,yn.end)}catch{}hn.focus(Sn)}}if(Jr.classList.remove(Q.config.swappingClass),oe(bn.elts,function(gn){gn.classList&&gn.classList.add(Q.config.settlingClass),ce(gn,"htmx:afterSwap",Qr)}),O(Kr,/HX-Trigger-After-Swap:/i)){var Pn=ze;se(ze)||(Pn=re().body),_e(Kr,"HX-Trigger-After-Swap",Pn)}var Mn=function(){if(oe(bn.tasks,function(Cn){Cn.call()}),oe(bn.elts,function(Cn){Cn.classList&&Cn.classList.remove(Q.config.settlingClass),ce(Cn,"htmx:afterSettle",Qr)}),Qr.pathInfo.anchor){var gn=re().getElementById(Qr.pathInfo.anchor);gn&&gn.scrollIntoView({block:"start",behavior:"auto"})}if(bn.title&&!ln){var Nn=C("title");Nn?Nn.innerHTML=bn.title:window.document.title=bn.title}if(Cr(bn.elts,Zr),O(Kr,/HX-Trigger-After-Settle:/i)){var In=ze;se(ze)||(In=re().body),_e(Kr,"HX-Trigger-After-Settle",In)}ie(sn)};Zr.settleDelay>0?setTimeout(Mn,Zr.settleDelay):Mn()}catch(gn){throw fe(ze,"htmx:swapError",Qr),ie(un),gn}},fn=Q.config.globalViewTransitions;if(Zr.hasOwnProperty("transition")&&(fn=Zr.transition),fn&&ce(ze,"htmx:beforeTransition",Qr)&&typeof Promise<"u"&&document.startViewTransition){var dn=new Promise(function(mn,yn){sn=mn,un=yn}),vn=cn;cn=function(){document.startViewTransition(function(){return vn(),dn})}}Zr.swapDelay>0?setTimeout(cn,Zr.swapDelay):cn()}an&&fe(ze,"htmx:responseError",le({error:"Response Status Error Code "+Kr.status+" from "+Qr.pathInfo.requestPath},Qr))}}}var Xr={};function Dr(){return{init:function(ze){return null},onEvent:function(ze,Qr){return!0},transformResponse:function(ze,Qr,Kr){return ze},isInlineSwap:function(ze){return!1},handleSwap:function(ze,Qr,Kr,Jr){return!1},encodeParameters:function(ze,Qr,Kr){return null}}}function Ur(ze,Qr){Qr.init&&Qr.init(r),Xr[ze]=le(Dr(),Qr)}function Fr(ze){delete Xr[ze]}function Br(ze,Qr,Kr){if(ze==null)return Qr;Qr==null&&(Qr=[]),Kr==null&&(Kr=[]);var Jr=te(ze,"hx-ext");return Jr&&oe(Jr.split(","),function(Wr){if(Wr=Wr.replace(/ /g,""),Wr.slice(0,7)=="ignore:"){Kr.push(Wr.slice(7));return}if(Kr.indexOf(Wr)<0){var Yr=Xr[Wr];Yr&&Qr.indexOf(Yr)<0&&Qr.push(Yr)}}),Br(u(ze),Qr,Kr)}var Vr=!1;re().addEventListener("DOMContentLoaded",function(){Vr=!0});function jr(ze){Vr||re().readyState==="complete"?ze():re().addEventListener("DOMContentLoaded",ze)}function _r(){Q.config.includeIndicatorStyles!==!1&&re().head.insertAdjacentHTML("beforeend","<style>                      ."+Q.config.indicatorClass+"{opacity:0}                      ."+Q.config.requestClass+" ."+Q.config.indicatorClass+"{opacity:1; transition: opacity 200ms ease-in;}                      ."+Q.config.requestClass+"."+Q.config.indicatorClass+"{opacity:1; transition: opacity 200ms ease-in;}                    </style>")}function zr(){var ze=re().querySelector('meta[name="htmx-config"]');return ze?E(ze.content):null}function $r(){var ze=zr();ze&&(Q.config=le(Q.config,ze))}return jr(function(){$r(),_r();var ze=re().body;zt(ze);var Qr=re().querySelectorAll("[hx-trigger='restored'],[data-hx-trigger='restored']");ze.addEventListener("htmx:abort",function(Jr){var Wr=Jr.target,Yr=ae(Wr);Yr&&Yr.xhr&&Yr.xhr.abort()});const Kr=window.onpopstate?window.onpopstate.bind(window):null;window.onpopstate=function(Jr){Jr.state&&Jr.state.htmx?(ar(),oe(Qr,function(Wr){ce(Wr,"htmx:restored",{document:re(),triggerEvent:ce})})):Kr&&Kr(Jr)},setTimeout(function(){ce(ze,"htmx:load",{}),ze=null},0)}),Q}()})})(htmx_min);var htmx_minExports=htmx_min.exports;const htmx=getDefaultExportFromCjs(htmx_minExports);window.Alpine=module_default;window.htmx=htmx;const authToken=document.querySelector('meta[name="gomon-token"]')?.content||"",authHeaders=()=>authToken?{Authorization:"Bearer "+authToken}:{},withToken=ze=>authToken?ze+(ze.includes("?")?"&":"?")+"token="+encodeURIComponent(authToken):ze;document.addEventListener("htmx:configRequest",ze=>{Object.assign(ze.detail.headers,authHeaders())});document.addEventListener("htmx:afterSwap",ze=>{const Qr=ze.target.querySelector("[data-timeline]");Qr&&renderTimeline(Qr);const Kr=ze.target.querySelector("[data-resources]");Kr&&renderResources(Kr)});const paneStreams={11:"stdout",12:"stderr"},paneQueues={stdout:[],stderr:[]};let paneSeq=0;const maxPausedEvents=1e4;module_default.data("search",()=>({searchText:"",runId:"all",level:"",isShowingSearchResults:!1,isShowingConnectionError:!1,eventQueue:[],toastTimeout:null,zoomContent:"",cursorId:"",selectionStart:"",selectionEnd:"",selectionMessage:"",isSplit:!1,pausedPanes:{stdout:!1,stderr:!1},isPaused:!1,isPausedByScroll:!1,isPauseOverflowed:!1,isProfiling:!1,profileMessage:"",init:function(){console.log("init"),this.$watch("searchText",ze=>{this.onSearchTextChanged(ze)}),this.$watch("runId",ze=>{this.onRunIdChanged(ze)}),this.$watch("level",ze=>{this.onLevelChanged(ze)}),this.$watch("isShowingSearchResults",ze=>{this.onIsShowingSearchResults(ze)}),document.addEventListener("htmx:afterSwap",ze=>{this.isSplit&&ze.target.id==="log-output-inner"&&this.rebuildPanes()}),this.connect()},connect:function(){const ze=new URL("/ws",window.location.href);ze.protocol=ze.protocol.replace("http","ws"),authToken&&ze.searchParams.set("token",authToken);let Qr=!1;const ni=new WebSocket(ze);ni.onopen=()=>{Qr=!0},ni.onmessage=Ui=>{this.handleEventSourceMessage(Ui)},ni.onclose=()=>{if(!Qr){this.connectEventSource();return}this.handleEventSourceError(),setTimeout(()=>this.connect(),1e3)}},connectEventSource:function(){const ze=new EventSource(withToken("/sse?stream=events"),{withCredentials:!1});ze.onmessage=Qr=>{this.handleEventSourceMessage(Qr)},ze.onerror=()=>{this.handleEventSourceError()}},onSearchTextChanged:function(ze){if(ze.length===0&&this.level===""){this.isShowingSearchResults=!1;return}this.isShowingSearchResults=!0,this.onClickSearch()},onRunIdChanged:function(ze){if(document.body.dispatchEvent(new Event("runSelected")),ze==="all"&&this.level===""){this.isShowingSearchResults=!1,this.searchText="";return}this.isShowingSearchResults=!0,(this.searchText.length>0||this.level!=="")&&this.onClickSearch()},onLevelChanged:function(ze){this.isShowingSearchResults=ze!==""||this.runId!=="all"||this.searchText.length>0,this.onClickSearch()},onIsShowingSearchResults:function(ze){console.log("isShowingSearchResults changed",ze),ze||this.flushEventQueue()},flushEventQueue:function(){if(!(this.isPaused||this.isShowingSearchResults)){if(this.isPauseOverflowed){this.isPauseOverflowed=!1,this.eventQueue=[],this.onClickSearch();return}for(;this.eventQueue.length>0;){const ze=this.eventQueue.shift();this.processEvent(ze)}}},onSearchTextKeyDown:function(ze){ze.key==="Enter"&&this.onClickSearch()},onClickSearch:function(){const ze=document.querySelector("#log-output-inner");if(!ze)throw new Error("Target element not found");const Qr=new CustomEvent("custom:search",{detail:{key:"value"}});ze.dispatchEvent(Qr)},handleEventSourceMessage:function(ze){if(this.isShowingSearchResults){this.eventQueue.push(ze);return}if(this.isPaused){if(!JSON.parse(ze.data).live){this.processEvent(ze);return}this.eventQueue.length>=maxPausedEvents&&(this.isPauseOverflowed=!0,this.eventQueue=[]),this.eventQueue.push(ze);return}this.processEvent(ze)},handleEventSourceError:function(){console.log("sse error"),this.isShowingConnectionError=!0,this.toastTimeout&&clearTimeout(this.toastTimeout),this.toastTimeout=setTimeout(()=>{this.isShowingConnectionError=!1},5e3)},processEvent:function(ze){var Kr;const Qr=JSON.parse(ze.data);if(swap(Qr),Qr.live){const Wr=document.getElementById("log-output");scrollPane(Wr,Wr.scrollHeight)}if(this.isSplit&&Qr.swap.startsWith("beforeend")){const Jr=(Kr=document.querySelector(Qr.target))==null?void 0:Kr.lastElementChild;Jr&&this.addToPanes([Jr])}},jump:async function(ze,Qr){const Kr=new URLSearchParams({marker:ze,dir:Qr,from:this.cursorId}),Jr=await fetch("/actions/jump?"+Kr.toString(),{headers:authHeaders()});if(Jr.status!==200)return;const Wr=Jr.headers.get("X-Gomon-Event-Id")||"",Yr=document.querySelector("#log-output-inner");this.isShowingSearchResults=!0,Yr.innerHTML=await Jr.text(),this.cursorId=Wr;const Gr=Yr.querySelector(` + "`" + `[data-event-id="${Wr}"]` + "`" + `);Gr==null||Gr.classList.add("marker-highlight");if(Gr==null||Gr.scrollIntoView({block:"center",behavior:"instant"}),this.isSplit){this.rebuildPanes();const Zr=document.querySelector(` + "`" + `#split-output [data-event-id="${Wr}"]` + "`" + `);Zr==null||Zr.scrollIntoView({block:"center",behavior:"instant"})}},pause:function(ze){this.isPaused=!0,this.isPausedByScroll=ze},resume:function(){this.isPaused=!1,this.isPausedByScroll=!1,this.flushEventQueue();const ze=document.getElementById("log-output");scrollPane(ze,ze.scrollHeight)},togglePaused:function(){this.isPaused?this.resume():this.pause(!1)},onOutputScroll:function(){const ze=document.getElementById("log-output");if(this.isShowingSearchResults||Math.abs(ze.scrollTop-Number(ze.dataset.autoScrollTop))<1)return;const Qr=isScrolledToEnd(ze);!Qr&&!this.isPaused?this.pause(!0):Qr&&this.isPausedByScroll&&this.resume()},pausedLabel:function(){if(this.isPauseOverflowed)return"Paused, more than "+maxPausedEvents+" new events";const ze=this.eventQueue.length;return"Paused, "+ze+(ze===1?" new event":" new events")},goLive:function(){this.clearSelection(),this.cursorId="",this.searchText="",this.runId="all",this.level="",this.isPaused=!1,this.isPausedByScroll=!1,this.isPauseOverflowed=!1,this.eventQueue=[],this.isShowingSearchResults=!1,this.onClickSearch()},onGlobalKeyDown:function(ze){const Qr=ze.target;if(!(["INPUT","SELECT","TEXTAREA"].includes(Qr.tagName)||ze.ctrlKey||ze.metaKey||ze.altKey))switch(ze.key){case"c":this.jump("crash","prev");break;case"C":this.jump("crash","next");break;case"s":this.jump("startup","prev");break;case"S":this.jump("startup","next");break;case"e":this.jump("error","prev");break;case"p":this.togglePaused();break;case"l":case"Escape":this.goLive();break}},onZoomEntry:function(ze){var Wr,Yr;const Kr=(Yr=(Wr=ze.target.closest(".log-entry"))==null?void 0:Wr.querySelector(".log-text"))==null?void 0:Yr.textContent;try{const Gr=JSON.parse(Kr||"");this.zoomContent=JSON.stringify(Gr,null,2)}catch{this.zoomContent=Kr||""}document.getElementById("zoom-dialog").showModal()},onLogClick:function(ze){var Wr;if(!ze.shiftKey)return;const Kr=ze.target.closest("[data-event-id]");if(!Kr)return;ze.preventDefault(),(Wr=window.getSelection())==null||Wr.removeAllRanges();const Yr=Kr.dataset.eventId||"";this.selectionStart||(this.selectionStart=Yr),this.selectionEnd=Yr,this.selectionMessage="",this.highlightSelection()},highlightSelection:function(){const ze=Array.from(document.querySelectorAll("#log-output-inner [data-event-id]")),Wr=ze.map(Gr=>Gr.dataset.eventId);let Kr=Wr.indexOf(this.selectionStart),Yr=Wr.indexOf(this.selectionEnd);Kr>Yr&&([Kr,Yr]=[Yr,Kr]),ze.forEach((Gr,Xr)=>{Gr.classList.toggle("selected-entry",Kr>=0&&Xr>=Kr&&Xr<=Yr)})},selectionParams:function(){return new URLSearchParams({from:this.selectionStart,to:this.selectionEnd})},copySelection:async function(ze){const Wr=this.selectionParams();Wr.set("format",ze);const Kr=await fetch("/actions/range?"+Wr.toString(),{headers:authHeaders()});if(Kr.status!==200){this.selectionMessage="Copy failed";return}await navigator.clipboard.writeText(await Kr.text()),this.selectionMessage="Copied to clipboard"},openSelectionInEditor:async function(){const ze=await fetch("/actions/scratch?"+this.selectionParams().toString(),{method:"POST",headers:authHeaders()});if(ze.status!==200){this.selectionMessage="Could not write scratch file";return}const Wr=await ze.json();this.selectionMessage="Saved to "+Wr.path,window.location.href=Wr.url},captureProfile:async function(){this.isProfiling=!0,this.profileMessage="";try{const ze=await fetch("/actions/profile",{method:"POST",headers:authHeaders()}),Qr=await ze.json();this.profileMessage=ze.status===200?"Saved to "+Qr.path:Qr.error}catch{this.profileMessage="Profile failed"}finally{this.isProfiling=!1}},clearSelection:function(){this.selectionStart="",this.selectionEnd="",this.selectionMessage="",this.highlightSelection()},selectRun:function(ze){document.getElementById("timeline-dialog").close(),this.runId=ze,this.isShowingSearchResults=!0,this.onClickSearch()},toggleSplit:function(){this.isSplit=!this.isSplit,this.isSplit&&this.rebuildPanes()},rebuildPanes:function(){for(const Qr of Object.values(paneStreams))this.clearPane(Qr);const ze=Array.from(document.querySelectorAll("#log-output-inner [data-event-type]"));this.addToPanes(ze)},addToPanes:function(ze){for(const Qr of ze){const Kr=paneStreams[Qr.dataset.eventType||""];if(!Kr)continue;const Jr=Qr.cloneNode(!0);if(Jr.dataset.seq=String(paneSeq++),this.pausedPanes[Kr]){paneQueues[Kr].push(Jr);continue}appendToPane(Kr,[Jr])}},togglePause:function(ze){this.pausedPanes[ze]=!this.pausedPanes[ze],this.pausedPanes[ze]||appendToPane(ze,paneQueues[ze].splice(0))},clearPane:function(ze){var Qr;paneQueues[ze]=[],(Qr=document.getElementById(ze+"-pane"))==null||Qr.replaceChildren()},onPaneScroll:function(ze){const Qr=document.getElementById(ze+"-pane");if(Math.abs(Qr.scrollTop-Number(Qr.dataset.autoScrollTop))<1)return;const Kr=document.getElementById((ze==="stdout"?"stderr":"stdout")+"-pane");syncPane(Qr,Kr)}}));module_default.start();const formatBytes=ze=>(ze/(1024*1024)).toFixed(1)+"MB",resourceMetrics=[{name:"Memory (RSS)",value:ze=>ze.rss,format:formatBytes},{name:"CPU",value:ze=>ze.cpu,format:ze=>ze.toFixed(1)+"%"},{name:"Heap",value:ze=>ze.heapAlloc,format:formatBytes},{name:"Goroutines",value:ze=>ze.goroutines,format:ze=>String(Math.round(ze))}];function renderResources(ze){const Qr=JSON.parse(ze.dataset.resources||"[]");ze.replaceChildren();for(const Kr of resourceMetrics){let Jr=0,Wr=!1;for(const Gr of Qr)for(const Zr of Gr.samples){const en=Kr.value(Zr);en!=null&&(Wr=!0,Jr=Math.max(Jr,en))}if(!Wr)continue;const Yr=document.createElement("section");Yr.className="resources-metric";const Xr=document.createElement("h3");Xr.textContent=` + "`" + `${Kr.name}, peak ${Kr.format(Jr)}` + "`" + `,Yr.appendChild(Xr);for(const Gr of Qr){const Zr=Gr.samples.map(ln=>Kr.value(ln)??0),en=document.createElement("div");en.className="resources-row";const tn=document.createElement("div");tn.className="resources-label",tn.textContent=new Date(Gr.samples[0].date).toLocaleTimeString(),en.appendChild(tn);const rn=document.createElementNS("http://www.w3.org/2000/svg","svg");rn.setAttribute("class","resources-chart"),rn.setAttribute("viewBox","0 0 100 20"),rn.setAttribute("preserveAspectRatio","none");const nn=document.createElementNS("http://www.w3.org/2000/svg","polyline"),on=Zr.length>1?100/(Zr.length-1):0;nn.setAttribute("points",Zr.map((ln,cn)=>` + "`" + `${cn*on},${20-(Jr>0?ln/Jr*20:0)}` + "`" + `).join(" ")),nn.setAttribute("vector-effect","non-scaling-stroke"),rn.appendChild(nn),en.appendChild(rn);const an=document.createElement("div");an.className="resources-value",an.textContent=Kr.format(Zr[Zr.length-1]),an.title="peak "+Kr.format(Math.max(...Zr)),en.appendChild(an),Yr.appendChild(en)}ze.appendChild(Yr)}}function isScrolledToEnd(ze){return ze.scrollHeight-ze.scrollTop-ze.clientHeight<4}function scrollPane(ze,Qr){ze.scrollTop=Qr,ze.dataset.autoScrollTop=String(ze.scrollTop)}function appendToPane(ze,Qr){const Kr=document.getElementById(ze+"-pane"),Jr=isScrolledToEnd(Kr);Kr.append(...Qr),Jr&&scrollPane(Kr,Kr.scrollHeight)}function syncPane(ze,Qr){if(isScrolledToEnd(ze)){scrollPane(Qr,Qr.scrollHeight);return}const Kr=ze.getBoundingClientRect().top,Wr=Array.from(ze.children).find(Gr=>Gr.getBoundingClientRect().bottom>Kr);if(!Wr)return;const Yr=Number(Wr.dataset.seq),Jr=Array.from(Qr.children).find(Gr=>Number(Gr.dataset.seq)>=Yr);scrollPane(Qr,Jr?Jr.offsetTop:Qr.scrollHeight)}function renderTimeline(ze){const Qr=JSON.parse(ze.dataset.timeline||"[]");if(Qr.length===0)return;const Kr=Zr=>new Date(Zr).getTime(),Jr=Zr=>new Date(Zr).toLocaleTimeString(),Wr=Qr[Qr.length-1],Yr=Zr=>Zr===Wr&&!Zr.ended?Date.now():Kr(Zr.end),Gr=Kr(Qr[0].start),en=Math.max(Yr(Wr)-Gr,1),tn=Zr=>(Zr-Gr)/en*100+"%",rn=document.createElement("div");rn.className="timeline-axis";for(const Zr of[Gr,Gr+en]){const nn=document.createElement("span");nn.textContent=Jr(Zr),rn.appendChild(nn)}ze.replaceChildren(rn);for(const Zr of Qr){const nn=Kr(Zr.start),an=document.createElement("div");an.className="timeline-row",an.setAttribute("x-on:click",` + "`" + `selectRun('${Zr.id}')` + "`" + `);const on=document.createElement("div");on.className="timeline-label",on.textContent=Jr(nn)+(Zr.errors>0?` + "`" + ` (${Zr.errors} errors)` + "`" + `:""),an.appendChild(on);const sn=document.createElement("div");sn.className="timeline-track";const ln=document.createElement("div");ln.className="timeline-bar "+(Zr.crashed?"timeline-crashed":Zr.ended?"timeline-stopped":"timeline-running"),ln.style.left=tn(nn),ln.style.width=(Yr(Zr)-nn)/en*100+"%",ln.title=[Zr.reason||"",Math.round((Yr(Zr)-nn)/1e3)+"s"].filter(un=>un).join(", "),sn.appendChild(ln);for(const un of Zr.markers){const cn=document.createElement("div");cn.className="timeline-marker timeline-"+un.kind,cn.style.left=tn(Kr(un.date)),cn.title=Jr(Kr(un.date))+" "+un.message,sn.appendChild(cn)}an.appendChild(sn),ze.appendChild(an)}}function swap(ze){var Zr,en,tn,rn,nn,an;const Un=document.querySelector("#label-select");if(ze.target==="#search-select"&&Un&&Un.value!=="")return;const Qr=document.querySelector(ze.target);if(!Qr)throw new Error(` + "`" + `Target element not found: ${ze.target}/${ze.id}` + "`" + `);const Kr=ze.swap.split(" "),Jr=Kr[0],Wr=Kr.length>1?Kr[1]:"",Yr=document.createRange();Yr.selectNode(Qr);const Gr=Yr.createContextualFragment(ze.markup);const hxParent=Qr.parentElement;switch(Jr){case"innerHTML":for(;Qr.firstChild;)Qr.removeChild(Qr.firstChild);Qr.appendChild(Gr);break;case"outerHTML":(Zr=Qr.parentNode)==null||Zr.replaceChild(Gr,Qr);break;case"beforebegin":(en=Qr.parentNode)==null||en.insertBefore(Gr,Qr);break;case"afterbegin":Qr.insertBefore(Gr,Qr.firstChild);break;case"beforeend":Qr.appendChild(Gr);break;case"afterend":(tn=Qr.parentNode)==null||tn.insertBefore(Gr,Qr.nextSibling);break}if(ze.markup.includes("hx-")){const hxSwapped=Jr==="innerHTML"||Jr==="afterbegin"||Jr==="beforeend"?Qr:hxParent;hxSwapped&&htmx.process(hxSwapped)}if(Wr){const ln=Wr.split(":"),pn=ln[0],_n=ln[1];switch(pn){case"scroll":switch(_n){case"view":(rn=Gr.firstChild)==null||rn.scrollIntoView();break;case"lastchild":(nn=Qr.lastChild)==null||nn.scrollIntoView({block:"end",behavior:"instant"});break;case"nextsibling":(an=Qr.nextSibling)==null||an.scrollIntoView({block:"end",behavior:"instant"});break}break}}}
 //# sourceMappingURL=data:application/json;charset=utf-8;base64,eyJ2ZXJzaW9uIjozLCJmaWxlIjoibWFpbi5qcyIsInNvdXJjZXMiOlsiLi4vbm9kZV9tb2R1bGVzLy5wbnBtL2FscGluZWpzQDMuMTMuNy9ub2RlX21vZHVsZXMvYWxwaW5lanMvZGlzdC9tb2R1bGUuZXNtLmpzIiwiLi4vbm9kZV9tb2R1bGVzLy5wbnBtL2h0bXgub3JnQDEuOS4xMS9ub2RlX21vZHVsZXMvaHRteC5vcmcvZGlzdC9odG14Lm1pbi5qcyIsIi4uL21haW4udHMiXSwic291cmNlc0NvbnRlbnQiOlsiLy8gcGFja2FnZXMvYWxwaW5lanMvc3JjL3NjaGVkdWxlci5qc1xudmFyIGZsdXNoUGVuZGluZyA9IGZhbHNlO1xudmFyIGZsdXNoaW5nID0gZmFsc2U7XG52YXIgcXVldWUgPSBbXTtcbnZhciBsYXN0Rmx1c2hlZEluZGV4ID0gLTE7XG5mdW5jdGlvbiBzY2hlZHVsZXIoY2FsbGJhY2spIHtcbiAgcXVldWVKb2IoY2FsbGJhY2spO1xufVxuZnVuY3Rpb24gcXVldWVKb2Ioam9iKSB7XG4gIGlmICghcXVldWUuaW5jbHVkZXMoam9iKSlcbiAgICBxdWV1ZS5wdXNoKGpvYik7XG4gIHF1ZXVlRmx1c2goKTtcbn1cbmZ1bmN0aW9uIGRlcXVldWVKb2Ioam9iKSB7XG4gIGxldCBpbmRleCA9IHF1ZXVlLmluZGV4T2Yoam9iKTtcbiAgaWYgKGluZGV4ICE9PSAtMSAmJiBpbmRleCA+IGxhc3RGbHVzaGVkSW5kZXgpXG4gICAgcXVldWUuc3BsaWNlKGluZGV4LCAxKTtcbn1cbmZ1bmN0aW9uIHF1ZXVlRmx1c2goKSB7XG4gIGlmICghZmx1c2hpbmcgJiYgIWZsdXNoUGVuZGluZykge1xuICAgIGZsdXNoUGVuZGluZyA9IHRydWU7XG4gICAgcXVldWVNaWNyb3Rhc2soZmx1c2hKb2JzKTtcbiAgfVxufVxuZnVuY3Rpb24gZmx1c2hKb2JzKCkge1xuICBmbHVzaFBlbmRpbmcgPSBmYWxzZTtcbiAgZmx1c2hpbmcgPSB0cnVlO1xuICBmb3IgKGxldCBpID0gMDsgaSA8IHF1ZXVlLmxlbmd0aDsgaSsrKSB7XG4gICAgcXVldWVbaV0oKTtcbiAgICBsYXN0Rmx1c2hlZEluZGV4ID0gaTtcbiAgfVxuICBxdWV1ZS5sZW5ndGggPSAwO1xuICBsYXN0Rmx1c2hlZEluZGV4ID0gLTE7XG4gIGZsdXNoaW5nID0gZmFsc2U7XG59XG5cbi8vIHBhY2thZ2VzL2FscGluZWpzL3NyYy9yZWFjdGl2aXR5LmpzXG52YXIgcmVhY3RpdmU7XG52YXIgZWZmZWN0O1xudmFyIHJlbGVhc2U7XG52YXIgcmF3O1xudmFyIHNob3VsZFNjaGVkdWxlID0gdHJ1ZTtcbmZ1bmN0aW9uIGRpc2FibGVFZmZlY3RTY2hlZHVsaW5nKGNhbGxiYWNrKSB7XG4gIHNob3VsZFNjaGVkdWxlID0gZmFsc2U7XG4gIGNhbGxiYWNrKCk7XG4gIHNob3VsZFNjaGVkdWxlID0gdHJ1ZTtcbn1cbmZ1bmN0aW9uIHNldFJlYWN0aXZpdHlFbmdpbmUoZW5naW5lKSB7XG4gIHJlYWN0aXZlID0gZW5naW5lLnJlYWN0aXZlO1xuICByZWxlYXNlID0gZW5naW5lLnJlbGVhc2U7XG4gIGVmZmVjdCA9IChjYWxsYmFjaykgPT4gZW5naW5lLmVmZmVjdChjYWxsYmFjaywgeyBzY2hlZHVsZXI6ICh0YXNrKSA9PiB7XG4gICAgaWYgKHNob3VsZFNjaGVkdWxlKSB7XG4gICAgICBzY2hlZHVsZXIodGFzayk7XG4gICAgfSBlbHNlIHtcbiAgICAgIHRhc2soKTtcbiAgICB9XG4gIH0gfSk7XG4gIHJhdyA9IGVuZ2luZS5yYXc7XG59XG5mdW5jdGlvbiBvdmVycmlkZUVmZmVjdChvdmVycmlkZSkge1xuICBlZmZlY3QgPSBvdmVycmlkZTtcbn1cbmZ1bmN0aW9uIGVsZW1lbnRCb3VuZEVmZmVjdChlbCkge1xuICBsZXQgY2xlYW51cDIgPSAoKSA9PiB7XG4gIH07XG4gIGxldCB3cmFwcGVkRWZmZWN0ID0gKGNhbGxiYWNrKSA9PiB7XG4gICAgbGV0IGVmZmVjdFJlZmVyZW5jZSA9IGVmZmVjdChjYWxsYmFjayk7XG4gICAgaWYgKCFlbC5feF9lZmZlY3RzKSB7XG4gICAgICBlbC5feF9lZmZlY3RzID0gLyogQF9fUFVSRV9fICovIG5ldyBTZXQoKTtcbiAgICAgIGVsLl94X3J1bkVmZmVjdHMgPSAoKSA9PiB7XG4gICAgICAgIGVsLl94X2VmZmVjdHMuZm9yRWFjaCgoaSkgPT4gaSgpKTtcbiAgICAgIH07XG4gICAgfVxuICAgIGVsLl94X2VmZmVjdHMuYWRkKGVmZmVjdFJlZmVyZW5jZSk7XG4gICAgY2xlYW51cDIgPSAoKSA9PiB7XG4gICAgICBpZiAoZWZmZWN0UmVmZXJlbmNlID09PSB2b2lkIDApXG4gICAgICAgIHJldHVybjtcbiAgICAgIGVsLl94X2VmZmVjdHMuZGVsZXRlKGVmZmVjdFJlZmVyZW5jZSk7XG4gICAgICByZWxlYXNlKGVmZmVjdFJlZmVyZW5jZSk7XG4gICAgfTtcbiAgICByZXR1cm4gZWZmZWN0UmVmZXJlbmNlO1xuICB9O1xuICByZXR1cm4gW3dyYXBwZWRFZmZlY3QsICgpID0+IHtcbiAgICBjbGVhbnVwMigpO1xuICB9XTtcbn1cbmZ1bmN0aW9uIHdhdGNoKGdldHRlciwgY2FsbGJhY2spIHtcbiAgbGV0IGZpcnN0VGltZSA9IHRydWU7XG4gIGxldCBvbGRWYWx1ZTtcbiAgbGV0IGVmZmVjdFJlZmVyZW5jZSA9IGVmZmVjdCgoKSA9PiB7XG4gICAgbGV0IHZhbHVlID0gZ2V0dGVyKCk7XG4gICAgSlNPTi5zdHJpbmdpZnkodmFsdWUpO1xuICAgIGlmICghZmlyc3RUaW1lKSB7XG4gICAgICBxdWV1ZU1pY3JvdGFzaygoKSA9PiB7XG4gICAgICAgIGNhbGxiYWNrKHZhbHVlLCBvbGRWYWx1ZSk7XG4gICAgICAgIG9sZFZhbHVlID0gdmFsdWU7XG4gICAgICB9KTtcbiAgICB9IGVsc2Uge1xuICAgICAgb2xkVmFsdWUgPSB2YWx1ZTtcbiAgICB9XG4gICAgZmlyc3RUaW1lID0gZmFsc2U7XG4gIH0pO1xuICByZXR1cm4gKCkgPT4gcmVsZWFzZShlZmZlY3RSZWZlcmVuY2UpO1xufVxuXG4vLyBwYWNrYWdlcy9hbHBpbmVqcy9zcmMvdXRpbHMvZGlzcGF0Y2guanNcbmZ1bmN0aW9uIGRpc3BhdGNoKGVsLCBuYW1lLCBkZXRhaWwgPSB7fSkge1xuICBlbC5kaXNwYXRjaEV2ZW50KFxuICAgIG5ldyBDdXN0b21FdmVudChuYW1lLCB7XG4gICAgICBkZXRhaWwsXG4gICAgICBidWJibGVzOiB0cnVlLFxuICAgICAgLy8gQWxsb3dzIGV2ZW50cyB0byBwYXNzIHRoZSBzaGFkb3cgRE9NIGJhcnJpZXIuXG4gICAgICBjb21wb3NlZDogdHJ1ZSxcbiAgICAgIGNhbmNlbGFibGU6IHRydWVcbiAgICB9KVxuICApO1xufVxuXG4vLyBwYWNrYWdlcy9hbHBpbmVqcy9zcmMvdXRpbHMvd2Fsay5qc1xuZnVuY3Rpb24gd2FsayhlbCwgY2FsbGJhY2spIHtcbiAgaWYgKHR5cGVvZiBTaGFkb3dSb290ID09PSBcImZ1bmN0aW9uXCIgJiYgZWwgaW5zdGFuY2VvZiBTaGFkb3dSb290KSB7XG4gICAgQXJyYXkuZnJvbShlbC5jaGlsZHJlbikuZm9yRWFjaCgoZWwyKSA9PiB3YWxrKGVsMiwgY2FsbGJhY2spKTtcbiAgICByZXR1cm47XG4gIH1cbiAgbGV0IHNraXAgPSBmYWxzZTtcbiAgY2FsbGJhY2soZWwsICgpID0+IHNraXAgPSB0cnVlKTtcbiAgaWYgKHNraXApXG4gICAgcmV0dXJuO1xuICBsZXQgbm9kZSA9IGVsLmZpcnN0RWxlbWVudENoaWxkO1xuICB3aGlsZSAobm9kZSkge1xuICAgIHdhbGsobm9kZSwgY2FsbGJhY2ssIGZhbHNlKTtcbiAgICBub2RlID0gbm9kZS5uZXh0RWxlbWVudFNpYmxpbmc7XG4gIH1cbn1cblxuLy8gcGFja2FnZXMvYWxwaW5lanMvc3JjL3V0aWxzL3dhcm4uanNcbmZ1bmN0aW9uIHdhcm4obWVzc2FnZSwgLi4uYXJncykge1xuICBjb25zb2xlLndhcm4oYEFscGluZSBXYXJuaW5nOiAke21lc3NhZ2V9YCwgLi4uYXJncyk7XG59XG5cbi8vIHBhY2thZ2VzL2FscGluZWpzL3NyYy9saWZlY3ljbGUuanNcbnZhciBzdGFydGVkID0gZmFsc2U7XG5mdW5jdGlvbiBzdGFydCgpIHtcbiAgaWYgKHN0YXJ0ZWQpXG4gICAgd2FybihcIkFscGluZSBoYXMgYWxyZWFkeSBiZWVuIGluaXRpYWxpemVkIG9uIHRoaXMgcGFnZS4gQ2FsbGluZyBBbHBpbmUuc3RhcnQoKSBtb3JlIHRoYW4gb25jZSBjYW4gY2F1c2UgcHJvYmxlbXMuXCIpO1xuICBzdGFydGVkID0gdHJ1ZTtcbiAgaWYgKCFkb2N1bWVudC5ib2R5KVxuICAgIHdhcm4oXCJVbmFibGUgdG8gaW5pdGlhbGl6ZS4gVHJ5aW5nIHRvIGxvYWQgQWxwaW5lIGJlZm9yZSBgPGJvZHk+YCBpcyBhdmFpbGFibGUuIERpZCB5b3UgZm9yZ2V0IHRvIGFkZCBgZGVmZXJgIGluIEFscGluZSdzIGA8c2NyaXB0PmAgdGFnP1wiKTtcbiAgZGlzcGF0Y2goZG9jdW1lbnQsIFwiYWxwaW5lOmluaXRcIik7XG4gIGRpc3BhdGNoKGRvY3VtZW50LCBcImFscGluZTppbml0aWFsaXppbmdcIik7XG4gIHN0YXJ0T2JzZXJ2aW5nTXV0YXRpb25zKCk7XG4gIG9uRWxBZGRlZCgoZWwpID0+IGluaXRUcmVlKGVsLCB3YWxrKSk7XG4gIG9uRWxSZW1vdmVkKChlbCkgPT4gZGVzdHJveVRyZWUoZWwpKTtcbiAgb25BdHRyaWJ1dGVzQWRkZWQoKGVsLCBhdHRycykgPT4ge1xuICAgIGRpcmVjdGl2ZXMoZWwsIGF0dHJzKS5mb3JFYWNoKChoYW5kbGUpID0+IGhhbmRsZSgpKTtcbiAgfSk7XG4gIGxldCBvdXROZXN0ZWRDb21wb25lbnRzID0gKGVsKSA9PiAhY2xvc2VzdFJvb3QoZWwucGFyZW50RWxlbWVudCwgdHJ1ZSk7XG4gIEFycmF5LmZyb20oZG9jdW1lbnQucXVlcnlTZWxlY3RvckFsbChhbGxTZWxlY3RvcnMoKS5qb2luKFwiLFwiKSkpLmZpbHRlcihvdXROZXN0ZWRDb21wb25lbnRzKS5mb3JFYWNoKChlbCkgPT4ge1xuICAgIGluaXRUcmVlKGVsKTtcbiAgfSk7XG4gIGRpc3BhdGNoKGRvY3VtZW50LCBcImFscGluZTppbml0aWFsaXplZFwiKTtcbn1cbnZhciByb290U2VsZWN0b3JDYWxsYmFja3MgPSBbXTtcbnZhciBpbml0U2VsZWN0b3JDYWxsYmFja3MgPSBbXTtcbmZ1bmN0aW9uIHJvb3RTZWxlY3RvcnMoKSB7XG4gIHJldHVybiByb290U2VsZWN0b3JDYWxsYmFja3MubWFwKChmbikgPT4gZm4oKSk7XG59XG5mdW5jdGlvbiBhbGxTZWxlY3RvcnMoKSB7XG4gIHJldHVybiByb290U2VsZWN0b3JDYWxsYmFja3MuY29uY2F0KGluaXRTZWxlY3RvckNhbGxiYWNrcykubWFwKChmbikgPT4gZm4oKSk7XG59XG5mdW5jdGlvbiBhZGRSb290U2VsZWN0b3Ioc2VsZWN0b3JDYWxsYmFjaykge1xuICByb290U2VsZWN0b3JDYWxsYmFja3MucHVzaChzZWxlY3RvckNhbGxiYWNrKTtcbn1cbmZ1bmN0aW9uIGFkZEluaXRTZWxlY3RvcihzZWxlY3RvckNhbGxiYWNrKSB7XG4gIGluaXRTZWxlY3RvckNhbGxiYWNrcy5wdXNoKHNlbGVjdG9yQ2FsbGJhY2spO1xufVxuZnVuY3Rpb24gY2xvc2VzdFJvb3QoZWwsIGluY2x1ZGVJbml0U2VsZWN0b3JzID0gZmFsc2UpIHtcbiAgcmV0dXJuIGZpbmRDbG9zZXN0KGVsLCAoZWxlbWVudCkgPT4ge1xuICAgIGNvbnN0IHNlbGVjdG9ycyA9IGluY2x1ZGVJbml0U2VsZWN0b3JzID8gYWxsU2VsZWN0b3JzKCkgOiByb290U2VsZWN0b3JzKCk7XG4gICAgaWYgKHNlbGVjdG9ycy5zb21lKChzZWxlY3RvcikgPT4gZWxlbWVudC5tYXRjaGVzKHNlbGVjdG9yKSkpXG4gICAgICByZXR1cm4gdHJ1ZTtcbiAgfSk7XG59XG5mdW5jdGlvbiBmaW5kQ2xvc2VzdChlbCwgY2FsbGJhY2spIHtcbiAgaWYgKCFlbClcbiAgICByZXR1cm47XG4gIGlmIChjYWxsYmFjayhlbCkpXG4gICAgcmV0dXJuIGVsO1xuICBpZiAoZWwuX3hfdGVsZXBvcnRCYWNrKVxuICAgIGVsID0gZWwuX3hfdGVsZXBvcnRCYWNrO1xuICBpZiAoIWVsLnBhcmVudEVsZW1lbnQpXG4gICAgcmV0dXJuO1xuICByZXR1cm4gZmluZENsb3Nlc3QoZWwucGFyZW50RWxlbWVudCwgY2FsbGJhY2spO1xufVxuZnVuY3Rpb24gaXNSb290KGVsKSB7XG4gIHJldHVybiByb290U2VsZWN0b3JzKCkuc29tZSgoc2VsZWN0b3IpID0+IGVsLm1hdGNoZXMoc2VsZWN0b3IpKTtcbn1cbnZhciBpbml0SW50ZXJjZXB0b3JzID0gW107XG5mdW5jdGlvbiBpbnRlcmNlcHRJbml0KGNhbGxiYWNrKSB7XG4gIGluaXRJbnRlcmNlcHRvcnMucHVzaChjYWxsYmFjayk7XG59XG5mdW5jdGlvbiBpbml0VHJlZShlbCwgd2Fsa2VyID0gd2FsaywgaW50ZXJjZXB0ID0gKCkgPT4ge1xufSkge1xuICBkZWZlckhhbmRsaW5nRGlyZWN0aXZlcygoKSA9PiB7XG4gICAgd2Fsa2VyKGVsLCAoZWwyLCBza2lwKSA9PiB7XG4gICAgICBpbnRlcmNlcHQoZWwyLCBza2lwKTtcbiAgICAgIGluaXRJbnRlcmNlcHRvcnMuZm9yRWFjaCgoaSkgPT4gaShlbDIsIHNraXApKTtcbiAgICAgIGRpcmVjdGl2ZXMoZWwyLCBlbDIuYXR0cmlidXRlcykuZm9yRWFjaCgoaGFuZGxlKSA9PiBoYW5kbGUoKSk7XG4gICAgICBlbDIuX3hfaWdub3JlICYmIHNraXAoKTtcbiAgICB9KTtcbiAgfSk7XG59XG5mdW5jdGlvbiBkZXN0cm95VHJlZShyb290LCB3YWxrZXIgPSB3YWxrKSB7XG4gIHdhbGtlcihyb290LCAoZWwpID0+IHtcbiAgICBjbGVhbnVwQXR0cmlidXRlcyhlbCk7XG4gICAgY2xlYW51cEVsZW1lbnQoZWwpO1xuICB9KTtcbn1cblxuLy8gcGFja2FnZXMvYWxwaW5lanMvc3JjL211dGF0aW9uLmpzXG52YXIgb25BdHRyaWJ1dGVBZGRlZHMgPSBbXTtcbnZhciBvbkVsUmVtb3ZlZHMgPSBbXTtcbnZhciBvbkVsQWRkZWRzID0gW107XG5mdW5jdGlvbiBvbkVsQWRkZWQoY2FsbGJhY2spIHtcbiAgb25FbEFkZGVkcy5wdXNoKGNhbGxiYWNrKTtcbn1cbmZ1bmN0aW9uIG9uRWxSZW1vdmVkKGVsLCBjYWxsYmFjaykge1xuICBpZiAodHlwZW9mIGNhbGxiYWNrID09PSBcImZ1bmN0aW9uXCIpIHtcbiAgICBpZiAoIWVsLl94X2NsZWFudXBzKVxuICAgICAgZWwuX3hfY2xlYW51cHMgPSBbXTtcbiAgICBlbC5feF9jbGVhbnVwcy5wdXNoKGNhbGxiYWNrKTtcbiAgfSBlbHNlIHtcbiAgICBjYWxsYmFjayA9IGVsO1xuICAgIG9uRWxSZW1vdmVkcy5wdXNoKGNhbGxiYWNrKTtcbiAgfVxufVxuZnVuY3Rpb24gb25BdHRyaWJ1dGVzQWRkZWQoY2FsbGJhY2spIHtcbiAgb25BdHRyaWJ1dGVBZGRlZHMucHVzaChjYWxsYmFjayk7XG59XG5mdW5jdGlvbiBvbkF0dHJpYnV0ZVJlbW92ZWQoZWwsIG5hbWUsIGNhbGxiYWNrKSB7XG4gIGlmICghZWwuX3hfYXR0cmlidXRlQ2xlYW51cHMpXG4gICAgZWwuX3hfYXR0cmlidXRlQ2xlYW51cHMgPSB7fTtcbiAgaWYgKCFlbC5feF9hdHRyaWJ1dGVDbGVhbnVwc1tuYW1lXSlcbiAgICBlbC5feF9hdHRyaWJ1dGVDbGVhbnVwc1tuYW1lXSA9IFtdO1xuICBlbC5feF9hdHRyaWJ1dGVDbGVhbnVwc1tuYW1lXS5wdXNoKGNhbGxiYWNrKTtcbn1cbmZ1bmN0aW9uIGNsZWFudXBBdHRyaWJ1dGVzKGVsLCBuYW1lcykge1xuICBpZiAoIWVsLl94X2F0dHJpYnV0ZUNsZWFudXBzKVxuICAgIHJldHVybjtcbiAgT2JqZWN0LmVudHJpZXMoZWwuX3hfYXR0cmlidXRlQ2xlYW51cHMpLmZvckVhY2goKFtuYW1lLCB2YWx1ZV0pID0+IHtcbiAgICBpZiAobmFtZXMgPT09IHZvaWQgMCB8fCBuYW1lcy5pbmNsdWRlcyhuYW1lKSkge1xuICAgICAgdmFsdWUuZm9yRWFjaCgoaSkgPT4gaSgpKTtcbiAgICAgIGRlbGV0ZSBlbC5feF9hdHRyaWJ1dGVDbGVhbnVwc1tuYW1lXTtcbiAgICB9XG4gIH0pO1xufVxuZnVuY3Rpb24gY2xlYW51cEVsZW1lbnQoZWwpIHtcbiAgaWYgKGVsLl94X2NsZWFudXBzKSB7XG4gICAgd2hpbGUgKGVsLl94X2NsZWFudXBzLmxlbmd0aClcbiAgICAgIGVsLl94X2NsZWFudXBzLnBvcCgpKCk7XG4gIH1cbn1cbnZhciBvYnNlcnZlciA9IG5ldyBNdXRhdGlvbk9ic2VydmVyKG9uTXV0YXRlKTtcbnZhciBjdXJyZW50bHlPYnNlcnZpbmcgPSBmYWxzZTtcbmZ1bmN0aW9uIHN0YXJ0T2JzZXJ2aW5nTXV0YXRpb25zKCkge1xuICBvYnNlcnZlci5vYnNlcnZlKGRvY3VtZW50LCB7IHN1YnRyZWU6IHRydWUsIGNoaWxkTGlzdDogdHJ1ZSwgYXR0cmlidXRlczogdHJ1ZSwgYXR0cmlidXRlT2xkVmFsdWU6IHRydWUgfSk7XG4gIGN1cnJlbnRseU9ic2VydmluZyA9IHRydWU7XG59XG5mdW5jdGlvbiBzdG9wT2JzZXJ2aW5nTXV0YXRpb25zKCkge1xuICBmbHVzaE9ic2VydmVyKCk7XG4gIG9ic2VydmVyLmRpc2Nvbm5lY3QoKTtcbiAgY3VycmVudGx5T2JzZXJ2aW5nID0gZmFsc2U7XG59XG52YXIgcXVldWVkTXV0YXRpb25zID0gW107XG5mdW5jdGlvbiBmbHVzaE9ic2VydmVyKCkge1xuICBsZXQgcmVjb3JkcyA9IG9ic2VydmVyLnRha2VSZWNvcmRzKCk7XG4gIHF1ZXVlZE11dGF0aW9ucy5wdXNoKCgpID0+IHJlY29yZHMubGVuZ3RoID4gMCAmJiBvbk11dGF0ZShyZWNvcmRzKSk7XG4gIGxldCBxdWV1ZUxlbmd0aFdoZW5UcmlnZ2VyZWQgPSBxdWV1ZWRNdXRhdGlvbnMubGVuZ3RoO1xuICBxdWV1ZU1pY3JvdGFzaygoKSA9PiB7XG4gICAgaWYgKHF1ZXVlZE11dGF0aW9ucy5sZW5ndGggPT09IHF1ZXVlTGVuZ3RoV2hlblRyaWdnZXJlZCkge1xuICAgICAgd2hpbGUgKHF1ZXVlZE11dGF0aW9ucy5sZW5ndGggPiAwKVxuICAgICAgICBxdWV1ZWRNdXRhdGlvbnMuc2hpZnQoKSgpO1xuICAgIH1cbiAgfSk7XG59XG5mdW5jdGlvbiBtdXRhdGVEb20oY2FsbGJhY2spIHtcbiAgaWYgKCFjdXJyZW50bHlPYnNlcnZpbmcpXG4gICAgcmV0dXJuIGNhbGxiYWNrKCk7XG4gIHN0b3BPYnNlcnZpbmdNdXRhdGlvbnMoKTtcbiAgbGV0IHJlc3VsdCA9IGNhbGxiYWNrKCk7XG4gIHN0YXJ0T2JzZXJ2aW5nTXV0YXRpb25zKCk7XG4gIHJldHVybiByZXN1bHQ7XG59XG52YXIgaXNDb2xsZWN0aW5nID0gZmFsc2U7XG52YXIgZGVmZXJyZWRNdXRhdGlvbnMgPSBbXTtcbmZ1bmN0aW9uIGRlZmVyTXV0YXRpb25zKCkge1xuICBpc0NvbGxlY3RpbmcgPSB0cnVlO1xufVxuZnVuY3Rpb24gZmx1c2hBbmRTdG9wRGVmZXJyaW5nTXV0YXRpb25zKCkge1xuICBpc0NvbGxlY3RpbmcgPSBmYWxzZTtcbiAgb25NdXRhdGUoZGVmZXJyZWRNdXRhdGlvbnMpO1xuICBkZWZlcnJlZE11dGF0aW9ucyA9IFtdO1xufVxuZnVuY3Rpb24gb25NdXRhdGUobXV0YXRpb25zKSB7XG4gIGlmIChpc0NvbGxlY3RpbmcpIHtcbiAgICBkZWZlcnJlZE11dGF0aW9ucyA9IGRlZmVycmVkTXV0YXRpb25zLmNvbmNhdChtdXRhdGlvbnMpO1xuICAgIHJldHVybjtcbiAgfVxuICBsZXQgYWRkZWROb2RlcyA9IC8qIEBfX1BVUkVfXyAqLyBuZXcgU2V0KCk7XG4gIGxldCByZW1vdmVkTm9kZXMgPSAvKiBAX19QVVJFX18gKi8gbmV3IFNldCgpO1xuICBsZXQgYWRkZWRBdHRyaWJ1dGVzID0gLyogQF9fUFVSRV9fICovIG5ldyBNYXAoKTtcbiAgbGV0IHJlbW92ZWRBdHRyaWJ1dGVzID0gLyogQF9fUFVSRV9fICovIG5ldyBNYXAoKTtcbiAgZm9yIChsZXQgaSA9IDA7IGkgPCBtdXRhdGlvbnMubGVuZ3RoOyBpKyspIHtcbiAgICBpZiAobXV0YXRpb25zW2ldLnRhcmdldC5feF9pZ25vcmVNdXRhdGlvbk9ic2VydmVyKVxuICAgICAgY29udGludWU7XG4gICAgaWYgKG11dGF0aW9uc1tpXS50eXBlID09PSBcImNoaWxkTGlzdFwiKSB7XG4gICAgICBtdXRhdGlvbnNbaV0uYWRkZWROb2Rlcy5mb3JFYWNoKChub2RlKSA9PiBub2RlLm5vZGVUeXBlID09PSAxICYmIGFkZGVkTm9kZXMuYWRkKG5vZGUpKTtcbiAgICAgIG11dGF0aW9uc1tpXS5yZW1vdmVkTm9kZXMuZm9yRWFjaCgobm9kZSkgPT4gbm9kZS5ub2RlVHlwZSA9PT0gMSAmJiByZW1vdmVkTm9kZXMuYWRkKG5vZGUpKTtcbiAgICB9XG4gICAgaWYgKG11dGF0aW9uc1tpXS50eXBlID09PSBcImF0dHJpYnV0ZXNcIikge1xuICAgICAgbGV0IGVsID0gbXV0YXRpb25zW2ldLnRhcmdldDtcbiAgICAgIGxldCBuYW1lID0gbXV0YXRpb25zW2ldLmF0dHJpYnV0ZU5hbWU7XG4gICAgICBsZXQgb2xkVmFsdWUgPSBtdXRhdGlvbnNbaV0ub2xkVmFsdWU7XG4gICAgICBsZXQgYWRkMiA9ICgpID0+IHtcbiAgICAgICAgaWYgKCFhZGRlZEF0dHJpYnV0ZXMuaGFzKGVsKSlcbiAgICAgICAgICBhZGRlZEF0dHJpYnV0ZXMuc2V0KGVsLCBbXSk7XG4gICAgICAgIGFkZGVkQXR0cmlidXRlcy5nZXQoZWwpLnB1c2goeyBuYW1lLCB2YWx1ZTogZWwuZ2V0QXR0cmlidXRlKG5hbWUpIH0pO1xuICAgICAgfTtcbiAgICAgIGxldCByZW1vdmUgPSAoKSA9PiB7XG4gICAgICAgIGlmICghcmVtb3ZlZEF0dHJpYnV0ZXMuaGFzKGVsKSlcbiAgICAgICAgICByZW1vdmVkQXR0cmlidXRlcy5zZXQoZWwsIFtdKTtcbiAgICAgICAgcmVtb3ZlZEF0dHJpYnV0ZXMuZ2V0KGVsKS5wdXNoKG5hbWUpO1xuICAgICAgfTtcbiAgICAgIGlmIChlbC5oYXNBdHRyaWJ1dGUobmFtZSkgJiYgb2xkVmFsdWUgPT09IG51bGwpIHtcbiAgICAgICAgYWRkMigpO1xuICAgICAgfSBlbHNlIGlmIChlbC5oYXNBdHRyaWJ1dGUobmFtZSkpIHtcbiAgICAgICAgcmVtb3ZlKCk7XG4gICAgICAgIGFkZDIoKTtcbiAgICAgIH0gZWxzZSB7XG4gICAgICAgIHJlbW92ZSgpO1xuICAgICAgfVxuICAgIH1cbiAgfVxuICByZW1vdmVkQXR0cmlidXRlcy5mb3JFYWNoKChhdHRycywgZWwpID0+IHtcbiAgICBjbGVhbnVwQXR0cmlidXRlcyhlbCwgYXR0cnMpO1xuICB9KTtcbiAgYWRkZWRBdHRyaWJ1dGVzLmZvckVhY2goKGF0dHJzLCBlbCkgPT4ge1xuICAgIG9uQXR0cmlidXRlQWRkZWRzLmZvckVhY2goKGkpID0+IGkoZWwsIGF0dHJzKSk7XG4gIH0pO1xuICBmb3IgKGxldCBub2RlIG9mIHJlbW92ZWROb2Rlcykge1xuICAgIGlmIChhZGRlZE5vZGVzLmhhcyhub2RlKSlcbiAgICAgIGNvbnRpbnVlO1xuICAgIG9uRWxSZW1vdmVkcy5mb3JFYWNoKChpKSA9PiBpKG5vZGUpKTtcbiAgICBkZXN0cm95VHJlZShub2RlKTtcbiAgfVxuICBhZGRlZE5vZGVzLmZvckVhY2goKG5vZGUpID0+IHtcbiAgICBub2RlLl94X2lnbm9yZVNlbGYgPSB0cnVlO1xuICAgIG5vZGUuX3hfaWdub3JlID0gdHJ1ZTtcbiAgfSk7XG4gIGZvciAobGV0IG5vZGUgb2YgYWRkZWROb2Rlcykge1xuICAgIGlmIChyZW1vdmVkTm9kZXMuaGFzKG5vZGUpKVxuICAgICAgY29udGludWU7XG4gICAgaWYgKCFub2RlLmlzQ29ubmVjdGVkKVxuICAgICAgY29udGludWU7XG4gICAgZGVsZXRlIG5vZGUuX3hfaWdub3JlU2VsZjtcbiAgICBkZWxldGUgbm9kZS5feF9pZ25vcmU7XG4gICAgb25FbEFkZGVkcy5mb3JFYWNoKChpKSA9PiBpKG5vZGUpKTtcbiAgICBub2RlLl94X2lnbm9yZSA9IHRydWU7XG4gICAgbm9kZS5feF9pZ25vcmVTZWxmID0gdHJ1ZTtcbiAgfVxuICBhZGRlZE5vZGVzLmZvckVhY2goKG5vZGUpID0+IHtcbiAgICBkZWxldGUgbm9kZS5feF9pZ25vcmVTZWxmO1xuICAgIGRlbGV0ZSBub2RlLl94X2lnbm9yZTtcbiAgfSk7XG4gIGFkZGVkTm9kZXMgPSBudWxsO1xuICByZW1vdmVkTm9kZXMgPSBudWxsO1xuICBhZGRlZEF0dHJpYnV0ZXMgPSBudWxsO1xuICByZW1vdmVkQXR0cmlidXRlcyA9IG51bGw7XG59XG5cbi8vIHBhY2thZ2VzL2FscGluZWpzL3NyYy9zY29wZS5qc1xuZnVuY3Rpb24gc2NvcGUobm9kZSkge1xuICByZXR1cm4gbWVyZ2VQcm94aWVzKGNsb3Nlc3REYXRhU3RhY2sobm9kZSkpO1xufVxuZnVuY3Rpb24gYWRkU2NvcGVUb05vZGUobm9kZSwgZGF0YTIsIHJlZmVyZW5jZU5vZGUpIHtcbiAgbm9kZS5feF9kYXRhU3RhY2sgPSBbZGF0YTIsIC4uLmNsb3Nlc3REYXRhU3RhY2socmVmZXJlbmNlTm9kZSB8fCBub2RlKV07XG4gIHJldHVybiAoKSA9PiB7XG4gICAgbm9kZS5feF9kYXRhU3RhY2sgPSBub2RlLl94X2RhdGFTdGFjay5maWx0ZXIoKGkpID0+IGkgIT09IGRhdGEyKTtcbiAgfTtcbn1cbmZ1bmN0aW9uIGNsb3Nlc3REYXRhU3RhY2sobm9kZSkge1xuICBpZiAobm9kZS5feF9kYXRhU3RhY2spXG4gICAgcmV0dXJuIG5vZGUuX3hfZGF0YVN0YWNrO1xuICBpZiAodHlwZW9mIFNoYWRvd1Jvb3QgPT09IFwiZnVuY3Rpb25cIiAmJiBub2RlIGluc3RhbmNlb2YgU2hhZG93Um9vdCkge1xuICAgIHJldHVybiBjbG9zZXN0RGF0YVN0YWNrKG5vZGUuaG9zdCk7XG4gIH1cbiAgaWYgKCFub2RlLnBhcmVudE5vZGUpIHtcbiAgICByZXR1cm4gW107XG4gIH1cbiAgcmV0dXJuIGNsb3Nlc3REYXRhU3RhY2sobm9kZS5wYXJlbnROb2RlKTtcbn1cbmZ1bmN0aW9uIG1lcmdlUHJveGllcyhvYmplY3RzKSB7XG4gIHJldHVybiBuZXcgUHJveHkoeyBvYmplY3RzIH0sIG1lcmdlUHJveHlUcmFwKTtcbn1cbnZhciBtZXJnZVByb3h5VHJhcCA9IHtcbiAgb3duS2V5cyh7IG9iamVjdHMgfSkge1xuICAgIHJldHVybiBBcnJheS5mcm9tKFxuICAgICAgbmV3IFNldChvYmplY3RzLmZsYXRNYXAoKGkpID0+IE9iamVjdC5rZXlzKGkpKSlcbiAgICApO1xuICB9LFxuICBoYXMoeyBvYmplY3RzIH0sIG5hbWUpIHtcbiAgICBpZiAobmFtZSA9PSBTeW1ib2wudW5zY29wYWJsZXMpXG4gICAgICByZXR1cm4gZmFsc2U7XG4gICAgcmV0dXJuIG9iamVjdHMuc29tZShcbiAgICAgIChvYmopID0+IE9iamVjdC5wcm90b3R5cGUuaGFzT3duUHJvcGVydHkuY2FsbChvYmosIG5hbWUpIHx8IFJlZmxlY3QuaGFzKG9iaiwgbmFtZSlcbiAgICApO1xuICB9LFxuICBnZXQoeyBvYmplY3RzIH0sIG5hbWUsIHRoaXNQcm94eSkge1xuICAgIGlmIChuYW1lID09IFwidG9KU09OXCIpXG4gICAgICByZXR1cm4gY29sbGFwc2VQcm94aWVzO1xuICAgIHJldHVybiBSZWZsZWN0LmdldChcbiAgICAgIG9iamVjdHMuZmluZChcbiAgICAgICAgKG9iaikgPT4gUmVmbGVjdC5oYXMob2JqLCBuYW1lKVxuICAgICAgKSB8fCB7fSxcbiAgICAgIG5hbWUsXG4gICAgICB0aGlzUHJveHlcbiAgICApO1xuICB9LFxuICBzZXQoeyBvYmplY3RzIH0sIG5hbWUsIHZhbHVlLCB0aGlzUHJveHkpIHtcbiAgICBjb25zdCB0YXJnZXQgPSBvYmplY3RzLmZpbmQoXG4gICAgICAob2JqKSA9PiBPYmplY3QucHJvdG90eXBlLmhhc093blByb3BlcnR5LmNhbGwob2JqLCBuYW1lKVxuICAgICkgfHwgb2JqZWN0c1tvYmplY3RzLmxlbmd0aCAtIDFdO1xuICAgIGNvbnN0IGRlc2NyaXB0b3IgPSBPYmplY3QuZ2V0T3duUHJvcGVydHlEZXNjcmlwdG9yKHRhcmdldCwgbmFtZSk7XG4gICAgaWYgKGRlc2NyaXB0b3I/LnNldCAmJiBkZXNjcmlwdG9yPy5nZXQpXG4gICAgICByZXR1cm4gUmVmbGVjdC5zZXQodGFyZ2V0LCBuYW1lLCB2YWx1ZSwgdGhpc1Byb3h5KTtcbiAgICByZXR1cm4gUmVmbGVjdC5zZXQodGFyZ2V0LCBuYW1lLCB2YWx1ZSk7XG4gIH1cbn07XG5mdW5jdGlvbiBjb2xsYXBzZVByb3hpZXMoKSB7XG4gIGxldCBrZXlzID0gUmVmbGVjdC5vd25LZXlzKHRoaXMpO1xuICByZXR1cm4ga2V5cy5yZWR1Y2UoKGFjYywga2V5KSA9PiB7XG4gICAgYWNjW2tleV0gPSBSZWZsZWN0LmdldCh0aGlzLCBrZXkpO1xuICAgIHJldHVybiBhY2M7XG4gIH0sIHt9KTtcbn1cblxuLy8gcGFja2FnZXMvYWxwaW5lanMvc3JjL2ludGVyY2VwdG9yLmpzXG5mdW5jdGlvbiBpbml0SW50ZXJjZXB0b3JzMihkYXRhMikge1xuICBsZXQgaXNPYmplY3QyID0gKHZhbCkgPT4gdHlwZW9mIHZhbCA9PT0gXCJvYmplY3RcIiAmJiAhQXJyYXkuaXNBcnJheSh2YWwpICYmIHZhbCAhPT0gbnVsbDtcbiAgbGV0IHJlY3Vyc2UgPSAob2JqLCBiYXNlUGF0aCA9IFwiXCIpID0+IHtcbiAgICBPYmplY3QuZW50cmllcyhPYmplY3QuZ2V0T3duUHJvcGVydHlEZXNjcmlwdG9ycyhvYmopKS5mb3JFYWNoKChba2V5LCB7IHZhbHVlLCBlbnVtZXJhYmxlIH1dKSA9PiB7XG4gICAgICBpZiAoZW51bWVyYWJsZSA9PT0gZmFsc2UgfHwgdmFsdWUgPT09IHZvaWQgMClcbiAgICAgICAgcmV0dXJuO1xuICAgICAgaWYgKHR5cGVvZiB2YWx1ZSA9PT0gXCJvYmplY3RcIiAmJiB2YWx1ZSAhPT0gbnVsbCAmJiB2YWx1ZS5fX3Zfc2tpcClcbiAgICAgICAgcmV0dXJuO1xuICAgICAgbGV0IHBhdGggPSBiYXNlUGF0aCA9PT0gXCJcIiA/IGtleSA6IGAke2Jhc2VQYXRofS4ke2tleX1gO1xuICAgICAgaWYgKHR5cGVvZiB2YWx1ZSA9PT0gXCJvYmplY3RcIiAmJiB2YWx1ZSAhPT0gbnVsbCAmJiB2YWx1ZS5feF9pbnRlcmNlcHRvcikge1xuICAgICAgICBvYmpba2V5XSA9IHZhbHVlLmluaXRpYWxpemUoZGF0YTIsIHBhdGgsIGtleSk7XG4gICAgICB9IGVsc2Uge1xuICAgICAgICBpZiAoaXNPYmplY3QyKHZhbHVlKSAmJiB2YWx1ZSAhPT0gb2JqICYmICEodmFsdWUgaW5zdGFuY2VvZiBFbGVtZW50KSkge1xuICAgICAgICAgIHJlY3Vyc2UodmFsdWUsIHBhdGgpO1xuICAgICAgICB9XG4gICAgICB9XG4gICAgfSk7XG4gIH07XG4gIHJldHVybiByZWN1cnNlKGRhdGEyKTtcbn1cbmZ1bmN0aW9uIGludGVyY2VwdG9yKGNhbGxiYWNrLCBtdXRhdGVPYmogPSAoKSA9PiB7XG59KSB7XG4gIGxldCBvYmogPSB7XG4gICAgaW5pdGlhbFZhbHVlOiB2b2lkIDAsXG4gICAgX3hfaW50ZXJjZXB0b3I6IHRydWUsXG4gICAgaW5pdGlhbGl6ZShkYXRhMiwgcGF0aCwga2V5KSB7XG4gICAgICByZXR1cm4gY2FsbGJhY2sodGhpcy5pbml0aWFsVmFsdWUsICgpID0+IGdldChkYXRhMiwgcGF0aCksICh2YWx1ZSkgPT4gc2V0KGRhdGEyLCBwYXRoLCB2YWx1ZSksIHBhdGgsIGtleSk7XG4gICAgfVxuICB9O1xuICBtdXRhdGVPYmoob2JqKTtcbiAgcmV0dXJuIChpbml0aWFsVmFsdWUpID0+IHtcbiAgICBpZiAodHlwZW9mIGluaXRpYWxWYWx1ZSA9PT0gXCJvYmplY3RcIiAmJiBpbml0aWFsVmFsdWUgIT09IG51bGwgJiYgaW5pdGlhbFZhbHVlLl94X2ludGVyY2VwdG9yKSB7XG4gICAgICBsZXQgaW5pdGlhbGl6ZSA9IG9iai5pbml0aWFsaXplLmJpbmQob2JqKTtcbiAgICAgIG9iai5pbml0aWFsaXplID0gKGRhdGEyLCBwYXRoLCBrZXkpID0+IHtcbiAgICAgICAgbGV0IGlubmVyVmFsdWUgPSBpbml0aWFsVmFsdWUuaW5pdGlhbGl6ZShkYXRhMiwgcGF0aCwga2V5KTtcbiAgICAgICAgb2JqLmluaXRpYWxWYWx1ZSA9IGlubmVyVmFsdWU7XG4gICAgICAgIHJldHVybiBpbml0aWFsaXplKGRhdGEyLCBwYXRoLCBrZXkpO1xuICAgICAgfTtcbiAgICB9IGVsc2Uge1xuICAgICAgb2JqLmluaXRpYWxWYWx1ZSA9IGluaXRpYWxWYWx1ZTtcbiAgICB9XG4gICAgcmV0dXJuIG9iajtcbiAgfTtcbn1cbmZ1bmN0aW9uIGdldChvYmosIHBhdGgpIHtcbiAgcmV0dXJuIHBhdGguc3BsaXQoXCIuXCIpLnJlZHVjZSgoY2FycnksIHNlZ21lbnQpID0+IGNhcnJ5W3NlZ21lbnRdLCBvYmopO1xufVxuZnVuY3Rpb24gc2V0KG9iaiwgcGF0aCwgdmFsdWUpIHtcbiAgaWYgKHR5cGVvZiBwYXRoID09PSBcInN0cmluZ1wiKVxuICAgIHBhdGggPSBwYXRoLnNwbGl0KFwiLlwiKTtcbiAgaWYgKHBhdGgubGVuZ3RoID09PSAxKVxuICAgIG9ialtwYXRoWzBdXSA9IHZhbHVlO1xuICBlbHNlIGlmIChwYXRoLmxlbmd0aCA9PT0gMClcbiAgICB0aHJvdyBlcnJvcjtcbiAgZWxzZSB7XG4gICAgaWYgKG9ialtwYXRoWzBdXSlcbiAgICAgIHJldHVybiBzZXQob2JqW3BhdGhbMF1dLCBwYXRoLnNsaWNlKDEpLCB2YWx1ZSk7XG4gICAgZWxzZSB7XG4gICAgICBvYmpbcGF0aFswXV0gPSB7fTtcbiAgICAgIHJldHVybiBzZXQob2JqW3BhdGhbMF1dLCBwYXRoLnNsaWNlKDEpLCB2YWx1ZSk7XG4gICAgfVxuICB9XG59XG5cbi8vIHBhY2thZ2VzL2FscGluZWpzL3NyYy9tYWdpY3MuanNcbnZhciBtYWdpY3MgPSB7fTtcbmZ1bmN0aW9uIG1hZ2ljKG5hbWUsIGNhbGxiYWNrKSB7XG4gIG1hZ2ljc1tuYW1lXSA9IGNhbGxiYWNrO1xufVxuZnVuY3Rpb24gaW5qZWN0TWFnaWNzKG9iaiwgZWwpIHtcbiAgT2JqZWN0LmVudHJpZXMobWFnaWNzKS5mb3JFYWNoKChbbmFtZSwgY2FsbGJhY2tdKSA9PiB7XG4gICAgbGV0IG1lbW9pemVkVXRpbGl0aWVzID0gbnVsbDtcbiAgICBmdW5jdGlvbiBnZXRVdGlsaXRpZXMoKSB7XG4gICAgICBpZiAobWVtb2l6ZWRVdGlsaXRpZXMpIHtcbiAgICAgICAgcmV0dXJuIG1lbW9pemVkVXRpbGl0aWVzO1xuICAgICAgfSBlbHNlIHtcbiAgICAgICAgbGV0IFt1dGlsaXRpZXMsIGNsZWFudXAyXSA9IGdldEVsZW1lbnRCb3VuZFV0aWxpdGllcyhlbCk7XG4gICAgICAgIG1lbW9pemVkVXRpbGl0aWVzID0geyBpbnRlcmNlcHRvciwgLi4udXRpbGl0aWVzIH07XG4gICAgICAgIG9uRWxSZW1vdmVkKGVsLCBjbGVhbnVwMik7XG4gICAgICAgIHJldHVybiBtZW1vaXplZFV0aWxpdGllcztcbiAgICAgIH1cbiAgICB9XG4gICAgT2JqZWN0LmRlZmluZVByb3BlcnR5KG9iaiwgYCQke25hbWV9YCwge1xuICAgICAgZ2V0KCkge1xuICAgICAgICByZXR1cm4gY2FsbGJhY2soZWwsIGdldFV0aWxpdGllcygpKTtcbiAgICAgIH0sXG4gICAgICBlbnVtZXJhYmxlOiBmYWxzZVxuICAgIH0pO1xuICB9KTtcbiAgcmV0dXJuIG9iajtcbn1cblxuLy8gcGFja2FnZXMvYWxwaW5lanMvc3JjL3V0aWxzL2Vycm9yLmpzXG5mdW5jdGlvbiB0cnlDYXRjaChlbCwgZXhwcmVzc2lvbiwgY2FsbGJhY2ssIC4uLmFyZ3MpIHtcbiAgdHJ5IHtcbiAgICByZXR1cm4gY2FsbGJhY2soLi4uYXJncyk7XG4gIH0gY2F0Y2ggKGUpIHtcbiAgICBoYW5kbGVFcnJvcihlLCBlbCwgZXhwcmVzc2lvbik7XG4gIH1cbn1cbmZ1bmN0aW9uIGhhbmRsZUVycm9yKGVycm9yMiwgZWwsIGV4cHJlc3Npb24gPSB2b2lkIDApIHtcbiAgZXJyb3IyID0gT2JqZWN0LmFzc2lnbihcbiAgICBlcnJvcjIgPz8geyBtZXNzYWdlOiBcIk5vIGVycm9yIG1lc3NhZ2UgZ2l2ZW4uXCIgfSxcbiAgICB7IGVsLCBleHByZXNzaW9uIH1cbiAgKTtcbiAgY29uc29sZS53YXJuKGBBbHBpbmUgRXhwcmVzc2lvbiBFcnJvcjogJHtlcnJvcjIubWVzc2FnZX1cblxuJHtleHByZXNzaW9uID8gJ0V4cHJlc3Npb246IFwiJyArIGV4cHJlc3Npb24gKyAnXCJcXG5cXG4nIDogXCJcIn1gLCBlbCk7XG4gIHNldFRpbWVvdXQoKCkgPT4ge1xuICAgIHRocm93IGVycm9yMjtcbiAgfSwgMCk7XG59XG5cbi8vIHBhY2thZ2VzL2FscGluZWpzL3NyYy9ldmFsdWF0b3IuanNcbnZhciBzaG91bGRBdXRvRXZhbHVhdGVGdW5jdGlvbnMgPSB0cnVlO1xuZnVuY3Rpb24gZG9udEF1dG9FdmFsdWF0ZUZ1bmN0aW9ucyhjYWxsYmFjaykge1xuICBsZXQgY2FjaGUgPSBzaG91bGRBdXRvRXZhbHVhdGVGdW5jdGlvbnM7XG4gIHNob3VsZEF1dG9FdmFsdWF0ZUZ1bmN0aW9ucyA9IGZhbHNlO1xuICBsZXQgcmVzdWx0ID0gY2FsbGJhY2soKTtcbiAgc2hvdWxkQXV0b0V2YWx1YXRlRnVuY3Rpb25zID0gY2FjaGU7XG4gIHJldHVybiByZXN1bHQ7XG59XG5mdW5jdGlvbiBldmFsdWF0ZShlbCwgZXhwcmVzc2lvbiwgZXh0cmFzID0ge30pIHtcbiAgbGV0IHJlc3VsdDtcbiAgZXZhbHVhdGVMYXRlcihlbCwgZXhwcmVzc2lvbikoKHZhbHVlKSA9PiByZXN1bHQgPSB2YWx1ZSwgZXh0cmFzKTtcbiAgcmV0dXJuIHJlc3VsdDtcbn1cbmZ1bmN0aW9uIGV2YWx1YXRlTGF0ZXIoLi4uYXJncykge1xuICByZXR1cm4gdGhlRXZhbHVhdG9yRnVuY3Rpb24oLi4uYXJncyk7XG59XG52YXIgdGhlRXZhbHVhdG9yRnVuY3Rpb24gPSBub3JtYWxFdmFsdWF0b3I7XG5mdW5jdGlvbiBzZXRFdmFsdWF0b3IobmV3RXZhbHVhdG9yKSB7XG4gIHRoZUV2YWx1YXRvckZ1bmN0aW9uID0gbmV3RXZhbHVhdG9yO1xufVxuZnVuY3Rpb24gbm9ybWFsRXZhbHVhdG9yKGVsLCBleHByZXNzaW9uKSB7XG4gIGxldCBvdmVycmlkZGVuTWFnaWNzID0ge307XG4gIGluamVjdE1hZ2ljcyhvdmVycmlkZGVuTWFnaWNzLCBlbCk7XG4gIGxldCBkYXRhU3RhY2sgPSBbb3ZlcnJpZGRlbk1hZ2ljcywgLi4uY2xvc2VzdERhdGFTdGFjayhlbCldO1xuICBsZXQgZXZhbHVhdG9yID0gdHlwZW9mIGV4cHJlc3Npb24gPT09IFwiZnVuY3Rpb25cIiA/IGdlbmVyYXRlRXZhbHVhdG9yRnJvbUZ1bmN0aW9uKGRhdGFTdGFjaywgZXhwcmVzc2lvbikgOiBnZW5lcmF0ZUV2YWx1YXRvckZyb21TdHJpbmcoZGF0YVN0YWNrLCBleHByZXNzaW9uLCBlbCk7XG4gIHJldHVybiB0cnlDYXRjaC5iaW5kKG51bGwsIGVsLCBleHByZXNzaW9uLCBldmFsdWF0b3IpO1xufVxuZnVuY3Rpb24gZ2VuZXJhdGVFdmFsdWF0b3JGcm9tRnVuY3Rpb24oZGF0YVN0YWNrLCBmdW5jKSB7XG4gIHJldHVybiAocmVjZWl2ZXIgPSAoKSA9PiB7XG4gIH0sIHsgc2NvcGU6IHNjb3BlMiA9IHt9LCBwYXJhbXMgPSBbXSB9ID0ge30pID0+IHtcbiAgICBsZXQgcmVzdWx0ID0gZnVuYy5hcHBseShtZXJnZVByb3hpZXMoW3Njb3BlMiwgLi4uZGF0YVN0YWNrXSksIHBhcmFtcyk7XG4gICAgcnVuSWZUeXBlT2ZGdW5jdGlvbihyZWNlaXZlciwgcmVzdWx0KTtcbiAgfTtcbn1cbnZhciBldmFsdWF0b3JNZW1vID0ge307XG5mdW5jdGlvbiBnZW5lcmF0ZUZ1bmN0aW9uRnJvbVN0cmluZyhleHByZXNzaW9uLCBlbCkge1xuICBpZiAoZXZhbHVhdG9yTWVtb1tleHByZXNzaW9uXSkge1xuICAgIHJldHVybiBldmFsdWF0b3JNZW1vW2V4cHJlc3Npb25dO1xuICB9XG4gIGxldCBBc3luY0Z1bmN0aW9uID0gT2JqZWN0LmdldFByb3RvdHlwZU9mKGFzeW5jIGZ1bmN0aW9uKCkge1xuICB9KS5jb25zdHJ1Y3RvcjtcbiAgbGV0IHJpZ2h0U2lkZVNhZmVFeHByZXNzaW9uID0gL15bXFxuXFxzXSppZi4qXFwoLipcXCkvLnRlc3QoZXhwcmVzc2lvbi50cmltKCkpIHx8IC9eKGxldHxjb25zdClcXHMvLnRlc3QoZXhwcmVzc2lvbi50cmltKCkpID8gYChhc3luYygpPT57ICR7ZXhwcmVzc2lvbn0gfSkoKWAgOiBleHByZXNzaW9uO1xuICBjb25zdCBzYWZlQXN5bmNGdW5jdGlvbiA9ICgpID0+IHtcbiAgICB0cnkge1xuICAgICAgbGV0IGZ1bmMyID0gbmV3IEFzeW5jRnVuY3Rpb24oXG4gICAgICAgIFtcIl9fc2VsZlwiLCBcInNjb3BlXCJdLFxuICAgICAgICBgd2l0aCAoc2NvcGUpIHsgX19zZWxmLnJlc3VsdCA9ICR7cmlnaHRTaWRlU2FmZUV4cHJlc3Npb259IH07IF9fc2VsZi5maW5pc2hlZCA9IHRydWU7IHJldHVybiBfX3NlbGYucmVzdWx0O2BcbiAgICAgICk7XG4gICAgICBPYmplY3QuZGVmaW5lUHJvcGVydHkoZnVuYzIsIFwibmFtZVwiLCB7XG4gICAgICAgIHZhbHVlOiBgW0FscGluZV0gJHtleHByZXNzaW9ufWBcbiAgICAgIH0pO1xuICAgICAgcmV0dXJuIGZ1bmMyO1xuICAgIH0gY2F0Y2ggKGVycm9yMikge1xuICAgICAgaGFuZGxlRXJyb3IoZXJyb3IyLCBlbCwgZXhwcmVzc2lvbik7XG4gICAgICByZXR1cm4gUHJvbWlzZS5yZXNvbHZlKCk7XG4gICAgfVxuICB9O1xuICBsZXQgZnVuYyA9IHNhZmVBc3luY0Z1bmN0aW9uKCk7XG4gIGV2YWx1YXRvck1lbW9bZXhwcmVzc2lvbl0gPSBmdW5jO1xuICByZXR1cm4gZnVuYztcbn1cbmZ1bmN0aW9uIGdlbmVyYXRlRXZhbHVhdG9yRnJvbVN0cmluZyhkYXRhU3RhY2ssIGV4cHJlc3Npb24sIGVsKSB7XG4gIGxldCBmdW5jID0gZ2VuZXJhdGVGdW5jdGlvbkZyb21TdHJpbmcoZXhwcmVzc2lvbiwgZWwpO1xuICByZXR1cm4gKHJlY2VpdmVyID0gKCkgPT4ge1xuICB9LCB7IHNjb3BlOiBzY29wZTIgPSB7fSwgcGFyYW1zID0gW10gfSA9IHt9KSA9PiB7XG4gICAgZnVuYy5yZXN1bHQgPSB2b2lkIDA7XG4gICAgZnVuYy5maW5pc2hlZCA9IGZhbHNlO1xuICAgIGxldCBjb21wbGV0ZVNjb3BlID0gbWVyZ2VQcm94aWVzKFtzY29wZTIsIC4uLmRhdGFTdGFja10pO1xuICAgIGlmICh0eXBlb2YgZnVuYyA9PT0gXCJmdW5jdGlvblwiKSB7XG4gICAgICBsZXQgcHJvbWlzZSA9IGZ1bmMoZnVuYywgY29tcGxldGVTY29wZSkuY2F0Y2goKGVycm9yMikgPT4gaGFuZGxlRXJyb3IoZXJyb3IyLCBlbCwgZXhwcmVzc2lvbikpO1xuICAgICAgaWYgKGZ1bmMuZmluaXNoZWQpIHtcbiAgICAgICAgcnVuSWZUeXBlT2ZGdW5jdGlvbihyZWNlaXZlciwgZnVuYy5yZXN1bHQsIGNvbXBsZXRlU2NvcGUsIHBhcmFtcywgZWwpO1xuICAgICAgICBmdW5jLnJlc3VsdCA9IHZvaWQgMDtcbiAgICAgIH0gZWxzZSB7XG4gICAgICAgIHByb21pc2UudGhlbigocmVzdWx0KSA9PiB7XG4gICAgICAgICAgcnVuSWZUeXBlT2ZGdW5jdGlvbihyZWNlaXZlciwgcmVzdWx0LCBjb21wbGV0ZVNjb3BlLCBwYXJhbXMsIGVsKTtcbiAgICAgICAgfSkuY2F0Y2goKGVycm9yMikgPT4gaGFuZGxlRXJyb3IoZXJyb3IyLCBlbCwgZXhwcmVzc2lvbikpLmZpbmFsbHkoKCkgPT4gZnVuYy5yZXN1bHQgPSB2b2lkIDApO1xuICAgICAgfVxuICAgIH1cbiAgfTtcbn1cbmZ1bmN0aW9uIHJ1bklmVHlwZU9mRnVuY3Rpb24ocmVjZWl2ZXIsIHZhbHVlLCBzY29wZTIsIHBhcmFtcywgZWwpIHtcbiAgaWYgKHNob3VsZEF1dG9FdmFsdWF0ZUZ1bmN0aW9ucyAmJiB0eXBlb2YgdmFsdWUgPT09IFwiZnVuY3Rpb25cIikge1xuICAgIGxldCByZXN1bHQgPSB2YWx1ZS5hcHBseShzY29wZTIsIHBhcmFtcyk7XG4gICAgaWYgKHJlc3VsdCBpbnN0YW5jZW9mIFByb21pc2UpIHtcbiAgICAgIHJlc3VsdC50aGVuKChpKSA9PiBydW5JZlR5cGVPZkZ1bmN0aW9uKHJlY2VpdmVyLCBpLCBzY29wZTIsIHBhcmFtcykpLmNhdGNoKChlcnJvcjIpID0+IGhhbmRsZUVycm9yKGVycm9yMiwgZWwsIHZhbHVlKSk7XG4gICAgfSBlbHNlIHtcbiAgICAgIHJlY2VpdmVyKHJlc3VsdCk7XG4gICAgfVxuICB9IGVsc2UgaWYgKHR5cGVvZiB2YWx1ZSA9PT0gXCJvYmplY3RcIiAmJiB2YWx1ZSBpbnN0YW5jZW9mIFByb21pc2UpIHtcbiAgICB2YWx1ZS50aGVuKChpKSA9PiByZWNlaXZlcihpKSk7XG4gIH0gZWxzZSB7XG4gICAgcmVjZWl2ZXIodmFsdWUpO1xuICB9XG59XG5cbi8vIHBhY2thZ2VzL2FscGluZWpzL3NyYy9kaXJlY3RpdmVzLmpzXG52YXIgcHJlZml4QXNTdHJpbmcgPSBcIngtXCI7XG5mdW5jdGlvbiBwcmVmaXgoc3ViamVjdCA9IFwiXCIpIHtcbiAgcmV0dXJuIHByZWZpeEFzU3RyaW5nICsgc3ViamVjdDtcbn1cbmZ1bmN0aW9uIHNldFByZWZpeChuZXdQcmVmaXgpIHtcbiAgcHJlZml4QXNTdHJpbmcgPSBuZXdQcmVmaXg7XG59XG52YXIgZGlyZWN0aXZlSGFuZGxlcnMgPSB7fTtcbmZ1bmN0aW9uIGRpcmVjdGl2ZShuYW1lLCBjYWxsYmFjaykge1xuICBkaXJlY3RpdmVIYW5kbGVyc1tuYW1lXSA9IGNhbGxiYWNrO1xuICByZXR1cm4ge1xuICAgIGJlZm9yZShkaXJlY3RpdmUyKSB7XG4gICAgICBpZiAoIWRpcmVjdGl2ZUhhbmRsZXJzW2RpcmVjdGl2ZTJdKSB7XG4gICAgICAgIGNvbnNvbGUud2FybihTdHJpbmcucmF3YENhbm5vdCBmaW5kIGRpcmVjdGl2ZSBcXGAke2RpcmVjdGl2ZTJ9XFxgLiBcXGAke25hbWV9XFxgIHdpbGwgdXNlIHRoZSBkZWZhdWx0IG9yZGVyIG9mIGV4ZWN1dGlvbmApO1xuICAgICAgICByZXR1cm47XG4gICAgICB9XG4gICAgICBjb25zdCBwb3MgPSBkaXJlY3RpdmVPcmRlci5pbmRleE9mKGRpcmVjdGl2ZTIpO1xuICAgICAgZGlyZWN0aXZlT3JkZXIuc3BsaWNlKHBvcyA+PSAwID8gcG9zIDogZGlyZWN0aXZlT3JkZXIuaW5kZXhPZihcIkRFRkFVTFRcIiksIDAsIG5hbWUpO1xuICAgIH1cbiAgfTtcbn1cbmZ1bmN0aW9uIGRpcmVjdGl2ZXMoZWwsIGF0dHJpYnV0ZXMsIG9yaWdpbmFsQXR0cmlidXRlT3ZlcnJpZGUpIHtcbiAgYXR0cmlidXRlcyA9IEFycmF5LmZyb20oYXR0cmlidXRlcyk7XG4gIGlmIChlbC5feF92aXJ0dWFsRGlyZWN0aXZlcykge1xuICAgIGxldCB2QXR0cmlidXRlcyA9IE9iamVjdC5lbnRyaWVzKGVsLl94X3ZpcnR1YWxEaXJlY3RpdmVzKS5tYXAoKFtuYW1lLCB2YWx1ZV0pID0+ICh7IG5hbWUsIHZhbHVlIH0pKTtcbiAgICBsZXQgc3RhdGljQXR0cmlidXRlcyA9IGF0dHJpYnV0ZXNPbmx5KHZBdHRyaWJ1dGVzKTtcbiAgICB2QXR0cmlidXRlcyA9IHZBdHRyaWJ1dGVzLm1hcCgoYXR0cmlidXRlKSA9PiB7XG4gICAgICBpZiAoc3RhdGljQXR0cmlidXRlcy5maW5kKChhdHRyKSA9PiBhdHRyLm5hbWUgPT09IGF0dHJpYnV0ZS5uYW1lKSkge1xuICAgICAgICByZXR1cm4ge1xuICAgICAgICAgIG5hbWU6IGB4LWJpbmQ6JHthdHRyaWJ1dGUubmFtZX1gLFxuICAgICAgICAgIHZhbHVlOiBgXCIke2F0dHJpYnV0ZS52YWx1ZX1cImBcbiAgICAgICAgfTtcbiAgICAgIH1cbiAgICAgIHJldHVybiBhdHRyaWJ1dGU7XG4gICAgfSk7XG4gICAgYXR0cmlidXRlcyA9IGF0dHJpYnV0ZXMuY29uY2F0KHZBdHRyaWJ1dGVzKTtcbiAgfVxuICBsZXQgdHJhbnNmb3JtZWRBdHRyaWJ1dGVNYXAgPSB7fTtcbiAgbGV0IGRpcmVjdGl2ZXMyID0gYXR0cmlidXRlcy5tYXAodG9UcmFuc2Zvcm1lZEF0dHJpYnV0ZXMoKG5ld05hbWUsIG9sZE5hbWUpID0+IHRyYW5zZm9ybWVkQXR0cmlidXRlTWFwW25ld05hbWVdID0gb2xkTmFtZSkpLmZpbHRlcihvdXROb25BbHBpbmVBdHRyaWJ1dGVzKS5tYXAodG9QYXJzZWREaXJlY3RpdmVzKHRyYW5zZm9ybWVkQXR0cmlidXRlTWFwLCBvcmlnaW5hbEF0dHJpYnV0ZU92ZXJyaWRlKSkuc29ydChieVByaW9yaXR5KTtcbiAgcmV0dXJuIGRpcmVjdGl2ZXMyLm1hcCgoZGlyZWN0aXZlMikgPT4ge1xuICAgIHJldHVybiBnZXREaXJlY3RpdmVIYW5kbGVyKGVsLCBkaXJlY3RpdmUyKTtcbiAgfSk7XG59XG5mdW5jdGlvbiBhdHRyaWJ1dGVzT25seShhdHRyaWJ1dGVzKSB7XG4gIHJldHVybiBBcnJheS5mcm9tKGF0dHJpYnV0ZXMpLm1hcCh0b1RyYW5zZm9ybWVkQXR0cmlidXRlcygpKS5maWx0ZXIoKGF0dHIpID0+ICFvdXROb25BbHBpbmVBdHRyaWJ1dGVzKGF0dHIpKTtcbn1cbnZhciBpc0RlZmVycmluZ0hhbmRsZXJzID0gZmFsc2U7XG52YXIgZGlyZWN0aXZlSGFuZGxlclN0YWNrcyA9IC8qIEBfX1BVUkVfXyAqLyBuZXcgTWFwKCk7XG52YXIgY3VycmVudEhhbmRsZXJTdGFja0tleSA9IFN5bWJvbCgpO1xuZnVuY3Rpb24gZGVmZXJIYW5kbGluZ0RpcmVjdGl2ZXMoY2FsbGJhY2spIHtcbiAgaXNEZWZlcnJpbmdIYW5kbGVycyA9IHRydWU7XG4gIGxldCBrZXkgPSBTeW1ib2woKTtcbiAgY3VycmVudEhhbmRsZXJTdGFja0tleSA9IGtleTtcbiAgZGlyZWN0aXZlSGFuZGxlclN0YWNrcy5zZXQoa2V5LCBbXSk7XG4gIGxldCBmbHVzaEhhbmRsZXJzID0gKCkgPT4ge1xuICAgIHdoaWxlIChkaXJlY3RpdmVIYW5kbGVyU3RhY2tzLmdldChrZXkpLmxlbmd0aClcbiAgICAgIGRpcmVjdGl2ZUhhbmRsZXJTdGFja3MuZ2V0KGtleSkuc2hpZnQoKSgpO1xuICAgIGRpcmVjdGl2ZUhhbmRsZXJTdGFja3MuZGVsZXRlKGtleSk7XG4gIH07XG4gIGxldCBzdG9wRGVmZXJyaW5nID0gKCkgPT4ge1xuICAgIGlzRGVmZXJyaW5nSGFuZGxlcnMgPSBmYWxzZTtcbiAgICBmbHVzaEhhbmRsZXJzKCk7XG4gIH07XG4gIGNhbGxiYWNrKGZsdXNoSGFuZGxlcnMpO1xuICBzdG9wRGVmZXJyaW5nKCk7XG59XG5mdW5jdGlvbiBnZXRFbGVtZW50Qm91bmRVdGlsaXRpZXMoZWwpIHtcbiAgbGV0IGNsZWFudXBzID0gW107XG4gIGxldCBjbGVhbnVwMiA9IChjYWxsYmFjaykgPT4gY2xlYW51cHMucHVzaChjYWxsYmFjayk7XG4gIGxldCBbZWZmZWN0MywgY2xlYW51cEVmZmVjdF0gPSBlbGVtZW50Qm91bmRFZmZlY3QoZWwpO1xuICBjbGVhbnVwcy5wdXNoKGNsZWFudXBFZmZlY3QpO1xuICBsZXQgdXRpbGl0aWVzID0ge1xuICAgIEFscGluZTogYWxwaW5lX2RlZmF1bHQsXG4gICAgZWZmZWN0OiBlZmZlY3QzLFxuICAgIGNsZWFudXA6IGNsZWFudXAyLFxuICAgIGV2YWx1YXRlTGF0ZXI6IGV2YWx1YXRlTGF0ZXIuYmluZChldmFsdWF0ZUxhdGVyLCBlbCksXG4gICAgZXZhbHVhdGU6IGV2YWx1YXRlLmJpbmQoZXZhbHVhdGUsIGVsKVxuICB9O1xuICBsZXQgZG9DbGVhbnVwID0gKCkgPT4gY2xlYW51cHMuZm9yRWFjaCgoaSkgPT4gaSgpKTtcbiAgcmV0dXJuIFt1dGlsaXRpZXMsIGRvQ2xlYW51cF07XG59XG5mdW5jdGlvbiBnZXREaXJlY3RpdmVIYW5kbGVyKGVsLCBkaXJlY3RpdmUyKSB7XG4gIGxldCBub29wID0gKCkgPT4ge1xuICB9O1xuICBsZXQgaGFuZGxlcjQgPSBkaXJlY3RpdmVIYW5kbGVyc1tkaXJlY3RpdmUyLnR5cGVdIHx8IG5vb3A7XG4gIGxldCBbdXRpbGl0aWVzLCBjbGVhbnVwMl0gPSBnZXRFbGVtZW50Qm91bmRVdGlsaXRpZXMoZWwpO1xuICBvbkF0dHJpYnV0ZVJlbW92ZWQoZWwsIGRpcmVjdGl2ZTIub3JpZ2luYWwsIGNsZWFudXAyKTtcbiAgbGV0IGZ1bGxIYW5kbGVyID0gKCkgPT4ge1xuICAgIGlmIChlbC5feF9pZ25vcmUgfHwgZWwuX3hfaWdub3JlU2VsZilcbiAgICAgIHJldHVybjtcbiAgICBoYW5kbGVyNC5pbmxpbmUgJiYgaGFuZGxlcjQuaW5saW5lKGVsLCBkaXJlY3RpdmUyLCB1dGlsaXRpZXMpO1xuICAgIGhhbmRsZXI0ID0gaGFuZGxlcjQuYmluZChoYW5kbGVyNCwgZWwsIGRpcmVjdGl2ZTIsIHV0aWxpdGllcyk7XG4gICAgaXNEZWZlcnJpbmdIYW5kbGVycyA/IGRpcmVjdGl2ZUhhbmRsZXJTdGFja3MuZ2V0KGN1cnJlbnRIYW5kbGVyU3RhY2tLZXkpLnB1c2goaGFuZGxlcjQpIDogaGFuZGxlcjQoKTtcbiAgfTtcbiAgZnVsbEhhbmRsZXIucnVuQ2xlYW51cHMgPSBjbGVhbnVwMjtcbiAgcmV0dXJuIGZ1bGxIYW5kbGVyO1xufVxudmFyIHN0YXJ0aW5nV2l0aCA9IChzdWJqZWN0LCByZXBsYWNlbWVudCkgPT4gKHsgbmFtZSwgdmFsdWUgfSkgPT4ge1xuICBpZiAobmFtZS5zdGFydHNXaXRoKHN1YmplY3QpKVxuICAgIG5hbWUgPSBuYW1lLnJlcGxhY2Uoc3ViamVjdCwgcmVwbGFjZW1lbnQpO1xuICByZXR1cm4geyBuYW1lLCB2YWx1ZSB9O1xufTtcbnZhciBpbnRvID0gKGkpID0+IGk7XG5mdW5jdGlvbiB0b1RyYW5zZm9ybWVkQXR0cmlidXRlcyhjYWxsYmFjayA9ICgpID0+IHtcbn0pIHtcbiAgcmV0dXJuICh7IG5hbWUsIHZhbHVlIH0pID0+IHtcbiAgICBsZXQgeyBuYW1lOiBuZXdOYW1lLCB2YWx1ZTogbmV3VmFsdWUgfSA9IGF0dHJpYnV0ZVRyYW5zZm9ybWVycy5yZWR1Y2UoKGNhcnJ5LCB0cmFuc2Zvcm0pID0+IHtcbiAgICAgIHJldHVybiB0cmFuc2Zvcm0oY2FycnkpO1xuICAgIH0sIHsgbmFtZSwgdmFsdWUgfSk7XG4gICAgaWYgKG5ld05hbWUgIT09IG5hbWUpXG4gICAgICBjYWxsYmFjayhuZXdOYW1lLCBuYW1lKTtcbiAgICByZXR1cm4geyBuYW1lOiBuZXdOYW1lLCB2YWx1ZTogbmV3VmFsdWUgfTtcbiAgfTtcbn1cbnZhciBhdHRyaWJ1dGVUcmFuc2Zvcm1lcnMgPSBbXTtcbmZ1bmN0aW9uIG1hcEF0dHJpYnV0ZXMoY2FsbGJhY2spIHtcbiAgYXR0cmlidXRlVHJhbnNmb3JtZXJzLnB1c2goY2FsbGJhY2spO1xufVxuZnVuY3Rpb24gb3V0Tm9uQWxwaW5lQXR0cmlidXRlcyh7IG5hbWUgfSkge1xuICByZXR1cm4gYWxwaW5lQXR0cmlidXRlUmVnZXgoKS50ZXN0KG5hbWUpO1xufVxudmFyIGFscGluZUF0dHJpYnV0ZVJlZ2V4ID0gKCkgPT4gbmV3IFJlZ0V4cChgXiR7cHJlZml4QXNTdHJpbmd9KFteOl4uXSspXFxcXGJgKTtcbmZ1bmN0aW9uIHRvUGFyc2VkRGlyZWN0aXZlcyh0cmFuc2Zvcm1lZEF0dHJpYnV0ZU1hcCwgb3JpZ2luYWxBdHRyaWJ1dGVPdmVycmlkZSkge1xuICByZXR1cm4gKHsgbmFtZSwgdmFsdWUgfSkgPT4ge1xuICAgIGxldCB0eXBlTWF0Y2ggPSBuYW1lLm1hdGNoKGFscGluZUF0dHJpYnV0ZVJlZ2V4KCkpO1xuICAgIGxldCB2YWx1ZU1hdGNoID0gbmFtZS5tYXRjaCgvOihbYS16QS1aMC05XFwtXzpdKykvKTtcbiAgICBsZXQgbW9kaWZpZXJzID0gbmFtZS5tYXRjaCgvXFwuW14uXFxdXSsoPz1bXlxcXV0qJCkvZykgfHwgW107XG4gICAgbGV0IG9yaWdpbmFsID0gb3JpZ2luYWxBdHRyaWJ1dGVPdmVycmlkZSB8fCB0cmFuc2Zvcm1lZEF0dHJpYnV0ZU1hcFtuYW1lXSB8fCBuYW1lO1xuICAgIHJldHVybiB7XG4gICAgICB0eXBlOiB0eXBlTWF0Y2ggPyB0eXBlTWF0Y2hbMV0gOiBudWxsLFxuICAgICAgdmFsdWU6IHZhbHVlTWF0Y2ggPyB2YWx1ZU1hdGNoWzFdIDogbnVsbCxcbiAgICAgIG1vZGlmaWVyczogbW9kaWZpZXJzLm1hcCgoaSkgPT4gaS5yZXBsYWNlKFwiLlwiLCBcIlwiKSksXG4gICAgICBleHByZXNzaW9uOiB2YWx1ZSxcbiAgICAgIG9yaWdpbmFsXG4gICAgfTtcbiAgfTtcbn1cbnZhciBERUZBVUxUID0gXCJERUZBVUxUXCI7XG52YXIgZGlyZWN0aXZlT3JkZXIgPSBbXG4gIFwiaWdub3JlXCIsXG4gIFwicmVmXCIsXG4gIFwiZGF0YVwiLFxuICBcImlkXCIsXG4gIFwiYW5jaG9yXCIsXG4gIFwiYmluZFwiLFxuICBcImluaXRcIixcbiAgXCJmb3JcIixcbiAgXCJtb2RlbFwiLFxuICBcIm1vZGVsYWJsZVwiLFxuICBcInRyYW5zaXRpb25cIixcbiAgXCJzaG93XCIsXG4gIFwiaWZcIixcbiAgREVGQVVMVCxcbiAgXCJ0ZWxlcG9ydFwiXG5dO1xuZnVuY3Rpb24gYnlQcmlvcml0eShhLCBiKSB7XG4gIGxldCB0eXBlQSA9IGRpcmVjdGl2ZU9yZGVyLmluZGV4T2YoYS50eXBlKSA9PT0gLTEgPyBERUZBVUxUIDogYS50eXBlO1xuICBsZXQgdHlwZUIgPSBkaXJlY3RpdmVPcmRlci5pbmRleE9mKGIudHlwZSkgPT09IC0xID8gREVGQVVMVCA6IGIudHlwZTtcbiAgcmV0dXJuIGRpcmVjdGl2ZU9yZGVyLmluZGV4T2YodHlwZUEpIC0gZGlyZWN0aXZlT3JkZXIuaW5kZXhPZih0eXBlQik7XG59XG5cbi8vIHBhY2thZ2VzL2FscGluZWpzL3NyYy9uZXh0VGljay5qc1xudmFyIHRpY2tTdGFjayA9IFtdO1xudmFyIGlzSG9sZGluZyA9IGZhbHNlO1xuZnVuY3Rpb24gbmV4dFRpY2soY2FsbGJhY2sgPSAoKSA9PiB7XG59KSB7XG4gIHF1ZXVlTWljcm90YXNrKCgpID0+IHtcbiAgICBpc0hvbGRpbmcgfHwgc2V0VGltZW91dCgoKSA9PiB7XG4gICAgICByZWxlYXNlTmV4dFRpY2tzKCk7XG4gICAgfSk7XG4gIH0pO1xuICByZXR1cm4gbmV3IFByb21pc2UoKHJlcykgPT4ge1xuICAgIHRpY2tTdGFjay5wdXNoKCgpID0+IHtcbiAgICAgIGNhbGxiYWNrKCk7XG4gICAgICByZXMoKTtcbiAgICB9KTtcbiAgfSk7XG59XG5mdW5jdGlvbiByZWxlYXNlTmV4dFRpY2tzKCkge1xuICBpc0hvbGRpbmcgPSBmYWxzZTtcbiAgd2hpbGUgKHRpY2tTdGFjay5sZW5ndGgpXG4gICAgdGlja1N0YWNrLnNoaWZ0KCkoKTtcbn1cbmZ1bmN0aW9uIGhvbGROZXh0VGlja3MoKSB7XG4gIGlzSG9sZGluZyA9IHRydWU7XG59XG5cbi8vIHBhY2thZ2VzL2FscGluZWpzL3NyYy91dGlscy9jbGFzc2VzLmpzXG5mdW5jdGlvbiBzZXRDbGFzc2VzKGVsLCB2YWx1ZSkge1xuICBpZiAoQXJyYXkuaXNBcnJheSh2YWx1ZSkpIHtcbiAgICByZXR1cm4gc2V0Q2xhc3Nlc0Zyb21TdHJpbmcoZWwsIHZhbHVlLmpvaW4oXCIgXCIpKTtcbiAgfSBlbHNlIGlmICh0eXBlb2YgdmFsdWUgPT09IFwib2JqZWN0XCIgJiYgdmFsdWUgIT09IG51bGwpIHtcbiAgICByZXR1cm4gc2V0Q2xhc3Nlc0Zyb21PYmplY3QoZWwsIHZhbHVlKTtcbiAgfSBlbHNlIGlmICh0eXBlb2YgdmFsdWUgPT09IFwiZnVuY3Rpb25cIikge1xuICAgIHJldHVybiBzZXRDbGFzc2VzKGVsLCB2YWx1ZSgpKTtcbiAgfVxuICByZXR1cm4gc2V0Q2xhc3Nlc0Zyb21TdHJpbmcoZWwsIHZhbHVlKTtcbn1cbmZ1bmN0aW9uIHNldENsYXNzZXNGcm9tU3RyaW5nKGVsLCBjbGFzc1N0cmluZykge1xuICBsZXQgc3BsaXQgPSAoY2xhc3NTdHJpbmcyKSA9PiBjbGFzc1N0cmluZzIuc3BsaXQoXCIgXCIpLmZpbHRlcihCb29sZWFuKTtcbiAgbGV0IG1pc3NpbmdDbGFzc2VzID0gKGNsYXNzU3RyaW5nMikgPT4gY2xhc3NTdHJpbmcyLnNwbGl0KFwiIFwiKS5maWx0ZXIoKGkpID0+ICFlbC5jbGFzc0xpc3QuY29udGFpbnMoaSkpLmZpbHRlcihCb29sZWFuKTtcbiAgbGV0IGFkZENsYXNzZXNBbmRSZXR1cm5VbmRvID0gKGNsYXNzZXMpID0+IHtcbiAgICBlbC5jbGFzc0xpc3QuYWRkKC4uLmNsYXNzZXMpO1xuICAgIHJldHVybiAoKSA9PiB7XG4gICAgICBlbC5jbGFzc0xpc3QucmVtb3ZlKC4uLmNsYXNzZXMpO1xuICAgIH07XG4gIH07XG4gIGNsYXNzU3RyaW5nID0gY2xhc3NTdHJpbmcgPT09IHRydWUgPyBjbGFzc1N0cmluZyA9IFwiXCIgOiBjbGFzc1N0cmluZyB8fCBcIlwiO1xuICByZXR1cm4gYWRkQ2xhc3Nlc0FuZFJldHVyblVuZG8obWlzc2luZ0NsYXNzZXMoY2xhc3NTdHJpbmcpKTtcbn1cbmZ1bmN0aW9uIHNldENsYXNzZXNGcm9tT2JqZWN0KGVsLCBjbGFzc09iamVjdCkge1xuICBsZXQgc3BsaXQgPSAoY2xhc3NTdHJpbmcpID0+IGNsYXNzU3RyaW5nLnNwbGl0KFwiIFwiKS5maWx0ZXIoQm9vbGVhbik7XG4gIGxldCBmb3JBZGQgPSBPYmplY3QuZW50cmllcyhjbGFzc09iamVjdCkuZmxhdE1hcCgoW2NsYXNzU3RyaW5nLCBib29sXSkgPT4gYm9vbCA/IHNwbGl0KGNsYXNzU3RyaW5nKSA6IGZhbHNlKS5maWx0ZXIoQm9vbGVhbik7XG4gIGxldCBmb3JSZW1vdmUgPSBPYmplY3QuZW50cmllcyhjbGFzc09iamVjdCkuZmxhdE1hcCgoW2NsYXNzU3RyaW5nLCBib29sXSkgPT4gIWJvb2wgPyBzcGxpdChjbGFzc1N0cmluZykgOiBmYWxzZSkuZmlsdGVyKEJvb2xlYW4pO1xuICBsZXQgYWRkZWQgPSBbXTtcbiAgbGV0IHJlbW92ZWQgPSBbXTtcbiAgZm9yUmVtb3ZlLmZvckVhY2goKGkpID0+IHtcbiAgICBpZiAoZWwuY2xhc3NMaXN0LmNvbnRhaW5zKGkpKSB7XG4gICAgICBlbC5jbGFzc0xpc3QucmVtb3ZlKGkpO1xuICAgICAgcmVtb3ZlZC5wdXNoKGkpO1xuICAgIH1cbiAgfSk7XG4gIGZvckFkZC5mb3JFYWNoKChpKSA9PiB7XG4gICAgaWYgKCFlbC5jbGFzc0xpc3QuY29udGFpbnMoaSkpIHtcbiAgICAgIGVsLmNsYXNzTGlzdC5hZGQoaSk7XG4gICAgICBhZGRlZC5wdXNoKGkpO1xuICAgIH1cbiAgfSk7XG4gIHJldHVybiAoKSA9PiB7XG4gICAgcmVtb3ZlZC5mb3JFYWNoKChpKSA9PiBlbC5jbGFzc0xpc3QuYWRkKGkpKTtcbiAgICBhZGRlZC5mb3JFYWNoKChpKSA9PiBlbC5jbGFzc0xpc3QucmVtb3ZlKGkpKTtcbiAgfTtcbn1cblxuLy8gcGFja2FnZXMvYWxwaW5lanMvc3JjL3V0aWxzL3N0eWxlcy5qc1xuZnVuY3Rpb24gc2V0U3R5bGVzKGVsLCB2YWx1ZSkge1xuICBpZiAodHlwZW9mIHZhbHVlID09PSBcIm9iamVjdFwiICYmIHZhbHVlICE9PSBudWxsKSB7XG4gICAgcmV0dXJuIHNldFN0eWxlc0Zyb21PYmplY3QoZWwsIHZhbHVlKTtcbiAgfVxuICByZXR1cm4gc2V0U3R5bGVzRnJvbVN0cmluZyhlbCwgdmFsdWUpO1xufVxuZnVuY3Rpb24gc2V0U3R5bGVzRnJvbU9iamVjdChlbCwgdmFsdWUpIHtcbiAgbGV0IHByZXZpb3VzU3R5bGVzID0ge307XG4gIE9iamVjdC5lbnRyaWVzKHZhbHVlKS5mb3JFYWNoKChba2V5LCB2YWx1ZTJdKSA9PiB7XG4gICAgcHJldmlvdXNTdHlsZXNba2V5XSA9IGVsLnN0eWxlW2tleV07XG4gICAgaWYgKCFrZXkuc3RhcnRzV2l0aChcIi0tXCIpKSB7XG4gICAgICBrZXkgPSBrZWJhYkNhc2Uoa2V5KTtcbiAgICB9XG4gICAgZWwuc3R5bGUuc2V0UHJvcGVydHkoa2V5LCB2YWx1ZTIpO1xuICB9KTtcbiAgc2V0VGltZW91dCgoKSA9PiB7XG4gICAgaWYgKGVsLnN0eWxlLmxlbmd0aCA9PT0gMCkge1xuICAgICAgZWwucmVtb3ZlQXR0cmlidXRlKFwic3R5bGVcIik7XG4gICAgfVxuICB9KTtcbiAgcmV0dXJuICgpID0+IHtcbiAgICBzZXRTdHlsZXMoZWwsIHByZXZpb3VzU3R5bGVzKTtcbiAgfTtcbn1cbmZ1bmN0aW9uIHNldFN0eWxlc0Zyb21TdHJpbmcoZWwsIHZhbHVlKSB7XG4gIGxldCBjYWNoZSA9IGVsLmdldEF0dHJpYnV0ZShcInN0eWxlXCIsIHZhbHVlKTtcbiAgZWwuc2V0QXR0cmlidXRlKFwic3R5bGVcIiwgdmFsdWUpO1xuICByZXR1cm4gKCkgPT4ge1xuICAgIGVsLnNldEF0dHJpYnV0ZShcInN0eWxlXCIsIGNhY2hlIHx8IFwiXCIpO1xuICB9O1xufVxuZnVuY3Rpb24ga2ViYWJDYXNlKHN1YmplY3QpIHtcbiAgcmV0dXJuIHN1YmplY3QucmVwbGFjZSgvKFthLXpdKShbQS1aXSkvZywgXCIkMS0kMlwiKS50b0xvd2VyQ2FzZSgpO1xufVxuXG4vLyBwYWNrYWdlcy9hbHBpbmVqcy9zcmMvdXRpbHMvb25jZS5qc1xuZnVuY3Rpb24gb25jZShjYWxsYmFjaywgZmFsbGJhY2sgPSAoKSA9PiB7XG59KSB7XG4gIGxldCBjYWxsZWQgPSBmYWxzZTtcbiAgcmV0dXJuIGZ1bmN0aW9uKCkge1xuICAgIGlmICghY2FsbGVkKSB7XG4gICAgICBjYWxsZWQgPSB0cnVlO1xuICAgICAgY2FsbGJhY2suYXBwbHkodGhpcywgYXJndW1lbnRzKTtcbiAgICB9IGVsc2Uge1xuICAgICAgZmFsbGJhY2suYXBwbHkodGhpcywgYXJndW1lbnRzKTtcbiAgICB9XG4gIH07XG59XG5cbi8vIHBhY2thZ2VzL2FscGluZWpzL3NyYy9kaXJlY3RpdmVzL3gtdHJhbnNpdGlvbi5qc1xuZGlyZWN0aXZlKFwidHJhbnNpdGlvblwiLCAoZWwsIHsgdmFsdWUsIG1vZGlmaWVycywgZXhwcmVzc2lvbiB9LCB7IGV2YWx1YXRlOiBldmFsdWF0ZTIgfSkgPT4ge1xuICBpZiAodHlwZW9mIGV4cHJlc3Npb24gPT09IFwiZnVuY3Rpb25cIilcbiAgICBleHByZXNzaW9uID0gZXZhbHVhdGUyKGV4cHJlc3Npb24pO1xuICBpZiAoZXhwcmVzc2lvbiA9PT0gZmFsc2UpXG4gICAgcmV0dXJuO1xuICBpZiAoIWV4cHJlc3Npb24gfHwgdHlwZW9mIGV4cHJlc3Npb24gPT09IFwiYm9vbGVhblwiKSB7XG4gICAgcmVnaXN0ZXJUcmFuc2l0aW9uc0Zyb21IZWxwZXIoZWwsIG1vZGlmaWVycywgdmFsdWUpO1xuICB9IGVsc2Uge1xuICAgIHJlZ2lzdGVyVHJhbnNpdGlvbnNGcm9tQ2xhc3NTdHJpbmcoZWwsIGV4cHJlc3Npb24sIHZhbHVlKTtcbiAgfVxufSk7XG5mdW5jdGlvbiByZWdpc3RlclRyYW5zaXRpb25zRnJvbUNsYXNzU3RyaW5nKGVsLCBjbGFzc1N0cmluZywgc3RhZ2UpIHtcbiAgcmVnaXN0ZXJUcmFuc2l0aW9uT2JqZWN0KGVsLCBzZXRDbGFzc2VzLCBcIlwiKTtcbiAgbGV0IGRpcmVjdGl2ZVN0b3JhZ2VNYXAgPSB7XG4gICAgXCJlbnRlclwiOiAoY2xhc3NlcykgPT4ge1xuICAgICAgZWwuX3hfdHJhbnNpdGlvbi5lbnRlci5kdXJpbmcgPSBjbGFzc2VzO1xuICAgIH0sXG4gICAgXCJlbnRlci1zdGFydFwiOiAoY2xhc3NlcykgPT4ge1xuICAgICAgZWwuX3hfdHJhbnNpdGlvbi5lbnRlci5zdGFydCA9IGNsYXNzZXM7XG4gICAgfSxcbiAgICBcImVudGVyLWVuZFwiOiAoY2xhc3NlcykgPT4ge1xuICAgICAgZWwuX3hfdHJhbnNpdGlvbi5lbnRlci5lbmQgPSBjbGFzc2VzO1xuICAgIH0sXG4gICAgXCJsZWF2ZVwiOiAoY2xhc3NlcykgPT4ge1xuICAgICAgZWwuX3hfdHJhbnNpdGlvbi5sZWF2ZS5kdXJpbmcgPSBjbGFzc2VzO1xuICAgIH0sXG4gICAgXCJsZWF2ZS1zdGFydFwiOiAoY2xhc3NlcykgPT4ge1xuICAgICAgZWwuX3hfdHJhbnNpdGlvbi5sZWF2ZS5zdGFydCA9IGNsYXNzZXM7XG4gICAgfSxcbiAgICBcImxlYXZlLWVuZFwiOiAoY2xhc3NlcykgPT4ge1xuICAgICAgZWwuX3hfdHJhbnNpdGlvbi5sZWF2ZS5lbmQgPSBjbGFzc2VzO1xuICAgIH1cbiAgfTtcbiAgZGlyZWN0aXZlU3RvcmFnZU1hcFtzdGFnZV0oY2xhc3NTdHJpbmcpO1xufVxuZnVuY3Rpb24gcmVnaXN0ZXJUcmFuc2l0aW9uc0Zyb21IZWxwZXIoZWwsIG1vZGlmaWVycywgc3RhZ2UpIHtcbiAgcmVnaXN0ZXJUcmFuc2l0aW9uT2JqZWN0KGVsLCBzZXRTdHlsZXMpO1xuICBsZXQgZG9lc250U3BlY2lmeSA9ICFtb2RpZmllcnMuaW5jbHVkZXMoXCJpblwiKSAmJiAhbW9kaWZpZXJzLmluY2x1ZGVzKFwib3V0XCIpICYmICFzdGFnZTtcbiAgbGV0IHRyYW5zaXRpb25pbmdJbiA9IGRvZXNudFNwZWNpZnkgfHwgbW9kaWZpZXJzLmluY2x1ZGVzKFwiaW5cIikgfHwgW1wiZW50ZXJcIl0uaW5jbHVkZXMoc3RhZ2UpO1xuICBsZXQgdHJhbnNpdGlvbmluZ091dCA9IGRvZXNudFNwZWNpZnkgfHwgbW9kaWZpZXJzLmluY2x1ZGVzKFwib3V0XCIpIHx8IFtcImxlYXZlXCJdLmluY2x1ZGVzKHN0YWdlKTtcbiAgaWYgKG1vZGlmaWVycy5pbmNsdWRlcyhcImluXCIpICYmICFkb2VzbnRTcGVjaWZ5KSB7XG4gICAgbW9kaWZpZXJzID0gbW9kaWZpZXJzLmZpbHRlcigoaSwgaW5kZXgpID0+IGluZGV4IDwgbW9kaWZpZXJzLmluZGV4T2YoXCJvdXRcIikpO1xuICB9XG4gIGlmIChtb2RpZmllcnMuaW5jbHVkZXMoXCJvdXRcIikgJiYgIWRvZXNudFNwZWNpZnkpIHtcbiAgICBtb2RpZmllcnMgPSBtb2RpZmllcnMuZmlsdGVyKChpLCBpbmRleCkgPT4gaW5kZXggPiBtb2RpZmllcnMuaW5kZXhPZihcIm91dFwiKSk7XG4gIH1cbiAgbGV0IHdhbnRzQWxsID0gIW1vZGlmaWVycy5pbmNsdWRlcyhcIm9wYWNpdHlcIikgJiYgIW1vZGlmaWVycy5pbmNsdWRlcyhcInNjYWxlXCIpO1xuICBsZXQgd2FudHNPcGFjaXR5ID0gd2FudHNBbGwgfHwgbW9kaWZpZXJzLmluY2x1ZGVzKFwib3BhY2l0eVwiKTtcbiAgbGV0IHdhbnRzU2NhbGUgPSB3YW50c0FsbCB8fCBtb2RpZmllcnMuaW5jbHVkZXMoXCJzY2FsZVwiKTtcbiAgbGV0IG9wYWNpdHlWYWx1ZSA9IHdhbnRzT3BhY2l0eSA/IDAgOiAxO1xuICBsZXQgc2NhbGVWYWx1ZSA9IHdhbnRzU2NhbGUgPyBtb2RpZmllclZhbHVlKG1vZGlmaWVycywgXCJzY2FsZVwiLCA5NSkgLyAxMDAgOiAxO1xuICBsZXQgZGVsYXkgPSBtb2RpZmllclZhbHVlKG1vZGlmaWVycywgXCJkZWxheVwiLCAwKSAvIDFlMztcbiAgbGV0IG9yaWdpbiA9IG1vZGlmaWVyVmFsdWUobW9kaWZpZXJzLCBcIm9yaWdpblwiLCBcImNlbnRlclwiKTtcbiAgbGV0IHByb3BlcnR5ID0gXCJvcGFjaXR5LCB0cmFuc2Zvcm1cIjtcbiAgbGV0IGR1cmF0aW9uSW4gPSBtb2RpZmllclZhbHVlKG1vZGlmaWVycywgXCJkdXJhdGlvblwiLCAxNTApIC8gMWUzO1xuICBsZXQgZHVyYXRpb25PdXQgPSBtb2RpZmllclZhbHVlKG1vZGlmaWVycywgXCJkdXJhdGlvblwiLCA3NSkgLyAxZTM7XG4gIGxldCBlYXNpbmcgPSBgY3ViaWMtYmV6aWVyKDAuNCwgMC4wLCAwLjIsIDEpYDtcbiAgaWYgKHRyYW5zaXRpb25pbmdJbikge1xuICAgIGVsLl94X3RyYW5zaXRpb24uZW50ZXIuZHVyaW5nID0ge1xuICAgICAgdHJhbnNmb3JtT3JpZ2luOiBvcmlnaW4sXG4gICAgICB0cmFuc2l0aW9uRGVsYXk6IGAke2RlbGF5fXNgLFxuICAgICAgdHJhbnNpdGlvblByb3BlcnR5OiBwcm9wZXJ0eSxcbiAgICAgIHRyYW5zaXRpb25EdXJhdGlvbjogYCR7ZHVyYXRpb25Jbn1zYCxcbiAgICAgIHRyYW5zaXRpb25UaW1pbmdGdW5jdGlvbjogZWFzaW5nXG4gICAgfTtcbiAgICBlbC5feF90cmFuc2l0aW9uLmVudGVyLnN0YXJ0ID0ge1xuICAgICAgb3BhY2l0eTogb3BhY2l0eVZhbHVlLFxuICAgICAgdHJhbnNmb3JtOiBgc2NhbGUoJHtzY2FsZVZhbHVlfSlgXG4gICAgfTtcbiAgICBlbC5feF90cmFuc2l0aW9uLmVudGVyLmVuZCA9IHtcbiAgICAgIG9wYWNpdHk6IDEsXG4gICAgICB0cmFuc2Zvcm06IGBzY2FsZSgxKWBcbiAgICB9O1xuICB9XG4gIGlmICh0cmFuc2l0aW9uaW5nT3V0KSB7XG4gICAgZWwuX3hfdHJhbnNpdGlvbi5sZWF2ZS5kdXJpbmcgPSB7XG4gICAgICB0cmFuc2Zvcm1PcmlnaW46IG9yaWdpbixcbiAgICAgIHRyYW5zaXRpb25EZWxheTogYCR7ZGVsYXl9c2AsXG4gICAgICB0cmFuc2l0aW9uUHJvcGVydHk6IHByb3BlcnR5LFxuICAgICAgdHJhbnNpdGlvbkR1cmF0aW9uOiBgJHtkdXJhdGlvbk91dH1zYCxcbiAgICAgIHRyYW5zaXRpb25UaW1pbmdGdW5jdGlvbjogZWFzaW5nXG4gICAgfTtcbiAgICBlbC5feF90cmFuc2l0aW9uLmxlYXZlLnN0YXJ0ID0ge1xuICAgICAgb3BhY2l0eTogMSxcbiAgICAgIHRyYW5zZm9ybTogYHNjYWxlKDEpYFxuICAgIH07XG4gICAgZWwuX3hfdHJhbnNpdGlvbi5sZWF2ZS5lbmQgPSB7XG4gICAgICBvcGFjaXR5OiBvcGFjaXR5VmFsdWUsXG4gICAgICB0cmFuc2Zvcm06IGBzY2FsZSgke3NjYWxlVmFsdWV9KWBcbiAgICB9O1xuICB9XG59XG5mdW5jdGlvbiByZWdpc3RlclRyYW5zaXRpb25PYmplY3QoZWwsIHNldEZ1bmN0aW9uLCBkZWZhdWx0VmFsdWUgPSB7fSkge1xuICBpZiAoIWVsLl94X3RyYW5zaXRpb24pXG4gICAgZWwuX3hfdHJhbnNpdGlvbiA9IHtcbiAgICAgIGVudGVyOiB7IGR1cmluZzogZGVmYXVsdFZhbHVlLCBzdGFydDogZGVmYXVsdFZhbHVlLCBlbmQ6IGRlZmF1bHRWYWx1ZSB9LFxuICAgICAgbGVhdmU6IHsgZHVyaW5nOiBkZWZhdWx0VmFsdWUsIHN0YXJ0OiBkZWZhdWx0VmFsdWUsIGVuZDogZGVmYXVsdFZhbHVlIH0sXG4gICAgICBpbihiZWZvcmUgPSAoKSA9PiB7XG4gICAgICB9LCBhZnRlciA9ICgpID0+IHtcbiAgICAgIH0pIHtcbiAgICAgICAgdHJhbnNpdGlvbihlbCwgc2V0RnVuY3Rpb24sIHtcbiAgICAgICAgICBkdXJpbmc6IHRoaXMuZW50ZXIuZHVyaW5nLFxuICAgICAgICAgIHN0YXJ0OiB0aGlzLmVudGVyLnN0YXJ0LFxuICAgICAgICAgIGVuZDogdGhpcy5lbnRlci5lbmRcbiAgICAgICAgfSwgYmVmb3JlLCBhZnRlcik7XG4gICAgICB9LFxuICAgICAgb3V0KGJlZm9yZSA9ICgpID0+IHtcbiAgICAgIH0sIGFmdGVyID0gKCkgPT4ge1xuICAgICAgfSkge1xuICAgICAgICB0cmFuc2l0aW9uKGVsLCBzZXRGdW5jdGlvbiwge1xuICAgICAgICAgIGR1cmluZzogdGhpcy5sZWF2ZS5kdXJpbmcsXG4gICAgICAgICAgc3RhcnQ6IHRoaXMubGVhdmUuc3RhcnQsXG4gICAgICAgICAgZW5kOiB0aGlzLmxlYXZlLmVuZFxuICAgICAgICB9LCBiZWZvcmUsIGFmdGVyKTtcbiAgICAgIH1cbiAgICB9O1xufVxud2luZG93LkVsZW1lbnQucHJvdG90eXBlLl94X3RvZ2dsZUFuZENhc2NhZGVXaXRoVHJhbnNpdGlvbnMgPSBmdW5jdGlvbihlbCwgdmFsdWUsIHNob3csIGhpZGUpIHtcbiAgY29uc3QgbmV4dFRpY2syID0gZG9jdW1lbnQudmlzaWJpbGl0eVN0YXRlID09PSBcInZpc2libGVcIiA/IHJlcXVlc3RBbmltYXRpb25GcmFtZSA6IHNldFRpbWVvdXQ7XG4gIGxldCBjbGlja0F3YXlDb21wYXRpYmxlU2hvdyA9ICgpID0+IG5leHRUaWNrMihzaG93KTtcbiAgaWYgKHZhbHVlKSB7XG4gICAgaWYgKGVsLl94X3RyYW5zaXRpb24gJiYgKGVsLl94X3RyYW5zaXRpb24uZW50ZXIgfHwgZWwuX3hfdHJhbnNpdGlvbi5sZWF2ZSkpIHtcbiAgICAgIGVsLl94X3RyYW5zaXRpb24uZW50ZXIgJiYgKE9iamVjdC5lbnRyaWVzKGVsLl94X3RyYW5zaXRpb24uZW50ZXIuZHVyaW5nKS5sZW5ndGggfHwgT2JqZWN0LmVudHJpZXMoZWwuX3hfdHJhbnNpdGlvbi5lbnRlci5zdGFydCkubGVuZ3RoIHx8IE9iamVjdC5lbnRyaWVzKGVsLl94X3RyYW5zaXRpb24uZW50ZXIuZW5kKS5sZW5ndGgpID8gZWwuX3hfdHJhbnNpdGlvbi5pbihzaG93KSA6IGNsaWNrQXdheUNvbXBhdGlibGVTaG93KCk7XG4gICAgfSBlbHNlIHtcbiAgICAgIGVsLl94X3RyYW5zaXRpb24gPyBlbC5feF90cmFuc2l0aW9uLmluKHNob3cpIDogY2xpY2tBd2F5Q29tcGF0aWJsZVNob3coKTtcbiAgICB9XG4gICAgcmV0dXJuO1xuICB9XG4gIGVsLl94X2hpZGVQcm9taXNlID0gZWwuX3hfdHJhbnNpdGlvbiA/IG5ldyBQcm9taXNlKChyZXNvbHZlLCByZWplY3QpID0+IHtcbiAgICBlbC5feF90cmFuc2l0aW9uLm91dCgoKSA9PiB7XG4gICAgfSwgKCkgPT4gcmVzb2x2ZShoaWRlKSk7XG4gICAgZWwuX3hfdHJhbnNpdGlvbmluZyAmJiBlbC5feF90cmFuc2l0aW9uaW5nLmJlZm9yZUNhbmNlbCgoKSA9PiByZWplY3QoeyBpc0Zyb21DYW5jZWxsZWRUcmFuc2l0aW9uOiB0cnVlIH0pKTtcbiAgfSkgOiBQcm9taXNlLnJlc29sdmUoaGlkZSk7XG4gIHF1ZXVlTWljcm90YXNrKCgpID0+IHtcbiAgICBsZXQgY2xvc2VzdCA9IGNsb3Nlc3RIaWRlKGVsKTtcbiAgICBpZiAoY2xvc2VzdCkge1xuICAgICAgaWYgKCFjbG9zZXN0Ll94X2hpZGVDaGlsZHJlbilcbiAgICAgICAgY2xvc2VzdC5feF9oaWRlQ2hpbGRyZW4gPSBbXTtcbiAgICAgIGNsb3Nlc3QuX3hfaGlkZUNoaWxkcmVuLnB1c2goZWwpO1xuICAgIH0gZWxzZSB7XG4gICAgICBuZXh0VGljazIoKCkgPT4ge1xuICAgICAgICBsZXQgaGlkZUFmdGVyQ2hpbGRyZW4gPSAoZWwyKSA9PiB7XG4gICAgICAgICAgbGV0IGNhcnJ5ID0gUHJvbWlzZS5hbGwoW1xuICAgICAgICAgICAgZWwyLl94X2hpZGVQcm9taXNlLFxuICAgICAgICAgICAgLi4uKGVsMi5feF9oaWRlQ2hpbGRyZW4gfHwgW10pLm1hcChoaWRlQWZ0ZXJDaGlsZHJlbilcbiAgICAgICAgICBdKS50aGVuKChbaV0pID0+IGkoKSk7XG4gICAgICAgICAgZGVsZXRlIGVsMi5feF9oaWRlUHJvbWlzZTtcbiAgICAgICAgICBkZWxldGUgZWwyLl94X2hpZGVDaGlsZHJlbjtcbiAgICAgICAgICByZXR1cm4gY2Fycnk7XG4gICAgICAgIH07XG4gICAgICAgIGhpZGVBZnRlckNoaWxkcmVuKGVsKS5jYXRjaCgoZSkgPT4ge1xuICAgICAgICAgIGlmICghZS5pc0Zyb21DYW5jZWxsZWRUcmFuc2l0aW9uKVxuICAgICAgICAgICAgdGhyb3cgZTtcbiAgICAgICAgfSk7XG4gICAgICB9KTtcbiAgICB9XG4gIH0pO1xufTtcbmZ1bmN0aW9uIGNsb3Nlc3RIaWRlKGVsKSB7XG4gIGxldCBwYXJlbnQgPSBlbC5wYXJlbnROb2RlO1xuICBpZiAoIXBhcmVudClcbiAgICByZXR1cm47XG4gIHJldHVybiBwYXJlbnQuX3hfaGlkZVByb21pc2UgPyBwYXJlbnQgOiBjbG9zZXN0SGlkZShwYXJlbnQpO1xufVxuZnVuY3Rpb24gdHJhbnNpdGlvbihlbCwgc2V0RnVuY3Rpb24sIHsgZHVyaW5nLCBzdGFydDogc3RhcnQyLCBlbmQgfSA9IHt9LCBiZWZvcmUgPSAoKSA9PiB7XG59LCBhZnRlciA9ICgpID0+IHtcbn0pIHtcbiAgaWYgKGVsLl94X3RyYW5zaXRpb25pbmcpXG4gICAgZWwuX3hfdHJhbnNpdGlvbmluZy5jYW5jZWwoKTtcbiAgaWYgKE9iamVjdC5rZXlzKGR1cmluZykubGVuZ3RoID09PSAwICYmIE9iamVjdC5rZXlzKHN0YXJ0MikubGVuZ3RoID09PSAwICYmIE9iamVjdC5rZXlzKGVuZCkubGVuZ3RoID09PSAwKSB7XG4gICAgYmVmb3JlKCk7XG4gICAgYWZ0ZXIoKTtcbiAgICByZXR1cm47XG4gIH1cbiAgbGV0IHVuZG9TdGFydCwgdW5kb0R1cmluZywgdW5kb0VuZDtcbiAgcGVyZm9ybVRyYW5zaXRpb24oZWwsIHtcbiAgICBzdGFydCgpIHtcbiAgICAgIHVuZG9TdGFydCA9IHNldEZ1bmN0aW9uKGVsLCBzdGFydDIpO1xuICAgIH0sXG4gICAgZHVyaW5nKCkge1xuICAgICAgdW5kb0R1cmluZyA9IHNldEZ1bmN0aW9uKGVsLCBkdXJpbmcpO1xuICAgIH0sXG4gICAgYmVmb3JlLFxuICAgIGVuZCgpIHtcbiAgICAgIHVuZG9TdGFydCgpO1xuICAgICAgdW5kb0VuZCA9IHNldEZ1bmN0aW9uKGVsLCBlbmQpO1xuICAgIH0sXG4gICAgYWZ0ZXIsXG4gICAgY2xlYW51cCgpIHtcbiAgICAgIHVuZG9EdXJpbmcoKTtcbiAgICAgIHVuZG9FbmQoKTtcbiAgICB9XG4gIH0pO1xufVxuZnVuY3Rpb24gcGVyZm9ybVRyYW5zaXRpb24oZWwsIHN0YWdlcykge1xuICBsZXQgaW50ZXJydXB0ZWQsIHJlYWNoZWRCZWZvcmUsIHJlYWNoZWRFbmQ7XG4gIGxldCBmaW5pc2ggPSBvbmNlKCgpID0+IHtcbiAgICBtdXRhdGVEb20oKCkgPT4ge1xuICAgICAgaW50ZXJydXB0ZWQgPSB0cnVlO1xuICAgICAgaWYgKCFyZWFjaGVkQmVmb3JlKVxuICAgICAgICBzdGFnZXMuYmVmb3JlKCk7XG4gICAgICBpZiAoIXJlYWNoZWRFbmQpIHtcbiAgICAgICAgc3RhZ2VzLmVuZCgpO1xuICAgICAgICByZWxlYXNlTmV4dFRpY2tzKCk7XG4gICAgICB9XG4gICAgICBzdGFnZXMuYWZ0ZXIoKTtcbiAgICAgIGlmIChlbC5pc0Nvbm5lY3RlZClcbiAgICAgICAgc3RhZ2VzLmNsZWFudXAoKTtcbiAgICAgIGRlbGV0ZSBlbC5feF90cmFuc2l0aW9uaW5nO1xuICAgIH0pO1xuICB9KTtcbiAgZWwuX3hfdHJhbnNpdGlvbmluZyA9IHtcbiAgICBiZWZvcmVDYW5jZWxzOiBbXSxcbiAgICBiZWZvcmVDYW5jZWwoY2FsbGJhY2spIHtcbiAgICAgIHRoaXMuYmVmb3JlQ2FuY2Vscy5wdXNoKGNhbGxiYWNrKTtcbiAgICB9LFxuICAgIGNhbmNlbDogb25jZShmdW5jdGlvbigpIHtcbiAgICAgIHdoaWxlICh0aGlzLmJlZm9yZUNhbmNlbHMubGVuZ3RoKSB7XG4gICAgICAgIHRoaXMuYmVmb3JlQ2FuY2Vscy5zaGlmdCgpKCk7XG4gICAgICB9XG4gICAgICA7XG4gICAgICBmaW5pc2goKTtcbiAgICB9KSxcbiAgICBmaW5pc2hcbiAgfTtcbiAgbXV0YXRlRG9tKCgpID0+IHtcbiAgICBzdGFnZXMuc3RhcnQoKTtcbiAgICBzdGFnZXMuZHVyaW5nKCk7XG4gIH0pO1xuICBob2xkTmV4dFRpY2tzKCk7XG4gIHJlcXVlc3RBbmltYXRpb25GcmFtZSgoKSA9PiB7XG4gICAgaWYgKGludGVycnVwdGVkKVxuICAgICAgcmV0dXJuO1xuICAgIGxldCBkdXJhdGlvbiA9IE51bWJlcihnZXRDb21wdXRlZFN0eWxlKGVsKS50cmFuc2l0aW9uRHVyYXRpb24ucmVwbGFjZSgvLC4qLywgXCJcIikucmVwbGFjZShcInNcIiwgXCJcIikpICogMWUzO1xuICAgIGxldCBkZWxheSA9IE51bWJlcihnZXRDb21wdXRlZFN0eWxlKGVsKS50cmFuc2l0aW9uRGVsYXkucmVwbGFjZSgvLC4qLywgXCJcIikucmVwbGFjZShcInNcIiwgXCJcIikpICogMWUzO1xuICAgIGlmIChkdXJhdGlvbiA9PT0gMClcbiAgICAgIGR1cmF0aW9uID0gTnVtYmVyKGdldENvbXB1dGVkU3R5bGUoZWwpLmFuaW1hdGlvbkR1cmF0aW9uLnJlcGxhY2UoXCJzXCIsIFwiXCIpKSAqIDFlMztcbiAgICBtdXRhdGVEb20oKCkgPT4ge1xuICAgICAgc3RhZ2VzLmJlZm9yZSgpO1xuICAgIH0pO1xuICAgIHJlYWNoZWRCZWZvcmUgPSB0cnVlO1xuICAgIHJlcXVlc3RBbmltYXRpb25GcmFtZSgoKSA9PiB7XG4gICAgICBpZiAoaW50ZXJydXB0ZWQpXG4gICAgICAgIHJldHVybjtcbiAgICAgIG11dGF0ZURvbSgoKSA9PiB7XG4gICAgICAgIHN0YWdlcy5lbmQoKTtcbiAgICAgIH0pO1xuICAgICAgcmVsZWFzZU5leHRUaWNrcygpO1xuICAgICAgc2V0VGltZW91dChlbC5feF90cmFuc2l0aW9uaW5nLmZpbmlzaCwgZHVyYXRpb24gKyBkZWxheSk7XG4gICAgICByZWFjaGVkRW5kID0gdHJ1ZTtcbiAgICB9KTtcbiAgfSk7XG59XG5mdW5jdGlvbiBtb2RpZmllclZhbHVlKG1vZGlmaWVycywga2V5LCBmYWxsYmFjaykge1xuICBpZiAobW9kaWZpZXJzLmluZGV4T2Yoa2V5KSA9PT0gLTEpXG4gICAgcmV0dXJuIGZhbGxiYWNrO1xuICBjb25zdCByYXdWYWx1ZSA9IG1vZGlmaWVyc1ttb2RpZmllcnMuaW5kZXhPZihrZXkpICsgMV07XG4gIGlmICghcmF3VmFsdWUpXG4gICAgcmV0dXJuIGZhbGxiYWNrO1xuICBpZiAoa2V5ID09PSBcInNjYWxlXCIpIHtcbiAgICBpZiAoaXNOYU4ocmF3VmFsdWUpKVxuICAgICAgcmV0dXJuIGZhbGxiYWNrO1xuICB9XG4gIGlmIChrZXkgPT09IFwiZHVyYXRpb25cIiB8fCBrZXkgPT09IFwiZGVsYXlcIikge1xuICAgIGxldCBtYXRjaCA9IHJhd1ZhbHVlLm1hdGNoKC8oWzAtOV0rKW1zLyk7XG4gICAgaWYgKG1hdGNoKVxuICAgICAgcmV0dXJuIG1hdGNoWzFdO1xuICB9XG4gIGlmIChrZXkgPT09IFwib3JpZ2luXCIpIHtcbiAgICBpZiAoW1widG9wXCIsIFwicmlnaHRcIiwgXCJsZWZ0XCIsIFwiY2VudGVyXCIsIFwiYm90dG9tXCJdLmluY2x1ZGVzKG1vZGlmaWVyc1ttb2RpZmllcnMuaW5kZXhPZihrZXkpICsgMl0pKSB7XG4gICAgICByZXR1cm4gW3Jhd1ZhbHVlLCBtb2RpZmllcnNbbW9kaWZpZXJzLmluZGV4T2Yoa2V5KSArIDJdXS5qb2luKFwiIFwiKTtcbiAgICB9XG4gIH1cbiAgcmV0dXJuIHJhd1ZhbHVlO1xufVxuXG4vLyBwYWNrYWdlcy9hbHBpbmVqcy9zcmMvY2xvbmUuanNcbnZhciBpc0Nsb25pbmcgPSBmYWxzZTtcbmZ1bmN0aW9uIHNraXBEdXJpbmdDbG9uZShjYWxsYmFjaywgZmFsbGJhY2sgPSAoKSA9PiB7XG59KSB7XG4gIHJldHVybiAoLi4uYXJncykgPT4gaXNDbG9uaW5nID8gZmFsbGJhY2soLi4uYXJncykgOiBjYWxsYmFjayguLi5hcmdzKTtcbn1cbmZ1bmN0aW9uIG9ubHlEdXJpbmdDbG9uZShjYWxsYmFjaykge1xuICByZXR1cm4gKC4uLmFyZ3MpID0+IGlzQ2xvbmluZyAmJiBjYWxsYmFjayguLi5hcmdzKTtcbn1cbnZhciBpbnRlcmNlcHRvcnMgPSBbXTtcbmZ1bmN0aW9uIGludGVyY2VwdENsb25lKGNhbGxiYWNrKSB7XG4gIGludGVyY2VwdG9ycy5wdXNoKGNhbGxiYWNrKTtcbn1cbmZ1bmN0aW9uIGNsb25lTm9kZShmcm9tLCB0bykge1xuICBpbnRlcmNlcHRvcnMuZm9yRWFjaCgoaSkgPT4gaShmcm9tLCB0bykpO1xuICBpc0Nsb25pbmcgPSB0cnVlO1xuICBkb250UmVnaXN0ZXJSZWFjdGl2ZVNpZGVFZmZlY3RzKCgpID0+IHtcbiAgICBpbml0VHJlZSh0bywgKGVsLCBjYWxsYmFjaykgPT4ge1xuICAgICAgY2FsbGJhY2soZWwsICgpID0+IHtcbiAgICAgIH0pO1xuICAgIH0pO1xuICB9KTtcbiAgaXNDbG9uaW5nID0gZmFsc2U7XG59XG52YXIgaXNDbG9uaW5nTGVnYWN5ID0gZmFsc2U7XG5mdW5jdGlvbiBjbG9uZShvbGRFbCwgbmV3RWwpIHtcbiAgaWYgKCFuZXdFbC5feF9kYXRhU3RhY2spXG4gICAgbmV3RWwuX3hfZGF0YVN0YWNrID0gb2xkRWwuX3hfZGF0YVN0YWNrO1xuICBpc0Nsb25pbmcgPSB0cnVlO1xuICBpc0Nsb25pbmdMZWdhY3kgPSB0cnVlO1xuICBkb250UmVnaXN0ZXJSZWFjdGl2ZVNpZGVFZmZlY3RzKCgpID0+IHtcbiAgICBjbG9uZVRyZWUobmV3RWwpO1xuICB9KTtcbiAgaXNDbG9uaW5nID0gZmFsc2U7XG4gIGlzQ2xvbmluZ0xlZ2FjeSA9IGZhbHNlO1xufVxuZnVuY3Rpb24gY2xvbmVUcmVlKGVsKSB7XG4gIGxldCBoYXNSdW5UaHJvdWdoRmlyc3RFbCA9IGZhbHNlO1xuICBsZXQgc2hhbGxvd1dhbGtlciA9IChlbDIsIGNhbGxiYWNrKSA9PiB7XG4gICAgd2FsayhlbDIsIChlbDMsIHNraXApID0+IHtcbiAgICAgIGlmIChoYXNSdW5UaHJvdWdoRmlyc3RFbCAmJiBpc1Jvb3QoZWwzKSlcbiAgICAgICAgcmV0dXJuIHNraXAoKTtcbiAgICAgIGhhc1J1blRocm91Z2hGaXJzdEVsID0gdHJ1ZTtcbiAgICAgIGNhbGxiYWNrKGVsMywgc2tpcCk7XG4gICAgfSk7XG4gIH07XG4gIGluaXRUcmVlKGVsLCBzaGFsbG93V2Fsa2VyKTtcbn1cbmZ1bmN0aW9uIGRvbnRSZWdpc3RlclJlYWN0aXZlU2lkZUVmZmVjdHMoY2FsbGJhY2spIHtcbiAgbGV0IGNhY2hlID0gZWZmZWN0O1xuICBvdmVycmlkZUVmZmVjdCgoY2FsbGJhY2syLCBlbCkgPT4ge1xuICAgIGxldCBzdG9yZWRFZmZlY3QgPSBjYWNoZShjYWxsYmFjazIpO1xuICAgIHJlbGVhc2Uoc3RvcmVkRWZmZWN0KTtcbiAgICByZXR1cm4gKCkgPT4ge1xuICAgIH07XG4gIH0pO1xuICBjYWxsYmFjaygpO1xuICBvdmVycmlkZUVmZmVjdChjYWNoZSk7XG59XG5cbi8vIHBhY2thZ2VzL2FscGluZWpzL3NyYy91dGlscy9iaW5kLmpzXG5mdW5jdGlvbiBiaW5kKGVsLCBuYW1lLCB2YWx1ZSwgbW9kaWZpZXJzID0gW10pIHtcbiAgaWYgKCFlbC5feF9iaW5kaW5ncylcbiAgICBlbC5feF9iaW5kaW5ncyA9IHJlYWN0aXZlKHt9KTtcbiAgZWwuX3hfYmluZGluZ3NbbmFtZV0gPSB2YWx1ZTtcbiAgbmFtZSA9IG1vZGlmaWVycy5pbmNsdWRlcyhcImNhbWVsXCIpID8gY2FtZWxDYXNlKG5hbWUpIDogbmFtZTtcbiAgc3dpdGNoIChuYW1lKSB7XG4gICAgY2FzZSBcInZhbHVlXCI6XG4gICAgICBiaW5kSW5wdXRWYWx1ZShlbCwgdmFsdWUpO1xuICAgICAgYnJlYWs7XG4gICAgY2FzZSBcInN0eWxlXCI6XG4gICAgICBiaW5kU3R5bGVzKGVsLCB2YWx1ZSk7XG4gICAgICBicmVhaztcbiAgICBjYXNlIFwiY2xhc3NcIjpcbiAgICAgIGJpbmRDbGFzc2VzKGVsLCB2YWx1ZSk7XG4gICAgICBicmVhaztcbiAgICBjYXNlIFwic2VsZWN0ZWRcIjpcbiAgICBjYXNlIFwiY2hlY2tlZFwiOlxuICAgICAgYmluZEF0dHJpYnV0ZUFuZFByb3BlcnR5KGVsLCBuYW1lLCB2YWx1ZSk7XG4gICAgICBicmVhaztcbiAgICBkZWZhdWx0OlxuICAgICAgYmluZEF0dHJpYnV0ZShlbCwgbmFtZSwgdmFsdWUpO1xuICAgICAgYnJlYWs7XG4gIH1cbn1cbmZ1bmN0aW9uIGJpbmRJbnB1dFZhbHVlKGVsLCB2YWx1ZSkge1xuICBpZiAoZWwudHlwZSA9PT0gXCJyYWRpb1wiKSB7XG4gICAgaWYgKGVsLmF0dHJpYnV0ZXMudmFsdWUgPT09IHZvaWQgMCkge1xuICAgICAgZWwudmFsdWUgPSB2YWx1ZTtcbiAgICB9XG4gICAgaWYgKHdpbmRvdy5mcm9tTW9kZWwpIHtcbiAgICAgIGlmICh0eXBlb2YgdmFsdWUgPT09IFwiYm9vbGVhblwiKSB7XG4gICAgICAgIGVsLmNoZWNrZWQgPSBzYWZlUGFyc2VCb29sZWFuKGVsLnZhbHVlKSA9PT0gdmFsdWU7XG4gICAgICB9IGVsc2Uge1xuICAgICAgICBlbC5jaGVja2VkID0gY2hlY2tlZEF0dHJMb29zZUNvbXBhcmUoZWwudmFsdWUsIHZhbHVlKTtcbiAgICAgIH1cbiAgICB9XG4gIH0gZWxzZSBpZiAoZWwudHlwZSA9PT0gXCJjaGVja2JveFwiKSB7XG4gICAgaWYgKE51bWJlci5pc0ludGVnZXIodmFsdWUpKSB7XG4gICAgICBlbC52YWx1ZSA9IHZhbHVlO1xuICAgIH0gZWxzZSBpZiAoIUFycmF5LmlzQXJyYXkodmFsdWUpICYmIHR5cGVvZiB2YWx1ZSAhPT0gXCJib29sZWFuXCIgJiYgIVtudWxsLCB2b2lkIDBdLmluY2x1ZGVzKHZhbHVlKSkge1xuICAgICAgZWwudmFsdWUgPSBTdHJpbmcodmFsdWUpO1xuICAgIH0gZWxzZSB7XG4gICAgICBpZiAoQXJyYXkuaXNBcnJheSh2YWx1ZSkpIHtcbiAgICAgICAgZWwuY2hlY2tlZCA9IHZhbHVlLnNvbWUoKHZhbCkgPT4gY2hlY2tlZEF0dHJMb29zZUNvbXBhcmUodmFsLCBlbC52YWx1ZSkpO1xuICAgICAgfSBlbHNlIHtcbiAgICAgICAgZWwuY2hlY2tlZCA9ICEhdmFsdWU7XG4gICAgICB9XG4gICAgfVxuICB9IGVsc2UgaWYgKGVsLnRhZ05hbWUgPT09IFwiU0VMRUNUXCIpIHtcbiAgICB1cGRhdGVTZWxlY3QoZWwsIHZhbHVlKTtcbiAgfSBlbHNlIHtcbiAgICBpZiAoZWwudmFsdWUgPT09IHZhbHVlKVxuICAgICAgcmV0dXJuO1xuICAgIGVsLnZhbHVlID0gdmFsdWUgPT09IHZvaWQgMCA/IFwiXCIgOiB2YWx1ZTtcbiAgfVxufVxuZnVuY3Rpb24gYmluZENsYXNzZXMoZWwsIHZhbHVlKSB7XG4gIGlmIChlbC5feF91bmRvQWRkZWRDbGFzc2VzKVxuICAgIGVsLl94X3VuZG9BZGRlZENsYXNzZXMoKTtcbiAgZWwuX3hfdW5kb0FkZGVkQ2xhc3NlcyA9IHNldENsYXNzZXMoZWwsIHZhbHVlKTtcbn1cbmZ1bmN0aW9uIGJpbmRTdHlsZXMoZWwsIHZhbHVlKSB7XG4gIGlmIChlbC5feF91bmRvQWRkZWRTdHlsZXMpXG4gICAgZWwuX3hfdW5kb0FkZGVkU3R5bGVzKCk7XG4gIGVsLl94X3VuZG9BZGRlZFN0eWxlcyA9IHNldFN0eWxlcyhlbCwgdmFsdWUpO1xufVxuZnVuY3Rpb24gYmluZEF0dHJpYnV0ZUFuZFByb3BlcnR5KGVsLCBuYW1lLCB2YWx1ZSkge1xuICBiaW5kQXR0cmlidXRlKGVsLCBuYW1lLCB2YWx1ZSk7XG4gIHNldFByb3BlcnR5SWZDaGFuZ2VkKGVsLCBuYW1lLCB2YWx1ZSk7XG59XG5mdW5jdGlvbiBiaW5kQXR0cmlidXRlKGVsLCBuYW1lLCB2YWx1ZSkge1xuICBpZiAoW251bGwsIHZvaWQgMCwgZmFsc2VdLmluY2x1ZGVzKHZhbHVlKSAmJiBhdHRyaWJ1dGVTaG91bGRudEJlUHJlc2VydmVkSWZGYWxzeShuYW1lKSkge1xuICAgIGVsLnJlbW92ZUF0dHJpYnV0ZShuYW1lKTtcbiAgfSBlbHNlIHtcbiAgICBpZiAoaXNCb29sZWFuQXR0cihuYW1lKSlcbiAgICAgIHZhbHVlID0gbmFtZTtcbiAgICBzZXRJZkNoYW5nZWQoZWwsIG5hbWUsIHZhbHVlKTtcbiAgfVxufVxuZnVuY3Rpb24gc2V0SWZDaGFuZ2VkKGVsLCBhdHRyTmFtZSwgdmFsdWUpIHtcbiAgaWYgKGVsLmdldEF0dHJpYnV0ZShhdHRyTmFtZSkgIT0gdmFsdWUpIHtcbiAgICBlbC5zZXRBdHRyaWJ1dGUoYXR0ck5hbWUsIHZhbHVlKTtcbiAgfVxufVxuZnVuY3Rpb24gc2V0UHJvcGVydHlJZkNoYW5nZWQoZWwsIHByb3BOYW1lLCB2YWx1ZSkge1xuICBpZiAoZWxbcHJvcE5hbWVdICE9PSB2YWx1ZSkge1xuICAgIGVsW3Byb3BOYW1lXSA9IHZhbHVlO1xuICB9XG59XG5mdW5jdGlvbiB1cGRhdGVTZWxlY3QoZWwsIHZhbHVlKSB7XG4gIGNvbnN0IGFycmF5V3JhcHBlZFZhbHVlID0gW10uY29uY2F0KHZhbHVlKS5tYXAoKHZhbHVlMikgPT4ge1xuICAgIHJldHVybiB2YWx1ZTIgKyBcIlwiO1xuICB9KTtcbiAgQXJyYXkuZnJvbShlbC5vcHRpb25zKS5mb3JFYWNoKChvcHRpb24pID0+IHtcbiAgICBvcHRpb24uc2VsZWN0ZWQgPSBhcnJheVdyYXBwZWRWYWx1ZS5pbmNsdWRlcyhvcHRpb24udmFsdWUpO1xuICB9KTtcbn1cbmZ1bmN0aW9uIGNhbWVsQ2FzZShzdWJqZWN0KSB7XG4gIHJldHVybiBzdWJqZWN0LnRvTG93ZXJDYXNlKCkucmVwbGFjZSgvLShcXHcpL2csIChtYXRjaCwgY2hhcikgPT4gY2hhci50b1VwcGVyQ2FzZSgpKTtcbn1cbmZ1bmN0aW9uIGNoZWNrZWRBdHRyTG9vc2VDb21wYXJlKHZhbHVlQSwgdmFsdWVCKSB7XG4gIHJldHVybiB2YWx1ZUEgPT0gdmFsdWVCO1xufVxuZnVuY3Rpb24gc2FmZVBhcnNlQm9vbGVhbihyYXdWYWx1ZSkge1xuICBpZiAoWzEsIFwiMVwiLCBcInRydWVcIiwgXCJvblwiLCBcInllc1wiLCB0cnVlXS5pbmNsdWRlcyhyYXdWYWx1ZSkpIHtcbiAgICByZXR1cm4gdHJ1ZTtcbiAgfVxuICBpZiAoWzAsIFwiMFwiLCBcImZhbHNlXCIsIFwib2ZmXCIsIFwibm9cIiwgZmFsc2VdLmluY2x1ZGVzKHJhd1ZhbHVlKSkge1xuICAgIHJldHVybiBmYWxzZTtcbiAgfVxuICByZXR1cm4gcmF3VmFsdWUgPyBCb29sZWFuKHJhd1ZhbHVlKSA6IG51bGw7XG59XG5mdW5jdGlvbiBpc0Jvb2xlYW5BdHRyKGF0dHJOYW1lKSB7XG4gIGNvbnN0IGJvb2xlYW5BdHRyaWJ1dGVzID0gW1xuICAgIFwiZGlzYWJsZWRcIixcbiAgICBcImNoZWNrZWRcIixcbiAgICBcInJlcXVpcmVkXCIsXG4gICAgXCJyZWFkb25seVwiLFxuICAgIFwiaGlkZGVuXCIsXG4gICAgXCJvcGVuXCIsXG4gICAgXCJzZWxlY3RlZFwiLFxuICAgIFwiYXV0b2ZvY3VzXCIsXG4gICAgXCJpdGVtc2NvcGVcIixcbiAgICBcIm11bHRpcGxlXCIsXG4gICAgXCJub3ZhbGlkYXRlXCIsXG4gICAgXCJhbGxvd2Z1bGxzY3JlZW5cIixcbiAgICBcImFsbG93cGF5bWVudHJlcXVlc3RcIixcbiAgICBcImZvcm1ub3ZhbGlkYXRlXCIsXG4gICAgXCJhdXRvcGxheVwiLFxuICAgIFwiY29udHJvbHNcIixcbiAgICBcImxvb3BcIixcbiAgICBcIm11dGVkXCIsXG4gICAgXCJwbGF5c2lubGluZVwiLFxuICAgIFwiZGVmYXVsdFwiLFxuICAgIFwiaXNtYXBcIixcbiAgICBcInJldmVyc2VkXCIsXG4gICAgXCJhc3luY1wiLFxuICAgIFwiZGVmZXJcIixcbiAgICBcIm5vbW9kdWxlXCJcbiAgXTtcbiAgcmV0dXJuIGJvb2xlYW5BdHRyaWJ1dGVzLmluY2x1ZGVzKGF0dHJOYW1lKTtcbn1cbmZ1bmN0aW9uIGF0dHJpYnV0ZVNob3VsZG50QmVQcmVzZXJ2ZWRJZkZhbHN5KG5hbWUpIHtcbiAgcmV0dXJuICFbXCJhcmlhLXByZXNzZWRcIiwgXCJhcmlhLWNoZWNrZWRcIiwgXCJhcmlhLWV4cGFuZGVkXCIsIFwiYXJpYS1zZWxlY3RlZFwiXS5pbmNsdWRlcyhuYW1lKTtcbn1cbmZ1bmN0aW9uIGdldEJpbmRpbmcoZWwsIG5hbWUsIGZhbGxiYWNrKSB7XG4gIGlmIChlbC5feF9iaW5kaW5ncyAmJiBlbC5feF9iaW5kaW5nc1tuYW1lXSAhPT0gdm9pZCAwKVxuICAgIHJldHVybiBlbC5feF9iaW5kaW5nc1tuYW1lXTtcbiAgcmV0dXJuIGdldEF0dHJpYnV0ZUJpbmRpbmcoZWwsIG5hbWUsIGZhbGxiYWNrKTtcbn1cbmZ1bmN0aW9uIGV4dHJhY3RQcm9wKGVsLCBuYW1lLCBmYWxsYmFjaywgZXh0cmFjdCA9IHRydWUpIHtcbiAgaWYgKGVsLl94X2JpbmRpbmdzICYmIGVsLl94X2JpbmRpbmdzW25hbWVdICE9PSB2b2lkIDApXG4gICAgcmV0dXJuIGVsLl94X2JpbmRpbmdzW25hbWVdO1xuICBpZiAoZWwuX3hfaW5saW5lQmluZGluZ3MgJiYgZWwuX3hfaW5saW5lQmluZGluZ3NbbmFtZV0gIT09IHZvaWQgMCkge1xuICAgIGxldCBiaW5kaW5nID0gZWwuX3hfaW5saW5lQmluZGluZ3NbbmFtZV07XG4gICAgYmluZGluZy5leHRyYWN0ID0gZXh0cmFjdDtcbiAgICByZXR1cm4gZG9udEF1dG9FdmFsdWF0ZUZ1bmN0aW9ucygoKSA9PiB7XG4gICAgICByZXR1cm4gZXZhbHVhdGUoZWwsIGJpbmRpbmcuZXhwcmVzc2lvbik7XG4gICAgfSk7XG4gIH1cbiAgcmV0dXJuIGdldEF0dHJpYnV0ZUJpbmRpbmcoZWwsIG5hbWUsIGZhbGxiYWNrKTtcbn1cbmZ1bmN0aW9uIGdldEF0dHJpYnV0ZUJpbmRpbmcoZWwsIG5hbWUsIGZhbGxiYWNrKSB7XG4gIGxldCBhdHRyID0gZWwuZ2V0QXR0cmlidXRlKG5hbWUpO1xuICBpZiAoYXR0ciA9PT0gbnVsbClcbiAgICByZXR1cm4gdHlwZW9mIGZhbGxiYWNrID09PSBcImZ1bmN0aW9uXCIgPyBmYWxsYmFjaygpIDogZmFsbGJhY2s7XG4gIGlmIChhdHRyID09PSBcIlwiKVxuICAgIHJldHVybiB0cnVlO1xuICBpZiAoaXNCb29sZWFuQXR0cihuYW1lKSkge1xuICAgIHJldHVybiAhIVtuYW1lLCBcInRydWVcIl0uaW5jbHVkZXMoYXR0cik7XG4gIH1cbiAgcmV0dXJuIGF0dHI7XG59XG5cbi8vIHBhY2thZ2VzL2FscGluZWpzL3NyYy91dGlscy9kZWJvdW5jZS5qc1xuZnVuY3Rpb24gZGVib3VuY2UoZnVuYywgd2FpdCkge1xuICB2YXIgdGltZW91dDtcbiAgcmV0dXJuIGZ1bmN0aW9uKCkge1xuICAgIHZhciBjb250ZXh0ID0gdGhpcywgYXJncyA9IGFyZ3VtZW50cztcbiAgICB2YXIgbGF0ZXIgPSBmdW5jdGlvbigpIHtcbiAgICAgIHRpbWVvdXQgPSBudWxsO1xuICAgICAgZnVuYy5hcHBseShjb250ZXh0LCBhcmdzKTtcbiAgICB9O1xuICAgIGNsZWFyVGltZW91dCh0aW1lb3V0KTtcbiAgICB0aW1lb3V0ID0gc2V0VGltZW91dChsYXRlciwgd2FpdCk7XG4gIH07XG59XG5cbi8vIHBhY2thZ2VzL2FscGluZWpzL3NyYy91dGlscy90aHJvdHRsZS5qc1xuZnVuY3Rpb24gdGhyb3R0bGUoZnVuYywgbGltaXQpIHtcbiAgbGV0IGluVGhyb3R0bGU7XG4gIHJldHVybiBmdW5jdGlvbigpIHtcbiAgICBsZXQgY29udGV4dCA9IHRoaXMsIGFyZ3MgPSBhcmd1bWVudHM7XG4gICAgaWYgKCFpblRocm90dGxlKSB7XG4gICAgICBmdW5jLmFwcGx5KGNvbnRleHQsIGFyZ3MpO1xuICAgICAgaW5UaHJvdHRsZSA9IHRydWU7XG4gICAgICBzZXRUaW1lb3V0KCgpID0+IGluVGhyb3R0bGUgPSBmYWxzZSwgbGltaXQpO1xuICAgIH1cbiAgfTtcbn1cblxuLy8gcGFja2FnZXMvYWxwaW5lanMvc3JjL2VudGFuZ2xlLmpzXG5mdW5jdGlvbiBlbnRhbmdsZSh7IGdldDogb3V0ZXJHZXQsIHNldDogb3V0ZXJTZXQgfSwgeyBnZXQ6IGlubmVyR2V0LCBzZXQ6IGlubmVyU2V0IH0pIHtcbiAgbGV0IGZpcnN0UnVuID0gdHJ1ZTtcbiAgbGV0IG91dGVySGFzaDtcbiAgbGV0IGlubmVySGFzaDtcbiAgbGV0IHJlZmVyZW5jZSA9IGVmZmVjdCgoKSA9PiB7XG4gICAgbGV0IG91dGVyID0gb3V0ZXJHZXQoKTtcbiAgICBsZXQgaW5uZXIgPSBpbm5lckdldCgpO1xuICAgIGlmIChmaXJzdFJ1bikge1xuICAgICAgaW5uZXJTZXQoY2xvbmVJZk9iamVjdChvdXRlcikpO1xuICAgICAgZmlyc3RSdW4gPSBmYWxzZTtcbiAgICB9IGVsc2Uge1xuICAgICAgbGV0IG91dGVySGFzaExhdGVzdCA9IEpTT04uc3RyaW5naWZ5KG91dGVyKTtcbiAgICAgIGxldCBpbm5lckhhc2hMYXRlc3QgPSBKU09OLnN0cmluZ2lmeShpbm5lcik7XG4gICAgICBpZiAob3V0ZXJIYXNoTGF0ZXN0ICE9PSBvdXRlckhhc2gpIHtcbiAgICAgICAgaW5uZXJTZXQoY2xvbmVJZk9iamVjdChvdXRlcikpO1xuICAgICAgfSBlbHNlIGlmIChvdXRlckhhc2hMYXRlc3QgIT09IGlubmVySGFzaExhdGVzdCkge1xuICAgICAgICBvdXRlclNldChjbG9uZUlmT2JqZWN0KGlubmVyKSk7XG4gICAgICB9IGVsc2Uge1xuICAgICAgfVxuICAgIH1cbiAgICBvdXRlckhhc2ggPSBKU09OLnN0cmluZ2lmeShvdXRlckdldCgpKTtcbiAgICBpbm5lckhhc2ggPSBKU09OLnN0cmluZ2lmeShpbm5lckdldCgpKTtcbiAgfSk7XG4gIHJldHVybiAoKSA9PiB7XG4gICAgcmVsZWFzZShyZWZlcmVuY2UpO1xuICB9O1xufVxuZnVuY3Rpb24gY2xvbmVJZk9iamVjdCh2YWx1ZSkge1xuICByZXR1cm4gdHlwZW9mIHZhbHVlID09PSBcIm9iamVjdFwiID8gSlNPTi5wYXJzZShKU09OLnN0cmluZ2lmeSh2YWx1ZSkpIDogdmFsdWU7XG59XG5cbi8vIHBhY2thZ2VzL2FscGluZWpzL3NyYy9wbHVnaW4uanNcbmZ1bmN0aW9uIHBsdWdpbihjYWxsYmFjaykge1xuICBsZXQgY2FsbGJhY2tzID0gQXJyYXkuaXNBcnJheShjYWxsYmFjaykgPyBjYWxsYmFjayA6IFtjYWxsYmFja107XG4gIGNhbGxiYWNrcy5mb3JFYWNoKChpKSA9PiBpKGFscGluZV9kZWZhdWx0KSk7XG59XG5cbi8vIHBhY2thZ2VzL2FscGluZWpzL3NyYy9zdG9yZS5qc1xudmFyIHN0b3JlcyA9IHt9O1xudmFyIGlzUmVhY3RpdmUgPSBmYWxzZTtcbmZ1bmN0aW9uIHN0b3JlKG5hbWUsIHZhbHVlKSB7XG4gIGlmICghaXNSZWFjdGl2ZSkge1xuICAgIHN0b3JlcyA9IHJlYWN0aXZlKHN0b3Jlcyk7XG4gICAgaXNSZWFjdGl2ZSA9IHRydWU7XG4gIH1cbiAgaWYgKHZhbHVlID09PSB2b2lkIDApIHtcbiAgICByZXR1cm4gc3RvcmVzW25hbWVdO1xuICB9XG4gIHN0b3Jlc1tuYW1lXSA9IHZhbHVlO1xuICBpZiAodHlwZW9mIHZhbHVlID09PSBcIm9iamVjdFwiICYmIHZhbHVlICE9PSBudWxsICYmIHZhbHVlLmhhc093blByb3BlcnR5KFwiaW5pdFwiKSAmJiB0eXBlb2YgdmFsdWUuaW5pdCA9PT0gXCJmdW5jdGlvblwiKSB7XG4gICAgc3RvcmVzW25hbWVdLmluaXQoKTtcbiAgfVxuICBpbml0SW50ZXJjZXB0b3JzMihzdG9yZXNbbmFtZV0pO1xufVxuZnVuY3Rpb24gZ2V0U3RvcmVzKCkge1xuICByZXR1cm4gc3RvcmVzO1xufVxuXG4vLyBwYWNrYWdlcy9hbHBpbmVqcy9zcmMvYmluZHMuanNcbnZhciBiaW5kcyA9IHt9O1xuZnVuY3Rpb24gYmluZDIobmFtZSwgYmluZGluZ3MpIHtcbiAgbGV0IGdldEJpbmRpbmdzID0gdHlwZW9mIGJpbmRpbmdzICE9PSBcImZ1bmN0aW9uXCIgPyAoKSA9PiBiaW5kaW5ncyA6IGJpbmRpbmdzO1xuICBpZiAobmFtZSBpbnN0YW5jZW9mIEVsZW1lbnQpIHtcbiAgICByZXR1cm4gYXBwbHlCaW5kaW5nc09iamVjdChuYW1lLCBnZXRCaW5kaW5ncygpKTtcbiAgfSBlbHNlIHtcbiAgICBiaW5kc1tuYW1lXSA9IGdldEJpbmRpbmdzO1xuICB9XG4gIHJldHVybiAoKSA9PiB7XG4gIH07XG59XG5mdW5jdGlvbiBpbmplY3RCaW5kaW5nUHJvdmlkZXJzKG9iaikge1xuICBPYmplY3QuZW50cmllcyhiaW5kcykuZm9yRWFjaCgoW25hbWUsIGNhbGxiYWNrXSkgPT4ge1xuICAgIE9iamVjdC5kZWZpbmVQcm9wZXJ0eShvYmosIG5hbWUsIHtcbiAgICAgIGdldCgpIHtcbiAgICAgICAgcmV0dXJuICguLi5hcmdzKSA9PiB7XG4gICAgICAgICAgcmV0dXJuIGNhbGxiYWNrKC4uLmFyZ3MpO1xuICAgICAgICB9O1xuICAgICAgfVxuICAgIH0pO1xuICB9KTtcbiAgcmV0dXJuIG9iajtcbn1cbmZ1bmN0aW9uIGFwcGx5QmluZGluZ3NPYmplY3QoZWwsIG9iaiwgb3JpZ2luYWwpIHtcbiAgbGV0IGNsZWFudXBSdW5uZXJzID0gW107XG4gIHdoaWxlIChjbGVhbnVwUnVubmVycy5sZW5ndGgpXG4gICAgY2xlYW51cFJ1bm5lcnMucG9wKCkoKTtcbiAgbGV0IGF0dHJpYnV0ZXMgPSBPYmplY3QuZW50cmllcyhvYmopLm1hcCgoW25hbWUsIHZhbHVlXSkgPT4gKHsgbmFtZSwgdmFsdWUgfSkpO1xuICBsZXQgc3RhdGljQXR0cmlidXRlcyA9IGF0dHJpYnV0ZXNPbmx5KGF0dHJpYnV0ZXMpO1xuICBhdHRyaWJ1dGVzID0gYXR0cmlidXRlcy5tYXAoKGF0dHJpYnV0ZSkgPT4ge1xuICAgIGlmIChzdGF0aWNBdHRyaWJ1dGVzLmZpbmQoKGF0dHIpID0+IGF0dHIubmFtZSA9PT0gYXR0cmlidXRlLm5hbWUpKSB7XG4gICAgICByZXR1cm4ge1xuICAgICAgICBuYW1lOiBgeC1iaW5kOiR7YXR0cmlidXRlLm5hbWV9YCxcbiAgICAgICAgdmFsdWU6IGBcIiR7YXR0cmlidXRlLnZhbHVlfVwiYFxuICAgICAgfTtcbiAgICB9XG4gICAgcmV0dXJuIGF0dHJpYnV0ZTtcbiAgfSk7XG4gIGRpcmVjdGl2ZXMoZWwsIGF0dHJpYnV0ZXMsIG9yaWdpbmFsKS5tYXAoKGhhbmRsZSkgPT4ge1xuICAgIGNsZWFudXBSdW5uZXJzLnB1c2goaGFuZGxlLnJ1bkNsZWFudXBzKTtcbiAgICBoYW5kbGUoKTtcbiAgfSk7XG4gIHJldHVybiAoKSA9PiB7XG4gICAgd2hpbGUgKGNsZWFudXBSdW5uZXJzLmxlbmd0aClcbiAgICAgIGNsZWFudXBSdW5uZXJzLnBvcCgpKCk7XG4gIH07XG59XG5cbi8vIHBhY2thZ2VzL2FscGluZWpzL3NyYy9kYXRhcy5qc1xudmFyIGRhdGFzID0ge307XG5mdW5jdGlvbiBkYXRhKG5hbWUsIGNhbGxiYWNrKSB7XG4gIGRhdGFzW25hbWVdID0gY2FsbGJhY2s7XG59XG5mdW5jdGlvbiBpbmplY3REYXRhUHJvdmlkZXJzKG9iaiwgY29udGV4dCkge1xuICBPYmplY3QuZW50cmllcyhkYXRhcykuZm9yRWFjaCgoW25hbWUsIGNhbGxiYWNrXSkgPT4ge1xuICAgIE9iamVjdC5kZWZpbmVQcm9wZXJ0eShvYmosIG5hbWUsIHtcbiAgICAgIGdldCgpIHtcbiAgICAgICAgcmV0dXJuICguLi5hcmdzKSA9PiB7XG4gICAgICAgICAgcmV0dXJuIGNhbGxiYWNrLmJpbmQoY29udGV4dCkoLi4uYXJncyk7XG4gICAgICAgIH07XG4gICAgICB9LFxuICAgICAgZW51bWVyYWJsZTogZmFsc2VcbiAgICB9KTtcbiAgfSk7XG4gIHJldHVybiBvYmo7XG59XG5cbi8vIHBhY2thZ2VzL2FscGluZWpzL3NyYy9hbHBpbmUuanNcbnZhciBBbHBpbmUgPSB7XG4gIGdldCByZWFjdGl2ZSgpIHtcbiAgICByZXR1cm4gcmVhY3RpdmU7XG4gIH0sXG4gIGdldCByZWxlYXNlKCkge1xuICAgIHJldHVybiByZWxlYXNlO1xuICB9LFxuICBnZXQgZWZmZWN0KCkge1xuICAgIHJldHVybiBlZmZlY3Q7XG4gIH0sXG4gIGdldCByYXcoKSB7XG4gICAgcmV0dXJuIHJhdztcbiAgfSxcbiAgdmVyc2lvbjogXCIzLjEzLjdcIixcbiAgZmx1c2hBbmRTdG9wRGVmZXJyaW5nTXV0YXRpb25zLFxuICBkb250QXV0b0V2YWx1YXRlRnVuY3Rpb25zLFxuICBkaXNhYmxlRWZmZWN0U2NoZWR1bGluZyxcbiAgc3RhcnRPYnNlcnZpbmdNdXRhdGlvbnMsXG4gIHN0b3BPYnNlcnZpbmdNdXRhdGlvbnMsXG4gIHNldFJlYWN0aXZpdHlFbmdpbmUsXG4gIG9uQXR0cmlidXRlUmVtb3ZlZCxcbiAgb25BdHRyaWJ1dGVzQWRkZWQsXG4gIGNsb3Nlc3REYXRhU3RhY2ssXG4gIHNraXBEdXJpbmdDbG9uZSxcbiAgb25seUR1cmluZ0Nsb25lLFxuICBhZGRSb290U2VsZWN0b3IsXG4gIGFkZEluaXRTZWxlY3RvcixcbiAgaW50ZXJjZXB0Q2xvbmUsXG4gIGFkZFNjb3BlVG9Ob2RlLFxuICBkZWZlck11dGF0aW9ucyxcbiAgbWFwQXR0cmlidXRlcyxcbiAgZXZhbHVhdGVMYXRlcixcbiAgaW50ZXJjZXB0SW5pdCxcbiAgc2V0RXZhbHVhdG9yLFxuICBtZXJnZVByb3hpZXMsXG4gIGV4dHJhY3RQcm9wLFxuICBmaW5kQ2xvc2VzdCxcbiAgb25FbFJlbW92ZWQsXG4gIGNsb3Nlc3RSb290LFxuICBkZXN0cm95VHJlZSxcbiAgaW50ZXJjZXB0b3IsXG4gIC8vIElOVEVSTkFMOiBub3QgcHVibGljIEFQSSBhbmQgaXMgc3ViamVjdCB0byBjaGFuZ2Ugd2l0aG91dCBtYWpvciByZWxlYXNlLlxuICB0cmFuc2l0aW9uLFxuICAvLyBJTlRFUk5BTFxuICBzZXRTdHlsZXMsXG4gIC8vIElOVEVSTkFMXG4gIG11dGF0ZURvbSxcbiAgZGlyZWN0aXZlLFxuICBlbnRhbmdsZSxcbiAgdGhyb3R0bGUsXG4gIGRlYm91bmNlLFxuICBldmFsdWF0ZSxcbiAgaW5pdFRyZWUsXG4gIG5leHRUaWNrLFxuICBwcmVmaXhlZDogcHJlZml4LFxuICBwcmVmaXg6IHNldFByZWZpeCxcbiAgcGx1Z2luLFxuICBtYWdpYyxcbiAgc3RvcmUsXG4gIHN0YXJ0LFxuICBjbG9uZSxcbiAgLy8gSU5URVJOQUxcbiAgY2xvbmVOb2RlLFxuICAvLyBJTlRFUk5BTFxuICBib3VuZDogZ2V0QmluZGluZyxcbiAgJGRhdGE6IHNjb3BlLFxuICB3YXRjaCxcbiAgd2FsayxcbiAgZGF0YSxcbiAgYmluZDogYmluZDJcbn07XG52YXIgYWxwaW5lX2RlZmF1bHQgPSBBbHBpbmU7XG5cbi8vIG5vZGVfbW9kdWxlcy9AdnVlL3NoYXJlZC9kaXN0L3NoYXJlZC5lc20tYnVuZGxlci5qc1xuZnVuY3Rpb24gbWFrZU1hcChzdHIsIGV4cGVjdHNMb3dlckNhc2UpIHtcbiAgY29uc3QgbWFwID0gLyogQF9fUFVSRV9fICovIE9iamVjdC5jcmVhdGUobnVsbCk7XG4gIGNvbnN0IGxpc3QgPSBzdHIuc3BsaXQoXCIsXCIpO1xuICBmb3IgKGxldCBpID0gMDsgaSA8IGxpc3QubGVuZ3RoOyBpKyspIHtcbiAgICBtYXBbbGlzdFtpXV0gPSB0cnVlO1xuICB9XG4gIHJldHVybiBleHBlY3RzTG93ZXJDYXNlID8gKHZhbCkgPT4gISFtYXBbdmFsLnRvTG93ZXJDYXNlKCldIDogKHZhbCkgPT4gISFtYXBbdmFsXTtcbn1cbnZhciBzcGVjaWFsQm9vbGVhbkF0dHJzID0gYGl0ZW1zY29wZSxhbGxvd2Z1bGxzY3JlZW4sZm9ybW5vdmFsaWRhdGUsaXNtYXAsbm9tb2R1bGUsbm92YWxpZGF0ZSxyZWFkb25seWA7XG52YXIgaXNCb29sZWFuQXR0cjIgPSAvKiBAX19QVVJFX18gKi8gbWFrZU1hcChzcGVjaWFsQm9vbGVhbkF0dHJzICsgYCxhc3luYyxhdXRvZm9jdXMsYXV0b3BsYXksY29udHJvbHMsZGVmYXVsdCxkZWZlcixkaXNhYmxlZCxoaWRkZW4sbG9vcCxvcGVuLHJlcXVpcmVkLHJldmVyc2VkLHNjb3BlZCxzZWFtbGVzcyxjaGVja2VkLG11dGVkLG11bHRpcGxlLHNlbGVjdGVkYCk7XG52YXIgRU1QVFlfT0JKID0gdHJ1ZSA/IE9iamVjdC5mcmVlemUoe30pIDoge307XG52YXIgRU1QVFlfQVJSID0gdHJ1ZSA/IE9iamVjdC5mcmVlemUoW10pIDogW107XG52YXIgaGFzT3duUHJvcGVydHkgPSBPYmplY3QucHJvdG90eXBlLmhhc093blByb3BlcnR5O1xudmFyIGhhc093biA9ICh2YWwsIGtleSkgPT4gaGFzT3duUHJvcGVydHkuY2FsbCh2YWwsIGtleSk7XG52YXIgaXNBcnJheSA9IEFycmF5LmlzQXJyYXk7XG52YXIgaXNNYXAgPSAodmFsKSA9PiB0b1R5cGVTdHJpbmcodmFsKSA9PT0gXCJbb2JqZWN0IE1hcF1cIjtcbnZhciBpc1N0cmluZyA9ICh2YWwpID0+IHR5cGVvZiB2YWwgPT09IFwic3RyaW5nXCI7XG52YXIgaXNTeW1ib2wgPSAodmFsKSA9PiB0eXBlb2YgdmFsID09PSBcInN5bWJvbFwiO1xudmFyIGlzT2JqZWN0ID0gKHZhbCkgPT4gdmFsICE9PSBudWxsICYmIHR5cGVvZiB2YWwgPT09IFwib2JqZWN0XCI7XG52YXIgb2JqZWN0VG9TdHJpbmcgPSBPYmplY3QucHJvdG90eXBlLnRvU3RyaW5nO1xudmFyIHRvVHlwZVN0cmluZyA9ICh2YWx1ZSkgPT4gb2JqZWN0VG9TdHJpbmcuY2FsbCh2YWx1ZSk7XG52YXIgdG9SYXdUeXBlID0gKHZhbHVlKSA9PiB7XG4gIHJldHVybiB0b1R5cGVTdHJpbmcodmFsdWUpLnNsaWNlKDgsIC0xKTtcbn07XG52YXIgaXNJbnRlZ2VyS2V5ID0gKGtleSkgPT4gaXNTdHJpbmcoa2V5KSAmJiBrZXkgIT09IFwiTmFOXCIgJiYga2V5WzBdICE9PSBcIi1cIiAmJiBcIlwiICsgcGFyc2VJbnQoa2V5LCAxMCkgPT09IGtleTtcbnZhciBjYWNoZVN0cmluZ0Z1bmN0aW9uID0gKGZuKSA9PiB7XG4gIGNvbnN0IGNhY2hlID0gLyogQF9fUFVSRV9fICovIE9iamVjdC5jcmVhdGUobnVsbCk7XG4gIHJldHVybiAoc3RyKSA9PiB7XG4gICAgY29uc3QgaGl0ID0gY2FjaGVbc3RyXTtcbiAgICByZXR1cm4gaGl0IHx8IChjYWNoZVtzdHJdID0gZm4oc3RyKSk7XG4gIH07XG59O1xudmFyIGNhbWVsaXplUkUgPSAvLShcXHcpL2c7XG52YXIgY2FtZWxpemUgPSBjYWNoZVN0cmluZ0Z1bmN0aW9uKChzdHIpID0+IHtcbiAgcmV0dXJuIHN0ci5yZXBsYWNlKGNhbWVsaXplUkUsIChfLCBjKSA9PiBjID8gYy50b1VwcGVyQ2FzZSgpIDogXCJcIik7XG59KTtcbnZhciBoeXBoZW5hdGVSRSA9IC9cXEIoW0EtWl0pL2c7XG52YXIgaHlwaGVuYXRlID0gY2FjaGVTdHJpbmdGdW5jdGlvbigoc3RyKSA9PiBzdHIucmVwbGFjZShoeXBoZW5hdGVSRSwgXCItJDFcIikudG9Mb3dlckNhc2UoKSk7XG52YXIgY2FwaXRhbGl6ZSA9IGNhY2hlU3RyaW5nRnVuY3Rpb24oKHN0cikgPT4gc3RyLmNoYXJBdCgwKS50b1VwcGVyQ2FzZSgpICsgc3RyLnNsaWNlKDEpKTtcbnZhciB0b0hhbmRsZXJLZXkgPSBjYWNoZVN0cmluZ0Z1bmN0aW9uKChzdHIpID0+IHN0ciA/IGBvbiR7Y2FwaXRhbGl6ZShzdHIpfWAgOiBgYCk7XG52YXIgaGFzQ2hhbmdlZCA9ICh2YWx1ZSwgb2xkVmFsdWUpID0+IHZhbHVlICE9PSBvbGRWYWx1ZSAmJiAodmFsdWUgPT09IHZhbHVlIHx8IG9sZFZhbHVlID09PSBvbGRWYWx1ZSk7XG5cbi8vIG5vZGVfbW9kdWxlcy9AdnVlL3JlYWN0aXZpdHkvZGlzdC9yZWFjdGl2aXR5LmVzbS1idW5kbGVyLmpzXG52YXIgdGFyZ2V0TWFwID0gLyogQF9fUFVSRV9fICovIG5ldyBXZWFrTWFwKCk7XG52YXIgZWZmZWN0U3RhY2sgPSBbXTtcbnZhciBhY3RpdmVFZmZlY3Q7XG52YXIgSVRFUkFURV9LRVkgPSBTeW1ib2wodHJ1ZSA/IFwiaXRlcmF0ZVwiIDogXCJcIik7XG52YXIgTUFQX0tFWV9JVEVSQVRFX0tFWSA9IFN5bWJvbCh0cnVlID8gXCJNYXAga2V5IGl0ZXJhdGVcIiA6IFwiXCIpO1xuZnVuY3Rpb24gaXNFZmZlY3QoZm4pIHtcbiAgcmV0dXJuIGZuICYmIGZuLl9pc0VmZmVjdCA9PT0gdHJ1ZTtcbn1cbmZ1bmN0aW9uIGVmZmVjdDIoZm4sIG9wdGlvbnMgPSBFTVBUWV9PQkopIHtcbiAgaWYgKGlzRWZmZWN0KGZuKSkge1xuICAgIGZuID0gZm4ucmF3O1xuICB9XG4gIGNvbnN0IGVmZmVjdDMgPSBjcmVhdGVSZWFjdGl2ZUVmZmVjdChmbiwgb3B0aW9ucyk7XG4gIGlmICghb3B0aW9ucy5sYXp5KSB7XG4gICAgZWZmZWN0MygpO1xuICB9XG4gIHJldHVybiBlZmZlY3QzO1xufVxuZnVuY3Rpb24gc3RvcChlZmZlY3QzKSB7XG4gIGlmIChlZmZlY3QzLmFjdGl2ZSkge1xuICAgIGNsZWFudXAoZWZmZWN0Myk7XG4gICAgaWYgKGVmZmVjdDMub3B0aW9ucy5vblN0b3ApIHtcbiAgICAgIGVmZmVjdDMub3B0aW9ucy5vblN0b3AoKTtcbiAgICB9XG4gICAgZWZmZWN0My5hY3RpdmUgPSBmYWxzZTtcbiAgfVxufVxudmFyIHVpZCA9IDA7XG5mdW5jdGlvbiBjcmVhdGVSZWFjdGl2ZUVmZmVjdChmbiwgb3B0aW9ucykge1xuICBjb25zdCBlZmZlY3QzID0gZnVuY3Rpb24gcmVhY3RpdmVFZmZlY3QoKSB7XG4gICAgaWYgKCFlZmZlY3QzLmFjdGl2ZSkge1xuICAgICAgcmV0dXJuIGZuKCk7XG4gICAgfVxuICAgIGlmICghZWZmZWN0U3RhY2suaW5jbHVkZXMoZWZmZWN0MykpIHtcbiAgICAgIGNsZWFudXAoZWZmZWN0Myk7XG4gICAgICB0cnkge1xuICAgICAgICBlbmFibGVUcmFja2luZygpO1xuICAgICAgICBlZmZlY3RTdGFjay5wdXNoKGVmZmVjdDMpO1xuICAgICAgICBhY3RpdmVFZmZlY3QgPSBlZmZlY3QzO1xuICAgICAgICByZXR1cm4gZm4oKTtcbiAgICAgIH0gZmluYWxseSB7XG4gICAgICAgIGVmZmVjdFN0YWNrLnBvcCgpO1xuICAgICAgICByZXNldFRyYWNraW5nKCk7XG4gICAgICAgIGFjdGl2ZUVmZmVjdCA9IGVmZmVjdFN0YWNrW2VmZmVjdFN0YWNrLmxlbmd0aCAtIDFdO1xuICAgICAgfVxuICAgIH1cbiAgfTtcbiAgZWZmZWN0My5pZCA9IHVpZCsrO1xuICBlZmZlY3QzLmFsbG93UmVjdXJzZSA9ICEhb3B0aW9ucy5hbGxvd1JlY3Vyc2U7XG4gIGVmZmVjdDMuX2lzRWZmZWN0ID0gdHJ1ZTtcbiAgZWZmZWN0My5hY3RpdmUgPSB0cnVlO1xuICBlZmZlY3QzLnJhdyA9IGZuO1xuICBlZmZlY3QzLmRlcHMgPSBbXTtcbiAgZWZmZWN0My5vcHRpb25zID0gb3B0aW9ucztcbiAgcmV0dXJuIGVmZmVjdDM7XG59XG5mdW5jdGlvbiBjbGVhbnVwKGVmZmVjdDMpIHtcbiAgY29uc3QgeyBkZXBzIH0gPSBlZmZlY3QzO1xuICBpZiAoZGVwcy5sZW5ndGgpIHtcbiAgICBmb3IgKGxldCBpID0gMDsgaSA8IGRlcHMubGVuZ3RoOyBpKyspIHtcbiAgICAgIGRlcHNbaV0uZGVsZXRlKGVmZmVjdDMpO1xuICAgIH1cbiAgICBkZXBzLmxlbmd0aCA9IDA7XG4gIH1cbn1cbnZhciBzaG91bGRUcmFjayA9IHRydWU7XG52YXIgdHJhY2tTdGFjayA9IFtdO1xuZnVuY3Rpb24gcGF1c2VUcmFja2luZygpIHtcbiAgdHJhY2tTdGFjay5wdXNoKHNob3VsZFRyYWNrKTtcbiAgc2hvdWxkVHJhY2sgPSBmYWxzZTtcbn1cbmZ1bmN0aW9uIGVuYWJsZVRyYWNraW5nKCkge1xuICB0cmFja1N0YWNrLnB1c2goc2hvdWxkVHJhY2spO1xuICBzaG91bGRUcmFjayA9IHRydWU7XG59XG5mdW5jdGlvbiByZXNldFRyYWNraW5nKCkge1xuICBjb25zdCBsYXN0ID0gdHJhY2tTdGFjay5wb3AoKTtcbiAgc2hvdWxkVHJhY2sgPSBsYXN0ID09PSB2b2lkIDAgPyB0cnVlIDogbGFzdDtcbn1cbmZ1bmN0aW9uIHRyYWNrKHRhcmdldCwgdHlwZSwga2V5KSB7XG4gIGlmICghc2hvdWxkVHJhY2sgfHwgYWN0aXZlRWZmZWN0ID09PSB2b2lkIDApIHtcbiAgICByZXR1cm47XG4gIH1cbiAgbGV0IGRlcHNNYXAgPSB0YXJnZXRNYXAuZ2V0KHRhcmdldCk7XG4gIGlmICghZGVwc01hcCkge1xuICAgIHRhcmdldE1hcC5zZXQodGFyZ2V0LCBkZXBzTWFwID0gLyogQF9fUFVSRV9fICovIG5ldyBNYXAoKSk7XG4gIH1cbiAgbGV0IGRlcCA9IGRlcHNNYXAuZ2V0KGtleSk7XG4gIGlmICghZGVwKSB7XG4gICAgZGVwc01hcC5zZXQoa2V5LCBkZXAgPSAvKiBAX19QVVJFX18gKi8gbmV3IFNldCgpKTtcbiAgfVxuICBpZiAoIWRlcC5oYXMoYWN0aXZlRWZmZWN0KSkge1xuICAgIGRlcC5hZGQoYWN0aXZlRWZmZWN0KTtcbiAgICBhY3RpdmVFZmZlY3QuZGVwcy5wdXNoKGRlcCk7XG4gICAgaWYgKGFjdGl2ZUVmZmVjdC5vcHRpb25zLm9uVHJhY2spIHtcbiAgICAgIGFjdGl2ZUVmZmVjdC5vcHRpb25zLm9uVHJhY2soe1xuICAgICAgICBlZmZlY3Q6IGFjdGl2ZUVmZmVjdCxcbiAgICAgICAgdGFyZ2V0LFxuICAgICAgICB0eXBlLFxuICAgICAgICBrZXlcbiAgICAgIH0pO1xuICAgIH1cbiAgfVxufVxuZnVuY3Rpb24gdHJpZ2dlcih0YXJnZXQsIHR5cGUsIGtleSwgbmV3VmFsdWUsIG9sZFZhbHVlLCBvbGRUYXJnZXQpIHtcbiAgY29uc3QgZGVwc01hcCA9IHRhcmdldE1hcC5nZXQodGFyZ2V0KTtcbiAgaWYgKCFkZXBzTWFwKSB7XG4gICAgcmV0dXJuO1xuICB9XG4gIGNvbnN0IGVmZmVjdHMgPSAvKiBAX19QVVJFX18gKi8gbmV3IFNldCgpO1xuICBjb25zdCBhZGQyID0gKGVmZmVjdHNUb0FkZCkgPT4ge1xuICAgIGlmIChlZmZlY3RzVG9BZGQpIHtcbiAgICAgIGVmZmVjdHNUb0FkZC5mb3JFYWNoKChlZmZlY3QzKSA9PiB7XG4gICAgICAgIGlmIChlZmZlY3QzICE9PSBhY3RpdmVFZmZlY3QgfHwgZWZmZWN0My5hbGxvd1JlY3Vyc2UpIHtcbiAgICAgICAgICBlZmZlY3RzLmFkZChlZmZlY3QzKTtcbiAgICAgICAgfVxuICAgICAgfSk7XG4gICAgfVxuICB9O1xuICBpZiAodHlwZSA9PT0gXCJjbGVhclwiKSB7XG4gICAgZGVwc01hcC5mb3JFYWNoKGFkZDIpO1xuICB9IGVsc2UgaWYgKGtleSA9PT0gXCJsZW5ndGhcIiAmJiBpc0FycmF5KHRhcmdldCkpIHtcbiAgICBkZXBzTWFwLmZvckVhY2goKGRlcCwga2V5MikgPT4ge1xuICAgICAgaWYgKGtleTIgPT09IFwibGVuZ3RoXCIgfHwga2V5MiA+PSBuZXdWYWx1ZSkge1xuICAgICAgICBhZGQyKGRlcCk7XG4gICAgICB9XG4gICAgfSk7XG4gIH0gZWxzZSB7XG4gICAgaWYgKGtleSAhPT0gdm9pZCAwKSB7XG4gICAgICBhZGQyKGRlcHNNYXAuZ2V0KGtleSkpO1xuICAgIH1cbiAgICBzd2l0Y2ggKHR5cGUpIHtcbiAgICAgIGNhc2UgXCJhZGRcIjpcbiAgICAgICAgaWYgKCFpc0FycmF5KHRhcmdldCkpIHtcbiAgICAgICAgICBhZGQyKGRlcHNNYXAuZ2V0KElURVJBVEVfS0VZKSk7XG4gICAgICAgICAgaWYgKGlzTWFwKHRhcmdldCkpIHtcbiAgICAgICAgICAgIGFkZDIoZGVwc01hcC5nZXQoTUFQX0tFWV9JVEVSQVRFX0tFWSkpO1xuICAgICAgICAgIH1cbiAgICAgICAgfSBlbHNlIGlmIChpc0ludGVnZXJLZXkoa2V5KSkge1xuICAgICAgICAgIGFkZDIoZGVwc01hcC5nZXQoXCJsZW5ndGhcIikpO1xuICAgICAgICB9XG4gICAgICAgIGJyZWFrO1xuICAgICAgY2FzZSBcImRlbGV0ZVwiOlxuICAgICAgICBpZiAoIWlzQXJyYXkodGFyZ2V0KSkge1xuICAgICAgICAgIGFkZDIoZGVwc01hcC5nZXQoSVRFUkFURV9LRVkpKTtcbiAgICAgICAgICBpZiAoaXNNYXAodGFyZ2V0KSkge1xuICAgICAgICAgICAgYWRkMihkZXBzTWFwLmdldChNQVBfS0VZX0lURVJBVEVfS0VZKSk7XG4gICAgICAgICAgfVxuICAgICAgICB9XG4gICAgICAgIGJyZWFrO1xuICAgICAgY2FzZSBcInNldFwiOlxuICAgICAgICBpZiAoaXNNYXAodGFyZ2V0KSkge1xuICAgICAgICAgIGFkZDIoZGVwc01hcC5nZXQoSVRFUkFURV9LRVkpKTtcbiAgICAgICAgfVxuICAgICAgICBicmVhaztcbiAgICB9XG4gIH1cbiAgY29uc3QgcnVuID0gKGVmZmVjdDMpID0+IHtcbiAgICBpZiAoZWZmZWN0My5vcHRpb25zLm9uVHJpZ2dlcikge1xuICAgICAgZWZmZWN0My5vcHRpb25zLm9uVHJpZ2dlcih7XG4gICAgICAgIGVmZmVjdDogZWZmZWN0MyxcbiAgICAgICAgdGFyZ2V0LFxuICAgICAgICBrZXksXG4gICAgICAgIHR5cGUsXG4gICAgICAgIG5ld1ZhbHVlLFxuICAgICAgICBvbGRWYWx1ZSxcbiAgICAgICAgb2xkVGFyZ2V0XG4gICAgICB9KTtcbiAgICB9XG4gICAgaWYgKGVmZmVjdDMub3B0aW9ucy5zY2hlZHVsZXIpIHtcbiAgICAgIGVmZmVjdDMub3B0aW9ucy5zY2hlZHVsZXIoZWZmZWN0Myk7XG4gICAgfSBlbHNlIHtcbiAgICAgIGVmZmVjdDMoKTtcbiAgICB9XG4gIH07XG4gIGVmZmVjdHMuZm9yRWFjaChydW4pO1xufVxudmFyIGlzTm9uVHJhY2thYmxlS2V5cyA9IC8qIEBfX1BVUkVfXyAqLyBtYWtlTWFwKGBfX3Byb3RvX18sX192X2lzUmVmLF9faXNWdWVgKTtcbnZhciBidWlsdEluU3ltYm9scyA9IG5ldyBTZXQoT2JqZWN0LmdldE93blByb3BlcnR5TmFtZXMoU3ltYm9sKS5tYXAoKGtleSkgPT4gU3ltYm9sW2tleV0pLmZpbHRlcihpc1N5bWJvbCkpO1xudmFyIGdldDIgPSAvKiBAX19QVVJFX18gKi8gY3JlYXRlR2V0dGVyKCk7XG52YXIgcmVhZG9ubHlHZXQgPSAvKiBAX19QVVJFX18gKi8gY3JlYXRlR2V0dGVyKHRydWUpO1xudmFyIGFycmF5SW5zdHJ1bWVudGF0aW9ucyA9IC8qIEBfX1BVUkVfXyAqLyBjcmVhdGVBcnJheUluc3RydW1lbnRhdGlvbnMoKTtcbmZ1bmN0aW9uIGNyZWF0ZUFycmF5SW5zdHJ1bWVudGF0aW9ucygpIHtcbiAgY29uc3QgaW5zdHJ1bWVudGF0aW9ucyA9IHt9O1xuICBbXCJpbmNsdWRlc1wiLCBcImluZGV4T2ZcIiwgXCJsYXN0SW5kZXhPZlwiXS5mb3JFYWNoKChrZXkpID0+IHtcbiAgICBpbnN0cnVtZW50YXRpb25zW2tleV0gPSBmdW5jdGlvbiguLi5hcmdzKSB7XG4gICAgICBjb25zdCBhcnIgPSB0b1Jhdyh0aGlzKTtcbiAgICAgIGZvciAobGV0IGkgPSAwLCBsID0gdGhpcy5sZW5ndGg7IGkgPCBsOyBpKyspIHtcbiAgICAgICAgdHJhY2soYXJyLCBcImdldFwiLCBpICsgXCJcIik7XG4gICAgICB9XG4gICAgICBjb25zdCByZXMgPSBhcnJba2V5XSguLi5hcmdzKTtcbiAgICAgIGlmIChyZXMgPT09IC0xIHx8IHJlcyA9PT0gZmFsc2UpIHtcbiAgICAgICAgcmV0dXJuIGFycltrZXldKC4uLmFyZ3MubWFwKHRvUmF3KSk7XG4gICAgICB9IGVsc2Uge1xuICAgICAgICByZXR1cm4gcmVzO1xuICAgICAgfVxuICAgIH07XG4gIH0pO1xuICBbXCJwdXNoXCIsIFwicG9wXCIsIFwic2hpZnRcIiwgXCJ1bnNoaWZ0XCIsIFwic3BsaWNlXCJdLmZvckVhY2goKGtleSkgPT4ge1xuICAgIGluc3RydW1lbnRhdGlvbnNba2V5XSA9IGZ1bmN0aW9uKC4uLmFyZ3MpIHtcbiAgICAgIHBhdXNlVHJhY2tpbmcoKTtcbiAgICAgIGNvbnN0IHJlcyA9IHRvUmF3KHRoaXMpW2tleV0uYXBwbHkodGhpcywgYXJncyk7XG4gICAgICByZXNldFRyYWNraW5nKCk7XG4gICAgICByZXR1cm4gcmVzO1xuICAgIH07XG4gIH0pO1xuICByZXR1cm4gaW5zdHJ1bWVudGF0aW9ucztcbn1cbmZ1bmN0aW9uIGNyZWF0ZUdldHRlcihpc1JlYWRvbmx5ID0gZmFsc2UsIHNoYWxsb3cgPSBmYWxzZSkge1xuICByZXR1cm4gZnVuY3Rpb24gZ2V0Myh0YXJnZXQsIGtleSwgcmVjZWl2ZXIpIHtcbiAgICBpZiAoa2V5ID09PSBcIl9fdl9pc1JlYWN0aXZlXCIpIHtcbiAgICAgIHJldHVybiAhaXNSZWFkb25seTtcbiAgICB9IGVsc2UgaWYgKGtleSA9PT0gXCJfX3ZfaXNSZWFkb25seVwiKSB7XG4gICAgICByZXR1cm4gaXNSZWFkb25seTtcbiAgICB9IGVsc2UgaWYgKGtleSA9PT0gXCJfX3ZfcmF3XCIgJiYgcmVjZWl2ZXIgPT09IChpc1JlYWRvbmx5ID8gc2hhbGxvdyA/IHNoYWxsb3dSZWFkb25seU1hcCA6IHJlYWRvbmx5TWFwIDogc2hhbGxvdyA/IHNoYWxsb3dSZWFjdGl2ZU1hcCA6IHJlYWN0aXZlTWFwKS5nZXQodGFyZ2V0KSkge1xuICAgICAgcmV0dXJuIHRhcmdldDtcbiAgICB9XG4gICAgY29uc3QgdGFyZ2V0SXNBcnJheSA9IGlzQXJyYXkodGFyZ2V0KTtcbiAgICBpZiAoIWlzUmVhZG9ubHkgJiYgdGFyZ2V0SXNBcnJheSAmJiBoYXNPd24oYXJyYXlJbnN0cnVtZW50YXRpb25zLCBrZXkpKSB7XG4gICAgICByZXR1cm4gUmVmbGVjdC5nZXQoYXJyYXlJbnN0cnVtZW50YXRpb25zLCBrZXksIHJlY2VpdmVyKTtcbiAgICB9XG4gICAgY29uc3QgcmVzID0gUmVmbGVjdC5nZXQodGFyZ2V0LCBrZXksIHJlY2VpdmVyKTtcbiAgICBpZiAoaXNTeW1ib2woa2V5KSA/IGJ1aWx0SW5TeW1ib2xzLmhhcyhrZXkpIDogaXNOb25UcmFja2FibGVLZXlzKGtleSkpIHtcbiAgICAgIHJldHVybiByZXM7XG4gICAgfVxuICAgIGlmICghaXNSZWFkb25seSkge1xuICAgICAgdHJhY2sodGFyZ2V0LCBcImdldFwiLCBrZXkpO1xuICAgIH1cbiAgICBpZiAoc2hhbGxvdykge1xuICAgICAgcmV0dXJuIHJlcztcbiAgICB9XG4gICAgaWYgKGlzUmVmKHJlcykpIHtcbiAgICAgIGNvbnN0IHNob3VsZFVud3JhcCA9ICF0YXJnZXRJc0FycmF5IHx8ICFpc0ludGVnZXJLZXkoa2V5KTtcbiAgICAgIHJldHVybiBzaG91bGRVbndyYXAgPyByZXMudmFsdWUgOiByZXM7XG4gICAgfVxuICAgIGlmIChpc09iamVjdChyZXMpKSB7XG4gICAgICByZXR1cm4gaXNSZWFkb25seSA/IHJlYWRvbmx5KHJlcykgOiByZWFjdGl2ZTIocmVzKTtcbiAgICB9XG4gICAgcmV0dXJuIHJlcztcbiAgfTtcbn1cbnZhciBzZXQyID0gLyogQF9fUFVSRV9fICovIGNyZWF0ZVNldHRlcigpO1xuZnVuY3Rpb24gY3JlYXRlU2V0dGVyKHNoYWxsb3cgPSBmYWxzZSkge1xuICByZXR1cm4gZnVuY3Rpb24gc2V0Myh0YXJnZXQsIGtleSwgdmFsdWUsIHJlY2VpdmVyKSB7XG4gICAgbGV0IG9sZFZhbHVlID0gdGFyZ2V0W2tleV07XG4gICAgaWYgKCFzaGFsbG93KSB7XG4gICAgICB2YWx1ZSA9IHRvUmF3KHZhbHVlKTtcbiAgICAgIG9sZFZhbHVlID0gdG9SYXcob2xkVmFsdWUpO1xuICAgICAgaWYgKCFpc0FycmF5KHRhcmdldCkgJiYgaXNSZWYob2xkVmFsdWUpICYmICFpc1JlZih2YWx1ZSkpIHtcbiAgICAgICAgb2xkVmFsdWUudmFsdWUgPSB2YWx1ZTtcbiAgICAgICAgcmV0dXJuIHRydWU7XG4gICAgICB9XG4gICAgfVxuICAgIGNvbnN0IGhhZEtleSA9IGlzQXJyYXkodGFyZ2V0KSAmJiBpc0ludGVnZXJLZXkoa2V5KSA/IE51bWJlcihrZXkpIDwgdGFyZ2V0Lmxlbmd0aCA6IGhhc093bih0YXJnZXQsIGtleSk7XG4gICAgY29uc3QgcmVzdWx0ID0gUmVmbGVjdC5zZXQodGFyZ2V0LCBrZXksIHZhbHVlLCByZWNlaXZlcik7XG4gICAgaWYgKHRhcmdldCA9PT0gdG9SYXcocmVjZWl2ZXIpKSB7XG4gICAgICBpZiAoIWhhZEtleSkge1xuICAgICAgICB0cmlnZ2VyKHRhcmdldCwgXCJhZGRcIiwga2V5LCB2YWx1ZSk7XG4gICAgICB9IGVsc2UgaWYgKGhhc0NoYW5nZWQodmFsdWUsIG9sZFZhbHVlKSkge1xuICAgICAgICB0cmlnZ2VyKHRhcmdldCwgXCJzZXRcIiwga2V5LCB2YWx1ZSwgb2xkVmFsdWUpO1xuICAgICAgfVxuICAgIH1cbiAgICByZXR1cm4gcmVzdWx0O1xuICB9O1xufVxuZnVuY3Rpb24gZGVsZXRlUHJvcGVydHkodGFyZ2V0LCBrZXkpIHtcbiAgY29uc3QgaGFkS2V5ID0gaGFzT3duKHRhcmdldCwga2V5KTtcbiAgY29uc3Qgb2xkVmFsdWUgPSB0YXJnZXRba2V5XTtcbiAgY29uc3QgcmVzdWx0ID0gUmVmbGVjdC5kZWxldGVQcm9wZXJ0eSh0YXJnZXQsIGtleSk7XG4gIGlmIChyZXN1bHQgJiYgaGFkS2V5KSB7XG4gICAgdHJpZ2dlcih0YXJnZXQsIFwiZGVsZXRlXCIsIGtleSwgdm9pZCAwLCBvbGRWYWx1ZSk7XG4gIH1cbiAgcmV0dXJuIHJlc3VsdDtcbn1cbmZ1bmN0aW9uIGhhcyh0YXJnZXQsIGtleSkge1xuICBjb25zdCByZXN1bHQgPSBSZWZsZWN0Lmhhcyh0YXJnZXQsIGtleSk7XG4gIGlmICghaXNTeW1ib2woa2V5KSB8fCAhYnVpbHRJblN5bWJvbHMuaGFzKGtleSkpIHtcbiAgICB0cmFjayh0YXJnZXQsIFwiaGFzXCIsIGtleSk7XG4gIH1cbiAgcmV0dXJuIHJlc3VsdDtcbn1cbmZ1bmN0aW9uIG93bktleXModGFyZ2V0KSB7XG4gIHRyYWNrKHRhcmdldCwgXCJpdGVyYXRlXCIsIGlzQXJyYXkodGFyZ2V0KSA/IFwibGVuZ3RoXCIgOiBJVEVSQVRFX0tFWSk7XG4gIHJldHVybiBSZWZsZWN0Lm93bktleXModGFyZ2V0KTtcbn1cbnZhciBtdXRhYmxlSGFuZGxlcnMgPSB7XG4gIGdldDogZ2V0MixcbiAgc2V0OiBzZXQyLFxuICBkZWxldGVQcm9wZXJ0eSxcbiAgaGFzLFxuICBvd25LZXlzXG59O1xudmFyIHJlYWRvbmx5SGFuZGxlcnMgPSB7XG4gIGdldDogcmVhZG9ubHlHZXQsXG4gIHNldCh0YXJnZXQsIGtleSkge1xuICAgIGlmICh0cnVlKSB7XG4gICAgICBjb25zb2xlLndhcm4oYFNldCBvcGVyYXRpb24gb24ga2V5IFwiJHtTdHJpbmcoa2V5KX1cIiBmYWlsZWQ6IHRhcmdldCBpcyByZWFkb25seS5gLCB0YXJnZXQpO1xuICAgIH1cbiAgICByZXR1cm4gdHJ1ZTtcbiAgfSxcbiAgZGVsZXRlUHJvcGVydHkodGFyZ2V0LCBrZXkpIHtcbiAgICBpZiAodHJ1ZSkge1xuICAgICAgY29uc29sZS53YXJuKGBEZWxldGUgb3BlcmF0aW9uIG9uIGtleSBcIiR7U3RyaW5nKGtleSl9XCIgZmFpbGVkOiB0YXJnZXQgaXMgcmVhZG9ubHkuYCwgdGFyZ2V0KTtcbiAgICB9XG4gICAgcmV0dXJuIHRydWU7XG4gIH1cbn07XG52YXIgdG9SZWFjdGl2ZSA9ICh2YWx1ZSkgPT4gaXNPYmplY3QodmFsdWUpID8gcmVhY3RpdmUyKHZhbHVlKSA6IHZhbHVlO1xudmFyIHRvUmVhZG9ubHkgPSAodmFsdWUpID0+IGlzT2JqZWN0KHZhbHVlKSA/IHJlYWRvbmx5KHZhbHVlKSA6IHZhbHVlO1xudmFyIHRvU2hhbGxvdyA9ICh2YWx1ZSkgPT4gdmFsdWU7XG52YXIgZ2V0UHJvdG8gPSAodikgPT4gUmVmbGVjdC5nZXRQcm90b3R5cGVPZih2KTtcbmZ1bmN0aW9uIGdldCQxKHRhcmdldCwga2V5LCBpc1JlYWRvbmx5ID0gZmFsc2UsIGlzU2hhbGxvdyA9IGZhbHNlKSB7XG4gIHRhcmdldCA9IHRhcmdldFtcbiAgICBcIl9fdl9yYXdcIlxuICAgIC8qIFJBVyAqL1xuICBdO1xuICBjb25zdCByYXdUYXJnZXQgPSB0b1Jhdyh0YXJnZXQpO1xuICBjb25zdCByYXdLZXkgPSB0b1JhdyhrZXkpO1xuICBpZiAoa2V5ICE9PSByYXdLZXkpIHtcbiAgICAhaXNSZWFkb25seSAmJiB0cmFjayhyYXdUYXJnZXQsIFwiZ2V0XCIsIGtleSk7XG4gIH1cbiAgIWlzUmVhZG9ubHkgJiYgdHJhY2socmF3VGFyZ2V0LCBcImdldFwiLCByYXdLZXkpO1xuICBjb25zdCB7IGhhczogaGFzMiB9ID0gZ2V0UHJvdG8ocmF3VGFyZ2V0KTtcbiAgY29uc3Qgd3JhcCA9IGlzU2hhbGxvdyA/IHRvU2hhbGxvdyA6IGlzUmVhZG9ubHkgPyB0b1JlYWRvbmx5IDogdG9SZWFjdGl2ZTtcbiAgaWYgKGhhczIuY2FsbChyYXdUYXJnZXQsIGtleSkpIHtcbiAgICByZXR1cm4gd3JhcCh0YXJnZXQuZ2V0KGtleSkpO1xuICB9IGVsc2UgaWYgKGhhczIuY2FsbChyYXdUYXJnZXQsIHJhd0tleSkpIHtcbiAgICByZXR1cm4gd3JhcCh0YXJnZXQuZ2V0KHJhd0tleSkpO1xuICB9IGVsc2UgaWYgKHRhcmdldCAhPT0gcmF3VGFyZ2V0KSB7XG4gICAgdGFyZ2V0LmdldChrZXkpO1xuICB9XG59XG5mdW5jdGlvbiBoYXMkMShrZXksIGlzUmVhZG9ubHkgPSBmYWxzZSkge1xuICBjb25zdCB0YXJnZXQgPSB0aGlzW1xuICAgIFwiX192X3Jhd1wiXG4gICAgLyogUkFXICovXG4gIF07XG4gIGNvbnN0IHJhd1RhcmdldCA9IHRvUmF3KHRhcmdldCk7XG4gIGNvbnN0IHJhd0tleSA9IHRvUmF3KGtleSk7XG4gIGlmIChrZXkgIT09IHJhd0tleSkge1xuICAgICFpc1JlYWRvbmx5ICYmIHRyYWNrKHJhd1RhcmdldCwgXCJoYXNcIiwga2V5KTtcbiAgfVxuICAhaXNSZWFkb25seSAmJiB0cmFjayhyYXdUYXJnZXQsIFwiaGFzXCIsIHJhd0tleSk7XG4gIHJldHVybiBrZXkgPT09IHJhd0tleSA/IHRhcmdldC5oYXMoa2V5KSA6IHRhcmdldC5oYXMoa2V5KSB8fCB0YXJnZXQuaGFzKHJhd0tleSk7XG59XG5mdW5jdGlvbiBzaXplKHRhcmdldCwgaXNSZWFkb25seSA9IGZhbHNlKSB7XG4gIHRhcmdldCA9IHRhcmdldFtcbiAgICBcIl9fdl9yYXdcIlxuICAgIC8qIFJBVyAqL1xuICBdO1xuICAhaXNSZWFkb25seSAmJiB0cmFjayh0b1Jhdyh0YXJnZXQpLCBcIml0ZXJhdGVcIiwgSVRFUkFURV9LRVkpO1xuICByZXR1cm4gUmVmbGVjdC5nZXQodGFyZ2V0LCBcInNpemVcIiwgdGFyZ2V0KTtcbn1cbmZ1bmN0aW9uIGFkZCh2YWx1ZSkge1xuICB2YWx1ZSA9IHRvUmF3KHZhbHVlKTtcbiAgY29uc3QgdGFyZ2V0ID0gdG9SYXcodGhpcyk7XG4gIGNvbnN0IHByb3RvID0gZ2V0UHJvdG8odGFyZ2V0KTtcbiAgY29uc3QgaGFkS2V5ID0gcHJvdG8uaGFzLmNhbGwodGFyZ2V0LCB2YWx1ZSk7XG4gIGlmICghaGFkS2V5KSB7XG4gICAgdGFyZ2V0LmFkZCh2YWx1ZSk7XG4gICAgdHJpZ2dlcih0YXJnZXQsIFwiYWRkXCIsIHZhbHVlLCB2YWx1ZSk7XG4gIH1cbiAgcmV0dXJuIHRoaXM7XG59XG5mdW5jdGlvbiBzZXQkMShrZXksIHZhbHVlKSB7XG4gIHZhbHVlID0gdG9SYXcodmFsdWUpO1xuICBjb25zdCB0YXJnZXQgPSB0b1Jhdyh0aGlzKTtcbiAgY29uc3QgeyBoYXM6IGhhczIsIGdldDogZ2V0MyB9ID0gZ2V0UHJvdG8odGFyZ2V0KTtcbiAgbGV0IGhhZEtleSA9IGhhczIuY2FsbCh0YXJnZXQsIGtleSk7XG4gIGlmICghaGFkS2V5KSB7XG4gICAga2V5ID0gdG9SYXcoa2V5KTtcbiAgICBoYWRLZXkgPSBoYXMyLmNhbGwodGFyZ2V0LCBrZXkpO1xuICB9IGVsc2UgaWYgKHRydWUpIHtcbiAgICBjaGVja0lkZW50aXR5S2V5cyh0YXJnZXQsIGhhczIsIGtleSk7XG4gIH1cbiAgY29uc3Qgb2xkVmFsdWUgPSBnZXQzLmNhbGwodGFyZ2V0LCBrZXkpO1xuICB0YXJnZXQuc2V0KGtleSwgdmFsdWUpO1xuICBpZiAoIWhhZEtleSkge1xuICAgIHRyaWdnZXIodGFyZ2V0LCBcImFkZFwiLCBrZXksIHZhbHVlKTtcbiAgfSBlbHNlIGlmIChoYXNDaGFuZ2VkKHZhbHVlLCBvbGRWYWx1ZSkpIHtcbiAgICB0cmlnZ2VyKHRhcmdldCwgXCJzZXRcIiwga2V5LCB2YWx1ZSwgb2xkVmFsdWUpO1xuICB9XG4gIHJldHVybiB0aGlzO1xufVxuZnVuY3Rpb24gZGVsZXRlRW50cnkoa2V5KSB7XG4gIGNvbnN0IHRhcmdldCA9IHRvUmF3KHRoaXMpO1xuICBjb25zdCB7IGhhczogaGFzMiwgZ2V0OiBnZXQzIH0gPSBnZXRQcm90byh0YXJnZXQpO1xuICBsZXQgaGFkS2V5ID0gaGFzMi5jYWxsKHRhcmdldCwga2V5KTtcbiAgaWYgKCFoYWRLZXkpIHtcbiAgICBrZXkgPSB0b1JhdyhrZXkpO1xuICAgIGhhZEtleSA9IGhhczIuY2FsbCh0YXJnZXQsIGtleSk7XG4gIH0gZWxzZSBpZiAodHJ1ZSkge1xuICAgIGNoZWNrSWRlbnRpdHlLZXlzKHRhcmdldCwgaGFzMiwga2V5KTtcbiAgfVxuICBjb25zdCBvbGRWYWx1ZSA9IGdldDMgPyBnZXQzLmNhbGwodGFyZ2V0LCBrZXkpIDogdm9pZCAwO1xuICBjb25zdCByZXN1bHQgPSB0YXJnZXQuZGVsZXRlKGtleSk7XG4gIGlmIChoYWRLZXkpIHtcbiAgICB0cmlnZ2VyKHRhcmdldCwgXCJkZWxldGVcIiwga2V5LCB2b2lkIDAsIG9sZFZhbHVlKTtcbiAgfVxuICByZXR1cm4gcmVzdWx0O1xufVxuZnVuY3Rpb24gY2xlYXIoKSB7XG4gIGNvbnN0IHRhcmdldCA9IHRvUmF3KHRoaXMpO1xuICBjb25zdCBoYWRJdGVtcyA9IHRhcmdldC5zaXplICE9PSAwO1xuICBjb25zdCBvbGRUYXJnZXQgPSB0cnVlID8gaXNNYXAodGFyZ2V0KSA/IG5ldyBNYXAodGFyZ2V0KSA6IG5ldyBTZXQodGFyZ2V0KSA6IHZvaWQgMDtcbiAgY29uc3QgcmVzdWx0ID0gdGFyZ2V0LmNsZWFyKCk7XG4gIGlmIChoYWRJdGVtcykge1xuICAgIHRyaWdnZXIodGFyZ2V0LCBcImNsZWFyXCIsIHZvaWQgMCwgdm9pZCAwLCBvbGRUYXJnZXQpO1xuICB9XG4gIHJldHVybiByZXN1bHQ7XG59XG5mdW5jdGlvbiBjcmVhdGVGb3JFYWNoKGlzUmVhZG9ubHksIGlzU2hhbGxvdykge1xuICByZXR1cm4gZnVuY3Rpb24gZm9yRWFjaChjYWxsYmFjaywgdGhpc0FyZykge1xuICAgIGNvbnN0IG9ic2VydmVkID0gdGhpcztcbiAgICBjb25zdCB0YXJnZXQgPSBvYnNlcnZlZFtcbiAgICAgIFwiX192X3Jhd1wiXG4gICAgICAvKiBSQVcgKi9cbiAgICBdO1xuICAgIGNvbnN0IHJhd1RhcmdldCA9IHRvUmF3KHRhcmdldCk7XG4gICAgY29uc3Qgd3JhcCA9IGlzU2hhbGxvdyA/IHRvU2hhbGxvdyA6IGlzUmVhZG9ubHkgPyB0b1JlYWRvbmx5IDogdG9SZWFjdGl2ZTtcbiAgICAhaXNSZWFkb25seSAmJiB0cmFjayhyYXdUYXJnZXQsIFwiaXRlcmF0ZVwiLCBJVEVSQVRFX0tFWSk7XG4gICAgcmV0dXJuIHRhcmdldC5mb3JFYWNoKCh2YWx1ZSwga2V5KSA9PiB7XG4gICAgICByZXR1cm4gY2FsbGJhY2suY2FsbCh0aGlzQXJnLCB3cmFwKHZhbHVlKSwgd3JhcChrZXkpLCBvYnNlcnZlZCk7XG4gICAgfSk7XG4gIH07XG59XG5mdW5jdGlvbiBjcmVhdGVJdGVyYWJsZU1ldGhvZChtZXRob2QsIGlzUmVhZG9ubHksIGlzU2hhbGxvdykge1xuICByZXR1cm4gZnVuY3Rpb24oLi4uYXJncykge1xuICAgIGNvbnN0IHRhcmdldCA9IHRoaXNbXG4gICAgICBcIl9fdl9yYXdcIlxuICAgICAgLyogUkFXICovXG4gICAgXTtcbiAgICBjb25zdCByYXdUYXJnZXQgPSB0b1Jhdyh0YXJnZXQpO1xuICAgIGNvbnN0IHRhcmdldElzTWFwID0gaXNNYXAocmF3VGFyZ2V0KTtcbiAgICBjb25zdCBpc1BhaXIgPSBtZXRob2QgPT09IFwiZW50cmllc1wiIHx8IG1ldGhvZCA9PT0gU3ltYm9sLml0ZXJhdG9yICYmIHRhcmdldElzTWFwO1xuICAgIGNvbnN0IGlzS2V5T25seSA9IG1ldGhvZCA9PT0gXCJrZXlzXCIgJiYgdGFyZ2V0SXNNYXA7XG4gICAgY29uc3QgaW5uZXJJdGVyYXRvciA9IHRhcmdldFttZXRob2RdKC4uLmFyZ3MpO1xuICAgIGNvbnN0IHdyYXAgPSBpc1NoYWxsb3cgPyB0b1NoYWxsb3cgOiBpc1JlYWRvbmx5ID8gdG9SZWFkb25seSA6IHRvUmVhY3RpdmU7XG4gICAgIWlzUmVhZG9ubHkgJiYgdHJhY2socmF3VGFyZ2V0LCBcIml0ZXJhdGVcIiwgaXNLZXlPbmx5ID8gTUFQX0tFWV9JVEVSQVRFX0tFWSA6IElURVJBVEVfS0VZKTtcbiAgICByZXR1cm4ge1xuICAgICAgLy8gaXRlcmF0b3IgcHJvdG9jb2xcbiAgICAgIG5leHQoKSB7XG4gICAgICAgIGNvbnN0IHsgdmFsdWUsIGRvbmUgfSA9IGlubmVySXRlcmF0b3IubmV4dCgpO1xuICAgICAgICByZXR1cm4gZG9uZSA/IHsgdmFsdWUsIGRvbmUgfSA6IHtcbiAgICAgICAgICB2YWx1ZTogaXNQYWlyID8gW3dyYXAodmFsdWVbMF0pLCB3cmFwKHZhbHVlWzFdKV0gOiB3cmFwKHZhbHVlKSxcbiAgICAgICAgICBkb25lXG4gICAgICAgIH07XG4gICAgICB9LFxuICAgICAgLy8gaXRlcmFibGUgcHJvdG9jb2xcbiAgICAgIFtTeW1ib2wuaXRlcmF0b3JdKCkge1xuICAgICAgICByZXR1cm4gdGhpcztcbiAgICAgIH1cbiAgICB9O1xuICB9O1xufVxuZnVuY3Rpb24gY3JlYXRlUmVhZG9ubHlNZXRob2QodHlwZSkge1xuICByZXR1cm4gZnVuY3Rpb24oLi4uYXJncykge1xuICAgIGlmICh0cnVlKSB7XG4gICAgICBjb25zdCBrZXkgPSBhcmdzWzBdID8gYG9uIGtleSBcIiR7YXJnc1swXX1cIiBgIDogYGA7XG4gICAgICBjb25zb2xlLndhcm4oYCR7Y2FwaXRhbGl6ZSh0eXBlKX0gb3BlcmF0aW9uICR7a2V5fWZhaWxlZDogdGFyZ2V0IGlzIHJlYWRvbmx5LmAsIHRvUmF3KHRoaXMpKTtcbiAgICB9XG4gICAgcmV0dXJuIHR5cGUgPT09IFwiZGVsZXRlXCIgPyBmYWxzZSA6IHRoaXM7XG4gIH07XG59XG5mdW5jdGlvbiBjcmVhdGVJbnN0cnVtZW50YXRpb25zKCkge1xuICBjb25zdCBtdXRhYmxlSW5zdHJ1bWVudGF0aW9uczIgPSB7XG4gICAgZ2V0KGtleSkge1xuICAgICAgcmV0dXJuIGdldCQxKHRoaXMsIGtleSk7XG4gICAgfSxcbiAgICBnZXQgc2l6ZSgpIHtcbiAgICAgIHJldHVybiBzaXplKHRoaXMpO1xuICAgIH0sXG4gICAgaGFzOiBoYXMkMSxcbiAgICBhZGQsXG4gICAgc2V0OiBzZXQkMSxcbiAgICBkZWxldGU6IGRlbGV0ZUVudHJ5LFxuICAgIGNsZWFyLFxuICAgIGZvckVhY2g6IGNyZWF0ZUZvckVhY2goZmFsc2UsIGZhbHNlKVxuICB9O1xuICBjb25zdCBzaGFsbG93SW5zdHJ1bWVudGF0aW9uczIgPSB7XG4gICAgZ2V0KGtleSkge1xuICAgICAgcmV0dXJuIGdldCQxKHRoaXMsIGtleSwgZmFsc2UsIHRydWUpO1xuICAgIH0sXG4gICAgZ2V0IHNpemUoKSB7XG4gICAgICByZXR1cm4gc2l6ZSh0aGlzKTtcbiAgICB9LFxuICAgIGhhczogaGFzJDEsXG4gICAgYWRkLFxuICAgIHNldDogc2V0JDEsXG4gICAgZGVsZXRlOiBkZWxldGVFbnRyeSxcbiAgICBjbGVhcixcbiAgICBmb3JFYWNoOiBjcmVhdGVGb3JFYWNoKGZhbHNlLCB0cnVlKVxuICB9O1xuICBjb25zdCByZWFkb25seUluc3RydW1lbnRhdGlvbnMyID0ge1xuICAgIGdldChrZXkpIHtcbiAgICAgIHJldHVybiBnZXQkMSh0aGlzLCBrZXksIHRydWUpO1xuICAgIH0sXG4gICAgZ2V0IHNpemUoKSB7XG4gICAgICByZXR1cm4gc2l6ZSh0aGlzLCB0cnVlKTtcbiAgICB9LFxuICAgIGhhcyhrZXkpIHtcbiAgICAgIHJldHVybiBoYXMkMS5jYWxsKHRoaXMsIGtleSwgdHJ1ZSk7XG4gICAgfSxcbiAgICBhZGQ6IGNyZWF0ZVJlYWRvbmx5TWV0aG9kKFxuICAgICAgXCJhZGRcIlxuICAgICAgLyogQUREICovXG4gICAgKSxcbiAgICBzZXQ6IGNyZWF0ZVJlYWRvbmx5TWV0aG9kKFxuICAgICAgXCJzZXRcIlxuICAgICAgLyogU0VUICovXG4gICAgKSxcbiAgICBkZWxldGU6IGNyZWF0ZVJlYWRvbmx5TWV0aG9kKFxuICAgICAgXCJkZWxldGVcIlxuICAgICAgLyogREVMRVRFICovXG4gICAgKSxcbiAgICBjbGVhcjogY3JlYXRlUmVhZG9ubHlNZXRob2QoXG4gICAgICBcImNsZWFyXCJcbiAgICAgIC8qIENMRUFSICovXG4gICAgKSxcbiAgICBmb3JFYWNoOiBjcmVhdGVGb3JFYWNoKHRydWUsIGZhbHNlKVxuICB9O1xuICBjb25zdCBzaGFsbG93UmVhZG9ubHlJbnN0cnVtZW50YXRpb25zMiA9IHtcbiAgICBnZXQoa2V5KSB7XG4gICAgICByZXR1cm4gZ2V0JDEodGhpcywga2V5LCB0cnVlLCB0cnVlKTtcbiAgICB9LFxuICAgIGdldCBzaXplKCkge1xuICAgICAgcmV0dXJuIHNpemUodGhpcywgdHJ1ZSk7XG4gICAgfSxcbiAgICBoYXMoa2V5KSB7XG4gICAgICByZXR1cm4gaGFzJDEuY2FsbCh0aGlzLCBrZXksIHRydWUpO1xuICAgIH0sXG4gICAgYWRkOiBjcmVhdGVSZWFkb25seU1ldGhvZChcbiAgICAgIFwiYWRkXCJcbiAgICAgIC8qIEFERCAqL1xuICAgICksXG4gICAgc2V0OiBjcmVhdGVSZWFkb25seU1ldGhvZChcbiAgICAgIFwic2V0XCJcbiAgICAgIC8qIFNFVCAqL1xuICAgICksXG4gICAgZGVsZXRlOiBjcmVhdGVSZWFkb25seU1ldGhvZChcbiAgICAgIFwiZGVsZXRlXCJcbiAgICAgIC8qIERFTEVURSAqL1xuICAgICksXG4gICAgY2xlYXI6IGNyZWF0ZVJlYWRvbmx5TWV0aG9kKFxuICAgICAgXCJjbGVhclwiXG4gICAgICAvKiBDTEVBUiAqL1xuICAgICksXG4gICAgZm9yRWFjaDogY3JlYXRlRm9yRWFjaCh0cnVlLCB0cnVlKVxuICB9O1xuICBjb25zdCBpdGVyYXRvck1ldGhvZHMgPSBbXCJrZXlzXCIsIFwidmFsdWVzXCIsIFwiZW50cmllc1wiLCBTeW1ib2wuaXRlcmF0b3JdO1xuICBpdGVyYXRvck1ldGhvZHMuZm9yRWFjaCgobWV0aG9kKSA9PiB7XG4gICAgbXV0YWJsZUluc3RydW1lbnRhdGlvbnMyW21ldGhvZF0gPSBjcmVhdGVJdGVyYWJsZU1ldGhvZChtZXRob2QsIGZhbHNlLCBmYWxzZSk7XG4gICAgcmVhZG9ubHlJbnN0cnVtZW50YXRpb25zMlttZXRob2RdID0gY3JlYXRlSXRlcmFibGVNZXRob2QobWV0aG9kLCB0cnVlLCBmYWxzZSk7XG4gICAgc2hhbGxvd0luc3RydW1lbnRhdGlvbnMyW21ldGhvZF0gPSBjcmVhdGVJdGVyYWJsZU1ldGhvZChtZXRob2QsIGZhbHNlLCB0cnVlKTtcbiAgICBzaGFsbG93UmVhZG9ubHlJbnN0cnVtZW50YXRpb25zMlttZXRob2RdID0gY3JlYXRlSXRlcmFibGVNZXRob2QobWV0aG9kLCB0cnVlLCB0cnVlKTtcbiAgfSk7XG4gIHJldHVybiBbXG4gICAgbXV0YWJsZUluc3RydW1lbnRhdGlvbnMyLFxuICAgIHJlYWRvbmx5SW5zdHJ1bWVudGF0aW9uczIsXG4gICAgc2hhbGxvd0luc3RydW1lbnRhdGlvbnMyLFxuICAgIHNoYWxsb3dSZWFkb25seUluc3RydW1lbnRhdGlvbnMyXG4gIF07XG59XG52YXIgW211dGFibGVJbnN0cnVtZW50YXRpb25zLCByZWFkb25seUluc3RydW1lbnRhdGlvbnMsIHNoYWxsb3dJbnN0cnVtZW50YXRpb25zLCBzaGFsbG93UmVhZG9ubHlJbnN0cnVtZW50YXRpb25zXSA9IC8qIEBfX1BVUkVfXyAqLyBjcmVhdGVJbnN0cnVtZW50YXRpb25zKCk7XG5mdW5jdGlvbiBjcmVhdGVJbnN0cnVtZW50YXRpb25HZXR0ZXIoaXNSZWFkb25seSwgc2hhbGxvdykge1xuICBjb25zdCBpbnN0cnVtZW50YXRpb25zID0gc2hhbGxvdyA/IGlzUmVhZG9ubHkgPyBzaGFsbG93UmVhZG9ubHlJbnN0cnVtZW50YXRpb25zIDogc2hhbGxvd0luc3RydW1lbnRhdGlvbnMgOiBpc1JlYWRvbmx5ID8gcmVhZG9ubHlJbnN0cnVtZW50YXRpb25zIDogbXV0YWJsZUluc3RydW1lbnRhdGlvbnM7XG4gIHJldHVybiAodGFyZ2V0LCBrZXksIHJlY2VpdmVyKSA9PiB7XG4gICAgaWYgKGtleSA9PT0gXCJfX3ZfaXNSZWFjdGl2ZVwiKSB7XG4gICAgICByZXR1cm4gIWlzUmVhZG9ubHk7XG4gICAgfSBlbHNlIGlmIChrZXkgPT09IFwiX192X2lzUmVhZG9ubHlcIikge1xuICAgICAgcmV0dXJuIGlzUmVhZG9ubHk7XG4gICAgfSBlbHNlIGlmIChrZXkgPT09IFwiX192X3Jhd1wiKSB7XG4gICAgICByZXR1cm4gdGFyZ2V0O1xuICAgIH1cbiAgICByZXR1cm4gUmVmbGVjdC5nZXQoaGFzT3duKGluc3RydW1lbnRhdGlvbnMsIGtleSkgJiYga2V5IGluIHRhcmdldCA/IGluc3RydW1lbnRhdGlvbnMgOiB0YXJnZXQsIGtleSwgcmVjZWl2ZXIpO1xuICB9O1xufVxudmFyIG11dGFibGVDb2xsZWN0aW9uSGFuZGxlcnMgPSB7XG4gIGdldDogLyogQF9fUFVSRV9fICovIGNyZWF0ZUluc3RydW1lbnRhdGlvbkdldHRlcihmYWxzZSwgZmFsc2UpXG59O1xudmFyIHJlYWRvbmx5Q29sbGVjdGlvbkhhbmRsZXJzID0ge1xuICBnZXQ6IC8qIEBfX1BVUkVfXyAqLyBjcmVhdGVJbnN0cnVtZW50YXRpb25HZXR0ZXIodHJ1ZSwgZmFsc2UpXG59O1xuZnVuY3Rpb24gY2hlY2tJZGVudGl0eUtleXModGFyZ2V0LCBoYXMyLCBrZXkpIHtcbiAgY29uc3QgcmF3S2V5ID0gdG9SYXcoa2V5KTtcbiAgaWYgKHJhd0tleSAhPT0ga2V5ICYmIGhhczIuY2FsbCh0YXJnZXQsIHJhd0tleSkpIHtcbiAgICBjb25zdCB0eXBlID0gdG9SYXdUeXBlKHRhcmdldCk7XG4gICAgY29uc29sZS53YXJuKGBSZWFjdGl2ZSAke3R5cGV9IGNvbnRhaW5zIGJvdGggdGhlIHJhdyBhbmQgcmVhY3RpdmUgdmVyc2lvbnMgb2YgdGhlIHNhbWUgb2JqZWN0JHt0eXBlID09PSBgTWFwYCA/IGAgYXMga2V5c2AgOiBgYH0sIHdoaWNoIGNhbiBsZWFkIHRvIGluY29uc2lzdGVuY2llcy4gQXZvaWQgZGlmZmVyZW50aWF0aW5nIGJldHdlZW4gdGhlIHJhdyBhbmQgcmVhY3RpdmUgdmVyc2lvbnMgb2YgYW4gb2JqZWN0IGFuZCBvbmx5IHVzZSB0aGUgcmVhY3RpdmUgdmVyc2lvbiBpZiBwb3NzaWJsZS5gKTtcbiAgfVxufVxudmFyIHJlYWN0aXZlTWFwID0gLyogQF9fUFVSRV9fICovIG5ldyBXZWFrTWFwKCk7XG52YXIgc2hhbGxvd1JlYWN0aXZlTWFwID0gLyogQF9fUFVSRV9fICovIG5ldyBXZWFrTWFwKCk7XG52YXIgcmVhZG9ubHlNYXAgPSAvKiBAX19QVVJFX18gKi8gbmV3IFdlYWtNYXAoKTtcbnZhciBzaGFsbG93UmVhZG9ubHlNYXAgPSAvKiBAX19QVVJFX18gKi8gbmV3IFdlYWtNYXAoKTtcbmZ1bmN0aW9uIHRhcmdldFR5cGVNYXAocmF3VHlwZSkge1xuICBzd2l0Y2ggKHJhd1R5cGUpIHtcbiAgICBjYXNlIFwiT2JqZWN0XCI6XG4gICAgY2FzZSBcIkFycmF5XCI6XG4gICAgICByZXR1cm4gMTtcbiAgICBjYXNlIFwiTWFwXCI6XG4gICAgY2FzZSBcIlNldFwiOlxuICAgIGNhc2UgXCJXZWFrTWFwXCI6XG4gICAgY2FzZSBcIldlYWtTZXRcIjpcbiAgICAgIHJldHVybiAyO1xuICAgIGRlZmF1bHQ6XG4gICAgICByZXR1cm4gMDtcbiAgfVxufVxuZnVuY3Rpb24gZ2V0VGFyZ2V0VHlwZSh2YWx1ZSkge1xuICByZXR1cm4gdmFsdWVbXG4gICAgXCJfX3Zfc2tpcFwiXG4gICAgLyogU0tJUCAqL1xuICBdIHx8ICFPYmplY3QuaXNFeHRlbnNpYmxlKHZhbHVlKSA/IDAgOiB0YXJnZXRUeXBlTWFwKHRvUmF3VHlwZSh2YWx1ZSkpO1xufVxuZnVuY3Rpb24gcmVhY3RpdmUyKHRhcmdldCkge1xuICBpZiAodGFyZ2V0ICYmIHRhcmdldFtcbiAgICBcIl9fdl9pc1JlYWRvbmx5XCJcbiAgICAvKiBJU19SRUFET05MWSAqL1xuICBdKSB7XG4gICAgcmV0dXJuIHRhcmdldDtcbiAgfVxuICByZXR1cm4gY3JlYXRlUmVhY3RpdmVPYmplY3QodGFyZ2V0LCBmYWxzZSwgbXV0YWJsZUhhbmRsZXJzLCBtdXRhYmxlQ29sbGVjdGlvbkhhbmRsZXJzLCByZWFjdGl2ZU1hcCk7XG59XG5mdW5jdGlvbiByZWFkb25seSh0YXJnZXQpIHtcbiAgcmV0dXJuIGNyZWF0ZVJlYWN0aXZlT2JqZWN0KHRhcmdldCwgdHJ1ZSwgcmVhZG9ubHlIYW5kbGVycywgcmVhZG9ubHlDb2xsZWN0aW9uSGFuZGxlcnMsIHJlYWRvbmx5TWFwKTtcbn1cbmZ1bmN0aW9uIGNyZWF0ZVJlYWN0aXZlT2JqZWN0KHRhcmdldCwgaXNSZWFkb25seSwgYmFzZUhhbmRsZXJzLCBjb2xsZWN0aW9uSGFuZGxlcnMsIHByb3h5TWFwKSB7XG4gIGlmICghaXNPYmplY3QodGFyZ2V0KSkge1xuICAgIGlmICh0cnVlKSB7XG4gICAgICBjb25zb2xlLndhcm4oYHZhbHVlIGNhbm5vdCBiZSBtYWRlIHJlYWN0aXZlOiAke1N0cmluZyh0YXJnZXQpfWApO1xuICAgIH1cbiAgICByZXR1cm4gdGFyZ2V0O1xuICB9XG4gIGlmICh0YXJnZXRbXG4gICAgXCJfX3ZfcmF3XCJcbiAgICAvKiBSQVcgKi9cbiAgXSAmJiAhKGlzUmVhZG9ubHkgJiYgdGFyZ2V0W1xuICAgIFwiX192X2lzUmVhY3RpdmVcIlxuICAgIC8qIElTX1JFQUNUSVZFICovXG4gIF0pKSB7XG4gICAgcmV0dXJuIHRhcmdldDtcbiAgfVxuICBjb25zdCBleGlzdGluZ1Byb3h5ID0gcHJveHlNYXAuZ2V0KHRhcmdldCk7XG4gIGlmIChleGlzdGluZ1Byb3h5KSB7XG4gICAgcmV0dXJuIGV4aXN0aW5nUHJveHk7XG4gIH1cbiAgY29uc3QgdGFyZ2V0VHlwZSA9IGdldFRhcmdldFR5cGUodGFyZ2V0KTtcbiAgaWYgKHRhcmdldFR5cGUgPT09IDApIHtcbiAgICByZXR1cm4gdGFyZ2V0O1xuICB9XG4gIGNvbnN0IHByb3h5ID0gbmV3IFByb3h5KHRhcmdldCwgdGFyZ2V0VHlwZSA9PT0gMiA/IGNvbGxlY3Rpb25IYW5kbGVycyA6IGJhc2VIYW5kbGVycyk7XG4gIHByb3h5TWFwLnNldCh0YXJnZXQsIHByb3h5KTtcbiAgcmV0dXJuIHByb3h5O1xufVxuZnVuY3Rpb24gdG9SYXcob2JzZXJ2ZWQpIHtcbiAgcmV0dXJuIG9ic2VydmVkICYmIHRvUmF3KG9ic2VydmVkW1xuICAgIFwiX192X3Jhd1wiXG4gICAgLyogUkFXICovXG4gIF0pIHx8IG9ic2VydmVkO1xufVxuZnVuY3Rpb24gaXNSZWYocikge1xuICByZXR1cm4gQm9vbGVhbihyICYmIHIuX192X2lzUmVmID09PSB0cnVlKTtcbn1cblxuLy8gcGFja2FnZXMvYWxwaW5lanMvc3JjL21hZ2ljcy8kbmV4dFRpY2suanNcbm1hZ2ljKFwibmV4dFRpY2tcIiwgKCkgPT4gbmV4dFRpY2spO1xuXG4vLyBwYWNrYWdlcy9hbHBpbmVqcy9zcmMvbWFnaWNzLyRkaXNwYXRjaC5qc1xubWFnaWMoXCJkaXNwYXRjaFwiLCAoZWwpID0+IGRpc3BhdGNoLmJpbmQoZGlzcGF0Y2gsIGVsKSk7XG5cbi8vIHBhY2thZ2VzL2FscGluZWpzL3NyYy9tYWdpY3MvJHdhdGNoLmpzXG5tYWdpYyhcIndhdGNoXCIsIChlbCwgeyBldmFsdWF0ZUxhdGVyOiBldmFsdWF0ZUxhdGVyMiwgY2xlYW51cDogY2xlYW51cDIgfSkgPT4gKGtleSwgY2FsbGJhY2spID0+IHtcbiAgbGV0IGV2YWx1YXRlMiA9IGV2YWx1YXRlTGF0ZXIyKGtleSk7XG4gIGxldCBnZXR0ZXIgPSAoKSA9PiB7XG4gICAgbGV0IHZhbHVlO1xuICAgIGV2YWx1YXRlMigoaSkgPT4gdmFsdWUgPSBpKTtcbiAgICByZXR1cm4gdmFsdWU7XG4gIH07XG4gIGxldCB1bndhdGNoID0gd2F0Y2goZ2V0dGVyLCBjYWxsYmFjayk7XG4gIGNsZWFudXAyKHVud2F0Y2gpO1xufSk7XG5cbi8vIHBhY2thZ2VzL2FscGluZWpzL3NyYy9tYWdpY3MvJHN0b3JlLmpzXG5tYWdpYyhcInN0b3JlXCIsIGdldFN0b3Jlcyk7XG5cbi8vIHBhY2thZ2VzL2FscGluZWpzL3NyYy9tYWdpY3MvJGRhdGEuanNcbm1hZ2ljKFwiZGF0YVwiLCAoZWwpID0+IHNjb3BlKGVsKSk7XG5cbi8vIHBhY2thZ2VzL2FscGluZWpzL3NyYy9tYWdpY3MvJHJvb3QuanNcbm1hZ2ljKFwicm9vdFwiLCAoZWwpID0+IGNsb3Nlc3RSb290KGVsKSk7XG5cbi8vIHBhY2thZ2VzL2FscGluZWpzL3NyYy9tYWdpY3MvJHJlZnMuanNcbm1hZ2ljKFwicmVmc1wiLCAoZWwpID0+IHtcbiAgaWYgKGVsLl94X3JlZnNfcHJveHkpXG4gICAgcmV0dXJuIGVsLl94X3JlZnNfcHJveHk7XG4gIGVsLl94X3JlZnNfcHJveHkgPSBtZXJnZVByb3hpZXMoZ2V0QXJyYXlPZlJlZk9iamVjdChlbCkpO1xuICByZXR1cm4gZWwuX3hfcmVmc19wcm94eTtcbn0pO1xuZnVuY3Rpb24gZ2V0QXJyYXlPZlJlZk9iamVjdChlbCkge1xuICBsZXQgcmVmT2JqZWN0cyA9IFtdO1xuICBmaW5kQ2xvc2VzdChlbCwgKGkpID0+IHtcbiAgICBpZiAoaS5feF9yZWZzKVxuICAgICAgcmVmT2JqZWN0cy5wdXNoKGkuX3hfcmVmcyk7XG4gIH0pO1xuICByZXR1cm4gcmVmT2JqZWN0cztcbn1cblxuLy8gcGFja2FnZXMvYWxwaW5lanMvc3JjL2lkcy5qc1xudmFyIGdsb2JhbElkTWVtbyA9IHt9O1xuZnVuY3Rpb24gZmluZEFuZEluY3JlbWVudElkKG5hbWUpIHtcbiAgaWYgKCFnbG9iYWxJZE1lbW9bbmFtZV0pXG4gICAgZ2xvYmFsSWRNZW1vW25hbWVdID0gMDtcbiAgcmV0dXJuICsrZ2xvYmFsSWRNZW1vW25hbWVdO1xufVxuZnVuY3Rpb24gY2xvc2VzdElkUm9vdChlbCwgbmFtZSkge1xuICByZXR1cm4gZmluZENsb3Nlc3QoZWwsIChlbGVtZW50KSA9PiB7XG4gICAgaWYgKGVsZW1lbnQuX3hfaWRzICYmIGVsZW1lbnQuX3hfaWRzW25hbWVdKVxuICAgICAgcmV0dXJuIHRydWU7XG4gIH0pO1xufVxuZnVuY3Rpb24gc2V0SWRSb290KGVsLCBuYW1lKSB7XG4gIGlmICghZWwuX3hfaWRzKVxuICAgIGVsLl94X2lkcyA9IHt9O1xuICBpZiAoIWVsLl94X2lkc1tuYW1lXSlcbiAgICBlbC5feF9pZHNbbmFtZV0gPSBmaW5kQW5kSW5jcmVtZW50SWQobmFtZSk7XG59XG5cbi8vIHBhY2thZ2VzL2FscGluZWpzL3NyYy9tYWdpY3MvJGlkLmpzXG5tYWdpYyhcImlkXCIsIChlbCwgeyBjbGVhbnVwOiBjbGVhbnVwMiB9KSA9PiAobmFtZSwga2V5ID0gbnVsbCkgPT4ge1xuICBsZXQgY2FjaGVLZXkgPSBgJHtuYW1lfSR7a2V5ID8gYC0ke2tleX1gIDogXCJcIn1gO1xuICByZXR1cm4gY2FjaGVJZEJ5TmFtZU9uRWxlbWVudChlbCwgY2FjaGVLZXksIGNsZWFudXAyLCAoKSA9PiB7XG4gICAgbGV0IHJvb3QgPSBjbG9zZXN0SWRSb290KGVsLCBuYW1lKTtcbiAgICBsZXQgaWQgPSByb290ID8gcm9vdC5feF9pZHNbbmFtZV0gOiBmaW5kQW5kSW5jcmVtZW50SWQobmFtZSk7XG4gICAgcmV0dXJuIGtleSA/IGAke25hbWV9LSR7aWR9LSR7a2V5fWAgOiBgJHtuYW1lfS0ke2lkfWA7XG4gIH0pO1xufSk7XG5pbnRlcmNlcHRDbG9uZSgoZnJvbSwgdG8pID0+IHtcbiAgaWYgKGZyb20uX3hfaWQpIHtcbiAgICB0by5feF9pZCA9IGZyb20uX3hfaWQ7XG4gIH1cbn0pO1xuZnVuY3Rpb24gY2FjaGVJZEJ5TmFtZU9uRWxlbWVudChlbCwgY2FjaGVLZXksIGNsZWFudXAyLCBjYWxsYmFjaykge1xuICBpZiAoIWVsLl94X2lkKVxuICAgIGVsLl94X2lkID0ge307XG4gIGlmIChlbC5feF9pZFtjYWNoZUtleV0pXG4gICAgcmV0dXJuIGVsLl94X2lkW2NhY2hlS2V5XTtcbiAgbGV0IG91dHB1dCA9IGNhbGxiYWNrKCk7XG4gIGVsLl94X2lkW2NhY2hlS2V5XSA9IG91dHB1dDtcbiAgY2xlYW51cDIoKCkgPT4ge1xuICAgIGRlbGV0ZSBlbC5feF9pZFtjYWNoZUtleV07XG4gIH0pO1xuICByZXR1cm4gb3V0cHV0O1xufVxuXG4vLyBwYWNrYWdlcy9hbHBpbmVqcy9zcmMvbWFnaWNzLyRlbC5qc1xubWFnaWMoXCJlbFwiLCAoZWwpID0+IGVsKTtcblxuLy8gcGFja2FnZXMvYWxwaW5lanMvc3JjL21hZ2ljcy9pbmRleC5qc1xud2Fybk1pc3NpbmdQbHVnaW5NYWdpYyhcIkZvY3VzXCIsIFwiZm9jdXNcIiwgXCJmb2N1c1wiKTtcbndhcm5NaXNzaW5nUGx1Z2luTWFnaWMoXCJQZXJzaXN0XCIsIFwicGVyc2lzdFwiLCBcInBlcnNpc3RcIik7XG5mdW5jdGlvbiB3YXJuTWlzc2luZ1BsdWdpbk1hZ2ljKG5hbWUsIG1hZ2ljTmFtZSwgc2x1Zykge1xuICBtYWdpYyhtYWdpY05hbWUsIChlbCkgPT4gd2FybihgWW91IGNhbid0IHVzZSBbJCR7bWFnaWNOYW1lfV0gd2l0aG91dCBmaXJzdCBpbnN0YWxsaW5nIHRoZSBcIiR7bmFtZX1cIiBwbHVnaW4gaGVyZTogaHR0cHM6Ly9hbHBpbmVqcy5kZXYvcGx1Z2lucy8ke3NsdWd9YCwgZWwpKTtcbn1cblxuLy8gcGFja2FnZXMvYWxwaW5lanMvc3JjL2RpcmVjdGl2ZXMveC1tb2RlbGFibGUuanNcbmRpcmVjdGl2ZShcIm1vZGVsYWJsZVwiLCAoZWwsIHsgZXhwcmVzc2lvbiB9LCB7IGVmZmVjdDogZWZmZWN0MywgZXZhbHVhdGVMYXRlcjogZXZhbHVhdGVMYXRlcjIsIGNsZWFudXA6IGNsZWFudXAyIH0pID0+IHtcbiAgbGV0IGZ1bmMgPSBldmFsdWF0ZUxhdGVyMihleHByZXNzaW9uKTtcbiAgbGV0IGlubmVyR2V0ID0gKCkgPT4ge1xuICAgIGxldCByZXN1bHQ7XG4gICAgZnVuYygoaSkgPT4gcmVzdWx0ID0gaSk7XG4gICAgcmV0dXJuIHJlc3VsdDtcbiAgfTtcbiAgbGV0IGV2YWx1YXRlSW5uZXJTZXQgPSBldmFsdWF0ZUxhdGVyMihgJHtleHByZXNzaW9ufSA9IF9fcGxhY2Vob2xkZXJgKTtcbiAgbGV0IGlubmVyU2V0ID0gKHZhbCkgPT4gZXZhbHVhdGVJbm5lclNldCgoKSA9PiB7XG4gIH0sIHsgc2NvcGU6IHsgXCJfX3BsYWNlaG9sZGVyXCI6IHZhbCB9IH0pO1xuICBsZXQgaW5pdGlhbFZhbHVlID0gaW5uZXJHZXQoKTtcbiAgaW5uZXJTZXQoaW5pdGlhbFZhbHVlKTtcbiAgcXVldWVNaWNyb3Rhc2soKCkgPT4ge1xuICAgIGlmICghZWwuX3hfbW9kZWwpXG4gICAgICByZXR1cm47XG4gICAgZWwuX3hfcmVtb3ZlTW9kZWxMaXN0ZW5lcnNbXCJkZWZhdWx0XCJdKCk7XG4gICAgbGV0IG91dGVyR2V0ID0gZWwuX3hfbW9kZWwuZ2V0O1xuICAgIGxldCBvdXRlclNldCA9IGVsLl94X21vZGVsLnNldDtcbiAgICBsZXQgcmVsZWFzZUVudGFuZ2xlbWVudCA9IGVudGFuZ2xlKFxuICAgICAge1xuICAgICAgICBnZXQoKSB7XG4gICAgICAgICAgcmV0dXJuIG91dGVyR2V0KCk7XG4gICAgICAgIH0sXG4gICAgICAgIHNldCh2YWx1ZSkge1xuICAgICAgICAgIG91dGVyU2V0KHZhbHVlKTtcbiAgICAgICAgfVxuICAgICAgfSxcbiAgICAgIHtcbiAgICAgICAgZ2V0KCkge1xuICAgICAgICAgIHJldHVybiBpbm5lckdldCgpO1xuICAgICAgICB9LFxuICAgICAgICBzZXQodmFsdWUpIHtcbiAgICAgICAgICBpbm5lclNldCh2YWx1ZSk7XG4gICAgICAgIH1cbiAgICAgIH1cbiAgICApO1xuICAgIGNsZWFudXAyKHJlbGVhc2VFbnRhbmdsZW1lbnQpO1xuICB9KTtcbn0pO1xuXG4vLyBwYWNrYWdlcy9hbHBpbmVqcy9zcmMvZGlyZWN0aXZlcy94LXRlbGVwb3J0LmpzXG5kaXJlY3RpdmUoXCJ0ZWxlcG9ydFwiLCAoZWwsIHsgbW9kaWZpZXJzLCBleHByZXNzaW9uIH0sIHsgY2xlYW51cDogY2xlYW51cDIgfSkgPT4ge1xuICBpZiAoZWwudGFnTmFtZS50b0xvd2VyQ2FzZSgpICE9PSBcInRlbXBsYXRlXCIpXG4gICAgd2FybihcIngtdGVsZXBvcnQgY2FuIG9ubHkgYmUgdXNlZCBvbiBhIDx0ZW1wbGF0ZT4gdGFnXCIsIGVsKTtcbiAgbGV0IHRhcmdldCA9IGdldFRhcmdldChleHByZXNzaW9uKTtcbiAgbGV0IGNsb25lMiA9IGVsLmNvbnRlbnQuY2xvbmVOb2RlKHRydWUpLmZpcnN0RWxlbWVudENoaWxkO1xuICBlbC5feF90ZWxlcG9ydCA9IGNsb25lMjtcbiAgY2xvbmUyLl94X3RlbGVwb3J0QmFjayA9IGVsO1xuICBlbC5zZXRBdHRyaWJ1dGUoXCJkYXRhLXRlbGVwb3J0LXRlbXBsYXRlXCIsIHRydWUpO1xuICBjbG9uZTIuc2V0QXR0cmlidXRlKFwiZGF0YS10ZWxlcG9ydC10YXJnZXRcIiwgdHJ1ZSk7XG4gIGlmIChlbC5feF9mb3J3YXJkRXZlbnRzKSB7XG4gICAgZWwuX3hfZm9yd2FyZEV2ZW50cy5mb3JFYWNoKChldmVudE5hbWUpID0+IHtcbiAgICAgIGNsb25lMi5hZGRFdmVudExpc3RlbmVyKGV2ZW50TmFtZSwgKGUpID0+IHtcbiAgICAgICAgZS5zdG9wUHJvcGFnYXRpb24oKTtcbiAgICAgICAgZWwuZGlzcGF0Y2hFdmVudChuZXcgZS5jb25zdHJ1Y3RvcihlLnR5cGUsIGUpKTtcbiAgICAgIH0pO1xuICAgIH0pO1xuICB9XG4gIGFkZFNjb3BlVG9Ob2RlKGNsb25lMiwge30sIGVsKTtcbiAgbGV0IHBsYWNlSW5Eb20gPSAoY2xvbmUzLCB0YXJnZXQyLCBtb2RpZmllcnMyKSA9PiB7XG4gICAgaWYgKG1vZGlmaWVyczIuaW5jbHVkZXMoXCJwcmVwZW5kXCIpKSB7XG4gICAgICB0YXJnZXQyLnBhcmVudE5vZGUuaW5zZXJ0QmVmb3JlKGNsb25lMywgdGFyZ2V0Mik7XG4gICAgfSBlbHNlIGlmIChtb2RpZmllcnMyLmluY2x1ZGVzKFwiYXBwZW5kXCIpKSB7XG4gICAgICB0YXJnZXQyLnBhcmVudE5vZGUuaW5zZXJ0QmVmb3JlKGNsb25lMywgdGFyZ2V0Mi5uZXh0U2libGluZyk7XG4gICAgfSBlbHNlIHtcbiAgICAgIHRhcmdldDIuYXBwZW5kQ2hpbGQoY2xvbmUzKTtcbiAgICB9XG4gIH07XG4gIG11dGF0ZURvbSgoKSA9PiB7XG4gICAgcGxhY2VJbkRvbShjbG9uZTIsIHRhcmdldCwgbW9kaWZpZXJzKTtcbiAgICBpbml0VHJlZShjbG9uZTIpO1xuICAgIGNsb25lMi5feF9pZ25vcmUgPSB0cnVlO1xuICB9KTtcbiAgZWwuX3hfdGVsZXBvcnRQdXRCYWNrID0gKCkgPT4ge1xuICAgIGxldCB0YXJnZXQyID0gZ2V0VGFyZ2V0KGV4cHJlc3Npb24pO1xuICAgIG11dGF0ZURvbSgoKSA9PiB7XG4gICAgICBwbGFjZUluRG9tKGVsLl94X3RlbGVwb3J0LCB0YXJnZXQyLCBtb2RpZmllcnMpO1xuICAgIH0pO1xuICB9O1xuICBjbGVhbnVwMigoKSA9PiBjbG9uZTIucmVtb3ZlKCkpO1xufSk7XG52YXIgdGVsZXBvcnRDb250YWluZXJEdXJpbmdDbG9uZSA9IGRvY3VtZW50LmNyZWF0ZUVsZW1lbnQoXCJkaXZcIik7XG5mdW5jdGlvbiBnZXRUYXJnZXQoZXhwcmVzc2lvbikge1xuICBsZXQgdGFyZ2V0ID0gc2tpcER1cmluZ0Nsb25lKCgpID0+IHtcbiAgICByZXR1cm4gZG9jdW1lbnQucXVlcnlTZWxlY3RvcihleHByZXNzaW9uKTtcbiAgfSwgKCkgPT4ge1xuICAgIHJldHVybiB0ZWxlcG9ydENvbnRhaW5lckR1cmluZ0Nsb25lO1xuICB9KSgpO1xuICBpZiAoIXRhcmdldClcbiAgICB3YXJuKGBDYW5ub3QgZmluZCB4LXRlbGVwb3J0IGVsZW1lbnQgZm9yIHNlbGVjdG9yOiBcIiR7ZXhwcmVzc2lvbn1cImApO1xuICByZXR1cm4gdGFyZ2V0O1xufVxuXG4vLyBwYWNrYWdlcy9hbHBpbmVqcy9zcmMvZGlyZWN0aXZlcy94LWlnbm9yZS5qc1xudmFyIGhhbmRsZXIgPSAoKSA9PiB7XG59O1xuaGFuZGxlci5pbmxpbmUgPSAoZWwsIHsgbW9kaWZpZXJzIH0sIHsgY2xlYW51cDogY2xlYW51cDIgfSkgPT4ge1xuICBtb2RpZmllcnMuaW5jbHVkZXMoXCJzZWxmXCIpID8gZWwuX3hfaWdub3JlU2VsZiA9IHRydWUgOiBlbC5feF9pZ25vcmUgPSB0cnVlO1xuICBjbGVhbnVwMigoKSA9PiB7XG4gICAgbW9kaWZpZXJzLmluY2x1ZGVzKFwic2VsZlwiKSA/IGRlbGV0ZSBlbC5feF9pZ25vcmVTZWxmIDogZGVsZXRlIGVsLl94X2lnbm9yZTtcbiAgfSk7XG59O1xuZGlyZWN0aXZlKFwiaWdub3JlXCIsIGhhbmRsZXIpO1xuXG4vLyBwYWNrYWdlcy9hbHBpbmVqcy9zcmMvZGlyZWN0aXZlcy94LWVmZmVjdC5qc1xuZGlyZWN0aXZlKFwiZWZmZWN0XCIsIHNraXBEdXJpbmdDbG9uZSgoZWwsIHsgZXhwcmVzc2lvbiB9LCB7IGVmZmVjdDogZWZmZWN0MyB9KSA9PiB7XG4gIGVmZmVjdDMoZXZhbHVhdGVMYXRlcihlbCwgZXhwcmVzc2lvbikpO1xufSkpO1xuXG4vLyBwYWNrYWdlcy9hbHBpbmVqcy9zcmMvdXRpbHMvb24uanNcbmZ1bmN0aW9uIG9uKGVsLCBldmVudCwgbW9kaWZpZXJzLCBjYWxsYmFjaykge1xuICBsZXQgbGlzdGVuZXJUYXJnZXQgPSBlbDtcbiAgbGV0IGhhbmRsZXI0ID0gKGUpID0+IGNhbGxiYWNrKGUpO1xuICBsZXQgb3B0aW9ucyA9IHt9O1xuICBsZXQgd3JhcEhhbmRsZXIgPSAoY2FsbGJhY2syLCB3cmFwcGVyKSA9PiAoZSkgPT4gd3JhcHBlcihjYWxsYmFjazIsIGUpO1xuICBpZiAobW9kaWZpZXJzLmluY2x1ZGVzKFwiZG90XCIpKVxuICAgIGV2ZW50ID0gZG90U3ludGF4KGV2ZW50KTtcbiAgaWYgKG1vZGlmaWVycy5pbmNsdWRlcyhcImNhbWVsXCIpKVxuICAgIGV2ZW50ID0gY2FtZWxDYXNlMihldmVudCk7XG4gIGlmIChtb2RpZmllcnMuaW5jbHVkZXMoXCJwYXNzaXZlXCIpKVxuICAgIG9wdGlvbnMucGFzc2l2ZSA9IHRydWU7XG4gIGlmIChtb2RpZmllcnMuaW5jbHVkZXMoXCJjYXB0dXJlXCIpKVxuICAgIG9wdGlvbnMuY2FwdHVyZSA9IHRydWU7XG4gIGlmIChtb2RpZmllcnMuaW5jbHVkZXMoXCJ3aW5kb3dcIikpXG4gICAgbGlzdGVuZXJUYXJnZXQgPSB3aW5kb3c7XG4gIGlmIChtb2RpZmllcnMuaW5jbHVkZXMoXCJkb2N1bWVudFwiKSlcbiAgICBsaXN0ZW5lclRhcmdldCA9IGRvY3VtZW50O1xuICBpZiAobW9kaWZpZXJzLmluY2x1ZGVzKFwiZGVib3VuY2VcIikpIHtcbiAgICBsZXQgbmV4dE1vZGlmaWVyID0gbW9kaWZpZXJzW21vZGlmaWVycy5pbmRleE9mKFwiZGVib3VuY2VcIikgKyAxXSB8fCBcImludmFsaWQtd2FpdFwiO1xuICAgIGxldCB3YWl0ID0gaXNOdW1lcmljKG5leHRNb2RpZmllci5zcGxpdChcIm1zXCIpWzBdKSA/IE51bWJlcihuZXh0TW9kaWZpZXIuc3BsaXQoXCJtc1wiKVswXSkgOiAyNTA7XG4gICAgaGFuZGxlcjQgPSBkZWJvdW5jZShoYW5kbGVyNCwgd2FpdCk7XG4gIH1cbiAgaWYgKG1vZGlmaWVycy5pbmNsdWRlcyhcInRocm90dGxlXCIpKSB7XG4gICAgbGV0IG5leHRNb2RpZmllciA9IG1vZGlmaWVyc1ttb2RpZmllcnMuaW5kZXhPZihcInRocm90dGxlXCIpICsgMV0gfHwgXCJpbnZhbGlkLXdhaXRcIjtcbiAgICBsZXQgd2FpdCA9IGlzTnVtZXJpYyhuZXh0TW9kaWZpZXIuc3BsaXQoXCJtc1wiKVswXSkgPyBOdW1iZXIobmV4dE1vZGlmaWVyLnNwbGl0KFwibXNcIilbMF0pIDogMjUwO1xuICAgIGhhbmRsZXI0ID0gdGhyb3R0bGUoaGFuZGxlcjQsIHdhaXQpO1xuICB9XG4gIGlmIChtb2RpZmllcnMuaW5jbHVkZXMoXCJwcmV2ZW50XCIpKVxuICAgIGhhbmRsZXI0ID0gd3JhcEhhbmRsZXIoaGFuZGxlcjQsIChuZXh0LCBlKSA9PiB7XG4gICAgICBlLnByZXZlbnREZWZhdWx0KCk7XG4gICAgICBuZXh0KGUpO1xuICAgIH0pO1xuICBpZiAobW9kaWZpZXJzLmluY2x1ZGVzKFwic3RvcFwiKSlcbiAgICBoYW5kbGVyNCA9IHdyYXBIYW5kbGVyKGhhbmRsZXI0LCAobmV4dCwgZSkgPT4ge1xuICAgICAgZS5zdG9wUHJvcGFnYXRpb24oKTtcbiAgICAgIG5leHQoZSk7XG4gICAgfSk7XG4gIGlmIChtb2RpZmllcnMuaW5jbHVkZXMoXCJzZWxmXCIpKVxuICAgIGhhbmRsZXI0ID0gd3JhcEhhbmRsZXIoaGFuZGxlcjQsIChuZXh0LCBlKSA9PiB7XG4gICAgICBlLnRhcmdldCA9PT0gZWwgJiYgbmV4dChlKTtcbiAgICB9KTtcbiAgaWYgKG1vZGlmaWVycy5pbmNsdWRlcyhcImF3YXlcIikgfHwgbW9kaWZpZXJzLmluY2x1ZGVzKFwib3V0c2lkZVwiKSkge1xuICAgIGxpc3RlbmVyVGFyZ2V0ID0gZG9jdW1lbnQ7XG4gICAgaGFuZGxlcjQgPSB3cmFwSGFuZGxlcihoYW5kbGVyNCwgKG5leHQsIGUpID0+IHtcbiAgICAgIGlmIChlbC5jb250YWlucyhlLnRhcmdldCkpXG4gICAgICAgIHJldHVybjtcbiAgICAgIGlmIChlLnRhcmdldC5pc0Nvbm5lY3RlZCA9PT0gZmFsc2UpXG4gICAgICAgIHJldHVybjtcbiAgICAgIGlmIChlbC5vZmZzZXRXaWR0aCA8IDEgJiYgZWwub2Zmc2V0SGVpZ2h0IDwgMSlcbiAgICAgICAgcmV0dXJuO1xuICAgICAgaWYgKGVsLl94X2lzU2hvd24gPT09IGZhbHNlKVxuICAgICAgICByZXR1cm47XG4gICAgICBuZXh0KGUpO1xuICAgIH0pO1xuICB9XG4gIGlmIChtb2RpZmllcnMuaW5jbHVkZXMoXCJvbmNlXCIpKSB7XG4gICAgaGFuZGxlcjQgPSB3cmFwSGFuZGxlcihoYW5kbGVyNCwgKG5leHQsIGUpID0+IHtcbiAgICAgIG5leHQoZSk7XG4gICAgICBsaXN0ZW5lclRhcmdldC5yZW1vdmVFdmVudExpc3RlbmVyKGV2ZW50LCBoYW5kbGVyNCwgb3B0aW9ucyk7XG4gICAgfSk7XG4gIH1cbiAgaGFuZGxlcjQgPSB3cmFwSGFuZGxlcihoYW5kbGVyNCwgKG5leHQsIGUpID0+IHtcbiAgICBpZiAoaXNLZXlFdmVudChldmVudCkpIHtcbiAgICAgIGlmIChpc0xpc3RlbmluZ0ZvckFTcGVjaWZpY0tleVRoYXRIYXNudEJlZW5QcmVzc2VkKGUsIG1vZGlmaWVycykpIHtcbiAgICAgICAgcmV0dXJuO1xuICAgICAgfVxuICAgIH1cbiAgICBuZXh0KGUpO1xuICB9KTtcbiAgbGlzdGVuZXJUYXJnZXQuYWRkRXZlbnRMaXN0ZW5lcihldmVudCwgaGFuZGxlcjQsIG9wdGlvbnMpO1xuICByZXR1cm4gKCkgPT4ge1xuICAgIGxpc3RlbmVyVGFyZ2V0LnJlbW92ZUV2ZW50TGlzdGVuZXIoZXZlbnQsIGhhbmRsZXI0LCBvcHRpb25zKTtcbiAgfTtcbn1cbmZ1bmN0aW9uIGRvdFN5bnRheChzdWJqZWN0KSB7XG4gIHJldHVybiBzdWJqZWN0LnJlcGxhY2UoLy0vZywgXCIuXCIpO1xufVxuZnVuY3Rpb24gY2FtZWxDYXNlMihzdWJqZWN0KSB7XG4gIHJldHVybiBzdWJqZWN0LnRvTG93ZXJDYXNlKCkucmVwbGFjZSgvLShcXHcpL2csIChtYXRjaCwgY2hhcikgPT4gY2hhci50b1VwcGVyQ2FzZSgpKTtcbn1cbmZ1bmN0aW9uIGlzTnVtZXJpYyhzdWJqZWN0KSB7XG4gIHJldHVybiAhQXJyYXkuaXNBcnJheShzdWJqZWN0KSAmJiAhaXNOYU4oc3ViamVjdCk7XG59XG5mdW5jdGlvbiBrZWJhYkNhc2UyKHN1YmplY3QpIHtcbiAgaWYgKFtcIiBcIiwgXCJfXCJdLmluY2x1ZGVzKFxuICAgIHN1YmplY3RcbiAgKSlcbiAgICByZXR1cm4gc3ViamVjdDtcbiAgcmV0dXJuIHN1YmplY3QucmVwbGFjZSgvKFthLXpdKShbQS1aXSkvZywgXCIkMS0kMlwiKS5yZXBsYWNlKC9bX1xcc10vLCBcIi1cIikudG9Mb3dlckNhc2UoKTtcbn1cbmZ1bmN0aW9uIGlzS2V5RXZlbnQoZXZlbnQpIHtcbiAgcmV0dXJuIFtcImtleWRvd25cIiwgXCJrZXl1cFwiXS5pbmNsdWRlcyhldmVudCk7XG59XG5mdW5jdGlvbiBpc0xpc3RlbmluZ0ZvckFTcGVjaWZpY0tleVRoYXRIYXNudEJlZW5QcmVzc2VkKGUsIG1vZGlmaWVycykge1xuICBsZXQga2V5TW9kaWZpZXJzID0gbW9kaWZpZXJzLmZpbHRlcigoaSkgPT4ge1xuICAgIHJldHVybiAhW1wid2luZG93XCIsIFwiZG9jdW1lbnRcIiwgXCJwcmV2ZW50XCIsIFwic3RvcFwiLCBcIm9uY2VcIiwgXCJjYXB0dXJlXCJdLmluY2x1ZGVzKGkpO1xuICB9KTtcbiAgaWYgKGtleU1vZGlmaWVycy5pbmNsdWRlcyhcImRlYm91bmNlXCIpKSB7XG4gICAgbGV0IGRlYm91bmNlSW5kZXggPSBrZXlNb2RpZmllcnMuaW5kZXhPZihcImRlYm91bmNlXCIpO1xuICAgIGtleU1vZGlmaWVycy5zcGxpY2UoZGVib3VuY2VJbmRleCwgaXNOdW1lcmljKChrZXlNb2RpZmllcnNbZGVib3VuY2VJbmRleCArIDFdIHx8IFwiaW52YWxpZC13YWl0XCIpLnNwbGl0KFwibXNcIilbMF0pID8gMiA6IDEpO1xuICB9XG4gIGlmIChrZXlNb2RpZmllcnMuaW5jbHVkZXMoXCJ0aHJvdHRsZVwiKSkge1xuICAgIGxldCBkZWJvdW5jZUluZGV4ID0ga2V5TW9kaWZpZXJzLmluZGV4T2YoXCJ0aHJvdHRsZVwiKTtcbiAgICBrZXlNb2RpZmllcnMuc3BsaWNlKGRlYm91bmNlSW5kZXgsIGlzTnVtZXJpYygoa2V5TW9kaWZpZXJzW2RlYm91bmNlSW5kZXggKyAxXSB8fCBcImludmFsaWQtd2FpdFwiKS5zcGxpdChcIm1zXCIpWzBdKSA/IDIgOiAxKTtcbiAgfVxuICBpZiAoa2V5TW9kaWZpZXJzLmxlbmd0aCA9PT0gMClcbiAgICByZXR1cm4gZmFsc2U7XG4gIGlmIChrZXlNb2RpZmllcnMubGVuZ3RoID09PSAxICYmIGtleVRvTW9kaWZpZXJzKGUua2V5KS5pbmNsdWRlcyhrZXlNb2RpZmllcnNbMF0pKVxuICAgIHJldHVybiBmYWxzZTtcbiAgY29uc3Qgc3lzdGVtS2V5TW9kaWZpZXJzID0gW1wiY3RybFwiLCBcInNoaWZ0XCIsIFwiYWx0XCIsIFwibWV0YVwiLCBcImNtZFwiLCBcInN1cGVyXCJdO1xuICBjb25zdCBzZWxlY3RlZFN5c3RlbUtleU1vZGlmaWVycyA9IHN5c3RlbUtleU1vZGlmaWVycy5maWx0ZXIoKG1vZGlmaWVyKSA9PiBrZXlNb2RpZmllcnMuaW5jbHVkZXMobW9kaWZpZXIpKTtcbiAga2V5TW9kaWZpZXJzID0ga2V5TW9kaWZpZXJzLmZpbHRlcigoaSkgPT4gIXNlbGVjdGVkU3lzdGVtS2V5TW9kaWZpZXJzLmluY2x1ZGVzKGkpKTtcbiAgaWYgKHNlbGVjdGVkU3lzdGVtS2V5TW9kaWZpZXJzLmxlbmd0aCA+IDApIHtcbiAgICBjb25zdCBhY3RpdmVseVByZXNzZWRLZXlNb2RpZmllcnMgPSBzZWxlY3RlZFN5c3RlbUtleU1vZGlmaWVycy5maWx0ZXIoKG1vZGlmaWVyKSA9PiB7XG4gICAgICBpZiAobW9kaWZpZXIgPT09IFwiY21kXCIgfHwgbW9kaWZpZXIgPT09IFwic3VwZXJcIilcbiAgICAgICAgbW9kaWZpZXIgPSBcIm1ldGFcIjtcbiAgICAgIHJldHVybiBlW2Ake21vZGlmaWVyfUtleWBdO1xuICAgIH0pO1xuICAgIGlmIChhY3RpdmVseVByZXNzZWRLZXlNb2RpZmllcnMubGVuZ3RoID09PSBzZWxlY3RlZFN5c3RlbUtleU1vZGlmaWVycy5sZW5ndGgpIHtcbiAgICAgIGlmIChrZXlUb01vZGlmaWVycyhlLmtleSkuaW5jbHVkZXMoa2V5TW9kaWZpZXJzWzBdKSlcbiAgICAgICAgcmV0dXJuIGZhbHNlO1xuICAgIH1cbiAgfVxuICByZXR1cm4gdHJ1ZTtcbn1cbmZ1bmN0aW9uIGtleVRvTW9kaWZpZXJzKGtleSkge1xuICBpZiAoIWtleSlcbiAgICByZXR1cm4gW107XG4gIGtleSA9IGtlYmFiQ2FzZTIoa2V5KTtcbiAgbGV0IG1vZGlmaWVyVG9LZXlNYXAgPSB7XG4gICAgXCJjdHJsXCI6IFwiY29udHJvbFwiLFxuICAgIFwic2xhc2hcIjogXCIvXCIsXG4gICAgXCJzcGFjZVwiOiBcIiBcIixcbiAgICBcInNwYWNlYmFyXCI6IFwiIFwiLFxuICAgIFwiY21kXCI6IFwibWV0YVwiLFxuICAgIFwiZXNjXCI6IFwiZXNjYXBlXCIsXG4gICAgXCJ1cFwiOiBcImFycm93LXVwXCIsXG4gICAgXCJkb3duXCI6IFwiYXJyb3ctZG93blwiLFxuICAgIFwibGVmdFwiOiBcImFycm93LWxlZnRcIixcbiAgICBcInJpZ2h0XCI6IFwiYXJyb3ctcmlnaHRcIixcbiAgICBcInBlcmlvZFwiOiBcIi5cIixcbiAgICBcImVxdWFsXCI6IFwiPVwiLFxuICAgIFwibWludXNcIjogXCItXCIsXG4gICAgXCJ1bmRlcnNjb3JlXCI6IFwiX1wiXG4gIH07XG4gIG1vZGlmaWVyVG9LZXlNYXBba2V5XSA9IGtleTtcbiAgcmV0dXJuIE9iamVjdC5rZXlzKG1vZGlmaWVyVG9LZXlNYXApLm1hcCgobW9kaWZpZXIpID0+IHtcbiAgICBpZiAobW9kaWZpZXJUb0tleU1hcFttb2RpZmllcl0gPT09IGtleSlcbiAgICAgIHJldHVybiBtb2RpZmllcjtcbiAgfSkuZmlsdGVyKChtb2RpZmllcikgPT4gbW9kaWZpZXIpO1xufVxuXG4vLyBwYWNrYWdlcy9hbHBpbmVqcy9zcmMvZGlyZWN0aXZlcy94LW1vZGVsLmpzXG5kaXJlY3RpdmUoXCJtb2RlbFwiLCAoZWwsIHsgbW9kaWZpZXJzLCBleHByZXNzaW9uIH0sIHsgZWZmZWN0OiBlZmZlY3QzLCBjbGVhbnVwOiBjbGVhbnVwMiB9KSA9PiB7XG4gIGxldCBzY29wZVRhcmdldCA9IGVsO1xuICBpZiAobW9kaWZpZXJzLmluY2x1ZGVzKFwicGFyZW50XCIpKSB7XG4gICAgc2NvcGVUYXJnZXQgPSBlbC5wYXJlbnROb2RlO1xuICB9XG4gIGxldCBldmFsdWF0ZUdldCA9IGV2YWx1YXRlTGF0ZXIoc2NvcGVUYXJnZXQsIGV4cHJlc3Npb24pO1xuICBsZXQgZXZhbHVhdGVTZXQ7XG4gIGlmICh0eXBlb2YgZXhwcmVzc2lvbiA9PT0gXCJzdHJpbmdcIikge1xuICAgIGV2YWx1YXRlU2V0ID0gZXZhbHVhdGVMYXRlcihzY29wZVRhcmdldCwgYCR7ZXhwcmVzc2lvbn0gPSBfX3BsYWNlaG9sZGVyYCk7XG4gIH0gZWxzZSBpZiAodHlwZW9mIGV4cHJlc3Npb24gPT09IFwiZnVuY3Rpb25cIiAmJiB0eXBlb2YgZXhwcmVzc2lvbigpID09PSBcInN0cmluZ1wiKSB7XG4gICAgZXZhbHVhdGVTZXQgPSBldmFsdWF0ZUxhdGVyKHNjb3BlVGFyZ2V0LCBgJHtleHByZXNzaW9uKCl9ID0gX19wbGFjZWhvbGRlcmApO1xuICB9IGVsc2Uge1xuICAgIGV2YWx1YXRlU2V0ID0gKCkgPT4ge1xuICAgIH07XG4gIH1cbiAgbGV0IGdldFZhbHVlID0gKCkgPT4ge1xuICAgIGxldCByZXN1bHQ7XG4gICAgZXZhbHVhdGVHZXQoKHZhbHVlKSA9PiByZXN1bHQgPSB2YWx1ZSk7XG4gICAgcmV0dXJuIGlzR2V0dGVyU2V0dGVyKHJlc3VsdCkgPyByZXN1bHQuZ2V0KCkgOiByZXN1bHQ7XG4gIH07XG4gIGxldCBzZXRWYWx1ZSA9ICh2YWx1ZSkgPT4ge1xuICAgIGxldCByZXN1bHQ7XG4gICAgZXZhbHVhdGVHZXQoKHZhbHVlMikgPT4gcmVzdWx0ID0gdmFsdWUyKTtcbiAgICBpZiAoaXNHZXR0ZXJTZXR0ZXIocmVzdWx0KSkge1xuICAgICAgcmVzdWx0LnNldCh2YWx1ZSk7XG4gICAgfSBlbHNlIHtcbiAgICAgIGV2YWx1YXRlU2V0KCgpID0+IHtcbiAgICAgIH0sIHtcbiAgICAgICAgc2NvcGU6IHsgXCJfX3BsYWNlaG9sZGVyXCI6IHZhbHVlIH1cbiAgICAgIH0pO1xuICAgIH1cbiAgfTtcbiAgaWYgKHR5cGVvZiBleHByZXNzaW9uID09PSBcInN0cmluZ1wiICYmIGVsLnR5cGUgPT09IFwicmFkaW9cIikge1xuICAgIG11dGF0ZURvbSgoKSA9PiB7XG4gICAgICBpZiAoIWVsLmhhc0F0dHJpYnV0ZShcIm5hbWVcIikpXG4gICAgICAgIGVsLnNldEF0dHJpYnV0ZShcIm5hbWVcIiwgZXhwcmVzc2lvbik7XG4gICAgfSk7XG4gIH1cbiAgdmFyIGV2ZW50ID0gZWwudGFnTmFtZS50b0xvd2VyQ2FzZSgpID09PSBcInNlbGVjdFwiIHx8IFtcImNoZWNrYm94XCIsIFwicmFkaW9cIl0uaW5jbHVkZXMoZWwudHlwZSkgfHwgbW9kaWZpZXJzLmluY2x1ZGVzKFwibGF6eVwiKSA/IFwiY2hhbmdlXCIgOiBcImlucHV0XCI7XG4gIGxldCByZW1vdmVMaXN0ZW5lciA9IGlzQ2xvbmluZyA/ICgpID0+IHtcbiAgfSA6IG9uKGVsLCBldmVudCwgbW9kaWZpZXJzLCAoZSkgPT4ge1xuICAgIHNldFZhbHVlKGdldElucHV0VmFsdWUoZWwsIG1vZGlmaWVycywgZSwgZ2V0VmFsdWUoKSkpO1xuICB9KTtcbiAgaWYgKG1vZGlmaWVycy5pbmNsdWRlcyhcImZpbGxcIikpIHtcbiAgICBpZiAoW3ZvaWQgMCwgbnVsbCwgXCJcIl0uaW5jbHVkZXMoZ2V0VmFsdWUoKSkgfHwgZWwudHlwZSA9PT0gXCJjaGVja2JveFwiICYmIEFycmF5LmlzQXJyYXkoZ2V0VmFsdWUoKSkpIHtcbiAgICAgIGVsLmRpc3BhdGNoRXZlbnQobmV3IEV2ZW50KGV2ZW50LCB7fSkpO1xuICAgIH1cbiAgfVxuICBpZiAoIWVsLl94X3JlbW92ZU1vZGVsTGlzdGVuZXJzKVxuICAgIGVsLl94X3JlbW92ZU1vZGVsTGlzdGVuZXJzID0ge307XG4gIGVsLl94X3JlbW92ZU1vZGVsTGlzdGVuZXJzW1wiZGVmYXVsdFwiXSA9IHJlbW92ZUxpc3RlbmVyO1xuICBjbGVhbnVwMigoKSA9PiBlbC5feF9yZW1vdmVNb2RlbExpc3RlbmVyc1tcImRlZmF1bHRcIl0oKSk7XG4gIGlmIChlbC5mb3JtKSB7XG4gICAgbGV0IHJlbW92ZVJlc2V0TGlzdGVuZXIgPSBvbihlbC5mb3JtLCBcInJlc2V0XCIsIFtdLCAoZSkgPT4ge1xuICAgICAgbmV4dFRpY2soKCkgPT4gZWwuX3hfbW9kZWwgJiYgZWwuX3hfbW9kZWwuc2V0KGVsLnZhbHVlKSk7XG4gICAgfSk7XG4gICAgY2xlYW51cDIoKCkgPT4gcmVtb3ZlUmVzZXRMaXN0ZW5lcigpKTtcbiAgfVxuICBlbC5feF9tb2RlbCA9IHtcbiAgICBnZXQoKSB7XG4gICAgICByZXR1cm4gZ2V0VmFsdWUoKTtcbiAgICB9LFxuICAgIHNldCh2YWx1ZSkge1xuICAgICAgc2V0VmFsdWUodmFsdWUpO1xuICAgIH1cbiAgfTtcbiAgZWwuX3hfZm9yY2VNb2RlbFVwZGF0ZSA9ICh2YWx1ZSkgPT4ge1xuICAgIGlmICh2YWx1ZSA9PT0gdm9pZCAwICYmIHR5cGVvZiBleHByZXNzaW9uID09PSBcInN0cmluZ1wiICYmIGV4cHJlc3Npb24ubWF0Y2goL1xcLi8pKVxuICAgICAgdmFsdWUgPSBcIlwiO1xuICAgIHdpbmRvdy5mcm9tTW9kZWwgPSB0cnVlO1xuICAgIG11dGF0ZURvbSgoKSA9PiBiaW5kKGVsLCBcInZhbHVlXCIsIHZhbHVlKSk7XG4gICAgZGVsZXRlIHdpbmRvdy5mcm9tTW9kZWw7XG4gIH07XG4gIGVmZmVjdDMoKCkgPT4ge1xuICAgIGxldCB2YWx1ZSA9IGdldFZhbHVlKCk7XG4gICAgaWYgKG1vZGlmaWVycy5pbmNsdWRlcyhcInVuaW50cnVzaXZlXCIpICYmIGRvY3VtZW50LmFjdGl2ZUVsZW1lbnQuaXNTYW1lTm9kZShlbCkpXG4gICAgICByZXR1cm47XG4gICAgZWwuX3hfZm9yY2VNb2RlbFVwZGF0ZSh2YWx1ZSk7XG4gIH0pO1xufSk7XG5mdW5jdGlvbiBnZXRJbnB1dFZhbHVlKGVsLCBtb2RpZmllcnMsIGV2ZW50LCBjdXJyZW50VmFsdWUpIHtcbiAgcmV0dXJuIG11dGF0ZURvbSgoKSA9PiB7XG4gICAgaWYgKGV2ZW50IGluc3RhbmNlb2YgQ3VzdG9tRXZlbnQgJiYgZXZlbnQuZGV0YWlsICE9PSB2b2lkIDApXG4gICAgICByZXR1cm4gZXZlbnQuZGV0YWlsICE9PSBudWxsICYmIGV2ZW50LmRldGFpbCAhPT0gdm9pZCAwID8gZXZlbnQuZGV0YWlsIDogZXZlbnQudGFyZ2V0LnZhbHVlO1xuICAgIGVsc2UgaWYgKGVsLnR5cGUgPT09IFwiY2hlY2tib3hcIikge1xuICAgICAgaWYgKEFycmF5LmlzQXJyYXkoY3VycmVudFZhbHVlKSkge1xuICAgICAgICBsZXQgbmV3VmFsdWUgPSBudWxsO1xuICAgICAgICBpZiAobW9kaWZpZXJzLmluY2x1ZGVzKFwibnVtYmVyXCIpKSB7XG4gICAgICAgICAgbmV3VmFsdWUgPSBzYWZlUGFyc2VOdW1iZXIoZXZlbnQudGFyZ2V0LnZhbHVlKTtcbiAgICAgICAgfSBlbHNlIGlmIChtb2RpZmllcnMuaW5jbHVkZXMoXCJib29sZWFuXCIpKSB7XG4gICAgICAgICAgbmV3VmFsdWUgPSBzYWZlUGFyc2VCb29sZWFuKGV2ZW50LnRhcmdldC52YWx1ZSk7XG4gICAgICAgIH0gZWxzZSB7XG4gICAgICAgICAgbmV3VmFsdWUgPSBldmVudC50YXJnZXQudmFsdWU7XG4gICAgICAgIH1cbiAgICAgICAgcmV0dXJuIGV2ZW50LnRhcmdldC5jaGVja2VkID8gY3VycmVudFZhbHVlLmNvbmNhdChbbmV3VmFsdWVdKSA6IGN1cnJlbnRWYWx1ZS5maWx0ZXIoKGVsMikgPT4gIWNoZWNrZWRBdHRyTG9vc2VDb21wYXJlMihlbDIsIG5ld1ZhbHVlKSk7XG4gICAgICB9IGVsc2Uge1xuICAgICAgICByZXR1cm4gZXZlbnQudGFyZ2V0LmNoZWNrZWQ7XG4gICAgICB9XG4gICAgfSBlbHNlIGlmIChlbC50YWdOYW1lLnRvTG93ZXJDYXNlKCkgPT09IFwic2VsZWN0XCIgJiYgZWwubXVsdGlwbGUpIHtcbiAgICAgIGlmIChtb2RpZmllcnMuaW5jbHVkZXMoXCJudW1iZXJcIikpIHtcbiAgICAgICAgcmV0dXJuIEFycmF5LmZyb20oZXZlbnQudGFyZ2V0LnNlbGVjdGVkT3B0aW9ucykubWFwKChvcHRpb24pID0+IHtcbiAgICAgICAgICBsZXQgcmF3VmFsdWUgPSBvcHRpb24udmFsdWUgfHwgb3B0aW9uLnRleHQ7XG4gICAgICAgICAgcmV0dXJuIHNhZmVQYXJzZU51bWJlcihyYXdWYWx1ZSk7XG4gICAgICAgIH0pO1xuICAgICAgfSBlbHNlIGlmIChtb2RpZmllcnMuaW5jbHVkZXMoXCJib29sZWFuXCIpKSB7XG4gICAgICAgIHJldHVybiBBcnJheS5mcm9tKGV2ZW50LnRhcmdldC5zZWxlY3RlZE9wdGlvbnMpLm1hcCgob3B0aW9uKSA9PiB7XG4gICAgICAgICAgbGV0IHJhd1ZhbHVlID0gb3B0aW9uLnZhbHVlIHx8IG9wdGlvbi50ZXh0O1xuICAgICAgICAgIHJldHVybiBzYWZlUGFyc2VCb29sZWFuKHJhd1ZhbHVlKTtcbiAgICAgICAgfSk7XG4gICAgICB9XG4gICAgICByZXR1cm4gQXJyYXkuZnJvbShldmVudC50YXJnZXQuc2VsZWN0ZWRPcHRpb25zKS5tYXAoKG9wdGlvbikgPT4ge1xuICAgICAgICByZXR1cm4gb3B0aW9uLnZhbHVlIHx8IG9wdGlvbi50ZXh0O1xuICAgICAgfSk7XG4gICAgfSBlbHNlIHtcbiAgICAgIGlmIChtb2RpZmllcnMuaW5jbHVkZXMoXCJudW1iZXJcIikpIHtcbiAgICAgICAgcmV0dXJuIHNhZmVQYXJzZU51bWJlcihldmVudC50YXJnZXQudmFsdWUpO1xuICAgICAgfSBlbHNlIGlmIChtb2RpZmllcnMuaW5jbHVkZXMoXCJib29sZWFuXCIpKSB7XG4gICAgICAgIHJldHVybiBzYWZlUGFyc2VCb29sZWFuKGV2ZW50LnRhcmdldC52YWx1ZSk7XG4gICAgICB9XG4gICAgICByZXR1cm4gbW9kaWZpZXJzLmluY2x1ZGVzKFwidHJpbVwiKSA/IGV2ZW50LnRhcmdldC52YWx1ZS50cmltKCkgOiBldmVudC50YXJnZXQudmFsdWU7XG4gICAgfVxuICB9KTtcbn1cbmZ1bmN0aW9uIHNhZmVQYXJzZU51bWJlcihyYXdWYWx1ZSkge1xuICBsZXQgbnVtYmVyID0gcmF3VmFsdWUgPyBwYXJzZUZsb2F0KHJhd1ZhbHVlKSA6IG51bGw7XG4gIHJldHVybiBpc051bWVyaWMyKG51bWJlcikgPyBudW1iZXIgOiByYXdWYWx1ZTtcbn1cbmZ1bmN0aW9uIGNoZWNrZWRBdHRyTG9vc2VDb21wYXJlMih2YWx1ZUEsIHZhbHVlQikge1xuICByZXR1cm4gdmFsdWVBID09IHZhbHVlQjtcbn1cbmZ1bmN0aW9uIGlzTnVtZXJpYzIoc3ViamVjdCkge1xuICByZXR1cm4gIUFycmF5LmlzQXJyYXkoc3ViamVjdCkgJiYgIWlzTmFOKHN1YmplY3QpO1xufVxuZnVuY3Rpb24gaXNHZXR0ZXJTZXR0ZXIodmFsdWUpIHtcbiAgcmV0dXJuIHZhbHVlICE9PSBudWxsICYmIHR5cGVvZiB2YWx1ZSA9PT0gXCJvYmplY3RcIiAmJiB0eXBlb2YgdmFsdWUuZ2V0ID09PSBcImZ1bmN0aW9uXCIgJiYgdHlwZW9mIHZhbHVlLnNldCA9PT0gXCJmdW5jdGlvblwiO1xufVxuXG4vLyBwYWNrYWdlcy9hbHBpbmVqcy9zcmMvZGlyZWN0aXZlcy94LWNsb2FrLmpzXG5kaXJlY3RpdmUoXCJjbG9ha1wiLCAoZWwpID0+IHF1ZXVlTWljcm90YXNrKCgpID0+IG11dGF0ZURvbSgoKSA9PiBlbC5yZW1vdmVBdHRyaWJ1dGUocHJlZml4KFwiY2xvYWtcIikpKSkpO1xuXG4vLyBwYWNrYWdlcy9hbHBpbmVqcy9zcmMvZGlyZWN0aXZlcy94LWluaXQuanNcbmFkZEluaXRTZWxlY3RvcigoKSA9PiBgWyR7cHJlZml4KFwiaW5pdFwiKX1dYCk7XG5kaXJlY3RpdmUoXCJpbml0XCIsIHNraXBEdXJpbmdDbG9uZSgoZWwsIHsgZXhwcmVzc2lvbiB9LCB7IGV2YWx1YXRlOiBldmFsdWF0ZTIgfSkgPT4ge1xuICBpZiAodHlwZW9mIGV4cHJlc3Npb24gPT09IFwic3RyaW5nXCIpIHtcbiAgICByZXR1cm4gISFleHByZXNzaW9uLnRyaW0oKSAmJiBldmFsdWF0ZTIoZXhwcmVzc2lvbiwge30sIGZhbHNlKTtcbiAgfVxuICByZXR1cm4gZXZhbHVhdGUyKGV4cHJlc3Npb24sIHt9LCBmYWxzZSk7XG59KSk7XG5cbi8vIHBhY2thZ2VzL2FscGluZWpzL3NyYy9kaXJlY3RpdmVzL3gtdGV4dC5qc1xuZGlyZWN0aXZlKFwidGV4dFwiLCAoZWwsIHsgZXhwcmVzc2lvbiB9LCB7IGVmZmVjdDogZWZmZWN0MywgZXZhbHVhdGVMYXRlcjogZXZhbHVhdGVMYXRlcjIgfSkgPT4ge1xuICBsZXQgZXZhbHVhdGUyID0gZXZhbHVhdGVMYXRlcjIoZXhwcmVzc2lvbik7XG4gIGVmZmVjdDMoKCkgPT4ge1xuICAgIGV2YWx1YXRlMigodmFsdWUpID0+IHtcbiAgICAgIG11dGF0ZURvbSgoKSA9PiB7XG4gICAgICAgIGVsLnRleHRDb250ZW50ID0gdmFsdWU7XG4gICAgICB9KTtcbiAgICB9KTtcbiAgfSk7XG59KTtcblxuLy8gcGFja2FnZXMvYWxwaW5lanMvc3JjL2RpcmVjdGl2ZXMveC1odG1sLmpzXG5kaXJlY3RpdmUoXCJodG1sXCIsIChlbCwgeyBleHByZXNzaW9uIH0sIHsgZWZmZWN0OiBlZmZlY3QzLCBldmFsdWF0ZUxhdGVyOiBldmFsdWF0ZUxhdGVyMiB9KSA9PiB7XG4gIGxldCBldmFsdWF0ZTIgPSBldmFsdWF0ZUxhdGVyMihleHByZXNzaW9uKTtcbiAgZWZmZWN0MygoKSA9PiB7XG4gICAgZXZhbHVhdGUyKCh2YWx1ZSkgPT4ge1xuICAgICAgbXV0YXRlRG9tKCgpID0+IHtcbiAgICAgICAgZWwuaW5uZXJIVE1MID0gdmFsdWU7XG4gICAgICAgIGVsLl94X2lnbm9yZVNlbGYgPSB0cnVlO1xuICAgICAgICBpbml0VHJlZShlbCk7XG4gICAgICAgIGRlbGV0ZSBlbC5feF9pZ25vcmVTZWxmO1xuICAgICAgfSk7XG4gICAgfSk7XG4gIH0pO1xufSk7XG5cbi8vIHBhY2thZ2VzL2FscGluZWpzL3NyYy9kaXJlY3RpdmVzL3gtYmluZC5qc1xubWFwQXR0cmlidXRlcyhzdGFydGluZ1dpdGgoXCI6XCIsIGludG8ocHJlZml4KFwiYmluZDpcIikpKSk7XG52YXIgaGFuZGxlcjIgPSAoZWwsIHsgdmFsdWUsIG1vZGlmaWVycywgZXhwcmVzc2lvbiwgb3JpZ2luYWwgfSwgeyBlZmZlY3Q6IGVmZmVjdDMgfSkgPT4ge1xuICBpZiAoIXZhbHVlKSB7XG4gICAgbGV0IGJpbmRpbmdQcm92aWRlcnMgPSB7fTtcbiAgICBpbmplY3RCaW5kaW5nUHJvdmlkZXJzKGJpbmRpbmdQcm92aWRlcnMpO1xuICAgIGxldCBnZXRCaW5kaW5ncyA9IGV2YWx1YXRlTGF0ZXIoZWwsIGV4cHJlc3Npb24pO1xuICAgIGdldEJpbmRpbmdzKChiaW5kaW5ncykgPT4ge1xuICAgICAgYXBwbHlCaW5kaW5nc09iamVjdChlbCwgYmluZGluZ3MsIG9yaWdpbmFsKTtcbiAgICB9LCB7IHNjb3BlOiBiaW5kaW5nUHJvdmlkZXJzIH0pO1xuICAgIHJldHVybjtcbiAgfVxuICBpZiAodmFsdWUgPT09IFwia2V5XCIpXG4gICAgcmV0dXJuIHN0b3JlS2V5Rm9yWEZvcihlbCwgZXhwcmVzc2lvbik7XG4gIGlmIChlbC5feF9pbmxpbmVCaW5kaW5ncyAmJiBlbC5feF9pbmxpbmVCaW5kaW5nc1t2YWx1ZV0gJiYgZWwuX3hfaW5saW5lQmluZGluZ3NbdmFsdWVdLmV4dHJhY3QpIHtcbiAgICByZXR1cm47XG4gIH1cbiAgbGV0IGV2YWx1YXRlMiA9IGV2YWx1YXRlTGF0ZXIoZWwsIGV4cHJlc3Npb24pO1xuICBlZmZlY3QzKCgpID0+IGV2YWx1YXRlMigocmVzdWx0KSA9PiB7XG4gICAgaWYgKHJlc3VsdCA9PT0gdm9pZCAwICYmIHR5cGVvZiBleHByZXNzaW9uID09PSBcInN0cmluZ1wiICYmIGV4cHJlc3Npb24ubWF0Y2goL1xcLi8pKSB7XG4gICAgICByZXN1bHQgPSBcIlwiO1xuICAgIH1cbiAgICBtdXRhdGVEb20oKCkgPT4gYmluZChlbCwgdmFsdWUsIHJlc3VsdCwgbW9kaWZpZXJzKSk7XG4gIH0pKTtcbn07XG5oYW5kbGVyMi5pbmxpbmUgPSAoZWwsIHsgdmFsdWUsIG1vZGlmaWVycywgZXhwcmVzc2lvbiB9KSA9PiB7XG4gIGlmICghdmFsdWUpXG4gICAgcmV0dXJuO1xuICBpZiAoIWVsLl94X2lubGluZUJpbmRpbmdzKVxuICAgIGVsLl94X2lubGluZUJpbmRpbmdzID0ge307XG4gIGVsLl94X2lubGluZUJpbmRpbmdzW3ZhbHVlXSA9IHsgZXhwcmVzc2lvbiwgZXh0cmFjdDogZmFsc2UgfTtcbn07XG5kaXJlY3RpdmUoXCJiaW5kXCIsIGhhbmRsZXIyKTtcbmZ1bmN0aW9uIHN0b3JlS2V5Rm9yWEZvcihlbCwgZXhwcmVzc2lvbikge1xuICBlbC5feF9rZXlFeHByZXNzaW9uID0gZXhwcmVzc2lvbjtcbn1cblxuLy8gcGFja2FnZXMvYWxwaW5lanMvc3JjL2RpcmVjdGl2ZXMveC1kYXRhLmpzXG5hZGRSb290U2VsZWN0b3IoKCkgPT4gYFske3ByZWZpeChcImRhdGFcIil9XWApO1xuZGlyZWN0aXZlKFwiZGF0YVwiLCAoZWwsIHsgZXhwcmVzc2lvbiB9LCB7IGNsZWFudXA6IGNsZWFudXAyIH0pID0+IHtcbiAgaWYgKHNob3VsZFNraXBSZWdpc3RlcmluZ0RhdGFEdXJpbmdDbG9uZShlbCkpXG4gICAgcmV0dXJuO1xuICBleHByZXNzaW9uID0gZXhwcmVzc2lvbiA9PT0gXCJcIiA/IFwie31cIiA6IGV4cHJlc3Npb247XG4gIGxldCBtYWdpY0NvbnRleHQgPSB7fTtcbiAgaW5qZWN0TWFnaWNzKG1hZ2ljQ29udGV4dCwgZWwpO1xuICBsZXQgZGF0YVByb3ZpZGVyQ29udGV4dCA9IHt9O1xuICBpbmplY3REYXRhUHJvdmlkZXJzKGRhdGFQcm92aWRlckNvbnRleHQsIG1hZ2ljQ29udGV4dCk7XG4gIGxldCBkYXRhMiA9IGV2YWx1YXRlKGVsLCBleHByZXNzaW9uLCB7IHNjb3BlOiBkYXRhUHJvdmlkZXJDb250ZXh0IH0pO1xuICBpZiAoZGF0YTIgPT09IHZvaWQgMCB8fCBkYXRhMiA9PT0gdHJ1ZSlcbiAgICBkYXRhMiA9IHt9O1xuICBpbmplY3RNYWdpY3MoZGF0YTIsIGVsKTtcbiAgbGV0IHJlYWN0aXZlRGF0YSA9IHJlYWN0aXZlKGRhdGEyKTtcbiAgaW5pdEludGVyY2VwdG9yczIocmVhY3RpdmVEYXRhKTtcbiAgbGV0IHVuZG8gPSBhZGRTY29wZVRvTm9kZShlbCwgcmVhY3RpdmVEYXRhKTtcbiAgcmVhY3RpdmVEYXRhW1wiaW5pdFwiXSAmJiBldmFsdWF0ZShlbCwgcmVhY3RpdmVEYXRhW1wiaW5pdFwiXSk7XG4gIGNsZWFudXAyKCgpID0+IHtcbiAgICByZWFjdGl2ZURhdGFbXCJkZXN0cm95XCJdICYmIGV2YWx1YXRlKGVsLCByZWFjdGl2ZURhdGFbXCJkZXN0cm95XCJdKTtcbiAgICB1bmRvKCk7XG4gIH0pO1xufSk7XG5pbnRlcmNlcHRDbG9uZSgoZnJvbSwgdG8pID0+IHtcbiAgaWYgKGZyb20uX3hfZGF0YVN0YWNrKSB7XG4gICAgdG8uX3hfZGF0YVN0YWNrID0gZnJvbS5feF9kYXRhU3RhY2s7XG4gICAgdG8uc2V0QXR0cmlidXRlKFwiZGF0YS1oYXMtYWxwaW5lLXN0YXRlXCIsIHRydWUpO1xuICB9XG59KTtcbmZ1bmN0aW9uIHNob3VsZFNraXBSZWdpc3RlcmluZ0RhdGFEdXJpbmdDbG9uZShlbCkge1xuICBpZiAoIWlzQ2xvbmluZylcbiAgICByZXR1cm4gZmFsc2U7XG4gIGlmIChpc0Nsb25pbmdMZWdhY3kpXG4gICAgcmV0dXJuIHRydWU7XG4gIHJldHVybiBlbC5oYXNBdHRyaWJ1dGUoXCJkYXRhLWhhcy1hbHBpbmUtc3RhdGVcIik7XG59XG5cbi8vIHBhY2thZ2VzL2FscGluZWpzL3NyYy9kaXJlY3RpdmVzL3gtc2hvdy5qc1xuZGlyZWN0aXZlKFwic2hvd1wiLCAoZWwsIHsgbW9kaWZpZXJzLCBleHByZXNzaW9uIH0sIHsgZWZmZWN0OiBlZmZlY3QzIH0pID0+IHtcbiAgbGV0IGV2YWx1YXRlMiA9IGV2YWx1YXRlTGF0ZXIoZWwsIGV4cHJlc3Npb24pO1xuICBpZiAoIWVsLl94X2RvSGlkZSlcbiAgICBlbC5feF9kb0hpZGUgPSAoKSA9PiB7XG4gICAgICBtdXRhdGVEb20oKCkgPT4ge1xuICAgICAgICBlbC5zdHlsZS5zZXRQcm9wZXJ0eShcImRpc3BsYXlcIiwgXCJub25lXCIsIG1vZGlmaWVycy5pbmNsdWRlcyhcImltcG9ydGFudFwiKSA/IFwiaW1wb3J0YW50XCIgOiB2b2lkIDApO1xuICAgICAgfSk7XG4gICAgfTtcbiAgaWYgKCFlbC5feF9kb1Nob3cpXG4gICAgZWwuX3hfZG9TaG93ID0gKCkgPT4ge1xuICAgICAgbXV0YXRlRG9tKCgpID0+IHtcbiAgICAgICAgaWYgKGVsLnN0eWxlLmxlbmd0aCA9PT0gMSAmJiBlbC5zdHlsZS5kaXNwbGF5ID09PSBcIm5vbmVcIikge1xuICAgICAgICAgIGVsLnJlbW92ZUF0dHJpYnV0ZShcInN0eWxlXCIpO1xuICAgICAgICB9IGVsc2Uge1xuICAgICAgICAgIGVsLnN0eWxlLnJlbW92ZVByb3BlcnR5KFwiZGlzcGxheVwiKTtcbiAgICAgICAgfVxuICAgICAgfSk7XG4gICAgfTtcbiAgbGV0IGhpZGUgPSAoKSA9PiB7XG4gICAgZWwuX3hfZG9IaWRlKCk7XG4gICAgZWwuX3hfaXNTaG93biA9IGZhbHNlO1xuICB9O1xuICBsZXQgc2hvdyA9ICgpID0+IHtcbiAgICBlbC5feF9kb1Nob3coKTtcbiAgICBlbC5feF9pc1Nob3duID0gdHJ1ZTtcbiAgfTtcbiAgbGV0IGNsaWNrQXdheUNvbXBhdGlibGVTaG93ID0gKCkgPT4gc2V0VGltZW91dChzaG93KTtcbiAgbGV0IHRvZ2dsZSA9IG9uY2UoXG4gICAgKHZhbHVlKSA9PiB2YWx1ZSA/IHNob3coKSA6IGhpZGUoKSxcbiAgICAodmFsdWUpID0+IHtcbiAgICAgIGlmICh0eXBlb2YgZWwuX3hfdG9nZ2xlQW5kQ2FzY2FkZVdpdGhUcmFuc2l0aW9ucyA9PT0gXCJmdW5jdGlvblwiKSB7XG4gICAgICAgIGVsLl94X3RvZ2dsZUFuZENhc2NhZGVXaXRoVHJhbnNpdGlvbnMoZWwsIHZhbHVlLCBzaG93LCBoaWRlKTtcbiAgICAgIH0gZWxzZSB7XG4gICAgICAgIHZhbHVlID8gY2xpY2tBd2F5Q29tcGF0aWJsZVNob3coKSA6IGhpZGUoKTtcbiAgICAgIH1cbiAgICB9XG4gICk7XG4gIGxldCBvbGRWYWx1ZTtcbiAgbGV0IGZpcnN0VGltZSA9IHRydWU7XG4gIGVmZmVjdDMoKCkgPT4gZXZhbHVhdGUyKCh2YWx1ZSkgPT4ge1xuICAgIGlmICghZmlyc3RUaW1lICYmIHZhbHVlID09PSBvbGRWYWx1ZSlcbiAgICAgIHJldHVybjtcbiAgICBpZiAobW9kaWZpZXJzLmluY2x1ZGVzKFwiaW1tZWRpYXRlXCIpKVxuICAgICAgdmFsdWUgPyBjbGlja0F3YXlDb21wYXRpYmxlU2hvdygpIDogaGlkZSgpO1xuICAgIHRvZ2dsZSh2YWx1ZSk7XG4gICAgb2xkVmFsdWUgPSB2YWx1ZTtcbiAgICBmaXJzdFRpbWUgPSBmYWxzZTtcbiAgfSkpO1xufSk7XG5cbi8vIHBhY2thZ2VzL2FscGluZWpzL3NyYy9kaXJlY3RpdmVzL3gtZm9yLmpzXG5kaXJlY3RpdmUoXCJmb3JcIiwgKGVsLCB7IGV4cHJlc3Npb24gfSwgeyBlZmZlY3Q6IGVmZmVjdDMsIGNsZWFudXA6IGNsZWFudXAyIH0pID0+IHtcbiAgbGV0IGl0ZXJhdG9yTmFtZXMgPSBwYXJzZUZvckV4cHJlc3Npb24oZXhwcmVzc2lvbik7XG4gIGxldCBldmFsdWF0ZUl0ZW1zID0gZXZhbHVhdGVMYXRlcihlbCwgaXRlcmF0b3JOYW1lcy5pdGVtcyk7XG4gIGxldCBldmFsdWF0ZUtleSA9IGV2YWx1YXRlTGF0ZXIoXG4gICAgZWwsXG4gICAgLy8gdGhlIHgtYmluZDprZXkgZXhwcmVzc2lvbiBpcyBzdG9yZWQgZm9yIG91ciB1c2UgaW5zdGVhZCBvZiBldmFsdWF0ZWQuXG4gICAgZWwuX3hfa2V5RXhwcmVzc2lvbiB8fCBcImluZGV4XCJcbiAgKTtcbiAgZWwuX3hfcHJldktleXMgPSBbXTtcbiAgZWwuX3hfbG9va3VwID0ge307XG4gIGVmZmVjdDMoKCkgPT4gbG9vcChlbCwgaXRlcmF0b3JOYW1lcywgZXZhbHVhdGVJdGVtcywgZXZhbHVhdGVLZXkpKTtcbiAgY2xlYW51cDIoKCkgPT4ge1xuICAgIE9iamVjdC52YWx1ZXMoZWwuX3hfbG9va3VwKS5mb3JFYWNoKChlbDIpID0+IGVsMi5yZW1vdmUoKSk7XG4gICAgZGVsZXRlIGVsLl94X3ByZXZLZXlzO1xuICAgIGRlbGV0ZSBlbC5feF9sb29rdXA7XG4gIH0pO1xufSk7XG5mdW5jdGlvbiBsb29wKGVsLCBpdGVyYXRvck5hbWVzLCBldmFsdWF0ZUl0ZW1zLCBldmFsdWF0ZUtleSkge1xuICBsZXQgaXNPYmplY3QyID0gKGkpID0+IHR5cGVvZiBpID09PSBcIm9iamVjdFwiICYmICFBcnJheS5pc0FycmF5KGkpO1xuICBsZXQgdGVtcGxhdGVFbCA9IGVsO1xuICBldmFsdWF0ZUl0ZW1zKChpdGVtcykgPT4ge1xuICAgIGlmIChpc051bWVyaWMzKGl0ZW1zKSAmJiBpdGVtcyA+PSAwKSB7XG4gICAgICBpdGVtcyA9IEFycmF5LmZyb20oQXJyYXkoaXRlbXMpLmtleXMoKSwgKGkpID0+IGkgKyAxKTtcbiAgICB9XG4gICAgaWYgKGl0ZW1zID09PSB2b2lkIDApXG4gICAgICBpdGVtcyA9IFtdO1xuICAgIGxldCBsb29rdXAgPSBlbC5feF9sb29rdXA7XG4gICAgbGV0IHByZXZLZXlzID0gZWwuX3hfcHJldktleXM7XG4gICAgbGV0IHNjb3BlcyA9IFtdO1xuICAgIGxldCBrZXlzID0gW107XG4gICAgaWYgKGlzT2JqZWN0MihpdGVtcykpIHtcbiAgICAgIGl0ZW1zID0gT2JqZWN0LmVudHJpZXMoaXRlbXMpLm1hcCgoW2tleSwgdmFsdWVdKSA9PiB7XG4gICAgICAgIGxldCBzY29wZTIgPSBnZXRJdGVyYXRpb25TY29wZVZhcmlhYmxlcyhpdGVyYXRvck5hbWVzLCB2YWx1ZSwga2V5LCBpdGVtcyk7XG4gICAgICAgIGV2YWx1YXRlS2V5KCh2YWx1ZTIpID0+IHtcbiAgICAgICAgICBpZiAoa2V5cy5pbmNsdWRlcyh2YWx1ZTIpKVxuICAgICAgICAgICAgd2FybihcIkR1cGxpY2F0ZSBrZXkgb24geC1mb3JcIiwgZWwpO1xuICAgICAgICAgIGtleXMucHVzaCh2YWx1ZTIpO1xuICAgICAgICB9LCB7IHNjb3BlOiB7IGluZGV4OiBrZXksIC4uLnNjb3BlMiB9IH0pO1xuICAgICAgICBzY29wZXMucHVzaChzY29wZTIpO1xuICAgICAgfSk7XG4gICAgfSBlbHNlIHtcbiAgICAgIGZvciAobGV0IGkgPSAwOyBpIDwgaXRlbXMubGVuZ3RoOyBpKyspIHtcbiAgICAgICAgbGV0IHNjb3BlMiA9IGdldEl0ZXJhdGlvblNjb3BlVmFyaWFibGVzKGl0ZXJhdG9yTmFtZXMsIGl0ZW1zW2ldLCBpLCBpdGVtcyk7XG4gICAgICAgIGV2YWx1YXRlS2V5KCh2YWx1ZSkgPT4ge1xuICAgICAgICAgIGlmIChrZXlzLmluY2x1ZGVzKHZhbHVlKSlcbiAgICAgICAgICAgIHdhcm4oXCJEdXBsaWNhdGUga2V5IG9uIHgtZm9yXCIsIGVsKTtcbiAgICAgICAgICBrZXlzLnB1c2godmFsdWUpO1xuICAgICAgICB9LCB7IHNjb3BlOiB7IGluZGV4OiBpLCAuLi5zY29wZTIgfSB9KTtcbiAgICAgICAgc2NvcGVzLnB1c2goc2NvcGUyKTtcbiAgICAgIH1cbiAgICB9XG4gICAgbGV0IGFkZHMgPSBbXTtcbiAgICBsZXQgbW92ZXMgPSBbXTtcbiAgICBsZXQgcmVtb3ZlcyA9IFtdO1xuICAgIGxldCBzYW1lcyA9IFtdO1xuICAgIGZvciAobGV0IGkgPSAwOyBpIDwgcHJldktleXMubGVuZ3RoOyBpKyspIHtcbiAgICAgIGxldCBrZXkgPSBwcmV2S2V5c1tpXTtcbiAgICAgIGlmIChrZXlzLmluZGV4T2Yoa2V5KSA9PT0gLTEpXG4gICAgICAgIHJlbW92ZXMucHVzaChrZXkpO1xuICAgIH1cbiAgICBwcmV2S2V5cyA9IHByZXZLZXlzLmZpbHRlcigoa2V5KSA9PiAhcmVtb3Zlcy5pbmNsdWRlcyhrZXkpKTtcbiAgICBsZXQgbGFzdEtleSA9IFwidGVtcGxhdGVcIjtcbiAgICBmb3IgKGxldCBpID0gMDsgaSA8IGtleXMubGVuZ3RoOyBpKyspIHtcbiAgICAgIGxldCBrZXkgPSBrZXlzW2ldO1xuICAgICAgbGV0IHByZXZJbmRleCA9IHByZXZLZXlzLmluZGV4T2Yoa2V5KTtcbiAgICAgIGlmIChwcmV2SW5kZXggPT09IC0xKSB7XG4gICAgICAgIHByZXZLZXlzLnNwbGljZShpLCAwLCBrZXkpO1xuICAgICAgICBhZGRzLnB1c2goW2xhc3RLZXksIGldKTtcbiAgICAgIH0gZWxzZSBpZiAocHJldkluZGV4ICE9PSBpKSB7XG4gICAgICAgIGxldCBrZXlJblNwb3QgPSBwcmV2S2V5cy5zcGxpY2UoaSwgMSlbMF07XG4gICAgICAgIGxldCBrZXlGb3JTcG90ID0gcHJldktleXMuc3BsaWNlKHByZXZJbmRleCAtIDEsIDEpWzBdO1xuICAgICAgICBwcmV2S2V5cy5zcGxpY2UoaSwgMCwga2V5Rm9yU3BvdCk7XG4gICAgICAgIHByZXZLZXlzLnNwbGljZShwcmV2SW5kZXgsIDAsIGtleUluU3BvdCk7XG4gICAgICAgIG1vdmVzLnB1c2goW2tleUluU3BvdCwga2V5Rm9yU3BvdF0pO1xuICAgICAgfSBlbHNlIHtcbiAgICAgICAgc2FtZXMucHVzaChrZXkpO1xuICAgICAgfVxuICAgICAgbGFzdEtleSA9IGtleTtcbiAgICB9XG4gICAgZm9yIChsZXQgaSA9IDA7IGkgPCByZW1vdmVzLmxlbmd0aDsgaSsrKSB7XG4gICAgICBsZXQga2V5ID0gcmVtb3Zlc1tpXTtcbiAgICAgIGlmICghIWxvb2t1cFtrZXldLl94X2VmZmVjdHMpIHtcbiAgICAgICAgbG9va3VwW2tleV0uX3hfZWZmZWN0cy5mb3JFYWNoKGRlcXVldWVKb2IpO1xuICAgICAgfVxuICAgICAgbG9va3VwW2tleV0ucmVtb3ZlKCk7XG4gICAgICBsb29rdXBba2V5XSA9IG51bGw7XG4gICAgICBkZWxldGUgbG9va3VwW2tleV07XG4gICAgfVxuICAgIGZvciAobGV0IGkgPSAwOyBpIDwgbW92ZXMubGVuZ3RoOyBpKyspIHtcbiAgICAgIGxldCBba2V5SW5TcG90LCBrZXlGb3JTcG90XSA9IG1vdmVzW2ldO1xuICAgICAgbGV0IGVsSW5TcG90ID0gbG9va3VwW2tleUluU3BvdF07XG4gICAgICBsZXQgZWxGb3JTcG90ID0gbG9va3VwW2tleUZvclNwb3RdO1xuICAgICAgbGV0IG1hcmtlciA9IGRvY3VtZW50LmNyZWF0ZUVsZW1lbnQoXCJkaXZcIik7XG4gICAgICBtdXRhdGVEb20oKCkgPT4ge1xuICAgICAgICBpZiAoIWVsRm9yU3BvdClcbiAgICAgICAgICB3YXJuKGB4LWZvciBcIjprZXlcIiBpcyB1bmRlZmluZWQgb3IgaW52YWxpZGAsIHRlbXBsYXRlRWwsIGtleUZvclNwb3QsIGxvb2t1cCk7XG4gICAgICAgIGVsRm9yU3BvdC5hZnRlcihtYXJrZXIpO1xuICAgICAgICBlbEluU3BvdC5hZnRlcihlbEZvclNwb3QpO1xuICAgICAgICBlbEZvclNwb3QuX3hfY3VycmVudElmRWwgJiYgZWxGb3JTcG90LmFmdGVyKGVsRm9yU3BvdC5feF9jdXJyZW50SWZFbCk7XG4gICAgICAgIG1hcmtlci5iZWZvcmUoZWxJblNwb3QpO1xuICAgICAgICBlbEluU3BvdC5feF9jdXJyZW50SWZFbCAmJiBlbEluU3BvdC5hZnRlcihlbEluU3BvdC5feF9jdXJyZW50SWZFbCk7XG4gICAgICAgIG1hcmtlci5yZW1vdmUoKTtcbiAgICAgIH0pO1xuICAgICAgZWxGb3JTcG90Ll94X3JlZnJlc2hYRm9yU2NvcGUoc2NvcGVzW2tleXMuaW5kZXhPZihrZXlGb3JTcG90KV0pO1xuICAgIH1cbiAgICBmb3IgKGxldCBpID0gMDsgaSA8IGFkZHMubGVuZ3RoOyBpKyspIHtcbiAgICAgIGxldCBbbGFzdEtleTIsIGluZGV4XSA9IGFkZHNbaV07XG4gICAgICBsZXQgbGFzdEVsID0gbGFzdEtleTIgPT09IFwidGVtcGxhdGVcIiA/IHRlbXBsYXRlRWwgOiBsb29rdXBbbGFzdEtleTJdO1xuICAgICAgaWYgKGxhc3RFbC5feF9jdXJyZW50SWZFbClcbiAgICAgICAgbGFzdEVsID0gbGFzdEVsLl94X2N1cnJlbnRJZkVsO1xuICAgICAgbGV0IHNjb3BlMiA9IHNjb3Blc1tpbmRleF07XG4gICAgICBsZXQga2V5ID0ga2V5c1tpbmRleF07XG4gICAgICBsZXQgY2xvbmUyID0gZG9jdW1lbnQuaW1wb3J0Tm9kZSh0ZW1wbGF0ZUVsLmNvbnRlbnQsIHRydWUpLmZpcnN0RWxlbWVudENoaWxkO1xuICAgICAgbGV0IHJlYWN0aXZlU2NvcGUgPSByZWFjdGl2ZShzY29wZTIpO1xuICAgICAgYWRkU2NvcGVUb05vZGUoY2xvbmUyLCByZWFjdGl2ZVNjb3BlLCB0ZW1wbGF0ZUVsKTtcbiAgICAgIGNsb25lMi5feF9yZWZyZXNoWEZvclNjb3BlID0gKG5ld1Njb3BlKSA9PiB7XG4gICAgICAgIE9iamVjdC5lbnRyaWVzKG5ld1Njb3BlKS5mb3JFYWNoKChba2V5MiwgdmFsdWVdKSA9PiB7XG4gICAgICAgICAgcmVhY3RpdmVTY29wZVtrZXkyXSA9IHZhbHVlO1xuICAgICAgICB9KTtcbiAgICAgIH07XG4gICAgICBtdXRhdGVEb20oKCkgPT4ge1xuICAgICAgICBsYXN0RWwuYWZ0ZXIoY2xvbmUyKTtcbiAgICAgICAgc2tpcER1cmluZ0Nsb25lKCgpID0+IGluaXRUcmVlKGNsb25lMikpKCk7XG4gICAgICB9KTtcbiAgICAgIGlmICh0eXBlb2Yga2V5ID09PSBcIm9iamVjdFwiKSB7XG4gICAgICAgIHdhcm4oXCJ4LWZvciBrZXkgY2Fubm90IGJlIGFuIG9iamVjdCwgaXQgbXVzdCBiZSBhIHN0cmluZyBvciBhbiBpbnRlZ2VyXCIsIHRlbXBsYXRlRWwpO1xuICAgICAgfVxuICAgICAgbG9va3VwW2tleV0gPSBjbG9uZTI7XG4gICAgfVxuICAgIGZvciAobGV0IGkgPSAwOyBpIDwgc2FtZXMubGVuZ3RoOyBpKyspIHtcbiAgICAgIGxvb2t1cFtzYW1lc1tpXV0uX3hfcmVmcmVzaFhGb3JTY29wZShzY29wZXNba2V5cy5pbmRleE9mKHNhbWVzW2ldKV0pO1xuICAgIH1cbiAgICB0ZW1wbGF0ZUVsLl94X3ByZXZLZXlzID0ga2V5cztcbiAgfSk7XG59XG5mdW5jdGlvbiBwYXJzZUZvckV4cHJlc3Npb24oZXhwcmVzc2lvbikge1xuICBsZXQgZm9ySXRlcmF0b3JSRSA9IC8sKFteLFxcfVxcXV0qKSg/OiwoW14sXFx9XFxdXSopKT8kLztcbiAgbGV0IHN0cmlwUGFyZW5zUkUgPSAvXlxccypcXCh8XFwpXFxzKiQvZztcbiAgbGV0IGZvckFsaWFzUkUgPSAvKFtcXHNcXFNdKj8pXFxzKyg/OmlufG9mKVxccysoW1xcc1xcU10qKS87XG4gIGxldCBpbk1hdGNoID0gZXhwcmVzc2lvbi5tYXRjaChmb3JBbGlhc1JFKTtcbiAgaWYgKCFpbk1hdGNoKVxuICAgIHJldHVybjtcbiAgbGV0IHJlcyA9IHt9O1xuICByZXMuaXRlbXMgPSBpbk1hdGNoWzJdLnRyaW0oKTtcbiAgbGV0IGl0ZW0gPSBpbk1hdGNoWzFdLnJlcGxhY2Uoc3RyaXBQYXJlbnNSRSwgXCJcIikudHJpbSgpO1xuICBsZXQgaXRlcmF0b3JNYXRjaCA9IGl0ZW0ubWF0Y2goZm9ySXRlcmF0b3JSRSk7XG4gIGlmIChpdGVyYXRvck1hdGNoKSB7XG4gICAgcmVzLml0ZW0gPSBpdGVtLnJlcGxhY2UoZm9ySXRlcmF0b3JSRSwgXCJcIikudHJpbSgpO1xuICAgIHJlcy5pbmRleCA9IGl0ZXJhdG9yTWF0Y2hbMV0udHJpbSgpO1xuICAgIGlmIChpdGVyYXRvck1hdGNoWzJdKSB7XG4gICAgICByZXMuY29sbGVjdGlvbiA9IGl0ZXJhdG9yTWF0Y2hbMl0udHJpbSgpO1xuICAgIH1cbiAgfSBlbHNlIHtcbiAgICByZXMuaXRlbSA9IGl0ZW07XG4gIH1cbiAgcmV0dXJuIHJlcztcbn1cbmZ1bmN0aW9uIGdldEl0ZXJhdGlvblNjb3BlVmFyaWFibGVzKGl0ZXJhdG9yTmFtZXMsIGl0ZW0sIGluZGV4LCBpdGVtcykge1xuICBsZXQgc2NvcGVWYXJpYWJsZXMgPSB7fTtcbiAgaWYgKC9eXFxbLipcXF0kLy50ZXN0KGl0ZXJhdG9yTmFtZXMuaXRlbSkgJiYgQXJyYXkuaXNBcnJheShpdGVtKSkge1xuICAgIGxldCBuYW1lcyA9IGl0ZXJhdG9yTmFtZXMuaXRlbS5yZXBsYWNlKFwiW1wiLCBcIlwiKS5yZXBsYWNlKFwiXVwiLCBcIlwiKS5zcGxpdChcIixcIikubWFwKChpKSA9PiBpLnRyaW0oKSk7XG4gICAgbmFtZXMuZm9yRWFjaCgobmFtZSwgaSkgPT4ge1xuICAgICAgc2NvcGVWYXJpYWJsZXNbbmFtZV0gPSBpdGVtW2ldO1xuICAgIH0pO1xuICB9IGVsc2UgaWYgKC9eXFx7LipcXH0kLy50ZXN0KGl0ZXJhdG9yTmFtZXMuaXRlbSkgJiYgIUFycmF5LmlzQXJyYXkoaXRlbSkgJiYgdHlwZW9mIGl0ZW0gPT09IFwib2JqZWN0XCIpIHtcbiAgICBsZXQgbmFtZXMgPSBpdGVyYXRvck5hbWVzLml0ZW0ucmVwbGFjZShcIntcIiwgXCJcIikucmVwbGFjZShcIn1cIiwgXCJcIikuc3BsaXQoXCIsXCIpLm1hcCgoaSkgPT4gaS50cmltKCkpO1xuICAgIG5hbWVzLmZvckVhY2goKG5hbWUpID0+IHtcbiAgICAgIHNjb3BlVmFyaWFibGVzW25hbWVdID0gaXRlbVtuYW1lXTtcbiAgICB9KTtcbiAgfSBlbHNlIHtcbiAgICBzY29wZVZhcmlhYmxlc1tpdGVyYXRvck5hbWVzLml0ZW1dID0gaXRlbTtcbiAgfVxuICBpZiAoaXRlcmF0b3JOYW1lcy5pbmRleClcbiAgICBzY29wZVZhcmlhYmxlc1tpdGVyYXRvck5hbWVzLmluZGV4XSA9IGluZGV4O1xuICBpZiAoaXRlcmF0b3JOYW1lcy5jb2xsZWN0aW9uKVxuICAgIHNjb3BlVmFyaWFibGVzW2l0ZXJhdG9yTmFtZXMuY29sbGVjdGlvbl0gPSBpdGVtcztcbiAgcmV0dXJuIHNjb3BlVmFyaWFibGVzO1xufVxuZnVuY3Rpb24gaXNOdW1lcmljMyhzdWJqZWN0KSB7XG4gIHJldHVybiAhQXJyYXkuaXNBcnJheShzdWJqZWN0KSAmJiAhaXNOYU4oc3ViamVjdCk7XG59XG5cbi8vIHBhY2thZ2VzL2FscGluZWpzL3NyYy9kaXJlY3RpdmVzL3gtcmVmLmpzXG5mdW5jdGlvbiBoYW5kbGVyMygpIHtcbn1cbmhhbmRsZXIzLmlubGluZSA9IChlbCwgeyBleHByZXNzaW9uIH0sIHsgY2xlYW51cDogY2xlYW51cDIgfSkgPT4ge1xuICBsZXQgcm9vdCA9IGNsb3Nlc3RSb290KGVsKTtcbiAgaWYgKCFyb290Ll94X3JlZnMpXG4gICAgcm9vdC5feF9yZWZzID0ge307XG4gIHJvb3QuX3hfcmVmc1tleHByZXNzaW9uXSA9IGVsO1xuICBjbGVhbnVwMigoKSA9PiBkZWxldGUgcm9vdC5feF9yZWZzW2V4cHJlc3Npb25dKTtcbn07XG5kaXJlY3RpdmUoXCJyZWZcIiwgaGFuZGxlcjMpO1xuXG4vLyBwYWNrYWdlcy9hbHBpbmVqcy9zcmMvZGlyZWN0aXZlcy94LWlmLmpzXG5kaXJlY3RpdmUoXCJpZlwiLCAoZWwsIHsgZXhwcmVzc2lvbiB9LCB7IGVmZmVjdDogZWZmZWN0MywgY2xlYW51cDogY2xlYW51cDIgfSkgPT4ge1xuICBpZiAoZWwudGFnTmFtZS50b0xvd2VyQ2FzZSgpICE9PSBcInRlbXBsYXRlXCIpXG4gICAgd2FybihcIngtaWYgY2FuIG9ubHkgYmUgdXNlZCBvbiBhIDx0ZW1wbGF0ZT4gdGFnXCIsIGVsKTtcbiAgbGV0IGV2YWx1YXRlMiA9IGV2YWx1YXRlTGF0ZXIoZWwsIGV4cHJlc3Npb24pO1xuICBsZXQgc2hvdyA9ICgpID0+IHtcbiAgICBpZiAoZWwuX3hfY3VycmVudElmRWwpXG4gICAgICByZXR1cm4gZWwuX3hfY3VycmVudElmRWw7XG4gICAgbGV0IGNsb25lMiA9IGVsLmNvbnRlbnQuY2xvbmVOb2RlKHRydWUpLmZpcnN0RWxlbWVudENoaWxkO1xuICAgIGFkZFNjb3BlVG9Ob2RlKGNsb25lMiwge30sIGVsKTtcbiAgICBtdXRhdGVEb20oKCkgPT4ge1xuICAgICAgZWwuYWZ0ZXIoY2xvbmUyKTtcbiAgICAgIHNraXBEdXJpbmdDbG9uZSgoKSA9PiBpbml0VHJlZShjbG9uZTIpKSgpO1xuICAgIH0pO1xuICAgIGVsLl94X2N1cnJlbnRJZkVsID0gY2xvbmUyO1xuICAgIGVsLl94X3VuZG9JZiA9ICgpID0+IHtcbiAgICAgIHdhbGsoY2xvbmUyLCAobm9kZSkgPT4ge1xuICAgICAgICBpZiAoISFub2RlLl94X2VmZmVjdHMpIHtcbiAgICAgICAgICBub2RlLl94X2VmZmVjdHMuZm9yRWFjaChkZXF1ZXVlSm9iKTtcbiAgICAgICAgfVxuICAgICAgfSk7XG4gICAgICBjbG9uZTIucmVtb3ZlKCk7XG4gICAgICBkZWxldGUgZWwuX3hfY3VycmVudElmRWw7XG4gICAgfTtcbiAgICByZXR1cm4gY2xvbmUyO1xuICB9O1xuICBsZXQgaGlkZSA9ICgpID0+IHtcbiAgICBpZiAoIWVsLl94X3VuZG9JZilcbiAgICAgIHJldHVybjtcbiAgICBlbC5feF91bmRvSWYoKTtcbiAgICBkZWxldGUgZWwuX3hfdW5kb0lmO1xuICB9O1xuICBlZmZlY3QzKCgpID0+IGV2YWx1YXRlMigodmFsdWUpID0+IHtcbiAgICB2YWx1ZSA/IHNob3coKSA6IGhpZGUoKTtcbiAgfSkpO1xuICBjbGVhbnVwMigoKSA9PiBlbC5feF91bmRvSWYgJiYgZWwuX3hfdW5kb0lmKCkpO1xufSk7XG5cbi8vIHBhY2thZ2VzL2FscGluZWpzL3NyYy9kaXJlY3RpdmVzL3gtaWQuanNcbmRpcmVjdGl2ZShcImlkXCIsIChlbCwgeyBleHByZXNzaW9uIH0sIHsgZXZhbHVhdGU6IGV2YWx1YXRlMiB9KSA9PiB7XG4gIGxldCBuYW1lcyA9IGV2YWx1YXRlMihleHByZXNzaW9uKTtcbiAgbmFtZXMuZm9yRWFjaCgobmFtZSkgPT4gc2V0SWRSb290KGVsLCBuYW1lKSk7XG59KTtcbmludGVyY2VwdENsb25lKChmcm9tLCB0bykgPT4ge1xuICBpZiAoZnJvbS5feF9pZHMpIHtcbiAgICB0by5feF9pZHMgPSBmcm9tLl94X2lkcztcbiAgfVxufSk7XG5cbi8vIHBhY2thZ2VzL2FscGluZWpzL3NyYy9kaXJlY3RpdmVzL3gtb24uanNcbm1hcEF0dHJpYnV0ZXMoc3RhcnRpbmdXaXRoKFwiQFwiLCBpbnRvKHByZWZpeChcIm9uOlwiKSkpKTtcbmRpcmVjdGl2ZShcIm9uXCIsIHNraXBEdXJpbmdDbG9uZSgoZWwsIHsgdmFsdWUsIG1vZGlmaWVycywgZXhwcmVzc2lvbiB9LCB7IGNsZWFudXA6IGNsZWFudXAyIH0pID0+IHtcbiAgbGV0IGV2YWx1YXRlMiA9IGV4cHJlc3Npb24gPyBldmFsdWF0ZUxhdGVyKGVsLCBleHByZXNzaW9uKSA6ICgpID0+IHtcbiAgfTtcbiAgaWYgKGVsLnRhZ05hbWUudG9Mb3dlckNhc2UoKSA9PT0gXCJ0ZW1wbGF0ZVwiKSB7XG4gICAgaWYgKCFlbC5feF9mb3J3YXJkRXZlbnRzKVxuICAgICAgZWwuX3hfZm9yd2FyZEV2ZW50cyA9IFtdO1xuICAgIGlmICghZWwuX3hfZm9yd2FyZEV2ZW50cy5pbmNsdWRlcyh2YWx1ZSkpXG4gICAgICBlbC5feF9mb3J3YXJkRXZlbnRzLnB1c2godmFsdWUpO1xuICB9XG4gIGxldCByZW1vdmVMaXN0ZW5lciA9IG9uKGVsLCB2YWx1ZSwgbW9kaWZpZXJzLCAoZSkgPT4ge1xuICAgIGV2YWx1YXRlMigoKSA9PiB7XG4gICAgfSwgeyBzY29wZTogeyBcIiRldmVudFwiOiBlIH0sIHBhcmFtczogW2VdIH0pO1xuICB9KTtcbiAgY2xlYW51cDIoKCkgPT4gcmVtb3ZlTGlzdGVuZXIoKSk7XG59KSk7XG5cbi8vIHBhY2thZ2VzL2FscGluZWpzL3NyYy9kaXJlY3RpdmVzL2luZGV4LmpzXG53YXJuTWlzc2luZ1BsdWdpbkRpcmVjdGl2ZShcIkNvbGxhcHNlXCIsIFwiY29sbGFwc2VcIiwgXCJjb2xsYXBzZVwiKTtcbndhcm5NaXNzaW5nUGx1Z2luRGlyZWN0aXZlKFwiSW50ZXJzZWN0XCIsIFwiaW50ZXJzZWN0XCIsIFwiaW50ZXJzZWN0XCIpO1xud2Fybk1pc3NpbmdQbHVnaW5EaXJlY3RpdmUoXCJGb2N1c1wiLCBcInRyYXBcIiwgXCJmb2N1c1wiKTtcbndhcm5NaXNzaW5nUGx1Z2luRGlyZWN0aXZlKFwiTWFza1wiLCBcIm1hc2tcIiwgXCJtYXNrXCIpO1xuZnVuY3Rpb24gd2Fybk1pc3NpbmdQbHVnaW5EaXJlY3RpdmUobmFtZSwgZGlyZWN0aXZlTmFtZSwgc2x1Zykge1xuICBkaXJlY3RpdmUoZGlyZWN0aXZlTmFtZSwgKGVsKSA9PiB3YXJuKGBZb3UgY2FuJ3QgdXNlIFt4LSR7ZGlyZWN0aXZlTmFtZX1dIHdpdGhvdXQgZmlyc3QgaW5zdGFsbGluZyB0aGUgXCIke25hbWV9XCIgcGx1Z2luIGhlcmU6IGh0dHBzOi8vYWxwaW5lanMuZGV2L3BsdWdpbnMvJHtzbHVnfWAsIGVsKSk7XG59XG5cbi8vIHBhY2thZ2VzL2FscGluZWpzL3NyYy9pbmRleC5qc1xuYWxwaW5lX2RlZmF1bHQuc2V0RXZhbHVhdG9yKG5vcm1hbEV2YWx1YXRvcik7XG5hbHBpbmVfZGVmYXVsdC5zZXRSZWFjdGl2aXR5RW5naW5lKHsgcmVhY3RpdmU6IHJlYWN0aXZlMiwgZWZmZWN0OiBlZmZlY3QyLCByZWxlYXNlOiBzdG9wLCByYXc6IHRvUmF3IH0pO1xudmFyIHNyY19kZWZhdWx0ID0gYWxwaW5lX2RlZmF1bHQ7XG5cbi8vIHBhY2thZ2VzL2FscGluZWpzL2J1aWxkcy9tb2R1bGUuanNcbnZhciBtb2R1bGVfZGVmYXVsdCA9IHNyY19kZWZhdWx0O1xuZXhwb3J0IHtcbiAgc3JjX2RlZmF1bHQgYXMgQWxwaW5lLFxuICBtb2R1bGVfZGVmYXVsdCBhcyBkZWZhdWx0XG59O1xuIiwiKGZ1bmN0aW9uKGUsdCl7aWYodHlwZW9mIGRlZmluZT09PVwiZnVuY3Rpb25cIiYmZGVmaW5lLmFtZCl7ZGVmaW5lKFtdLHQpfWVsc2UgaWYodHlwZW9mIG1vZHVsZT09PVwib2JqZWN0XCImJm1vZHVsZS5leHBvcnRzKXttb2R1bGUuZXhwb3J0cz10KCl9ZWxzZXtlLmh0bXg9ZS5odG14fHx0KCl9fSkodHlwZW9mIHNlbGYhPT1cInVuZGVmaW5lZFwiP3NlbGY6dGhpcyxmdW5jdGlvbigpe3JldHVybiBmdW5jdGlvbigpe1widXNlIHN0cmljdFwiO3ZhciBRPXtvbkxvYWQ6Qixwcm9jZXNzOnp0LG9uOmRlLG9mZjpnZSx0cmlnZ2VyOmNlLGFqYXg6TnIsZmluZDpDLGZpbmRBbGw6ZixjbG9zZXN0OnYsdmFsdWVzOmZ1bmN0aW9uKGUsdCl7dmFyIHI9ZHIoZSx0fHxcInBvc3RcIik7cmV0dXJuIHIudmFsdWVzfSxyZW1vdmU6XyxhZGRDbGFzczp6LHJlbW92ZUNsYXNzOm4sdG9nZ2xlQ2xhc3M6JCx0YWtlQ2xhc3M6VyxkZWZpbmVFeHRlbnNpb246VXIscmVtb3ZlRXh0ZW5zaW9uOkZyLGxvZ0FsbDpWLGxvZ05vbmU6aixsb2dnZXI6bnVsbCxjb25maWc6e2hpc3RvcnlFbmFibGVkOnRydWUsaGlzdG9yeUNhY2hlU2l6ZToxMCxyZWZyZXNoT25IaXN0b3J5TWlzczpmYWxzZSxkZWZhdWx0U3dhcFN0eWxlOlwiaW5uZXJIVE1MXCIsZGVmYXVsdFN3YXBEZWxheTowLGRlZmF1bHRTZXR0bGVEZWxheToyMCxpbmNsdWRlSW5kaWNhdG9yU3R5bGVzOnRydWUsaW5kaWNhdG9yQ2xhc3M6XCJodG14LWluZGljYXRvclwiLHJlcXVlc3RDbGFzczpcImh0bXgtcmVxdWVzdFwiLGFkZGVkQ2xhc3M6XCJodG14LWFkZGVkXCIsc2V0dGxpbmdDbGFzczpcImh0bXgtc2V0dGxpbmdcIixzd2FwcGluZ0NsYXNzOlwiaHRteC1zd2FwcGluZ1wiLGFsbG93RXZhbDp0cnVlLGFsbG93U2NyaXB0VGFnczp0cnVlLGlubGluZVNjcmlwdE5vbmNlOlwiXCIsYXR0cmlidXRlc1RvU2V0dGxlOltcImNsYXNzXCIsXCJzdHlsZVwiLFwid2lkdGhcIixcImhlaWdodFwiXSx3aXRoQ3JlZGVudGlhbHM6ZmFsc2UsdGltZW91dDowLHdzUmVjb25uZWN0RGVsYXk6XCJmdWxsLWppdHRlclwiLHdzQmluYXJ5VHlwZTpcImJsb2JcIixkaXNhYmxlU2VsZWN0b3I6XCJbaHgtZGlzYWJsZV0sIFtkYXRhLWh4LWRpc2FibGVdXCIsdXNlVGVtcGxhdGVGcmFnbWVudHM6ZmFsc2Usc2Nyb2xsQmVoYXZpb3I6XCJzbW9vdGhcIixkZWZhdWx0Rm9jdXNTY3JvbGw6ZmFsc2UsZ2V0Q2FjaGVCdXN0ZXJQYXJhbTpmYWxzZSxnbG9iYWxWaWV3VHJhbnNpdGlvbnM6ZmFsc2UsbWV0aG9kc1RoYXRVc2VVcmxQYXJhbXM6W1wiZ2V0XCJdLHNlbGZSZXF1ZXN0c09ubHk6ZmFsc2UsaWdub3JlVGl0bGU6ZmFsc2Usc2Nyb2xsSW50b1ZpZXdPbkJvb3N0OnRydWUsdHJpZ2dlclNwZWNzQ2FjaGU6bnVsbH0scGFyc2VJbnRlcnZhbDpkLF86dCxjcmVhdGVFdmVudFNvdXJjZTpmdW5jdGlvbihlKXtyZXR1cm4gbmV3IEV2ZW50U291cmNlKGUse3dpdGhDcmVkZW50aWFsczp0cnVlfSl9LGNyZWF0ZVdlYlNvY2tldDpmdW5jdGlvbihlKXt2YXIgdD1uZXcgV2ViU29ja2V0KGUsW10pO3QuYmluYXJ5VHlwZT1RLmNvbmZpZy53c0JpbmFyeVR5cGU7cmV0dXJuIHR9LHZlcnNpb246XCIxLjkuMTFcIn07dmFyIHI9e2FkZFRyaWdnZXJIYW5kbGVyOkx0LGJvZHlDb250YWluczpzZSxjYW5BY2Nlc3NMb2NhbFN0b3JhZ2U6VSxmaW5kVGhpc0VsZW1lbnQ6eGUsZmlsdGVyVmFsdWVzOnlyLGhhc0F0dHJpYnV0ZTpvLGdldEF0dHJpYnV0ZVZhbHVlOnRlLGdldENsb3Nlc3RBdHRyaWJ1dGVWYWx1ZTpuZSxnZXRDbG9zZXN0TWF0Y2g6YyxnZXRFeHByZXNzaW9uVmFyczpIcixnZXRIZWFkZXJzOnhyLGdldElucHV0VmFsdWVzOmRyLGdldEludGVybmFsRGF0YTphZSxnZXRTd2FwU3BlY2lmaWNhdGlvbjp3cixnZXRUcmlnZ2VyU3BlY3M6aXQsZ2V0VGFyZ2V0OnllLG1ha2VGcmFnbWVudDpsLG1lcmdlT2JqZWN0czpsZSxtYWtlU2V0dGxlSW5mbzpULG9vYlN3YXA6RWUscXVlcnlTZWxlY3RvckV4dDp1ZSxzZWxlY3RBbmRTd2FwOmplLHNldHRsZUltbWVkaWF0ZWx5Om5yLHNob3VsZENhbmNlbDp1dCx0cmlnZ2VyRXZlbnQ6Y2UsdHJpZ2dlckVycm9yRXZlbnQ6ZmUsd2l0aEV4dGVuc2lvbnM6Un07dmFyIHc9W1wiZ2V0XCIsXCJwb3N0XCIsXCJwdXRcIixcImRlbGV0ZVwiLFwicGF0Y2hcIl07dmFyIGk9dy5tYXAoZnVuY3Rpb24oZSl7cmV0dXJuXCJbaHgtXCIrZStcIl0sIFtkYXRhLWh4LVwiK2UrXCJdXCJ9KS5qb2luKFwiLCBcIik7dmFyIFM9ZShcImhlYWRcIikscT1lKFwidGl0bGVcIiksSD1lKFwic3ZnXCIsdHJ1ZSk7ZnVuY3Rpb24gZShlLHQ9ZmFsc2Upe3JldHVybiBuZXcgUmVnRXhwKGA8JHtlfShcXFxcc1tePl0qPnw+KShbXFxcXHNcXFxcU10qPyk8XFxcXC8ke2V9PmAsdD9cImdpbVwiOlwiaW1cIil9ZnVuY3Rpb24gZChlKXtpZihlPT11bmRlZmluZWQpe3JldHVybiB1bmRlZmluZWR9bGV0IHQ9TmFOO2lmKGUuc2xpY2UoLTIpPT1cIm1zXCIpe3Q9cGFyc2VGbG9hdChlLnNsaWNlKDAsLTIpKX1lbHNlIGlmKGUuc2xpY2UoLTEpPT1cInNcIil7dD1wYXJzZUZsb2F0KGUuc2xpY2UoMCwtMSkpKjFlM31lbHNlIGlmKGUuc2xpY2UoLTEpPT1cIm1cIil7dD1wYXJzZUZsb2F0KGUuc2xpY2UoMCwtMSkpKjFlMyo2MH1lbHNle3Q9cGFyc2VGbG9hdChlKX1yZXR1cm4gaXNOYU4odCk/dW5kZWZpbmVkOnR9ZnVuY3Rpb24gZWUoZSx0KXtyZXR1cm4gZS5nZXRBdHRyaWJ1dGUmJmUuZ2V0QXR0cmlidXRlKHQpfWZ1bmN0aW9uIG8oZSx0KXtyZXR1cm4gZS5oYXNBdHRyaWJ1dGUmJihlLmhhc0F0dHJpYnV0ZSh0KXx8ZS5oYXNBdHRyaWJ1dGUoXCJkYXRhLVwiK3QpKX1mdW5jdGlvbiB0ZShlLHQpe3JldHVybiBlZShlLHQpfHxlZShlLFwiZGF0YS1cIit0KX1mdW5jdGlvbiB1KGUpe3JldHVybiBlLnBhcmVudEVsZW1lbnR9ZnVuY3Rpb24gcmUoKXtyZXR1cm4gZG9jdW1lbnR9ZnVuY3Rpb24gYyhlLHQpe3doaWxlKGUmJiF0KGUpKXtlPXUoZSl9cmV0dXJuIGU/ZTpudWxsfWZ1bmN0aW9uIEwoZSx0LHIpe3ZhciBuPXRlKHQscik7dmFyIGk9dGUodCxcImh4LWRpc2luaGVyaXRcIik7aWYoZSE9PXQmJmkmJihpPT09XCIqXCJ8fGkuc3BsaXQoXCIgXCIpLmluZGV4T2Yocik+PTApKXtyZXR1cm5cInVuc2V0XCJ9ZWxzZXtyZXR1cm4gbn19ZnVuY3Rpb24gbmUodCxyKXt2YXIgbj1udWxsO2ModCxmdW5jdGlvbihlKXtyZXR1cm4gbj1MKHQsZSxyKX0pO2lmKG4hPT1cInVuc2V0XCIpe3JldHVybiBufX1mdW5jdGlvbiBoKGUsdCl7dmFyIHI9ZS5tYXRjaGVzfHxlLm1hdGNoZXNTZWxlY3Rvcnx8ZS5tc01hdGNoZXNTZWxlY3Rvcnx8ZS5tb3pNYXRjaGVzU2VsZWN0b3J8fGUud2Via2l0TWF0Y2hlc1NlbGVjdG9yfHxlLm9NYXRjaGVzU2VsZWN0b3I7cmV0dXJuIHImJnIuY2FsbChlLHQpfWZ1bmN0aW9uIEEoZSl7dmFyIHQ9LzwoW2Etel1bXlxcL1xcMD5cXHgyMFxcdFxcclxcblxcZl0qKS9pO3ZhciByPXQuZXhlYyhlKTtpZihyKXtyZXR1cm4gclsxXS50b0xvd2VyQ2FzZSgpfWVsc2V7cmV0dXJuXCJcIn19ZnVuY3Rpb24gcyhlLHQpe3ZhciByPW5ldyBET01QYXJzZXI7dmFyIG49ci5wYXJzZUZyb21TdHJpbmcoZSxcInRleHQvaHRtbFwiKTt2YXIgaT1uLmJvZHk7d2hpbGUodD4wKXt0LS07aT1pLmZpcnN0Q2hpbGR9aWYoaT09bnVsbCl7aT1yZSgpLmNyZWF0ZURvY3VtZW50RnJhZ21lbnQoKX1yZXR1cm4gaX1mdW5jdGlvbiBOKGUpe3JldHVybi88Ym9keS8udGVzdChlKX1mdW5jdGlvbiBsKGUpe3ZhciB0PSFOKGUpO3ZhciByPUEoZSk7dmFyIG49ZTtpZihyPT09XCJoZWFkXCIpe249bi5yZXBsYWNlKFMsXCJcIil9aWYoUS5jb25maWcudXNlVGVtcGxhdGVGcmFnbWVudHMmJnQpe3ZhciBpPXMoXCI8Ym9keT48dGVtcGxhdGU+XCIrbitcIjwvdGVtcGxhdGU+PC9ib2R5PlwiLDApO3ZhciBhPWkucXVlcnlTZWxlY3RvcihcInRlbXBsYXRlXCIpLmNvbnRlbnQ7aWYoUS5jb25maWcuYWxsb3dTY3JpcHRUYWdzKXtvZShhLnF1ZXJ5U2VsZWN0b3JBbGwoXCJzY3JpcHRcIiksZnVuY3Rpb24oZSl7aWYoUS5jb25maWcuaW5saW5lU2NyaXB0Tm9uY2Upe2Uubm9uY2U9US5jb25maWcuaW5saW5lU2NyaXB0Tm9uY2V9ZS5odG14RXhlY3V0ZWQ9bmF2aWdhdG9yLnVzZXJBZ2VudC5pbmRleE9mKFwiRmlyZWZveFwiKT09PS0xfSl9ZWxzZXtvZShhLnF1ZXJ5U2VsZWN0b3JBbGwoXCJzY3JpcHRcIiksZnVuY3Rpb24oZSl7XyhlKX0pfXJldHVybiBhfXN3aXRjaChyKXtjYXNlXCJ0aGVhZFwiOmNhc2VcInRib2R5XCI6Y2FzZVwidGZvb3RcIjpjYXNlXCJjb2xncm91cFwiOmNhc2VcImNhcHRpb25cIjpyZXR1cm4gcyhcIjx0YWJsZT5cIituK1wiPC90YWJsZT5cIiwxKTtjYXNlXCJjb2xcIjpyZXR1cm4gcyhcIjx0YWJsZT48Y29sZ3JvdXA+XCIrbitcIjwvY29sZ3JvdXA+PC90YWJsZT5cIiwyKTtjYXNlXCJ0clwiOnJldHVybiBzKFwiPHRhYmxlPjx0Ym9keT5cIituK1wiPC90Ym9keT48L3RhYmxlPlwiLDIpO2Nhc2VcInRkXCI6Y2FzZVwidGhcIjpyZXR1cm4gcyhcIjx0YWJsZT48dGJvZHk+PHRyPlwiK24rXCI8L3RyPjwvdGJvZHk+PC90YWJsZT5cIiwzKTtjYXNlXCJzY3JpcHRcIjpjYXNlXCJzdHlsZVwiOnJldHVybiBzKFwiPGRpdj5cIituK1wiPC9kaXY+XCIsMSk7ZGVmYXVsdDpyZXR1cm4gcyhuLDApfX1mdW5jdGlvbiBpZShlKXtpZihlKXtlKCl9fWZ1bmN0aW9uIEkoZSx0KXtyZXR1cm4gT2JqZWN0LnByb3RvdHlwZS50b1N0cmluZy5jYWxsKGUpPT09XCJbb2JqZWN0IFwiK3QrXCJdXCJ9ZnVuY3Rpb24gayhlKXtyZXR1cm4gSShlLFwiRnVuY3Rpb25cIil9ZnVuY3Rpb24gUChlKXtyZXR1cm4gSShlLFwiT2JqZWN0XCIpfWZ1bmN0aW9uIGFlKGUpe3ZhciB0PVwiaHRteC1pbnRlcm5hbC1kYXRhXCI7dmFyIHI9ZVt0XTtpZighcil7cj1lW3RdPXt9fXJldHVybiByfWZ1bmN0aW9uIE0oZSl7dmFyIHQ9W107aWYoZSl7Zm9yKHZhciByPTA7cjxlLmxlbmd0aDtyKyspe3QucHVzaChlW3JdKX19cmV0dXJuIHR9ZnVuY3Rpb24gb2UoZSx0KXtpZihlKXtmb3IodmFyIHI9MDtyPGUubGVuZ3RoO3IrKyl7dChlW3JdKX19fWZ1bmN0aW9uIFgoZSl7dmFyIHQ9ZS5nZXRCb3VuZGluZ0NsaWVudFJlY3QoKTt2YXIgcj10LnRvcDt2YXIgbj10LmJvdHRvbTtyZXR1cm4gcjx3aW5kb3cuaW5uZXJIZWlnaHQmJm4+PTB9ZnVuY3Rpb24gc2UoZSl7aWYoZS5nZXRSb290Tm9kZSYmZS5nZXRSb290Tm9kZSgpaW5zdGFuY2VvZiB3aW5kb3cuU2hhZG93Um9vdCl7cmV0dXJuIHJlKCkuYm9keS5jb250YWlucyhlLmdldFJvb3ROb2RlKCkuaG9zdCl9ZWxzZXtyZXR1cm4gcmUoKS5ib2R5LmNvbnRhaW5zKGUpfX1mdW5jdGlvbiBEKGUpe3JldHVybiBlLnRyaW0oKS5zcGxpdCgvXFxzKy8pfWZ1bmN0aW9uIGxlKGUsdCl7Zm9yKHZhciByIGluIHQpe2lmKHQuaGFzT3duUHJvcGVydHkocikpe2Vbcl09dFtyXX19cmV0dXJuIGV9ZnVuY3Rpb24gRShlKXt0cnl7cmV0dXJuIEpTT04ucGFyc2UoZSl9Y2F0Y2goZSl7YihlKTtyZXR1cm4gbnVsbH19ZnVuY3Rpb24gVSgpe3ZhciBlPVwiaHRteDpsb2NhbFN0b3JhZ2VUZXN0XCI7dHJ5e2xvY2FsU3RvcmFnZS5zZXRJdGVtKGUsZSk7bG9jYWxTdG9yYWdlLnJlbW92ZUl0ZW0oZSk7cmV0dXJuIHRydWV9Y2F0Y2goZSl7cmV0dXJuIGZhbHNlfX1mdW5jdGlvbiBGKHQpe3RyeXt2YXIgZT1uZXcgVVJMKHQpO2lmKGUpe3Q9ZS5wYXRobmFtZStlLnNlYXJjaH1pZighL15cXC8kLy50ZXN0KHQpKXt0PXQucmVwbGFjZSgvXFwvKyQvLFwiXCIpfXJldHVybiB0fWNhdGNoKGUpe3JldHVybiB0fX1mdW5jdGlvbiB0KGUpe3JldHVybiBUcihyZSgpLmJvZHksZnVuY3Rpb24oKXtyZXR1cm4gZXZhbChlKX0pfWZ1bmN0aW9uIEIodCl7dmFyIGU9US5vbihcImh0bXg6bG9hZFwiLGZ1bmN0aW9uKGUpe3QoZS5kZXRhaWwuZWx0KX0pO3JldHVybiBlfWZ1bmN0aW9uIFYoKXtRLmxvZ2dlcj1mdW5jdGlvbihlLHQscil7aWYoY29uc29sZSl7Y29uc29sZS5sb2codCxlLHIpfX19ZnVuY3Rpb24gaigpe1EubG9nZ2VyPW51bGx9ZnVuY3Rpb24gQyhlLHQpe2lmKHQpe3JldHVybiBlLnF1ZXJ5U2VsZWN0b3IodCl9ZWxzZXtyZXR1cm4gQyhyZSgpLGUpfX1mdW5jdGlvbiBmKGUsdCl7aWYodCl7cmV0dXJuIGUucXVlcnlTZWxlY3RvckFsbCh0KX1lbHNle3JldHVybiBmKHJlKCksZSl9fWZ1bmN0aW9uIF8oZSx0KXtlPXAoZSk7aWYodCl7c2V0VGltZW91dChmdW5jdGlvbigpe18oZSk7ZT1udWxsfSx0KX1lbHNle2UucGFyZW50RWxlbWVudC5yZW1vdmVDaGlsZChlKX19ZnVuY3Rpb24geihlLHQscil7ZT1wKGUpO2lmKHIpe3NldFRpbWVvdXQoZnVuY3Rpb24oKXt6KGUsdCk7ZT1udWxsfSxyKX1lbHNle2UuY2xhc3NMaXN0JiZlLmNsYXNzTGlzdC5hZGQodCl9fWZ1bmN0aW9uIG4oZSx0LHIpe2U9cChlKTtpZihyKXtzZXRUaW1lb3V0KGZ1bmN0aW9uKCl7bihlLHQpO2U9bnVsbH0scil9ZWxzZXtpZihlLmNsYXNzTGlzdCl7ZS5jbGFzc0xpc3QucmVtb3ZlKHQpO2lmKGUuY2xhc3NMaXN0Lmxlbmd0aD09PTApe2UucmVtb3ZlQXR0cmlidXRlKFwiY2xhc3NcIil9fX19ZnVuY3Rpb24gJChlLHQpe2U9cChlKTtlLmNsYXNzTGlzdC50b2dnbGUodCl9ZnVuY3Rpb24gVyhlLHQpe2U9cChlKTtvZShlLnBhcmVudEVsZW1lbnQuY2hpbGRyZW4sZnVuY3Rpb24oZSl7bihlLHQpfSk7eihlLHQpfWZ1bmN0aW9uIHYoZSx0KXtlPXAoZSk7aWYoZS5jbG9zZXN0KXtyZXR1cm4gZS5jbG9zZXN0KHQpfWVsc2V7ZG97aWYoZT09bnVsbHx8aChlLHQpKXtyZXR1cm4gZX19d2hpbGUoZT1lJiZ1KGUpKTtyZXR1cm4gbnVsbH19ZnVuY3Rpb24gZyhlLHQpe3JldHVybiBlLnN1YnN0cmluZygwLHQubGVuZ3RoKT09PXR9ZnVuY3Rpb24gRyhlLHQpe3JldHVybiBlLnN1YnN0cmluZyhlLmxlbmd0aC10Lmxlbmd0aCk9PT10fWZ1bmN0aW9uIEooZSl7dmFyIHQ9ZS50cmltKCk7aWYoZyh0LFwiPFwiKSYmRyh0LFwiLz5cIikpe3JldHVybiB0LnN1YnN0cmluZygxLHQubGVuZ3RoLTIpfWVsc2V7cmV0dXJuIHR9fWZ1bmN0aW9uIFooZSx0KXtpZih0LmluZGV4T2YoXCJjbG9zZXN0IFwiKT09PTApe3JldHVyblt2KGUsSih0LnN1YnN0cig4KSkpXX1lbHNlIGlmKHQuaW5kZXhPZihcImZpbmQgXCIpPT09MCl7cmV0dXJuW0MoZSxKKHQuc3Vic3RyKDUpKSldfWVsc2UgaWYodD09PVwibmV4dFwiKXtyZXR1cm5bZS5uZXh0RWxlbWVudFNpYmxpbmddfWVsc2UgaWYodC5pbmRleE9mKFwibmV4dCBcIik9PT0wKXtyZXR1cm5bSyhlLEoodC5zdWJzdHIoNSkpKV19ZWxzZSBpZih0PT09XCJwcmV2aW91c1wiKXtyZXR1cm5bZS5wcmV2aW91c0VsZW1lbnRTaWJsaW5nXX1lbHNlIGlmKHQuaW5kZXhPZihcInByZXZpb3VzIFwiKT09PTApe3JldHVybltZKGUsSih0LnN1YnN0cig5KSkpXX1lbHNlIGlmKHQ9PT1cImRvY3VtZW50XCIpe3JldHVybltkb2N1bWVudF19ZWxzZSBpZih0PT09XCJ3aW5kb3dcIil7cmV0dXJuW3dpbmRvd119ZWxzZSBpZih0PT09XCJib2R5XCIpe3JldHVybltkb2N1bWVudC5ib2R5XX1lbHNle3JldHVybiByZSgpLnF1ZXJ5U2VsZWN0b3JBbGwoSih0KSl9fXZhciBLPWZ1bmN0aW9uKGUsdCl7dmFyIHI9cmUoKS5xdWVyeVNlbGVjdG9yQWxsKHQpO2Zvcih2YXIgbj0wO248ci5sZW5ndGg7bisrKXt2YXIgaT1yW25dO2lmKGkuY29tcGFyZURvY3VtZW50UG9zaXRpb24oZSk9PT1Ob2RlLkRPQ1VNRU5UX1BPU0lUSU9OX1BSRUNFRElORyl7cmV0dXJuIGl9fX07dmFyIFk9ZnVuY3Rpb24oZSx0KXt2YXIgcj1yZSgpLnF1ZXJ5U2VsZWN0b3JBbGwodCk7Zm9yKHZhciBuPXIubGVuZ3RoLTE7bj49MDtuLS0pe3ZhciBpPXJbbl07aWYoaS5jb21wYXJlRG9jdW1lbnRQb3NpdGlvbihlKT09PU5vZGUuRE9DVU1FTlRfUE9TSVRJT05fRk9MTE9XSU5HKXtyZXR1cm4gaX19fTtmdW5jdGlvbiB1ZShlLHQpe2lmKHQpe3JldHVybiBaKGUsdClbMF19ZWxzZXtyZXR1cm4gWihyZSgpLmJvZHksZSlbMF19fWZ1bmN0aW9uIHAoZSl7aWYoSShlLFwiU3RyaW5nXCIpKXtyZXR1cm4gQyhlKX1lbHNle3JldHVybiBlfX1mdW5jdGlvbiB2ZShlLHQscil7aWYoayh0KSl7cmV0dXJue3RhcmdldDpyZSgpLmJvZHksZXZlbnQ6ZSxsaXN0ZW5lcjp0fX1lbHNle3JldHVybnt0YXJnZXQ6cChlKSxldmVudDp0LGxpc3RlbmVyOnJ9fX1mdW5jdGlvbiBkZSh0LHIsbil7anIoZnVuY3Rpb24oKXt2YXIgZT12ZSh0LHIsbik7ZS50YXJnZXQuYWRkRXZlbnRMaXN0ZW5lcihlLmV2ZW50LGUubGlzdGVuZXIpfSk7dmFyIGU9ayhyKTtyZXR1cm4gZT9yOm59ZnVuY3Rpb24gZ2UodCxyLG4pe2pyKGZ1bmN0aW9uKCl7dmFyIGU9dmUodCxyLG4pO2UudGFyZ2V0LnJlbW92ZUV2ZW50TGlzdGVuZXIoZS5ldmVudCxlLmxpc3RlbmVyKX0pO3JldHVybiBrKHIpP3I6bn12YXIgcGU9cmUoKS5jcmVhdGVFbGVtZW50KFwib3V0cHV0XCIpO2Z1bmN0aW9uIG1lKGUsdCl7dmFyIHI9bmUoZSx0KTtpZihyKXtpZihyPT09XCJ0aGlzXCIpe3JldHVyblt4ZShlLHQpXX1lbHNle3ZhciBuPVooZSxyKTtpZihuLmxlbmd0aD09PTApe2IoJ1RoZSBzZWxlY3RvciBcIicrcisnXCIgb24gJyt0K1wiIHJldHVybmVkIG5vIG1hdGNoZXMhXCIpO3JldHVybltwZV19ZWxzZXtyZXR1cm4gbn19fX1mdW5jdGlvbiB4ZShlLHQpe3JldHVybiBjKGUsZnVuY3Rpb24oZSl7cmV0dXJuIHRlKGUsdCkhPW51bGx9KX1mdW5jdGlvbiB5ZShlKXt2YXIgdD1uZShlLFwiaHgtdGFyZ2V0XCIpO2lmKHQpe2lmKHQ9PT1cInRoaXNcIil7cmV0dXJuIHhlKGUsXCJoeC10YXJnZXRcIil9ZWxzZXtyZXR1cm4gdWUoZSx0KX19ZWxzZXt2YXIgcj1hZShlKTtpZihyLmJvb3N0ZWQpe3JldHVybiByZSgpLmJvZHl9ZWxzZXtyZXR1cm4gZX19fWZ1bmN0aW9uIGJlKGUpe3ZhciB0PVEuY29uZmlnLmF0dHJpYnV0ZXNUb1NldHRsZTtmb3IodmFyIHI9MDtyPHQubGVuZ3RoO3IrKyl7aWYoZT09PXRbcl0pe3JldHVybiB0cnVlfX1yZXR1cm4gZmFsc2V9ZnVuY3Rpb24gd2UodCxyKXtvZSh0LmF0dHJpYnV0ZXMsZnVuY3Rpb24oZSl7aWYoIXIuaGFzQXR0cmlidXRlKGUubmFtZSkmJmJlKGUubmFtZSkpe3QucmVtb3ZlQXR0cmlidXRlKGUubmFtZSl9fSk7b2Uoci5hdHRyaWJ1dGVzLGZ1bmN0aW9uKGUpe2lmKGJlKGUubmFtZSkpe3Quc2V0QXR0cmlidXRlKGUubmFtZSxlLnZhbHVlKX19KX1mdW5jdGlvbiBTZShlLHQpe3ZhciByPUJyKHQpO2Zvcih2YXIgbj0wO248ci5sZW5ndGg7bisrKXt2YXIgaT1yW25dO3RyeXtpZihpLmlzSW5saW5lU3dhcChlKSl7cmV0dXJuIHRydWV9fWNhdGNoKGUpe2IoZSl9fXJldHVybiBlPT09XCJvdXRlckhUTUxcIn1mdW5jdGlvbiBFZShlLGksYSl7dmFyIHQ9XCIjXCIrZWUoaSxcImlkXCIpO3ZhciBvPVwib3V0ZXJIVE1MXCI7aWYoZT09PVwidHJ1ZVwiKXt9ZWxzZSBpZihlLmluZGV4T2YoXCI6XCIpPjApe289ZS5zdWJzdHIoMCxlLmluZGV4T2YoXCI6XCIpKTt0PWUuc3Vic3RyKGUuaW5kZXhPZihcIjpcIikrMSxlLmxlbmd0aCl9ZWxzZXtvPWV9dmFyIHI9cmUoKS5xdWVyeVNlbGVjdG9yQWxsKHQpO2lmKHIpe29lKHIsZnVuY3Rpb24oZSl7dmFyIHQ7dmFyIHI9aS5jbG9uZU5vZGUodHJ1ZSk7dD1yZSgpLmNyZWF0ZURvY3VtZW50RnJhZ21lbnQoKTt0LmFwcGVuZENoaWxkKHIpO2lmKCFTZShvLGUpKXt0PXJ9dmFyIG49e3Nob3VsZFN3YXA6dHJ1ZSx0YXJnZXQ6ZSxmcmFnbWVudDp0fTtpZighY2UoZSxcImh0bXg6b29iQmVmb3JlU3dhcFwiLG4pKXJldHVybjtlPW4udGFyZ2V0O2lmKG5bXCJzaG91bGRTd2FwXCJdKXtCZShvLGUsZSx0LGEpfW9lKGEuZWx0cyxmdW5jdGlvbihlKXtjZShlLFwiaHRteDpvb2JBZnRlclN3YXBcIixuKX0pfSk7aS5wYXJlbnROb2RlLnJlbW92ZUNoaWxkKGkpfWVsc2V7aS5wYXJlbnROb2RlLnJlbW92ZUNoaWxkKGkpO2ZlKHJlKCkuYm9keSxcImh0bXg6b29iRXJyb3JOb1RhcmdldFwiLHtjb250ZW50Oml9KX1yZXR1cm4gZX1mdW5jdGlvbiBDZShlLHQscil7dmFyIG49bmUoZSxcImh4LXNlbGVjdC1vb2JcIik7aWYobil7dmFyIGk9bi5zcGxpdChcIixcIik7Zm9yKHZhciBhPTA7YTxpLmxlbmd0aDthKyspe3ZhciBvPWlbYV0uc3BsaXQoXCI6XCIsMik7dmFyIHM9b1swXS50cmltKCk7aWYocy5pbmRleE9mKFwiI1wiKT09PTApe3M9cy5zdWJzdHJpbmcoMSl9dmFyIGw9b1sxXXx8XCJ0cnVlXCI7dmFyIHU9dC5xdWVyeVNlbGVjdG9yKFwiI1wiK3MpO2lmKHUpe0VlKGwsdSxyKX19fW9lKGYodCxcIltoeC1zd2FwLW9vYl0sIFtkYXRhLWh4LXN3YXAtb29iXVwiKSxmdW5jdGlvbihlKXt2YXIgdD10ZShlLFwiaHgtc3dhcC1vb2JcIik7aWYodCE9bnVsbCl7RWUodCxlLHIpfX0pfWZ1bmN0aW9uIFJlKGUpe29lKGYoZSxcIltoeC1wcmVzZXJ2ZV0sIFtkYXRhLWh4LXByZXNlcnZlXVwiKSxmdW5jdGlvbihlKXt2YXIgdD10ZShlLFwiaWRcIik7dmFyIHI9cmUoKS5nZXRFbGVtZW50QnlJZCh0KTtpZihyIT1udWxsKXtlLnBhcmVudE5vZGUucmVwbGFjZUNoaWxkKHIsZSl9fSl9ZnVuY3Rpb24gVGUobyxlLHMpe29lKGUucXVlcnlTZWxlY3RvckFsbChcIltpZF1cIiksZnVuY3Rpb24oZSl7dmFyIHQ9ZWUoZSxcImlkXCIpO2lmKHQmJnQubGVuZ3RoPjApe3ZhciByPXQucmVwbGFjZShcIidcIixcIlxcXFwnXCIpO3ZhciBuPWUudGFnTmFtZS5yZXBsYWNlKFwiOlwiLFwiXFxcXDpcIik7dmFyIGk9by5xdWVyeVNlbGVjdG9yKG4rXCJbaWQ9J1wiK3IrXCInXVwiKTtpZihpJiZpIT09byl7dmFyIGE9ZS5jbG9uZU5vZGUoKTt3ZShlLGkpO3MudGFza3MucHVzaChmdW5jdGlvbigpe3dlKGUsYSl9KX19fSl9ZnVuY3Rpb24gT2UoZSl7cmV0dXJuIGZ1bmN0aW9uKCl7bihlLFEuY29uZmlnLmFkZGVkQ2xhc3MpO3p0KGUpO050KGUpO3FlKGUpO2NlKGUsXCJodG14OmxvYWRcIil9fWZ1bmN0aW9uIHFlKGUpe3ZhciB0PVwiW2F1dG9mb2N1c11cIjt2YXIgcj1oKGUsdCk/ZTplLnF1ZXJ5U2VsZWN0b3IodCk7aWYociE9bnVsbCl7ci5mb2N1cygpfX1mdW5jdGlvbiBhKGUsdCxyLG4pe1RlKGUscixuKTt3aGlsZShyLmNoaWxkTm9kZXMubGVuZ3RoPjApe3ZhciBpPXIuZmlyc3RDaGlsZDt6KGksUS5jb25maWcuYWRkZWRDbGFzcyk7ZS5pbnNlcnRCZWZvcmUoaSx0KTtpZihpLm5vZGVUeXBlIT09Tm9kZS5URVhUX05PREUmJmkubm9kZVR5cGUhPT1Ob2RlLkNPTU1FTlRfTk9ERSl7bi50YXNrcy5wdXNoKE9lKGkpKX19fWZ1bmN0aW9uIEhlKGUsdCl7dmFyIHI9MDt3aGlsZShyPGUubGVuZ3RoKXt0PSh0PDw1KS10K2UuY2hhckNvZGVBdChyKyspfDB9cmV0dXJuIHR9ZnVuY3Rpb24gTGUoZSl7dmFyIHQ9MDtpZihlLmF0dHJpYnV0ZXMpe2Zvcih2YXIgcj0wO3I8ZS5hdHRyaWJ1dGVzLmxlbmd0aDtyKyspe3ZhciBuPWUuYXR0cmlidXRlc1tyXTtpZihuLnZhbHVlKXt0PUhlKG4ubmFtZSx0KTt0PUhlKG4udmFsdWUsdCl9fX1yZXR1cm4gdH1mdW5jdGlvbiBBZShlKXt2YXIgdD1hZShlKTtpZih0Lm9uSGFuZGxlcnMpe2Zvcih2YXIgcj0wO3I8dC5vbkhhbmRsZXJzLmxlbmd0aDtyKyspe2NvbnN0IG49dC5vbkhhbmRsZXJzW3JdO2UucmVtb3ZlRXZlbnRMaXN0ZW5lcihuLmV2ZW50LG4ubGlzdGVuZXIpfWRlbGV0ZSB0Lm9uSGFuZGxlcnN9fWZ1bmN0aW9uIE5lKGUpe3ZhciB0PWFlKGUpO2lmKHQudGltZW91dCl7Y2xlYXJUaW1lb3V0KHQudGltZW91dCl9aWYodC53ZWJTb2NrZXQpe3Qud2ViU29ja2V0LmNsb3NlKCl9aWYodC5zc2VFdmVudFNvdXJjZSl7dC5zc2VFdmVudFNvdXJjZS5jbG9zZSgpfWlmKHQubGlzdGVuZXJJbmZvcyl7b2UodC5saXN0ZW5lckluZm9zLGZ1bmN0aW9uKGUpe2lmKGUub24pe2Uub24ucmVtb3ZlRXZlbnRMaXN0ZW5lcihlLnRyaWdnZXIsZS5saXN0ZW5lcil9fSl9QWUoZSk7b2UoT2JqZWN0LmtleXModCksZnVuY3Rpb24oZSl7ZGVsZXRlIHRbZV19KX1mdW5jdGlvbiBtKGUpe2NlKGUsXCJodG14OmJlZm9yZUNsZWFudXBFbGVtZW50XCIpO05lKGUpO2lmKGUuY2hpbGRyZW4pe29lKGUuY2hpbGRyZW4sZnVuY3Rpb24oZSl7bShlKX0pfX1mdW5jdGlvbiBJZSh0LGUscil7aWYodC50YWdOYW1lPT09XCJCT0RZXCIpe3JldHVybiBVZSh0LGUscil9ZWxzZXt2YXIgbjt2YXIgaT10LnByZXZpb3VzU2libGluZzthKHUodCksdCxlLHIpO2lmKGk9PW51bGwpe249dSh0KS5maXJzdENoaWxkfWVsc2V7bj1pLm5leHRTaWJsaW5nfXIuZWx0cz1yLmVsdHMuZmlsdGVyKGZ1bmN0aW9uKGUpe3JldHVybiBlIT10fSk7d2hpbGUobiYmbiE9PXQpe2lmKG4ubm9kZVR5cGU9PT1Ob2RlLkVMRU1FTlRfTk9ERSl7ci5lbHRzLnB1c2gobil9bj1uLm5leHRFbGVtZW50U2libGluZ31tKHQpO3UodCkucmVtb3ZlQ2hpbGQodCl9fWZ1bmN0aW9uIGtlKGUsdCxyKXtyZXR1cm4gYShlLGUuZmlyc3RDaGlsZCx0LHIpfWZ1bmN0aW9uIFBlKGUsdCxyKXtyZXR1cm4gYSh1KGUpLGUsdCxyKX1mdW5jdGlvbiBNZShlLHQscil7cmV0dXJuIGEoZSxudWxsLHQscil9ZnVuY3Rpb24gWGUoZSx0LHIpe3JldHVybiBhKHUoZSksZS5uZXh0U2libGluZyx0LHIpfWZ1bmN0aW9uIERlKGUsdCxyKXttKGUpO3JldHVybiB1KGUpLnJlbW92ZUNoaWxkKGUpfWZ1bmN0aW9uIFVlKGUsdCxyKXt2YXIgbj1lLmZpcnN0Q2hpbGQ7YShlLG4sdCxyKTtpZihuKXt3aGlsZShuLm5leHRTaWJsaW5nKXttKG4ubmV4dFNpYmxpbmcpO2UucmVtb3ZlQ2hpbGQobi5uZXh0U2libGluZyl9bShuKTtlLnJlbW92ZUNoaWxkKG4pfX1mdW5jdGlvbiBGZShlLHQscil7dmFyIG49cnx8bmUoZSxcImh4LXNlbGVjdFwiKTtpZihuKXt2YXIgaT1yZSgpLmNyZWF0ZURvY3VtZW50RnJhZ21lbnQoKTtvZSh0LnF1ZXJ5U2VsZWN0b3JBbGwobiksZnVuY3Rpb24oZSl7aS5hcHBlbmRDaGlsZChlKX0pO3Q9aX1yZXR1cm4gdH1mdW5jdGlvbiBCZShlLHQscixuLGkpe3N3aXRjaChlKXtjYXNlXCJub25lXCI6cmV0dXJuO2Nhc2VcIm91dGVySFRNTFwiOkllKHIsbixpKTtyZXR1cm47Y2FzZVwiYWZ0ZXJiZWdpblwiOmtlKHIsbixpKTtyZXR1cm47Y2FzZVwiYmVmb3JlYmVnaW5cIjpQZShyLG4saSk7cmV0dXJuO2Nhc2VcImJlZm9yZWVuZFwiOk1lKHIsbixpKTtyZXR1cm47Y2FzZVwiYWZ0ZXJlbmRcIjpYZShyLG4saSk7cmV0dXJuO2Nhc2VcImRlbGV0ZVwiOkRlKHIsbixpKTtyZXR1cm47ZGVmYXVsdDp2YXIgYT1Ccih0KTtmb3IodmFyIG89MDtvPGEubGVuZ3RoO28rKyl7dmFyIHM9YVtvXTt0cnl7dmFyIGw9cy5oYW5kbGVTd2FwKGUscixuLGkpO2lmKGwpe2lmKHR5cGVvZiBsLmxlbmd0aCE9PVwidW5kZWZpbmVkXCIpe2Zvcih2YXIgdT0wO3U8bC5sZW5ndGg7dSsrKXt2YXIgZj1sW3VdO2lmKGYubm9kZVR5cGUhPT1Ob2RlLlRFWFRfTk9ERSYmZi5ub2RlVHlwZSE9PU5vZGUuQ09NTUVOVF9OT0RFKXtpLnRhc2tzLnB1c2goT2UoZikpfX19cmV0dXJufX1jYXRjaChlKXtiKGUpfX1pZihlPT09XCJpbm5lckhUTUxcIil7VWUocixuLGkpfWVsc2V7QmUoUS5jb25maWcuZGVmYXVsdFN3YXBTdHlsZSx0LHIsbixpKX19fWZ1bmN0aW9uIFZlKGUpe2lmKGUuaW5kZXhPZihcIjx0aXRsZVwiKT4tMSl7dmFyIHQ9ZS5yZXBsYWNlKEgsXCJcIik7dmFyIHI9dC5tYXRjaChxKTtpZihyKXtyZXR1cm4gclsyXX19fWZ1bmN0aW9uIGplKGUsdCxyLG4saSxhKXtpLnRpdGxlPVZlKG4pO3ZhciBvPWwobik7aWYobyl7Q2UocixvLGkpO289RmUocixvLGEpO1JlKG8pO3JldHVybiBCZShlLHIsdCxvLGkpfX1mdW5jdGlvbiBfZShlLHQscil7dmFyIG49ZS5nZXRSZXNwb25zZUhlYWRlcih0KTtpZihuLmluZGV4T2YoXCJ7XCIpPT09MCl7dmFyIGk9RShuKTtmb3IodmFyIGEgaW4gaSl7aWYoaS5oYXNPd25Qcm9wZXJ0eShhKSl7dmFyIG89aVthXTtpZighUChvKSl7bz17dmFsdWU6b319Y2UocixhLG8pfX19ZWxzZXt2YXIgcz1uLnNwbGl0KFwiLFwiKTtmb3IodmFyIGw9MDtsPHMubGVuZ3RoO2wrKyl7Y2UocixzW2xdLnRyaW0oKSxbXSl9fX12YXIgemU9L1xccy87dmFyIHg9L1tcXHMsXS87dmFyICRlPS9bXyRhLXpBLVpdLzt2YXIgV2U9L1tfJGEtekEtWjAtOV0vO3ZhciBHZT1bJ1wiJyxcIidcIixcIi9cIl07dmFyIEplPS9bXlxcc10vO3ZhciBaZT0vW3soXS87dmFyIEtlPS9bfSldLztmdW5jdGlvbiBZZShlKXt2YXIgdD1bXTt2YXIgcj0wO3doaWxlKHI8ZS5sZW5ndGgpe2lmKCRlLmV4ZWMoZS5jaGFyQXQocikpKXt2YXIgbj1yO3doaWxlKFdlLmV4ZWMoZS5jaGFyQXQocisxKSkpe3IrK310LnB1c2goZS5zdWJzdHIobixyLW4rMSkpfWVsc2UgaWYoR2UuaW5kZXhPZihlLmNoYXJBdChyKSkhPT0tMSl7dmFyIGk9ZS5jaGFyQXQocik7dmFyIG49cjtyKys7d2hpbGUocjxlLmxlbmd0aCYmZS5jaGFyQXQocikhPT1pKXtpZihlLmNoYXJBdChyKT09PVwiXFxcXFwiKXtyKyt9cisrfXQucHVzaChlLnN1YnN0cihuLHItbisxKSl9ZWxzZXt2YXIgYT1lLmNoYXJBdChyKTt0LnB1c2goYSl9cisrfXJldHVybiB0fWZ1bmN0aW9uIFFlKGUsdCxyKXtyZXR1cm4gJGUuZXhlYyhlLmNoYXJBdCgwKSkmJmUhPT1cInRydWVcIiYmZSE9PVwiZmFsc2VcIiYmZSE9PVwidGhpc1wiJiZlIT09ciYmdCE9PVwiLlwifWZ1bmN0aW9uIGV0KGUsdCxyKXtpZih0WzBdPT09XCJbXCIpe3Quc2hpZnQoKTt2YXIgbj0xO3ZhciBpPVwiIHJldHVybiAoZnVuY3Rpb24oXCIrcitcIil7IHJldHVybiAoXCI7dmFyIGE9bnVsbDt3aGlsZSh0Lmxlbmd0aD4wKXt2YXIgbz10WzBdO2lmKG89PT1cIl1cIil7bi0tO2lmKG49PT0wKXtpZihhPT09bnVsbCl7aT1pK1widHJ1ZVwifXQuc2hpZnQoKTtpKz1cIil9KVwiO3RyeXt2YXIgcz1UcihlLGZ1bmN0aW9uKCl7cmV0dXJuIEZ1bmN0aW9uKGkpKCl9LGZ1bmN0aW9uKCl7cmV0dXJuIHRydWV9KTtzLnNvdXJjZT1pO3JldHVybiBzfWNhdGNoKGUpe2ZlKHJlKCkuYm9keSxcImh0bXg6c3ludGF4OmVycm9yXCIse2Vycm9yOmUsc291cmNlOml9KTtyZXR1cm4gbnVsbH19fWVsc2UgaWYobz09PVwiW1wiKXtuKyt9aWYoUWUobyxhLHIpKXtpKz1cIigoXCIrcitcIi5cIitvK1wiKSA/IChcIityK1wiLlwiK28rXCIpIDogKHdpbmRvdy5cIitvK1wiKSlcIn1lbHNle2k9aStvfWE9dC5zaGlmdCgpfX19ZnVuY3Rpb24geShlLHQpe3ZhciByPVwiXCI7d2hpbGUoZS5sZW5ndGg+MCYmIXQudGVzdChlWzBdKSl7cis9ZS5zaGlmdCgpfXJldHVybiByfWZ1bmN0aW9uIHR0KGUpe3ZhciB0O2lmKGUubGVuZ3RoPjAmJlplLnRlc3QoZVswXSkpe2Uuc2hpZnQoKTt0PXkoZSxLZSkudHJpbSgpO2Uuc2hpZnQoKX1lbHNle3Q9eShlLHgpfXJldHVybiB0fXZhciBydD1cImlucHV0LCB0ZXh0YXJlYSwgc2VsZWN0XCI7ZnVuY3Rpb24gbnQoZSx0LHIpe3ZhciBuPVtdO3ZhciBpPVllKHQpO2Rve3koaSxKZSk7dmFyIGE9aS5sZW5ndGg7dmFyIG89eShpLC9bLFxcW1xcc10vKTtpZihvIT09XCJcIil7aWYobz09PVwiZXZlcnlcIil7dmFyIHM9e3RyaWdnZXI6XCJldmVyeVwifTt5KGksSmUpO3MucG9sbEludGVydmFsPWQoeShpLC9bLFxcW1xcc10vKSk7eShpLEplKTt2YXIgbD1ldChlLGksXCJldmVudFwiKTtpZihsKXtzLmV2ZW50RmlsdGVyPWx9bi5wdXNoKHMpfWVsc2UgaWYoby5pbmRleE9mKFwic3NlOlwiKT09PTApe24ucHVzaCh7dHJpZ2dlcjpcInNzZVwiLHNzZUV2ZW50Om8uc3Vic3RyKDQpfSl9ZWxzZXt2YXIgdT17dHJpZ2dlcjpvfTt2YXIgbD1ldChlLGksXCJldmVudFwiKTtpZihsKXt1LmV2ZW50RmlsdGVyPWx9d2hpbGUoaS5sZW5ndGg+MCYmaVswXSE9PVwiLFwiKXt5KGksSmUpO3ZhciBmPWkuc2hpZnQoKTtpZihmPT09XCJjaGFuZ2VkXCIpe3UuY2hhbmdlZD10cnVlfWVsc2UgaWYoZj09PVwib25jZVwiKXt1Lm9uY2U9dHJ1ZX1lbHNlIGlmKGY9PT1cImNvbnN1bWVcIil7dS5jb25zdW1lPXRydWV9ZWxzZSBpZihmPT09XCJkZWxheVwiJiZpWzBdPT09XCI6XCIpe2kuc2hpZnQoKTt1LmRlbGF5PWQoeShpLHgpKX1lbHNlIGlmKGY9PT1cImZyb21cIiYmaVswXT09PVwiOlwiKXtpLnNoaWZ0KCk7aWYoWmUudGVzdChpWzBdKSl7dmFyIGM9dHQoaSl9ZWxzZXt2YXIgYz15KGkseCk7aWYoYz09PVwiY2xvc2VzdFwifHxjPT09XCJmaW5kXCJ8fGM9PT1cIm5leHRcInx8Yz09PVwicHJldmlvdXNcIil7aS5zaGlmdCgpO3ZhciBoPXR0KGkpO2lmKGgubGVuZ3RoPjApe2MrPVwiIFwiK2h9fX11LmZyb209Y31lbHNlIGlmKGY9PT1cInRhcmdldFwiJiZpWzBdPT09XCI6XCIpe2kuc2hpZnQoKTt1LnRhcmdldD10dChpKX1lbHNlIGlmKGY9PT1cInRocm90dGxlXCImJmlbMF09PT1cIjpcIil7aS5zaGlmdCgpO3UudGhyb3R0bGU9ZCh5KGkseCkpfWVsc2UgaWYoZj09PVwicXVldWVcIiYmaVswXT09PVwiOlwiKXtpLnNoaWZ0KCk7dS5xdWV1ZT15KGkseCl9ZWxzZSBpZihmPT09XCJyb290XCImJmlbMF09PT1cIjpcIil7aS5zaGlmdCgpO3VbZl09dHQoaSl9ZWxzZSBpZihmPT09XCJ0aHJlc2hvbGRcIiYmaVswXT09PVwiOlwiKXtpLnNoaWZ0KCk7dVtmXT15KGkseCl9ZWxzZXtmZShlLFwiaHRteDpzeW50YXg6ZXJyb3JcIix7dG9rZW46aS5zaGlmdCgpfSl9fW4ucHVzaCh1KX19aWYoaS5sZW5ndGg9PT1hKXtmZShlLFwiaHRteDpzeW50YXg6ZXJyb3JcIix7dG9rZW46aS5zaGlmdCgpfSl9eShpLEplKX13aGlsZShpWzBdPT09XCIsXCImJmkuc2hpZnQoKSk7aWYocil7clt0XT1ufXJldHVybiBufWZ1bmN0aW9uIGl0KGUpe3ZhciB0PXRlKGUsXCJoeC10cmlnZ2VyXCIpO3ZhciByPVtdO2lmKHQpe3ZhciBuPVEuY29uZmlnLnRyaWdnZXJTcGVjc0NhY2hlO3I9biYmblt0XXx8bnQoZSx0LG4pfWlmKHIubGVuZ3RoPjApe3JldHVybiByfWVsc2UgaWYoaChlLFwiZm9ybVwiKSl7cmV0dXJuW3t0cmlnZ2VyOlwic3VibWl0XCJ9XX1lbHNlIGlmKGgoZSwnaW5wdXRbdHlwZT1cImJ1dHRvblwiXSwgaW5wdXRbdHlwZT1cInN1Ym1pdFwiXScpKXtyZXR1cm5be3RyaWdnZXI6XCJjbGlja1wifV19ZWxzZSBpZihoKGUscnQpKXtyZXR1cm5be3RyaWdnZXI6XCJjaGFuZ2VcIn1dfWVsc2V7cmV0dXJuW3t0cmlnZ2VyOlwiY2xpY2tcIn1dfX1mdW5jdGlvbiBhdChlKXthZShlKS5jYW5jZWxsZWQ9dHJ1ZX1mdW5jdGlvbiBvdChlLHQscil7dmFyIG49YWUoZSk7bi50aW1lb3V0PXNldFRpbWVvdXQoZnVuY3Rpb24oKXtpZihzZShlKSYmbi5jYW5jZWxsZWQhPT10cnVlKXtpZighY3QocixlLFd0KFwiaHg6cG9sbDp0cmlnZ2VyXCIse3RyaWdnZXJTcGVjOnIsdGFyZ2V0OmV9KSkpe3QoZSl9b3QoZSx0LHIpfX0sci5wb2xsSW50ZXJ2YWwpfWZ1bmN0aW9uIHN0KGUpe3JldHVybiBsb2NhdGlvbi5ob3N0bmFtZT09PWUuaG9zdG5hbWUmJmVlKGUsXCJocmVmXCIpJiZlZShlLFwiaHJlZlwiKS5pbmRleE9mKFwiI1wiKSE9PTB9ZnVuY3Rpb24gbHQodCxyLGUpe2lmKHQudGFnTmFtZT09PVwiQVwiJiZzdCh0KSYmKHQudGFyZ2V0PT09XCJcInx8dC50YXJnZXQ9PT1cIl9zZWxmXCIpfHx0LnRhZ05hbWU9PT1cIkZPUk1cIil7ci5ib29zdGVkPXRydWU7dmFyIG4saTtpZih0LnRhZ05hbWU9PT1cIkFcIil7bj1cImdldFwiO2k9ZWUodCxcImhyZWZcIil9ZWxzZXt2YXIgYT1lZSh0LFwibWV0aG9kXCIpO249YT9hLnRvTG93ZXJDYXNlKCk6XCJnZXRcIjtpZihuPT09XCJnZXRcIil7fWk9ZWUodCxcImFjdGlvblwiKX1lLmZvckVhY2goZnVuY3Rpb24oZSl7aHQodCxmdW5jdGlvbihlLHQpe2lmKHYoZSxRLmNvbmZpZy5kaXNhYmxlU2VsZWN0b3IpKXttKGUpO3JldHVybn1oZShuLGksZSx0KX0scixlLHRydWUpfSl9fWZ1bmN0aW9uIHV0KGUsdCl7aWYoZS50eXBlPT09XCJzdWJtaXRcInx8ZS50eXBlPT09XCJjbGlja1wiKXtpZih0LnRhZ05hbWU9PT1cIkZPUk1cIil7cmV0dXJuIHRydWV9aWYoaCh0LCdpbnB1dFt0eXBlPVwic3VibWl0XCJdLCBidXR0b24nKSYmdih0LFwiZm9ybVwiKSE9PW51bGwpe3JldHVybiB0cnVlfWlmKHQudGFnTmFtZT09PVwiQVwiJiZ0LmhyZWYmJih0LmdldEF0dHJpYnV0ZShcImhyZWZcIik9PT1cIiNcInx8dC5nZXRBdHRyaWJ1dGUoXCJocmVmXCIpLmluZGV4T2YoXCIjXCIpIT09MCkpe3JldHVybiB0cnVlfX1yZXR1cm4gZmFsc2V9ZnVuY3Rpb24gZnQoZSx0KXtyZXR1cm4gYWUoZSkuYm9vc3RlZCYmZS50YWdOYW1lPT09XCJBXCImJnQudHlwZT09PVwiY2xpY2tcIiYmKHQuY3RybEtleXx8dC5tZXRhS2V5KX1mdW5jdGlvbiBjdChlLHQscil7dmFyIG49ZS5ldmVudEZpbHRlcjtpZihuKXt0cnl7cmV0dXJuIG4uY2FsbCh0LHIpIT09dHJ1ZX1jYXRjaChlKXtmZShyZSgpLmJvZHksXCJodG14OmV2ZW50RmlsdGVyOmVycm9yXCIse2Vycm9yOmUsc291cmNlOm4uc291cmNlfSk7cmV0dXJuIHRydWV9fXJldHVybiBmYWxzZX1mdW5jdGlvbiBodChhLG8sZSxzLGwpe3ZhciB1PWFlKGEpO3ZhciB0O2lmKHMuZnJvbSl7dD1aKGEscy5mcm9tKX1lbHNle3Q9W2FdfWlmKHMuY2hhbmdlZCl7dC5mb3JFYWNoKGZ1bmN0aW9uKGUpe3ZhciB0PWFlKGUpO3QubGFzdFZhbHVlPWUudmFsdWV9KX1vZSh0LGZ1bmN0aW9uKG4pe3ZhciBpPWZ1bmN0aW9uKGUpe2lmKCFzZShhKSl7bi5yZW1vdmVFdmVudExpc3RlbmVyKHMudHJpZ2dlcixpKTtyZXR1cm59aWYoZnQoYSxlKSl7cmV0dXJufWlmKGx8fHV0KGUsYSkpe2UucHJldmVudERlZmF1bHQoKX1pZihjdChzLGEsZSkpe3JldHVybn12YXIgdD1hZShlKTt0LnRyaWdnZXJTcGVjPXM7aWYodC5oYW5kbGVkRm9yPT1udWxsKXt0LmhhbmRsZWRGb3I9W119aWYodC5oYW5kbGVkRm9yLmluZGV4T2YoYSk8MCl7dC5oYW5kbGVkRm9yLnB1c2goYSk7aWYocy5jb25zdW1lKXtlLnN0b3BQcm9wYWdhdGlvbigpfWlmKHMudGFyZ2V0JiZlLnRhcmdldCl7aWYoIWgoZS50YXJnZXQscy50YXJnZXQpKXtyZXR1cm59fWlmKHMub25jZSl7aWYodS50cmlnZ2VyZWRPbmNlKXtyZXR1cm59ZWxzZXt1LnRyaWdnZXJlZE9uY2U9dHJ1ZX19aWYocy5jaGFuZ2VkKXt2YXIgcj1hZShuKTtpZihyLmxhc3RWYWx1ZT09PW4udmFsdWUpe3JldHVybn1yLmxhc3RWYWx1ZT1uLnZhbHVlfWlmKHUuZGVsYXllZCl7Y2xlYXJUaW1lb3V0KHUuZGVsYXllZCl9aWYodS50aHJvdHRsZSl7cmV0dXJufWlmKHMudGhyb3R0bGU+MCl7aWYoIXUudGhyb3R0bGUpe28oYSxlKTt1LnRocm90dGxlPXNldFRpbWVvdXQoZnVuY3Rpb24oKXt1LnRocm90dGxlPW51bGx9LHMudGhyb3R0bGUpfX1lbHNlIGlmKHMuZGVsYXk+MCl7dS5kZWxheWVkPXNldFRpbWVvdXQoZnVuY3Rpb24oKXtvKGEsZSl9LHMuZGVsYXkpfWVsc2V7Y2UoYSxcImh0bXg6dHJpZ2dlclwiKTtvKGEsZSl9fX07aWYoZS5saXN0ZW5lckluZm9zPT1udWxsKXtlLmxpc3RlbmVySW5mb3M9W119ZS5saXN0ZW5lckluZm9zLnB1c2goe3RyaWdnZXI6cy50cmlnZ2VyLGxpc3RlbmVyOmksb246bn0pO24uYWRkRXZlbnRMaXN0ZW5lcihzLnRyaWdnZXIsaSl9KX12YXIgdnQ9ZmFsc2U7dmFyIGR0PW51bGw7ZnVuY3Rpb24gZ3QoKXtpZighZHQpe2R0PWZ1bmN0aW9uKCl7dnQ9dHJ1ZX07d2luZG93LmFkZEV2ZW50TGlzdGVuZXIoXCJzY3JvbGxcIixkdCk7c2V0SW50ZXJ2YWwoZnVuY3Rpb24oKXtpZih2dCl7dnQ9ZmFsc2U7b2UocmUoKS5xdWVyeVNlbGVjdG9yQWxsKFwiW2h4LXRyaWdnZXI9J3JldmVhbGVkJ10sW2RhdGEtaHgtdHJpZ2dlcj0ncmV2ZWFsZWQnXVwiKSxmdW5jdGlvbihlKXtwdChlKX0pfX0sMjAwKX19ZnVuY3Rpb24gcHQodCl7aWYoIW8odCxcImRhdGEtaHgtcmV2ZWFsZWRcIikmJlgodCkpe3Quc2V0QXR0cmlidXRlKFwiZGF0YS1oeC1yZXZlYWxlZFwiLFwidHJ1ZVwiKTt2YXIgZT1hZSh0KTtpZihlLmluaXRIYXNoKXtjZSh0LFwicmV2ZWFsZWRcIil9ZWxzZXt0LmFkZEV2ZW50TGlzdGVuZXIoXCJodG14OmFmdGVyUHJvY2Vzc05vZGVcIixmdW5jdGlvbihlKXtjZSh0LFwicmV2ZWFsZWRcIil9LHtvbmNlOnRydWV9KX19fWZ1bmN0aW9uIG10KGUsdCxyKXt2YXIgbj1EKHIpO2Zvcih2YXIgaT0wO2k8bi5sZW5ndGg7aSsrKXt2YXIgYT1uW2ldLnNwbGl0KC86KC4rKS8pO2lmKGFbMF09PT1cImNvbm5lY3RcIil7eHQoZSxhWzFdLDApfWlmKGFbMF09PT1cInNlbmRcIil7YnQoZSl9fX1mdW5jdGlvbiB4dChzLHIsbil7aWYoIXNlKHMpKXtyZXR1cm59aWYoci5pbmRleE9mKFwiL1wiKT09MCl7dmFyIGU9bG9jYXRpb24uaG9zdG5hbWUrKGxvY2F0aW9uLnBvcnQ/XCI6XCIrbG9jYXRpb24ucG9ydDpcIlwiKTtpZihsb2NhdGlvbi5wcm90b2NvbD09XCJodHRwczpcIil7cj1cIndzczovL1wiK2Urcn1lbHNlIGlmKGxvY2F0aW9uLnByb3RvY29sPT1cImh0dHA6XCIpe3I9XCJ3czovL1wiK2Urcn19dmFyIHQ9US5jcmVhdGVXZWJTb2NrZXQocik7dC5vbmVycm9yPWZ1bmN0aW9uKGUpe2ZlKHMsXCJodG14OndzRXJyb3JcIix7ZXJyb3I6ZSxzb2NrZXQ6dH0pO3l0KHMpfTt0Lm9uY2xvc2U9ZnVuY3Rpb24oZSl7aWYoWzEwMDYsMTAxMiwxMDEzXS5pbmRleE9mKGUuY29kZSk+PTApe3ZhciB0PXd0KG4pO3NldFRpbWVvdXQoZnVuY3Rpb24oKXt4dChzLHIsbisxKX0sdCl9fTt0Lm9ub3Blbj1mdW5jdGlvbihlKXtuPTB9O2FlKHMpLndlYlNvY2tldD10O3QuYWRkRXZlbnRMaXN0ZW5lcihcIm1lc3NhZ2VcIixmdW5jdGlvbihlKXtpZih5dChzKSl7cmV0dXJufXZhciB0PWUuZGF0YTtSKHMsZnVuY3Rpb24oZSl7dD1lLnRyYW5zZm9ybVJlc3BvbnNlKHQsbnVsbCxzKX0pO3ZhciByPVQocyk7dmFyIG49bCh0KTt2YXIgaT1NKG4uY2hpbGRyZW4pO2Zvcih2YXIgYT0wO2E8aS5sZW5ndGg7YSsrKXt2YXIgbz1pW2FdO0VlKHRlKG8sXCJoeC1zd2FwLW9vYlwiKXx8XCJ0cnVlXCIsbyxyKX1ucihyLnRhc2tzKX0pfWZ1bmN0aW9uIHl0KGUpe2lmKCFzZShlKSl7YWUoZSkud2ViU29ja2V0LmNsb3NlKCk7cmV0dXJuIHRydWV9fWZ1bmN0aW9uIGJ0KHUpe3ZhciBmPWModSxmdW5jdGlvbihlKXtyZXR1cm4gYWUoZSkud2ViU29ja2V0IT1udWxsfSk7aWYoZil7dS5hZGRFdmVudExpc3RlbmVyKGl0KHUpWzBdLnRyaWdnZXIsZnVuY3Rpb24oZSl7dmFyIHQ9YWUoZikud2ViU29ja2V0O3ZhciByPXhyKHUsZik7dmFyIG49ZHIodSxcInBvc3RcIik7dmFyIGk9bi5lcnJvcnM7dmFyIGE9bi52YWx1ZXM7dmFyIG89SHIodSk7dmFyIHM9bGUoYSxvKTt2YXIgbD15cihzLHUpO2xbXCJIRUFERVJTXCJdPXI7aWYoaSYmaS5sZW5ndGg+MCl7Y2UodSxcImh0bXg6dmFsaWRhdGlvbjpoYWx0ZWRcIixpKTtyZXR1cm59dC5zZW5kKEpTT04uc3RyaW5naWZ5KGwpKTtpZih1dChlLHUpKXtlLnByZXZlbnREZWZhdWx0KCl9fSl9ZWxzZXtmZSh1LFwiaHRteDpub1dlYlNvY2tldFNvdXJjZUVycm9yXCIpfX1mdW5jdGlvbiB3dChlKXt2YXIgdD1RLmNvbmZpZy53c1JlY29ubmVjdERlbGF5O2lmKHR5cGVvZiB0PT09XCJmdW5jdGlvblwiKXtyZXR1cm4gdChlKX1pZih0PT09XCJmdWxsLWppdHRlclwiKXt2YXIgcj1NYXRoLm1pbihlLDYpO3ZhciBuPTFlMypNYXRoLnBvdygyLHIpO3JldHVybiBuKk1hdGgucmFuZG9tKCl9YignaHRteC5jb25maWcud3NSZWNvbm5lY3REZWxheSBtdXN0IGVpdGhlciBiZSBhIGZ1bmN0aW9uIG9yIHRoZSBzdHJpbmcgXCJmdWxsLWppdHRlclwiJyl9ZnVuY3Rpb24gU3QoZSx0LHIpe3ZhciBuPUQocik7Zm9yKHZhciBpPTA7aTxuLmxlbmd0aDtpKyspe3ZhciBhPW5baV0uc3BsaXQoLzooLispLyk7aWYoYVswXT09PVwiY29ubmVjdFwiKXtFdChlLGFbMV0pfWlmKGFbMF09PT1cInN3YXBcIil7Q3QoZSxhWzFdKX19fWZ1bmN0aW9uIEV0KHQsZSl7dmFyIHI9US5jcmVhdGVFdmVudFNvdXJjZShlKTtyLm9uZXJyb3I9ZnVuY3Rpb24oZSl7ZmUodCxcImh0bXg6c3NlRXJyb3JcIix7ZXJyb3I6ZSxzb3VyY2U6cn0pO1R0KHQpfTthZSh0KS5zc2VFdmVudFNvdXJjZT1yfWZ1bmN0aW9uIEN0KGEsbyl7dmFyIHM9YyhhLE90KTtpZihzKXt2YXIgbD1hZShzKS5zc2VFdmVudFNvdXJjZTt2YXIgdT1mdW5jdGlvbihlKXtpZihUdChzKSl7cmV0dXJufWlmKCFzZShhKSl7bC5yZW1vdmVFdmVudExpc3RlbmVyKG8sdSk7cmV0dXJufXZhciB0PWUuZGF0YTtSKGEsZnVuY3Rpb24oZSl7dD1lLnRyYW5zZm9ybVJlc3BvbnNlKHQsbnVsbCxhKX0pO3ZhciByPXdyKGEpO3ZhciBuPXllKGEpO3ZhciBpPVQoYSk7amUoci5zd2FwU3R5bGUsbixhLHQsaSk7bnIoaS50YXNrcyk7Y2UoYSxcImh0bXg6c3NlTWVzc2FnZVwiLGUpfTthZShhKS5zc2VMaXN0ZW5lcj11O2wuYWRkRXZlbnRMaXN0ZW5lcihvLHUpfWVsc2V7ZmUoYSxcImh0bXg6bm9TU0VTb3VyY2VFcnJvclwiKX19ZnVuY3Rpb24gUnQoZSx0LHIpe3ZhciBuPWMoZSxPdCk7aWYobil7dmFyIGk9YWUobikuc3NlRXZlbnRTb3VyY2U7dmFyIGE9ZnVuY3Rpb24oKXtpZighVHQobikpe2lmKHNlKGUpKXt0KGUpfWVsc2V7aS5yZW1vdmVFdmVudExpc3RlbmVyKHIsYSl9fX07YWUoZSkuc3NlTGlzdGVuZXI9YTtpLmFkZEV2ZW50TGlzdGVuZXIocixhKX1lbHNle2ZlKGUsXCJodG14Om5vU1NFU291cmNlRXJyb3JcIil9fWZ1bmN0aW9uIFR0KGUpe2lmKCFzZShlKSl7YWUoZSkuc3NlRXZlbnRTb3VyY2UuY2xvc2UoKTtyZXR1cm4gdHJ1ZX19ZnVuY3Rpb24gT3QoZSl7cmV0dXJuIGFlKGUpLnNzZUV2ZW50U291cmNlIT1udWxsfWZ1bmN0aW9uIHF0KGUsdCxyLG4pe3ZhciBpPWZ1bmN0aW9uKCl7aWYoIXIubG9hZGVkKXtyLmxvYWRlZD10cnVlO3QoZSl9fTtpZihuPjApe3NldFRpbWVvdXQoaSxuKX1lbHNle2koKX19ZnVuY3Rpb24gSHQodCxpLGUpe3ZhciBhPWZhbHNlO29lKHcsZnVuY3Rpb24ocil7aWYobyh0LFwiaHgtXCIrcikpe3ZhciBuPXRlKHQsXCJoeC1cIityKTthPXRydWU7aS5wYXRoPW47aS52ZXJiPXI7ZS5mb3JFYWNoKGZ1bmN0aW9uKGUpe0x0KHQsZSxpLGZ1bmN0aW9uKGUsdCl7aWYodihlLFEuY29uZmlnLmRpc2FibGVTZWxlY3Rvcikpe20oZSk7cmV0dXJufWhlKHIsbixlLHQpfSl9KX19KTtyZXR1cm4gYX1mdW5jdGlvbiBMdChuLGUsdCxyKXtpZihlLnNzZUV2ZW50KXtSdChuLHIsZS5zc2VFdmVudCl9ZWxzZSBpZihlLnRyaWdnZXI9PT1cInJldmVhbGVkXCIpe2d0KCk7aHQobixyLHQsZSk7cHQobil9ZWxzZSBpZihlLnRyaWdnZXI9PT1cImludGVyc2VjdFwiKXt2YXIgaT17fTtpZihlLnJvb3Qpe2kucm9vdD11ZShuLGUucm9vdCl9aWYoZS50aHJlc2hvbGQpe2kudGhyZXNob2xkPXBhcnNlRmxvYXQoZS50aHJlc2hvbGQpfXZhciBhPW5ldyBJbnRlcnNlY3Rpb25PYnNlcnZlcihmdW5jdGlvbihlKXtmb3IodmFyIHQ9MDt0PGUubGVuZ3RoO3QrKyl7dmFyIHI9ZVt0XTtpZihyLmlzSW50ZXJzZWN0aW5nKXtjZShuLFwiaW50ZXJzZWN0XCIpO2JyZWFrfX19LGkpO2Eub2JzZXJ2ZShuKTtodChuLHIsdCxlKX1lbHNlIGlmKGUudHJpZ2dlcj09PVwibG9hZFwiKXtpZighY3QoZSxuLFd0KFwibG9hZFwiLHtlbHQ6bn0pKSl7cXQobixyLHQsZS5kZWxheSl9fWVsc2UgaWYoZS5wb2xsSW50ZXJ2YWw+MCl7dC5wb2xsaW5nPXRydWU7b3QobixyLGUpfWVsc2V7aHQobixyLHQsZSl9fWZ1bmN0aW9uIEF0KGUpe2lmKCFlLmh0bXhFeGVjdXRlZCYmUS5jb25maWcuYWxsb3dTY3JpcHRUYWdzJiYoZS50eXBlPT09XCJ0ZXh0L2phdmFzY3JpcHRcInx8ZS50eXBlPT09XCJtb2R1bGVcInx8ZS50eXBlPT09XCJcIikpe3ZhciB0PXJlKCkuY3JlYXRlRWxlbWVudChcInNjcmlwdFwiKTtvZShlLmF0dHJpYnV0ZXMsZnVuY3Rpb24oZSl7dC5zZXRBdHRyaWJ1dGUoZS5uYW1lLGUudmFsdWUpfSk7dC50ZXh0Q29udGVudD1lLnRleHRDb250ZW50O3QuYXN5bmM9ZmFsc2U7aWYoUS5jb25maWcuaW5saW5lU2NyaXB0Tm9uY2Upe3Qubm9uY2U9US5jb25maWcuaW5saW5lU2NyaXB0Tm9uY2V9dmFyIHI9ZS5wYXJlbnRFbGVtZW50O3RyeXtyLmluc2VydEJlZm9yZSh0LGUpfWNhdGNoKGUpe2IoZSl9ZmluYWxseXtpZihlLnBhcmVudEVsZW1lbnQpe2UucGFyZW50RWxlbWVudC5yZW1vdmVDaGlsZChlKX19fX1mdW5jdGlvbiBOdChlKXtpZihoKGUsXCJzY3JpcHRcIikpe0F0KGUpfW9lKGYoZSxcInNjcmlwdFwiKSxmdW5jdGlvbihlKXtBdChlKX0pfWZ1bmN0aW9uIEl0KGUpe3ZhciB0PWUuYXR0cmlidXRlcztmb3IodmFyIHI9MDtyPHQubGVuZ3RoO3IrKyl7dmFyIG49dFtyXS5uYW1lO2lmKGcobixcImh4LW9uOlwiKXx8ZyhuLFwiZGF0YS1oeC1vbjpcIil8fGcobixcImh4LW9uLVwiKXx8ZyhuLFwiZGF0YS1oeC1vbi1cIikpe3JldHVybiB0cnVlfX1yZXR1cm4gZmFsc2V9ZnVuY3Rpb24ga3QoZSl7dmFyIHQ9bnVsbDt2YXIgcj1bXTtpZihJdChlKSl7ci5wdXNoKGUpfWlmKGRvY3VtZW50LmV2YWx1YXRlKXt2YXIgbj1kb2N1bWVudC5ldmFsdWF0ZSgnLi8vKltAKlsgc3RhcnRzLXdpdGgobmFtZSgpLCBcImh4LW9uOlwiKSBvciBzdGFydHMtd2l0aChuYW1lKCksIFwiZGF0YS1oeC1vbjpcIikgb3InKycgc3RhcnRzLXdpdGgobmFtZSgpLCBcImh4LW9uLVwiKSBvciBzdGFydHMtd2l0aChuYW1lKCksIFwiZGF0YS1oeC1vbi1cIikgXV0nLGUpO3doaWxlKHQ9bi5pdGVyYXRlTmV4dCgpKXIucHVzaCh0KX1lbHNle3ZhciBpPWUuZ2V0RWxlbWVudHNCeVRhZ05hbWUoXCIqXCIpO2Zvcih2YXIgYT0wO2E8aS5sZW5ndGg7YSsrKXtpZihJdChpW2FdKSl7ci5wdXNoKGlbYV0pfX19cmV0dXJuIHJ9ZnVuY3Rpb24gUHQoZSl7aWYoZS5xdWVyeVNlbGVjdG9yQWxsKXt2YXIgdD1cIiwgW2h4LWJvb3N0XSBhLCBbZGF0YS1oeC1ib29zdF0gYSwgYVtoeC1ib29zdF0sIGFbZGF0YS1oeC1ib29zdF1cIjt2YXIgcj1lLnF1ZXJ5U2VsZWN0b3JBbGwoaSt0K1wiLCBmb3JtLCBbdHlwZT0nc3VibWl0J10sIFtoeC1zc2VdLCBbZGF0YS1oeC1zc2VdLCBbaHgtd3NdLFwiK1wiIFtkYXRhLWh4LXdzXSwgW2h4LWV4dF0sIFtkYXRhLWh4LWV4dF0sIFtoeC10cmlnZ2VyXSwgW2RhdGEtaHgtdHJpZ2dlcl0sIFtoeC1vbl0sIFtkYXRhLWh4LW9uXVwiKTtyZXR1cm4gcn1lbHNle3JldHVybltdfX1mdW5jdGlvbiBNdChlKXt2YXIgdD12KGUudGFyZ2V0LFwiYnV0dG9uLCBpbnB1dFt0eXBlPSdzdWJtaXQnXVwiKTt2YXIgcj1EdChlKTtpZihyKXtyLmxhc3RCdXR0b25DbGlja2VkPXR9fWZ1bmN0aW9uIFh0KGUpe3ZhciB0PUR0KGUpO2lmKHQpe3QubGFzdEJ1dHRvbkNsaWNrZWQ9bnVsbH19ZnVuY3Rpb24gRHQoZSl7dmFyIHQ9dihlLnRhcmdldCxcImJ1dHRvbiwgaW5wdXRbdHlwZT0nc3VibWl0J11cIik7aWYoIXQpe3JldHVybn12YXIgcj1wKFwiI1wiK2VlKHQsXCJmb3JtXCIpKXx8dih0LFwiZm9ybVwiKTtpZighcil7cmV0dXJufXJldHVybiBhZShyKX1mdW5jdGlvbiBVdChlKXtlLmFkZEV2ZW50TGlzdGVuZXIoXCJjbGlja1wiLE10KTtlLmFkZEV2ZW50TGlzdGVuZXIoXCJmb2N1c2luXCIsTXQpO2UuYWRkRXZlbnRMaXN0ZW5lcihcImZvY3Vzb3V0XCIsWHQpfWZ1bmN0aW9uIEZ0KGUpe3ZhciB0PVllKGUpO3ZhciByPTA7Zm9yKHZhciBuPTA7bjx0Lmxlbmd0aDtuKyspe2NvbnN0IGk9dFtuXTtpZihpPT09XCJ7XCIpe3IrK31lbHNlIGlmKGk9PT1cIn1cIil7ci0tfX1yZXR1cm4gcn1mdW5jdGlvbiBCdCh0LGUscil7dmFyIG49YWUodCk7aWYoIUFycmF5LmlzQXJyYXkobi5vbkhhbmRsZXJzKSl7bi5vbkhhbmRsZXJzPVtdfXZhciBpO3ZhciBhPWZ1bmN0aW9uKGUpe3JldHVybiBUcih0LGZ1bmN0aW9uKCl7aWYoIWkpe2k9bmV3IEZ1bmN0aW9uKFwiZXZlbnRcIixyKX1pLmNhbGwodCxlKX0pfTt0LmFkZEV2ZW50TGlzdGVuZXIoZSxhKTtuLm9uSGFuZGxlcnMucHVzaCh7ZXZlbnQ6ZSxsaXN0ZW5lcjphfSl9ZnVuY3Rpb24gVnQoZSl7dmFyIHQ9dGUoZSxcImh4LW9uXCIpO2lmKHQpe3ZhciByPXt9O3ZhciBuPXQuc3BsaXQoXCJcXG5cIik7dmFyIGk9bnVsbDt2YXIgYT0wO3doaWxlKG4ubGVuZ3RoPjApe3ZhciBvPW4uc2hpZnQoKTt2YXIgcz1vLm1hdGNoKC9eXFxzKihbYS16QS1aOlxcLVxcLl0rOikoLiopLyk7aWYoYT09PTAmJnMpe28uc3BsaXQoXCI6XCIpO2k9c1sxXS5zbGljZSgwLC0xKTtyW2ldPXNbMl19ZWxzZXtyW2ldKz1vfWErPUZ0KG8pfWZvcih2YXIgbCBpbiByKXtCdChlLGwscltsXSl9fX1mdW5jdGlvbiBqdChlKXtBZShlKTtmb3IodmFyIHQ9MDt0PGUuYXR0cmlidXRlcy5sZW5ndGg7dCsrKXt2YXIgcj1lLmF0dHJpYnV0ZXNbdF0ubmFtZTt2YXIgbj1lLmF0dHJpYnV0ZXNbdF0udmFsdWU7aWYoZyhyLFwiaHgtb25cIil8fGcocixcImRhdGEtaHgtb25cIikpe3ZhciBpPXIuaW5kZXhPZihcIi1vblwiKSszO3ZhciBhPXIuc2xpY2UoaSxpKzEpO2lmKGE9PT1cIi1cInx8YT09PVwiOlwiKXt2YXIgbz1yLnNsaWNlKGkrMSk7aWYoZyhvLFwiOlwiKSl7bz1cImh0bXhcIitvfWVsc2UgaWYoZyhvLFwiLVwiKSl7bz1cImh0bXg6XCIrby5zbGljZSgxKX1lbHNlIGlmKGcobyxcImh0bXgtXCIpKXtvPVwiaHRteDpcIitvLnNsaWNlKDUpfUJ0KGUsbyxuKX19fX1mdW5jdGlvbiBfdCh0KXtpZih2KHQsUS5jb25maWcuZGlzYWJsZVNlbGVjdG9yKSl7bSh0KTtyZXR1cm59dmFyIHI9YWUodCk7aWYoci5pbml0SGFzaCE9PUxlKHQpKXtOZSh0KTtyLmluaXRIYXNoPUxlKHQpO1Z0KHQpO2NlKHQsXCJodG14OmJlZm9yZVByb2Nlc3NOb2RlXCIpO2lmKHQudmFsdWUpe3IubGFzdFZhbHVlPXQudmFsdWV9dmFyIGU9aXQodCk7dmFyIG49SHQodCxyLGUpO2lmKCFuKXtpZihuZSh0LFwiaHgtYm9vc3RcIik9PT1cInRydWVcIil7bHQodCxyLGUpfWVsc2UgaWYobyh0LFwiaHgtdHJpZ2dlclwiKSl7ZS5mb3JFYWNoKGZ1bmN0aW9uKGUpe0x0KHQsZSxyLGZ1bmN0aW9uKCl7fSl9KX19aWYodC50YWdOYW1lPT09XCJGT1JNXCJ8fGVlKHQsXCJ0eXBlXCIpPT09XCJzdWJtaXRcIiYmbyh0LFwiZm9ybVwiKSl7VXQodCl9dmFyIGk9dGUodCxcImh4LXNzZVwiKTtpZihpKXtTdCh0LHIsaSl9dmFyIGE9dGUodCxcImh4LXdzXCIpO2lmKGEpe210KHQscixhKX1jZSh0LFwiaHRteDphZnRlclByb2Nlc3NOb2RlXCIpfX1mdW5jdGlvbiB6dChlKXtlPXAoZSk7aWYodihlLFEuY29uZmlnLmRpc2FibGVTZWxlY3Rvcikpe20oZSk7cmV0dXJufV90KGUpO29lKFB0KGUpLGZ1bmN0aW9uKGUpe190KGUpfSk7b2Uoa3QoZSksanQpfWZ1bmN0aW9uICR0KGUpe3JldHVybiBlLnJlcGxhY2UoLyhbYS16MC05XSkoW0EtWl0pL2csXCIkMS0kMlwiKS50b0xvd2VyQ2FzZSgpfWZ1bmN0aW9uIFd0KGUsdCl7dmFyIHI7aWYod2luZG93LkN1c3RvbUV2ZW50JiZ0eXBlb2Ygd2luZG93LkN1c3RvbUV2ZW50PT09XCJmdW5jdGlvblwiKXtyPW5ldyBDdXN0b21FdmVudChlLHtidWJibGVzOnRydWUsY2FuY2VsYWJsZTp0cnVlLGRldGFpbDp0fSl9ZWxzZXtyPXJlKCkuY3JlYXRlRXZlbnQoXCJDdXN0b21FdmVudFwiKTtyLmluaXRDdXN0b21FdmVudChlLHRydWUsdHJ1ZSx0KX1yZXR1cm4gcn1mdW5jdGlvbiBmZShlLHQscil7Y2UoZSx0LGxlKHtlcnJvcjp0fSxyKSl9ZnVuY3Rpb24gR3QoZSl7cmV0dXJuIGU9PT1cImh0bXg6YWZ0ZXJQcm9jZXNzTm9kZVwifWZ1bmN0aW9uIFIoZSx0KXtvZShCcihlKSxmdW5jdGlvbihlKXt0cnl7dChlKX1jYXRjaChlKXtiKGUpfX0pfWZ1bmN0aW9uIGIoZSl7aWYoY29uc29sZS5lcnJvcil7Y29uc29sZS5lcnJvcihlKX1lbHNlIGlmKGNvbnNvbGUubG9nKXtjb25zb2xlLmxvZyhcIkVSUk9SOiBcIixlKX19ZnVuY3Rpb24gY2UoZSx0LHIpe2U9cChlKTtpZihyPT1udWxsKXtyPXt9fXJbXCJlbHRcIl09ZTt2YXIgbj1XdCh0LHIpO2lmKFEubG9nZ2VyJiYhR3QodCkpe1EubG9nZ2VyKGUsdCxyKX1pZihyLmVycm9yKXtiKHIuZXJyb3IpO2NlKGUsXCJodG14OmVycm9yXCIse2Vycm9ySW5mbzpyfSl9dmFyIGk9ZS5kaXNwYXRjaEV2ZW50KG4pO3ZhciBhPSR0KHQpO2lmKGkmJmEhPT10KXt2YXIgbz1XdChhLG4uZGV0YWlsKTtpPWkmJmUuZGlzcGF0Y2hFdmVudChvKX1SKGUsZnVuY3Rpb24oZSl7aT1pJiYoZS5vbkV2ZW50KHQsbikhPT1mYWxzZSYmIW4uZGVmYXVsdFByZXZlbnRlZCl9KTtyZXR1cm4gaX12YXIgSnQ9bG9jYXRpb24ucGF0aG5hbWUrbG9jYXRpb24uc2VhcmNoO2Z1bmN0aW9uIFp0KCl7dmFyIGU9cmUoKS5xdWVyeVNlbGVjdG9yKFwiW2h4LWhpc3RvcnktZWx0XSxbZGF0YS1oeC1oaXN0b3J5LWVsdF1cIik7cmV0dXJuIGV8fHJlKCkuYm9keX1mdW5jdGlvbiBLdChlLHQscixuKXtpZighVSgpKXtyZXR1cm59aWYoUS5jb25maWcuaGlzdG9yeUNhY2hlU2l6ZTw9MCl7bG9jYWxTdG9yYWdlLnJlbW92ZUl0ZW0oXCJodG14LWhpc3RvcnktY2FjaGVcIik7cmV0dXJufWU9RihlKTt2YXIgaT1FKGxvY2FsU3RvcmFnZS5nZXRJdGVtKFwiaHRteC1oaXN0b3J5LWNhY2hlXCIpKXx8W107Zm9yKHZhciBhPTA7YTxpLmxlbmd0aDthKyspe2lmKGlbYV0udXJsPT09ZSl7aS5zcGxpY2UoYSwxKTticmVha319dmFyIG89e3VybDplLGNvbnRlbnQ6dCx0aXRsZTpyLHNjcm9sbDpufTtjZShyZSgpLmJvZHksXCJodG14Omhpc3RvcnlJdGVtQ3JlYXRlZFwiLHtpdGVtOm8sY2FjaGU6aX0pO2kucHVzaChvKTt3aGlsZShpLmxlbmd0aD5RLmNvbmZpZy5oaXN0b3J5Q2FjaGVTaXplKXtpLnNoaWZ0KCl9d2hpbGUoaS5sZW5ndGg+MCl7dHJ5e2xvY2FsU3RvcmFnZS5zZXRJdGVtKFwiaHRteC1oaXN0b3J5LWNhY2hlXCIsSlNPTi5zdHJpbmdpZnkoaSkpO2JyZWFrfWNhdGNoKGUpe2ZlKHJlKCkuYm9keSxcImh0bXg6aGlzdG9yeUNhY2hlRXJyb3JcIix7Y2F1c2U6ZSxjYWNoZTppfSk7aS5zaGlmdCgpfX19ZnVuY3Rpb24gWXQoZSl7aWYoIVUoKSl7cmV0dXJuIG51bGx9ZT1GKGUpO3ZhciB0PUUobG9jYWxTdG9yYWdlLmdldEl0ZW0oXCJodG14LWhpc3RvcnktY2FjaGVcIikpfHxbXTtmb3IodmFyIHI9MDtyPHQubGVuZ3RoO3IrKyl7aWYodFtyXS51cmw9PT1lKXtyZXR1cm4gdFtyXX19cmV0dXJuIG51bGx9ZnVuY3Rpb24gUXQoZSl7dmFyIHQ9US5jb25maWcucmVxdWVzdENsYXNzO3ZhciByPWUuY2xvbmVOb2RlKHRydWUpO29lKGYocixcIi5cIit0KSxmdW5jdGlvbihlKXtuKGUsdCl9KTtyZXR1cm4gci5pbm5lckhUTUx9ZnVuY3Rpb24gZXIoKXt2YXIgZT1adCgpO3ZhciB0PUp0fHxsb2NhdGlvbi5wYXRobmFtZStsb2NhdGlvbi5zZWFyY2g7dmFyIHI7dHJ5e3I9cmUoKS5xdWVyeVNlbGVjdG9yKCdbaHgtaGlzdG9yeT1cImZhbHNlXCIgaV0sW2RhdGEtaHgtaGlzdG9yeT1cImZhbHNlXCIgaV0nKX1jYXRjaChlKXtyPXJlKCkucXVlcnlTZWxlY3RvcignW2h4LWhpc3Rvcnk9XCJmYWxzZVwiXSxbZGF0YS1oeC1oaXN0b3J5PVwiZmFsc2VcIl0nKX1pZighcil7Y2UocmUoKS5ib2R5LFwiaHRteDpiZWZvcmVIaXN0b3J5U2F2ZVwiLHtwYXRoOnQsaGlzdG9yeUVsdDplfSk7S3QodCxRdChlKSxyZSgpLnRpdGxlLHdpbmRvdy5zY3JvbGxZKX1pZihRLmNvbmZpZy5oaXN0b3J5RW5hYmxlZCloaXN0b3J5LnJlcGxhY2VTdGF0ZSh7aHRteDp0cnVlfSxyZSgpLnRpdGxlLHdpbmRvdy5sb2NhdGlvbi5ocmVmKX1mdW5jdGlvbiB0cihlKXtpZihRLmNvbmZpZy5nZXRDYWNoZUJ1c3RlclBhcmFtKXtlPWUucmVwbGFjZSgvb3JnXFwuaHRteFxcLmNhY2hlLWJ1c3Rlcj1bXiZdKiY/LyxcIlwiKTtpZihHKGUsXCImXCIpfHxHKGUsXCI/XCIpKXtlPWUuc2xpY2UoMCwtMSl9fWlmKFEuY29uZmlnLmhpc3RvcnlFbmFibGVkKXtoaXN0b3J5LnB1c2hTdGF0ZSh7aHRteDp0cnVlfSxcIlwiLGUpfUp0PWV9ZnVuY3Rpb24gcnIoZSl7aWYoUS5jb25maWcuaGlzdG9yeUVuYWJsZWQpaGlzdG9yeS5yZXBsYWNlU3RhdGUoe2h0bXg6dHJ1ZX0sXCJcIixlKTtKdD1lfWZ1bmN0aW9uIG5yKGUpe29lKGUsZnVuY3Rpb24oZSl7ZS5jYWxsKCl9KX1mdW5jdGlvbiBpcihhKXt2YXIgZT1uZXcgWE1MSHR0cFJlcXVlc3Q7dmFyIG89e3BhdGg6YSx4aHI6ZX07Y2UocmUoKS5ib2R5LFwiaHRteDpoaXN0b3J5Q2FjaGVNaXNzXCIsbyk7ZS5vcGVuKFwiR0VUXCIsYSx0cnVlKTtlLnNldFJlcXVlc3RIZWFkZXIoXCJIWC1SZXF1ZXN0XCIsXCJ0cnVlXCIpO2Uuc2V0UmVxdWVzdEhlYWRlcihcIkhYLUhpc3RvcnktUmVzdG9yZS1SZXF1ZXN0XCIsXCJ0cnVlXCIpO2Uuc2V0UmVxdWVzdEhlYWRlcihcIkhYLUN1cnJlbnQtVVJMXCIscmUoKS5sb2NhdGlvbi5ocmVmKTtlLm9ubG9hZD1mdW5jdGlvbigpe2lmKHRoaXMuc3RhdHVzPj0yMDAmJnRoaXMuc3RhdHVzPDQwMCl7Y2UocmUoKS5ib2R5LFwiaHRteDpoaXN0b3J5Q2FjaGVNaXNzTG9hZFwiLG8pO3ZhciBlPWwodGhpcy5yZXNwb25zZSk7ZT1lLnF1ZXJ5U2VsZWN0b3IoXCJbaHgtaGlzdG9yeS1lbHRdLFtkYXRhLWh4LWhpc3RvcnktZWx0XVwiKXx8ZTt2YXIgdD1adCgpO3ZhciByPVQodCk7dmFyIG49VmUodGhpcy5yZXNwb25zZSk7aWYobil7dmFyIGk9QyhcInRpdGxlXCIpO2lmKGkpe2kuaW5uZXJIVE1MPW59ZWxzZXt3aW5kb3cuZG9jdW1lbnQudGl0bGU9bn19VWUodCxlLHIpO25yKHIudGFza3MpO0p0PWE7Y2UocmUoKS5ib2R5LFwiaHRteDpoaXN0b3J5UmVzdG9yZVwiLHtwYXRoOmEsY2FjaGVNaXNzOnRydWUsc2VydmVyUmVzcG9uc2U6dGhpcy5yZXNwb25zZX0pfWVsc2V7ZmUocmUoKS5ib2R5LFwiaHRteDpoaXN0b3J5Q2FjaGVNaXNzTG9hZEVycm9yXCIsbyl9fTtlLnNlbmQoKX1mdW5jdGlvbiBhcihlKXtlcigpO2U9ZXx8bG9jYXRpb24ucGF0aG5hbWUrbG9jYXRpb24uc2VhcmNoO3ZhciB0PVl0KGUpO2lmKHQpe3ZhciByPWwodC5jb250ZW50KTt2YXIgbj1adCgpO3ZhciBpPVQobik7VWUobixyLGkpO25yKGkudGFza3MpO2RvY3VtZW50LnRpdGxlPXQudGl0bGU7c2V0VGltZW91dChmdW5jdGlvbigpe3dpbmRvdy5zY3JvbGxUbygwLHQuc2Nyb2xsKX0sMCk7SnQ9ZTtjZShyZSgpLmJvZHksXCJodG14Omhpc3RvcnlSZXN0b3JlXCIse3BhdGg6ZSxpdGVtOnR9KX1lbHNle2lmKFEuY29uZmlnLnJlZnJlc2hPbkhpc3RvcnlNaXNzKXt3aW5kb3cubG9jYXRpb24ucmVsb2FkKHRydWUpfWVsc2V7aXIoZSl9fX1mdW5jdGlvbiBvcihlKXt2YXIgdD1tZShlLFwiaHgtaW5kaWNhdG9yXCIpO2lmKHQ9PW51bGwpe3Q9W2VdfW9lKHQsZnVuY3Rpb24oZSl7dmFyIHQ9YWUoZSk7dC5yZXF1ZXN0Q291bnQ9KHQucmVxdWVzdENvdW50fHwwKSsxO2UuY2xhc3NMaXN0W1wiYWRkXCJdLmNhbGwoZS5jbGFzc0xpc3QsUS5jb25maWcucmVxdWVzdENsYXNzKX0pO3JldHVybiB0fWZ1bmN0aW9uIHNyKGUpe3ZhciB0PW1lKGUsXCJoeC1kaXNhYmxlZC1lbHRcIik7aWYodD09bnVsbCl7dD1bXX1vZSh0LGZ1bmN0aW9uKGUpe3ZhciB0PWFlKGUpO3QucmVxdWVzdENvdW50PSh0LnJlcXVlc3RDb3VudHx8MCkrMTtlLnNldEF0dHJpYnV0ZShcImRpc2FibGVkXCIsXCJcIil9KTtyZXR1cm4gdH1mdW5jdGlvbiBscihlLHQpe29lKGUsZnVuY3Rpb24oZSl7dmFyIHQ9YWUoZSk7dC5yZXF1ZXN0Q291bnQ9KHQucmVxdWVzdENvdW50fHwwKS0xO2lmKHQucmVxdWVzdENvdW50PT09MCl7ZS5jbGFzc0xpc3RbXCJyZW1vdmVcIl0uY2FsbChlLmNsYXNzTGlzdCxRLmNvbmZpZy5yZXF1ZXN0Q2xhc3MpfX0pO29lKHQsZnVuY3Rpb24oZSl7dmFyIHQ9YWUoZSk7dC5yZXF1ZXN0Q291bnQ9KHQucmVxdWVzdENvdW50fHwwKS0xO2lmKHQucmVxdWVzdENvdW50PT09MCl7ZS5yZW1vdmVBdHRyaWJ1dGUoXCJkaXNhYmxlZFwiKX19KX1mdW5jdGlvbiB1cihlLHQpe2Zvcih2YXIgcj0wO3I8ZS5sZW5ndGg7cisrKXt2YXIgbj1lW3JdO2lmKG4uaXNTYW1lTm9kZSh0KSl7cmV0dXJuIHRydWV9fXJldHVybiBmYWxzZX1mdW5jdGlvbiBmcihlKXtpZihlLm5hbWU9PT1cIlwifHxlLm5hbWU9PW51bGx8fGUuZGlzYWJsZWR8fHYoZSxcImZpZWxkc2V0W2Rpc2FibGVkXVwiKSl7cmV0dXJuIGZhbHNlfWlmKGUudHlwZT09PVwiYnV0dG9uXCJ8fGUudHlwZT09PVwic3VibWl0XCJ8fGUudGFnTmFtZT09PVwiaW1hZ2VcInx8ZS50YWdOYW1lPT09XCJyZXNldFwifHxlLnRhZ05hbWU9PT1cImZpbGVcIil7cmV0dXJuIGZhbHNlfWlmKGUudHlwZT09PVwiY2hlY2tib3hcInx8ZS50eXBlPT09XCJyYWRpb1wiKXtyZXR1cm4gZS5jaGVja2VkfXJldHVybiB0cnVlfWZ1bmN0aW9uIGNyKGUsdCxyKXtpZihlIT1udWxsJiZ0IT1udWxsKXt2YXIgbj1yW2VdO2lmKG49PT11bmRlZmluZWQpe3JbZV09dH1lbHNlIGlmKEFycmF5LmlzQXJyYXkobikpe2lmKEFycmF5LmlzQXJyYXkodCkpe3JbZV09bi5jb25jYXQodCl9ZWxzZXtuLnB1c2godCl9fWVsc2V7aWYoQXJyYXkuaXNBcnJheSh0KSl7cltlXT1bbl0uY29uY2F0KHQpfWVsc2V7cltlXT1bbix0XX19fX1mdW5jdGlvbiBocih0LHIsbixlLGkpe2lmKGU9PW51bGx8fHVyKHQsZSkpe3JldHVybn1lbHNle3QucHVzaChlKX1pZihmcihlKSl7dmFyIGE9ZWUoZSxcIm5hbWVcIik7dmFyIG89ZS52YWx1ZTtpZihlLm11bHRpcGxlJiZlLnRhZ05hbWU9PT1cIlNFTEVDVFwiKXtvPU0oZS5xdWVyeVNlbGVjdG9yQWxsKFwib3B0aW9uOmNoZWNrZWRcIikpLm1hcChmdW5jdGlvbihlKXtyZXR1cm4gZS52YWx1ZX0pfWlmKGUuZmlsZXMpe289TShlLmZpbGVzKX1jcihhLG8scik7aWYoaSl7dnIoZSxuKX19aWYoaChlLFwiZm9ybVwiKSl7dmFyIHM9ZS5lbGVtZW50cztvZShzLGZ1bmN0aW9uKGUpe2hyKHQscixuLGUsaSl9KX19ZnVuY3Rpb24gdnIoZSx0KXtpZihlLndpbGxWYWxpZGF0ZSl7Y2UoZSxcImh0bXg6dmFsaWRhdGlvbjp2YWxpZGF0ZVwiKTtpZighZS5jaGVja1ZhbGlkaXR5KCkpe3QucHVzaCh7ZWx0OmUsbWVzc2FnZTplLnZhbGlkYXRpb25NZXNzYWdlLHZhbGlkaXR5OmUudmFsaWRpdHl9KTtjZShlLFwiaHRteDp2YWxpZGF0aW9uOmZhaWxlZFwiLHttZXNzYWdlOmUudmFsaWRhdGlvbk1lc3NhZ2UsdmFsaWRpdHk6ZS52YWxpZGl0eX0pfX19ZnVuY3Rpb24gZHIoZSx0KXt2YXIgcj1bXTt2YXIgbj17fTt2YXIgaT17fTt2YXIgYT1bXTt2YXIgbz1hZShlKTtpZihvLmxhc3RCdXR0b25DbGlja2VkJiYhc2Uoby5sYXN0QnV0dG9uQ2xpY2tlZCkpe28ubGFzdEJ1dHRvbkNsaWNrZWQ9bnVsbH12YXIgcz1oKGUsXCJmb3JtXCIpJiZlLm5vVmFsaWRhdGUhPT10cnVlfHx0ZShlLFwiaHgtdmFsaWRhdGVcIik9PT1cInRydWVcIjtpZihvLmxhc3RCdXR0b25DbGlja2VkKXtzPXMmJm8ubGFzdEJ1dHRvbkNsaWNrZWQuZm9ybU5vVmFsaWRhdGUhPT10cnVlfWlmKHQhPT1cImdldFwiKXtocihyLGksYSx2KGUsXCJmb3JtXCIpLHMpfWhyKHIsbixhLGUscyk7aWYoby5sYXN0QnV0dG9uQ2xpY2tlZHx8ZS50YWdOYW1lPT09XCJCVVRUT05cInx8ZS50YWdOYW1lPT09XCJJTlBVVFwiJiZlZShlLFwidHlwZVwiKT09PVwic3VibWl0XCIpe3ZhciBsPW8ubGFzdEJ1dHRvbkNsaWNrZWR8fGU7dmFyIHU9ZWUobCxcIm5hbWVcIik7Y3IodSxsLnZhbHVlLGkpfXZhciBmPW1lKGUsXCJoeC1pbmNsdWRlXCIpO29lKGYsZnVuY3Rpb24oZSl7aHIocixuLGEsZSxzKTtpZighaChlLFwiZm9ybVwiKSl7b2UoZS5xdWVyeVNlbGVjdG9yQWxsKHJ0KSxmdW5jdGlvbihlKXtocihyLG4sYSxlLHMpfSl9fSk7bj1sZShuLGkpO3JldHVybntlcnJvcnM6YSx2YWx1ZXM6bn19ZnVuY3Rpb24gZ3IoZSx0LHIpe2lmKGUhPT1cIlwiKXtlKz1cIiZcIn1pZihTdHJpbmcocik9PT1cIltvYmplY3QgT2JqZWN0XVwiKXtyPUpTT04uc3RyaW5naWZ5KHIpfXZhciBuPWVuY29kZVVSSUNvbXBvbmVudChyKTtlKz1lbmNvZGVVUklDb21wb25lbnQodCkrXCI9XCIrbjtyZXR1cm4gZX1mdW5jdGlvbiBwcihlKXt2YXIgdD1cIlwiO2Zvcih2YXIgciBpbiBlKXtpZihlLmhhc093blByb3BlcnR5KHIpKXt2YXIgbj1lW3JdO2lmKEFycmF5LmlzQXJyYXkobikpe29lKG4sZnVuY3Rpb24oZSl7dD1ncih0LHIsZSl9KX1lbHNle3Q9Z3IodCxyLG4pfX19cmV0dXJuIHR9ZnVuY3Rpb24gbXIoZSl7dmFyIHQ9bmV3IEZvcm1EYXRhO2Zvcih2YXIgciBpbiBlKXtpZihlLmhhc093blByb3BlcnR5KHIpKXt2YXIgbj1lW3JdO2lmKEFycmF5LmlzQXJyYXkobikpe29lKG4sZnVuY3Rpb24oZSl7dC5hcHBlbmQocixlKX0pfWVsc2V7dC5hcHBlbmQocixuKX19fXJldHVybiB0fWZ1bmN0aW9uIHhyKGUsdCxyKXt2YXIgbj17XCJIWC1SZXF1ZXN0XCI6XCJ0cnVlXCIsXCJIWC1UcmlnZ2VyXCI6ZWUoZSxcImlkXCIpLFwiSFgtVHJpZ2dlci1OYW1lXCI6ZWUoZSxcIm5hbWVcIiksXCJIWC1UYXJnZXRcIjp0ZSh0LFwiaWRcIiksXCJIWC1DdXJyZW50LVVSTFwiOnJlKCkubG9jYXRpb24uaHJlZn07UnIoZSxcImh4LWhlYWRlcnNcIixmYWxzZSxuKTtpZihyIT09dW5kZWZpbmVkKXtuW1wiSFgtUHJvbXB0XCJdPXJ9aWYoYWUoZSkuYm9vc3RlZCl7bltcIkhYLUJvb3N0ZWRcIl09XCJ0cnVlXCJ9cmV0dXJuIG59ZnVuY3Rpb24geXIodCxlKXt2YXIgcj1uZShlLFwiaHgtcGFyYW1zXCIpO2lmKHIpe2lmKHI9PT1cIm5vbmVcIil7cmV0dXJue319ZWxzZSBpZihyPT09XCIqXCIpe3JldHVybiB0fWVsc2UgaWYoci5pbmRleE9mKFwibm90IFwiKT09PTApe29lKHIuc3Vic3RyKDQpLnNwbGl0KFwiLFwiKSxmdW5jdGlvbihlKXtlPWUudHJpbSgpO2RlbGV0ZSB0W2VdfSk7cmV0dXJuIHR9ZWxzZXt2YXIgbj17fTtvZShyLnNwbGl0KFwiLFwiKSxmdW5jdGlvbihlKXtlPWUudHJpbSgpO25bZV09dFtlXX0pO3JldHVybiBufX1lbHNle3JldHVybiB0fX1mdW5jdGlvbiBicihlKXtyZXR1cm4gZWUoZSxcImhyZWZcIikmJmVlKGUsXCJocmVmXCIpLmluZGV4T2YoXCIjXCIpPj0wfWZ1bmN0aW9uIHdyKGUsdCl7dmFyIHI9dD90Om5lKGUsXCJoeC1zd2FwXCIpO3ZhciBuPXtzd2FwU3R5bGU6YWUoZSkuYm9vc3RlZD9cImlubmVySFRNTFwiOlEuY29uZmlnLmRlZmF1bHRTd2FwU3R5bGUsc3dhcERlbGF5OlEuY29uZmlnLmRlZmF1bHRTd2FwRGVsYXksc2V0dGxlRGVsYXk6US5jb25maWcuZGVmYXVsdFNldHRsZURlbGF5fTtpZihRLmNvbmZpZy5zY3JvbGxJbnRvVmlld09uQm9vc3QmJmFlKGUpLmJvb3N0ZWQmJiFicihlKSl7bltcInNob3dcIl09XCJ0b3BcIn1pZihyKXt2YXIgaT1EKHIpO2lmKGkubGVuZ3RoPjApe2Zvcih2YXIgYT0wO2E8aS5sZW5ndGg7YSsrKXt2YXIgbz1pW2FdO2lmKG8uaW5kZXhPZihcInN3YXA6XCIpPT09MCl7bltcInN3YXBEZWxheVwiXT1kKG8uc3Vic3RyKDUpKX1lbHNlIGlmKG8uaW5kZXhPZihcInNldHRsZTpcIik9PT0wKXtuW1wic2V0dGxlRGVsYXlcIl09ZChvLnN1YnN0cig3KSl9ZWxzZSBpZihvLmluZGV4T2YoXCJ0cmFuc2l0aW9uOlwiKT09PTApe25bXCJ0cmFuc2l0aW9uXCJdPW8uc3Vic3RyKDExKT09PVwidHJ1ZVwifWVsc2UgaWYoby5pbmRleE9mKFwiaWdub3JlVGl0bGU6XCIpPT09MCl7bltcImlnbm9yZVRpdGxlXCJdPW8uc3Vic3RyKDEyKT09PVwidHJ1ZVwifWVsc2UgaWYoby5pbmRleE9mKFwic2Nyb2xsOlwiKT09PTApe3ZhciBzPW8uc3Vic3RyKDcpO3ZhciBsPXMuc3BsaXQoXCI6XCIpO3ZhciB1PWwucG9wKCk7dmFyIGY9bC5sZW5ndGg+MD9sLmpvaW4oXCI6XCIpOm51bGw7bltcInNjcm9sbFwiXT11O25bXCJzY3JvbGxUYXJnZXRcIl09Zn1lbHNlIGlmKG8uaW5kZXhPZihcInNob3c6XCIpPT09MCl7dmFyIGM9by5zdWJzdHIoNSk7dmFyIGw9Yy5zcGxpdChcIjpcIik7dmFyIGg9bC5wb3AoKTt2YXIgZj1sLmxlbmd0aD4wP2wuam9pbihcIjpcIik6bnVsbDtuW1wic2hvd1wiXT1oO25bXCJzaG93VGFyZ2V0XCJdPWZ9ZWxzZSBpZihvLmluZGV4T2YoXCJmb2N1cy1zY3JvbGw6XCIpPT09MCl7dmFyIHY9by5zdWJzdHIoXCJmb2N1cy1zY3JvbGw6XCIubGVuZ3RoKTtuW1wiZm9jdXNTY3JvbGxcIl09dj09XCJ0cnVlXCJ9ZWxzZSBpZihhPT0wKXtuW1wic3dhcFN0eWxlXCJdPW99ZWxzZXtiKFwiVW5rbm93biBtb2RpZmllciBpbiBoeC1zd2FwOiBcIitvKX19fX1yZXR1cm4gbn1mdW5jdGlvbiBTcihlKXtyZXR1cm4gbmUoZSxcImh4LWVuY29kaW5nXCIpPT09XCJtdWx0aXBhcnQvZm9ybS1kYXRhXCJ8fGgoZSxcImZvcm1cIikmJmVlKGUsXCJlbmN0eXBlXCIpPT09XCJtdWx0aXBhcnQvZm9ybS1kYXRhXCJ9ZnVuY3Rpb24gRXIodCxyLG4pe3ZhciBpPW51bGw7UihyLGZ1bmN0aW9uKGUpe2lmKGk9PW51bGwpe2k9ZS5lbmNvZGVQYXJhbWV0ZXJzKHQsbixyKX19KTtpZihpIT1udWxsKXtyZXR1cm4gaX1lbHNle2lmKFNyKHIpKXtyZXR1cm4gbXIobil9ZWxzZXtyZXR1cm4gcHIobil9fX1mdW5jdGlvbiBUKGUpe3JldHVybnt0YXNrczpbXSxlbHRzOltlXX19ZnVuY3Rpb24gQ3IoZSx0KXt2YXIgcj1lWzBdO3ZhciBuPWVbZS5sZW5ndGgtMV07aWYodC5zY3JvbGwpe3ZhciBpPW51bGw7aWYodC5zY3JvbGxUYXJnZXQpe2k9dWUocix0LnNjcm9sbFRhcmdldCl9aWYodC5zY3JvbGw9PT1cInRvcFwiJiYocnx8aSkpe2k9aXx8cjtpLnNjcm9sbFRvcD0wfWlmKHQuc2Nyb2xsPT09XCJib3R0b21cIiYmKG58fGkpKXtpPWl8fG47aS5zY3JvbGxUb3A9aS5zY3JvbGxIZWlnaHR9fWlmKHQuc2hvdyl7dmFyIGk9bnVsbDtpZih0LnNob3dUYXJnZXQpe3ZhciBhPXQuc2hvd1RhcmdldDtpZih0LnNob3dUYXJnZXQ9PT1cIndpbmRvd1wiKXthPVwiYm9keVwifWk9dWUocixhKX1pZih0LnNob3c9PT1cInRvcFwiJiYocnx8aSkpe2k9aXx8cjtpLnNjcm9sbEludG9WaWV3KHtibG9jazpcInN0YXJ0XCIsYmVoYXZpb3I6US5jb25maWcuc2Nyb2xsQmVoYXZpb3J9KX1pZih0LnNob3c9PT1cImJvdHRvbVwiJiYobnx8aSkpe2k9aXx8bjtpLnNjcm9sbEludG9WaWV3KHtibG9jazpcImVuZFwiLGJlaGF2aW9yOlEuY29uZmlnLnNjcm9sbEJlaGF2aW9yfSl9fX1mdW5jdGlvbiBScihlLHQscixuKXtpZihuPT1udWxsKXtuPXt9fWlmKGU9PW51bGwpe3JldHVybiBufXZhciBpPXRlKGUsdCk7aWYoaSl7dmFyIGE9aS50cmltKCk7dmFyIG89cjtpZihhPT09XCJ1bnNldFwiKXtyZXR1cm4gbnVsbH1pZihhLmluZGV4T2YoXCJqYXZhc2NyaXB0OlwiKT09PTApe2E9YS5zdWJzdHIoMTEpO289dHJ1ZX1lbHNlIGlmKGEuaW5kZXhPZihcImpzOlwiKT09PTApe2E9YS5zdWJzdHIoMyk7bz10cnVlfWlmKGEuaW5kZXhPZihcIntcIikhPT0wKXthPVwie1wiK2ErXCJ9XCJ9dmFyIHM7aWYobyl7cz1UcihlLGZ1bmN0aW9uKCl7cmV0dXJuIEZ1bmN0aW9uKFwicmV0dXJuIChcIithK1wiKVwiKSgpfSx7fSl9ZWxzZXtzPUUoYSl9Zm9yKHZhciBsIGluIHMpe2lmKHMuaGFzT3duUHJvcGVydHkobCkpe2lmKG5bbF09PW51bGwpe25bbF09c1tsXX19fX1yZXR1cm4gUnIodShlKSx0LHIsbil9ZnVuY3Rpb24gVHIoZSx0LHIpe2lmKFEuY29uZmlnLmFsbG93RXZhbCl7cmV0dXJuIHQoKX1lbHNle2ZlKGUsXCJodG14OmV2YWxEaXNhbGxvd2VkRXJyb3JcIik7cmV0dXJuIHJ9fWZ1bmN0aW9uIE9yKGUsdCl7cmV0dXJuIFJyKGUsXCJoeC12YXJzXCIsdHJ1ZSx0KX1mdW5jdGlvbiBxcihlLHQpe3JldHVybiBScihlLFwiaHgtdmFsc1wiLGZhbHNlLHQpfWZ1bmN0aW9uIEhyKGUpe3JldHVybiBsZShPcihlKSxxcihlKSl9ZnVuY3Rpb24gTHIodCxyLG4pe2lmKG4hPT1udWxsKXt0cnl7dC5zZXRSZXF1ZXN0SGVhZGVyKHIsbil9Y2F0Y2goZSl7dC5zZXRSZXF1ZXN0SGVhZGVyKHIsZW5jb2RlVVJJQ29tcG9uZW50KG4pKTt0LnNldFJlcXVlc3RIZWFkZXIocitcIi1VUkktQXV0b0VuY29kZWRcIixcInRydWVcIil9fX1mdW5jdGlvbiBBcih0KXtpZih0LnJlc3BvbnNlVVJMJiZ0eXBlb2YgVVJMIT09XCJ1bmRlZmluZWRcIil7dHJ5e3ZhciBlPW5ldyBVUkwodC5yZXNwb25zZVVSTCk7cmV0dXJuIGUucGF0aG5hbWUrZS5zZWFyY2h9Y2F0Y2goZSl7ZmUocmUoKS5ib2R5LFwiaHRteDpiYWRSZXNwb25zZVVybFwiLHt1cmw6dC5yZXNwb25zZVVSTH0pfX19ZnVuY3Rpb24gTyhlLHQpe3JldHVybiB0LnRlc3QoZS5nZXRBbGxSZXNwb25zZUhlYWRlcnMoKSl9ZnVuY3Rpb24gTnIoZSx0LHIpe2U9ZS50b0xvd2VyQ2FzZSgpO2lmKHIpe2lmKHIgaW5zdGFuY2VvZiBFbGVtZW50fHxJKHIsXCJTdHJpbmdcIikpe3JldHVybiBoZShlLHQsbnVsbCxudWxsLHt0YXJnZXRPdmVycmlkZTpwKHIpLHJldHVyblByb21pc2U6dHJ1ZX0pfWVsc2V7cmV0dXJuIGhlKGUsdCxwKHIuc291cmNlKSxyLmV2ZW50LHtoYW5kbGVyOnIuaGFuZGxlcixoZWFkZXJzOnIuaGVhZGVycyx2YWx1ZXM6ci52YWx1ZXMsdGFyZ2V0T3ZlcnJpZGU6cChyLnRhcmdldCksc3dhcE92ZXJyaWRlOnIuc3dhcCxzZWxlY3Q6ci5zZWxlY3QscmV0dXJuUHJvbWlzZTp0cnVlfSl9fWVsc2V7cmV0dXJuIGhlKGUsdCxudWxsLG51bGwse3JldHVyblByb21pc2U6dHJ1ZX0pfX1mdW5jdGlvbiBJcihlKXt2YXIgdD1bXTt3aGlsZShlKXt0LnB1c2goZSk7ZT1lLnBhcmVudEVsZW1lbnR9cmV0dXJuIHR9ZnVuY3Rpb24ga3IoZSx0LHIpe3ZhciBuO3ZhciBpO2lmKHR5cGVvZiBVUkw9PT1cImZ1bmN0aW9uXCIpe2k9bmV3IFVSTCh0LGRvY3VtZW50LmxvY2F0aW9uLmhyZWYpO3ZhciBhPWRvY3VtZW50LmxvY2F0aW9uLm9yaWdpbjtuPWE9PT1pLm9yaWdpbn1lbHNle2k9dDtuPWcodCxkb2N1bWVudC5sb2NhdGlvbi5vcmlnaW4pfWlmKFEuY29uZmlnLnNlbGZSZXF1ZXN0c09ubHkpe2lmKCFuKXtyZXR1cm4gZmFsc2V9fXJldHVybiBjZShlLFwiaHRteDp2YWxpZGF0ZVVybFwiLGxlKHt1cmw6aSxzYW1lSG9zdDpufSxyKSl9ZnVuY3Rpb24gaGUodCxyLG4saSxhLGUpe3ZhciBvPW51bGw7dmFyIHM9bnVsbDthPWEhPW51bGw/YTp7fTtpZihhLnJldHVyblByb21pc2UmJnR5cGVvZiBQcm9taXNlIT09XCJ1bmRlZmluZWRcIil7dmFyIGw9bmV3IFByb21pc2UoZnVuY3Rpb24oZSx0KXtvPWU7cz10fSl9aWYobj09bnVsbCl7bj1yZSgpLmJvZHl9dmFyIE09YS5oYW5kbGVyfHxNcjt2YXIgWD1hLnNlbGVjdHx8bnVsbDtpZighc2Uobikpe2llKG8pO3JldHVybiBsfXZhciB1PWEudGFyZ2V0T3ZlcnJpZGV8fHllKG4pO2lmKHU9PW51bGx8fHU9PXBlKXtmZShuLFwiaHRteDp0YXJnZXRFcnJvclwiLHt0YXJnZXQ6dGUobixcImh4LXRhcmdldFwiKX0pO2llKHMpO3JldHVybiBsfXZhciBmPWFlKG4pO3ZhciBjPWYubGFzdEJ1dHRvbkNsaWNrZWQ7aWYoYyl7dmFyIGg9ZWUoYyxcImZvcm1hY3Rpb25cIik7aWYoaCE9bnVsbCl7cj1ofXZhciB2PWVlKGMsXCJmb3JtbWV0aG9kXCIpO2lmKHYhPW51bGwpe2lmKHYudG9Mb3dlckNhc2UoKSE9PVwiZGlhbG9nXCIpe3Q9dn19fXZhciBkPW5lKG4sXCJoeC1jb25maXJtXCIpO2lmKGU9PT11bmRlZmluZWQpe3ZhciBEPWZ1bmN0aW9uKGUpe3JldHVybiBoZSh0LHIsbixpLGEsISFlKX07dmFyIFU9e3RhcmdldDp1LGVsdDpuLHBhdGg6cix2ZXJiOnQsdHJpZ2dlcmluZ0V2ZW50OmksZXRjOmEsaXNzdWVSZXF1ZXN0OkQscXVlc3Rpb246ZH07aWYoY2UobixcImh0bXg6Y29uZmlybVwiLFUpPT09ZmFsc2Upe2llKG8pO3JldHVybiBsfX12YXIgZz1uO3ZhciBwPW5lKG4sXCJoeC1zeW5jXCIpO3ZhciBtPW51bGw7dmFyIHg9ZmFsc2U7aWYocCl7dmFyIEY9cC5zcGxpdChcIjpcIik7dmFyIEI9RlswXS50cmltKCk7aWYoQj09PVwidGhpc1wiKXtnPXhlKG4sXCJoeC1zeW5jXCIpfWVsc2V7Zz11ZShuLEIpfXA9KEZbMV18fFwiZHJvcFwiKS50cmltKCk7Zj1hZShnKTtpZihwPT09XCJkcm9wXCImJmYueGhyJiZmLmFib3J0YWJsZSE9PXRydWUpe2llKG8pO3JldHVybiBsfWVsc2UgaWYocD09PVwiYWJvcnRcIil7aWYoZi54aHIpe2llKG8pO3JldHVybiBsfWVsc2V7eD10cnVlfX1lbHNlIGlmKHA9PT1cInJlcGxhY2VcIil7Y2UoZyxcImh0bXg6YWJvcnRcIil9ZWxzZSBpZihwLmluZGV4T2YoXCJxdWV1ZVwiKT09PTApe3ZhciBWPXAuc3BsaXQoXCIgXCIpO209KFZbMV18fFwibGFzdFwiKS50cmltKCl9fWlmKGYueGhyKXtpZihmLmFib3J0YWJsZSl7Y2UoZyxcImh0bXg6YWJvcnRcIil9ZWxzZXtpZihtPT1udWxsKXtpZihpKXt2YXIgeT1hZShpKTtpZih5JiZ5LnRyaWdnZXJTcGVjJiZ5LnRyaWdnZXJTcGVjLnF1ZXVlKXttPXkudHJpZ2dlclNwZWMucXVldWV9fWlmKG09PW51bGwpe209XCJsYXN0XCJ9fWlmKGYucXVldWVkUmVxdWVzdHM9PW51bGwpe2YucXVldWVkUmVxdWVzdHM9W119aWYobT09PVwiZmlyc3RcIiYmZi5xdWV1ZWRSZXF1ZXN0cy5sZW5ndGg9PT0wKXtmLnF1ZXVlZFJlcXVlc3RzLnB1c2goZnVuY3Rpb24oKXtoZSh0LHIsbixpLGEpfSl9ZWxzZSBpZihtPT09XCJhbGxcIil7Zi5xdWV1ZWRSZXF1ZXN0cy5wdXNoKGZ1bmN0aW9uKCl7aGUodCxyLG4saSxhKX0pfWVsc2UgaWYobT09PVwibGFzdFwiKXtmLnF1ZXVlZFJlcXVlc3RzPVtdO2YucXVldWVkUmVxdWVzdHMucHVzaChmdW5jdGlvbigpe2hlKHQscixuLGksYSl9KX1pZShvKTtyZXR1cm4gbH19dmFyIGI9bmV3IFhNTEh0dHBSZXF1ZXN0O2YueGhyPWI7Zi5hYm9ydGFibGU9eDt2YXIgdz1mdW5jdGlvbigpe2YueGhyPW51bGw7Zi5hYm9ydGFibGU9ZmFsc2U7aWYoZi5xdWV1ZWRSZXF1ZXN0cyE9bnVsbCYmZi5xdWV1ZWRSZXF1ZXN0cy5sZW5ndGg+MCl7dmFyIGU9Zi5xdWV1ZWRSZXF1ZXN0cy5zaGlmdCgpO2UoKX19O3ZhciBqPW5lKG4sXCJoeC1wcm9tcHRcIik7aWYoail7dmFyIFM9cHJvbXB0KGopO2lmKFM9PT1udWxsfHwhY2UobixcImh0bXg6cHJvbXB0XCIse3Byb21wdDpTLHRhcmdldDp1fSkpe2llKG8pO3coKTtyZXR1cm4gbH19aWYoZCYmIWUpe2lmKCFjb25maXJtKGQpKXtpZShvKTt3KCk7cmV0dXJuIGx9fXZhciBFPXhyKG4sdSxTKTtpZih0IT09XCJnZXRcIiYmIVNyKG4pKXtFW1wiQ29udGVudC1UeXBlXCJdPVwiYXBwbGljYXRpb24veC13d3ctZm9ybS11cmxlbmNvZGVkXCJ9aWYoYS5oZWFkZXJzKXtFPWxlKEUsYS5oZWFkZXJzKX12YXIgXz1kcihuLHQpO3ZhciBDPV8uZXJyb3JzO3ZhciBSPV8udmFsdWVzO2lmKGEudmFsdWVzKXtSPWxlKFIsYS52YWx1ZXMpfXZhciB6PUhyKG4pO3ZhciAkPWxlKFIseik7dmFyIFQ9eXIoJCxuKTtpZihRLmNvbmZpZy5nZXRDYWNoZUJ1c3RlclBhcmFtJiZ0PT09XCJnZXRcIil7VFtcIm9yZy5odG14LmNhY2hlLWJ1c3RlclwiXT1lZSh1LFwiaWRcIil8fFwidHJ1ZVwifWlmKHI9PW51bGx8fHI9PT1cIlwiKXtyPXJlKCkubG9jYXRpb24uaHJlZn12YXIgTz1ScihuLFwiaHgtcmVxdWVzdFwiKTt2YXIgVz1hZShuKS5ib29zdGVkO3ZhciBxPVEuY29uZmlnLm1ldGhvZHNUaGF0VXNlVXJsUGFyYW1zLmluZGV4T2YodCk+PTA7dmFyIEg9e2Jvb3N0ZWQ6Vyx1c2VVcmxQYXJhbXM6cSxwYXJhbWV0ZXJzOlQsdW5maWx0ZXJlZFBhcmFtZXRlcnM6JCxoZWFkZXJzOkUsdGFyZ2V0OnUsdmVyYjp0LGVycm9yczpDLHdpdGhDcmVkZW50aWFsczphLmNyZWRlbnRpYWxzfHxPLmNyZWRlbnRpYWxzfHxRLmNvbmZpZy53aXRoQ3JlZGVudGlhbHMsdGltZW91dDphLnRpbWVvdXR8fE8udGltZW91dHx8US5jb25maWcudGltZW91dCxwYXRoOnIsdHJpZ2dlcmluZ0V2ZW50Oml9O2lmKCFjZShuLFwiaHRteDpjb25maWdSZXF1ZXN0XCIsSCkpe2llKG8pO3coKTtyZXR1cm4gbH1yPUgucGF0aDt0PUgudmVyYjtFPUguaGVhZGVycztUPUgucGFyYW1ldGVycztDPUguZXJyb3JzO3E9SC51c2VVcmxQYXJhbXM7aWYoQyYmQy5sZW5ndGg+MCl7Y2UobixcImh0bXg6dmFsaWRhdGlvbjpoYWx0ZWRcIixIKTtpZShvKTt3KCk7cmV0dXJuIGx9dmFyIEc9ci5zcGxpdChcIiNcIik7dmFyIEo9R1swXTt2YXIgTD1HWzFdO3ZhciBBPXI7aWYocSl7QT1KO3ZhciBaPU9iamVjdC5rZXlzKFQpLmxlbmd0aCE9PTA7aWYoWil7aWYoQS5pbmRleE9mKFwiP1wiKTwwKXtBKz1cIj9cIn1lbHNle0ErPVwiJlwifUErPXByKFQpO2lmKEwpe0ErPVwiI1wiK0x9fX1pZigha3IobixBLEgpKXtmZShuLFwiaHRteDppbnZhbGlkUGF0aFwiLEgpO2llKHMpO3JldHVybiBsfWIub3Blbih0LnRvVXBwZXJDYXNlKCksQSx0cnVlKTtiLm92ZXJyaWRlTWltZVR5cGUoXCJ0ZXh0L2h0bWxcIik7Yi53aXRoQ3JlZGVudGlhbHM9SC53aXRoQ3JlZGVudGlhbHM7Yi50aW1lb3V0PUgudGltZW91dDtpZihPLm5vSGVhZGVycyl7fWVsc2V7Zm9yKHZhciBOIGluIEUpe2lmKEUuaGFzT3duUHJvcGVydHkoTikpe3ZhciBLPUVbTl07THIoYixOLEspfX19dmFyIEk9e3hocjpiLHRhcmdldDp1LHJlcXVlc3RDb25maWc6SCxldGM6YSxib29zdGVkOlcsc2VsZWN0OlgscGF0aEluZm86e3JlcXVlc3RQYXRoOnIsZmluYWxSZXF1ZXN0UGF0aDpBLGFuY2hvcjpMfX07Yi5vbmxvYWQ9ZnVuY3Rpb24oKXt0cnl7dmFyIGU9SXIobik7SS5wYXRoSW5mby5yZXNwb25zZVBhdGg9QXIoYik7TShuLEkpO2xyKGssUCk7Y2UobixcImh0bXg6YWZ0ZXJSZXF1ZXN0XCIsSSk7Y2UobixcImh0bXg6YWZ0ZXJPbkxvYWRcIixJKTtpZighc2Uobikpe3ZhciB0PW51bGw7d2hpbGUoZS5sZW5ndGg+MCYmdD09bnVsbCl7dmFyIHI9ZS5zaGlmdCgpO2lmKHNlKHIpKXt0PXJ9fWlmKHQpe2NlKHQsXCJodG14OmFmdGVyUmVxdWVzdFwiLEkpO2NlKHQsXCJodG14OmFmdGVyT25Mb2FkXCIsSSl9fWllKG8pO3coKX1jYXRjaChlKXtmZShuLFwiaHRteDpvbkxvYWRFcnJvclwiLGxlKHtlcnJvcjplfSxJKSk7dGhyb3cgZX19O2Iub25lcnJvcj1mdW5jdGlvbigpe2xyKGssUCk7ZmUobixcImh0bXg6YWZ0ZXJSZXF1ZXN0XCIsSSk7ZmUobixcImh0bXg6c2VuZEVycm9yXCIsSSk7aWUocyk7dygpfTtiLm9uYWJvcnQ9ZnVuY3Rpb24oKXtscihrLFApO2ZlKG4sXCJodG14OmFmdGVyUmVxdWVzdFwiLEkpO2ZlKG4sXCJodG14OnNlbmRBYm9ydFwiLEkpO2llKHMpO3coKX07Yi5vbnRpbWVvdXQ9ZnVuY3Rpb24oKXtscihrLFApO2ZlKG4sXCJodG14OmFmdGVyUmVxdWVzdFwiLEkpO2ZlKG4sXCJodG14OnRpbWVvdXRcIixJKTtpZShzKTt3KCl9O2lmKCFjZShuLFwiaHRteDpiZWZvcmVSZXF1ZXN0XCIsSSkpe2llKG8pO3coKTtyZXR1cm4gbH12YXIgaz1vcihuKTt2YXIgUD1zcihuKTtvZShbXCJsb2Fkc3RhcnRcIixcImxvYWRlbmRcIixcInByb2dyZXNzXCIsXCJhYm9ydFwiXSxmdW5jdGlvbih0KXtvZShbYixiLnVwbG9hZF0sZnVuY3Rpb24oZSl7ZS5hZGRFdmVudExpc3RlbmVyKHQsZnVuY3Rpb24oZSl7Y2UobixcImh0bXg6eGhyOlwiK3Qse2xlbmd0aENvbXB1dGFibGU6ZS5sZW5ndGhDb21wdXRhYmxlLGxvYWRlZDplLmxvYWRlZCx0b3RhbDplLnRvdGFsfSl9KX0pfSk7Y2UobixcImh0bXg6YmVmb3JlU2VuZFwiLEkpO3ZhciBZPXE/bnVsbDpFcihiLG4sVCk7Yi5zZW5kKFkpO3JldHVybiBsfWZ1bmN0aW9uIFByKGUsdCl7dmFyIHI9dC54aHI7dmFyIG49bnVsbDt2YXIgaT1udWxsO2lmKE8ociwvSFgtUHVzaDovaSkpe249ci5nZXRSZXNwb25zZUhlYWRlcihcIkhYLVB1c2hcIik7aT1cInB1c2hcIn1lbHNlIGlmKE8ociwvSFgtUHVzaC1Vcmw6L2kpKXtuPXIuZ2V0UmVzcG9uc2VIZWFkZXIoXCJIWC1QdXNoLVVybFwiKTtpPVwicHVzaFwifWVsc2UgaWYoTyhyLC9IWC1SZXBsYWNlLVVybDovaSkpe249ci5nZXRSZXNwb25zZUhlYWRlcihcIkhYLVJlcGxhY2UtVXJsXCIpO2k9XCJyZXBsYWNlXCJ9aWYobil7aWYobj09PVwiZmFsc2VcIil7cmV0dXJue319ZWxzZXtyZXR1cm57dHlwZTppLHBhdGg6bn19fXZhciBhPXQucGF0aEluZm8uZmluYWxSZXF1ZXN0UGF0aDt2YXIgbz10LnBhdGhJbmZvLnJlc3BvbnNlUGF0aDt2YXIgcz1uZShlLFwiaHgtcHVzaC11cmxcIik7dmFyIGw9bmUoZSxcImh4LXJlcGxhY2UtdXJsXCIpO3ZhciB1PWFlKGUpLmJvb3N0ZWQ7dmFyIGY9bnVsbDt2YXIgYz1udWxsO2lmKHMpe2Y9XCJwdXNoXCI7Yz1zfWVsc2UgaWYobCl7Zj1cInJlcGxhY2VcIjtjPWx9ZWxzZSBpZih1KXtmPVwicHVzaFwiO2M9b3x8YX1pZihjKXtpZihjPT09XCJmYWxzZVwiKXtyZXR1cm57fX1pZihjPT09XCJ0cnVlXCIpe2M9b3x8YX1pZih0LnBhdGhJbmZvLmFuY2hvciYmYy5pbmRleE9mKFwiI1wiKT09PS0xKXtjPWMrXCIjXCIrdC5wYXRoSW5mby5hbmNob3J9cmV0dXJue3R5cGU6ZixwYXRoOmN9fWVsc2V7cmV0dXJue319fWZ1bmN0aW9uIE1yKGwsdSl7dmFyIGY9dS54aHI7dmFyIGM9dS50YXJnZXQ7dmFyIGU9dS5ldGM7dmFyIHQ9dS5yZXF1ZXN0Q29uZmlnO3ZhciBoPXUuc2VsZWN0O2lmKCFjZShsLFwiaHRteDpiZWZvcmVPbkxvYWRcIix1KSlyZXR1cm47aWYoTyhmLC9IWC1UcmlnZ2VyOi9pKSl7X2UoZixcIkhYLVRyaWdnZXJcIixsKX1pZihPKGYsL0hYLUxvY2F0aW9uOi9pKSl7ZXIoKTt2YXIgcj1mLmdldFJlc3BvbnNlSGVhZGVyKFwiSFgtTG9jYXRpb25cIik7dmFyIHY7aWYoci5pbmRleE9mKFwie1wiKT09PTApe3Y9RShyKTtyPXZbXCJwYXRoXCJdO2RlbGV0ZSB2W1wicGF0aFwiXX1OcihcIkdFVFwiLHIsdikudGhlbihmdW5jdGlvbigpe3RyKHIpfSk7cmV0dXJufXZhciBuPU8oZiwvSFgtUmVmcmVzaDovaSkmJlwidHJ1ZVwiPT09Zi5nZXRSZXNwb25zZUhlYWRlcihcIkhYLVJlZnJlc2hcIik7aWYoTyhmLC9IWC1SZWRpcmVjdDovaSkpe2xvY2F0aW9uLmhyZWY9Zi5nZXRSZXNwb25zZUhlYWRlcihcIkhYLVJlZGlyZWN0XCIpO24mJmxvY2F0aW9uLnJlbG9hZCgpO3JldHVybn1pZihuKXtsb2NhdGlvbi5yZWxvYWQoKTtyZXR1cm59aWYoTyhmLC9IWC1SZXRhcmdldDovaSkpe2lmKGYuZ2V0UmVzcG9uc2VIZWFkZXIoXCJIWC1SZXRhcmdldFwiKT09PVwidGhpc1wiKXt1LnRhcmdldD1sfWVsc2V7dS50YXJnZXQ9dWUobCxmLmdldFJlc3BvbnNlSGVhZGVyKFwiSFgtUmV0YXJnZXRcIikpfX12YXIgZD1QcihsLHUpO3ZhciBpPWYuc3RhdHVzPj0yMDAmJmYuc3RhdHVzPDQwMCYmZi5zdGF0dXMhPT0yMDQ7dmFyIGc9Zi5yZXNwb25zZTt2YXIgYT1mLnN0YXR1cz49NDAwO3ZhciBwPVEuY29uZmlnLmlnbm9yZVRpdGxlO3ZhciBvPWxlKHtzaG91bGRTd2FwOmksc2VydmVyUmVzcG9uc2U6Zyxpc0Vycm9yOmEsaWdub3JlVGl0bGU6cH0sdSk7aWYoIWNlKGMsXCJodG14OmJlZm9yZVN3YXBcIixvKSlyZXR1cm47Yz1vLnRhcmdldDtnPW8uc2VydmVyUmVzcG9uc2U7YT1vLmlzRXJyb3I7cD1vLmlnbm9yZVRpdGxlO3UudGFyZ2V0PWM7dS5mYWlsZWQ9YTt1LnN1Y2Nlc3NmdWw9IWE7aWYoby5zaG91bGRTd2FwKXtpZihmLnN0YXR1cz09PTI4Nil7YXQobCl9UihsLGZ1bmN0aW9uKGUpe2c9ZS50cmFuc2Zvcm1SZXNwb25zZShnLGYsbCl9KTtpZihkLnR5cGUpe2VyKCl9dmFyIHM9ZS5zd2FwT3ZlcnJpZGU7aWYoTyhmLC9IWC1SZXN3YXA6L2kpKXtzPWYuZ2V0UmVzcG9uc2VIZWFkZXIoXCJIWC1SZXN3YXBcIil9dmFyIHY9d3IobCxzKTtpZih2Lmhhc093blByb3BlcnR5KFwiaWdub3JlVGl0bGVcIikpe3A9di5pZ25vcmVUaXRsZX1jLmNsYXNzTGlzdC5hZGQoUS5jb25maWcuc3dhcHBpbmdDbGFzcyk7dmFyIG09bnVsbDt2YXIgeD1udWxsO3ZhciB5PWZ1bmN0aW9uKCl7dHJ5e3ZhciBlPWRvY3VtZW50LmFjdGl2ZUVsZW1lbnQ7dmFyIHQ9e307dHJ5e3Q9e2VsdDplLHN0YXJ0OmU/ZS5zZWxlY3Rpb25TdGFydDpudWxsLGVuZDplP2Uuc2VsZWN0aW9uRW5kOm51bGx9fWNhdGNoKGUpe312YXIgcjtpZihoKXtyPWh9aWYoTyhmLC9IWC1SZXNlbGVjdDovaSkpe3I9Zi5nZXRSZXNwb25zZUhlYWRlcihcIkhYLVJlc2VsZWN0XCIpfWlmKGQudHlwZSl7Y2UocmUoKS5ib2R5LFwiaHRteDpiZWZvcmVIaXN0b3J5VXBkYXRlXCIsbGUoe2hpc3Rvcnk6ZH0sdSkpO2lmKGQudHlwZT09PVwicHVzaFwiKXt0cihkLnBhdGgpO2NlKHJlKCkuYm9keSxcImh0bXg6cHVzaGVkSW50b0hpc3RvcnlcIix7cGF0aDpkLnBhdGh9KX1lbHNle3JyKGQucGF0aCk7Y2UocmUoKS5ib2R5LFwiaHRteDpyZXBsYWNlZEluSGlzdG9yeVwiLHtwYXRoOmQucGF0aH0pfX12YXIgbj1UKGMpO2plKHYuc3dhcFN0eWxlLGMsbCxnLG4scik7aWYodC5lbHQmJiFzZSh0LmVsdCkmJmVlKHQuZWx0LFwiaWRcIikpe3ZhciBpPWRvY3VtZW50LmdldEVsZW1lbnRCeUlkKGVlKHQuZWx0LFwiaWRcIikpO3ZhciBhPXtwcmV2ZW50U2Nyb2xsOnYuZm9jdXNTY3JvbGwhPT11bmRlZmluZWQ/IXYuZm9jdXNTY3JvbGw6IVEuY29uZmlnLmRlZmF1bHRGb2N1c1Njcm9sbH07aWYoaSl7aWYodC5zdGFydCYmaS5zZXRTZWxlY3Rpb25SYW5nZSl7dHJ5e2kuc2V0U2VsZWN0aW9uUmFuZ2UodC5zdGFydCx0LmVuZCl9Y2F0Y2goZSl7fX1pLmZvY3VzKGEpfX1jLmNsYXNzTGlzdC5yZW1vdmUoUS5jb25maWcuc3dhcHBpbmdDbGFzcyk7b2Uobi5lbHRzLGZ1bmN0aW9uKGUpe2lmKGUuY2xhc3NMaXN0KXtlLmNsYXNzTGlzdC5hZGQoUS5jb25maWcuc2V0dGxpbmdDbGFzcyl9Y2UoZSxcImh0bXg6YWZ0ZXJTd2FwXCIsdSl9KTtpZihPKGYsL0hYLVRyaWdnZXItQWZ0ZXItU3dhcDovaSkpe3ZhciBvPWw7aWYoIXNlKGwpKXtvPXJlKCkuYm9keX1fZShmLFwiSFgtVHJpZ2dlci1BZnRlci1Td2FwXCIsbyl9dmFyIHM9ZnVuY3Rpb24oKXtvZShuLnRhc2tzLGZ1bmN0aW9uKGUpe2UuY2FsbCgpfSk7b2Uobi5lbHRzLGZ1bmN0aW9uKGUpe2lmKGUuY2xhc3NMaXN0KXtlLmNsYXNzTGlzdC5yZW1vdmUoUS5jb25maWcuc2V0dGxpbmdDbGFzcyl9Y2UoZSxcImh0bXg6YWZ0ZXJTZXR0bGVcIix1KX0pO2lmKHUucGF0aEluZm8uYW5jaG9yKXt2YXIgZT1yZSgpLmdldEVsZW1lbnRCeUlkKHUucGF0aEluZm8uYW5jaG9yKTtpZihlKXtlLnNjcm9sbEludG9WaWV3KHtibG9jazpcInN0YXJ0XCIsYmVoYXZpb3I6XCJhdXRvXCJ9KX19aWYobi50aXRsZSYmIXApe3ZhciB0PUMoXCJ0aXRsZVwiKTtpZih0KXt0LmlubmVySFRNTD1uLnRpdGxlfWVsc2V7d2luZG93LmRvY3VtZW50LnRpdGxlPW4udGl0bGV9fUNyKG4uZWx0cyx2KTtpZihPKGYsL0hYLVRyaWdnZXItQWZ0ZXItU2V0dGxlOi9pKSl7dmFyIHI9bDtpZighc2UobCkpe3I9cmUoKS5ib2R5fV9lKGYsXCJIWC1UcmlnZ2VyLUFmdGVyLVNldHRsZVwiLHIpfWllKG0pfTtpZih2LnNldHRsZURlbGF5PjApe3NldFRpbWVvdXQocyx2LnNldHRsZURlbGF5KX1lbHNle3MoKX19Y2F0Y2goZSl7ZmUobCxcImh0bXg6c3dhcEVycm9yXCIsdSk7aWUoeCk7dGhyb3cgZX19O3ZhciBiPVEuY29uZmlnLmdsb2JhbFZpZXdUcmFuc2l0aW9ucztpZih2Lmhhc093blByb3BlcnR5KFwidHJhbnNpdGlvblwiKSl7Yj12LnRyYW5zaXRpb259aWYoYiYmY2UobCxcImh0bXg6YmVmb3JlVHJhbnNpdGlvblwiLHUpJiZ0eXBlb2YgUHJvbWlzZSE9PVwidW5kZWZpbmVkXCImJmRvY3VtZW50LnN0YXJ0Vmlld1RyYW5zaXRpb24pe3ZhciB3PW5ldyBQcm9taXNlKGZ1bmN0aW9uKGUsdCl7bT1lO3g9dH0pO3ZhciBTPXk7eT1mdW5jdGlvbigpe2RvY3VtZW50LnN0YXJ0Vmlld1RyYW5zaXRpb24oZnVuY3Rpb24oKXtTKCk7cmV0dXJuIHd9KX19aWYodi5zd2FwRGVsYXk+MCl7c2V0VGltZW91dCh5LHYuc3dhcERlbGF5KX1lbHNle3koKX19aWYoYSl7ZmUobCxcImh0bXg6cmVzcG9uc2VFcnJvclwiLGxlKHtlcnJvcjpcIlJlc3BvbnNlIFN0YXR1cyBFcnJvciBDb2RlIFwiK2Yuc3RhdHVzK1wiIGZyb20gXCIrdS5wYXRoSW5mby5yZXF1ZXN0UGF0aH0sdSkpfX12YXIgWHI9e307ZnVuY3Rpb24gRHIoKXtyZXR1cm57aW5pdDpmdW5jdGlvbihlKXtyZXR1cm4gbnVsbH0sb25FdmVudDpmdW5jdGlvbihlLHQpe3JldHVybiB0cnVlfSx0cmFuc2Zvcm1SZXNwb25zZTpmdW5jdGlvbihlLHQscil7cmV0dXJuIGV9LGlzSW5saW5lU3dhcDpmdW5jdGlvbihlKXtyZXR1cm4gZmFsc2V9LGhhbmRsZVN3YXA6ZnVuY3Rpb24oZSx0LHIsbil7cmV0dXJuIGZhbHNlfSxlbmNvZGVQYXJhbWV0ZXJzOmZ1bmN0aW9uKGUsdCxyKXtyZXR1cm4gbnVsbH19fWZ1bmN0aW9uIFVyKGUsdCl7aWYodC5pbml0KXt0LmluaXQocil9WHJbZV09bGUoRHIoKSx0KX1mdW5jdGlvbiBGcihlKXtkZWxldGUgWHJbZV19ZnVuY3Rpb24gQnIoZSxyLG4pe2lmKGU9PXVuZGVmaW5lZCl7cmV0dXJuIHJ9aWYocj09dW5kZWZpbmVkKXtyPVtdfWlmKG49PXVuZGVmaW5lZCl7bj1bXX12YXIgdD10ZShlLFwiaHgtZXh0XCIpO2lmKHQpe29lKHQuc3BsaXQoXCIsXCIpLGZ1bmN0aW9uKGUpe2U9ZS5yZXBsYWNlKC8gL2csXCJcIik7aWYoZS5zbGljZSgwLDcpPT1cImlnbm9yZTpcIil7bi5wdXNoKGUuc2xpY2UoNykpO3JldHVybn1pZihuLmluZGV4T2YoZSk8MCl7dmFyIHQ9WHJbZV07aWYodCYmci5pbmRleE9mKHQpPDApe3IucHVzaCh0KX19fSl9cmV0dXJuIEJyKHUoZSkscixuKX12YXIgVnI9ZmFsc2U7cmUoKS5hZGRFdmVudExpc3RlbmVyKFwiRE9NQ29udGVudExvYWRlZFwiLGZ1bmN0aW9uKCl7VnI9dHJ1ZX0pO2Z1bmN0aW9uIGpyKGUpe2lmKFZyfHxyZSgpLnJlYWR5U3RhdGU9PT1cImNvbXBsZXRlXCIpe2UoKX1lbHNle3JlKCkuYWRkRXZlbnRMaXN0ZW5lcihcIkRPTUNvbnRlbnRMb2FkZWRcIixlKX19ZnVuY3Rpb24gX3IoKXtpZihRLmNvbmZpZy5pbmNsdWRlSW5kaWNhdG9yU3R5bGVzIT09ZmFsc2Upe3JlKCkuaGVhZC5pbnNlcnRBZGphY2VudEhUTUwoXCJiZWZvcmVlbmRcIixcIjxzdHlsZT4gICAgICAgICAgICAgICAgICAgICAgLlwiK1EuY29uZmlnLmluZGljYXRvckNsYXNzK1wie29wYWNpdHk6MH0gICAgICAgICAgICAgICAgICAgICAgLlwiK1EuY29uZmlnLnJlcXVlc3RDbGFzcytcIiAuXCIrUS5jb25maWcuaW5kaWNhdG9yQ2xhc3MrXCJ7b3BhY2l0eToxOyB0cmFuc2l0aW9uOiBvcGFjaXR5IDIwMG1zIGVhc2UtaW47fSAgICAgICAgICAgICAgICAgICAgICAuXCIrUS5jb25maWcucmVxdWVzdENsYXNzK1wiLlwiK1EuY29uZmlnLmluZGljYXRvckNsYXNzK1wie29wYWNpdHk6MTsgdHJhbnNpdGlvbjogb3BhY2l0eSAyMDBtcyBlYXNlLWluO30gICAgICAgICAgICAgICAgICAgIDwvc3R5bGU+XCIpfX1mdW5jdGlvbiB6cigpe3ZhciBlPXJlKCkucXVlcnlTZWxlY3RvcignbWV0YVtuYW1lPVwiaHRteC1jb25maWdcIl0nKTtpZihlKXtyZXR1cm4gRShlLmNvbnRlbnQpfWVsc2V7cmV0dXJuIG51bGx9fWZ1bmN0aW9uICRyKCl7dmFyIGU9enIoKTtpZihlKXtRLmNvbmZpZz1sZShRLmNvbmZpZyxlKX19anIoZnVuY3Rpb24oKXskcigpO19yKCk7dmFyIGU9cmUoKS5ib2R5O3p0KGUpO3ZhciB0PXJlKCkucXVlcnlTZWxlY3RvckFsbChcIltoeC10cmlnZ2VyPSdyZXN0b3JlZCddLFtkYXRhLWh4LXRyaWdnZXI9J3Jlc3RvcmVkJ11cIik7ZS5hZGRFdmVudExpc3RlbmVyKFwiaHRteDphYm9ydFwiLGZ1bmN0aW9uKGUpe3ZhciB0PWUudGFyZ2V0O3ZhciByPWFlKHQpO2lmKHImJnIueGhyKXtyLnhoci5hYm9ydCgpfX0pO2NvbnN0IHI9d2luZG93Lm9ucG9wc3RhdGU/d2luZG93Lm9ucG9wc3RhdGUuYmluZCh3aW5kb3cpOm51bGw7d2luZG93Lm9ucG9wc3RhdGU9ZnVuY3Rpb24oZSl7aWYoZS5zdGF0ZSYmZS5zdGF0ZS5odG14KXthcigpO29lKHQsZnVuY3Rpb24oZSl7Y2UoZSxcImh0bXg6cmVzdG9yZWRcIix7ZG9jdW1lbnQ6cmUoKSx0cmlnZ2VyRXZlbnQ6Y2V9KX0pfWVsc2V7aWYocil7cihlKX19fTtzZXRUaW1lb3V0KGZ1bmN0aW9uKCl7Y2UoZSxcImh0bXg6bG9hZFwiLHt9KTtlPW51bGx9LDApfSk7cmV0dXJuIFF9KCl9KTsiLCJpbXBvcnQgXCIuL21haW4uY3NzXCI7XG5cbmltcG9ydCBBbHBpbmUgZnJvbSBcImFscGluZWpzXCI7XG5pbXBvcnQgaHRteCBmcm9tIFwiaHRteC5vcmdcIjtcblxuaW50ZXJmYWNlIFNTRUV2ZW50IHtcbiAgaWQ6IHN0cmluZztcbiAgZHQ6IHN0cmluZztcbiAgdGFyZ2V0OiBzdHJpbmc7XG4gIHN3YXA6IHN0cmluZztcbiAgbWFya3VwOiBzdHJpbmc7XG59XG5cbmV4cG9ydCB0eXBlIFN3YXBUeXBlID1cbiAgfCBcImlubmVySFRNTFwiXG4gIHwgXCJvdXRlckhUTUxcIlxuICB8IFwiYmVmb3JlYmVnaW5cIlxuICB8IFwiYWZ0ZXJiZWdpblwiXG4gIHwgXCJiZWZvcmVlbmRcIlxuICB8IFwiYWZ0ZXJlbmRcIlxuICB8IFwiZGVsZXRlXCJcbiAgfCBcIm5vbmVcIjtcblxuZGVjbGFyZSBnbG9iYWwge1xuICBpbnRlcmZhY2UgV2luZG93IHtcbiAgICBBbHBpbmU6IHR5cGVvZiBBbHBpbmU7XG4gICAgaHRteDogdHlwZW9mIGh0bXg7XG4gIH1cbn1cblxud2luZG93LkFscGluZSA9IEFscGluZTtcbndpbmRvdy5odG14ID0gaHRteDtcblxuQWxwaW5lLmRhdGEoXCJzZWFyY2hcIiwgKCkgPT4gKHtcbiAgc2VhcmNoVGV4dDogXCJcIixcbiAgcnVuSWQ6IFwiYWxsXCIsXG4gIGlzU2hvd2luZ1NlYXJjaFJlc3VsdHM6IGZhbHNlLFxuICBpc1Nob3dpbmdDb25uZWN0aW9uRXJyb3I6IGZhbHNlLFxuICBldmVudFNvdXJjZTogbmV3IEV2ZW50U291cmNlKFwiL3NzZT9zdHJlYW09ZXZlbnRzXCIsIHtcbiAgICB3aXRoQ3JlZGVudGlhbHM6IGZhbHNlXG4gIH0pLFxuICBldmVudFF1ZXVlOiBbXSBhcyBNZXNzYWdlRXZlbnRbXSxcbiAgdG9hc3RUaW1lb3V0OiBudWxsIGFzIG51bWJlciB8IG51bGwsXG4gIHpvb21Db250ZW50OiBcIlwiLFxuICBpbml0OiBmdW5jdGlvbiAoKSB7XG4gICAgY29uc29sZS5sb2coXCJpbml0XCIpO1xuICAgIHRoaXMuJHdhdGNoKFwic2VhcmNoVGV4dFwiLCAodmFsKSA9PiB7XG4gICAgICB0aGlzLm9uU2VhcmNoVGV4dENoYW5nZWQodmFsKTtcbiAgICB9KTtcbiAgICB0aGlzLiR3YXRjaChcInJ1bklkXCIsICh2YWwpID0+IHtcbiAgICAgIHRoaXMub25SdW5JZENoYW5nZWQodmFsKTtcbiAgICB9KTtcbiAgICB0aGlzLiR3YXRjaChcImlzU2hvd2luZ1NlYXJjaFJlc3VsdHNcIiwgKHZhbCkgPT4ge1xuICAgICAgdGhpcy5vbklzU2hvd2luZ1NlYXJjaFJlc3VsdHModmFsKTtcbiAgICB9KTtcblxuICAgIHRoaXMuZXZlbnRTb3VyY2Uub25tZXNzYWdlID0gKGV2KSA9PiB7XG4gICAgICB0aGlzLmhhbmRsZUV2ZW50U291cmNlTWVzc2FnZShldik7XG4gICAgfTtcbiAgICB0aGlzLmV2ZW50U291cmNlLm9uZXJyb3IgPSAoKSA9PiB7XG4gICAgICB0aGlzLmhhbmRsZUV2ZW50U291cmNlRXJyb3IoKTtcbiAgICB9O1xuICB9LFxuICBvblNlYXJjaFRleHRDaGFuZ2VkOiBmdW5jdGlvbiAodmFsdWU6IHN0cmluZykge1xuICAgIGlmICh2YWx1ZS5sZW5ndGggPT09IDApIHtcbiAgICAgIHRoaXMuaXNTaG93aW5nU2VhcmNoUmVzdWx0cyA9IGZhbHNlO1xuICAgICAgcmV0dXJuO1xuICAgIH1cbiAgICB0aGlzLmlzU2hvd2luZ1NlYXJjaFJlc3VsdHMgPSB0cnVlO1xuICAgIHRoaXMub25DbGlja1NlYXJjaCgpO1xuICB9LFxuICBvblJ1bklkQ2hhbmdlZDogZnVuY3Rpb24gKHZhbHVlOiBzdHJpbmcpIHtcbiAgICBpZiAodmFsdWUgPT09IFwiYWxsXCIpIHtcbiAgICAgIHRoaXMuaXNTaG93aW5nU2VhcmNoUmVzdWx0cyA9IGZhbHNlO1xuICAgICAgdGhpcy5zZWFyY2hUZXh0ID0gXCJcIjtcbiAgICAgIHJldHVybjtcbiAgICB9XG4gICAgdGhpcy5pc1Nob3dpbmdTZWFyY2hSZXN1bHRzID0gdHJ1ZTtcbiAgICBpZiAodGhpcy5zZWFyY2hUZXh0Lmxlbmd0aCA+IDApIHtcbiAgICAgIHRoaXMub25DbGlja1NlYXJjaCgpO1xuICAgIH1cbiAgfSxcbiAgb25Jc1Nob3dpbmdTZWFyY2hSZXN1bHRzOiBmdW5jdGlvbiAodmFsdWU6IGJvb2xlYW4pIHtcbiAgICBjb25zb2xlLmxvZyhcImlzU2hvd2luZ1NlYXJjaFJlc3VsdHMgY2hhbmdlZFwiLCB2YWx1ZSk7XG4gICAgaWYgKCF2YWx1ZSkge1xuICAgICAgd2hpbGUgKHRoaXMuZXZlbnRRdWV1ZS5sZW5ndGggPiAwKSB7XG4gICAgICAgIGNvbnN0IGV2ID0gdGhpcy5ldmVudFF1ZXVlLnNoaWZ0KCkhO1xuICAgICAgICB0aGlzLnByb2Nlc3NFdmVudChldik7XG4gICAgICB9XG4gICAgfVxuICB9LFxuICBvblNlYXJjaFRleHRLZXlEb3duOiBmdW5jdGlvbiAoZXY6IEtleWJvYXJkRXZlbnQpIHtcbiAgICBpZiAoZXYua2V5ID09PSBcIkVudGVyXCIpIHtcbiAgICAgIHRoaXMub25DbGlja1NlYXJjaCgpO1xuICAgIH1cbiAgfSxcbiAgb25DbGlja1NlYXJjaDogZnVuY3Rpb24gKCkge1xuICAgIGNvbnN0IHRhcmdldEVsID0gZG9jdW1lbnQucXVlcnlTZWxlY3RvcihcIiNsb2ctb3V0cHV0LWlubmVyXCIpIGFzIEhUTUxFbGVtZW50O1xuICAgIGlmICghdGFyZ2V0RWwpIHtcbiAgICAgIHRocm93IG5ldyBFcnJvcihcIlRhcmdldCBlbGVtZW50IG5vdCBmb3VuZFwiKTtcbiAgICB9XG4gICAgY29uc3QgZXZlbnQgPSBuZXcgQ3VzdG9tRXZlbnQoXCJjdXN0b206c2VhcmNoXCIsIHtcbiAgICAgIGRldGFpbDogeyBrZXk6IFwidmFsdWVcIiB9XG4gICAgfSk7XG4gICAgdGFyZ2V0RWwuZGlzcGF0Y2hFdmVudChldmVudCk7XG4gIH0sXG4gIGhhbmRsZUV2ZW50U291cmNlTWVzc2FnZTogZnVuY3Rpb24gKGV2OiBNZXNzYWdlRXZlbnQpIHtcbiAgICBpZiAodGhpcy5pc1Nob3dpbmdTZWFyY2hSZXN1bHRzKSB7XG4gICAgICB0aGlzLmV2ZW50UXVldWUucHVzaChldik7XG4gICAgICByZXR1cm47XG4gICAgfVxuICAgIHRoaXMucHJvY2Vzc0V2ZW50KGV2KTtcbiAgfSxcbiAgaGFuZGxlRXZlbnRTb3VyY2VFcnJvcjogZnVuY3Rpb24gKCkge1xuICAgIGNvbnNvbGUubG9nKFwic3NlIGVycm9yXCIpO1xuICAgIHRoaXMuaXNTaG93aW5nQ29ubmVjdGlvbkVycm9yID0gdHJ1ZTtcbiAgICBpZiAodGhpcy50b2FzdFRpbWVvdXQpIHtcbiAgICAgIGNsZWFyVGltZW91dCh0aGlzLnRvYXN0VGltZW91dCk7XG4gICAgfVxuICAgIHRoaXMudG9hc3RUaW1lb3V0ID0gc2V0VGltZW91dCgoKSA9PiB7XG4gICAgICB0aGlzLmlzU2hvd2luZ0Nvbm5lY3Rpb25FcnJvciA9IGZhbHNlO1xuICAgIH0sIDUwMDApO1xuICB9LFxuICBwcm9jZXNzRXZlbnQ6IGZ1bmN0aW9uIChldjogTWVzc2FnZUV2ZW50KSB7XG4gICAgY29uc3QgbXNnID0gSlNPTi5wYXJzZShldi5kYXRhKSBhcyBTU0VFdmVudDtcbiAgICBzd2FwKG1zZyk7XG4gIH0sXG4gIG9uWm9vbUVudHJ5OiBmdW5jdGlvbiAoZXY6IE1vdXNlRXZlbnQpIHtcbiAgICBjb25zdCB0YXJnZXRFbCA9IGV2LnRhcmdldCBhcyBIVE1MRWxlbWVudDtcbiAgICBjb25zdCB0ZXh0Q29udGVudCA9IHRhcmdldEVsXG4gICAgICAuY2xvc2VzdChcIi5sb2ctZW50cnlcIilcbiAgICAgID8ucXVlcnlTZWxlY3RvcihcIi5sb2ctdGV4dFwiKT8udGV4dENvbnRlbnQ7XG4gICAgdHJ5IHtcbiAgICAgIGNvbnN0IGRhdGEgPSBKU09OLnBhcnNlKHRleHRDb250ZW50IHx8IFwiXCIpO1xuICAgICAgdGhpcy56b29tQ29udGVudCA9IEpTT04uc3RyaW5naWZ5KGRhdGEsIG51bGwsIDIpO1xuICAgIH0gY2F0Y2ggKGUpIHtcbiAgICAgIHRoaXMuem9vbUNvbnRlbnQgPSB0ZXh0Q29udGVudCB8fCBcIlwiO1xuICAgIH1cbiAgICBjb25zdCBkaWFsb2cgPSBkb2N1bWVudC5nZXRFbGVtZW50QnlJZChcInpvb20tZGlhbG9nXCIpIGFzIEhUTUxEaWFsb2dFbGVtZW50O1xuICAgIGRpYWxvZy5zaG93TW9kYWwoKTtcbiAgfVxufSkpO1xuXG5BbHBpbmUuc3RhcnQoKTtcblxuZnVuY3Rpb24gc3dhcChtc2c6IFNTRUV2ZW50KSB7XG4gIGNvbnN0IHRhcmdldEVsID0gZG9jdW1lbnQucXVlcnlTZWxlY3Rvcihtc2cudGFyZ2V0KSBhcyBIVE1MRWxlbWVudDtcbiAgaWYgKCF0YXJnZXRFbCkge1xuICAgIHRocm93IG5ldyBFcnJvcihgVGFyZ2V0IGVsZW1lbnQgbm90IGZvdW5kOiAke21zZy50YXJnZXR9LyR7bXNnLmlkfWApO1xuICB9XG5cbiAgY29uc3QgZiA9IG1zZy5zd2FwLnNwbGl0KFwiIFwiKTtcbiAgY29uc3Qgc3dhcFR5cGUgPSBmWzBdIGFzIFN3YXBUeXBlO1xuICBjb25zdCBzY3JvbGxFeHByID0gZi5sZW5ndGggPiAxID8gZlsxXSA6IFwiXCI7XG5cbiAgY29uc3QgcmFuZ2UgPSBkb2N1bWVudC5jcmVhdGVSYW5nZSgpO1xuICByYW5nZS5zZWxlY3ROb2RlKHRhcmdldEVsKTtcbiAgY29uc3QgZG9jdW1lbnRGcmFnbWVudCA9IHJhbmdlLmNyZWF0ZUNvbnRleHR1YWxGcmFnbWVudChtc2cubWFya3VwKTtcblxuICBzd2l0Y2ggKHN3YXBUeXBlKSB7XG4gICAgY2FzZSBcImlubmVySFRNTFwiOlxuICAgICAgd2hpbGUgKHRhcmdldEVsLmZpcnN0Q2hpbGQpIHtcbiAgICAgICAgdGFyZ2V0RWwucmVtb3ZlQ2hpbGQodGFyZ2V0RWwuZmlyc3RDaGlsZCk7XG4gICAgICB9XG4gICAgICB0YXJnZXRFbC5hcHBlbmRDaGlsZChkb2N1bWVudEZyYWdtZW50KTtcbiAgICAgIGJyZWFrO1xuICAgIGNhc2UgXCJvdXRlckhUTUxcIjpcbiAgICAgIHRhcmdldEVsLnBhcmVudE5vZGU/LnJlcGxhY2VDaGlsZChkb2N1bWVudEZyYWdtZW50LCB0YXJnZXRFbCk7XG4gICAgICBicmVhaztcbiAgICBjYXNlIFwiYmVmb3JlYmVnaW5cIjpcbiAgICAgIHRhcmdldEVsLnBhcmVudE5vZGU/Lmluc2VydEJlZm9yZShkb2N1bWVudEZyYWdtZW50LCB0YXJnZXRFbCk7XG4gICAgICBicmVhaztcbiAgICBjYXNlIFwiYWZ0ZXJiZWdpblwiOlxuICAgICAgdGFyZ2V0RWwuaW5zZXJ0QmVmb3JlKGRvY3VtZW50RnJhZ21lbnQsIHRhcmdldEVsLmZpcnN0Q2hpbGQpO1xuICAgICAgYnJlYWs7XG4gICAgY2FzZSBcImJlZm9yZWVuZFwiOlxuICAgICAgdGFyZ2V0RWwuYXBwZW5kQ2hpbGQoZG9jdW1lbnRGcmFnbWVudCk7XG4gICAgICBicmVhaztcbiAgICBjYXNlIFwiYWZ0ZXJlbmRcIjpcbiAgICAgIHRhcmdldEVsLnBhcmVudE5vZGU/Lmluc2VydEJlZm9yZShkb2N1bWVudEZyYWdtZW50LCB0YXJnZXRFbC5uZXh0U2libGluZyk7XG4gICAgICBicmVhaztcbiAgICBkZWZhdWx0OlxuICAgICAgYnJlYWs7XG4gIH1cblxuICBpZiAoc2Nyb2xsRXhwcikge1xuICAgIGNvbnN0IGYgPSBzY3JvbGxFeHByLnNwbGl0KFwiOlwiKTtcbiAgICBjb25zdCBzY3JvbGxUeXBlID0gZlswXTtcbiAgICBjb25zdCBzY3JvbGxUYXJnZXQgPSBmWzFdO1xuICAgIHN3aXRjaCAoc2Nyb2xsVHlwZSkge1xuICAgICAgY2FzZSBcInNjcm9sbFwiOlxuICAgICAgICBzd2l0Y2ggKHNjcm9sbFRhcmdldCkge1xuICAgICAgICAgIGNhc2UgXCJ2aWV3XCI6XG4gICAgICAgICAgICAoZG9jdW1lbnRGcmFnbWVudC5maXJzdENoaWxkIGFzIEhUTUxFbGVtZW50KT8uc2Nyb2xsSW50b1ZpZXcoKTtcbiAgICAgICAgICAgIGJyZWFrO1xuICAgICAgICAgIGNhc2UgXCJsYXN0Y2hpbGRcIjpcbiAgICAgICAgICAgICh0YXJnZXRFbC5sYXN0Q2hpbGQgYXMgSFRNTEVsZW1lbnQpPy5zY3JvbGxJbnRvVmlldyh7XG4gICAgICAgICAgICAgIGJsb2NrOiBcImVuZFwiLFxuICAgICAgICAgICAgICBiZWhhdmlvcjogXCJpbnN0YW50XCJcbiAgICAgICAgICAgIH0pO1xuICAgICAgICAgICAgYnJlYWs7XG4gICAgICAgICAgY2FzZSBcIm5leHRzaWJsaW5nXCI6XG4gICAgICAgICAgICAodGFyZ2V0RWwubmV4dFNpYmxpbmcgYXMgSFRNTEVsZW1lbnQpPy5zY3JvbGxJbnRvVmlldyh7XG4gICAgICAgICAgICAgIGJsb2NrOiBcImVuZFwiLFxuICAgICAgICAgICAgICBiZWhhdmlvcjogXCJpbnN0YW50XCJcbiAgICAgICAgICAgIH0pO1xuICAgICAgICAgICAgYnJlYWs7XG4gICAgICAgIH1cbiAgICAgICAgYnJlYWs7XG4gICAgfVxuICB9XG59Il0sIm5hbWVzIjpbImNhbGxiYWNrIiwiam9iIiwiaW5kZXgiLCJpIiwiZW5naW5lIiwidGFzayIsIm92ZXJyaWRlIiwiZWwiLCJjbGVhbnVwMiIsImVmZmVjdFJlZmVyZW5jZSIsImdldHRlciIsImZpcnN0VGltZSIsIm9sZFZhbHVlIiwidmFsdWUiLCJuYW1lIiwiZGV0YWlsIiwiZWwyIiwic2tpcCIsIm5vZGUiLCJtZXNzYWdlIiwiYXJncyIsImF0dHJzIiwiaGFuZGxlIiwib3V0TmVzdGVkQ29tcG9uZW50cyIsImZuIiwic2VsZWN0b3JDYWxsYmFjayIsImluY2x1ZGVJbml0U2VsZWN0b3JzIiwiZWxlbWVudCIsInNlbGVjdG9yIiwid2Fsa2VyIiwiaW50ZXJjZXB0Iiwicm9vdCIsIm5hbWVzIiwicmVjb3JkcyIsInF1ZXVlTGVuZ3RoV2hlblRyaWdnZXJlZCIsInJlc3VsdCIsIm11dGF0aW9ucyIsImFkZGVkTm9kZXMiLCJyZW1vdmVkTm9kZXMiLCJhZGRlZEF0dHJpYnV0ZXMiLCJyZW1vdmVkQXR0cmlidXRlcyIsImFkZDIiLCJyZW1vdmUiLCJkYXRhMiIsInJlZmVyZW5jZU5vZGUiLCJvYmplY3RzIiwib2JqIiwidGhpc1Byb3h5IiwidGFyZ2V0IiwiZGVzY3JpcHRvciIsImFjYyIsImtleSIsImlzT2JqZWN0MiIsInZhbCIsInJlY3Vyc2UiLCJiYXNlUGF0aCIsImVudW1lcmFibGUiLCJwYXRoIiwibXV0YXRlT2JqIiwiaW5pdGlhbFZhbHVlIiwiaW5pdGlhbGl6ZSIsImlubmVyVmFsdWUiLCJjYXJyeSIsInNlZ21lbnQiLCJtZW1vaXplZFV0aWxpdGllcyIsImdldFV0aWxpdGllcyIsInV0aWxpdGllcyIsImV4cHJlc3Npb24iLCJlIiwiZXJyb3IyIiwiY2FjaGUiLCJleHRyYXMiLCJuZXdFdmFsdWF0b3IiLCJvdmVycmlkZGVuTWFnaWNzIiwiZGF0YVN0YWNrIiwiZXZhbHVhdG9yIiwiZnVuYyIsInJlY2VpdmVyIiwic2NvcGUyIiwicGFyYW1zIiwiQXN5bmNGdW5jdGlvbiIsInJpZ2h0U2lkZVNhZmVFeHByZXNzaW9uIiwiZnVuYzIiLCJjb21wbGV0ZVNjb3BlIiwicHJvbWlzZSIsInN1YmplY3QiLCJuZXdQcmVmaXgiLCJkaXJlY3RpdmUyIiwicG9zIiwiYXR0cmlidXRlcyIsIm9yaWdpbmFsQXR0cmlidXRlT3ZlcnJpZGUiLCJ2QXR0cmlidXRlcyIsInN0YXRpY0F0dHJpYnV0ZXMiLCJhdHRyaWJ1dGUiLCJhdHRyIiwidHJhbnNmb3JtZWRBdHRyaWJ1dGVNYXAiLCJuZXdOYW1lIiwib2xkTmFtZSIsImZsdXNoSGFuZGxlcnMiLCJzdG9wRGVmZXJyaW5nIiwiY2xlYW51cHMiLCJlZmZlY3QzIiwiY2xlYW51cEVmZmVjdCIsIm5vb3AiLCJoYW5kbGVyNCIsImZ1bGxIYW5kbGVyIiwicmVwbGFjZW1lbnQiLCJuZXdWYWx1ZSIsInRyYW5zZm9ybSIsInR5cGVNYXRjaCIsInZhbHVlTWF0Y2giLCJtb2RpZmllcnMiLCJvcmlnaW5hbCIsImEiLCJiIiwidHlwZUEiLCJ0eXBlQiIsInJlcyIsImNsYXNzU3RyaW5nIiwibWlzc2luZ0NsYXNzZXMiLCJjbGFzc1N0cmluZzIiLCJhZGRDbGFzc2VzQW5kUmV0dXJuVW5kbyIsImNsYXNzZXMiLCJjbGFzc09iamVjdCIsInNwbGl0IiwiZm9yQWRkIiwiYm9vbCIsImZvclJlbW92ZSIsImFkZGVkIiwicmVtb3ZlZCIsInByZXZpb3VzU3R5bGVzIiwidmFsdWUyIiwiZmFsbGJhY2siLCJjYWxsZWQiLCJldmFsdWF0ZTIiLCJzdGFnZSIsImRvZXNudFNwZWNpZnkiLCJ0cmFuc2l0aW9uaW5nSW4iLCJ0cmFuc2l0aW9uaW5nT3V0Iiwid2FudHNBbGwiLCJ3YW50c09wYWNpdHkiLCJ3YW50c1NjYWxlIiwib3BhY2l0eVZhbHVlIiwic2NhbGVWYWx1ZSIsImRlbGF5Iiwib3JpZ2luIiwicHJvcGVydHkiLCJkdXJhdGlvbkluIiwiZHVyYXRpb25PdXQiLCJlYXNpbmciLCJzZXRGdW5jdGlvbiIsImRlZmF1bHRWYWx1ZSIsImJlZm9yZSIsImFmdGVyIiwic2hvdyIsImhpZGUiLCJuZXh0VGljazIiLCJjbGlja0F3YXlDb21wYXRpYmxlU2hvdyIsInJlc29sdmUiLCJyZWplY3QiLCJjbG9zZXN0IiwiaGlkZUFmdGVyQ2hpbGRyZW4iLCJwYXJlbnQiLCJkdXJpbmciLCJzdGFydDIiLCJlbmQiLCJ1bmRvU3RhcnQiLCJ1bmRvRHVyaW5nIiwidW5kb0VuZCIsInN0YWdlcyIsImludGVycnVwdGVkIiwicmVhY2hlZEJlZm9yZSIsInJlYWNoZWRFbmQiLCJmaW5pc2giLCJkdXJhdGlvbiIsInJhd1ZhbHVlIiwibWF0Y2giLCJmcm9tIiwidG8iLCJvbGRFbCIsIm5ld0VsIiwiaGFzUnVuVGhyb3VnaEZpcnN0RWwiLCJlbDMiLCJjYWxsYmFjazIiLCJzdG9yZWRFZmZlY3QiLCJhdHRyTmFtZSIsInByb3BOYW1lIiwiYXJyYXlXcmFwcGVkVmFsdWUiLCJvcHRpb24iLCJjaGFyIiwidmFsdWVBIiwidmFsdWVCIiwiZXh0cmFjdCIsImJpbmRpbmciLCJ3YWl0IiwidGltZW91dCIsImNvbnRleHQiLCJsYXRlciIsImxpbWl0IiwiaW5UaHJvdHRsZSIsIm91dGVyR2V0Iiwib3V0ZXJTZXQiLCJpbm5lckdldCIsImlubmVyU2V0IiwiZmlyc3RSdW4iLCJvdXRlckhhc2giLCJyZWZlcmVuY2UiLCJvdXRlciIsImlubmVyIiwib3V0ZXJIYXNoTGF0ZXN0IiwiaW5uZXJIYXNoTGF0ZXN0IiwiYmluZGluZ3MiLCJnZXRCaW5kaW5ncyIsImNsZWFudXBSdW5uZXJzIiwic3RyIiwiZXhwZWN0c0xvd2VyQ2FzZSIsIm1hcCIsImxpc3QiLCJvcHRpb25zIiwiZGVwcyIsImxhc3QiLCJ0eXBlIiwiZGVwc01hcCIsImRlcCIsIm9sZFRhcmdldCIsImVmZmVjdHMiLCJlZmZlY3RzVG9BZGQiLCJrZXkyIiwicnVuIiwiaW5zdHJ1bWVudGF0aW9ucyIsImFyciIsImwiLCJpc1JlYWRvbmx5Iiwic2hhbGxvdyIsInRhcmdldElzQXJyYXkiLCJoYWRLZXkiLCJ2IiwiaXNTaGFsbG93IiwicmF3VGFyZ2V0IiwicmF3S2V5IiwiaGFzMiIsIndyYXAiLCJnZXQzIiwiaGFkSXRlbXMiLCJ0aGlzQXJnIiwib2JzZXJ2ZWQiLCJtZXRob2QiLCJ0YXJnZXRJc01hcCIsImlzUGFpciIsImlzS2V5T25seSIsImlubmVySXRlcmF0b3IiLCJkb25lIiwibXV0YWJsZUluc3RydW1lbnRhdGlvbnMyIiwic2hhbGxvd0luc3RydW1lbnRhdGlvbnMyIiwicmVhZG9ubHlJbnN0cnVtZW50YXRpb25zMiIsInNoYWxsb3dSZWFkb25seUluc3RydW1lbnRhdGlvbnMyIiwicmF3VHlwZSIsImJhc2VIYW5kbGVycyIsImNvbGxlY3Rpb25IYW5kbGVycyIsInByb3h5TWFwIiwiZXhpc3RpbmdQcm94eSIsInRhcmdldFR5cGUiLCJwcm94eSIsInIiLCJldmFsdWF0ZUxhdGVyMiIsInVud2F0Y2giLCJyZWZPYmplY3RzIiwiY2FjaGVLZXkiLCJpZCIsIm91dHB1dCIsIm1hZ2ljTmFtZSIsInNsdWciLCJldmFsdWF0ZUlubmVyU2V0IiwicmVsZWFzZUVudGFuZ2xlbWVudCIsImNsb25lMiIsImV2ZW50TmFtZSIsInBsYWNlSW5Eb20iLCJjbG9uZTMiLCJ0YXJnZXQyIiwibW9kaWZpZXJzMiIsImV2ZW50IiwibGlzdGVuZXJUYXJnZXQiLCJ3cmFwSGFuZGxlciIsIndyYXBwZXIiLCJuZXh0TW9kaWZpZXIiLCJuZXh0Iiwia2V5TW9kaWZpZXJzIiwiZGVib3VuY2VJbmRleCIsInNlbGVjdGVkU3lzdGVtS2V5TW9kaWZpZXJzIiwibW9kaWZpZXIiLCJtb2RpZmllclRvS2V5TWFwIiwic2NvcGVUYXJnZXQiLCJldmFsdWF0ZUdldCIsImV2YWx1YXRlU2V0IiwiZ2V0VmFsdWUiLCJzZXRWYWx1ZSIsInJlbW92ZUxpc3RlbmVyIiwicmVtb3ZlUmVzZXRMaXN0ZW5lciIsImN1cnJlbnRWYWx1ZSIsIm51bWJlciIsImJpbmRpbmdQcm92aWRlcnMiLCJtYWdpY0NvbnRleHQiLCJkYXRhUHJvdmlkZXJDb250ZXh0IiwicmVhY3RpdmVEYXRhIiwidW5kbyIsInRvZ2dsZSIsIml0ZXJhdG9yTmFtZXMiLCJldmFsdWF0ZUl0ZW1zIiwiZXZhbHVhdGVLZXkiLCJ0ZW1wbGF0ZUVsIiwiaXRlbXMiLCJsb29rdXAiLCJwcmV2S2V5cyIsInNjb3BlcyIsImtleXMiLCJhZGRzIiwibW92ZXMiLCJyZW1vdmVzIiwic2FtZXMiLCJsYXN0S2V5IiwicHJldkluZGV4Iiwia2V5SW5TcG90Iiwia2V5Rm9yU3BvdCIsImVsSW5TcG90IiwiZWxGb3JTcG90IiwibWFya2VyIiwibGFzdEtleTIiLCJsYXN0RWwiLCJyZWFjdGl2ZVNjb3BlIiwibmV3U2NvcGUiLCJmb3JJdGVyYXRvclJFIiwic3RyaXBQYXJlbnNSRSIsImZvckFsaWFzUkUiLCJpbk1hdGNoIiwiaXRlbSIsIml0ZXJhdG9yTWF0Y2giLCJzY29wZVZhcmlhYmxlcyIsImRpcmVjdGl2ZU5hbWUiLCJ0IiwidGhpcyIsIm4iLCJvIiwicyIsInUiLCJmIiwiYyIsImgiLCJNIiwiWCIsImQiLCJEIiwiVSIsImciLCJwIiwibSIsIngiLCJGIiwiQiIsIlYiLCJ5IiwidyIsImoiLCJTIiwiRSIsIl8iLCJDIiwiUiIsInoiLCIkIiwiVCIsIk8iLCJXIiwicSIsIkgiLCJHIiwiSiIsIkwiLCJBIiwiWiIsIk4iLCJLIiwiSSIsImsiLCJQIiwiWSIsIkFscGluZSIsImV2IiwidGFyZ2V0RWwiLCJtc2ciLCJfYSIsIl9iIiwidGV4dENvbnRlbnQiLCJkYXRhIiwiX2MiLCJfZCIsIl9lIiwiX2YiLCJzd2FwVHlwZSIsInNjcm9sbEV4cHIiLCJyYW5nZSIsImRvY3VtZW50RnJhZ21lbnQiLCJzY3JvbGxUeXBlIiwic2Nyb2xsVGFyZ2V0Il0sIm1hcHBpbmdzIjoiQUFDQSxJQUFJLGFBQWUsR0FDZixTQUFXLEdBQ1gsTUFBUSxDQUFBLEVBQ1IsaUJBQW1CLEdBQ3ZCLFNBQVMsVUFBVUEsR0FBVSxDQUMzQixTQUFTQSxFQUFRLENBQ25CLENBQ0EsU0FBUyxTQUFTQyxHQUFLLENBQ2hCLE1BQU0sU0FBU0EsRUFBRyxHQUNyQixNQUFNLEtBQUtBLEVBQUcsRUFDaEIsWUFDRixDQUNBLFNBQVMsV0FBV0EsR0FBSyxDQUN2QixJQUFJQyxHQUFRLE1BQU0sUUFBUUQsRUFBRyxFQUN6QkMsS0FBVSxJQUFNQSxHQUFRLGtCQUMxQixNQUFNLE9BQU9BLEdBQU8sQ0FBQyxDQUN6QixDQUNBLFNBQVMsWUFBYSxDQUNoQixDQUFDLFVBQVksQ0FBQyxlQUNoQixhQUFlLEdBQ2YsZUFBZSxTQUFTLEVBRTVCLENBQ0EsU0FBUyxXQUFZLENBQ25CLGFBQWUsR0FDZixTQUFXLEdBQ1gsUUFBU0MsR0FBSSxFQUFHQSxHQUFJLE1BQU0sT0FBUUEsS0FDaEMsTUFBTUEsRUFBQyxJQUNQLGlCQUFtQkEsR0FFckIsTUFBTSxPQUFTLEVBQ2YsaUJBQW1CLEdBQ25CLFNBQVcsRUFDYixDQUdBLElBQUksU0FDQSxPQUNBLFFBQ0EsSUFDQSxlQUFpQixHQUNyQixTQUFTLHdCQUF3QkgsR0FBVSxDQUN6QyxlQUFpQixHQUNqQkEsS0FDQSxlQUFpQixFQUNuQixDQUNBLFNBQVMsb0JBQW9CSSxHQUFRLENBQ25DLFNBQVdBLEdBQU8sU0FDbEIsUUFBVUEsR0FBTyxRQUNqQixPQUFVSixJQUFhSSxHQUFPLE9BQU9KLEdBQVUsQ0FBRSxVQUFZSyxJQUFTLENBQ2hFLGVBQ0YsVUFBVUEsRUFBSSxFQUVkQSxJQUVILENBQUEsQ0FBRSxFQUNILElBQU1ELEdBQU8sR0FDZixDQUNBLFNBQVMsZUFBZUUsR0FBVSxDQUNoQyxPQUFTQSxFQUNYLENBQ0EsU0FBUyxtQkFBbUJDLEdBQUksQ0FDOUIsSUFBSUMsR0FBVyxJQUFNLENBQ3ZCLEVBa0JFLE1BQU8sQ0FqQmNSLElBQWEsQ0FDaEMsSUFBSVMsR0FBa0IsT0FBT1QsRUFBUSxFQUNyQyxPQUFLTyxHQUFHLGFBQ05BLEdBQUcsV0FBNkIsSUFBSSxJQUNwQ0EsR0FBRyxjQUFnQixJQUFNLENBQ3ZCQSxHQUFHLFdBQVcsUUFBU0osSUFBTUEsR0FBRyxDQUFBLENBQ3hDLEdBRUlJLEdBQUcsV0FBVyxJQUFJRSxFQUFlLEVBQ2pDRCxHQUFXLElBQU0sQ0FDWEMsS0FBb0IsU0FFeEJGLEdBQUcsV0FBVyxPQUFPRSxFQUFlLEVBQ3BDLFFBQVFBLEVBQWUsRUFDN0IsRUFDV0EsRUFDWCxFQUN5QixJQUFNLENBQzNCRCxJQUNKLENBQUcsQ0FDSCxDQUNBLFNBQVMsTUFBTUUsR0FBUVYsR0FBVSxDQUMvQixJQUFJVyxHQUFZLEdBQ1pDLEdBQ0FILEdBQWtCLE9BQU8sSUFBTSxDQUNqQyxJQUFJSSxHQUFRSCxLQUNaLEtBQUssVUFBVUcsRUFBSyxFQUNmRixHQU1IQyxHQUFXQyxHQUxYLGVBQWUsSUFBTSxDQUNuQmIsR0FBU2EsR0FBT0QsRUFBUSxFQUN4QkEsR0FBV0MsRUFDbkIsQ0FBTyxFQUlIRixHQUFZLEVBQ2hCLENBQUcsRUFDRCxNQUFPLElBQU0sUUFBUUYsRUFBZSxDQUN0QyxDQUdBLFNBQVMsU0FBU0YsR0FBSU8sR0FBTUMsR0FBUyxDQUFBLEVBQUksQ0FDdkNSLEdBQUcsY0FDRCxJQUFJLFlBQVlPLEdBQU0sQ0FDcEIsT0FBQUMsR0FDQSxRQUFTLEdBRVQsU0FBVSxHQUNWLFdBQVksRUFDbEIsQ0FBSyxDQUNMLENBQ0EsQ0FHQSxTQUFTLEtBQUtSLEdBQUlQLEdBQVUsQ0FDMUIsR0FBSSxPQUFPLFlBQWUsWUFBY08sY0FBYyxXQUFZLENBQ2hFLE1BQU0sS0FBS0EsR0FBRyxRQUFRLEVBQUUsUUFBU1MsSUFBUSxLQUFLQSxHQUFLaEIsRUFBUSxDQUFDLEVBQzVELE1BQ0QsQ0FDRCxJQUFJaUIsR0FBTyxHQUVYLEdBREFqQixHQUFTTyxHQUFJLElBQU1VLEdBQU8sRUFBSSxFQUMxQkEsR0FDRixPQUNGLElBQUlDLEdBQU9YLEdBQUcsa0JBQ2QsS0FBT1csSUFDTCxLQUFLQSxHQUFNbEIsRUFBZSxFQUMxQmtCLEdBQU9BLEdBQUssa0JBRWhCLENBR0EsU0FBUyxLQUFLQyxNQUFZQyxHQUFNLENBQzlCLFFBQVEsS0FBSyxtQkFBbUJELEVBQU8sR0FBSSxHQUFHQyxFQUFJLENBQ3BELENBR0EsSUFBSSxRQUFVLEdBQ2QsU0FBUyxPQUFRLENBQ1gsU0FDRixLQUFLLDZHQUE2RyxFQUNwSCxRQUFVLEdBQ0wsU0FBUyxNQUNaLEtBQUsscUlBQXFJLEVBQzVJLFNBQVMsU0FBVSxhQUFhLEVBQ2hDLFNBQVMsU0FBVSxxQkFBcUIsRUFDeEMsMEJBQ0EsVUFBV2IsSUFBTyxTQUFTQSxHQUFJLElBQUksQ0FBQyxFQUNwQyxZQUFhQSxJQUFPLFlBQVlBLEVBQUUsQ0FBQyxFQUNuQyxrQkFBa0IsQ0FBQ0EsR0FBSWMsS0FBVSxDQUMvQixXQUFXZCxHQUFJYyxFQUFLLEVBQUUsUUFBU0MsSUFBV0EsR0FBTSxDQUFFLENBQ3RELENBQUcsRUFDRCxJQUFJQyxHQUF1QmhCLElBQU8sQ0FBQyxZQUFZQSxHQUFHLGNBQWUsRUFBSSxFQUNyRSxNQUFNLEtBQUssU0FBUyxpQkFBaUIsYUFBYyxFQUFDLEtBQUssR0FBRyxDQUFDLENBQUMsRUFBRSxPQUFPZ0IsRUFBbUIsRUFBRSxRQUFTaEIsSUFBTyxDQUMxRyxTQUFTQSxFQUFFLENBQ2YsQ0FBRyxFQUNELFNBQVMsU0FBVSxvQkFBb0IsQ0FDekMsQ0FDQSxJQUFJLHNCQUF3QixDQUFBLEVBQ3hCLHNCQUF3QixDQUFBLEVBQzVCLFNBQVMsZUFBZ0IsQ0FDdkIsT0FBTyxzQkFBc0IsSUFBS2lCLElBQU9BLEdBQUksQ0FBQSxDQUMvQyxDQUNBLFNBQVMsY0FBZSxDQUN0QixPQUFPLHNCQUFzQixPQUFPLHFCQUFxQixFQUFFLElBQUtBLElBQU9BLEdBQUUsQ0FBRSxDQUM3RSxDQUNBLFNBQVMsZ0JBQWdCQyxHQUFrQixDQUN6QyxzQkFBc0IsS0FBS0EsRUFBZ0IsQ0FDN0MsQ0FDQSxTQUFTLGdCQUFnQkEsR0FBa0IsQ0FDekMsc0JBQXNCLEtBQUtBLEVBQWdCLENBQzdDLENBQ0EsU0FBUyxZQUFZbEIsR0FBSW1CLEdBQXVCLEdBQU8sQ0FDckQsT0FBTyxZQUFZbkIsR0FBS29CLElBQVksQ0FFbEMsSUFEa0JELEdBQXVCLGFBQWMsRUFBRyxjQUFhLEdBQ3pELEtBQU1FLElBQWFELEdBQVEsUUFBUUMsRUFBUSxDQUFDLEVBQ3hELE1BQU8sRUFDYixDQUFHLENBQ0gsQ0FDQSxTQUFTLFlBQVlyQixHQUFJUCxHQUFVLENBQ2pDLEdBQUtPLEdBRUwsSUFBSVAsR0FBU08sRUFBRSxFQUNiLE9BQU9BLEdBR1QsR0FGSUEsR0FBRyxrQkFDTEEsR0FBS0EsR0FBRyxpQkFDTixFQUFDQSxHQUFHLGNBRVIsT0FBTyxZQUFZQSxHQUFHLGNBQWVQLEVBQVEsRUFDL0MsQ0FDQSxTQUFTLE9BQU9PLEdBQUksQ0FDbEIsT0FBTyxjQUFhLEVBQUcsS0FBTXFCLElBQWFyQixHQUFHLFFBQVFxQixFQUFRLENBQUMsQ0FDaEUsQ0FDQSxJQUFJLGlCQUFtQixDQUFBLEVBQ3ZCLFNBQVMsY0FBYzVCLEdBQVUsQ0FDL0IsaUJBQWlCLEtBQUtBLEVBQVEsQ0FDaEMsQ0FDQSxTQUFTLFNBQVNPLEdBQUlzQixHQUFTLEtBQU1DLEdBQVksSUFBTSxDQUN2RCxFQUFHLENBQ0Qsd0JBQXdCLElBQU0sQ0FDNUJELEdBQU90QixHQUFJLENBQUNTLEdBQUtDLEtBQVMsQ0FDeEJhLEdBQVVkLEdBQUtDLEVBQUksRUFDbkIsaUJBQWlCLFFBQVNkLElBQU1BLEdBQUVhLEdBQUtDLEVBQUksQ0FBQyxFQUM1QyxXQUFXRCxHQUFLQSxHQUFJLFVBQVUsRUFBRSxRQUFTTSxJQUFXQSxHQUFNLENBQUUsRUFDNUROLEdBQUksV0FBYUMsSUFDdkIsQ0FBSyxDQUNMLENBQUcsQ0FDSCxDQUNBLFNBQVMsWUFBWWMsR0FBTUYsR0FBUyxLQUFNLENBQ3hDQSxHQUFPRSxHQUFPeEIsSUFBTyxDQUNuQixrQkFBa0JBLEVBQUUsRUFDcEIsZUFBZUEsRUFBRSxDQUNyQixDQUFHLENBQ0gsQ0FHQSxJQUFJLGtCQUFvQixDQUFBLEVBQ3BCLGFBQWUsQ0FBQSxFQUNmLFdBQWEsQ0FBQSxFQUNqQixTQUFTLFVBQVVQLEdBQVUsQ0FDM0IsV0FBVyxLQUFLQSxFQUFRLENBQzFCLENBQ0EsU0FBUyxZQUFZTyxHQUFJUCxHQUFVLENBQzdCLE9BQU9BLElBQWEsWUFDakJPLEdBQUcsY0FDTkEsR0FBRyxZQUFjLElBQ25CQSxHQUFHLFlBQVksS0FBS1AsRUFBUSxJQUU1QkEsR0FBV08sR0FDWCxhQUFhLEtBQUtQLEVBQVEsRUFFOUIsQ0FDQSxTQUFTLGtCQUFrQkEsR0FBVSxDQUNuQyxrQkFBa0IsS0FBS0EsRUFBUSxDQUNqQyxDQUNBLFNBQVMsbUJBQW1CTyxHQUFJTyxHQUFNZCxHQUFVLENBQ3pDTyxHQUFHLHVCQUNOQSxHQUFHLHFCQUF1QixJQUN2QkEsR0FBRyxxQkFBcUJPLEVBQUksSUFDL0JQLEdBQUcscUJBQXFCTyxFQUFJLEVBQUksSUFDbENQLEdBQUcscUJBQXFCTyxFQUFJLEVBQUUsS0FBS2QsRUFBUSxDQUM3QyxDQUNBLFNBQVMsa0JBQWtCTyxHQUFJeUIsR0FBTyxDQUMvQnpCLEdBQUcsc0JBRVIsT0FBTyxRQUFRQSxHQUFHLG9CQUFvQixFQUFFLFFBQVEsQ0FBQyxDQUFDTyxHQUFNRCxFQUFLLElBQU0sRUFDN0RtQixLQUFVLFFBQVVBLEdBQU0sU0FBU2xCLEVBQUksS0FDekNELEdBQU0sUUFBU1YsSUFBTUEsR0FBRyxDQUFBLEVBQ3hCLE9BQU9JLEdBQUcscUJBQXFCTyxFQUFJLEVBRXpDLENBQUcsQ0FDSCxDQUNBLFNBQVMsZUFBZVAsR0FBSSxDQUMxQixHQUFJQSxHQUFHLFlBQ0wsS0FBT0EsR0FBRyxZQUFZLFFBQ3BCQSxHQUFHLFlBQVksSUFBRyxHQUV4QixDQUNBLElBQUksU0FBVyxJQUFJLGlCQUFpQixRQUFRLEVBQ3hDLG1CQUFxQixHQUN6QixTQUFTLHlCQUEwQixDQUNqQyxTQUFTLFFBQVEsU0FBVSxDQUFFLFFBQVMsR0FBTSxVQUFXLEdBQU0sV0FBWSxHQUFNLGtCQUFtQixFQUFNLENBQUEsRUFDeEcsbUJBQXFCLEVBQ3ZCLENBQ0EsU0FBUyx3QkFBeUIsQ0FDaEMsZ0JBQ0EsU0FBUyxXQUFVLEVBQ25CLG1CQUFxQixFQUN2QixDQUNBLElBQUksZ0JBQWtCLENBQUEsRUFDdEIsU0FBUyxlQUFnQixDQUN2QixJQUFJMEIsR0FBVSxTQUFTLGNBQ3ZCLGdCQUFnQixLQUFLLElBQU1BLEdBQVEsT0FBUyxHQUFLLFNBQVNBLEVBQU8sQ0FBQyxFQUNsRSxJQUFJQyxHQUEyQixnQkFBZ0IsT0FDL0MsZUFBZSxJQUFNLENBQ25CLEdBQUksZ0JBQWdCLFNBQVdBLEdBQzdCLEtBQU8sZ0JBQWdCLE9BQVMsR0FDOUIsZ0JBQWdCLE1BQUssR0FFN0IsQ0FBRyxDQUNILENBQ0EsU0FBUyxVQUFVbEMsR0FBVSxDQUMzQixHQUFJLENBQUMsbUJBQ0gsT0FBT0EsR0FBUSxFQUNqQix5QkFDQSxJQUFJbUMsR0FBU25DLEtBQ2IsaUNBQ09tQyxFQUNULENBQ0EsSUFBSSxhQUFlLEdBQ2Ysa0JBQW9CLENBQUEsRUFDeEIsU0FBUyxnQkFBaUIsQ0FDeEIsYUFBZSxFQUNqQixDQUNBLFNBQVMsZ0NBQWlDLENBQ3hDLGFBQWUsR0FDZixTQUFTLGlCQUFpQixFQUMxQixrQkFBb0IsQ0FBQSxDQUN0QixDQUNBLFNBQVMsU0FBU0MsR0FBVyxDQUMzQixHQUFJLGFBQWMsQ0FDaEIsa0JBQW9CLGtCQUFrQixPQUFPQSxFQUFTLEVBQ3RELE1BQ0QsQ0FDRCxJQUFJQyxHQUE2QixJQUFJLElBQ2pDQyxHQUErQixJQUFJLElBQ25DQyxHQUFrQyxJQUFJLElBQ3RDQyxHQUFvQyxJQUFJLElBQzVDLFFBQVNyQyxHQUFJLEVBQUdBLEdBQUlpQyxHQUFVLE9BQVFqQyxLQUNwQyxHQUFJLENBQUFpQyxHQUFVakMsRUFBQyxFQUFFLE9BQU8sNEJBRXBCaUMsR0FBVWpDLEVBQUMsRUFBRSxPQUFTLGNBQ3hCaUMsR0FBVWpDLEVBQUMsRUFBRSxXQUFXLFFBQVNlLElBQVNBLEdBQUssV0FBYSxHQUFLbUIsR0FBVyxJQUFJbkIsRUFBSSxDQUFDLEVBQ3JGa0IsR0FBVWpDLEVBQUMsRUFBRSxhQUFhLFFBQVNlLElBQVNBLEdBQUssV0FBYSxHQUFLb0IsR0FBYSxJQUFJcEIsRUFBSSxDQUFDLEdBRXZGa0IsR0FBVWpDLEVBQUMsRUFBRSxPQUFTLGNBQWMsQ0FDdEMsSUFBSUksR0FBSzZCLEdBQVVqQyxFQUFDLEVBQUUsT0FDbEJXLEdBQU9zQixHQUFVakMsRUFBQyxFQUFFLGNBQ3BCUyxHQUFXd0IsR0FBVWpDLEVBQUMsRUFBRSxTQUN4QnNDLEdBQU8sSUFBTSxDQUNWRixHQUFnQixJQUFJaEMsRUFBRSxHQUN6QmdDLEdBQWdCLElBQUloQyxHQUFJLENBQUEsQ0FBRSxFQUM1QmdDLEdBQWdCLElBQUloQyxFQUFFLEVBQUUsS0FBSyxDQUFFLEtBQUFPLEdBQU0sTUFBT1AsR0FBRyxhQUFhTyxFQUFJLENBQUcsQ0FBQSxDQUMzRSxFQUNVNEIsR0FBUyxJQUFNLENBQ1pGLEdBQWtCLElBQUlqQyxFQUFFLEdBQzNCaUMsR0FBa0IsSUFBSWpDLEdBQUksQ0FBQSxDQUFFLEVBQzlCaUMsR0FBa0IsSUFBSWpDLEVBQUUsRUFBRSxLQUFLTyxFQUFJLENBQzNDLEVBQ1VQLEdBQUcsYUFBYU8sRUFBSSxHQUFLRixLQUFhLEtBQ3hDNkIsS0FDU2xDLEdBQUcsYUFBYU8sRUFBSSxHQUM3QjRCLEtBQ0FELE1BRUFDLElBRUgsQ0FFSEYsR0FBa0IsUUFBUSxDQUFDbkIsR0FBT2QsS0FBTyxDQUN2QyxrQkFBa0JBLEdBQUljLEVBQUssQ0FDL0IsQ0FBRyxFQUNEa0IsR0FBZ0IsUUFBUSxDQUFDbEIsR0FBT2QsS0FBTyxDQUNyQyxrQkFBa0IsUUFBU0osSUFBTUEsR0FBRUksR0FBSWMsRUFBSyxDQUFDLENBQ2pELENBQUcsRUFDRCxRQUFTSCxNQUFRb0IsR0FDWEQsR0FBVyxJQUFJbkIsRUFBSSxJQUV2QixhQUFhLFFBQVNmLElBQU1BLEdBQUVlLEVBQUksQ0FBQyxFQUNuQyxZQUFZQSxFQUFJLEdBRWxCbUIsR0FBVyxRQUFTbkIsSUFBUyxDQUMzQkEsR0FBSyxjQUFnQixHQUNyQkEsR0FBSyxVQUFZLEVBQ3JCLENBQUcsRUFDRCxRQUFTQSxNQUFRbUIsR0FDWEMsR0FBYSxJQUFJcEIsRUFBSSxHQUVwQkEsR0FBSyxjQUVWLE9BQU9BLEdBQUssY0FDWixPQUFPQSxHQUFLLFVBQ1osV0FBVyxRQUFTZixJQUFNQSxHQUFFZSxFQUFJLENBQUMsRUFDakNBLEdBQUssVUFBWSxHQUNqQkEsR0FBSyxjQUFnQixJQUV2Qm1CLEdBQVcsUUFBU25CLElBQVMsQ0FDM0IsT0FBT0EsR0FBSyxjQUNaLE9BQU9BLEdBQUssU0FDaEIsQ0FBRyxFQUNEbUIsR0FBYSxLQUNiQyxHQUFlLEtBQ2ZDLEdBQWtCLEtBQ2xCQyxHQUFvQixJQUN0QixDQUdBLFNBQVMsTUFBTXRCLEdBQU0sQ0FDbkIsT0FBTyxhQUFhLGlCQUFpQkEsRUFBSSxDQUFDLENBQzVDLENBQ0EsU0FBUyxlQUFlQSxHQUFNeUIsR0FBT0MsR0FBZSxDQUNsRCxPQUFBMUIsR0FBSyxhQUFlLENBQUN5QixHQUFPLEdBQUcsaUJBQWlCQyxJQUFpQjFCLEVBQUksQ0FBQyxFQUMvRCxJQUFNLENBQ1hBLEdBQUssYUFBZUEsR0FBSyxhQUFhLE9BQVFmLElBQU1BLEtBQU13QyxFQUFLLENBQ25FLENBQ0EsQ0FDQSxTQUFTLGlCQUFpQnpCLEdBQU0sQ0FDOUIsT0FBSUEsR0FBSyxhQUNBQSxHQUFLLGFBQ1YsT0FBTyxZQUFlLFlBQWNBLGNBQWdCLFdBQy9DLGlCQUFpQkEsR0FBSyxJQUFJLEVBRTlCQSxHQUFLLFdBR0gsaUJBQWlCQSxHQUFLLFVBQVUsRUFGOUIsRUFHWCxDQUNBLFNBQVMsYUFBYTJCLEdBQVMsQ0FDN0IsT0FBTyxJQUFJLE1BQU0sQ0FBRSxRQUFBQSxFQUFTLEVBQUUsY0FBYyxDQUM5QyxDQUNBLElBQUksZUFBaUIsQ0FDbkIsUUFBUSxDQUFFLFFBQUFBLElBQVcsQ0FDbkIsT0FBTyxNQUFNLEtBQ1gsSUFBSSxJQUFJQSxHQUFRLFFBQVMxQyxJQUFNLE9BQU8sS0FBS0EsRUFBQyxDQUFDLENBQUMsQ0FDcEQsQ0FDRyxFQUNELElBQUksQ0FBRSxRQUFBMEMsRUFBUyxFQUFFL0IsR0FBTSxDQUNyQixPQUFJQSxJQUFRLE9BQU8sWUFDVixHQUNGK0IsR0FBUSxLQUNaQyxJQUFRLE9BQU8sVUFBVSxlQUFlLEtBQUtBLEdBQUtoQyxFQUFJLEdBQUssUUFBUSxJQUFJZ0MsR0FBS2hDLEVBQUksQ0FDdkYsQ0FDRyxFQUNELElBQUksQ0FBRSxRQUFBK0IsSUFBVy9CLEdBQU1pQyxHQUFXLENBQ2hDLE9BQUlqQyxJQUFRLFNBQ0gsZ0JBQ0YsUUFBUSxJQUNiK0IsR0FBUSxLQUNMQyxJQUFRLFFBQVEsSUFBSUEsR0FBS2hDLEVBQUksQ0FDdEMsR0FBVyxDQUFFLEVBQ1BBLEdBQ0FpQyxFQUNOLENBQ0csRUFDRCxJQUFJLENBQUUsUUFBQUYsRUFBTyxFQUFJL0IsR0FBTUQsR0FBT2tDLEdBQVcsQ0FDdkMsTUFBTUMsR0FBU0gsR0FBUSxLQUNwQkMsSUFBUSxPQUFPLFVBQVUsZUFBZSxLQUFLQSxHQUFLaEMsRUFBSSxDQUN4RCxHQUFJK0IsR0FBUUEsR0FBUSxPQUFTLENBQUMsRUFDekJJLEdBQWEsT0FBTyx5QkFBeUJELEdBQVFsQyxFQUFJLEVBQy9ELE9BQUltQyxJQUFBLE1BQUFBLEdBQVksTUFBT0EsSUFBQSxNQUFBQSxHQUFZLEtBQzFCLFFBQVEsSUFBSUQsR0FBUWxDLEdBQU1ELEdBQU9rQyxFQUFTLEVBQzVDLFFBQVEsSUFBSUMsR0FBUWxDLEdBQU1ELEVBQUssQ0FDdkMsQ0FDSCxFQUNBLFNBQVMsaUJBQWtCLENBRXpCLE9BRFcsUUFBUSxRQUFRLElBQUksRUFDbkIsT0FBTyxDQUFDcUMsR0FBS0MsTUFDdkJELEdBQUlDLEVBQUcsRUFBSSxRQUFRLElBQUksS0FBTUEsRUFBRyxFQUN6QkQsSUFDTixDQUFFLENBQUEsQ0FDUCxDQUdBLFNBQVMsa0JBQWtCUCxHQUFPLENBQ2hDLElBQUlTLEdBQWFDLElBQVEsT0FBT0EsSUFBUSxVQUFZLENBQUMsTUFBTSxRQUFRQSxFQUFHLEdBQUtBLEtBQVEsS0FDL0VDLEdBQVUsQ0FBQ1IsR0FBS1MsR0FBVyxLQUFPLENBQ3BDLE9BQU8sUUFBUSxPQUFPLDBCQUEwQlQsRUFBRyxDQUFDLEVBQUUsUUFBUSxDQUFDLENBQUNLLEdBQUssQ0FBRSxNQUFBdEMsR0FBTyxXQUFBMkMsRUFBWSxDQUFBLElBQU0sQ0FHOUYsR0FGSUEsS0FBZSxJQUFTM0MsS0FBVSxRQUVsQyxPQUFPQSxJQUFVLFVBQVlBLEtBQVUsTUFBUUEsR0FBTSxTQUN2RCxPQUNGLElBQUk0QyxHQUFPRixLQUFhLEdBQUtKLEdBQU0sR0FBR0ksRUFBUSxJQUFJSixFQUFHLEdBQ2pELE9BQU90QyxJQUFVLFVBQVlBLEtBQVUsTUFBUUEsR0FBTSxlQUN2RGlDLEdBQUlLLEVBQUcsRUFBSXRDLEdBQU0sV0FBVzhCLEdBQU9jLEdBQU1OLEVBQUcsRUFFeENDLEdBQVV2QyxFQUFLLEdBQUtBLEtBQVVpQyxJQUFPLEVBQUVqQyxjQUFpQixVQUMxRHlDLEdBQVF6QyxHQUFPNEMsRUFBSSxDQUc3QixDQUFLLENBQ0wsRUFDRSxPQUFPSCxHQUFRWCxFQUFLLENBQ3RCLENBQ0EsU0FBUyxZQUFZM0MsR0FBVTBELEdBQVksSUFBTSxDQUNqRCxFQUFHLENBQ0QsSUFBSVosR0FBTSxDQUNSLGFBQWMsT0FDZCxlQUFnQixHQUNoQixXQUFXSCxHQUFPYyxHQUFNTixHQUFLLENBQzNCLE9BQU9uRCxHQUFTLEtBQUssYUFBYyxJQUFNLElBQUkyQyxHQUFPYyxFQUFJLEVBQUk1QyxJQUFVLElBQUk4QixHQUFPYyxHQUFNNUMsRUFBSyxFQUFHNEMsR0FBTU4sRUFBRyxDQUN6RyxDQUNMLEVBQ0UsT0FBQU8sR0FBVVosRUFBRyxFQUNMYSxJQUFpQixDQUN2QixHQUFJLE9BQU9BLElBQWlCLFVBQVlBLEtBQWlCLE1BQVFBLEdBQWEsZUFBZ0IsQ0FDNUYsSUFBSUMsR0FBYWQsR0FBSSxXQUFXLEtBQUtBLEVBQUcsRUFDeENBLEdBQUksV0FBYSxDQUFDSCxHQUFPYyxHQUFNTixLQUFRLENBQ3JDLElBQUlVLEdBQWFGLEdBQWEsV0FBV2hCLEdBQU9jLEdBQU1OLEVBQUcsRUFDekQsT0FBQUwsR0FBSSxhQUFlZSxHQUNaRCxHQUFXakIsR0FBT2MsR0FBTU4sRUFBRyxDQUMxQyxDQUNBLE1BQ01MLEdBQUksYUFBZWEsR0FFckIsT0FBT2IsRUFDWCxDQUNBLENBQ0EsU0FBUyxJQUFJQSxHQUFLVyxHQUFNLENBQ3RCLE9BQU9BLEdBQUssTUFBTSxHQUFHLEVBQUUsT0FBTyxDQUFDSyxHQUFPQyxLQUFZRCxHQUFNQyxFQUFPLEVBQUdqQixFQUFHLENBQ3ZFLENBQ0EsU0FBUyxJQUFJQSxHQUFLVyxHQUFNNUMsR0FBTyxDQUc3QixHQUZJLE9BQU80QyxJQUFTLFdBQ2xCQSxHQUFPQSxHQUFLLE1BQU0sR0FBRyxHQUNuQkEsR0FBSyxTQUFXLEVBQ2xCWCxHQUFJVyxHQUFLLENBQUMsQ0FBQyxFQUFJNUMsT0FDWixJQUFJNEMsR0FBSyxTQUFXLEVBQ3ZCLE1BQU0sTUFFTixPQUFJWCxHQUFJVyxHQUFLLENBQUMsQ0FBQyxJQUdiWCxHQUFJVyxHQUFLLENBQUMsQ0FBQyxFQUFJLENBQUEsR0FDUixJQUFJWCxHQUFJVyxHQUFLLENBQUMsQ0FBQyxFQUFHQSxHQUFLLE1BQU0sQ0FBQyxFQUFHNUMsRUFBSyxFQUduRCxDQUdBLElBQUksT0FBUyxDQUFBLEVBQ2IsU0FBUyxNQUFNQyxHQUFNZCxHQUFVLENBQzdCLE9BQU9jLEVBQUksRUFBSWQsRUFDakIsQ0FDQSxTQUFTLGFBQWE4QyxHQUFLdkMsR0FBSSxDQUM3QixjQUFPLFFBQVEsTUFBTSxFQUFFLFFBQVEsQ0FBQyxDQUFDTyxHQUFNZCxFQUFRLElBQU0sQ0FDbkQsSUFBSWdFLEdBQW9CLEtBQ3hCLFNBQVNDLElBQWUsQ0FDdEIsR0FBSUQsR0FDRixPQUFPQSxHQUNGLENBQ0wsR0FBSSxDQUFDRSxHQUFXMUQsRUFBUSxFQUFJLHlCQUF5QkQsRUFBRSxFQUN2RCxPQUFBeUQsR0FBb0IsQ0FBRSxZQUFhLEdBQUdFLElBQ3RDLFlBQVkzRCxHQUFJQyxFQUFRLEVBQ2pCd0QsRUFDUixDQUNGLENBQ0QsT0FBTyxlQUFlbEIsR0FBSyxJQUFJaEMsRUFBSSxHQUFJLENBQ3JDLEtBQU0sQ0FDSixPQUFPZCxHQUFTTyxHQUFJMEQsR0FBWSxDQUFFLENBQ25DLEVBQ0QsV0FBWSxFQUNsQixDQUFLLENBQ0wsQ0FBRyxFQUNNbkIsRUFDVCxDQUdBLFNBQVMsU0FBU3ZDLEdBQUk0RCxHQUFZbkUsTUFBYW9CLEdBQU0sQ0FDbkQsR0FBSSxDQUNGLE9BQU9wQixHQUFTLEdBQUdvQixFQUFJLENBQ3hCLE9BQVFnRCxHQUFHLENBQ1YsWUFBWUEsR0FBRzdELEdBQUk0RCxFQUFVLENBQzlCLENBQ0gsQ0FDQSxTQUFTLFlBQVlFLEdBQVE5RCxHQUFJNEQsR0FBYSxPQUFRLENBQ3BERSxHQUFTLE9BQU8sT0FDZEEsSUFBVSxDQUFFLFFBQVMseUJBQTJCLEVBQ2hELENBQUUsR0FBQTlELEdBQUksV0FBQTRELEVBQVksQ0FDdEIsRUFDRSxRQUFRLEtBQUssNEJBQTRCRSxHQUFPLE9BQU87QUFBQTtBQUFBLEVBRXZERixHQUFhLGdCQUFrQkEsR0FBYTtBQUFBO0FBQUEsRUFBVSxFQUFFLEdBQUk1RCxFQUFFLEVBQzlELFdBQVcsSUFBTSxDQUNmLE1BQU04RCxFQUNQLEVBQUUsQ0FBQyxDQUNOLENBR0EsSUFBSSw0QkFBOEIsR0FDbEMsU0FBUywwQkFBMEJyRSxHQUFVLENBQzNDLElBQUlzRSxHQUFRLDRCQUNaLDRCQUE4QixHQUM5QixJQUFJbkMsR0FBU25DLEtBQ2IsbUNBQThCc0UsR0FDdkJuQyxFQUNULENBQ0EsU0FBUyxTQUFTNUIsR0FBSTRELEdBQVlJLEdBQVMsQ0FBQSxFQUFJLENBQzdDLElBQUlwQyxHQUNKLHFCQUFjNUIsR0FBSTRELEVBQVUsRUFBR3RELElBQVVzQixHQUFTdEIsR0FBTzBELEVBQU0sRUFDeERwQyxFQUNULENBQ0EsU0FBUyxpQkFBaUJmLEdBQU0sQ0FDOUIsT0FBTyxxQkFBcUIsR0FBR0EsRUFBSSxDQUNyQyxDQUNBLElBQUkscUJBQXVCLGdCQUMzQixTQUFTLGFBQWFvRCxHQUFjLENBQ2xDLHFCQUF1QkEsRUFDekIsQ0FDQSxTQUFTLGdCQUFnQmpFLEdBQUk0RCxHQUFZLENBQ3ZDLElBQUlNLEdBQW1CLENBQUEsRUFDdkIsYUFBYUEsR0FBa0JsRSxFQUFFLEVBQ2pDLElBQUltRSxHQUFZLENBQUNELEdBQWtCLEdBQUcsaUJBQWlCbEUsRUFBRSxDQUFDLEVBQ3REb0UsR0FBWSxPQUFPUixJQUFlLFdBQWEsOEJBQThCTyxHQUFXUCxFQUFVLEVBQUksNEJBQTRCTyxHQUFXUCxHQUFZNUQsRUFBRSxFQUMvSixPQUFPLFNBQVMsS0FBSyxLQUFNQSxHQUFJNEQsR0FBWVEsRUFBUyxDQUN0RCxDQUNBLFNBQVMsOEJBQThCRCxHQUFXRSxHQUFNLENBQ3RELE1BQU8sQ0FBQ0MsR0FBVyxJQUFNLENBQzNCLEVBQUssQ0FBRSxNQUFPQyxHQUFTLENBQUEsRUFBSSxPQUFBQyxHQUFTLENBQUEsQ0FBSSxFQUFHLEtBQU8sQ0FDOUMsSUFBSTVDLEdBQVN5QyxHQUFLLE1BQU0sYUFBYSxDQUFDRSxHQUFRLEdBQUdKLEVBQVMsQ0FBQyxFQUFHSyxFQUFNLEVBQ3BFLG9CQUFvQkYsR0FBVTFDLEVBQU0sQ0FDeEMsQ0FDQSxDQUNBLElBQUksY0FBZ0IsQ0FBQSxFQUNwQixTQUFTLDJCQUEyQmdDLEdBQVk1RCxHQUFJLENBQ2xELEdBQUksY0FBYzRELEVBQVUsRUFDMUIsT0FBTyxjQUFjQSxFQUFVLEVBRWpDLElBQUlhLEdBQWdCLE9BQU8sZUFBZSxnQkFBaUIsQ0FDMUQsQ0FBQSxFQUFFLFlBQ0NDLEdBQTBCLHFCQUFxQixLQUFLZCxHQUFXLEtBQUksQ0FBRSxHQUFLLGlCQUFpQixLQUFLQSxHQUFXLEtBQU0sQ0FBQSxFQUFJLGVBQWVBLEVBQVUsUUFBVUEsR0FnQnhKUyxJQWZzQixJQUFNLENBQzlCLEdBQUksQ0FDRixJQUFJTSxHQUFRLElBQUlGLEdBQ2QsQ0FBQyxTQUFVLE9BQU8sRUFDbEIsa0NBQWtDQyxFQUF1QixtREFDakUsRUFDTSxjQUFPLGVBQWVDLEdBQU8sT0FBUSxDQUNuQyxNQUFPLFlBQVlmLEVBQVUsRUFDckMsQ0FBTyxFQUNNZSxFQUNSLE9BQVFiLEdBQVEsQ0FDZixtQkFBWUEsR0FBUTlELEdBQUk0RCxFQUFVLEVBQzNCLFFBQVEsU0FDaEIsQ0FDTCxLQUVFLHFCQUFjQSxFQUFVLEVBQUlTLEdBQ3JCQSxFQUNULENBQ0EsU0FBUyw0QkFBNEJGLEdBQVdQLEdBQVk1RCxHQUFJLENBQzlELElBQUlxRSxHQUFPLDJCQUEyQlQsR0FBWTVELEVBQUUsRUFDcEQsTUFBTyxDQUFDc0UsR0FBVyxJQUFNLENBQzNCLEVBQUssQ0FBRSxNQUFPQyxHQUFTLENBQUEsRUFBSSxPQUFBQyxHQUFTLENBQUEsQ0FBSSxFQUFHLEtBQU8sQ0FDOUNILEdBQUssT0FBUyxPQUNkQSxHQUFLLFNBQVcsR0FDaEIsSUFBSU8sR0FBZ0IsYUFBYSxDQUFDTCxHQUFRLEdBQUdKLEVBQVMsQ0FBQyxFQUN2RCxHQUFJLE9BQU9FLElBQVMsV0FBWSxDQUM5QixJQUFJUSxHQUFVUixHQUFLQSxHQUFNTyxFQUFhLEVBQUUsTUFBT2QsSUFBVyxZQUFZQSxHQUFROUQsR0FBSTRELEVBQVUsQ0FBQyxFQUN6RlMsR0FBSyxVQUNQLG9CQUFvQkMsR0FBVUQsR0FBSyxPQUFRTyxHQUFlSixHQUFReEUsRUFBRSxFQUNwRXFFLEdBQUssT0FBUyxRQUVkUSxHQUFRLEtBQU1qRCxJQUFXLENBQ3ZCLG9CQUFvQjBDLEdBQVUxQyxHQUFRZ0QsR0FBZUosR0FBUXhFLEVBQUUsQ0FDaEUsQ0FBQSxFQUFFLE1BQU84RCxJQUFXLFlBQVlBLEdBQVE5RCxHQUFJNEQsRUFBVSxDQUFDLEVBQUUsUUFBUSxJQUFNUyxHQUFLLE9BQVMsTUFBTSxDQUUvRixDQUNMLENBQ0EsQ0FDQSxTQUFTLG9CQUFvQkMsR0FBVWhFLEdBQU9pRSxHQUFRQyxHQUFReEUsR0FBSSxDQUNoRSxHQUFJLDZCQUErQixPQUFPTSxJQUFVLFdBQVksQ0FDOUQsSUFBSXNCLEdBQVN0QixHQUFNLE1BQU1pRSxHQUFRQyxFQUFNLEVBQ25DNUMsY0FBa0IsUUFDcEJBLEdBQU8sS0FBTWhDLElBQU0sb0JBQW9CMEUsR0FBVTFFLEdBQUcyRSxHQUFRQyxFQUFNLENBQUMsRUFBRSxNQUFPVixJQUFXLFlBQVlBLEdBQVE5RCxHQUFJTSxFQUFLLENBQUMsRUFFckhnRSxHQUFTMUMsRUFBTSxDQUVsQixNQUFVLE9BQU90QixJQUFVLFVBQVlBLGNBQWlCLFFBQ3ZEQSxHQUFNLEtBQU1WLElBQU0wRSxHQUFTMUUsRUFBQyxDQUFDLEVBRTdCMEUsR0FBU2hFLEVBQUssQ0FFbEIsQ0FHQSxJQUFJLGVBQWlCLEtBQ3JCLFNBQVMsT0FBT3dFLEdBQVUsR0FBSSxDQUM1QixPQUFPLGVBQWlCQSxFQUMxQixDQUNBLFNBQVMsVUFBVUMsR0FBVyxDQUM1QixlQUFpQkEsRUFDbkIsQ0FDQSxJQUFJLGtCQUFvQixDQUFBLEVBQ3hCLFNBQVMsVUFBVXhFLEdBQU1kLEdBQVUsQ0FDakMseUJBQWtCYyxFQUFJLEVBQUlkLEdBQ25CLENBQ0wsT0FBT3VGLEdBQVksQ0FDakIsR0FBSSxDQUFDLGtCQUFrQkEsRUFBVSxFQUFHLENBQ2xDLFFBQVEsS0FBSyxPQUFPLDhCQUE4QkEsRUFBVSxTQUFTekUsRUFBSSw0Q0FBNEMsRUFDckgsTUFDRCxDQUNELE1BQU0wRSxHQUFNLGVBQWUsUUFBUUQsRUFBVSxFQUM3QyxlQUFlLE9BQU9DLElBQU8sRUFBSUEsR0FBTSxlQUFlLFFBQVEsU0FBUyxFQUFHLEVBQUcxRSxFQUFJLENBQ2xGLENBQ0wsQ0FDQSxDQUNBLFNBQVMsV0FBV1AsR0FBSWtGLEdBQVlDLEdBQTJCLENBRTdELEdBREFELEdBQWEsTUFBTSxLQUFLQSxFQUFVLEVBQzlCbEYsR0FBRyxxQkFBc0IsQ0FDM0IsSUFBSW9GLEdBQWMsT0FBTyxRQUFRcEYsR0FBRyxvQkFBb0IsRUFBRSxJQUFJLENBQUMsQ0FBQ08sR0FBTUQsRUFBSyxLQUFPLENBQUUsS0FBQUMsR0FBTSxNQUFBRCxFQUFLLEVBQUcsRUFDOUYrRSxHQUFtQixlQUFlRCxFQUFXLEVBQ2pEQSxHQUFjQSxHQUFZLElBQUtFLElBQ3pCRCxHQUFpQixLQUFNRSxJQUFTQSxHQUFLLE9BQVNELEdBQVUsSUFBSSxFQUN2RCxDQUNMLEtBQU0sVUFBVUEsR0FBVSxJQUFJLEdBQzlCLE1BQU8sSUFBSUEsR0FBVSxLQUFLLEdBQ3BDLEVBRWFBLEVBQ1IsRUFDREosR0FBYUEsR0FBVyxPQUFPRSxFQUFXLENBQzNDLENBQ0QsSUFBSUksR0FBMEIsQ0FBQSxFQUU5QixPQURrQk4sR0FBVyxJQUFJLHdCQUF3QixDQUFDTyxHQUFTQyxLQUFZRixHQUF3QkMsRUFBTyxFQUFJQyxFQUFPLENBQUMsRUFBRSxPQUFPLHNCQUFzQixFQUFFLElBQUksbUJBQW1CRixHQUF5QkwsRUFBeUIsQ0FBQyxFQUFFLEtBQUssVUFBVSxFQUNuTyxJQUFLSCxJQUNmLG9CQUFvQmhGLEdBQUlnRixFQUFVLENBQzFDLENBQ0gsQ0FDQSxTQUFTLGVBQWVFLEdBQVksQ0FDbEMsT0FBTyxNQUFNLEtBQUtBLEVBQVUsRUFBRSxJQUFJLHdCQUF1QixDQUFFLEVBQUUsT0FBUUssSUFBUyxDQUFDLHVCQUF1QkEsRUFBSSxDQUFDLENBQzdHLENBQ0EsSUFBSSxvQkFBc0IsR0FDdEIsdUJBQXlDLElBQUksSUFDN0MsdUJBQXlCLE9BQU0sRUFDbkMsU0FBUyx3QkFBd0I5RixHQUFVLENBQ3pDLG9CQUFzQixHQUN0QixJQUFJbUQsR0FBTSxTQUNWLHVCQUF5QkEsR0FDekIsdUJBQXVCLElBQUlBLEdBQUssQ0FBQSxDQUFFLEVBQ2xDLElBQUkrQyxHQUFnQixJQUFNLENBQ3hCLEtBQU8sdUJBQXVCLElBQUkvQyxFQUFHLEVBQUUsUUFDckMsdUJBQXVCLElBQUlBLEVBQUcsRUFBRSxNQUFPLEVBQUEsRUFDekMsdUJBQXVCLE9BQU9BLEVBQUcsQ0FDckMsRUFDTWdELEdBQWdCLElBQU0sQ0FDeEIsb0JBQXNCLEdBQ3RCRCxJQUNKLEVBQ0VsRyxHQUFTa0csRUFBYSxFQUN0QkMsSUFDRixDQUNBLFNBQVMseUJBQXlCNUYsR0FBSSxDQUNwQyxJQUFJNkYsR0FBVyxDQUFBLEVBQ1g1RixHQUFZUixJQUFhb0csR0FBUyxLQUFLcEcsRUFBUSxFQUMvQyxDQUFDcUcsR0FBU0MsRUFBYSxFQUFJLG1CQUFtQi9GLEVBQUUsRUFDcEQsT0FBQTZGLEdBQVMsS0FBS0UsRUFBYSxFQVNwQixDQVJTLENBQ2QsT0FBUSxlQUNSLE9BQVFELEdBQ1IsUUFBUzdGLEdBQ1QsY0FBZSxjQUFjLEtBQUssY0FBZUQsRUFBRSxFQUNuRCxTQUFVLFNBQVMsS0FBSyxTQUFVQSxFQUFFLENBQ3hDLEVBQ2tCLElBQU02RixHQUFTLFFBQVNqRyxJQUFNQSxHQUFDLENBQUUsQ0FDckIsQ0FDOUIsQ0FDQSxTQUFTLG9CQUFvQkksR0FBSWdGLEdBQVksQ0FDM0MsSUFBSWdCLEdBQU8sSUFBTSxDQUNuQixFQUNNQyxHQUFXLGtCQUFrQmpCLEdBQVcsSUFBSSxHQUFLZ0IsR0FDakQsQ0FBQ3JDLEdBQVcxRCxFQUFRLEVBQUkseUJBQXlCRCxFQUFFLEVBQ3ZELG1CQUFtQkEsR0FBSWdGLEdBQVcsU0FBVS9FLEVBQVEsRUFDcEQsSUFBSWlHLEdBQWMsSUFBTSxDQUNsQmxHLEdBQUcsV0FBYUEsR0FBRyxnQkFFdkJpRyxHQUFTLFFBQVVBLEdBQVMsT0FBT2pHLEdBQUlnRixHQUFZckIsRUFBUyxFQUM1RHNDLEdBQVdBLEdBQVMsS0FBS0EsR0FBVWpHLEdBQUlnRixHQUFZckIsRUFBUyxFQUM1RCxvQkFBc0IsdUJBQXVCLElBQUksc0JBQXNCLEVBQUUsS0FBS3NDLEVBQVEsRUFBSUEsS0FDOUYsRUFDRSxPQUFBQyxHQUFZLFlBQWNqRyxHQUNuQmlHLEVBQ1QsQ0FDQSxJQUFJLGFBQWUsQ0FBQ3BCLEdBQVNxQixLQUFnQixDQUFDLENBQUUsS0FBQTVGLEdBQU0sTUFBQUQsT0FDaERDLEdBQUssV0FBV3VFLEVBQU8sSUFDekJ2RSxHQUFPQSxHQUFLLFFBQVF1RSxHQUFTcUIsRUFBVyxHQUNuQyxDQUFFLEtBQUE1RixHQUFNLE1BQUFELEtBRWIsS0FBUVYsSUFBTUEsR0FDbEIsU0FBUyx3QkFBd0JILEdBQVcsSUFBTSxDQUNsRCxFQUFHLENBQ0QsTUFBTyxDQUFDLENBQUUsS0FBQWMsR0FBTSxNQUFBRCxNQUFZLENBQzFCLEdBQUksQ0FBRSxLQUFNbUYsR0FBUyxNQUFPVyxJQUFhLHNCQUFzQixPQUFPLENBQUM3QyxHQUFPOEMsS0FDckVBLEdBQVU5QyxFQUFLLEVBQ3JCLENBQUUsS0FBQWhELEdBQU0sTUFBQUQsRUFBSyxDQUFFLEVBQ2xCLE9BQUltRixLQUFZbEYsSUFDZGQsR0FBU2dHLEdBQVNsRixFQUFJLEVBQ2pCLENBQUUsS0FBTWtGLEdBQVMsTUFBT1csRUFBUSxDQUMzQyxDQUNBLENBQ0EsSUFBSSxzQkFBd0IsQ0FBQSxFQUM1QixTQUFTLGNBQWMzRyxHQUFVLENBQy9CLHNCQUFzQixLQUFLQSxFQUFRLENBQ3JDLENBQ0EsU0FBUyx1QkFBdUIsQ0FBRSxLQUFBYyxJQUFRLENBQ3hDLE9BQU8scUJBQXNCLEVBQUMsS0FBS0EsRUFBSSxDQUN6QyxDQUNBLElBQUkscUJBQXVCLElBQU0sSUFBSSxPQUFPLElBQUksY0FBYyxjQUFjLEVBQzVFLFNBQVMsbUJBQW1CaUYsR0FBeUJMLEdBQTJCLENBQzlFLE1BQU8sQ0FBQyxDQUFFLEtBQUE1RSxHQUFNLE1BQUFELE1BQVksQ0FDMUIsSUFBSWdHLEdBQVkvRixHQUFLLE1BQU0scUJBQXNCLENBQUEsRUFDN0NnRyxHQUFhaEcsR0FBSyxNQUFNLHFCQUFxQixFQUM3Q2lHLEdBQVlqRyxHQUFLLE1BQU0sdUJBQXVCLEdBQUssQ0FBQSxFQUNuRGtHLEdBQVd0QixJQUE2QkssR0FBd0JqRixFQUFJLEdBQUtBLEdBQzdFLE1BQU8sQ0FDTCxLQUFNK0YsR0FBWUEsR0FBVSxDQUFDLEVBQUksS0FDakMsTUFBT0MsR0FBYUEsR0FBVyxDQUFDLEVBQUksS0FDcEMsVUFBV0MsR0FBVSxJQUFLNUcsSUFBTUEsR0FBRSxRQUFRLElBQUssRUFBRSxDQUFDLEVBQ2xELFdBQVlVLEdBQ1osU0FBQW1HLEVBQ04sQ0FDQSxDQUNBLENBQ0EsSUFBSSxRQUFVLFVBQ1YsZUFBaUIsQ0FDbkIsU0FDQSxNQUNBLE9BQ0EsS0FDQSxTQUNBLE9BQ0EsT0FDQSxNQUNBLFFBQ0EsWUFDQSxhQUNBLE9BQ0EsS0FDQSxRQUNBLFVBQ0YsRUFDQSxTQUFTLFdBQVdDLEdBQUdDLEdBQUcsQ0FDeEIsSUFBSUMsR0FBUSxlQUFlLFFBQVFGLEdBQUUsSUFBSSxJQUFNLEdBQUssUUFBVUEsR0FBRSxLQUM1REcsR0FBUSxlQUFlLFFBQVFGLEdBQUUsSUFBSSxJQUFNLEdBQUssUUFBVUEsR0FBRSxLQUNoRSxPQUFPLGVBQWUsUUFBUUMsRUFBSyxFQUFJLGVBQWUsUUFBUUMsRUFBSyxDQUNyRSxDQUdBLElBQUksVUFBWSxDQUFBLEVBQ1osVUFBWSxHQUNoQixTQUFTLFNBQVNwSCxHQUFXLElBQU0sQ0FDbkMsRUFBRyxDQUNELHNCQUFlLElBQU0sQ0FDbkIsV0FBYSxXQUFXLElBQU0sQ0FDNUIsa0JBQ04sQ0FBSyxDQUNMLENBQUcsRUFDTSxJQUFJLFFBQVNxSCxJQUFRLENBQzFCLFVBQVUsS0FBSyxJQUFNLENBQ25CckgsS0FDQXFILElBQ04sQ0FBSyxDQUNMLENBQUcsQ0FDSCxDQUNBLFNBQVMsa0JBQW1CLENBRTFCLElBREEsVUFBWSxHQUNMLFVBQVUsUUFDZixVQUFVLE1BQUssR0FDbkIsQ0FDQSxTQUFTLGVBQWdCLENBQ3ZCLFVBQVksRUFDZCxDQUdBLFNBQVMsV0FBVzlHLEdBQUlNLEdBQU8sQ0FDN0IsT0FBSSxNQUFNLFFBQVFBLEVBQUssRUFDZCxxQkFBcUJOLEdBQUlNLEdBQU0sS0FBSyxHQUFHLENBQUMsRUFDdEMsT0FBT0EsSUFBVSxVQUFZQSxLQUFVLEtBQ3pDLHFCQUFxQk4sR0FBSU0sRUFBSyxFQUM1QixPQUFPQSxJQUFVLFdBQ25CLFdBQVdOLEdBQUlNLEdBQUssQ0FBRSxFQUV4QixxQkFBcUJOLEdBQUlNLEVBQUssQ0FDdkMsQ0FDQSxTQUFTLHFCQUFxQk4sR0FBSStHLEdBQWEsQ0FFN0MsSUFBSUMsR0FBa0JDLElBQWlCQSxHQUFhLE1BQU0sR0FBRyxFQUFFLE9BQVFySCxJQUFNLENBQUNJLEdBQUcsVUFBVSxTQUFTSixFQUFDLENBQUMsRUFBRSxPQUFPLE9BQU8sRUFDbEhzSCxHQUEyQkMsS0FDN0JuSCxHQUFHLFVBQVUsSUFBSSxHQUFHbUgsRUFBTyxFQUNwQixJQUFNLENBQ1huSCxHQUFHLFVBQVUsT0FBTyxHQUFHbUgsRUFBTyxDQUNwQyxHQUVFLE9BQUFKLEdBQWNBLEtBQWdCLEdBQU9BLEdBQWMsR0FBS0EsSUFBZSxHQUNoRUcsR0FBd0JGLEdBQWVELEVBQVcsQ0FBQyxDQUM1RCxDQUNBLFNBQVMscUJBQXFCL0csR0FBSW9ILEdBQWEsQ0FDN0MsSUFBSUMsR0FBU04sSUFBZ0JBLEdBQVksTUFBTSxHQUFHLEVBQUUsT0FBTyxPQUFPLEVBQzlETyxHQUFTLE9BQU8sUUFBUUYsRUFBVyxFQUFFLFFBQVEsQ0FBQyxDQUFDTCxHQUFhUSxFQUFJLElBQU1BLEdBQU9GLEdBQU1OLEVBQVcsRUFBSSxFQUFLLEVBQUUsT0FBTyxPQUFPLEVBQ3ZIUyxHQUFZLE9BQU8sUUFBUUosRUFBVyxFQUFFLFFBQVEsQ0FBQyxDQUFDTCxHQUFhUSxFQUFJLElBQU9BLEdBQTRCLEdBQXJCRixHQUFNTixFQUFXLENBQVMsRUFBRSxPQUFPLE9BQU8sRUFDM0hVLEdBQVEsQ0FBQSxFQUNSQyxHQUFVLENBQUEsRUFDZCxPQUFBRixHQUFVLFFBQVM1SCxJQUFNLENBQ25CSSxHQUFHLFVBQVUsU0FBU0osRUFBQyxJQUN6QkksR0FBRyxVQUFVLE9BQU9KLEVBQUMsRUFDckI4SCxHQUFRLEtBQUs5SCxFQUFDLEVBRXBCLENBQUcsRUFDRDBILEdBQU8sUUFBUzFILElBQU0sQ0FDZkksR0FBRyxVQUFVLFNBQVNKLEVBQUMsSUFDMUJJLEdBQUcsVUFBVSxJQUFJSixFQUFDLEVBQ2xCNkgsR0FBTSxLQUFLN0gsRUFBQyxFQUVsQixDQUFHLEVBQ00sSUFBTSxDQUNYOEgsR0FBUSxRQUFTOUgsSUFBTUksR0FBRyxVQUFVLElBQUlKLEVBQUMsQ0FBQyxFQUMxQzZILEdBQU0sUUFBUzdILElBQU1JLEdBQUcsVUFBVSxPQUFPSixFQUFDLENBQUMsQ0FDL0MsQ0FDQSxDQUdBLFNBQVMsVUFBVUksR0FBSU0sR0FBTyxDQUM1QixPQUFJLE9BQU9BLElBQVUsVUFBWUEsS0FBVSxLQUNsQyxvQkFBb0JOLEdBQUlNLEVBQUssRUFFL0Isb0JBQW9CTixHQUFJTSxFQUFLLENBQ3RDLENBQ0EsU0FBUyxvQkFBb0JOLEdBQUlNLEdBQU8sQ0FDdEMsSUFBSXFILEdBQWlCLENBQUEsRUFDckIsY0FBTyxRQUFRckgsRUFBSyxFQUFFLFFBQVEsQ0FBQyxDQUFDc0MsR0FBS2dGLEVBQU0sSUFBTSxDQUMvQ0QsR0FBZS9FLEVBQUcsRUFBSTVDLEdBQUcsTUFBTTRDLEVBQUcsRUFDN0JBLEdBQUksV0FBVyxJQUFJLElBQ3RCQSxHQUFNLFVBQVVBLEVBQUcsR0FFckI1QyxHQUFHLE1BQU0sWUFBWTRDLEdBQUtnRixFQUFNLENBQ3BDLENBQUcsRUFDRCxXQUFXLElBQU0sQ0FDWDVILEdBQUcsTUFBTSxTQUFXLEdBQ3RCQSxHQUFHLGdCQUFnQixPQUFPLENBRWhDLENBQUcsRUFDTSxJQUFNLENBQ1gsVUFBVUEsR0FBSTJILEVBQWMsQ0FDaEMsQ0FDQSxDQUNBLFNBQVMsb0JBQW9CM0gsR0FBSU0sR0FBTyxDQUN0QyxJQUFJeUQsR0FBUS9ELEdBQUcsYUFBYSxRQUFTTSxFQUFLLEVBQzFDLE9BQUFOLEdBQUcsYUFBYSxRQUFTTSxFQUFLLEVBQ3ZCLElBQU0sQ0FDWE4sR0FBRyxhQUFhLFFBQVMrRCxJQUFTLEVBQUUsQ0FDeEMsQ0FDQSxDQUNBLFNBQVMsVUFBVWUsR0FBUyxDQUMxQixPQUFPQSxHQUFRLFFBQVEsa0JBQW1CLE9BQU8sRUFBRSxZQUFXLENBQ2hFLENBR0EsU0FBUyxLQUFLckYsR0FBVW9JLEdBQVcsSUFBTSxDQUN6QyxFQUFHLENBQ0QsSUFBSUMsR0FBUyxHQUNiLE9BQU8sVUFBVyxDQUNYQSxHQUlIRCxHQUFTLE1BQU0sS0FBTSxTQUFTLEdBSDlCQyxHQUFTLEdBQ1RySSxHQUFTLE1BQU0sS0FBTSxTQUFTLEVBSXBDLENBQ0EsQ0FHQSxVQUFVLGFBQWMsQ0FBQ08sR0FBSSxDQUFFLE1BQUFNLEdBQU8sVUFBQWtHLEdBQVcsV0FBQTVDLElBQWMsQ0FBRSxTQUFVbUUsTUFBZ0IsQ0FDckYsT0FBT25FLElBQWUsYUFDeEJBLEdBQWFtRSxHQUFVbkUsRUFBVSxHQUMvQkEsS0FBZSxLQUVmLENBQUNBLElBQWMsT0FBT0EsSUFBZSxVQUN2Qyw4QkFBOEI1RCxHQUFJd0csR0FBV2xHLEVBQUssRUFFbEQsbUNBQW1DTixHQUFJNEQsR0FBWXRELEVBQUssRUFFNUQsQ0FBQyxFQUNELFNBQVMsbUNBQW1DTixHQUFJK0csR0FBYWlCLEdBQU8sQ0FDbEUseUJBQXlCaEksR0FBSSxXQUFZLEVBQUUsRUFDakIsQ0FDeEIsTUFBVW1ILElBQVksQ0FDcEJuSCxHQUFHLGNBQWMsTUFBTSxPQUFTbUgsRUFDakMsRUFDRCxjQUFnQkEsSUFBWSxDQUMxQm5ILEdBQUcsY0FBYyxNQUFNLE1BQVFtSCxFQUNoQyxFQUNELFlBQWNBLElBQVksQ0FDeEJuSCxHQUFHLGNBQWMsTUFBTSxJQUFNbUgsRUFDOUIsRUFDRCxNQUFVQSxJQUFZLENBQ3BCbkgsR0FBRyxjQUFjLE1BQU0sT0FBU21ILEVBQ2pDLEVBQ0QsY0FBZ0JBLElBQVksQ0FDMUJuSCxHQUFHLGNBQWMsTUFBTSxNQUFRbUgsRUFDaEMsRUFDRCxZQUFjQSxJQUFZLENBQ3hCbkgsR0FBRyxjQUFjLE1BQU0sSUFBTW1ILEVBQzlCLENBQ0wsRUFDc0JhLEVBQUssRUFBRWpCLEVBQVcsQ0FDeEMsQ0FDQSxTQUFTLDhCQUE4Qi9HLEdBQUl3RyxHQUFXd0IsR0FBTyxDQUMzRCx5QkFBeUJoSSxHQUFJLFNBQVMsRUFDdEMsSUFBSWlJLEdBQWdCLENBQUN6QixHQUFVLFNBQVMsSUFBSSxHQUFLLENBQUNBLEdBQVUsU0FBUyxLQUFLLEdBQUssQ0FBQ3dCLEdBQzVFRSxHQUFrQkQsSUFBaUJ6QixHQUFVLFNBQVMsSUFBSSxHQUFLLENBQUMsT0FBTyxFQUFFLFNBQVN3QixFQUFLLEVBQ3ZGRyxHQUFtQkYsSUFBaUJ6QixHQUFVLFNBQVMsS0FBSyxHQUFLLENBQUMsT0FBTyxFQUFFLFNBQVN3QixFQUFLLEVBQ3pGeEIsR0FBVSxTQUFTLElBQUksR0FBSyxDQUFDeUIsS0FDL0J6QixHQUFZQSxHQUFVLE9BQU8sQ0FBQzVHLEdBQUdELEtBQVVBLEdBQVE2RyxHQUFVLFFBQVEsS0FBSyxDQUFDLEdBRXpFQSxHQUFVLFNBQVMsS0FBSyxHQUFLLENBQUN5QixLQUNoQ3pCLEdBQVlBLEdBQVUsT0FBTyxDQUFDNUcsR0FBR0QsS0FBVUEsR0FBUTZHLEdBQVUsUUFBUSxLQUFLLENBQUMsR0FFN0UsSUFBSTRCLEdBQVcsQ0FBQzVCLEdBQVUsU0FBUyxTQUFTLEdBQUssQ0FBQ0EsR0FBVSxTQUFTLE9BQU8sRUFDeEU2QixHQUFlRCxJQUFZNUIsR0FBVSxTQUFTLFNBQVMsRUFDdkQ4QixHQUFhRixJQUFZNUIsR0FBVSxTQUFTLE9BQU8sRUFDbkQrQixHQUFlRixHQUFlLEVBQUksRUFDbENHLEdBQWFGLEdBQWEsY0FBYzlCLEdBQVcsUUFBUyxFQUFFLEVBQUksSUFBTSxFQUN4RWlDLEdBQVEsY0FBY2pDLEdBQVcsUUFBUyxDQUFDLEVBQUksSUFDL0NrQyxHQUFTLGNBQWNsQyxHQUFXLFNBQVUsUUFBUSxFQUNwRG1DLEdBQVcscUJBQ1hDLEdBQWEsY0FBY3BDLEdBQVcsV0FBWSxHQUFHLEVBQUksSUFDekRxQyxHQUFjLGNBQWNyQyxHQUFXLFdBQVksRUFBRSxFQUFJLElBQ3pEc0MsR0FBUyxpQ0FDVFosS0FDRmxJLEdBQUcsY0FBYyxNQUFNLE9BQVMsQ0FDOUIsZ0JBQWlCMEksR0FDakIsZ0JBQWlCLEdBQUdELEVBQUssSUFDekIsbUJBQW9CRSxHQUNwQixtQkFBb0IsR0FBR0MsRUFBVSxJQUNqQyx5QkFBMEJFLEVBQ2hDLEVBQ0k5SSxHQUFHLGNBQWMsTUFBTSxNQUFRLENBQzdCLFFBQVN1SSxHQUNULFVBQVcsU0FBU0MsRUFBVSxHQUNwQyxFQUNJeEksR0FBRyxjQUFjLE1BQU0sSUFBTSxDQUMzQixRQUFTLEVBQ1QsVUFBVyxVQUNqQixHQUVNbUksS0FDRm5JLEdBQUcsY0FBYyxNQUFNLE9BQVMsQ0FDOUIsZ0JBQWlCMEksR0FDakIsZ0JBQWlCLEdBQUdELEVBQUssSUFDekIsbUJBQW9CRSxHQUNwQixtQkFBb0IsR0FBR0UsRUFBVyxJQUNsQyx5QkFBMEJDLEVBQ2hDLEVBQ0k5SSxHQUFHLGNBQWMsTUFBTSxNQUFRLENBQzdCLFFBQVMsRUFDVCxVQUFXLFVBQ2pCLEVBQ0lBLEdBQUcsY0FBYyxNQUFNLElBQU0sQ0FDM0IsUUFBU3VJLEdBQ1QsVUFBVyxTQUFTQyxFQUFVLEdBQ3BDLEVBRUEsQ0FDQSxTQUFTLHlCQUF5QnhJLEdBQUkrSSxHQUFhQyxHQUFlLENBQUEsRUFBSSxDQUMvRGhKLEdBQUcsZ0JBQ05BLEdBQUcsY0FBZ0IsQ0FDakIsTUFBTyxDQUFFLE9BQVFnSixHQUFjLE1BQU9BLEdBQWMsSUFBS0EsRUFBYyxFQUN2RSxNQUFPLENBQUUsT0FBUUEsR0FBYyxNQUFPQSxHQUFjLElBQUtBLEVBQWMsRUFDdkUsR0FBR0MsR0FBUyxJQUFNLENBQ2pCLEVBQUVDLEdBQVEsSUFBTSxDQUN2QixFQUFTLENBQ0QsV0FBV2xKLEdBQUkrSSxHQUFhLENBQzFCLE9BQVEsS0FBSyxNQUFNLE9BQ25CLE1BQU8sS0FBSyxNQUFNLE1BQ2xCLElBQUssS0FBSyxNQUFNLEdBQzFCLEVBQVdFLEdBQVFDLEVBQUssQ0FDakIsRUFDRCxJQUFJRCxHQUFTLElBQU0sQ0FDbEIsRUFBRUMsR0FBUSxJQUFNLENBQ3ZCLEVBQVMsQ0FDRCxXQUFXbEosR0FBSStJLEdBQWEsQ0FDMUIsT0FBUSxLQUFLLE1BQU0sT0FDbkIsTUFBTyxLQUFLLE1BQU0sTUFDbEIsSUFBSyxLQUFLLE1BQU0sR0FDMUIsRUFBV0UsR0FBUUMsRUFBSyxDQUNqQixDQUNQLEVBQ0EsQ0FDQSxPQUFPLFFBQVEsVUFBVSxtQ0FBcUMsU0FBU2xKLEdBQUlNLEdBQU82SSxHQUFNQyxHQUFNLENBQzVGLE1BQU1DLEdBQVksU0FBUyxrQkFBb0IsVUFBWSxzQkFBd0IsV0FDbkYsSUFBSUMsR0FBMEIsSUFBTUQsR0FBVUYsRUFBSSxFQUNsRCxHQUFJN0ksR0FBTyxDQUNMTixHQUFHLGdCQUFrQkEsR0FBRyxjQUFjLE9BQVNBLEdBQUcsY0FBYyxPQUNsRUEsR0FBRyxjQUFjLFFBQVUsT0FBTyxRQUFRQSxHQUFHLGNBQWMsTUFBTSxNQUFNLEVBQUUsUUFBVSxPQUFPLFFBQVFBLEdBQUcsY0FBYyxNQUFNLEtBQUssRUFBRSxRQUFVLE9BQU8sUUFBUUEsR0FBRyxjQUFjLE1BQU0sR0FBRyxFQUFFLFFBQVVBLEdBQUcsY0FBYyxHQUFHbUosRUFBSSxFQUFJRyxLQUUzTnRKLEdBQUcsY0FBZ0JBLEdBQUcsY0FBYyxHQUFHbUosRUFBSSxFQUFJRyxLQUVqRCxNQUNELENBQ0R0SixHQUFHLGVBQWlCQSxHQUFHLGNBQWdCLElBQUksUUFBUSxDQUFDdUosR0FBU0MsS0FBVyxDQUN0RXhKLEdBQUcsY0FBYyxJQUFJLElBQU0sQ0FDL0IsRUFBTyxJQUFNdUosR0FBUUgsRUFBSSxDQUFDLEVBQ3RCcEosR0FBRyxrQkFBb0JBLEdBQUcsaUJBQWlCLGFBQWEsSUFBTXdKLEdBQU8sQ0FBRSwwQkFBMkIsRUFBTSxDQUFBLENBQUMsQ0FDMUcsQ0FBQSxFQUFJLFFBQVEsUUFBUUosRUFBSSxFQUN6QixlQUFlLElBQU0sQ0FDbkIsSUFBSUssR0FBVSxZQUFZekosRUFBRSxFQUN4QnlKLElBQ0dBLEdBQVEsa0JBQ1hBLEdBQVEsZ0JBQWtCLElBQzVCQSxHQUFRLGdCQUFnQixLQUFLekosRUFBRSxHQUUvQnFKLEdBQVUsSUFBTSxDQUNkLElBQUlLLEdBQXFCakosSUFBUSxDQUMvQixJQUFJOEMsR0FBUSxRQUFRLElBQUksQ0FDdEI5QyxHQUFJLGVBQ0osSUFBSUEsR0FBSSxpQkFBbUIsQ0FBQSxHQUFJLElBQUlpSixFQUFpQixDQUNoRSxDQUFXLEVBQUUsS0FBSyxDQUFDLENBQUM5SixFQUFDLElBQU1BLEdBQUcsQ0FBQSxFQUNwQixjQUFPYSxHQUFJLGVBQ1gsT0FBT0EsR0FBSSxnQkFDSjhDLEVBQ2pCLEVBQ1FtRyxHQUFrQjFKLEVBQUUsRUFBRSxNQUFPNkQsSUFBTSxDQUNqQyxHQUFJLENBQUNBLEdBQUUsMEJBQ0wsTUFBTUEsRUFDbEIsQ0FBUyxDQUNULENBQU8sQ0FFUCxDQUFHLENBQ0gsRUFDQSxTQUFTLFlBQVk3RCxHQUFJLENBQ3ZCLElBQUkySixHQUFTM0osR0FBRyxXQUNoQixHQUFLMkosR0FFTCxPQUFPQSxHQUFPLGVBQWlCQSxHQUFTLFlBQVlBLEVBQU0sQ0FDNUQsQ0FDQSxTQUFTLFdBQVczSixHQUFJK0ksR0FBYSxDQUFFLE9BQUFhLEdBQVEsTUFBT0MsR0FBUSxJQUFBQyxFQUFLLEVBQUcsR0FBSWIsR0FBUyxJQUFNLENBQ3pGLEVBQUdDLEdBQVEsSUFBTSxDQUNqQixFQUFHLENBR0QsR0FGSWxKLEdBQUcsa0JBQ0xBLEdBQUcsaUJBQWlCLFNBQ2xCLE9BQU8sS0FBSzRKLEVBQU0sRUFBRSxTQUFXLEdBQUssT0FBTyxLQUFLQyxFQUFNLEVBQUUsU0FBVyxHQUFLLE9BQU8sS0FBS0MsRUFBRyxFQUFFLFNBQVcsRUFBRyxDQUN6R2IsS0FDQUMsS0FDQSxNQUNELENBQ0QsSUFBSWEsR0FBV0MsR0FBWUMsR0FDM0Isa0JBQWtCakssR0FBSSxDQUNwQixPQUFRLENBQ04rSixHQUFZaEIsR0FBWS9JLEdBQUk2SixFQUFNLENBQ25DLEVBQ0QsUUFBUyxDQUNQRyxHQUFhakIsR0FBWS9JLEdBQUk0SixFQUFNLENBQ3BDLEVBQ0QsT0FBQVgsR0FDQSxLQUFNLENBQ0pjLEtBQ0FFLEdBQVVsQixHQUFZL0ksR0FBSThKLEVBQUcsQ0FDOUIsRUFDRCxNQUFBWixHQUNBLFNBQVUsQ0FDUmMsS0FDQUMsSUFDRCxDQUNMLENBQUcsQ0FDSCxDQUNBLFNBQVMsa0JBQWtCakssR0FBSWtLLEdBQVEsQ0FDckMsSUFBSUMsR0FBYUMsR0FBZUMsR0FDNUJDLEdBQVMsS0FBSyxJQUFNLENBQ3RCLFVBQVUsSUFBTSxDQUNkSCxHQUFjLEdBQ1RDLElBQ0hGLEdBQU8sT0FBTSxFQUNWRyxLQUNISCxHQUFPLElBQUcsRUFDVixvQkFFRkEsR0FBTyxNQUFLLEVBQ1JsSyxHQUFHLGFBQ0xrSyxHQUFPLFFBQU8sRUFDaEIsT0FBT2xLLEdBQUcsZ0JBQ2hCLENBQUssQ0FDTCxDQUFHLEVBQ0RBLEdBQUcsaUJBQW1CLENBQ3BCLGNBQWUsQ0FBRSxFQUNqQixhQUFhUCxHQUFVLENBQ3JCLEtBQUssY0FBYyxLQUFLQSxFQUFRLENBQ2pDLEVBQ0QsT0FBUSxLQUFLLFVBQVcsQ0FDdEIsS0FBTyxLQUFLLGNBQWMsUUFDeEIsS0FBSyxjQUFjLE1BQUssSUFHMUI2SyxJQUNOLENBQUssRUFDRCxPQUFBQSxFQUNKLEVBQ0UsVUFBVSxJQUFNLENBQ2RKLEdBQU8sTUFBSyxFQUNaQSxHQUFPLE9BQU0sQ0FDakIsQ0FBRyxFQUNELGdCQUNBLHNCQUFzQixJQUFNLENBQzFCLEdBQUlDLEdBQ0YsT0FDRixJQUFJSSxHQUFXLE9BQU8saUJBQWlCdkssRUFBRSxFQUFFLG1CQUFtQixRQUFRLE1BQU8sRUFBRSxFQUFFLFFBQVEsSUFBSyxFQUFFLENBQUMsRUFBSSxJQUNqR3lJLEdBQVEsT0FBTyxpQkFBaUJ6SSxFQUFFLEVBQUUsZ0JBQWdCLFFBQVEsTUFBTyxFQUFFLEVBQUUsUUFBUSxJQUFLLEVBQUUsQ0FBQyxFQUFJLElBQzNGdUssS0FBYSxJQUNmQSxHQUFXLE9BQU8saUJBQWlCdkssRUFBRSxFQUFFLGtCQUFrQixRQUFRLElBQUssRUFBRSxDQUFDLEVBQUksS0FDL0UsVUFBVSxJQUFNLENBQ2RrSyxHQUFPLE9BQU0sQ0FDbkIsQ0FBSyxFQUNERSxHQUFnQixHQUNoQixzQkFBc0IsSUFBTSxDQUN0QkQsS0FFSixVQUFVLElBQU0sQ0FDZEQsR0FBTyxJQUFHLENBQ2xCLENBQU8sRUFDRCxtQkFDQSxXQUFXbEssR0FBRyxpQkFBaUIsT0FBUXVLLEdBQVc5QixFQUFLLEVBQ3ZENEIsR0FBYSxHQUNuQixDQUFLLENBQ0wsQ0FBRyxDQUNILENBQ0EsU0FBUyxjQUFjN0QsR0FBVzVELEdBQUtpRixHQUFVLENBQy9DLEdBQUlyQixHQUFVLFFBQVE1RCxFQUFHLElBQU0sR0FDN0IsT0FBT2lGLEdBQ1QsTUFBTTJDLEdBQVdoRSxHQUFVQSxHQUFVLFFBQVE1RCxFQUFHLEVBQUksQ0FBQyxFQUdyRCxHQUZJLENBQUM0SCxJQUVENUgsS0FBUSxTQUNOLE1BQU00SCxFQUFRLEVBQ2hCLE9BQU8zQyxHQUVYLEdBQUlqRixLQUFRLFlBQWNBLEtBQVEsUUFBUyxDQUN6QyxJQUFJNkgsR0FBUUQsR0FBUyxNQUFNLFlBQVksRUFDdkMsR0FBSUMsR0FDRixPQUFPQSxHQUFNLENBQUMsQ0FDakIsQ0FDRCxPQUFJN0gsS0FBUSxVQUNOLENBQUMsTUFBTyxRQUFTLE9BQVEsU0FBVSxRQUFRLEVBQUUsU0FBUzRELEdBQVVBLEdBQVUsUUFBUTVELEVBQUcsRUFBSSxDQUFDLENBQUMsRUFDdEYsQ0FBQzRILEdBQVVoRSxHQUFVQSxHQUFVLFFBQVE1RCxFQUFHLEVBQUksQ0FBQyxDQUFDLEVBQUUsS0FBSyxHQUFHLEVBRzlENEgsRUFDVCxDQUdBLElBQUksVUFBWSxHQUNoQixTQUFTLGdCQUFnQi9LLEdBQVVvSSxHQUFXLElBQU0sQ0FDcEQsRUFBRyxDQUNELE1BQU8sSUFBSWhILEtBQVMsVUFBWWdILEdBQVMsR0FBR2hILEVBQUksRUFBSXBCLEdBQVMsR0FBR29CLEVBQUksQ0FDdEUsQ0FDQSxTQUFTLGdCQUFnQnBCLEdBQVUsQ0FDakMsTUFBTyxJQUFJb0IsS0FBUyxXQUFhcEIsR0FBUyxHQUFHb0IsRUFBSSxDQUNuRCxDQUNBLElBQUksYUFBZSxDQUFBLEVBQ25CLFNBQVMsZUFBZXBCLEdBQVUsQ0FDaEMsYUFBYSxLQUFLQSxFQUFRLENBQzVCLENBQ0EsU0FBUyxVQUFVaUwsR0FBTUMsR0FBSSxDQUMzQixhQUFhLFFBQVMvSyxJQUFNQSxHQUFFOEssR0FBTUMsRUFBRSxDQUFDLEVBQ3ZDLFVBQVksR0FDWixnQ0FBZ0MsSUFBTSxDQUNwQyxTQUFTQSxHQUFJLENBQUMzSyxHQUFJUCxLQUFhLENBQzdCQSxHQUFTTyxHQUFJLElBQU0sQ0FDekIsQ0FBTyxDQUNQLENBQUssQ0FDTCxDQUFHLEVBQ0QsVUFBWSxFQUNkLENBQ0EsSUFBSSxnQkFBa0IsR0FDdEIsU0FBUyxNQUFNNEssR0FBT0MsR0FBTyxDQUN0QkEsR0FBTSxlQUNUQSxHQUFNLGFBQWVELEdBQU0sY0FDN0IsVUFBWSxHQUNaLGdCQUFrQixHQUNsQixnQ0FBZ0MsSUFBTSxDQUNwQyxVQUFVQyxFQUFLLENBQ25CLENBQUcsRUFDRCxVQUFZLEdBQ1osZ0JBQWtCLEVBQ3BCLENBQ0EsU0FBUyxVQUFVN0ssR0FBSSxDQUNyQixJQUFJOEssR0FBdUIsR0FTM0IsU0FBUzlLLEdBUlcsQ0FBQ1MsR0FBS2hCLEtBQWEsQ0FDckMsS0FBS2dCLEdBQUssQ0FBQ3NLLEdBQUtySyxLQUFTLENBQ3ZCLEdBQUlvSyxJQUF3QixPQUFPQyxFQUFHLEVBQ3BDLE9BQU9ySyxHQUFJLEVBQ2JvSyxHQUF1QixHQUN2QnJMLEdBQVNzTCxHQUFLckssRUFBSSxDQUN4QixDQUFLLENBQ0wsQ0FDNEIsQ0FDNUIsQ0FDQSxTQUFTLGdDQUFnQ2pCLEdBQVUsQ0FDakQsSUFBSXNFLEdBQVEsT0FDWixlQUFlLENBQUNpSCxHQUFXaEwsS0FBTyxDQUNoQyxJQUFJaUwsR0FBZWxILEdBQU1pSCxFQUFTLEVBQ2xDLGVBQVFDLEVBQVksRUFDYixJQUFNLENBQ2pCLENBQ0EsQ0FBRyxFQUNEeEwsS0FDQSxlQUFlc0UsRUFBSyxDQUN0QixDQUdBLFNBQVMsS0FBSy9ELEdBQUlPLEdBQU1ELEdBQU9rRyxHQUFZLENBQUEsRUFBSSxDQUs3QyxPQUpLeEcsR0FBRyxjQUNOQSxHQUFHLFlBQWMsU0FBUyxDQUFBLENBQUUsR0FDOUJBLEdBQUcsWUFBWU8sRUFBSSxFQUFJRCxHQUN2QkMsR0FBT2lHLEdBQVUsU0FBUyxPQUFPLEVBQUksVUFBVWpHLEVBQUksRUFBSUEsR0FDL0NBLEdBQUksQ0FDVixJQUFLLFFBQ0gsZUFBZVAsR0FBSU0sRUFBSyxFQUN4QixNQUNGLElBQUssUUFDSCxXQUFXTixHQUFJTSxFQUFLLEVBQ3BCLE1BQ0YsSUFBSyxRQUNILFlBQVlOLEdBQUlNLEVBQUssRUFDckIsTUFDRixJQUFLLFdBQ0wsSUFBSyxVQUNILHlCQUF5Qk4sR0FBSU8sR0FBTUQsRUFBSyxFQUN4QyxNQUNGLFFBQ0UsY0FBY04sR0FBSU8sR0FBTUQsRUFBSyxFQUM3QixLQUNILENBQ0gsQ0FDQSxTQUFTLGVBQWVOLEdBQUlNLEdBQU8sQ0FDakMsR0FBSU4sR0FBRyxPQUFTLFFBQ1ZBLEdBQUcsV0FBVyxRQUFVLFNBQzFCQSxHQUFHLE1BQVFNLElBRVQsT0FBTyxZQUNMLE9BQU9BLElBQVUsVUFDbkJOLEdBQUcsUUFBVSxpQkFBaUJBLEdBQUcsS0FBSyxJQUFNTSxHQUU1Q04sR0FBRyxRQUFVLHdCQUF3QkEsR0FBRyxNQUFPTSxFQUFLLFdBRy9DTixHQUFHLE9BQVMsV0FDakIsT0FBTyxVQUFVTSxFQUFLLEVBQ3hCTixHQUFHLE1BQVFNLEdBQ0YsQ0FBQyxNQUFNLFFBQVFBLEVBQUssR0FBSyxPQUFPQSxJQUFVLFdBQWEsQ0FBQyxDQUFDLEtBQU0sTUFBTSxFQUFFLFNBQVNBLEVBQUssRUFDOUZOLEdBQUcsTUFBUSxPQUFPTSxFQUFLLEVBRW5CLE1BQU0sUUFBUUEsRUFBSyxFQUNyQk4sR0FBRyxRQUFVTSxHQUFNLEtBQU13QyxJQUFRLHdCQUF3QkEsR0FBSzlDLEdBQUcsS0FBSyxDQUFDLEVBRXZFQSxHQUFHLFFBQVUsQ0FBQyxDQUFDTSxXQUdWTixHQUFHLFVBQVksU0FDeEIsYUFBYUEsR0FBSU0sRUFBSyxNQUNqQixDQUNMLEdBQUlOLEdBQUcsUUFBVU0sR0FDZixPQUNGTixHQUFHLE1BQVFNLEtBQVUsT0FBUyxHQUFLQSxFQUNwQyxDQUNILENBQ0EsU0FBUyxZQUFZTixHQUFJTSxHQUFPLENBQzFCTixHQUFHLHFCQUNMQSxHQUFHLG9CQUFtQixFQUN4QkEsR0FBRyxvQkFBc0IsV0FBV0EsR0FBSU0sRUFBSyxDQUMvQyxDQUNBLFNBQVMsV0FBV04sR0FBSU0sR0FBTyxDQUN6Qk4sR0FBRyxvQkFDTEEsR0FBRyxtQkFBa0IsRUFDdkJBLEdBQUcsbUJBQXFCLFVBQVVBLEdBQUlNLEVBQUssQ0FDN0MsQ0FDQSxTQUFTLHlCQUF5Qk4sR0FBSU8sR0FBTUQsR0FBTyxDQUNqRCxjQUFjTixHQUFJTyxHQUFNRCxFQUFLLEVBQzdCLHFCQUFxQk4sR0FBSU8sR0FBTUQsRUFBSyxDQUN0QyxDQUNBLFNBQVMsY0FBY04sR0FBSU8sR0FBTUQsR0FBTyxDQUNsQyxDQUFDLEtBQU0sT0FBUSxFQUFLLEVBQUUsU0FBU0EsRUFBSyxHQUFLLG9DQUFvQ0MsRUFBSSxFQUNuRlAsR0FBRyxnQkFBZ0JPLEVBQUksR0FFbkIsY0FBY0EsRUFBSSxJQUNwQkQsR0FBUUMsSUFDVixhQUFhUCxHQUFJTyxHQUFNRCxFQUFLLEVBRWhDLENBQ0EsU0FBUyxhQUFhTixHQUFJa0wsR0FBVTVLLEdBQU8sQ0FDckNOLEdBQUcsYUFBYWtMLEVBQVEsR0FBSzVLLElBQy9CTixHQUFHLGFBQWFrTCxHQUFVNUssRUFBSyxDQUVuQyxDQUNBLFNBQVMscUJBQXFCTixHQUFJbUwsR0FBVTdLLEdBQU8sQ0FDN0NOLEdBQUdtTCxFQUFRLElBQU03SyxLQUNuQk4sR0FBR21MLEVBQVEsRUFBSTdLLEdBRW5CLENBQ0EsU0FBUyxhQUFhTixHQUFJTSxHQUFPLENBQy9CLE1BQU04SyxHQUFvQixDQUFBLEVBQUcsT0FBTzlLLEVBQUssRUFBRSxJQUFLc0gsSUFDdkNBLEdBQVMsRUFDakIsRUFDRCxNQUFNLEtBQUs1SCxHQUFHLE9BQU8sRUFBRSxRQUFTcUwsSUFBVyxDQUN6Q0EsR0FBTyxTQUFXRCxHQUFrQixTQUFTQyxHQUFPLEtBQUssQ0FDN0QsQ0FBRyxDQUNILENBQ0EsU0FBUyxVQUFVdkcsR0FBUyxDQUMxQixPQUFPQSxHQUFRLGNBQWMsUUFBUSxTQUFVLENBQUMyRixHQUFPYSxLQUFTQSxHQUFLLFlBQVcsQ0FBRSxDQUNwRixDQUNBLFNBQVMsd0JBQXdCQyxHQUFRQyxHQUFRLENBQy9DLE9BQU9ELElBQVVDLEVBQ25CLENBQ0EsU0FBUyxpQkFBaUJoQixHQUFVLENBQ2xDLE1BQUksQ0FBQyxFQUFHLElBQUssT0FBUSxLQUFNLE1BQU8sRUFBSSxFQUFFLFNBQVNBLEVBQVEsRUFDaEQsR0FFTCxDQUFDLEVBQUcsSUFBSyxRQUFTLE1BQU8sS0FBTSxFQUFLLEVBQUUsU0FBU0EsRUFBUSxFQUNsRCxHQUVGQSxHQUFXLEVBQVFBLEdBQVksSUFDeEMsQ0FDQSxTQUFTLGNBQWNVLEdBQVUsQ0E0Qi9CLE1BM0IwQixDQUN4QixXQUNBLFVBQ0EsV0FDQSxXQUNBLFNBQ0EsT0FDQSxXQUNBLFlBQ0EsWUFDQSxXQUNBLGFBQ0Esa0JBQ0Esc0JBQ0EsaUJBQ0EsV0FDQSxXQUNBLE9BQ0EsUUFDQSxjQUNBLFVBQ0EsUUFDQSxXQUNBLFFBQ0EsUUFDQSxVQUNKLEVBQzJCLFNBQVNBLEVBQVEsQ0FDNUMsQ0FDQSxTQUFTLG9DQUFvQzNLLEdBQU0sQ0FDakQsTUFBTyxDQUFDLENBQUMsZUFBZ0IsZUFBZ0IsZ0JBQWlCLGVBQWUsRUFBRSxTQUFTQSxFQUFJLENBQzFGLENBQ0EsU0FBUyxXQUFXUCxHQUFJTyxHQUFNc0gsR0FBVSxDQUN0QyxPQUFJN0gsR0FBRyxhQUFlQSxHQUFHLFlBQVlPLEVBQUksSUFBTSxPQUN0Q1AsR0FBRyxZQUFZTyxFQUFJLEVBQ3JCLG9CQUFvQlAsR0FBSU8sR0FBTXNILEVBQVEsQ0FDL0MsQ0FDQSxTQUFTLFlBQVk3SCxHQUFJTyxHQUFNc0gsR0FBVTRELEdBQVUsR0FBTSxDQUN2RCxHQUFJekwsR0FBRyxhQUFlQSxHQUFHLFlBQVlPLEVBQUksSUFBTSxPQUM3QyxPQUFPUCxHQUFHLFlBQVlPLEVBQUksRUFDNUIsR0FBSVAsR0FBRyxtQkFBcUJBLEdBQUcsa0JBQWtCTyxFQUFJLElBQU0sT0FBUSxDQUNqRSxJQUFJbUwsR0FBVTFMLEdBQUcsa0JBQWtCTyxFQUFJLEVBQ3ZDLE9BQUFtTCxHQUFRLFFBQVVELEdBQ1gsMEJBQTBCLElBQ3hCLFNBQVN6TCxHQUFJMEwsR0FBUSxVQUFVLENBQ3ZDLENBQ0YsQ0FDRCxPQUFPLG9CQUFvQjFMLEdBQUlPLEdBQU1zSCxFQUFRLENBQy9DLENBQ0EsU0FBUyxvQkFBb0I3SCxHQUFJTyxHQUFNc0gsR0FBVSxDQUMvQyxJQUFJdEMsR0FBT3ZGLEdBQUcsYUFBYU8sRUFBSSxFQUMvQixPQUFJZ0YsS0FBUyxLQUNKLE9BQU9zQyxJQUFhLFdBQWFBLEdBQVEsRUFBS0EsR0FDbkR0QyxLQUFTLEdBQ0osR0FDTCxjQUFjaEYsRUFBSSxFQUNiLENBQUMsQ0FBQyxDQUFDQSxHQUFNLE1BQU0sRUFBRSxTQUFTZ0YsRUFBSSxFQUVoQ0EsRUFDVCxDQUdBLFNBQVMsU0FBU2xCLEdBQU1zSCxHQUFNLENBQzVCLElBQUlDLEdBQ0osT0FBTyxVQUFXLENBQ2hCLElBQUlDLEdBQVUsS0FBTWhMLEdBQU8sVUFDdkJpTCxHQUFRLFVBQVcsQ0FDckJGLEdBQVUsS0FDVnZILEdBQUssTUFBTXdILEdBQVNoTCxFQUFJLENBQzlCLEVBQ0ksYUFBYStLLEVBQU8sRUFDcEJBLEdBQVUsV0FBV0UsR0FBT0gsRUFBSSxDQUNwQyxDQUNBLENBR0EsU0FBUyxTQUFTdEgsR0FBTTBILEdBQU8sQ0FDN0IsSUFBSUMsR0FDSixPQUFPLFVBQVcsQ0FDaEIsSUFBSUgsR0FBVSxLQUFNaEwsR0FBTyxVQUN0Qm1MLEtBQ0gzSCxHQUFLLE1BQU13SCxHQUFTaEwsRUFBSSxFQUN4Qm1MLEdBQWEsR0FDYixXQUFXLElBQU1BLEdBQWEsR0FBT0QsRUFBSyxFQUVoRCxDQUNBLENBR0EsU0FBUyxTQUFTLENBQUUsSUFBS0UsR0FBVSxJQUFLQyxJQUFZLENBQUUsSUFBS0MsR0FBVSxJQUFLQyxFQUFRLEVBQUksQ0FDcEYsSUFBSUMsR0FBVyxHQUNYQyxHQUVBQyxHQUFZLE9BQU8sSUFBTSxDQUMzQixJQUFJQyxHQUFRUCxLQUNSUSxHQUFRTixLQUNaLEdBQUlFLEdBQ0ZELEdBQVMsY0FBY0ksRUFBSyxDQUFDLEVBQzdCSCxHQUFXLE9BQ04sQ0FDTCxJQUFJSyxHQUFrQixLQUFLLFVBQVVGLEVBQUssRUFDdENHLEdBQWtCLEtBQUssVUFBVUYsRUFBSyxFQUN0Q0MsS0FBb0JKLEdBQ3RCRixHQUFTLGNBQWNJLEVBQUssQ0FBQyxFQUNwQkUsS0FBb0JDLElBQzdCVCxHQUFTLGNBQWNPLEVBQUssQ0FBQyxDQUdoQyxDQUNESCxHQUFZLEtBQUssVUFBVUwsR0FBVSxDQUFBLEVBQ3pCLEtBQUssVUFBVUUsR0FBUSxDQUFFLENBQ3pDLENBQUcsRUFDRCxNQUFPLElBQU0sQ0FDWCxRQUFRSSxFQUFTLENBQ3JCLENBQ0EsQ0FDQSxTQUFTLGNBQWNqTSxHQUFPLENBQzVCLE9BQU8sT0FBT0EsSUFBVSxTQUFXLEtBQUssTUFBTSxLQUFLLFVBQVVBLEVBQUssQ0FBQyxFQUFJQSxFQUN6RSxDQUdBLFNBQVMsT0FBT2IsR0FBVSxFQUNSLE1BQU0sUUFBUUEsRUFBUSxFQUFJQSxHQUFXLENBQUNBLEVBQVEsR0FDcEQsUUFBU0csSUFBTUEsR0FBRSxjQUFjLENBQUMsQ0FDNUMsQ0FHQSxJQUFJLE9BQVMsQ0FBQSxFQUNULFdBQWEsR0FDakIsU0FBUyxNQUFNVyxHQUFNRCxHQUFPLENBSzFCLEdBSkssYUFDSCxPQUFTLFNBQVMsTUFBTSxFQUN4QixXQUFhLElBRVhBLEtBQVUsT0FDWixPQUFPLE9BQU9DLEVBQUksRUFFcEIsT0FBT0EsRUFBSSxFQUFJRCxHQUNYLE9BQU9BLElBQVUsVUFBWUEsS0FBVSxNQUFRQSxHQUFNLGVBQWUsTUFBTSxHQUFLLE9BQU9BLEdBQU0sTUFBUyxZQUN2RyxPQUFPQyxFQUFJLEVBQUUsT0FFZixrQkFBa0IsT0FBT0EsRUFBSSxDQUFDLENBQ2hDLENBQ0EsU0FBUyxXQUFZLENBQ25CLE9BQU8sTUFDVCxDQUdBLElBQUksTUFBUSxDQUFBLEVBQ1osU0FBUyxNQUFNQSxHQUFNcU0sR0FBVSxDQUM3QixJQUFJQyxHQUFjLE9BQU9ELElBQWEsV0FBYSxJQUFNQSxHQUFXQSxHQUNwRSxPQUFJck0sY0FBZ0IsUUFDWCxvQkFBb0JBLEdBQU1zTSxHQUFXLENBQUUsR0FFOUMsTUFBTXRNLEVBQUksRUFBSXNNLEdBRVQsSUFBTSxDQUNmLEVBQ0EsQ0FDQSxTQUFTLHVCQUF1QnRLLEdBQUssQ0FDbkMsY0FBTyxRQUFRLEtBQUssRUFBRSxRQUFRLENBQUMsQ0FBQ2hDLEdBQU1kLEVBQVEsSUFBTSxDQUNsRCxPQUFPLGVBQWU4QyxHQUFLaEMsR0FBTSxDQUMvQixLQUFNLENBQ0osTUFBTyxJQUFJTSxLQUNGcEIsR0FBUyxHQUFHb0IsRUFBSSxDQUUxQixDQUNQLENBQUssQ0FDTCxDQUFHLEVBQ00wQixFQUNULENBQ0EsU0FBUyxvQkFBb0J2QyxHQUFJdUMsR0FBS2tFLEdBQVUsQ0FDOUMsSUFBSXFHLEdBQWlCLENBQUEsRUFDckIsS0FBT0EsR0FBZSxRQUNwQkEsR0FBZSxJQUFHLElBQ3BCLElBQUk1SCxHQUFhLE9BQU8sUUFBUTNDLEVBQUcsRUFBRSxJQUFJLENBQUMsQ0FBQ2hDLEdBQU1ELEVBQUssS0FBTyxDQUFFLEtBQUFDLEdBQU0sTUFBQUQsRUFBSyxFQUFHLEVBQ3pFK0UsR0FBbUIsZUFBZUgsRUFBVSxFQUNoRCxPQUFBQSxHQUFhQSxHQUFXLElBQUtJLElBQ3ZCRCxHQUFpQixLQUFNRSxJQUFTQSxHQUFLLE9BQVNELEdBQVUsSUFBSSxFQUN2RCxDQUNMLEtBQU0sVUFBVUEsR0FBVSxJQUFJLEdBQzlCLE1BQU8sSUFBSUEsR0FBVSxLQUFLLEdBQ2xDLEVBRVdBLEVBQ1IsRUFDRCxXQUFXdEYsR0FBSWtGLEdBQVl1QixFQUFRLEVBQUUsSUFBSzFGLElBQVcsQ0FDbkQrTCxHQUFlLEtBQUsvTCxHQUFPLFdBQVcsRUFDdENBLElBQ0osQ0FBRyxFQUNNLElBQU0sQ0FDWCxLQUFPK0wsR0FBZSxRQUNwQkEsR0FBZSxJQUFHLEdBQ3hCLENBQ0EsQ0FHQSxJQUFJLE1BQVEsQ0FBQSxFQUNaLFNBQVMsS0FBS3ZNLEdBQU1kLEdBQVUsQ0FDNUIsTUFBTWMsRUFBSSxFQUFJZCxFQUNoQixDQUNBLFNBQVMsb0JBQW9COEMsR0FBS3NKLEdBQVMsQ0FDekMsY0FBTyxRQUFRLEtBQUssRUFBRSxRQUFRLENBQUMsQ0FBQ3RMLEdBQU1kLEVBQVEsSUFBTSxDQUNsRCxPQUFPLGVBQWU4QyxHQUFLaEMsR0FBTSxDQUMvQixLQUFNLENBQ0osTUFBTyxJQUFJTSxLQUNGcEIsR0FBUyxLQUFLb00sRUFBTyxFQUFFLEdBQUdoTCxFQUFJLENBRXhDLEVBQ0QsV0FBWSxFQUNsQixDQUFLLENBQ0wsQ0FBRyxFQUNNMEIsRUFDVCxDQUdBLElBQUksT0FBUyxDQUNYLElBQUksVUFBVyxDQUNiLE9BQU8sUUFDUixFQUNELElBQUksU0FBVSxDQUNaLE9BQU8sT0FDUixFQUNELElBQUksUUFBUyxDQUNYLE9BQU8sTUFDUixFQUNELElBQUksS0FBTSxDQUNSLE9BQU8sR0FDUixFQUNELFFBQVMsU0FDVCwrQkFDQSwwQkFDQSx3QkFDQSx3QkFDQSx1QkFDQSxvQkFDQSxtQkFDQSxrQkFDQSxpQkFDQSxnQkFDQSxnQkFDQSxnQkFDQSxnQkFDQSxlQUNBLGVBQ0EsZUFDQSxjQUNBLGNBQ0EsY0FDQSxhQUNBLGFBQ0EsWUFDQSxZQUNBLFlBQ0EsWUFDQSxZQUNBLFlBRUEsV0FFQSxVQUVBLFVBQ0EsVUFDQSxTQUNBLFNBQ0EsU0FDQSxTQUNBLFNBQ0EsU0FDQSxTQUFVLE9BQ1YsT0FBUSxVQUNSLE9BQ0EsTUFDQSxNQUNBLE1BQ0EsTUFFQSxVQUVBLE1BQU8sV0FDUCxNQUFPLE1BQ1AsTUFDQSxLQUNBLEtBQ0EsS0FBTSxLQUNSLEVBQ0ksZUFBaUIsT0FHckIsU0FBUyxRQUFRd0ssR0FBS0MsR0FBa0IsQ0FDdEMsTUFBTUMsR0FBc0IsT0FBTyxPQUFPLElBQUksRUFDeENDLEdBQU9ILEdBQUksTUFBTSxHQUFHLEVBQzFCLFFBQVNuTixHQUFJLEVBQUdBLEdBQUlzTixHQUFLLE9BQVF0TixLQUMvQnFOLEdBQUlDLEdBQUt0TixFQUFDLENBQUMsRUFBSSxHQUVqQixPQUFPb04sR0FBb0JsSyxJQUFRLENBQUMsQ0FBQ21LLEdBQUluSyxHQUFJLFlBQWEsQ0FBQSxFQUFLQSxJQUFRLENBQUMsQ0FBQ21LLEdBQUluSyxFQUFHLENBQ2xGLENBR0EsSUFBSSxVQUFtQixPQUFPLE9BQU8sQ0FBQSxDQUFFLEVBRW5DLGVBQWlCLE9BQU8sVUFBVSxlQUNsQyxPQUFTLENBQUNBLEdBQUtGLEtBQVEsZUFBZSxLQUFLRSxHQUFLRixFQUFHLEVBQ25ELFFBQVUsTUFBTSxRQUNoQixNQUFTRSxJQUFRLGFBQWFBLEVBQUcsSUFBTSxlQUN2QyxTQUFZQSxJQUFRLE9BQU9BLElBQVEsU0FDbkMsU0FBWUEsSUFBUSxPQUFPQSxJQUFRLFNBQ25DLFNBQVlBLElBQVFBLEtBQVEsTUFBUSxPQUFPQSxJQUFRLFNBQ25ELGVBQWlCLE9BQU8sVUFBVSxTQUNsQyxhQUFnQnhDLElBQVUsZUFBZSxLQUFLQSxFQUFLLEVBQ25ELFVBQWFBLElBQ1IsYUFBYUEsRUFBSyxFQUFFLE1BQU0sRUFBRyxFQUFFLEVBRXBDLGFBQWdCc0MsSUFBUSxTQUFTQSxFQUFHLEdBQUtBLEtBQVEsT0FBU0EsR0FBSSxDQUFDLElBQU0sS0FBTyxHQUFLLFNBQVNBLEdBQUssRUFBRSxJQUFNQSxHQUN2RyxvQkFBdUIzQixJQUFPLENBQ2hDLE1BQU04QyxHQUF3QixPQUFPLE9BQU8sSUFBSSxFQUNoRCxPQUFRZ0osSUFDTWhKLEdBQU1nSixFQUFHLElBQ05oSixHQUFNZ0osRUFBRyxFQUFJOUwsR0FBRzhMLEVBQUcsRUFFdEMsRUFPSSxXQUFhLG9CQUFxQkEsSUFBUUEsR0FBSSxPQUFPLENBQUMsRUFBRSxZQUFhLEVBQUdBLEdBQUksTUFBTSxDQUFDLENBQUMsRUFFcEYsV0FBYSxDQUFDek0sR0FBT0QsS0FBYUMsS0FBVUQsS0FBYUMsS0FBVUEsSUFBU0QsS0FBYUEsSUFHekYsVUFBNEIsSUFBSSxRQUNoQyxZQUFjLENBQUEsRUFDZCxhQUNBLFlBQWMsT0FBYyxTQUFjLEVBQzFDLG9CQUFzQixPQUFjLGlCQUFzQixFQUM5RCxTQUFTLFNBQVNZLEdBQUksQ0FDcEIsT0FBT0EsSUFBTUEsR0FBRyxZQUFjLEVBQ2hDLENBQ0EsU0FBUyxRQUFRQSxHQUFJa00sR0FBVSxVQUFXLENBQ3BDLFNBQVNsTSxFQUFFLElBQ2JBLEdBQUtBLEdBQUcsS0FFVixNQUFNNkUsR0FBVSxxQkFBcUI3RSxHQUFJa00sRUFBTyxFQUNoRCxPQUFLQSxHQUFRLE1BQ1hySCxLQUVLQSxFQUNULENBQ0EsU0FBUyxLQUFLQSxHQUFTLENBQ2pCQSxHQUFRLFNBQ1YsUUFBUUEsRUFBTyxFQUNYQSxHQUFRLFFBQVEsUUFDbEJBLEdBQVEsUUFBUSxTQUVsQkEsR0FBUSxPQUFTLEdBRXJCLENBQ0EsSUFBSSxJQUFNLEVBQ1YsU0FBUyxxQkFBcUI3RSxHQUFJa00sR0FBUyxDQUN6QyxNQUFNckgsR0FBVSxVQUEwQixDQUN4QyxHQUFJLENBQUNBLEdBQVEsT0FDWCxPQUFPN0UsR0FBRSxFQUVYLEdBQUksQ0FBQyxZQUFZLFNBQVM2RSxFQUFPLEVBQUcsQ0FDbEMsUUFBUUEsRUFBTyxFQUNmLEdBQUksQ0FDRix3QkFDQSxZQUFZLEtBQUtBLEVBQU8sRUFDeEIsYUFBZUEsR0FDUjdFLEdBQUUsQ0FDakIsUUFBZ0IsQ0FDUixZQUFZLElBQUcsRUFDZixnQkFDQSxhQUFlLFlBQVksWUFBWSxPQUFTLENBQUMsQ0FDbEQsQ0FDRixDQUNMLEVBQ0UsT0FBQTZFLEdBQVEsR0FBSyxNQUNiQSxHQUFRLGFBQWUsQ0FBQyxDQUFDcUgsR0FBUSxhQUNqQ3JILEdBQVEsVUFBWSxHQUNwQkEsR0FBUSxPQUFTLEdBQ2pCQSxHQUFRLElBQU03RSxHQUNkNkUsR0FBUSxLQUFPLEdBQ2ZBLEdBQVEsUUFBVXFILEdBQ1hySCxFQUNULENBQ0EsU0FBUyxRQUFRQSxHQUFTLENBQ3hCLEtBQU0sQ0FBRSxLQUFBc0gsRUFBTSxFQUFHdEgsR0FDakIsR0FBSXNILEdBQUssT0FBUSxDQUNmLFFBQVN4TixHQUFJLEVBQUdBLEdBQUl3TixHQUFLLE9BQVF4TixLQUMvQndOLEdBQUt4TixFQUFDLEVBQUUsT0FBT2tHLEVBQU8sRUFFeEJzSCxHQUFLLE9BQVMsQ0FDZixDQUNILENBQ0EsSUFBSSxZQUFjLEdBQ2QsV0FBYSxDQUFBLEVBQ2pCLFNBQVMsZUFBZ0IsQ0FDdkIsV0FBVyxLQUFLLFdBQVcsRUFDM0IsWUFBYyxFQUNoQixDQUNBLFNBQVMsZ0JBQWlCLENBQ3hCLFdBQVcsS0FBSyxXQUFXLEVBQzNCLFlBQWMsRUFDaEIsQ0FDQSxTQUFTLGVBQWdCLENBQ3ZCLE1BQU1DLEdBQU8sV0FBVyxNQUN4QixZQUFjQSxLQUFTLE9BQVMsR0FBT0EsRUFDekMsQ0FDQSxTQUFTLE1BQU01SyxHQUFRNkssR0FBTTFLLEdBQUssQ0FDaEMsR0FBSSxDQUFDLGFBQWUsZUFBaUIsT0FDbkMsT0FFRixJQUFJMkssR0FBVSxVQUFVLElBQUk5SyxFQUFNLEVBQzdCOEssSUFDSCxVQUFVLElBQUk5SyxHQUFROEssR0FBMEIsSUFBSSxHQUFLLEVBRTNELElBQUlDLEdBQU1ELEdBQVEsSUFBSTNLLEVBQUcsRUFDcEI0SyxJQUNIRCxHQUFRLElBQUkzSyxHQUFLNEssR0FBc0IsSUFBSSxHQUFLLEVBRTdDQSxHQUFJLElBQUksWUFBWSxJQUN2QkEsR0FBSSxJQUFJLFlBQVksRUFDcEIsYUFBYSxLQUFLLEtBQUtBLEVBQUcsRUFDdEIsYUFBYSxRQUFRLFNBQ3ZCLGFBQWEsUUFBUSxRQUFRLENBQzNCLE9BQVEsYUFDUixPQUFBL0ssR0FDQSxLQUFBNkssR0FDQSxJQUFBMUssRUFDUixDQUFPLEVBR1AsQ0FDQSxTQUFTLFFBQVFILEdBQVE2SyxHQUFNMUssR0FBS3dELEdBQVUvRixHQUFVb04sR0FBVyxDQUNqRSxNQUFNRixHQUFVLFVBQVUsSUFBSTlLLEVBQU0sRUFDcEMsR0FBSSxDQUFDOEssR0FDSCxPQUVGLE1BQU1HLEdBQTBCLElBQUksSUFDOUJ4TCxHQUFReUwsSUFBaUIsQ0FDekJBLElBQ0ZBLEdBQWEsUUFBUzdILElBQVksRUFDNUJBLEtBQVksY0FBZ0JBLEdBQVEsZUFDdEM0SCxHQUFRLElBQUk1SCxFQUFPLENBRTdCLENBQU8sQ0FFUCxFQUNFLEdBQUl3SCxLQUFTLFFBQ1hDLEdBQVEsUUFBUXJMLEVBQUksVUFDWFUsS0FBUSxVQUFZLFFBQVFILEVBQU0sRUFDM0M4SyxHQUFRLFFBQVEsQ0FBQ0MsR0FBS0ksS0FBUyxFQUN6QkEsS0FBUyxVQUFZQSxJQUFReEgsS0FDL0JsRSxHQUFLc0wsRUFBRyxDQUVoQixDQUFLLE1BS0QsUUFISTVLLEtBQVEsUUFDVlYsR0FBS3FMLEdBQVEsSUFBSTNLLEVBQUcsQ0FBQyxFQUVmMEssR0FBSSxDQUNWLElBQUssTUFDRSxRQUFRN0ssRUFBTSxFQUtSLGFBQWFHLEVBQUcsR0FDekJWLEdBQUtxTCxHQUFRLElBQUksUUFBUSxDQUFDLEdBTDFCckwsR0FBS3FMLEdBQVEsSUFBSSxXQUFXLENBQUMsRUFDekIsTUFBTTlLLEVBQU0sR0FDZFAsR0FBS3FMLEdBQVEsSUFBSSxtQkFBbUIsQ0FBQyxHQUt6QyxNQUNGLElBQUssU0FDRSxRQUFROUssRUFBTSxJQUNqQlAsR0FBS3FMLEdBQVEsSUFBSSxXQUFXLENBQUMsRUFDekIsTUFBTTlLLEVBQU0sR0FDZFAsR0FBS3FMLEdBQVEsSUFBSSxtQkFBbUIsQ0FBQyxHQUd6QyxNQUNGLElBQUssTUFDQyxNQUFNOUssRUFBTSxHQUNkUCxHQUFLcUwsR0FBUSxJQUFJLFdBQVcsQ0FBQyxFQUUvQixLQUNILENBRUgsTUFBTU0sR0FBTy9ILElBQVksQ0FDbkJBLEdBQVEsUUFBUSxXQUNsQkEsR0FBUSxRQUFRLFVBQVUsQ0FDeEIsT0FBUUEsR0FDUixPQUFBckQsR0FDQSxJQUFBRyxHQUNBLEtBQUEwSyxHQUNBLFNBQUFsSCxHQUNBLFNBQUEvRixHQUNBLFVBQUFvTixFQUNSLENBQU8sRUFFQzNILEdBQVEsUUFBUSxVQUNsQkEsR0FBUSxRQUFRLFVBQVVBLEVBQU8sRUFFakNBLElBRU4sRUFDRTRILEdBQVEsUUFBUUcsRUFBRyxDQUNyQixDQUNBLElBQUksbUJBQXFDLFFBQVEsNkJBQTZCLEVBQzFFLGVBQWlCLElBQUksSUFBSSxPQUFPLG9CQUFvQixNQUFNLEVBQUUsSUFBS2pMLElBQVEsT0FBT0EsRUFBRyxDQUFDLEVBQUUsT0FBTyxRQUFRLENBQUMsRUFDdEcsS0FBdUIsYUFBWSxFQUNuQyxZQUE4QixhQUFhLEVBQUksRUFDL0Msc0JBQXdDLDRCQUEyQixFQUN2RSxTQUFTLDZCQUE4QixDQUNyQyxNQUFNa0wsR0FBbUIsQ0FBQSxFQUN6QixPQUFDLFdBQVksVUFBVyxhQUFhLEVBQUUsUUFBU2xMLElBQVEsQ0FDdERrTCxHQUFpQmxMLEVBQUcsRUFBSSxZQUFZL0IsR0FBTSxDQUN4QyxNQUFNa04sR0FBTSxNQUFNLElBQUksRUFDdEIsUUFBU25PLEdBQUksRUFBR29PLEdBQUksS0FBSyxPQUFRcE8sR0FBSW9PLEdBQUdwTyxLQUN0QyxNQUFNbU8sR0FBSyxNQUFPbk8sR0FBSSxFQUFFLEVBRTFCLE1BQU1rSCxHQUFNaUgsR0FBSW5MLEVBQUcsRUFBRSxHQUFHL0IsRUFBSSxFQUM1QixPQUFJaUcsS0FBUSxJQUFNQSxLQUFRLEdBQ2pCaUgsR0FBSW5MLEVBQUcsRUFBRSxHQUFHL0IsR0FBSyxJQUFJLEtBQUssQ0FBQyxFQUUzQmlHLEVBRWYsQ0FDQSxDQUFHLEVBQ0QsQ0FBQyxPQUFRLE1BQU8sUUFBUyxVQUFXLFFBQVEsRUFBRSxRQUFTbEUsSUFBUSxDQUM3RGtMLEdBQWlCbEwsRUFBRyxFQUFJLFlBQVkvQixHQUFNLENBQ3hDLGdCQUNBLE1BQU1pRyxHQUFNLE1BQU0sSUFBSSxFQUFFbEUsRUFBRyxFQUFFLE1BQU0sS0FBTS9CLEVBQUksRUFDN0MsdUJBQ09pRyxFQUNiLENBQ0EsQ0FBRyxFQUNNZ0gsRUFDVCxDQUNBLFNBQVMsYUFBYUcsR0FBYSxHQUFPQyxHQUFVLEdBQU8sQ0FDekQsT0FBTyxTQUFjekwsR0FBUUcsR0FBSzBCLEdBQVUsQ0FDMUMsR0FBSTFCLEtBQVEsaUJBQ1YsTUFBTyxDQUFDcUwsR0FDSCxHQUFJckwsS0FBUSxpQkFDakIsT0FBT3FMLEdBQ0YsR0FBSXJMLEtBQVEsV0FBYTBCLE1BQWMySixHQUFhQyxHQUFVLG1CQUFxQixZQUFjQSxHQUFVLG1CQUFxQixhQUFhLElBQUl6TCxFQUFNLEVBQzVKLE9BQU9BLEdBRVQsTUFBTTBMLEdBQWdCLFFBQVExTCxFQUFNLEVBQ3BDLEdBQUksQ0FBQ3dMLElBQWNFLElBQWlCLE9BQU8sc0JBQXVCdkwsRUFBRyxFQUNuRSxPQUFPLFFBQVEsSUFBSSxzQkFBdUJBLEdBQUswQixFQUFRLEVBRXpELE1BQU13QyxHQUFNLFFBQVEsSUFBSXJFLEdBQVFHLEdBQUswQixFQUFRLEVBTzdDLE9BTkksU0FBUzFCLEVBQUcsRUFBSSxlQUFlLElBQUlBLEVBQUcsRUFBSSxtQkFBbUJBLEVBQUcsS0FHL0RxTCxJQUNILE1BQU14TCxHQUFRLE1BQU9HLEVBQUcsRUFFdEJzTCxJQUNLcEgsR0FFTCxNQUFNQSxFQUFHLEVBQ1UsQ0FBQ3FILElBQWlCLENBQUMsYUFBYXZMLEVBQUcsRUFDbENrRSxHQUFJLE1BQVFBLEdBRWhDLFNBQVNBLEVBQUcsRUFDUG1ILEdBQWEsU0FBU25ILEVBQUcsRUFBSSxVQUFVQSxFQUFHLEVBRTVDQSxFQUNYLENBQ0EsQ0FDQSxJQUFJLEtBQXVCLGFBQVksRUFDdkMsU0FBUyxhQUFhb0gsR0FBVSxHQUFPLENBQ3JDLE9BQU8sU0FBY3pMLEdBQVFHLEdBQUt0QyxHQUFPZ0UsR0FBVSxDQUNqRCxJQUFJakUsR0FBV29DLEdBQU9HLEVBQUcsRUFDekIsR0FBSSxDQUFDc0wsS0FDSDVOLEdBQVEsTUFBTUEsRUFBSyxFQUNuQkQsR0FBVyxNQUFNQSxFQUFRLEVBQ3JCLENBQUMsUUFBUW9DLEVBQU0sR0FBSyxNQUFNcEMsRUFBUSxHQUFLLENBQUMsTUFBTUMsRUFBSyxHQUNyRCxPQUFBRCxHQUFTLE1BQVFDLEdBQ1YsR0FHWCxNQUFNOE4sR0FBUyxRQUFRM0wsRUFBTSxHQUFLLGFBQWFHLEVBQUcsRUFBSSxPQUFPQSxFQUFHLEVBQUlILEdBQU8sT0FBUyxPQUFPQSxHQUFRRyxFQUFHLEVBQ2hHaEIsR0FBUyxRQUFRLElBQUlhLEdBQVFHLEdBQUt0QyxHQUFPZ0UsRUFBUSxFQUN2RCxPQUFJN0IsS0FBVyxNQUFNNkIsRUFBUSxJQUN0QjhKLEdBRU0sV0FBVzlOLEdBQU9ELEVBQVEsR0FDbkMsUUFBUW9DLEdBQVEsTUFBT0csR0FBS3RDLEdBQU9ELEVBQVEsRUFGM0MsUUFBUW9DLEdBQVEsTUFBT0csR0FBS3RDLEVBQUssR0FLOUJzQixFQUNYLENBQ0EsQ0FDQSxTQUFTLGVBQWVhLEdBQVFHLEdBQUssQ0FDbkMsTUFBTXdMLEdBQVMsT0FBTzNMLEdBQVFHLEVBQUcsRUFDM0J2QyxHQUFXb0MsR0FBT0csRUFBRyxFQUNyQmhCLEdBQVMsUUFBUSxlQUFlYSxHQUFRRyxFQUFHLEVBQ2pELE9BQUloQixJQUFVd00sSUFDWixRQUFRM0wsR0FBUSxTQUFVRyxHQUFLLE9BQVF2QyxFQUFRLEVBRTFDdUIsRUFDVCxDQUNBLFNBQVMsSUFBSWEsR0FBUUcsR0FBSyxDQUN4QixNQUFNaEIsR0FBUyxRQUFRLElBQUlhLEdBQVFHLEVBQUcsRUFDdEMsT0FBSSxDQUFDLFNBQVNBLEVBQUcsR0FBSyxDQUFDLGVBQWUsSUFBSUEsRUFBRyxJQUMzQyxNQUFNSCxHQUFRLE1BQU9HLEVBQUcsRUFFbkJoQixFQUNULENBQ0EsU0FBUyxRQUFRYSxHQUFRLENBQ3ZCLGFBQU1BLEdBQVEsVUFBVyxRQUFRQSxFQUFNLEVBQUksU0FBVyxXQUFXLEVBQzFELFFBQVEsUUFBUUEsRUFBTSxDQUMvQixDQUNBLElBQUksZ0JBQWtCLENBQ3BCLElBQUssS0FDTCxJQUFLLEtBQ0wsZUFDQSxJQUNBLE9BQ0YsRUFDSSxpQkFBbUIsQ0FDckIsSUFBSyxZQUNMLElBQUlBLEdBQVFHLEdBQUssQ0FFYixlQUFRLEtBQUsseUJBQXlCLE9BQU9BLEVBQUcsQ0FBQyxnQ0FBaUNILEVBQU0sRUFFbkYsRUFDUixFQUNELGVBQWVBLEdBQVFHLEdBQUssQ0FFeEIsZUFBUSxLQUFLLDRCQUE0QixPQUFPQSxFQUFHLENBQUMsZ0NBQWlDSCxFQUFNLEVBRXRGLEVBQ1IsQ0FDSCxFQUNJLFdBQWNuQyxJQUFVLFNBQVNBLEVBQUssRUFBSSxVQUFVQSxFQUFLLEVBQUlBLEdBQzdELFdBQWNBLElBQVUsU0FBU0EsRUFBSyxFQUFJLFNBQVNBLEVBQUssRUFBSUEsR0FDNUQsVUFBYUEsSUFBVUEsR0FDdkIsU0FBWStOLElBQU0sUUFBUSxlQUFlQSxFQUFDLEVBQzlDLFNBQVMsTUFBTTVMLEdBQVFHLEdBQUtxTCxHQUFhLEdBQU9LLEdBQVksR0FBTyxDQUNqRTdMLEdBQVNBLEdBQ1AsUUFHRixNQUFNOEwsR0FBWSxNQUFNOUwsRUFBTSxFQUN4QitMLEdBQVMsTUFBTTVMLEVBQUcsRUFDcEJBLEtBQVE0TCxJQUNWLENBQUNQLElBQWMsTUFBTU0sR0FBVyxNQUFPM0wsRUFBRyxFQUU1QyxDQUFDcUwsSUFBYyxNQUFNTSxHQUFXLE1BQU9DLEVBQU0sRUFDN0MsS0FBTSxDQUFFLElBQUtDLEVBQU0sRUFBRyxTQUFTRixFQUFTLEVBQ2xDRyxHQUFPSixHQUFZLFVBQVlMLEdBQWEsV0FBYSxXQUMvRCxHQUFJUSxHQUFLLEtBQUtGLEdBQVczTCxFQUFHLEVBQzFCLE9BQU84TCxHQUFLak0sR0FBTyxJQUFJRyxFQUFHLENBQUMsRUFDdEIsR0FBSTZMLEdBQUssS0FBS0YsR0FBV0MsRUFBTSxFQUNwQyxPQUFPRSxHQUFLak0sR0FBTyxJQUFJK0wsRUFBTSxDQUFDLEVBQ3JCL0wsS0FBVzhMLElBQ3BCOUwsR0FBTyxJQUFJRyxFQUFHLENBRWxCLENBQ0EsU0FBUyxNQUFNQSxHQUFLcUwsR0FBYSxHQUFPLENBQ3RDLE1BQU14TCxHQUFTLEtBQ2IsUUFHSThMLEdBQVksTUFBTTlMLEVBQU0sRUFDeEIrTCxHQUFTLE1BQU01TCxFQUFHLEVBQ3hCLE9BQUlBLEtBQVE0TCxJQUNWLENBQUNQLElBQWMsTUFBTU0sR0FBVyxNQUFPM0wsRUFBRyxFQUU1QyxDQUFDcUwsSUFBYyxNQUFNTSxHQUFXLE1BQU9DLEVBQU0sRUFDdEM1TCxLQUFRNEwsR0FBUy9MLEdBQU8sSUFBSUcsRUFBRyxFQUFJSCxHQUFPLElBQUlHLEVBQUcsR0FBS0gsR0FBTyxJQUFJK0wsRUFBTSxDQUNoRixDQUNBLFNBQVMsS0FBSy9MLEdBQVF3TCxHQUFhLEdBQU8sQ0FDeEMsT0FBQXhMLEdBQVNBLEdBQ1AsUUFHRixDQUFDd0wsSUFBYyxNQUFNLE1BQU14TCxFQUFNLEVBQUcsVUFBVyxXQUFXLEVBQ25ELFFBQVEsSUFBSUEsR0FBUSxPQUFRQSxFQUFNLENBQzNDLENBQ0EsU0FBUyxJQUFJbkMsR0FBTyxDQUNsQkEsR0FBUSxNQUFNQSxFQUFLLEVBQ25CLE1BQU1tQyxHQUFTLE1BQU0sSUFBSSxFQUd6QixPQUZjLFNBQVNBLEVBQU0sRUFDUixJQUFJLEtBQUtBLEdBQVFuQyxFQUFLLElBRXpDbUMsR0FBTyxJQUFJbkMsRUFBSyxFQUNoQixRQUFRbUMsR0FBUSxNQUFPbkMsR0FBT0EsRUFBSyxHQUU5QixJQUNULENBQ0EsU0FBUyxNQUFNc0MsR0FBS3RDLEdBQU8sQ0FDekJBLEdBQVEsTUFBTUEsRUFBSyxFQUNuQixNQUFNbUMsR0FBUyxNQUFNLElBQUksRUFDbkIsQ0FBRSxJQUFLZ00sR0FBTSxJQUFLRSxJQUFTLFNBQVNsTSxFQUFNLEVBQ2hELElBQUkyTCxHQUFTSyxHQUFLLEtBQUtoTSxHQUFRRyxFQUFHLEVBQzdCd0wsR0FJSCxrQkFBa0IzTCxHQUFRZ00sR0FBTTdMLEVBQUcsR0FIbkNBLEdBQU0sTUFBTUEsRUFBRyxFQUNmd0wsR0FBU0ssR0FBSyxLQUFLaE0sR0FBUUcsRUFBRyxHQUloQyxNQUFNdkMsR0FBV3NPLEdBQUssS0FBS2xNLEdBQVFHLEVBQUcsRUFDdEMsT0FBQUgsR0FBTyxJQUFJRyxHQUFLdEMsRUFBSyxFQUNoQjhOLEdBRU0sV0FBVzlOLEdBQU9ELEVBQVEsR0FDbkMsUUFBUW9DLEdBQVEsTUFBT0csR0FBS3RDLEdBQU9ELEVBQVEsRUFGM0MsUUFBUW9DLEdBQVEsTUFBT0csR0FBS3RDLEVBQUssRUFJNUIsSUFDVCxDQUNBLFNBQVMsWUFBWXNDLEdBQUssQ0FDeEIsTUFBTUgsR0FBUyxNQUFNLElBQUksRUFDbkIsQ0FBRSxJQUFLZ00sR0FBTSxJQUFLRSxJQUFTLFNBQVNsTSxFQUFNLEVBQ2hELElBQUkyTCxHQUFTSyxHQUFLLEtBQUtoTSxHQUFRRyxFQUFHLEVBQzdCd0wsR0FJSCxrQkFBa0IzTCxHQUFRZ00sR0FBTTdMLEVBQUcsR0FIbkNBLEdBQU0sTUFBTUEsRUFBRyxFQUNmd0wsR0FBU0ssR0FBSyxLQUFLaE0sR0FBUUcsRUFBRyxHQUloQyxNQUFNdkMsR0FBV3NPLEdBQU9BLEdBQUssS0FBS2xNLEdBQVFHLEVBQUcsRUFBSSxPQUMzQ2hCLEdBQVNhLEdBQU8sT0FBT0csRUFBRyxFQUNoQyxPQUFJd0wsSUFDRixRQUFRM0wsR0FBUSxTQUFVRyxHQUFLLE9BQVF2QyxFQUFRLEVBRTFDdUIsRUFDVCxDQUNBLFNBQVMsT0FBUSxDQUNmLE1BQU1hLEdBQVMsTUFBTSxJQUFJLEVBQ25CbU0sR0FBV25NLEdBQU8sT0FBUyxFQUMzQmdMLEdBQW1CLE1BQU1oTCxFQUFNLEVBQUksSUFBSSxJQUFJQSxFQUFNLEVBQUksSUFBSSxJQUFJQSxFQUFNLEVBQ25FYixHQUFTYSxHQUFPLFFBQ3RCLE9BQUltTSxJQUNGLFFBQVFuTSxHQUFRLFFBQVMsT0FBUSxPQUFRZ0wsRUFBUyxFQUU3QzdMLEVBQ1QsQ0FDQSxTQUFTLGNBQWNxTSxHQUFZSyxHQUFXLENBQzVDLE9BQU8sU0FBaUI3TyxHQUFVb1AsR0FBUyxDQUN6QyxNQUFNQyxHQUFXLEtBQ1hyTSxHQUFTcU0sR0FDYixRQUdJUCxHQUFZLE1BQU05TCxFQUFNLEVBQ3hCaU0sR0FBT0osR0FBWSxVQUFZTCxHQUFhLFdBQWEsV0FDL0QsT0FBQ0EsSUFBYyxNQUFNTSxHQUFXLFVBQVcsV0FBVyxFQUMvQzlMLEdBQU8sUUFBUSxDQUFDbkMsR0FBT3NDLEtBQ3JCbkQsR0FBUyxLQUFLb1AsR0FBU0gsR0FBS3BPLEVBQUssRUFBR29PLEdBQUs5TCxFQUFHLEVBQUdrTSxFQUFRLENBQy9ELENBQ0wsQ0FDQSxDQUNBLFNBQVMscUJBQXFCQyxHQUFRZCxHQUFZSyxHQUFXLENBQzNELE9BQU8sWUFBWXpOLEdBQU0sQ0FDdkIsTUFBTTRCLEdBQVMsS0FDYixRQUdJOEwsR0FBWSxNQUFNOUwsRUFBTSxFQUN4QnVNLEdBQWMsTUFBTVQsRUFBUyxFQUM3QlUsR0FBU0YsS0FBVyxXQUFhQSxLQUFXLE9BQU8sVUFBWUMsR0FDL0RFLEdBQVlILEtBQVcsUUFBVUMsR0FDakNHLEdBQWdCMU0sR0FBT3NNLEVBQU0sRUFBRSxHQUFHbE8sRUFBSSxFQUN0QzZOLEdBQU9KLEdBQVksVUFBWUwsR0FBYSxXQUFhLFdBQy9ELE9BQUNBLElBQWMsTUFBTU0sR0FBVyxVQUFXVyxHQUFZLG9CQUFzQixXQUFXLEVBQ2pGLENBRUwsTUFBTyxDQUNMLEtBQU0sQ0FBRSxNQUFBNU8sR0FBTyxLQUFBOE8sRUFBTSxFQUFHRCxHQUFjLEtBQUksRUFDMUMsT0FBT0MsR0FBTyxDQUFFLE1BQUE5TyxHQUFPLEtBQUE4TyxJQUFTLENBQzlCLE1BQU9ILEdBQVMsQ0FBQ1AsR0FBS3BPLEdBQU0sQ0FBQyxDQUFDLEVBQUdvTyxHQUFLcE8sR0FBTSxDQUFDLENBQUMsQ0FBQyxFQUFJb08sR0FBS3BPLEVBQUssRUFDN0QsS0FBQThPLEVBQ1YsQ0FDTyxFQUVELENBQUMsT0FBTyxRQUFRLEdBQUksQ0FDbEIsT0FBTyxJQUNSLENBQ1AsQ0FDQSxDQUNBLENBQ0EsU0FBUyxxQkFBcUI5QixHQUFNLENBQ2xDLE9BQU8sWUFBWXpNLEdBQU0sQ0FDYixDQUNSLE1BQU0rQixHQUFNL0IsR0FBSyxDQUFDLEVBQUksV0FBV0EsR0FBSyxDQUFDLENBQUMsS0FBTyxHQUMvQyxRQUFRLEtBQUssR0FBRyxXQUFXeU0sRUFBSSxDQUFDLGNBQWMxSyxFQUFHLDhCQUErQixNQUFNLElBQUksQ0FBQyxDQUM1RixDQUNELE9BQU8wSyxLQUFTLFNBQVcsR0FBUSxJQUN2QyxDQUNBLENBQ0EsU0FBUyx3QkFBeUIsQ0FDaEMsTUFBTStCLEdBQTJCLENBQy9CLElBQUl6TSxHQUFLLENBQ1AsT0FBTyxNQUFNLEtBQU1BLEVBQUcsQ0FDdkIsRUFDRCxJQUFJLE1BQU8sQ0FDVCxPQUFPLEtBQUssSUFBSSxDQUNqQixFQUNELElBQUssTUFDTCxJQUNBLElBQUssTUFDTCxPQUFRLFlBQ1IsTUFDQSxRQUFTLGNBQWMsR0FBTyxFQUFLLENBQ3ZDLEVBQ1EwTSxHQUEyQixDQUMvQixJQUFJMU0sR0FBSyxDQUNQLE9BQU8sTUFBTSxLQUFNQSxHQUFLLEdBQU8sRUFBSSxDQUNwQyxFQUNELElBQUksTUFBTyxDQUNULE9BQU8sS0FBSyxJQUFJLENBQ2pCLEVBQ0QsSUFBSyxNQUNMLElBQ0EsSUFBSyxNQUNMLE9BQVEsWUFDUixNQUNBLFFBQVMsY0FBYyxHQUFPLEVBQUksQ0FDdEMsRUFDUTJNLEdBQTRCLENBQ2hDLElBQUkzTSxHQUFLLENBQ1AsT0FBTyxNQUFNLEtBQU1BLEdBQUssRUFBSSxDQUM3QixFQUNELElBQUksTUFBTyxDQUNULE9BQU8sS0FBSyxLQUFNLEVBQUksQ0FDdkIsRUFDRCxJQUFJQSxHQUFLLENBQ1AsT0FBTyxNQUFNLEtBQUssS0FBTUEsR0FBSyxFQUFJLENBQ2xDLEVBQ0QsSUFBSyxxQkFDSCxLQUVELEVBQ0QsSUFBSyxxQkFDSCxLQUVELEVBQ0QsT0FBUSxxQkFDTixRQUVELEVBQ0QsTUFBTyxxQkFDTCxPQUVELEVBQ0QsUUFBUyxjQUFjLEdBQU0sRUFBSyxDQUN0QyxFQUNRNE0sR0FBbUMsQ0FDdkMsSUFBSTVNLEdBQUssQ0FDUCxPQUFPLE1BQU0sS0FBTUEsR0FBSyxHQUFNLEVBQUksQ0FDbkMsRUFDRCxJQUFJLE1BQU8sQ0FDVCxPQUFPLEtBQUssS0FBTSxFQUFJLENBQ3ZCLEVBQ0QsSUFBSUEsR0FBSyxDQUNQLE9BQU8sTUFBTSxLQUFLLEtBQU1BLEdBQUssRUFBSSxDQUNsQyxFQUNELElBQUsscUJBQ0gsS0FFRCxFQUNELElBQUsscUJBQ0gsS0FFRCxFQUNELE9BQVEscUJBQ04sUUFFRCxFQUNELE1BQU8scUJBQ0wsT0FFRCxFQUNELFFBQVMsY0FBYyxHQUFNLEVBQUksQ0FDckMsRUFFRSxNQUR3QixDQUFDLE9BQVEsU0FBVSxVQUFXLE9BQU8sUUFBUSxFQUNyRCxRQUFTbU0sSUFBVyxDQUNsQ00sR0FBeUJOLEVBQU0sRUFBSSxxQkFBcUJBLEdBQVEsR0FBTyxFQUFLLEVBQzVFUSxHQUEwQlIsRUFBTSxFQUFJLHFCQUFxQkEsR0FBUSxHQUFNLEVBQUssRUFDNUVPLEdBQXlCUCxFQUFNLEVBQUkscUJBQXFCQSxHQUFRLEdBQU8sRUFBSSxFQUMzRVMsR0FBaUNULEVBQU0sRUFBSSxxQkFBcUJBLEdBQVEsR0FBTSxFQUFJLENBQ3RGLENBQUcsRUFDTSxDQUNMTSxHQUNBRSxHQUNBRCxHQUNBRSxFQUNKLENBQ0EsQ0FDQSxHQUFJLENBQUMsd0JBQXlCLHlCQUEwQix3QkFBeUIsK0JBQStCLEVBQW9CLHVCQUFzQixFQUMxSixTQUFTLDRCQUE0QnZCLEdBQVlDLEdBQVMsQ0FDeEQsTUFBTUosR0FBbUJJLEdBQVVELEdBQWEsZ0NBQWtDLHdCQUEwQkEsR0FBYSx5QkFBMkIsd0JBQ3BKLE1BQU8sQ0FBQ3hMLEdBQVFHLEdBQUswQixLQUNmMUIsS0FBUSxpQkFDSCxDQUFDcUwsR0FDQ3JMLEtBQVEsaUJBQ1ZxTCxHQUNFckwsS0FBUSxVQUNWSCxHQUVGLFFBQVEsSUFBSSxPQUFPcUwsR0FBa0JsTCxFQUFHLEdBQUtBLE1BQU9ILEdBQVNxTCxHQUFtQnJMLEdBQVFHLEdBQUswQixFQUFRLENBRWhILENBQ0EsSUFBSSwwQkFBNEIsQ0FDOUIsSUFBcUIsNEJBQTRCLEdBQU8sRUFBSyxDQUMvRCxFQUNJLDJCQUE2QixDQUMvQixJQUFxQiw0QkFBNEIsR0FBTSxFQUFLLENBQzlELEVBQ0EsU0FBUyxrQkFBa0I3QixHQUFRZ00sR0FBTTdMLEdBQUssQ0FDNUMsTUFBTTRMLEdBQVMsTUFBTTVMLEVBQUcsRUFDeEIsR0FBSTRMLEtBQVc1TCxJQUFPNkwsR0FBSyxLQUFLaE0sR0FBUStMLEVBQU0sRUFBRyxDQUMvQyxNQUFNbEIsR0FBTyxVQUFVN0ssRUFBTSxFQUM3QixRQUFRLEtBQUssWUFBWTZLLEVBQUksa0VBQWtFQSxLQUFTLE1BQVEsV0FBYSxFQUFFLDhKQUE4SixDQUM5UixDQUNILENBQ0EsSUFBSSxZQUE4QixJQUFJLFFBQ2xDLG1CQUFxQyxJQUFJLFFBQ3pDLFlBQThCLElBQUksUUFDbEMsbUJBQXFDLElBQUksUUFDN0MsU0FBUyxjQUFjbUMsR0FBUyxDQUM5QixPQUFRQSxHQUFPLENBQ2IsSUFBSyxTQUNMLElBQUssUUFDSCxNQUFPLEdBQ1QsSUFBSyxNQUNMLElBQUssTUFDTCxJQUFLLFVBQ0wsSUFBSyxVQUNILE1BQU8sR0FDVCxRQUNFLE1BQU8sRUFDVixDQUNILENBQ0EsU0FBUyxjQUFjblAsR0FBTyxDQUM1QixPQUFPQSxHQUNMLFVBRUcsQ0FBQyxPQUFPLGFBQWFBLEVBQUssRUFBSSxFQUFJLGNBQWMsVUFBVUEsRUFBSyxDQUFDLENBQ3ZFLENBQ0EsU0FBUyxVQUFVbUMsR0FBUSxDQUN6QixPQUFJQSxJQUFVQSxHQUNaLGVBR09BLEdBRUYscUJBQXFCQSxHQUFRLEdBQU8sZ0JBQWlCLDBCQUEyQixXQUFXLENBQ3BHLENBQ0EsU0FBUyxTQUFTQSxHQUFRLENBQ3hCLE9BQU8scUJBQXFCQSxHQUFRLEdBQU0saUJBQWtCLDJCQUE0QixXQUFXLENBQ3JHLENBQ0EsU0FBUyxxQkFBcUJBLEdBQVF3TCxHQUFZeUIsR0FBY0MsR0FBb0JDLEdBQVUsQ0FDNUYsR0FBSSxDQUFDLFNBQVNuTixFQUFNLEVBRWhCLGVBQVEsS0FBSyxrQ0FBa0MsT0FBT0EsRUFBTSxDQUFDLEVBQUUsRUFFMURBLEdBRVQsR0FBSUEsR0FDRixTQUVHLEVBQUV3TCxJQUFjeEwsR0FDbkIsZ0JBR0EsT0FBT0EsR0FFVCxNQUFNb04sR0FBZ0JELEdBQVMsSUFBSW5OLEVBQU0sRUFDekMsR0FBSW9OLEdBQ0YsT0FBT0EsR0FFVCxNQUFNQyxHQUFhLGNBQWNyTixFQUFNLEVBQ3ZDLEdBQUlxTixLQUFlLEVBQ2pCLE9BQU9yTixHQUVULE1BQU1zTixHQUFRLElBQUksTUFBTXROLEdBQVFxTixLQUFlLEVBQUlILEdBQXFCRCxFQUFZLEVBQ3BGLE9BQUFFLEdBQVMsSUFBSW5OLEdBQVFzTixFQUFLLEVBQ25CQSxFQUNULENBQ0EsU0FBUyxNQUFNakIsR0FBVSxDQUN2QixPQUFPQSxJQUFZLE1BQU1BLEdBQ3ZCLE9BRUQsR0FBS0EsRUFDUixDQUNBLFNBQVMsTUFBTWtCLEdBQUcsQ0FDaEIsTUFBTyxHQUFRQSxJQUFLQSxHQUFFLFlBQWMsR0FDdEMsQ0FHQSxNQUFNLFdBQVksSUFBTSxRQUFRLEVBR2hDLE1BQU0sV0FBYWhRLElBQU8sU0FBUyxLQUFLLFNBQVVBLEVBQUUsQ0FBQyxFQUdyRCxNQUFNLFFBQVMsQ0FBQ0EsR0FBSSxDQUFFLGNBQWVpUSxHQUFnQixRQUFTaFEsRUFBVSxJQUFLLENBQUMyQyxHQUFLbkQsS0FBYSxDQUM5RixJQUFJc0ksR0FBWWtJLEdBQWVyTixFQUFHLEVBTTlCc04sR0FBVSxNQUxELElBQU0sQ0FDakIsSUFBSTVQLEdBQ0osT0FBQXlILEdBQVduSSxJQUFNVSxHQUFRVixFQUFDLEVBQ25CVSxFQUNYLEVBQzhCYixFQUFRLEVBQ3BDUSxHQUFTaVEsRUFBTyxDQUNsQixDQUFDLEVBR0QsTUFBTSxRQUFTLFNBQVMsRUFHeEIsTUFBTSxPQUFTbFEsSUFBTyxNQUFNQSxFQUFFLENBQUMsRUFHL0IsTUFBTSxPQUFTQSxJQUFPLFlBQVlBLEVBQUUsQ0FBQyxFQUdyQyxNQUFNLE9BQVNBLEtBQ1RBLEdBQUcsZ0JBRVBBLEdBQUcsY0FBZ0IsYUFBYSxvQkFBb0JBLEVBQUUsQ0FBQyxHQUNoREEsR0FBRyxjQUNYLEVBQ0QsU0FBUyxvQkFBb0JBLEdBQUksQ0FDL0IsSUFBSW1RLEdBQWEsQ0FBQSxFQUNqQixtQkFBWW5RLEdBQUtKLElBQU0sQ0FDakJBLEdBQUUsU0FDSnVRLEdBQVcsS0FBS3ZRLEdBQUUsT0FBTyxDQUMvQixDQUFHLEVBQ011USxFQUNULENBR0EsSUFBSSxhQUFlLENBQUEsRUFDbkIsU0FBUyxtQkFBbUI1UCxHQUFNLENBQ2hDLE9BQUssYUFBYUEsRUFBSSxJQUNwQixhQUFhQSxFQUFJLEVBQUksR0FDaEIsRUFBRSxhQUFhQSxFQUFJLENBQzVCLENBQ0EsU0FBUyxjQUFjUCxHQUFJTyxHQUFNLENBQy9CLE9BQU8sWUFBWVAsR0FBS29CLElBQVksQ0FDbEMsR0FBSUEsR0FBUSxRQUFVQSxHQUFRLE9BQU9iLEVBQUksRUFDdkMsTUFBTyxFQUNiLENBQUcsQ0FDSCxDQUNBLFNBQVMsVUFBVVAsR0FBSU8sR0FBTSxDQUN0QlAsR0FBRyxTQUNOQSxHQUFHLE9BQVMsSUFDVEEsR0FBRyxPQUFPTyxFQUFJLElBQ2pCUCxHQUFHLE9BQU9PLEVBQUksRUFBSSxtQkFBbUJBLEVBQUksRUFDN0MsQ0FHQSxNQUFNLEtBQU0sQ0FBQ1AsR0FBSSxDQUFFLFFBQVNDLEVBQVEsSUFBTyxDQUFDTSxHQUFNcUMsR0FBTSxPQUFTLENBQy9ELElBQUl3TixHQUFXLEdBQUc3UCxFQUFJLEdBQUdxQyxHQUFNLElBQUlBLEVBQUcsR0FBSyxFQUFFLEdBQzdDLE9BQU8sdUJBQXVCNUMsR0FBSW9RLEdBQVVuUSxHQUFVLElBQU0sQ0FDMUQsSUFBSXVCLEdBQU8sY0FBY3hCLEdBQUlPLEVBQUksRUFDN0I4UCxHQUFLN08sR0FBT0EsR0FBSyxPQUFPakIsRUFBSSxFQUFJLG1CQUFtQkEsRUFBSSxFQUMzRCxPQUFPcUMsR0FBTSxHQUFHckMsRUFBSSxJQUFJOFAsRUFBRSxJQUFJek4sRUFBRyxHQUFLLEdBQUdyQyxFQUFJLElBQUk4UCxFQUFFLEVBQ3ZELENBQUcsQ0FDSCxDQUFDLEVBQ0QsZUFBZSxDQUFDM0YsR0FBTUMsS0FBTyxDQUN2QkQsR0FBSyxRQUNQQyxHQUFHLE1BQVFELEdBQUssTUFFcEIsQ0FBQyxFQUNELFNBQVMsdUJBQXVCMUssR0FBSW9RLEdBQVVuUSxHQUFVUixHQUFVLENBR2hFLEdBRktPLEdBQUcsUUFDTkEsR0FBRyxNQUFRLElBQ1RBLEdBQUcsTUFBTW9RLEVBQVEsRUFDbkIsT0FBT3BRLEdBQUcsTUFBTW9RLEVBQVEsRUFDMUIsSUFBSUUsR0FBUzdRLEtBQ2IsT0FBQU8sR0FBRyxNQUFNb1EsRUFBUSxFQUFJRSxHQUNyQnJRLEdBQVMsSUFBTSxDQUNiLE9BQU9ELEdBQUcsTUFBTW9RLEVBQVEsQ0FDNUIsQ0FBRyxFQUNNRSxFQUNULENBR0EsTUFBTSxLQUFPdFEsSUFBT0EsRUFBRSxFQUd0Qix1QkFBdUIsUUFBUyxRQUFTLE9BQU8sRUFDaEQsdUJBQXVCLFVBQVcsVUFBVyxTQUFTLEVBQ3RELFNBQVMsdUJBQXVCTyxHQUFNZ1EsR0FBV0MsR0FBTSxDQUNyRCxNQUFNRCxHQUFZdlEsSUFBTyxLQUFLLG1CQUFtQnVRLEVBQVMsbUNBQW1DaFEsRUFBSSwrQ0FBK0NpUSxFQUFJLEdBQUl4USxFQUFFLENBQUMsQ0FDN0osQ0FHQSxVQUFVLFlBQWEsQ0FBQ0EsR0FBSSxDQUFFLFdBQUE0RCxFQUFVLEVBQUksQ0FBRSxPQUFRa0MsR0FBUyxjQUFlbUssR0FBZ0IsUUFBU2hRLEVBQVEsSUFBTyxDQUNwSCxJQUFJb0UsR0FBTzRMLEdBQWVyTSxFQUFVLEVBQ2hDdUksR0FBVyxJQUFNLENBQ25CLElBQUl2SyxHQUNKLE9BQUF5QyxHQUFNekUsSUFBTWdDLEdBQVNoQyxFQUFDLEVBQ2ZnQyxFQUNYLEVBQ002TyxHQUFtQlIsR0FBZSxHQUFHck0sRUFBVSxrQkFBa0IsRUFDakV3SSxHQUFZdEosSUFBUTJOLEdBQWlCLElBQU0sQ0FDOUMsRUFBRSxDQUFFLE1BQU8sQ0FBRSxjQUFpQjNOLEVBQUcsQ0FBSSxDQUFBLEVBQ2xDTSxHQUFlK0ksS0FDbkJDLEdBQVNoSixFQUFZLEVBQ3JCLGVBQWUsSUFBTSxDQUNuQixHQUFJLENBQUNwRCxHQUFHLFNBQ04sT0FDRkEsR0FBRyx3QkFBd0IsVUFDM0IsSUFBSWlNLEdBQVdqTSxHQUFHLFNBQVMsSUFDdkJrTSxHQUFXbE0sR0FBRyxTQUFTLElBQ3ZCMFEsR0FBc0IsU0FDeEIsQ0FDRSxLQUFNLENBQ0osT0FBT3pFLEdBQVEsQ0FDaEIsRUFDRCxJQUFJM0wsR0FBTyxDQUNUNEwsR0FBUzVMLEVBQUssQ0FDZixDQUNGLEVBQ0QsQ0FDRSxLQUFNLENBQ0osT0FBTzZMLEdBQVEsQ0FDaEIsRUFDRCxJQUFJN0wsR0FBTyxDQUNUOEwsR0FBUzlMLEVBQUssQ0FDZixDQUNGLENBQ1AsRUFDSUwsR0FBU3lRLEVBQW1CLENBQ2hDLENBQUcsQ0FDSCxDQUFDLEVBR0QsVUFBVSxXQUFZLENBQUMxUSxHQUFJLENBQUUsVUFBQXdHLEdBQVcsV0FBQTVDLElBQWMsQ0FBRSxRQUFTM0QsTUFBZSxDQUMxRUQsR0FBRyxRQUFRLFlBQVcsSUFBTyxZQUMvQixLQUFLLGtEQUFtREEsRUFBRSxFQUM1RCxJQUFJeUMsR0FBUyxVQUFVbUIsRUFBVSxFQUM3QitNLEdBQVMzUSxHQUFHLFFBQVEsVUFBVSxFQUFJLEVBQUUsa0JBQ3hDQSxHQUFHLFlBQWMyUSxHQUNqQkEsR0FBTyxnQkFBa0IzUSxHQUN6QkEsR0FBRyxhQUFhLHlCQUEwQixFQUFJLEVBQzlDMlEsR0FBTyxhQUFhLHVCQUF3QixFQUFJLEVBQzVDM1EsR0FBRyxrQkFDTEEsR0FBRyxpQkFBaUIsUUFBUzRRLElBQWMsQ0FDekNELEdBQU8saUJBQWlCQyxHQUFZL00sSUFBTSxDQUN4Q0EsR0FBRSxnQkFBZSxFQUNqQjdELEdBQUcsY0FBYyxJQUFJNkQsR0FBRSxZQUFZQSxHQUFFLEtBQU1BLEVBQUMsQ0FBQyxDQUNyRCxDQUFPLENBQ1AsQ0FBSyxFQUVILGVBQWU4TSxHQUFRLEdBQUkzUSxFQUFFLEVBQzdCLElBQUk2USxHQUFhLENBQUNDLEdBQVFDLEdBQVNDLEtBQWUsQ0FDNUNBLEdBQVcsU0FBUyxTQUFTLEVBQy9CRCxHQUFRLFdBQVcsYUFBYUQsR0FBUUMsRUFBTyxFQUN0Q0MsR0FBVyxTQUFTLFFBQVEsRUFDckNELEdBQVEsV0FBVyxhQUFhRCxHQUFRQyxHQUFRLFdBQVcsRUFFM0RBLEdBQVEsWUFBWUQsRUFBTSxDQUVoQyxFQUNFLFVBQVUsSUFBTSxDQUNkRCxHQUFXRixHQUFRbE8sR0FBUStELEVBQVMsRUFDcEMsU0FBU21LLEVBQU0sRUFDZkEsR0FBTyxVQUFZLEVBQ3ZCLENBQUcsRUFDRDNRLEdBQUcsbUJBQXFCLElBQU0sQ0FDNUIsSUFBSStRLEdBQVUsVUFBVW5OLEVBQVUsRUFDbEMsVUFBVSxJQUFNLENBQ2RpTixHQUFXN1EsR0FBRyxZQUFhK1EsR0FBU3ZLLEVBQVMsQ0FDbkQsQ0FBSyxDQUNMLEVBQ0V2RyxHQUFTLElBQU0wUSxHQUFPLE9BQU0sQ0FBRSxDQUNoQyxDQUFDLEVBQ0QsSUFBSSw2QkFBK0IsU0FBUyxjQUFjLEtBQUssRUFDL0QsU0FBUyxVQUFVL00sR0FBWSxDQUM3QixJQUFJbkIsR0FBUyxnQkFBZ0IsSUFDcEIsU0FBUyxjQUFjbUIsRUFBVSxFQUN2QyxJQUNNLDRCQUNSLElBQ0QsT0FBS25CLElBQ0gsS0FBSyxpREFBaURtQixFQUFVLEdBQUcsRUFDOURuQixFQUNULENBR0EsSUFBSSxRQUFVLElBQU0sQ0FDcEIsRUFDQSxRQUFRLE9BQVMsQ0FBQ3pDLEdBQUksQ0FBRSxVQUFBd0csRUFBUyxFQUFJLENBQUUsUUFBU3ZHLE1BQWUsQ0FDN0R1RyxHQUFVLFNBQVMsTUFBTSxFQUFJeEcsR0FBRyxjQUFnQixHQUFPQSxHQUFHLFVBQVksR0FDdEVDLEdBQVMsSUFBTSxDQUNidUcsR0FBVSxTQUFTLE1BQU0sRUFBSSxPQUFPeEcsR0FBRyxjQUFnQixPQUFPQSxHQUFHLFNBQ3JFLENBQUcsQ0FDSCxFQUNBLFVBQVUsU0FBVSxPQUFPLEVBRzNCLFVBQVUsU0FBVSxnQkFBZ0IsQ0FBQ0EsR0FBSSxDQUFFLFdBQUE0RCxJQUFjLENBQUUsT0FBUWtDLE1BQWMsQ0FDL0VBLEdBQVEsY0FBYzlGLEdBQUk0RCxFQUFVLENBQUMsQ0FDdkMsQ0FBQyxDQUFDLEVBR0YsU0FBUyxHQUFHNUQsR0FBSWlSLEdBQU96SyxHQUFXL0csR0FBVSxDQUMxQyxJQUFJeVIsR0FBaUJsUixHQUNqQmlHLEdBQVlwQyxJQUFNcEUsR0FBU29FLEVBQUMsRUFDNUJzSixHQUFVLENBQUEsRUFDVmdFLEdBQWMsQ0FBQ25HLEdBQVdvRyxLQUFhdk4sSUFBTXVOLEdBQVFwRyxHQUFXbkgsRUFBQyxFQWFyRSxHQVpJMkMsR0FBVSxTQUFTLEtBQUssSUFDMUJ5SyxHQUFRLFVBQVVBLEVBQUssR0FDckJ6SyxHQUFVLFNBQVMsT0FBTyxJQUM1QnlLLEdBQVEsV0FBV0EsRUFBSyxHQUN0QnpLLEdBQVUsU0FBUyxTQUFTLElBQzlCMkcsR0FBUSxRQUFVLElBQ2hCM0csR0FBVSxTQUFTLFNBQVMsSUFDOUIyRyxHQUFRLFFBQVUsSUFDaEIzRyxHQUFVLFNBQVMsUUFBUSxJQUM3QjBLLEdBQWlCLFFBQ2YxSyxHQUFVLFNBQVMsVUFBVSxJQUMvQjBLLEdBQWlCLFVBQ2YxSyxHQUFVLFNBQVMsVUFBVSxFQUFHLENBQ2xDLElBQUk2SyxHQUFlN0ssR0FBVUEsR0FBVSxRQUFRLFVBQVUsRUFBSSxDQUFDLEdBQUssZUFDL0RtRixHQUFPLFVBQVUwRixHQUFhLE1BQU0sSUFBSSxFQUFFLENBQUMsQ0FBQyxFQUFJLE9BQU9BLEdBQWEsTUFBTSxJQUFJLEVBQUUsQ0FBQyxDQUFDLEVBQUksSUFDMUZwTCxHQUFXLFNBQVNBLEdBQVUwRixFQUFJLENBQ25DLENBQ0QsR0FBSW5GLEdBQVUsU0FBUyxVQUFVLEVBQUcsQ0FDbEMsSUFBSTZLLEdBQWU3SyxHQUFVQSxHQUFVLFFBQVEsVUFBVSxFQUFJLENBQUMsR0FBSyxlQUMvRG1GLEdBQU8sVUFBVTBGLEdBQWEsTUFBTSxJQUFJLEVBQUUsQ0FBQyxDQUFDLEVBQUksT0FBT0EsR0FBYSxNQUFNLElBQUksRUFBRSxDQUFDLENBQUMsRUFBSSxJQUMxRnBMLEdBQVcsU0FBU0EsR0FBVTBGLEVBQUksQ0FDbkMsQ0FDRCxPQUFJbkYsR0FBVSxTQUFTLFNBQVMsSUFDOUJQLEdBQVdrTCxHQUFZbEwsR0FBVSxDQUFDcUwsR0FBTXpOLEtBQU0sQ0FDNUNBLEdBQUUsZUFBYyxFQUNoQnlOLEdBQUt6TixFQUFDLENBQ1osQ0FBSyxHQUNDMkMsR0FBVSxTQUFTLE1BQU0sSUFDM0JQLEdBQVdrTCxHQUFZbEwsR0FBVSxDQUFDcUwsR0FBTXpOLEtBQU0sQ0FDNUNBLEdBQUUsZ0JBQWUsRUFDakJ5TixHQUFLek4sRUFBQyxDQUNaLENBQUssR0FDQzJDLEdBQVUsU0FBUyxNQUFNLElBQzNCUCxHQUFXa0wsR0FBWWxMLEdBQVUsQ0FBQ3FMLEdBQU16TixLQUFNLENBQzVDQSxHQUFFLFNBQVc3RCxJQUFNc1IsR0FBS3pOLEVBQUMsQ0FDL0IsQ0FBSyxJQUNDMkMsR0FBVSxTQUFTLE1BQU0sR0FBS0EsR0FBVSxTQUFTLFNBQVMsS0FDNUQwSyxHQUFpQixTQUNqQmpMLEdBQVdrTCxHQUFZbEwsR0FBVSxDQUFDcUwsR0FBTXpOLEtBQU0sQ0FDeEM3RCxHQUFHLFNBQVM2RCxHQUFFLE1BQU0sR0FFcEJBLEdBQUUsT0FBTyxjQUFnQixLQUV6QjdELEdBQUcsWUFBYyxHQUFLQSxHQUFHLGFBQWUsR0FFeENBLEdBQUcsYUFBZSxJQUV0QnNSLEdBQUt6TixFQUFDLEVBQ1osQ0FBSyxHQUVDMkMsR0FBVSxTQUFTLE1BQU0sSUFDM0JQLEdBQVdrTCxHQUFZbEwsR0FBVSxDQUFDcUwsR0FBTXpOLEtBQU0sQ0FDNUN5TixHQUFLek4sRUFBQyxFQUNOcU4sR0FBZSxvQkFBb0JELEdBQU9oTCxHQUFVa0gsRUFBTyxDQUNqRSxDQUFLLEdBRUhsSCxHQUFXa0wsR0FBWWxMLEdBQVUsQ0FBQ3FMLEdBQU16TixLQUFNLENBQ3hDLFdBQVdvTixFQUFLLEdBQ2QsK0NBQStDcE4sR0FBRzJDLEVBQVMsR0FJakU4SyxHQUFLek4sRUFBQyxDQUNWLENBQUcsRUFDRHFOLEdBQWUsaUJBQWlCRCxHQUFPaEwsR0FBVWtILEVBQU8sRUFDakQsSUFBTSxDQUNYK0QsR0FBZSxvQkFBb0JELEdBQU9oTCxHQUFVa0gsRUFBTyxDQUMvRCxDQUNBLENBQ0EsU0FBUyxVQUFVckksR0FBUyxDQUMxQixPQUFPQSxHQUFRLFFBQVEsS0FBTSxHQUFHLENBQ2xDLENBQ0EsU0FBUyxXQUFXQSxHQUFTLENBQzNCLE9BQU9BLEdBQVEsY0FBYyxRQUFRLFNBQVUsQ0FBQzJGLEdBQU9hLEtBQVNBLEdBQUssWUFBVyxDQUFFLENBQ3BGLENBQ0EsU0FBUyxVQUFVeEcsR0FBUyxDQUMxQixNQUFPLENBQUMsTUFBTSxRQUFRQSxFQUFPLEdBQUssQ0FBQyxNQUFNQSxFQUFPLENBQ2xELENBQ0EsU0FBUyxXQUFXQSxHQUFTLENBQzNCLE1BQUksQ0FBQyxJQUFLLEdBQUcsRUFBRSxTQUNiQSxFQUNELEVBQ1FBLEdBQ0ZBLEdBQVEsUUFBUSxrQkFBbUIsT0FBTyxFQUFFLFFBQVEsUUFBUyxHQUFHLEVBQUUsYUFDM0UsQ0FDQSxTQUFTLFdBQVdtTSxHQUFPLENBQ3pCLE1BQU8sQ0FBQyxVQUFXLE9BQU8sRUFBRSxTQUFTQSxFQUFLLENBQzVDLENBQ0EsU0FBUywrQ0FBK0NwTixHQUFHMkMsR0FBVyxDQUNwRSxJQUFJK0ssR0FBZS9LLEdBQVUsT0FBUTVHLElBQzVCLENBQUMsQ0FBQyxTQUFVLFdBQVksVUFBVyxPQUFRLE9BQVEsU0FBUyxFQUFFLFNBQVNBLEVBQUMsQ0FDaEYsRUFDRCxHQUFJMlIsR0FBYSxTQUFTLFVBQVUsRUFBRyxDQUNyQyxJQUFJQyxHQUFnQkQsR0FBYSxRQUFRLFVBQVUsRUFDbkRBLEdBQWEsT0FBT0MsR0FBZSxXQUFXRCxHQUFhQyxHQUFnQixDQUFDLEdBQUssZ0JBQWdCLE1BQU0sSUFBSSxFQUFFLENBQUMsQ0FBQyxFQUFJLEVBQUksQ0FBQyxDQUN6SCxDQUNELEdBQUlELEdBQWEsU0FBUyxVQUFVLEVBQUcsQ0FDckMsSUFBSUMsR0FBZ0JELEdBQWEsUUFBUSxVQUFVLEVBQ25EQSxHQUFhLE9BQU9DLEdBQWUsV0FBV0QsR0FBYUMsR0FBZ0IsQ0FBQyxHQUFLLGdCQUFnQixNQUFNLElBQUksRUFBRSxDQUFDLENBQUMsRUFBSSxFQUFJLENBQUMsQ0FDekgsQ0FHRCxHQUZJRCxHQUFhLFNBQVcsR0FFeEJBLEdBQWEsU0FBVyxHQUFLLGVBQWUxTixHQUFFLEdBQUcsRUFBRSxTQUFTME4sR0FBYSxDQUFDLENBQUMsRUFDN0UsTUFBTyxHQUVULE1BQU1FLEdBRHFCLENBQUMsT0FBUSxRQUFTLE1BQU8sT0FBUSxNQUFPLE9BQU8sRUFDcEIsT0FBUUMsSUFBYUgsR0FBYSxTQUFTRyxFQUFRLENBQUMsRUFFMUcsT0FEQUgsR0FBZUEsR0FBYSxPQUFRM1IsSUFBTSxDQUFDNlIsR0FBMkIsU0FBUzdSLEVBQUMsQ0FBQyxFQUM3RSxFQUFBNlIsR0FBMkIsT0FBUyxHQUNGQSxHQUEyQixPQUFRQyxNQUNqRUEsS0FBYSxPQUFTQSxLQUFhLFdBQ3JDQSxHQUFXLFFBQ043TixHQUFFLEdBQUc2TixFQUFRLEtBQUssRUFDMUIsRUFDK0IsU0FBV0QsR0FBMkIsUUFDaEUsZUFBZTVOLEdBQUUsR0FBRyxFQUFFLFNBQVMwTixHQUFhLENBQUMsQ0FBQyxFQUt4RCxDQUNBLFNBQVMsZUFBZTNPLEdBQUssQ0FDM0IsR0FBSSxDQUFDQSxHQUNILE1BQU8sR0FDVEEsR0FBTSxXQUFXQSxFQUFHLEVBQ3BCLElBQUkrTyxHQUFtQixDQUNyQixLQUFRLFVBQ1IsTUFBUyxJQUNULE1BQVMsSUFDVCxTQUFZLElBQ1osSUFBTyxPQUNQLElBQU8sU0FDUCxHQUFNLFdBQ04sS0FBUSxhQUNSLEtBQVEsYUFDUixNQUFTLGNBQ1QsT0FBVSxJQUNWLE1BQVMsSUFDVCxNQUFTLElBQ1QsV0FBYyxHQUNsQixFQUNFLE9BQUFBLEdBQWlCL08sRUFBRyxFQUFJQSxHQUNqQixPQUFPLEtBQUsrTyxFQUFnQixFQUFFLElBQUtELElBQWEsQ0FDckQsR0FBSUMsR0FBaUJELEVBQVEsSUFBTTlPLEdBQ2pDLE9BQU84TyxFQUNWLENBQUEsRUFBRSxPQUFRQSxJQUFhQSxFQUFRLENBQ2xDLENBR0EsVUFBVSxRQUFTLENBQUMxUixHQUFJLENBQUUsVUFBQXdHLEdBQVcsV0FBQTVDLElBQWMsQ0FBRSxPQUFRa0MsR0FBUyxRQUFTN0YsRUFBUSxJQUFPLENBQzVGLElBQUkyUixHQUFjNVIsR0FDZHdHLEdBQVUsU0FBUyxRQUFRLElBQzdCb0wsR0FBYzVSLEdBQUcsWUFFbkIsSUFBSTZSLEdBQWMsY0FBY0QsR0FBYWhPLEVBQVUsRUFDbkRrTyxHQUNBLE9BQU9sTyxJQUFlLFNBQ3hCa08sR0FBYyxjQUFjRixHQUFhLEdBQUdoTyxFQUFVLGtCQUFrQixFQUMvRCxPQUFPQSxJQUFlLFlBQWMsT0FBT0EsR0FBVSxHQUFPLFNBQ3JFa08sR0FBYyxjQUFjRixHQUFhLEdBQUdoTyxHQUFZLENBQUEsa0JBQWtCLEVBRTFFa08sR0FBYyxJQUFNLENBQ3hCLEVBRUUsSUFBSUMsR0FBVyxJQUFNLENBQ25CLElBQUluUSxHQUNKLE9BQUFpUSxHQUFhdlIsSUFBVXNCLEdBQVN0QixFQUFLLEVBQzlCLGVBQWVzQixFQUFNLEVBQUlBLEdBQU8sSUFBRyxFQUFLQSxFQUNuRCxFQUNNb1EsR0FBWTFSLElBQVUsQ0FDeEIsSUFBSXNCLEdBQ0ppUSxHQUFhakssSUFBV2hHLEdBQVNnRyxFQUFNLEVBQ25DLGVBQWVoRyxFQUFNLEVBQ3ZCQSxHQUFPLElBQUl0QixFQUFLLEVBRWhCd1IsR0FBWSxJQUFNLENBQ3hCLEVBQVMsQ0FDRCxNQUFPLENBQUUsY0FBaUJ4UixFQUFPLENBQ3pDLENBQU8sQ0FFUCxFQUNNLE9BQU9zRCxJQUFlLFVBQVk1RCxHQUFHLE9BQVMsU0FDaEQsVUFBVSxJQUFNLENBQ1RBLEdBQUcsYUFBYSxNQUFNLEdBQ3pCQSxHQUFHLGFBQWEsT0FBUTRELEVBQVUsQ0FDMUMsQ0FBSyxFQUVILElBQUlxTixHQUFRalIsR0FBRyxRQUFRLFlBQVcsSUFBTyxVQUFZLENBQUMsV0FBWSxPQUFPLEVBQUUsU0FBU0EsR0FBRyxJQUFJLEdBQUt3RyxHQUFVLFNBQVMsTUFBTSxFQUFJLFNBQVcsUUFDeEksSUFBSXlMLEdBQWlCLFVBQVksSUFBTSxDQUN0QyxFQUFHLEdBQUdqUyxHQUFJaVIsR0FBT3pLLEdBQVkzQyxJQUFNLENBQ2xDbU8sR0FBUyxjQUFjaFMsR0FBSXdHLEdBQVczQyxHQUFHa08sR0FBVSxDQUFBLENBQUMsQ0FDeEQsQ0FBRyxFQVVELEdBVEl2TCxHQUFVLFNBQVMsTUFBTSxJQUN2QixDQUFDLE9BQVEsS0FBTSxFQUFFLEVBQUUsU0FBU3VMLEdBQVEsQ0FBRSxHQUFLL1IsR0FBRyxPQUFTLFlBQWMsTUFBTSxRQUFRK1IsR0FBUSxDQUFFLElBQy9GL1IsR0FBRyxjQUFjLElBQUksTUFBTWlSLEdBQU8sQ0FBQSxDQUFFLENBQUMsRUFHcENqUixHQUFHLDBCQUNOQSxHQUFHLHdCQUEwQixJQUMvQkEsR0FBRyx3QkFBd0IsUUFBYWlTLEdBQ3hDaFMsR0FBUyxJQUFNRCxHQUFHLHdCQUF3QixRQUFZLENBQUEsRUFDbERBLEdBQUcsS0FBTSxDQUNYLElBQUlrUyxHQUFzQixHQUFHbFMsR0FBRyxLQUFNLFFBQVMsQ0FBQSxFQUFLNkQsSUFBTSxDQUN4RCxTQUFTLElBQU03RCxHQUFHLFVBQVlBLEdBQUcsU0FBUyxJQUFJQSxHQUFHLEtBQUssQ0FBQyxDQUM3RCxDQUFLLEVBQ0RDLEdBQVMsSUFBTWlTLEdBQW1CLENBQUUsQ0FDckMsQ0FDRGxTLEdBQUcsU0FBVyxDQUNaLEtBQU0sQ0FDSixPQUFPK1IsR0FBUSxDQUNoQixFQUNELElBQUl6UixHQUFPLENBQ1QwUixHQUFTMVIsRUFBSyxDQUNmLENBQ0wsRUFDRU4sR0FBRyxvQkFBdUJNLElBQVUsQ0FDOUJBLEtBQVUsUUFBVSxPQUFPc0QsSUFBZSxVQUFZQSxHQUFXLE1BQU0sSUFBSSxJQUM3RXRELEdBQVEsSUFDVixPQUFPLFVBQVksR0FDbkIsVUFBVSxJQUFNLEtBQUtOLEdBQUksUUFBU00sRUFBSyxDQUFDLEVBQ3hDLE9BQU8sT0FBTyxTQUNsQixFQUNFd0YsR0FBUSxJQUFNLENBQ1osSUFBSXhGLEdBQVF5UixLQUNSdkwsR0FBVSxTQUFTLGFBQWEsR0FBSyxTQUFTLGNBQWMsV0FBV3hHLEVBQUUsR0FFN0VBLEdBQUcsb0JBQW9CTSxFQUFLLENBQ2hDLENBQUcsQ0FDSCxDQUFDLEVBQ0QsU0FBUyxjQUFjTixHQUFJd0csR0FBV3lLLEdBQU9rQixHQUFjLENBQ3pELE9BQU8sVUFBVSxJQUFNLENBQ3JCLEdBQUlsQixjQUFpQixhQUFlQSxHQUFNLFNBQVcsT0FDbkQsT0FBT0EsR0FBTSxTQUFXLE1BQVFBLEdBQU0sU0FBVyxPQUFTQSxHQUFNLE9BQVNBLEdBQU0sT0FBTyxNQUNuRixHQUFJalIsR0FBRyxPQUFTLFdBQ25CLEdBQUksTUFBTSxRQUFRbVMsRUFBWSxFQUFHLENBQy9CLElBQUkvTCxHQUFXLEtBQ2YsT0FBSUksR0FBVSxTQUFTLFFBQVEsRUFDN0JKLEdBQVcsZ0JBQWdCNkssR0FBTSxPQUFPLEtBQUssRUFDcEN6SyxHQUFVLFNBQVMsU0FBUyxFQUNyQ0osR0FBVyxpQkFBaUI2SyxHQUFNLE9BQU8sS0FBSyxFQUU5QzdLLEdBQVc2SyxHQUFNLE9BQU8sTUFFbkJBLEdBQU0sT0FBTyxRQUFVa0IsR0FBYSxPQUFPLENBQUMvTCxFQUFRLENBQUMsRUFBSStMLEdBQWEsT0FBUTFSLElBQVEsQ0FBQyx5QkFBeUJBLEdBQUsyRixFQUFRLENBQUMsQ0FDN0ksS0FDUSxRQUFPNkssR0FBTSxPQUFPLFlBRWpCLFFBQUlqUixHQUFHLFFBQVEsWUFBYSxJQUFLLFVBQVlBLEdBQUcsU0FDakR3RyxHQUFVLFNBQVMsUUFBUSxFQUN0QixNQUFNLEtBQUt5SyxHQUFNLE9BQU8sZUFBZSxFQUFFLElBQUs1RixJQUFXLENBQzlELElBQUliLEdBQVdhLEdBQU8sT0FBU0EsR0FBTyxLQUN0QyxPQUFPLGdCQUFnQmIsRUFBUSxDQUN6QyxDQUFTLEVBQ1FoRSxHQUFVLFNBQVMsU0FBUyxFQUM5QixNQUFNLEtBQUt5SyxHQUFNLE9BQU8sZUFBZSxFQUFFLElBQUs1RixJQUFXLENBQzlELElBQUliLEdBQVdhLEdBQU8sT0FBU0EsR0FBTyxLQUN0QyxPQUFPLGlCQUFpQmIsRUFBUSxDQUMxQyxDQUFTLEVBRUksTUFBTSxLQUFLeUcsR0FBTSxPQUFPLGVBQWUsRUFBRSxJQUFLNUYsSUFDNUNBLEdBQU8sT0FBU0EsR0FBTyxJQUMvQixFQUVHN0UsR0FBVSxTQUFTLFFBQVEsRUFDdEIsZ0JBQWdCeUssR0FBTSxPQUFPLEtBQUssRUFDaEN6SyxHQUFVLFNBQVMsU0FBUyxFQUM5QixpQkFBaUJ5SyxHQUFNLE9BQU8sS0FBSyxFQUVyQ3pLLEdBQVUsU0FBUyxNQUFNLEVBQUl5SyxHQUFNLE9BQU8sTUFBTSxLQUFNLEVBQUdBLEdBQU0sT0FBTyxLQUVuRixDQUFHLENBQ0gsQ0FDQSxTQUFTLGdCQUFnQnpHLEdBQVUsQ0FDakMsSUFBSTRILEdBQVM1SCxHQUFXLFdBQVdBLEVBQVEsRUFBSSxLQUMvQyxPQUFPLFdBQVc0SCxFQUFNLEVBQUlBLEdBQVM1SCxFQUN2QyxDQUNBLFNBQVMseUJBQXlCZSxHQUFRQyxHQUFRLENBQ2hELE9BQU9ELElBQVVDLEVBQ25CLENBQ0EsU0FBUyxXQUFXMUcsR0FBUyxDQUMzQixNQUFPLENBQUMsTUFBTSxRQUFRQSxFQUFPLEdBQUssQ0FBQyxNQUFNQSxFQUFPLENBQ2xELENBQ0EsU0FBUyxlQUFleEUsR0FBTyxDQUM3QixPQUFPQSxLQUFVLE1BQVEsT0FBT0EsSUFBVSxVQUFZLE9BQU9BLEdBQU0sS0FBUSxZQUFjLE9BQU9BLEdBQU0sS0FBUSxVQUNoSCxDQUdBLFVBQVUsUUFBVU4sSUFBTyxlQUFlLElBQU0sVUFBVSxJQUFNQSxHQUFHLGdCQUFnQixPQUFPLE9BQU8sQ0FBQyxDQUFDLENBQUMsQ0FBQyxFQUdyRyxnQkFBZ0IsSUFBTSxJQUFJLE9BQU8sTUFBTSxDQUFDLEdBQUcsRUFDM0MsVUFBVSxPQUFRLGdCQUFnQixDQUFDQSxHQUFJLENBQUUsV0FBQTRELElBQWMsQ0FBRSxTQUFVbUUsTUFDN0QsT0FBT25FLElBQWUsU0FDakIsQ0FBQyxDQUFDQSxHQUFXLEtBQU0sR0FBSW1FLEdBQVVuRSxHQUFZLENBQUEsRUFBSSxFQUFLLEVBRXhEbUUsR0FBVW5FLEdBQVksQ0FBRSxFQUFFLEVBQUssQ0FDdkMsQ0FBQyxFQUdGLFVBQVUsT0FBUSxDQUFDNUQsR0FBSSxDQUFFLFdBQUE0RCxFQUFVLEVBQUksQ0FBRSxPQUFRa0MsR0FBUyxjQUFlbUssTUFBcUIsQ0FDNUYsSUFBSWxJLEdBQVlrSSxHQUFlck0sRUFBVSxFQUN6Q2tDLEdBQVEsSUFBTSxDQUNaaUMsR0FBV3pILElBQVUsQ0FDbkIsVUFBVSxJQUFNLENBQ2ROLEdBQUcsWUFBY00sRUFDekIsQ0FBTyxDQUNQLENBQUssQ0FDTCxDQUFHLENBQ0gsQ0FBQyxFQUdELFVBQVUsT0FBUSxDQUFDTixHQUFJLENBQUUsV0FBQTRELEVBQVUsRUFBSSxDQUFFLE9BQVFrQyxHQUFTLGNBQWVtSyxNQUFxQixDQUM1RixJQUFJbEksR0FBWWtJLEdBQWVyTSxFQUFVLEVBQ3pDa0MsR0FBUSxJQUFNLENBQ1ppQyxHQUFXekgsSUFBVSxDQUNuQixVQUFVLElBQU0sQ0FDZE4sR0FBRyxVQUFZTSxHQUNmTixHQUFHLGNBQWdCLEdBQ25CLFNBQVNBLEVBQUUsRUFDWCxPQUFPQSxHQUFHLGFBQ2xCLENBQU8sQ0FDUCxDQUFLLENBQ0wsQ0FBRyxDQUNILENBQUMsRUFHRCxjQUFjLGFBQWEsSUFBSyxLQUFLLE9BQU8sT0FBTyxDQUFDLENBQUMsQ0FBQyxFQUN0RCxJQUFJLFNBQVcsQ0FBQ0EsR0FBSSxDQUFFLE1BQUFNLEdBQU8sVUFBQWtHLEdBQVcsV0FBQTVDLEdBQVksU0FBQTZDLElBQVksQ0FBRSxPQUFRWCxNQUFjLENBQ3RGLEdBQUksQ0FBQ3hGLEdBQU8sQ0FDVixJQUFJK1IsR0FBbUIsQ0FBQSxFQUN2Qix1QkFBdUJBLEVBQWdCLEVBQ3JCLGNBQWNyUyxHQUFJNEQsRUFBVSxFQUNqQ2dKLElBQWEsQ0FDeEIsb0JBQW9CNU0sR0FBSTRNLEdBQVVuRyxFQUFRLENBQ2hELEVBQU8sQ0FBRSxNQUFPNEwsRUFBZ0IsQ0FBRSxFQUM5QixNQUNELENBQ0QsR0FBSS9SLEtBQVUsTUFDWixPQUFPLGdCQUFnQk4sR0FBSTRELEVBQVUsRUFDdkMsR0FBSTVELEdBQUcsbUJBQXFCQSxHQUFHLGtCQUFrQk0sRUFBSyxHQUFLTixHQUFHLGtCQUFrQk0sRUFBSyxFQUFFLFFBQ3JGLE9BRUYsSUFBSXlILEdBQVksY0FBYy9ILEdBQUk0RCxFQUFVLEVBQzVDa0MsR0FBUSxJQUFNaUMsR0FBV25HLElBQVcsQ0FDOUJBLEtBQVcsUUFBVSxPQUFPZ0MsSUFBZSxVQUFZQSxHQUFXLE1BQU0sSUFBSSxJQUM5RWhDLEdBQVMsSUFFWCxVQUFVLElBQU0sS0FBSzVCLEdBQUlNLEdBQU9zQixHQUFRNEUsRUFBUyxDQUFDLENBQ25ELENBQUEsQ0FBQyxDQUNKLEVBQ0EsU0FBUyxPQUFTLENBQUN4RyxHQUFJLENBQUUsTUFBQU0sR0FBTyxVQUFBa0csR0FBVyxXQUFBNUMsTUFBaUIsQ0FDckR0RCxLQUVBTixHQUFHLG9CQUNOQSxHQUFHLGtCQUFvQixJQUN6QkEsR0FBRyxrQkFBa0JNLEVBQUssRUFBSSxDQUFFLFdBQUFzRCxHQUFZLFFBQVMsSUFDdkQsRUFDQSxVQUFVLE9BQVEsUUFBUSxFQUMxQixTQUFTLGdCQUFnQjVELEdBQUk0RCxHQUFZLENBQ3ZDNUQsR0FBRyxpQkFBbUI0RCxFQUN4QixDQUdBLGdCQUFnQixJQUFNLElBQUksT0FBTyxNQUFNLENBQUMsR0FBRyxFQUMzQyxVQUFVLE9BQVEsQ0FBQzVELEdBQUksQ0FBRSxXQUFBNEQsRUFBVSxFQUFJLENBQUUsUUFBUzNELE1BQWUsQ0FDL0QsR0FBSSxxQ0FBcUNELEVBQUUsRUFDekMsT0FDRjRELEdBQWFBLEtBQWUsR0FBSyxLQUFPQSxHQUN4QyxJQUFJME8sR0FBZSxDQUFBLEVBQ25CLGFBQWFBLEdBQWN0UyxFQUFFLEVBQzdCLElBQUl1UyxHQUFzQixDQUFBLEVBQzFCLG9CQUFvQkEsR0FBcUJELEVBQVksRUFDckQsSUFBSWxRLEdBQVEsU0FBU3BDLEdBQUk0RCxHQUFZLENBQUUsTUFBTzJPLEVBQW1CLENBQUUsR0FDL0RuUSxLQUFVLFFBQVVBLEtBQVUsTUFDaENBLEdBQVEsQ0FBQSxHQUNWLGFBQWFBLEdBQU9wQyxFQUFFLEVBQ3RCLElBQUl3UyxHQUFlLFNBQVNwUSxFQUFLLEVBQ2pDLGtCQUFrQm9RLEVBQVksRUFDOUIsSUFBSUMsR0FBTyxlQUFlelMsR0FBSXdTLEVBQVksRUFDMUNBLEdBQWEsTUFBVyxTQUFTeFMsR0FBSXdTLEdBQWEsSUFBTyxFQUN6RHZTLEdBQVMsSUFBTSxDQUNidVMsR0FBYSxTQUFjLFNBQVN4UyxHQUFJd1MsR0FBYSxPQUFVLEVBQy9EQyxJQUNKLENBQUcsQ0FDSCxDQUFDLEVBQ0QsZUFBZSxDQUFDL0gsR0FBTUMsS0FBTyxDQUN2QkQsR0FBSyxlQUNQQyxHQUFHLGFBQWVELEdBQUssYUFDdkJDLEdBQUcsYUFBYSx3QkFBeUIsRUFBSSxFQUVqRCxDQUFDLEVBQ0QsU0FBUyxxQ0FBcUMzSyxHQUFJLENBQ2hELE9BQUssVUFFRCxnQkFDSyxHQUNGQSxHQUFHLGFBQWEsdUJBQXVCLEVBSHJDLEVBSVgsQ0FHQSxVQUFVLE9BQVEsQ0FBQ0EsR0FBSSxDQUFFLFVBQUF3RyxHQUFXLFdBQUE1QyxJQUFjLENBQUUsT0FBUWtDLE1BQWMsQ0FDeEUsSUFBSWlDLEdBQVksY0FBYy9ILEdBQUk0RCxFQUFVLEVBQ3ZDNUQsR0FBRyxZQUNOQSxHQUFHLFVBQVksSUFBTSxDQUNuQixVQUFVLElBQU0sQ0FDZEEsR0FBRyxNQUFNLFlBQVksVUFBVyxPQUFRd0csR0FBVSxTQUFTLFdBQVcsRUFBSSxZQUFjLE1BQU0sQ0FDdEcsQ0FBTyxDQUNQLEdBQ094RyxHQUFHLFlBQ05BLEdBQUcsVUFBWSxJQUFNLENBQ25CLFVBQVUsSUFBTSxDQUNWQSxHQUFHLE1BQU0sU0FBVyxHQUFLQSxHQUFHLE1BQU0sVUFBWSxPQUNoREEsR0FBRyxnQkFBZ0IsT0FBTyxFQUUxQkEsR0FBRyxNQUFNLGVBQWUsU0FBUyxDQUUzQyxDQUFPLENBQ1AsR0FDRSxJQUFJb0osR0FBTyxJQUFNLENBQ2ZwSixHQUFHLFVBQVMsRUFDWkEsR0FBRyxXQUFhLEVBQ3BCLEVBQ01tSixHQUFPLElBQU0sQ0FDZm5KLEdBQUcsVUFBUyxFQUNaQSxHQUFHLFdBQWEsRUFDcEIsRUFDTXNKLEdBQTBCLElBQU0sV0FBV0gsRUFBSSxFQUMvQ3VKLEdBQVMsS0FDVnBTLElBQVVBLEdBQVE2SSxHQUFJLEVBQUtDLEdBQU0sRUFDakM5SSxJQUFVLENBQ0wsT0FBT04sR0FBRyxvQ0FBdUMsV0FDbkRBLEdBQUcsbUNBQW1DQSxHQUFJTSxHQUFPNkksR0FBTUMsRUFBSSxFQUUzRDlJLEdBQVFnSixLQUE0QkYsSUFFdkMsQ0FDTCxFQUNNL0ksR0FDQUQsR0FBWSxHQUNoQjBGLEdBQVEsSUFBTWlDLEdBQVd6SCxJQUFVLENBQzdCLENBQUNGLElBQWFFLEtBQVVELEtBRXhCbUcsR0FBVSxTQUFTLFdBQVcsSUFDaENsRyxHQUFRZ0osS0FBNEJGLE1BQ3RDc0osR0FBT3BTLEVBQUssRUFDWkQsR0FBV0MsR0FDWEYsR0FBWSxHQUNiLENBQUEsQ0FBQyxDQUNKLENBQUMsRUFHRCxVQUFVLE1BQU8sQ0FBQ0osR0FBSSxDQUFFLFdBQUE0RCxFQUFVLEVBQUksQ0FBRSxPQUFRa0MsR0FBUyxRQUFTN0YsTUFBZSxDQUMvRSxJQUFJMFMsR0FBZ0IsbUJBQW1CL08sRUFBVSxFQUM3Q2dQLEdBQWdCLGNBQWM1UyxHQUFJMlMsR0FBYyxLQUFLLEVBQ3JERSxHQUFjLGNBQ2hCN1MsR0FFQUEsR0FBRyxrQkFBb0IsT0FDM0IsRUFDRUEsR0FBRyxZQUFjLEdBQ2pCQSxHQUFHLFVBQVksR0FDZjhGLEdBQVEsSUFBTSxLQUFLOUYsR0FBSTJTLEdBQWVDLEdBQWVDLEVBQVcsQ0FBQyxFQUNqRTVTLEdBQVMsSUFBTSxDQUNiLE9BQU8sT0FBT0QsR0FBRyxTQUFTLEVBQUUsUUFBU1MsSUFBUUEsR0FBSSxPQUFNLENBQUUsRUFDekQsT0FBT1QsR0FBRyxZQUNWLE9BQU9BLEdBQUcsU0FDZCxDQUFHLENBQ0gsQ0FBQyxFQUNELFNBQVMsS0FBS0EsR0FBSTJTLEdBQWVDLEdBQWVDLEdBQWEsQ0FDM0QsSUFBSWhRLEdBQWFqRCxJQUFNLE9BQU9BLElBQU0sVUFBWSxDQUFDLE1BQU0sUUFBUUEsRUFBQyxFQUM1RGtULEdBQWE5UyxHQUNqQjRTLEdBQWVHLElBQVUsQ0FDbkIsV0FBV0EsRUFBSyxHQUFLQSxJQUFTLElBQ2hDQSxHQUFRLE1BQU0sS0FBSyxNQUFNQSxFQUFLLEVBQUUsS0FBSSxFQUFLblQsSUFBTUEsR0FBSSxDQUFDLEdBRWxEbVQsS0FBVSxTQUNaQSxHQUFRLENBQUEsR0FDVixJQUFJQyxHQUFTaFQsR0FBRyxVQUNaaVQsR0FBV2pULEdBQUcsWUFDZGtULEdBQVMsQ0FBQSxFQUNUQyxHQUFPLENBQUEsRUFDWCxHQUFJdFEsR0FBVWtRLEVBQUssRUFDakJBLEdBQVEsT0FBTyxRQUFRQSxFQUFLLEVBQUUsSUFBSSxDQUFDLENBQUNuUSxHQUFLdEMsRUFBSyxJQUFNLENBQ2xELElBQUlpRSxHQUFTLDJCQUEyQm9PLEdBQWVyUyxHQUFPc0MsR0FBS21RLEVBQUssRUFDeEVGLEdBQWFqTCxJQUFXLENBQ2xCdUwsR0FBSyxTQUFTdkwsRUFBTSxHQUN0QixLQUFLLHlCQUEwQjVILEVBQUUsRUFDbkNtVCxHQUFLLEtBQUt2TCxFQUFNLENBQzFCLEVBQVcsQ0FBRSxNQUFPLENBQUUsTUFBT2hGLEdBQUssR0FBRzJCLEVBQVEsQ0FBQSxDQUFFLEVBQ3ZDMk8sR0FBTyxLQUFLM08sRUFBTSxDQUMxQixDQUFPLE1BRUQsU0FBUzNFLEdBQUksRUFBR0EsR0FBSW1ULEdBQU0sT0FBUW5ULEtBQUssQ0FDckMsSUFBSTJFLEdBQVMsMkJBQTJCb08sR0FBZUksR0FBTW5ULEVBQUMsRUFBR0EsR0FBR21ULEVBQUssRUFDekVGLEdBQWF2UyxJQUFVLENBQ2pCNlMsR0FBSyxTQUFTN1MsRUFBSyxHQUNyQixLQUFLLHlCQUEwQk4sRUFBRSxFQUNuQ21ULEdBQUssS0FBSzdTLEVBQUssQ0FDekIsRUFBVyxDQUFFLE1BQU8sQ0FBRSxNQUFPVixHQUFHLEdBQUcyRSxFQUFRLENBQUEsQ0FBRSxFQUNyQzJPLEdBQU8sS0FBSzNPLEVBQU0sQ0FDbkIsQ0FFSCxJQUFJNk8sR0FBTyxDQUFBLEVBQ1BDLEdBQVEsQ0FBQSxFQUNSQyxHQUFVLENBQUEsRUFDVkMsR0FBUSxDQUFBLEVBQ1osUUFBUzNULEdBQUksRUFBR0EsR0FBSXFULEdBQVMsT0FBUXJULEtBQUssQ0FDeEMsSUFBSWdELEdBQU1xUSxHQUFTclQsRUFBQyxFQUNoQnVULEdBQUssUUFBUXZRLEVBQUcsSUFBTSxJQUN4QjBRLEdBQVEsS0FBSzFRLEVBQUcsQ0FDbkIsQ0FDRHFRLEdBQVdBLEdBQVMsT0FBUXJRLElBQVEsQ0FBQzBRLEdBQVEsU0FBUzFRLEVBQUcsQ0FBQyxFQUMxRCxJQUFJNFEsR0FBVSxXQUNkLFFBQVM1VCxHQUFJLEVBQUdBLEdBQUl1VCxHQUFLLE9BQVF2VCxLQUFLLENBQ3BDLElBQUlnRCxHQUFNdVEsR0FBS3ZULEVBQUMsRUFDWjZULEdBQVlSLEdBQVMsUUFBUXJRLEVBQUcsRUFDcEMsR0FBSTZRLEtBQWMsR0FDaEJSLEdBQVMsT0FBT3JULEdBQUcsRUFBR2dELEVBQUcsRUFDekJ3USxHQUFLLEtBQUssQ0FBQ0ksR0FBUzVULEVBQUMsQ0FBQyxVQUNiNlQsS0FBYzdULEdBQUcsQ0FDMUIsSUFBSThULEdBQVlULEdBQVMsT0FBT3JULEdBQUcsQ0FBQyxFQUFFLENBQUMsRUFDbkMrVCxHQUFhVixHQUFTLE9BQU9RLEdBQVksRUFBRyxDQUFDLEVBQUUsQ0FBQyxFQUNwRFIsR0FBUyxPQUFPclQsR0FBRyxFQUFHK1QsRUFBVSxFQUNoQ1YsR0FBUyxPQUFPUSxHQUFXLEVBQUdDLEVBQVMsRUFDdkNMLEdBQU0sS0FBSyxDQUFDSyxHQUFXQyxFQUFVLENBQUMsQ0FDMUMsTUFDUUosR0FBTSxLQUFLM1EsRUFBRyxFQUVoQjRRLEdBQVU1USxFQUNYLENBQ0QsUUFBU2hELEdBQUksRUFBR0EsR0FBSTBULEdBQVEsT0FBUTFULEtBQUssQ0FDdkMsSUFBSWdELEdBQU0wUSxHQUFRMVQsRUFBQyxFQUNib1QsR0FBT3BRLEVBQUcsRUFBRSxZQUNoQm9RLEdBQU9wUSxFQUFHLEVBQUUsV0FBVyxRQUFRLFVBQVUsRUFFM0NvUSxHQUFPcFEsRUFBRyxFQUFFLFNBQ1pvUSxHQUFPcFEsRUFBRyxFQUFJLEtBQ2QsT0FBT29RLEdBQU9wUSxFQUFHLENBQ2xCLENBQ0QsUUFBU2hELEdBQUksRUFBR0EsR0FBSXlULEdBQU0sT0FBUXpULEtBQUssQ0FDckMsR0FBSSxDQUFDOFQsR0FBV0MsRUFBVSxFQUFJTixHQUFNelQsRUFBQyxFQUNqQ2dVLEdBQVdaLEdBQU9VLEVBQVMsRUFDM0JHLEdBQVliLEdBQU9XLEVBQVUsRUFDN0JHLEdBQVMsU0FBUyxjQUFjLEtBQUssRUFDekMsVUFBVSxJQUFNLENBQ1RELElBQ0gsS0FBSyx1Q0FBd0NmLEdBQVlhLEdBQVlYLEVBQU0sRUFDN0VhLEdBQVUsTUFBTUMsRUFBTSxFQUN0QkYsR0FBUyxNQUFNQyxFQUFTLEVBQ3hCQSxHQUFVLGdCQUFrQkEsR0FBVSxNQUFNQSxHQUFVLGNBQWMsRUFDcEVDLEdBQU8sT0FBT0YsRUFBUSxFQUN0QkEsR0FBUyxnQkFBa0JBLEdBQVMsTUFBTUEsR0FBUyxjQUFjLEVBQ2pFRSxHQUFPLE9BQU0sQ0FDckIsQ0FBTyxFQUNERCxHQUFVLG9CQUFvQlgsR0FBT0MsR0FBSyxRQUFRUSxFQUFVLENBQUMsQ0FBQyxDQUMvRCxDQUNELFFBQVMvVCxHQUFJLEVBQUdBLEdBQUl3VCxHQUFLLE9BQVF4VCxLQUFLLENBQ3BDLEdBQUksQ0FBQ21VLEdBQVVwVSxFQUFLLEVBQUl5VCxHQUFLeFQsRUFBQyxFQUMxQm9VLEdBQVNELEtBQWEsV0FBYWpCLEdBQWFFLEdBQU9lLEVBQVEsRUFDL0RDLEdBQU8saUJBQ1RBLEdBQVNBLEdBQU8sZ0JBQ2xCLElBQUl6UCxHQUFTMk8sR0FBT3ZULEVBQUssRUFDckJpRCxHQUFNdVEsR0FBS3hULEVBQUssRUFDaEJnUixHQUFTLFNBQVMsV0FBV21DLEdBQVcsUUFBUyxFQUFJLEVBQUUsa0JBQ3ZEbUIsR0FBZ0IsU0FBUzFQLEVBQU0sRUFDbkMsZUFBZW9NLEdBQVFzRCxHQUFlbkIsRUFBVSxFQUNoRG5DLEdBQU8sb0JBQXVCdUQsSUFBYSxDQUN6QyxPQUFPLFFBQVFBLEVBQVEsRUFBRSxRQUFRLENBQUMsQ0FBQ3RHLEdBQU10TixFQUFLLElBQU0sQ0FDbEQyVCxHQUFjckcsRUFBSSxFQUFJdE4sRUFDaEMsQ0FBUyxDQUNULEVBQ00sVUFBVSxJQUFNLENBQ2QwVCxHQUFPLE1BQU1yRCxFQUFNLEVBQ25CLGdCQUFnQixJQUFNLFNBQVNBLEVBQU0sQ0FBQyxFQUFDLENBQy9DLENBQU8sRUFDRyxPQUFPL04sSUFBUSxVQUNqQixLQUFLLG1FQUFvRWtRLEVBQVUsRUFFckZFLEdBQU9wUSxFQUFHLEVBQUkrTixFQUNmLENBQ0QsUUFBUy9RLEdBQUksRUFBR0EsR0FBSTJULEdBQU0sT0FBUTNULEtBQ2hDb1QsR0FBT08sR0FBTTNULEVBQUMsQ0FBQyxFQUFFLG9CQUFvQnNULEdBQU9DLEdBQUssUUFBUUksR0FBTTNULEVBQUMsQ0FBQyxDQUFDLENBQUMsRUFFckVrVCxHQUFXLFlBQWNLLEVBQzdCLENBQUcsQ0FDSCxDQUNBLFNBQVMsbUJBQW1CdlAsR0FBWSxDQUN0QyxJQUFJdVEsR0FBZ0IsaUNBQ2hCQyxHQUFnQixpQkFDaEJDLEdBQWEscUNBQ2JDLEdBQVUxUSxHQUFXLE1BQU15USxFQUFVLEVBQ3pDLEdBQUksQ0FBQ0MsR0FDSCxPQUNGLElBQUl4TixHQUFNLENBQUEsRUFDVkEsR0FBSSxNQUFRd04sR0FBUSxDQUFDLEVBQUUsS0FBSSxFQUMzQixJQUFJQyxHQUFPRCxHQUFRLENBQUMsRUFBRSxRQUFRRixHQUFlLEVBQUUsRUFBRSxPQUM3Q0ksR0FBZ0JELEdBQUssTUFBTUosRUFBYSxFQUM1QyxPQUFJSyxJQUNGMU4sR0FBSSxLQUFPeU4sR0FBSyxRQUFRSixHQUFlLEVBQUUsRUFBRSxPQUMzQ3JOLEdBQUksTUFBUTBOLEdBQWMsQ0FBQyxFQUFFLEtBQUksRUFDN0JBLEdBQWMsQ0FBQyxJQUNqQjFOLEdBQUksV0FBYTBOLEdBQWMsQ0FBQyxFQUFFLEtBQUksSUFHeEMxTixHQUFJLEtBQU95TixHQUVOek4sRUFDVCxDQUNBLFNBQVMsMkJBQTJCNkwsR0FBZTRCLEdBQU01VSxHQUFPb1QsR0FBTyxDQUNyRSxJQUFJMEIsR0FBaUIsQ0FBQSxFQUNyQixNQUFJLFdBQVcsS0FBSzlCLEdBQWMsSUFBSSxHQUFLLE1BQU0sUUFBUTRCLEVBQUksRUFDL0M1QixHQUFjLEtBQUssUUFBUSxJQUFLLEVBQUUsRUFBRSxRQUFRLElBQUssRUFBRSxFQUFFLE1BQU0sR0FBRyxFQUFFLElBQUsvUyxJQUFNQSxHQUFFLEtBQUksQ0FBRSxFQUN6RixRQUFRLENBQUNXLEdBQU1YLEtBQU0sQ0FDekI2VSxHQUFlbFUsRUFBSSxFQUFJZ1UsR0FBSzNVLEVBQUMsQ0FDbkMsQ0FBSyxFQUNRLFdBQVcsS0FBSytTLEdBQWMsSUFBSSxHQUFLLENBQUMsTUFBTSxRQUFRNEIsRUFBSSxHQUFLLE9BQU9BLElBQVMsU0FDNUU1QixHQUFjLEtBQUssUUFBUSxJQUFLLEVBQUUsRUFBRSxRQUFRLElBQUssRUFBRSxFQUFFLE1BQU0sR0FBRyxFQUFFLElBQUsvUyxJQUFNQSxHQUFFLEtBQUksQ0FBRSxFQUN6RixRQUFTVyxJQUFTLENBQ3RCa1UsR0FBZWxVLEVBQUksRUFBSWdVLEdBQUtoVSxFQUFJLENBQ3RDLENBQUssRUFFRGtVLEdBQWU5QixHQUFjLElBQUksRUFBSTRCLEdBRW5DNUIsR0FBYyxRQUNoQjhCLEdBQWU5QixHQUFjLEtBQUssRUFBSWhULElBQ3BDZ1QsR0FBYyxhQUNoQjhCLEdBQWU5QixHQUFjLFVBQVUsRUFBSUksSUFDdEMwQixFQUNULENBQ0EsU0FBUyxXQUFXM1AsR0FBUyxDQUMzQixNQUFPLENBQUMsTUFBTSxRQUFRQSxFQUFPLEdBQUssQ0FBQyxNQUFNQSxFQUFPLENBQ2xELENBR0EsU0FBUyxVQUFXLENBQ3BCLENBQ0EsU0FBUyxPQUFTLENBQUM5RSxHQUFJLENBQUUsV0FBQTRELEVBQVUsRUFBSSxDQUFFLFFBQVMzRCxNQUFlLENBQy9ELElBQUl1QixHQUFPLFlBQVl4QixFQUFFLEVBQ3BCd0IsR0FBSyxVQUNSQSxHQUFLLFFBQVUsSUFDakJBLEdBQUssUUFBUW9DLEVBQVUsRUFBSTVELEdBQzNCQyxHQUFTLElBQU0sT0FBT3VCLEdBQUssUUFBUW9DLEVBQVUsQ0FBQyxDQUNoRCxFQUNBLFVBQVUsTUFBTyxRQUFRLEVBR3pCLFVBQVUsS0FBTSxDQUFDNUQsR0FBSSxDQUFFLFdBQUE0RCxFQUFVLEVBQUksQ0FBRSxPQUFRa0MsR0FBUyxRQUFTN0YsTUFBZSxDQUMxRUQsR0FBRyxRQUFRLFlBQVcsSUFBTyxZQUMvQixLQUFLLDRDQUE2Q0EsRUFBRSxFQUN0RCxJQUFJK0gsR0FBWSxjQUFjL0gsR0FBSTRELEVBQVUsRUFDeEN1RixHQUFPLElBQU0sQ0FDZixHQUFJbkosR0FBRyxlQUNMLE9BQU9BLEdBQUcsZUFDWixJQUFJMlEsR0FBUzNRLEdBQUcsUUFBUSxVQUFVLEVBQUksRUFBRSxrQkFDeEMsc0JBQWUyUSxHQUFRLEdBQUkzUSxFQUFFLEVBQzdCLFVBQVUsSUFBTSxDQUNkQSxHQUFHLE1BQU0yUSxFQUFNLEVBQ2YsZ0JBQWdCLElBQU0sU0FBU0EsRUFBTSxDQUFDLEVBQUMsQ0FDN0MsQ0FBSyxFQUNEM1EsR0FBRyxlQUFpQjJRLEdBQ3BCM1EsR0FBRyxVQUFZLElBQU0sQ0FDbkIsS0FBSzJRLEdBQVNoUSxJQUFTLENBQ2ZBLEdBQUssWUFDVEEsR0FBSyxXQUFXLFFBQVEsVUFBVSxDQUU1QyxDQUFPLEVBQ0RnUSxHQUFPLE9BQU0sRUFDYixPQUFPM1EsR0FBRyxjQUNoQixFQUNXMlEsRUFDWCxFQUNNdkgsR0FBTyxJQUFNLENBQ1ZwSixHQUFHLFlBRVJBLEdBQUcsVUFBUyxFQUNaLE9BQU9BLEdBQUcsVUFDZCxFQUNFOEYsR0FBUSxJQUFNaUMsR0FBV3pILElBQVUsQ0FDakNBLEdBQVE2SSxLQUFTQyxJQUNsQixDQUFBLENBQUMsRUFDRm5KLEdBQVMsSUFBTUQsR0FBRyxXQUFhQSxHQUFHLFVBQVcsQ0FBQSxDQUMvQyxDQUFDLEVBR0QsVUFBVSxLQUFNLENBQUNBLEdBQUksQ0FBRSxXQUFBNEQsRUFBVSxFQUFJLENBQUUsU0FBVW1FLE1BQWdCLENBQ25EQSxHQUFVbkUsRUFBVSxFQUMxQixRQUFTckQsSUFBUyxVQUFVUCxHQUFJTyxFQUFJLENBQUMsQ0FDN0MsQ0FBQyxFQUNELGVBQWUsQ0FBQ21LLEdBQU1DLEtBQU8sQ0FDdkJELEdBQUssU0FDUEMsR0FBRyxPQUFTRCxHQUFLLE9BRXJCLENBQUMsRUFHRCxjQUFjLGFBQWEsSUFBSyxLQUFLLE9BQU8sS0FBSyxDQUFDLENBQUMsQ0FBQyxFQUNwRCxVQUFVLEtBQU0sZ0JBQWdCLENBQUMxSyxHQUFJLENBQUUsTUFBQU0sR0FBTyxVQUFBa0csR0FBVyxXQUFBNUMsRUFBWSxFQUFFLENBQUUsUUFBUzNELEVBQVEsSUFBTyxDQUMvRixJQUFJOEgsR0FBWW5FLEdBQWEsY0FBYzVELEdBQUk0RCxFQUFVLEVBQUksSUFBTSxDQUNyRSxFQUNNNUQsR0FBRyxRQUFRLFlBQVcsSUFBTyxhQUMxQkEsR0FBRyxtQkFDTkEsR0FBRyxpQkFBbUIsSUFDbkJBLEdBQUcsaUJBQWlCLFNBQVNNLEVBQUssR0FDckNOLEdBQUcsaUJBQWlCLEtBQUtNLEVBQUssR0FFbEMsSUFBSTJSLEdBQWlCLEdBQUdqUyxHQUFJTSxHQUFPa0csR0FBWTNDLElBQU0sQ0FDbkRrRSxHQUFVLElBQU0sQ0FDcEIsRUFBTyxDQUFFLE1BQU8sQ0FBRSxPQUFVbEUsRUFBRyxFQUFFLE9BQVEsQ0FBQ0EsRUFBQyxDQUFDLENBQUUsQ0FDOUMsQ0FBRyxFQUNENUQsR0FBUyxJQUFNZ1MsR0FBYyxDQUFFLENBQ2pDLENBQUMsQ0FBQyxFQUdGLDJCQUEyQixXQUFZLFdBQVksVUFBVSxFQUM3RCwyQkFBMkIsWUFBYSxZQUFhLFdBQVcsRUFDaEUsMkJBQTJCLFFBQVMsT0FBUSxPQUFPLEVBQ25ELDJCQUEyQixPQUFRLE9BQVEsTUFBTSxFQUNqRCxTQUFTLDJCQUEyQjFSLEdBQU1tVSxHQUFlbEUsR0FBTSxDQUM3RCxVQUFVa0UsR0FBZ0IxVSxJQUFPLEtBQUssb0JBQW9CMFUsRUFBYSxtQ0FBbUNuVSxFQUFJLCtDQUErQ2lRLEVBQUksR0FBSXhRLEVBQUUsQ0FBQyxDQUMxSyxDQUdBLGVBQWUsYUFBYSxlQUFlLEVBQzNDLGVBQWUsb0JBQW9CLENBQUUsU0FBVSxVQUFXLE9BQVEsUUFBUyxRQUFTLEtBQU0sSUFBSyxLQUFPLENBQUEsRUFDdEcsSUFBSSxZQUFjLGVBR2QsZUFBaUIsbVRDcHdHcEIsU0FBUzZELEdBQUU4USxHQUFFLENBQTJGLE9BQU8sUUFBUyxPQUFBLFFBQWVBLEdBQUMsRUFBUTlRLEdBQUUsS0FBS0EsR0FBRSxNQUFNOFEsR0FBRyxDQUFDLEdBQUcsT0FBTyxLQUFPLElBQVksS0FBS0MsZUFBSyxVQUFVLENBQUMsT0FBTyxVQUFVLENBQWMsSUFBSSxFQUFFLENBQUMsT0FBTyxFQUFFLFFBQVEsR0FBRyxHQUFHLEdBQUcsSUFBSSxHQUFHLFFBQVEsR0FBRyxLQUFLLEdBQUcsS0FBSyxFQUFFLFFBQVEsRUFBRSxRQUFRLEVBQUUsT0FBTyxTQUFTL1EsR0FBRThRLEdBQUUsQ0FBQyxJQUFJM0UsR0FBRSxHQUFHbk0sR0FBRThRLElBQUcsTUFBTSxFQUFFLE9BQU8zRSxHQUFFLE1BQU0sRUFBRSxPQUFPLEVBQUUsU0FBUyxFQUFFLFlBQVksRUFBRSxZQUFZLEVBQUUsVUFBVSxFQUFFLGdCQUFnQixHQUFHLGdCQUFnQixHQUFHLE9BQU8sRUFBRSxRQUFRLEVBQUUsT0FBTyxLQUFLLE9BQU8sQ0FBQyxlQUFlLEdBQUssaUJBQWlCLEdBQUcscUJBQXFCLEdBQU0saUJBQWlCLFlBQVksaUJBQWlCLEVBQUUsbUJBQW1CLEdBQUcsdUJBQXVCLEdBQUssZUFBZSxpQkFBaUIsYUFBYSxlQUFlLFdBQVcsYUFBYSxjQUFjLGdCQUFnQixjQUFjLGdCQUFnQixVQUFVLEdBQUssZ0JBQWdCLEdBQUssa0JBQWtCLEdBQUcsbUJBQW1CLENBQUMsUUFBUSxRQUFRLFFBQVEsUUFBUSxFQUFFLGdCQUFnQixHQUFNLFFBQVEsRUFBRSxpQkFBaUIsY0FBYyxhQUFhLE9BQU8sZ0JBQWdCLGtDQUFrQyxxQkFBcUIsR0FBTSxlQUFlLFNBQVMsbUJBQW1CLEdBQU0sb0JBQW9CLEdBQU0sc0JBQXNCLEdBQU0sd0JBQXdCLENBQUMsS0FBSyxFQUFFLGlCQUFpQixHQUFNLFlBQVksR0FBTSxzQkFBc0IsR0FBSyxrQkFBa0IsSUFBSSxFQUFFLGNBQWMsRUFBRSxFQUFFLEVBQUUsa0JBQWtCLFNBQVNuTSxHQUFFLENBQUMsT0FBTyxJQUFJLFlBQVlBLEdBQUUsQ0FBQyxnQkFBZ0IsRUFBSSxDQUFDLENBQUMsRUFBRSxnQkFBZ0IsU0FBU0EsR0FBRSxDQUFDLElBQUk4USxHQUFFLElBQUksVUFBVTlRLEdBQUUsRUFBRSxFQUFFLE9BQUE4USxHQUFFLFdBQVcsRUFBRSxPQUFPLGFBQW9CQSxFQUFDLEVBQUUsUUFBUSxRQUFRLEVBQU0sRUFBRSxDQUFDLGtCQUFrQixHQUFHLGFBQWEsR0FBRyxzQkFBc0IsRUFBRSxnQkFBZ0IsR0FBRyxhQUFhLEdBQUcsYUFBYSxFQUFFLGtCQUFrQixHQUFHLHlCQUF5QixHQUFHLGdCQUFnQixFQUFFLGtCQUFrQixHQUFHLFdBQVcsR0FBRyxlQUFlLEdBQUcsZ0JBQWdCLEdBQUcscUJBQXFCLEdBQUcsZ0JBQWdCLEdBQUcsVUFBVSxHQUFHLGFBQWEsRUFBRSxhQUFhLEdBQUcsZUFBZSxFQUFFLFFBQVEsR0FBRyxpQkFBaUIsR0FBRyxjQUFjLEdBQUcsa0JBQWtCLEdBQUcsYUFBYSxHQUFHLGFBQWEsR0FBRyxrQkFBa0IsR0FBRyxlQUFlLENBQUMsRUFBTSxFQUFFLENBQUMsTUFBTSxPQUFPLE1BQU0sU0FBUyxPQUFPLEVBQU0sRUFBRSxFQUFFLElBQUksU0FBUzlRLEdBQUUsQ0FBQyxNQUFNLE9BQU9BLEdBQUUsZUFBZUEsR0FBRSxHQUFHLENBQUMsRUFBRSxLQUFLLElBQUksRUFBTSxFQUFFLEVBQUUsTUFBTSxFQUFFLEVBQUUsRUFBRSxPQUFPLEVBQUUsRUFBRSxFQUFFLE1BQU0sRUFBSSxFQUFFLFNBQVMsRUFBRUEsR0FBRThRLEdBQUUsR0FBTSxDQUFDLE9BQU8sSUFBSSxPQUFPLElBQUk5USxFQUFDLGdDQUFnQ0EsRUFBQyxJQUFJOFEsR0FBRSxNQUFNLElBQUksQ0FBQyxDQUFDLFNBQVMsRUFBRTlRLEdBQUUsQ0FBQyxHQUFHQSxJQUFHLEtBQVcsT0FBaUIsSUFBSThRLEdBQUUsSUFBSSxPQUFHOVEsR0FBRSxNQUFNLEVBQUUsR0FBRyxLQUFNOFEsR0FBRSxXQUFXOVEsR0FBRSxNQUFNLEVBQUUsRUFBRSxDQUFDLEVBQVVBLEdBQUUsTUFBTSxFQUFFLEdBQUcsSUFBSzhRLEdBQUUsV0FBVzlRLEdBQUUsTUFBTSxFQUFFLEVBQUUsQ0FBQyxFQUFFLElBQVlBLEdBQUUsTUFBTSxFQUFFLEdBQUcsSUFBSzhRLEdBQUUsV0FBVzlRLEdBQUUsTUFBTSxFQUFFLEVBQUUsQ0FBQyxFQUFFLElBQUksR0FBUThRLEdBQUUsV0FBVzlRLEVBQUMsRUFBUyxNQUFNOFEsRUFBQyxFQUFFLE9BQVVBLEVBQUMsQ0FBQyxTQUFTLEdBQUc5USxHQUFFOFEsR0FBRSxDQUFDLE9BQU85USxHQUFFLGNBQWNBLEdBQUUsYUFBYThRLEVBQUMsQ0FBQyxDQUFDLFNBQVMsRUFBRTlRLEdBQUU4USxHQUFFLENBQUMsT0FBTzlRLEdBQUUsZUFBZUEsR0FBRSxhQUFhOFEsRUFBQyxHQUFHOVEsR0FBRSxhQUFhLFFBQVE4USxFQUFDLEVBQUUsQ0FBQyxTQUFTLEdBQUc5USxHQUFFOFEsR0FBRSxDQUFDLE9BQU8sR0FBRzlRLEdBQUU4USxFQUFDLEdBQUcsR0FBRzlRLEdBQUUsUUFBUThRLEVBQUMsQ0FBQyxDQUFDLFNBQVMsRUFBRTlRLEdBQUUsQ0FBQyxPQUFPQSxHQUFFLGFBQWEsQ0FBQyxTQUFTLElBQUksQ0FBQyxPQUFPLFFBQVEsQ0FBQyxTQUFTLEVBQUVBLEdBQUU4USxHQUFFLENBQUMsS0FBTTlRLElBQUcsQ0FBQzhRLEdBQUU5USxFQUFDLEdBQUdBLEdBQUUsRUFBRUEsRUFBQyxFQUFFLE9BQU9BLElBQUksSUFBSSxDQUFDLFNBQVMsRUFBRUEsR0FBRThRLEdBQUUzRSxHQUFFLENBQUMsSUFBSTZFLEdBQUUsR0FBR0YsR0FBRTNFLEVBQUMsRUFBTXBRLEdBQUUsR0FBRytVLEdBQUUsZUFBZSxFQUFFLE9BQUc5USxLQUFJOFEsSUFBRy9VLEtBQUlBLEtBQUksS0FBS0EsR0FBRSxNQUFNLEdBQUcsRUFBRSxRQUFRb1EsRUFBQyxHQUFHLEdBQVUsUUFBb0I2RSxFQUFFLENBQUMsU0FBUyxHQUFHRixHQUFFM0UsR0FBRSxDQUFDLElBQUk2RSxHQUFFLEtBQXlDLEdBQXBDLEVBQUVGLEdBQUUsU0FBUzlRLEdBQUUsQ0FBQyxPQUFPZ1IsR0FBRSxFQUFFRixHQUFFOVEsR0FBRW1NLEVBQUMsQ0FBQyxDQUFDLEVBQUs2RSxLQUFJLFFBQVMsT0FBT0EsRUFBRSxDQUFDLFNBQVMsRUFBRWhSLEdBQUU4USxHQUFFLENBQUMsSUFBSTNFLEdBQUVuTSxHQUFFLFNBQVNBLEdBQUUsaUJBQWlCQSxHQUFFLG1CQUFtQkEsR0FBRSxvQkFBb0JBLEdBQUUsdUJBQXVCQSxHQUFFLGlCQUFpQixPQUFPbU0sSUFBR0EsR0FBRSxLQUFLbk0sR0FBRThRLEVBQUMsQ0FBQyxDQUFDLFNBQVMsRUFBRTlRLEdBQUUsQ0FBQyxJQUFJOFEsR0FBRSxpQ0FBcUMzRSxHQUFFMkUsR0FBRSxLQUFLOVEsRUFBQyxFQUFFLE9BQUdtTSxHQUFVQSxHQUFFLENBQUMsRUFBRSxjQUF5QixFQUFHLENBQUMsU0FBUyxFQUFFbk0sR0FBRThRLEdBQUUsQ0FBeUUsUUFBcEUzRSxHQUFFLElBQUksVUFBYzZFLEdBQUU3RSxHQUFFLGdCQUFnQm5NLEdBQUUsV0FBVyxFQUFNakUsR0FBRWlWLEdBQUUsS0FBV0YsR0FBRSxHQUFHQSxLQUFJL1UsR0FBRUEsR0FBRSxXQUFXLE9BQUdBLElBQUcsT0FBTUEsR0FBRSxHQUFJLEVBQUMsdUJBQXdCLEdBQVFBLEVBQUMsQ0FBQyxTQUFTLEVBQUVpRSxHQUFFLENBQUMsTUFBTSxRQUFRLEtBQUtBLEVBQUMsQ0FBQyxDQUFDLFNBQVMsRUFBRUEsR0FBRSxDQUFDLElBQUk4USxHQUFFLENBQUMsRUFBRTlRLEVBQUMsRUFBTW1NLEdBQUUsRUFBRW5NLEVBQUMsRUFBTWdSLEdBQUVoUixHQUFtQyxHQUE5Qm1NLEtBQUksU0FBUTZFLEdBQUVBLEdBQUUsUUFBUSxFQUFFLEVBQUUsR0FBSyxFQUFFLE9BQU8sc0JBQXNCRixHQUFFLENBQUMsSUFBSS9VLEdBQUUsRUFBRSxtQkFBbUJpVixHQUFFLHFCQUFxQixDQUFDLEVBQU1uTyxHQUFFOUcsR0FBRSxjQUFjLFVBQVUsRUFBRSxRQUFRLE9BQUcsRUFBRSxPQUFPLGdCQUFpQixHQUFHOEcsR0FBRSxpQkFBaUIsUUFBUSxFQUFFLFNBQVM3QyxHQUFFLENBQUksRUFBRSxPQUFPLG9CQUFtQkEsR0FBRSxNQUFNLEVBQUUsT0FBTyxtQkFBa0JBLEdBQUUsYUFBYSxVQUFVLFVBQVUsUUFBUSxTQUFTLElBQUksRUFBRSxDQUFDLEVBQU8sR0FBRzZDLEdBQUUsaUJBQWlCLFFBQVEsRUFBRSxTQUFTN0MsR0FBRSxDQUFDLEVBQUVBLEVBQUMsQ0FBQyxDQUFDLEVBQVM2QyxFQUFDLENBQUMsT0FBT3NKLEdBQUMsQ0FBRSxJQUFJLFFBQVEsSUFBSSxRQUFRLElBQUksUUFBUSxJQUFJLFdBQVcsSUFBSSxVQUFVLE9BQU8sRUFBRSxVQUFVNkUsR0FBRSxXQUFXLENBQUMsRUFBRSxJQUFJLE1BQU0sT0FBTyxFQUFFLG9CQUFvQkEsR0FBRSxzQkFBc0IsQ0FBQyxFQUFFLElBQUksS0FBSyxPQUFPLEVBQUUsaUJBQWlCQSxHQUFFLG1CQUFtQixDQUFDLEVBQUUsSUFBSSxLQUFLLElBQUksS0FBSyxPQUFPLEVBQUUscUJBQXFCQSxHQUFFLHdCQUF3QixDQUFDLEVBQUUsSUFBSSxTQUFTLElBQUksUUFBUSxPQUFPLEVBQUUsUUFBUUEsR0FBRSxTQUFTLENBQUMsRUFBRSxRQUFRLE9BQU8sRUFBRUEsR0FBRSxDQUFDLENBQUMsQ0FBQyxDQUFDLFNBQVMsR0FBR2hSLEdBQUUsQ0FBSUEsSUFBR0EsR0FBRyxDQUFDLENBQUMsU0FBUyxFQUFFQSxHQUFFOFEsR0FBRSxDQUFDLE9BQU8sT0FBTyxVQUFVLFNBQVMsS0FBSzlRLEVBQUMsSUFBSSxXQUFXOFEsR0FBRSxHQUFHLENBQUMsU0FBUyxFQUFFOVEsR0FBRSxDQUFDLE9BQU8sRUFBRUEsR0FBRSxVQUFVLENBQUMsQ0FBQyxTQUFTLEVBQUVBLEdBQUUsQ0FBQyxPQUFPLEVBQUVBLEdBQUUsUUFBUSxDQUFDLENBQUMsU0FBUyxHQUFHQSxHQUFFLENBQUMsSUFBSThRLEdBQUUscUJBQXlCM0UsR0FBRW5NLEdBQUU4USxFQUFDLEVBQUUsT0FBSTNFLEtBQUdBLEdBQUVuTSxHQUFFOFEsRUFBQyxFQUFFLENBQUUsR0FBUTNFLEVBQUMsQ0FBQyxTQUFTLEVBQUVuTSxHQUFFLENBQUMsSUFBSThRLEdBQUUsQ0FBRSxFQUFDLEdBQUc5USxHQUFHLFFBQVFtTSxHQUFFLEVBQUVBLEdBQUVuTSxHQUFFLE9BQU9tTSxLQUFLMkUsR0FBRSxLQUFLOVEsR0FBRW1NLEVBQUMsQ0FBQyxFQUFHLE9BQU8yRSxFQUFDLENBQUMsU0FBUyxHQUFHOVEsR0FBRThRLEdBQUUsQ0FBQyxHQUFHOVEsR0FBRyxRQUFRbU0sR0FBRSxFQUFFQSxHQUFFbk0sR0FBRSxPQUFPbU0sS0FBSzJFLEdBQUU5USxHQUFFbU0sRUFBQyxDQUFDLENBQUcsQ0FBQyxTQUFTLEVBQUVuTSxHQUFFLENBQUMsSUFBSThRLEdBQUU5USxHQUFFLHNCQUFxQixFQUFPbU0sR0FBRTJFLEdBQUUsSUFBUUUsR0FBRUYsR0FBRSxPQUFPLE9BQU8zRSxHQUFFLE9BQU8sYUFBYTZFLElBQUcsQ0FBQyxDQUFDLFNBQVMsR0FBR2hSLEdBQUUsQ0FBQyxPQUFHQSxHQUFFLGFBQWFBLEdBQUUsWUFBVyxZQUFhLE9BQU8sV0FBbUIsR0FBRSxFQUFHLEtBQUssU0FBU0EsR0FBRSxZQUFhLEVBQUMsSUFBSSxFQUFjLEdBQUksRUFBQyxLQUFLLFNBQVNBLEVBQUMsQ0FBRSxDQUFDLFNBQVMsRUFBRUEsR0FBRSxDQUFDLE9BQU9BLEdBQUUsS0FBSSxFQUFHLE1BQU0sS0FBSyxDQUFDLENBQUMsU0FBUyxHQUFHQSxHQUFFOFEsR0FBRSxDQUFDLFFBQVEzRSxNQUFLMkUsR0FBTUEsR0FBRSxlQUFlM0UsRUFBQyxJQUFHbk0sR0FBRW1NLEVBQUMsRUFBRTJFLEdBQUUzRSxFQUFDLEdBQUcsT0FBT25NLEVBQUMsQ0FBQyxTQUFTLEVBQUVBLEdBQUUsQ0FBQyxHQUFHLENBQUMsT0FBTyxLQUFLLE1BQU1BLEVBQUMsQ0FBQyxPQUFPQSxHQUFFLENBQUMsU0FBRUEsRUFBQyxFQUFTLElBQUksQ0FBQyxDQUFDLFNBQVMsR0FBRyxDQUFDLElBQUlBLEdBQUUsd0JBQXdCLEdBQUcsQ0FBQyxvQkFBYSxRQUFRQSxHQUFFQSxFQUFDLEVBQUUsYUFBYSxXQUFXQSxFQUFDLEVBQVMsRUFBSSxNQUFTLENBQUMsTUFBTyxFQUFLLENBQUMsQ0FBQyxTQUFTLEVBQUU4USxHQUFFLENBQUMsR0FBRyxDQUFDLElBQUk5USxHQUFFLElBQUksSUFBSThRLEVBQUMsRUFBRSxPQUFHOVEsS0FBRzhRLEdBQUU5USxHQUFFLFNBQVNBLEdBQUUsUUFBVyxPQUFPLEtBQUs4USxFQUFDLElBQUdBLEdBQUVBLEdBQUUsUUFBUSxPQUFPLEVBQUUsR0FBU0EsRUFBQyxNQUFTLENBQUMsT0FBT0EsRUFBQyxDQUFDLENBQUMsU0FBUyxFQUFFLEVBQUUsQ0FBQyxPQUFPLEdBQUcsS0FBSyxLQUFLLFVBQVUsQ0FBQyxPQUFPLEtBQUssQ0FBQyxDQUFDLENBQUMsQ0FBQyxDQUFDLFNBQVMsRUFBRUEsR0FBRSxDQUFDLElBQUk5USxHQUFFLEVBQUUsR0FBRyxZQUFZLFNBQVNBLEdBQUUsQ0FBQzhRLEdBQUU5USxHQUFFLE9BQU8sR0FBRyxDQUFDLENBQUMsRUFBRSxPQUFPQSxFQUFDLENBQUMsU0FBUyxHQUFHLENBQUMsRUFBRSxPQUFPLFNBQVNBLEdBQUU4USxHQUFFM0UsR0FBRSxDQUFJLFNBQVMsUUFBUSxJQUFJMkUsR0FBRTlRLEdBQUVtTSxFQUFDLENBQUUsQ0FBQyxDQUFDLFNBQVMsR0FBRyxDQUFDLEVBQUUsT0FBTyxJQUFJLENBQUMsU0FBUyxFQUFFbk0sR0FBRThRLEdBQUUsQ0FBQyxPQUFHQSxHQUFVOVEsR0FBRSxjQUFjOFEsRUFBQyxFQUFjLEVBQUUsR0FBSSxFQUFDOVEsRUFBQyxDQUFFLENBQUMsU0FBUyxFQUFFQSxHQUFFOFEsR0FBRSxDQUFDLE9BQUdBLEdBQVU5USxHQUFFLGlCQUFpQjhRLEVBQUMsRUFBYyxFQUFFLEdBQUUsRUFBRzlRLEVBQUMsQ0FBRSxDQUFDLFNBQVMsRUFBRUEsR0FBRThRLEdBQUUsQ0FBQzlRLEdBQUUsRUFBRUEsRUFBQyxFQUFLOFEsR0FBRyxXQUFXLFVBQVUsQ0FBQyxFQUFFOVEsRUFBQyxFQUFFQSxHQUFFLElBQUksRUFBRThRLEVBQUMsRUFBTzlRLEdBQUUsY0FBYyxZQUFZQSxFQUFDLENBQUUsQ0FBQyxTQUFTLEVBQUVBLEdBQUU4USxHQUFFM0UsR0FBRSxDQUFDbk0sR0FBRSxFQUFFQSxFQUFDLEVBQUttTSxHQUFHLFdBQVcsVUFBVSxDQUFDLEVBQUVuTSxHQUFFOFEsRUFBQyxFQUFFOVEsR0FBRSxJQUFJLEVBQUVtTSxFQUFDLEVBQU9uTSxHQUFFLFdBQVdBLEdBQUUsVUFBVSxJQUFJOFEsRUFBQyxDQUFFLENBQUMsU0FBUyxFQUFFOVEsR0FBRThRLEdBQUUzRSxHQUFFLENBQUNuTSxHQUFFLEVBQUVBLEVBQUMsRUFBS21NLEdBQUcsV0FBVyxVQUFVLENBQUMsRUFBRW5NLEdBQUU4USxFQUFDLEVBQUU5USxHQUFFLElBQUksRUFBRW1NLEVBQUMsRUFBVW5NLEdBQUUsWUFBV0EsR0FBRSxVQUFVLE9BQU84USxFQUFDLEVBQUs5USxHQUFFLFVBQVUsU0FBUyxHQUFHQSxHQUFFLGdCQUFnQixPQUFPLEVBQUksQ0FBQyxTQUFTLEVBQUVBLEdBQUU4USxHQUFFLENBQUM5USxHQUFFLEVBQUVBLEVBQUMsRUFBRUEsR0FBRSxVQUFVLE9BQU84USxFQUFDLENBQUMsQ0FBQyxTQUFTLEVBQUU5USxHQUFFOFEsR0FBRSxDQUFDOVEsR0FBRSxFQUFFQSxFQUFDLEVBQUUsR0FBR0EsR0FBRSxjQUFjLFNBQVMsU0FBU0EsR0FBRSxDQUFDLEVBQUVBLEdBQUU4USxFQUFDLENBQUMsQ0FBQyxFQUFFLEVBQUU5USxHQUFFOFEsRUFBQyxDQUFDLENBQUMsU0FBUyxFQUFFOVEsR0FBRThRLEdBQUUsQ0FBUSxHQUFQOVEsR0FBRSxFQUFFQSxFQUFDLEVBQUtBLEdBQUUsUUFBUyxPQUFPQSxHQUFFLFFBQVE4USxFQUFDLEVBQU8sRUFBRyxJQUFHOVEsSUFBRyxNQUFNLEVBQUVBLEdBQUU4USxFQUFDLEVBQUcsT0FBTzlRLFNBQVNBLEdBQUVBLElBQUcsRUFBRUEsRUFBQyxHQUFHLE9BQU8sSUFBSyxDQUFDLFNBQVMsRUFBRUEsR0FBRThRLEdBQUUsQ0FBQyxPQUFPOVEsR0FBRSxVQUFVLEVBQUU4USxHQUFFLE1BQU0sSUFBSUEsRUFBQyxDQUFDLFNBQVMsRUFBRTlRLEdBQUU4USxHQUFFLENBQUMsT0FBTzlRLEdBQUUsVUFBVUEsR0FBRSxPQUFPOFEsR0FBRSxNQUFNLElBQUlBLEVBQUMsQ0FBQyxTQUFTLEVBQUU5USxHQUFFLENBQUMsSUFBSThRLEdBQUU5USxHQUFFLE9BQU8sT0FBRyxFQUFFOFEsR0FBRSxHQUFHLEdBQUcsRUFBRUEsR0FBRSxJQUFJLEVBQVVBLEdBQUUsVUFBVSxFQUFFQSxHQUFFLE9BQU8sQ0FBQyxFQUFjQSxFQUFFLENBQUMsU0FBUyxFQUFFOVEsR0FBRThRLEdBQUUsQ0FBQyxPQUFHQSxHQUFFLFFBQVEsVUFBVSxJQUFJLEVBQVMsQ0FBQyxFQUFFOVEsR0FBRSxFQUFFOFEsR0FBRSxPQUFPLENBQUMsQ0FBQyxDQUFDLENBQUMsRUFBVUEsR0FBRSxRQUFRLE9BQU8sSUFBSSxFQUFTLENBQUMsRUFBRTlRLEdBQUUsRUFBRThRLEdBQUUsT0FBTyxDQUFDLENBQUMsQ0FBQyxDQUFDLEVBQVVBLEtBQUksT0FBYyxDQUFDOVEsR0FBRSxrQkFBa0IsRUFBVThRLEdBQUUsUUFBUSxPQUFPLElBQUksRUFBUyxDQUFDLEVBQUU5USxHQUFFLEVBQUU4USxHQUFFLE9BQU8sQ0FBQyxDQUFDLENBQUMsQ0FBQyxFQUFVQSxLQUFJLFdBQWtCLENBQUM5USxHQUFFLHNCQUFzQixFQUFVOFEsR0FBRSxRQUFRLFdBQVcsSUFBSSxFQUFTLENBQUMsRUFBRTlRLEdBQUUsRUFBRThRLEdBQUUsT0FBTyxDQUFDLENBQUMsQ0FBQyxDQUFDLEVBQVVBLEtBQUksV0FBa0IsQ0FBQyxRQUFRLEVBQVVBLEtBQUksU0FBZ0IsQ0FBQyxNQUFNLEVBQVVBLEtBQUksT0FBYyxDQUFDLFNBQVMsSUFBSSxFQUFjLEtBQUssaUJBQWlCLEVBQUVBLEVBQUMsQ0FBQyxDQUFFLENBQUMsSUFBSSxFQUFFLFNBQVM5USxHQUFFOFEsR0FBRSxDQUFnQyxRQUEzQjNFLEdBQUUsS0FBSyxpQkFBaUIyRSxFQUFDLEVBQVVFLEdBQUUsRUFBRUEsR0FBRTdFLEdBQUUsT0FBTzZFLEtBQUksQ0FBQyxJQUFJalYsR0FBRW9RLEdBQUU2RSxFQUFDLEVBQUUsR0FBR2pWLEdBQUUsd0JBQXdCaUUsRUFBQyxJQUFJLEtBQUssNEJBQTZCLE9BQU9qRSxFQUFFLENBQUMsRUFBTSxFQUFFLFNBQVNpRSxHQUFFOFEsR0FBRSxDQUFnQyxRQUEzQjNFLEdBQUUsS0FBSyxpQkFBaUIyRSxFQUFDLEVBQVVFLEdBQUU3RSxHQUFFLE9BQU8sRUFBRTZFLElBQUcsRUFBRUEsS0FBSSxDQUFDLElBQUlqVixHQUFFb1EsR0FBRTZFLEVBQUMsRUFBRSxHQUFHalYsR0FBRSx3QkFBd0JpRSxFQUFDLElBQUksS0FBSyw0QkFBNkIsT0FBT2pFLEVBQUUsQ0FBQyxFQUFFLFNBQVMsR0FBR2lFLEdBQUU4USxHQUFFLENBQUMsT0FBR0EsR0FBVSxFQUFFOVEsR0FBRThRLEVBQUMsRUFBRSxDQUFDLEVBQWMsRUFBRSxHQUFFLEVBQUcsS0FBSzlRLEVBQUMsRUFBRSxDQUFDLENBQUUsQ0FBQyxTQUFTLEVBQUVBLEdBQUUsQ0FBQyxPQUFHLEVBQUVBLEdBQUUsUUFBUSxFQUFVLEVBQUVBLEVBQUMsRUFBY0EsRUFBRSxDQUFDLFNBQVMsR0FBR0EsR0FBRThRLEdBQUUzRSxHQUFFLENBQUMsT0FBRyxFQUFFMkUsRUFBQyxFQUFTLENBQUMsT0FBTyxHQUFFLEVBQUcsS0FBSyxNQUFNOVEsR0FBRSxTQUFTOFEsRUFBQyxFQUFhLENBQUMsT0FBTyxFQUFFOVEsRUFBQyxFQUFFLE1BQU04USxHQUFFLFNBQVMzRSxFQUFDLENBQUUsQ0FBQyxTQUFTLEdBQUcyRSxHQUFFM0UsR0FBRTZFLEdBQUUsQ0FBQyxHQUFHLFVBQVUsQ0FBQyxJQUFJaFIsR0FBRSxHQUFHOFEsR0FBRTNFLEdBQUU2RSxFQUFDLEVBQUVoUixHQUFFLE9BQU8saUJBQWlCQSxHQUFFLE1BQU1BLEdBQUUsUUFBUSxDQUFDLENBQUMsRUFBRSxJQUFJQSxHQUFFLEVBQUVtTSxFQUFDLEVBQUUsT0FBT25NLEdBQUVtTSxHQUFFNkUsRUFBQyxDQUFDLFNBQVMsR0FBR0YsR0FBRTNFLEdBQUU2RSxHQUFFLENBQUMsVUFBRyxVQUFVLENBQUMsSUFBSWhSLEdBQUUsR0FBRzhRLEdBQUUzRSxHQUFFNkUsRUFBQyxFQUFFaFIsR0FBRSxPQUFPLG9CQUFvQkEsR0FBRSxNQUFNQSxHQUFFLFFBQVEsQ0FBQyxDQUFDLEVBQVMsRUFBRW1NLEVBQUMsRUFBRUEsR0FBRTZFLEVBQUMsQ0FBQyxJQUFJLEdBQUcsR0FBRSxFQUFHLGNBQWMsUUFBUSxFQUFFLFNBQVMsR0FBR2hSLEdBQUU4USxHQUFFLENBQUMsSUFBSTNFLEdBQUUsR0FBR25NLEdBQUU4USxFQUFDLEVBQUUsR0FBRzNFLEdBQUUsQ0FBQyxHQUFHQSxLQUFJLE9BQVEsTUFBTSxDQUFDLEdBQUduTSxHQUFFOFEsRUFBQyxDQUFDLEVBQU8sSUFBSUUsR0FBRSxFQUFFaFIsR0FBRW1NLEVBQUMsRUFBRSxPQUFHNkUsR0FBRSxTQUFTLEdBQUcsRUFBRSxpQkFBaUI3RSxHQUFFLFFBQVEyRSxHQUFFLHVCQUF1QixFQUFRLENBQUMsRUFBRSxHQUFjRSxFQUFHLENBQUMsQ0FBQyxTQUFTLEdBQUdoUixHQUFFOFEsR0FBRSxDQUFDLE9BQU8sRUFBRTlRLEdBQUUsU0FBU0EsR0FBRSxDQUFDLE9BQU8sR0FBR0EsR0FBRThRLEVBQUMsR0FBRyxJQUFJLENBQUMsQ0FBQyxDQUFDLFNBQVMsR0FBRzlRLEdBQUUsQ0FBQyxJQUFJOFEsR0FBRSxHQUFHOVEsR0FBRSxXQUFXLEVBQUUsR0FBRzhRLEdBQUcsT0FBR0EsS0FBSSxPQUFlLEdBQUc5USxHQUFFLFdBQVcsRUFBYyxHQUFHQSxHQUFFOFEsRUFBQyxFQUFRLElBQUkzRSxHQUFFLEdBQUduTSxFQUFDLEVBQUUsT0FBR21NLEdBQUUsUUFBZ0IsR0FBRSxFQUFHLEtBQWlCbk0sRUFBRyxDQUFDLFNBQVMsR0FBR0EsR0FBRSxDQUFtQyxRQUE5QjhRLEdBQUUsRUFBRSxPQUFPLG1CQUEyQjNFLEdBQUUsRUFBRUEsR0FBRTJFLEdBQUUsT0FBTzNFLEtBQUssR0FBR25NLEtBQUk4USxHQUFFM0UsRUFBQyxFQUFHLE1BQU8sR0FBTSxNQUFPLEVBQUssQ0FBQyxTQUFTLEdBQUcyRSxHQUFFM0UsR0FBRSxDQUFDLEdBQUcyRSxHQUFFLFdBQVcsU0FBUzlRLEdBQUUsQ0FBSSxDQUFDbU0sR0FBRSxhQUFhbk0sR0FBRSxJQUFJLEdBQUcsR0FBR0EsR0FBRSxJQUFJLEdBQUc4USxHQUFFLGdCQUFnQjlRLEdBQUUsSUFBSSxDQUFFLENBQUMsRUFBRSxHQUFHbU0sR0FBRSxXQUFXLFNBQVNuTSxHQUFFLENBQUksR0FBR0EsR0FBRSxJQUFJLEdBQUc4USxHQUFFLGFBQWE5USxHQUFFLEtBQUtBLEdBQUUsS0FBSyxDQUFFLENBQUMsQ0FBQyxDQUFDLFNBQVMsR0FBR0EsR0FBRThRLEdBQUUsQ0FBYSxRQUFSM0UsR0FBRSxHQUFHMkUsRUFBQyxFQUFVRSxHQUFFLEVBQUVBLEdBQUU3RSxHQUFFLE9BQU82RSxLQUFJLENBQUMsSUFBSWpWLEdBQUVvUSxHQUFFNkUsRUFBQyxFQUFFLEdBQUcsQ0FBQyxHQUFHalYsR0FBRSxhQUFhaUUsRUFBQyxFQUFHLE1BQU8sRUFBSyxPQUFPQSxHQUFFLENBQUMsRUFBRUEsRUFBQyxDQUFDLENBQUMsQ0FBQyxPQUFPQSxLQUFJLFdBQVcsQ0FBQyxTQUFTLEdBQUdBLEdBQUVqRSxHQUFFOEcsR0FBRSxDQUFDLElBQUlpTyxHQUFFLElBQUksR0FBRy9VLEdBQUUsSUFBSSxFQUFNa1YsR0FBRSxZQUFlalIsS0FBSSxTQUFpQkEsR0FBRSxRQUFRLEdBQUcsRUFBRSxHQUFHaVIsR0FBRWpSLEdBQUUsT0FBTyxFQUFFQSxHQUFFLFFBQVEsR0FBRyxDQUFDLEVBQUU4USxHQUFFOVEsR0FBRSxPQUFPQSxHQUFFLFFBQVEsR0FBRyxFQUFFLEVBQUVBLEdBQUUsTUFBTSxHQUFPaVIsR0FBRWpSLElBQUUsSUFBSW1NLEdBQUUsR0FBRSxFQUFHLGlCQUFpQjJFLEVBQUMsRUFBRSxPQUFHM0UsSUFBRyxHQUFHQSxHQUFFLFNBQVNuTSxHQUFFLENBQUMsSUFBSThRLEdBQU0zRSxHQUFFcFEsR0FBRSxVQUFVLEVBQUksRUFBRStVLEdBQUUsR0FBSSxFQUFDLHVCQUFzQixFQUFHQSxHQUFFLFlBQVkzRSxFQUFDLEVBQU0sR0FBRzhFLEdBQUVqUixFQUFDLElBQUc4USxHQUFFM0UsSUFBRSxJQUFJNkUsR0FBRSxDQUFDLFdBQVcsR0FBSyxPQUFPaFIsR0FBRSxTQUFTOFEsRUFBQyxFQUFNLEdBQUc5USxHQUFFLHFCQUFxQmdSLEVBQUMsSUFBU2hSLEdBQUVnUixHQUFFLE9BQVVBLEdBQUUsWUFBZSxHQUFHQyxHQUFFalIsR0FBRUEsR0FBRThRLEdBQUVqTyxFQUFDLEVBQUUsR0FBR0EsR0FBRSxLQUFLLFNBQVM3QyxHQUFFLENBQUMsR0FBR0EsR0FBRSxvQkFBb0JnUixFQUFDLENBQUMsQ0FBQyxFQUFDLENBQUMsRUFBRWpWLEdBQUUsV0FBVyxZQUFZQSxFQUFDLElBQU9BLEdBQUUsV0FBVyxZQUFZQSxFQUFDLEVBQUUsR0FBRyxLQUFLLEtBQUssd0JBQXdCLENBQUMsUUFBUUEsRUFBQyxDQUFDLEdBQVNpRSxFQUFDLENBQUMsU0FBUyxHQUFHQSxHQUFFOFEsR0FBRTNFLEdBQUUsQ0FBQyxJQUFJNkUsR0FBRSxHQUFHaFIsR0FBRSxlQUFlLEVBQUUsR0FBR2dSLEdBQXNCLFFBQWZqVixHQUFFaVYsR0FBRSxNQUFNLEdBQUcsRUFBVW5PLEdBQUUsRUFBRUEsR0FBRTlHLEdBQUUsT0FBTzhHLEtBQUksQ0FBQyxJQUFJb08sR0FBRWxWLEdBQUU4RyxFQUFDLEVBQUUsTUFBTSxJQUFJLENBQUMsRUFBTXFPLEdBQUVELEdBQUUsQ0FBQyxFQUFFLEtBQUksRUFBTUMsR0FBRSxRQUFRLEdBQUcsSUFBSSxJQUFHQSxHQUFFQSxHQUFFLFVBQVUsQ0FBQyxHQUFFLElBQUkvRyxHQUFFOEcsR0FBRSxDQUFDLEdBQUcsT0FBV0UsR0FBRUwsR0FBRSxjQUFjLElBQUlJLEVBQUMsRUFBS0MsSUFBRyxHQUFHaEgsR0FBRWdILEdBQUVoRixFQUFDLENBQUUsQ0FBRSxHQUFHLEVBQUUyRSxHQUFFLG1DQUFtQyxFQUFFLFNBQVM5USxHQUFFLENBQUMsSUFBSThRLEdBQUUsR0FBRzlRLEdBQUUsYUFBYSxFQUFLOFEsSUFBRyxNQUFNLEdBQUdBLEdBQUU5USxHQUFFbU0sRUFBQyxDQUFFLENBQUMsQ0FBQyxDQUFDLFNBQVMsR0FBR25NLEdBQUUsQ0FBQyxHQUFHLEVBQUVBLEdBQUUsbUNBQW1DLEVBQUUsU0FBU0EsR0FBRSxDQUFDLElBQUk4USxHQUFFLEdBQUc5USxHQUFFLElBQUksRUFBTW1NLEdBQUUsR0FBRSxFQUFHLGVBQWUyRSxFQUFDLEVBQUszRSxJQUFHLE1BQU1uTSxHQUFFLFdBQVcsYUFBYW1NLEdBQUVuTSxFQUFDLENBQUUsQ0FBQyxDQUFDLENBQUMsU0FBUyxHQUFHaVIsR0FBRWpSLEdBQUVrUixHQUFFLENBQUMsR0FBR2xSLEdBQUUsaUJBQWlCLE1BQU0sRUFBRSxTQUFTQSxHQUFFLENBQUMsSUFBSThRLEdBQUUsR0FBRzlRLEdBQUUsSUFBSSxFQUFFLEdBQUc4USxJQUFHQSxHQUFFLE9BQU8sRUFBRSxDQUFDLElBQUkzRSxHQUFFMkUsR0FBRSxRQUFRLElBQUksS0FBSyxFQUFNRSxHQUFFaFIsR0FBRSxRQUFRLFFBQVEsSUFBSSxLQUFLLEVBQU1qRSxHQUFFa1YsR0FBRSxjQUFjRCxHQUFFLFFBQVE3RSxHQUFFLElBQUksRUFBRSxHQUFHcFEsSUFBR0EsS0FBSWtWLEdBQUUsQ0FBQyxJQUFJcE8sR0FBRTdDLEdBQUUsVUFBVyxFQUFDLEdBQUdBLEdBQUVqRSxFQUFDLEVBQUVtVixHQUFFLE1BQU0sS0FBSyxVQUFVLENBQUMsR0FBR2xSLEdBQUU2QyxFQUFDLENBQUMsQ0FBQyxDQUFDLENBQUMsQ0FBQyxDQUFDLENBQUMsQ0FBQyxTQUFTLEdBQUc3QyxHQUFFLENBQUMsT0FBTyxVQUFVLENBQUMsRUFBRUEsR0FBRSxFQUFFLE9BQU8sVUFBVSxFQUFFLEdBQUdBLEVBQUMsRUFBRSxHQUFHQSxFQUFDLEVBQUUsR0FBR0EsRUFBQyxFQUFFLEdBQUdBLEdBQUUsV0FBVyxDQUFDLENBQUMsQ0FBQyxTQUFTLEdBQUdBLEdBQUUsQ0FBQyxJQUFJOFEsR0FBRSxjQUFrQjNFLEdBQUUsRUFBRW5NLEdBQUU4USxFQUFDLEVBQUU5USxHQUFFQSxHQUFFLGNBQWM4USxFQUFDLEVBQUszRSxJQUFHLE1BQU1BLEdBQUUsTUFBSyxDQUFHLENBQUMsU0FBUyxFQUFFbk0sR0FBRThRLEdBQUUzRSxHQUFFNkUsR0FBRSxDQUFXLElBQVYsR0FBR2hSLEdBQUVtTSxHQUFFNkUsRUFBQyxFQUFRN0UsR0FBRSxXQUFXLE9BQU8sR0FBRSxDQUFDLElBQUlwUSxHQUFFb1EsR0FBRSxXQUFXLEVBQUVwUSxHQUFFLEVBQUUsT0FBTyxVQUFVLEVBQUVpRSxHQUFFLGFBQWFqRSxHQUFFK1UsRUFBQyxFQUFLL1UsR0FBRSxXQUFXLEtBQUssV0FBV0EsR0FBRSxXQUFXLEtBQUssY0FBY2lWLEdBQUUsTUFBTSxLQUFLLEdBQUdqVixFQUFDLENBQUMsQ0FBRSxDQUFDLENBQUMsU0FBUyxHQUFHaUUsR0FBRThRLEdBQUUsQ0FBUyxRQUFKM0UsR0FBRSxFQUFRQSxHQUFFbk0sR0FBRSxRQUFROFEsSUFBR0EsSUFBRyxHQUFHQSxHQUFFOVEsR0FBRSxXQUFXbU0sSUFBRyxFQUFFLEVBQUUsT0FBTzJFLEVBQUMsQ0FBQyxTQUFTLEdBQUc5USxHQUFFLENBQUMsSUFBSThRLEdBQUUsRUFBRSxHQUFHOVEsR0FBRSxXQUFZLFFBQVFtTSxHQUFFLEVBQUVBLEdBQUVuTSxHQUFFLFdBQVcsT0FBT21NLEtBQUksQ0FBQyxJQUFJNkUsR0FBRWhSLEdBQUUsV0FBV21NLEVBQUMsRUFBSzZFLEdBQUUsUUFBT0YsR0FBRSxHQUFHRSxHQUFFLEtBQUtGLEVBQUMsRUFBRUEsR0FBRSxHQUFHRSxHQUFFLE1BQU1GLEVBQUMsRUFBRSxDQUFFLE9BQU9BLEVBQUMsQ0FBQyxTQUFTLEdBQUc5USxHQUFFLENBQUMsSUFBSThRLEdBQUUsR0FBRzlRLEVBQUMsRUFBRSxHQUFHOFEsR0FBRSxXQUFXLENBQUMsUUFBUTNFLEdBQUUsRUFBRUEsR0FBRTJFLEdBQUUsV0FBVyxPQUFPM0UsS0FBSSxDQUFDLE1BQU02RSxHQUFFRixHQUFFLFdBQVczRSxFQUFDLEVBQUVuTSxHQUFFLG9CQUFvQmdSLEdBQUUsTUFBTUEsR0FBRSxRQUFRLENBQUMsQ0FBQyxPQUFPRixHQUFFLFVBQVUsQ0FBQyxDQUFDLFNBQVMsR0FBRzlRLEdBQUUsQ0FBQyxJQUFJOFEsR0FBRSxHQUFHOVEsRUFBQyxFQUFLOFEsR0FBRSxTQUFTLGFBQWFBLEdBQUUsT0FBTyxFQUFLQSxHQUFFLFdBQVdBLEdBQUUsVUFBVSxNQUFPLEVBQUlBLEdBQUUsZ0JBQWdCQSxHQUFFLGVBQWUsTUFBSyxFQUFNQSxHQUFFLGVBQWUsR0FBR0EsR0FBRSxjQUFjLFNBQVM5USxHQUFFLENBQUlBLEdBQUUsSUFBSUEsR0FBRSxHQUFHLG9CQUFvQkEsR0FBRSxRQUFRQSxHQUFFLFFBQVEsQ0FBRSxDQUFDLEVBQUUsR0FBR0EsRUFBQyxFQUFFLEdBQUcsT0FBTyxLQUFLOFEsRUFBQyxFQUFFLFNBQVM5USxHQUFFLENBQUMsT0FBTzhRLEdBQUU5USxFQUFDLENBQUMsQ0FBQyxDQUFDLENBQUMsU0FBUyxFQUFFQSxHQUFFLENBQUMsR0FBR0EsR0FBRSwyQkFBMkIsRUFBRSxHQUFHQSxFQUFDLEVBQUtBLEdBQUUsVUFBVSxHQUFHQSxHQUFFLFNBQVMsU0FBU0EsR0FBRSxDQUFDLEVBQUVBLEVBQUMsQ0FBQyxDQUFDLENBQUUsQ0FBQyxTQUFTLEdBQUc4USxHQUFFOVEsR0FBRW1NLEdBQUUsQ0FBQyxHQUFHMkUsR0FBRSxVQUFVLE9BQVEsT0FBTyxHQUFHQSxHQUFFOVEsR0FBRW1NLEVBQUMsRUFBTyxJQUFJNkUsR0FBTWpWLEdBQUUrVSxHQUFFLGdCQUFnSSxJQUFoSCxFQUFFLEVBQUVBLEVBQUMsRUFBRUEsR0FBRTlRLEdBQUVtTSxFQUFDLEVBQUtwUSxJQUFHLEtBQU1pVixHQUFFLEVBQUVGLEVBQUMsRUFBRSxXQUFnQkUsR0FBRWpWLEdBQUUsWUFBWW9RLEdBQUUsS0FBS0EsR0FBRSxLQUFLLE9BQU8sU0FBU25NLEdBQUUsQ0FBQyxPQUFPQSxJQUFHOFEsRUFBQyxDQUFDLEVBQVFFLElBQUdBLEtBQUlGLElBQU1FLEdBQUUsV0FBVyxLQUFLLGNBQWM3RSxHQUFFLEtBQUssS0FBSzZFLEVBQUMsRUFBRUEsR0FBRUEsR0FBRSxtQkFBbUIsRUFBRUYsRUFBQyxFQUFFLEVBQUVBLEVBQUMsRUFBRSxZQUFZQSxFQUFDLENBQUUsQ0FBQyxTQUFTLEdBQUc5USxHQUFFOFEsR0FBRTNFLEdBQUUsQ0FBQyxPQUFPLEVBQUVuTSxHQUFFQSxHQUFFLFdBQVc4USxHQUFFM0UsRUFBQyxDQUFDLENBQUMsU0FBUyxHQUFHbk0sR0FBRThRLEdBQUUzRSxHQUFFLENBQUMsT0FBTyxFQUFFLEVBQUVuTSxFQUFDLEVBQUVBLEdBQUU4USxHQUFFM0UsRUFBQyxDQUFDLENBQUMsU0FBUyxHQUFHbk0sR0FBRThRLEdBQUUzRSxHQUFFLENBQUMsT0FBTyxFQUFFbk0sR0FBRSxLQUFLOFEsR0FBRTNFLEVBQUMsQ0FBQyxDQUFDLFNBQVMsR0FBR25NLEdBQUU4USxHQUFFM0UsR0FBRSxDQUFDLE9BQU8sRUFBRSxFQUFFbk0sRUFBQyxFQUFFQSxHQUFFLFlBQVk4USxHQUFFM0UsRUFBQyxDQUFDLENBQUMsU0FBUyxHQUFHbk0sR0FBRThRLEdBQUUzRSxHQUFFLENBQUMsU0FBRW5NLEVBQUMsRUFBUyxFQUFFQSxFQUFDLEVBQUUsWUFBWUEsRUFBQyxDQUFDLENBQUMsU0FBUyxHQUFHQSxHQUFFOFEsR0FBRTNFLEdBQUUsQ0FBQyxJQUFJNkUsR0FBRWhSLEdBQUUsV0FBc0IsR0FBWCxFQUFFQSxHQUFFZ1IsR0FBRUYsR0FBRTNFLEVBQUMsRUFBSzZFLEdBQUUsQ0FBQyxLQUFNQSxHQUFFLGFBQWEsRUFBRUEsR0FBRSxXQUFXLEVBQUVoUixHQUFFLFlBQVlnUixHQUFFLFdBQVcsRUFBRSxFQUFFQSxFQUFDLEVBQUVoUixHQUFFLFlBQVlnUixFQUFDLENBQUMsQ0FBQyxDQUFDLFNBQVMsR0FBR2hSLEdBQUU4USxHQUFFM0UsR0FBRSxDQUFDLElBQUk2RSxHQUFFN0UsSUFBRyxHQUFHbk0sR0FBRSxXQUFXLEVBQUUsR0FBR2dSLEdBQUUsQ0FBQyxJQUFJalYsR0FBRSxHQUFJLEVBQUMsdUJBQXNCLEVBQUcsR0FBRytVLEdBQUUsaUJBQWlCRSxFQUFDLEVBQUUsU0FBU2hSLEdBQUUsQ0FBQ2pFLEdBQUUsWUFBWWlFLEVBQUMsQ0FBQyxDQUFDLEVBQUU4USxHQUFFL1UsRUFBQyxDQUFDLE9BQU8rVSxFQUFDLENBQUMsU0FBUyxHQUFHOVEsR0FBRThRLEdBQUUzRSxHQUFFNkUsR0FBRWpWLEdBQUUsQ0FBQyxPQUFPaUUsR0FBRyxDQUFBLElBQUksT0FBTyxPQUFPLElBQUksWUFBWSxHQUFHbU0sR0FBRTZFLEdBQUVqVixFQUFDLEVBQUUsT0FBTyxJQUFJLGFBQWEsR0FBR29RLEdBQUU2RSxHQUFFalYsRUFBQyxFQUFFLE9BQU8sSUFBSSxjQUFjLEdBQUdvUSxHQUFFNkUsR0FBRWpWLEVBQUMsRUFBRSxPQUFPLElBQUksWUFBWSxHQUFHb1EsR0FBRTZFLEdBQUVqVixFQUFDLEVBQUUsT0FBTyxJQUFJLFdBQVcsR0FBR29RLEdBQUU2RSxHQUFFalYsRUFBQyxFQUFFLE9BQU8sSUFBSSxTQUFTLEdBQUdvUSxFQUFLLEVBQUUsT0FBTyxRQUFvQixRQUFSdEosR0FBRSxHQUFHaU8sRUFBQyxFQUFVRyxHQUFFLEVBQUVBLEdBQUVwTyxHQUFFLE9BQU9vTyxLQUFJLENBQUMsSUFBSUMsR0FBRXJPLEdBQUVvTyxFQUFDLEVBQUUsR0FBRyxDQUFDLElBQUk5RyxHQUFFK0csR0FBRSxXQUFXbFIsR0FBRW1NLEdBQUU2RSxHQUFFalYsRUFBQyxFQUFFLEdBQUdvTyxHQUFFLENBQUMsR0FBRyxPQUFPQSxHQUFFLE9BQVMsSUFBYSxRQUFRZ0gsR0FBRSxFQUFFQSxHQUFFaEgsR0FBRSxPQUFPZ0gsS0FBSSxDQUFDLElBQUlDLEdBQUVqSCxHQUFFZ0gsRUFBQyxFQUFLQyxHQUFFLFdBQVcsS0FBSyxXQUFXQSxHQUFFLFdBQVcsS0FBSyxjQUFjclYsR0FBRSxNQUFNLEtBQUssR0FBR3FWLEVBQUMsQ0FBQyxDQUFFLENBQUUsTUFBTSxDQUFDLE9BQU9wUixHQUFFLENBQUMsRUFBRUEsRUFBQyxDQUFDLENBQUMsQ0FBSUEsS0FBSSxZQUFhLEdBQUdtTSxHQUFFNkUsR0FBRWpWLEVBQUMsRUFBTyxHQUFHLEVBQUUsT0FBTyxpQkFBaUIrVSxHQUFFM0UsR0FBRTZFLEdBQUVqVixFQUFDLENBQUUsQ0FBQyxDQUFDLFNBQVMsR0FBR2lFLEdBQUUsQ0FBQyxHQUFHQSxHQUFFLFFBQVEsUUFBUSxFQUFFLEdBQUcsQ0FBQyxJQUFJOFEsR0FBRTlRLEdBQUUsUUFBUSxFQUFFLEVBQUUsRUFBTW1NLEdBQUUyRSxHQUFFLE1BQU0sQ0FBQyxFQUFFLEdBQUczRSxHQUFHLE9BQU9BLEdBQUUsQ0FBQyxDQUFFLENBQUMsQ0FBQyxTQUFTLEdBQUduTSxHQUFFOFEsR0FBRTNFLEdBQUU2RSxHQUFFalYsR0FBRThHLEdBQUUsQ0FBQzlHLEdBQUUsTUFBTSxHQUFHaVYsRUFBQyxFQUFFLElBQUlDLEdBQUUsRUFBRUQsRUFBQyxFQUFFLEdBQUdDLEdBQUcsVUFBRzlFLEdBQUU4RSxHQUFFbFYsRUFBQyxFQUFFa1YsR0FBRSxHQUFHOUUsR0FBRThFLEdBQUVwTyxFQUFDLEVBQUUsR0FBR29PLEVBQUMsRUFBUyxHQUFHalIsR0FBRW1NLEdBQUUyRSxHQUFFRyxHQUFFbFYsRUFBQyxDQUFFLENBQUMsU0FBUyxHQUFHaUUsR0FBRThRLEdBQUUzRSxHQUFFLENBQUMsSUFBSTZFLEdBQUVoUixHQUFFLGtCQUFrQjhRLEVBQUMsRUFBRSxHQUFHRSxHQUFFLFFBQVEsR0FBRyxJQUFJLEVBQUUsQ0FBQyxJQUFJalYsR0FBRSxFQUFFaVYsRUFBQyxFQUFFLFFBQVFuTyxNQUFLOUcsR0FBRyxHQUFHQSxHQUFFLGVBQWU4RyxFQUFDLEVBQUUsQ0FBQyxJQUFJb08sR0FBRWxWLEdBQUU4RyxFQUFDLEVBQU0sRUFBRW9PLEVBQUMsSUFBR0EsR0FBRSxDQUFDLE1BQU1BLEVBQUMsR0FBRSxHQUFHOUUsR0FBRXRKLEdBQUVvTyxFQUFDLENBQUMsQ0FBRSxLQUF5QixTQUFmQyxHQUFFRixHQUFFLE1BQU0sR0FBRyxFQUFVN0csR0FBRSxFQUFFQSxHQUFFK0csR0FBRSxPQUFPL0csS0FBSyxHQUFHZ0MsR0FBRStFLEdBQUUvRyxFQUFDLEVBQUUsS0FBTSxFQUFDLEVBQUUsQ0FBRyxDQUFhLElBQUksRUFBRSxRQUFZLEdBQUcsYUFBaUIsR0FBRyxnQkFBb0IsR0FBRyxDQUFDLElBQUksSUFBSSxHQUFHLEVBQU0sR0FBRyxRQUFZLEdBQUcsT0FBVyxHQUFHLE9BQU8sU0FBUyxHQUFHbkssR0FBRSxDQUFrQixRQUFiOFEsR0FBRSxDQUFFLEVBQUszRSxHQUFFLEVBQVFBLEdBQUVuTSxHQUFFLFFBQU8sQ0FBQyxHQUFHLEdBQUcsS0FBS0EsR0FBRSxPQUFPbU0sRUFBQyxDQUFDLEVBQUUsQ0FBUyxRQUFKNkUsR0FBRTdFLEdBQVEsR0FBRyxLQUFLbk0sR0FBRSxPQUFPbU0sR0FBRSxDQUFDLENBQUMsR0FBR0EsS0FBSTJFLEdBQUUsS0FBSzlRLEdBQUUsT0FBT2dSLEdBQUU3RSxHQUFFNkUsR0FBRSxDQUFDLENBQUMsQ0FBQyxTQUFTLEdBQUcsUUFBUWhSLEdBQUUsT0FBT21NLEVBQUMsQ0FBQyxJQUFJLEdBQUcsQ0FBQyxJQUFJcFEsR0FBRWlFLEdBQUUsT0FBT21NLEVBQUMsRUFBTTZFLEdBQUU3RSxHQUFNLElBQUpBLEtBQVVBLEdBQUVuTSxHQUFFLFFBQVFBLEdBQUUsT0FBT21NLEVBQUMsSUFBSXBRLElBQU1pRSxHQUFFLE9BQU9tTSxFQUFDLElBQUksTUFBTUEsS0FBSUEsS0FBSTJFLEdBQUUsS0FBSzlRLEdBQUUsT0FBT2dSLEdBQUU3RSxHQUFFNkUsR0FBRSxDQUFDLENBQUMsQ0FBQyxLQUFLLENBQUMsSUFBSW5PLEdBQUU3QyxHQUFFLE9BQU9tTSxFQUFDLEVBQUUyRSxHQUFFLEtBQUtqTyxFQUFDLENBQUMsQ0FBQ3NKLElBQUcsQ0FBQyxPQUFPMkUsRUFBQyxDQUFDLFNBQVMsR0FBRzlRLEdBQUU4USxHQUFFM0UsR0FBRSxDQUFDLE9BQU8sR0FBRyxLQUFLbk0sR0FBRSxPQUFPLENBQUMsQ0FBQyxHQUFHQSxLQUFJLFFBQVFBLEtBQUksU0FBU0EsS0FBSSxRQUFRQSxLQUFJbU0sSUFBRzJFLEtBQUksR0FBRyxDQUFDLFNBQVMsR0FBRzlRLEdBQUU4USxHQUFFM0UsR0FBRSxDQUFDLEdBQUcyRSxHQUFFLENBQUMsSUFBSSxJQUFJLENBQUNBLEdBQUUsTUFBTyxFQUErRCxRQUExREUsR0FBRSxFQUFNalYsR0FBRSxxQkFBcUJvUSxHQUFFLGNBQWtCdEosR0FBRSxLQUFXaU8sR0FBRSxPQUFPLEdBQUUsQ0FBQyxJQUFJRyxHQUFFSCxHQUFFLENBQUMsRUFBRSxHQUFHRyxLQUFJLEtBQVMsR0FBSkQsS0FBT0EsS0FBSSxFQUFFLENBQUluTyxLQUFJLE9BQU05RyxHQUFFQSxHQUFFLFFBQU8rVSxHQUFFLE1BQU8sRUFBQy9VLElBQUcsTUFBTSxHQUFHLENBQUMsSUFBSW1WLEdBQUUsR0FBR2xSLEdBQUUsVUFBVSxDQUFDLE9BQU8sU0FBU2pFLEVBQUMsRUFBRyxDQUFBLEVBQUUsVUFBVSxDQUFDLE1BQU8sRUFBSSxDQUFDLEVBQUUsT0FBQW1WLEdBQUUsT0FBT25WLEdBQVNtVixFQUFDLE9BQU9sUixHQUFFLENBQUMsVUFBRyxHQUFJLEVBQUMsS0FBSyxvQkFBb0IsQ0FBQyxNQUFNQSxHQUFFLE9BQU9qRSxFQUFDLENBQUMsRUFBUyxJQUFJLENBQUMsT0FBVWtWLEtBQUksS0FBS0QsS0FBTyxHQUFHQyxHQUFFcE8sR0FBRXNKLEVBQUMsRUFBR3BRLElBQUcsS0FBS29RLEdBQUUsSUFBSThFLEdBQUUsUUFBUTlFLEdBQUUsSUFBSThFLEdBQUUsZUFBZUEsR0FBRSxLQUFVbFYsR0FBRUEsR0FBRWtWLEdBQUVwTyxHQUFFaU8sR0FBRSxNQUFLLENBQUUsQ0FBQyxDQUFDLENBQUMsU0FBUyxFQUFFOVEsR0FBRThRLEdBQUUsQ0FBVSxRQUFMM0UsR0FBRSxHQUFTbk0sR0FBRSxPQUFPLEdBQUcsQ0FBQzhRLEdBQUUsS0FBSzlRLEdBQUUsQ0FBQyxDQUFDLEdBQUdtTSxJQUFHbk0sR0FBRSxNQUFPLEVBQUMsT0FBT21NLEVBQUMsQ0FBQyxTQUFTLEdBQUduTSxHQUFFLENBQUMsSUFBSThRLEdBQUUsT0FBRzlRLEdBQUUsT0FBTyxHQUFHLEdBQUcsS0FBS0EsR0FBRSxDQUFDLENBQUMsR0FBR0EsR0FBRSxNQUFPLEVBQUM4USxHQUFFLEVBQUU5USxHQUFFLEVBQUUsRUFBRSxLQUFNLEVBQUNBLEdBQUUsTUFBTyxHQUFNOFEsR0FBRSxFQUFFOVEsR0FBRSxDQUFDLEVBQVM4USxFQUFDLENBQUMsSUFBSSxHQUFHLDBCQUEwQixTQUFTLEdBQUc5USxHQUFFOFEsR0FBRTNFLEdBQUUsQ0FBQyxJQUFJNkUsR0FBRSxDQUFFLEVBQUtqVixHQUFFLEdBQUcrVSxFQUFDLEVBQUUsRUFBRSxDQUFDLEVBQUUvVSxHQUFFLEVBQUUsRUFBRSxJQUFJOEcsR0FBRTlHLEdBQUUsT0FBV2tWLEdBQUUsRUFBRWxWLEdBQUUsU0FBUyxFQUFFLEdBQUdrVixLQUFJLEdBQUksR0FBR0EsS0FBSSxRQUFRLENBQUMsSUFBSUMsR0FBRSxDQUFDLFFBQVEsT0FBTyxFQUFFLEVBQUVuVixHQUFFLEVBQUUsRUFBRW1WLEdBQUUsYUFBYSxFQUFFLEVBQUVuVixHQUFFLFNBQVMsQ0FBQyxFQUFFLEVBQUVBLEdBQUUsRUFBRSxFQUFFLElBQUlvTyxHQUFFLEdBQUduSyxHQUFFakUsR0FBRSxPQUFPLEVBQUtvTyxLQUFHK0csR0FBRSxZQUFZL0csSUFBRTZHLEdBQUUsS0FBS0UsRUFBQyxDQUFDLFNBQVNELEdBQUUsUUFBUSxNQUFNLElBQUksRUFBR0QsR0FBRSxLQUFLLENBQUMsUUFBUSxNQUFNLFNBQVNDLEdBQUUsT0FBTyxDQUFDLENBQUMsQ0FBQyxNQUFNLENBQUMsSUFBSUUsR0FBRSxDQUFDLFFBQVFGLEVBQUMsRUFBTTlHLEdBQUUsR0FBR25LLEdBQUVqRSxHQUFFLE9BQU8sRUFBd0IsSUFBbkJvTyxLQUFHZ0gsR0FBRSxZQUFZaEgsSUFBUXBPLEdBQUUsT0FBTyxHQUFHQSxHQUFFLENBQUMsSUFBSSxLQUFJLENBQUMsRUFBRUEsR0FBRSxFQUFFLEVBQUUsSUFBSXFWLEdBQUVyVixHQUFFLE1BQU8sRUFBQyxHQUFHcVYsS0FBSSxVQUFXRCxHQUFFLFFBQVEsV0FBYUMsS0FBSSxPQUFRRCxHQUFFLEtBQUssV0FBYUMsS0FBSSxVQUFXRCxHQUFFLFFBQVEsV0FBYUMsS0FBSSxTQUFTclYsR0FBRSxDQUFDLElBQUksSUFBS0EsR0FBRSxRQUFRb1YsR0FBRSxNQUFNLEVBQUUsRUFBRXBWLEdBQUUsQ0FBQyxDQUFDLFVBQVVxVixLQUFJLFFBQVFyVixHQUFFLENBQUMsSUFBSSxJQUFJLENBQVcsR0FBVkEsR0FBRSxNQUFLLEVBQU0sR0FBRyxLQUFLQSxHQUFFLENBQUMsQ0FBQyxFQUFHLElBQUlzVixHQUFFLEdBQUd0VixFQUFDLE1BQU0sQ0FBQyxJQUFJc1YsR0FBRSxFQUFFdFYsR0FBRSxDQUFDLEVBQUUsR0FBR3NWLEtBQUksV0FBV0EsS0FBSSxRQUFRQSxLQUFJLFFBQVFBLEtBQUksV0FBVyxDQUFDdFYsR0FBRSxNQUFLLEVBQUcsSUFBSXVWLEdBQUUsR0FBR3ZWLEVBQUMsRUFBS3VWLEdBQUUsT0FBTyxJQUFHRCxJQUFHLElBQUlDLEdBQUUsQ0FBQyxDQUFDSCxHQUFFLEtBQUtFLEVBQUMsTUFBU0QsS0FBSSxVQUFVclYsR0FBRSxDQUFDLElBQUksS0FBS0EsR0FBRSxNQUFLLEVBQUdvVixHQUFFLE9BQU8sR0FBR3BWLEVBQUMsR0FBVXFWLEtBQUksWUFBWXJWLEdBQUUsQ0FBQyxJQUFJLEtBQUtBLEdBQUUsTUFBSyxFQUFHb1YsR0FBRSxTQUFTLEVBQUUsRUFBRXBWLEdBQUUsQ0FBQyxDQUFDLEdBQVVxVixLQUFJLFNBQVNyVixHQUFFLENBQUMsSUFBSSxLQUFLQSxHQUFFLE1BQU8sRUFBQ29WLEdBQUUsTUFBTSxFQUFFcFYsR0FBRSxDQUFDLEdBQVVxVixLQUFJLFFBQVFyVixHQUFFLENBQUMsSUFBSSxLQUFLQSxHQUFFLE1BQUssRUFBR29WLEdBQUVDLEVBQUMsRUFBRSxHQUFHclYsRUFBQyxHQUFVcVYsS0FBSSxhQUFhclYsR0FBRSxDQUFDLElBQUksS0FBS0EsR0FBRSxNQUFLLEVBQUdvVixHQUFFQyxFQUFDLEVBQUUsRUFBRXJWLEdBQUUsQ0FBQyxHQUFPLEdBQUdpRSxHQUFFLG9CQUFvQixDQUFDLE1BQU1qRSxHQUFFLE1BQU8sQ0FBQSxDQUFDLENBQUUsQ0FBQ2lWLEdBQUUsS0FBS0csRUFBQyxDQUFDLENBQUtwVixHQUFFLFNBQVM4RyxJQUFHLEdBQUc3QyxHQUFFLG9CQUFvQixDQUFDLE1BQU1qRSxHQUFFLE1BQU8sQ0FBQSxDQUFDLEVBQUUsRUFBRUEsR0FBRSxFQUFFLENBQUMsT0FBT0EsR0FBRSxDQUFDLElBQUksS0FBS0EsR0FBRSxNQUFPLEdBQUUsT0FBR29RLEtBQUdBLEdBQUUyRSxFQUFDLEVBQUVFLElBQVNBLEVBQUMsQ0FBQyxTQUFTLEdBQUdoUixHQUFFLENBQUMsSUFBSThRLEdBQUUsR0FBRzlRLEdBQUUsWUFBWSxFQUFNbU0sR0FBRSxHQUFHLEdBQUcyRSxHQUFFLENBQUMsSUFBSUUsR0FBRSxFQUFFLE9BQU8sa0JBQWtCN0UsR0FBRTZFLElBQUdBLEdBQUVGLEVBQUMsR0FBRyxHQUFHOVEsR0FBRThRLEdBQUVFLEVBQUMsQ0FBQyxDQUFDLE9BQUc3RSxHQUFFLE9BQU8sRUFBVUEsR0FBVSxFQUFFbk0sR0FBRSxNQUFNLEVBQVMsQ0FBQyxDQUFDLFFBQVEsUUFBUSxDQUFDLEVBQVUsRUFBRUEsR0FBRSw0Q0FBNEMsRUFBUyxDQUFDLENBQUMsUUFBUSxPQUFPLENBQUMsRUFBVSxFQUFFQSxHQUFFLEVBQUUsRUFBUyxDQUFDLENBQUMsUUFBUSxRQUFRLENBQUMsRUFBYSxDQUFDLENBQUMsUUFBUSxPQUFPLENBQUMsQ0FBRSxDQUFDLFNBQVMsR0FBR0EsR0FBRSxDQUFDLEdBQUdBLEVBQUMsRUFBRSxVQUFVLEVBQUksQ0FBQyxTQUFTLEdBQUdBLEdBQUU4USxHQUFFM0UsR0FBRSxDQUFDLElBQUk2RSxHQUFFLEdBQUdoUixFQUFDLEVBQUVnUixHQUFFLFFBQVEsV0FBVyxVQUFVLENBQUksR0FBR2hSLEVBQUMsR0FBR2dSLEdBQUUsWUFBWSxLQUFVLEdBQUc3RSxHQUFFbk0sR0FBRSxHQUFHLGtCQUFrQixDQUFDLFlBQVltTSxHQUFFLE9BQU9uTSxFQUFDLENBQUMsQ0FBQyxHQUFHOFEsR0FBRTlRLEVBQUMsRUFBRSxHQUFHQSxHQUFFOFEsR0FBRTNFLEVBQUMsRUFBRSxFQUFFQSxHQUFFLFlBQVksQ0FBQyxDQUFDLFNBQVMsR0FBR25NLEdBQUUsQ0FBQyxPQUFPLFNBQVMsV0FBV0EsR0FBRSxVQUFVLEdBQUdBLEdBQUUsTUFBTSxHQUFHLEdBQUdBLEdBQUUsTUFBTSxFQUFFLFFBQVEsR0FBRyxJQUFJLENBQUMsQ0FBQyxTQUFTLEdBQUc4USxHQUFFM0UsR0FBRW5NLEdBQUUsQ0FBQyxHQUFHOFEsR0FBRSxVQUFVLEtBQUssR0FBR0EsRUFBQyxJQUFJQSxHQUFFLFNBQVMsSUFBSUEsR0FBRSxTQUFTLFVBQVVBLEdBQUUsVUFBVSxPQUFPLENBQUMzRSxHQUFFLFFBQVEsR0FBSyxJQUFJNkUsR0FBRWpWLEdBQUUsR0FBRytVLEdBQUUsVUFBVSxJQUFLRSxHQUFFLE1BQU1qVixHQUFFLEdBQUcrVSxHQUFFLE1BQU0sTUFBTSxDQUFDLElBQUlqTyxHQUFFLEdBQUdpTyxHQUFFLFFBQVEsRUFBRUUsR0FBRW5PLEdBQUVBLEdBQUUsY0FBYyxNQUFxQjlHLEdBQUUsR0FBRytVLEdBQUUsUUFBUSxDQUFDLENBQUM5USxHQUFFLFFBQVEsU0FBU0EsR0FBRSxDQUFDLEdBQUc4USxHQUFFLFNBQVM5USxHQUFFOFEsR0FBRSxDQUFDLEdBQUcsRUFBRTlRLEdBQUUsRUFBRSxPQUFPLGVBQWUsRUFBRSxDQUFDLEVBQUVBLEVBQUMsRUFBRSxNQUFNLENBQUMsR0FBR2dSLEdBQUVqVixHQUFFaUUsR0FBRThRLEVBQUMsQ0FBQyxFQUFFM0UsR0FBRW5NLEdBQUUsRUFBSSxDQUFDLENBQUMsQ0FBQyxDQUFDLENBQUMsU0FBUyxHQUFHQSxHQUFFOFEsR0FBRSxDQUFDLE1BQUcsSUFBQTlRLEdBQUUsT0FBTyxVQUFVQSxHQUFFLE9BQU8sV0FBWThRLEdBQUUsVUFBVSxRQUF1QixFQUFFQSxHQUFFLDhCQUE4QixHQUFHLEVBQUVBLEdBQUUsTUFBTSxJQUFJLE1BQXFCQSxHQUFFLFVBQVUsS0FBS0EsR0FBRSxPQUFPQSxHQUFFLGFBQWEsTUFBTSxJQUFJLEtBQUtBLEdBQUUsYUFBYSxNQUFNLEVBQUUsUUFBUSxHQUFHLElBQUksSUFBNkIsQ0FBQyxTQUFTLEdBQUc5USxHQUFFOFEsR0FBRSxDQUFDLE9BQU8sR0FBRzlRLEVBQUMsRUFBRSxTQUFTQSxHQUFFLFVBQVUsS0FBSzhRLEdBQUUsT0FBTyxVQUFVQSxHQUFFLFNBQVNBLEdBQUUsUUFBUSxDQUFDLFNBQVMsR0FBRzlRLEdBQUU4USxHQUFFM0UsR0FBRSxDQUFDLElBQUk2RSxHQUFFaFIsR0FBRSxZQUFZLEdBQUdnUixHQUFHLEdBQUcsQ0FBQyxPQUFPQSxHQUFFLEtBQUtGLEdBQUUzRSxFQUFDLElBQUksRUFBSSxPQUFPbk0sR0FBRSxDQUFDLFVBQUcsR0FBSSxFQUFDLEtBQUsseUJBQXlCLENBQUMsTUFBTUEsR0FBRSxPQUFPZ1IsR0FBRSxNQUFNLENBQUMsRUFBUyxFQUFJLENBQUUsTUFBTyxFQUFLLENBQUMsU0FBUyxHQUFHbk8sR0FBRW9PLEdBQUVqUixHQUFFa1IsR0FBRS9HLEdBQUUsQ0FBQyxJQUFJZ0gsR0FBRSxHQUFHdE8sRUFBQyxFQUFNaU8sR0FBS0ksR0FBRSxLQUFNSixHQUFFLEVBQUVqTyxHQUFFcU8sR0FBRSxJQUFJLEVBQU9KLEdBQUUsQ0FBQ2pPLEVBQUMsRUFBS3FPLEdBQUUsU0FBU0osR0FBRSxRQUFRLFNBQVM5USxHQUFFLENBQUMsSUFBSThRLEdBQUUsR0FBRzlRLEVBQUMsRUFBRThRLEdBQUUsVUFBVTlRLEdBQUUsS0FBSyxDQUFDLEVBQUUsR0FBRzhRLEdBQUUsU0FBU0UsR0FBRSxDQUFDLElBQUlqVixHQUFFLFNBQVNpRSxHQUFFLENBQUMsR0FBRyxDQUFDLEdBQUc2QyxFQUFDLEVBQUUsQ0FBQ21PLEdBQUUsb0JBQW9CRSxHQUFFLFFBQVFuVixFQUFDLEVBQUUsTUFBTSxDQUFDLEdBQUcsSUFBRzhHLEdBQUU3QyxFQUFDLEtBQWFtSyxJQUFHLEdBQUduSyxHQUFFNkMsRUFBQyxJQUFHN0MsR0FBRSxpQkFBb0IsSUFBR2tSLEdBQUVyTyxHQUFFN0MsRUFBQyxHQUFVLEtBQUk4USxHQUFFLEdBQUc5USxFQUFDLEVBQXlELEdBQXZEOFEsR0FBRSxZQUFZSSxHQUFLSixHQUFFLFlBQVksT0FBTUEsR0FBRSxXQUFXLENBQUUsR0FBSUEsR0FBRSxXQUFXLFFBQVFqTyxFQUFDLEVBQUUsRUFBRSxDQUF3RCxHQUF2RGlPLEdBQUUsV0FBVyxLQUFLak8sRUFBQyxFQUFLcU8sR0FBRSxTQUFTbFIsR0FBRSxnQkFBZSxFQUFNa1IsR0FBRSxRQUFRbFIsR0FBRSxRQUFXLENBQUMsRUFBRUEsR0FBRSxPQUFPa1IsR0FBRSxNQUFNLEVBQUcsT0FBUSxHQUFHQSxHQUFFLEtBQUssQ0FBQyxHQUFHQyxHQUFFLGNBQWUsT0FBWUEsR0FBRSxjQUFjLEVBQUssQ0FBQyxHQUFHRCxHQUFFLFFBQVEsQ0FBQyxJQUFJL0UsR0FBRSxHQUFHNkUsRUFBQyxFQUFFLEdBQUc3RSxHQUFFLFlBQVk2RSxHQUFFLE1BQU8sT0FBTzdFLEdBQUUsVUFBVTZFLEdBQUUsS0FBSyxDQUF1QyxHQUFuQ0csR0FBRSxTQUFTLGFBQWFBLEdBQUUsT0FBTyxFQUFLQSxHQUFFLFNBQVUsT0FBVUQsR0FBRSxTQUFTLEVBQU9DLEdBQUUsV0FBVUYsR0FBRXBPLEdBQUU3QyxFQUFDLEVBQUVtUixHQUFFLFNBQVMsV0FBVyxVQUFVLENBQUNBLEdBQUUsU0FBUyxJQUFJLEVBQUVELEdBQUUsUUFBUSxHQUFXQSxHQUFFLE1BQU0sRUFBR0MsR0FBRSxRQUFRLFdBQVcsVUFBVSxDQUFDRixHQUFFcE8sR0FBRTdDLEVBQUMsQ0FBQyxFQUFFa1IsR0FBRSxLQUFLLEdBQU8sR0FBR3JPLEdBQUUsY0FBYyxFQUFFb08sR0FBRXBPLEdBQUU3QyxFQUFDLEVBQUUsRUFBQyxFQUFLQSxHQUFFLGVBQWUsT0FBTUEsR0FBRSxjQUFjLENBQUUsR0FBQ0EsR0FBRSxjQUFjLEtBQUssQ0FBQyxRQUFRa1IsR0FBRSxRQUFRLFNBQVNuVixHQUFFLEdBQUdpVixFQUFDLENBQUMsRUFBRUEsR0FBRSxpQkFBaUJFLEdBQUUsUUFBUW5WLEVBQUMsQ0FBQyxDQUFDLENBQUMsQ0FBQyxJQUFJLEdBQUcsR0FBVSxHQUFHLEtBQUssU0FBUyxJQUFJLENBQUssS0FBSSxHQUFHLFVBQVUsQ0FBQyxHQUFHLEVBQUksRUFBRSxPQUFPLGlCQUFpQixTQUFTLEVBQUUsRUFBRSxZQUFZLFVBQVUsQ0FBSSxLQUFJLEdBQUcsR0FBTSxHQUFHLEtBQUssaUJBQWlCLHNEQUFzRCxFQUFFLFNBQVNpRSxHQUFFLENBQUMsR0FBR0EsRUFBQyxDQUFDLENBQUMsRUFBRSxFQUFFLEdBQUcsRUFBRSxDQUFDLFNBQVMsR0FBRzhRLEdBQUUsQ0FBQyxHQUFHLENBQUMsRUFBRUEsR0FBRSxrQkFBa0IsR0FBRyxFQUFFQSxFQUFDLEVBQUUsQ0FBQ0EsR0FBRSxhQUFhLG1CQUFtQixNQUFNLEVBQUUsSUFBSTlRLEdBQUUsR0FBRzhRLEVBQUMsRUFBSzlRLEdBQUUsU0FBVSxHQUFHOFEsR0FBRSxVQUFVLEVBQU9BLEdBQUUsaUJBQWlCLHdCQUF3QixTQUFTOVEsR0FBRSxDQUFDLEdBQUc4USxHQUFFLFVBQVUsQ0FBQyxFQUFFLENBQUMsS0FBSyxFQUFJLENBQUMsQ0FBRSxDQUFDLENBQUMsU0FBUyxHQUFHOVEsR0FBRThRLEdBQUUzRSxHQUFFLENBQVksUUFBUDZFLEdBQUUsRUFBRTdFLEVBQUMsRUFBVXBRLEdBQUUsRUFBRUEsR0FBRWlWLEdBQUUsT0FBT2pWLEtBQUksQ0FBQyxJQUFJOEcsR0FBRW1PLEdBQUVqVixFQUFDLEVBQUUsTUFBTSxPQUFPLEVBQUs4RyxHQUFFLENBQUMsSUFBSSxXQUFXLEdBQUc3QyxHQUFFNkMsR0FBRSxDQUFDLEVBQUUsQ0FBQyxFQUFLQSxHQUFFLENBQUMsSUFBSSxRQUFRLEdBQUc3QyxFQUFDLENBQUUsQ0FBQyxDQUFDLFNBQVMsR0FBR2tSLEdBQUUvRSxHQUFFNkUsR0FBRSxDQUFDLEdBQUksR0FBR0UsRUFBQyxFQUFVLElBQUcvRSxHQUFFLFFBQVEsR0FBRyxHQUFHLEVBQUUsQ0FBQyxJQUFJbk0sR0FBRSxTQUFTLFVBQVUsU0FBUyxLQUFLLElBQUksU0FBUyxLQUFLLElBQU8sU0FBUyxVQUFVLFNBQVVtTSxHQUFFLFNBQVNuTSxHQUFFbU0sR0FBVSxTQUFTLFVBQVUsVUFBU0EsR0FBRSxRQUFRbk0sR0FBRW1NLEdBQUUsQ0FBQyxJQUFJMkUsR0FBRSxFQUFFLGdCQUFnQjNFLEVBQUMsRUFBRTJFLEdBQUUsUUFBUSxTQUFTOVEsR0FBRSxDQUFDLEdBQUdrUixHQUFFLGVBQWUsQ0FBQyxNQUFNbFIsR0FBRSxPQUFPOFEsRUFBQyxDQUFDLEVBQUUsR0FBR0ksRUFBQyxDQUFDLEVBQUVKLEdBQUUsUUFBUSxTQUFTOVEsR0FBRSxDQUFDLEdBQUcsQ0FBQyxLQUFLLEtBQUssSUFBSSxFQUFFLFFBQVFBLEdBQUUsSUFBSSxHQUFHLEVBQUUsQ0FBQyxJQUFJOFEsR0FBRSxHQUFHRSxFQUFDLEVBQUUsV0FBVyxVQUFVLENBQUMsR0FBR0UsR0FBRS9FLEdBQUU2RSxHQUFFLENBQUMsQ0FBQyxFQUFFRixFQUFDLENBQUMsQ0FBQyxFQUFFQSxHQUFFLE9BQU8sU0FBUzlRLEdBQUUsQ0FBQ2dSLEdBQUUsQ0FBQyxFQUFFLEdBQUdFLEVBQUMsRUFBRSxVQUFVSixHQUFFQSxHQUFFLGlCQUFpQixVQUFVLFNBQVM5USxHQUFFLENBQUMsR0FBRyxJQUFHa1IsRUFBQyxFQUFVLEtBQUlKLEdBQUU5USxHQUFFLEtBQUssRUFBRWtSLEdBQUUsU0FBU2xSLEdBQUUsQ0FBQzhRLEdBQUU5USxHQUFFLGtCQUFrQjhRLEdBQUUsS0FBS0ksRUFBQyxDQUFDLENBQUMsRUFBNEMsUUFBdEMvRSxHQUFFLEVBQUUrRSxFQUFDLEVBQU1GLEdBQUUsRUFBRUYsRUFBQyxFQUFNL1UsR0FBRSxFQUFFaVYsR0FBRSxRQUFRLEVBQVVuTyxHQUFFLEVBQUVBLEdBQUU5RyxHQUFFLE9BQU84RyxLQUFJLENBQUMsSUFBSW9PLEdBQUVsVixHQUFFOEcsRUFBQyxFQUFFLEdBQUcsR0FBR29PLEdBQUUsYUFBYSxHQUFHLE9BQU9BLEdBQUU5RSxFQUFDLENBQUMsQ0FBQyxHQUFHQSxHQUFFLEtBQUssRUFBQyxDQUFDLEVBQUMsQ0FBQyxTQUFTLEdBQUduTSxHQUFFLENBQUMsR0FBRyxDQUFDLEdBQUdBLEVBQUMsRUFBRyxVQUFHQSxFQUFDLEVBQUUsVUFBVSxNQUFLLEVBQVUsRUFBSyxDQUFDLFNBQVMsR0FBR21SLEdBQUUsQ0FBQyxJQUFJQyxHQUFFLEVBQUVELEdBQUUsU0FBU25SLEdBQUUsQ0FBQyxPQUFPLEdBQUdBLEVBQUMsRUFBRSxXQUFXLElBQUksQ0FBQyxFQUFLb1IsR0FBR0QsR0FBRSxpQkFBaUIsR0FBR0EsRUFBQyxFQUFFLENBQUMsRUFBRSxRQUFRLFNBQVNuUixHQUFFLENBQUMsSUFBSThRLEdBQUUsR0FBR00sRUFBQyxFQUFFLFVBQWNqRixHQUFFLEdBQUdnRixHQUFFQyxFQUFDLEVBQU1KLEdBQUUsR0FBR0csR0FBRSxNQUFNLEVBQU1wVixHQUFFaVYsR0FBRSxPQUFXbk8sR0FBRW1PLEdBQUUsT0FBV0MsR0FBRSxHQUFHRSxFQUFDLEVBQU1ELEdBQUUsR0FBR3JPLEdBQUVvTyxFQUFDLEVBQU05RyxHQUFFLEdBQUcrRyxHQUFFQyxFQUFDLEVBQWlCLEdBQWZoSCxHQUFFLFFBQVdnQyxHQUFLcFEsSUFBR0EsR0FBRSxPQUFPLEVBQUUsQ0FBQyxHQUFHb1YsR0FBRSx5QkFBeUJwVixFQUFDLEVBQUUsTUFBTSxDQUFDK1UsR0FBRSxLQUFLLEtBQUssVUFBVTNHLEVBQUMsQ0FBQyxFQUFLLEdBQUduSyxHQUFFbVIsRUFBQyxHQUFHblIsR0FBRSxlQUFjLENBQUcsQ0FBQyxFQUFPLEdBQUdtUixHQUFFLDZCQUE2QixDQUFFLENBQUMsU0FBUyxHQUFHblIsR0FBRSxDQUFDLElBQUk4USxHQUFFLEVBQUUsT0FBTyxpQkFBaUIsR0FBRyxPQUFPQSxJQUFJLFdBQVksT0FBT0EsR0FBRTlRLEVBQUMsRUFBRSxHQUFHOFEsS0FBSSxjQUFjLENBQUMsSUFBSTNFLEdBQUUsS0FBSyxJQUFJbk0sR0FBRSxDQUFDLEVBQU1nUixHQUFFLElBQUksS0FBSyxJQUFJLEVBQUU3RSxFQUFDLEVBQUUsT0FBTzZFLEdBQUUsS0FBSyxPQUFRLENBQUEsQ0FBQyxFQUFFLG9GQUFvRixDQUFDLENBQUMsU0FBUyxHQUFHaFIsR0FBRThRLEdBQUUzRSxHQUFFLENBQVksUUFBUDZFLEdBQUUsRUFBRTdFLEVBQUMsRUFBVXBRLEdBQUUsRUFBRUEsR0FBRWlWLEdBQUUsT0FBT2pWLEtBQUksQ0FBQyxJQUFJOEcsR0FBRW1PLEdBQUVqVixFQUFDLEVBQUUsTUFBTSxPQUFPLEVBQUs4RyxHQUFFLENBQUMsSUFBSSxXQUFXLEdBQUc3QyxHQUFFNkMsR0FBRSxDQUFDLENBQUMsRUFBS0EsR0FBRSxDQUFDLElBQUksUUFBUSxHQUFHN0MsR0FBRTZDLEdBQUUsQ0FBQyxDQUFDLENBQUUsQ0FBQyxDQUFDLFNBQVMsR0FBR2lPLEdBQUU5USxHQUFFLENBQUMsSUFBSW1NLEdBQUUsRUFBRSxrQkFBa0JuTSxFQUFDLEVBQUVtTSxHQUFFLFFBQVEsU0FBU25NLEdBQUUsQ0FBQyxHQUFHOFEsR0FBRSxnQkFBZ0IsQ0FBQyxNQUFNOVEsR0FBRSxPQUFPbU0sRUFBQyxDQUFDLEVBQUUsR0FBRzJFLEVBQUMsQ0FBQyxFQUFFLEdBQUdBLEVBQUMsRUFBRSxlQUFlM0UsRUFBQyxDQUFDLFNBQVMsR0FBR3RKLEdBQUVvTyxHQUFFLENBQUMsSUFBSUMsR0FBRSxFQUFFck8sR0FBRSxFQUFFLEVBQUUsR0FBR3FPLEdBQUUsQ0FBQyxJQUFJL0csR0FBRSxHQUFHK0csRUFBQyxFQUFFLGVBQW1CQyxHQUFFLFNBQVNuUixHQUFFLENBQUMsR0FBRyxJQUFHa1IsRUFBQyxFQUFVLElBQUcsQ0FBQyxHQUFHck8sRUFBQyxFQUFFLENBQUNzSCxHQUFFLG9CQUFvQjhHLEdBQUVFLEVBQUMsRUFBRSxNQUFNLENBQUMsSUFBSUwsR0FBRTlRLEdBQUUsS0FBSyxFQUFFNkMsR0FBRSxTQUFTN0MsR0FBRSxDQUFDOFEsR0FBRTlRLEdBQUUsa0JBQWtCOFEsR0FBRSxLQUFLak8sRUFBQyxDQUFDLENBQUMsRUFBRSxJQUFJc0osR0FBRSxHQUFHdEosRUFBQyxFQUFNbU8sR0FBRSxHQUFHbk8sRUFBQyxFQUFNOUcsR0FBRSxFQUFFOEcsRUFBQyxFQUFFLEdBQUdzSixHQUFFLFVBQVU2RSxHQUFFbk8sR0FBRWlPLEdBQUUvVSxFQUFDLEVBQUUsR0FBR0EsR0FBRSxLQUFLLEVBQUUsR0FBRzhHLEdBQUUsa0JBQWtCN0MsRUFBQyxFQUFDLEVBQUUsR0FBRzZDLEVBQUMsRUFBRSxZQUFZc08sR0FBRWhILEdBQUUsaUJBQWlCOEcsR0FBRUUsRUFBQyxDQUFDLE1BQU0sR0FBR3RPLEdBQUUsdUJBQXVCLENBQUUsQ0FBQyxTQUFTLEdBQUc3QyxHQUFFOFEsR0FBRTNFLEdBQUUsQ0FBQyxJQUFJNkUsR0FBRSxFQUFFaFIsR0FBRSxFQUFFLEVBQUUsR0FBR2dSLEdBQUUsQ0FBQyxJQUFJalYsR0FBRSxHQUFHaVYsRUFBQyxFQUFFLGVBQW1Cbk8sR0FBRSxVQUFVLENBQUssR0FBR21PLEVBQUMsSUFBTSxHQUFHaFIsRUFBQyxFQUFHOFEsR0FBRTlRLEVBQUMsRUFBT2pFLEdBQUUsb0JBQW9Cb1EsR0FBRXRKLEVBQUMsRUFBRyxFQUFFLEdBQUc3QyxFQUFDLEVBQUUsWUFBWTZDLEdBQUU5RyxHQUFFLGlCQUFpQm9RLEdBQUV0SixFQUFDLENBQUMsTUFBTSxHQUFHN0MsR0FBRSx1QkFBdUIsQ0FBRSxDQUFDLFNBQVMsR0FBR0EsR0FBRSxDQUFDLEdBQUcsQ0FBQyxHQUFHQSxFQUFDLEVBQUcsVUFBR0EsRUFBQyxFQUFFLGVBQWUsUUFBZSxFQUFLLENBQUMsU0FBUyxHQUFHQSxHQUFFLENBQUMsT0FBTyxHQUFHQSxFQUFDLEVBQUUsZ0JBQWdCLElBQUksQ0FBQyxTQUFTLEdBQUdBLEdBQUU4USxHQUFFM0UsR0FBRTZFLEdBQUUsQ0FBQyxJQUFJalYsR0FBRSxVQUFVLENBQUtvUSxHQUFFLFNBQVFBLEdBQUUsT0FBTyxHQUFLMkUsR0FBRTlRLEVBQUMsRUFBRSxFQUFLZ1IsR0FBRSxFQUFHLFdBQVdqVixHQUFFaVYsRUFBQyxFQUFPalYsR0FBRyxDQUFDLENBQUMsU0FBUyxHQUFHK1UsR0FBRS9VLEdBQUVpRSxHQUFFLENBQUMsSUFBSTZDLEdBQUUsR0FBTSxVQUFHLEVBQUUsU0FBU3NKLEdBQUUsQ0FBQyxHQUFHLEVBQUUyRSxHQUFFLE1BQU0zRSxFQUFDLEVBQUUsQ0FBQyxJQUFJNkUsR0FBRSxHQUFHRixHQUFFLE1BQU0zRSxFQUFDLEVBQUV0SixHQUFFLEdBQUs5RyxHQUFFLEtBQUtpVixHQUFFalYsR0FBRSxLQUFLb1EsR0FBRW5NLEdBQUUsUUFBUSxTQUFTQSxHQUFFLENBQUMsR0FBRzhRLEdBQUU5USxHQUFFakUsR0FBRSxTQUFTaUUsR0FBRThRLEdBQUUsQ0FBQyxHQUFHLEVBQUU5USxHQUFFLEVBQUUsT0FBTyxlQUFlLEVBQUUsQ0FBQyxFQUFFQSxFQUFDLEVBQUUsTUFBTSxDQUFDLEdBQUdtTSxHQUFFNkUsR0FBRWhSLEdBQUU4USxFQUFDLENBQUMsQ0FBQyxDQUFDLENBQUMsQ0FBQyxDQUFDLENBQUMsRUFBU2pPLEVBQUMsQ0FBQyxTQUFTLEdBQUdtTyxHQUFFaFIsR0FBRThRLEdBQUUzRSxHQUFFLENBQUMsR0FBR25NLEdBQUUsU0FBVSxHQUFHZ1IsR0FBRTdFLEdBQUVuTSxHQUFFLFFBQVEsVUFBVUEsR0FBRSxVQUFVLFdBQVksR0FBRSxFQUFHLEdBQUdnUixHQUFFN0UsR0FBRTJFLEdBQUU5USxFQUFDLEVBQUUsR0FBR2dSLEVBQUMsVUFBVWhSLEdBQUUsVUFBVSxZQUFZLENBQUMsSUFBSWpFLEdBQUUsQ0FBQSxFQUFNaUUsR0FBRSxPQUFNakUsR0FBRSxLQUFLLEdBQUdpVixHQUFFaFIsR0FBRSxJQUFJLEdBQUtBLEdBQUUsWUFBV2pFLEdBQUUsVUFBVSxXQUFXaUUsR0FBRSxTQUFTLEdBQUUsSUFBSTZDLEdBQUUsSUFBSSxxQkFBcUIsU0FBUzdDLEdBQUUsQ0FBQyxRQUFROFEsR0FBRSxFQUFFQSxHQUFFOVEsR0FBRSxPQUFPOFEsS0FBSSxDQUFDLElBQUkzRSxHQUFFbk0sR0FBRThRLEVBQUMsRUFBRSxHQUFHM0UsR0FBRSxlQUFlLENBQUMsR0FBRzZFLEdBQUUsV0FBVyxFQUFFLEtBQUssQ0FBQyxDQUFDLEVBQUVqVixFQUFDLEVBQUU4RyxHQUFFLFFBQVFtTyxFQUFDLEVBQUUsR0FBR0EsR0FBRTdFLEdBQUUyRSxHQUFFOVEsRUFBQyxDQUFDLE1BQVNBLEdBQUUsVUFBVSxPQUFZLEdBQUdBLEdBQUVnUixHQUFFLEdBQUcsT0FBTyxDQUFDLElBQUlBLEVBQUMsQ0FBQyxDQUFDLEdBQUcsR0FBR0EsR0FBRTdFLEdBQUUyRSxHQUFFOVEsR0FBRSxLQUFLLEVBQVdBLEdBQUUsYUFBYSxHQUFHOFEsR0FBRSxRQUFRLEdBQUssR0FBR0UsR0FBRTdFLEdBQUVuTSxFQUFDLEdBQU8sR0FBR2dSLEdBQUU3RSxHQUFFMkUsR0FBRTlRLEVBQUMsQ0FBRSxDQUFDLFNBQVMsR0FBR0EsR0FBRSxDQUFDLEdBQUcsQ0FBQ0EsR0FBRSxjQUFjLEVBQUUsT0FBTyxrQkFBa0JBLEdBQUUsT0FBTyxtQkFBbUJBLEdBQUUsT0FBTyxVQUFVQSxHQUFFLE9BQU8sSUFBSSxDQUFDLElBQUk4USxHQUFFLEdBQUksRUFBQyxjQUFjLFFBQVEsRUFBRSxHQUFHOVEsR0FBRSxXQUFXLFNBQVNBLEdBQUUsQ0FBQzhRLEdBQUUsYUFBYTlRLEdBQUUsS0FBS0EsR0FBRSxLQUFLLENBQUMsQ0FBQyxFQUFFOFEsR0FBRSxZQUFZOVEsR0FBRSxZQUFZOFEsR0FBRSxNQUFNLEdBQVMsRUFBRSxPQUFPLG9CQUFtQkEsR0FBRSxNQUFNLEVBQUUsT0FBTyxtQkFBa0IsSUFBSTNFLEdBQUVuTSxHQUFFLGNBQWMsR0FBRyxDQUFDbU0sR0FBRSxhQUFhMkUsR0FBRTlRLEVBQUMsQ0FBQyxPQUFPQSxHQUFFLENBQUMsRUFBRUEsRUFBQyxDQUFDLFFBQUMsQ0FBV0EsR0FBRSxlQUFlQSxHQUFFLGNBQWMsWUFBWUEsRUFBQyxDQUFFLENBQUMsQ0FBQyxDQUFDLFNBQVMsR0FBR0EsR0FBRSxDQUFJLEVBQUVBLEdBQUUsUUFBUSxHQUFHLEdBQUdBLEVBQUMsRUFBRSxHQUFHLEVBQUVBLEdBQUUsUUFBUSxFQUFFLFNBQVNBLEdBQUUsQ0FBQyxHQUFHQSxFQUFDLENBQUMsQ0FBQyxDQUFDLENBQUMsU0FBUyxHQUFHQSxHQUFFLENBQW9CLFFBQWY4USxHQUFFOVEsR0FBRSxXQUFtQm1NLEdBQUUsRUFBRUEsR0FBRTJFLEdBQUUsT0FBTzNFLEtBQUksQ0FBQyxJQUFJNkUsR0FBRUYsR0FBRTNFLEVBQUMsRUFBRSxLQUFLLEdBQUcsRUFBRTZFLEdBQUUsUUFBUSxHQUFHLEVBQUVBLEdBQUUsYUFBYSxHQUFHLEVBQUVBLEdBQUUsUUFBUSxHQUFHLEVBQUVBLEdBQUUsYUFBYSxFQUFHLE1BQU8sRUFBSyxDQUFDLE1BQU8sRUFBSyxDQUFDLFNBQVMsR0FBR2hSLEdBQUUsQ0FBQyxJQUFJOFEsR0FBRSxLQUFTM0UsR0FBRSxDQUFBLEVBQXVCLEdBQWpCLEdBQUduTSxFQUFDLEdBQUdtTSxHQUFFLEtBQUtuTSxFQUFDLEVBQUssU0FBUyxTQUFpTSxRQUFuTGdSLEdBQUUsU0FBUyxTQUFTLHlKQUE0SmhSLEVBQUMsRUFBUThRLEdBQUVFLEdBQUUsWUFBYSxHQUFDN0UsR0FBRSxLQUFLMkUsRUFBQyxNQUF5QyxTQUE5Qi9VLEdBQUVpRSxHQUFFLHFCQUFxQixHQUFHLEVBQVU2QyxHQUFFLEVBQUVBLEdBQUU5RyxHQUFFLE9BQU84RyxLQUFRLEdBQUc5RyxHQUFFOEcsRUFBQyxDQUFDLEdBQUdzSixHQUFFLEtBQUtwUSxHQUFFOEcsRUFBQyxDQUFDLEVBQUksT0FBT3NKLEVBQUMsQ0FBQyxTQUFTLEdBQUduTSxHQUFFLENBQUMsR0FBR0EsR0FBRSxpQkFBaUIsQ0FBQyxJQUFJOFEsR0FBRSxtRUFBdUUzRSxHQUFFbk0sR0FBRSxpQkFBaUIsRUFBRThRLEdBQUUsMEpBQTZKLEVBQUUsT0FBTzNFLEVBQUMsS0FBTSxPQUFNLENBQUUsQ0FBQyxDQUFDLFNBQVMsR0FBR25NLEdBQUUsQ0FBQyxJQUFJOFEsR0FBRSxFQUFFOVEsR0FBRSxPQUFPLDhCQUE4QixFQUFNbU0sR0FBRSxHQUFHbk0sRUFBQyxFQUFLbU0sS0FBR0EsR0FBRSxrQkFBa0IyRSxHQUFFLENBQUMsU0FBUyxHQUFHOVEsR0FBRSxDQUFDLElBQUk4USxHQUFFLEdBQUc5USxFQUFDLEVBQUs4USxLQUFHQSxHQUFFLGtCQUFrQixLQUFLLENBQUMsU0FBUyxHQUFHOVEsR0FBRSxDQUFDLElBQUk4USxHQUFFLEVBQUU5USxHQUFFLE9BQU8sOEJBQThCLEVBQUUsR0FBSThRLEdBQVUsS0FBSTNFLEdBQUUsRUFBRSxJQUFJLEdBQUcyRSxHQUFFLE1BQU0sQ0FBQyxHQUFHLEVBQUVBLEdBQUUsTUFBTSxFQUFFLEdBQUkzRSxHQUFVLE9BQU8sR0FBR0EsRUFBQyxFQUFDLENBQUMsU0FBUyxHQUFHbk0sR0FBRSxDQUFDQSxHQUFFLGlCQUFpQixRQUFRLEVBQUUsRUFBRUEsR0FBRSxpQkFBaUIsVUFBVSxFQUFFLEVBQUVBLEdBQUUsaUJBQWlCLFdBQVcsRUFBRSxDQUFDLENBQUMsU0FBUyxHQUFHQSxHQUFFLENBQXFCLFFBQWhCOFEsR0FBRSxHQUFHOVEsRUFBQyxFQUFNbU0sR0FBRSxFQUFVNkUsR0FBRSxFQUFFQSxHQUFFRixHQUFFLE9BQU9FLEtBQUksQ0FBQyxNQUFNalYsR0FBRStVLEdBQUVFLEVBQUMsRUFBS2pWLEtBQUksSUFBS29RLEtBQVlwUSxLQUFJLEtBQUtvUSxJQUFJLENBQUMsT0FBT0EsRUFBQyxDQUFDLFNBQVMsR0FBRzJFLEdBQUU5USxHQUFFbU0sR0FBRSxDQUFDLElBQUk2RSxHQUFFLEdBQUdGLEVBQUMsRUFBTSxNQUFNLFFBQVFFLEdBQUUsVUFBVSxJQUFHQSxHQUFFLFdBQVcsSUFBRyxJQUFJalYsR0FBTThHLEdBQUUsU0FBUzdDLEdBQUUsQ0FBQyxPQUFPLEdBQUc4USxHQUFFLFVBQVUsQ0FBSy9VLEtBQUdBLEdBQUUsSUFBSSxTQUFTLFFBQVFvUSxFQUFDLEdBQUVwUSxHQUFFLEtBQUsrVSxHQUFFOVEsRUFBQyxDQUFDLENBQUMsQ0FBQyxFQUFFOFEsR0FBRSxpQkFBaUI5USxHQUFFNkMsRUFBQyxFQUFFbU8sR0FBRSxXQUFXLEtBQUssQ0FBQyxNQUFNaFIsR0FBRSxTQUFTNkMsRUFBQyxDQUFDLENBQUMsQ0FBQyxTQUFTLEdBQUc3QyxHQUFFLENBQUMsSUFBSThRLEdBQUUsR0FBRzlRLEdBQUUsT0FBTyxFQUFFLEdBQUc4USxHQUFFLENBQWlELFFBQTVDM0UsR0FBRSxDQUFBLEVBQU82RSxHQUFFRixHQUFFLE1BQU07QUFBQSxDQUFJLEVBQU0vVSxHQUFFLEtBQVM4RyxHQUFFLEVBQVFtTyxHQUFFLE9BQU8sR0FBRSxDQUFDLElBQUlDLEdBQUVELEdBQUUsTUFBTyxFQUFLRSxHQUFFRCxHQUFFLE1BQU0sMkJBQTJCLEVBQUtwTyxLQUFJLEdBQUdxTyxJQUFHRCxHQUFFLE1BQU0sR0FBRyxFQUFFbFYsR0FBRW1WLEdBQUUsQ0FBQyxFQUFFLE1BQU0sRUFBRSxFQUFFLEVBQUUvRSxHQUFFcFEsRUFBQyxFQUFFbVYsR0FBRSxDQUFDLEdBQU8vRSxHQUFFcFEsRUFBQyxHQUFHa1YsR0FBRXBPLElBQUcsR0FBR29PLEVBQUMsQ0FBQyxDQUFDLFFBQVE5RyxNQUFLZ0MsR0FBRyxHQUFHbk0sR0FBRW1LLEdBQUVnQyxHQUFFaEMsRUFBQyxDQUFDLENBQUUsQ0FBQyxDQUFDLFNBQVMsR0FBR25LLEdBQUUsQ0FBQyxHQUFHQSxFQUFDLEVBQUUsUUFBUThRLEdBQUUsRUFBRUEsR0FBRTlRLEdBQUUsV0FBVyxPQUFPOFEsS0FBSSxDQUFDLElBQUkzRSxHQUFFbk0sR0FBRSxXQUFXOFEsRUFBQyxFQUFFLEtBQVNFLEdBQUVoUixHQUFFLFdBQVc4USxFQUFDLEVBQUUsTUFBTSxHQUFHLEVBQUUzRSxHQUFFLE9BQU8sR0FBRyxFQUFFQSxHQUFFLFlBQVksRUFBRSxDQUFDLElBQUlwUSxHQUFFb1EsR0FBRSxRQUFRLEtBQUssRUFBRSxFQUFNdEosR0FBRXNKLEdBQUUsTUFBTXBRLEdBQUVBLEdBQUUsQ0FBQyxFQUFFLEdBQUc4RyxLQUFJLEtBQUtBLEtBQUksSUFBSSxDQUFDLElBQUlvTyxHQUFFOUUsR0FBRSxNQUFNcFEsR0FBRSxDQUFDLEVBQUssRUFBRWtWLEdBQUUsR0FBRyxFQUFHQSxHQUFFLE9BQU9BLEdBQVUsRUFBRUEsR0FBRSxHQUFHLEVBQUdBLEdBQUUsUUFBUUEsR0FBRSxNQUFNLENBQUMsRUFBVSxFQUFFQSxHQUFFLE9BQU8sSUFBR0EsR0FBRSxRQUFRQSxHQUFFLE1BQU0sQ0FBQyxHQUFFLEdBQUdqUixHQUFFaVIsR0FBRUQsRUFBQyxDQUFDLENBQUMsQ0FBQyxDQUFDLENBQUMsU0FBUyxHQUFHRixHQUFFLENBQUMsR0FBRyxFQUFFQSxHQUFFLEVBQUUsT0FBTyxlQUFlLEVBQUUsQ0FBQyxFQUFFQSxFQUFDLEVBQUUsTUFBTSxDQUFDLElBQUkzRSxHQUFFLEdBQUcyRSxFQUFDLEVBQUUsR0FBRzNFLEdBQUUsV0FBVyxHQUFHMkUsRUFBQyxFQUFFLENBQUMsR0FBR0EsRUFBQyxFQUFFM0UsR0FBRSxTQUFTLEdBQUcyRSxFQUFDLEVBQUUsR0FBR0EsRUFBQyxFQUFFLEdBQUdBLEdBQUUsd0JBQXdCLEVBQUtBLEdBQUUsUUFBTzNFLEdBQUUsVUFBVTJFLEdBQUUsT0FBTSxJQUFJOVEsR0FBRSxHQUFHOFEsRUFBQyxFQUFNRSxHQUFFLEdBQUdGLEdBQUUzRSxHQUFFbk0sRUFBQyxFQUFNZ1IsS0FBTSxHQUFHRixHQUFFLFVBQVUsSUFBSSxPQUFRLEdBQUdBLEdBQUUzRSxHQUFFbk0sRUFBQyxFQUFVLEVBQUU4USxHQUFFLFlBQVksR0FBRzlRLEdBQUUsUUFBUSxTQUFTQSxHQUFFLENBQUMsR0FBRzhRLEdBQUU5USxHQUFFbU0sR0FBRSxVQUFVLEVBQUUsQ0FBQyxDQUFDLElBQU0yRSxHQUFFLFVBQVUsUUFBUSxHQUFHQSxHQUFFLE1BQU0sSUFBSSxVQUFVLEVBQUVBLEdBQUUsTUFBTSxJQUFHLEdBQUdBLEVBQUMsRUFBRSxJQUFJL1UsR0FBRSxHQUFHK1UsR0FBRSxRQUFRLEVBQUsvVSxJQUFHLEdBQUcrVSxHQUFFM0UsR0FBRXBRLEVBQUMsRUFBRSxJQUFJOEcsR0FBRSxHQUFHaU8sR0FBRSxPQUFPLEVBQUtqTyxJQUFHLEdBQUdpTyxHQUFFM0UsR0FBRXRKLEVBQUMsRUFBRSxHQUFHaU8sR0FBRSx1QkFBdUIsQ0FBQyxDQUFDLENBQUMsU0FBUyxHQUFHOVEsR0FBRSxDQUFRLEdBQVBBLEdBQUUsRUFBRUEsRUFBQyxFQUFLLEVBQUVBLEdBQUUsRUFBRSxPQUFPLGVBQWUsRUFBRSxDQUFDLEVBQUVBLEVBQUMsRUFBRSxNQUFNLENBQUMsR0FBR0EsRUFBQyxFQUFFLEdBQUcsR0FBR0EsRUFBQyxFQUFFLFNBQVNBLEdBQUUsQ0FBQyxHQUFHQSxFQUFDLENBQUMsQ0FBQyxFQUFFLEdBQUcsR0FBR0EsRUFBQyxFQUFFLEVBQUUsQ0FBQyxDQUFDLFNBQVMsR0FBR0EsR0FBRSxDQUFDLE9BQU9BLEdBQUUsUUFBUSxxQkFBcUIsT0FBTyxFQUFFLFlBQWEsQ0FBQSxDQUFDLFNBQVMsR0FBR0EsR0FBRThRLEdBQUUsQ0FBQyxJQUFJM0UsR0FBRSxPQUFHLE9BQU8sYUFBYSxPQUFPLE9BQU8sYUFBYyxXQUFZQSxHQUFFLElBQUksWUFBWW5NLEdBQUUsQ0FBQyxRQUFRLEdBQUssV0FBVyxHQUFLLE9BQU84USxFQUFDLENBQUMsR0FBTzNFLEdBQUUsR0FBRSxFQUFHLFlBQVksYUFBYSxFQUFFQSxHQUFFLGdCQUFnQm5NLEdBQUUsR0FBSyxHQUFLOFEsRUFBQyxHQUFTM0UsRUFBQyxDQUFDLFNBQVMsR0FBR25NLEdBQUU4USxHQUFFM0UsR0FBRSxDQUFDLEdBQUduTSxHQUFFOFEsR0FBRSxHQUFHLENBQUMsTUFBTUEsRUFBQyxFQUFFM0UsRUFBQyxDQUFDLENBQUMsQ0FBQyxTQUFTLEdBQUduTSxHQUFFLENBQUMsT0FBT0EsS0FBSSx1QkFBdUIsQ0FBQyxTQUFTLEVBQUVBLEdBQUU4USxHQUFFLENBQUMsR0FBRyxHQUFHOVEsRUFBQyxFQUFFLFNBQVNBLEdBQUUsQ0FBQyxHQUFHLENBQUM4USxHQUFFOVEsRUFBQyxDQUFDLE9BQU9BLEdBQUUsQ0FBQyxFQUFFQSxFQUFDLENBQUMsQ0FBQyxDQUFDLENBQUMsQ0FBQyxTQUFTLEVBQUVBLEdBQUUsQ0FBSSxRQUFRLE1BQU8sUUFBUSxNQUFNQSxFQUFDLEVBQVUsUUFBUSxLQUFLLFFBQVEsSUFBSSxVQUFVQSxFQUFDLENBQUUsQ0FBQyxTQUFTLEdBQUdBLEdBQUU4USxHQUFFM0UsR0FBRSxDQUFDbk0sR0FBRSxFQUFFQSxFQUFDLEVBQUttTSxJQUFHLE9BQU1BLEdBQUUsQ0FBRSxHQUFDQSxHQUFFLElBQU9uTSxHQUFFLElBQUlnUixHQUFFLEdBQUdGLEdBQUUzRSxFQUFDLEVBQUssRUFBRSxRQUFRLENBQUMsR0FBRzJFLEVBQUMsR0FBRyxFQUFFLE9BQU85USxHQUFFOFEsR0FBRTNFLEVBQUMsRUFBS0EsR0FBRSxRQUFPLEVBQUVBLEdBQUUsS0FBSyxFQUFFLEdBQUduTSxHQUFFLGFBQWEsQ0FBQyxVQUFVbU0sRUFBQyxDQUFDLEdBQUUsSUFBSXBRLEdBQUVpRSxHQUFFLGNBQWNnUixFQUFDLEVBQU1uTyxHQUFFLEdBQUdpTyxFQUFDLEVBQUUsR0FBRy9VLElBQUc4RyxLQUFJaU8sR0FBRSxDQUFDLElBQUlHLEdBQUUsR0FBR3BPLEdBQUVtTyxHQUFFLE1BQU0sRUFBRWpWLEdBQUVBLElBQUdpRSxHQUFFLGNBQWNpUixFQUFDLENBQUMsQ0FBQyxTQUFFalIsR0FBRSxTQUFTQSxHQUFFLENBQUNqRSxHQUFFQSxJQUFJaUUsR0FBRSxRQUFROFEsR0FBRUUsRUFBQyxJQUFJLElBQU8sQ0FBQ0EsR0FBRSxnQkFBaUIsQ0FBQyxFQUFTalYsRUFBQyxDQUFDLElBQUksR0FBRyxTQUFTLFNBQVMsU0FBUyxPQUFPLFNBQVMsSUFBSSxDQUFDLElBQUlpRSxHQUFFLEdBQUUsRUFBRyxjQUFjLHdDQUF3QyxFQUFFLE9BQU9BLElBQUcsR0FBSSxFQUFDLElBQUksQ0FBQyxTQUFTLEdBQUdBLEdBQUU4USxHQUFFM0UsR0FBRTZFLEdBQUUsQ0FBQyxHQUFJLEVBQUcsRUFBUyxJQUFHLEVBQUUsT0FBTyxrQkFBa0IsRUFBRSxDQUFDLGFBQWEsV0FBVyxvQkFBb0IsRUFBRSxNQUFNLENBQUNoUixHQUFFLEVBQUVBLEVBQUMsRUFBMEQsUUFBcERqRSxHQUFFLEVBQUUsYUFBYSxRQUFRLG9CQUFvQixDQUFDLEdBQUcsQ0FBQSxFQUFXOEcsR0FBRSxFQUFFQSxHQUFFOUcsR0FBRSxPQUFPOEcsS0FBSyxHQUFHOUcsR0FBRThHLEVBQUMsRUFBRSxNQUFNN0MsR0FBRSxDQUFDakUsR0FBRSxPQUFPOEcsR0FBRSxDQUFDLEVBQUUsS0FBSyxDQUFFLElBQUlvTyxHQUFFLENBQUMsSUFBSWpSLEdBQUUsUUFBUThRLEdBQUUsTUFBTTNFLEdBQUUsT0FBTzZFLEVBQUMsRUFBcUUsSUFBbkUsR0FBRyxHQUFFLEVBQUcsS0FBSywwQkFBMEIsQ0FBQyxLQUFLQyxHQUFFLE1BQU1sVixFQUFDLENBQUMsRUFBRUEsR0FBRSxLQUFLa1YsRUFBQyxFQUFRbFYsR0FBRSxPQUFPLEVBQUUsT0FBTyxrQkFBa0JBLEdBQUUsTUFBSyxFQUFHLEtBQU1BLEdBQUUsT0FBTyxHQUFHLEdBQUcsQ0FBQyxhQUFhLFFBQVEscUJBQXFCLEtBQUssVUFBVUEsRUFBQyxDQUFDLEVBQUUsS0FBSyxPQUFPaUUsR0FBRSxDQUFDLEdBQUcsR0FBRSxFQUFHLEtBQUsseUJBQXlCLENBQUMsTUFBTUEsR0FBRSxNQUFNakUsRUFBQyxDQUFDLEVBQUVBLEdBQUUsTUFBSyxDQUFFLEVBQUUsQ0FBQyxTQUFTLEdBQUdpRSxHQUFFLENBQUMsR0FBRyxDQUFDLEVBQUcsRUFBRSxPQUFPLEtBQUtBLEdBQUUsRUFBRUEsRUFBQyxFQUEwRCxRQUFwRDhRLEdBQUUsRUFBRSxhQUFhLFFBQVEsb0JBQW9CLENBQUMsR0FBRyxHQUFXM0UsR0FBRSxFQUFFQSxHQUFFMkUsR0FBRSxPQUFPM0UsS0FBSyxHQUFHMkUsR0FBRTNFLEVBQUMsRUFBRSxNQUFNbk0sR0FBRyxPQUFPOFEsR0FBRTNFLEVBQUMsRUFBRyxPQUFPLElBQUksQ0FBQyxTQUFTLEdBQUduTSxHQUFFLENBQUMsSUFBSThRLEdBQUUsRUFBRSxPQUFPLGFBQWlCM0UsR0FBRW5NLEdBQUUsVUFBVSxFQUFJLEVBQUUsVUFBRyxFQUFFbU0sR0FBRSxJQUFJMkUsRUFBQyxFQUFFLFNBQVM5USxHQUFFLENBQUMsRUFBRUEsR0FBRThRLEVBQUMsQ0FBQyxDQUFDLEVBQVMzRSxHQUFFLFNBQVMsQ0FBQyxTQUFTLElBQUksQ0FBQyxJQUFJbk0sR0FBRSxLQUFTOFEsR0FBRSxJQUFJLFNBQVMsU0FBUyxTQUFTLE9BQVczRSxHQUFFLEdBQUcsQ0FBQ0EsR0FBRSxHQUFJLEVBQUMsY0FBYyxvREFBb0QsQ0FBQyxNQUFTLENBQUNBLEdBQUUsR0FBSSxFQUFDLGNBQWMsZ0RBQWdELENBQUMsQ0FBS0EsS0FBRyxHQUFHLEdBQUUsRUFBRyxLQUFLLHlCQUF5QixDQUFDLEtBQUsyRSxHQUFFLFdBQVc5USxFQUFDLENBQUMsRUFBRSxHQUFHOFEsR0FBRSxHQUFHOVEsRUFBQyxFQUFFLEdBQUUsRUFBRyxNQUFNLE9BQU8sT0FBTyxHQUFLLEVBQUUsT0FBTyxnQkFBZSxRQUFRLGFBQWEsQ0FBQyxLQUFLLEVBQUksRUFBRSxLQUFLLE1BQU0sT0FBTyxTQUFTLElBQUksQ0FBQyxDQUFDLFNBQVMsR0FBR0EsR0FBRSxDQUFJLEVBQUUsT0FBTyxzQkFBcUJBLEdBQUVBLEdBQUUsUUFBUSxrQ0FBa0MsRUFBRSxHQUFLLEVBQUVBLEdBQUUsR0FBRyxHQUFHLEVBQUVBLEdBQUUsR0FBRyxLQUFHQSxHQUFFQSxHQUFFLE1BQU0sRUFBRSxFQUFFLElBQU0sRUFBRSxPQUFPLGdCQUFnQixRQUFRLFVBQVUsQ0FBQyxLQUFLLEVBQUksRUFBRSxHQUFHQSxFQUFDLEVBQUUsR0FBR0EsRUFBQyxDQUFDLFNBQVMsR0FBR0EsR0FBRSxDQUFJLEVBQUUsT0FBTyxnQkFBZSxRQUFRLGFBQWEsQ0FBQyxLQUFLLEVBQUksRUFBRSxHQUFHQSxFQUFDLEVBQUUsR0FBR0EsRUFBQyxDQUFDLFNBQVMsR0FBR0EsR0FBRSxDQUFDLEdBQUdBLEdBQUUsU0FBU0EsR0FBRSxDQUFDQSxHQUFFLEtBQU0sQ0FBQSxDQUFDLENBQUMsQ0FBQyxTQUFTLEdBQUc2QyxHQUFFLENBQUMsSUFBSTdDLEdBQUUsSUFBSSxlQUFtQmlSLEdBQUUsQ0FBQyxLQUFLcE8sR0FBRSxJQUFJN0MsRUFBQyxFQUFFLEdBQUcsS0FBSyxLQUFLLHdCQUF3QmlSLEVBQUMsRUFBRWpSLEdBQUUsS0FBSyxNQUFNNkMsR0FBRSxFQUFJLEVBQUU3QyxHQUFFLGlCQUFpQixhQUFhLE1BQU0sRUFBRUEsR0FBRSxpQkFBaUIsNkJBQTZCLE1BQU0sRUFBRUEsR0FBRSxpQkFBaUIsaUJBQWlCLEdBQUksRUFBQyxTQUFTLElBQUksRUFBRUEsR0FBRSxPQUFPLFVBQVUsQ0FBQyxHQUFHLEtBQUssUUFBUSxLQUFLLEtBQUssT0FBTyxJQUFJLENBQUMsR0FBRyxHQUFJLEVBQUMsS0FBSyw0QkFBNEJpUixFQUFDLEVBQUUsSUFBSWpSLEdBQUUsRUFBRSxLQUFLLFFBQVEsRUFBRUEsR0FBRUEsR0FBRSxjQUFjLHdDQUF3QyxHQUFHQSxHQUFFLElBQUk4USxHQUFFLEdBQUksRUFBSzNFLEdBQUUsRUFBRTJFLEVBQUMsRUFBTUUsR0FBRSxHQUFHLEtBQUssUUFBUSxFQUFFLEdBQUdBLEdBQUUsQ0FBQyxJQUFJalYsR0FBRSxFQUFFLE9BQU8sRUFBS0EsR0FBR0EsR0FBRSxVQUFVaVYsR0FBTyxPQUFPLFNBQVMsTUFBTUEsRUFBRSxDQUFDLEdBQUdGLEdBQUU5USxHQUFFbU0sRUFBQyxFQUFFLEdBQUdBLEdBQUUsS0FBSyxFQUFFLEdBQUd0SixHQUFFLEdBQUcsR0FBRSxFQUFHLEtBQUssc0JBQXNCLENBQUMsS0FBS0EsR0FBRSxVQUFVLEdBQUssZUFBZSxLQUFLLFFBQVEsQ0FBQyxDQUFDLE1BQU0sR0FBRyxHQUFJLEVBQUMsS0FBSyxpQ0FBaUNvTyxFQUFDLENBQUUsRUFBRWpSLEdBQUUsS0FBTSxDQUFBLENBQUMsU0FBUyxHQUFHQSxHQUFFLENBQUMsS0FBS0EsR0FBRUEsSUFBRyxTQUFTLFNBQVMsU0FBUyxPQUFPLElBQUk4USxHQUFFLEdBQUc5USxFQUFDLEVBQUUsR0FBRzhRLEdBQUUsQ0FBQyxJQUFJM0UsR0FBRSxFQUFFMkUsR0FBRSxPQUFPLEVBQU1FLEdBQUUsR0FBSSxFQUFLalYsR0FBRSxFQUFFaVYsRUFBQyxFQUFFLEdBQUdBLEdBQUU3RSxHQUFFcFEsRUFBQyxFQUFFLEdBQUdBLEdBQUUsS0FBSyxFQUFFLFNBQVMsTUFBTStVLEdBQUUsTUFBTSxXQUFXLFVBQVUsQ0FBQyxPQUFPLFNBQVMsRUFBRUEsR0FBRSxNQUFNLENBQUMsRUFBRSxDQUFDLEVBQUUsR0FBRzlRLEdBQUUsR0FBRyxHQUFFLEVBQUcsS0FBSyxzQkFBc0IsQ0FBQyxLQUFLQSxHQUFFLEtBQUs4USxFQUFDLENBQUMsQ0FBQyxNQUFTLEVBQUUsT0FBTyxxQkFBc0IsT0FBTyxTQUFTLE9BQU8sRUFBSSxFQUFPLEdBQUc5USxFQUFDLENBQUcsQ0FBQyxTQUFTLEdBQUdBLEdBQUUsQ0FBQyxJQUFJOFEsR0FBRSxHQUFHOVEsR0FBRSxjQUFjLEVBQUUsT0FBRzhRLElBQUcsT0FBTUEsR0FBRSxDQUFDOVEsRUFBQyxHQUFFLEdBQUc4USxHQUFFLFNBQVM5USxHQUFFLENBQUMsSUFBSThRLEdBQUUsR0FBRzlRLEVBQUMsRUFBRThRLEdBQUUsY0FBY0EsR0FBRSxjQUFjLEdBQUcsRUFBRTlRLEdBQUUsVUFBVSxJQUFPLEtBQUtBLEdBQUUsVUFBVSxFQUFFLE9BQU8sWUFBWSxDQUFDLENBQUMsRUFBUzhRLEVBQUMsQ0FBQyxTQUFTLEdBQUc5USxHQUFFLENBQUMsSUFBSThRLEdBQUUsR0FBRzlRLEdBQUUsaUJBQWlCLEVBQUUsT0FBRzhRLElBQUcsT0FBTUEsR0FBRSxDQUFBLEdBQUcsR0FBR0EsR0FBRSxTQUFTOVEsR0FBRSxDQUFDLElBQUk4USxHQUFFLEdBQUc5USxFQUFDLEVBQUU4USxHQUFFLGNBQWNBLEdBQUUsY0FBYyxHQUFHLEVBQUU5USxHQUFFLGFBQWEsV0FBVyxFQUFFLENBQUMsQ0FBQyxFQUFTOFEsRUFBQyxDQUFDLFNBQVMsR0FBRzlRLEdBQUU4USxHQUFFLENBQUMsR0FBRzlRLEdBQUUsU0FBU0EsR0FBRSxDQUFDLElBQUk4USxHQUFFLEdBQUc5USxFQUFDLEVBQUU4USxHQUFFLGNBQWNBLEdBQUUsY0FBYyxHQUFHLEVBQUtBLEdBQUUsZUFBZSxHQUFHOVEsR0FBRSxVQUFVLE9BQVUsS0FBS0EsR0FBRSxVQUFVLEVBQUUsT0FBTyxZQUFZLENBQUUsQ0FBQyxFQUFFLEdBQUc4USxHQUFFLFNBQVM5USxHQUFFLENBQUMsSUFBSThRLEdBQUUsR0FBRzlRLEVBQUMsRUFBRThRLEdBQUUsY0FBY0EsR0FBRSxjQUFjLEdBQUcsRUFBS0EsR0FBRSxlQUFlLEdBQUc5USxHQUFFLGdCQUFnQixVQUFVLENBQUUsQ0FBQyxDQUFDLENBQUMsU0FBUyxHQUFHQSxHQUFFOFEsR0FBRSxDQUFDLFFBQVEzRSxHQUFFLEVBQUVBLEdBQUVuTSxHQUFFLE9BQU9tTSxLQUFJLENBQUMsSUFBSTZFLEdBQUVoUixHQUFFbU0sRUFBQyxFQUFFLEdBQUc2RSxHQUFFLFdBQVdGLEVBQUMsRUFBRyxNQUFPLEVBQUssQ0FBQyxNQUFPLEVBQUssQ0FBQyxTQUFTLEdBQUc5USxHQUFFLENBQW1GLE9BQS9FQSxHQUFFLE9BQU8sSUFBSUEsR0FBRSxNQUFNLE1BQU1BLEdBQUUsVUFBVSxFQUFFQSxHQUFFLG9CQUFvQixHQUFtQkEsR0FBRSxPQUFPLFVBQVVBLEdBQUUsT0FBTyxVQUFVQSxHQUFFLFVBQVUsU0FBU0EsR0FBRSxVQUFVLFNBQVNBLEdBQUUsVUFBVSxPQUFlLEdBQVNBLEdBQUUsT0FBTyxZQUFZQSxHQUFFLE9BQU8sUUFBZ0JBLEdBQUUsUUFBZSxFQUFJLENBQUMsU0FBUyxHQUFHQSxHQUFFOFEsR0FBRTNFLEdBQUUsQ0FBQyxHQUFHbk0sSUFBRyxNQUFNOFEsSUFBRyxLQUFLLENBQUMsSUFBSUUsR0FBRTdFLEdBQUVuTSxFQUFDLEVBQUtnUixLQUFJLE9BQVc3RSxHQUFFbk0sRUFBQyxFQUFFOFEsR0FBVSxNQUFNLFFBQVFFLEVBQUMsRUFBTSxNQUFNLFFBQVFGLEVBQUMsRUFBRzNFLEdBQUVuTSxFQUFDLEVBQUVnUixHQUFFLE9BQU9GLEVBQUMsRUFBT0UsR0FBRSxLQUFLRixFQUFDLEVBQVcsTUFBTSxRQUFRQSxFQUFDLEVBQUczRSxHQUFFbk0sRUFBQyxFQUFFLENBQUNnUixFQUFDLEVBQUUsT0FBT0YsRUFBQyxFQUFPM0UsR0FBRW5NLEVBQUMsRUFBRSxDQUFDZ1IsR0FBRUYsRUFBQyxDQUFHLENBQUMsQ0FBQyxTQUFTLEdBQUdBLEdBQUUzRSxHQUFFNkUsR0FBRWhSLEdBQUVqRSxHQUFFLENBQUMsR0FBRyxFQUFBaUUsSUFBRyxNQUFNLEdBQUc4USxHQUFFOVEsRUFBQyxHQUF5QixJQUFWOFEsR0FBRSxLQUFLOVEsRUFBQyxFQUFLLEdBQUdBLEVBQUMsRUFBRSxDQUFDLElBQUk2QyxHQUFFLEdBQUc3QyxHQUFFLE1BQU0sRUFBTWlSLEdBQUVqUixHQUFFLE1BQVNBLEdBQUUsVUFBVUEsR0FBRSxVQUFVLFdBQVVpUixHQUFFLEVBQUVqUixHQUFFLGlCQUFpQixnQkFBZ0IsQ0FBQyxFQUFFLElBQUksU0FBU0EsR0FBRSxDQUFDLE9BQU9BLEdBQUUsS0FBSyxDQUFDLEdBQUtBLEdBQUUsUUFBT2lSLEdBQUUsRUFBRWpSLEdBQUUsS0FBSyxHQUFFLEdBQUc2QyxHQUFFb08sR0FBRTlFLEVBQUMsRUFBS3BRLElBQUcsR0FBR2lFLEdBQUVnUixFQUFDLENBQUUsQ0FBQyxHQUFHLEVBQUVoUixHQUFFLE1BQU0sRUFBRSxDQUFDLElBQUlrUixHQUFFbFIsR0FBRSxTQUFTLEdBQUdrUixHQUFFLFNBQVNsUixHQUFFLENBQUMsR0FBRzhRLEdBQUUzRSxHQUFFNkUsR0FBRWhSLEdBQUVqRSxFQUFDLENBQUMsQ0FBQyxDQUFDLEVBQUMsQ0FBQyxTQUFTLEdBQUdpRSxHQUFFOFEsR0FBRSxDQUFJOVEsR0FBRSxlQUFjLEdBQUdBLEdBQUUsMEJBQTBCLEVBQU1BLEdBQUUsY0FBYSxJQUFJOFEsR0FBRSxLQUFLLENBQUMsSUFBSTlRLEdBQUUsUUFBUUEsR0FBRSxrQkFBa0IsU0FBU0EsR0FBRSxRQUFRLENBQUMsRUFBRSxHQUFHQSxHQUFFLHlCQUF5QixDQUFDLFFBQVFBLEdBQUUsa0JBQWtCLFNBQVNBLEdBQUUsUUFBUSxDQUFDLEdBQUcsQ0FBQyxTQUFTLEdBQUdBLEdBQUU4USxHQUFFLENBQUMsSUFBSTNFLEdBQUUsQ0FBQSxFQUFPNkUsR0FBRSxDQUFBLEVBQU9qVixHQUFFLENBQUEsRUFBTzhHLEdBQUUsQ0FBQSxFQUFPb08sR0FBRSxHQUFHalIsRUFBQyxFQUFLaVIsR0FBRSxtQkFBbUIsQ0FBQyxHQUFHQSxHQUFFLGlCQUFpQixJQUFHQSxHQUFFLGtCQUFrQixNQUFLLElBQUlDLEdBQUUsRUFBRWxSLEdBQUUsTUFBTSxHQUFHQSxHQUFFLGFBQWEsSUFBTSxHQUFHQSxHQUFFLGFBQWEsSUFBSSxPQUFrSSxHQUF4SGlSLEdBQUUsb0JBQW1CQyxHQUFFQSxJQUFHRCxHQUFFLGtCQUFrQixpQkFBaUIsSUFBUUgsS0FBSSxPQUFPLEdBQUczRSxHQUFFcFEsR0FBRThHLEdBQUUsRUFBRTdDLEdBQUUsTUFBTSxFQUFFa1IsRUFBQyxFQUFFLEdBQUcvRSxHQUFFNkUsR0FBRW5PLEdBQUU3QyxHQUFFa1IsRUFBQyxFQUFLRCxHQUFFLG1CQUFtQmpSLEdBQUUsVUFBVSxVQUFVQSxHQUFFLFVBQVUsU0FBUyxHQUFHQSxHQUFFLE1BQU0sSUFBSSxTQUFTLENBQUMsSUFBSW1LLEdBQUU4RyxHQUFFLG1CQUFtQmpSLEdBQU1tUixHQUFFLEdBQUdoSCxHQUFFLE1BQU0sRUFBRSxHQUFHZ0gsR0FBRWhILEdBQUUsTUFBTXBPLEVBQUMsQ0FBQyxDQUFDLElBQUlxVixHQUFFLEdBQUdwUixHQUFFLFlBQVksRUFBRSxVQUFHb1IsR0FBRSxTQUFTcFIsR0FBRSxDQUFDLEdBQUdtTSxHQUFFNkUsR0FBRW5PLEdBQUU3QyxHQUFFa1IsRUFBQyxFQUFNLEVBQUVsUixHQUFFLE1BQU0sR0FBRyxHQUFHQSxHQUFFLGlCQUFpQixFQUFFLEVBQUUsU0FBU0EsR0FBRSxDQUFDLEdBQUdtTSxHQUFFNkUsR0FBRW5PLEdBQUU3QyxHQUFFa1IsRUFBQyxDQUFDLENBQUMsQ0FBRSxDQUFDLEVBQUVGLEdBQUUsR0FBR0EsR0FBRWpWLEVBQUMsRUFBUSxDQUFDLE9BQU84RyxHQUFFLE9BQU9tTyxFQUFDLENBQUMsQ0FBQyxTQUFTLEdBQUdoUixHQUFFOFEsR0FBRTNFLEdBQUUsQ0FBSW5NLEtBQUksS0FBSUEsSUFBRyxLQUFPLE9BQU9tTSxFQUFDLElBQUksb0JBQW1CQSxHQUFFLEtBQUssVUFBVUEsRUFBQyxHQUFFLElBQUk2RSxHQUFFLG1CQUFtQjdFLEVBQUMsRUFBRSxPQUFBbk0sSUFBRyxtQkFBbUI4USxFQUFDLEVBQUUsSUFBSUUsR0FBU2hSLEVBQUMsQ0FBQyxTQUFTLEdBQUdBLEdBQUUsQ0FBQyxJQUFJOFEsR0FBRSxHQUFHLFFBQVEzRSxNQUFLbk0sR0FBRyxHQUFHQSxHQUFFLGVBQWVtTSxFQUFDLEVBQUUsQ0FBQyxJQUFJNkUsR0FBRWhSLEdBQUVtTSxFQUFDLEVBQUssTUFBTSxRQUFRNkUsRUFBQyxFQUFHLEdBQUdBLEdBQUUsU0FBU2hSLEdBQUUsQ0FBQzhRLEdBQUUsR0FBR0EsR0FBRTNFLEdBQUVuTSxFQUFDLENBQUMsQ0FBQyxFQUFPOFEsR0FBRSxHQUFHQSxHQUFFM0UsR0FBRTZFLEVBQUMsQ0FBRSxDQUFFLE9BQU9GLEVBQUMsQ0FBQyxTQUFTLEdBQUc5USxHQUFFLENBQUMsSUFBSThRLEdBQUUsSUFBSSxTQUFTLFFBQVEzRSxNQUFLbk0sR0FBRyxHQUFHQSxHQUFFLGVBQWVtTSxFQUFDLEVBQUUsQ0FBQyxJQUFJNkUsR0FBRWhSLEdBQUVtTSxFQUFDLEVBQUssTUFBTSxRQUFRNkUsRUFBQyxFQUFHLEdBQUdBLEdBQUUsU0FBU2hSLEdBQUUsQ0FBQzhRLEdBQUUsT0FBTzNFLEdBQUVuTSxFQUFDLENBQUMsQ0FBQyxFQUFPOFEsR0FBRSxPQUFPM0UsR0FBRTZFLEVBQUMsQ0FBRSxDQUFFLE9BQU9GLEVBQUMsQ0FBQyxTQUFTLEdBQUc5USxHQUFFOFEsR0FBRTNFLEdBQUUsQ0FBQyxJQUFJNkUsR0FBRSxDQUFDLGFBQWEsT0FBTyxhQUFhLEdBQUdoUixHQUFFLElBQUksRUFBRSxrQkFBa0IsR0FBR0EsR0FBRSxNQUFNLEVBQUUsWUFBWSxHQUFHOFEsR0FBRSxJQUFJLEVBQUUsaUJBQWlCLEdBQUksRUFBQyxTQUFTLElBQUksRUFBRSxVQUFHOVEsR0FBRSxhQUFhLEdBQU1nUixFQUFDLEVBQUs3RSxLQUFJLFNBQVc2RSxHQUFFLFdBQVcsRUFBRTdFLElBQUssR0FBR25NLEVBQUMsRUFBRSxVQUFTZ1IsR0FBRSxZQUFZLEVBQUUsUUFBY0EsRUFBQyxDQUFDLFNBQVMsR0FBR0YsR0FBRTlRLEdBQUUsQ0FBQyxJQUFJbU0sR0FBRSxHQUFHbk0sR0FBRSxXQUFXLEVBQUUsR0FBR21NLEdBQUUsQ0FBQyxHQUFHQSxLQUFJLE9BQVEsTUFBTSxHQUFRLEdBQUdBLEtBQUksSUFBSyxPQUFPMkUsR0FBTyxHQUFHM0UsR0FBRSxRQUFRLE1BQU0sSUFBSSxFQUFHLFVBQUdBLEdBQUUsT0FBTyxDQUFDLEVBQUUsTUFBTSxHQUFHLEVBQUUsU0FBU25NLEdBQUUsQ0FBQ0EsR0FBRUEsR0FBRSxLQUFNLEVBQUMsT0FBTzhRLEdBQUU5USxFQUFDLENBQUMsQ0FBQyxFQUFTOFEsR0FBTyxJQUFJRSxHQUFFLENBQUEsRUFBRyxVQUFHN0UsR0FBRSxNQUFNLEdBQUcsRUFBRSxTQUFTbk0sR0FBRSxDQUFDQSxHQUFFQSxHQUFFLEtBQU0sRUFBQ2dSLEdBQUVoUixFQUFDLEVBQUU4USxHQUFFOVEsRUFBQyxDQUFDLENBQUMsRUFBU2dSLEVBQUUsS0FBTSxRQUFPRixFQUFFLENBQUMsU0FBUyxHQUFHOVEsR0FBRSxDQUFDLE9BQU8sR0FBR0EsR0FBRSxNQUFNLEdBQUcsR0FBR0EsR0FBRSxNQUFNLEVBQUUsUUFBUSxHQUFHLEdBQUcsQ0FBQyxDQUFDLFNBQVMsR0FBR0EsR0FBRThRLEdBQUUsQ0FBQyxJQUFJM0UsR0FBRTJFLElBQUksR0FBRzlRLEdBQUUsU0FBUyxFQUFNZ1IsR0FBRSxDQUFDLFVBQVUsR0FBR2hSLEVBQUMsRUFBRSxRQUFRLFlBQVksRUFBRSxPQUFPLGlCQUFpQixVQUFVLEVBQUUsT0FBTyxpQkFBaUIsWUFBWSxFQUFFLE9BQU8sa0JBQWtCLEVBQTRFLEdBQXZFLEVBQUUsT0FBTyx1QkFBdUIsR0FBR0EsRUFBQyxFQUFFLFNBQVMsQ0FBQyxHQUFHQSxFQUFDLElBQUdnUixHQUFFLEtBQVEsT0FBUzdFLEdBQUUsQ0FBQyxJQUFJcFEsR0FBRSxFQUFFb1EsRUFBQyxFQUFFLEdBQUdwUSxHQUFFLE9BQU8sRUFBRyxRQUFROEcsR0FBRSxFQUFFQSxHQUFFOUcsR0FBRSxPQUFPOEcsS0FBSSxDQUFDLElBQUlvTyxHQUFFbFYsR0FBRThHLEVBQUMsRUFBRSxHQUFHb08sR0FBRSxRQUFRLE9BQU8sSUFBSSxFQUFHRCxHQUFFLFVBQWEsRUFBRUMsR0FBRSxPQUFPLENBQUMsQ0FBQyxVQUFVQSxHQUFFLFFBQVEsU0FBUyxJQUFJLEVBQUdELEdBQUUsWUFBZSxFQUFFQyxHQUFFLE9BQU8sQ0FBQyxDQUFDLFVBQVVBLEdBQUUsUUFBUSxhQUFhLElBQUksRUFBR0QsR0FBRSxXQUFjQyxHQUFFLE9BQU8sRUFBRSxJQUFJLGVBQWVBLEdBQUUsUUFBUSxjQUFjLElBQUksRUFBR0QsR0FBRSxZQUFlQyxHQUFFLE9BQU8sRUFBRSxJQUFJLGVBQWVBLEdBQUUsUUFBUSxTQUFTLElBQUksRUFBRSxDQUFDLElBQUlDLEdBQUVELEdBQUUsT0FBTyxDQUFDLEVBQU05RyxHQUFFK0csR0FBRSxNQUFNLEdBQUcsRUFBTUMsR0FBRWhILEdBQUUsSUFBRyxFQUFPaUgsR0FBRWpILEdBQUUsT0FBTyxFQUFFQSxHQUFFLEtBQUssR0FBRyxFQUFFLEtBQUs2RyxHQUFFLE9BQVVHLEdBQUVILEdBQUUsYUFBZ0JJLEVBQUMsU0FBU0gsR0FBRSxRQUFRLE9BQU8sSUFBSSxFQUFFLENBQUMsSUFBSUksR0FBRUosR0FBRSxPQUFPLENBQUMsRUFBTTlHLEdBQUVrSCxHQUFFLE1BQU0sR0FBRyxFQUFNQyxHQUFFbkgsR0FBRSxJQUFHLEVBQU9pSCxHQUFFakgsR0FBRSxPQUFPLEVBQUVBLEdBQUUsS0FBSyxHQUFHLEVBQUUsS0FBSzZHLEdBQUUsS0FBUU0sR0FBRU4sR0FBRSxXQUFjSSxFQUFDLFNBQVNILEdBQUUsUUFBUSxlQUFlLElBQUksRUFBRSxDQUFDLElBQUl6RyxHQUFFeUcsR0FBRSxPQUFPLEVBQXNCLEVBQUVELEdBQUUsWUFBZXhHLElBQUcsTUFBTSxNQUFTM0gsSUFBRyxFQUFHbU8sR0FBRSxVQUFhQyxHQUFPLEVBQUUsZ0NBQWdDQSxFQUFDLENBQUUsQ0FBRSxDQUFDLE9BQU9ELEVBQUMsQ0FBQyxTQUFTLEdBQUdoUixHQUFFLENBQUMsT0FBTyxHQUFHQSxHQUFFLGFBQWEsSUFBSSx1QkFBdUIsRUFBRUEsR0FBRSxNQUFNLEdBQUcsR0FBR0EsR0FBRSxTQUFTLElBQUkscUJBQXFCLENBQUMsU0FBUyxHQUFHOFEsR0FBRTNFLEdBQUU2RSxHQUFFLENBQUMsSUFBSWpWLEdBQUUsS0FBZ0UsT0FBM0QsRUFBRW9RLEdBQUUsU0FBU25NLEdBQUUsQ0FBSWpFLElBQUcsT0FBTUEsR0FBRWlFLEdBQUUsaUJBQWlCOFEsR0FBRUUsR0FBRTdFLEVBQUMsRUFBRSxDQUFDLEVBQUtwUSxLQUEwQixHQUFHb1EsRUFBQyxFQUFVLEdBQUc2RSxFQUFDLEVBQWMsR0FBR0EsRUFBQyxFQUFHLENBQUMsU0FBUyxFQUFFaFIsR0FBRSxDQUFDLE1BQU0sQ0FBQyxNQUFNLENBQUUsRUFBQyxLQUFLLENBQUNBLEVBQUMsQ0FBQyxDQUFDLENBQUMsU0FBUyxHQUFHQSxHQUFFOFEsR0FBRSxDQUFDLElBQUkzRSxHQUFFbk0sR0FBRSxDQUFDLEVBQU1nUixHQUFFaFIsR0FBRUEsR0FBRSxPQUFPLENBQUMsRUFBRSxHQUFHOFEsR0FBRSxPQUFPLENBQUMsSUFBSS9VLEdBQUUsS0FBUStVLEdBQUUsZUFBYy9VLEdBQUUsR0FBR29RLEdBQUUyRSxHQUFFLFlBQVksR0FBS0EsR0FBRSxTQUFTLFFBQVEzRSxJQUFHcFEsTUFBSUEsR0FBRUEsSUFBR29RLEdBQUVwUSxHQUFFLFVBQVUsR0FBSytVLEdBQUUsU0FBUyxXQUFXRSxJQUFHalYsTUFBSUEsR0FBRUEsSUFBR2lWLEdBQUVqVixHQUFFLFVBQVVBLEdBQUUsYUFBYSxDQUFDLEdBQUcrVSxHQUFFLEtBQUssQ0FBQyxJQUFJL1UsR0FBRSxLQUFLLEdBQUcrVSxHQUFFLFdBQVcsQ0FBQyxJQUFJak8sR0FBRWlPLEdBQUUsV0FBY0EsR0FBRSxhQUFhLFdBQVVqTyxHQUFFLFFBQU85RyxHQUFFLEdBQUdvUSxHQUFFdEosRUFBQyxDQUFDLENBQUlpTyxHQUFFLE9BQU8sUUFBUTNFLElBQUdwUSxNQUFJQSxHQUFFQSxJQUFHb1EsR0FBRXBRLEdBQUUsZUFBZSxDQUFDLE1BQU0sUUFBUSxTQUFTLEVBQUUsT0FBTyxjQUFjLENBQUMsR0FBSytVLEdBQUUsT0FBTyxXQUFXRSxJQUFHalYsTUFBSUEsR0FBRUEsSUFBR2lWLEdBQUVqVixHQUFFLGVBQWUsQ0FBQyxNQUFNLE1BQU0sU0FBUyxFQUFFLE9BQU8sY0FBYyxDQUFDLEVBQUUsQ0FBQyxDQUFDLFNBQVMsR0FBR2lFLEdBQUU4USxHQUFFM0UsR0FBRTZFLEdBQUUsQ0FBa0IsR0FBZEEsSUFBRyxPQUFNQSxHQUFFLENBQUEsR0FBTWhSLElBQUcsS0FBTSxPQUFPZ1IsR0FBRSxJQUFJalYsR0FBRSxHQUFHaUUsR0FBRThRLEVBQUMsRUFBRSxHQUFHL1UsR0FBRSxDQUFDLElBQUk4RyxHQUFFOUcsR0FBRSxLQUFJLEVBQU9rVixHQUFFOUUsR0FBRSxHQUFHdEosS0FBSSxRQUFTLE9BQU8sS0FBUUEsR0FBRSxRQUFRLGFBQWEsSUFBSSxHQUFHQSxHQUFFQSxHQUFFLE9BQU8sRUFBRSxFQUFFb08sR0FBRSxJQUFhcE8sR0FBRSxRQUFRLEtBQUssSUFBSSxJQUFHQSxHQUFFQSxHQUFFLE9BQU8sQ0FBQyxFQUFFb08sR0FBRSxJQUFRcE8sR0FBRSxRQUFRLEdBQUcsSUFBSSxJQUFHQSxHQUFFLElBQUlBLEdBQUUsS0FBSSxJQUFJcU8sR0FBS0QsR0FBR0MsR0FBRSxHQUFHbFIsR0FBRSxVQUFVLENBQUMsT0FBTyxTQUFTLFdBQVc2QyxHQUFFLEdBQUcsRUFBQyxDQUFFLEVBQUUsQ0FBQSxDQUFFLEVBQU9xTyxHQUFFLEVBQUVyTyxFQUFDLEVBQUUsUUFBUXNILE1BQUsrRyxHQUFNQSxHQUFFLGVBQWUvRyxFQUFDLEdBQU02RyxHQUFFN0csRUFBQyxHQUFHLE9BQU02RyxHQUFFN0csRUFBQyxFQUFFK0csR0FBRS9HLEVBQUMsRUFBSSxDQUFDLE9BQU8sR0FBRyxFQUFFbkssRUFBQyxFQUFFOFEsR0FBRTNFLEdBQUU2RSxFQUFDLENBQUMsQ0FBQyxTQUFTLEdBQUdoUixHQUFFOFEsR0FBRTNFLEdBQUUsQ0FBQyxPQUFHLEVBQUUsT0FBTyxVQUFrQjJFLEdBQUcsR0FBTSxHQUFHOVEsR0FBRSwwQkFBMEIsRUFBU21NLEdBQUUsQ0FBQyxTQUFTLEdBQUduTSxHQUFFOFEsR0FBRSxDQUFDLE9BQU8sR0FBRzlRLEdBQUUsVUFBVSxHQUFLOFEsRUFBQyxDQUFDLENBQUMsU0FBUyxHQUFHOVEsR0FBRThRLEdBQUUsQ0FBQyxPQUFPLEdBQUc5USxHQUFFLFVBQVUsR0FBTThRLEVBQUMsQ0FBQyxDQUFDLFNBQVMsR0FBRzlRLEdBQUUsQ0FBQyxPQUFPLEdBQUcsR0FBR0EsRUFBQyxFQUFFLEdBQUdBLEVBQUMsQ0FBQyxDQUFDLENBQUMsU0FBUyxHQUFHOFEsR0FBRTNFLEdBQUU2RSxHQUFFLENBQUMsR0FBR0EsS0FBSSxLQUFNLEdBQUcsQ0FBQ0YsR0FBRSxpQkFBaUIzRSxHQUFFNkUsRUFBQyxDQUFDLE1BQVMsQ0FBQ0YsR0FBRSxpQkFBaUIzRSxHQUFFLG1CQUFtQjZFLEVBQUMsQ0FBQyxFQUFFRixHQUFFLGlCQUFpQjNFLEdBQUUsbUJBQW1CLE1BQU0sQ0FBQyxDQUFFLENBQUMsU0FBUyxHQUFHMkUsR0FBRSxDQUFDLEdBQUdBLEdBQUUsYUFBYSxPQUFPLElBQU0sSUFBYSxHQUFHLENBQUMsSUFBSTlRLEdBQUUsSUFBSSxJQUFJOFEsR0FBRSxXQUFXLEVBQUUsT0FBTzlRLEdBQUUsU0FBU0EsR0FBRSxNQUFNLE1BQVMsQ0FBQyxHQUFHLEtBQUssS0FBSyxzQkFBc0IsQ0FBQyxJQUFJOFEsR0FBRSxXQUFXLENBQUMsQ0FBQyxDQUFFLENBQUMsU0FBUyxFQUFFOVEsR0FBRThRLEdBQUUsQ0FBQyxPQUFPQSxHQUFFLEtBQUs5USxHQUFFLHNCQUFxQixDQUFFLENBQUMsQ0FBQyxTQUFTLEdBQUdBLEdBQUU4USxHQUFFM0UsR0FBRSxDQUFtQixPQUFsQm5NLEdBQUVBLEdBQUUsWUFBYSxFQUFJbU0sR0FBTUEsY0FBYSxTQUFTLEVBQUVBLEdBQUUsUUFBUSxFQUFVLEdBQUduTSxHQUFFOFEsR0FBRSxLQUFLLEtBQUssQ0FBQyxlQUFlLEVBQUUzRSxFQUFDLEVBQUUsY0FBYyxFQUFJLENBQUMsRUFBYyxHQUFHbk0sR0FBRThRLEdBQUUsRUFBRTNFLEdBQUUsTUFBTSxFQUFFQSxHQUFFLE1BQU0sQ0FBQyxRQUFRQSxHQUFFLFFBQVEsUUFBUUEsR0FBRSxRQUFRLE9BQU9BLEdBQUUsT0FBTyxlQUFlLEVBQUVBLEdBQUUsTUFBTSxFQUFFLGFBQWFBLEdBQUUsS0FBSyxPQUFPQSxHQUFFLE9BQU8sY0FBYyxFQUFJLENBQUMsRUFBZSxHQUFHbk0sR0FBRThRLEdBQUUsS0FBSyxLQUFLLENBQUMsY0FBYyxFQUFJLENBQUMsQ0FBRSxDQUFDLFNBQVMsR0FBRzlRLEdBQUUsQ0FBVSxRQUFMOFEsR0FBRSxDQUFBLEVBQVM5USxJQUFHOFEsR0FBRSxLQUFLOVEsRUFBQyxFQUFFQSxHQUFFQSxHQUFFLGNBQWMsT0FBTzhRLEVBQUMsQ0FBQyxTQUFTLEdBQUc5USxHQUFFOFEsR0FBRTNFLEdBQUUsQ0FBQyxJQUFJNkUsR0FBTWpWLEdBQUUsR0FBRyxPQUFPLEtBQU0sV0FBVyxDQUFDQSxHQUFFLElBQUksSUFBSStVLEdBQUUsU0FBUyxTQUFTLElBQUksRUFBRSxJQUFJak8sR0FBRSxTQUFTLFNBQVMsT0FBT21PLEdBQUVuTyxLQUFJOUcsR0FBRSxNQUFNLE1BQU1BLEdBQUUrVSxHQUFFRSxHQUFFLEVBQUVGLEdBQUUsU0FBUyxTQUFTLE1BQU0sRUFBRSxPQUFHLEVBQUUsT0FBTyxrQkFBcUIsQ0FBQ0UsR0FBVSxHQUFjLEdBQUdoUixHQUFFLG1CQUFtQixHQUFHLENBQUMsSUFBSWpFLEdBQUUsU0FBU2lWLEVBQUMsRUFBRTdFLEVBQUMsQ0FBQyxDQUFDLENBQUMsU0FBUyxHQUFHMkUsR0FBRTNFLEdBQUU2RSxHQUFFalYsR0FBRThHLEdBQUU3QyxHQUFFLENBQUMsSUFBSWlSLEdBQUUsS0FBU0MsR0FBRSxLQUFvQixHQUFmck8sR0FBRUEsSUFBVSxHQUFNQSxHQUFFLGVBQWUsT0FBTyxRQUFVLElBQWEsSUFBSXNILEdBQUUsSUFBSSxRQUFRLFNBQVNuSyxHQUFFOFEsR0FBRSxDQUFDRyxHQUFFalIsR0FBRWtSLEdBQUVKLEVBQUMsQ0FBQyxFQUFLRSxJQUFHLE9BQU1BLEdBQUUsR0FBSSxFQUFDLE1BQUssSUFBSU8sR0FBRTFPLEdBQUUsU0FBUyxHQUFPMk8sR0FBRTNPLEdBQUUsUUFBUSxLQUFLLEdBQUcsQ0FBQyxHQUFHbU8sRUFBQyxFQUFHLFVBQUdDLEVBQUMsRUFBUzlHLEdBQUUsSUFBSWdILEdBQUV0TyxHQUFFLGdCQUFnQixHQUFHbU8sRUFBQyxFQUFFLEdBQUdHLElBQUcsTUFBTUEsSUFBRyxHQUFJLFVBQUdILEdBQUUsbUJBQW1CLENBQUMsT0FBTyxHQUFHQSxHQUFFLFdBQVcsQ0FBQyxDQUFDLEVBQUUsR0FBR0UsRUFBQyxFQUFTL0csR0FBRSxJQUFJaUgsR0FBRSxHQUFHSixFQUFDLEVBQU1LLEdBQUVELEdBQUUsa0JBQWtCLEdBQUdDLEdBQUUsQ0FBQyxJQUFJQyxHQUFFLEdBQUdELEdBQUUsWUFBWSxFQUFLQyxJQUFHLE9BQU1uRixHQUFFbUYsSUFBRSxJQUFJOUcsR0FBRSxHQUFHNkcsR0FBRSxZQUFZLEVBQUs3RyxJQUFHLE1BQVNBLEdBQUUsWUFBVyxJQUFLLFdBQVVzRyxHQUFFdEcsR0FBRyxDQUFDLElBQUlpSCxHQUFFLEdBQUdULEdBQUUsWUFBWSxFQUFFLEdBQUdoUixLQUFJLE9BQVUsQ0FBQyxJQUFJMFIsR0FBRSxTQUFTMVIsR0FBRSxDQUFDLE9BQU8sR0FBRzhRLEdBQUUzRSxHQUFFNkUsR0FBRWpWLEdBQUU4RyxHQUFFLENBQUMsQ0FBQzdDLEVBQUMsQ0FBQyxFQUFNMlIsR0FBRSxDQUFDLE9BQU9SLEdBQUUsSUFBSUgsR0FBRSxLQUFLN0UsR0FBRSxLQUFLMkUsR0FBRSxnQkFBZ0IvVSxHQUFFLElBQUk4RyxHQUFFLGFBQWE2TyxHQUFFLFNBQVNELEVBQUMsRUFBRSxHQUFHLEdBQUdULEdBQUUsZUFBZVcsRUFBQyxJQUFJLEdBQU8sVUFBR1YsRUFBQyxFQUFTOUcsRUFBRSxDQUFDLElBQUl5SCxHQUFFWixHQUFNYSxHQUFFLEdBQUdiLEdBQUUsU0FBUyxFQUFNYyxHQUFFLEtBQVNDLEdBQUUsR0FBTSxHQUFHRixHQUFFLENBQUMsSUFBSUcsR0FBRUgsR0FBRSxNQUFNLEdBQUcsRUFBTUksR0FBRUQsR0FBRSxDQUFDLEVBQUUsS0FBTSxFQUFpRixHQUE3RUMsS0FBSSxPQUFRTCxHQUFFLEdBQUdaLEdBQUUsU0FBUyxFQUFPWSxHQUFFLEdBQUdaLEdBQUVpQixFQUFDLEVBQUVKLElBQUdHLEdBQUUsQ0FBQyxHQUFHLFFBQVEsS0FBTSxFQUFDWixHQUFFLEdBQUdRLEVBQUMsRUFBS0MsS0FBSSxRQUFRVCxHQUFFLEtBQUtBLEdBQUUsWUFBWSxHQUFNLFVBQUdILEVBQUMsRUFBUzlHLEdBQU8sR0FBRzBILEtBQUksUUFBUSxDQUFDLEdBQUdULEdBQUUsSUFBSyxVQUFHSCxFQUFDLEVBQVM5RyxHQUFPNEgsR0FBRSxFQUFLLFNBQVNGLEtBQUksVUFBVyxHQUFHRCxHQUFFLFlBQVksVUFBVUMsR0FBRSxRQUFRLE9BQU8sSUFBSSxFQUFFLENBQUMsSUFBSUssR0FBRUwsR0FBRSxNQUFNLEdBQUcsRUFBRUMsSUFBR0ksR0FBRSxDQUFDLEdBQUcsUUFBUSxLQUFNLENBQUEsQ0FBQyxDQUFDLEdBQUdkLEdBQUUsSUFBSyxHQUFHQSxHQUFFLFVBQVcsR0FBR1EsR0FBRSxZQUFZLE1BQU0sQ0FBQyxHQUFHRSxJQUFHLEtBQUssQ0FBQyxHQUFHL1YsR0FBRSxDQUFDLElBQUlvVyxHQUFFLEdBQUdwVyxFQUFDLEVBQUtvVyxJQUFHQSxHQUFFLGFBQWFBLEdBQUUsWUFBWSxRQUFPTCxHQUFFSyxHQUFFLFlBQVksTUFBTSxDQUFJTCxJQUFHLE9BQU1BLEdBQUUsT0FBTyxDQUFDLE9BQUdWLEdBQUUsZ0JBQWdCLE9BQU1BLEdBQUUsZUFBZSxDQUFBLEdBQU1VLEtBQUksU0FBU1YsR0FBRSxlQUFlLFNBQVMsRUFBR0EsR0FBRSxlQUFlLEtBQUssVUFBVSxDQUFDLEdBQUdOLEdBQUUzRSxHQUFFNkUsR0FBRWpWLEdBQUU4RyxFQUFDLENBQUMsQ0FBQyxFQUFVaVAsS0FBSSxNQUFPVixHQUFFLGVBQWUsS0FBSyxVQUFVLENBQUMsR0FBR04sR0FBRTNFLEdBQUU2RSxHQUFFalYsR0FBRThHLEVBQUMsQ0FBQyxDQUFDLEVBQVVpUCxLQUFJLFNBQVFWLEdBQUUsZUFBZSxHQUFHQSxHQUFFLGVBQWUsS0FBSyxVQUFVLENBQUMsR0FBR04sR0FBRTNFLEdBQUU2RSxHQUFFalYsR0FBRThHLEVBQUMsQ0FBQyxDQUFDLEdBQUUsR0FBR29PLEVBQUMsRUFBUzlHLEVBQUMsQ0FBRSxJQUFJckgsR0FBRSxJQUFJLGVBQWVzTyxHQUFFLElBQUl0TyxHQUFFc08sR0FBRSxVQUFVVyxHQUFFLElBQUlLLEdBQUUsVUFBVSxDQUE4QixHQUE3QmhCLEdBQUUsSUFBSSxLQUFLQSxHQUFFLFVBQVUsR0FBU0EsR0FBRSxnQkFBZ0IsTUFBTUEsR0FBRSxlQUFlLE9BQU8sRUFBRSxDQUFDLElBQUlwUixHQUFFb1IsR0FBRSxlQUFlLE1BQU8sRUFBQ3BSLEdBQUMsQ0FBRSxDQUFDLEVBQU1xUyxHQUFFLEdBQUdyQixHQUFFLFdBQVcsRUFBRSxHQUFHcUIsR0FBRSxDQUFDLElBQUlDLEdBQUUsT0FBT0QsRUFBQyxFQUFFLEdBQUdDLEtBQUksTUFBTSxDQUFDLEdBQUd0QixHQUFFLGNBQWMsQ0FBQyxPQUFPc0IsR0FBRSxPQUFPbkIsRUFBQyxDQUFDLEVBQUcsVUFBR0YsRUFBQyxFQUFFbUIsR0FBRyxFQUFRakksRUFBRSxDQUFDLEdBQUdzSCxJQUFHLENBQUN6UixJQUFNLENBQUMsUUFBUXlSLEVBQUMsRUFBRyxVQUFHUixFQUFDLEVBQUVtQixHQUFHLEVBQVFqSSxHQUFHLElBQUlvSSxHQUFFLEdBQUd2QixHQUFFRyxHQUFFbUIsRUFBQyxFQUFLeEIsS0FBSSxPQUFPLENBQUMsR0FBR0UsRUFBQyxJQUFHdUIsR0FBRSxjQUFjLEVBQUUscUNBQXVDMVAsR0FBRSxVQUFTMFAsR0FBRSxHQUFHQSxHQUFFMVAsR0FBRSxPQUFPLEdBQUUsSUFBSTJQLEdBQUUsR0FBR3hCLEdBQUVGLEVBQUMsRUFBTTJCLEdBQUVELEdBQUUsT0FBV0UsR0FBRUYsR0FBRSxPQUFVM1AsR0FBRSxTQUFRNlAsR0FBRSxHQUFHQSxHQUFFN1AsR0FBRSxNQUFNLEdBQUUsSUFBSThQLEdBQUUsR0FBRzNCLEVBQUMsRUFBTTRCLEdBQUUsR0FBR0YsR0FBRUMsRUFBQyxFQUFNRSxHQUFFLEdBQUdELEdBQUU1QixFQUFDLEVBQUssRUFBRSxPQUFPLHFCQUFxQkYsS0FBSSxRQUFPK0IsR0FBRSx1QkFBdUIsRUFBRSxHQUFHMUIsR0FBRSxJQUFJLEdBQUcsU0FBVWhGLElBQUcsTUFBTUEsS0FBSSxNQUFJQSxHQUFFLEdBQUUsRUFBRyxTQUFTLE1BQUssSUFBSTJHLEdBQUUsR0FBRzlCLEdBQUUsWUFBWSxFQUFNK0IsR0FBRSxHQUFHL0IsRUFBQyxFQUFFLFFBQVlnQyxHQUFFLEVBQUUsT0FBTyx3QkFBd0IsUUFBUWxDLEVBQUMsR0FBRyxFQUFNbUMsR0FBRSxDQUFDLFFBQVFGLEdBQUUsYUFBYUMsR0FBRSxXQUFXSCxHQUFFLHFCQUFxQkQsR0FBRSxRQUFRTCxHQUFFLE9BQU9wQixHQUFFLEtBQUtMLEdBQUUsT0FBTzJCLEdBQUUsZ0JBQWdCNVAsR0FBRSxhQUFhaVEsR0FBRSxhQUFhLEVBQUUsT0FBTyxnQkFBZ0IsUUFBUWpRLEdBQUUsU0FBU2lRLEdBQUUsU0FBUyxFQUFFLE9BQU8sUUFBUSxLQUFLM0csR0FBRSxnQkFBZ0JwUSxFQUFDLEVBQUUsR0FBRyxDQUFDLEdBQUdpVixHQUFFLHFCQUFxQmlDLEVBQUMsRUFBRyxVQUFHaEMsRUFBQyxFQUFFbUIsR0FBRyxFQUFRakksR0FBMkUsR0FBekVnQyxHQUFFOEcsR0FBRSxLQUFLbkMsR0FBRW1DLEdBQUUsS0FBS1YsR0FBRVUsR0FBRSxRQUFRSixHQUFFSSxHQUFFLFdBQVdSLEdBQUVRLEdBQUUsT0FBT0QsR0FBRUMsR0FBRSxhQUFnQlIsSUFBR0EsR0FBRSxPQUFPLEVBQUcsVUFBR3pCLEdBQUUseUJBQXlCaUMsRUFBQyxFQUFFLEdBQUdoQyxFQUFDLEVBQUVtQixLQUFXakksR0FBRSxJQUFJK0ksR0FBRS9HLEdBQUUsTUFBTSxHQUFHLEVBQU1nSCxHQUFFRCxHQUFFLENBQUMsRUFBTUUsR0FBRUYsR0FBRSxDQUFDLEVBQU1HLEdBQUVsSCxHQUFFLEdBQUc2RyxHQUFFLENBQUNLLEdBQUVGLEdBQUUsSUFBSUcsR0FBRSxPQUFPLEtBQUtULEVBQUMsRUFBRSxTQUFTLEVBQUtTLEtBQU1ELEdBQUUsUUFBUSxHQUFHLEVBQUUsRUFBR0EsSUFBRyxJQUFTQSxJQUFHLElBQUlBLElBQUcsR0FBR1IsRUFBQyxFQUFLTyxLQUFHQyxJQUFHLElBQUlELElBQUcsQ0FBQyxHQUFHLENBQUMsR0FBR3BDLEdBQUVxQyxHQUFFSixFQUFDLEVBQUcsVUFBR2pDLEdBQUUsbUJBQW1CaUMsRUFBQyxFQUFFLEdBQUcvQixFQUFDLEVBQVMvRyxHQUF5SCxHQUF2SHJILEdBQUUsS0FBS2dPLEdBQUUsWUFBVyxFQUFHdUMsR0FBRSxFQUFJLEVBQUV2USxHQUFFLGlCQUFpQixXQUFXLEVBQUVBLEdBQUUsZ0JBQWdCbVEsR0FBRSxnQkFBZ0JuUSxHQUFFLFFBQVFtUSxHQUFFLFFBQVcsQ0FBQUgsR0FBRSxXQUFpQixRQUFRUyxNQUFLaEIsR0FBRyxHQUFHQSxHQUFFLGVBQWVnQixFQUFDLEVBQUUsQ0FBQyxJQUFJQyxHQUFFakIsR0FBRWdCLEVBQUMsRUFBRSxHQUFHelEsR0FBRXlRLEdBQUVDLEVBQUMsQ0FBQyxFQUFHLElBQUlDLEdBQUUsQ0FBQyxJQUFJM1EsR0FBRSxPQUFPcU8sR0FBRSxjQUFjOEIsR0FBRSxJQUFJcFEsR0FBRSxRQUFRa1EsR0FBRSxPQUFPdkIsR0FBRSxTQUFTLENBQUMsWUFBWXJGLEdBQUUsaUJBQWlCa0gsR0FBRSxPQUFPRCxFQUFDLENBQUMsRUFBcW5CLEdBQW5uQnRRLEdBQUUsT0FBTyxVQUFVLENBQUMsR0FBRyxDQUFDLElBQUk5QyxHQUFFLEdBQUdnUixFQUFDLEVBQXNHLEdBQXBHeUMsR0FBRSxTQUFTLGFBQWEsR0FBRzNRLEVBQUMsRUFBRXlPLEdBQUVQLEdBQUV5QyxFQUFDLEVBQUUsR0FBR0MsR0FBRUMsRUFBQyxFQUFFLEdBQUczQyxHQUFFLG9CQUFvQnlDLEVBQUMsRUFBRSxHQUFHekMsR0FBRSxtQkFBbUJ5QyxFQUFDLEVBQUssQ0FBQyxHQUFHekMsRUFBQyxFQUFFLENBQVksUUFBUEYsR0FBRSxLQUFXOVEsR0FBRSxPQUFPLEdBQUc4USxJQUFHLE1BQUssQ0FBQyxJQUFJM0UsR0FBRW5NLEdBQUUsTUFBSyxFQUFNLEdBQUdtTSxFQUFDLElBQUcyRSxHQUFFM0UsR0FBRSxDQUFJMkUsS0FBRyxHQUFHQSxHQUFFLG9CQUFvQjJDLEVBQUMsRUFBRSxHQUFHM0MsR0FBRSxtQkFBbUIyQyxFQUFDLEVBQUUsQ0FBQyxHQUFHeEMsRUFBQyxFQUFFbUIsR0FBQyxDQUFFLE9BQU9wUyxHQUFFLENBQUMsU0FBR2dSLEdBQUUsbUJBQW1CLEdBQUcsQ0FBQyxNQUFNaFIsRUFBQyxFQUFFeVQsRUFBQyxDQUFDLEVBQVF6VCxFQUFDLENBQUMsRUFBRThDLEdBQUUsUUFBUSxVQUFVLENBQUMsR0FBRzRRLEdBQUVDLEVBQUMsRUFBRSxHQUFHM0MsR0FBRSxvQkFBb0J5QyxFQUFDLEVBQUUsR0FBR3pDLEdBQUUsaUJBQWlCeUMsRUFBQyxFQUFFLEdBQUd2QyxFQUFDLEVBQUVrQixHQUFHLENBQUEsRUFBRXRQLEdBQUUsUUFBUSxVQUFVLENBQUMsR0FBRzRRLEdBQUVDLEVBQUMsRUFBRSxHQUFHM0MsR0FBRSxvQkFBb0J5QyxFQUFDLEVBQUUsR0FBR3pDLEdBQUUsaUJBQWlCeUMsRUFBQyxFQUFFLEdBQUd2QyxFQUFDLEVBQUVrQixHQUFDLENBQUUsRUFBRXRQLEdBQUUsVUFBVSxVQUFVLENBQUMsR0FBRzRRLEdBQUVDLEVBQUMsRUFBRSxHQUFHM0MsR0FBRSxvQkFBb0J5QyxFQUFDLEVBQUUsR0FBR3pDLEdBQUUsZUFBZXlDLEVBQUMsRUFBRSxHQUFHdkMsRUFBQyxFQUFFa0IsR0FBRyxDQUFBLEVBQUssQ0FBQyxHQUFHcEIsR0FBRSxxQkFBcUJ5QyxFQUFDLEVBQUcsVUFBR3hDLEVBQUMsRUFBRW1CLEdBQUMsRUFBVWpJLEdBQUUsSUFBSXVKLEdBQUUsR0FBRzFDLEVBQUMsRUFBTTJDLEdBQUUsR0FBRzNDLEVBQUMsRUFBRSxHQUFHLENBQUMsWUFBWSxVQUFVLFdBQVcsT0FBTyxFQUFFLFNBQVNGLEdBQUUsQ0FBQyxHQUFHLENBQUNoTyxHQUFFQSxHQUFFLE1BQU0sRUFBRSxTQUFTOUMsR0FBRSxDQUFDQSxHQUFFLGlCQUFpQjhRLEdBQUUsU0FBUzlRLEdBQUUsQ0FBQyxHQUFHZ1IsR0FBRSxZQUFZRixHQUFFLENBQUMsaUJBQWlCOVEsR0FBRSxpQkFBaUIsT0FBT0EsR0FBRSxPQUFPLE1BQU1BLEdBQUUsS0FBSyxDQUFDLENBQUMsQ0FBQyxDQUFDLENBQUMsQ0FBQyxDQUFDLEVBQUUsR0FBR2dSLEdBQUUsa0JBQWtCeUMsRUFBQyxFQUFFLElBQUlHLEdBQUVaLEdBQUUsS0FBSyxHQUFHbFEsR0FBRWtPLEdBQUU2QixFQUFDLEVBQUUsT0FBQS9QLEdBQUUsS0FBSzhRLEVBQUMsRUFBU3pKLEVBQUMsQ0FBQyxTQUFTLEdBQUduSyxHQUFFOFEsR0FBRSxDQUFDLElBQUkzRSxHQUFFMkUsR0FBRSxJQUFRRSxHQUFFLEtBQVNqVixHQUFFLEtBQXFPLEdBQTdOLEVBQUVvUSxHQUFFLFdBQVcsR0FBRzZFLEdBQUU3RSxHQUFFLGtCQUFrQixTQUFTLEVBQUVwUSxHQUFFLFFBQWUsRUFBRW9RLEdBQUUsZUFBZSxHQUFHNkUsR0FBRTdFLEdBQUUsa0JBQWtCLGFBQWEsRUFBRXBRLEdBQUUsUUFBZSxFQUFFb1EsR0FBRSxrQkFBa0IsSUFBRzZFLEdBQUU3RSxHQUFFLGtCQUFrQixnQkFBZ0IsRUFBRXBRLEdBQUUsV0FBYWlWLEdBQUcsT0FBR0EsS0FBSSxRQUFlLENBQUEsRUFBYyxDQUFDLEtBQUtqVixHQUFFLEtBQUtpVixFQUFDLEVBQUcsSUFBSW5PLEdBQUVpTyxHQUFFLFNBQVMsaUJBQXFCRyxHQUFFSCxHQUFFLFNBQVMsYUFBaUJJLEdBQUUsR0FBR2xSLEdBQUUsYUFBYSxFQUFNbUssR0FBRSxHQUFHbkssR0FBRSxnQkFBZ0IsRUFBTW1SLEdBQUUsR0FBR25SLEVBQUMsRUFBRSxRQUFZb1IsR0FBRSxLQUFTQyxHQUFFLEtBQThFLE9BQXRFSCxJQUFHRSxHQUFFLE9BQU9DLEdBQUVILElBQVUvRyxJQUFHaUgsR0FBRSxVQUFVQyxHQUFFbEgsSUFBVWdILEtBQUdDLEdBQUUsT0FBT0MsR0FBRUosSUFBR3BPLElBQUt3TyxHQUFNQSxLQUFJLFFBQWUsQ0FBQSxHQUFNQSxLQUFJLFNBQVFBLEdBQUVKLElBQUdwTyxJQUFLaU8sR0FBRSxTQUFTLFFBQVFPLEdBQUUsUUFBUSxHQUFHLElBQUksS0FBSUEsR0FBRUEsR0FBRSxJQUFJUCxHQUFFLFNBQVMsUUFBYSxDQUFDLEtBQUtNLEdBQUUsS0FBS0MsRUFBQyxHQUFhLENBQUEsQ0FBRyxDQUFDLFNBQVMsR0FBR2xILEdBQUVnSCxHQUFFLENBQUMsSUFBSUMsR0FBRUQsR0FBRSxJQUFRRSxHQUFFRixHQUFFLE9BQVduUixHQUFFbVIsR0FBRSxJQUFVQSxHQUFFLGNBQWMsSUFBSUcsR0FBRUgsR0FBRSxPQUFPLEdBQUksR0FBR2hILEdBQUUsb0JBQW9CZ0gsRUFBQyxFQUFzRCxJQUExQyxFQUFFQyxHQUFFLGNBQWMsR0FBRyxHQUFHQSxHQUFFLGFBQWFqSCxFQUFDLEVBQUssRUFBRWlILEdBQUUsZUFBZSxFQUFFLENBQUMsR0FBRSxFQUFHLElBQUlqRixHQUFFaUYsR0FBRSxrQkFBa0IsYUFBYSxFQUFNNUcsR0FBSzJCLEdBQUUsUUFBUSxHQUFHLElBQUksSUFBRzNCLEdBQUUsRUFBRTJCLEVBQUMsRUFBRUEsR0FBRTNCLEdBQUUsS0FBUSxPQUFPQSxHQUFFLE1BQVEsR0FBRyxNQUFNMkIsR0FBRTNCLEVBQUMsRUFBRSxLQUFLLFVBQVUsQ0FBQyxHQUFHMkIsRUFBQyxDQUFDLENBQUMsRUFBRSxNQUFNLENBQUMsSUFBSTZFLEdBQUUsRUFBRUksR0FBRSxjQUFjLEdBQVlBLEdBQUUsa0JBQWtCLFlBQVksSUFBekMsT0FBMkMsR0FBRyxFQUFFQSxHQUFFLGVBQWUsRUFBRSxDQUFDLFNBQVMsS0FBS0EsR0FBRSxrQkFBa0IsYUFBYSxFQUFFSixJQUFHLFNBQVMsT0FBUSxFQUFDLE1BQU0sQ0FBQyxHQUFHQSxHQUFFLENBQUMsU0FBUyxTQUFTLE1BQU0sQ0FBSSxFQUFFSSxHQUFFLGVBQWUsSUFBTUEsR0FBRSxrQkFBa0IsYUFBYSxJQUFJLE9BQVFELEdBQUUsT0FBT2hILEdBQU9nSCxHQUFFLE9BQU8sR0FBR2hILEdBQUVpSCxHQUFFLGtCQUFrQixhQUFhLENBQUMsR0FBRyxJQUFJSyxHQUFFLEdBQUd0SCxHQUFFZ0gsRUFBQyxFQUFNcFYsR0FBRXFWLEdBQUUsUUFBUSxLQUFLQSxHQUFFLE9BQU8sS0FBS0EsR0FBRSxTQUFTLElBQVFRLEdBQUVSLEdBQUUsU0FBYXZPLEdBQUV1TyxHQUFFLFFBQVEsSUFBUVMsR0FBRSxFQUFFLE9BQU8sWUFBZ0JaLEdBQUUsR0FBRyxDQUFDLFdBQVdsVixHQUFFLGVBQWU2VixHQUFFLFFBQVEvTyxHQUFFLFlBQVlnUCxFQUFDLEVBQUVWLEVBQUMsRUFBRSxHQUFJLEdBQUdFLEdBQUUsa0JBQWtCSixFQUFDLEVBQXlHLElBQWhHSSxHQUFFSixHQUFFLE9BQU9XLEdBQUVYLEdBQUUsZUFBZXBPLEdBQUVvTyxHQUFFLFFBQVFZLEdBQUVaLEdBQUUsWUFBWUUsR0FBRSxPQUFPRSxHQUFFRixHQUFFLE9BQU90TyxHQUFFc08sR0FBRSxXQUFXLENBQUN0TyxHQUFLb08sR0FBRSxXQUFXLENBQUlHLEdBQUUsU0FBUyxLQUFLLEdBQUdqSCxFQUFDLEVBQUUsRUFBRUEsR0FBRSxTQUFTbkssR0FBRSxDQUFDNFIsR0FBRTVSLEdBQUUsa0JBQWtCNFIsR0FBRVIsR0FBRWpILEVBQUMsQ0FBQyxDQUFDLEVBQUtzSCxHQUFFLE1BQU0sR0FBRSxFQUFHLElBQUlQLEdBQUVsUixHQUFFLGFBQWdCLEVBQUVvUixHQUFFLGFBQWEsSUFBR0YsR0FBRUUsR0FBRSxrQkFBa0IsV0FBVyxHQUFFLElBQUk1RyxHQUFFLEdBQUdMLEdBQUUrRyxFQUFDLEVBQUsxRyxHQUFFLGVBQWUsYUFBYSxJQUFHcUgsR0FBRXJILEdBQUUsYUFBWTZHLEdBQUUsVUFBVSxJQUFJLEVBQUUsT0FBTyxhQUFhLEVBQUUsSUFBSVMsR0FBRSxLQUFTQyxHQUFFLEtBQVNJLEdBQUUsVUFBVSxDQUFDLEdBQUcsQ0FBQyxJQUFJblMsR0FBRSxTQUFTLGNBQWtCOFEsR0FBRSxHQUFHLEdBQUcsQ0FBQ0EsR0FBRSxDQUFDLElBQUk5USxHQUFFLE1BQU1BLEdBQUVBLEdBQUUsZUFBZSxLQUFLLElBQUlBLEdBQUVBLEdBQUUsYUFBYSxJQUFJLENBQUMsTUFBUyxDQUFFLENBQUEsSUFBSW1NLEdBQUttRixLQUFHbkYsR0FBRW1GLElBQUssRUFBRUYsR0FBRSxlQUFlLElBQUdqRixHQUFFaUYsR0FBRSxrQkFBa0IsYUFBYSxHQUFLSyxHQUFFLE9BQU0sR0FBRyxHQUFFLEVBQUcsS0FBSywyQkFBMkIsR0FBRyxDQUFDLFFBQVFBLEVBQUMsRUFBRU4sRUFBQyxDQUFDLEVBQUtNLEdBQUUsT0FBTyxRQUFRLEdBQUdBLEdBQUUsSUFBSSxFQUFFLEdBQUcsR0FBRSxFQUFHLEtBQUsseUJBQXlCLENBQUMsS0FBS0EsR0FBRSxJQUFJLENBQUMsSUFBTyxHQUFHQSxHQUFFLElBQUksRUFBRSxHQUFHLEdBQUUsRUFBRyxLQUFLLHlCQUF5QixDQUFDLEtBQUtBLEdBQUUsSUFBSSxDQUFDLElBQUcsSUFBSVQsR0FBRSxFQUFFSyxFQUFDLEVBQTRCLEdBQTFCLEdBQUc3RyxHQUFFLFVBQVU2RyxHQUFFbEgsR0FBRXlILEdBQUVaLEdBQUU3RSxFQUFDLEVBQUsyRSxHQUFFLEtBQUssQ0FBQyxHQUFHQSxHQUFFLEdBQUcsR0FBRyxHQUFHQSxHQUFFLElBQUksSUFBSSxFQUFFLENBQUMsSUFBSS9VLEdBQUUsU0FBUyxlQUFlLEdBQUcrVSxHQUFFLElBQUksSUFBSSxDQUFDLEVBQU1qTyxHQUFFLENBQUMsY0FBYzJILEdBQUUsY0FBYyxPQUFVLENBQUNBLEdBQUUsWUFBWSxDQUFDLEVBQUUsT0FBTyxrQkFBa0IsRUFBRSxHQUFHek8sR0FBRSxDQUFDLEdBQUcrVSxHQUFFLE9BQU8vVSxHQUFFLGtCQUFtQixHQUFHLENBQUNBLEdBQUUsa0JBQWtCK1UsR0FBRSxNQUFNQSxHQUFFLEdBQUcsQ0FBQyxNQUFTLEVBQUcvVSxHQUFFLE1BQU04RyxFQUFDLENBQUMsQ0FBQyxDQUFxSixHQUFwSndPLEdBQUUsVUFBVSxPQUFPLEVBQUUsT0FBTyxhQUFhLEVBQUUsR0FBR0wsR0FBRSxLQUFLLFNBQVNoUixHQUFFLENBQUlBLEdBQUUsV0FBV0EsR0FBRSxVQUFVLElBQUksRUFBRSxPQUFPLGFBQWEsRUFBRSxHQUFHQSxHQUFFLGlCQUFpQm1SLEVBQUMsQ0FBQyxDQUFDLEVBQUssRUFBRUMsR0FBRSx5QkFBeUIsRUFBRSxDQUFDLElBQUlILEdBQUU5RyxHQUFNLEdBQUdBLEVBQUMsSUFBRzhHLEdBQUUsR0FBRSxFQUFHLE1BQUssR0FBR0csR0FBRSx3QkFBd0JILEVBQUMsQ0FBQyxDQUFDLElBQUlDLEdBQUUsVUFBVSxDQUFpSixHQUFoSixHQUFHRixHQUFFLE1BQU0sU0FBU2hSLEdBQUUsQ0FBQ0EsR0FBRSxLQUFJLENBQUUsQ0FBQyxFQUFFLEdBQUdnUixHQUFFLEtBQUssU0FBU2hSLEdBQUUsQ0FBSUEsR0FBRSxXQUFXQSxHQUFFLFVBQVUsT0FBTyxFQUFFLE9BQU8sYUFBYSxFQUFFLEdBQUdBLEdBQUUsbUJBQW1CbVIsRUFBQyxDQUFDLENBQUMsRUFBS0EsR0FBRSxTQUFTLE9BQU8sQ0FBQyxJQUFJblIsR0FBRSxHQUFFLEVBQUcsZUFBZW1SLEdBQUUsU0FBUyxNQUFNLEVBQUtuUixJQUFHQSxHQUFFLGVBQWUsQ0FBQyxNQUFNLFFBQVEsU0FBUyxNQUFNLENBQUMsQ0FBRSxDQUFDLEdBQUdnUixHQUFFLE9BQU8sQ0FBQ2EsR0FBRSxDQUFDLElBQUlmLEdBQUUsRUFBRSxPQUFPLEVBQUtBLEdBQUdBLEdBQUUsVUFBVUUsR0FBRSxNQUFXLE9BQU8sU0FBUyxNQUFNQSxHQUFFLEtBQU0sQ0FBYyxHQUFiLEdBQUdBLEdBQUUsS0FBS3hHLEVBQUMsRUFBSyxFQUFFNEcsR0FBRSwyQkFBMkIsRUFBRSxDQUFDLElBQUlqRixHQUFFaEMsR0FBTSxHQUFHQSxFQUFDLElBQUdnQyxHQUFFLEdBQUUsRUFBRyxNQUFLLEdBQUdpRixHQUFFLDBCQUEwQmpGLEVBQUMsQ0FBQyxDQUFDLEdBQUcyRixFQUFDLENBQUMsRUFBS3RILEdBQUUsWUFBWSxFQUFHLFdBQVcwRyxHQUFFMUcsR0FBRSxXQUFXLEVBQU8wRyxHQUFDLENBQUcsT0FBT2xSLEdBQUUsQ0FBQyxTQUFHbUssR0FBRSxpQkFBaUJnSCxFQUFDLEVBQUUsR0FBR1ksRUFBQyxFQUFRL1IsRUFBQyxDQUFDLEVBQU04QyxHQUFFLEVBQUUsT0FBTyxzQkFBd0UsR0FBL0MwSCxHQUFFLGVBQWUsWUFBWSxJQUFHMUgsR0FBRTBILEdBQUUsWUFBYzFILElBQUcsR0FBR3FILEdBQUUsd0JBQXdCZ0gsRUFBQyxHQUFHLE9BQU8sUUFBVSxLQUFhLFNBQVMsb0JBQW9CLENBQUMsSUFBSWlCLEdBQUUsSUFBSSxRQUFRLFNBQVNwUyxHQUFFOFEsR0FBRSxDQUFDZ0IsR0FBRTlSLEdBQUUrUixHQUFFakIsRUFBQyxDQUFDLEVBQU13QixHQUFFSCxHQUFFQSxHQUFFLFVBQVUsQ0FBQyxTQUFTLG9CQUFvQixVQUFVLENBQUMsT0FBQUcsR0FBRyxFQUFRRixFQUFDLENBQUMsQ0FBQyxDQUFDLENBQUk1SCxHQUFFLFVBQVUsRUFBRyxXQUFXMkgsR0FBRTNILEdBQUUsU0FBUyxFQUFPMkgsR0FBQyxDQUFHLENBQUl0UCxJQUFHLEdBQUdzSCxHQUFFLHFCQUFxQixHQUFHLENBQUMsTUFBTSw4QkFBOEJpSCxHQUFFLE9BQU8sU0FBU0QsR0FBRSxTQUFTLFdBQVcsRUFBRUEsRUFBQyxDQUFDLEdBQUUsQ0FBQyxJQUFJLEdBQUcsR0FBRyxTQUFTLElBQUksQ0FBQyxNQUFNLENBQUMsS0FBSyxTQUFTblIsR0FBRSxDQUFDLE9BQU8sSUFBSSxFQUFFLFFBQVEsU0FBU0EsR0FBRThRLEdBQUUsQ0FBQyxNQUFPLEVBQUksRUFBRSxrQkFBa0IsU0FBUzlRLEdBQUU4USxHQUFFM0UsR0FBRSxDQUFDLE9BQU9uTSxFQUFDLEVBQUUsYUFBYSxTQUFTQSxHQUFFLENBQUMsTUFBTyxFQUFLLEVBQUUsV0FBVyxTQUFTQSxHQUFFOFEsR0FBRTNFLEdBQUU2RSxHQUFFLENBQUMsTUFBTyxFQUFLLEVBQUUsaUJBQWlCLFNBQVNoUixHQUFFOFEsR0FBRTNFLEdBQUUsQ0FBQyxPQUFPLElBQUksQ0FBQyxDQUFDLENBQUMsU0FBUyxHQUFHbk0sR0FBRThRLEdBQUUsQ0FBSUEsR0FBRSxNQUFNQSxHQUFFLEtBQUssQ0FBQyxFQUFFLEdBQUc5USxFQUFDLEVBQUUsR0FBRyxHQUFJLEVBQUM4USxFQUFDLENBQUMsQ0FBQyxTQUFTLEdBQUc5USxHQUFFLENBQUMsT0FBTyxHQUFHQSxFQUFDLENBQUMsQ0FBQyxTQUFTLEdBQUdBLEdBQUVtTSxHQUFFNkUsR0FBRSxDQUFDLEdBQUdoUixJQUFHLEtBQVcsT0FBT21NLEdBQUtBLElBQUcsT0FBV0EsR0FBRSxDQUFBLEdBQU02RSxJQUFHLE9BQVdBLEdBQUUsSUFBRyxJQUFJRixHQUFFLEdBQUc5USxHQUFFLFFBQVEsRUFBRSxPQUFHOFEsSUFBRyxHQUFHQSxHQUFFLE1BQU0sR0FBRyxFQUFFLFNBQVM5USxHQUFFLENBQXNCLEdBQXJCQSxHQUFFQSxHQUFFLFFBQVEsS0FBSyxFQUFFLEVBQUtBLEdBQUUsTUFBTSxFQUFFLENBQUMsR0FBRyxVQUFVLENBQUNnUixHQUFFLEtBQUtoUixHQUFFLE1BQU0sQ0FBQyxDQUFDLEVBQUUsTUFBTSxDQUFDLEdBQUdnUixHQUFFLFFBQVFoUixFQUFDLEVBQUUsRUFBRSxDQUFDLElBQUk4USxHQUFFLEdBQUc5USxFQUFDLEVBQUs4USxJQUFHM0UsR0FBRSxRQUFRMkUsRUFBQyxFQUFFLEdBQUczRSxHQUFFLEtBQUsyRSxFQUFDLENBQUUsQ0FBQyxDQUFDLEVBQVMsR0FBRyxFQUFFOVEsRUFBQyxFQUFFbU0sR0FBRTZFLEVBQUMsQ0FBQyxDQUFDLElBQUksR0FBRyxHQUFNLEdBQUksRUFBQyxpQkFBaUIsbUJBQW1CLFVBQVUsQ0FBQyxHQUFHLEVBQUksQ0FBQyxFQUFFLFNBQVMsR0FBR2hSLEdBQUUsQ0FBSSxJQUFJLEdBQUUsRUFBRyxhQUFhLFdBQVlBLEdBQUMsRUFBUSxHQUFFLEVBQUcsaUJBQWlCLG1CQUFtQkEsRUFBQyxDQUFFLENBQUMsU0FBUyxJQUFJLENBQUksRUFBRSxPQUFPLHlCQUF5QixJQUFPLEdBQUksRUFBQyxLQUFLLG1CQUFtQixZQUFZLGlDQUFpQyxFQUFFLE9BQU8sZUFBZSxxQ0FBcUMsRUFBRSxPQUFPLGFBQWEsS0FBSyxFQUFFLE9BQU8sZUFBZSx5RUFBeUUsRUFBRSxPQUFPLGFBQWEsSUFBSSxFQUFFLE9BQU8sZUFBZSw2RUFBNkUsQ0FBRSxDQUFDLFNBQVMsSUFBSSxDQUFDLElBQUlBLEdBQUUsS0FBSyxjQUFjLDBCQUEwQixFQUFFLE9BQUdBLEdBQVUsRUFBRUEsR0FBRSxPQUFPLEVBQWMsSUFBSyxDQUFDLFNBQVMsSUFBSSxDQUFDLElBQUlBLEdBQUUsR0FBRSxFQUFNQSxLQUFHLEVBQUUsT0FBTyxHQUFHLEVBQUUsT0FBT0EsRUFBQyxFQUFFLENBQUMsVUFBRyxVQUFVLENBQUMsR0FBSSxFQUFDLEdBQUUsRUFBRyxJQUFJQSxHQUFFLEtBQUssS0FBSyxHQUFHQSxFQUFDLEVBQUUsSUFBSThRLEdBQUUsR0FBSSxFQUFDLGlCQUFpQixzREFBc0QsRUFBRTlRLEdBQUUsaUJBQWlCLGFBQWEsU0FBU0EsR0FBRSxDQUFDLElBQUk4USxHQUFFOVEsR0FBRSxPQUFXbU0sR0FBRSxHQUFHMkUsRUFBQyxFQUFLM0UsSUFBR0EsR0FBRSxLQUFLQSxHQUFFLElBQUksT0FBUSxDQUFDLEVBQUUsTUFBTUEsR0FBRSxPQUFPLFdBQVcsT0FBTyxXQUFXLEtBQUssTUFBTSxFQUFFLEtBQUssT0FBTyxXQUFXLFNBQVNuTSxHQUFFLENBQUlBLEdBQUUsT0FBT0EsR0FBRSxNQUFNLE1BQU0sR0FBSSxFQUFDLEdBQUc4USxHQUFFLFNBQVM5USxHQUFFLENBQUMsR0FBR0EsR0FBRSxnQkFBZ0IsQ0FBQyxTQUFTLEdBQUUsRUFBRyxhQUFhLEVBQUUsQ0FBQyxDQUFDLENBQUMsR0FBVW1NLElBQUdBLEdBQUVuTSxFQUFDLENBQUcsRUFBRSxXQUFXLFVBQVUsQ0FBQyxHQUFHQSxHQUFFLFlBQVksQ0FBQSxDQUFFLEVBQUVBLEdBQUUsSUFBSSxFQUFFLENBQUMsQ0FBQyxDQUFDLEVBQVMsQ0FBQyxFQUFHLENBQUEsQ0FBQyx1R0M4Qmw2OUMsT0FBTyxPQUFTNlQsZUFDaEIsT0FBTyxLQUFPLEtBRWRBLGVBQU8sS0FBSyxTQUFVLEtBQU8sQ0FDM0IsV0FBWSxHQUNaLE1BQU8sTUFDUCx1QkFBd0IsR0FDeEIseUJBQTBCLEdBQzFCLFlBQWEsSUFBSSxZQUFZLHFCQUFzQixDQUNqRCxnQkFBaUIsRUFBQSxDQUNsQixFQUNELFdBQVksQ0FBQyxFQUNiLGFBQWMsS0FDZCxZQUFhLEdBQ2IsS0FBTSxVQUFZLENBQ2hCLFFBQVEsSUFBSSxNQUFNLEVBQ2IsS0FBQSxPQUFPLGFBQWU1VSxJQUFRLENBQ2pDLEtBQUssb0JBQW9CQSxFQUFHLENBQUEsQ0FDN0IsRUFDSSxLQUFBLE9BQU8sUUFBVUEsSUFBUSxDQUM1QixLQUFLLGVBQWVBLEVBQUcsQ0FBQSxDQUN4QixFQUNJLEtBQUEsT0FBTyx5QkFBMkJBLElBQVEsQ0FDN0MsS0FBSyx5QkFBeUJBLEVBQUcsQ0FBQSxDQUNsQyxFQUVJLEtBQUEsWUFBWSxVQUFhNlUsSUFBTyxDQUNuQyxLQUFLLHlCQUF5QkEsRUFBRSxDQUFBLEVBRTdCLEtBQUEsWUFBWSxRQUFVLElBQU0sQ0FDL0IsS0FBSyx1QkFBdUIsQ0FBQSxDQUVoQyxFQUNBLG9CQUFxQixTQUFVclgsR0FBZSxDQUN4QyxHQUFBQSxHQUFNLFNBQVcsRUFBRyxDQUN0QixLQUFLLHVCQUF5QixHQUM5QixNQUNGLENBQ0EsS0FBSyx1QkFBeUIsR0FDOUIsS0FBSyxjQUFjLENBQ3JCLEVBQ0EsZUFBZ0IsU0FBVUEsR0FBZSxDQUN2QyxHQUFJQSxLQUFVLE1BQU8sQ0FDbkIsS0FBSyx1QkFBeUIsR0FDOUIsS0FBSyxXQUFhLEdBQ2xCLE1BQ0YsQ0FDQSxLQUFLLHVCQUF5QixHQUMxQixLQUFLLFdBQVcsT0FBUyxHQUMzQixLQUFLLGNBQWMsQ0FFdkIsRUFDQSx5QkFBMEIsU0FBVUEsR0FBZ0IsQ0FFbEQsR0FEUSxRQUFBLElBQUksaUNBQWtDQSxFQUFLLEVBQy9DLENBQUNBLEdBQ0ksS0FBQSxLQUFLLFdBQVcsT0FBUyxHQUFHLENBQzNCLE1BQUFxWCxHQUFLLEtBQUssV0FBVyxNQUFNLEVBQ2pDLEtBQUssYUFBYUEsRUFBRSxDQUN0QixDQUVKLEVBQ0Esb0JBQXFCLFNBQVVBLEdBQW1CLENBQzVDQSxHQUFHLE1BQVEsU0FDYixLQUFLLGNBQWMsQ0FFdkIsRUFDQSxjQUFlLFVBQVksQ0FDbkIsTUFBQUMsR0FBVyxTQUFTLGNBQWMsbUJBQW1CLEVBQzNELEdBQUksQ0FBQ0EsR0FDRyxNQUFBLElBQUksTUFBTSwwQkFBMEIsRUFFdEMsTUFBQTNHLEdBQVEsSUFBSSxZQUFZLGdCQUFpQixDQUM3QyxPQUFRLENBQUUsSUFBSyxPQUFRLENBQUEsQ0FDeEIsRUFDRDJHLEdBQVMsY0FBYzNHLEVBQUssQ0FDOUIsRUFDQSx5QkFBMEIsU0FBVTBHLEdBQWtCLENBQ3BELEdBQUksS0FBSyx1QkFBd0IsQ0FDMUIsS0FBQSxXQUFXLEtBQUtBLEVBQUUsRUFDdkIsTUFDRixDQUNBLEtBQUssYUFBYUEsRUFBRSxDQUN0QixFQUNBLHVCQUF3QixVQUFZLENBQ2xDLFFBQVEsSUFBSSxXQUFXLEVBQ3ZCLEtBQUsseUJBQTJCLEdBQzVCLEtBQUssY0FDUCxhQUFhLEtBQUssWUFBWSxFQUUzQixLQUFBLGFBQWUsV0FBVyxJQUFNLENBQ25DLEtBQUsseUJBQTJCLElBQy9CLEdBQUksQ0FDVCxFQUNBLGFBQWMsU0FBVUEsR0FBa0IsQ0FDeEMsTUFBTUUsR0FBTSxLQUFLLE1BQU1GLEdBQUcsSUFBSSxFQUM5QixLQUFLRSxFQUFHLENBQ1YsRUFDQSxZQUFhLFNBQVVGLEdBQWdCLENGL0h6QyxJQUFBRyxHQUFBQyxHRWlJSSxNQUFNQyxJQUFjRCxJQUFBRCxHQURISCxHQUFHLE9BRWpCLFFBQVEsWUFBWSxJQURILFlBQUFHLEdBRWhCLGNBQWMsZUFGRSxZQUFBQyxHQUVZLFlBQzVCLEdBQUEsQ0FDRixNQUFNRSxHQUFPLEtBQUssTUFBTUQsSUFBZSxFQUFFLEVBQ3pDLEtBQUssWUFBYyxLQUFLLFVBQVVDLEdBQU0sS0FBTSxDQUFDLE9BQ3JDLENBQ1YsS0FBSyxZQUFjRCxJQUFlLEVBQ3BDLENBQ2UsU0FBUyxlQUFlLGFBQWEsRUFDN0MsVUFBVSxDQUNuQixDQUNGLEVBQUUsRUFFRk4sZUFBTyxNQUFNLEVBRWIsU0FBUyxLQUFLRyxHQUFlLENGako3QixJQUFBQyxHQUFBQyxHQUFBRyxHQUFBQyxHQUFBQyxHQUFBQyxHRWtKRSxNQUFNVCxHQUFXLFNBQVMsY0FBY0MsR0FBSSxNQUFNLEVBQ2xELEdBQUksQ0FBQ0QsR0FDRyxNQUFBLElBQUksTUFBTSw2QkFBNkJDLEdBQUksTUFBTSxJQUFJQSxHQUFJLEVBQUUsRUFBRSxFQUdyRSxNQUFNNUMsR0FBSTRDLEdBQUksS0FBSyxNQUFNLEdBQUcsRUFDdEJTLEdBQVdyRCxHQUFFLENBQUMsRUFDZHNELEdBQWF0RCxHQUFFLE9BQVMsRUFBSUEsR0FBRSxDQUFDLEVBQUksR0FFbkN1RCxHQUFRLFNBQVMsY0FDdkJBLEdBQU0sV0FBV1osRUFBUSxFQUN6QixNQUFNYSxHQUFtQkQsR0FBTSx5QkFBeUJYLEdBQUksTUFBTSxFQUVsRSxPQUFRUyxHQUFVLENBQ2hCLElBQUssWUFDSCxLQUFPVixHQUFTLFlBQ0xBLEdBQUEsWUFBWUEsR0FBUyxVQUFVLEVBRTFDQSxHQUFTLFlBQVlhLEVBQWdCLEVBQ3JDLE1BQ0YsSUFBSyxhQUNNWCxHQUFBRixHQUFBLGFBQUEsTUFBQUUsR0FBWSxhQUFhVyxHQUFrQmIsSUFDcEQsTUFDRixJQUFLLGVBQ01HLEdBQUFILEdBQUEsYUFBQSxNQUFBRyxHQUFZLGFBQWFVLEdBQWtCYixJQUNwRCxNQUNGLElBQUssYUFDTUEsR0FBQSxhQUFhYSxHQUFrQmIsR0FBUyxVQUFVLEVBQzNELE1BQ0YsSUFBSyxZQUNIQSxHQUFTLFlBQVlhLEVBQWdCLEVBQ3JDLE1BQ0YsSUFBSyxZQUNIUCxHQUFBTixHQUFTLGFBQVQsTUFBQU0sR0FBcUIsYUFBYU8sR0FBa0JiLEdBQVMsYUFDN0QsS0FHSixDQUVBLEdBQUlXLEdBQVksQ0FDUnRELE1BQUFBLEdBQUlzRCxHQUFXLE1BQU0sR0FBRyxFQUN4QkcsR0FBYXpELEdBQUUsQ0FBQyxFQUNoQjBELEdBQWUxRCxHQUFFLENBQUMsRUFDeEIsT0FBUXlELEdBQVksQ0FDbEIsSUFBSyxTQUNILE9BQVFDLEdBQWMsQ0FDcEIsSUFBSyxRQUNGUixHQUFBTSxHQUFpQixhQUFqQixNQUFBTixHQUE2QyxpQkFDOUMsTUFDRixJQUFLLGFBQ0ZDLEdBQUFSLEdBQVMsWUFBVCxNQUFBUSxHQUFvQyxlQUFlLENBQ2xELE1BQU8sTUFDUCxTQUFVLFNBQUEsR0FFWixNQUNGLElBQUssZUFDRkMsR0FBQVQsR0FBUyxjQUFULE1BQUFTLEdBQXNDLGVBQWUsQ0FDcEQsTUFBTyxNQUNQLFNBQVUsU0FBQSxHQUVaLEtBQ0osQ0FDQSxLQUNKLENBQ0YsQ0FDRiIsInhfZ29vZ2xlX2lnbm9yZUxpc3QiOlswLDFdfQ==
 `)
 
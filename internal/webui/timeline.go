@@ -0,0 +1,62 @@
+package webui
+
+// gomon is a simple command line tool that watches your files and automatically restarts the application when it detects any changes in the working directory.
+// Copyright (C) 2023 John Dudmesh
+
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+
+// You should have received a copy of the GNU General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>.
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/jdudmesh/gomon/internal/utils"
+)
+
+// timelineComponentHandler renders the timeline of the most recent runs, the bars are drawn by the client from the
+// runs embedded in the component
+func (c *server) timelineComponentHandler(w http.ResponseWriter, r *http.Request) {
+	runs, err := c.db.FindTimeline()
+	if err != nil {
+		log.Errorf("finding timeline: %v", err)
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+
+	runIDs := make([]string, 0, len(runs))
+	for _, run := range runs {
+		runIDs = append(runIDs, run.ID)
+	}
+	triggers, err := c.db.FindRunTriggers(runIDs)
+	if err != nil {
+		log.Errorf("finding triggers: %v", err)
+	}
+	for _, run := range runs {
+		run.Reason = restartReason(triggers[run.ID])
+	}
+
+	w.Header().Set("Content-Type", "text/html; charset=utf-8")
+	err = Timeline(runs).Render(r.Context(), w)
+	if err != nil {
+		log.Errorf("rendering: %v", err)
+	}
+}
+
+func timelineJSON(runs []*utils.TimelineRun) string {
+	buf, err := json.Marshal(runs)
+	if err != nil {
+		log.Errorf("encoding timeline: %v", err)
+		return "[]"
+	}
+	return string(buf)
+}
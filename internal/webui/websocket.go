@@ -0,0 +1,110 @@
+package webui
+
+// gomon is a simple command line tool that watches your files and automatically restarts the application when it detects any changes in the working directory.
+// Copyright (C) 2023 John Dudmesh
+
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+
+// You should have received a copy of the GNU General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>.
+
+import (
+	"sync"
+
+	"golang.org/x/net/websocket"
+)
+
+// websocketHub fans out the same events as the SSE stream to websocket clients, for networks where SSE is buffered
+type websocketHub struct {
+	clients    map[chan []byte]struct{}
+	clientLock sync.Mutex
+	bufferSize int
+}
+
+func newWebsocketHub(bufferSize int) *websocketHub {
+	return &websocketHub{
+		clients:    map[chan []byte]struct{}{},
+		bufferSize: bufferSize,
+	}
+}
+
+func (h *websocketHub) Publish(data []byte) {
+	h.clientLock.Lock()
+	defer h.clientLock.Unlock()
+
+	for client := range h.clients {
+		select {
+		case client <- data:
+		default:
+			log.Warn("websocket client is not keeping up, dropping event")
+		}
+	}
+}
+
+// Clients returns the number of connected clients
+func (h *websocketHub) Clients() int {
+	h.clientLock.Lock()
+	defer h.clientLock.Unlock()
+
+	return len(h.clients)
+}
+
+func (h *websocketHub) Close() {
+	h.clientLock.Lock()
+	defer h.clientLock.Unlock()
+
+	for client := range h.clients {
+		close(client)
+		delete(h.clients, client)
+	}
+}
+
+func (h *websocketHub) Handler() websocket.Handler {
+	return func(conn *websocket.Conn) {
+		defer conn.Close()
+
+		client := make(chan []byte, h.bufferSize)
+		h.clientLock.Lock()
+		h.clients[client] = struct{}{}
+		h.clientLock.Unlock()
+
+		defer func() {
+			h.clientLock.Lock()
+			delete(h.clients, client)
+			h.clientLock.Unlock()
+		}()
+
+		// the client never sends anything, reading is just used to detect when the connection is closed
+		closed := make(chan struct{})
+		go func() {
+			defer close(closed)
+			var msg string
+			for websocket.Message.Receive(conn, &msg) == nil {
+			}
+		}()
+
+		for {
+			select {
+			case data, ok := <-client:
+				if !ok {
+					return
+				}
+				err := websocket.Message.Send(conn, string(data))
+				if err != nil {
+					log.Debugf("writing to websocket: %v", err)
+					return
+				}
+			case <-closed:
+				return
+			}
+		}
+	}
+}
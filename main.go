@@ -19,14 +19,32 @@ package main
 import (
 	"bytes"
 	"context"
+	"encoding/json"
+	"errors"
 	"flag"
 	"fmt"
 	"os"
+	"os/signal"
 	"path/filepath"
+	"sort"
 	"strings"
+	"syscall"
+	"time"
 
 	"github.com/jdudmesh/gomon/internal/app"
+	"github.com/jdudmesh/gomon/internal/attach"
+	"github.com/jdudmesh/gomon/internal/auth"
 	"github.com/jdudmesh/gomon/internal/config"
+	"github.com/jdudmesh/gomon/internal/console"
+	"github.com/jdudmesh/gomon/internal/doctor"
+	"github.com/jdudmesh/gomon/internal/hub"
+	"github.com/jdudmesh/gomon/internal/notification"
+	"github.com/jdudmesh/gomon/internal/process"
+	"github.com/jdudmesh/gomon/internal/scaffold"
+	"github.com/jdudmesh/gomon/internal/services"
+	"github.com/jdudmesh/gomon/internal/utils"
+	"github.com/jdudmesh/gomon/internal/watcher"
+	"github.com/jdudmesh/gomon/internal/webui"
 	log "github.com/sirupsen/logrus"
 )
 
@@ -41,170 +59,735 @@ func main() {
 	formatter := new(logFormatter)
 	log.SetFormatter(formatter)
 
-	cfg, err := loadConfig()
-	if err != nil {
-		log.Fatalf("loading config: %v", err)
+	if len(os.Args) > 1 && os.Args[1] == "init" {
+		err := runInit(os.Args[2:])
+		if err != nil {
+			log.Fatalf("init: %v", err)
+		}
+		return
 	}
 
-	if cfg.Entrypoint == "" {
-		log.Fatalf("entrypoint is required")
+	if len(os.Args) > 1 && os.Args[1] == "simulate" {
+		err := runSimulate(os.Args[2:])
+		if err != nil {
+			log.Fatalf("simulate: %v", err)
+		}
+		return
 	}
 
-	err = os.Chdir(cfg.RootDirectory)
-	if err != nil {
-		log.Fatalf("Cannot set working directory: %v", err)
+	if len(os.Args) > 1 && os.Args[1] == "check" {
+		ok, err := runCheck(os.Args[2:])
+		if err != nil {
+			log.Fatalf("check: %v", err)
+		}
+		if !ok {
+			os.Exit(1)
+		}
+		return
 	}
 
-	// create a context that can be used to cancel all the other components
-	ctx, ctxCancel := context.WithCancel(context.Background())
-	defer ctxCancel()
+	if len(os.Args) > 1 && os.Args[1] == "doctor" {
+		ok, err := runDoctor(os.Args[2:])
+		if err != nil {
+			log.Fatalf("doctor: %v", err)
+		}
+		if !ok {
+			os.Exit(1)
+		}
+		return
+	}
 
-	// create the app, this orchestrates all the other components
-	app, err := app.New(cfg)
-	if err != nil {
-		log.Fatalf("creating app: %v", err)
+	if len(os.Args) > 1 && os.Args[1] == "token" {
+		err := runToken(os.Args[2:])
+		if err != nil {
+			log.Fatalf("token: %v", err)
+		}
+		return
 	}
-	defer app.Close()
 
-	// run the web proxy
-	go func() {
-		err = app.RunProxy()
+	if len(os.Args) > 1 && os.Args[1] == "agent" {
+		err := runAgent(os.Args[2:])
 		if err != nil {
-			log.Errorf("starting proxy: %v", err)
-			ctxCancel()
+			log.Fatalf("agent: %v", err)
 		}
-	}()
+		return
+	}
 
-	// run the user interface
-	go func() {
-		err := app.RunWebUI()
+	if len(os.Args) > 1 && os.Args[1] == "attach" {
+		err := runAttach(os.Args[2:])
 		if err != nil {
-			log.Errorf("starting web UI: %v", err)
-			ctxCancel()
+			log.Fatalf("attach: %v", err)
 		}
-	}()
+		return
+	}
 
-	// start the console
-	go func() {
-		err := app.RunConsole()
+	if len(os.Args) > 1 && os.Args[1] == "hub" {
+		err := runHub(os.Args[2:])
 		if err != nil {
-			log.Errorf("starting console: %v", err)
-			ctxCancel()
+			log.Fatalf("hub: %v", err)
 		}
-	}()
+		return
+	}
 
-	// start the IPC server
-	go func() {
-		err := app.RunNotifer()
+	if len(os.Args) > 1 && os.Args[1] == "services" {
+		err := runServices(os.Args[2:])
 		if err != nil {
-			log.Errorf("starting IPC server: %v", err)
-			ctxCancel()
+			log.Fatalf("services: %v", err)
 		}
-	}()
+		return
+	}
 
-	// start listening for file changes
-	go func() {
-		err := app.MonitorFileChanges(ctx)
+	if len(os.Args) > 1 && os.Args[1] == "logs" {
+		err := runLogs(os.Args[2:])
 		if err != nil {
-			ctxCancel()
+			log.Fatalf("logs: %v", err)
 		}
-	}()
+		return
+	}
 
-	// monitor and handle signals
-	go func() {
-		err := app.ProcessSignals()
+	if len(os.Args) > 1 && os.Args[1] == "status" {
+		err := runStatus(os.Args[2:])
 		if err != nil {
-			ctxCancel()
+			log.Fatalf("status: %v", err)
 		}
-	}()
+		return
+	}
 
-	// monitor and handle restart events
-	go app.ProcessRestartEvents(ctx)
+	if len(os.Args) > 1 && os.Args[1] == "run" {
+		err := runTask(os.Args[2:])
+		if err != nil {
+			log.Fatalf("run: %v", err)
+		}
+		return
+	}
 
-	// all components should be up and running by now
-	pid := os.Getpid()
-	log.Infof("gomon started with pid %d", pid)
+	// `gomon test` runs the tests affected by each change instead of the entrypoint
+	args := os.Args[1:]
+	isTestMode := len(args) > 0 && args[0] == "test"
+	if isTestMode {
+		args = args[1:]
+	}
 
-	// this is the main process loop, just keep restarting the child process until the main context is cancelled or an error occurs
-	if !cfg.ProxyOnly {
-		go func() {
-			for ctx.Err() == nil {
-				err := app.RunChildProcess(cfg)
-				if err != nil {
-					ctxCancel()
-				}
-			}
-		}()
+	cfg, err := loadConfig(args, isTestMode)
+	if err != nil {
+		log.Fatalf("loading config: %v", err)
 	}
 
-	<-ctx.Done()
+	switch cfg.LogFormat {
+	case "", "text":
+	case "json":
+		log.SetFormatter(&log.JSONFormatter{})
+	default:
+		log.Fatalf("unsupported log format: %s", cfg.LogFormat)
+	}
+
+	// compose services run the command from the compose file
+	isComposeService := cfg.Runtime == config.RuntimeDocker && cfg.Docker.Compose != ""
+	if cfg.Entrypoint == "" && cfg.Mode != config.ModeTest && !isComposeService {
+		// offer to create a config file the first time gomon is run in a project
+		if cfg.ConfigPath != "" || !isInteractive() {
+			log.Fatalf("entrypoint is required")
+		}
+
+		_, err = scaffold.Wizard(cfg.RootDirectory, os.Stdin, os.Stdout)
+		if err != nil {
+			log.Fatalf("creating config file: %v", err)
+		}
+
+		cfg, err = loadConfig(args, isTestMode)
+		if err != nil {
+			log.Fatalf("loading config: %v", err)
+		}
+	}
+
+	err = os.Chdir(cfg.RootDirectory)
+	if err != nil {
+		log.Fatalf("Cannot set working directory: %v", err)
+	}
+
+	opts := app.RunOptions{Watch: true, HandleSignals: true, ReadCommands: true}
+
+	// create the app, this orchestrates all the other components
+	app, err := app.New(cfg)
+	if err != nil {
+		log.Fatalf("creating app: %v", err)
+	}
+	defer app.Close()
+
+	err = app.Run(context.Background(), opts)
+	if err != nil {
+		log.Errorf("running gomon: %v", err)
+	}
 }
 
-func loadConfig() (config.Config, error) {
+// loadConfig reads the config file and applies the command line flags, in test mode the arguments are the packages
+// to test rather than the entrypoint
+func loadConfig(args []string, isTestMode bool) (config.Config, error) {
 	var configPath string
 	var rootDirectory string
 	var entrypoint string
 	var entrypointArgs []string
 	var envFiles string
 	var proxyOnly bool
+	var logFormat string
+	var useTUI bool
+	var profile string
+	var statusLine bool
+	var autoPorts bool
 
 	fs := flag.NewFlagSet("gomon flags", flag.ExitOnError)
 	fs.StringVar(&configPath, "conf", "", "Path to a config file (gomon.config.yml))")
 	fs.StringVar(&rootDirectory, "dir", "", "The directory to watch")
 	fs.StringVar(&envFiles, "env", "", "A comma separated list of env files to load")
 	fs.BoolVar(&proxyOnly, "proxy-only", false, "Only start the proxy, do not start the child process")
-	err := fs.Parse(os.Args[1:])
+	fs.StringVar(&logFormat, "log-format", "", "Format of gomon's own log output (text|json)")
+	fs.BoolVar(&useTUI, "tui", false, "Run an interactive terminal UI")
+	fs.BoolVar(&statusLine, "status-line", false, "Show a status line at the bottom of the terminal when there is no UI")
+	fs.BoolVar(&autoPorts, "auto-ports", false, "Use the next free ports for the proxy and UI if theirs are in use")
+	fs.StringVar(&profile, "profile", "", "A profile name passed to the child process as GOMON_PROFILE")
+	err := fs.Parse(args)
 	if err != nil {
 		log.Fatalf("parsing flags: %v", err)
 	}
 
-	args := strings.Split(fs.Arg(0), " ")
-	entrypoint = args[0]
-	entrypointArgs = args[1:]
+	cfg, err := config.Load(configPath, rootDirectory)
+	if err != nil {
+		log.Fatalf("loading config: %v", err)
+	}
+
+	if isTestMode {
+		cfg.Mode = config.ModeTest
+		if fs.NArg() > 0 {
+			cfg.Test.Packages = fs.Args()
+		}
+	} else {
+		args := strings.Split(fs.Arg(0), " ")
+		entrypoint = args[0]
+		entrypointArgs = args[1:]
+	}
 
-	if rootDirectory == "" {
-		curDir, err := os.Getwd()
+	cfg.CommandLine.Entrypoint = entrypoint
+	cfg.CommandLine.EntrypointArgs = entrypointArgs
+	if envFiles != "" {
+		cfg.CommandLine.EnvFiles = strings.Split(envFiles, ",")
+	}
+	cfg.CommandLine.Profile = profile
+	cfg.ApplyCommandLine()
+
+	if proxyOnly {
+		cfg.ProxyOnly = true
+	}
+
+	if logFormat != "" {
+		cfg.LogFormat = logFormat
+	}
+
+	if useTUI {
+		cfg.TUI = true
+	}
+
+	if statusLine {
+		cfg.StatusLine = true
+	}
+
+	if autoPorts {
+		cfg.AutoPorts = true
+	}
+
+	return cfg, nil
+}
+
+func runSimulate(args []string) error {
+	var configPath string
+	var rootDirectory string
+
+	fs := flag.NewFlagSet("gomon simulate flags", flag.ExitOnError)
+	fs.StringVar(&configPath, "conf", "", "Path to a config file (gomon.config.yml))")
+	fs.StringVar(&rootDirectory, "dir", "", "The directory to watch")
+	err := fs.Parse(args)
+	if err != nil {
+		return fmt.Errorf("parsing flags: %w", err)
+	}
+
+	if fs.NArg() != 1 {
+		return errors.New("usage: gomon simulate [--conf <config file>] [--dir <root directory>] <script>")
+	}
+
+	// only the outcome of each event is of interest
+	log.SetLevel(log.WarnLevel)
+
+	script, err := os.Open(fs.Arg(0))
+	if err != nil {
+		return fmt.Errorf("opening script: %w", err)
+	}
+	defer script.Close()
+
+	cfg, err := config.Load(configPath, rootDirectory)
+	if err != nil {
+		return fmt.Errorf("loading config: %w", err)
+	}
+
+	cfg.RootDirectory, err = filepath.Abs(cfg.RootDirectory)
+	if err != nil {
+		return fmt.Errorf("resolving root directory: %w", err)
+	}
+
+	return watcher.Simulate(cfg, script, os.Stdout)
+}
+
+// runCheck reports problems with the reload rules in the config file, it returns false if any were found
+func runCheck(args []string) (bool, error) {
+	var configPath string
+	var rootDirectory string
+
+	fs := flag.NewFlagSet("gomon check flags", flag.ExitOnError)
+	fs.StringVar(&configPath, "conf", "", "Path to a config file (gomon.config.yml))")
+	fs.StringVar(&rootDirectory, "dir", "", "The directory to watch")
+	err := fs.Parse(args)
+	if err != nil {
+		return false, fmt.Errorf("parsing flags: %w", err)
+	}
+
+	cfg, err := config.Load(configPath, rootDirectory)
+	if err != nil {
+		return false, fmt.Errorf("loading config: %w", err)
+	}
+
+	findings := watcher.Lint(cfg)
+	for _, finding := range findings {
+		fmt.Println(finding)
+	}
+	if len(findings) > 0 {
+		return false, nil
+	}
+
+	fmt.Println("no problems found")
+	return true, nil
+}
+
+// runTask runs a named task from the config file, using the running gomon instance if there is one so that the
+// output appears in its UI, otherwise the task is run here and its output written to the event store
+func runTask(args []string) error {
+	var configPath string
+	var rootDirectory string
+
+	fs := flag.NewFlagSet("gomon run flags", flag.ExitOnError)
+	fs.StringVar(&configPath, "conf", "", "Path to a config file (gomon.config.yml))")
+	fs.StringVar(&rootDirectory, "dir", "", "The project root directory")
+	err := fs.Parse(args)
+	if err != nil {
+		return fmt.Errorf("parsing flags: %w", err)
+	}
+
+	if fs.NArg() != 1 {
+		return errors.New("usage: gomon run [--conf <config file>] [--dir <root directory>] <task>")
+	}
+
+	cfg, err := config.Load(configPath, rootDirectory)
+	if err != nil {
+		return fmt.Errorf("loading config: %w", err)
+	}
+
+	name := fs.Arg(0)
+	command, ok := cfg.Tasks[name]
+	if !ok {
+		names := []string{}
+		for k := range cfg.Tasks {
+			names = append(names, k)
+		}
+		sort.Strings(names)
+		return fmt.Errorf("unknown task %s, available tasks: %s", name, strings.Join(names, ", "))
+	}
+
+	n, err := webui.RequestTask(cfg, name)
+	if err == nil {
+		log.Infof("task %s requested, event id: %s", name, n.ID)
+		return nil
+	}
+	if !errors.Is(err, webui.ErrNotRunning) {
+		return err
+	}
+
+	log.Infof("gomon is not running, running task %s locally", name)
+
+	db, err := utils.NewStore(cfg)
+	if err != nil {
+		return fmt.Errorf("opening database: %w", err)
+	}
+	defer db.Close()
+
+	task := process.NewOutOfBandTask(cfg.RootDirectory, command, os.Environ())
+	return task.Run(notification.NextID(), func(n notification.Notification) error {
+		switch n.Type {
+		case notification.NotificationTypeOOBTaskStdOut:
+			fmt.Fprintln(os.Stdout, n.Message)
+		case notification.NotificationTypeOOBTaskStdErr:
+			fmt.Fprintln(os.Stderr, n.Message)
+		case notification.NotificationTypeOOBTaskComplete:
+			log.Info(n.Message)
+		}
+		return db.Notify(n)
+	})
+}
+
+// runStatus prints a dump of a running gomon instance's internal state
+func runStatus(args []string) error {
+	var configPath string
+	var rootDirectory string
+	var asJSON bool
+
+	fs := flag.NewFlagSet("gomon status flags", flag.ExitOnError)
+	fs.StringVar(&configPath, "conf", "", "Path to a config file (gomon.config.yml))")
+	fs.StringVar(&rootDirectory, "dir", "", "The project root directory")
+	fs.BoolVar(&asJSON, "json", false, "Print the state as JSON")
+	err := fs.Parse(args)
+	if err != nil {
+		return fmt.Errorf("parsing flags: %w", err)
+	}
+
+	cfg, err := config.Load(configPath, rootDirectory)
+	if err != nil {
+		return fmt.Errorf("loading config: %w", err)
+	}
+
+	state, err := webui.RequestState(cfg)
+	if errors.Is(err, webui.ErrNotRunning) {
+		return fmt.Errorf("%w, without the UI send SIGUSR2 to gomon to write the state to its console", err)
+	} else if err != nil {
+		return err
+	}
+
+	if asJSON {
+		enc := json.NewEncoder(os.Stdout)
+		enc.SetIndent("", "  ")
+		return enc.Encode(state)
+	}
+
+	state.Format(os.Stdout)
+	return nil
+}
+
+// runAttach shows the output of a gomon instance running elsewhere e.g. in a devcontainer
+func runAttach(args []string) error {
+	var token string
+	var useTUI bool
+
+	fs := flag.NewFlagSet("gomon attach flags", flag.ExitOnError)
+	fs.StringVar(&token, "token", "", "A token with the read:events scope, defaults to GOMON_TOKEN")
+	fs.BoolVar(&useTUI, "tui", false, "Show the output in the interactive terminal UI")
+	err := fs.Parse(args)
+	if err != nil {
+		return fmt.Errorf("parsing flags: %w", err)
+	}
+
+	if fs.NArg() != 1 {
+		return errors.New("usage: gomon attach [--token <token>] [--tui] <url>")
+	}
+
+	if token == "" {
+		token = os.Getenv("GOMON_TOKEN")
+	}
+
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+
+	return attach.Attach(ctx, attach.Options{
+		URL:   fs.Arg(0),
+		Token: token,
+		TUI:   useTUI,
+	})
+}
+
+// runHub shows the gomon instances of several projects in a single dashboard
+func runHub(args []string) error {
+	var listen string
+	var token string
+
+	fs := flag.NewFlagSet("gomon hub flags", flag.ExitOnError)
+	fs.StringVar(&listen, "listen", hub.DefaultListen, "The address to serve the dashboard on")
+	fs.StringVar(&token, "token", "", "A token with the read:events and control:restart scopes for projects given as URLs, defaults to GOMON_TOKEN")
+	err := fs.Parse(args)
+	if err != nil {
+		return fmt.Errorf("parsing flags: %w", err)
+	}
+
+	if fs.NArg() == 0 {
+		return errors.New("usage: gomon hub [--listen <address>] [--token <token>] [name=]<directory|url>...")
+	}
+
+	if token == "" {
+		token = os.Getenv("GOMON_TOKEN")
+	}
+
+	projects := []hub.Project{}
+	for _, arg := range fs.Args() {
+		p, err := hub.ParseProject(arg, token)
 		if err != nil {
-			log.Fatalf("getting current directory: %v", err)
+			return err
 		}
-		rootDirectory = curDir
+		projects = append(projects, p)
 	}
 
-	if configPath == "" {
-		nextConfigPath := filepath.Join(rootDirectory, config.DefaultConfigFileName)
-		if _, err := os.Stat(nextConfigPath); err == nil {
-			configPath = nextConfigPath
-		} else if !os.IsNotExist(err) {
-			log.Fatalf("checking for default config file: %v", err)
+	h, err := hub.New(projects)
+	if err != nil {
+		return err
+	}
+
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+
+	return h.ListenAndServe(ctx, listen)
+}
+
+// runServices runs the services listed in the config file, starting each once the services it depends on are ready
+func runServices(args []string) error {
+	var configPath string
+	var rootDirectory string
+	var listen string
+
+	fs := flag.NewFlagSet("gomon services flags", flag.ExitOnError)
+	fs.StringVar(&configPath, "conf", "", "Path to a config file (gomon.config.yml))")
+	fs.StringVar(&rootDirectory, "dir", "", "The monorepo's root directory, the services' directories are relative to it")
+	fs.StringVar(&listen, "listen", services.DefaultListen, "The address to serve the dependency graph on")
+	err := fs.Parse(args)
+	if err != nil {
+		return fmt.Errorf("parsing flags: %w", err)
+	}
+
+	cfg, err := config.Load(configPath, rootDirectory)
+	if err != nil {
+		return fmt.Errorf("loading config: %w", err)
+	}
+
+	s, err := services.New(cfg)
+	if err != nil {
+		return err
+	}
+
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+
+	return s.ListenAndServe(ctx, listen)
+}
+
+// runLogs prints the output of the running gomon instance, following it with --follow
+func runLogs(args []string) error {
+	var configPath string
+	var rootDirectory string
+	var opts attach.LogsOptions
+
+	fs := flag.NewFlagSet("gomon logs flags", flag.ExitOnError)
+	fs.StringVar(&configPath, "conf", "", "Path to a config file (gomon.config.yml))")
+	fs.StringVar(&rootDirectory, "dir", "", "The project root directory")
+	fs.StringVar(&opts.URL, "url", "", "The URL of a gomon instance running elsewhere, defaults to the one in the config")
+	fs.StringVar(&opts.Token, "token", "", "A token with the read:events scope, defaults to GOMON_TOKEN")
+	fs.BoolVar(&opts.Follow, "follow", false, "Keep printing new events as they happen")
+	fs.BoolVar(&opts.Follow, "f", false, "Shorthand for --follow")
+	fs.StringVar(&opts.RunID, "run", "", "The run to show, defaults to the latest")
+	fs.StringVar(&opts.Filter, "filter", "", "Only show events containing the text")
+	fs.StringVar(&opts.Level, "level", "", "Only show lines of output at this level or above (debug, info, warn, error)")
+	err := fs.Parse(args)
+	if err != nil {
+		return fmt.Errorf("parsing flags: %w", err)
+	}
+
+	if fs.NArg() != 0 {
+		return errors.New("usage: gomon logs [--follow] [--run <id>] [--filter <text>] [--level <level>] [--url <url>] [--token <token>]")
+	}
+
+	if opts.URL == "" {
+		cfg, err := config.Load(configPath, rootDirectory)
+		if err != nil {
+			return fmt.Errorf("loading config: %w", err)
+		}
+
+		baseURL, token, err := webui.Endpoint(cfg)
+		if err != nil {
+			return err
 		}
+		opts.URL = baseURL
+		if opts.Token == "" {
+			opts.Token = token
+		}
+	}
+	if opts.Token == "" {
+		opts.Token = os.Getenv("GOMON_TOKEN")
 	}
 
-	cfg, err := config.New(configPath)
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+
+	return attach.Logs(ctx, opts)
+}
+
+// runAgent only watches for file changes, streaming them to the gomon instances which subscribe to it
+func runAgent(args []string) error {
+	var configPath string
+	var rootDirectory string
+	var listen string
+	var token string
+
+	fs := flag.NewFlagSet("gomon agent flags", flag.ExitOnError)
+	fs.StringVar(&configPath, "conf", "", "Path to a config file (gomon.config.yml))")
+	fs.StringVar(&rootDirectory, "dir", "", "The directory to watch")
+	fs.StringVar(&listen, "listen", ":7070", "The address to listen on for subscribers")
+	fs.StringVar(&token, "token", "", "A token which subscribers must send, defaults to GOMON_AGENT_TOKEN")
+	err := fs.Parse(args)
 	if err != nil {
-		log.Fatalf("loading config: %v", err)
+		return fmt.Errorf("parsing flags: %w", err)
 	}
 
-	if cfg.RootDirectory == "" {
-		cfg.RootDirectory = rootDirectory
+	if token == "" {
+		token = os.Getenv("GOMON_AGENT_TOKEN")
+	}
+
+	cfg, err := config.Load(configPath, rootDirectory)
+	if err != nil {
+		return fmt.Errorf("loading config: %w", err)
 	}
 
-	if entrypoint != "" {
+	cfg.RootDirectory, err = filepath.Abs(cfg.RootDirectory)
+	if err != nil {
+		return fmt.Errorf("resolving root directory: %w", err)
+	}
+
+	agent, err := watcher.NewAgent(cfg, token)
+	if err != nil {
+		return fmt.Errorf("creating agent: %w", err)
+	}
+
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+
+	return agent.ListenAndServe(ctx, listen)
+}
+
+// runDoctor checks the environment for common problems, it returns false if any were found
+func runDoctor(args []string) (bool, error) {
+	var configPath string
+	var rootDirectory string
+
+	fs := flag.NewFlagSet("gomon doctor flags", flag.ExitOnError)
+	fs.StringVar(&configPath, "conf", "", "Path to a config file (gomon.config.yml))")
+	fs.StringVar(&rootDirectory, "dir", "", "The directory to watch")
+	err := fs.Parse(args)
+	if err != nil {
+		return false, fmt.Errorf("parsing flags: %w", err)
+	}
+
+	// only the results are of interest
+	log.SetLevel(log.ErrorLevel)
+
+	cfg, err := config.Load(configPath, rootDirectory)
+	if err != nil {
+		return false, fmt.Errorf("loading config: %w", err)
+	}
+
+	if entrypoint := strings.Split(fs.Arg(0), " ")[0]; entrypoint != "" {
 		cfg.Entrypoint = entrypoint
 	}
 
-	if len(entrypointArgs) > 0 {
-		cfg.EntrypointArgs = entrypointArgs
+	cfg.RootDirectory, err = filepath.Abs(cfg.RootDirectory)
+	if err != nil {
+		return false, fmt.Errorf("resolving root directory: %w", err)
 	}
 
-	if envFiles != "" {
-		cfg.EnvFiles = strings.Split(envFiles, ",")
+	hasProblems := doctor.Print(os.Stdout, doctor.Run(cfg))
+	return !hasProblems, nil
+}
+
+// runToken creates, lists and revokes the scoped API tokens in .gomon/tokens.json
+func runToken(args []string) error {
+	usage := errors.New("usage: gomon token create [--name <name>] --scopes <scopes> | list | revoke <id or name>")
+	if len(args) == 0 {
+		return usage
 	}
 
-	if proxyOnly {
-		cfg.ProxyOnly = true
+	var configPath string
+	var rootDirectory string
+	var name string
+	var scopeList string
+
+	fs := flag.NewFlagSet("gomon token flags", flag.ExitOnError)
+	fs.StringVar(&configPath, "conf", "", "Path to a config file (gomon.config.yml))")
+	fs.StringVar(&rootDirectory, "dir", "", "The project root directory")
+	fs.StringVar(&name, "name", "", "A name for the token e.g. ci")
+	fs.StringVar(&scopeList, "scopes", "", "A comma separated list of scopes: read:events, control:restart, control:tasks, write:labels")
+	err := fs.Parse(args[1:])
+	if err != nil {
+		return fmt.Errorf("parsing flags: %w", err)
 	}
 
-	return cfg, nil
+	cfg, err := config.Load(configPath, rootDirectory)
+	if err != nil {
+		return fmt.Errorf("loading config: %w", err)
+	}
+	store := auth.NewStore(cfg.RootDirectory)
+
+	switch args[0] {
+	case "create":
+		scopes, err := auth.ParseScopes(scopeList)
+		if err != nil {
+			return err
+		}
+		secret, _, err := store.Create(name, scopes)
+		if err != nil {
+			return fmt.Errorf("creating token: %w", err)
+		}
+		// the secret isn't stored so this is the only chance to see it
+		fmt.Println(secret)
+	case "list":
+		tokens, err := store.List()
+		if err != nil {
+			return fmt.Errorf("listing tokens: %w", err)
+		}
+		for _, t := range tokens {
+			scopes := []string{}
+			for _, scope := range t.Scopes {
+				scopes = append(scopes, string(scope))
+			}
+			fmt.Printf("%s\t%s\t%s\t%s\n", t.ID, t.Name, strings.Join(scopes, ","), t.CreatedAt.Format(time.RFC3339))
+		}
+	case "revoke":
+		if fs.NArg() != 1 {
+			return usage
+		}
+		err := store.Revoke(fs.Arg(0))
+		if err != nil {
+			return fmt.Errorf("revoking token: %w", err)
+		}
+	default:
+		return usage
+	}
+
+	return nil
+}
+
+func runInit(args []string) error {
+	var rootDirectory string
+	var force bool
+
+	fs := flag.NewFlagSet("gomon init flags", flag.ExitOnError)
+	fs.StringVar(&rootDirectory, "dir", "", "The directory to create the config file in")
+	fs.BoolVar(&force, "force", false, "Overwrite an existing config file")
+	err := fs.Parse(args)
+	if err != nil {
+		return fmt.Errorf("parsing flags: %w", err)
+	}
+
+	if rootDirectory == "" {
+		rootDirectory, err = os.Getwd()
+		if err != nil {
+			return fmt.Errorf("getting current directory: %w", err)
+		}
+	}
+
+	return scaffold.Run(rootDirectory, force)
+}
+
+// isInteractive returns true if stdin is a terminal so that the user can be asked questions
+func isInteractive() bool {
+	return console.IsTerminal(os.Stdin)
 }
 
 type logFormatter struct {
@@ -0,0 +1,237 @@
+// Package client connects a program run by gomon to gomon's IPC channel so that it can reload templates and
+// assets on a soft reload instead of being restarted.
+//
+//	c := client.New()
+//	c.OnSoftReload(func(paths []string) error {
+//		return tmpl.Reload()
+//	})
+//	go c.ListenAndServe(ctx)
+//
+// Outside of gomon ListenAndServe returns straight away, so the client can be left in production builds.
+package client
+
+// gomon is a simple command line tool that watches your files and automatically restarts the application when it detects any changes in the working directory.
+// Copyright (C) 2023 John Dudmesh
+
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+
+// You should have received a copy of the GNU General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>.
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"os"
+	"strconv"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	ipc "github.com/jdudmesh/gomon-ipc"
+	"github.com/jdudmesh/gomon/pkg/protocol"
+)
+
+// The variables set in the child process's environment. EnvIPCChannel is the address of gomon's IPC channel,
+// EnvIPCTransport and EnvIPCToken are only set when it is a unix socket or TCP port.
+const (
+	EnvIPCChannel   = "GOMON_IPC_CHANNEL"
+	EnvIPCTransport = "GOMON_IPC_TRANSPORT"
+	EnvIPCToken     = "GOMON_IPC_TOKEN"
+)
+
+// writeTimeout is how long gomon is given to receive a message
+const writeTimeout = time.Second
+
+// Client is a connection to gomon's IPC channel
+type Client struct {
+	channel      string
+	transport    string
+	token        string
+	lock         sync.Mutex
+	onSoftReload func(paths []string) error
+	onError      func(error)
+	// version is the negotiated protocol version, it is 0 until gomon welcomes the client
+	version atomic.Int32
+}
+
+// Option configures a Client
+type Option func(*Client)
+
+// WithChannel sets the address of gomon's IPC channel instead of reading it from GOMON_IPC_CHANNEL
+func WithChannel(addr string) Option {
+	return func(c *Client) {
+		c.channel = addr
+	}
+}
+
+// WithTransport sets the transport and token of gomon's IPC channel instead of reading them from
+// GOMON_IPC_TRANSPORT and GOMON_IPC_TOKEN, transport is one of the protocol.Transport constants
+func WithTransport(transport, token string) Option {
+	return func(c *Client) {
+		c.transport = transport
+		c.token = token
+	}
+}
+
+// WithErrorHandler is called with errors which can't be returned e.g. failing to reply to gomon, by default they
+// are ignored
+func WithErrorHandler(fn func(error)) Option {
+	return func(c *Client) {
+		c.onError = fn
+	}
+}
+
+func New(opts ...Option) *Client {
+	c := &Client{
+		channel:   os.Getenv(EnvIPCChannel),
+		transport: os.Getenv(EnvIPCTransport),
+		token:     os.Getenv(EnvIPCToken),
+		onError:   func(error) {},
+	}
+	for _, opt := range opts {
+		opt(c)
+	}
+	return c
+}
+
+// OnSoftReload is called with the changed paths on each soft reload, gomon reloads the browsers once it returns or
+// shows the error if it fails
+func (c *Client) OnSoftReload(fn func(paths []string) error) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	c.onSoftReload = fn
+}
+
+// Version returns the negotiated protocol version, 0 means gomon only supports the legacy protocol
+func (c *Client) Version() int {
+	return int(c.version.Load())
+}
+
+// ListenAndServe connects to gomon and handles its messages until ctx is cancelled. It returns straight away if
+// the program isn't being run by gomon.
+func (c *Client) ListenAndServe(ctx context.Context) error {
+	if c.channel == "" {
+		return nil
+	}
+
+	switch c.transport {
+	case "", protocol.TransportUDP:
+		return c.listenUDP(ctx)
+	case protocol.TransportUnix, protocol.TransportTCP:
+		return c.listenStream(ctx)
+	default:
+		return fmt.Errorf("unsupported IPC transport: %s", c.transport)
+	}
+}
+
+func (c *Client) listenUDP(ctx context.Context) error {
+	host, port, err := net.SplitHostPort(c.channel)
+	if err != nil {
+		return fmt.Errorf("parsing IPC channel: %w", err)
+	}
+	portNumber, err := strconv.Atoi(port)
+	if err != nil {
+		return fmt.Errorf("parsing IPC channel: %w", err)
+	}
+
+	var conn ipc.Connection
+	conn, err = ipc.NewConnection(ipc.ClientConnection, ipc.WithServerHost(host), ipc.WithServerPort(portNumber), ipc.WithReadHandler(func(data []byte) error {
+		// replies are written from another goroutine so the connection keeps reading while they are sent
+		go func() {
+			reply := c.handle(data)
+			if reply != nil {
+				c.write(ctx, conn, reply)
+			}
+		}()
+		return nil
+	}))
+	if err != nil {
+		return fmt.Errorf("creating IPC client: %w", err)
+	}
+	defer conn.Close()
+
+	go func() {
+		// waits for the connection to be established
+		hello, err := protocol.Encode(protocol.Message{Type: protocol.TypeHello})
+		if err == nil {
+			c.write(ctx, conn, hello)
+		}
+	}()
+
+	return conn.ListenAndServe(ctx, func(ipc.ConnectionState) error { return nil })
+}
+
+// writer is the connection replies are sent on, either the UDP channel or a stream
+type writer interface {
+	Write(ctx context.Context, data []byte) error
+}
+
+func (c *Client) write(ctx context.Context, conn writer, data []byte) {
+	ctx, cancel := context.WithTimeout(ctx, writeTimeout)
+	defer cancel()
+
+	err := conn.Write(ctx, data)
+	if err != nil {
+		c.onError(fmt.Errorf("writing to gomon: %w", err))
+	}
+}
+
+// handle acts on a message from gomon and returns the reply, if there is one
+func (c *Client) handle(data []byte) []byte {
+	m, ok := protocol.Decode(data)
+	if !ok {
+		// gomon only supports the legacy protocol, the message is the changed path
+		if c.softReload([]string{string(data)}) != nil {
+			return nil
+		}
+		return []byte(protocol.LegacySoftReloaded)
+	}
+
+	switch m.Type {
+	case protocol.TypeWelcome:
+		c.version.Store(int32(protocol.Negotiate(m.Version)))
+		return c.encode(protocol.Message{Type: protocol.TypeStarted})
+	case protocol.TypeSoftReload:
+		err := c.softReload(m.Paths)
+		if err != nil {
+			return c.encode(protocol.Message{Type: protocol.TypeReloadFailed, Error: err.Error()})
+		}
+		return c.encode(protocol.Message{Type: protocol.TypeReloaded})
+	}
+	return nil
+}
+
+func (c *Client) softReload(paths []string) error {
+	c.lock.Lock()
+	fn := c.onSoftReload
+	c.lock.Unlock()
+
+	if fn == nil {
+		return nil
+	}
+	err := fn(paths)
+	if err != nil {
+		c.onError(err)
+	}
+	return err
+}
+
+func (c *Client) encode(m protocol.Message) []byte {
+	m.Version = c.Version()
+	data, err := protocol.Encode(m)
+	if err != nil {
+		c.onError(err)
+		return nil
+	}
+	return data
+}
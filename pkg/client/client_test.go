@@ -0,0 +1,77 @@
+package client
+
+// gomon is a simple command line tool that watches your files and automatically restarts the application when it detects any changes in the working directory.
+// Copyright (C) 2023 John Dudmesh
+
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+
+// You should have received a copy of the GNU General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>.
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/jdudmesh/gomon/pkg/protocol"
+)
+
+func TestHandle(t *testing.T) {
+	c := New()
+	reloaded := [][]string{}
+	c.OnSoftReload(func(paths []string) error {
+		reloaded = append(reloaded, paths)
+		if paths[0] == "broken.html" {
+			return errors.New("parse error")
+		}
+		return nil
+	})
+
+	// before the handshake gomon sends the changed path as is
+	if reply := string(c.handle([]byte("views/index.html"))); reply != protocol.LegacySoftReloaded {
+		t.Errorf("expected a legacy reply, got %s", reply)
+	}
+
+	welcome, _ := protocol.Encode(protocol.Message{Type: protocol.TypeWelcome})
+	reply, ok := protocol.Decode(c.handle(welcome))
+	if !ok || reply.Type != protocol.TypeStarted || c.Version() != protocol.Version {
+		t.Errorf("expected started once welcomed, got %+v at version %d", reply, c.Version())
+	}
+
+	softReload, _ := protocol.Encode(protocol.Message{Type: protocol.TypeSoftReload, Paths: []string{"a.html", "b.html"}})
+	reply, ok = protocol.Decode(c.handle(softReload))
+	if !ok || reply.Type != protocol.TypeReloaded {
+		t.Errorf("expected reloaded, got %+v", reply)
+	}
+
+	softReload, _ = protocol.Encode(protocol.Message{Type: protocol.TypeSoftReload, Paths: []string{"broken.html"}})
+	reply, ok = protocol.Decode(c.handle(softReload))
+	if !ok || reply.Type != protocol.TypeReloadFailed || reply.Error != "parse error" {
+		t.Errorf("expected the error to be reported, got %+v", reply)
+	}
+
+	got := []string{}
+	for _, paths := range reloaded {
+		got = append(got, strings.Join(paths, ","))
+	}
+	if strings.Join(got, " ") != "views/index.html a.html,b.html broken.html" {
+		t.Errorf("unexpected reloads: %v", got)
+	}
+}
+
+func TestListenAndServeOutsideGomon(t *testing.T) {
+	t.Setenv(EnvIPCChannel, "")
+	err := New().ListenAndServe(context.Background())
+	if err != nil {
+		t.Errorf("expected ListenAndServe to return straight away, got %v", err)
+	}
+}
@@ -0,0 +1,117 @@
+package client
+
+// gomon is a simple command line tool that watches your files and automatically restarts the application when it detects any changes in the working directory.
+// Copyright (C) 2023 John Dudmesh
+
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+
+// You should have received a copy of the GNU General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>.
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"sync"
+	"time"
+
+	"github.com/jdudmesh/gomon/pkg/protocol"
+)
+
+// dialTimeout is how long the client keeps trying to connect, gomon may still be starting its IPC server
+const dialTimeout = 10 * time.Second
+
+// dialInterval is the delay between attempts to connect
+const dialInterval = 250 * time.Millisecond
+
+// stream is a connection to gomon's unix socket or TCP port
+type stream struct {
+	conn net.Conn
+	lock sync.Mutex
+}
+
+func (s *stream) Write(ctx context.Context, data []byte) error {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	deadline, ok := ctx.Deadline()
+	if ok {
+		s.conn.SetWriteDeadline(deadline)
+		defer s.conn.SetWriteDeadline(time.Time{})
+	}
+	return protocol.WriteFrame(s.conn, data)
+}
+
+// listenStream connects to gomon, sends the token and handles messages until gomon closes the connection or ctx is
+// cancelled
+func (c *Client) listenStream(ctx context.Context) error {
+	conn, err := c.dial(ctx)
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+
+	// unblocks the read when ctx is cancelled
+	stop := context.AfterFunc(ctx, func() {
+		conn.Close()
+	})
+	defer stop()
+
+	s := &stream{conn: conn}
+	err = s.Write(ctx, []byte(c.token))
+	if err != nil {
+		return fmt.Errorf("sending IPC token: %w", err)
+	}
+
+	hello, err := protocol.Encode(protocol.Message{Type: protocol.TypeHello})
+	if err != nil {
+		return err
+	}
+	c.write(ctx, s, hello)
+
+	for {
+		data, err := protocol.ReadFrame(conn)
+		if ctx.Err() != nil || errors.Is(err, io.EOF) {
+			return nil
+		} else if err != nil {
+			return fmt.Errorf("reading from gomon: %w", err)
+		}
+
+		// replies are written from another goroutine so the connection keeps reading while they are sent
+		go func() {
+			reply := c.handle(data)
+			if reply != nil {
+				c.write(ctx, s, reply)
+			}
+		}()
+	}
+}
+
+func (c *Client) dial(ctx context.Context) (net.Conn, error) {
+	ctx, cancel := context.WithTimeout(ctx, dialTimeout)
+	defer cancel()
+
+	dialer := net.Dialer{}
+	for {
+		conn, err := dialer.DialContext(ctx, c.transport, c.channel)
+		if err == nil {
+			return conn, nil
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil, fmt.Errorf("connecting to gomon: %w", err)
+		case <-time.After(dialInterval):
+		}
+	}
+}
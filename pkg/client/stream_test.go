@@ -0,0 +1,88 @@
+package client
+
+// gomon is a simple command line tool that watches your files and automatically restarts the application when it detects any changes in the working directory.
+// Copyright (C) 2023 John Dudmesh
+
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+
+// You should have received a copy of the GNU General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>.
+
+import (
+	"context"
+	"net"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/jdudmesh/gomon/pkg/protocol"
+)
+
+func TestListenAndServeUnixSocket(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "ipc.sock")
+	listener, err := net.Listen("unix", path)
+	if err != nil {
+		t.Fatalf("listening: %v", err)
+	}
+	defer listener.Close()
+
+	reloaded := make(chan []string, 1)
+	c := New(WithChannel(path), WithTransport(protocol.TransportUnix, "secret"))
+	c.OnSoftReload(func(paths []string) error {
+		reloaded <- paths
+		return nil
+	})
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	done := make(chan error, 1)
+	go func() {
+		done <- c.ListenAndServe(ctx)
+	}()
+
+	conn, err := listener.Accept()
+	if err != nil {
+		t.Fatalf("accepting: %v", err)
+	}
+	defer conn.Close()
+	conn.SetDeadline(time.Now().Add(5 * time.Second))
+
+	// gomon expects the token before any messages
+	if token, err := protocol.ReadFrame(conn); err != nil || string(token) != "secret" {
+		t.Fatalf("expected the token, got %q %v", token, err)
+	}
+	if hello, err := protocol.ReadFrame(conn); err != nil || !strings.Contains(string(hello), `"hello"`) {
+		t.Fatalf("expected hello, got %q %v", hello, err)
+	}
+
+	welcome, _ := protocol.Encode(protocol.Message{Type: protocol.TypeWelcome})
+	protocol.WriteFrame(conn, welcome)
+	if started, err := protocol.ReadFrame(conn); err != nil || !strings.Contains(string(started), `"started"`) {
+		t.Fatalf("expected started, got %q %v", started, err)
+	}
+
+	softReload, _ := protocol.Encode(protocol.Message{Type: protocol.TypeSoftReload, Paths: []string{"a.html", "b.html"}})
+	protocol.WriteFrame(conn, softReload)
+	if reply, err := protocol.ReadFrame(conn); err != nil || !strings.Contains(string(reply), `"reloaded"`) {
+		t.Fatalf("expected reloaded, got %q %v", reply, err)
+	}
+	if paths := <-reloaded; strings.Join(paths, ",") != "a.html,b.html" {
+		t.Errorf("unexpected paths: %v", paths)
+	}
+
+	// the client stops when gomon closes the connection
+	conn.Close()
+	if err := <-done; err != nil {
+		t.Errorf("expected ListenAndServe to return cleanly, got %v", err)
+	}
+}
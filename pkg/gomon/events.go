@@ -0,0 +1,102 @@
+package gomon
+
+// gomon is a simple command line tool that watches your files and automatically restarts the application when it detects any changes in the working directory.
+// Copyright (C) 2023 John Dudmesh
+
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+
+// You should have received a copy of the GNU General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>.
+
+import (
+	"github.com/jdudmesh/gomon/internal/notification"
+)
+
+// DefaultEventBuffer is the number of events queued for a subscriber while it is busy, unless WithEventBuffer is used
+const DefaultEventBuffer = 256
+
+// Event is a notification published by gomon e.g. the child process starting or a line of its output
+type Event = notification.Notification
+
+// EventType is the kind of an Event, its String method returns the name used by the UI and the API e.g. "crash"
+type EventType = notification.NotificationType
+
+// The event types which are most useful to integrations, ParseEventType returns the others by name
+const (
+	EventStartup              = notification.NotificationTypeStartup
+	EventRunning              = notification.NotificationTypeRunning
+	EventHardRestart          = notification.NotificationTypeHardRestart
+	EventSoftRestart          = notification.NotificationTypeSoftRestart
+	EventShutdown             = notification.NotificationTypeShutdown
+	EventCrash                = notification.NotificationTypeCrash
+	EventBuildError           = notification.NotificationTypeBuildError
+	EventUnhealthy            = notification.NotificationTypeUnhealthy
+	EventStdOut               = notification.NotificationTypeStdOut
+	EventStdErr               = notification.NotificationTypeStdErr
+	EventLog                  = notification.NotificationTypeLogEvent
+	EventHardRestartRequested = notification.NotificationTypeHardRestartRequested
+	EventSoftRestartRequested = notification.NotificationTypeSoftRestartRequested
+	EventTaskComplete         = notification.NotificationTypeOOBTaskComplete
+	EventTestPass             = notification.NotificationTypeTestPass
+	EventTestFail             = notification.NotificationTypeTestFail
+	EventSystemError          = notification.NotificationTypeSystemError
+)
+
+// ParseEventType returns the event type with the given name e.g. "crash"
+func ParseEventType(name string) (EventType, bool) {
+	return notification.ParseType(name)
+}
+
+// OverflowPolicy is what happens when a subscriber falls behind and its buffer is full
+type OverflowPolicy = notification.OverflowPolicy
+
+const (
+	// DropNewest discards events published while the buffer is full, this is the default
+	DropNewest = notification.DropNewest
+	// DropOldest discards the oldest queued event to make room for the new one
+	DropOldest = notification.DropOldest
+	// Disconnect unsubscribes a subscriber which falls behind
+	Disconnect = notification.Disconnect
+)
+
+// SubscribeOption configures a subscription
+type SubscribeOption = notification.SubscribeOption
+
+// WithEventTypes only delivers events of the given types, by default every event is delivered
+func WithEventTypes(types ...EventType) SubscribeOption {
+	return notification.WithTypes(types...)
+}
+
+// WithEventBuffer sets the number of events queued while the subscriber is busy. A size of 0 calls the subscriber
+// on gomon's own goroutine, which holds up gomon until it returns.
+func WithEventBuffer(size int) SubscribeOption {
+	return notification.WithBuffer(size)
+}
+
+// WithOverflow sets what happens when the subscriber's buffer is full
+func WithOverflow(policy OverflowPolicy) SubscribeOption {
+	return notification.WithOverflow(policy)
+}
+
+// Subscription is returned by Subscribe, Close unsubscribes and Dropped reports how many events were lost because
+// the subscriber fell behind
+type Subscription = notification.Subscription
+
+// Subscribe calls fn with each event gomon publishes until the subscription is closed. Subscriptions can be made
+// before the Runner is started and carry on across Stop and Start. Events are delivered in the order they are
+// published on a goroutine of the subscription's own, so fn doesn't need to return quickly but mustn't call Close.
+func (r *Runner) Subscribe(fn func(Event), opts ...SubscribeOption) *Subscription {
+	opts = append([]SubscribeOption{notification.WithName("subscriber"), WithEventBuffer(DefaultEventBuffer)}, opts...)
+	return r.bus.Subscribe(notification.ConsumerFunc(func(n notification.Notification) error {
+		fn(n)
+		return nil
+	}), opts...)
+}
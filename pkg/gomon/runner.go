@@ -0,0 +1,290 @@
+// Package gomon runs gomon from Go code so that it can be embedded in other development tools. A Runner watches the
+// project, supervises the child process and runs the proxy and web UI exactly as the gomon command does.
+//
+//	runner, err := gomon.New(
+//		gomon.WithRootDirectory("./services/api"),
+//		gomon.WithEntrypoint("./cmd/api"),
+//		gomon.WithUI(false),
+//	)
+//	if err != nil {
+//		return err
+//	}
+//	err = runner.Start(ctx)
+//	if err != nil {
+//		return err
+//	}
+//	defer runner.Stop()
+//
+// gomon logs using the standard logrus logger, configure it before starting the Runner to change the output.
+package gomon
+
+// gomon is a simple command line tool that watches your files and automatically restarts the application when it detects any changes in the working directory.
+// Copyright (C) 2023 John Dudmesh
+
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+
+// You should have received a copy of the GNU General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>.
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"path/filepath"
+	"sync"
+
+	"github.com/jdudmesh/gomon/internal/app"
+	"github.com/jdudmesh/gomon/internal/config"
+	"github.com/jdudmesh/gomon/internal/notification"
+)
+
+// ErrNotStarted is returned when a running Runner is required
+var ErrNotStarted = errors.New("gomon is not running")
+
+// ErrAlreadyStarted is returned by Start if the Runner is already running
+var ErrAlreadyStarted = errors.New("gomon is already running")
+
+// Runner is an embedded instance of gomon. The zero value isn't usable, create one with New.
+type Runner struct {
+	configPath    string
+	rootDirectory string
+	watch         bool
+	overrides     []func(*config.Config)
+
+	cfg config.Config
+	// bus is shared by each app the Runner starts so that subscriptions carry on across restarts
+	bus    *notification.Bus
+	lock   sync.Mutex
+	app    *app.App
+	ctx    context.Context
+	cancel context.CancelFunc
+	done   chan struct{}
+	err    error
+}
+
+// Option configures a Runner. Options are applied on top of the config file, if there is one.
+type Option func(*Runner) error
+
+// WithConfigFile loads settings from the config file at path, by default gomon.config.yml is loaded from
+// the root directory if it exists
+func WithConfigFile(path string) Option {
+	return func(r *Runner) error {
+		r.configPath = path
+		return nil
+	}
+}
+
+// WithRootDirectory sets the directory which is watched and in which commands are run, by default this
+// is the current directory
+func WithRootDirectory(dir string) Option {
+	return func(r *Runner) error {
+		absDir, err := filepath.Abs(dir)
+		if err != nil {
+			return fmt.Errorf("resolving root directory: %w", err)
+		}
+		r.rootDirectory = absDir
+		return nil
+	}
+}
+
+// WithEntrypoint sets the package which is run with go run, and the arguments passed to it
+func WithEntrypoint(entrypoint string, args ...string) Option {
+	return func(r *Runner) error {
+		r.overrides = append(r.overrides, func(cfg *config.Config) {
+			cfg.CommandLine.Entrypoint = entrypoint
+			cfg.CommandLine.EntrypointArgs = args
+			cfg.ApplyCommandLine()
+		})
+		return nil
+	}
+}
+
+// WithEnvFiles sets the env files which are loaded into the child process's environment
+func WithEnvFiles(files ...string) Option {
+	return func(r *Runner) error {
+		r.overrides = append(r.overrides, func(cfg *config.Config) {
+			cfg.EnvFiles = files
+			cfg.CommandLine.EnvFiles = files
+		})
+		return nil
+	}
+}
+
+// WithProfile sets the profile name passed to the child process as GOMON_PROFILE
+func WithProfile(profile string) Option {
+	return func(r *Runner) error {
+		r.overrides = append(r.overrides, func(cfg *config.Config) {
+			cfg.Profile = profile
+			cfg.CommandLine.Profile = profile
+		})
+		return nil
+	}
+}
+
+// WithWatcher enables or disables restarting the child process when files change, it is enabled by default.
+// Without the watcher restarts have to be requested with Restart and Reload.
+func WithWatcher(enabled bool) Option {
+	return func(r *Runner) error {
+		r.watch = enabled
+		return nil
+	}
+}
+
+// WithProxy enables or disables the reloading proxy, the downstream and port are read from the config file
+func WithProxy(enabled bool) Option {
+	return func(r *Runner) error {
+		r.overrides = append(r.overrides, func(cfg *config.Config) {
+			cfg.Proxy.Enabled = enabled
+		})
+		return nil
+	}
+}
+
+// WithUI enables or disables the web UI
+func WithUI(enabled bool) Option {
+	return func(r *Runner) error {
+		r.overrides = append(r.overrides, func(cfg *config.Config) {
+			cfg.UI.Enabled = enabled
+		})
+		return nil
+	}
+}
+
+// New creates a Runner, the config is loaded straight away so that errors are reported before it is started
+func New(opts ...Option) (*Runner, error) {
+	r := &Runner{
+		watch: true,
+		bus:   notification.NewBus(),
+	}
+
+	for _, opt := range opts {
+		err := opt(r)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	cfg, err := config.Load(r.configPath, r.rootDirectory)
+	if err != nil {
+		return nil, fmt.Errorf("loading config: %w", err)
+	}
+
+	for _, override := range r.overrides {
+		override(&cfg)
+	}
+
+	// a terminal UI would take over the host program's terminal
+	cfg.TUI = false
+
+	if cfg.Entrypoint == "" && len(cfg.Command) == 0 {
+		return nil, errors.New("an entrypoint is required")
+	}
+
+	r.cfg = cfg
+	return r, nil
+}
+
+// Start runs gomon in the background and returns once it has started. gomon stops when Stop is called, ctx
+// is cancelled or a shutdown is requested from the UI, use Wait to find out when this happens.
+func (r *Runner) Start(ctx context.Context) error {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	if r.app != nil {
+		return ErrAlreadyStarted
+	}
+
+	a, err := app.New(r.cfg, app.WithBus(r.bus))
+	if err != nil {
+		return fmt.Errorf("creating app: %w", err)
+	}
+
+	r.app = a
+	r.ctx, r.cancel = context.WithCancel(ctx)
+	r.done = make(chan struct{})
+	r.err = nil
+
+	go func(ctx context.Context, done chan struct{}) {
+		err := a.Run(ctx, app.RunOptions{Watch: r.watch})
+		a.Close()
+
+		r.lock.Lock()
+		r.err = err
+		r.app = nil
+		r.lock.Unlock()
+		close(done)
+	}(r.ctx, r.done)
+
+	return nil
+}
+
+// Stop stops the child process and all of gomon's components, waiting for them to shut down. The error
+// which caused gomon to exit, if any, is returned.
+func (r *Runner) Stop() error {
+	r.lock.Lock()
+	cancel, done := r.cancel, r.done
+	r.lock.Unlock()
+
+	if done == nil {
+		return nil
+	}
+
+	cancel()
+	<-done
+
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	return r.err
+}
+
+// Wait blocks until gomon exits and returns the error which caused it to exit, if any
+func (r *Runner) Wait() error {
+	r.lock.Lock()
+	done := r.done
+	r.lock.Unlock()
+
+	if done == nil {
+		return ErrNotStarted
+	}
+
+	<-done
+
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	return r.err
+}
+
+// Restart stops the child process and starts it again, rebuilding it first if builds are enabled
+func (r *Runner) Restart() error {
+	return r.restart(true, "restart requested")
+}
+
+// Reload asks the child process to reload without restarting, hint is passed to the child process over IPC
+// e.g. the path of the template which changed
+func (r *Runner) Reload(hint string) error {
+	return r.restart(false, hint)
+}
+
+func (r *Runner) restart(hard bool, hint string) error {
+	r.lock.Lock()
+	a, ctx := r.app, r.ctx
+	r.lock.Unlock()
+
+	if a == nil {
+		return ErrNotStarted
+	}
+
+	err := a.Restart(ctx, hard, hint)
+	if err != nil {
+		return ErrNotStarted
+	}
+	return nil
+}
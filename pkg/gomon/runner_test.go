@@ -0,0 +1,220 @@
+package gomon
+
+// gomon is a simple command line tool that watches your files and automatically restarts the application when it detects any changes in the working directory.
+// Copyright (C) 2023 John Dudmesh
+
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+
+// You should have received a copy of the GNU General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>.
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestNewAppliesOptionsOverConfigFile(t *testing.T) {
+	rootDirectory := t.TempDir()
+	err := os.WriteFile(filepath.Join(rootDirectory, "gomon.config.yml"), []byte("entrypoint: ./cmd/web\nui:\n  enabled: true\ntui: true\n"), 0644)
+	if err != nil {
+		t.Fatalf("writing config: %v", err)
+	}
+
+	r, err := New(
+		WithRootDirectory(rootDirectory),
+		WithEntrypoint("./cmd/api", "--port", "8080"),
+		WithUI(false),
+		WithWatcher(false),
+	)
+	if err != nil {
+		t.Fatalf("creating runner: %v", err)
+	}
+
+	if r.cfg.RootDirectory != rootDirectory {
+		t.Errorf("expected root directory %s, got %s", rootDirectory, r.cfg.RootDirectory)
+	}
+	if r.cfg.Entrypoint != "./cmd/api" || len(r.cfg.EntrypointArgs) != 2 {
+		t.Errorf("expected the entrypoint option to override the config file, got %s %v", r.cfg.Entrypoint, r.cfg.EntrypointArgs)
+	}
+	if r.cfg.UI.Enabled {
+		t.Error("expected the UI to be disabled")
+	}
+	if r.cfg.TUI {
+		t.Error("expected the terminal UI to be disabled when embedded")
+	}
+	if r.watch {
+		t.Error("expected the watcher to be disabled")
+	}
+}
+
+func TestNewRequiresEntrypoint(t *testing.T) {
+	_, err := New(WithRootDirectory(t.TempDir()))
+	if err == nil {
+		t.Error("expected an error without an entrypoint")
+	}
+}
+
+func TestRunnerNotStarted(t *testing.T) {
+	r, err := New(WithRootDirectory(t.TempDir()), WithEntrypoint("."))
+	if err != nil {
+		t.Fatalf("creating runner: %v", err)
+	}
+
+	if err := r.Restart(); err != ErrNotStarted {
+		t.Errorf("expected ErrNotStarted, got %v", err)
+	}
+	if err := r.Wait(); err != ErrNotStarted {
+		t.Errorf("expected ErrNotStarted, got %v", err)
+	}
+	if err := r.Stop(); err != nil {
+		t.Errorf("expected stopping a runner which hasn't started to succeed, got %v", err)
+	}
+}
+
+func TestRunnerReportsChildProcessErrors(t *testing.T) {
+	rootDirectory := t.TempDir()
+	err := os.WriteFile(filepath.Join(rootDirectory, "gomon.config.yml"), []byte("entrypoint: .\nprocess:\n  stopSignal: SIGBOGUS\n"), 0644)
+	if err != nil {
+		t.Fatalf("writing config: %v", err)
+	}
+
+	r, err := New(WithRootDirectory(rootDirectory), WithWatcher(false), WithUI(false), WithProxy(false))
+	if err != nil {
+		t.Fatalf("creating runner: %v", err)
+	}
+
+	err = r.Start(context.Background())
+	if err != nil {
+		t.Fatalf("starting runner: %v", err)
+	}
+
+	done := make(chan error, 1)
+	go func() {
+		done <- r.Wait()
+	}()
+	select {
+	case err = <-done:
+	case <-time.After(10 * time.Second):
+		r.Stop()
+		t.Fatal("expected the runner to stop")
+	}
+	if err == nil || !strings.Contains(err.Error(), "stop signal") {
+		t.Errorf("expected the invalid stop signal to be returned, got %v", err)
+	}
+}
+
+func TestSubscribe(t *testing.T) {
+	r, err := New(WithRootDirectory(t.TempDir()), WithEntrypoint("."))
+	if err != nil {
+		t.Fatalf("creating runner: %v", err)
+	}
+
+	received := []EventType{}
+	sub := r.Subscribe(func(e Event) {
+		received = append(received, e.Type)
+	}, WithEventTypes(EventStartup, EventCrash))
+
+	for _, eventType := range []EventType{EventStartup, EventStdOut, EventCrash, EventLog} {
+		r.bus.Publish(Event{Type: eventType})
+	}
+	sub.Close()
+	r.bus.Publish(Event{Type: EventStartup})
+
+	if len(received) != 2 || received[0] != EventStartup || received[1] != EventCrash {
+		t.Errorf("expected startup and crash events, got %v", received)
+	}
+}
+
+func TestSubscribeSlowConsumer(t *testing.T) {
+	r, err := New(WithRootDirectory(t.TempDir()), WithEntrypoint("."))
+	if err != nil {
+		t.Fatalf("creating runner: %v", err)
+	}
+
+	started := make(chan struct{}, 1)
+	release := make(chan struct{})
+	received := []string{}
+	sub := r.Subscribe(func(e Event) {
+		select {
+		case started <- struct{}{}:
+		default:
+		}
+		<-release
+		received = append(received, e.Message)
+	}, WithEventBuffer(2), WithOverflow(DropOldest))
+
+	// the first event is being handled while the next two are queued, the oldest of them makes way for the last
+	r.bus.Publish(Event{Type: EventLog, Message: "a"})
+	<-started
+	for _, message := range []string{"b", "c", "d"} {
+		r.bus.Publish(Event{Type: EventLog, Message: message})
+	}
+	close(release)
+	sub.Close()
+
+	if strings.Join(received, "") != "acd" {
+		t.Errorf("expected events a, c and d, got %v", received)
+	}
+	if sub.Dropped() != 1 {
+		t.Errorf("expected 1 dropped event, got %d", sub.Dropped())
+	}
+}
+
+func TestRunnerRestartNever(t *testing.T) {
+	rootDirectory := t.TempDir()
+	err := os.WriteFile(filepath.Join(rootDirectory, "gomon.config.yml"), []byte("command: [\"true\"]\nrestart: never\nipc:\n  transport: unix\n  token: test\n"), 0644)
+	if err != nil {
+		t.Fatalf("writing config: %v", err)
+	}
+
+	r, err := New(WithRootDirectory(rootDirectory), WithEntrypoint("."), WithWatcher(false), WithUI(false), WithProxy(false))
+	if err != nil {
+		t.Fatalf("creating runner: %v", err)
+	}
+
+	startups := make(chan Event, 10)
+	sub := r.Subscribe(func(e Event) {
+		startups <- e
+	}, WithEventTypes(EventStartup))
+	defer sub.Close()
+
+	err = r.Start(context.Background())
+	if err != nil {
+		t.Fatalf("starting runner: %v", err)
+	}
+	defer r.Stop()
+
+	select {
+	case <-startups:
+	case <-time.After(10 * time.Second):
+		t.Fatal("expected the child process to start")
+	}
+	// the process exits straight away and isn't started again until a restart is requested
+	select {
+	case <-startups:
+		t.Fatal("expected the child process not to be restarted after it exited")
+	case <-time.After(time.Second):
+	}
+
+	err = r.Restart()
+	if err != nil {
+		t.Fatalf("restarting: %v", err)
+	}
+	select {
+	case <-startups:
+	case <-time.After(10 * time.Second):
+		t.Fatal("expected the child process to be started by the restart")
+	}
+}
@@ -0,0 +1,101 @@
+// Package protocol defines the messages exchanged over IPC between gomon and the child process. Messages are JSON
+// envelopes carrying the version of the protocol they belong to. A client sends hello with the newest version it
+// supports when it connects and gomon replies with welcome and the version both sides will use.
+//
+// Peers which haven't negotiated a version use the legacy protocol instead: gomon sends the changed path as a raw
+// string on a soft reload and the client replies with LegacySoftReloaded. Old clients never send hello so they keep
+// working unchanged, and a new client which doesn't receive welcome falls back to the legacy messages.
+package protocol
+
+// gomon is a simple command line tool that watches your files and automatically restarts the application when it detects any changes in the working directory.
+// Copyright (C) 2023 John Dudmesh
+
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+
+// You should have received a copy of the GNU General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>.
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+)
+
+// Version is the newest version of the protocol, 0 is the legacy protocol
+const Version = 1
+
+// Legacy messages, sent by clients which haven't negotiated a version
+const (
+	LegacySoftReloaded = "__soft_reload"
+	LegacyStarted      = "__hard_restart"
+)
+
+type MessageType string
+
+const (
+	// TypeHello is sent by the client when it connects
+	TypeHello MessageType = "hello"
+	// TypeWelcome is gomon's reply to hello, it carries the negotiated version
+	TypeWelcome MessageType = "welcome"
+	// TypeSoftReload is sent by gomon when files matching the soft reload rules change
+	TypeSoftReload MessageType = "softReload"
+	// TypeReloaded is sent by the client once it has reloaded, gomon then reloads the browsers
+	TypeReloaded MessageType = "reloaded"
+	// TypeReloadFailed is sent by the client if it couldn't reload, the error is shown by gomon
+	TypeReloadFailed MessageType = "reloadFailed"
+	// TypeStarted is sent by the client once the program is ready after a hard restart
+	TypeStarted MessageType = "started"
+)
+
+// Message is the envelope for every message, fields which don't apply to the type are omitted
+type Message struct {
+	Version int         `json:"version"`
+	Type    MessageType `json:"type"`
+	// Paths are the files which changed, relative to gomon's root directory
+	Paths []string `json:"paths,omitempty"`
+	Error string   `json:"error,omitempty"`
+}
+
+// Encode returns the message as JSON, the version is set to Version if it isn't set
+func Encode(m Message) ([]byte, error) {
+	if m.Version == 0 {
+		m.Version = Version
+	}
+	data, err := json.Marshal(m)
+	if err != nil {
+		return nil, fmt.Errorf("encoding %s message: %w", m.Type, err)
+	}
+	return data, nil
+}
+
+// Decode parses a message, it returns false for legacy messages which aren't envelopes
+func Decode(data []byte) (Message, bool) {
+	m := Message{}
+	if !bytes.HasPrefix(bytes.TrimSpace(data), []byte("{")) {
+		return m, false
+	}
+	err := json.Unmarshal(data, &m)
+	if err != nil || m.Type == "" {
+		return Message{}, false
+	}
+	return m, true
+}
+
+// Negotiate returns the version used with a peer which supports versions up to peerVersion
+func Negotiate(peerVersion int) int {
+	if peerVersion < 0 {
+		return 0
+	}
+	if peerVersion < Version {
+		return peerVersion
+	}
+	return Version
+}
@@ -0,0 +1,90 @@
+package protocol
+
+// gomon is a simple command line tool that watches your files and automatically restarts the application when it detects any changes in the working directory.
+// Copyright (C) 2023 John Dudmesh
+
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+
+// You should have received a copy of the GNU General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>.
+
+import (
+	"bytes"
+	"io"
+	"testing"
+)
+
+func TestEncodeDecode(t *testing.T) {
+	data, err := Encode(Message{Type: TypeSoftReload, Paths: []string{"views/index.html"}})
+	if err != nil {
+		t.Fatalf("encoding: %v", err)
+	}
+	if string(data) != `{"version":1,"type":"softReload","paths":["views/index.html"]}` {
+		t.Errorf("unexpected encoding: %s", data)
+	}
+
+	m, ok := Decode(data)
+	if !ok || m.Version != Version || m.Type != TypeSoftReload || len(m.Paths) != 1 || m.Paths[0] != "views/index.html" {
+		t.Errorf("unexpected message: %+v %v", m, ok)
+	}
+
+	for _, legacy := range []string{LegacySoftReloaded, LegacyStarted, "views/index.html", "{not json", `{"version":1}`} {
+		if _, ok := Decode([]byte(legacy)); ok {
+			t.Errorf("expected %s to be a legacy message", legacy)
+		}
+	}
+}
+
+func TestNegotiate(t *testing.T) {
+	tests := []struct {
+		peer int
+		want int
+	}{
+		{-1, 0},
+		{0, 0},
+		{Version, Version},
+		{Version + 1, Version},
+	}
+	for _, tt := range tests {
+		if got := Negotiate(tt.peer); got != tt.want {
+			t.Errorf("%d: expected %d, got %d", tt.peer, tt.want, got)
+		}
+	}
+}
+
+func TestFrames(t *testing.T) {
+	buf := bytes.Buffer{}
+	for _, data := range []string{"token", "", `{"version":1,"type":"hello"}`} {
+		err := WriteFrame(&buf, []byte(data))
+		if err != nil {
+			t.Fatalf("writing frame: %v", err)
+		}
+	}
+
+	for _, expected := range []string{"token", "", `{"version":1,"type":"hello"}`} {
+		data, err := ReadFrame(&buf)
+		if err != nil || string(data) != expected {
+			t.Errorf("expected %q, got %q %v", expected, data, err)
+		}
+	}
+	if _, err := ReadFrame(&buf); err != io.EOF {
+		t.Errorf("expected EOF at the end of the stream, got %v", err)
+	}
+
+	if err := WriteFrame(&buf, make([]byte, MaxFrameSize+1)); err == nil {
+		t.Error("expected an error writing a frame which is too large")
+	}
+	buf.Reset()
+	buf.Write([]byte{0xff, 0xff, 0xff, 0xff})
+	if _, err := ReadFrame(&buf); err == nil {
+		t.Error("expected an error reading a frame which is too large")
+	}
+}
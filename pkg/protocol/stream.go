@@ -0,0 +1,73 @@
+package protocol
+
+// gomon is a simple command line tool that watches your files and automatically restarts the application when it detects any changes in the working directory.
+// Copyright (C) 2023 John Dudmesh
+
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+
+// You should have received a copy of the GNU General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>.
+
+import (
+	"encoding/binary"
+	"fmt"
+	"io"
+)
+
+// The transports the IPC channel can be served on. UDP is the default, it only reaches children on the same host
+// and in the same network namespace. Unix sockets and TCP are streams of frames, the first frame the client sends
+// is the shared token and gomon closes the connection if it doesn't match.
+const (
+	TransportUDP  = "udp"
+	TransportUnix = "unix"
+	TransportTCP  = "tcp"
+)
+
+// MaxFrameSize stops a misbehaving peer from making the other allocate an unbounded buffer
+const MaxFrameSize = 1 << 20
+
+// WriteFrame writes data prefixed with its length as a 32 bit big endian integer
+func WriteFrame(w io.Writer, data []byte) error {
+	if len(data) > MaxFrameSize {
+		return fmt.Errorf("frame is too large: %d bytes", len(data))
+	}
+
+	buf := make([]byte, 4+len(data))
+	binary.BigEndian.PutUint32(buf, uint32(len(data)))
+	copy(buf[4:], data)
+
+	_, err := w.Write(buf)
+	if err != nil {
+		return fmt.Errorf("writing frame: %w", err)
+	}
+	return nil
+}
+
+// ReadFrame reads a frame written by WriteFrame, it returns io.EOF if the stream ends between frames
+func ReadFrame(r io.Reader) ([]byte, error) {
+	header := make([]byte, 4)
+	_, err := io.ReadFull(r, header)
+	if err != nil {
+		return nil, err
+	}
+
+	size := binary.BigEndian.Uint32(header)
+	if size > MaxFrameSize {
+		return nil, fmt.Errorf("frame is too large: %d bytes", size)
+	}
+
+	data := make([]byte, size)
+	_, err = io.ReadFull(r, data)
+	if err != nil {
+		return nil, fmt.Errorf("reading frame: %w", err)
+	}
+	return data, nil
+}
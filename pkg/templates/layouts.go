@@ -0,0 +1,132 @@
+package templates
+
+// gomon is a simple command line tool that watches your files and automatically restarts the application when it detects any changes in the working directory.
+// Copyright (C) 2023 John Dudmesh
+
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+
+// You should have received a copy of the GNU General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>.
+
+import (
+	"fmt"
+	"html/template"
+	"io"
+	"io/fs"
+	"path"
+)
+
+// templateSet is the result of parsing the templates, either one set of templates named after their files or a set
+// per page containing its layouts and the partials
+type templateSet struct {
+	// shared holds every template when pages aren't parsed separately, otherwise only the partials
+	shared *template.Template
+	pages  map[string]*page
+}
+
+type page struct {
+	tmpl *template.Template
+	// entry is the outermost layout, or the page itself if it has no layouts
+	entry string
+}
+
+func (s *templateSet) execute(w io.Writer, name string, data any) error {
+	if p, ok := s.pages[name]; ok {
+		return p.tmpl.ExecuteTemplate(w, p.entry, data)
+	}
+	return s.shared.ExecuteTemplate(w, name, data)
+}
+
+// parse reads the files, which are paths in the file system, into a templateSet
+func (t *Templates) parse(files []string) (*templateSet, error) {
+	set := &templateSet{
+		shared: template.New("").Funcs(t.funcs),
+		pages:  map[string]*page{},
+	}
+
+	if t.layout == "" && len(t.partials) == 0 {
+		for _, file := range files {
+			err := t.parseFile(set.shared, path.Base(file), file)
+			if err != nil {
+				return nil, err
+			}
+		}
+		return set, nil
+	}
+
+	layouts := map[string]string{}
+	pages := []string{}
+	for _, file := range files {
+		switch {
+		case matchAny(t.partials, file):
+			err := t.parseFile(set.shared, path.Base(file), file)
+			if err != nil {
+				return nil, err
+			}
+		case t.layout != "" && path.Base(file) == t.layout:
+			layouts[path.Dir(file)] = file
+		default:
+			pages = append(pages, file)
+		}
+	}
+
+	for _, file := range pages {
+		tmpl, err := set.shared.Clone()
+		if err != nil {
+			return nil, fmt.Errorf("copying partials: %w", err)
+		}
+
+		p := &page{tmpl: tmpl, entry: file}
+		for i, layout := range pageLayouts(file, layouts) {
+			if i == 0 {
+				p.entry = layout
+			}
+			err = t.parseFile(tmpl, layout, layout)
+			if err != nil {
+				return nil, err
+			}
+		}
+
+		// the page is parsed last so that its blocks replace those of its layouts
+		err = t.parseFile(tmpl, file, file)
+		if err != nil {
+			return nil, err
+		}
+		set.pages[file] = p
+	}
+
+	return set, nil
+}
+
+func (t *Templates) parseFile(tmpl *template.Template, name, file string) error {
+	contents, err := fs.ReadFile(t.fsys, file)
+	if err != nil {
+		return fmt.Errorf("reading %s: %w", file, err)
+	}
+	_, err = tmpl.New(name).Parse(string(contents))
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+// pageLayouts returns the layouts which wrap a page, outermost first
+func pageLayouts(file string, layouts map[string]string) []string {
+	found := []string{}
+	for dir := path.Dir(file); ; dir = path.Dir(dir) {
+		if layout, ok := layouts[dir]; ok {
+			found = append([]string{layout}, found...)
+		}
+		if dir == "." {
+			return found
+		}
+	}
+}
@@ -0,0 +1,323 @@
+// Package templates parses the html/template templates listed in gomon's templates.paths and parses them again
+// when gomon reports that one has changed, so the program keeps running while its templates are edited.
+//
+//	tmpl, err := templates.New()
+//	if err != nil {
+//		return err
+//	}
+//	go tmpl.ListenAndServe(ctx)
+//
+//	http.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
+//		tmpl.Render(w, http.StatusOK, "index.html", data)
+//	})
+//
+// Templates are named after their files, as with template.ParseFiles. If layouts or partials are used each page
+// is parsed with its own copy of them and is named by its path instead, see WithLayouts. Templates are read from
+// the working directory by default or from any fs.FS, e.g. an embed.FS in production builds. Outside of gomon the
+// templates are parsed once and ListenAndServe returns straight away.
+package templates
+
+// gomon is a simple command line tool that watches your files and automatically restarts the application when it detects any changes in the working directory.
+// Copyright (C) 2023 John Dudmesh
+
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+
+// You should have received a copy of the GNU General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>.
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"html/template"
+	"io"
+	"io/fs"
+	"log"
+	"net/http"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+	"sync"
+
+	"github.com/jdudmesh/gomon/pkg/client"
+)
+
+// envTemplates matches the variable set by gomon, see internal/process/env.go
+const envTemplates = "GOMON_TEMPLATES"
+
+// ErrNoTemplates is returned by New if there are no patterns, either from WithPatterns or GOMON_TEMPLATES
+var ErrNoTemplates = errors.New("no template patterns, set templates.paths in the gomon config file")
+
+// Reloader is implemented by anything which can be reloaded when gomon sends a soft reload, e.g. Templates
+type Reloader interface {
+	Reload() error
+}
+
+// Templates is a set of templates which can be reloaded while they are being executed
+type Templates struct {
+	fsys     fs.FS
+	patterns []string
+	layout   string
+	partials []string
+	funcs    template.FuncMap
+	onError  func(error)
+	lock     sync.RWMutex
+	set      *templateSet
+}
+
+// Option configures Templates
+type Option func(*Templates)
+
+// WithPatterns sets the patterns of the template files instead of reading them from GOMON_TEMPLATES. Patterns
+// without a path separator match file names anywhere beneath the directory and "**" matches any number of
+// directories, in the same way as gomon's reload rules.
+func WithPatterns(patterns ...string) Option {
+	return func(t *Templates) {
+		t.patterns = patterns
+	}
+}
+
+// WithDirectory sets the directory the patterns are relative to, by default this is the working directory
+func WithDirectory(dir string) Option {
+	return func(t *Templates) {
+		t.fsys = os.DirFS(dir)
+	}
+}
+
+// WithFS reads the templates from fsys instead of a directory, the patterns are relative to its root
+func WithFS(fsys fs.FS) Option {
+	return func(t *Templates) {
+		t.fsys = fsys
+	}
+}
+
+// WithLayouts wraps each page in the files called name (e.g. "layout.html") in its directory and the directories
+// above it. The outermost layout is executed when a page is rendered, each layout fills the blocks of the one above
+// it and the page fills the blocks of the innermost layout:
+//
+//	layout.html:       <html><body>{{ block "content" . }}{{ end }}</body></html>
+//	admin/layout.html: {{ define "content" }}<nav>admin</nav>{{ block "admin" . }}{{ end }}{{ end }}
+//	admin/users.html:  {{ define "admin" }}<ul>users</ul>{{ end }}
+//
+// Pages are named by their path e.g. "admin/users.html".
+func WithLayouts(name string) Option {
+	return func(t *Templates) {
+		t.layout = name
+	}
+}
+
+// WithPartials parses the files matching patterns into every page rather than treating them as pages, they are
+// named after their files e.g. {{ template "nav.html" . }}. Pages are named by their path.
+func WithPartials(patterns ...string) Option {
+	return func(t *Templates) {
+		t.partials = patterns
+	}
+}
+
+// WithFuncs adds functions to the templates' function map, it is applied on every reload
+func WithFuncs(funcs template.FuncMap) Option {
+	return func(t *Templates) {
+		t.funcs = funcs
+	}
+}
+
+// WithErrorHandler is called when templates can't be reloaded after a change, by default the error is logged
+func WithErrorHandler(fn func(error)) Option {
+	return func(t *Templates) {
+		t.onError = fn
+	}
+}
+
+// New parses the templates, an error is returned if they can't be parsed
+func New(opts ...Option) (*Templates, error) {
+	t := &Templates{
+		fsys:    os.DirFS("."),
+		onError: func(err error) { log.Printf("reloading templates: %v", err) },
+	}
+	if env := os.Getenv(envTemplates); env != "" {
+		t.patterns = filepath.SplitList(env)
+	}
+
+	for _, opt := range opts {
+		opt(t)
+	}
+
+	if len(t.patterns) == 0 {
+		return nil, ErrNoTemplates
+	}
+
+	err := t.Reload()
+	if err != nil {
+		return nil, err
+	}
+	return t, nil
+}
+
+// Reload parses the templates again, the previous templates are kept if any of them can't be parsed
+func (t *Templates) Reload() error {
+	files, err := t.files()
+	if err != nil {
+		return err
+	}
+	if len(files) == 0 {
+		return fmt.Errorf("no templates match %s", strings.Join(t.patterns, ", "))
+	}
+
+	set, err := t.parse(files)
+	if err != nil {
+		return fmt.Errorf("parsing templates: %w", err)
+	}
+
+	t.lock.Lock()
+	t.set = set
+	t.lock.Unlock()
+	return nil
+}
+
+func (t *Templates) current() *templateSet {
+	t.lock.RLock()
+	defer t.lock.RUnlock()
+
+	return t.set
+}
+
+// Template returns the current templates, or the partials if pages are parsed separately. It shouldn't be kept as
+// it is replaced on each reload.
+func (t *Templates) Template() *template.Template {
+	return t.current().shared
+}
+
+// ExecuteTemplate executes the named page in its layouts, or any other template by name, with the current
+// templates
+func (t *Templates) ExecuteTemplate(w io.Writer, name string, data any) error {
+	return t.current().execute(w, name, data)
+}
+
+// Render executes the named template and writes it as the response with the status code, nothing is written if it
+// fails so the caller can still respond with an error
+func (t *Templates) Render(w http.ResponseWriter, status int, name string, data any) error {
+	buf := &bytes.Buffer{}
+	err := t.ExecuteTemplate(buf, name, data)
+	if err != nil {
+		return err
+	}
+
+	if w.Header().Get("Content-Type") == "" {
+		w.Header().Set("Content-Type", "text/html; charset=utf-8")
+	}
+	w.WriteHeader(status)
+	_, err = buf.WriteTo(w)
+	return err
+}
+
+// ListenAndServe reloads the templates each time gomon sends a soft reload, then tells gomon so that browsers are
+// reloaded once the new templates are in use. It returns when ctx is cancelled, or straight away if the program
+// isn't being run by gomon.
+func (t *Templates) ListenAndServe(ctx context.Context) error {
+	return ListenAndServe(ctx, t.onError, t)
+}
+
+// ListenAndServe reloads each of reloaders in turn each time gomon sends a soft reload, then tells gomon so that
+// browsers are reloaded. Errors are passed to onError and gomon isn't told about a reload which failed. It returns
+// when ctx is cancelled, or straight away if the program isn't being run by gomon.
+func ListenAndServe(ctx context.Context, onError func(error), reloaders ...Reloader) error {
+	c := client.New(client.WithErrorHandler(onError))
+	c.OnSoftReload(func([]string) error {
+		for _, r := range reloaders {
+			err := r.Reload()
+			if err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	return c.ListenAndServe(ctx)
+}
+
+// files returns the files which match the patterns in the order they were matched
+func (t *Templates) files() ([]string, error) {
+	files := []string{}
+	err := fs.WalkDir(t.fsys, ".", func(filePath string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if d.IsDir() {
+			// e.g. .git
+			if filePath != "." && strings.HasPrefix(d.Name(), ".") {
+				return fs.SkipDir
+			}
+			return nil
+		}
+		if matchAny(t.patterns, filePath) || matchAny(t.partials, filePath) {
+			files = append(files, filePath)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("finding templates: %w", err)
+	}
+	return files, nil
+}
+
+func matchAny(patterns []string, relPath string) bool {
+	for _, patt := range patterns {
+		if matchPattern(patt, relPath) {
+			return true
+		}
+	}
+	return false
+}
+
+// matchPattern is the same as gomon's matching of reload rules
+func matchPattern(pattern, relPath string) bool {
+	pattern = strings.TrimPrefix(filepath.ToSlash(pattern), "./")
+	relPath = filepath.ToSlash(relPath)
+
+	if !strings.Contains(pattern, "/") {
+		match, _ := path.Match(pattern, path.Base(relPath))
+		return match
+	}
+
+	return matchSegments(strings.Split(pattern, "/"), strings.Split(relPath, "/"))
+}
+
+func matchSegments(pattern, parts []string) bool {
+	for len(pattern) > 0 {
+		if pattern[0] == "**" {
+			for len(pattern) > 0 && pattern[0] == "**" {
+				pattern = pattern[1:]
+			}
+			if len(pattern) == 0 {
+				return true
+			}
+			for i := range parts {
+				if matchSegments(pattern, parts[i:]) {
+					return true
+				}
+			}
+			return false
+		}
+
+		if len(parts) == 0 {
+			return false
+		}
+		match, err := path.Match(pattern[0], parts[0])
+		if err != nil || !match {
+			return false
+		}
+		pattern = pattern[1:]
+		parts = parts[1:]
+	}
+
+	return len(parts) == 0
+}
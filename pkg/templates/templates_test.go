@@ -0,0 +1,182 @@
+package templates
+
+// gomon is a simple command line tool that watches your files and automatically restarts the application when it detects any changes in the working directory.
+// Copyright (C) 2023 John Dudmesh
+
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+
+// You should have received a copy of the GNU General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>.
+
+import (
+	"bytes"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+	"testing/fstest"
+)
+
+func writeFile(t *testing.T, name, contents string) {
+	t.Helper()
+	err := os.MkdirAll(filepath.Dir(name), 0755)
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = os.WriteFile(name, []byte(contents), 0644)
+	if err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestReload(t *testing.T) {
+	dir := t.TempDir()
+	writeFile(t, filepath.Join(dir, "views", "index.html"), `Hello {{ . }}`)
+	writeFile(t, filepath.Join(dir, "views", "partials", "nav.html"), `<nav></nav>`)
+	writeFile(t, filepath.Join(dir, "README.md"), `{{ broken`)
+
+	tmpl, err := New(WithDirectory(dir), WithPatterns("views/**/*.html"))
+	if err != nil {
+		t.Fatalf("creating templates: %v", err)
+	}
+	if tmpl.Template().Lookup("nav.html") == nil {
+		t.Error("expected templates in subdirectories to be parsed")
+	}
+
+	execute := func() string {
+		out := &bytes.Buffer{}
+		err := tmpl.ExecuteTemplate(out, "index.html", "World")
+		if err != nil {
+			t.Fatalf("executing template: %v", err)
+		}
+		return out.String()
+	}
+	if got := execute(); got != "Hello World" {
+		t.Errorf("unexpected output: %s", got)
+	}
+
+	writeFile(t, filepath.Join(dir, "views", "index.html"), `Goodbye {{ . }}`)
+	err = tmpl.Reload()
+	if err != nil {
+		t.Fatalf("reloading templates: %v", err)
+	}
+	if got := execute(); got != "Goodbye World" {
+		t.Errorf("expected the changed template, got %s", got)
+	}
+
+	writeFile(t, filepath.Join(dir, "views", "index.html"), `{{ broken`)
+	err = tmpl.Reload()
+	if err == nil {
+		t.Fatal("expected an error for an invalid template")
+	}
+	if got := execute(); got != "Goodbye World" {
+		t.Errorf("expected the previous templates to be kept, got %s", got)
+	}
+}
+
+func TestPatternsFromEnvironment(t *testing.T) {
+	dir := t.TempDir()
+	writeFile(t, filepath.Join(dir, "index.tmpl"), `index`)
+
+	t.Setenv(envTemplates, "")
+	_, err := New(WithDirectory(dir))
+	if !errors.Is(err, ErrNoTemplates) {
+		t.Errorf("expected ErrNoTemplates, got %v", err)
+	}
+
+	t.Setenv(envTemplates, "*.html"+string(filepath.ListSeparator)+"*.tmpl")
+	tmpl, err := New(WithDirectory(dir))
+	if err != nil {
+		t.Fatalf("creating templates: %v", err)
+	}
+	if tmpl.Template().Lookup("index.tmpl") == nil {
+		t.Error("expected the patterns to be read from the environment")
+	}
+}
+
+func TestLayoutsAndPartials(t *testing.T) {
+	fsys := fstest.MapFS{
+		"layout.html":          {Data: []byte(`<main>{{ template "nav.html" . }}{{ block "content" . }}default{{ end }}</main>`)},
+		"admin/layout.html":    {Data: []byte(`{{ define "content" }}<aside>admin</aside>{{ block "admin" . }}{{ end }}{{ end }}`)},
+		"admin/users.html":     {Data: []byte(`{{ define "admin" }}users of {{ . }}{{ end }}`)},
+		"index.html":           {Data: []byte(`{{ define "content" }}home of {{ . }}{{ end }}`)},
+		"about.html":           {Data: []byte(``)},
+		"partials/nav.html":    {Data: []byte(`<nav></nav>`)},
+		"partials/ignored.txt": {Data: []byte(`{{ broken`)},
+	}
+
+	tmpl, err := New(WithFS(fsys), WithPatterns("*.html"), WithLayouts("layout.html"), WithPartials("partials/*.html"))
+	if err != nil {
+		t.Fatalf("creating templates: %v", err)
+	}
+
+	tests := []struct {
+		page string
+		want string
+	}{
+		{"index.html", "<main><nav></nav>home of gomon</main>"},
+		{"admin/users.html", "<main><nav></nav><aside>admin</aside>users of gomon</main>"},
+		{"about.html", "<main><nav></nav>default</main>"},
+		{"nav.html", "<nav></nav>"},
+	}
+	for _, tt := range tests {
+		out := &bytes.Buffer{}
+		err := tmpl.ExecuteTemplate(out, tt.page, "gomon")
+		if err != nil {
+			t.Errorf("executing %s: %v", tt.page, err)
+			continue
+		}
+		if out.String() != tt.want {
+			t.Errorf("%s: expected %s, got %s", tt.page, tt.want, out.String())
+		}
+	}
+
+	fsys["admin/layout.html"] = &fstest.MapFile{Data: []byte(`{{ define "content" }}<aside>changed</aside>{{ block "admin" . }}{{ end }}{{ end }}`)}
+	err = tmpl.Reload()
+	if err != nil {
+		t.Fatalf("reloading templates: %v", err)
+	}
+	out := &bytes.Buffer{}
+	err = tmpl.ExecuteTemplate(out, "admin/users.html", "gomon")
+	if err != nil || out.String() != "<main><nav></nav><aside>changed</aside>users of gomon</main>" {
+		t.Errorf("expected the changed layout, got %s %v", out.String(), err)
+	}
+}
+
+func TestRender(t *testing.T) {
+	fsys := fstest.MapFS{
+		"index.html": {Data: []byte(`Hello {{ .Name }}`)},
+	}
+	tmpl, err := New(WithFS(fsys), WithPatterns("*.html"))
+	if err != nil {
+		t.Fatalf("creating templates: %v", err)
+	}
+
+	rec := httptest.NewRecorder()
+	err = tmpl.Render(rec, http.StatusCreated, "index.html", map[string]string{"Name": "<World>"})
+	if err != nil {
+		t.Fatalf("rendering: %v", err)
+	}
+	if rec.Code != http.StatusCreated || rec.Header().Get("Content-Type") != "text/html; charset=utf-8" || rec.Body.String() != "Hello &lt;World&gt;" {
+		t.Errorf("unexpected response: %d %s %s", rec.Code, rec.Header().Get("Content-Type"), rec.Body.String())
+	}
+
+	rec = httptest.NewRecorder()
+	err = tmpl.Render(rec, http.StatusOK, "missing.html", nil)
+	if err == nil {
+		t.Fatal("expected an error for a missing template")
+	}
+	if rec.Body.Len() != 0 || rec.Code != http.StatusOK {
+		t.Errorf("expected nothing to be written, got %d %s", rec.Code, rec.Body.String())
+	}
+}